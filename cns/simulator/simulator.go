@@ -18,7 +18,10 @@ package simulator
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,6 +31,9 @@ import (
 	cnstypes "github.com/vmware/govmomi/cns/types"
 	pbmtypes "github.com/vmware/govmomi/pbm/types"
 	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	vim25methods "github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
 	vim25types "github.com/vmware/govmomi/vim25/types"
 )
@@ -55,6 +61,11 @@ func New() *simulator.Registry {
 	return r
 }
 
+// CnsVolumeManager simulates the CNS volume manager.
+// Block volumes are backed by FCDs (first class disks) of the vim25 VcenterVStorageObjectManager,
+// such that the volume ID is the FCD ID, attaching a volume adds a disk device to the VM
+// and volume snapshots are FCD snapshots. File volumes and statically provisioned volumes
+// with a BackingDiskId that is not an FCD are tracked by the CnsVolumeManager only.
 type CnsVolumeManager struct {
 	vim25types.ManagedObjectReference
 	volumes     map[vim25types.ManagedObjectReference]map[cnstypes.CnsVolumeId]*cnstypes.CnsVolume
@@ -64,95 +75,194 @@ type CnsVolumeManager struct {
 
 const simulatorDiskUUID = "6000c298595bf4575739e9105b2c0c2d"
 
-func (m *CnsVolumeManager) CnsCreateVolume(ctx *simulator.Context, req *cnstypes.CnsCreateVolume) soap.HasFault {
-	task := simulator.CreateTask(m, "CnsCreateVolume", func(*simulator.Task) (vim25types.AnyType, vim25types.BaseMethodFault) {
-		if len(req.CreateSpecs) == 0 {
-			return nil, &vim25types.InvalidArgument{InvalidProperty: "CnsVolumeCreateSpec"}
+// vim returns a Context for the vim25 endpoint, which owns the datastores, FCDs and VMs.
+func vim(ctx *simulator.Context) *simulator.Context {
+	return ctx.For(vim25.Path)
+}
+
+func vStorageObjectManager(ctx *simulator.Context) *simulator.VcenterVStorageObjectManager {
+	si := ctx.Map.Get(vim25.ServiceInstance).(*simulator.ServiceInstance)
+	return ctx.Map.Get(*si.Content.VStorageObjectManager).(*simulator.VcenterVStorageObjectManager)
+}
+
+// runTask invokes the given vim25 task method with a lock held on obj and waits for the task to complete.
+func runTask(ctx *simulator.Context, obj mo.Reference, method func() vim25types.ManagedObjectReference) (vim25types.AnyType, vim25types.BaseMethodFault) {
+	var ref vim25types.ManagedObjectReference
+	ctx.WithLock(obj, func() {
+		ref = method()
+	})
+
+	task := ctx.Map.Get(ref).(*simulator.Task)
+	task.Wait()
+
+	if task.Info.Error != nil {
+		return nil, task.Info.Error.Fault
+	}
+	return task.Info.Result, nil
+}
+
+// fcd returns the FCD backing the given volume, if any.
+func fcd(ctx *simulator.Context, ds vim25types.ManagedObjectReference, id string) *vim25types.VStorageObject {
+	m := vStorageObjectManager(ctx)
+	var res soap.HasFault
+	ctx.WithLock(m, func() {
+		res = m.RetrieveVStorageObject(ctx, &vim25types.RetrieveVStorageObject{
+			This:      m.Self,
+			Id:        vim25types.ID{Id: id},
+			Datastore: ds,
+		})
+	})
+	if res.Fault() != nil {
+		return nil
+	}
+	return &res.(*vim25methods.RetrieveVStorageObjectBody).Res.Returnval
+}
+
+// volume returns the volume with the given ID and the datastore it was created on.
+func (m *CnsVolumeManager) volume(id cnstypes.CnsVolumeId) (vim25types.ManagedObjectReference, *cnstypes.CnsVolume) {
+	for ds, dsVolumes := range m.volumes {
+		if volume, ok := dsVolumes[id]; ok {
+			return ds, volume
 		}
+	}
+	return vim25types.ManagedObjectReference{}, nil
+}
 
-		operationResult := []cnstypes.BaseCnsVolumeOperationResult{}
-		for _, createSpec := range req.CreateSpecs {
-			staticProvisionedSpec, ok := interface{}(createSpec.BackingObjectDetails).(*cnstypes.CnsBlockBackingDetails)
-			if ok && staticProvisionedSpec.BackingDiskId != "" {
-				datastore := simulator.Map.Any("Datastore").(*simulator.Datastore)
-				volumes, ok := m.volumes[datastore.Self]
-				if !ok {
-					volumes = make(map[cnstypes.CnsVolumeId]*cnstypes.CnsVolume)
-					m.volumes[datastore.Self] = volumes
-				}
-				newVolume := &cnstypes.CnsVolume{
-					VolumeId: cnstypes.CnsVolumeId{
-						Id: interface{}(createSpec.BackingObjectDetails).(*cnstypes.CnsBlockBackingDetails).BackingDiskId,
-					},
-					Name:                         createSpec.Name,
-					VolumeType:                   createSpec.VolumeType,
-					DatastoreUrl:                 datastore.Info.GetDatastoreInfo().Url,
-					Metadata:                     createSpec.Metadata,
-					BackingObjectDetails:         createSpec.BackingObjectDetails.(cnstypes.BaseCnsBackingObjectDetails).GetCnsBackingObjectDetails(),
-					ComplianceStatus:             "Simulator Compliance Status",
-					DatastoreAccessibilityStatus: "Simulator Datastore Accessibility Status",
-					HealthStatus:                 string(pbmtypes.PbmHealthStatusForEntityGreen),
-				}
+func volumeFault(id cnstypes.CnsVolumeId, fault vim25types.BaseMethodFault) *cnstypes.CnsVolumeOperationResult {
+	return &cnstypes.CnsVolumeOperationResult{
+		VolumeId: id,
+		Fault: &vim25types.LocalizedMethodFault{
+			Fault:            fault,
+			LocalizedMessage: fmt.Sprintf("%T", fault),
+		},
+	}
+}
 
-				volumes[newVolume.VolumeId] = newVolume
-				placementResults := []cnstypes.CnsPlacementResult{}
-				placementResults = append(placementResults, cnstypes.CnsPlacementResult{
-					Datastore: datastore.Reference(),
-				})
-				operationResult = append(operationResult, &cnstypes.CnsVolumeCreateResult{
-					CnsVolumeOperationResult: cnstypes.CnsVolumeOperationResult{
-						VolumeId: newVolume.VolumeId,
-					},
-					Name:             createSpec.Name,
-					PlacementResults: placementResults,
-				})
+func volumeNotFound(id cnstypes.CnsVolumeId) *cnstypes.CnsVolumeOperationResult {
+	return volumeFault(id, cnstypes.CnsVolumeNotFoundFault{VolumeId: id})
+}
 
-			} else {
-				for _, datastoreRef := range createSpec.Datastores {
-					datastore := simulator.Map.Get(datastoreRef).(*simulator.Datastore)
+func newVolume(ds *simulator.Datastore, spec cnstypes.CnsVolumeCreateSpec, id string, details cnstypes.BaseCnsBackingObjectDetails) *cnstypes.CnsVolume {
+	var policyId string
+	if spec.Profile != nil && spec.Profile[0] != nil &&
+		reflect.TypeOf(spec.Profile[0]) == reflect.TypeOf(&vim25types.VirtualMachineDefinedProfileSpec{}) {
+		policyId = interface{}(spec.Profile[0]).(*vim25types.VirtualMachineDefinedProfileSpec).ProfileId
+	}
 
-					volumes, ok := m.volumes[datastore.Self]
-					if !ok {
-						volumes = make(map[cnstypes.CnsVolumeId]*cnstypes.CnsVolume)
-						m.volumes[datastore.Self] = volumes
+	return &cnstypes.CnsVolume{
+		VolumeId: cnstypes.CnsVolumeId{
+			Id: id,
+		},
+		Name:                         spec.Name,
+		VolumeType:                   spec.VolumeType,
+		DatastoreUrl:                 ds.Info.GetDatastoreInfo().Url,
+		Metadata:                     spec.Metadata,
+		BackingObjectDetails:         details,
+		ComplianceStatus:             "Simulator Compliance Status",
+		DatastoreAccessibilityStatus: "Simulator Datastore Accessibility Status",
+		HealthStatus:                 string(pbmtypes.PbmHealthStatusForEntityGreen),
+		StoragePolicyId:              policyId,
+	}
+}
 
-					}
+// createVolume creates a volume on the first of the spec's datastores, or any datastore if none are specified.
+// Block volumes are created as an FCD, statically provisioned volumes use the FCD with the spec's BackingDiskId, if any.
+func (m *CnsVolumeManager) createVolume(ctx *simulator.Context, spec cnstypes.CnsVolumeCreateSpec) (*simulator.Datastore, *cnstypes.CnsVolume, vim25types.BaseMethodFault) {
+	ctx = vim(ctx)
+
+	var ds *simulator.Datastore
+	if len(spec.Datastores) == 0 {
+		ds = ctx.Map.Any("Datastore").(*simulator.Datastore)
+	} else {
+		var ok bool
+		if ds, ok = ctx.Map.Get(spec.Datastores[0]).(*simulator.Datastore); !ok {
+			return nil, nil, &vim25types.ManagedObjectNotFound{Obj: spec.Datastores[0]}
+		}
+	}
 
-					var policyId string
-					if createSpec.Profile != nil && createSpec.Profile[0] != nil &&
-						reflect.TypeOf(createSpec.Profile[0]) == reflect.TypeOf(&vim25types.VirtualMachineDefinedProfileSpec{}) {
-						policyId = interface{}(createSpec.Profile[0]).(*vim25types.VirtualMachineDefinedProfileSpec).ProfileId
-					}
+	capacity := spec.BackingObjectDetails.GetCnsBackingObjectDetails().CapacityInMb
 
-					newVolume := &cnstypes.CnsVolume{
-						VolumeId: cnstypes.CnsVolumeId{
-							Id: uuid.New().String(),
-						},
-						Name:                         createSpec.Name,
-						VolumeType:                   createSpec.VolumeType,
-						DatastoreUrl:                 datastore.Info.GetDatastoreInfo().Url,
-						Metadata:                     createSpec.Metadata,
-						BackingObjectDetails:         createSpec.BackingObjectDetails.(cnstypes.BaseCnsBackingObjectDetails).GetCnsBackingObjectDetails(),
-						ComplianceStatus:             "Simulator Compliance Status",
-						DatastoreAccessibilityStatus: "Simulator Datastore Accessibility Status",
-						HealthStatus:                 string(pbmtypes.PbmHealthStatusForEntityGreen),
-						StoragePolicyId:              policyId,
-					}
+	if block, ok := spec.BackingObjectDetails.(*cnstypes.CnsBlockBackingDetails); ok && block.BackingDiskId != "" {
+		// static provisioning
+		for _, e := range ctx.Map.All("Datastore") {
+			if disk := fcd(ctx, e.Reference(), block.BackingDiskId); disk != nil {
+				ds = e.(*simulator.Datastore)
+				block.BackingDiskPath = disk.Config.Backing.(*vim25types.BaseConfigInfoDiskFileBackingInfo).FilePath
+				block.CapacityInMb = disk.Config.CapacityInMB
+				break
+			}
+		}
+		return ds, newVolume(ds, spec, block.BackingDiskId, block), nil
+	}
 
-					volumes[newVolume.VolumeId] = newVolume
-					placementResults := []cnstypes.CnsPlacementResult{}
-					placementResults = append(placementResults, cnstypes.CnsPlacementResult{
-						Datastore: datastore.Reference(),
-					})
-					operationResult = append(operationResult, &cnstypes.CnsVolumeCreateResult{
-						CnsVolumeOperationResult: cnstypes.CnsVolumeOperationResult{
-							VolumeId: newVolume.VolumeId,
-						},
-						Name:             createSpec.Name,
-						PlacementResults: placementResults,
-					})
-				}
+	if spec.VolumeType == string(cnstypes.CnsVolumeTypeFile) {
+		return ds, newVolume(ds, spec, uuid.New().String(), spec.BackingObjectDetails), nil
+	}
+
+	om := vStorageObjectManager(ctx)
+	req := &vim25types.CreateDisk_Task{
+		This: om.Self,
+		Spec: vim25types.VslmCreateSpec{
+			Name:         spec.Name,
+			CapacityInMB: capacity,
+			Profile:      spec.Profile,
+			BackingSpec: &vim25types.VslmCreateSpecDiskFileBackingSpec{
+				VslmCreateSpecBackingSpec: vim25types.VslmCreateSpecBackingSpec{
+					Datastore: ds.Self,
+				},
+				ProvisioningType: string(vim25types.BaseConfigInfoDiskFileBackingInfoProvisioningTypeThin),
+			},
+		},
+	}
+
+	res, fault := runTask(ctx, om, func() vim25types.ManagedObjectReference {
+		return om.CreateDiskTask(ctx, req).(*vim25methods.CreateDisk_TaskBody).Res.Returnval
+	})
+	if fault != nil {
+		return nil, nil, fault
+	}
+
+	disk := res.(*vim25types.VStorageObject)
+	details := &cnstypes.CnsBlockBackingDetails{
+		CnsBackingObjectDetails: cnstypes.CnsBackingObjectDetails{
+			CapacityInMb: capacity,
+		},
+		BackingDiskId:   disk.Config.Id.Id,
+		BackingDiskPath: disk.Config.Backing.(*vim25types.BaseConfigInfoDiskFileBackingInfo).FilePath,
+	}
+
+	return ds, newVolume(ds, spec, disk.Config.Id.Id, details), nil
+}
+
+func (m *CnsVolumeManager) CnsCreateVolume(ctx *simulator.Context, req *cnstypes.CnsCreateVolume) soap.HasFault {
+	task := simulator.CreateTask(m, "CnsCreateVolume", func(*simulator.Task) (vim25types.AnyType, vim25types.BaseMethodFault) {
+		if len(req.CreateSpecs) == 0 {
+			return nil, &vim25types.InvalidArgument{InvalidProperty: "CnsVolumeCreateSpec"}
+		}
+
+		operationResult := []cnstypes.BaseCnsVolumeOperationResult{}
+		for _, createSpec := range req.CreateSpecs {
+			datastore, volume, fault := m.createVolume(ctx, createSpec)
+			if fault != nil {
+				return nil, fault
 			}
+
+			volumes, ok := m.volumes[datastore.Self]
+			if !ok {
+				volumes = make(map[cnstypes.CnsVolumeId]*cnstypes.CnsVolume)
+				m.volumes[datastore.Self] = volumes
+			}
+			volumes[volume.VolumeId] = volume
+
+			operationResult = append(operationResult, &cnstypes.CnsVolumeCreateResult{
+				CnsVolumeOperationResult: cnstypes.CnsVolumeOperationResult{
+					VolumeId: volume.VolumeId,
+				},
+				Name: createSpec.Name,
+				PlacementResults: []cnstypes.CnsPlacementResult{{
+					Datastore: datastore.Self,
+				}},
+			})
 		}
 
 		return &cnstypes.CnsVolumeOperationBatchResult{
@@ -167,36 +277,50 @@ func (m *CnsVolumeManager) CnsCreateVolume(ctx *simulator.Context, req *cnstypes
 	}
 }
 
-// CnsQueryVolume simulates the query volumes implementation for CNSQuery API
-func (m *CnsVolumeManager) CnsQueryVolume(ctx context.Context, req *cnstypes.CnsQueryVolume) soap.HasFault {
-	retVolumes := []cnstypes.CnsVolume{}
-	reqVolumeIds := make(map[string]bool)
-	isQueryFilter := false
+// queryVolumes returns the volumes matching the VolumeIds, Names, ContainerClusterIds and Datastores of the given filter.
+func (m *CnsVolumeManager) queryVolumes(filter cnstypes.CnsQueryFilter) []cnstypes.CnsVolume {
+	volumes := []cnstypes.CnsVolume{}
 
-	if req.Filter.VolumeIds != nil {
-		isQueryFilter = true
-	}
-	// Create map of requested volume Ids in query request
-	for _, volumeID := range req.Filter.VolumeIds {
-		reqVolumeIds[volumeID.Id] = true
+	clusterMatch := func(volume *cnstypes.CnsVolume) bool {
+		if len(filter.ContainerClusterIds) == 0 {
+			return true
+		}
+		if slices.Contains(filter.ContainerClusterIds, volume.Metadata.ContainerCluster.ClusterId) {
+			return true
+		}
+		return slices.ContainsFunc(volume.Metadata.ContainerClusterArray, func(c cnstypes.CnsContainerCluster) bool {
+			return slices.Contains(filter.ContainerClusterIds, c.ClusterId)
+		})
 	}
 
-	for _, dsVolumes := range m.volumes {
+	for ds, dsVolumes := range m.volumes {
+		if len(filter.Datastores) != 0 && !slices.Contains(filter.Datastores, ds) {
+			continue
+		}
+
 		for _, volume := range dsVolumes {
-			if isQueryFilter {
-				if _, ok := reqVolumeIds[volume.VolumeId.Id]; ok {
-					retVolumes = append(retVolumes, *volume)
-				}
-			} else {
-				retVolumes = append(retVolumes, *volume)
+			if len(filter.VolumeIds) != 0 && !slices.Contains(filter.VolumeIds, volume.VolumeId) {
+				continue
+			}
+			if len(filter.Names) != 0 && !slices.Contains(filter.Names, volume.Name) {
+				continue
 			}
+			if !clusterMatch(volume) {
+				continue
+			}
+			volumes = append(volumes, *volume)
 		}
 	}
 
+	return volumes
+}
+
+// CnsQueryVolume simulates the query volumes implementation for CNSQuery API
+func (m *CnsVolumeManager) CnsQueryVolume(ctx context.Context, req *cnstypes.CnsQueryVolume) soap.HasFault {
 	return &methods.CnsQueryVolumeBody{
 		Res: &cnstypes.CnsQueryVolumeResponse{
 			Returnval: cnstypes.CnsQueryResult{
-				Volumes: retVolumes,
+				Volumes: m.queryVolumes(req.Filter),
 				Cursor:  cnstypes.CnsCursor{},
 			},
 		},
@@ -205,34 +329,10 @@ func (m *CnsVolumeManager) CnsQueryVolume(ctx context.Context, req *cnstypes.Cns
 
 // CnsQueryAllVolume simulates the query volumes implementation for CNSQueryAll API
 func (m *CnsVolumeManager) CnsQueryAllVolume(ctx context.Context, req *cnstypes.CnsQueryAllVolume) soap.HasFault {
-	retVolumes := []cnstypes.CnsVolume{}
-	reqVolumeIds := make(map[string]bool)
-	isQueryFilter := false
-
-	if req.Filter.VolumeIds != nil {
-		isQueryFilter = true
-	}
-	// Create map of requested volume Ids in query request
-	for _, volumeID := range req.Filter.VolumeIds {
-		reqVolumeIds[volumeID.Id] = true
-	}
-
-	for _, dsVolumes := range m.volumes {
-		for _, volume := range dsVolumes {
-			if isQueryFilter {
-				if _, ok := reqVolumeIds[volume.VolumeId.Id]; ok {
-					retVolumes = append(retVolumes, *volume)
-				}
-			} else {
-				retVolumes = append(retVolumes, *volume)
-			}
-		}
-	}
-
 	return &methods.CnsQueryAllVolumeBody{
 		Res: &cnstypes.CnsQueryAllVolumeResponse{
 			Returnval: cnstypes.CnsQueryResult{
-				Volumes: retVolumes,
+				Volumes: m.queryVolumes(req.Filter),
 				Cursor:  cnstypes.CnsCursor{},
 			},
 		},
@@ -243,16 +343,37 @@ func (m *CnsVolumeManager) CnsDeleteVolume(ctx *simulator.Context, req *cnstypes
 	task := simulator.CreateTask(m, "CnsDeleteVolume", func(*simulator.Task) (vim25types.AnyType, vim25types.BaseMethodFault) {
 		operationResult := []cnstypes.BaseCnsVolumeOperationResult{}
 		for _, volumeId := range req.VolumeIds {
-			for ds, dsVolumes := range m.volumes {
-				volume := dsVolumes[volumeId]
-				if volume != nil {
-					delete(m.volumes[ds], volumeId)
-					operationResult = append(operationResult, &cnstypes.CnsVolumeOperationResult{
-						VolumeId: volumeId,
-					})
+			ds, volume := m.volume(volumeId)
+			if volume == nil {
+				continue
+			}
 
+			if req.DeleteDisk {
+				vctx := vim(ctx)
+				if disk := fcd(vctx, ds, volumeId.Id); disk != nil {
+					om := vStorageObjectManager(vctx)
+					_, fault := runTask(vctx, om, func() vim25types.ManagedObjectReference {
+						return om.DeleteVStorageObjectTask(vctx, &vim25types.DeleteVStorageObject_Task{
+							This:      om.Self,
+							Id:        disk.Config.Id,
+							Datastore: ds,
+						}).(*vim25methods.DeleteVStorageObject_TaskBody).Res.Returnval
+					})
+					if fault != nil {
+						if _, ok := fault.(*vim25types.InvalidState); ok {
+							fault = &vim25types.ResourceInUse{Name: volumeId.Id}
+						}
+						operationResult = append(operationResult, volumeFault(volumeId, fault))
+						continue
+					}
 				}
 			}
+
+			delete(m.volumes[ds], volumeId)
+			delete(m.snapshots, volumeId)
+			operationResult = append(operationResult, &cnstypes.CnsVolumeOperationResult{
+				VolumeId: volumeId,
+			})
 		}
 		return &cnstypes.CnsVolumeOperationBatchResult{
 			VolumeResults: operationResult,
@@ -304,21 +425,59 @@ func (m *CnsVolumeManager) CnsAttachVolume(ctx *simulator.Context, req *cnstypes
 		if len(req.AttachSpecs) == 0 {
 			return nil, &vim25types.InvalidArgument{InvalidProperty: "CnsAttachVolumeSpec"}
 		}
+		vctx := vim(ctx)
 		operationResult := []cnstypes.BaseCnsVolumeOperationResult{}
 		for _, attachSpec := range req.AttachSpecs {
-			node := simulator.Map.Get(attachSpec.Vm).(*simulator.VirtualMachine)
-			if _, ok := m.attachments[attachSpec.VolumeId]; !ok {
-				m.attachments[attachSpec.VolumeId] = node.Self
-			} else {
+			node, ok := vctx.Map.Get(attachSpec.Vm).(*simulator.VirtualMachine)
+			if !ok {
+				return nil, &vim25types.ManagedObjectNotFound{Obj: attachSpec.Vm}
+			}
+			if _, ok := m.attachments[attachSpec.VolumeId]; ok {
 				return nil, &vim25types.ResourceInUse{
 					Name: attachSpec.VolumeId.Id,
 				}
 			}
+
+			diskUUID := simulatorDiskUUID
+
+			ds, volume := m.volume(attachSpec.VolumeId)
+			if volume == nil {
+				operationResult = append(operationResult, volumeNotFound(attachSpec.VolumeId))
+				continue
+			}
+
+			if disk := fcd(vctx, ds, attachSpec.VolumeId.Id); disk != nil {
+				_, fault := runTask(vctx, node, func() vim25types.ManagedObjectReference {
+					return node.AttachDiskTask(vctx, &vim25types.AttachDisk_Task{
+						This:      node.Self,
+						DiskId:    disk.Config.Id,
+						Datastore: ds,
+					}).(*vim25methods.AttachDisk_TaskBody).Res.Returnval
+				})
+				if fault != nil {
+					operationResult = append(operationResult, volumeFault(attachSpec.VolumeId, fault))
+					continue
+				}
+
+				vctx.WithLock(node, func() {
+					for _, device := range node.Config.Hardware.Device {
+						vdisk, ok := device.(*vim25types.VirtualDisk)
+						if !ok || vdisk.VDiskId == nil || vdisk.VDiskId.Id != disk.Config.Id.Id {
+							continue
+						}
+						if backing, ok := vdisk.Backing.(*vim25types.VirtualDiskFlatVer2BackingInfo); ok {
+							diskUUID = strings.ReplaceAll(backing.Uuid, "-", "")
+						}
+					}
+				})
+			}
+
+			m.attachments[attachSpec.VolumeId] = node.Self
 			operationResult = append(operationResult, &cnstypes.CnsVolumeAttachResult{
 				CnsVolumeOperationResult: cnstypes.CnsVolumeOperationResult{
 					VolumeId: attachSpec.VolumeId,
 				},
-				DiskUUID: simulatorDiskUUID,
+				DiskUUID: diskUUID,
 			})
 		}
 
@@ -340,18 +499,36 @@ func (m *CnsVolumeManager) CnsDetachVolume(ctx *simulator.Context, req *cnstypes
 		if len(req.DetachSpecs) == 0 {
 			return nil, &vim25types.InvalidArgument{InvalidProperty: "CnsDetachVolumeSpec"}
 		}
+		vctx := vim(ctx)
 		operationResult := []cnstypes.BaseCnsVolumeOperationResult{}
 		for _, detachSpec := range req.DetachSpecs {
-			if _, ok := m.attachments[detachSpec.VolumeId]; ok {
-				delete(m.attachments, detachSpec.VolumeId)
-				operationResult = append(operationResult, &cnstypes.CnsVolumeOperationResult{
-					VolumeId: detachSpec.VolumeId,
-				})
-			} else {
+			ref, ok := m.attachments[detachSpec.VolumeId]
+			if !ok {
 				return nil, &vim25types.InvalidArgument{
 					InvalidProperty: detachSpec.VolumeId.Id,
 				}
 			}
+
+			ds, _ := m.volume(detachSpec.VolumeId)
+			if disk := fcd(vctx, ds, detachSpec.VolumeId.Id); disk != nil {
+				if node, ok := vctx.Map.Get(ref).(*simulator.VirtualMachine); ok {
+					_, fault := runTask(vctx, node, func() vim25types.ManagedObjectReference {
+						return node.DetachDiskTask(vctx, &vim25types.DetachDisk_Task{
+							This:   node.Self,
+							DiskId: disk.Config.Id,
+						}).(*vim25methods.DetachDisk_TaskBody).Res.Returnval
+					})
+					if fault != nil {
+						operationResult = append(operationResult, volumeFault(detachSpec.VolumeId, fault))
+						continue
+					}
+				}
+			}
+
+			delete(m.attachments, detachSpec.VolumeId)
+			operationResult = append(operationResult, &cnstypes.CnsVolumeOperationResult{
+				VolumeId: detachSpec.VolumeId,
+			})
 		}
 
 		return &cnstypes.CnsVolumeOperationBatchResult{
@@ -371,22 +548,39 @@ func (m *CnsVolumeManager) CnsExtendVolume(ctx *simulator.Context, req *cnstypes
 		if len(req.ExtendSpecs) == 0 {
 			return nil, &vim25types.InvalidArgument{InvalidProperty: "CnsExtendVolumeSpec"}
 		}
+		vctx := vim(ctx)
 		operationResult := []cnstypes.BaseCnsVolumeOperationResult{}
 
 		for _, extendSpecs := range req.ExtendSpecs {
-			for _, dsVolumes := range m.volumes {
-				for id, volume := range dsVolumes {
-					if id.Id == extendSpecs.VolumeId.Id {
-						volume.BackingObjectDetails = &cnstypes.CnsBackingObjectDetails{
-							CapacityInMb: extendSpecs.CapacityInMb,
-						}
-						operationResult = append(operationResult, &cnstypes.CnsVolumeOperationResult{
-							VolumeId: volume.VolumeId,
-						})
-						break
-					}
+			ds, volume := m.volume(extendSpecs.VolumeId)
+			if volume == nil {
+				operationResult = append(operationResult, volumeNotFound(extendSpecs.VolumeId))
+				continue
+			}
+
+			if disk := fcd(vctx, ds, extendSpecs.VolumeId.Id); disk != nil {
+				om := vStorageObjectManager(vctx)
+				_, fault := runTask(vctx, om, func() vim25types.ManagedObjectReference {
+					return om.ExtendDiskTask(vctx, &vim25types.ExtendDisk_Task{
+						This:            om.Self,
+						Id:              disk.Config.Id,
+						Datastore:       ds,
+						NewCapacityInMB: extendSpecs.CapacityInMb,
+					}).(*vim25methods.ExtendDisk_TaskBody).Res.Returnval
+				})
+				if fault != nil {
+					operationResult = append(operationResult, volumeFault(extendSpecs.VolumeId, fault))
+					continue
 				}
 			}
+
+			if volume.BackingObjectDetails == nil {
+				volume.BackingObjectDetails = new(cnstypes.CnsBackingObjectDetails)
+			}
+			volume.BackingObjectDetails.GetCnsBackingObjectDetails().CapacityInMb = extendSpecs.CapacityInMb
+			operationResult = append(operationResult, &cnstypes.CnsVolumeOperationResult{
+				VolumeId: volume.VolumeId,
+			})
 		}
 
 		return &cnstypes.CnsVolumeOperationBatchResult{
@@ -403,15 +597,35 @@ func (m *CnsVolumeManager) CnsExtendVolume(ctx *simulator.Context, req *cnstypes
 
 func (m *CnsVolumeManager) CnsQueryVolumeInfo(ctx *simulator.Context, req *cnstypes.CnsQueryVolumeInfo) soap.HasFault {
 	task := simulator.CreateTask(m, "CnsQueryVolumeInfo", func(*simulator.Task) (vim25types.AnyType, vim25types.BaseMethodFault) {
+		vctx := vim(ctx)
 		operationResult := []cnstypes.BaseCnsVolumeOperationResult{}
 		for _, volumeId := range req.VolumeIds {
+			ds, volume := m.volume(volumeId)
+			if volume == nil {
+				operationResult = append(operationResult, volumeNotFound(volumeId))
+				continue
+			}
+
+			if disk := fcd(vctx, ds, volumeId.Id); disk != nil {
+				operationResult = append(operationResult, &cnstypes.CnsQueryVolumeInfoResult{
+					CnsVolumeOperationResult: cnstypes.CnsVolumeOperationResult{
+						VolumeId: volumeId,
+					},
+					VolumeInfo: &cnstypes.CnsBlockVolumeInfo{
+						CnsVolumeInfo:  cnstypes.CnsVolumeInfo{},
+						VStorageObject: *disk,
+					},
+				})
+				continue
+			}
+
 			vstorageObject := vim25types.VStorageObject{
 				Config: vim25types.VStorageObjectConfigInfo{
 					BaseConfigInfo: vim25types.BaseConfigInfo{
 						Id: vim25types.ID{
 							Id: uuid.New().String(),
 						},
-						Name:                        "name",
+						Name:                        volume.Name,
 						CreateTime:                  time.Now(),
 						KeepAfterDeleteVm:           vim25types.NewBool(true),
 						RelocationDisabled:          vim25types.NewBool(false),
@@ -427,7 +641,7 @@ func (m *CnsVolumeManager) CnsQueryVolumeInfo(ctx *simulator.Context, req *cnsty
 			vstorageObject.Config.Backing = &vim25types.BaseConfigInfoDiskFileBackingInfo{
 				BaseConfigInfoFileBackingInfo: vim25types.BaseConfigInfoFileBackingInfo{
 					BaseConfigInfoBackingInfo: vim25types.BaseConfigInfoBackingInfo{
-						Datastore: simulator.Map.Any("Datastore").(*simulator.Datastore).Self,
+						Datastore: ds,
 					},
 					FilePath:        "[vsanDatastore] 6785a85e-268e-6352-a2e8-02008b7afadd/kubernetes-dynamic-pvc-68734c9f-a679-42e6-a694-39632c51e31f.vmdk",
 					BackingObjectId: volumeId.Id,
@@ -510,38 +724,57 @@ func (m *CnsVolumeManager) CnsCreateSnapshots(ctx *simulator.Context, req *cnsty
 			return nil, &vim25types.InvalidArgument{InvalidProperty: "CnsSnapshotCreateSpec"}
 		}
 
+		vctx := vim(ctx)
 		snapshotOperationResult := []cnstypes.BaseCnsVolumeOperationResult{}
 		for _, snapshotCreateSpec := range req.SnapshotSpecs {
-			for _, dsVolumes := range m.volumes {
-				for id, _ := range dsVolumes {
-					if id.Id != snapshotCreateSpec.VolumeId.Id {
-						continue
-					}
-					snapshots, ok := m.snapshots[snapshotCreateSpec.VolumeId]
-					if !ok {
-						snapshots = make(map[cnstypes.CnsSnapshotId]*cnstypes.CnsSnapshot)
-						m.snapshots[snapshotCreateSpec.VolumeId] = snapshots
-					}
+			ds, volume := m.volume(snapshotCreateSpec.VolumeId)
+			if volume == nil {
+				snapshotOperationResult = append(snapshotOperationResult, volumeNotFound(snapshotCreateSpec.VolumeId))
+				continue
+			}
 
-					newSnapshot := &cnstypes.CnsSnapshot{
-						SnapshotId: cnstypes.CnsSnapshotId{
-							Id: uuid.New().String(),
-						},
-						VolumeId:    snapshotCreateSpec.VolumeId,
+			id := uuid.New().String()
+
+			if disk := fcd(vctx, ds, snapshotCreateSpec.VolumeId.Id); disk != nil {
+				om := vStorageObjectManager(vctx)
+				res, fault := runTask(vctx, om, func() vim25types.ManagedObjectReference {
+					return om.VStorageObjectCreateSnapshotTask(vctx, &vim25types.VStorageObjectCreateSnapshot_Task{
+						This:        om.Self,
+						Id:          disk.Config.Id,
+						Datastore:   ds,
 						Description: snapshotCreateSpec.Description,
-						CreateTime:  time.Now(),
-					}
-					snapshots[newSnapshot.SnapshotId] = newSnapshot
-					snapshotOperationResult = append(snapshotOperationResult, &cnstypes.CnsSnapshotCreateResult{
-						CnsSnapshotOperationResult: cnstypes.CnsSnapshotOperationResult{
-							CnsVolumeOperationResult: cnstypes.CnsVolumeOperationResult{
-								VolumeId: newSnapshot.VolumeId,
-							},
-						},
-						Snapshot: *newSnapshot,
-					})
+					}).(*vim25methods.VStorageObjectCreateSnapshot_TaskBody).Res.Returnval
+				})
+				if fault != nil {
+					snapshotOperationResult = append(snapshotOperationResult, volumeFault(snapshotCreateSpec.VolumeId, fault))
+					continue
 				}
+				id = res.(*vim25types.ID).Id
+			}
+
+			snapshots, ok := m.snapshots[snapshotCreateSpec.VolumeId]
+			if !ok {
+				snapshots = make(map[cnstypes.CnsSnapshotId]*cnstypes.CnsSnapshot)
+				m.snapshots[snapshotCreateSpec.VolumeId] = snapshots
 			}
+
+			newSnapshot := &cnstypes.CnsSnapshot{
+				SnapshotId: cnstypes.CnsSnapshotId{
+					Id: id,
+				},
+				VolumeId:    snapshotCreateSpec.VolumeId,
+				Description: snapshotCreateSpec.Description,
+				CreateTime:  time.Now(),
+			}
+			snapshots[newSnapshot.SnapshotId] = newSnapshot
+			snapshotOperationResult = append(snapshotOperationResult, &cnstypes.CnsSnapshotCreateResult{
+				CnsSnapshotOperationResult: cnstypes.CnsSnapshotOperationResult{
+					CnsVolumeOperationResult: cnstypes.CnsVolumeOperationResult{
+						VolumeId: newSnapshot.VolumeId,
+					},
+				},
+				Snapshot: *newSnapshot,
+			})
 		}
 
 		return &cnstypes.CnsVolumeOperationBatchResult{
@@ -558,28 +791,43 @@ func (m *CnsVolumeManager) CnsCreateSnapshots(ctx *simulator.Context, req *cnsty
 
 func (m *CnsVolumeManager) CnsDeleteSnapshots(ctx *simulator.Context, req *cnstypes.CnsDeleteSnapshots) soap.HasFault {
 	task := simulator.CreateTask(m, "DeleteSnapshots", func(*simulator.Task) (vim25types.AnyType, vim25types.BaseMethodFault) {
+		vctx := vim(ctx)
 		snapshotOperationResult := []cnstypes.BaseCnsVolumeOperationResult{}
 		for _, snapshotDeleteSpec := range req.SnapshotDeleteSpecs {
-			for _, dsVolumes := range m.volumes {
-				for id, _ := range dsVolumes {
-					if id.Id != snapshotDeleteSpec.VolumeId.Id {
-						continue
-					}
-					snapshots := m.snapshots[snapshotDeleteSpec.VolumeId]
-					snapshot, ok := snapshots[snapshotDeleteSpec.SnapshotId]
-					if ok {
-						delete(m.snapshots[snapshotDeleteSpec.VolumeId], snapshotDeleteSpec.SnapshotId)
-						snapshotOperationResult = append(snapshotOperationResult, &cnstypes.CnsSnapshotDeleteResult{
-							CnsSnapshotOperationResult: cnstypes.CnsSnapshotOperationResult{
-								CnsVolumeOperationResult: cnstypes.CnsVolumeOperationResult{
-									VolumeId: snapshot.VolumeId,
-								},
-							},
-							SnapshotId: snapshot.SnapshotId,
-						})
-					}
+			ds, volume := m.volume(snapshotDeleteSpec.VolumeId)
+			if volume == nil {
+				continue
+			}
+			snapshot, ok := m.snapshots[snapshotDeleteSpec.VolumeId][snapshotDeleteSpec.SnapshotId]
+			if !ok {
+				continue
+			}
+
+			if disk := fcd(vctx, ds, snapshotDeleteSpec.VolumeId.Id); disk != nil {
+				om := vStorageObjectManager(vctx)
+				_, fault := runTask(vctx, om, func() vim25types.ManagedObjectReference {
+					return om.DeleteSnapshotTask(vctx, &vim25types.DeleteSnapshot_Task{
+						This:       om.Self,
+						Id:         disk.Config.Id,
+						Datastore:  ds,
+						SnapshotId: vim25types.ID{Id: snapshot.SnapshotId.Id},
+					}).(*vim25methods.DeleteSnapshot_TaskBody).Res.Returnval
+				})
+				if fault != nil {
+					snapshotOperationResult = append(snapshotOperationResult, volumeFault(snapshotDeleteSpec.VolumeId, fault))
+					continue
 				}
 			}
+
+			delete(m.snapshots[snapshotDeleteSpec.VolumeId], snapshotDeleteSpec.SnapshotId)
+			snapshotOperationResult = append(snapshotOperationResult, &cnstypes.CnsSnapshotDeleteResult{
+				CnsSnapshotOperationResult: cnstypes.CnsSnapshotOperationResult{
+					CnsVolumeOperationResult: cnstypes.CnsVolumeOperationResult{
+						VolumeId: snapshot.VolumeId,
+					},
+				},
+				SnapshotId: snapshot.SnapshotId,
+			})
 		}
 
 		return &cnstypes.CnsVolumeOperationBatchResult{
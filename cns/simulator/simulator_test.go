@@ -18,6 +18,8 @@ package simulator
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -25,8 +27,11 @@ import (
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/cns"
 	cnstypes "github.com/vmware/govmomi/cns/types"
+	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
 	vim25types "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vslm"
 )
 
 const (
@@ -585,3 +590,207 @@ func TestSimulator(t *testing.T) {
 	}
 
 }
+
+func TestSimulatorFCD(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		cnsClient, err := cns.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := vslm.NewObjectManager(c)
+
+		result := func(task *object.Task, err error) cnstypes.BaseCnsVolumeOperationResult {
+			t.Helper()
+			if err != nil {
+				t.Fatal(err)
+			}
+			info, err := cns.GetTaskInfo(ctx, task)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := cns.GetTaskResult(ctx, info)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return res
+		}
+
+		datastore := simulator.Map.Any("Datastore").(*simulator.Datastore)
+		vm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		ndevices := len(vm.Config.Hardware.Device)
+
+		res := result(cnsClient.CreateVolume(ctx, []cnstypes.CnsVolumeCreateSpec{{
+			Name:       "pvc-1",
+			VolumeType: string(cnstypes.CnsVolumeTypeBlock),
+			Datastores: []vim25types.ManagedObjectReference{datastore.Self},
+			BackingObjectDetails: &cnstypes.CnsBackingObjectDetails{
+				CapacityInMb: 10,
+			},
+		}}))
+		if res.GetCnsVolumeOperationResult().Fault != nil {
+			t.Fatal(res.GetCnsVolumeOperationResult().Fault)
+		}
+		volumeId := res.GetCnsVolumeOperationResult().VolumeId
+
+		// the volume is an FCD
+		disk, err := m.Retrieve(ctx, datastore, volumeId.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if disk.Config.Name != "pvc-1" || disk.Config.CapacityInMB != 10 {
+			t.Errorf("disk=%#v", disk.Config)
+		}
+
+		// static provisioning of an existing FCD
+		res = result(cnsClient.CreateVolume(ctx, []cnstypes.CnsVolumeCreateSpec{{
+			Name:       "pv-1",
+			VolumeType: string(cnstypes.CnsVolumeTypeBlock),
+			BackingObjectDetails: &cnstypes.CnsBlockBackingDetails{
+				BackingDiskId: volumeId.Id,
+			},
+		}}))
+		if id := res.GetCnsVolumeOperationResult().VolumeId; id != volumeId {
+			t.Errorf("volume id=%s", id.Id)
+		}
+		volume := res.(*cnstypes.CnsVolumeCreateResult)
+		if volume.PlacementResults[0].Datastore != datastore.Self {
+			t.Errorf("datastore=%s", volume.PlacementResults[0].Datastore)
+		}
+
+		// attach adds a disk device to the VM
+		res = result(cnsClient.AttachVolume(ctx, []cnstypes.CnsVolumeAttachDetachSpec{{VolumeId: volumeId, Vm: vm.Self}}))
+		if res.GetCnsVolumeOperationResult().Fault != nil {
+			t.Fatal(res.GetCnsVolumeOperationResult().Fault)
+		}
+		devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
+		if len(devices) != ndevices+1 {
+			t.Fatalf("%d devices", len(devices))
+		}
+		vdisk := devices[len(devices)-1].(*vim25types.VirtualDisk)
+		if vdisk.VDiskId == nil || vdisk.VDiskId.Id != volumeId.Id {
+			t.Errorf("vDiskId=%#v", vdisk.VDiskId)
+		}
+		uuid := vdisk.Backing.(*vim25types.VirtualDiskFlatVer2BackingInfo).Uuid
+		if res.(*cnstypes.CnsVolumeAttachResult).DiskUUID != strings.ReplaceAll(uuid, "-", "") {
+			t.Errorf("disk uuid=%s", res.(*cnstypes.CnsVolumeAttachResult).DiskUUID)
+		}
+
+		// extend
+		res = result(cnsClient.ExtendVolume(ctx, []cnstypes.CnsVolumeExtendSpec{{VolumeId: volumeId, CapacityInMb: 20}}))
+		if res.GetCnsVolumeOperationResult().Fault != nil {
+			t.Fatal(res.GetCnsVolumeOperationResult().Fault)
+		}
+		disk, err = m.Retrieve(ctx, datastore, volumeId.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if disk.Config.CapacityInMB != 20 {
+			t.Errorf("capacity=%d", disk.Config.CapacityInMB)
+		}
+
+		// snapshots are FCD snapshots
+		res = result(cnsClient.CreateSnapshots(ctx, []cnstypes.CnsSnapshotCreateSpec{{VolumeId: volumeId, Description: "snap-1"}}))
+		snapshot := res.(*cnstypes.CnsSnapshotCreateResult).Snapshot
+		info, err := m.RetrieveSnapshotInfo(ctx, datastore, volumeId.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(info.Snapshots) != 1 || info.Snapshots[0].Id.Id != snapshot.SnapshotId.Id {
+			t.Errorf("snapshots=%#v", info.Snapshots)
+		}
+
+		res = result(cnsClient.DeleteSnapshots(ctx, []cnstypes.CnsSnapshotDeleteSpec{{VolumeId: volumeId, SnapshotId: snapshot.SnapshotId}}))
+		if res.GetCnsVolumeOperationResult().Fault != nil {
+			t.Fatal(res.GetCnsVolumeOperationResult().Fault)
+		}
+		info, err = m.RetrieveSnapshotInfo(ctx, datastore, volumeId.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(info.Snapshots) != 0 {
+			t.Errorf("snapshots=%#v", info.Snapshots)
+		}
+
+		// an attached volume cannot be deleted
+		res = result(cnsClient.DeleteVolume(ctx, []cnstypes.CnsVolumeId{volumeId}, true))
+		if fault := res.GetCnsVolumeOperationResult().Fault; fault == nil {
+			t.Error("expected fault")
+		} else if _, ok := fault.Fault.(*vim25types.ResourceInUse); !ok {
+			t.Errorf("fault=%T", fault.Fault)
+		}
+
+		// detach removes the disk device
+		res = result(cnsClient.DetachVolume(ctx, []cnstypes.CnsVolumeAttachDetachSpec{{VolumeId: volumeId}}))
+		if res.GetCnsVolumeOperationResult().Fault != nil {
+			t.Fatal(res.GetCnsVolumeOperationResult().Fault)
+		}
+		if n := len(vm.Config.Hardware.Device); n != ndevices {
+			t.Errorf("%d devices", n)
+		}
+
+		res = result(cnsClient.DeleteVolume(ctx, []cnstypes.CnsVolumeId{volumeId}, true))
+		if res.GetCnsVolumeOperationResult().Fault != nil {
+			t.Fatal(res.GetCnsVolumeOperationResult().Fault)
+		}
+		if _, err = m.Retrieve(ctx, datastore, volumeId.Id); err == nil {
+			t.Error("disk not deleted")
+		}
+	})
+}
+
+func TestSimulatorQueryFilter(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		cnsClient, err := cns.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		datastore := simulator.Map.Any("Datastore").(*simulator.Datastore)
+
+		for i, cluster := range []string{"cluster-a", "cluster-a", "cluster-b"} {
+			task, err := cnsClient.CreateVolume(ctx, []cnstypes.CnsVolumeCreateSpec{{
+				Name:       fmt.Sprintf("pvc-%d", i),
+				VolumeType: string(cnstypes.CnsVolumeTypeBlock),
+				Datastores: []vim25types.ManagedObjectReference{datastore.Self},
+				Metadata: cnstypes.CnsVolumeMetadata{
+					ContainerCluster: cnstypes.CnsContainerCluster{
+						ClusterType: string(cnstypes.CnsClusterTypeKubernetes),
+						ClusterId:   cluster,
+					},
+				},
+				BackingObjectDetails: &cnstypes.CnsBackingObjectDetails{
+					CapacityInMb: 10,
+				},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		tests := []struct {
+			filter cnstypes.CnsQueryFilter
+			expect int
+		}{
+			{cnstypes.CnsQueryFilter{}, 3},
+			{cnstypes.CnsQueryFilter{Names: []string{"pvc-1"}}, 1},
+			{cnstypes.CnsQueryFilter{Names: []string{"pvc-0", "pvc-2"}}, 2},
+			{cnstypes.CnsQueryFilter{ContainerClusterIds: []string{"cluster-a"}}, 2},
+			{cnstypes.CnsQueryFilter{ContainerClusterIds: []string{"cluster-b"}, Names: []string{"pvc-0"}}, 0},
+			{cnstypes.CnsQueryFilter{Datastores: []vim25types.ManagedObjectReference{datastore.Self}}, 3},
+			{cnstypes.CnsQueryFilter{Names: []string{"enoent"}}, 0},
+		}
+
+		for _, test := range tests {
+			res, err := cnsClient.QueryVolume(ctx, test.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Volumes) != test.expect {
+				t.Errorf("%#v: %d volumes", test.filter, len(res.Volumes))
+			}
+		}
+	})
+}
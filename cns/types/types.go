@@ -309,7 +309,7 @@ type CnsVolume struct {
 	VolumeType                   string                      `xml:"volumeType,omitempty"`
 	StoragePolicyId              string                      `xml:"storagePolicyId,omitempty"`
 	Metadata                     CnsVolumeMetadata           `xml:"metadata,omitempty"`
-	BackingObjectDetails         BaseCnsBackingObjectDetails `xml:"backingObjectDetails,omitempty"`
+	BackingObjectDetails         BaseCnsBackingObjectDetails `xml:"backingObjectDetails,omitempty,typeattr"`
 	ComplianceStatus             string                      `xml:"complianceStatus,omitempty"`
 	DatastoreAccessibilityStatus string                      `xml:"datastoreAccessibilityStatus,omitempty"`
 	HealthStatus                 string                      `xml:"healthStatus,omitempty"`
@@ -328,6 +328,31 @@ func (m ManagerKmip) ListKeys(
 	return res.Returnval, nil
 }
 
+// Bits for the checkKeyBitMap parameter of QueryCryptoKeyStatus.
+const (
+	CheckKeyAvailable   = int32(0x01)
+	CheckKeyUsedByVms   = int32(0x02)
+	CheckKeyUsedByHosts = int32(0x04)
+	CheckKeyUsedByOther = int32(0x08)
+)
+
+func (m ManagerKmip) QueryCryptoKeyStatus(
+	ctx context.Context,
+	ids []types.CryptoKeyId,
+	check int32) ([]types.CryptoManagerKmipCryptoKeyStatus, error) {
+
+	req := types.QueryCryptoKeyStatus{
+		This:           m.Reference(),
+		KeyIds:         ids,
+		CheckKeyBitMap: check,
+	}
+	res, err := methods.QueryCryptoKeyStatus(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Returnval, nil
+}
+
 func (m ManagerKmip) IsValidKey(
 	ctx context.Context,
 	keyID string) (bool, error) {
@@ -386,6 +411,28 @@ func (m ManagerKmip) IsValidServer(
 	return false, nil
 }
 
+func (m ManagerKmip) GetServer(
+	ctx context.Context,
+	providerID, serverName string) (*types.KmipServerInfo, error) {
+
+	clusters, err := m.ListKmipServers(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range clusters {
+		if clusters[i].ClusterId.Id == providerID {
+			for j := range clusters[i].Servers {
+				if clusters[i].Servers[j].Name == serverName {
+					return &clusters[i].Servers[j], nil
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("invalid server name")
+}
+
 func (m ManagerKmip) GenerateKey(
 	ctx context.Context,
 	providerID string) (string, error) {
@@ -426,3 +473,92 @@ func (e generateKeyError) Error() string {
 func (e generateKeyError) GetLocalizedMethodFault() *types.LocalizedMethodFault {
 	return &e.LocalizedMethodFault
 }
+
+func (m ManagerKmip) RetrieveKmipServerCert(
+	ctx context.Context,
+	providerID string,
+	server types.KmipServerInfo) (*types.CryptoManagerKmipServerCertInfo, error) {
+
+	req := types.RetrieveKmipServerCert{
+		This: m.Reference(),
+		KeyProvider: types.KeyProviderId{
+			Id: providerID,
+		},
+		Server: server,
+	}
+	res, err := methods.RetrieveKmipServerCert(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Returnval, nil
+}
+
+func (m ManagerKmip) UploadKmipServerCert(
+	ctx context.Context,
+	providerID, certificate string) error {
+
+	req := types.UploadKmipServerCert{
+		This: m.Reference(),
+		Cluster: types.KeyProviderId{
+			Id: providerID,
+		},
+		Certificate: certificate,
+	}
+	_, err := methods.UploadKmipServerCert(ctx, m.Client(), &req)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m ManagerKmip) RetrieveClientCert(
+	ctx context.Context,
+	providerID string) (string, error) {
+
+	req := types.RetrieveClientCert{
+		This: m.Reference(),
+		Cluster: types.KeyProviderId{
+			Id: providerID,
+		},
+	}
+	res, err := methods.RetrieveClientCert(ctx, m.Client(), &req)
+	if err != nil {
+		return "", err
+	}
+	return res.Returnval, nil
+}
+
+func (m ManagerKmip) GenerateSelfSignedClientCert(
+	ctx context.Context,
+	providerID string) (string, error) {
+
+	req := types.GenerateSelfSignedClientCert{
+		This: m.Reference(),
+		Cluster: types.KeyProviderId{
+			Id: providerID,
+		},
+	}
+	res, err := methods.GenerateSelfSignedClientCert(ctx, m.Client(), &req)
+	if err != nil {
+		return "", err
+	}
+	return res.Returnval, nil
+}
+
+func (m ManagerKmip) UpdateSelfSignedClientCert(
+	ctx context.Context,
+	providerID, certificate string) error {
+
+	req := types.UpdateSelfSignedClientCert{
+		This: m.Reference(),
+		Cluster: types.KeyProviderId{
+			Id: providerID,
+		},
+		Certificate: certificate,
+	}
+	_, err := methods.UpdateSelfSignedClientCert(ctx, m.Client(), &req)
+	if err != nil {
+		return err
+	}
+	return nil
+}
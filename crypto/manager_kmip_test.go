@@ -20,12 +20,14 @@ import (
 	"context"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/vmware/govmomi/crypto"
 	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25"
@@ -965,10 +967,21 @@ func TestCryptoManagerKmip(t *testing.T) {
 			keys, err = m.ListKeys(ctx, types.NewInt32(1))
 			assert.NoError(t, err)
 			assert.Len(t, keys, 1)
+			assert.Equal(t, keyID1, keys[0].KeyId)
 
 			keys, err = m.ListKeys(ctx, types.NewInt32(2))
 			assert.NoError(t, err)
 			assert.Len(t, keys, 2)
+			assert.Equal(t, keyID1, keys[0].KeyId)
+			assert.Equal(t, keyID2, keys[1].KeyId)
+
+			// Results are returned in the order keys were generated
+			for i := 0; i < 10; i++ {
+				keys, err = m.ListKeys(ctx, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{keyID1, keyID2, keyID3},
+					[]string{keys[0].KeyId, keys[1].KeyId, keys[2].KeyId})
+			}
 		})
 	})
 
@@ -995,4 +1008,243 @@ func TestCryptoManagerKmip(t *testing.T) {
 			assert.True(t, ok)
 		})
 	})
+
+	t.Run("QueryCryptoKeyStatus", func(t *testing.T) {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			m, err := crypto.GetManagerKmip(c)
+			assert.NoError(t, err)
+
+			providerID := uuid.NewString()
+
+			assert.NoError(t, m.RegisterKmipCluster(
+				ctx,
+				providerID,
+				types.KmipClusterInfoKmsManagementTypeUnknown))
+
+			keyID, err := m.GenerateKey(ctx, providerID)
+			assert.NoError(t, err)
+
+			key := types.CryptoKeyId{
+				KeyId:      keyID,
+				ProviderId: &types.KeyProviderId{Id: providerID},
+			}
+			missing := types.CryptoKeyId{
+				KeyId:      uuid.NewString(),
+				ProviderId: &types.KeyProviderId{Id: providerID},
+			}
+
+			status, err := m.QueryCryptoKeyStatus(
+				ctx, []types.CryptoKeyId{key, missing}, crypto.CheckKeyAvailable)
+			assert.NoError(t, err)
+			assert.Len(t, status, 2)
+			assert.False(t, *status[0].KeyAvailable)
+			assert.Equal(t,
+				string(types.CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateClusterUnreachable),
+				status[0].Reason)
+			assert.False(t, *status[1].KeyAvailable)
+			assert.Equal(t,
+				string(types.CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateMissingInKMS),
+				status[1].Reason)
+
+			assert.NoError(t, m.RegisterKmipServer(
+				ctx,
+				types.KmipServerSpec{
+					ClusterId: types.KeyProviderId{Id: providerID},
+					Info: types.KmipServerInfo{
+						Name:    "kms1",
+						Address: "kms1.local",
+						Port:    5696,
+					},
+				}))
+
+			finder := find.NewFinder(c)
+			vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+			assert.NoError(t, err)
+
+			task, err := vm.PowerOff(ctx)
+			assert.NoError(t, err)
+			assert.NoError(t, task.Wait(ctx))
+
+			task, err = vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{
+				Crypto: &types.CryptoSpecEncrypt{
+					CryptoKeyId: key,
+				},
+			})
+			assert.NoError(t, err)
+			assert.NoError(t, task.Wait(ctx))
+
+			status, err = m.QueryCryptoKeyStatus(
+				ctx,
+				[]types.CryptoKeyId{key},
+				crypto.CheckKeyAvailable|crypto.CheckKeyUsedByVms|crypto.CheckKeyUsedByHosts)
+			assert.NoError(t, err)
+			assert.Len(t, status, 1)
+			assert.True(t, *status[0].KeyAvailable)
+			assert.Empty(t, status[0].Reason)
+			assert.Equal(t, []types.ManagedObjectReference{vm.Reference()}, status[0].EncryptedVMs)
+			assert.Empty(t, status[0].AffectedHosts)
+
+			status, err = m.QueryCryptoKeyStatus(
+				ctx, []types.CryptoKeyId{key}, crypto.CheckKeyUsedByHosts)
+			assert.NoError(t, err)
+			assert.Len(t, status, 1)
+			assert.Nil(t, status[0].KeyAvailable)
+			assert.Empty(t, status[0].EncryptedVMs)
+
+			assert.NoError(t, m.UnregisterKmsCluster(ctx, providerID))
+
+			status, err = m.QueryCryptoKeyStatus(
+				ctx, []types.CryptoKeyId{key}, crypto.CheckKeyAvailable)
+			assert.NoError(t, err)
+			assert.Len(t, status, 1)
+			assert.False(t, *status[0].KeyAvailable)
+			assert.Equal(t,
+				string(types.CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateClusterInvalid),
+				status[0].Reason)
+		})
+	})
+
+	t.Run("Trust", func(t *testing.T) {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			m, err := crypto.GetManagerKmip(c)
+			assert.NoError(t, err)
+
+			providerID := uuid.NewString()
+			server := types.KmipServerInfo{
+				Name:    uuid.NewString(),
+				Address: "kms.example.com",
+			}
+
+			_, err = m.RetrieveKmipServerCert(ctx, providerID, server)
+			assert.EqualError(t, err, "ServerFaultCode: Invalid cluster ID")
+
+			assert.NoError(t, m.RegisterKmipCluster(
+				ctx,
+				providerID,
+				types.KmipClusterInfoKmsManagementTypeVCenter))
+			assert.NoError(t, m.RegisterKmipServer(ctx, types.KmipServerSpec{
+				ClusterId: types.KeyProviderId{
+					Id: providerID,
+				},
+				Info: server,
+			}))
+
+			// vCenter connects to the server's Address and Port, the Name is not sufficient
+			_, err = m.RetrieveKmipServerCert(ctx, providerID, types.KmipServerInfo{Name: server.Name})
+			assert.EqualError(t, err, "ServerFaultCode: Invalid server")
+
+			info, err := m.GetServer(ctx, providerID, server.Name)
+			assert.NoError(t, err)
+			assert.Equal(t, server.Address, info.Address)
+
+			_, err = m.GetServer(ctx, providerID, "enoent")
+			assert.EqualError(t, err, "invalid server name")
+
+			status, err := m.GetServerStatus(ctx, providerID, server.Name)
+			assert.NoError(t, err)
+			assert.False(t, *status.ClientTrustServer)
+			assert.False(t, *status.ServerTrustClient)
+
+			// vCenter trusts the KMS server
+			cert, err := m.RetrieveKmipServerCert(ctx, providerID, server)
+			assert.NoError(t, err)
+			assert.Contains(t, cert.Certificate, "BEGIN CERTIFICATE")
+			assert.Equal(t, "CN=kms.example.com,O=VMware", cert.CertInfo.Subject)
+			assert.False(t, *cert.ClientTrustServer)
+
+			err = m.UploadKmipServerCert(ctx, providerID, "invalid")
+			assert.True(t, fault.Is(err, &types.InvalidArgument{}))
+			assert.NoError(t, m.UploadKmipServerCert(ctx, providerID, cert.Certificate))
+
+			// KMS server trusts vCenter
+			clientCert, err := m.RetrieveClientCert(ctx, providerID)
+			assert.NoError(t, err)
+			assert.Empty(t, clientCert)
+
+			clientCert, err = m.GenerateSelfSignedClientCert(ctx, providerID)
+			assert.NoError(t, err)
+			assert.NoError(t, m.UpdateSelfSignedClientCert(ctx, providerID, clientCert))
+
+			pem, err := m.RetrieveClientCert(ctx, providerID)
+			assert.NoError(t, err)
+			assert.Equal(t, clientCert, pem)
+
+			status, err = m.GetServerStatus(ctx, providerID, server.Name)
+			assert.NoError(t, err)
+			assert.True(t, *status.ClientTrustServer)
+			assert.True(t, *status.ServerTrustClient)
+			assert.Equal(t, cert.CertInfo.Fingerprint, status.CertInfo.Fingerprint)
+
+			clusterStatus, err := m.GetClusterStatus(ctx, providerID)
+			assert.NoError(t, err)
+			assert.Equal(t, "CN=vCenter,O=VMware", clusterStatus.ClientCertInfo.Subject)
+		})
+	})
+
+	t.Run("InjectFault", func(t *testing.T) {
+		simulator.Test(func(ctx context.Context, c *vim25.Client) {
+			m, err := crypto.GetManagerKmip(c)
+			assert.NoError(t, err)
+
+			sm := simulator.Map.Get(m.Reference()).(*simulator.CryptoManagerKmip)
+
+			providerID := uuid.NewString()
+
+			assert.NoError(t, m.RegisterKmipCluster(
+				ctx,
+				providerID,
+				types.KmipClusterInfoKmsManagementTypeUnknown))
+
+			// Fault applies to the next call only
+			sm.InjectFault("GenerateKey", simulator.CryptoManagerKmipFault{
+				Fault:   &types.InvalidState{},
+				Message: "KMS unavailable",
+				Count:   1,
+			})
+
+			_, err = m.GenerateKey(ctx, providerID)
+			assert.EqualError(t, err, "ServerFaultCode: KMS unavailable")
+			assert.True(t, fault.Is(err, &types.InvalidState{}))
+
+			keyID, err := m.GenerateKey(ctx, providerID)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, keyID)
+
+			// Fault applies until cleared
+			sm.InjectFault("RegisterKmsCluster", simulator.CryptoManagerKmipFault{
+				Fault: &types.AlreadyExists{},
+			})
+
+			for i := 0; i < 2; i++ {
+				err = m.RegisterKmipCluster(
+					ctx,
+					uuid.NewString(),
+					types.KmipClusterInfoKmsManagementTypeUnknown)
+				assert.True(t, fault.Is(err, &types.AlreadyExists{}))
+			}
+
+			sm.ClearFault("RegisterKmsCluster")
+
+			assert.NoError(t, m.RegisterKmipCluster(
+				ctx,
+				uuid.NewString(),
+				types.KmipClusterInfoKmsManagementTypeUnknown))
+
+			// Delay only, to simulate a KMS timeout
+			sm.InjectFault("ListKmipServers", simulator.CryptoManagerKmipFault{
+				Delay: time.Second,
+			})
+
+			tctx, cancel := context.WithTimeout(ctx, time.Second/10)
+			defer cancel()
+			_, err = m.ListKmipServers(tctx, nil)
+			assert.Error(t, err)
+
+			sm.ClearFault("")
+
+			clusters, err := m.ListKmipServers(ctx, nil)
+			assert.NoError(t, err)
+			assert.Len(t, clusters, 2)
+		})
+	})
 }
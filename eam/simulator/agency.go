@@ -78,12 +78,74 @@ func NewAgency(
 	// Alias the registry that contains the vim25 objects.
 	vimMap := simulator.Map
 
-	// Create the agents.
-	for i, agentConfig := range agencyConfig.AgentConfig {
+	// addAgent creates an agent VM named after the agent's index.
+	addAgent := func(
+		i int,
+		agentConfig types.AgentConfigInfo,
+		vmPlacement AgentVMPlacementOptions) vim.BaseMethodFault {
 
 		// vmName follows the defined pattern for naming agent VMs
 		vmName := fmt.Sprintf("%s (%d)", agencyConfig.AgentName, i+1)
 
+		agent, fault := NewAgent(
+			ctx,
+			agency.Self,
+			agentConfig,
+			vmName,
+			vmPlacement)
+		if fault != nil {
+			return fault
+		}
+
+		ctx.WithLock(agent, func() {
+			agent.Runtime.GoalState = initialGoalState
+		})
+		agency.Agent = append(agency.Agent, agent.Self)
+
+		return nil
+	}
+
+	placementFault := func(err error) vim.BaseMethodFault {
+		return &vim.MethodFault{
+			FaultCause: &vim.LocalizedMethodFault{
+				LocalizedMessage: err.Error(),
+			},
+		}
+	}
+
+	// When the scope is a set of compute resources, an agent is deployed to
+	// each host of each compute resource, otherwise an agent is deployed per
+	// AgentConfig. The hosts all have the same ESX version, so the first
+	// AgentConfig applies to each host.
+	if hosts := getScopeHosts(ctx, vimMap, agencyConfig); len(hosts) != 0 &&
+		len(agencyConfig.AgentConfig) != 0 {
+
+		for i, host := range hosts {
+			// The placement index refers to the first AgentConfig.
+			vmPlacement, err := getAgentVMPlacementOptions(
+				ctx,
+				vimMap,
+				rng,
+				0,
+				agencyConfig)
+			if err != nil {
+				return nil, placementFault(err)
+			}
+			vmPlacement.computeResource = host.computeResource
+			vmPlacement.pool = host.pool
+			vmPlacement.host = host.host
+
+			if fault := addAgent(i, agencyConfig.AgentConfig[0], vmPlacement); fault != nil {
+				return nil, fault
+			}
+		}
+
+		return agency, nil
+	}
+
+	// Create the agents.
+	for i, agentConfig := range agencyConfig.AgentConfig {
+
 		// vmPlacement contains MoRefs to the resources required to create and
 		// place the VM inside of the inventory.
 		vmPlacement, err := getAgentVMPlacementOptions(
@@ -93,20 +155,10 @@ func NewAgency(
 			i,
 			agencyConfig)
 		if err != nil {
-			return nil, &vim.MethodFault{
-				FaultCause: &vim.LocalizedMethodFault{
-					LocalizedMessage: err.Error(),
-				},
-			}
+			return nil, placementFault(err)
 		}
 
-		if _, fault := NewAgent(
-			ctx,
-			agency.Self,
-			agentConfig,
-			vmName,
-			vmPlacement); fault != nil {
-
+		if fault := addAgent(i, agentConfig, vmPlacement); fault != nil {
 			return nil, fault
 		}
 	}
@@ -118,13 +170,7 @@ func (m *Agency) AgencyQueryRuntime(
 	ctx *simulator.Context,
 	req *types.AgencyQueryRuntime) soap.HasFault {
 
-	// Copy the agency's issues into its runtime object upon return.
-	m.Runtime.Issue = make([]types.BaseIssue, len(m.Issue))
-	i := 0
-	for _, issue := range m.Issue {
-		m.Runtime.Issue[i] = issue
-		i++
-	}
+	m.updateRuntime(ctx)
 
 	return &methods.AgencyQueryRuntimeBody{
 		Res: &types.AgencyQueryRuntimeResponse{
@@ -133,17 +179,56 @@ func (m *Agency) AgencyQueryRuntime(
 	}
 }
 
+// agents calls f with each of the agency's agents, while holding the agent's lock.
+func (m *Agency) agents(ctx *simulator.Context, f func(*Agent)) {
+	for _, ref := range m.Agent {
+		if agent, ok := ctx.Map.Get(ref).(*Agent); ok {
+			ctx.WithLock(agent, func() {
+				f(agent)
+			})
+		}
+	}
+}
+
+// updateRuntime updates the agency's runtime information, which includes
+// the issues of the agency and those of its agents.
+func (m *Agency) updateRuntime(ctx *simulator.Context) {
+	// Copy the agency's issues into its runtime object upon return.
+	m.Runtime.Issue = append([]types.BaseIssue(nil), m.Issue...)
+
+	m.agents(ctx, func(agent *Agent) {
+		agent.updateRuntime(ctx)
+		m.Runtime.Issue = append(m.Runtime.Issue, agent.Issue...)
+	})
+
+	m.Runtime.Status = string(types.EamObjectRuntimeInfoStatusGreen)
+	if len(m.Runtime.Issue) != 0 {
+		m.Runtime.Status = string(types.EamObjectRuntimeInfoStatusRed)
+	}
+}
+
+// setGoalState sets the goal state of the agency and its agents.
+func (m *Agency) setGoalState(ctx *simulator.Context, state types.EamObjectRuntimeInfoGoalState) {
+	m.Runtime.GoalState = string(state)
+
+	m.agents(ctx, func(agent *Agent) {
+		agent.Runtime.GoalState = string(state)
+	})
+}
+
 func (m *Agency) DestroyAgency(
 	ctx *simulator.Context,
 	req *types.DestroyAgency) soap.HasFault {
 
-	// Remove any agents associated with this agency.
-	agentObjs := ctx.Map.AllReference(internal.Agent)
-	for _, obj := range agentObjs {
-		agent := obj.(*Agent)
-		if *agent.Runtime.Agency == m.Self {
-			ctx.Map.Remove(ctx, agent.Self)
+	// Remove any agents associated with this agency, along with their VMs.
+	m.agents(ctx, func(agent *Agent) {
+		agent.destroyVm()
+		for _, issue := range agent.Issue {
+			freeIssueKey(issue.GetIssue().Key)
 		}
+	})
+	for _, ref := range m.Agent {
+		ctx.Map.Remove(ctx, ref)
 	}
 
 	ctx.Map.Remove(ctx, m.Self)
@@ -156,7 +241,7 @@ func (m *Agency) Agency_Disable(
 	ctx *simulator.Context,
 	req *types.Agency_Disable) soap.HasFault {
 
-	m.Runtime.GoalState = string(types.EamObjectRuntimeInfoGoalStateDisabled)
+	m.setGoalState(ctx, types.EamObjectRuntimeInfoGoalStateDisabled)
 
 	return &methods.Agency_DisableBody{
 		Res: &types.Agency_DisableResponse{},
@@ -167,7 +252,7 @@ func (m *Agency) Agency_Enable(
 	ctx *simulator.Context,
 	req *types.Agency_Enable) soap.HasFault {
 
-	m.Runtime.GoalState = string(types.EamObjectRuntimeInfoGoalStateEnabled)
+	m.setGoalState(ctx, types.EamObjectRuntimeInfoGoalStateEnabled)
 
 	return &methods.Agency_EnableBody{
 		Res: &types.Agency_EnableResponse{},
@@ -178,16 +263,9 @@ func (m *Agency) QueryAgent(
 	ctx *simulator.Context,
 	req *types.QueryAgent) soap.HasFault {
 
-	objs := ctx.Map.AllReference(internal.Agent)
-	moRefs := make([]vim.ManagedObjectReference, len(objs))
-	i := 0
-	for _, ref := range objs {
-		moRefs[i] = ref.Reference()
-		i++
-	}
 	return &methods.QueryAgentBody{
 		Res: &types.QueryAgentResponse{
-			Returnval: moRefs,
+			Returnval: m.Agent,
 		},
 	}
 }
@@ -218,7 +296,7 @@ func (m *Agency) Uninstall(
 	ctx *simulator.Context,
 	req *types.Uninstall) soap.HasFault {
 
-	m.Runtime.GoalState = string(types.EamObjectRuntimeInfoGoalStateUninstalled)
+	m.setGoalState(ctx, types.EamObjectRuntimeInfoGoalStateUninstalled)
 
 	return &methods.UninstallBody{
 		Res: &types.UninstallResponse{},
@@ -244,3 +322,47 @@ func (m *Agency) Update(
 		Res: &types.UpdateResponse{},
 	}
 }
+
+func (m *Agency) QueryIssue(
+	ctx *simulator.Context,
+	req *types.QueryIssue) soap.HasFault {
+
+	m.updateRuntime(ctx)
+
+	return &methods.QueryIssueBody{
+		Res: &types.QueryIssueResponse{
+			Returnval: queryIssue(m.Runtime.Issue, req.IssueKey),
+		},
+	}
+}
+
+func (m *Agency) Resolve(
+	ctx *simulator.Context,
+	req *types.Resolve) soap.HasFault {
+
+	// Keys of agent issues are resolved by the agent that raised the issue.
+	keys := m.EamObject.resolve(req.IssueKey)
+	m.agents(ctx, func(agent *Agent) {
+		keys = agent.resolve(keys)
+	})
+	m.updateRuntime(ctx)
+
+	return &methods.ResolveBody{
+		Res: &types.ResolveResponse{
+			Returnval: keys,
+		},
+	}
+}
+
+func (m *Agency) ResolveAll(
+	ctx *simulator.Context,
+	req *types.ResolveAll) soap.HasFault {
+
+	m.EamObject.resolveAll()
+	m.agents(ctx, func(agent *Agent) {
+		agent.resolve(agent.issueKeys())
+	})
+	m.updateRuntime(ctx)
+
+	return &methods.ResolveAllBody{Res: &types.ResolveAllResponse{}}
+}
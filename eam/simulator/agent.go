@@ -19,6 +19,8 @@ package simulator
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -40,6 +42,8 @@ import (
 type Agent struct {
 	EamObject
 	mo.Agent
+
+	placement AgentVMPlacementOptions
 }
 
 type AgentVMPlacementOptions struct {
@@ -60,7 +64,6 @@ func NewAgent(
 	config types.AgentConfigInfo,
 	vmName string,
 	vmPlacement AgentVMPlacementOptions) (*Agent, vim.BaseMethodFault) {
-	vimMap := simulator.Map
 
 	agent := &Agent{
 		EamObject: EamObject{
@@ -79,160 +82,313 @@ func NewAgent(
 				EsxAgentResourcePool: &vmPlacement.pool,
 			},
 		},
+		placement: vmPlacement,
 	}
 
 	// Register the agent with the registry in order for the agent to start
 	// receiving API calls from clients.
 	ctx.Map.Put(agent)
 
-	// simulator.VirtualMachine related calls need the vimMap (aka global Map)
-	vimCtx := simulator.SpoofContext()
+	if err := agent.createVm(); err != nil {
+		return nil, &vim.RuntimeFault{
+			MethodFault: vim.MethodFault{
+				FaultCause: err,
+			},
+		}
+	}
+
+	// Start watching this VM and updating the agent's information about the VM.
+	go func(ctx *simulator.Context, eamReg *simulator.Registry) {
+		ticker := time.NewTicker(1 * time.Second)
+		for range ticker.C {
+			eamReg.WithLock(ctx, agent.Self, func() {
+				if eamReg.Get(agent.Self) == nil {
+					log.Printf("not found: %v", agent.Self)
+					// If the agent no longer exists then stop watching it.
+					ticker.Stop()
+					return
+				}
+				agent.updateRuntime(ctx)
+			})
+		}
+	}(simulator.SpoofContext(), ctx.Map)
 
-	createVm := func() (vim.ManagedObjectReference, *vim.LocalizedMethodFault) {
-		var vmRef vim.ManagedObjectReference
+	return agent, nil
+}
 
-		// vmExtraConfig is used when creating the VM for this agent.
-		vmExtraConfig := []vim.BaseOptionValue{}
+// createVm creates the agent's VM, replacing any previously created VM.
+func (m *Agent) createVm() *vim.LocalizedMethodFault {
+	// simulator.VirtualMachine related calls need the vimMap (aka global Map)
+	vimMap := simulator.Map
+	vimCtx := simulator.SpoofContext()
+	config := m.Config
+
+	// vmExtraConfig is used when creating the VM for this agent.
+	vmExtraConfig := []vim.BaseOptionValue{}
+
+	// If config.OvfPackageUrl is non-empty and does not appear to point to
+	// a local file or an HTTP URI, then assume it is a container.
+	if url := config.OvfPackageUrl; url != "" && !fsOrHTTPRx.MatchString(url) {
+		vmExtraConfig = append(
+			vmExtraConfig,
+			&vim.OptionValue{
+				Key:   "RUN.container",
+				Value: url,
+			})
+	}
 
-		// If config.OvfPackageUrl is non-empty and does not appear to point to
-		// a local file or an HTTP URI, then assume it is a container.
-		if url := config.OvfPackageUrl; url != "" && !fsOrHTTPRx.MatchString(url) {
+	// Copy the OVF environment properties into the VM's ExtraConfig property.
+	if ovfEnv := config.OvfEnvironment; ovfEnv != nil {
+		for _, ovfProp := range ovfEnv.OvfProperty {
 			vmExtraConfig = append(
 				vmExtraConfig,
 				&vim.OptionValue{
-					Key:   "RUN.container",
-					Value: url,
+					Key:   ovfProp.Key,
+					Value: ovfProp.Value,
 				})
 		}
+	}
+
+	vmName := m.Runtime.VmName
+	datastore := vimMap.Get(m.placement.datastore).(*simulator.Datastore)
+	vmPathName := fmt.Sprintf("[%[1]s] %[2]s/%[2]s.vmx", datastore.Name, vmName)
+	vmConfigSpec := vim.VirtualMachineConfigSpec{
+		Name:        vmName,
+		ExtraConfig: vmExtraConfig,
+		Files: &vim.VirtualMachineFileInfo{
+			VmPathName: vmPathName,
+		},
+	}
+
+	// Create the VM for this agent.
+	vmFolder := vimMap.Get(m.placement.folder).(*simulator.Folder)
+	createVmTaskRef := vmFolder.CreateVMTask(vimCtx, &vim.CreateVM_Task{
+		This:   vmFolder.Self,
+		Config: vmConfigSpec,
+		Pool:   m.placement.pool,
+		Host:   &m.placement.host,
+	}).(*vimmethods.CreateVM_TaskBody).Res.Returnval
+	createVmTask := vimMap.Get(createVmTaskRef).(*simulator.Task)
+
+	// Wait for the task to complete and see if there is an error.
+	createVmTask.Wait()
+	if createVmTask.Info.Error != nil {
+		return createVmTask.Info.Error
+	}
+
+	vmRef := createVmTask.Info.Result.(vim.ManagedObjectReference)
+	log.Printf("created agent vm: MoRef=%v, Name=%s", vmRef, vmName)
+
+	// Link the agent to this VM.
+	m.Runtime.Vm = &vmRef
+
+	return nil
+}
+
+// vm returns the agent's VM, or nil if the VM does not exist.
+func (m *Agent) vm() *simulator.VirtualMachine {
+	if m.Runtime.Vm == nil {
+		return nil
+	}
+	if vm, ok := simulator.Map.Get(*m.Runtime.Vm).(*simulator.VirtualMachine); ok {
+		return vm
+	}
+	return nil
+}
 
-		// Copy the OVF environment properties into the VM's ExtraConfig property.
-		if ovfEnv := config.OvfEnvironment; ovfEnv != nil {
-			for _, ovfProp := range ovfEnv.OvfProperty {
-				vmExtraConfig = append(
-					vmExtraConfig,
-					&vim.OptionValue{
-						Key:   ovfProp.Key,
-						Value: ovfProp.Value,
-					})
+// updateRuntime updates the agent's runtime information from its VM,
+// raising or clearing the VmNotDeployed and VmPoweredOff issues.
+func (m *Agent) updateRuntime(ctx *simulator.Context) {
+	vimMap := simulator.Map
+	deployed, poweredOn := false, false
+
+	if vm := m.vm(); vm != nil {
+		vimMap.WithLock(ctx, vm.Self, func() {
+			deployed = true
+			poweredOn = vm.Runtime.PowerState == vim.VirtualMachinePowerStatePoweredOn
+
+			m.Runtime.VmPowerState = vm.Runtime.PowerState
+			if guest := vm.Summary.Guest; guest == nil {
+				m.Runtime.VmIp = ""
+			} else {
+				m.Runtime.VmIp = guest.IpAddress
 			}
-		}
+		})
+	} else {
+		m.Runtime.VmPowerState = vim.VirtualMachinePowerStatePoweredOff
+		m.Runtime.VmIp = ""
+	}
 
-		datastore := vimMap.Get(vmPlacement.datastore).(*simulator.Datastore)
-		vmPathName := fmt.Sprintf("[%[1]s] %[2]s/%[2]s.vmx", datastore.Name, vmName)
-		vmConfigSpec := vim.VirtualMachineConfigSpec{
-			Name:        vmName,
-			ExtraConfig: vmExtraConfig,
-			Files: &vim.VirtualMachineFileInfo{
-				VmPathName: vmPathName,
-			},
+	enabled := m.Runtime.GoalState == string(types.EamObjectRuntimeInfoGoalStateEnabled)
+
+	m.setIssue(&types.VmNotDeployed{AgentIssue: m.agentIssue()}, !deployed && enabled)
+
+	issue := &types.VmPoweredOff{VmIssue: types.VmIssue{AgentIssue: m.agentIssue()}}
+	if m.Runtime.Vm != nil {
+		issue.Vm = *m.Runtime.Vm
+	}
+	m.setIssue(issue, deployed && !poweredOn && enabled)
+
+	m.Runtime.Issue = m.Issue
+	m.Runtime.Status = string(types.EamObjectRuntimeInfoStatusGreen)
+	if len(m.Issue) != 0 {
+		m.Runtime.Status = string(types.EamObjectRuntimeInfoStatusRed)
+	}
+}
+
+// agentIssue returns an AgentIssue describing this agent.
+func (m *Agent) agentIssue() types.AgentIssue {
+	issue := types.AgentIssue{
+		AgencyIssue: types.AgencyIssue{
+			Agency: *m.Runtime.Agency,
+		},
+		Agent:     m.Self,
+		AgentName: m.Runtime.VmName,
+		Host:      m.placement.host,
+	}
+	if host, ok := simulator.Map.Get(m.placement.host).(*simulator.HostSystem); ok {
+		issue.HostName = host.Name
+	}
+	return issue
+}
+
+// setIssue adds the issue if raise is true and the agent does not have an issue of the same type,
+// otherwise removes any issue of the same type if raise is false.
+func (m *Agent) setIssue(issue types.BaseIssue, raise bool) {
+	kind := reflect.TypeOf(issue)
+
+	for i, existing := range m.Issue {
+		if reflect.TypeOf(existing) == kind {
+			if !raise {
+				freeIssueKey(existing.GetIssue().Key)
+				m.Issue = append(m.Issue[:i], m.Issue[i+1:]...)
+			}
+			return
 		}
+	}
+
+	if raise {
+		base := issue.GetIssue()
+		base.Key = nextAvailableIssueKey()
+		base.Time = time.Now().UTC()
+		m.Issue = append(m.Issue, issue)
+	}
+}
 
-		// Create the VM for this agent.
-		vmFolder := vimMap.Get(vmPlacement.folder).(*simulator.Folder)
-		createVmTaskRef := vmFolder.CreateVMTask(vimCtx, &vim.CreateVM_Task{
-			This:   vmFolder.Self,
-			Config: vmConfigSpec,
-			Pool:   vmPlacement.pool,
-			Host:   &vmPlacement.host,
-		}).(*vimmethods.CreateVM_TaskBody).Res.Returnval
-		createVmTask := simulator.Map.Get(createVmTaskRef).(*simulator.Task)
-
-		// Wait for the task to complete and see if there is an error.
-		createVmTask.Wait()
-		if createVmTask.Info.Error != nil {
-			return vmRef, createVmTask.Info.Error
+// remediate applies the resolution of the given issue.
+func (m *Agent) remediate(issue types.BaseIssue) *vim.LocalizedMethodFault {
+	switch issue.(type) {
+	case *types.VmNotDeployed:
+		if m.vm() == nil {
+			if err := m.createVm(); err != nil {
+				return err
+			}
+			return m.powerOnVm()
 		}
+	case *types.VmPoweredOff:
+		return m.powerOnVm()
+	}
+	return nil
+}
 
-		vmRef = createVmTask.Info.Result.(vim.ManagedObjectReference)
-		vm := vimMap.Get(vmRef).(*simulator.VirtualMachine)
-		log.Printf("created agent vm: MoRef=%v, Name=%s", vm.Self, vm.Name)
+// powerOnVm powers on the agent's VM, if not already powered on.
+func (m *Agent) powerOnVm() *vim.LocalizedMethodFault {
+	vm := m.vm()
+	if vm == nil || vm.Runtime.PowerState == vim.VirtualMachinePowerStatePoweredOn {
+		return nil
+	}
+
+	vimCtx := simulator.SpoofContext()
+	res := vm.PowerOnVMTask(vimCtx, &vim.PowerOnVM_Task{This: vm.Self})
+	task := simulator.Map.Get(res.(*vimmethods.PowerOnVM_TaskBody).Res.Returnval).(*simulator.Task)
+	task.Wait()
+	return task.Info.Error
+}
 
-		// Link the agent to this VM.
-		agent.Runtime.Vm = &vm.Self
+// destroyVm powers off and destroys the agent's VM.
+func (m *Agent) destroyVm() {
+	vm := m.vm()
+	if vm == nil {
+		return
+	}
+	vimCtx := simulator.SpoofContext()
 
-		return vm.Self, nil
+	if vm.Runtime.PowerState == vim.VirtualMachinePowerStatePoweredOn {
+		res := vm.PowerOffVMTask(vimCtx, &vim.PowerOffVM_Task{This: vm.Self})
+		simulator.Map.Get(res.(*vimmethods.PowerOffVM_TaskBody).Res.Returnval).(*simulator.Task).Wait()
 	}
 
-	vmRef, err := createVm()
-	if err != nil {
-		return nil, &vim.RuntimeFault{
-			MethodFault: vim.MethodFault{
-				FaultCause: err,
-			},
+	res := vm.DestroyTask(vimCtx, &vim.Destroy_Task{This: vm.Self})
+	simulator.Map.Get(res.(*vimmethods.Destroy_TaskBody).Res.Returnval).(*simulator.Task).Wait()
+	m.Runtime.Vm = nil
+}
+
+// resolve remediates and removes the issues with the given keys,
+// returning the keys of issues that were not found.
+func (m *Agent) resolve(keys []int32) []int32 {
+	var notFound []int32
+
+	for _, key := range keys {
+		i := slices.IndexFunc(m.Issue, func(issue types.BaseIssue) bool {
+			return issue.GetIssue().Key == key
+		})
+		if i == -1 {
+			notFound = append(notFound, key)
+			continue
+		}
+
+		if err := m.remediate(m.Issue[i]); err != nil {
+			log.Printf("failed to resolve issue %d of agent %v: %s", key, m.Self, err.LocalizedMessage)
+			continue
 		}
+
+		freeIssueKey(key)
+		m.Issue = append(m.Issue[:i], m.Issue[i+1:]...)
 	}
 
-	// Start watching this VM and updating the agent's information about the VM.
-	go func(ctx *simulator.Context, eamReg, vimReg *simulator.Registry) {
-		var (
-			ticker = time.NewTicker(1 * time.Second)
-			vmName string
-		)
-		for range ticker.C {
-			eamReg.WithLock(ctx, agent.Self, func() {
-				agentObj := eamReg.Get(agent.Self)
-				if agentObj == nil {
-					log.Printf("not found: %v", agent.Self)
-					// If the agent no longer exists then stop watching it.
-					ticker.Stop()
-					return
-				}
+	return notFound
+}
 
-				updateAgent := func(vm *simulator.VirtualMachine) {
-					if vmName == "" {
-						vmName = vm.Config.Name
-					}
-
-					// Update the agent's properties from the VM.
-					agent := agentObj.(*Agent)
-					agent.Runtime.VmPowerState = vm.Runtime.PowerState
-					if guest := vm.Summary.Guest; guest == nil {
-						agent.Runtime.VmIp = ""
-					} else {
-						agent.Runtime.VmIp = guest.IpAddress
-					}
-				}
+func (m *Agent) QueryIssue(
+	ctx *simulator.Context,
+	req *types.QueryIssue) soap.HasFault {
 
-				vimReg.WithLock(ctx, vmRef, func() {
-					if vmObj := vimReg.Get(vmRef); vmObj != nil {
-						updateAgent(vmObj.(*simulator.VirtualMachine))
-					} else {
-						// If the VM no longer exists then create a new agent VM.
-						log.Printf(
-							"creating new agent vm: %v, %v, vmName=%s",
-							agent.Self, vmRef, vmName)
-
-						newVmRef, err := createVm()
-						if err != nil {
-							log.Printf(
-								"failed to create new agent vm: %v, %v, vmName=%s, err=%v",
-								agent.Self, vmRef, vmName, *err)
-							ticker.Stop()
-							return
-						}
-
-						// Make sure the vmRef variable is assigned to the new
-						// VM's reference for the next time through this loop.
-						vmRef = newVmRef
-
-						// Get a lock for the *new* VM.
-						vimReg.WithLock(ctx, vmRef, func() {
-							vmObj = vimReg.Get(vmRef)
-							if vmObj == nil {
-								log.Printf("not found: %v", vmRef)
-								ticker.Stop()
-								return
-							}
-							updateAgent(vmObj.(*simulator.VirtualMachine))
-						})
-					}
+	m.updateRuntime(ctx)
 
-				})
-			})
-		}
-	}(simulator.SpoofContext(), ctx.Map, vimMap)
+	return m.EamObject.QueryIssue(ctx, req)
+}
 
-	return agent, nil
+func (m *Agent) Resolve(
+	ctx *simulator.Context,
+	req *types.Resolve) soap.HasFault {
+
+	notFound := m.resolve(req.IssueKey)
+	m.updateRuntime(ctx)
+
+	return &methods.ResolveBody{
+		Res: &types.ResolveResponse{
+			Returnval: notFound,
+		},
+	}
+}
+
+func (m *Agent) ResolveAll(
+	ctx *simulator.Context,
+	req *types.ResolveAll) soap.HasFault {
+
+	m.resolve(m.issueKeys())
+	m.updateRuntime(ctx)
+
+	return &methods.ResolveAllBody{Res: &types.ResolveAllResponse{}}
+}
+
+// issueKeys returns the keys of the agent's issues.
+func (m *Agent) issueKeys() []int32 {
+	keys := make([]int32, len(m.Issue))
+	for i, issue := range m.Issue {
+		keys[i] = issue.GetIssue().Key
+	}
+	return keys
 }
 
 func (m *Agent) AgentQueryConfig(
@@ -250,6 +406,8 @@ func (m *Agent) AgentQueryRuntime(
 	ctx *simulator.Context,
 	req *types.AgentQueryRuntime) soap.HasFault {
 
+	m.updateRuntime(ctx)
+
 	return &methods.AgentQueryRuntimeBody{
 		Res: &types.AgentQueryRuntimeResponse{
 			Returnval: m.Runtime,
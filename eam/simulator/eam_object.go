@@ -17,6 +17,7 @@ limitations under the License.
 package simulator
 
 import (
+	"slices"
 	"time"
 
 	"github.com/vmware/govmomi/eam/methods"
@@ -61,77 +62,59 @@ func (m *EamObject) QueryIssue(
 	ctx *simulator.Context,
 	req *types.QueryIssue) soap.HasFault {
 
-	var issues []types.BaseIssue
-
-	if len(req.IssueKey) == 0 {
-		// If no keys were specified then return all issues.
-		issues = m.Issue
-	} else {
-		// Get only the issues for the specified keys.
-		for _, issueKey := range req.IssueKey {
-			for _, issue := range m.Issue {
-				if issue.GetIssue().Key == issueKey {
-					issues = append(issues, issue)
-				}
-			}
-		}
-	}
-
 	return &methods.QueryIssueBody{
 		Res: &types.QueryIssueResponse{
-			Returnval: issues,
+			Returnval: queryIssue(m.Issue, req.IssueKey),
 		},
 	}
 }
 
-func (m *EamObject) Resolve(
-	ctx *simulator.Context,
-	req *types.Resolve) soap.HasFault {
-
-	// notFoundKeys is a list of issue keys that were sent but
-	// not found for the given object.
-	notFoundKeys := []int32{}
+// queryIssue returns the issues with the given keys, or all issues if no
+// keys are specified.
+func queryIssue(issues []types.BaseIssue, keys []int32) []types.BaseIssue {
+	if len(keys) == 0 {
+		// If no keys were specified then return all issues.
+		return issues
+	}
 
-	// issueExists is a helper function that returns true
-	issueExists := func(issueKey int32) bool {
-		for _, k := range req.IssueKey {
-			if k == issueKey {
-				return true
+	// Get only the issues for the specified keys.
+	var res []types.BaseIssue
+	for _, issueKey := range keys {
+		for _, issue := range issues {
+			if issue.GetIssue().Key == issueKey {
+				res = append(res, issue)
 			}
 		}
-		return false
 	}
 
-	// Iterate over the object's issues, and if a key matches, then remove
-	// the issue from the list of the object's issues. If a key does not match
-	// then record the key as notFound.
-	for i := 0; i < len(m.Issue); i++ {
-		issueKey := m.Issue[i].GetIssue().Key
-
-		if ok := issueExists(issueKey); ok {
-			// Update the object's issue list so that it no longer includes
-			// the current issue.
-			m.Issue = append(m.Issue[:i], m.Issue[i+1:]...)
-			i--
-
-			// Ensure the key is removed from the global key space.
-			freeIssueKey(issueKey)
-		} else {
-			notFoundKeys = append(notFoundKeys, issueKey)
+	return res
+}
+
+// resolve removes the issues with the given keys, returning the keys of
+// issues that were not found.
+func (m *EamObject) resolve(keys []int32) []int32 {
+	var notFound []int32
+
+	for _, key := range keys {
+		i := slices.IndexFunc(m.Issue, func(issue types.BaseIssue) bool {
+			return issue.GetIssue().Key == key
+		})
+		if i == -1 {
+			notFound = append(notFound, key)
+			continue
 		}
-	}
 
-	return &methods.ResolveBody{
-		Res: &types.ResolveResponse{
-			Returnval: notFoundKeys,
-		},
+		// Update the object's issue list so that it no longer includes the
+		// issue, and ensure the key is removed from the global key space.
+		m.Issue = append(m.Issue[:i], m.Issue[i+1:]...)
+		freeIssueKey(key)
 	}
-}
 
-func (m *EamObject) ResolveAll(
-	ctx *simulator.Context,
-	req *types.ResolveAll) soap.HasFault {
+	return notFound
+}
 
+// resolveAll removes all of the object's issues.
+func (m *EamObject) resolveAll() {
 	// Iterate over the issues and ensure each one of their keys are removed
 	// from the global key space.
 	for _, issue := range m.Issue {
@@ -140,6 +123,24 @@ func (m *EamObject) ResolveAll(
 
 	// Reset the object's issues.
 	m.Issue = m.Issue[:0]
+}
+
+func (m *EamObject) Resolve(
+	ctx *simulator.Context,
+	req *types.Resolve) soap.HasFault {
+
+	return &methods.ResolveBody{
+		Res: &types.ResolveResponse{
+			Returnval: m.resolve(req.IssueKey),
+		},
+	}
+}
+
+func (m *EamObject) ResolveAll(
+	ctx *simulator.Context,
+	req *types.ResolveAll) soap.HasFault {
+
+	m.resolveAll()
 
 	return &methods.ResolveAllBody{Res: &types.ResolveAllResponse{}}
 }
@@ -394,3 +394,176 @@ func TestNotAuthenticated(t *testing.T) {
 
 	})
 }
+
+func TestAgencyIssues(t *testing.T) {
+	vcsim.Test(func(ctx context.Context, vimClient *vim25.Client) {
+		finder := find.NewFinder(vimClient, true)
+
+		datacenter, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(datacenter)
+
+		folder, err := finder.DefaultFolder(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cluster, err := finder.ClusterComputeResourceOrDefault(ctx, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		hosts, err := cluster.Hosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		datastore, err := finder.DatastoreOrDefault(ctx, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		network, err := finder.NetworkOrDefault(ctx, "DVS0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mgr := object.NewEsxAgentManager(eam.NewClient(vimClient), eam.EsxAgentManager)
+
+		agency, err := mgr.CreateAgency(
+			ctx,
+			&types.AgencyConfigInfo{
+				AgencyName: "agency",
+				Scope: &types.AgencyComputeResourceScope{
+					ComputeResource: []vim.ManagedObjectReference{cluster.Reference()},
+				},
+				AgentVmDatastore: []vim.ManagedObjectReference{datastore.Reference()},
+				AgentVmNetwork:   []vim.ManagedObjectReference{network.Reference()},
+				Folders: []types.AgencyVMFolder{{
+					FolderId:     folder.Reference(),
+					DatacenterId: datacenter.Reference(),
+				}},
+				AgentConfig: []types.AgentConfigInfo{{}},
+			},
+			string(types.EamObjectRuntimeInfoGoalStateEnabled),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// An agent is deployed to each host in scope
+		agents, err := agency.Agents(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(agents) != len(hosts) {
+			t.Fatalf("%d agents, %d hosts", len(agents), len(hosts))
+		}
+
+		scope := make(map[vim.ManagedObjectReference]bool)
+		for _, agent := range agents {
+			runtime, err := agent.Runtime(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			scope[*runtime.Host] = true
+
+			// Agent VMs are created powered off
+			if runtime.Status != string(types.EamObjectRuntimeInfoStatusRed) || len(runtime.Issue) != 1 {
+				t.Fatalf("status=%s, issues=%d", runtime.Status, len(runtime.Issue))
+			}
+			if _, ok := runtime.Issue[0].(*types.VmPoweredOff); !ok {
+				t.Errorf("issue=%T", runtime.Issue[0])
+			}
+		}
+		if len(scope) != len(hosts) {
+			t.Errorf("agents deployed to %d hosts", len(scope))
+		}
+
+		runtime, err := agency.Runtime(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if runtime.Status != string(types.EamObjectRuntimeInfoStatusRed) || len(runtime.Issue) != len(agents) {
+			t.Fatalf("status=%s, issues=%d", runtime.Status, len(runtime.Issue))
+		}
+
+		// Resolving the issue powers on the agent VM
+		issues, err := agents[0].Issues(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		notFound, err := agents[0].Resolve(ctx, []int32{issues[0].GetIssue().Key, -1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(notFound) != 1 || notFound[0] != -1 {
+			t.Errorf("notFound=%v", notFound)
+		}
+
+		agent, err := agents[0].Runtime(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if agent.Status != string(types.EamObjectRuntimeInfoStatusGreen) || agent.VmPowerState != vim.VirtualMachinePowerStatePoweredOn {
+			t.Errorf("status=%s, power=%s", agent.Status, agent.VmPowerState)
+		}
+
+		// Removing an agent VM raises a VmNotDeployed issue
+		agent, err = agents[1].Runtime(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		task, err := vimobject.NewVirtualMachine(vimClient, *agent.Vm).Destroy(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		issues, err = agents[1].Issues(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(issues) != 1 {
+			t.Fatalf("issues=%d", len(issues))
+		}
+		if _, ok := issues[0].(*types.VmNotDeployed); !ok {
+			t.Errorf("issue=%T", issues[0])
+		}
+
+		// Resolving all of the agency's issues redeploys and powers on agent VMs
+		if err = agency.ResolveAll(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		runtime, err = agency.Runtime(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if runtime.Status != string(types.EamObjectRuntimeInfoStatusGreen) || len(runtime.Issue) != 0 {
+			t.Errorf("status=%s, issues=%d", runtime.Status, len(runtime.Issue))
+		}
+
+		agent, err = agents[1].Runtime(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if agent.Vm == nil || agent.VmPowerState != vim.VirtualMachinePowerStatePoweredOn {
+			t.Errorf("vm=%v, power=%s", agent.Vm, agent.VmPowerState)
+		}
+
+		// Destroying the agency removes the agent VMs
+		if err = agency.Destroy(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		vms, err := finder.VirtualMachineList(ctx, "agency*")
+		if err == nil {
+			t.Errorf("vms=%v", vms)
+		}
+	})
+}
@@ -116,6 +116,45 @@ func getAgentVMPlacementOptions(
 	return opts, nil
 }
 
+// scopeHost is a host of a compute resource within an agency's scope.
+type scopeHost struct {
+	computeResource vim.ManagedObjectReference
+	pool            vim.ManagedObjectReference
+	host            vim.ManagedObjectReference
+}
+
+// getScopeHosts returns the hosts of the compute resources within the
+// agency's scope, if the scope is an AgencyComputeResourceScope and no
+// ResourcePools are specified.
+func getScopeHosts(
+	ctx *simulator.Context,
+	reg *simulator.Registry,
+	baseAgencyConfig types.BaseAgencyConfigInfo) []scopeHost {
+
+	agencyConfig := baseAgencyConfig.GetAgencyConfigInfo()
+	scope, ok := agencyConfig.Scope.(*types.AgencyComputeResourceScope)
+	if !ok || len(agencyConfig.ResourcePools) != 0 {
+		return nil
+	}
+
+	var hosts []scopeHost
+	for _, crRef := range scope.ComputeResource {
+		poolRef, err := getPoolFromComputeResource(ctx, reg, crRef)
+		if err != nil {
+			continue
+		}
+		for _, host := range getHostsFromPool(ctx, reg, *poolRef) {
+			hosts = append(hosts, scopeHost{
+				computeResource: crRef,
+				pool:            *poolRef,
+				host:            host,
+			})
+		}
+	}
+
+	return hosts
+}
+
 func getPoolFromComputeResource(
 	ctx *simulator.Context,
 	reg *simulator.Registry,
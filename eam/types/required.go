@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2021-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"reflect"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func init() {
+	types.AddRequiredFields(reflect.TypeOf((*AddIssue)(nil)).Elem(), "This", "Issue")
+	types.AddRequiredFields(reflect.TypeOf((*AddIssueRequestType)(nil)).Elem(), "This", "Issue")
+	types.AddRequiredFields(reflect.TypeOf((*AddIssueResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*AgencyIssue)(nil)).Elem(), "Agency", "AgencyName", "SolutionId", "SolutionName")
+	types.AddRequiredFields(reflect.TypeOf((*AgencyQueryRuntime)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*AgencyQueryRuntimeRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*AgencyQueryRuntimeResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*AgencyVMFolder)(nil)).Elem(), "FolderId", "DatacenterId")
+	types.AddRequiredFields(reflect.TypeOf((*AgencyVMResourcePool)(nil)).Elem(), "ResourcePoolId", "ComputeResourceId")
+	types.AddRequiredFields(reflect.TypeOf((*Agency_Disable)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*Agency_DisableRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*Agency_Enable)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*Agency_EnableRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*AgentIssue)(nil)).Elem(), "Agent", "AgentName", "Host", "HostName")
+	types.AddRequiredFields(reflect.TypeOf((*AgentOvfEnvironmentInfoOvfProperty)(nil)).Elem(), "Key", "Value")
+	types.AddRequiredFields(reflect.TypeOf((*AgentPinnedPemCertificate)(nil)).Elem(), "SslCertificate")
+	types.AddRequiredFields(reflect.TypeOf((*AgentQueryConfig)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*AgentQueryConfigRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*AgentQueryConfigResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*AgentQueryRuntime)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*AgentQueryRuntimeRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*AgentQueryRuntimeResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*AgentRuntimeInfo)(nil)).Elem(), "VmPowerState", "ReceivingHeartBeat", "VmName")
+	types.AddRequiredFields(reflect.TypeOf((*AgentVibMatchingRule)(nil)).Elem(), "VibNameRegex", "VibVersionRegex")
+	types.AddRequiredFields(reflect.TypeOf((*AgentVmHook)(nil)).Elem(), "Vm", "VmState")
+	types.AddRequiredFields(reflect.TypeOf((*AgentVsanStoragePolicy)(nil)).Elem(), "ProfileId")
+	types.AddRequiredFields(reflect.TypeOf((*CannotAccessAgentOVF)(nil)).Elem(), "DownloadUrl")
+	types.AddRequiredFields(reflect.TypeOf((*CannotAccessAgentVib)(nil)).Elem(), "DownloadUrl")
+	types.AddRequiredFields(reflect.TypeOf((*CertificateNotTrusted)(nil)).Elem(), "Url")
+	types.AddRequiredFields(reflect.TypeOf((*ClusterAgentAgentIssue)(nil)).Elem(), "Agent")
+	types.AddRequiredFields(reflect.TypeOf((*ClusterAgentCertificateNotTrusted)(nil)).Elem(), "Url")
+	types.AddRequiredFields(reflect.TypeOf((*ClusterAgentInvalidConfig)(nil)).Elem(), "Error")
+	types.AddRequiredFields(reflect.TypeOf((*ClusterAgentVmIssue)(nil)).Elem(), "Vm")
+	types.AddRequiredFields(reflect.TypeOf((*CreateAgency)(nil)).Elem(), "This", "AgencyConfigInfo", "InitialGoalState")
+	types.AddRequiredFields(reflect.TypeOf((*CreateAgencyRequestType)(nil)).Elem(), "This", "AgencyConfigInfo", "InitialGoalState")
+	types.AddRequiredFields(reflect.TypeOf((*CreateAgencyResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*DestroyAgency)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*DestroyAgencyRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*EamObjectRuntimeInfo)(nil)).Elem(), "Status", "GoalState", "Entity")
+	types.AddRequiredFields(reflect.TypeOf((*ExtensibleIssue)(nil)).Elem(), "TypeId")
+	types.AddRequiredFields(reflect.TypeOf((*GetMaintenanceModePolicy)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*GetMaintenanceModePolicyRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*GetMaintenanceModePolicyResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*HooksMarkAsProcessedSpec)(nil)).Elem(), "Vm", "HookType", "Success")
+	types.AddRequiredFields(reflect.TypeOf((*HostIssue)(nil)).Elem(), "Host")
+	types.AddRequiredFields(reflect.TypeOf((*InsufficientIpAddresses)(nil)).Elem(), "Network")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidConfig)(nil)).Elem(), "Error")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidUrl)(nil)).Elem(), "Url", "MalformedUrl", "UnknownHost", "ConnectionRefused")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidUrlFault)(nil)).Elem(), "Url", "MalformedUrl", "UnknownHost", "ConnectionRefused")
+	types.AddRequiredFields(reflect.TypeOf((*Issue)(nil)).Elem(), "Key", "Description", "Time")
+	types.AddRequiredFields(reflect.TypeOf((*MarkAsAvailable)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*MarkAsAvailableRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*MissingAgentIpPool)(nil)).Elem(), "Network")
+	types.AddRequiredFields(reflect.TypeOf((*NoCustomAgentVmDatastore)(nil)).Elem(), "CustomAgentVmDatastore", "CustomAgentVmDatastoreName")
+	types.AddRequiredFields(reflect.TypeOf((*NoCustomAgentVmNetwork)(nil)).Elem(), "CustomAgentVmNetwork", "CustomAgentVmNetworkName")
+	types.AddRequiredFields(reflect.TypeOf((*PersonalityAgencyCannotConfigureSolutions)(nil)).Elem(), "Cr")
+	types.AddRequiredFields(reflect.TypeOf((*PersonalityAgencyCannotUploadDepot)(nil)).Elem(), "LocalDepotUrl")
+	types.AddRequiredFields(reflect.TypeOf((*PersonalityAgencyDepotIssue)(nil)).Elem(), "RemoteDepotUrl")
+	types.AddRequiredFields(reflect.TypeOf((*QueryAgency)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryAgencyRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryAgent)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryAgentRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryConfig)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryConfigRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryConfigResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*QueryIssue)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryIssueRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySolutionId)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySolutionIdRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySolutionIdResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*RegisterAgentVm)(nil)).Elem(), "This", "AgentVm")
+	types.AddRequiredFields(reflect.TypeOf((*RegisterAgentVmRequestType)(nil)).Elem(), "This", "AgentVm")
+	types.AddRequiredFields(reflect.TypeOf((*RegisterAgentVmResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*Resolve)(nil)).Elem(), "This", "IssueKey")
+	types.AddRequiredFields(reflect.TypeOf((*ResolveAll)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*ResolveAllRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*ResolveRequestType)(nil)).Elem(), "This", "IssueKey")
+	types.AddRequiredFields(reflect.TypeOf((*ScanForUnknownAgentVm)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*ScanForUnknownAgentVmRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*SetMaintenanceModePolicy)(nil)).Elem(), "This", "Policy")
+	types.AddRequiredFields(reflect.TypeOf((*SetMaintenanceModePolicyRequestType)(nil)).Elem(), "This", "Policy")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsClusterBoundSolutionConfig)(nil)).Elem(), "VmCount")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsClusterSolutionComplianceResult)(nil)).Elem(), "Solution", "Compliant")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsComplianceResult)(nil)).Elem(), "Compliant")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsDeploymentUnitComplianceResult)(nil)).Elem(), "DeploymentUnit", "Compliant")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsHookConfig)(nil)).Elem(), "Type", "Acknowledgement")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsHookInfo)(nil)).Elem(), "Vm", "Solution", "Config", "RaisedAt")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsHostComplianceResult)(nil)).Elem(), "Host", "Compliant")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsOvfProperty)(nil)).Elem(), "Key", "Value")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsProfileIdStoragePolicy)(nil)).Elem(), "ProfileId")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsSolutionComplianceResult)(nil)).Elem(), "Solution", "Compliant")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsSolutionConfig)(nil)).Elem(), "Solution", "DisplayName", "DisplayVersion", "VmSource", "PrefixVmName", "UuidVmName", "TypeSpecificConfig")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsSolutionValidationResult)(nil)).Elem(), "Solution", "Valid")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsTransitionSpec)(nil)).Elem(), "Solution", "AgencyId")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsUrlVMSource)(nil)).Elem(), "OvfUrl")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsVMNetworkMapping)(nil)).Elem(), "Name", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsValidateSpec)(nil)).Elem(), "DesiredState")
+	types.AddRequiredFields(reflect.TypeOf((*SolutionsValidationResult)(nil)).Elem(), "Valid")
+	types.AddRequiredFields(reflect.TypeOf((*Uninstall)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*UninstallRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*UnknownAgentVm)(nil)).Elem(), "Vm")
+	types.AddRequiredFields(reflect.TypeOf((*UnregisterAgentVm)(nil)).Elem(), "This", "AgentVm")
+	types.AddRequiredFields(reflect.TypeOf((*UnregisterAgentVmRequestType)(nil)).Elem(), "This", "AgentVm")
+	types.AddRequiredFields(reflect.TypeOf((*Update)(nil)).Elem(), "This", "Config")
+	types.AddRequiredFields(reflect.TypeOf((*UpdateRequestType)(nil)).Elem(), "This", "Config")
+	types.AddRequiredFields(reflect.TypeOf((*VibRequiresManualInstallation)(nil)).Elem(), "Bulletin")
+	types.AddRequiredFields(reflect.TypeOf((*VibRequiresManualUninstallation)(nil)).Elem(), "Bulletin")
+	types.AddRequiredFields(reflect.TypeOf((*VibVibInfo)(nil)).Elem(), "Id", "Name", "Version", "Vendor", "Summary", "ReleaseDate")
+	types.AddRequiredFields(reflect.TypeOf((*VibVibServicesPinnedPemCertificate)(nil)).Elem(), "SslCertificate")
+	types.AddRequiredFields(reflect.TypeOf((*VmIssue)(nil)).Elem(), "Vm")
+	types.AddRequiredFields(reflect.TypeOf((*VmWrongFolder)(nil)).Elem(), "CurrentFolder", "RequiredFolder")
+	types.AddRequiredFields(reflect.TypeOf((*VmWrongResourcePool)(nil)).Elem(), "CurrentResourcePool", "RequiredResourcePool")
+}
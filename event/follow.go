@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const defaultFollowPageSize = 100
+
+// Bookmark is the position of a Follow event stream, the key and creation time of the last event seen.
+// A Bookmark can be persisted, for example as JSON, to resume a stream after a restart.
+type Bookmark struct {
+	Key         int32     `json:"key"`
+	CreatedTime time.Time `json:"createdTime"`
+}
+
+// IsZero returns true if the Bookmark has not seen any events.
+func (b *Bookmark) IsZero() bool {
+	return b.Key == 0 && b.CreatedTime.IsZero()
+}
+
+// update advances the Bookmark to the given event.
+func (b *Bookmark) update(e types.BaseEvent) {
+	event := e.GetEvent()
+	b.Key = event.Key
+	b.CreatedTime = event.CreatedTime
+}
+
+// Follow streams the events matching filter to f in the order they were created,
+// until ctx is done or an error occurs. The bookmark is updated after each call to f.
+// If the bookmark is zero, the stream starts with the latest page of events,
+// otherwise with the events created after the bookmark.
+// Changes to the collector's latestPage only trigger reading all new events, pageSize at a time,
+// such that no events are dropped when more than a page is created between updates.
+// Follow returns nil when ctx is done. After an error, such as session loss,
+// the stream can be resumed by calling Follow again with the same bookmark.
+func (m Manager) Follow(ctx context.Context, filter types.EventFilterSpec, pageSize int32, bookmark *Bookmark, f func([]types.BaseEvent) error) error {
+	if pageSize <= 0 {
+		pageSize = defaultFollowPageSize
+	}
+
+	err := m.follow(ctx, filter, pageSize, bookmark, f)
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
+
+func (m Manager) follow(ctx context.Context, filter types.EventFilterSpec, pageSize int32, bookmark *Bookmark, f func([]types.BaseEvent) error) error {
+	resume := !bookmark.IsZero()
+	if resume {
+		// event keys increase over time, the filter only narrows the events to skip
+		filter.Time = types.NewEventFilterSpecByTime(bookmark.CreatedTime, time.Time{})
+	}
+
+	collector, err := m.CreateCollectorForEvents(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = collector.Destroy(context.Background())
+	}()
+
+	if err = collector.SetPageSize(ctx, pageSize); err != nil {
+		return err
+	}
+
+	emit := func(events []types.BaseEvent) error {
+		var page []types.BaseEvent
+		for _, e := range events {
+			if resume && e.GetEvent().Key <= bookmark.Key {
+				continue
+			}
+			page = append(page, e)
+		}
+		if len(page) == 0 {
+			return nil
+		}
+
+		Sort(page)
+		if err := f(page); err != nil {
+			return err
+		}
+
+		bookmark.update(page[len(page)-1])
+		resume = true
+		return nil
+	}
+
+	if resume {
+		err = collector.Rewind(ctx)
+	} else {
+		var page []types.BaseEvent
+		if page, err = collector.LatestPage(ctx); err == nil {
+			if err = emit(page); err == nil {
+				// positions the collector before the latest page, which emit skips if seen
+				err = collector.Reset(ctx)
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	read := func() error {
+		for {
+			events, err := collector.ReadNextEvents(ctx, pageSize)
+			if err != nil || len(events) == 0 {
+				return err
+			}
+			if err = emit(events); err != nil {
+				return err
+			}
+		}
+	}
+
+	pc := property.DefaultCollector(m.Client())
+	props := []string{"latestPage"}
+
+	var rerr error
+
+	err = property.Wait(ctx, pc, collector.Reference(), props, func([]types.PropertyChange) bool {
+		rerr = read()
+		return rerr != nil
+	})
+
+	if rerr != nil {
+		return rerr
+	}
+
+	return err
+}
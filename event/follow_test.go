@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestManagerFollow(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		m := event.NewManager(c)
+
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		filter := types.EventFilterSpec{
+			Entity: &types.EventFilterSpecByEntity{
+				Entity:    vm.Reference(),
+				Recursion: types.EventFilterSpecRecursionOptionSelf,
+			},
+		}
+
+		// follow until the given number of events have been seen
+		follow := func(bookmark *event.Bookmark, n int) []types.BaseEvent {
+			var seen []types.BaseEvent
+			fctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			err := m.Follow(fctx, filter, 2, bookmark, func(events []types.BaseEvent) error {
+				seen = append(seen, events...)
+				if len(seen) >= n {
+					cancel()
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return seen
+		}
+
+		var bookmark event.Bookmark
+
+		// zero bookmark starts with the latest page
+		seen := follow(&bookmark, 2)
+		if len(seen) != 2 {
+			t.Fatalf("seen=%d", len(seen))
+		}
+		if _, ok := seen[1].(*types.VmPoweredOnEvent); !ok {
+			t.Errorf("last=%T", seen[1])
+		}
+		if bookmark.Key != seen[1].GetEvent().Key {
+			t.Errorf("bookmark=%d", bookmark.Key)
+		}
+
+		// events created while not following, more than a page
+		for i := 0; i < 3; i++ {
+			if err = m.LogUserEvent(ctx, vm.Reference(), "follow"); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		seen = follow(&bookmark, 3)
+		for _, e := range seen {
+			if _, ok := e.(*types.GeneralUserEvent); !ok {
+				t.Errorf("unexpected %T", e)
+			}
+		}
+
+		// events created while following
+		go func() {
+			task, _ := vm.PowerOff(ctx)
+			_ = task.Wait(ctx)
+		}()
+
+		seen = follow(&bookmark, 2)
+		if _, ok := seen[1].(*types.VmPoweredOffEvent); !ok {
+			t.Errorf("last=%T", seen[1])
+		}
+
+		// the bookmark is not updated when f returns an error
+		key := bookmark.Key
+		if err = m.LogUserEvent(ctx, vm.Reference(), "follow"); err != nil {
+			t.Fatal(err)
+		}
+		done := errors.New("done")
+		err = m.Follow(ctx, filter, 2, &bookmark, func([]types.BaseEvent) error { return done })
+		if err != done || bookmark.Key != key {
+			t.Errorf("err=%v key=%d", err, bookmark.Key)
+		}
+	})
+}
@@ -68,6 +68,42 @@ EOF
     each { |e| e.dump(io); e.dump_init(io) }
 end
 
+File.open(File.join(ARGV.first, "types/required.go"), "w") do |io|
+  io.print WSDL.header("types")
+  if target != "vim"
+    io.print <<EOF
+import (
+        "reflect"
+        "github.com/vmware/govmomi/vim25/types"
+)
+EOF
+  end
+
+  types = wsdl.
+    types.
+    sort_by { |x| x.name }.
+    uniq { |x| x.name }.
+    select { |t| !t.is_enum? }
+
+  # Elements with a type, such as method requests, share the fields of the referenced type.
+  fields = {}
+  types.each do |t|
+    fields[ucfirst(t.name)] = t.required_fields unless t.is_a?(Element) && t.has_type?
+  end
+
+  io.print "func init() {\n"
+  types.each do |t|
+    if t.is_a?(Element) && t.has_type?
+      next unless t.vim_type?
+      f = fields[t.vim_type]
+    else
+      f = fields[ucfirst(t.name)]
+    end
+    init_required(io, t.name, f) unless f.nil? || f.empty?
+  end
+  io.print "}\n"
+end
+
 File.open(File.join(ARGV.first, "types/if.go"), "w") do |io|
   io.print WSDL.header("types")
 
@@ -88,6 +88,17 @@ def init_type(io, name, kind, minApiVersion=nil, minApiVersionsForValues=nil)
   io.print "}\n\n"
 end
 
+def init_required(io, name, fields)
+  t = "reflect.TypeOf((*#{ucfirst name})(nil)).Elem()"
+  names = fields.map { |f| "\"#{f}\"" }.join(", ")
+
+  if $target == "vim25"
+    io.print "requiredFields[#{t}] = []string{#{names}}\n"
+  else
+    io.print "types.AddRequiredFields(#{t}, #{names})\n"
+  end
+end
+
 class Peek
   class Type
     attr_accessor :parent, :children, :klass
@@ -225,6 +236,10 @@ class Simple
     # noop
   end
 
+  def required_fields
+    []
+  end
+
   def var_name
     n = self.name
     n = n[1..-1] if n[0] == "_" # Strip leading _
@@ -455,6 +470,10 @@ class Element < Simple
     end
   end
 
+  def required_fields
+    has_type? ? [] : child.required_fields
+  end
+
   def dump_field(io, json_tag="", vijson_props=nil)
     xmlTag = name
     xmlTag += ",omitempty" if need_omitempty?
@@ -678,6 +697,11 @@ class ComplexType < Simple
       io.print "ServerGUID %s `xml:\"serverGuid,attr,omitempty\" json:\"serverGuid,omitempty\"`\n" % type
     end
 
+    def required_fields
+      attr = Attribute.new(@node.at_xpath(".//xsd:attribute"), @vijson)
+      [attr.var_name, "Value"]
+    end
+
     def peek
     end
   end
@@ -701,6 +725,10 @@ class ComplexType < Simple
       Sequence.new(@node, @vijson).dump_interface(io, name)
     end
 
+    def required_fields
+      Sequence.new(@node, @vijson).required_fields
+    end
+
     def peek
       Sequence.new(@node, @vijson).peek(vim_type(base))
     end
@@ -751,6 +779,12 @@ class ComplexType < Simple
       init_type io, "Base#{name}", name
     end
 
+    # Fields of the base type are not included, as the generated struct embeds the base type.
+    def required_fields
+      return [] unless elements = sequence
+      elements.reject { |e| e.optional? }.map { |e| e.var_name }
+    end
+
     def peek(base = nil)
       return unless elements = sequence
       name = @node.attr("name")
@@ -829,6 +863,10 @@ class ComplexType < Simple
     io.print "}\n\n"
   end
 
+  def required_fields
+    klass ? klass.required_fields : []
+  end
+
   def peek
     Peek.register(name).klass = klass
     klass.peek if klass
@@ -107,7 +107,7 @@ func (cmd *download) Run(ctx context.Context, f *flag.FlagSet) error {
 		return err
 	}
 
-	if cmd.DatastoreFlag.OutputFlag.TTY {
+	if cmd.DatastoreFlag.OutputFlag.ShowProgress() {
 		logger := cmd.DatastoreFlag.ProgressLogger(fmt.Sprintf("Downloading%s... ", via))
 		p.Progress = logger
 		defer logger.Wait()
@@ -18,22 +18,29 @@ package datastore
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"io"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/vmware/govmomi/govc/cli"
 	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/object"
 )
 
 type tail struct {
 	*flags.DatastoreFlag
 	*flags.HostSystemFlag
 
-	count  int64
-	lines  int
-	follow bool
+	count    int64
+	lines    int
+	follow   bool
+	name     bool
+	quiet    bool
+	interval time.Duration
 }
 
 func init() {
@@ -50,14 +57,24 @@ func (cmd *tail) Register(ctx context.Context, f *flag.FlagSet) {
 	f.Int64Var(&cmd.count, "c", -1, "Output the last NUM bytes")
 	f.IntVar(&cmd.lines, "n", 10, "Output the last NUM lines")
 	f.BoolVar(&cmd.follow, "f", false, "Output appended data as the file grows")
+	f.BoolVar(&cmd.name, "F", false, "Same as -f, but keep following if the file is removed or rotated")
+	f.BoolVar(&cmd.quiet, "q", false, "Never output headers giving file names")
+	f.DurationVar(&cmd.interval, "s", time.Second, "Interval between polls with -f")
 }
 
 func (cmd *tail) Description() string {
 	return `Output the last part of datastore files.
 
+Files are followed by polling the datastore with ranged HTTP GET requests.
+If a followed file is truncated, output resumes from the start of the file.
+With -F, a file that is removed or rotated is followed again once it has been recreated.
+With more than one PATH, each part of the output is preceded by a header giving the file name.
+
 Examples:
   govc datastore.tail -n 100 vm-name/vmware.log
-  govc datastore.tail -n 0 -f vm-name/vmware.log`
+  govc datastore.tail -n 0 -f vm-name/vmware.log
+  govc datastore.tail -F -s 5s vm-a/vmware.log vm-b/vmware.log
+  govc datastore.tail -ds $scratch -F .locker/log/vmkernel.log # host scratch location`
 }
 
 func (cmd *tail) Process(ctx context.Context) error {
@@ -67,20 +84,100 @@ func (cmd *tail) Process(ctx context.Context) error {
 	if err := cmd.HostSystemFlag.Process(ctx); err != nil {
 		return err
 	}
+	if cmd.interval <= 0 {
+		return fmt.Errorf("invalid interval: %s", cmd.interval)
+	}
+	if cmd.name {
+		cmd.follow = true
+	}
 	return nil
 }
 
 func (cmd *tail) Usage() string {
-	return "PATH"
+	return "PATH..."
+}
+
+// tailWriter serializes output from multiple files,
+// writing a header each time the output switches to a different file.
+type tailWriter struct {
+	sync.Mutex
+
+	w       io.Writer
+	headers bool
+	last    string
+}
+
+func (w *tailWriter) write(name string, b []byte) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.headers && name != w.last {
+		sep := "\n"
+		if w.last == "" {
+			sep = ""
+		}
+		if _, err := fmt.Fprintf(w.w, "%s==> %s <==\n", sep, name); err != nil {
+			return err
+		}
+		w.last = name
+	}
+
+	_, err := w.w.Write(b)
+	return err
+}
+
+type tailFile struct {
+	*tailWriter
+	name string
+}
+
+func (w *tailFile) Write(b []byte) (int, error) {
+	if err := w.write(w.name, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (cmd *tail) open(ctx context.Context, ds *object.Datastore, name string) (io.ReadCloser, error) {
+	file, err := ds.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.count >= 0 {
+		info, serr := file.Stat()
+		if serr != nil {
+			return nil, serr
+		}
+
+		if info.Size() > cmd.count {
+			_, err = file.Seek(info.Size()-cmd.count, io.SeekStart)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else if cmd.lines >= 0 {
+		err = file.Tail(cmd.lines)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case cmd.name:
+		return file.FollowName(cmd.interval), nil
+	case cmd.follow:
+		return file.Follow(cmd.interval), nil
+	default:
+		return file, nil
+	}
 }
 
 func (cmd *tail) Run(ctx context.Context, f *flag.FlagSet) error {
-	if f.NArg() != 1 {
+	if f.NArg() == 0 {
 		return flag.ErrHelp
 	}
 
-	p := cmd.Args(f.Args())[0]
-
 	ds, err := cmd.Datastore()
 	if err != nil {
 		return err
@@ -95,43 +192,49 @@ func (cmd *tail) Run(ctx context.Context, f *flag.FlagSet) error {
 		ctx = ds.HostContext(ctx, h)
 	}
 
-	file, err := ds.Open(ctx, p.Path)
-	if err != nil {
+	args := f.Args()
+	paths := cmd.Args(args)
+	out := &tailWriter{w: os.Stdout, headers: len(paths) > 1 && !cmd.quiet}
+
+	output := func(name string, r io.ReadCloser) error {
+		_, err := io.Copy(&tailFile{tailWriter: out, name: name}, r)
+		_ = r.Close()
 		return err
 	}
 
-	var reader io.ReadCloser = file
-
-	var offset int64
-
-	if cmd.count >= 0 {
-		info, serr := file.Stat()
-		if serr != nil {
-			return serr
-		}
-
-		if info.Size() > cmd.count {
-			offset = info.Size() - cmd.count
-
-			_, err = file.Seek(offset, io.SeekStart)
+	if !cmd.follow {
+		for i, p := range paths {
+			r, err := cmd.open(ctx, ds, p.Path)
 			if err != nil {
 				return err
 			}
+			if err = output(args[i], r); err != nil {
+				return err
+			}
 		}
-	} else if cmd.lines >= 0 {
-		err = file.Tail(cmd.lines)
+		return nil
+	}
+
+	readers := make([]io.ReadCloser, len(paths))
+	for i, p := range paths {
+		readers[i], err = cmd.open(ctx, ds, p.Path)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.follow {
-		reader = file.Follow(time.Second)
-	}
+	var wg sync.WaitGroup
+	errs := make([]error, len(readers))
 
-	_, err = io.Copy(os.Stdout, reader)
+	for i := range readers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = output(args[i], readers[i])
+		}(i)
+	}
 
-	_ = reader.Close()
+	wg.Wait()
 
-	return err
+	return errors.Join(errs...)
 }
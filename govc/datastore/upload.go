@@ -88,7 +88,7 @@ func (cmd *upload) Run(ctx context.Context, f *flag.FlagSet) error {
 		return ds.Upload(ctx, os.Stdin, dst, &p)
 	}
 
-	if cmd.OutputFlag.TTY {
+	if cmd.OutputFlag.ShowProgress() {
 		logger := cmd.ProgressLogger("Uploading... ")
 		p.Progress = logger
 		defer logger.Wait()
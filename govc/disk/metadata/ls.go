@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vslm"
+)
+
+type ls struct {
+	*flags.DatastoreFlag
+
+	snapshot string
+	prefix   string
+}
+
+func init() {
+	cli.Register("disk.metadata.ls", &ls{})
+}
+
+func (cmd *ls) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+
+	f.StringVar(&cmd.snapshot, "s", "", "Snapshot ID")
+	f.StringVar(&cmd.prefix, "p", "", "Limit to keys with prefix")
+}
+
+func (cmd *ls) Usage() string {
+	return "ID"
+}
+
+func (cmd *ls) Description() string {
+	return `List metadata of disk ID on DS.
+
+Examples:
+  govc disk.metadata.ls 9b06a8b-d047-4d3c-b15b-43ea9608b1a6
+  govc disk.metadata.ls -p tier 9b06a8b-d047-4d3c-b15b-43ea9608b1a6
+  govc disk.metadata.ls -s ecbca542-0a25-4127-a585-82e4047750d6 9b06a8b-d047-4d3c-b15b-43ea9608b1a6`
+}
+
+type lsResult []types.KeyValue
+
+func (r lsResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	for _, kv := range r {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\n", kv.Key, kv.Value)
+	}
+
+	return tw.Flush()
+}
+
+func (cmd *ls) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 {
+		return flag.ErrHelp
+	}
+
+	ds, err := cmd.Datastore()
+	if err != nil {
+		return err
+	}
+
+	id := f.Arg(0)
+	c := ds.Client()
+
+	var res []types.KeyValue
+
+	if c.IsVC() {
+		// Metadata can only be retrieved from vCenter via the vslm endpoint
+		vc, err := vslm.NewClient(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		var sid *types.ID
+		if cmd.snapshot != "" {
+			sid = &types.ID{Id: cmd.snapshot}
+		}
+
+		m := vslm.NewGlobalObjectManager(vc)
+		res, err = m.RetrieveMetadata(ctx, types.ID{Id: id}, sid, cmd.prefix)
+		if err != nil {
+			return err
+		}
+	} else {
+		m := vslm.NewObjectManager(c)
+		res, err = m.RetrieveMetadata(ctx, ds, id, cmd.snapshot, cmd.prefix)
+		if err != nil {
+			return err
+		}
+	}
+
+	return cmd.WriteResult(lsResult(res))
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vslm"
+)
+
+type update struct {
+	*flags.DatastoreFlag
+
+	remove flags.StringList
+}
+
+func init() {
+	cli.Register("disk.metadata.update", &update{})
+}
+
+func (cmd *update) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+
+	f.Var(&cmd.remove, "d", "Delete metadata KEY")
+}
+
+func (cmd *update) Usage() string {
+	return "ID [KEY=VALUE]..."
+}
+
+func (cmd *update) Description() string {
+	return `Update metadata of disk ID on DS.
+
+Each KEY=VALUE argument adds or replaces the value of KEY.
+
+Examples:
+  govc disk.metadata.update 9b06a8b-d047-4d3c-b15b-43ea9608b1a6 owner=ops tier=gold
+  govc disk.metadata.update -d tier 9b06a8b-d047-4d3c-b15b-43ea9608b1a6`
+}
+
+func (cmd *update) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() < 1 || (f.NArg() == 1 && len(cmd.remove) == 0) {
+		return flag.ErrHelp
+	}
+
+	var metadata []types.KeyValue
+
+	for _, arg := range f.Args()[1:] {
+		key, val, ok := strings.Cut(arg, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("invalid metadata: %q", arg)
+		}
+		metadata = append(metadata, types.KeyValue{Key: key, Value: val})
+	}
+
+	ds, err := cmd.Datastore()
+	if err != nil {
+		return err
+	}
+
+	id := f.Arg(0)
+	m := vslm.NewObjectManager(ds.Client())
+
+	task, err := m.UpdateMetadata(ctx, ds, id, metadata, cmd.remove)
+	if err != nil {
+		return err
+	}
+	logger := cmd.ProgressLogger(fmt.Sprintf("Updating %s metadata...", id))
+	defer logger.Wait()
+
+	_, err = task.WaitForResult(ctx, logger)
+	return err
+}
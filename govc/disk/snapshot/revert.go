@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/vslm"
+)
+
+type revert struct {
+	*flags.DatastoreFlag
+}
+
+func init() {
+	cli.Register("disk.snapshot.revert", &revert{})
+}
+
+func (cmd *revert) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+}
+
+func (cmd *revert) Usage() string {
+	return "ID SID"
+}
+
+func (cmd *revert) Description() string {
+	return `Revert disk ID to snapshot SID on DS.
+
+Snapshots taken after SID are removed.
+The disk must not be attached to a VM.
+
+Examples:
+  govc disk.snapshot.revert ffe6a398-eb8e-4eaa-9118-e1f16b8b8e3c ecbca542-0a25-4127-a585-82e4047750d6`
+}
+
+func (cmd *revert) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 2 {
+		return flag.ErrHelp
+	}
+
+	ds, err := cmd.Datastore()
+	if err != nil {
+		return err
+	}
+
+	sid := f.Arg(1)
+	m := vslm.NewObjectManager(ds.Client())
+
+	task, err := m.Revert(ctx, ds, f.Arg(0), sid)
+	if err != nil {
+		return err
+	}
+	logger := cmd.ProgressLogger(fmt.Sprintf("Reverting to %s...", sid))
+	defer logger.Wait()
+
+	_, err = task.WaitForResult(ctx, logger)
+	return err
+}
@@ -0,0 +1,479 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dvs
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type migrate struct {
+	*flags.HostSystemFlag
+
+	path      string
+	portgroup flags.StringList
+	uplink    string
+	dryRun    bool
+}
+
+func init() {
+	cli.Register("dvs.migrate", &migrate{})
+}
+
+func (cmd *migrate) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.HostSystemFlag, ctx = flags.NewHostSystemFlag(ctx)
+	cmd.HostSystemFlag.Register(ctx, f)
+
+	f.StringVar(&cmd.path, "dvs", "", "DVS path")
+	f.Var(&cmd.portgroup, "portgroup", "Migrate standard portgroup SRC to DVS portgroup DST (SRC=DST)")
+	f.StringVar(&cmd.uplink, "uplink", "", "Names of the host physical NICs to move from standard switches to the DVS")
+	f.BoolVar(&cmd.dryRun, "dry-run", false, "List the migration steps, without making any changes")
+}
+
+func (cmd *migrate) Process(ctx context.Context) error {
+	if err := cmd.HostSystemFlag.Process(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cmd *migrate) Usage() string {
+	return "HOST..."
+}
+
+func (cmd *migrate) Description() string {
+	return `Migrate HOST networking from standard switches to DVS.
+
+VM network adapters and VMkernel NICs connected to a standard portgroup SRC are moved to the DVS portgroup DST.
+Hosts are added to the DVS if needed. The steps are ordered to minimize disruption:
+VM network adapters are moved first, followed by VMkernel NICs, with '-uplink' physical NICs moved last.
+
+Examples:
+  govc dvs.migrate -dvs DSwitch -portgroup "VM Network=DPortGroup" -dry-run hostA
+  govc dvs.migrate -dvs DSwitch -portgroup "VM Network=DPortGroup" -portgroup "Management Network=DMgmt" -uplink vmnic0,vmnic1 hostA hostB
+  govc dvs.migrate -dvs DSwitch -portgroup "VM Network=DPortGroup" -dry-run -json hostA | jq .`
+}
+
+type migrateStep struct {
+	Host string `json:"host"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+
+	apply func(context.Context) error
+}
+
+func (s *migrateStep) String() string {
+	if s.From == "" {
+		return fmt.Sprintf("%s: add %s %s to %s", s.Host, s.Type, s.Name, s.To)
+	}
+	return fmt.Sprintf("%s: migrate %s %s from %s to %s", s.Host, s.Type, s.Name, s.From, s.To)
+}
+
+type migrateResult struct {
+	DVS    string        `json:"dvs"`
+	DryRun bool          `json:"dryRun"`
+	Steps  []migrateStep `json:"steps"`
+}
+
+func (r *migrateResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Host\tType\tName\tFrom\tTo\n")
+
+	for _, s := range r.Steps {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", s.Host, s.Type, s.Name, s.From, s.To)
+	}
+
+	return tw.Flush()
+}
+
+// migratePortgroup is the DVS portgroup a standard portgroup is migrated to.
+type migratePortgroup struct {
+	name    string
+	backing types.BaseVirtualDeviceBackingInfo
+	port    types.DistributedVirtualSwitchPortConnection
+}
+
+// portgroups returns the -portgroup mappings, keyed by standard portgroup name.
+func (cmd *migrate) portgroups(ctx context.Context, dvs *object.DistributedVirtualSwitch) (map[string]*migratePortgroup, error) {
+	finder, err := cmd.Finder()
+	if err != nil {
+		return nil, err
+	}
+
+	pgs := make(map[string]*migratePortgroup)
+
+	for _, arg := range cmd.portgroup {
+		src, dst, ok := strings.Cut(arg, "=")
+		if !ok || src == "" || dst == "" {
+			return nil, fmt.Errorf("invalid portgroup mapping: %q", arg)
+		}
+
+		net, err := finder.Network(ctx, dst)
+		if err != nil {
+			return nil, err
+		}
+
+		pg, ok := net.(*object.DistributedVirtualPortgroup)
+		if !ok {
+			return nil, fmt.Errorf("%s (%T) is not of type %T", dst, net, pg)
+		}
+
+		var dvpg mo.DistributedVirtualPortgroup
+		err = pg.Properties(ctx, pg.Reference(), []string{"config.distributedVirtualSwitch"}, &dvpg)
+		if err != nil {
+			return nil, err
+		}
+
+		if dvpg.Config.DistributedVirtualSwitch == nil || *dvpg.Config.DistributedVirtualSwitch != dvs.Reference() {
+			return nil, fmt.Errorf("%s is not a portgroup of %s", dst, dvs.InventoryPath)
+		}
+
+		backing, err := pg.EthernetCardBackingInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		pgs[src] = &migratePortgroup{
+			name:    pg.Name(),
+			backing: backing,
+			port:    backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo).Port,
+		}
+	}
+
+	return pgs, nil
+}
+
+// plan returns the steps to migrate the given host to the DVS, in order of: DVS membership, VMs, vmknics and uplinks.
+func (cmd *migrate) plan(ctx context.Context, dvs *object.DistributedVirtualSwitch, s *mo.DistributedVirtualSwitch, pgs map[string]*migratePortgroup, host *object.HostSystem) ([]migrateStep, error) {
+	var steps []migrateStep
+
+	name := host.Name()
+	ref := host.Reference()
+	dvsName := dvs.Name()
+
+	var h mo.HostSystem
+	err := host.Properties(ctx, ref, []string{"vm", "configManager.networkSystem"}, &h)
+	if err != nil {
+		return nil, err
+	}
+
+	ns := object.NewHostNetworkSystem(host.Client(), *h.ConfigManager.NetworkSystem)
+
+	var mns mo.HostNetworkSystem
+	err = ns.Properties(ctx, ns.Reference(), []string{"networkInfo"}, &mns)
+	if err != nil {
+		return nil, err
+	}
+
+	info := s.Config.GetDVSConfigInfo()
+	var member *types.DistributedVirtualSwitchHostMember
+	for i := range info.Host {
+		if *info.Host[i].Config.Host == ref {
+			member = &info.Host[i]
+		}
+	}
+
+	var pnics []types.DistributedVirtualSwitchHostMemberPnicSpec
+	if member != nil {
+		if backing, ok := member.Config.Backing.(*types.DistributedVirtualSwitchHostMemberPnicBacking); ok {
+			pnics = backing.PnicSpec
+		}
+	} else {
+		steps = append(steps, migrateStep{
+			Host: name,
+			Type: "host",
+			Name: name,
+			To:   dvsName,
+			apply: func(ctx context.Context) error {
+				return cmd.reconfigure(ctx, dvs, types.DistributedVirtualSwitchHostMemberConfigSpec{
+					Operation: string(types.ConfigSpecOperationAdd),
+					Host:      ref,
+					Backing:   new(types.DistributedVirtualSwitchHostMemberPnicBacking),
+				})
+			},
+		})
+	}
+
+	var vms []mo.VirtualMachine
+	if len(h.Vm) != 0 {
+		pc := property.DefaultCollector(host.Client())
+		err = pc.Retrieve(ctx, h.Vm, []string{"name", "config.hardware.device"}, &vms)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortFunc(vms, func(a, b mo.VirtualMachine) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, vm := range vms {
+		if vm.Config == nil {
+			continue
+		}
+
+		devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
+
+		for _, device := range devices.SelectByType((*types.VirtualEthernetCard)(nil)) {
+			backing, ok := device.GetVirtualDevice().Backing.(*types.VirtualEthernetCardNetworkBackingInfo)
+			if !ok {
+				continue
+			}
+
+			pg, ok := pgs[backing.DeviceName]
+			if !ok {
+				continue
+			}
+
+			obj := object.NewVirtualMachine(host.Client(), vm.Self)
+			nic := device
+
+			steps = append(steps, migrateStep{
+				Host: name,
+				Type: "vm",
+				Name: vm.Name + "/" + devices.Name(device),
+				From: backing.DeviceName,
+				To:   pg.name,
+				apply: func(ctx context.Context) error {
+					nic.GetVirtualDevice().Backing = pg.backing
+					return obj.EditDevice(ctx, nic)
+				},
+			})
+		}
+	}
+
+	for _, nic := range mns.NetworkInfo.Vnic {
+		pg, ok := pgs[nic.Portgroup]
+		if !ok {
+			continue
+		}
+
+		device := nic.Device
+
+		steps = append(steps, migrateStep{
+			Host: name,
+			Type: "vmknic",
+			Name: device,
+			From: nic.Portgroup,
+			To:   pg.name,
+			apply: func(ctx context.Context) error {
+				port := pg.port
+				return ns.UpdateVirtualNic(ctx, device, types.HostVirtualNicSpec{DistributedVirtualPort: &port})
+			},
+		})
+	}
+
+	if cmd.uplink == "" {
+		return steps, nil
+	}
+
+	for _, uplink := range strings.Split(cmd.uplink, ",") {
+		uplink = strings.TrimSpace(uplink)
+
+		if slices.ContainsFunc(pnics, func(spec types.DistributedVirtualSwitchHostMemberPnicSpec) bool {
+			return spec.PnicDevice == uplink
+		}) {
+			continue // already moved
+		}
+
+		i := slices.IndexFunc(mns.NetworkInfo.Pnic, func(pnic types.PhysicalNic) bool {
+			return pnic.Device == uplink
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("%s: physical NIC %s not found", name, uplink)
+		}
+		key := mns.NetworkInfo.Pnic[i].Key
+
+		var vswitch *types.HostVirtualSwitch
+		for j := range mns.NetworkInfo.Vswitch {
+			if slices.Contains(mns.NetworkInfo.Vswitch[j].Pnic, key) {
+				vswitch = &mns.NetworkInfo.Vswitch[j]
+			}
+		}
+
+		pnics = append(pnics, types.DistributedVirtualSwitchHostMemberPnicSpec{PnicDevice: uplink})
+		backing := &types.DistributedVirtualSwitchHostMemberPnicBacking{PnicSpec: slices.Clone(pnics)}
+
+		step := migrateStep{
+			Host: name,
+			Type: "uplink",
+			Name: uplink,
+			To:   dvsName,
+		}
+
+		var spec *types.HostVirtualSwitchSpec
+		if vswitch != nil {
+			step.From = vswitch.Name
+			spec = removeUplink(&vswitch.Spec, uplink)
+			vswitch.Spec = *spec
+		}
+
+		vswitchName := step.From
+		step.apply = func(ctx context.Context) error {
+			if spec != nil {
+				if err := ns.UpdateVirtualSwitch(ctx, vswitchName, *spec); err != nil {
+					return err
+				}
+			}
+
+			return cmd.reconfigure(ctx, dvs, types.DistributedVirtualSwitchHostMemberConfigSpec{
+				Operation: string(types.ConfigSpecOperationEdit),
+				Host:      ref,
+				Backing:   backing,
+			})
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+// removeUplink returns a copy of the standard switch spec, with the given physical NIC removed from its bridge and teaming policy.
+func removeUplink(spec *types.HostVirtualSwitchSpec, uplink string) *types.HostVirtualSwitchSpec {
+	remove := func(nics []string) []string {
+		return slices.DeleteFunc(slices.Clone(nics), func(nic string) bool { return nic == uplink })
+	}
+
+	update := *spec
+
+	if bridge, ok := spec.Bridge.(*types.HostVirtualSwitchBondBridge); ok {
+		b := *bridge
+		b.NicDevice = remove(b.NicDevice)
+		if len(b.NicDevice) == 0 {
+			update.Bridge = nil
+		} else {
+			update.Bridge = &b
+		}
+	}
+
+	if spec.Policy != nil && spec.Policy.NicTeaming != nil && spec.Policy.NicTeaming.NicOrder != nil {
+		policy := *spec.Policy
+		teaming := *policy.NicTeaming
+		order := *teaming.NicOrder
+		order.ActiveNic = remove(order.ActiveNic)
+		order.StandbyNic = remove(order.StandbyNic)
+		teaming.NicOrder = &order
+		policy.NicTeaming = &teaming
+		update.Policy = &policy
+	}
+
+	return &update
+}
+
+func (cmd *migrate) reconfigure(ctx context.Context, dvs *object.DistributedVirtualSwitch, member types.DistributedVirtualSwitchHostMemberConfigSpec) error {
+	var s mo.DistributedVirtualSwitch
+	err := dvs.Properties(ctx, dvs.Reference(), []string{"config"}, &s)
+	if err != nil {
+		return err
+	}
+
+	task, err := dvs.Reconfigure(ctx, &types.DVSConfigSpec{
+		ConfigVersion: s.Config.GetDVSConfigInfo().ConfigVersion,
+		Host:          []types.DistributedVirtualSwitchHostMemberConfigSpec{member},
+	})
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
+
+func (cmd *migrate) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() == 0 || len(cmd.portgroup) == 0 && cmd.uplink == "" {
+		return flag.ErrHelp
+	}
+
+	finder, err := cmd.Finder()
+	if err != nil {
+		return err
+	}
+
+	net, err := finder.Network(ctx, cmd.path)
+	if err != nil {
+		return err
+	}
+
+	dvs, ok := net.(*object.DistributedVirtualSwitch)
+	if !ok {
+		return fmt.Errorf("%s (%T) is not of type %T", cmd.path, net, dvs)
+	}
+
+	var s mo.DistributedVirtualSwitch
+	err = dvs.Properties(ctx, dvs.Reference(), []string{"config"}, &s)
+	if err != nil {
+		return err
+	}
+
+	pgs, err := cmd.portgroups(ctx, dvs)
+	if err != nil {
+		return err
+	}
+
+	hosts, err := cmd.HostSystems(f.Args())
+	if err != nil {
+		return err
+	}
+
+	r := &migrateResult{
+		DVS:    dvs.Name(),
+		DryRun: cmd.dryRun,
+		Steps:  []migrateStep{},
+	}
+
+	for _, host := range hosts {
+		steps, err := cmd.plan(ctx, dvs, &s, pgs, host)
+		if err != nil {
+			return err
+		}
+		r.Steps = append(r.Steps, steps...)
+	}
+
+	if !cmd.dryRun {
+		for i := range r.Steps {
+			step := &r.Steps[i]
+
+			fmt.Fprintf(cmd, "%s... ", step)
+			if err = step.apply(ctx); err != nil {
+				fmt.Fprintln(cmd, "failed")
+				return fmt.Errorf("%s: %s", step, err)
+			}
+			fmt.Fprintln(cmd, "OK")
+		}
+
+		if !cmd.All() {
+			return nil
+		}
+	}
+
+	return cmd.WriteResult(r)
+}
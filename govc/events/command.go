@@ -35,11 +35,13 @@ import (
 type events struct {
 	*flags.DatacenterFlag
 
-	Max   int32
-	Tail  bool
-	Force bool
-	Long  bool
-	Kind  kinds
+	Max    int32
+	Tail   bool
+	Follow bool
+	Resume string
+	Force  bool
+	Long   bool
+	Kind   kinds
 }
 
 type kinds []string
@@ -65,6 +67,8 @@ func (cmd *events) Register(ctx context.Context, f *flag.FlagSet) {
 	cmd.Max = 25 // default
 	f.Var(flags.NewInt32(&cmd.Max), "n", "Output the last N events")
 	f.BoolVar(&cmd.Tail, "f", false, "Follow event stream")
+	f.BoolVar(&cmd.Follow, "follow", false, "Follow event stream, reconnecting after session loss")
+	f.StringVar(&cmd.Resume, "resume", "", "Resume -follow after the last event key saved in FILE")
 	f.BoolVar(&cmd.Force, "force", false, "Disable number objects to monitor limit")
 	f.BoolVar(&cmd.Long, "l", false, "Long listing format")
 	f.Var(&cmd.Kind, "type", "Include only the specified event types")
@@ -73,10 +77,15 @@ func (cmd *events) Register(ctx context.Context, f *flag.FlagSet) {
 func (cmd *events) Description() string {
 	return `Display events.
 
+The '-follow' option streams the events of a single PATH without gaps, until interrupted.
+If the connection fails or the session is lost, govc logs in again and resumes the stream.
+With '-resume', the last event key is saved to FILE and a restarted stream continues after that event.
+
 Examples:
   govc events vm/my-vm1 vm/my-vm2
   govc events /dc1/vm/* /dc2/vm/*
   govc events -type VmPoweredOffEvent -type VmPoweredOnEvent
+  govc events -follow -resume /var/lib/govc/events.json -json | logger -t vcenter
   govc ls -t HostSystem host/* | xargs govc events | grep -i vsan`
 }
 
@@ -89,7 +98,7 @@ func (cmd *events) printEvents(ctx context.Context, obj *types.ManagedObjectRefe
 	source := ""
 	if obj != nil {
 		source = obj.String()
-		if !cmd.JSON {
+		if !cmd.JSON && !cmd.YAML {
 			// print the object reference
 			fmt.Fprintf(os.Stdout, "\n==> %s <==\n", source)
 		}
@@ -182,6 +191,20 @@ func (cmd *events) Run(ctx context.Context, f *flag.FlagSet) error {
 		return err
 	}
 
+	if cmd.Follow {
+		if len(objs) != 1 {
+			return flag.ErrHelp
+		}
+
+		return cmd.WithCancel(ctx, func(wctx context.Context) error {
+			return cmd.follow(wctx, c, objs[0])
+		})
+	}
+
+	if cmd.Resume != "" {
+		return flag.ErrHelp
+	}
+
 	m := event.NewManager(c)
 
 	return cmd.WithCancel(ctx, func(wctx context.Context) error {
@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/retry"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// reconnect retries after the session was lost or the connection failed, such as while vpxd restarts.
+var reconnect = retry.On(retry.Exponential(time.Second, time.Minute),
+	retry.IsSessionExpired,
+	retry.IsServiceUnavailable,
+	vim25.IsTemporaryNetworkError,
+)
+
+// loadBookmark reads the -resume file. If the file does not exist, the stream starts with the latest events.
+func (cmd *events) loadBookmark() (*event.Bookmark, error) {
+	bookmark := new(event.Bookmark)
+
+	if cmd.Resume == "" {
+		return bookmark, nil
+	}
+
+	b, err := os.ReadFile(cmd.Resume)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return bookmark, nil
+		}
+		return nil, err
+	}
+
+	if err = json.Unmarshal(b, bookmark); err != nil {
+		return nil, fmt.Errorf("%s: %s", cmd.Resume, err)
+	}
+
+	return bookmark, nil
+}
+
+// saveBookmark writes the -resume file via rename, such that the file is never left truncated.
+func (cmd *events) saveBookmark(bookmark event.Bookmark) error {
+	if cmd.Resume == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(bookmark)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(cmd.Resume), filepath.Base(cmd.Resume))
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(append(b, '\n'))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), cmd.Resume)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+
+	return err
+}
+
+// login replaces the session of c, after the previous session was lost.
+func (cmd *events) login(ctx context.Context, c *vim25.Client) error {
+	if err := cmd.Session.Login(ctx, c, cmd.ConfigureTLS); err != nil {
+		return err
+	}
+
+	c.RoundTripper = cmd.RoundTripper(c.Client)
+
+	return nil
+}
+
+// follow streams events for obj until canceled, reconnecting as needed.
+func (cmd *events) follow(ctx context.Context, c *vim25.Client, obj types.ManagedObjectReference) error {
+	bookmark, err := cmd.loadBookmark()
+	if err != nil {
+		return err
+	}
+
+	m := event.NewManager(c)
+
+	filter := types.EventFilterSpec{
+		Entity: &types.EventFilterSpecByEntity{
+			Entity:    obj,
+			Recursion: types.EventFilterSpecRecursionOptionAll,
+		},
+		EventTypeId: cmd.Kind,
+	}
+
+	print := func(page []types.BaseEvent) error {
+		if err := cmd.printEvents(ctx, nil, page, m); err != nil {
+			return err
+		}
+
+		last := page[len(page)-1].GetEvent()
+		return cmd.saveBookmark(event.Bookmark{Key: last.Key, CreatedTime: last.CreatedTime})
+	}
+
+	login := false
+
+	for attempt := 1; ; attempt++ {
+		key := bookmark.Key
+
+		if login {
+			err = cmd.login(ctx, c)
+		}
+		if !login || err == nil {
+			err = m.Follow(ctx, filter, cmd.Max, bookmark, print)
+			if err == nil {
+				return nil // canceled
+			}
+			login = retry.IsSessionExpired(err)
+		}
+
+		if bookmark.Key != key {
+			attempt = 1 // events were streamed since the previous attempt
+		}
+
+		ok, delay := reconnect(attempt, err)
+		if !ok {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "%s: %s (reconnecting in %s)\n", os.Args[0], err, delay.Round(time.Millisecond))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/types"
@@ -32,6 +33,7 @@ type NetworkFlag struct {
 	*DatacenterFlag
 
 	name    string
+	names   []string
 	net     object.NetworkReference
 	adapter string
 	address string
@@ -82,6 +84,7 @@ func (flag *NetworkFlag) String() string {
 
 func (flag *NetworkFlag) Set(name string) error {
 	flag.name = name
+	flag.names = append(flag.names, name)
 	flag.isset = true
 	return nil
 }
@@ -113,12 +116,71 @@ func (flag *NetworkFlag) Device() (types.BaseVirtualDevice, error) {
 		return nil, err
 	}
 
+	return flag.device(net, flag.adapter, flag.address)
+}
+
+// Devices returns a network device for each value of a repeated -net flag,
+// or a single device for the default network if -net is not specified.
+// Each value may include comma separated options overriding -net.adapter and -net.address for that device:
+//
+//	-net "VM Network,adapter=vmxnet3,address=00:50:56:00:00:01"
+func (flag *NetworkFlag) Devices() ([]types.BaseVirtualDevice, error) {
+	if len(flag.names) == 0 {
+		device, err := flag.Device()
+		if err != nil {
+			return nil, err
+		}
+		return []types.BaseVirtualDevice{device}, nil
+	}
+
+	finder, err := flag.Finder()
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []types.BaseVirtualDevice
+
+	for _, value := range flag.names {
+		options := strings.Split(value, ",")
+		name := options[0]
+		adapter := flag.adapter
+		address := flag.address
+
+		for _, option := range options[1:] {
+			key, val, _ := strings.Cut(option, "=")
+			switch key {
+			case "adapter":
+				adapter = val
+			case "address":
+				address = val
+			default:
+				return nil, fmt.Errorf("invalid -net option %q", option)
+			}
+		}
+
+		net, err := finder.NetworkOrDefault(context.TODO(), name)
+		if err != nil {
+			return nil, err
+		}
+
+		device, err := flag.device(net, adapter, address)
+		if err != nil {
+			return nil, err
+		}
+
+		devices = append(devices, device)
+	}
+
+	return devices, nil
+}
+
+func (flag *NetworkFlag) device(net object.NetworkReference, adapter, address string) (types.BaseVirtualDevice, error) {
 	backing, err := net.EthernetCardBackingInfo(context.TODO())
 	if err != nil {
 		return nil, err
 	}
 
-	device, err := object.EthernetCardTypes().CreateEthernetCard(flag.adapter, backing)
+	device, err := object.EthernetCardTypes().CreateEthernetCard(adapter, backing)
 	if err != nil {
 		return nil, err
 	}
@@ -135,14 +197,14 @@ func (flag *NetworkFlag) Device() (types.BaseVirtualDevice, error) {
 		return nil, fmt.Errorf("device protocol is only supported for vmxnet3vrdma at the moment")
 	}
 
-	if flag.address == "-" {
+	if address == "-" {
 		card := device.(types.BaseVirtualEthernetCard).GetVirtualEthernetCard()
 		card.AddressType = string(types.VirtualEthernetCardMacTypeGenerated)
 		card.MacAddress = ""
-	} else if flag.address != "" {
+	} else if address != "" {
 		card := device.(types.BaseVirtualEthernetCard).GetVirtualEthernetCard()
 		card.AddressType = string(types.VirtualEthernetCardMacTypeManual)
-		card.MacAddress = flag.address
+		card.MacAddress = address
 	}
 
 	return device, nil
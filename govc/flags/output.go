@@ -17,6 +17,7 @@ limitations under the License.
 package flags
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -29,6 +30,7 @@ import (
 	"time"
 
 	"github.com/dougm/pretty"
+	"gopkg.in/yaml.v3"
 
 	"github.com/vmware/govmomi/govc/cli"
 	"github.com/vmware/govmomi/task"
@@ -47,13 +49,16 @@ type OutputFlag struct {
 
 	JSON bool
 	XML  bool
+	YAML bool
 	TTY  bool
 	Dump bool
 	Out  io.Writer
 	Spec bool
 
+	progress     string
 	formatError  bool
 	formatIndent bool
+	yamlDocs     int
 }
 
 var outputFlagKey = flagKey("output")
@@ -72,7 +77,9 @@ func (flag *OutputFlag) Register(ctx context.Context, f *flag.FlagSet) {
 	flag.RegisterOnce(func() {
 		f.BoolVar(&flag.JSON, "json", false, "Enable JSON output")
 		f.BoolVar(&flag.XML, "xml", false, "Enable XML output")
+		f.BoolVar(&flag.YAML, "yaml", false, "Enable YAML output")
 		f.BoolVar(&flag.Dump, "dump", false, "Enable Go output")
+		f.StringVar(&flag.progress, "progress", os.Getenv("GOVC_PROGRESS"), "Progress output format, json for JSON lines on stderr [GOVC_PROGRESS]")
 		if cli.ShowUnreleased() {
 			f.BoolVar(&flag.Spec, "spec", false, "Output spec without sending request")
 		}
@@ -89,6 +96,12 @@ func (flag *OutputFlag) Process(ctx context.Context) error {
 			flag.TTY = true
 		}
 
+		switch flag.progress {
+		case "", "json":
+		default:
+			return fmt.Errorf("invalid progress format: %q", flag.progress)
+		}
+
 		return nil
 	})
 }
@@ -126,7 +139,7 @@ func (flag *OutputFlag) WriteString(s string) (int, error) {
 }
 
 func (flag *OutputFlag) All() bool {
-	return flag.JSON || flag.XML || flag.Dump
+	return flag.JSON || flag.XML || flag.YAML || flag.Dump
 }
 
 func dumpValue(val interface{}) interface{} {
@@ -198,6 +211,18 @@ func (flag *OutputFlag) WriteResult(result OutputWriter) error {
 			e.SetIndent("", "  ")
 		}
 		err = e.Encode(result)
+	case flag.YAML:
+		var b []byte
+		b, err = encodeYAML(result)
+		if err != nil {
+			return err
+		}
+		if flag.yamlDocs > 0 {
+			// separate documents when a command writes more than one result
+			b = append([]byte("---\n"), b...)
+		}
+		flag.yamlDocs++
+		_, err = flag.Out.Write(b)
 	case flag.XML:
 		e := xml.NewEncoder(flag.Out)
 		if flag.formatIndent {
@@ -214,6 +239,43 @@ func (flag *OutputFlag) WriteResult(result OutputWriter) error {
 	return err
 }
 
+// yamlBlockStyle clears the flow and quoting styles of nodes decoded from JSON,
+// such that the encoder uses block style and only quotes strings where needed.
+func yamlBlockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		yamlBlockStyle(n)
+	}
+}
+
+// encodeYAML returns the YAML encoding of v, converted from its JSON encoding
+// such that json struct tags and MarshalJSON methods are honored.
+func encodeYAML(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var node yaml.Node
+	if err = yaml.Unmarshal(b, &node); err != nil {
+		return nil, err
+	}
+
+	yamlBlockStyle(&node)
+
+	var buf bytes.Buffer
+	e := yaml.NewEncoder(&buf)
+	e.SetIndent(2)
+	if err = e.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err = e.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
 func (flag *OutputFlag) WriteError(err error) bool {
 	if flag.formatError {
 		flag.Out = os.Stderr
@@ -303,6 +365,15 @@ func (e errorOutput) MarshalXML(encoder *xml.Encoder, start xml.StartElement) er
 	return errCannotEncode
 }
 
+// ShowProgress returns true if progress output is enabled, either for a terminal or as JSON.
+func (flag *OutputFlag) ShowProgress() bool {
+	return flag.TTY || flag.progress == "json"
+}
+
+// ProgressLogger returns a ProgressLogger for the terminal, or one that writes JSON lines to stderr with the -progress=json flag.
 func (flag *OutputFlag) ProgressLogger(prefix string) *progress.ProgressLogger {
+	if flag.progress == "json" {
+		return progress.NewJSONLogger(os.Stderr, prefix)
+	}
 	return progress.NewProgressLogger(flag.Log, prefix)
 }
@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type yamlResult struct {
+	Name  string                       `json:"name"`
+	Ref   types.ManagedObjectReference `json:"ref"`
+	Tags  []string                     `json:"tags"`
+	Empty []string                     `json:"empty,omitempty"`
+}
+
+func (*yamlResult) Write(io.Writer) error {
+	return nil
+}
+
+func TestOutputFlagYAML(t *testing.T) {
+	var buf bytes.Buffer
+
+	flag := &OutputFlag{YAML: true, Out: &buf}
+
+	res := &yamlResult{
+		Name: "true", // must remain a string
+		Ref:  types.ManagedObjectReference{Type: "VirtualMachine", Value: "vm-42"},
+		Tags: []string{"a", "b"},
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := flag.WriteResult(res); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	doc := `name: "true"
+ref:
+  type: VirtualMachine
+  value: vm-42
+tags:
+  - a
+  - b
+`
+	expect := doc + "---\n" + doc
+
+	if buf.String() != expect {
+		t.Errorf("output:\n%s", buf.String())
+	}
+}
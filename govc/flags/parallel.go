@@ -0,0 +1,203 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type ParallelFlag struct {
+	common
+
+	*OutputFlag
+
+	Parallel int
+}
+
+var parallelFlagKey = flagKey("parallel")
+
+func NewParallelFlag(ctx context.Context) (*ParallelFlag, context.Context) {
+	if v := ctx.Value(parallelFlagKey); v != nil {
+		return v.(*ParallelFlag), ctx
+	}
+
+	v := &ParallelFlag{}
+	v.OutputFlag, ctx = NewOutputFlag(ctx)
+	ctx = context.WithValue(ctx, parallelFlagKey, v)
+	return v, ctx
+}
+
+func (flag *ParallelFlag) Register(ctx context.Context, f *flag.FlagSet) {
+	flag.RegisterOnce(func() {
+		flag.OutputFlag.Register(ctx, f)
+
+		env := "GOVC_PARALLEL"
+		value := 1
+		if n, err := strconv.Atoi(os.Getenv(env)); err == nil {
+			value = n
+		}
+		usage := fmt.Sprintf("Number of objects to operate on concurrently [%s]", env)
+		f.IntVar(&flag.Parallel, "parallel", value, usage)
+	})
+}
+
+func (flag *ParallelFlag) Process(ctx context.Context) error {
+	return flag.ProcessOnce(func() error {
+		if err := flag.OutputFlag.Process(ctx); err != nil {
+			return err
+		}
+
+		if flag.Parallel < 1 {
+			return fmt.Errorf("invalid parallel value: %d", flag.Parallel)
+		}
+
+		return nil
+	})
+}
+
+// ParallelResult is the outcome of an operation on a single object.
+type ParallelResult struct {
+	Object types.ManagedObjectReference `json:"object"`
+	Name   string                       `json:"name,omitempty"`
+	Error  string                       `json:"error,omitempty"`
+
+	err error
+}
+
+// ParallelResults is the outcome of RunParallel, in the same order as the given objects.
+type ParallelResults struct {
+	Results []ParallelResult `json:"results"`
+}
+
+func (r *ParallelResults) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	for _, res := range r.Results {
+		status := "OK"
+		if res.err != nil {
+			status = "Error: " + res.Error
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", res.Name, status)
+	}
+
+	return tw.Flush()
+}
+
+// Err returns the errors of all failed operations joined together, or nil if none failed.
+func (r *ParallelResults) Err() error {
+	var errs []error
+
+	for _, res := range r.Results {
+		if res.err != nil {
+			errs = append(errs, res.err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// countWriter tracks if any output was written by an operation.
+type countWriter struct {
+	io.Writer
+	n int
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	w.n += len(p)
+	return w.Writer.Write(p)
+}
+
+// RunParallel calls fn for each of the given objects, with at most flag.Parallel calls in flight at a time.
+// Any progress output written to fn's io.Writer is followed by "OK" or the error on the same line.
+// When running more than one call at a time, output is buffered until the object's operation completes,
+// such that lines are not interleaved.
+// All objects are operated on, regardless of any errors, see ParallelResults.Err.
+func RunParallel[T mo.Reference](ctx context.Context, flag *ParallelFlag, objs []T, fn func(context.Context, io.Writer, T) error) *ParallelResults {
+	res := &ParallelResults{Results: make([]ParallelResult, len(objs))}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		jobs = make(chan int)
+	)
+
+	run := func(i int) {
+		obj := objs[i]
+		r := &res.Results[i]
+		r.Object = obj.Reference()
+		r.Name = r.Object.String()
+		if o, ok := any(obj).(interface{ Name() string }); ok && o.Name() != "" {
+			r.Name = o.Name()
+		}
+
+		var buf bytes.Buffer
+		w := &countWriter{Writer: flag.OutputFlag}
+		if flag.Parallel > 1 {
+			w.Writer = &buf
+		}
+
+		r.err = fn(ctx, w, obj)
+		if r.err != nil {
+			r.Error = r.err.Error()
+		}
+
+		if w.n != 0 {
+			if r.err == nil {
+				fmt.Fprintln(w, "OK")
+			} else {
+				fmt.Fprintf(w, "Error: %s\n", r.Error)
+			}
+		}
+
+		if buf.Len() != 0 {
+			mu.Lock()
+			_, _ = flag.OutputFlag.Write(buf.Bytes())
+			mu.Unlock()
+		}
+	}
+
+	for n := 0; n < min(flag.Parallel, len(objs)); n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				run(i)
+			}
+		}()
+	}
+
+	for i := range objs {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	return res
+}
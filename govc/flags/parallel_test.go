@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestRunParallel(t *testing.T) {
+	ctx := context.Background()
+
+	objs := make([]types.ManagedObjectReference, 10)
+	for i := range objs {
+		objs[i] = types.ManagedObjectReference{Type: "VirtualMachine", Value: fmt.Sprintf("vm-%d", i)}
+	}
+
+	for _, n := range []int{1, 3} {
+		t.Run(fmt.Sprintf("parallel=%d", n), func(t *testing.T) {
+			var out bytes.Buffer
+			flag := &ParallelFlag{OutputFlag: &OutputFlag{Out: &out, TTY: true}, Parallel: n}
+
+			var active, peak int32
+
+			res := RunParallel(ctx, flag, objs, func(_ context.Context, w io.Writer, obj types.ManagedObjectReference) error {
+				cur := atomic.AddInt32(&active, 1)
+				defer atomic.AddInt32(&active, -1)
+				for {
+					prev := atomic.LoadInt32(&peak)
+					if cur <= prev || atomic.CompareAndSwapInt32(&peak, prev, cur) {
+						break
+					}
+				}
+
+				fmt.Fprintf(w, "Working on %s... ", obj)
+				time.Sleep(10 * time.Millisecond)
+
+				if obj.Value == "vm-5" {
+					return errors.New("failed")
+				}
+				return nil
+			})
+
+			if int(peak) > n {
+				t.Errorf("peak=%d", peak)
+			}
+
+			if len(res.Results) != len(objs) {
+				t.Fatalf("results=%d", len(res.Results))
+			}
+
+			for i, r := range res.Results {
+				if r.Object != objs[i] {
+					t.Errorf("results[%d]=%s", i, r.Object)
+				}
+				if (r.Error != "") != (i == 5) {
+					t.Errorf("results[%d].Error=%q", i, r.Error)
+				}
+			}
+
+			if err := res.Err(); err == nil || err.Error() != "failed" {
+				t.Errorf("err=%v", err)
+			}
+
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			if len(lines) != len(objs) {
+				t.Fatalf("lines=%d", len(lines))
+			}
+			for _, line := range lines {
+				if !strings.HasSuffix(line, "... OK") && !strings.HasSuffix(line, "... Error: failed") {
+					t.Errorf("line=%q", line)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package date
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/object"
+)
+
+type report struct {
+	*flags.HostSystemFlag
+	*flags.OutputFlag
+
+	threshold time.Duration
+}
+
+func init() {
+	cli.Register("host.date.report", &report{})
+}
+
+func (cmd *report) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.HostSystemFlag, ctx = flags.NewHostSystemFlag(ctx)
+	cmd.HostSystemFlag.Register(ctx, f)
+
+	cmd.OutputFlag, ctx = flags.NewOutputFlag(ctx)
+	cmd.OutputFlag.Register(ctx, f)
+
+	f.DurationVar(&cmd.threshold, "threshold", 5*time.Second, "Maximum drift of host time versus server time")
+}
+
+func (cmd *report) Process(ctx context.Context) error {
+	if err := cmd.HostSystemFlag.Process(ctx); err != nil {
+		return err
+	}
+	if err := cmd.OutputFlag.Process(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cmd *report) Usage() string {
+	return "[PATH]..."
+}
+
+func (cmd *report) Description() string {
+	return `Report date and time drift of hosts in PATH versus the vCenter (or ESX) server time.
+
+Hosts are queried concurrently. Hosts with a drift greater than THRESHOLD are flagged,
+along with hosts that could not be queried.
+If PATH is not specified, all hosts are checked.
+
+Examples:
+  govc host.date.report
+  govc host.date.report -threshold 1s /dc1/host/cluster1
+  govc host.date.report -json | jq '.hosts[] | select(.exceeded)'`
+}
+
+type driftReport struct {
+	object.HostDateTimeDrift
+	Error string `json:"error,omitempty"`
+}
+
+func (d *driftReport) status() string {
+	switch {
+	case d.Err != nil:
+		return "ERROR"
+	case d.Exceeded:
+		return "DRIFT"
+	default:
+		return "OK"
+	}
+}
+
+type reportResult struct {
+	Threshold time.Duration `json:"threshold"`
+	Hosts     []driftReport `json:"hosts"`
+}
+
+func (r *reportResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Host\tHost Time\tDrift\tNTP\tNTP Servers\tStatus\n")
+
+	for _, d := range r.Hosts {
+		if d.Err != nil {
+			fmt.Fprintf(tw, "%s\t-\t-\t-\t-\t%s: %s\n", d.Name, d.status(), d.Error)
+			continue
+		}
+
+		ntp := "Stopped"
+		if d.NtpRunning {
+			ntp = "Running"
+		}
+
+		servers := "None"
+		if len(d.NtpServers) != 0 {
+			servers = strings.Join(d.NtpServers, ",")
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", d.Name, d.HostTime.Format(time.RFC3339),
+			d.Drift.Round(time.Millisecond), ntp, servers, d.status())
+	}
+
+	return tw.Flush()
+}
+
+func (cmd *report) Run(ctx context.Context, f *flag.FlagSet) error {
+	c, err := cmd.Client()
+	if err != nil {
+		return err
+	}
+
+	host, err := cmd.HostSystemIfSpecified()
+	if err != nil {
+		return err
+	}
+
+	var hosts []*object.HostSystem
+
+	if host != nil {
+		hosts = append(hosts, host)
+	} else {
+		args := f.Args()
+		if len(args) == 0 {
+			args = []string{"*"}
+		}
+		hosts, err = cmd.HostSystems(args)
+		if err != nil {
+			return err
+		}
+	}
+
+	drifts, err := object.HostDateTimeDrifts(ctx, c, hosts, cmd.threshold)
+	if err != nil {
+		return err
+	}
+
+	res := reportResult{Threshold: cmd.threshold}
+
+	for _, d := range drifts {
+		r := driftReport{HostDateTimeDrift: d}
+		if d.Err != nil {
+			r.Error = d.Err.Error()
+		}
+		res.Hosts = append(res.Hosts, r)
+	}
+
+	return cmd.WriteResult(&res)
+}
@@ -0,0 +1,326 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hardening
+
+import (
+	"context"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// services that should not be running on a hardened host
+var services = []string{"TSM", "TSM-SSH"}
+
+// rulesets that should be disabled or restricted to specific IP addresses
+var rulesets = []string{"CIMHttpServer", "CIMHttpsServer", "snmp", "sshServer", "vSphereClient", "webAccess"}
+
+// settings are the advanced options validated against an inclusive range
+var settings = []struct {
+	key      string
+	min, max int64
+}{
+	{"Security.AccountLockFailures", 1, 5},
+	{"Security.AccountUnlockTime", 900, -1},
+	{"UserVars.DcuiTimeOut", 1, 600},
+	{"UserVars.ESXiShellInteractiveTimeOut", 1, 900},
+	{"UserVars.ESXiShellTimeOut", 1, 900},
+}
+
+type report struct {
+	*flags.HostSystemFlag
+	*flags.OutputFlag
+
+	csv  bool
+	days int
+}
+
+func init() {
+	cli.Register("host.hardening.report", &report{})
+}
+
+func (cmd *report) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.HostSystemFlag, ctx = flags.NewHostSystemFlag(ctx)
+	cmd.HostSystemFlag.Register(ctx, f)
+
+	cmd.OutputFlag, ctx = flags.NewOutputFlag(ctx)
+	cmd.OutputFlag.Register(ctx, f)
+
+	f.BoolVar(&cmd.csv, "csv", false, "Enable CSV output")
+	f.IntVar(&cmd.days, "days", 30, "Minimum number of days before the host certificate expires")
+}
+
+func (cmd *report) Process(ctx context.Context) error {
+	if err := cmd.HostSystemFlag.Process(ctx); err != nil {
+		return err
+	}
+	if err := cmd.OutputFlag.Process(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cmd *report) Usage() string {
+	return "[PATH]..."
+}
+
+func (cmd *report) Description() string {
+	return `Report security hardening status of hosts in PATH.
+
+Each host is checked against a built-in baseline:
+  lockdown mode is enabled
+  ESXi Shell (TSM) and SSH (TSM-SSH) services are stopped
+  management firewall rulesets are disabled or restricted to specific IP addresses
+  host certificate does not expire within the given number of DAYS
+  account lockout and shell/DCUI timeout advanced settings are within range
+
+If PATH is not specified, all hosts are checked.
+
+Examples:
+  govc host.hardening.report
+  govc host.hardening.report -host hostname
+  govc host.hardening.report -json /dc1/host/cluster1
+  govc host.hardening.report -csv -days 90 > report.csv`
+}
+
+func (cmd *report) Run(ctx context.Context, f *flag.FlagSet) error {
+	host, err := cmd.HostSystemIfSpecified()
+	if err != nil {
+		return err
+	}
+
+	var hosts []*object.HostSystem
+
+	if host != nil {
+		hosts = append(hosts, host)
+	} else {
+		args := f.Args()
+		if len(args) == 0 {
+			args = []string{"*"}
+		}
+		hosts, err = cmd.HostSystems(args)
+		if err != nil {
+			return err
+		}
+	}
+
+	var res reportResult
+
+	for _, host := range hosts {
+		checks, err := cmd.check(ctx, host)
+		if err != nil {
+			return fmt.Errorf("%s: %s", host.InventoryPath, err)
+		}
+		res.Checks = append(res.Checks, checks...)
+	}
+
+	if cmd.csv {
+		return res.WriteCSV(cmd.Out)
+	}
+
+	return cmd.WriteResult(&res)
+}
+
+// Check is the result of a single baseline check
+type Check struct {
+	Host     string `json:"host"`
+	Check    string `json:"check"`
+	Value    string `json:"value"`
+	Baseline string `json:"baseline"`
+	Pass     bool   `json:"pass"`
+}
+
+func (c *Check) result() string {
+	if c.Pass {
+		return "PASS"
+	}
+	return "FAIL"
+}
+
+type reportResult struct {
+	Checks []Check `json:"checks"`
+}
+
+func (r *reportResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Host\tCheck\tValue\tBaseline\tResult\n")
+
+	for _, c := range r.Checks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", c.Host, c.Check, c.Value, c.Baseline, c.result())
+	}
+
+	return tw.Flush()
+}
+
+func (r *reportResult) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	_ = cw.Write([]string{"host", "check", "value", "baseline", "result"})
+
+	for _, c := range r.Checks {
+		_ = cw.Write([]string{c.Host, c.Check, c.Value, c.Baseline, c.result()})
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func (cmd *report) check(ctx context.Context, host *object.HostSystem) ([]Check, error) {
+	var checks []Check
+
+	add := func(name, value, baseline string, pass bool) {
+		checks = append(checks, Check{
+			Host:     host.Name(),
+			Check:    name,
+			Value:    value,
+			Baseline: baseline,
+			Pass:     pass,
+		})
+	}
+
+	m := host.ConfigManager()
+
+	// Lockdown mode
+	am, err := m.AccessManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := am.LockdownMode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	add("lockdown.mode", string(mode), "lockdownNormal|lockdownStrict", mode != types.HostLockdownModeLockdownDisabled && mode != "")
+
+	// Services
+	ss, err := m.ServiceSystem(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hs, err := ss.Service(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range services {
+		for _, s := range hs {
+			if s.Key == key {
+				value := "Stopped"
+				if s.Running {
+					value = "Running"
+				}
+				add("service."+key, value, "Stopped", !s.Running)
+			}
+		}
+	}
+
+	// Firewall exceptions
+	fs, err := m.FirewallSystem(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := fs.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range rulesets {
+		for _, rs := range info.Ruleset {
+			if rs.Key == key {
+				value := "disabled"
+				if rs.Enabled {
+					value = "restricted"
+					if rs.AllowedHosts == nil || rs.AllowedHosts.AllIp {
+						value = "all"
+					}
+				}
+				add("firewall."+key, value, "disabled|restricted", value != "all")
+			}
+		}
+	}
+
+	// Certificate expiry
+	cm, err := m.CertificateManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := cm.CertificateInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, pass := "-", false
+	if cert.NotAfter != nil {
+		value = cert.NotAfter.Format(time.RFC3339)
+		pass = cert.NotAfter.After(time.Now().Add(time.Duration(cmd.days) * 24 * time.Hour))
+	}
+	add("certificate.expiry", value, fmt.Sprintf(">%dd", cmd.days), pass)
+
+	// Advanced settings
+	om, err := m.OptionManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range settings {
+		baseline := fmt.Sprintf("%d-%d", s.min, s.max)
+		if s.max < 0 {
+			baseline = fmt.Sprintf(">=%d", s.min)
+		}
+
+		opts, err := om.Query(ctx, s.key)
+		if err != nil {
+			if fault.Is(err, &types.InvalidName{}) {
+				add(s.key, "-", baseline, false)
+				continue
+			}
+			return nil, err
+		}
+
+		if len(opts) == 0 {
+			add(s.key, "-", baseline, false)
+			continue
+		}
+
+		v := opts[0].GetOptionValue().Value
+		val, ok := optionInt(v)
+		add(s.key, fmt.Sprintf("%v", v), baseline, ok && val >= s.min && (s.max < 0 || val <= s.max))
+	}
+
+	return checks, nil
+}
+
+func optionInt(v any) (int64, bool) {
+	switch x := v.(type) {
+	case int32:
+		return int64(x), true
+	case int64:
+		return x, true
+	case int:
+		return int64(x), true
+	case string:
+		i, err := strconv.ParseInt(x, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
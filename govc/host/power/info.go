@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package power
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type info struct {
+	*flags.HostSystemFlag
+	*flags.OutputFlag
+}
+
+func init() {
+	cli.Register("host.power.info", &info{})
+}
+
+func (cmd *info) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.HostSystemFlag, ctx = flags.NewHostSystemFlag(ctx)
+	cmd.HostSystemFlag.Register(ctx, f)
+
+	cmd.OutputFlag, ctx = flags.NewOutputFlag(ctx)
+	cmd.OutputFlag.Register(ctx, f)
+}
+
+func (cmd *info) Description() string {
+	return `Display power management policy info for HOST.
+
+Examples:
+  govc host.power.info -host hostname
+  govc host.power.info -host hostname -json | jq .cpu`
+}
+
+func (cmd *info) Process(ctx context.Context) error {
+	if err := cmd.HostSystemFlag.Process(ctx); err != nil {
+		return err
+	}
+	if err := cmd.OutputFlag.Process(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+type powerInfo struct {
+	types.PowerSystemInfo
+	types.PowerSystemCapability
+	Cpu *object.HostCpuPowerManagement `json:"cpu"`
+}
+
+func yesno(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func (info *powerInfo) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Current policy:\t%s\n", info.CurrentPolicy.ShortName)
+	fmt.Fprintf(tw, "Available policies:\n")
+	for _, p := range info.AvailablePolicy {
+		fmt.Fprintf(tw, "  %d:\t%s\n", p.Key, p.ShortName)
+	}
+	fmt.Fprintf(tw, "CPU policy:\t%s\n", info.Cpu.CurrentPolicy)
+	fmt.Fprintf(tw, "CPU hardware support:\t%s\n", info.Cpu.HardwareSupport)
+	fmt.Fprintf(tw, "  P-states (turbo):\t%s\n", yesno(info.Cpu.PStates))
+	fmt.Fprintf(tw, "  C-states (idle):\t%s\n", yesno(info.Cpu.CStates))
+
+	return tw.Flush()
+}
+
+func (cmd *info) Run(ctx context.Context, f *flag.FlagSet) error {
+	host, err := cmd.HostSystem()
+	if err != nil {
+		return err
+	}
+
+	s, err := host.ConfigManager().PowerSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	var res powerInfo
+
+	current, err := s.Info(ctx)
+	if err != nil {
+		return err
+	}
+	res.PowerSystemInfo = *current
+
+	capability, err := s.Capability(ctx)
+	if err != nil {
+		return err
+	}
+	res.PowerSystemCapability = *capability
+
+	res.Cpu, err = host.CpuPowerManagement(ctx)
+	if err != nil {
+		return err
+	}
+
+	return cmd.WriteResult(&res)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package power
+
+import (
+	"context"
+	"flag"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+)
+
+type policy struct {
+	*flags.HostSystemFlag
+}
+
+func init() {
+	cli.Register("host.power.policy", &policy{})
+}
+
+func (cmd *policy) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.HostSystemFlag, ctx = flags.NewHostSystemFlag(ctx)
+	cmd.HostSystemFlag.Register(ctx, f)
+}
+
+func (cmd *policy) Usage() string {
+	return "POLICY"
+}
+
+func (cmd *policy) Description() string {
+	return `Change power management policy for HOST.
+
+POLICY can be the policy key or short name as displayed by host.power.info,
+or one of: "high-performance", "balanced", "low-power" or "custom".
+Settings of the custom policy can be changed using the host "Power." advanced options.
+
+Examples:
+  govc host.power.policy -host hostname high-performance
+  govc host.power.policy -host hostname balanced
+  govc host.option.set -host hostname Power.MaxCpuLoad 80
+  govc host.power.policy -host hostname custom`
+}
+
+func (cmd *policy) Process(ctx context.Context) error {
+	if err := cmd.HostSystemFlag.Process(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cmd *policy) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 {
+		return flag.ErrHelp
+	}
+
+	host, err := cmd.HostSystem()
+	if err != nil {
+		return err
+	}
+
+	s, err := host.ConfigManager().PowerSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.SetPolicy(ctx, f.Arg(0))
+}
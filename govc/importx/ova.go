@@ -38,6 +38,16 @@ func (cmd *ova) Usage() string {
 	return "PATH_TO_OVA"
 }
 
+func (cmd *ova) Description() string {
+	return `Import OVA.
+
+See 'govc import.ovf -h' for the '-resume', '-threads' and '-retries' flags and import report.
+
+Examples:
+  govc import.ova -m -resume import.json -json vm.ova | jq .files
+  govc import.ova -threads 4 vm.ova`
+}
+
 func (cmd *ova) Run(ctx context.Context, f *flag.FlagSet) error {
 	fpath, err := cmd.Prepare(f)
 	if err != nil {
@@ -55,7 +65,11 @@ func (cmd *ova) Run(ctx context.Context, f *flag.FlagSet) error {
 	}
 
 	vm := object.NewVirtualMachine(cmd.Importer.Client, *moref)
-	return cmd.Deploy(vm, cmd.OutputFlag)
+	if err = cmd.Deploy(vm, cmd.OutputFlag); err != nil {
+		return err
+	}
+
+	return cmd.writeReport()
 }
 
 func (cmd *ova) Import(fpath string) (*types.ManagedObjectReference, error) {
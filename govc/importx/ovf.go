@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
+	"io"
 
 	"github.com/vmware/govmomi/govc/cli"
 	"github.com/vmware/govmomi/govc/flags"
@@ -61,6 +63,9 @@ func (cmd *ovfx) Register(ctx context.Context, f *flag.FlagSet) {
 	f.StringVar(&cmd.Importer.Name, "name", "", "Name to use for new entity")
 	f.BoolVar(&cmd.Importer.VerifyManifest, "m", false, "Verify checksum of uploaded files against manifest (.mf)")
 	f.BoolVar(&cmd.Importer.Hidden, "hidden", false, "Enable hidden properties")
+	f.StringVar(&cmd.Importer.Resume, "resume", "", "Save import progress to FILE, resuming an interrupted import if FILE exists")
+	f.IntVar(&cmd.Importer.Threads, "threads", 1, "Number of files to upload in parallel")
+	f.IntVar(&cmd.Importer.Retries, "retries", 3, "Number of times to retry the upload of a file after a transient error")
 }
 
 func (cmd *ovfx) Process(ctx context.Context) error {
@@ -82,6 +87,12 @@ func (cmd *ovfx) Process(ctx context.Context) error {
 	if err := cmd.FolderFlag.Process(ctx); err != nil {
 		return err
 	}
+	if cmd.Importer.Threads < 1 {
+		return fmt.Errorf("invalid threads value: %d", cmd.Importer.Threads)
+	}
+	if cmd.Importer.Retries < 0 {
+		return fmt.Errorf("invalid retries value: %d", cmd.Importer.Retries)
+	}
 	return nil
 }
 
@@ -89,6 +100,25 @@ func (cmd *ovfx) Usage() string {
 	return "PATH_TO_OVF"
 }
 
+func (cmd *ovfx) Description() string {
+	return `Import OVF.
+
+When the '-resume' flag is specified, progress is saved to the given FILE.
+If the import is interrupted, running the same command again continues with the same
+NFC lease if it is still valid, uploading only the remaining files.
+The FILE is removed once the import completes.
+
+With '-threads', multiple disks are uploaded in parallel and their aggregate progress is reported.
+The upload of a file that fails with a transient network error is retried with backoff, up to '-retries' times.
+NFC uploads cannot continue from the middle of a file, so a retried file is uploaded again from the beginning.
+
+With the '-json', '-xml' or '-dump' flags, a report of the uploaded files and their checksums is written.
+
+Examples:
+  govc import.ovf -m -resume import.json -json vm.ovf | jq .files
+  govc import.ovf -threads 4 -retries 5 vm.ovf`
+}
+
 func (cmd *ovfx) Run(ctx context.Context, f *flag.FlagSet) error {
 	fpath, err := cmd.Prepare(f)
 	if err != nil {
@@ -106,7 +136,27 @@ func (cmd *ovfx) Run(ctx context.Context, f *flag.FlagSet) error {
 	}
 
 	vm := object.NewVirtualMachine(cmd.Importer.Client, *moref)
-	return cmd.Deploy(vm, cmd.OutputFlag)
+	if err = cmd.Deploy(vm, cmd.OutputFlag); err != nil {
+		return err
+	}
+
+	return cmd.writeReport()
+}
+
+type reportResult struct {
+	*importer.Report
+}
+
+func (*reportResult) Write(io.Writer) error {
+	return nil
+}
+
+func (cmd *ovfx) writeReport() error {
+	if !cmd.All() || cmd.Importer.Report == nil {
+		return nil
+	}
+
+	return cmd.WriteResult(&reportResult{cmd.Importer.Report})
 }
 
 func (cmd *ovfx) Prepare(f *flag.FlagSet) (string, error) {
@@ -118,6 +168,7 @@ func (cmd *ovfx) Prepare(f *flag.FlagSet) (string, error) {
 	}
 
 	cmd.Importer.Log = cmd.OutputFlag.Log
+	cmd.Importer.ProgressLogger = cmd.OutputFlag.ProgressLogger
 	cmd.Importer.Client, err = cmd.DatastoreFlag.Client()
 	if err != nil {
 		return "", err
@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"flag"
+
+	"github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type add struct {
+	*flags.ClientFlag
+
+	native bool
+	types.KmipServerInfo
+}
+
+func init() {
+	cli.Register("kms.add", &add{})
+}
+
+func (cmd *add) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ClientFlag, ctx = flags.NewClientFlag(ctx)
+	cmd.ClientFlag.Register(ctx, f)
+
+	f.BoolVar(&cmd.native, "N", false, "Add native key provider")
+	f.StringVar(&cmd.Name, "n", "", "Server name (defaults to address)")
+	f.StringVar(&cmd.Address, "a", "", "Server address")
+	cmd.Port = 5696 // default KMIP port
+	f.Var(flags.NewInt32(&cmd.Port), "p", "Server port")
+	f.StringVar(&cmd.ProxyAddress, "proxy", "", "Proxy address")
+	f.Var(flags.NewInt32(&cmd.ProxyPort), "proxy-port", "Proxy port")
+	f.StringVar(&cmd.UserName, "user", "", "Server username")
+}
+
+func (cmd *add) Usage() string {
+	return "NAME"
+}
+
+func (cmd *add) Description() string {
+	return `Add KMS cluster.
+
+Standard key providers require at least one server, added with the server address.
+Additional servers can be added to an existing standard key provider.
+Native key providers (-N) are managed by vCenter and have no servers.
+
+Examples:
+  govc kms.add -a kms.example.com my-kp
+  govc kms.add -a kms2.example.com -p 5696 my-kp
+  govc kms.add -N nkp`
+}
+
+func (cmd *add) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 {
+		return flag.ErrHelp
+	}
+	id := f.Arg(0)
+
+	if cmd.native != (cmd.Address == "") {
+		return flag.ErrHelp
+	}
+
+	c, err := cmd.Client()
+	if err != nil {
+		return err
+	}
+
+	m, err := crypto.GetManagerKmip(c)
+	if err != nil {
+		return err
+	}
+
+	if cmd.native {
+		return m.RegisterKmipCluster(ctx, id, types.KmipClusterInfoKmsManagementTypeNativeProvider)
+	}
+
+	ok, err := m.IsValidProvider(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		err = m.RegisterKmipCluster(ctx, id, types.KmipClusterInfoKmsManagementTypeVCenter)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Name == "" {
+		cmd.Name = cmd.Address
+	}
+
+	return m.RegisterKmipServer(ctx, types.KmipServerSpec{
+		ClusterId: types.KeyProviderId{Id: id},
+		Info:      cmd.KmipServerInfo,
+	})
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"flag"
+
+	"github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+)
+
+type setDefault struct {
+	*flags.DatacenterFlag
+
+	entity string
+}
+
+func init() {
+	cli.Register("kms.default", &setDefault{})
+}
+
+func (cmd *setDefault) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatacenterFlag, ctx = flags.NewDatacenterFlag(ctx)
+	cmd.DatacenterFlag.Register(ctx, f)
+
+	f.StringVar(&cmd.entity, "e", "", "Set entity default KMS cluster (cluster or host folder)")
+}
+
+func (cmd *setDefault) Usage() string {
+	return "NAME"
+}
+
+func (cmd *setDefault) Description() string {
+	return `Set default KMS cluster.
+
+When an entity is specified, NAME can be empty to remove the entity default.
+
+Examples:
+  govc kms.default my-kp
+  govc kms.default -e /dc/host/cluster my-kp
+  govc kms.default -e /dc/host/cluster "" # remove entity default`
+}
+
+func (cmd *setDefault) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 {
+		return flag.ErrHelp
+	}
+	id := f.Arg(0)
+
+	c, err := cmd.Client()
+	if err != nil {
+		return err
+	}
+
+	m, err := crypto.GetManagerKmip(c)
+	if err != nil {
+		return err
+	}
+
+	if cmd.entity == "" {
+		if id == "" {
+			return flag.ErrHelp
+		}
+		return m.MarkDefault(ctx, id)
+	}
+
+	ref, err := cmd.ManagedObject(ctx, cmd.entity)
+	if err != nil {
+		return err
+	}
+
+	return m.SetDefaultKmsClusterId(ctx, id, &ref)
+}
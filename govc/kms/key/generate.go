@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package key
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+)
+
+type generate struct {
+	*flags.ClientFlag
+}
+
+func init() {
+	cli.Register("kms.key.generate", &generate{})
+}
+
+func (cmd *generate) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ClientFlag, ctx = flags.NewClientFlag(ctx)
+	cmd.ClientFlag.Register(ctx, f)
+}
+
+func (cmd *generate) Usage() string {
+	return "[NAME]"
+}
+
+func (cmd *generate) Description() string {
+	return `Generate crypto key.
+
+The key is generated by key provider NAME, or the default key provider if NAME is not specified.
+The ID of the new key is output.
+
+Examples:
+  govc kms.key.generate
+  govc kms.key.generate my-kp`
+}
+
+func (cmd *generate) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() > 1 {
+		return flag.ErrHelp
+	}
+
+	c, err := cmd.Client()
+	if err != nil {
+		return err
+	}
+
+	m, err := crypto.GetManagerKmip(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := m.GenerateKey(ctx, f.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(id)
+
+	return nil
+}
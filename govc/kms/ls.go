@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type ls struct {
+	*flags.ClientFlag
+	*flags.OutputFlag
+}
+
+func init() {
+	cli.Register("kms.ls", &ls{})
+}
+
+func (cmd *ls) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ClientFlag, ctx = flags.NewClientFlag(ctx)
+	cmd.ClientFlag.Register(ctx, f)
+
+	cmd.OutputFlag, ctx = flags.NewOutputFlag(ctx)
+	cmd.OutputFlag.Register(ctx, f)
+}
+
+func (cmd *ls) Process(ctx context.Context) error {
+	if err := cmd.ClientFlag.Process(ctx); err != nil {
+		return err
+	}
+	return cmd.OutputFlag.Process(ctx)
+}
+
+func (cmd *ls) Usage() string {
+	return "[NAME]"
+}
+
+func (cmd *ls) Description() string {
+	return `Display KMS information.
+
+List key providers when NAME is not specified, otherwise list the servers of key provider NAME.
+
+Examples:
+  govc kms.ls
+  govc kms.ls -json
+  govc kms.ls my-kp`
+}
+
+type providerInfo struct {
+	types.KmipClusterInfo
+	Status *types.CryptoManagerKmipClusterStatus `json:"status,omitempty"`
+}
+
+type lsResult struct {
+	name      string
+	Providers []providerInfo `json:"providers"`
+}
+
+func providerType(info types.KmipClusterInfo) string {
+	if info.ManagementType == string(types.KmipClusterInfoKmsManagementTypeNativeProvider) {
+		return "native"
+	}
+	return "standard"
+}
+
+func (r *lsResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	if r.name == "" {
+		fmt.Fprintf(tw, "Name\tType\tStatus\tDefault\tServers\n")
+
+		for _, p := range r.Providers {
+			status := ""
+			if p.Status != nil {
+				status = string(p.Status.OverallStatus)
+			}
+
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%t\t%d\n",
+				p.ClusterId.Id, providerType(p.KmipClusterInfo), status, p.UseAsDefault, len(p.Servers))
+		}
+
+		return tw.Flush()
+	}
+
+	fmt.Fprintf(tw, "Name\tAddress\tStatus\tTrusted\n")
+
+	for _, p := range r.Providers {
+		status := map[string]types.CryptoManagerKmipServerStatus{}
+		if p.Status != nil {
+			for _, s := range p.Status.Servers {
+				status[s.Name] = s
+			}
+		}
+
+		for _, s := range p.Servers {
+			var trust []string
+			if st, ok := status[s.Name]; ok {
+				if st.ClientTrustServer != nil && *st.ClientTrustServer {
+					trust = append(trust, "client")
+				}
+				if st.ServerTrustClient != nil && *st.ServerTrustClient {
+					trust = append(trust, "server")
+				}
+			}
+
+			fmt.Fprintf(tw, "%s\t%s:%d\t%s\t%s\n",
+				s.Name, s.Address, s.Port, status[s.Name].Status, strings.Join(trust, ","))
+		}
+	}
+
+	return tw.Flush()
+}
+
+func (cmd *ls) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() > 1 {
+		return flag.ErrHelp
+	}
+
+	c, err := cmd.Client()
+	if err != nil {
+		return err
+	}
+
+	m, err := crypto.GetManagerKmip(c)
+	if err != nil {
+		return err
+	}
+
+	clusters, err := m.ListKmipServers(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	res := &lsResult{name: f.Arg(0), Providers: []providerInfo{}}
+
+	for _, info := range clusters {
+		if res.name != "" && info.ClusterId.Id != res.name {
+			continue
+		}
+		res.Providers = append(res.Providers, providerInfo{KmipClusterInfo: info})
+	}
+
+	if res.name != "" && len(res.Providers) == 0 {
+		return fmt.Errorf("key provider %q not found", res.name)
+	}
+
+	if len(res.Providers) != 0 {
+		req := make([]types.KmipClusterInfo, len(res.Providers))
+		for i := range res.Providers {
+			req[i] = res.Providers[i].KmipClusterInfo
+		}
+
+		status, err := m.GetStatus(ctx, req...)
+		if err != nil {
+			return err
+		}
+
+		for i := range status {
+			for j := range res.Providers {
+				if res.Providers[j].ClusterId.Id == status[i].ClusterId.Id {
+					res.Providers[j].Status = &status[i]
+				}
+			}
+		}
+	}
+
+	return cmd.WriteResult(res)
+}
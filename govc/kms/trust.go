@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type trust struct {
+	*flags.ClientFlag
+	*flags.OutputFlag
+
+	server string
+	client bool
+	pem    string
+}
+
+func init() {
+	cli.Register("kms.trust", &trust{})
+}
+
+func (cmd *trust) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ClientFlag, ctx = flags.NewClientFlag(ctx)
+	cmd.ClientFlag.Register(ctx, f)
+
+	cmd.OutputFlag, ctx = flags.NewOutputFlag(ctx)
+	cmd.OutputFlag.Register(ctx, f)
+
+	f.StringVar(&cmd.server, "s", "", "Trust the certificate of KMS server name")
+	f.BoolVar(&cmd.client, "c", false, "Establish KMS trust of vCenter, using a self-signed client certificate")
+	f.StringVar(&cmd.pem, "pem", "", "Client certificate PEM (with -c)")
+}
+
+func (cmd *trust) Process(ctx context.Context) error {
+	if err := cmd.ClientFlag.Process(ctx); err != nil {
+		return err
+	}
+	return cmd.OutputFlag.Process(ctx)
+}
+
+func (cmd *trust) Usage() string {
+	return "NAME"
+}
+
+func (cmd *trust) Description() string {
+	return `Establish trust between vCenter and KMS cluster NAME.
+
+With -s, vCenter trusts the certificate presented by the KMS server.
+With -c, a self-signed client certificate is generated for vCenter if one does not
+already exist, or the given -pem is used.
+The client certificate is output and must be uploaded to the KMS.
+
+Examples:
+  govc kms.trust -s my-server my-kp
+  govc kms.trust -c my-kp > vcenter.pem
+  govc kms.trust -c -pem "$(cat vcenter.pem)" my-kp
+  govc kms.trust -c -json my-kp | jq -r .certificate`
+}
+
+type trustResult struct {
+	Certificate string                                  `json:"certificate"`
+	CertInfo    *types.CryptoManagerKmipCertificateInfo `json:"certInfo,omitempty"`
+}
+
+func (r *trustResult) Write(w io.Writer) error {
+	if r.CertInfo == nil {
+		_, err := fmt.Fprint(w, r.Certificate)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Subject:\t%s\n", r.CertInfo.Subject)
+	fmt.Fprintf(tw, "Issuer:\t%s\n", r.CertInfo.Issuer)
+	fmt.Fprintf(tw, "Expires:\t%s\n", r.CertInfo.NotAfter)
+	fmt.Fprintf(tw, "Fingerprint:\t%s\n", r.CertInfo.Fingerprint)
+	return tw.Flush()
+}
+
+func (cmd *trust) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 || cmd.client == (cmd.server != "") {
+		return flag.ErrHelp
+	}
+	id := f.Arg(0)
+
+	c, err := cmd.Client()
+	if err != nil {
+		return err
+	}
+
+	m, err := crypto.GetManagerKmip(c)
+	if err != nil {
+		return err
+	}
+
+	if cmd.server != "" {
+		// vCenter connects to the server's Address and Port to retrieve its certificate
+		server, err := m.GetServer(ctx, id, cmd.server)
+		if err != nil {
+			return err
+		}
+
+		cert, err := m.RetrieveKmipServerCert(ctx, id, *server)
+		if err != nil {
+			return err
+		}
+
+		if err = m.UploadKmipServerCert(ctx, id, cert.Certificate); err != nil {
+			return err
+		}
+
+		return cmd.WriteResult(&trustResult{Certificate: cert.Certificate, CertInfo: cert.CertInfo})
+	}
+
+	cert := cmd.pem
+	if cert == "" {
+		cert, err = m.RetrieveClientCert(ctx, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cert == "" {
+		cert, err = m.GenerateSelfSignedClientCert(ctx, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = m.UpdateSelfSignedClientCert(ctx, id, cert); err != nil {
+		return err
+	}
+
+	return cmd.WriteResult(&trustResult{Certificate: cert})
+}
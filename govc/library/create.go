@@ -92,18 +92,12 @@ func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
 	if cmd.sub.SubscriptionURL != "" {
 		cmd.library.Subscription = &cmd.sub
 		cmd.library.Type = "SUBSCRIBED"
-		cmd.sub.AuthenticationMethod = "NONE"
-		if cmd.sub.Password != "" {
-			cmd.sub.AuthenticationMethod = "BASIC"
-		}
+		cmd.sub.AuthenticationMethod = authenticationMethod(cmd.sub.Password)
 	}
 
 	if cmd.pub.Published != nil && *cmd.pub.Published {
 		cmd.library.Publication = &cmd.pub
-		cmd.pub.AuthenticationMethod = "NONE"
-		if cmd.pub.Password != "" {
-			cmd.sub.AuthenticationMethod = "BASIC"
-		}
+		cmd.pub.AuthenticationMethod = authenticationMethod(cmd.pub.Password)
 	}
 
 	c, err := cmd.RestClient()
@@ -149,7 +149,7 @@ func (cmd *export) Run(ctx context.Context, f *flag.FlagSet) error {
 			return err
 		}
 
-		if cmd.OutputFlag.TTY {
+		if cmd.OutputFlag.ShowProgress() {
 			logger := cmd.ProgressLogger(fmt.Sprintf("Downloading %s... ", src.String()))
 			defer logger.Wait()
 			p.Progress = logger
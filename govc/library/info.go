@@ -208,12 +208,21 @@ func (r infoResultsWriter) writeLibrary(
 		fmt.Fprintf(w, "    AutoSync:\t%t\n", *v.Subscription.AutomaticSyncEnabled)
 		fmt.Fprintf(w, "    URL:\t%s\n", v.Subscription.SubscriptionURL)
 		fmt.Fprintf(w, "    Auth:\t%s\n", v.Subscription.AuthenticationMethod)
+		if v.Subscription.UserName != "" {
+			fmt.Fprintf(w, "    User:\t%s\n", v.Subscription.UserName)
+		}
 		fmt.Fprintf(w, "    Download:\t%s\n", dl)
+		if v.LastSyncTime != nil {
+			fmt.Fprintf(w, "    Last Sync:\t%s\n", v.LastSyncTime.Format(time.ANSIC))
+		}
 	}
 	if published {
 		fmt.Fprintf(w, "  Publication:\t\n")
 		fmt.Fprintf(w, "    URL:\t%s\n", v.Publication.PublishURL)
 		fmt.Fprintf(w, "    Auth:\t%s\n", v.Publication.AuthenticationMethod)
+		if v.Publication.UserName != "" {
+			fmt.Fprintf(w, "    User:\t%s\n", v.Publication.UserName)
+		}
 	}
 	return nil
 }
@@ -233,6 +242,9 @@ func (r infoResultsWriter) writeItem(
 	fmt.Fprintf(w, "  Created:\t%s\n", v.CreationTime.Format(time.ANSIC))
 	fmt.Fprintf(w, "  Modified:\t%s\n", v.LastModifiedTime.Format(time.ANSIC))
 	fmt.Fprintf(w, "  Version:\t%s\n", v.Version)
+	if v.LastSyncTime != nil {
+		fmt.Fprintf(w, "  Last Sync:\t%s\n", v.LastSyncTime.Format(time.ANSIC))
+	}
 	if v.SecurityCompliance != nil {
 		fmt.Fprintf(w, "  Security Compliance:\t%t\n", *v.SecurityCompliance)
 	}
@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package library
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/units"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type prune struct {
+	*flags.ClientFlag
+	*flags.OutputFlag
+
+	days   int
+	keep   flags.StringList
+	dryRun bool
+}
+
+func init() {
+	cli.Register("library.prune", &prune{})
+}
+
+func (cmd *prune) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ClientFlag, ctx = flags.NewClientFlag(ctx)
+	cmd.ClientFlag.Register(ctx, f)
+
+	cmd.OutputFlag, ctx = flags.NewOutputFlag(ctx)
+	cmd.OutputFlag.Register(ctx, f)
+
+	f.IntVar(&cmd.days, "days", 30, "Delete items not used within the given number of days")
+	f.Var(&cmd.keep, "keep", "Never delete items with the given tag name or ID")
+	f.BoolVar(&cmd.dryRun, "dry-run", false, "List the items that would be deleted, without deleting")
+}
+
+func (cmd *prune) Process(ctx context.Context) error {
+	if err := cmd.ClientFlag.Process(ctx); err != nil {
+		return err
+	}
+	return cmd.OutputFlag.Process(ctx)
+}
+
+func (cmd *prune) Usage() string {
+	return "LIBRARY"
+}
+
+func (cmd *prune) Description() string {
+	return `Delete unused items in LIBRARY.
+
+An item is unused when it was not created, modified or deployed within the given number of days.
+Item deployment is tracked via the ` + library.DeployLibraryItemEvent + ` events
+posted when a VM or template is deployed from the item.
+
+Examples:
+  govc library.prune -dry-run my-content
+  govc library.prune -days 90 -keep golden-image my-content
+  govc library.prune -dry-run -json my-content | jq .`
+}
+
+type pruneItem struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	Size     int64     `json:"size"`
+	LastUsed time.Time `json:"lastUsed"`
+}
+
+type pruneResult struct {
+	Library string      `json:"library"`
+	DryRun  bool        `json:"dryRun"`
+	Items   []pruneItem `json:"items"`
+	Size    int64       `json:"size"`
+}
+
+func (r *pruneResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	for _, item := range r.Items {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", item.Name, units.ByteSize(item.Size), item.LastUsed.Format(time.RFC3339))
+	}
+
+	verb := "Deleted"
+	if r.DryRun {
+		verb = "Reclaimable"
+	}
+	fmt.Fprintf(tw, "%s:\t%s\t(%d items)\n", verb, units.ByteSize(r.Size), len(r.Items))
+
+	return tw.Flush()
+}
+
+// deployments returns the time of the latest deployment of each library item since the given time.
+func (cmd *prune) deployments(ctx context.Context, c *vim25.Client, since time.Time) (map[string]time.Time, error) {
+	filter := types.EventFilterSpec{
+		EventTypeId: []string{library.DeployLibraryItemEvent},
+		Time:        &types.EventFilterSpecByTime{BeginTime: &since},
+	}
+
+	collector, err := event.NewManager(c).CreateCollectorForEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = collector.Destroy(ctx)
+	}()
+
+	used := make(map[string]time.Time)
+
+	for {
+		events, err := collector.ReadNextEvents(ctx, 100)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			return used, nil
+		}
+
+		for _, e := range events {
+			x, ok := e.(*types.EventEx)
+			if !ok {
+				continue
+			}
+			if x.CreatedTime.After(used[x.ObjectId]) {
+				used[x.ObjectId] = x.CreatedTime
+			}
+		}
+	}
+}
+
+// kept returns the IDs of objects attached to any of the -keep tags.
+func (cmd *prune) kept(ctx context.Context, m *tags.Manager) (map[string]bool, error) {
+	keep := make(map[string]bool)
+
+	for _, tag := range cmd.keep {
+		refs, err := m.ListAttachedObjects(ctx, tag)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range refs {
+			keep[ref.Reference().Value] = true
+		}
+	}
+
+	return keep, nil
+}
+
+func (cmd *prune) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 {
+		return flag.ErrHelp
+	}
+
+	c, err := cmd.RestClient()
+	if err != nil {
+		return err
+	}
+
+	vc, err := cmd.Client()
+	if err != nil {
+		return err
+	}
+
+	m := library.NewManager(c)
+
+	res, err := flags.ContentLibraryResult(ctx, c, "", f.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	lib, ok := res.GetResult().(library.Library)
+	if !ok {
+		return fmt.Errorf("%q is a %T", f.Arg(0), res.GetResult())
+	}
+
+	now, err := methods.GetCurrentTime(ctx, vc)
+	if err != nil {
+		return err
+	}
+	cutoff := now.AddDate(0, 0, -cmd.days)
+
+	used, err := cmd.deployments(ctx, vc, cutoff)
+	if err != nil {
+		return err
+	}
+
+	keep, err := cmd.kept(ctx, tags.NewManager(c))
+	if err != nil {
+		return err
+	}
+
+	items, err := m.GetLibraryItems(ctx, lib.ID)
+	if err != nil {
+		return err
+	}
+
+	r := &pruneResult{
+		Library: lib.Name,
+		DryRun:  cmd.dryRun,
+		Items:   []pruneItem{},
+	}
+
+	for _, item := range items {
+		if keep[item.ID] {
+			continue
+		}
+
+		last := used[item.ID]
+		for _, t := range []*time.Time{item.CreationTime, item.LastModifiedTime} {
+			if t != nil && t.After(last) {
+				last = *t
+			}
+		}
+		if last.After(cutoff) {
+			continue
+		}
+
+		usage, err := m.GetLibraryItemStorageUsage(ctx, item.ID)
+		if err != nil {
+			return err
+		}
+
+		if !cmd.dryRun {
+			if err = m.DeleteLibraryItem(ctx, &item); err != nil {
+				return err
+			}
+		}
+
+		r.Items = append(r.Items, pruneItem{
+			ID:       item.ID,
+			Name:     item.Name,
+			Size:     usage.Size,
+			LastUsed: last,
+		})
+		r.Size += usage.Size
+	}
+
+	return cmd.WriteResult(r)
+}
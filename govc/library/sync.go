@@ -20,6 +20,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"time"
 
 	"github.com/vmware/govmomi/govc/cli"
 	"github.com/vmware/govmomi/govc/flags"
@@ -33,8 +34,12 @@ type sync struct {
 
 	force bool
 	vmtx  string
+	wait  bool
 }
 
+// syncPollInterval is the interval at which library.sync -wait polls for progress.
+var syncPollInterval = time.Second
+
 func init() {
 	cli.Register("library.sync", &sync{})
 }
@@ -48,6 +53,7 @@ func (cmd *sync) Register(ctx context.Context, f *flag.FlagSet) {
 
 	f.BoolVar(&cmd.force, "f", false, "Forcefully synchronize file content")
 	f.StringVar(&cmd.vmtx, "vmtx", "", "Sync subscribed library to local library as VM Templates")
+	f.BoolVar(&cmd.wait, "wait", false, "Wait for sync to complete, reporting progress")
 }
 
 func (cmd *sync) Process(ctx context.Context) error {
@@ -63,6 +69,7 @@ func (cmd *sync) Description() string {
 Examples:
   govc library.sync subscribed-library
   govc library.sync subscribed-library/item
+  govc library.sync -wait subscribed-library
   govc library.sync -vmtx local-library subscribed-library # convert subscribed OVFs to local VMTX`
 }
 
@@ -106,6 +113,72 @@ func (cmd *sync) syncVMTX(ctx context.Context, m *library.Manager, src library.L
 	return vcenter.NewManager(m.Client).SyncTemplateLibrary(ctx, l, items...)
 }
 
+// synced returns true if a sync completed after the given last sync time.
+func synced(last, current *time.Time) bool {
+	if current == nil {
+		return false
+	}
+	return last == nil || current.After(*last)
+}
+
+// waitLibrary waits for the sync of library l to complete, reporting the number of items synced.
+func (cmd *sync) waitLibrary(ctx context.Context, m *library.Manager, l library.Library) error {
+	for {
+		lib, err := m.GetLibraryByID(ctx, l.ID)
+		if err != nil {
+			return err
+		}
+
+		items, err := m.GetLibraryItems(ctx, l.ID)
+		if err != nil {
+			return err
+		}
+
+		n := 0
+		for _, item := range items {
+			if synced(l.LastSyncTime, item.LastSyncTime) {
+				n++
+			}
+		}
+
+		if synced(l.LastSyncTime, lib.LastSyncTime) {
+			_, _ = cmd.FolderFlag.Log(fmt.Sprintf("\rSynced %s (%d/%d items)\n", l.Name, n, len(items)))
+			return nil
+		}
+
+		_, _ = cmd.FolderFlag.Log(fmt.Sprintf("\rSyncing %s (%d/%d items)...", l.Name, n, len(items)))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(syncPollInterval):
+		}
+	}
+}
+
+// waitItem waits for the sync of the given library item to complete.
+func (cmd *sync) waitItem(ctx context.Context, m *library.Manager, item library.Item) error {
+	for {
+		current, err := m.GetLibraryItem(ctx, item.ID)
+		if err != nil {
+			return err
+		}
+
+		if synced(item.LastSyncTime, current.LastSyncTime) {
+			_, _ = cmd.FolderFlag.Log(fmt.Sprintf("\rSynced %s\n", item.Name))
+			return nil
+		}
+
+		_, _ = cmd.FolderFlag.Log(fmt.Sprintf("\rSyncing %s...", item.Name))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(syncPollInterval):
+		}
+	}
+}
+
 func (cmd *sync) shouldSync(l library.Library) bool {
 	if cmd.vmtx == "" {
 		return true
@@ -150,6 +223,11 @@ func (cmd *sync) Run(ctx context.Context, f *flag.FlagSet) error {
 			if err = m.SyncLibrary(ctx, &t); err != nil {
 				return err
 			}
+			if cmd.wait {
+				if err = cmd.waitLibrary(ctx, m, t); err != nil {
+					return err
+				}
+			}
 		}
 		return cmd.syncVMTX(ctx, m, t, local)
 	case library.Item:
@@ -158,6 +236,11 @@ func (cmd *sync) Run(ctx context.Context, f *flag.FlagSet) error {
 			if err = m.SyncLibraryItem(ctx, &t, cmd.force); err != nil {
 				return err
 			}
+			if cmd.wait {
+				if err = cmd.waitItem(ctx, m, t); err != nil {
+					return err
+				}
+			}
 		}
 		return cmd.syncVMTX(ctx, m, lib, local, t)
 	default:
@@ -31,6 +31,11 @@ type update struct {
 
 	name string
 	desc *string
+
+	pub         library.Publication
+	pubPassword *string
+	sub         library.Subscription
+	subPassword *string
 }
 
 func init() {
@@ -43,6 +48,16 @@ func (cmd *update) Register(ctx context.Context, f *flag.FlagSet) {
 
 	f.StringVar(&cmd.name, "n", "", "Library or item name")
 	f.Var(flags.NewOptionalString(&cmd.desc), "d", "Library or item description")
+	f.Var(flags.NewOptionalBool(&cmd.pub.Published), "pub", "Publish library")
+	f.StringVar(&cmd.pub.UserName, "pub-username", "", "Publication username")
+	f.Var(flags.NewOptionalString(&cmd.pubPassword), "pub-password", "Publication password (empty string disables authentication)")
+	f.StringVar(&cmd.pub.CurrentPassword, "pub-current-password", "", "Current publication password, required to change the password")
+	f.StringVar(&cmd.sub.SubscriptionURL, "sub", "", "Subscribe to library URL")
+	f.StringVar(&cmd.sub.UserName, "sub-username", "", "Subscription username")
+	f.Var(flags.NewOptionalString(&cmd.subPassword), "sub-password", "Subscription password (empty string disables authentication)")
+	f.StringVar(&cmd.sub.SslThumbprint, "thumbprint", "", "SHA-1 thumbprint of the host's SSL certificate")
+	f.Var(flags.NewOptionalBool(&cmd.sub.AutomaticSyncEnabled), "sub-autosync", "Automatic synchronization")
+	f.Var(flags.NewOptionalBool(&cmd.sub.OnDemand), "sub-ondemand", "Download content on demand")
 }
 
 func (cmd *update) Usage() string {
@@ -54,7 +69,18 @@ func (cmd *update) Description() string {
 
 Examples:
   govc library.update -d "new library description" -n "new-name" my-library
-  govc library.update -d "new item description" -n "new-item-name" my-library/my-item`
+  govc library.update -d "new item description" -n "new-item-name" my-library/my-item
+  govc library.update -pub -pub-password secret my-library # publish with basic auth
+  govc library.update -pub=false my-library # unpublish
+  govc library.update -sub-autosync=false -sub-ondemand=true subscribed-library`
+}
+
+// authenticationMethod returns the library authentication method for the given password.
+func authenticationMethod(password string) string {
+	if password == "" {
+		return "NONE"
+	}
+	return "BASIC"
 }
 
 func (cmd *update) Run(ctx context.Context, f *flag.FlagSet) error {
@@ -83,8 +109,33 @@ func (cmd *update) Run(ctx context.Context, f *flag.FlagSet) error {
 		if cmd.desc != nil {
 			lib.Description = cmd.desc
 		}
+		if cmd.pubPassword != nil {
+			cmd.pub.Password = *cmd.pubPassword
+			cmd.pub.AuthenticationMethod = authenticationMethod(cmd.pub.Password)
+		}
+		if cmd.pub != (library.Publication{}) && t.Type == "SUBSCRIBED" {
+			return fmt.Errorf("%q is a subscribed library", f.Arg(0))
+		}
+		if cmd.subPassword != nil {
+			cmd.sub.Password = *cmd.subPassword
+			cmd.sub.AuthenticationMethod = authenticationMethod(cmd.sub.Password)
+		}
+		if cmd.sub != (library.Subscription{}) && t.Type != "SUBSCRIBED" {
+			return fmt.Errorf("%q is not a subscribed library", f.Arg(0))
+		}
 		t.Patch(lib)
-		return m.UpdateLibrary(ctx, &t)
+		if err = m.UpdateLibrary(ctx, &t); err != nil {
+			return err
+		}
+		if cmd.pub != (library.Publication{}) {
+			if err = m.UpdateLibraryPublication(ctx, &t, &cmd.pub); err != nil {
+				return err
+			}
+		}
+		if cmd.sub != (library.Subscription{}) {
+			return m.UpdateLibrarySubscription(ctx, &t, &cmd.sub)
+		}
+		return nil
 	case library.Item:
 		item := &library.Item{
 			ID:   t.ID,
@@ -78,7 +78,7 @@ func (cmd *download) DownloadFile(c *vim25.Client, b string) error {
 
 	dst := path.Base(u.Path)
 	p := soap.DefaultDownload
-	if cmd.OutputFlag.TTY {
+	if cmd.OutputFlag.ShowProgress() {
 		logger := cmd.ProgressLogger(fmt.Sprintf("Downloading %s... ", dst))
 		defer logger.Wait()
 		p.Progress = logger
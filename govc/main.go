@@ -47,6 +47,7 @@ import (
 	_ "github.com/vmware/govmomi/govc/device/serial"
 	_ "github.com/vmware/govmomi/govc/device/usb"
 	_ "github.com/vmware/govmomi/govc/disk"
+	_ "github.com/vmware/govmomi/govc/disk/metadata"
 	_ "github.com/vmware/govmomi/govc/disk/snapshot"
 	_ "github.com/vmware/govmomi/govc/dvs"
 	_ "github.com/vmware/govmomi/govc/dvs/portgroup"
@@ -63,15 +64,19 @@ import (
 	_ "github.com/vmware/govmomi/govc/host/date"
 	_ "github.com/vmware/govmomi/govc/host/esxcli"
 	_ "github.com/vmware/govmomi/govc/host/firewall"
+	_ "github.com/vmware/govmomi/govc/host/hardening"
 	_ "github.com/vmware/govmomi/govc/host/maintenance"
 	_ "github.com/vmware/govmomi/govc/host/option"
 	_ "github.com/vmware/govmomi/govc/host/portgroup"
+	_ "github.com/vmware/govmomi/govc/host/power"
 	_ "github.com/vmware/govmomi/govc/host/service"
 	_ "github.com/vmware/govmomi/govc/host/storage"
 	_ "github.com/vmware/govmomi/govc/host/tpm"
 	_ "github.com/vmware/govmomi/govc/host/vnic"
 	_ "github.com/vmware/govmomi/govc/host/vswitch"
 	_ "github.com/vmware/govmomi/govc/importx"
+	_ "github.com/vmware/govmomi/govc/kms"
+	_ "github.com/vmware/govmomi/govc/kms/key"
 	_ "github.com/vmware/govmomi/govc/library"
 	_ "github.com/vmware/govmomi/govc/library/policy"
 	_ "github.com/vmware/govmomi/govc/library/session"
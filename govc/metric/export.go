@@ -0,0 +1,316 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/performance"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	formatPrometheus  = "prometheus"
+	formatOpenMetrics = "openmetrics"
+)
+
+var contentType = map[string]string{
+	formatPrometheus:  "text/plain; version=0.0.4; charset=utf-8",
+	formatOpenMetrics: "application/openmetrics-text; version=1.0.0; charset=utf-8",
+}
+
+type export struct {
+	*PerformanceFlag
+
+	format   string
+	listen   string
+	prefix   string
+	instance string
+}
+
+func init() {
+	cli.Register("metric.export", &export{})
+}
+
+func (cmd *export) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.PerformanceFlag, ctx = NewPerformanceFlag(ctx)
+	cmd.PerformanceFlag.Register(ctx, f)
+
+	f.StringVar(&cmd.format, "format", formatPrometheus, "Output format (prometheus|openmetrics)")
+	f.StringVar(&cmd.listen, "listen", "", "Serve metrics via HTTP at ADDR/metrics, sampled on each scrape")
+	f.StringVar(&cmd.prefix, "prefix", "vsphere", "Metric name prefix")
+	f.StringVar(&cmd.instance, "instance", "*", "Instance")
+}
+
+func (cmd *export) Usage() string {
+	return "PATH... NAME..."
+}
+
+func (cmd *export) Description() string {
+	return `Export the latest sample for object PATH of metric NAME in Prometheus or OpenMetrics text format.
+
+Each metric NAME is exported as a gauge named PREFIX_NAME, with '.' replaced by '_'.
+Samples are labeled with the entity name, type and moid, along with the instance if any.
+Percentage values are scaled from hundredths to percent.
+
+With the '-listen' flag, govc serves the metrics via HTTP until interrupted,
+querying the PerformanceManager each time the '/metrics' endpoint is scraped.
+
+INSTANCE behaves as it does with metric.sample.
+
+Examples:
+  govc metric.export host/cluster1/* cpu.usage.average mem.usage.average
+  govc metric.export -format openmetrics vm/* net.bytesTx.average
+  govc metric.export -instance - -listen :9272 host/* vm/* cpu.usage.average`
+}
+
+type exportResult struct {
+	cmd      *export
+	counters map[string]*types.PerfCounterInfo
+	names    map[types.ManagedObjectReference]string
+	Sample   []performance.EntityMetric `json:"sample"`
+}
+
+var invalidMetricChars = regexp.MustCompile("[^a-zA-Z0-9_:]")
+
+func (r *exportResult) metricName(counter string) string {
+	name := counter
+	if r.cmd.prefix != "" {
+		name = r.cmd.prefix + "_" + name
+	}
+	return invalidMetricChars.ReplaceAllString(name, "_")
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func (r *exportResult) labels(entity types.ManagedObjectReference, instance string) string {
+	labels := []string{
+		"entity", r.names[entity],
+		"type", entity.Type,
+		"moid", entity.Value,
+	}
+	if instance != "" {
+		labels = append(labels, "instance", instance)
+	}
+
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labels[i], labelValueEscaper.Replace(labels[i+1])))
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func (r *exportResult) timestamp(t time.Time) string {
+	if r.cmd.format == formatOpenMetrics {
+		return strconv.FormatFloat(float64(t.UnixMilli())/1000, 'f', -1, 64)
+	}
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+func (r *exportResult) Write(w io.Writer) error {
+	// samples of the same metric must be grouped together, following the HELP and TYPE lines
+	family := make(map[string][]string)
+
+	for _, metric := range r.Sample {
+		n := len(metric.SampleInfo)
+		if n == 0 {
+			continue
+		}
+		ts := r.timestamp(metric.SampleInfo[n-1].Timestamp)
+
+		for _, v := range metric.Value {
+			if len(v.Value) == 0 || v.Value[len(v.Value)-1] < 0 {
+				continue // -1 is reported when no data is available
+			}
+
+			sample := fmt.Sprintf("%s{%s} %s %s", r.metricName(v.Name), r.labels(metric.Entity, v.Instance), v.Format(v.Value[len(v.Value)-1]), ts)
+			family[v.Name] = append(family[v.Name], sample)
+		}
+	}
+
+	names := make([]string, 0, len(family))
+	for name := range family {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		counter := r.counters[name]
+		help := fmt.Sprintf("%s (%s)", counter.NameInfo.GetElementDescription().Summary, counter.UnitInfo.GetElementDescription().Label)
+
+		fmt.Fprintf(w, "# HELP %s %s\n", r.metricName(name), strings.ReplaceAll(help, "\n", " "))
+		fmt.Fprintf(w, "# TYPE %s gauge\n", r.metricName(name))
+
+		for _, sample := range family[name] {
+			fmt.Fprintln(w, sample)
+		}
+	}
+
+	if r.cmd.format == formatOpenMetrics {
+		fmt.Fprintln(w, "# EOF")
+	}
+
+	return nil
+}
+
+func (cmd *export) sample(ctx context.Context, objs []types.ManagedObjectReference, names []string) (*exportResult, error) {
+	m, err := cmd.Manager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := m.ProviderSummary(ctx, objs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	instance := cmd.instance
+	if instance == "-" {
+		instance = ""
+	}
+
+	spec := types.PerfQuerySpec{
+		Format:     string(types.PerfFormatNormal),
+		MaxSample:  1,
+		MetricId:   []types.PerfMetricId{{Instance: instance}},
+		IntervalId: cmd.Interval(s.RefreshRate),
+	}
+
+	sample, err := m.SampleByName(ctx, spec, names, objs)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := m.ToMetricSeries(ctx, sample)
+	if err != nil {
+		return nil, err
+	}
+
+	counters, err := m.CounterInfoByName(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []mo.ManagedEntity
+	pc := property.DefaultCollector(m.Client())
+	if err = pc.Retrieve(ctx, objs, []string{"name"}, &entities); err != nil {
+		return nil, err
+	}
+
+	labels := make(map[types.ManagedObjectReference]string, len(entities))
+	for _, e := range entities {
+		labels[e.Self] = e.Name
+	}
+
+	return &exportResult{cmd, counters, labels, result}, nil
+}
+
+func (cmd *export) serve(ctx context.Context, objs []types.ManagedObjectReference, names []string) error {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		res, err := cmd.sample(r.Context(), objs, names)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var buf bytes.Buffer
+		_ = res.Write(&buf)
+
+		w.Header().Set("Content-Type", contentType[cmd.format])
+		_, _ = buf.WriteTo(w)
+	})
+
+	srv := &http.Server{Addr: cmd.listen, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		_ = srv.Shutdown(context.Background())
+	}()
+
+	err := srv.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+func (cmd *export) Run(ctx context.Context, f *flag.FlagSet) error {
+	if _, ok := contentType[cmd.format]; !ok {
+		return flag.ErrHelp
+	}
+
+	m, err := cmd.Manager(ctx)
+	if err != nil {
+		return err
+	}
+
+	byName, err := m.CounterInfoByName(ctx)
+	if err != nil {
+		return err
+	}
+
+	var paths []string
+	var names []string
+
+	for _, arg := range f.Args() {
+		if _, ok := byName[arg]; ok {
+			names = append(names, arg)
+		} else {
+			paths = append(paths, arg)
+		}
+	}
+
+	if len(paths) == 0 || len(names) == 0 {
+		return flag.ErrHelp
+	}
+
+	objs, err := cmd.ManagedObjects(ctx, paths)
+	if err != nil {
+		return err
+	}
+
+	if cmd.listen != "" {
+		return cmd.WithCancel(ctx, func(wctx context.Context) error {
+			return cmd.serve(wctx, objs, names)
+		})
+	}
+
+	res, err := cmd.sample(ctx, objs, names)
+	if err != nil {
+		return err
+	}
+
+	return cmd.WriteResult(res)
+}
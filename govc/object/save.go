@@ -76,6 +76,8 @@ By default, the object tree and all properties are saved, starting at PATH.
 PATH defaults to ServiceContent, but can be specified to save a subset of objects.
 The primary use case for this command is to save inventory from a live vCenter and
 load it into a vcsim instance.
+When saving from the ServiceContent root of a vCenter, tags, tag associations and
+content library metadata are also saved to the "vapi" sub directory.
 
 Examples:
   govc object.save -d my-vcenter
@@ -274,6 +276,8 @@ func (cmd *save) Run(ctx context.Context, f *flag.FlagSet) error {
 		Options: types.RetrieveOptions{MaxObjects: 10},
 	}
 
+	vapi := root == vim25.ServiceInstance && c.IsVC()
+
 	if root == vim25.ServiceInstance {
 		err := pc.RetrieveOne(ctx, root, []string{"content"}, &content)
 		if err != nil {
@@ -441,6 +445,12 @@ func (cmd *save) Run(ctx context.Context, f *flag.FlagSet) error {
 		}
 	}
 
+	if vapi {
+		if err = cmd.saveVAPI(ctx); err != nil {
+			return err
+		}
+	}
+
 	var summary []string
 	for k, v := range cmd.summary {
 		if v == 1 && !cmd.verbose {
@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/persist"
+	"github.com/vmware/govmomi/vapi/tags"
+)
+
+// writeJSON encodes data to file name in the vapi directory
+func (cmd *save) writeJSON(name string, data interface{}) error {
+	dir := filepath.Join(cmd.dir, persist.Dir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, name), b, 0644)
+}
+
+// saveVAPI saves tags and content library metadata, which are not available via the PropertyCollector
+func (cmd *save) saveVAPI(ctx context.Context) error {
+	c, err := cmd.RestClient()
+	if err != nil {
+		return err
+	}
+
+	if cmd.verbose {
+		fmt.Print("Saving tags...")
+	}
+
+	var tagging persist.Tagging
+	tm := tags.NewManager(c)
+
+	if tagging.Categories, err = tm.GetCategories(ctx); err != nil {
+		return err
+	}
+
+	if tagging.Tags, err = tm.GetTags(ctx); err != nil {
+		return err
+	}
+
+	for _, tag := range tagging.Tags {
+		objs, err := tm.ListAttachedObjects(ctx, tag.ID)
+		if err != nil {
+			return err
+		}
+		if len(objs) == 0 {
+			continue
+		}
+		a := persist.TagAssociation{TagID: tag.ID}
+		for _, obj := range objs {
+			a.Objects = append(a.Objects, obj.Reference())
+		}
+		tagging.Associations = append(tagging.Associations, a)
+	}
+
+	if err = cmd.writeJSON(persist.TaggingFile, tagging); err != nil {
+		return err
+	}
+
+	cmd.summary["Tag"] += len(tagging.Tags)
+	if cmd.verbose {
+		fmt.Println("ok")
+		fmt.Print("Saving content libraries...")
+	}
+
+	var libraries []persist.Library
+	lm := library.NewManager(c)
+
+	libs, err := lm.GetLibraries(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, lib := range libs {
+		l := persist.Library{Library: lib}
+
+		items, err := lm.GetLibraryItems(ctx, lib.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			files, err := lm.ListLibraryItemFiles(ctx, item.ID)
+			if err != nil {
+				return err
+			}
+			l.Items = append(l.Items, persist.LibraryItem{Item: item, Files: files})
+		}
+
+		cmd.summary["LibraryItem"] += len(items)
+		libraries = append(libraries, l)
+	}
+
+	if err = cmd.writeJSON(persist.LibraryFile, libraries); err != nil {
+		return err
+	}
+
+	cmd.summary["Library"] += len(libraries)
+	if cmd.verbose {
+		fmt.Println("ok")
+	}
+
+	return nil
+}
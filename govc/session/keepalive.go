@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package session
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/session/cache"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25"
+)
+
+type keepalive struct {
+	*flags.ClientFlag
+
+	interval time.Duration
+	vapi     bool
+}
+
+func init() {
+	cli.Register("session.keepalive", &keepalive{})
+}
+
+func (cmd *keepalive) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ClientFlag, ctx = flags.NewClientFlag(ctx)
+	cmd.ClientFlag.Register(ctx, f)
+
+	f.DurationVar(&cmd.interval, "i", 5*time.Minute, "Session refresh interval")
+	f.BoolVar(&cmd.vapi, "r", false, "Keep REST session alive")
+}
+
+func (cmd *keepalive) Process(ctx context.Context) error {
+	if err := cmd.ClientFlag.Process(ctx); err != nil {
+		return err
+	}
+	if cmd.interval <= 0 {
+		return errors.New("invalid interval")
+	}
+	return nil
+}
+
+func (cmd *keepalive) Description() string {
+	return `Keep the cached session alive.
+
+The cached session is refreshed at the given interval, preventing it from expiring when idle.
+If the session has expired or was terminated, a new session is created and written to the
+session cache, such that other govc commands using the same URL continue with a valid session.
+A new login requires credentials, via GOVC_URL or GOVC_USERNAME and GOVC_PASSWORD.
+The command runs until interrupted.
+
+Examples:
+  govc session.keepalive -u user:pass@host & # refresh every 5 minutes
+  govc session.keepalive -u user:pass@host -i 1m -r &
+  govc vm.info -u user@host my-vm # uses the cached session
+  kill %1`
+}
+
+func (cmd *keepalive) Run(ctx context.Context, f *flag.FlagSet) error {
+	return keepAlive(ctx, cmd.ClientFlag, cmd.interval, cmd.vapi)
+}
+
+// keepAlive refreshes the cached session(s) at the given interval until interrupted,
+// creating and caching a new session when the cached session is no longer valid.
+func keepAlive(ctx context.Context, cmd *flags.ClientFlag, interval time.Duration, vapi bool) error {
+	if cmd.Session.Passthrough {
+		return errors.New("session cache is disabled (-persist-session=false)")
+	}
+
+	refresh := func(ctx context.Context) error {
+		clients := []cache.Client{new(vim25.Client)}
+		if vapi {
+			clients = append(clients, new(rest.Client))
+		}
+
+		var errs []error
+
+		for _, c := range clients {
+			// Load validates the cached session, which also resets its idle time
+			ok, err := cmd.Session.Load(ctx, c, cmd.ConfigureTLS)
+			if err == nil && !ok {
+				err = cmd.Session.Login(ctx, c, cmd.ConfigureTLS)
+				if err == nil {
+					u := cmd.Session.Endpoint()
+					u.Path = c.Path()
+					fmt.Fprintf(os.Stderr, "%s login %s\n", time.Now().Format(time.RFC3339), u)
+				}
+			}
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+
+	if err := refresh(ctx); err != nil {
+		return err
+	}
+
+	return cmd.WithCancel(ctx, func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				// Errors such as a network outage are not fatal, retry at the next interval
+				if err := refresh(ctx); err != nil && ctx.Err() == nil {
+					fmt.Fprintf(os.Stderr, "%s %s\n", time.Now().Format(time.RFC3339), err)
+				}
+			}
+		}
+	})
+}
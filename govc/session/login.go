@@ -43,18 +43,19 @@ type login struct {
 	*flags.ClientFlag
 	*flags.OutputFlag
 
-	clone  bool
-	issue  bool
-	renew  bool
-	long   bool
-	vapi   bool
-	ticket string
-	life   time.Duration
-	cookie string
-	token  string
-	ext    string
-	as     string
-	method string
+	clone   bool
+	issue   bool
+	renew   bool
+	long    bool
+	vapi    bool
+	ticket  string
+	life    time.Duration
+	cookie  string
+	token   string
+	ext     string
+	as      string
+	method  string
+	persist time.Duration
 }
 
 func init() {
@@ -79,6 +80,7 @@ func (cmd *login) Register(ctx context.Context, f *flag.FlagSet) {
 	f.StringVar(&cmd.ext, "extension", "", "Extension name")
 	f.StringVar(&cmd.as, "as", "", "Impersonate user")
 	f.StringVar(&cmd.method, "X", "", "HTTP method")
+	f.DurationVar(&cmd.persist, "persist", 0, "Keep session alive, refreshing at the given interval (see session.keepalive)")
 }
 
 func (cmd *login) Process(ctx context.Context) error {
@@ -107,6 +109,7 @@ The session.login command can be used to:
 - Impersonate a user
 - Avoid passing credentials to other govc commands
 - Send an authenticated raw HTTP request
+- Keep a session alive for use by other govc commands via the '-persist' flag
 
 The session.login command can be used for authenticated curl-style HTTP requests when a PATH arg is given.
 PATH may also contain a query string. The '-u' flag (GOVC_URL) is used for the URL scheme, host and port.
@@ -115,6 +118,7 @@ The request method (-X) defaults to GET. When set to POST, PUT or PATCH, a reque
 Examples:
   govc session.login -u root:password@host # Creates a cached session in ~/.govmomi/sessions
   govc session.ls -u root@host # Use the cached session with another command
+  govc session.login -u root:password@host -persist 5m & # Keep the cached session alive
   ticket=$(govc session.login -u root@host -clone)
   govc session.login -u root@host -ticket $ticket
   govc session.login -u Administrator@vsphere.local:password@host -as other@vsphere.local
@@ -428,5 +432,13 @@ func (cmd *login) Run(ctx context.Context, f *flag.FlagSet) error {
 		r.Cookie = cmd.cookie
 	}
 
-	return cmd.WriteResult(r)
+	if err = cmd.WriteResult(r); err != nil {
+		return err
+	}
+
+	if cmd.persist > 0 {
+		return keepAlive(ctx, cmd.ClientFlag, cmd.persist, cmd.vapi)
+	}
+
+	return nil
 }
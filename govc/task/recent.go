@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -47,7 +48,8 @@ type recent struct {
 	end   time.Duration
 	r     bool
 
-	plain bool
+	timeout time.Duration
+	plain   bool
 }
 
 func init() {
@@ -65,6 +67,7 @@ func (cmd *recent) Register(ctx context.Context, f *flag.FlagSet) {
 	f.DurationVar(&cmd.begin, "b", 0, "Begin time of task history")
 	f.DurationVar(&cmd.end, "e", 0, "End time of task history")
 	f.BoolVar(&cmd.r, "r", false, "Include child entities when PATH is specified")
+	f.DurationVar(&cmd.timeout, "timeout", 0, "Follow task updates for DURATION, exit non-zero if any task fails (implies -f)")
 }
 
 func (cmd *recent) Description() string {
@@ -76,7 +79,12 @@ the completion percentage and the task ID.  The task ID can be used as an argume
 the 'task.cancel' command.
 
 By default, all recent tasks are included (via TaskManager), but can be limited by PATH
-to a specific inventory object.
+to a specific inventory object.  Use the '-r' flag to include tasks for all entities in the
+PATH subtree (via TaskHistoryCollector).
+
+When following task updates, the result column of a running task includes a progress bar.
+The '-timeout' flag can be used to follow task updates for the given duration, exiting with
+a non-zero status if any task failed within that window.
 
 Examples:
   govc tasks        # tasks completed within the past 10 minutes
@@ -85,7 +93,9 @@ Examples:
   govc tasks -s error -s success  # completed tasks
   govc tasks -r /dc1/vm/Namespaces # tasks for VMs in this Folder only
   govc tasks -f
-  govc tasks -f /dc1/host/cluster1`
+  govc tasks -f /dc1/host/cluster1
+  govc tasks -f -r /dc1/host/cluster1 # tasks for the cluster, its hosts and VMs
+  govc tasks -timeout 10m -r /dc1/vm/ci # fail if any task for VMs in this Folder fails within 10 minutes`
 }
 
 func (cmd *recent) Usage() string {
@@ -131,6 +141,8 @@ type history struct {
 }
 
 func (h *history) Collect(ctx context.Context, f func([]types.TaskInfo)) error {
+	running := make(map[types.ManagedObjectReference]types.TaskInfo)
+
 	for {
 		tasks, err := h.ReadNextTasks(ctx, 10)
 		if err != nil {
@@ -139,19 +151,75 @@ func (h *history) Collect(ctx context.Context, f func([]types.TaskInfo)) error {
 
 		if len(tasks) == 0 {
 			if h.cmd.follow {
-				// TODO: this only follows new events.
-				// need to watch TaskHistoryCollector.LatestPage for updates to existing Tasks
-				time.Sleep(time.Second)
+				// ReadNextTasks only returns new tasks, updates to existing tasks are retrieved directly.
+				tasks, err = h.updates(ctx, running)
+				if err != nil {
+					return err
+				}
+
+				if len(tasks) != 0 {
+					f(tasks)
+				}
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(time.Second):
+				}
 				continue
 			}
 			break
 		}
 
+		for _, info := range tasks {
+			if info.CompleteTime == nil {
+				running[info.Task] = info
+			}
+		}
+
 		f(tasks)
 	}
 	return nil
 }
 
+// updates returns the info for tasks in the running map that have changed state or progress.
+// Completed tasks are removed from the map.
+func (h *history) updates(ctx context.Context, running map[types.ManagedObjectReference]types.TaskInfo) ([]types.TaskInfo, error) {
+	if len(running) == 0 {
+		return nil, nil
+	}
+
+	refs := make([]types.ManagedObjectReference, 0, len(running))
+	for ref := range running {
+		refs = append(refs, ref)
+	}
+
+	var content []mo.Task
+	err := property.DefaultCollector(h.Client()).Retrieve(ctx, refs, []string{"info"}, &content)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []types.TaskInfo
+
+	for _, task := range content {
+		info := task.Info
+		prev := running[task.Self]
+
+		if info.CompleteTime != nil {
+			delete(running, task.Self)
+		} else {
+			running[task.Self] = info
+		}
+
+		if info.State != prev.State || info.Progress != prev.Progress {
+			tasks = append(tasks, info)
+		}
+	}
+
+	return tasks, nil
+}
+
 type collector interface {
 	Collect(context.Context, func([]types.TaskInfo)) error
 	Destroy(context.Context) error
@@ -262,6 +330,10 @@ func (cmd *recent) Run(ctx context.Context, f *flag.FlagSet) error {
 		}
 	}
 
+	if cmd.timeout != 0 {
+		cmd.follow = true
+	}
+
 	var watch *types.ManagedObjectReference
 
 	if f.NArg() == 1 {
@@ -275,8 +347,8 @@ func (cmd *recent) Run(ctx context.Context, f *flag.FlagSet) error {
 		watch = &refs[0]
 	}
 
-	// writes dump/json/xml once even if follow is specified, otherwise syntax error occurs
-	cmd.plain = !(cmd.Dump || cmd.JSON || cmd.XML)
+	// writes dump/json/xml/yaml once even if follow is specified, otherwise syntax error occurs
+	cmd.plain = !cmd.All()
 
 	v, err := cmd.newCollector(ctx, c, watch)
 	if err != nil {
@@ -287,14 +359,37 @@ func (cmd *recent) Run(ctx context.Context, f *flag.FlagSet) error {
 		_ = v.Destroy(context.Background())
 	}()
 
-	res := &taskResult{name: tn}
+	res := &taskResult{name: tn, bar: cmd.follow}
 	if cmd.plain {
 		res.WriteHeader(cmd.Out)
 	}
 
+	var since time.Time
+	failed := make(map[string]bool)
+
+	if cmd.timeout != 0 {
+		now, err := methods.GetCurrentTime(ctx, c) // vCenter server time (UTC)
+		if err != nil {
+			return err
+		}
+		since = *now
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cmd.timeout)
+		defer cancel()
+	}
+
 	updated := false
 
-	return v.Collect(ctx, func(tasks []types.TaskInfo) {
+	err = v.Collect(ctx, func(tasks []types.TaskInfo) {
+		if cmd.timeout != 0 {
+			for _, info := range tasks {
+				if info.State == types.TaskInfoStateError && info.CompleteTime != nil && info.CompleteTime.After(since) {
+					failed[info.Task.Value] = true
+				}
+			}
+		}
+
 		if !updated && len(tasks) > cmd.max {
 			tasks = tasks[len(tasks)-cmd.max:]
 		}
@@ -303,12 +398,31 @@ func (cmd *recent) Run(ctx context.Context, f *flag.FlagSet) error {
 		res.Tasks = tasks
 		cmd.WriteResult(res)
 	})
+
+	if cmd.timeout != 0 && ctx.Err() == context.DeadlineExceeded {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(failed) != 0 {
+		ids := make([]string, 0, len(failed))
+		for id := range failed {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		return fmt.Errorf("%d task(s) failed: %s", len(ids), strings.Join(ids, ", "))
+	}
+
+	return nil
 }
 
 type taskResult struct {
 	Tasks []types.TaskInfo `json:"tasks"`
 	last  string
 	name  func(info *types.TaskInfo) string
+	bar   bool
 }
 
 func (t *taskResult) WriteHeader(w io.Writer) {
@@ -351,7 +465,7 @@ func (t *taskResult) Write(w io.Writer) error {
 		if info.CompleteTime != nil && info.StartTime != nil {
 			msg = info.CompleteTime.Sub(*info.StartTime).String()
 
-			if info.State == types.TaskInfoStateError {
+			if info.State == types.TaskInfoStateError && info.Error != nil {
 				msg = strings.TrimSuffix(info.Error.LocalizedMessage, ".")
 			}
 
@@ -359,6 +473,9 @@ func (t *taskResult) Write(w io.Writer) error {
 		}
 
 		result := fmt.Sprintf("%-7s [%s]", info.State, msg)
+		if t.bar && info.State == types.TaskInfoStateRunning {
+			result = fmt.Sprintf("%-7s %s %s", info.State, progressBar(info.Progress), msg)
+		}
 
 		item := t.format(chop(t.name(&info), 40), chop(info.EntityName, 30), chop(user, 30), queued, start, end, result)
 
@@ -373,6 +490,26 @@ func (t *taskResult) Write(w io.Writer) error {
 	return nil
 }
 
+// progressBar renders percent as a fixed width bar, such as "[=====>              ]"
+func progressBar(percent int32) string {
+	const width = 20
+
+	n := int(percent) * width / 100
+	if n < 0 {
+		n = 0
+	}
+	if n > width {
+		n = width
+	}
+
+	bar := strings.Repeat("=", n)
+	if n < width {
+		bar += ">" + strings.Repeat(" ", width-n-1)
+	}
+
+	return "[" + bar + "]"
+}
+
 func (t *taskResult) format(task, target, initiator, queued, started, completed, result string) string {
 	return fmt.Sprintf("%-40s %-30s %-30s %9s %9s %9s %s\n",
 		task, target, initiator, queued, started, completed, result)
@@ -64,6 +64,15 @@ load test_helper
   run env GOVC_SHOW_UNRELEASED=true govc event.post -s info -i vcsim.vm.success $vm
   assert_success
 
+  run govc events -type AlarmStatusChangedEvent $vm
+  assert_success
+  assert_matches "green to yellow"
+  assert_matches "yellow to green"
+
+  run govc events -type AlarmAcknowledgedEvent $vm
+  assert_success
+  assert_matches "Acknowledged alarm"
+
   run govc object.collect -s $vm triggeredAlarmState
   assert_success "" # empty
 
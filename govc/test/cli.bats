@@ -216,6 +216,45 @@ load test_helper
   assert_matches "requires 2 more usable fault domains"
 }
 
+@test "govc yaml" {
+  vcsim_env
+
+  run govc about -yaml
+  assert_success
+  assert_line "about:"
+  assert_line "  vendor: VMware, Inc."
+  assert_line '  build: "5973321"' # quoted to remain a string
+
+  run govc events -yaml -n 2 vm/DC0_H0_VM0
+  assert_success
+  assert_line "---" # document per event
+  refute_line "==> vm/DC0_H0_VM0 <=="
+
+  run govc vm.power -yaml -on DC0_H0_VM0
+  assert_failure
+  assert_line "    error: '*types.InvalidPowerState'"
+}
+
+@test "govc progress json" {
+  vcsim_env
+
+  run govc vm.clone -progress=xml -vm DC0_H0_VM0 "$(new_id)"
+  assert_failure # invalid format
+
+  run govc vm.clone -progress=json -on=false -vm DC0_H0_VM0 clone-json
+  assert_success
+  assert_equal "success" "$(jq -r .status <<<"$output" | tail -1)"
+  assert_equal "VirtualMachine.cloneVm" "$(jq -r .stage <<<"$output" | tail -1)"
+
+  GOVC_PROGRESS=json run govc vm.migrate -ds LocalDS_0 clone-json
+  assert_success
+  assert_equal "100" "$(jq -r .percent <<<"$output" | tail -1)"
+
+  run govc datastore.upload -json -progress=json "$BATS_TEST_FILENAME" progress.bats
+  assert_success
+  assert_equal "success" "$(jq -r .status <<<"$output" | tail -1)"
+}
+
 @test "insecure cookies" {
   vcsim_start -tls=false
 
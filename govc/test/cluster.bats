@@ -367,6 +367,12 @@ _EOF_
   run govc cluster.mv -cluster DC0_C1 DC0_C0_H*
   assert_failure
 
+  run govc host.maintenance.enter DC0_C0_H*
+  assert_failure # powered on VMs cannot be evacuated from the last host
+
+  run govc vm.power -off DC0_C0_RP0_VM*
+  assert_success
+
   run govc host.maintenance.enter DC0_C0_H*
   assert_success
 
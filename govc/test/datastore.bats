@@ -232,6 +232,33 @@ upload_file() {
   done
 }
 
+@test "datastore.tail multiple files" {
+  vcsim_env -esx
+
+  a=$(upload_file)
+  b=$(upload_file)
+
+  run govc datastore.tail -s 0 "$a"
+  assert_failure # invalid interval
+
+  run govc datastore.tail "$a"
+  assert_success "Hello world"
+
+  run govc datastore.tail "$a" "$b"
+  assert_success
+  assert_line "==> $a <=="
+  assert_line "==> $b <=="
+  [ ${#lines[@]} -eq 4 ]
+
+  run govc datastore.tail -q "$a" "$b"
+  assert_success
+  refute_line "==> $a <=="
+  [ ${#lines[@]} -eq 2 ]
+
+  run govc datastore.tail "$a" enoent.log
+  assert_failure
+}
+
 @test "datastore.disk" {
   esx_env
 
@@ -137,6 +137,21 @@ load test_helper
 
   govc disk.snapshot.ls -json "$id" | jq .
 
+  run govc disk.snapshot.create "$id" second
+  assert_success
+  sid2="${lines[1]}"
+
+  run govc disk.snapshot.revert "$id"
+  assert_failure # missing SID
+
+  run govc disk.snapshot.revert "$id" "$sid"
+  assert_success
+
+  run govc disk.snapshot.ls "$id"
+  assert_success
+  assert_equal 1 "${#lines[@]}" # $sid2 removed by revert
+  assert_matches "$sid"
+
   run govc disk.snapshot.rm "$id" "$sid"
   assert_success
 
@@ -147,6 +162,55 @@ load test_helper
   assert_success
 }
 
+@test "disk.metadata" {
+  vcsim_env
+
+  name=$(new_id)
+
+  run govc disk.create -size 10M "$name"
+  assert_success
+  id="${lines[1]}"
+
+  run govc disk.metadata.ls "$id"
+  assert_success ""
+
+  run govc disk.metadata.update "$id"
+  assert_failure # no changes
+
+  run govc disk.metadata.update "$id" invalid
+  assert_failure
+
+  run govc disk.metadata.update "$id" owner=ops k8s.pvc=pvc-0
+  assert_success
+
+  run govc disk.metadata.ls -json "$id"
+  assert_success
+  assert_equal ops "$(jq -r '.[] | select(.key == "owner") | .value' <<<"$output")"
+
+  run govc disk.snapshot.create "$id"
+  assert_success
+  sid="${lines[1]}"
+
+  run govc disk.metadata.update -d owner "$id" tier=gold
+  assert_success
+
+  run govc disk.metadata.ls -p k8s. "$id"
+  assert_success
+  assert_equal 1 "${#lines[@]}"
+
+  run govc disk.metadata.ls "$id"
+  assert_success
+  assert_equal 2 "${#lines[@]}" # owner removed
+  assert_matches gold
+
+  run govc disk.metadata.ls -s "$sid" "$id"
+  assert_success
+  assert_matches ops
+
+  run govc disk.rm "$id"
+  assert_success
+}
+
 @test "disk.tags" {
   vcsim_env
 
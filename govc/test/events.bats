@@ -24,6 +24,42 @@ load test_helper
   [ "$(govc events -l -n 1 -json | jq -r 'has("key")')" = "true" ]
 }
 
+@test "events -follow" {
+  vcsim_env
+
+  vm=DC0_H0_VM0
+  bookmark="$BATS_TMPDIR/$(new_id).json"
+
+  run govc events -follow vm/$vm vm/DC0_H0_VM1
+  assert_failure
+
+  run govc events -resume "$bookmark" vm/$vm
+  assert_failure
+
+  run timeout -s INT 2 govc events -follow -n 2 -resume "$bookmark" vm/$vm
+  assert_equal 2 ${#lines[@]}
+  assert_matches "powered on" "${lines[1]}"
+  key=$(jq -r .key "$bookmark")
+
+  run govc vm.power -off $vm
+  assert_success
+
+  # resume after the saved key
+  run timeout -s INT 2 govc events -follow -l -resume "$bookmark" vm/$vm
+  assert_equal 2 ${#lines[@]}
+  assert_matches VmStoppingEvent "${lines[0]}"
+  assert_matches VmPoweredOffEvent "${lines[1]}"
+  [ "$(jq -r .key "$bookmark")" -gt "$key" ]
+
+  (sleep 1; govc vm.power -on $vm) &
+
+  run timeout -s INT 3 govc events -follow -l -resume "$bookmark" vm/$vm
+  assert_equal 2 ${#lines[@]}
+  assert_matches VmPoweredOnEvent "${lines[1]}"
+
+  rm -f "$bookmark"
+}
+
 @test "events host" {
   vcsim_env -esx
 
@@ -157,6 +157,216 @@ vcsim_guest() {
   assert_matches PWD=/tmp
 }
 
+@test "guest file manager in-memory" {
+  vcsim_env
+
+  export GOVC_VM=DC0_H0_VM0 GOVC_GUEST_LOGIN=user:pass
+
+  run govc guest.mkdir /tmp/foo/bar
+  assert_failure # parent does not exist
+
+  run govc guest.mkdir -p /tmp/foo/bar
+  assert_success
+
+  run govc guest.rmdir /tmp/foo
+  assert_failure # not empty
+
+  run govc guest.rmdir -r /tmp/foo
+  assert_success
+
+  run govc guest.mktemp
+  assert_success
+  tmp="$output"
+
+  run govc guest.ls "$tmp"
+  assert_success
+  assert_matches "rw-------" # 0600
+
+  run govc guest.chmod 0644 "$tmp"
+  assert_success
+
+  run govc guest.ls "$tmp"
+  assert_success
+  assert_matches "rw-r--r--" # 0644
+
+  run govc guest.upload -f README.md "$tmp"
+  assert_success
+
+  run govc guest.download "$tmp" -
+  assert_success "$(cat README.md)"
+
+  run govc guest.mv "$tmp" "$tmp-new"
+  assert_success
+
+  run govc guest.ls "$tmp"
+  assert_failure
+
+  run govc guest.rm "$tmp-new"
+  assert_success
+
+  run govc guest.rm "$tmp-new"
+  assert_failure # does not exist
+
+  run govc guest.touch /tmp/new
+  assert_success
+
+  run govc guest.ls /tmp/new
+  assert_success
+
+  run govc vm.power -off $GOVC_VM
+  assert_success
+
+  run govc guest.ls /tmp
+  assert_failure # powered off
+}
+
+@test "guest.sync in-memory" {
+  vcsim_env
+
+  export GOVC_VM=DC0_H0_VM0 GOVC_GUEST_LOGIN=user:pass
+
+  src=$BATS_TMPDIR/$(new_id)
+  mkdir -p "$src/a/b" "$src/.git"
+  echo one > "$src/one.txt"
+  echo two > "$src/a/two.log"
+  echo three > "$src/a/b/three.txt"
+  echo git > "$src/.git/config"
+  chmod 0750 "$src/a/two.log"
+
+  run govc guest.sync "$src/enoent" /tmp/sync
+  assert_failure
+
+  run govc guest.sync -n "$src" /tmp/sync
+  assert_success
+  assert_line "one.txt"
+
+  run govc guest.ls /tmp/sync
+  assert_failure # dry run
+
+  run govc guest.sync -exclude .git "$src" /tmp/sync
+  assert_success
+  assert_line "one.txt"
+  assert_line "a/two.log"
+  assert_line "a/b/three.txt"
+  refute_line ".git/config"
+
+  run govc guest.download /tmp/sync/a/b/three.txt -
+  assert_success "three"
+
+  run govc guest.ls /tmp/sync/a/two.log
+  assert_success
+  assert_matches "rwxr-x---" # 0750
+
+  run govc guest.sync -exclude .git "$src" /tmp/sync
+  assert_success "" # unchanged
+
+  echo ONE > "$src/one.txt"
+  run govc guest.sync -exclude .git "$src" /tmp/sync
+  assert_success "one.txt"
+
+  dst=$BATS_TMPDIR/$(new_id)
+
+  run govc guest.sync -download -include '*.txt' /tmp/sync "$dst"
+  assert_success
+  assert_line "one.txt"
+  assert_line "a/b/three.txt"
+  refute_line "a/two.log"
+
+  assert_equal "ONE" "$(cat "$dst/one.txt")"
+  [ ! -e "$dst/a/two.log" ]
+
+  run govc guest.sync -download /tmp/sync "$dst"
+  assert_success "a/two.log"
+
+  assert_equal "750" "$(stat -c %a "$dst/a/two.log")"
+
+  rm -rf "$src" "$dst"
+}
+
+@test "guest process manager in-memory" {
+  vcsim_env
+
+  export GOVC_VM=DC0_H0_VM0 GOVC_GUEST_LOGIN=user:pass
+
+  run govc guest.kill -p 123456
+  assert_failure # process does not exist
+
+  run govc guest.start /bin/df -h
+  assert_success
+  pid="$output"
+
+  run govc guest.ps -x -p "$pid" -json
+  assert_success
+  assert_equal "true" "$(jq '.processInfo[0].endTime != null' <<<"$output")"
+  assert_equal "null" "$(jq .processInfo[0].exitCode <<<"$output")" # 0
+
+  run govc guest.start /bin/false
+  assert_success
+  pid="$output"
+
+  run govc guest.ps -x -p "$pid" -json
+  assert_success
+  assert_equal "1" "$(jq .processInfo[].exitCode <<<"$output")"
+
+  run govc guest.start /bin/sleep 3600
+  assert_success
+  pid="$output"
+
+  run govc guest.ps -p "$pid"
+  assert_success
+  assert_matches "/bin/sleep 3600"
+
+  run govc guest.kill -p "$pid"
+  assert_success
+
+  run govc guest.ps -x -p "$pid" -json
+  assert_success
+  assert_equal "137" "$(jq .processInfo[].exitCode <<<"$output")"
+}
+
+@test "guest exec in-memory" {
+  vcsim_env
+
+  export GOVC_GUEST_LOGIN=user:pass
+
+  run govc guest.exec /bin/true
+  assert_failure # no VMs selected
+
+  run govc guest.exec -vm '*' -json /bin/true
+  assert_success
+  assert_equal 4 "$(jq length <<<"$output")"
+  assert_equal 0 "$(jq '[.[] | select(.exitCode != 0)] | length' <<<"$output")"
+
+  run govc guest.exec -vm DC0_H0_VM0 -folder /DC0/vm -P 1 -json /bin/true
+  assert_success
+  assert_equal 4 "$(jq length <<<"$output")" # no duplicates
+
+  run govc guest.exec -vm 'DC0_H0_*' /bin/false
+  assert_failure
+  assert_matches "2 of 2 VMs failed"
+
+  run govc vm.power -off DC0_H0_VM1
+  assert_success
+
+  run govc guest.exec -vm 'DC0_H0_*' /bin/true
+  assert_failure
+  assert_matches "InvalidPowerState"
+  assert_matches "1 of 2 VMs failed"
+
+  run govc tags.category.create region
+  assert_success
+
+  run govc tags.create -c region us-west
+  assert_success
+
+  run govc tags.attach us-west /DC0/vm/DC0_C0_RP0_VM0
+  assert_success
+
+  run govc guest.exec -tag us-west -json /bin/true
+  assert_success
+  assert_equal DC0_C0_RP0_VM0 "$(jq -r .[].vm <<<"$output")"
+}
+
 @test "guest tools status" {
   vcsim_guest
 
@@ -90,6 +90,12 @@ load test_helper
   assert_success
   grep -q -v Maintenance <<<"$output"
 
+  run govc host.maintenance.enter "$GOVC_HOST"
+  assert_failure # powered on VMs
+
+  run govc vm.power -off DC0_H0_VM*
+  assert_success
+
   run govc host.maintenance.enter "$GOVC_HOST"
   assert_success
 
@@ -114,12 +120,36 @@ load test_helper
   govc host.vnic.info -json | jq .
 }
 
+@test "host.vnic.service" {
+  vcsim_env
+
+  run govc host.vnic.service -host DC0_C0_H0 vmotion vmk0
+  assert_success
+
+  id=$(govc object.collect -s host/DC0_C0/DC0_C0_H0 configManager.virtualNicManager)
+  run govc object.collect -json "$id" info
+  assert_success
+  selected=$(jq -r '.[].val.netConfig[] | select(.nicType == "vmotion") | .selectedVnic[]' <<<"$output")
+  assert_equal "vmotion.key-vim.host.VirtualNic-vmk0" "$selected"
+
+  run govc host.vnic.service -host DC0_C0_H0 -enable=false vmotion vmk0
+  assert_success
+
+  run govc host.vnic.service -host DC0_C0_H0 vmotion vmk9
+  assert_failure
+}
+
 @test "host.vnic.hint" {
   vcsim_env
 
   run govc host.vnic.hint -xml -host DC0_C0_H0
   assert_success
 
+  run govc host.vnic.hint -json -host DC0_C0_H1 vmnic0
+  assert_success
+  assert_equal DC0_C0-tor "$(jq -r .hint[].lldpInfo.chassisId <<<"$output")"
+  assert_equal Ethernet1/3 "$(jq -r .hint[].connectedSwitchPort.portId <<<"$output")"
+
   run govc host.disconnect DC0_C0_H0
   assert_success
 
@@ -265,6 +295,74 @@ load test_helper
   assert_success
 }
 
+@test "host.date.change" {
+  vcsim_env
+
+  run govc host.date.change -tz Mars/Olympus_Mons
+  assert_failure
+
+  run govc host.date.change -tz Asia/Tokyo -server 0.pool.ntp.org
+  assert_success
+
+  result=$(govc host.date.info -json | jq -r .timeZone.key)
+  assert_equal Asia/Tokyo "$result"
+
+  result=$(govc host.date.info -json | jq -r '.ntpConfig.server[]')
+  assert_equal 0.pool.ntp.org "$result"
+}
+
+@test "host.date.report" {
+  vcsim_env
+
+  run govc host.date.report
+  assert_success
+
+  result=$(govc host.date.report -json | jq '[.hosts[] | select(.exceeded)] | length')
+  assert_equal 0 "$result"
+
+  run govc host.date.change -date "$(date -u -d '-1 hour')"
+  assert_success
+
+  run govc host.date.report
+  assert_success
+  assert_matches DRIFT
+
+  result=$(govc host.date.report -json | jq -r '.hosts[] | select(.exceeded) | .name')
+  assert_equal "$(basename "$GOVC_HOST")" "$result"
+
+  result=$(govc host.date.report -json -threshold 2h "$GOVC_HOST" | jq '.hosts[0].exceeded')
+  assert_equal false "$result"
+}
+
+@test "host.power" {
+  vcsim_env
+
+  run govc host.power.info
+  assert_success
+  assert_matches "Current policy: *dynamic"
+
+  result=$(govc host.power.info -json | jq -r .cpu.currentPolicy)
+  assert_equal Balanced "$result"
+
+  run govc host.power.policy turbo
+  assert_failure
+
+  run govc host.power.policy high-performance
+  assert_success
+
+  result=$(govc host.power.info -json | jq -r .currentPolicy.shortName)
+  assert_equal static "$result"
+
+  result=$(govc host.power.info -json | jq -r .cpu.currentPolicy)
+  assert_equal "High Performance" "$result"
+
+  run govc host.power.policy 3
+  assert_success
+
+  result=$(govc host.power.info -json | jq -r .currentPolicy.shortName)
+  assert_equal low "$result"
+}
+
 @test "host.disconnect and host.reconnect" {
   vcsim_env
 
@@ -299,3 +397,36 @@ load test_helper
   run govc host.tpm.report
   assert_success
 }
+
+@test "host.hardening.report" {
+  vcsim_env
+
+  run govc host.hardening.report
+  assert_success
+
+  run govc host.hardening.report -json
+  assert_success
+
+  run govc host.hardening.report -csv
+  assert_success
+  assert_matches "^host,check,value,baseline,result"
+
+  result=$(govc host.hardening.report -json | jq -r '.checks[] | select(.check == "lockdown.mode") | .pass')
+  assert_equal "false" "$result"
+
+  run govc host.service start TSM-SSH
+  assert_success
+
+  result=$(govc host.hardening.report -json | jq -r '.checks[] | select(.check == "service.TSM-SSH") | .pass')
+  assert_equal "false" "$result"
+
+  run govc host.option.set UserVars.ESXiShellTimeOut 600
+  assert_success
+
+  result=$(govc host.hardening.report -json | jq -r '.checks[] | select(.check == "UserVars.ESXiShellTimeOut") | .pass')
+  assert_equal "true" "$result"
+
+  run govc host.hardening.report -days 100000
+  assert_success
+  assert_matches "certificate.expiry.*FAIL"
+}
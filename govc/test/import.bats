@@ -52,6 +52,33 @@ load test_helper
   assert_success
 }
 
+@test "import.ova report and resume" {
+  vcsim_env
+
+  resume="$BATS_TMPDIR/$(new_id).json"
+
+  run govc import.ova -name=report-vm -m -resume "$resume" -json "$GOVC_IMAGES/$TTYLINUX_NAME.ova"
+  assert_success
+
+  assert_equal false "$(jq -r .resumed <<<"$output")"
+  assert_equal true "$(jq -r .files[0].uploaded <<<"$output")"
+  assert_equal true "$(jq -r .files[0].verified <<<"$output")"
+  [ -n "$(jq -r .files[0].checksum <<<"$output")" ]
+  [ ! -e "$resume" ] # removed once complete
+
+  # progress of another import
+  echo '{"name": "other-vm", "lease": {"type": "HttpNfcLease", "value": "enoent"}}' > "$resume"
+  run govc import.ova -name=resume-vm -resume "$resume" "$GOVC_IMAGES/$TTYLINUX_NAME.ova"
+  assert_failure
+
+  # lease is no longer valid, import is restarted
+  echo '{"name": "resume-vm", "lease": {"type": "HttpNfcLease", "value": "enoent"}}' > "$resume"
+  run govc import.ova -name=resume-vm -resume "$resume" -json "$GOVC_IMAGES/$TTYLINUX_NAME.ova"
+  assert_success
+  assert_equal false "$(jq -r .resumed <<<"$output")"
+  [ ! -e "$resume" ]
+}
+
 @test "import.ova with iso" {
   vcsim_env
 
@@ -67,6 +94,23 @@ load test_helper
   assert_success
 }
 
+@test "import.ova -threads" {
+  vcsim_env
+
+  run govc import.ova -threads 0 "$GOVC_IMAGES/${TTYLINUX_NAME}-live.ova"
+  assert_failure
+
+  run govc import.ova -threads 2 -retries 1 -json "$GOVC_IMAGES/${TTYLINUX_NAME}-live.ova"
+  assert_success
+
+  assert_equal 2 "$(jq -r '[.files[] | select(.uploaded)] | length' <<<"$output")"
+
+  run govc device.ls -vm "${TTYLINUX_NAME}-live"
+  assert_success
+  assert_matches "disk-"
+  assert_matches "cdrom-"
+}
+
 @test "import.ovf" {
   vcsim_env
 
@@ -212,6 +256,29 @@ load test_helper
   grep "invalid NetworkMapping.Name" <<<"$output"
 }
 
+@test "import with ip pool" {
+  vcsim_env
+
+  ovf="$GOVC_IMAGES/$TTYLINUX_NAME.ovf"
+
+  spec=$(govc import.spec "$ovf" | jq '.NetworkMapping[].Network = "VM Network"')
+
+  options=$(jq '.IPPool = "ip-pool"' <<<"$spec")
+
+  run govc import.ovf -name ttylinux -options - "$ovf" <<<"$options"
+  assert_failure # requires transient or fixedAllocated policy
+
+  options=$(jq '.IPAllocationPolicy = "transientPolicy" | .IPPool = "enoent"' <<<"$spec")
+
+  run govc import.ovf -name ttylinux -options - "$ovf" <<<"$options"
+  assert_failure # pool not found
+
+  options=$(jq '.IPAllocationPolicy = "transientPolicy" | .IPPool = "ip-pool"' <<<"$spec")
+
+  run govc import.ovf -name ttylinux -options - "$ovf" <<<"$options"
+  assert_success
+}
+
 @test "import invalid disk provisioning" {
   vcsim_env
 
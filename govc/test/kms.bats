@@ -0,0 +1,95 @@
+#!/usr/bin/env bats
+
+load test_helper
+
+@test "kms" {
+  vcsim_env
+
+  run govc kms.ls
+  assert_success
+
+  run govc kms.add my-kp # missing -a
+  assert_failure
+
+  run govc kms.add -a kms.example.com my-kp
+  assert_success
+
+  run govc kms.add -a kms2.example.com -n kms2 my-kp
+  assert_success
+
+  run govc kms.add -N nkp
+  assert_success
+
+  run govc kms.ls -json
+  assert_success
+  assert_equal 2 "$(jq '.providers | length' <<<"$output")"
+  assert_equal nativeProvider "$(jq -r '.providers[] | select(.clusterId.id == "nkp") | .managementType' <<<"$output")"
+
+  run govc kms.ls -json my-kp
+  assert_success
+  assert_equal 2 "$(jq '.providers[0].servers | length' <<<"$output")"
+
+  run govc kms.ls invalid
+  assert_failure
+
+  run govc kms.key.generate my-kp
+  assert_success
+  key="$output"
+
+  run govc kms.key.generate # no default
+  assert_failure
+
+  run govc kms.default my-kp
+  assert_success
+
+  run govc kms.key.generate
+  assert_success
+
+  run govc kms.key.ls -json my-kp
+  assert_success
+  assert_equal 2 "$(jq length <<<"$output")"
+  assert_equal my-kp "$(jq -r ".[] | select(.keyId == \"$key\") | .providerId.id" <<<"$output")"
+
+  run govc kms.key.ls -json nkp
+  assert_success
+  assert_equal 0 "$(jq length <<<"$output")"
+
+  run govc kms.trust my-kp # missing -s or -c
+  assert_failure
+
+  run govc kms.trust -s enoent my-kp
+  assert_failure
+
+  run govc kms.trust -s kms2 my-kp
+  assert_success
+
+  run govc kms.trust -c -json my-kp
+  assert_success
+  cert=$(jq -r .certificate <<<"$output")
+
+  run govc kms.trust -c -json my-kp
+  assert_success
+  assert_equal "$cert" "$(jq -r .certificate <<<"$output")"
+
+  run govc kms.ls -json my-kp
+  assert_success
+  assert_equal true "$(jq -r '.providers[0].status.servers[] | select(.name == "kms2") | .clientTrustServer' <<<"$output")"
+  assert_equal true "$(jq -r '.providers[0].status.servers[] | select(.name == "kms2") | .serverTrustClient' <<<"$output")"
+
+  run govc kms.default -e /DC0/host/DC0_C0 nkp
+  assert_success
+
+  run govc kms.rm -s kms2 my-kp
+  assert_success
+
+  run govc kms.rm -s kms2 my-kp
+  assert_failure
+
+  run govc kms.rm nkp
+  assert_success
+
+  run govc kms.ls -json
+  assert_success
+  assert_equal 1 "$(jq '.providers | length' <<<"$output")"
+  assert_equal 1 "$(jq '.providers[0].servers | length' <<<"$output")"
+}
@@ -340,6 +340,15 @@ EOF
   run govc library.deploy my-content/$item my-vm
   assert_success
 
+  run govc object.collect -s vm/my-vm config.template
+  assert_success "false"
+
+  run govc library.deploy -ds LocalDS_0 -folder vm -pool DC0_C0/Resources my-content/$item my-vm-placed
+  assert_success
+
+  run govc object.collect -s vm/my-vm-placed resourcePool
+  assert_success "$(govc find -i -type p /DC0/host/DC0_C0 -name Resources)"
+
   run govc library.checkout my-content/enoent my-vm-checkout
   assert_failure # vmtx item does not exist
 
@@ -415,6 +424,40 @@ EOF
 
   run govc library.sync my-content
   assert_success
+
+  # subscribe using lib.json to sync over http, as with a library published by another instance
+  run govc library.create -sub "$url/lib.json" -sub-ondemand remote-content
+  assert_success
+
+  run govc library.info remote-content/ttylinux-latest
+  assert_success
+  assert_matches "Cached: *false"
+
+  run govc library.sync remote-content/ttylinux-latest
+  assert_success
+
+  run govc library.info remote-content/ttylinux-latest
+  assert_success
+  assert_matches "Cached: *true"
+
+  run govc library.rm published-content/ttylinux-latest
+  assert_success
+
+  run govc library.sync remote-content
+  assert_success
+
+  run govc library.ls remote-content/
+  assert_success ""
+
+  run govc library.create -pub -pub-password secret private-content
+  assert_success
+  url="$(govc library.info -U private-content)"
+
+  run govc library.create -sub "$url/lib.json" -sub-password invalid private-sub
+  assert_failure
+
+  run govc library.create -sub "$url/lib.json" -sub-password secret private-sub
+  assert_success
 }
 
 @test "library.subscriber example" {
@@ -636,6 +679,60 @@ EOF
   assert_matches INVALID_URL
 }
 
+@test "library.update pubsub" {
+  vcsim_env
+
+  run govc library.create -ds LocalDS_0 pub
+  assert_success
+
+  run govc library.import pub "$GOVC_IMAGES/$TTYLINUX_NAME.iso"
+  assert_success
+
+  run govc library.update -pub -pub-username admin -pub-password secret pub
+  assert_success
+
+  run govc library.info pub
+  assert_success
+  assert_matches "Publication:"
+  assert_matches "Auth: *BASIC"
+  assert_matches "User: *admin"
+
+  run govc library.create -sub "$(govc library.info -U pub)" -sub-password secret sub
+  assert_success
+
+  run govc library.update -sub-autosync=false -sub-ondemand=true sub
+  assert_success
+
+  ondemand=$(govc library.info -json sub | jq -r .[].subscription_info.on_demand)
+  assert_equal "true" "$ondemand"
+
+  autosync=$(govc library.info -json sub | jq -r .[].subscription_info.automatic_sync_enabled)
+  assert_equal "false" "$autosync"
+
+  run govc library.update -sub-autosync=false pub
+  assert_failure # not a subscribed library
+
+  run govc library.update -pub sub
+  assert_failure # cannot publish a subscribed library
+
+  run govc library.sync -wait sub
+  assert_success
+
+  run govc library.info sub
+  assert_success
+  assert_matches "Last Sync:"
+
+  run govc library.sync -wait "sub/$TTYLINUX_NAME"
+  assert_success
+
+  run govc library.update -pub=false pub
+  assert_success
+
+  run govc library.info pub
+  assert_success
+  refute_line "Publication:"
+}
+
 @test "library.evict" {
   vcsim_env
 
@@ -679,3 +776,46 @@ EOF
   cached=$(govc library.info subscribed-content/ttylinux-latest | grep Cached: | awk '{print $2}')
   assert_equal "false" "$cached"
 }
+
+@test "library.prune" {
+  vcsim_env
+
+  run govc library.create my-content
+  assert_success
+
+  for item in used unused golden ; do
+    run govc library.clone -vm DC0_H0_VM0 my-content $item
+    assert_success
+  done
+
+  run govc library.deploy my-content/used my-vm
+  assert_success
+
+  run govc events -type com.vmware.cl.DeployLibraryItemEvent vm/my-vm
+  assert_success
+  assert_matches "Deployed library item used to my-vm"
+
+  run govc library.prune -dry-run my-content
+  assert_success "Reclaimable:  0B  (0 items)" # all items are recently created
+
+  run govc tags.category.create retention
+  assert_success
+
+  run govc tags.create -c retention keep
+  assert_success
+
+  run govc tags.attach -c retention keep my-content/golden
+  assert_success
+
+  run govc library.prune -days 0 -dry-run -keep keep -json my-content
+  assert_success
+  assert_equal "2" "$(jq -r '.items | length' <<<"$output")"
+  assert_equal "true" "$(jq -r '.dryRun' <<<"$output")"
+
+  run govc library.prune -days 0 -keep keep my-content
+  assert_success
+  assert_matches "Deleted:"
+
+  run govc library.ls my-content/
+  assert_success "/my-content/golden"
+}
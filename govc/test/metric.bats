@@ -60,6 +60,33 @@ load test_helper
   assert_success
 }
 
+@test "metric.export" {
+  vcsim_env
+
+  host=$(govc ls -t HostSystem ./... | head -n 1)
+  name=$(basename "$host")
+
+  run govc metric.export "$host" enoent
+  assert_failure
+
+  run govc metric.export -format enoent "$host" cpu.usage.average
+  assert_failure
+
+  run govc metric.export -instance - "$host" cpu.usage.average mem.usage.average
+  assert_success
+  assert_matches "# TYPE vsphere_cpu_usage_average gauge"
+  assert_matches "# TYPE vsphere_mem_usage_average gauge"
+  assert_matches "vsphere_cpu_usage_average{entity=\"$name\",type=\"HostSystem\",moid=\"host-[0-9]+\"} [0-9.]+ [0-9]+$"
+
+  run govc metric.export -format openmetrics -prefix vc vm/DC0_H0_VM0 cpu.usage.average
+  assert_success
+  assert_matches "^vc_cpu_usage_average{"
+  assert_equal "# EOF" "${lines[-1]}"
+
+  run govc metric.export -json vm/DC0_H0_VM0 cpu.usage.average
+  assert_success
+}
+
 @test "metric.info" {
   vcsim_env
 
@@ -95,3 +122,25 @@ load test_helper
 
   govc object.collect -json "$moid" | jq .
 }
+
+@test "metric.sample -perf-config" {
+  vcsim_env -perf-config 'cpu.usage.average:constant:1h:4200'
+
+  run govc metric.sample -n 3 vm/DC0_H0_VM0 cpu.usage.average
+  assert_success
+  assert_matches "42.00,42.00,42.00"
+}
+
+@test "metric.interval.change" {
+  vcsim_env
+
+  run govc metric.interval.change -i 300 -enabled=false
+  assert_success
+
+  run govc metric.interval.info -i 300
+  assert_success
+  assert_matches "Enabled: *false"
+
+  run govc metric.interval.change -i 42
+  assert_failure
+}
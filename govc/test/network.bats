@@ -292,3 +292,51 @@ load test_helper
   assert_success
   [ ${#lines[@]} -eq 2 ]
 }
+
+@test "dvs.migrate" {
+  vcsim_env
+
+  run govc dvs.create DVS1
+  assert_success
+
+  run govc dvs.portgroup.add -dvs DVS1 DVPG1
+  assert_success
+
+  run govc vm.network.change -vm DC0_H0_VM0 -net "VM Network" ethernet-0
+  assert_success
+
+  run govc dvs.migrate -dvs DVS1 DC0_H0
+  assert_failure # no -portgroup or -uplink
+
+  run govc dvs.migrate -dvs DVS1 -portgroup "VM Network=DC0_DVPG0" DC0_H0
+  assert_failure # not a DVS1 portgroup
+
+  run govc dvs.migrate -dvs DVS1 -portgroup "VM Network=DVPG1" -portgroup "Management Network=DVPG1" -uplink vmnic0 -dry-run -json DC0_H0
+  assert_success
+
+  assert_equal "host vm vmknic uplink" "$(jq -r '[.steps[].type] | join(" ")' <<<"$output")"
+  assert_equal "DC0_H0_VM0/ethernet-0" "$(jq -r '.steps[] | select(.type == "vm") | .name' <<<"$output")"
+
+  # dry-run makes no changes
+  run govc object.collect -s network/DVS1 summary.hostMember
+  assert_success ""
+
+  run govc dvs.migrate -dvs DVS1 -portgroup "VM Network=DVPG1" -portgroup "Management Network=DVPG1" -uplink vmnic0 DC0_H0
+  assert_success
+
+  run govc device.info -vm DC0_H0_VM0 -json ethernet-0
+  assert_success
+  assert_equal "$(govc object.collect -s network/DVPG1 key)" "$(jq -r .devices[].backing.port.portgroupKey <<<"$output")"
+
+  pnic=$(govc object.collect -json network/DVS1 config | jq -r '.[].val.host[].config.backing.pnicSpec[].pnicDevice')
+  assert_equal vmnic0 "$pnic"
+
+  run govc host.vswitch.info -host DC0_H0 -json
+  assert_success
+  assert_equal 0 "$(jq '.vswitch[0].pnic | length' <<<"$output")"
+
+  # nothing left to migrate
+  run govc dvs.migrate -dvs DVS1 -portgroup "VM Network=DVPG1" -portgroup "Management Network=DVPG1" -uplink vmnic0 -dry-run -json DC0_H0
+  assert_success
+  assert_equal 0 "$(jq '.steps | length' <<<"$output")"
+}
@@ -77,6 +77,36 @@ load test_helper
   rm -rf "$dir"
 }
 
+@test "session.keepalive" {
+  vcsim_env -session-idle-timeout 2s
+
+  dir=$($mktemp --tmpdir -d govc-test-XXXXX 2>/dev/null || $mktemp -d -t govc-test-XXXXX)
+  export GOVMOMI_HOME="$dir"
+  export GOVC_PERSIST_SESSION=true
+
+  run govc session.keepalive -i 0
+  assert_failure
+
+  run govc session.keepalive -persist-session=false
+  assert_failure
+
+  govc session.keepalive -i 1s &
+  keepalive=$!
+
+  sleep 1
+  key=$(govc session.ls -json | jq -r .currentSession.key)
+
+  sleep 3 # exceed the session idle timeout
+
+  result=$(govc session.ls -json | jq -r .currentSession.key)
+  assert_equal "$key" "$result" # cached session is still valid
+
+  kill $keepalive
+  wait $keepalive || true
+
+  rm -rf "$dir"
+}
+
 @test "session.login" {
     vcsim_env
 
@@ -132,3 +132,58 @@ test_vm_snapshot() {
 
   test_vm_snapshot $vm
 }
+
+@test "vm.snapshot delta disks vcsim" {
+  vcsim_env
+
+  vm=DC0_H0_VM0
+
+  run govc snapshot.create -vm "$vm" root
+  assert_success
+
+  run govc datastore.ls "$vm/disk1-000001.vmdk"
+  assert_success
+
+  run govc device.info -vm "$vm" -json disk-*
+  assert_success
+  assert_matches "disk1-000001.vmdk" "$(jq -r .devices[].backing.fileName <<<"$output")"
+  assert_matches "disk1.vmdk" "$(jq -r .devices[].backing.parent.fileName <<<"$output")"
+
+  run govc snapshot.create -vm "$vm" child
+  assert_success
+
+  run govc snapshot.revert -vm "$vm" root
+  assert_success
+
+  run govc datastore.ls "$vm/disk1-000002.vmdk"
+  assert_failure # not captured by a snapshot
+
+  run govc snapshot.remove -vm "$vm" '*'
+  assert_success
+
+  run govc datastore.ls "$vm/disk1-000001.vmdk"
+  assert_failure # consolidated
+
+  run govc device.info -vm "$vm" -json disk-*
+  assert_success
+  assert_equal "[LocalDS_0] $vm/disk1.vmdk" "$(jq -r .devices[].backing.fileName <<<"$output")"
+}
+
+@test "snapshot.create -parallel" {
+  vcsim_env
+
+  run govc snapshot.create -vm 'DC0_H0_*' -parallel 2 -json root
+  assert_success
+
+  run jq -r '.results[].name' <<<"$output"
+  assert_success
+  assert_equal 2 ${#lines[@]}
+
+  for vm in DC0_H0_VM0 DC0_H0_VM1 ; do
+    run govc snapshot.tree -C -vm "$vm"
+    assert_success "root"
+  done
+
+  run govc snapshot.tree -C -vm DC0_C0_RP0_VM0
+  assert_success ""
+}
@@ -39,12 +39,33 @@ load test_helper
   run govc storage.policy.info MyStoragePolicy
   assert_success
 
+  run govc storage.policy.info -s -json MyStoragePolicy
+  assert_success
+  [ "$(jq -r '.policies[].compatibleDatastores | length' <<<"$output")" = "0" ]
+
+  run govc tags.category.create my_cat
+  assert_success
+
+  run govc tags.create -c my_cat my_tag
+  assert_success
+
+  run govc tags.attach -c my_cat my_tag /DC0/datastore/LocalDS_0
+  assert_success
+
+  run govc storage.policy.info -s -json MyStoragePolicy
+  assert_success
+  [ "$(jq -r '.policies[].compatibleDatastores[]' <<<"$output")" = "LocalDS_0" ]
+
   govc storage.policy.create -z MyZonalPolicy
   assert_success
 
   run govc storage.policy.info MyZonalPolicy
   assert_success
 
+  run govc storage.policy.info -s MyZonalPolicy
+  assert_success
+  assert_matches LocalDS_0
+
   run govc storage.policy.create -category my_cat -tag my_tag -z MyCombinedPolicy
   assert_success
 
@@ -82,3 +82,63 @@ load test_helper
   run govc task.set -s running "$task"
   assert_failure
 }
+
+# wait_for_output waits until the given pattern is written to the given file, up to 10 seconds.
+wait_for_output() {
+  for _ in $(seq 1 100) ; do
+    if grep -q "$2" "$1" ; then
+      return 0
+    fi
+    sleep 0.1
+  done
+  flunk "timeout waiting for '$2' in $1"
+}
+
+@test "tasks -timeout" {
+  vcsim_env -method-delay 'PowerOff:2000'
+
+  export GOVC_SHOW_UNRELEASED=true
+
+  run govc tasks -timeout 1s
+  assert_success # no tasks failed
+
+  out="$BATS_TMPDIR/$(new_id)"
+
+  govc tasks -timeout 10s -r /DC0/vm >"$out" &
+  follow=$!
+
+  wait_for_output "$out" Task # header is written once the collector is created
+
+  run govc vm.power -off DC0_H0_VM0
+  assert_success
+
+  status=0
+  wait $follow || status=$?
+  assert_success
+
+  run cat "$out"
+  assert_matches "running \[.*>.*\]" # progress bar
+  assert_matches "PowerOff .* success"
+
+  id=$(govc extension.info -json | jq -r '.extensions[].taskList | select(. != null) | .[].taskID' | head -1)
+  task=$(govc task.create "$id")
+  run govc task.set -s running "$task"
+  assert_success
+
+  govc tasks -timeout 5s >"$out" 2>&1 &
+  follow=$!
+
+  wait_for_output "$out" running # the running task is listed once following updates
+
+  run govc task.set -s error -e failed "$task"
+  assert_success
+
+  status=0
+  wait $follow || status=$?
+  assert_failure
+
+  run cat "$out"
+  assert_matches "failed: $task"
+
+  rm -f "$out"
+}
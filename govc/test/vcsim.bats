@@ -563,6 +563,35 @@ EOF
   assert_success # issue #2016
 }
 
+@test "vcsim model load vapi" {
+  vcsim_start
+  dir="$BATS_TMPDIR/$(new_id)"
+
+  run govc tags.category.create region
+  assert_success
+
+  run govc tags.create -c region us-west
+  assert_success
+
+  run govc tags.attach us-west /DC0/vm/DC0_H0_VM0
+  assert_success
+
+  run govc library.create my-content
+  assert_success
+
+  govc object.save -v -d "$dir"
+  vcsim_stop
+
+  vcsim_env -load "$dir"
+  rm -rf "$dir"
+
+  run govc tags.attached.ls -r /DC0/vm/DC0_H0_VM0
+  assert_success "us-west"
+
+  run govc library.ls
+  assert_success "/my-content"
+}
+
 @test "vcsim trace file" {
   file="$BATS_TMPDIR/$(new_id).trace"
 
@@ -335,6 +335,36 @@ load test_helper
   assert_failure
 }
 
+@test "vm.power -parallel" {
+  vcsim_env -autostart=false
+
+  run govc vm.power -on -parallel 0 DC0_H0_VM0
+  assert_failure
+
+  run govc vm.power -on -parallel 4 '*'
+  assert_success
+
+  on=$(govc find / -type m -runtime.powerState poweredOn | wc -l)
+  assert_equal 4 "$on"
+
+  # already powered on
+  run govc vm.power -on -parallel 4 'DC0_H0_*'
+  assert_failure
+
+  errors=$(govc vm.power -on -parallel 4 -json 'DC0_H0_*' 2>/dev/null | jq -r '.results[].error' | grep -c InvalidPowerState)
+  assert_equal 2 "$errors"
+
+  run govc vm.destroy -parallel 4 -json '*'
+  assert_success
+
+  run jq -r '.results[].name' <<<"$output"
+  assert_success
+  assert_equal 4 ${#lines[@]}
+
+  run govc find / -type m
+  assert_success "" # expect all VMs are gone
+}
+
 @test "vm.destroy" {
   vcsim_env
 
@@ -395,6 +425,9 @@ load test_helper
 
   run govc vm.create -disk 10M -datastore-cluster $pod "$id"
   assert_success
+
+  run govc vm.clone -vm "$id" -datastore-cluster $pod "$(new_id)"
+  assert_success
 }
 
 @test "vm.info" {
@@ -759,6 +792,42 @@ load test_helper
   assert_success
 }
 
+@test "vm.create multiple disks and nics" {
+  vcsim_env
+
+  vm=$(new_id)
+
+  run govc vm.create -disk 1GB,format=foo -on=false "$vm"
+  assert_failure
+
+  run govc vm.create -net "VM Network,mac=00:50:56:00:00:01" -on=false "$vm"
+  assert_failure
+
+  run govc vm.create -disk 1GB,profile=enoent -on=false "$vm"
+  assert_failure
+
+  run govc vm.create -on=false \
+      -disk 1GB -disk 2GB,format=eager,profile="vSAN Default Storage Policy" \
+      -net DC0_DVPG0 -net "VM Network,adapter=vmxnet3,address=00:50:56:00:00:01" "$vm"
+  assert_success
+
+  run govc device.ls -vm "$vm" disk-*
+  assert_success
+  assert_equal 2 "${#lines[@]}"
+
+  eager=$(govc object.collect -json "vm/$vm" config.hardware.device | jq '[.[].val._value[] | select(.backing.eagerlyScrub == true)] | length')
+  assert_equal 1 "$eager"
+
+  run govc device.info -vm "$vm" ethernet-1
+  assert_success
+  assert_matches VirtualVmxnet3
+  assert_matches 00:50:56:00:00:01
+
+  run govc device.info -vm "$vm" ethernet-0
+  assert_success
+  assert_matches DVSwitch
+}
+
 @test "vm.register" {
   vcsim_env
 
@@ -916,6 +985,46 @@ load test_helper
   assert_failure
 }
 
+@test "vm.instantclone" {
+  vcsim_env
+
+  vm="DC0_C0_RP0_VM0"
+  clone=$(new_id)
+
+  run govc vm.instantclone -vm "$vm" -e guestinfo.ipaddress=10.0.0.9 -e SET.guest.ipAddress=10.0.0.9 "$clone"
+  assert_success
+
+  run govc object.collect -s "vm/$clone" runtime.powerState guest.ipAddress
+  assert_success "poweredOn
+10.0.0.9"
+
+  run govc vm.info -e "$clone"
+  assert_success
+  assert_matches "guestinfo.ipaddress: *10.0.0.9"
+
+  run govc device.info -vm "$clone" disk-*
+  assert_success
+  assert_matches "$clone/disk1-000001.vmdk"
+
+  run govc vm.instantclone -vm "$vm" "$clone"
+  assert_failure # DuplicateName
+
+  run govc vm.change -vm "$vm" -e SET.runtime.instantCloneFrozen=true
+  assert_success
+
+  run govc vm.instantclone -vm "$vm" "$(new_id)"
+  assert_success
+
+  run govc object.collect -s "vm/$vm" runtime.instantCloneFrozen
+  assert_success "true"
+
+  run govc vm.power -off "$vm"
+  assert_success
+
+  run govc vm.instantclone -vm "$vm" "$(new_id)"
+  assert_failure # InvalidPowerState
+}
+
 @test "vm.migrate" {
   vcsim_env -cluster 2
 
@@ -977,6 +1086,31 @@ load test_helper
   assert_matches "Network: +VM Network"
 }
 
+@test "vm.migrate -ds" {
+  vcsim_env -ds 2
+
+  vm=DC0_H0_VM0
+
+  run govc vm.power -off $vm
+  assert_success
+
+  run govc vm.migrate -ds LocalDS_1 $vm
+  assert_success
+
+  run govc object.collect -s vm/$vm config.files.vmPathName
+  assert_output "[LocalDS_1] $vm/$vm.vmx"
+
+  run govc datastore.ls -ds LocalDS_1 $vm/$vm.vmx
+  assert_success
+
+  run govc datastore.ls -ds LocalDS_0 $vm
+  assert_failure
+
+  run govc events -type VmRelocatedEvent vm/$vm
+  assert_success
+  assert_matches "was relocated from"
+}
+
 @test "object name with slash" {
   vcsim_env
 
@@ -1230,10 +1364,10 @@ load test_helper
   assert_success 10.0.0.45
 
   host=$(govc ls -L "$(govc object.collect -s vm/DC0_H0_VM0 runtime.host)")
-  run govc host.maintenance.enter "$host"
+  run govc vm.power -off DC0_H0_VM0 DC0_H0_VM1
   assert_success
 
-  run govc vm.power -off DC0_H0_VM0
+  run govc host.maintenance.enter "$host"
   assert_success
 
   run govc vm.power -on DC0_H0_VM0
@@ -1331,3 +1465,74 @@ load test_helper
   run govc vm.check.relocate -vm $vm <<<"$spec"
   assert_success
 }
+
+@test "vm.encrypt" {
+  vcsim_env
+
+  export GOVC_VM=DC0_H0_VM0
+
+  run govc vm.encrypt
+  assert_failure # no default key provider
+
+  run govc kms.add -a kms.example.com my-kp
+  assert_success
+
+  run govc kms.default my-kp
+  assert_success
+
+  run govc vm.encrypt
+  assert_failure # powered on
+
+  run govc vm.power -off $GOVC_VM
+  assert_success
+
+  run govc vm.disk.create -name disk2 -size 1M
+  assert_success
+
+  run govc vm.encrypt -disk disk-202-0
+  assert_success
+
+  key=$(govc object.collect -s vm/$GOVC_VM config.keyId.keyId)
+  assert_equal "$key" "$(govc device.info -json disk-202-0 | jq -r .devices[].backing.keyId.keyId)"
+  assert_equal null "$(govc device.info -json disk-202-1 | jq -r .devices[].backing.keyId)"
+
+  run govc vm.encrypt
+  assert_success
+  assert_equal my-kp "$(govc device.info -json disk-202-1 | jq -r .devices[].backing.keyId.providerId.id)"
+
+  run govc vm.encrypt
+  assert_failure # already encrypted
+
+  run govc vm.power -on $GOVC_VM
+  assert_success
+
+  run govc vm.rekey -deep
+  assert_failure # powered on
+
+  run govc vm.rekey
+  assert_success
+  assert_equal "$(govc object.collect -s vm/$GOVC_VM config.keyId.keyId)" "$(govc device.info -json disk-202-0 | jq -r .devices[].backing.keyId.keyId)"
+
+  run govc vm.power -off $GOVC_VM
+  assert_success
+
+  key=$(govc kms.key.generate)
+  run govc vm.rekey -deep -key-id "$key" -disk disk-202-1
+  assert_success
+  assert_equal "$key" "$(govc device.info -json disk-202-1 | jq -r .devices[].backing.keyId.keyId)"
+
+  run govc vm.decrypt -disk disk-202-1
+  assert_success
+  assert_equal null "$(govc device.info -json disk-202-1 | jq -r .devices[].backing.keyId)"
+
+  run govc vm.decrypt
+  assert_success
+  assert_equal "" "$(govc object.collect -s vm/$GOVC_VM config.keyId)"
+  assert_equal null "$(govc device.info -json disk-202-0 | jq -r .devices[].backing.keyId)"
+
+  run govc vm.decrypt
+  assert_failure # not encrypted
+
+  run govc vm.rekey
+  assert_failure # not encrypted
+}
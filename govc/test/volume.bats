@@ -21,3 +21,69 @@ load test_helper
   run govc volume.snapshot.create
   assert_failure
 }
+
+@test "volume.create" {
+  vcsim_env
+
+  run govc volume.create pvc-0
+  assert_failure # -cluster-id is required
+
+  run govc volume.create -cluster-id cluster-a -size 10M pvc-0
+  assert_success
+  id="$output"
+
+  run govc volume.create -cluster-id cluster-b -size 10M pvc-1
+  assert_success
+
+  run govc volume.ls -i
+  assert_success
+  assert_equal 2 "${#lines[@]}"
+
+  run govc volume.ls -n pvc-0 -i
+  assert_success "$id"
+
+  run govc volume.ls -c cluster-b
+  assert_success
+  assert_matches pvc-1
+
+  run govc volume.ls -c enoent
+  assert_success ""
+
+  run govc volume.ls -L "$id"
+  assert_success "$id" # block volume backed by an FCD with the same ID
+
+  run govc disk.ls "$id"
+  assert_success
+
+  run govc volume.attach "$id"
+  assert_failure # -vm is required
+
+  run govc volume.attach -vm DC0_H0_VM0 "$id"
+  assert_success
+
+  run govc device.info -vm DC0_H0_VM0 -json disk-*
+  assert_success
+  assert_matches "$id"
+
+  run govc volume.attach -vm DC0_H0_VM1 "$id"
+  assert_failure # already attached
+
+  run govc volume.detach -vm DC0_H0_VM0 "$id"
+  assert_success
+
+  run govc volume.detach -vm DC0_H0_VM0 "$id"
+  assert_failure
+
+  run govc volume.rm "$id"
+  assert_success
+
+  run govc volume.ls -n pvc-0
+  assert_success ""
+
+  run govc disk.create -size 10M my-disk
+  assert_success
+  disk="${lines[1]}"
+
+  run govc volume.create -cluster-id cluster-a -disk-id "$disk" pv-static
+  assert_success "$disk"
+}
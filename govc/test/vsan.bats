@@ -36,3 +36,22 @@ load test_helper
   config=$(jq .clusters[].info.FileServiceConfig.Enabled <<<"$output")
   assert_equal true "$config"
 }
+
+@test "vsan.info -vsan" {
+  vcsim_env -cluster 2 -vsan 1
+
+  run govc vsan.info -json DC0_C0
+  assert_success
+  config=$(jq .clusters[].info.enabled <<<"$output")
+  assert_equal true "$config"
+
+  run govc vsan.info -json DC0_C1
+  assert_success
+  config=$(jq .clusters[].info.enabled <<<"$output")
+  assert_equal null "$config"
+
+  run govc datastore.info -json vsanDatastore
+  assert_success
+  type=$(jq -r .datastores[].summary.type <<<"$output")
+  assert_equal vsan "$type"
+}
@@ -13,6 +13,8 @@ common_opts=$(cat <<EOF
   -dump=false               Enable output dump
   -json=false               Enable JSON output
   -xml=false                Enable XML output
+  -yaml=false               Enable YAML output
+  -progress=                Progress output format, json for JSON lines on stderr [GOVC_PROGRESS]
   -k=false                  Skip verification of server certificate [GOVC_INSECURE]
   -key=                     Private key [GOVC_PRIVATE_KEY]
   -persist-session=true     Persist session to disk [GOVC_PERSIST_SESSION]
@@ -71,14 +71,11 @@ type create struct {
 	iso              string
 	isoDatastoreFlag *flags.DatastoreFlag
 
-	disk              string
+	disk              flags.StringList
+	disks             []*createDisk
 	diskDatastoreFlag *flags.DatastoreFlag
 	diskDatastore     *object.Datastore
 
-	// Only set if the disk argument is a byte size, which means the disk
-	// doesn't exist yet and should be created
-	diskByteSize int64
-
 	Client       *vim25.Client
 	Cluster      *object.ClusterComputeResource
 	Datacenter   *object.Datacenter
@@ -89,6 +86,19 @@ type create struct {
 	Folder       *object.Folder
 }
 
+// createDisk is a -disk flag value
+type createDisk struct {
+	path    string
+	format  string
+	profile string
+
+	// Only set if the disk argument is a byte size, which means the disk
+	// doesn't exist yet and should be created
+	size int64
+
+	device *types.VirtualDisk
+}
+
 func init() {
 	cli.Register("vm.create", &create{})
 }
@@ -151,7 +161,7 @@ func (cmd *create) Register(ctx context.Context, f *flag.FlagSet) {
 	cmd.isoDatastoreFlag, ctx = flags.NewCustomDatastoreFlag(ctx)
 	f.StringVar(&cmd.isoDatastoreFlag.Name, "iso-datastore", "", "Datastore for ISO file")
 
-	f.StringVar(&cmd.disk, "disk", "", "Disk path (to use existing) OR size (to create new, e.g. 20GB)")
+	f.Var(&cmd.disk, "disk", "Disk path (to use existing) OR size (to create new, e.g. 20GB), can be specified multiple times")
 	cmd.diskDatastoreFlag, _ = flags.NewCustomDatastoreFlag(ctx)
 	f.StringVar(&cmd.diskDatastoreFlag.Name, "disk-datastore", "", "Datastore for disk file")
 }
@@ -209,12 +219,21 @@ func (cmd *create) Description() string {
 For a list of possible '-g' IDs, use 'govc vm.option.info' or see:
 https://code.vmware.com/apis/358/vsphere/doc/vim.vm.GuestOsDescriptor.GuestOsIdentifier.html
 
+Multiple disks and NICs can be created by repeating the '-disk' and '-net' flags.
+Each '-disk' value may include comma separated options to override the defaults for that disk:
+  format=thin|thick|eager  New disk provisioning, defaults to '-disk.thick' and '-disk.eager'
+  profile=NAME             Storage profile name or ID
+Each '-net' value may include comma separated options to override the defaults for that NIC:
+  adapter=TYPE             Network adapter type, defaults to '-net.adapter'
+  address=MAC              Network hardware address, defaults to '-net.address'
+
 Examples:
   govc vm.create -on=false vm-name
   govc vm.create -iso library:/boot/linux/ubuntu.iso vm-name # Content Library ISO
   govc vm.create -cluster cluster1 vm-name # use compute cluster placement
   govc vm.create -datastore-cluster dscluster vm-name # use datastore cluster placement
-  govc vm.create -m 2048 -c 2 -g freebsd64Guest -net.adapter vmxnet3 -disk.controller pvscsi vm-name`
+  govc vm.create -m 2048 -c 2 -g freebsd64Guest -net.adapter vmxnet3 -disk.controller pvscsi vm-name
+  govc vm.create -disk 20GB -disk 100GB,format=eager,profile=gold -net "VM Network" -net DPortGroup,adapter=vmxnet3 vm-name`
 }
 
 func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
@@ -291,16 +310,15 @@ func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
 		cmd.iso = iso
 	}
 
-	// Verify disk exists
-	if cmd.disk != "" {
-		var b units.ByteSize
+	// Verify disks exist
+	for _, value := range cmd.disk {
+		disk, err := parseCreateDisk(value)
+		if err != nil {
+			return err
+		}
 
-		// If disk can be parsed as byte units, don't stat
-		err = b.Set(cmd.disk)
-		if err == nil {
-			cmd.diskByteSize = int64(b)
-		} else {
-			_, err = cmd.diskDatastoreFlag.Stat(ctx, cmd.disk)
+		if disk.size == 0 {
+			_, err = cmd.diskDatastoreFlag.Stat(ctx, disk.path)
 			if err != nil {
 				return err
 			}
@@ -310,6 +328,8 @@ func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
 				return err
 			}
 		}
+
+		cmd.disks = append(cmd.disks, disk)
 	}
 
 	task, err := cmd.createVM(ctx)
@@ -341,6 +361,38 @@ func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
 	return nil
 }
 
+func parseCreateDisk(value string) (*createDisk, error) {
+	options := strings.Split(value, ",")
+	disk := &createDisk{path: options[0]}
+
+	for _, option := range options[1:] {
+		key, val, _ := strings.Cut(option, "=")
+		switch key {
+		case "format":
+			switch val {
+			case "thin", "thick", "eager":
+				disk.format = val
+			default:
+				return nil, fmt.Errorf("invalid -disk format %q", val)
+			}
+		case "profile":
+			disk.profile = val
+		default:
+			return nil, fmt.Errorf("invalid -disk option %q", option)
+		}
+	}
+
+	// If disk can be parsed as byte units, don't stat
+	var b units.ByteSize
+	if err := b.Set(disk.path); err == nil {
+		disk.size = int64(b)
+	} else if disk.format != "" {
+		return nil, fmt.Errorf("-disk format only applies to new disks: %s", value)
+	}
+
+	return disk, nil
+}
+
 type place struct {
 	Spec            types.PlacementSpec           `json:"spec"`
 	Recommendations []types.ClusterRecommendation `json:"recommendations"`
@@ -440,6 +492,10 @@ func (cmd *create) createVM(ctx context.Context) (*object.Task, error) {
 		return nil, err
 	}
 
+	if err = cmd.diskProfiles(ctx, deviceChange); err != nil {
+		return nil, err
+	}
+
 	spec.DeviceChange = deviceChange
 
 	var datastore *object.Datastore
@@ -548,44 +604,50 @@ func (cmd *create) addStorage(devices object.VirtualDeviceList) (object.VirtualD
 		devices = append(devices, ide)
 	}
 
-	if cmd.diskByteSize != 0 {
+	for _, d := range cmd.disks {
 		controller, err := devices.FindDiskController(cmd.controller)
 		if err != nil {
 			return nil, err
 		}
 
-		backing := &types.VirtualDiskFlatVer2BackingInfo{
-			DiskMode:        string(types.VirtualDiskModePersistent),
-			ThinProvisioned: types.NewBool(!cmd.thick),
-		}
-		if cmd.thick {
-			backing.EagerlyScrub = &cmd.eager
-		}
-		disk := &types.VirtualDisk{
-			VirtualDevice: types.VirtualDevice{
-				Key:     devices.NewKey(),
-				Backing: backing,
-			},
-			CapacityInKB: cmd.diskByteSize / 1024,
-		}
+		if d.size != 0 {
+			thick, eager := cmd.thick, cmd.eager
+			switch d.format {
+			case "thin":
+				thick, eager = false, false
+			case "thick":
+				thick, eager = true, false
+			case "eager":
+				thick, eager = true, true
+			}
 
-		devices.AssignController(disk, controller)
-		devices = append(devices, disk)
-	} else if cmd.disk != "" {
-		controller, err := devices.FindDiskController(cmd.controller)
-		if err != nil {
-			return nil, err
-		}
+			backing := &types.VirtualDiskFlatVer2BackingInfo{
+				DiskMode:        string(types.VirtualDiskModePersistent),
+				ThinProvisioned: types.NewBool(!thick),
+			}
+			if thick {
+				backing.EagerlyScrub = &eager
+			}
+			d.device = &types.VirtualDisk{
+				VirtualDevice: types.VirtualDevice{
+					Key:     devices.NewKey(),
+					Backing: backing,
+				},
+				CapacityInKB: d.size / 1024,
+			}
 
-		ds := cmd.diskDatastore.Reference()
-		path := cmd.diskDatastore.Path(cmd.disk)
-		disk := devices.CreateDisk(controller, ds, path)
+			devices.AssignController(d.device, controller)
+		} else {
+			ds := cmd.diskDatastore.Reference()
+			path := cmd.diskDatastore.Path(d.path)
+			d.device = devices.CreateDisk(controller, ds, path)
 
-		if cmd.link {
-			disk = devices.ChildDisk(disk)
+			if cmd.link {
+				d.device = devices.ChildDisk(d.device)
+			}
 		}
 
-		devices = append(devices, disk)
+		devices = append(devices, d.device)
 	}
 
 	if cmd.iso != "" {
@@ -606,13 +668,42 @@ func (cmd *create) addStorage(devices object.VirtualDeviceList) (object.VirtualD
 	return devices, nil
 }
 
+// diskProfiles applies the storage profile of each -disk with a profile option to its device spec.
+func (cmd *create) diskProfiles(ctx context.Context, deviceChange []types.BaseVirtualDeviceConfigSpec) error {
+	profiles := flags.StorageProfileFlag{ClientFlag: cmd.ClientFlag}
+	var disks []*createDisk
+
+	for _, d := range cmd.disks {
+		if d.profile != "" {
+			profiles.Name = append(profiles.Name, d.profile)
+			disks = append(disks, d)
+		}
+	}
+
+	spec, err := profiles.StorageProfileSpec(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, d := range disks {
+		for _, change := range deviceChange {
+			dspec := change.GetVirtualDeviceConfigSpec()
+			if dspec.Device == d.device {
+				dspec.Profile = []types.BaseVirtualMachineProfileSpec{spec[i]}
+			}
+		}
+	}
+
+	return nil
+}
+
 func (cmd *create) addNetwork(devices object.VirtualDeviceList) (object.VirtualDeviceList, error) {
-	netdev, err := cmd.NetworkFlag.Device()
+	netdevs, err := cmd.NetworkFlag.Devices()
 	if err != nil {
 		return nil, err
 	}
 
-	devices = append(devices, netdev)
+	devices = append(devices, netdevs...)
 	return devices, nil
 }
 
@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// cryptoFlag is shared by the vm.encrypt, vm.decrypt and vm.rekey commands.
+type cryptoFlag struct {
+	*flags.VirtualMachineFlag
+
+	key      bool
+	provider string
+	keyID    string
+	disks    flags.StringList
+}
+
+func newCryptoFlag(ctx context.Context, key bool) (*cryptoFlag, context.Context) {
+	f := &cryptoFlag{key: key}
+	f.VirtualMachineFlag, ctx = flags.NewVirtualMachineFlag(ctx)
+	return f, ctx
+}
+
+func (flag *cryptoFlag) Register(ctx context.Context, f *flag.FlagSet) {
+	flag.VirtualMachineFlag.Register(ctx, f)
+
+	if flag.key {
+		f.StringVar(&flag.provider, "provider", "", "Key provider (defaults to the default key provider)")
+		f.StringVar(&flag.keyID, "key-id", "", "Key ID (defaults to a key generated by the key provider)")
+	}
+	f.Var(&flag.disks, "disk", "Disk device name (defaults to all disks)")
+}
+
+func (flag *cryptoFlag) Process(ctx context.Context) error {
+	return flag.VirtualMachineFlag.Process(ctx)
+}
+
+// cryptoKey returns the key specified by the -k and -p flags, generating a new key if -k is not specified.
+func (flag *cryptoFlag) cryptoKey(ctx context.Context) (types.CryptoKeyId, error) {
+	var key types.CryptoKeyId
+
+	c, err := flag.Client()
+	if err != nil {
+		return key, err
+	}
+
+	m, err := crypto.GetManagerKmip(c)
+	if err != nil {
+		return key, err
+	}
+
+	provider := flag.provider
+	if provider == "" {
+		provider, err = m.GetDefaultKmsClusterID(ctx, nil, true)
+		if err != nil {
+			return key, err
+		}
+		if provider == "" {
+			return key, errors.New("no default key provider, specify -provider")
+		}
+	}
+
+	key.KeyId = flag.keyID
+	key.ProviderId = &types.KeyProviderId{Id: provider}
+
+	if key.KeyId == "" {
+		key.KeyId, err = m.GenerateKey(ctx, provider)
+		if err != nil {
+			return key, err
+		}
+	}
+
+	return key, nil
+}
+
+func diskKeyID(disk *types.VirtualDisk) *types.CryptoKeyId {
+	switch backing := disk.Backing.(type) {
+	case *types.VirtualDiskFlatVer2BackingInfo:
+		return backing.KeyId
+	case *types.VirtualDiskSeSparseBackingInfo:
+		return backing.KeyId
+	case *types.VirtualDiskSparseVer2BackingInfo:
+		return backing.KeyId
+	}
+	return nil
+}
+
+// cryptoVM returns the VM and its properties relevant to crypto operations.
+func (flag *cryptoFlag) cryptoVM(ctx context.Context) (*object.VirtualMachine, *mo.VirtualMachine, error) {
+	vm, err := flag.VirtualMachine()
+	if err != nil {
+		return nil, nil, err
+	}
+	if vm == nil {
+		return nil, nil, errors.New("no vm specified")
+	}
+
+	var props mo.VirtualMachine
+	err = vm.Properties(ctx, vm.Reference(), []string{"config.keyId", "config.hardware.device"}, &props)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vm, &props, nil
+}
+
+// diskChanges returns the device changes applying spec to the disks specified by the -disk flag,
+// or to all disks for which include returns true if -disk is not specified.
+func (flag *cryptoFlag) diskChanges(
+	vm *mo.VirtualMachine,
+	include func(*types.VirtualDisk) bool,
+	spec types.BaseCryptoSpec) ([]types.BaseVirtualDeviceConfigSpec, error) {
+
+	devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
+
+	var disks []types.BaseVirtualDevice
+	if len(flag.disks) == 0 {
+		disks = devices.SelectByType((*types.VirtualDisk)(nil)).Select(func(d types.BaseVirtualDevice) bool {
+			return include(d.(*types.VirtualDisk))
+		})
+	} else {
+		for _, name := range flag.disks {
+			disk, ok := devices.Find(name).(*types.VirtualDisk)
+			if !ok {
+				return nil, fmt.Errorf("disk %q not found", name)
+			}
+			disks = append(disks, disk)
+		}
+	}
+
+	var changes []types.BaseVirtualDeviceConfigSpec
+	for _, disk := range disks {
+		changes = append(changes, &types.VirtualDeviceConfigSpec{
+			Operation: types.VirtualDeviceConfigSpecOperationEdit,
+			Device:    disk,
+			Backing: &types.VirtualDeviceConfigSpecBackingSpec{
+				Crypto: spec,
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+func (flag *cryptoFlag) reconfigure(ctx context.Context, vm *object.VirtualMachine, spec types.VirtualMachineConfigSpec) error {
+	task, err := vm.Reconfigure(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"context"
+	"errors"
+	"flag"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type decrypt struct {
+	*cryptoFlag
+}
+
+func init() {
+	cli.Register("vm.decrypt", &decrypt{})
+}
+
+func (cmd *decrypt) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.cryptoFlag, ctx = newCryptoFlag(ctx, false)
+	cmd.cryptoFlag.Register(ctx, f)
+}
+
+func (cmd *decrypt) Description() string {
+	return `Decrypt VM.
+
+If disks are specified, only those disks are decrypted.
+Otherwise, the VM home and all encrypted disks are decrypted.
+The VM must be powered off and have no snapshots.
+
+Examples:
+  govc vm.decrypt -vm $vm
+  govc vm.decrypt -vm $vm -disk disk-1000-1`
+}
+
+func (cmd *decrypt) Run(ctx context.Context, f *flag.FlagSet) error {
+	vm, props, err := cmd.cryptoVM(ctx)
+	if err != nil {
+		return err
+	}
+
+	var spec types.VirtualMachineConfigSpec
+
+	if len(cmd.disks) == 0 && props.Config.KeyId != nil {
+		spec.Crypto = &types.CryptoSpecDecrypt{}
+	}
+
+	spec.DeviceChange, err = cmd.diskChanges(props, func(disk *types.VirtualDisk) bool {
+		return diskKeyID(disk) != nil
+	}, &types.CryptoSpecDecrypt{})
+	if err != nil {
+		return err
+	}
+
+	if spec.Crypto == nil && len(spec.DeviceChange) == 0 {
+		return errors.New("vm is not encrypted")
+	}
+
+	return cmd.reconfigure(ctx, vm, spec)
+}
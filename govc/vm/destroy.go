@@ -19,6 +19,7 @@ package vm
 import (
 	"context"
 	"flag"
+	"io"
 
 	"github.com/vmware/govmomi/govc/cli"
 	"github.com/vmware/govmomi/govc/flags"
@@ -29,6 +30,7 @@ import (
 type destroy struct {
 	*flags.ClientFlag
 	*flags.SearchFlag
+	*flags.ParallelFlag
 }
 
 func init() {
@@ -41,6 +43,9 @@ func (cmd *destroy) Register(ctx context.Context, f *flag.FlagSet) {
 
 	cmd.SearchFlag, ctx = flags.NewSearchFlag(ctx, flags.SearchVirtualMachines)
 	cmd.SearchFlag.Register(ctx, f)
+
+	cmd.ParallelFlag, ctx = flags.NewParallelFlag(ctx)
+	cmd.ParallelFlag.Register(ctx, f)
 }
 
 func (cmd *destroy) Process(ctx context.Context) error {
@@ -50,6 +55,9 @@ func (cmd *destroy) Process(ctx context.Context) error {
 	if err := cmd.SearchFlag.Process(ctx); err != nil {
 		return err
 	}
+	if err := cmd.ParallelFlag.Process(ctx); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -65,7 +73,8 @@ Use the 'device.remove -vm VM -keep disk-*' command to detach and
 keep disks if needed, prior to calling vm.destroy.
 
 Examples:
-  govc vm.destroy my-vm`
+  govc vm.destroy my-vm
+  govc vm.destroy -parallel 8 'test-vm-*'`
 }
 
 func (cmd *destroy) Run(ctx context.Context, f *flag.FlagSet) error {
@@ -74,37 +83,38 @@ func (cmd *destroy) Run(ctx context.Context, f *flag.FlagSet) error {
 		return err
 	}
 
-	for _, vm := range vms {
-		var (
-			task  *object.Task
-			state types.VirtualMachinePowerState
-		)
+	res := flags.RunParallel(ctx, cmd.ParallelFlag, vms, cmd.destroy)
 
-		state, err = vm.PowerState(ctx)
-		if err != nil {
+	if cmd.All() {
+		if err = cmd.WriteResult(res); err != nil {
 			return err
 		}
+	}
 
-		if state == types.VirtualMachinePowerStatePoweredOn {
-			task, err = vm.PowerOff(ctx)
-			if err != nil {
-				return err
-			}
+	return res.Err()
+}
 
-			// Ignore error since the VM may already been in powered off state.
-			// vm.Destroy will fail if the VM is still powered on.
-			_ = task.Wait(ctx)
-		}
+func (cmd *destroy) destroy(ctx context.Context, _ io.Writer, vm *object.VirtualMachine) error {
+	state, err := vm.PowerState(ctx)
+	if err != nil {
+		return err
+	}
 
-		task, err = vm.Destroy(ctx)
+	if state == types.VirtualMachinePowerStatePoweredOn {
+		task, err := vm.PowerOff(ctx)
 		if err != nil {
 			return err
 		}
 
-		if err = task.Wait(ctx); err != nil {
-			return err
-		}
+		// Ignore error since the VM may already been in powered off state.
+		// vm.Destroy will fail if the VM is still powered on.
+		_ = task.Wait(ctx)
 	}
 
-	return nil
+	task, err := vm.Destroy(ctx)
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
 }
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"context"
+	"errors"
+	"flag"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type encrypt struct {
+	*cryptoFlag
+
+	profile string
+}
+
+func init() {
+	cli.Register("vm.encrypt", &encrypt{})
+}
+
+func (cmd *encrypt) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.cryptoFlag, ctx = newCryptoFlag(ctx, true)
+	cmd.cryptoFlag.Register(ctx, f)
+
+	f.StringVar(&cmd.profile, "profile", "", "Storage profile ID")
+}
+
+func (cmd *encrypt) Description() string {
+	return `Encrypt VM.
+
+The VM home is encrypted if not already encrypted, along with the given disks or all unencrypted disks.
+If a key ID is not specified, a new key is generated by the key provider.
+The VM must be powered off and have no snapshots.
+
+Examples:
+  govc vm.encrypt -vm $vm
+  govc vm.encrypt -vm $vm -provider my-kp -profile $(govc storage.policy.info -json "VM Encryption Policy" | jq -r .policies[].id)
+  govc vm.encrypt -vm $vm -key-id $(govc kms.key.generate my-kp) -provider my-kp
+  govc vm.encrypt -vm $vm -disk disk-1000-1`
+}
+
+func (cmd *encrypt) Run(ctx context.Context, f *flag.FlagSet) error {
+	vm, props, err := cmd.cryptoVM(ctx)
+	if err != nil {
+		return err
+	}
+
+	key, err := cmd.cryptoKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	var profile []types.BaseVirtualMachineProfileSpec
+	if cmd.profile != "" {
+		profile = []types.BaseVirtualMachineProfileSpec{
+			&types.VirtualMachineDefinedProfileSpec{ProfileId: cmd.profile},
+		}
+	}
+
+	var spec types.VirtualMachineConfigSpec
+
+	if props.Config.KeyId == nil {
+		spec.Crypto = &types.CryptoSpecEncrypt{CryptoKeyId: key}
+		spec.VmProfile = profile
+	}
+
+	spec.DeviceChange, err = cmd.diskChanges(props, func(disk *types.VirtualDisk) bool {
+		return diskKeyID(disk) == nil
+	}, &types.CryptoSpecEncrypt{CryptoKeyId: key})
+	if err != nil {
+		return err
+	}
+
+	for _, change := range spec.DeviceChange {
+		change.GetVirtualDeviceConfigSpec().Profile = profile
+	}
+
+	if spec.Crypto == nil && len(spec.DeviceChange) == 0 {
+		return errors.New("vm is already encrypted")
+	}
+
+	return cmd.reconfigure(ctx, vm, spec)
+}
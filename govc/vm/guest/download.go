@@ -96,7 +96,7 @@ func (cmd *download) Run(ctx context.Context, f *flag.FlagSet) error {
 
 	var p progress.Sinker
 
-	if cmd.OutputFlag.TTY {
+	if cmd.OutputFlag.ShowProgress() {
 		logger := cmd.ProgressLogger("Downloading... ")
 		p = logger
 		defer logger.Wait()
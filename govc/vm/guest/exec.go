@@ -0,0 +1,325 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/guest/toolbox"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type execCmd struct {
+	*flags.DatacenterFlag
+	*flags.OutputFlag
+	*AuthFlag
+
+	vms     flags.StringList
+	folders flags.StringList
+	tags    flags.StringList
+	workers int
+
+	data string
+	dir  string
+	vars env
+}
+
+func init() {
+	cli.Register("guest.exec", &execCmd{})
+}
+
+func (cmd *execCmd) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatacenterFlag, ctx = flags.NewDatacenterFlag(ctx)
+	cmd.DatacenterFlag.Register(ctx, f)
+
+	cmd.OutputFlag, ctx = flags.NewOutputFlag(ctx)
+	cmd.OutputFlag.Register(ctx, f)
+
+	cmd.AuthFlag, ctx = newAuthFlag(ctx)
+	cmd.AuthFlag.proc = true
+	cmd.AuthFlag.Register(ctx, f)
+
+	f.Var(&cmd.vms, "vm", "Run in VMs matching inventory path or glob pattern")
+	f.Var(&cmd.folders, "folder", "Run in all VMs within folder, recursively")
+	f.Var(&cmd.tags, "tag", "Run in all VMs with tag name or ID attached")
+	f.IntVar(&cmd.workers, "P", 10, "Maximum number of VMs to run in parallel")
+
+	f.StringVar(&cmd.data, "d", "", "Input data string. A value of '-' reads from OS stdin")
+	f.StringVar(&cmd.dir, "C", "", "The absolute path of the working directory for the program to start")
+	f.Var(&cmd.vars, "e", "Set environment variables")
+}
+
+func (cmd *execCmd) Process(ctx context.Context) error {
+	if err := cmd.DatacenterFlag.Process(ctx); err != nil {
+		return err
+	}
+	if err := cmd.OutputFlag.Process(ctx); err != nil {
+		return err
+	}
+	return cmd.AuthFlag.Process(ctx)
+}
+
+func (cmd *execCmd) Usage() string {
+	return "PATH [ARG]..."
+}
+
+func (cmd *execCmd) Description() string {
+	return `Run program PATH in multiple VMs and display output.
+
+The guest.exec command runs a program in each VM selected by the '-vm', '-folder' and '-tag' flags,
+in parallel, as guest.run does for a single VM.
+The output and exit code of each VM are collected and displayed once all VMs have completed.
+The govc exit code is non-zero if the program failed to run or exited non-zero in any VM.
+
+Examples:
+  govc guest.exec -vm '*web*' uptime
+  govc guest.exec -folder /dc1/vm/prod -P 20 -json systemctl is-active nginx | jq -r '.[] | select(.exitCode != 0) | .vm'
+  govc guest.exec -tag k8s-node -tag k8s-master -l root:mypassword df -h /
+  govc guest.exec -vm vm1 -vm vm2 -d "hello $USER" cat`
+}
+
+// execResult is the outcome of running the program in a single VM.
+type execResult struct {
+	VM       string                       `json:"vm"`
+	Ref      types.ManagedObjectReference `json:"ref"`
+	ExitCode int                          `json:"exitCode"`
+	Stdout   string                       `json:"stdout"`
+	Stderr   string                       `json:"stderr"`
+	Error    string                       `json:"error,omitempty"`
+}
+
+type execReport []execResult
+
+func (r execReport) Write(w io.Writer) error {
+	for _, res := range r {
+		for _, out := range []string{res.Stdout, res.Stderr} {
+			scanner := bufio.NewScanner(bytes.NewBufferString(out))
+			for scanner.Scan() {
+				fmt.Fprintf(w, "%s: %s\n", res.VM, scanner.Text())
+			}
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "VM\tExit\tError\n")
+	for _, res := range r {
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", res.VM, res.ExitCode, res.Error)
+	}
+	return tw.Flush()
+}
+
+// virtualMachines returns the VMs matching any of the selector flags, without duplicates.
+func (cmd *execCmd) virtualMachines(ctx context.Context) ([]*object.VirtualMachine, error) {
+	finder, err := cmd.Finder()
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*object.VirtualMachine
+	seen := make(map[types.ManagedObjectReference]bool)
+
+	add := func(list ...*object.VirtualMachine) {
+		for _, vm := range list {
+			if !seen[vm.Reference()] {
+				seen[vm.Reference()] = true
+				vms = append(vms, vm)
+			}
+		}
+	}
+
+	for _, pattern := range cmd.vms {
+		list, err := finder.VirtualMachineList(ctx, pattern)
+		if err != nil {
+			return nil, err
+		}
+		add(list...)
+	}
+
+	for _, name := range cmd.folders {
+		folder, err := finder.Folder(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		list, err := finder.VirtualMachineList(ctx, path.Join(folder.InventoryPath, "..."))
+		if err != nil {
+			if _, ok := err.(*find.NotFoundError); ok {
+				continue
+			}
+			return nil, err
+		}
+		add(list...)
+	}
+
+	if len(cmd.tags) != 0 {
+		rc, err := cmd.RestClient()
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := cmd.Client()
+		if err != nil {
+			return nil, err
+		}
+
+		attached, err := tags.NewManager(rc).GetAttachedObjectsOnTags(ctx, cmd.tags)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range attached {
+			for _, obj := range tag.ObjectIDs {
+				ref := obj.Reference()
+				if ref.Type != "VirtualMachine" {
+					continue
+				}
+				vm := object.NewVirtualMachine(c, ref)
+				name, err := vm.ObjectName(ctx)
+				if err != nil {
+					return nil, err
+				}
+				vm.InventoryPath = name
+				add(vm)
+			}
+		}
+	}
+
+	return vms, nil
+}
+
+func (cmd *execCmd) exec(ctx context.Context, vm *object.VirtualMachine, f *flag.FlagSet, stdin []byte) execResult {
+	res := execResult{VM: vm.InventoryPath, Ref: vm.Reference()}
+
+	var stdout, stderr bytes.Buffer
+
+	err := func() error {
+		c, err := cmd.Client()
+		if err != nil {
+			return err
+		}
+
+		tc, err := toolbox.NewClient(ctx, c, vm, cmd.Auth())
+		if err != nil {
+			return err
+		}
+
+		ecmd := &exec.Cmd{
+			Path:   f.Arg(0),
+			Args:   f.Args()[1:],
+			Env:    cmd.vars,
+			Dir:    cmd.dir,
+			Stdout: &stdout,
+			Stderr: &stderr,
+		}
+		if stdin != nil {
+			ecmd.Stdin = bytes.NewReader(stdin)
+		}
+
+		return tc.Run(ctx, ecmd)
+	}()
+
+	res.Stdout = stdout.String()
+	res.Stderr = stderr.String()
+
+	if err != nil {
+		if exit, ok := err.(interface{ ExitCode() int }); ok {
+			res.ExitCode = exit.ExitCode()
+		} else {
+			res.ExitCode = -1
+			res.Error = err.Error()
+		}
+	}
+
+	return res
+}
+
+func (cmd *execCmd) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() == 0 || cmd.workers < 1 {
+		return flag.ErrHelp
+	}
+
+	if len(cmd.vms)+len(cmd.folders)+len(cmd.tags) == 0 {
+		return fmt.Errorf("no VMs selected, specify at least one of '-vm', '-folder' or '-tag'")
+	}
+
+	vms, err := cmd.virtualMachines(ctx)
+	if err != nil {
+		return err
+	}
+
+	var stdin []byte
+	switch cmd.data {
+	case "":
+	case "-":
+		if stdin, err = io.ReadAll(os.Stdin); err != nil {
+			return err
+		}
+	default:
+		stdin = []byte(cmd.data)
+	}
+
+	report := make(execReport, len(vms))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < cmd.workers && i < len(vms); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				report[j] = cmd.exec(ctx, vms[j], f, stdin)
+			}
+		}()
+	}
+
+	for i := range vms {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err = cmd.WriteResult(report); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, res := range report {
+		if res.ExitCode != 0 {
+			failed++
+		}
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("%d of %d VMs failed", failed, len(report))
+	}
+
+	return nil
+}
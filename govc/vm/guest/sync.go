@@ -0,0 +1,564 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/guest/toolbox"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type guestSync struct {
+	*GuestFlag
+
+	download bool
+	dryRun   bool
+	include  flags.StringList
+	exclude  flags.StringList
+}
+
+func init() {
+	cli.Register("guest.sync", &guestSync{})
+}
+
+func (cmd *guestSync) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.GuestFlag, ctx = newGuestFlag(ctx)
+	cmd.GuestFlag.Register(ctx, f)
+
+	f.BoolVar(&cmd.download, "download", false, "Copy SOURCE directory in the guest VM to DEST on the local system")
+	f.BoolVar(&cmd.dryRun, "n", false, "Dry run, list files that would be copied")
+	f.Var(&cmd.include, "include", "Only copy files matching PATTERN (can be specified multiple times)")
+	f.Var(&cmd.exclude, "exclude", "Do not copy files or directories matching PATTERN (can be specified multiple times)")
+}
+
+func (cmd *guestSync) Usage() string {
+	return "SOURCE DEST"
+}
+
+func (cmd *guestSync) Description() string {
+	return `Copy the SOURCE directory tree from the local system to DEST in the guest VM.
+
+With -download, the SOURCE directory tree is copied from the guest VM to DEST on the local system.
+DEST directories are created as needed.
+Files are skipped if the destination has the same size and SHA-256 checksum,
+which requires reading the guest file when the sizes match.
+The permissions of copied files and created directories are preserved, symbolic links are not copied.
+The path of each file copied is written to stdout.
+
+Patterns are matched against both the base name and the path relative to SOURCE, using '/' as the separator.
+An excluded directory is not traversed. When -include is specified, directories are only created
+if they contain a file that is copied.
+
+Examples:
+  govc guest.sync -vm $name ./app /opt/app
+  govc guest.sync -vm $name -exclude .git -exclude '*.o' ./src /home/$USER/src
+  govc guest.sync -vm $name -download -include '*.log' /var/log ./logs
+  govc guest.sync -vm $name -n ./app /opt/app # list files that would be copied`
+}
+
+func (cmd *guestSync) Process(ctx context.Context) error {
+	if err := cmd.GuestFlag.Process(ctx); err != nil {
+		return err
+	}
+	for _, p := range append(cmd.include, cmd.exclude...) {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %s", p, err)
+		}
+	}
+	return nil
+}
+
+// syncEntry describes a file or directory in a sync tree.
+type syncEntry struct {
+	dir  bool
+	size int64
+	perm os.FileMode
+}
+
+// syncFS is implemented for the local file system and the guest file system.
+// Names are relative to the root of the tree, using '/' as the separator.
+type syncFS interface {
+	// tree returns the entries under the root directory, or nil if the root does not exist.
+	// Entries for which walk returns false are omitted, along with the contents of such directories.
+	tree(ctx context.Context, walk func(string, bool) bool) (map[string]syncEntry, error)
+	mkdir(ctx context.Context, name string, perm os.FileMode) error
+	open(ctx context.Context, name string) (io.ReadCloser, error)
+	create(ctx context.Context, name string, r io.Reader, e syncEntry) error
+}
+
+type localFS struct {
+	root string
+}
+
+func (l *localFS) path(name string) string {
+	return filepath.Join(l.root, filepath.FromSlash(name))
+}
+
+func (l *localFS) tree(_ context.Context, walk func(string, bool) bool) (map[string]syncEntry, error) {
+	root := l.root
+	info, err := os.Stat(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s: not a directory", root)
+	}
+
+	entries := make(map[string]syncEntry)
+
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == root || d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+
+		name, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+
+		if !walk(name, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		entries[name] = syncEntry{dir: d.IsDir(), size: info.Size(), perm: info.Mode().Perm()}
+		return nil
+	})
+
+	return entries, err
+}
+
+func (l *localFS) mkdir(_ context.Context, name string, perm os.FileMode) error {
+	p := l.path(name)
+	if err := os.MkdirAll(p, 0o755); err != nil {
+		return err
+	}
+	if perm == 0 {
+		return nil
+	}
+	return os.Chmod(p, perm)
+}
+
+func (l *localFS) open(_ context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(l.path(name))
+}
+
+func (l *localFS) create(_ context.Context, name string, r io.Reader, e syncEntry) error {
+	p := l.path(name)
+
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if e.perm == 0 {
+		return nil
+	}
+	return os.Chmod(p, e.perm)
+}
+
+type guestFS struct {
+	*toolbox.Client
+
+	root  string
+	posix bool
+}
+
+func (g *guestFS) path(name string) string {
+	return path.Join(g.root, name)
+}
+
+func (g *guestFS) list(ctx context.Context, dir string) ([]types.GuestFileInfo, error) {
+	var files []types.GuestFileInfo
+	var offset int32
+
+	for {
+		info, err := g.FileManager.ListFiles(ctx, g.Authentication, dir, offset, 0, "")
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, info.Files...)
+
+		if info.Remaining == 0 {
+			return files, nil
+		}
+		offset += int32(len(info.Files))
+	}
+}
+
+// detect sets g.posix if the guest file attributes are posix, by listing dir.
+func (g *guestFS) detect(ctx context.Context, dir string) {
+	files, err := g.list(ctx, dir)
+	if err != nil {
+		return
+	}
+	for _, f := range files {
+		if _, ok := f.Attributes.(*types.GuestPosixFileAttributes); ok {
+			g.posix = true
+		}
+	}
+}
+
+func (g *guestFS) tree(ctx context.Context, walk func(string, bool) bool) (map[string]syncEntry, error) {
+	root := g.root
+	entries := make(map[string]syncEntry)
+
+	var visit func(string, string) error
+
+	visit = func(dir, rel string) error {
+		files, err := g.list(ctx, dir)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range files {
+			if _, ok := f.Attributes.(*types.GuestPosixFileAttributes); ok {
+				g.posix = true
+			}
+
+			// The listing includes the directory itself, as "." or its full path
+			base := path.Base(f.Path)
+			if base == "." || base == ".." || path.Clean(f.Path) == path.Clean(dir) {
+				continue
+			}
+
+			name := path.Join(rel, base)
+			e := syncEntry{size: f.Size}
+
+			switch types.GuestFileType(f.Type) {
+			case types.GuestFileTypeDirectory:
+				e.dir = true
+			case types.GuestFileTypeSymlink:
+				continue
+			}
+
+			if attr, ok := f.Attributes.(*types.GuestPosixFileAttributes); ok {
+				e.perm = os.FileMode(attr.Permissions).Perm()
+			}
+
+			if !walk(name, e.dir) {
+				continue
+			}
+
+			entries[name] = e
+
+			if e.dir {
+				if err = visit(path.Join(dir, base), name); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	err := visit(root, "")
+	if err != nil {
+		if fault.Is(err, &types.FileNotFound{}) {
+			return nil, nil
+		}
+		if fault.Is(err, &types.NotADirectory{}) {
+			return nil, fmt.Errorf("%s: not a directory", root)
+		}
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (g *guestFS) attr(perm os.FileMode) types.BaseGuestFileAttributes {
+	if g.posix && perm != 0 {
+		return &types.GuestPosixFileAttributes{Permissions: int64(perm)}
+	}
+	return &types.GuestFileAttributes{}
+}
+
+func (g *guestFS) mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	p := g.path(name)
+
+	err := g.FileManager.MakeDirectory(ctx, g.Authentication, p, true)
+	if err != nil && !fault.Is(err, &types.FileAlreadyExists{}) {
+		return err
+	}
+
+	if g.posix && perm != 0 {
+		return g.FileManager.ChangeFileAttributes(ctx, g.Authentication, p, g.attr(perm))
+	}
+
+	return nil
+}
+
+func (g *guestFS) open(ctx context.Context, name string) (io.ReadCloser, error) {
+	r, _, err := g.Download(ctx, g.path(name))
+	return r, err
+}
+
+func (g *guestFS) create(ctx context.Context, name string, r io.Reader, e syncEntry) error {
+	p := soap.DefaultUpload
+	p.ContentLength = e.size
+
+	if e.size == 0 {
+		r = bytes.NewReader(nil) // Upload reads src to determine the size when ContentLength is 0
+	}
+
+	return g.Upload(ctx, r, g.path(name), p, g.attr(e.perm), true)
+}
+
+// match returns true if name matches any of the patterns.
+func match(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+		if ok, _ := path.Match(p, path.Base(name)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// walk returns false if name should not be copied, or in the case of a directory, not traversed.
+func (cmd *guestSync) walk(name string, dir bool) bool {
+	if match(cmd.exclude, name) {
+		return false
+	}
+	if dir || len(cmd.include) == 0 {
+		return true
+	}
+	return match(cmd.include, name)
+}
+
+func checksum(ctx context.Context, fsys syncFS, name string) ([]byte, error) {
+	f, err := fsys.open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// unchanged returns true if the file in src and dst have the same size and checksum.
+func unchanged(ctx context.Context, src, dst syncFS, name string, s, d syncEntry) (bool, error) {
+	if s.size != d.size {
+		return false, nil
+	}
+
+	a, err := checksum(ctx, src, name)
+	if err != nil {
+		return false, err
+	}
+
+	b, err := checksum(ctx, dst, name)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(a, b), nil
+}
+
+func (cmd *guestSync) copy(ctx context.Context, src, dst syncFS, name string, e syncEntry) error {
+	f, err := src.open(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return dst.create(ctx, name, f, e)
+}
+
+func (cmd *guestSync) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 2 {
+		return flag.ErrHelp
+	}
+
+	c, err := cmd.Toolbox(ctx)
+	if err != nil {
+		return err
+	}
+
+	local := &localFS{root: f.Arg(0)}
+	remote := &guestFS{Client: c, root: f.Arg(1)}
+	var src, dst syncFS = local, remote
+
+	if cmd.download {
+		local.root, remote.root = f.Arg(1), f.Arg(0)
+		src, dst = remote, local
+	}
+
+	gtree, err := remote.tree(ctx, cmd.walk)
+	if err != nil {
+		return err
+	}
+	if gtree == nil && !cmd.download {
+		// DEST does not exist yet, use its parent to determine the guest file attribute type
+		remote.detect(ctx, path.Dir(remote.root))
+	}
+
+	ltree, err := local.tree(ctx, cmd.walk)
+	if err != nil {
+		return err
+	}
+
+	a, b := ltree, gtree
+	if cmd.download {
+		a, b = gtree, ltree
+	}
+
+	if a == nil {
+		return fmt.Errorf("%s: %w", f.Arg(0), os.ErrNotExist)
+	}
+
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	created := make(map[string]bool)
+
+	makeDir := func(name string, perm os.FileMode) error {
+		if _, ok := b[name]; ok || created[name] {
+			return nil
+		}
+		created[name] = true
+		if cmd.dryRun {
+			return nil
+		}
+		return dst.mkdir(ctx, name, perm)
+	}
+
+	// Create parent directories that were not walked, such as DEST itself
+	parents := func(name string) error {
+		var dirs []string
+		for dir := path.Dir(name); ; dir = path.Dir(dir) {
+			dirs = append(dirs, dir)
+			if dir == "." {
+				break
+			}
+		}
+		for i := len(dirs) - 1; i >= 0; i-- {
+			perm := a[dirs[i]].perm
+			if dirs[i] == "." {
+				if b != nil {
+					continue
+				}
+				perm = 0
+			}
+			if err := makeDir(dirs[i], perm); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var errs []error
+
+	for _, name := range names {
+		e := a[name]
+
+		if e.dir {
+			if len(cmd.include) != 0 {
+				continue // created only if a file is copied
+			}
+			if err = parents(name); err == nil {
+				err = makeDir(name, e.perm)
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if d, ok := b[name]; ok {
+			if d.dir {
+				errs = append(errs, fmt.Errorf("%s: is a directory", name))
+				continue
+			}
+
+			same, err := unchanged(ctx, src, dst, name, e, d)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s", name, err))
+				continue
+			}
+			if same {
+				continue
+			}
+		}
+
+		if err = parents(name); err != nil {
+			return err
+		}
+
+		fmt.Println(name)
+
+		if cmd.dryRun {
+			continue
+		}
+
+		if err = cmd.copy(ctx, src, dst, name, e); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
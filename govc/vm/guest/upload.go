@@ -98,7 +98,7 @@ func (cmd *upload) Run(ctx context.Context, f *flag.FlagSet) error {
 
 		r = f
 
-		if cmd.OutputFlag.TTY {
+		if cmd.OutputFlag.ShowProgress() {
 			logger := cmd.ProgressLogger("Uploading... ")
 			p.Progress = logger
 			defer logger.Wait()
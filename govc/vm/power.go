@@ -20,6 +20,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 
 	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/govc/cli"
@@ -31,6 +32,7 @@ import (
 type power struct {
 	*flags.ClientFlag
 	*flags.SearchFlag
+	*flags.ParallelFlag
 
 	On       bool
 	Off      bool
@@ -55,6 +57,9 @@ func (cmd *power) Register(ctx context.Context, f *flag.FlagSet) {
 	cmd.SearchFlag, ctx = flags.NewSearchFlag(ctx, flags.SearchVirtualMachines)
 	cmd.SearchFlag.Register(ctx, f)
 
+	cmd.ParallelFlag, ctx = flags.NewParallelFlag(ctx)
+	cmd.ParallelFlag.Register(ctx, f)
+
 	f.BoolVar(&cmd.On, "on", false, "Power on")
 	f.BoolVar(&cmd.Off, "off", false, "Power off")
 	f.BoolVar(&cmd.Reset, "reset", false, "Power reset")
@@ -77,7 +82,8 @@ func (cmd *power) Description() string {
 Examples:
   govc vm.power -on VM1 VM2 VM3
   govc vm.power -on -M VM1 VM2 VM3
-  govc vm.power -off -force VM1`
+  govc vm.power -off -force VM1
+  govc vm.power -on -parallel 4 -json 'web-*'`
 }
 
 func (cmd *power) Process(ctx context.Context) error {
@@ -87,6 +93,9 @@ func (cmd *power) Process(ctx context.Context) error {
 	if err := cmd.SearchFlag.Process(ctx); err != nil {
 		return err
 	}
+	if err := cmd.ParallelFlag.Process(ctx); err != nil {
+		return err
+	}
 	opts := []bool{cmd.On, cmd.Off, cmd.Reset, cmd.Suspend, cmd.Reboot, cmd.Shutdown, cmd.Standby}
 	selected := false
 
@@ -153,64 +162,70 @@ func (cmd *power) Run(ctx context.Context, f *flag.FlagSet) error {
 		}
 	}
 
-	for _, vm := range vms {
-		var task *object.Task
-
-		switch {
-		case cmd.On:
-			fmt.Fprintf(cmd, "Powering on %s... ", vm.Reference())
-			task, err = vm.PowerOn(ctx)
-		case cmd.Off:
-			fmt.Fprintf(cmd, "Powering off %s... ", vm.Reference())
-			task, err = vm.PowerOff(ctx)
-		case cmd.Reset:
-			fmt.Fprintf(cmd, "Reset %s... ", vm.Reference())
-			task, err = vm.Reset(ctx)
-		case cmd.Suspend:
-			fmt.Fprintf(cmd, "Suspend %s... ", vm.Reference())
-			task, err = vm.Suspend(ctx)
-		case cmd.Reboot:
-			fmt.Fprintf(cmd, "Reboot guest %s... ", vm.Reference())
-			err = vm.RebootGuest(ctx)
+	res := flags.RunParallel(ctx, cmd.ParallelFlag, vms, cmd.power)
 
-			if err != nil && cmd.Force && isToolsUnavailable(err) {
-				task, err = vm.Reset(ctx)
-			}
-		case cmd.Shutdown:
-			fmt.Fprintf(cmd, "Shutdown guest %s... ", vm.Reference())
-			err = vm.ShutdownGuest(ctx)
+	if cmd.All() {
+		if err = cmd.WriteResult(res); err != nil {
+			return err
+		}
+	}
 
-			if err != nil && cmd.Force && isToolsUnavailable(err) {
-				task, err = vm.PowerOff(ctx)
-			}
-		case cmd.Standby:
-			fmt.Fprintf(cmd, "Standby guest %s... ", vm.Reference())
-			err = vm.StandbyGuest(ctx)
+	if cmd.Force {
+		return nil
+	}
 
-			if err != nil && cmd.Force && isToolsUnavailable(err) {
-				task, err = vm.Suspend(ctx)
-			}
-		}
+	return res.Err()
+}
 
-		if err != nil {
-			return err
+func (cmd *power) power(ctx context.Context, w io.Writer, vm *object.VirtualMachine) error {
+	var (
+		task *object.Task
+		err  error
+	)
+
+	switch {
+	case cmd.On:
+		fmt.Fprintf(w, "Powering on %s... ", vm.Reference())
+		task, err = vm.PowerOn(ctx)
+	case cmd.Off:
+		fmt.Fprintf(w, "Powering off %s... ", vm.Reference())
+		task, err = vm.PowerOff(ctx)
+	case cmd.Reset:
+		fmt.Fprintf(w, "Reset %s... ", vm.Reference())
+		task, err = vm.Reset(ctx)
+	case cmd.Suspend:
+		fmt.Fprintf(w, "Suspend %s... ", vm.Reference())
+		task, err = vm.Suspend(ctx)
+	case cmd.Reboot:
+		fmt.Fprintf(w, "Reboot guest %s... ", vm.Reference())
+		err = vm.RebootGuest(ctx)
+
+		if err != nil && cmd.Force && isToolsUnavailable(err) {
+			task, err = vm.Reset(ctx)
 		}
+	case cmd.Shutdown:
+		fmt.Fprintf(w, "Shutdown guest %s... ", vm.Reference())
+		err = vm.ShutdownGuest(ctx)
 
-		if cmd.Wait && task != nil {
-			err = task.Wait(ctx)
-		}
-		if err == nil {
-			fmt.Fprintf(cmd, "OK\n")
-			continue
+		if err != nil && cmd.Force && isToolsUnavailable(err) {
+			task, err = vm.PowerOff(ctx)
 		}
+	case cmd.Standby:
+		fmt.Fprintf(w, "Standby guest %s... ", vm.Reference())
+		err = vm.StandbyGuest(ctx)
 
-		if cmd.Force {
-			fmt.Fprintf(cmd, "Error: %s\n", err)
-			continue
+		if err != nil && cmd.Force && isToolsUnavailable(err) {
+			task, err = vm.Suspend(ctx)
 		}
+	}
 
+	if err != nil {
 		return err
 	}
 
+	if cmd.Wait && task != nil {
+		return task.Wait(ctx)
+	}
+
 	return nil
 }
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"context"
+	"errors"
+	"flag"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type rekey struct {
+	*cryptoFlag
+
+	deep bool
+}
+
+func init() {
+	cli.Register("vm.rekey", &rekey{})
+}
+
+func (cmd *rekey) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.cryptoFlag, ctx = newCryptoFlag(ctx, true)
+	cmd.cryptoFlag.Register(ctx, f)
+
+	f.BoolVar(&cmd.deep, "deep", false, "Deep rekey, re-encrypting data with a new internal key")
+}
+
+func (cmd *rekey) Description() string {
+	return `Rekey encrypted VM.
+
+A shallow rekey replaces the key encryption key and can be performed with the VM powered on,
+if the VM has no snapshots other than a single chain.
+A deep rekey (-deep) also re-encrypts the data and requires a powered off VM with no snapshots.
+
+If disks are specified, only those disks are rekeyed.
+Otherwise, the VM home and all encrypted disks are rekeyed.
+If a key ID is not specified, a new key is generated by the key provider.
+
+Examples:
+  govc vm.rekey -vm $vm
+  govc vm.rekey -vm $vm -provider my-other-kp
+  govc vm.rekey -vm $vm -deep -disk disk-1000-1`
+}
+
+func (cmd *rekey) Run(ctx context.Context, f *flag.FlagSet) error {
+	vm, props, err := cmd.cryptoVM(ctx)
+	if err != nil {
+		return err
+	}
+
+	if props.Config.KeyId == nil {
+		return errors.New("vm is not encrypted")
+	}
+
+	key, err := cmd.cryptoKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	var recrypt types.BaseCryptoSpec = &types.CryptoSpecShallowRecrypt{NewKeyId: key}
+	if cmd.deep {
+		recrypt = &types.CryptoSpecDeepRecrypt{NewKeyId: key}
+	}
+
+	var spec types.VirtualMachineConfigSpec
+
+	if len(cmd.disks) == 0 {
+		spec.Crypto = recrypt
+	}
+
+	spec.DeviceChange, err = cmd.diskChanges(props, func(disk *types.VirtualDisk) bool {
+		return diskKeyID(disk) != nil
+	}, recrypt)
+	if err != nil {
+		return err
+	}
+
+	return cmd.reconfigure(ctx, vm, spec)
+}
@@ -19,13 +19,16 @@ package snapshot
 import (
 	"context"
 	"flag"
+	"io"
 
 	"github.com/vmware/govmomi/govc/cli"
 	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/object"
 )
 
 type create struct {
 	*flags.VirtualMachineFlag
+	*flags.ParallelFlag
 
 	description string
 	memory      bool
@@ -40,6 +43,9 @@ func (cmd *create) Register(ctx context.Context, f *flag.FlagSet) {
 	cmd.VirtualMachineFlag, ctx = flags.NewVirtualMachineFlag(ctx)
 	cmd.VirtualMachineFlag.Register(ctx, f)
 
+	cmd.ParallelFlag, ctx = flags.NewParallelFlag(ctx)
+	cmd.ParallelFlag.Register(ctx, f)
+
 	f.BoolVar(&cmd.memory, "m", true, "Include memory state")
 	f.BoolVar(&cmd.quiesce, "q", false, "Quiesce guest file system")
 	f.StringVar(&cmd.description, "d", "", "Snapshot description")
@@ -52,14 +58,20 @@ func (cmd *create) Usage() string {
 func (cmd *create) Description() string {
 	return `Create snapshot of VM with NAME.
 
+The '-vm' flag may be a pattern matching multiple VMs, each of which is snapshotted.
+
 Examples:
-  govc snapshot.create -vm my-vm happy-vm-state`
+  govc snapshot.create -vm my-vm happy-vm-state
+  govc snapshot.create -vm 'web-*' -parallel 4 -json pre-upgrade`
 }
 
 func (cmd *create) Process(ctx context.Context) error {
 	if err := cmd.VirtualMachineFlag.Process(ctx); err != nil {
 		return err
 	}
+	if err := cmd.ParallelFlag.Process(ctx); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -68,19 +80,31 @@ func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
 		return flag.ErrHelp
 	}
 
-	vm, err := cmd.VirtualMachine()
+	vms, err := cmd.VirtualMachineList()
 	if err != nil {
 		return err
 	}
 
-	if vm == nil {
+	if len(vms) == 0 {
 		return flag.ErrHelp
 	}
 
-	task, err := vm.CreateSnapshot(ctx, f.Arg(0), cmd.description, cmd.memory, cmd.quiesce)
-	if err != nil {
-		return err
+	name := f.Arg(0)
+
+	res := flags.RunParallel(ctx, cmd.ParallelFlag, vms, func(ctx context.Context, _ io.Writer, vm *object.VirtualMachine) error {
+		task, err := vm.CreateSnapshot(ctx, name, cmd.description, cmd.memory, cmd.quiesce)
+		if err != nil {
+			return err
+		}
+
+		return task.Wait(ctx)
+	})
+
+	if cmd.All() {
+		if err = cmd.WriteResult(res); err != nil {
+			return err
+		}
 	}
 
-	return task.Wait(ctx)
+	return res.Err()
 }
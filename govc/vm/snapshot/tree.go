@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"path"
 	"strings"
 	"time"
@@ -145,14 +146,24 @@ func (cmd *tree) makeSnapshotRecord(vm mo.VirtualMachine, node types.VirtualMach
 
 }
 
-func (cmd *tree) writeJson(vm mo.VirtualMachine) {
-	var SnapshotRecords []SnapshotRecord
+type snapshotRecords []SnapshotRecord
+
+func (r snapshotRecords) Write(io.Writer) error {
+	return nil // plain output is written by tree.write
+}
+
+func (cmd *tree) writeRecords(vm mo.VirtualMachine) error {
+	var SnapshotRecords snapshotRecords
 	for _, rootSnapshot := range vm.Snapshot.RootSnapshotList {
 		SnapshotRecords = append(SnapshotRecords, cmd.makeSnapshotRecord(vm, rootSnapshot, nil))
 	}
-	b, _ := json.MarshalIndent(SnapshotRecords, "", "  ")
-	fmt.Println(string(b))
-
+	if cmd.JSON {
+		// JSON output is always indented, regardless of GOVC_INDENT
+		b, _ := json.MarshalIndent(SnapshotRecords, "", "  ")
+		fmt.Println(string(b))
+		return nil
+	}
+	return cmd.WriteResult(SnapshotRecords)
 }
 func (cmd *tree) write(level int, parent string, pref *types.ManagedObjectReference, st []types.VirtualMachineSnapshotTree) {
 	for _, s := range st {
@@ -248,11 +259,11 @@ func (cmd *tree) Run(ctx context.Context, f *flag.FlagSet) error {
 
 	cmd.info = o.Snapshot
 	cmd.layout = o.LayoutEx
-	if cmd.JSON {
-		cmd.writeJson(o)
-	} else {
-		cmd.write(0, "", nil, o.Snapshot.RootSnapshotList)
+	if cmd.JSON || cmd.YAML {
+		return cmd.writeRecords(o)
 	}
 
+	cmd.write(0, "", nil, o.Snapshot.RootSnapshotList)
+
 	return nil
 }
@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/cns/types"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+)
+
+type attach struct {
+	*flags.VirtualMachineFlag
+
+	attach bool
+}
+
+func init() {
+	cli.Register("volume.attach", &attach{attach: true})
+	cli.Register("volume.detach", &attach{attach: false})
+}
+
+func (cmd *attach) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.VirtualMachineFlag, ctx = flags.NewVirtualMachineFlag(ctx)
+	cmd.VirtualMachineFlag.Register(ctx, f)
+}
+
+func (cmd *attach) Usage() string {
+	return "ID"
+}
+
+func (cmd *attach) Description() string {
+	if cmd.attach {
+		return `Attach CNS volume ID to VM.
+
+The disk UUID of the attached volume is printed on success.
+
+Examples:
+  govc volume.attach -vm my-vm f75989dc-95b9-4db7-af96-8583f24bc59d`
+	}
+
+	return `Detach CNS volume ID from VM.
+
+Examples:
+  govc volume.detach -vm my-vm f75989dc-95b9-4db7-af96-8583f24bc59d`
+}
+
+func (cmd *attach) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 {
+		return flag.ErrHelp
+	}
+
+	vm, err := cmd.VirtualMachine()
+	if err != nil {
+		return err
+	}
+	if vm == nil {
+		return flag.ErrHelp
+	}
+
+	c, err := cmd.CnsClient()
+	if err != nil {
+		return err
+	}
+
+	spec := []types.CnsVolumeAttachDetachSpec{{
+		VolumeId: types.CnsVolumeId{Id: f.Arg(0)},
+		Vm:       vm.Reference(),
+	}}
+
+	if !cmd.attach {
+		task, err := c.DetachVolume(ctx, spec)
+		if err != nil {
+			return err
+		}
+
+		_, err = volumeResult(ctx, task)
+		return err
+	}
+
+	task, err := c.AttachVolume(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	res, err := volumeResult(ctx, task)
+	if err != nil {
+		return err
+	}
+
+	if r, ok := res.(*types.CnsVolumeAttachResult); ok {
+		fmt.Println(r.DiskUUID)
+	}
+
+	return nil
+}
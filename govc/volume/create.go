@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/cns/types"
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/units"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+type create struct {
+	*flags.DatastoreFlag
+	*flags.StorageProfileFlag
+
+	types.CnsContainerCluster
+
+	size units.ByteSize
+	disk string
+}
+
+func init() {
+	cli.Register("volume.create", &create{})
+}
+
+func (cmd *create) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+
+	cmd.StorageProfileFlag, ctx = flags.NewStorageProfileFlag(ctx)
+	cmd.StorageProfileFlag.Register(ctx, f)
+
+	_ = cmd.size.Set("10G")
+	f.Var(&cmd.size, "size", "Size of new volume")
+	f.StringVar(&cmd.disk, "disk-id", "", "Create volume backed by existing disk ID (static provisioning)")
+	f.StringVar(&cmd.ClusterId, "cluster-id", "", "Container cluster ID")
+	f.StringVar(&cmd.ClusterType, "cluster-type", string(types.CnsClusterTypeKubernetes), "Container cluster type")
+	f.StringVar(&cmd.ClusterFlavor, "cluster-flavor", string(types.CnsClusterFlavorVanilla), "Container cluster flavor")
+	f.StringVar(&cmd.VSphereUser, "user", "", "vSphere user of the container cluster (default to session user)")
+}
+
+func (cmd *create) Process(ctx context.Context) error {
+	if err := cmd.DatastoreFlag.Process(ctx); err != nil {
+		return err
+	}
+	return cmd.StorageProfileFlag.Process(ctx)
+}
+
+func (cmd *create) Usage() string {
+	return "NAME"
+}
+
+func (cmd *create) Description() string {
+	return `Create CNS block volume NAME.
+
+The volume ID is printed on success.
+When NAME is the name of a Kubernetes PV, the volume can be queried using 'govc volume.ls -n NAME'.
+
+Examples:
+  govc volume.create -cluster-id my-cluster -size 1G pvc-9744a4ff-07f4-43c4-b8ed-48ea7a528734
+  govc volume.create -cluster-id my-cluster -ds vsanDatastore -profile "vSAN Default Storage Policy" my-volume
+  govc volume.create -cluster-id my-cluster -disk-id $(govc disk.create -size 1G my-disk) my-volume`
+}
+
+func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 || cmd.ClusterId == "" {
+		return flag.ErrHelp
+	}
+
+	ds, err := cmd.DatastoreIfSpecified()
+	if err != nil {
+		return err
+	}
+
+	profile, err := cmd.StorageProfileSpec(ctx)
+	if err != nil {
+		return err
+	}
+
+	c, err := cmd.CnsClient()
+	if err != nil {
+		return err
+	}
+
+	if cmd.VSphereUser == "" && cmd.Session.URL != nil {
+		cmd.VSphereUser = cmd.Session.URL.User.Username()
+	}
+
+	spec := types.CnsVolumeCreateSpec{
+		Name:       f.Arg(0),
+		VolumeType: string(types.CnsVolumeTypeBlock),
+		Metadata: types.CnsVolumeMetadata{
+			ContainerCluster:      cmd.CnsContainerCluster,
+			ContainerClusterArray: []types.CnsContainerCluster{cmd.CnsContainerCluster},
+		},
+		BackingObjectDetails: &types.CnsBlockBackingDetails{
+			CnsBackingObjectDetails: types.CnsBackingObjectDetails{
+				CapacityInMb: int64(cmd.size) / units.MB,
+			},
+			BackingDiskId: cmd.disk,
+		},
+		Profile: profile,
+	}
+
+	if ds != nil {
+		spec.Datastores = []vim.ManagedObjectReference{ds.Reference()}
+	}
+
+	task, err := c.CreateVolume(ctx, []types.CnsVolumeCreateSpec{spec})
+	if err != nil {
+		return err
+	}
+
+	res, err := volumeResult(ctx, task)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(res.GetCnsVolumeOperationResult().VolumeId.Id)
+
+	return nil
+}
@@ -60,6 +60,8 @@ func (cmd *ls) Register(ctx context.Context, f *flag.FlagSet) {
 	f.BoolVar(&cmd.long, "l", false, "Long listing format")
 	f.BoolVar(&cmd.id, "i", false, "List volume ID only")
 	f.BoolVar(&cmd.disk, "L", false, "List volume disk or file backing ID only")
+	f.Var((*flags.StringList)(&cmd.Names), "n", "Filter by volume NAME, such as a Kubernetes PV name")
+	f.Var((*flags.StringList)(&cmd.ContainerClusterIds), "c", "Filter by container cluster ID")
 }
 
 func (cmd *ls) Process(ctx context.Context) error {
@@ -84,6 +86,8 @@ Examples:
   govc volume.ls -l
   govc volume.ls -ds vsanDatastore
   govc volume.ls df86393b-5ae0-4fca-87d0-b692dbc67d45
+  govc volume.ls -n pvc-9744a4ff-07f4-43c4-b8ed-48ea7a528734
+  govc volume.ls -c my-cluster -i | xargs -n1 govc volume.rm
   govc disk.ls -l $(govc volume.ls -L pvc-9744a4ff-07f4-43c4-b8ed-48ea7a528734)`
 }
 
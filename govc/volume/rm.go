@@ -20,10 +20,12 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
 
 	"github.com/vmware/govmomi/cns/types"
 	"github.com/vmware/govmomi/govc/cli"
 	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/soap"
 )
 
@@ -67,23 +69,32 @@ func (cmd *rm) Run(ctx context.Context, f *flag.FlagSet) error {
 		return err
 	}
 
+	_, err = volumeResult(ctx, task)
+	return err
+}
+
+// volumeResult waits for the given CNS task and returns the first volume operation result,
+// or an error if the task or the volume operation failed.
+func volumeResult(ctx context.Context, task *object.Task) (types.BaseCnsVolumeOperationResult, error) {
 	info, err := task.WaitForResult(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	res, ok := info.Result.(types.CnsVolumeOperationBatchResult)
+	if !ok || len(res.VolumeResults) == 0 {
+		return nil, fmt.Errorf("%s: no volume result", info.DescriptionId)
 	}
 
-	if res, ok := info.Result.(types.CnsVolumeOperationBatchResult); ok {
-		for _, r := range res.VolumeResults {
-			fault := r.GetCnsVolumeOperationResult().Fault
+	r := res.VolumeResults[0]
+	fault := r.GetCnsVolumeOperationResult().Fault
 
-			if fault != nil {
-				if fault.Fault != nil {
-					return soap.WrapVimFault(fault.Fault)
-				}
-				return errors.New(fault.LocalizedMessage)
-			}
+	if fault != nil {
+		if fault.Fault != nil {
+			return nil, soap.WrapVimFault(fault.Fault)
 		}
+		return nil, errors.New(fault.LocalizedMessage)
 	}
 
-	return nil
+	return r, nil
 }
@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package guest
+
+import (
+	"context"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// AliasManager manages guest credential aliases, which map a certificate and subject
+// to a guest user account, for use with SAML token authentication.
+type AliasManager struct {
+	types.ManagedObjectReference
+
+	vm types.ManagedObjectReference
+
+	c *vim25.Client
+}
+
+func (m AliasManager) Reference() types.ManagedObjectReference {
+	return m.ManagedObjectReference
+}
+
+func (m AliasManager) AddAlias(ctx context.Context, auth types.BaseGuestAuthentication, username string, mapCert bool, base64Cert string, aliasInfo types.GuestAuthAliasInfo) error {
+	req := types.AddGuestAlias{
+		This:       m.Reference(),
+		Vm:         m.vm,
+		Auth:       auth,
+		Username:   username,
+		MapCert:    mapCert,
+		Base64Cert: base64Cert,
+		AliasInfo:  aliasInfo,
+	}
+
+	_, err := methods.AddGuestAlias(ctx, m.c, &req)
+	return err
+}
+
+func (m AliasManager) RemoveAlias(ctx context.Context, auth types.BaseGuestAuthentication, username string, base64Cert string, subject types.BaseGuestAuthSubject) error {
+	req := types.RemoveGuestAlias{
+		This:       m.Reference(),
+		Vm:         m.vm,
+		Auth:       auth,
+		Username:   username,
+		Base64Cert: base64Cert,
+		Subject:    subject,
+	}
+
+	_, err := methods.RemoveGuestAlias(ctx, m.c, &req)
+	return err
+}
+
+func (m AliasManager) RemoveAliasByCert(ctx context.Context, auth types.BaseGuestAuthentication, username string, base64Cert string) error {
+	req := types.RemoveGuestAliasByCert{
+		This:       m.Reference(),
+		Vm:         m.vm,
+		Auth:       auth,
+		Username:   username,
+		Base64Cert: base64Cert,
+	}
+
+	_, err := methods.RemoveGuestAliasByCert(ctx, m.c, &req)
+	return err
+}
+
+func (m AliasManager) ListAliases(ctx context.Context, auth types.BaseGuestAuthentication, username string) ([]types.GuestAliases, error) {
+	req := types.ListGuestAliases{
+		This:     m.Reference(),
+		Vm:       m.vm,
+		Auth:     auth,
+		Username: username,
+	}
+
+	res, err := methods.ListGuestAliases(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+func (m AliasManager) ListMappedAliases(ctx context.Context, auth types.BaseGuestAuthentication) ([]types.GuestMappedAliases, error) {
+	req := types.ListGuestMappedAliases{
+		This: m.Reference(),
+		Vm:   m.vm,
+		Auth: auth,
+	}
+
+	res, err := methods.ListGuestMappedAliases(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
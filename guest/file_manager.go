@@ -39,8 +39,23 @@ type FileManager struct {
 
 	c *vim25.Client
 
+	// Proxy, if true, rewrites transfer URLs to use vCenter as a proxy to the ESX host,
+	// as the vSphere UI does, rather than connecting to the ESX host directly.
+	// Proxy is useful when the client cannot reach ESX hosts, such as behind NAT or a bastion host.
+	// Proxy is also used when the ESX host name cannot be resolved by the client.
+	// When proxying, TLS terminates at vCenter and the connection is verified using the vCenter certificate.
+	// The ESX host thumbprint cannot be verified by the client in that case, vCenter verifies its own connection to the host.
+	// Direct connections to an ESX host are always verified using the host's thumbprint.
+	Proxy bool
+
 	mu    *sync.Mutex
-	hosts map[string]string
+	hosts map[string]transferHost
+}
+
+// transferHost caches the TransferURL rewrite for an ESX host name.
+type transferHost struct {
+	host  string
+	proxy *types.ManagedObjectReference
 }
 
 func (m FileManager) Reference() types.ManagedObjectReference {
@@ -124,9 +139,31 @@ func (m FileManager) DeleteFile(ctx context.Context, auth types.BaseGuestAuthent
 // escape hatch to disable the preference to use ESX host management IP for guest file transfer
 var useGuestTransferIP = os.Getenv("GOVMOMI_USE_GUEST_TRANSFER_IP") != "false"
 
+// enable FileManager.Proxy by default, for clients such as govc
+var useGuestTransferProxy = os.Getenv("GOVMOMI_GUEST_TRANSFER_PROXY") == "true"
+
+// proxyURL rewrites the url to use vCenter's host gateway for the given ESX host.
+// The connection is verified using the vCenter certificate, see FileManager.Proxy.
+func (m FileManager) proxyURL(u *url.URL, host types.ManagedObjectReference) *url.URL {
+	turl := *u
+	turl.Scheme = m.c.URL().Scheme
+	turl.Host = m.c.URL().Host
+	turl.Path = fmt.Sprintf("/hgw/%s%s", host.Value, u.Path)
+	return &turl
+}
+
+// resolvable returns true if the given host name can be resolved by this client.
+// A lookup error may be transient, so the result is not cached by TransferURL.
+func resolvable(ctx context.Context, name string) bool {
+	_, err := net.DefaultResolver.LookupHost(ctx, name)
+	return err == nil
+}
+
 // TransferURL rewrites the url with a valid hostname and adds the host's thumbprint.
 // The InitiateFileTransfer{From,To}Guest methods return a URL with the host set to "*" when connected directly to ESX,
 // but return the address of VM's runtime host when connected to vCenter.
+// When connected to vCenter and Proxy is true, or the host name cannot be resolved,
+// the url is rewritten to use vCenter as a proxy to the ESX host.
 func (m FileManager) TransferURL(ctx context.Context, u string) (*url.URL, error) {
 	turl, err := url.Parse(u)
 	if err != nil {
@@ -144,18 +181,22 @@ func (m FileManager) TransferURL(ctx context.Context, u string) (*url.URL, error
 	name := turl.Hostname()
 	port := turl.Port()
 	isHostname := net.ParseIP(name) == nil
+	proxy := m.Proxy || useGuestTransferProxy
 
 	m.mu.Lock()
-	mname, ok := m.hosts[name]
+	th, ok := m.hosts[name]
 	m.mu.Unlock()
 
-	if ok {
-		turl.Host = mname
+	if ok && (th.proxy != nil || !proxy) {
+		if th.proxy != nil {
+			return m.proxyURL(turl, *th.proxy), nil
+		}
+		turl.Host = th.host
 		return turl, nil
-	} else {
-		mname = turl.Host
 	}
 
+	mname := turl.Host
+
 	c := property.DefaultCollector(m.c)
 
 	var vm mo.VirtualMachine
@@ -176,6 +217,11 @@ func (m FileManager) TransferURL(ctx context.Context, u string) (*url.URL, error
 		return internal.HostGatewayTransferURL(turl, *vm.Runtime.Host), nil
 	}
 
+	if proxy {
+		m.cache(name, transferHost{proxy: vm.Runtime.Host})
+		return m.proxyURL(turl, *vm.Runtime.Host), nil
+	}
+
 	// Determine host thumbprint, address etc. to be able to trust host.
 	props := []string{
 		"name",
@@ -210,18 +256,24 @@ func (m FileManager) TransferURL(ctx context.Context, u string) (*url.URL, error
 			mname = net.JoinHostPort(ips[0].String(), port)
 
 			turl.Host = mname
+		} else if !resolvable(ctx, name) {
+			return m.proxyURL(turl, *vm.Runtime.Host), nil
 		}
 	}
 
-	m.mu.Lock()
-	m.hosts[name] = mname
-	m.mu.Unlock()
+	m.cache(name, transferHost{host: mname})
 
 	m.c.SetThumbprint(turl.Host, host.Summary.Config.SslThumbprint)
 
 	return turl, nil
 }
 
+func (m FileManager) cache(name string, th transferHost) {
+	m.mu.Lock()
+	m.hosts[name] = th
+	m.mu.Unlock()
+}
+
 func (m FileManager) InitiateFileTransferFromGuest(ctx context.Context, auth types.BaseGuestAuthentication, guestFilePath string) (*types.FileTransferInformation, error) {
 	req := types.InitiateFileTransferFromGuest{
 		This:          m.Reference(),
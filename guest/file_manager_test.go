@@ -68,16 +68,39 @@ func TestTranferURL(t *testing.T) {
 		}
 
 		for i := 0; i < 2; i++ { // 2nd time is to validate the cached value
-			turl := "https://esx2:443/foo/bar"
+			turl := "https://localhost:443/foo/bar"
 			u, err := m.TransferURL(ctx, turl)
 			if err != nil {
 				t.Fatal(err)
 			}
-			if u.Hostname() != "esx2" {
+			if u.Hostname() != "localhost" {
 				t.Errorf("hostname=%s", u.Hostname())
 			}
 		}
 
+		// vCenter should be used as a proxy when the hostname cannot be resolved
+		proxy := fmt.Sprintf("/hgw/%s/foo/bar", host.Reference().Value)
+		for i := 0; i < 2; i++ { // 2nd time the lookup is retried, as lookup errors are not cached
+			turl := "https://esx2.invalid:443/foo/bar?id=1"
+			u, err := m.TransferURL(ctx, turl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if u.Host != c.URL().Host || u.Path != proxy || u.RawQuery != "id=1" {
+				t.Errorf("url=%s", u)
+			}
+		}
+
+		// vCenter should be used as a proxy for any host name when Proxy is enabled
+		m.Proxy = true
+		u, err := m.TransferURL(ctx, "https://localhost:443/foo/bar")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Host != c.URL().Host || u.Path != proxy {
+			t.Errorf("url=%s", u)
+		}
+
 		// error should be returned if HostSystem.Config is nil
 		host.Config = nil
 		m, err = ops.FileManager(ctx)
@@ -86,7 +109,7 @@ func TestTranferURL(t *testing.T) {
 		}
 
 		turl := "https://noconfig:443/foo/bar"
-		u, err := m.TransferURL(ctx, turl)
+		u, err = m.TransferURL(ctx, turl)
 		if err == nil {
 			t.Errorf("expected error (url=%s)", u)
 		}
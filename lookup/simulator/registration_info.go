@@ -17,21 +17,33 @@ limitations under the License.
 package simulator
 
 import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/vmware/govmomi/lookup"
 	"github.com/vmware/govmomi/lookup/types"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
 )
 
 var (
 	siteID = "vcsim"
+
+	// linkedTimeout bounds the time spent connecting to each linked vCenter instance
+	linkedTimeout = 10 * time.Second
 )
 
 // registrationInfo returns a ServiceRegistration populated with vcsim's OptionManager settings.
 // The complete list can be captured using: govc sso.service.ls -dump
-func registrationInfo() []types.LookupServiceRegistrationInfo {
+func registrationInfo(ctx context.Context) []types.LookupServiceRegistrationInfo {
 	vc := simulator.Map.Get(vim25.ServiceInstance).(*simulator.ServiceInstance)
 	setting := simulator.Map.OptionManager().Setting
 	sm := simulator.Map.SessionManager()
@@ -48,7 +60,11 @@ func registrationInfo() []types.LookupServiceRegistrationInfo {
 	if sm.TLSCert != nil {
 		trust[0] = sm.TLSCert()
 	}
-	sdk := opts["vcsim.server.url"] + vim25.Path
+	sdk := opts["vcsim.server.url"]
+	if u, err := url.Parse(sdk); err == nil {
+		u.Path = vim25.Path // vcsim.server.url may or may not include the path
+		sdk = u.String()
+	}
 	admin := opts["config.vpxd.sso.default.admin"]
 	owner := opts["config.vpxd.sso.solutionUser.name"]
 	instance := opts["VirtualCenter.InstanceName"]
@@ -103,61 +119,111 @@ func registrationInfo() []types.LookupServiceRegistrationInfo {
 			ServiceId: siteID + ":" + uuid.New().String(),
 			SiteId:    siteID,
 		},
-		{
-			LookupServiceRegistrationCommonServiceInfo: types.LookupServiceRegistrationCommonServiceInfo{
-				LookupServiceRegistrationMutableServiceInfo: types.LookupServiceRegistrationMutableServiceInfo{
-					ServiceVersion: vim25.Version,
-					ServiceEndpoints: []types.LookupServiceRegistrationEndpoint{
-						{
-							Url: sdk,
-							EndpointType: types.LookupServiceRegistrationEndpointType{
-								Protocol: "vmomi",
-								Type:     "com.vmware.vim",
-							},
-							SslTrust: trust,
-							EndpointAttributes: []types.LookupServiceRegistrationAttribute{
-								{
-									Key:   "cis.common.ep.localurl",
-									Value: sdk,
-								},
-							},
-						},
-					},
-					ServiceAttributes: []types.LookupServiceRegistrationAttribute{
-						{
-							Key:   "com.vmware.cis.cm.GroupInternalId",
-							Value: "com.vmware.vim.vcenter",
-						},
-						{
-							Key:   "com.vmware.vim.vcenter.instanceName",
-							Value: instance,
-						},
-						{
-							Key:   "com.vmware.cis.cm.ControlScript",
-							Value: "service-control-default-vmon",
+		vcenterRegistration(vc.Content.About.InstanceUuid, instance, sdk, owner, trust),
+	}
+
+	for _, s := range strings.Split(opts["vcsim.linked.url"], ",") {
+		if s == "" {
+			continue
+		}
+		reg, err := linkedRegistration(ctx, s, owner)
+		if err != nil {
+			log.Printf("lookup: linked vCenter %s: %s", s, err)
+			continue
+		}
+		info = append(info, *reg)
+	}
+
+	sts := info[0]
+	sts.ServiceType.Type = "sso:sts" // obsolete service type, but still used by PowerCLI
+
+	return append(info, sts)
+}
+
+// vcenterRegistration returns a vcenterserver ServiceRegistration for the vCenter instance with the given sdk URL.
+func vcenterRegistration(id, instance, sdk, owner string, trust []string) types.LookupServiceRegistrationInfo {
+	return types.LookupServiceRegistrationInfo{
+		LookupServiceRegistrationCommonServiceInfo: types.LookupServiceRegistrationCommonServiceInfo{
+			LookupServiceRegistrationMutableServiceInfo: types.LookupServiceRegistrationMutableServiceInfo{
+				ServiceVersion: vim25.Version,
+				ServiceEndpoints: []types.LookupServiceRegistrationEndpoint{
+					{
+						Url: sdk,
+						EndpointType: types.LookupServiceRegistrationEndpointType{
+							Protocol: "vmomi",
+							Type:     "com.vmware.vim",
 						},
-						{
-							Key:   "com.vmware.cis.cm.HostId",
-							Value: uuid.New().String(),
+						SslTrust: trust,
+						EndpointAttributes: []types.LookupServiceRegistrationAttribute{
+							{
+								Key:   "cis.common.ep.localurl",
+								Value: sdk,
+							},
 						},
 					},
-					ServiceNameResourceKey:        "AboutInfo.vpx.name",
-					ServiceDescriptionResourceKey: "AboutInfo.vpx.name",
 				},
-				OwnerId: owner,
-				ServiceType: types.LookupServiceRegistrationServiceType{
-					Product: "com.vmware.cis",
-					Type:    "vcenterserver",
+				ServiceAttributes: []types.LookupServiceRegistrationAttribute{
+					{
+						Key:   "com.vmware.cis.cm.GroupInternalId",
+						Value: "com.vmware.vim.vcenter",
+					},
+					{
+						Key:   "com.vmware.vim.vcenter.instanceName",
+						Value: instance,
+					},
+					{
+						Key:   "com.vmware.cis.cm.ControlScript",
+						Value: "service-control-default-vmon",
+					},
+					{
+						Key:   "com.vmware.cis.cm.HostId",
+						Value: uuid.New().String(),
+					},
 				},
-				NodeId: uuid.New().String(),
+				ServiceNameResourceKey:        "AboutInfo.vpx.name",
+				ServiceDescriptionResourceKey: "AboutInfo.vpx.name",
 			},
-			ServiceId: vc.Content.About.InstanceUuid,
-			SiteId:    siteID,
+			OwnerId: owner,
+			ServiceType: types.LookupServiceRegistrationServiceType{
+				Product: "com.vmware.cis",
+				Type:    "vcenterserver",
+			},
+			NodeId: uuid.New().String(),
 		},
+		ServiceId: id,
+		SiteId:    siteID,
 	}
+}
 
-	sts := info[0]
-	sts.ServiceType.Type = "sso:sts" // obsolete service type, but still used by PowerCLI
+// linkedRegistration returns a vcenterserver ServiceRegistration for a linked vCenter instance,
+// as specified by simulator.Model.Linked.
+func linkedRegistration(ctx context.Context, s, owner string) (*types.LookupServiceRegistrationInfo, error) {
+	u, err := soap.ParseURL(s)
+	if err != nil {
+		return nil, err
+	}
+	u.User = nil
 
-	return append(info, sts)
+	ctx, cancel := context.WithTimeout(ctx, linkedTimeout)
+	defer cancel()
+
+	c, err := vim25.NewClient(ctx, soap.NewClient(u, true))
+	if err != nil {
+		return nil, err
+	}
+
+	trust := []string{""}
+	if u.Scheme == "https" {
+		dialer := &tls.Dialer{Config: &tls.Config{InsecureSkipVerify: true}}
+		conn, err := dialer.DialContext(ctx, "tcp", u.Host)
+		if err != nil {
+			return nil, err
+		}
+		trust[0] = base64.StdEncoding.EncodeToString(conn.(*tls.Conn).ConnectionState().PeerCertificates[0].Raw)
+		_ = conn.Close()
+	}
+
+	reg := vcenterRegistration(c.ServiceContent.About.InstanceUuid, u.Host, u.String(), owner, trust)
+
+	return &reg, nil
 }
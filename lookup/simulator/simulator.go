@@ -17,6 +17,7 @@ limitations under the License.
 package simulator
 
 import (
+	"context"
 	"net/url"
 	"strings"
 	"sync"
@@ -52,10 +53,10 @@ func New() *simulator.Registry {
 	r.Put(&ServiceInstance{
 		ManagedObjectReference: lookup.ServiceInstance,
 		Content:                content,
-		register: func() {
+		register: func(ctx context.Context) {
 			r.Put(&ServiceRegistration{
 				ManagedObjectReference: *content.ServiceRegistration,
-				Info:                   registrationInfo(),
+				Info:                   registrationInfo(ctx),
 			})
 		},
 	})
@@ -69,13 +70,13 @@ type ServiceInstance struct {
 	Content types.LookupServiceContent
 
 	instance sync.Once
-	register func()
+	register func(context.Context)
 }
 
-func (s *ServiceInstance) RetrieveServiceContent(_ *types.RetrieveServiceContent) soap.HasFault {
+func (s *ServiceInstance) RetrieveServiceContent(ctx *simulator.Context, _ *types.RetrieveServiceContent) soap.HasFault {
 	// defer register to this point to ensure we can include vcsim's cert in ServiceEndpoints.SslTrust
 	// TODO: we should be able to register within New(), but this is the only place that currently depends on vcsim's cert.
-	s.instance.Do(s.register)
+	s.instance.Do(func() { s.register(ctx) })
 
 	return &methods.RetrieveServiceContentBody{
 		Res: &types.RetrieveServiceContentResponse{
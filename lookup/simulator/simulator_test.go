@@ -19,12 +19,18 @@ package simulator
 import (
 	"context"
 	"log"
+	"net"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/lookup"
 	"github.com/vmware/govmomi/lookup/types"
+	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	vim "github.com/vmware/govmomi/vim25/types"
 )
 
 func TestClient(t *testing.T) {
@@ -151,3 +157,60 @@ func TestClient(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestLinked(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		// The linked instance is this instance, as vcsim supports 1 instance per process
+		u := *vc.URL()
+		u.User = nil
+
+		// A linked instance that does not respond is skipped once linkedTimeout expires
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+
+		timeout := linkedTimeout
+		linkedTimeout = 100 * time.Millisecond
+		defer func() { linkedTimeout = timeout }()
+
+		linked := []string{"https://" + l.Addr().String() + "/sdk", u.String()}
+
+		m := object.NewOptionManager(vc, *vc.ServiceContent.Setting)
+		err = m.Update(ctx, []vim.BaseOptionValue{&vim.OptionValue{Key: "vcsim.linked.url", Value: strings.Join(linked, ",")}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		c, err := lookup.NewClient(ctx, vc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		info, err := c.List(ctx, &types.LookupServiceRegistrationFilter{
+			ServiceType: &types.LookupServiceRegistrationServiceType{
+				Product: "com.vmware.cis",
+				Type:    "vcenterserver",
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(info) != 2 {
+			t.Fatalf("len=%d", len(info))
+		}
+
+		reg := info[1]
+		if reg.ServiceId != vc.ServiceContent.About.InstanceUuid {
+			t.Errorf("ServiceId=%s", reg.ServiceId)
+		}
+		if reg.ServiceEndpoints[0].Url != u.String() {
+			t.Errorf("Url=%s", reg.ServiceEndpoints[0].Url)
+		}
+		if reg.ServiceEndpoints[0].SslTrust[0] == "" {
+			t.Error("no SslTrust")
+		}
+	})
+}
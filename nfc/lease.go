@@ -27,6 +27,7 @@ import (
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/progress"
 	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -208,10 +209,43 @@ func (l *Lease) StartUpdater(ctx context.Context, info *LeaseInfo) *LeaseUpdater
 	return newLeaseUpdater(ctx, l, info)
 }
 
-func (l *Lease) Upload(ctx context.Context, item FileItem, f io.Reader, opts soap.Upload) error {
-	if opts.Progress == nil {
-		opts.Progress = item
+// itemSink forwards the progress of a transfer attempt to the LeaseUpdater of the given item.
+// Error reports are dropped and the item is only marked complete when the attempt succeeds,
+// such that a failed transfer of the item can be retried.
+func itemSink(item FileItem) progress.Sinker {
+	return progress.SinkFunc(func() chan<- progress.Report {
+		ch := make(chan progress.Report)
+
+		go func() {
+			var err error
+			for r := range ch {
+				if err = r.Error(); err == nil {
+					item.ch <- r
+				}
+			}
+			if err == nil {
+				close(item.ch)
+			}
+		}()
+
+		return ch
+	})
+}
+
+// itemProgress returns a Sinker that feeds the LeaseUpdater of the given item,
+// in addition to the caller's Sinker or the context Sinker, if any.
+func itemProgress(ctx context.Context, item FileItem, s progress.Sinker) progress.Sinker {
+	if s == nil {
+		s = progress.FromContext(ctx)
+	}
+	if s == nil {
+		return itemSink(item)
 	}
+	return progress.Tee(itemSink(item), s)
+}
+
+func (l *Lease) Upload(ctx context.Context, item FileItem, f io.Reader, opts soap.Upload) error {
+	opts.Progress = itemProgress(ctx, item, opts.Progress)
 
 	// Non-disk files (such as .iso) use the PUT method.
 	// Overwrite: t header is also required in this case (ovftool does the same)
@@ -229,9 +263,7 @@ func (l *Lease) Upload(ctx context.Context, item FileItem, f io.Reader, opts soa
 }
 
 func (l *Lease) DownloadFile(ctx context.Context, file string, item FileItem, opts soap.Download) error {
-	if opts.Progress == nil {
-		opts.Progress = item
-	}
+	opts.Progress = itemProgress(ctx, item, opts.Progress)
 
 	return l.c.DownloadFile(ctx, file, item.URL, &opts)
 }
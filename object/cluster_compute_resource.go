@@ -101,3 +101,21 @@ func (c ClusterComputeResource) PlaceVm(ctx context.Context, spec types.Placemen
 
 	return &res.Returnval, nil
 }
+
+// EvcManager returns the cluster's ClusterEVCManager.
+func (c ClusterComputeResource) EvcManager(ctx context.Context) (*ClusterEVCManager, error) {
+	req := types.EvcManager{
+		This: c.Reference(),
+	}
+
+	res, err := methods.EvcManager(ctx, c.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Returnval == nil {
+		return nil, ErrNotSupported
+	}
+
+	return NewClusterEVCManager(c.c, *res.Returnval), nil
+}
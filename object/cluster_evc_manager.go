@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// ClusterEVCManager manages the Enhanced vMotion Compatibility (EVC) mode of a cluster,
+// see ClusterComputeResource.EvcManager.
+type ClusterEVCManager struct {
+	Common
+}
+
+func NewClusterEVCManager(c *vim25.Client, ref types.ManagedObjectReference) *ClusterEVCManager {
+	return &ClusterEVCManager{
+		Common: NewCommon(c, ref),
+	}
+}
+
+// EvcState returns the EVC state of the cluster, including the supported EVC modes and the current mode, if any.
+func (m ClusterEVCManager) EvcState(ctx context.Context) (*types.ClusterEVCManagerEVCState, error) {
+	var o mo.ClusterEVCManager
+
+	err := m.Properties(ctx, m.Reference(), []string{"evcState"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	return &o.EvcState, nil
+}
+
+// SupportedEVCModes returns the EVC modes supported by the vCenter instance.
+func SupportedEVCModes(ctx context.Context, c *vim25.Client) ([]types.EVCMode, error) {
+	var o mo.ServiceInstance
+
+	err := NewCommon(c, vim25.ServiceInstance).Properties(ctx, vim25.ServiceInstance, []string{"capability.supportedEVCMode"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.Capability.SupportedEVCMode, nil
+}
+
+// ConfigureEvcMode enables EVC on the cluster or changes the cluster's EVC mode.
+// The graphicsModeKey is optional (vSphere 7.0U1+).
+func (m ClusterEVCManager) ConfigureEvcMode(ctx context.Context, modeKey string, graphicsModeKey string) (*Task, error) {
+	req := types.ConfigureEvcMode_Task{
+		This:               m.Reference(),
+		EvcModeKey:         modeKey,
+		EvcGraphicsModeKey: graphicsModeKey,
+	}
+
+	res, err := methods.ConfigureEvcMode_Task(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.c, res.Returnval), nil
+}
+
+// DisableEvcMode disables EVC on the cluster.
+func (m ClusterEVCManager) DisableEvcMode(ctx context.Context) (*Task, error) {
+	req := types.DisableEvcMode_Task{
+		This: m.Reference(),
+	}
+
+	res, err := methods.DisableEvcMode_Task(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.c, res.Returnval), nil
+}
+
+// CheckConfigureEvcMode tests the feasibility of a ConfigureEvcMode operation,
+// returning a result for each problem found, if any.
+func (m ClusterEVCManager) CheckConfigureEvcMode(ctx context.Context, modeKey string, graphicsModeKey string) ([]types.ClusterEVCManagerCheckResult, error) {
+	req := types.CheckConfigureEvcMode_Task{
+		This:               m.Reference(),
+		EvcModeKey:         modeKey,
+		EvcGraphicsModeKey: graphicsModeKey,
+	}
+
+	res, err := methods.CheckConfigureEvcMode_Task(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.checkResult(ctx, res.Returnval)
+}
+
+// CheckAddHostEvc tests the feasibility of adding the host specified by spec to the cluster with its current EVC mode,
+// returning a result for each problem found, if any.
+func (m ClusterEVCManager) CheckAddHostEvc(ctx context.Context, spec types.HostConnectSpec) ([]types.ClusterEVCManagerCheckResult, error) {
+	req := types.CheckAddHostEvc_Task{
+		This:    m.Reference(),
+		CnxSpec: spec,
+	}
+
+	res, err := methods.CheckAddHostEvc_Task(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.checkResult(ctx, res.Returnval)
+}
+
+func (m ClusterEVCManager) checkResult(ctx context.Context, ref types.ManagedObjectReference) ([]types.ClusterEVCManagerCheckResult, error) {
+	ti, err := NewTask(m.c, ref).WaitForResult(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res, ok := ti.Result.(types.ArrayOfClusterEVCManagerCheckResult); ok {
+		return res.ClusterEVCManagerCheckResult, nil
+	}
+
+	return nil, nil
+}
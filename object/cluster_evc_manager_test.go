@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestClusterEVCManager(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		modes, err := object.SupportedEVCModes(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(modes) == 0 {
+			t.Fatal("no supported EVC modes")
+		}
+
+		finder := find.NewFinder(c)
+		cluster, err := finder.DefaultClusterComputeResource(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m, err := cluster.EvcManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		state, err := m.EvcState(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state.CurrentEVCModeKey != "" || len(state.SupportedEVCMode) == 0 {
+			t.Fatalf("state=%#v", state)
+		}
+
+		var amd string
+		for _, mode := range modes {
+			if mode.Vendor == "amd" {
+				amd = mode.Key
+			}
+		}
+		key := state.SupportedEVCMode[0].Key
+
+		res, err := m.CheckConfigureEvcMode(ctx, key, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res) != 0 {
+			t.Errorf("check %s=%#v", key, res)
+		}
+
+		res, err = m.CheckConfigureEvcMode(ctx, amd, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res) != 1 || res[0].EvcModeKey != amd {
+			t.Errorf("check %s=%#v", amd, res)
+		}
+
+		task, err := m.ConfigureEvcMode(ctx, amd, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err == nil {
+			t.Error("expected error")
+		}
+
+		task, err = m.ConfigureEvcMode(ctx, key, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		state, err = m.EvcState(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state.CurrentEVCModeKey != key {
+			t.Errorf("mode=%s", state.CurrentEVCModeKey)
+		}
+
+		var cr mo.ClusterComputeResource
+		if err = cluster.Properties(ctx, cluster.Reference(), []string{"summary", "host"}, &cr); err != nil {
+			t.Fatal(err)
+		}
+		if mode := cr.Summary.(*types.ClusterComputeResourceSummary).CurrentEVCModeKey; mode != key {
+			t.Errorf("cluster mode=%s", mode)
+		}
+
+		var host mo.HostSystem
+		if err = cluster.Properties(ctx, cr.Host[0], []string{"summary"}, &host); err != nil {
+			t.Fatal(err)
+		}
+		if host.Summary.CurrentEVCModeKey != key {
+			t.Errorf("host mode=%s", host.Summary.CurrentEVCModeKey)
+		}
+
+		res, err = m.CheckAddHostEvc(ctx, types.HostConnectSpec{HostName: "esx.example.com"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res) != 0 {
+			t.Errorf("check add host=%#v", res)
+		}
+
+		task, err = m.DisableEvcMode(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		state, err = m.EvcState(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state.CurrentEVCModeKey != "" {
+			t.Errorf("mode=%s", state.CurrentEVCModeKey)
+		}
+	})
+}
+
+func TestVirtualMachineApplyEvcMode(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mask := []types.HostFeatureMask{{Key: "cpuid.AVX", FeatureName: "cpuid.AVX", Value: "Val:0"}}
+
+		task, err := vm.ApplyEvcMode(ctx, mask, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err == nil {
+			t.Error("expected error") // powered on
+		}
+
+		task, err = vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		runtime := func() types.VirtualMachineRuntimeInfo {
+			var o mo.VirtualMachine
+			if err := vm.Properties(ctx, vm.Reference(), []string{"runtime"}, &o); err != nil {
+				t.Fatal(err)
+			}
+			return o.Runtime
+		}
+
+		for _, m := range [][]types.HostFeatureMask{mask, nil} {
+			task, err = vm.ApplyEvcMode(ctx, m, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			if n := len(runtime().FeatureMask); n != len(m) {
+				t.Errorf("mask=%d", n)
+			}
+		}
+	})
+}
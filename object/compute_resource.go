@@ -68,6 +68,27 @@ func (c ComputeResource) Hosts(ctx context.Context) ([]*HostSystem, error) {
 	return hosts, nil
 }
 
+// EstimateAdmission returns the HostSystem.EstimateAdmission result for each host of the compute resource,
+// for use in validating placement of a VM created with the given spec.
+func (c ComputeResource) EstimateAdmission(ctx context.Context, spec types.VirtualMachineConfigSpec) ([]AdmissionEstimate, error) {
+	hosts, err := c.Hosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []AdmissionEstimate
+
+	for _, host := range hosts {
+		e, err := host.EstimateAdmission(ctx, spec)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *e)
+	}
+
+	return res, nil
+}
+
 func (c ComputeResource) Datastores(ctx context.Context) ([]*Datastore, error) {
 	var cr mo.ComputeResource
 
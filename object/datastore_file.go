@@ -31,7 +31,7 @@ import (
 	"github.com/vmware/govmomi/vim25/soap"
 )
 
-// DatastoreFile implements io.Reader, io.Seeker and io.Closer interfaces for datastore file access.
+// DatastoreFile implements io.Reader, io.ReaderAt, io.Seeker and io.Closer interfaces for datastore file access.
 type DatastoreFile struct {
 	d    Datastore
 	ctx  context.Context
@@ -219,6 +219,9 @@ func (f *DatastoreFile) get() (io.Reader, error) {
 		}
 	case http.StatusRequestedRangeNotSatisfiable:
 		// ok: Read() will return io.EOF
+		_, _ = fmt.Sscanf(res.Header.Get("Content-Range"), "bytes */%d", &f.length)
+		_ = res.Body.Close()
+		res.Body = http.NoBody
 	default:
 		return nil, statusError(res)
 	}
@@ -233,6 +236,75 @@ func (f *DatastoreFile) get() (io.Reader, error) {
 	return f.body, nil
 }
 
+// ReadAt reads len(b) bytes from the DatastoreFile starting at byte offset off,
+// using a single ranged GET request. The offset used by Read and Seek is not affected,
+// allowing ReadAt to be used concurrently, for example with an io.SectionReader.
+func (f *DatastoreFile) ReadAt(b []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("ReadAt: negative offset")
+	}
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	u, p, err := f.d.downloadTicket(f.ctx, f.name, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	p.Headers = map[string]string{
+		"Range": fmt.Sprintf("bytes=%d-%d", off, off+int64(len(b))-1),
+	}
+
+	res, err := f.d.Client().DownloadRequest(f.ctx, u, p)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// Range header was ignored, skip to the offset
+		if _, err = io.CopyN(io.Discard, res.Body, off); err != nil {
+			if err == io.EOF {
+				return 0, io.EOF
+			}
+			return 0, err
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		return 0, io.EOF
+	default:
+		return 0, statusError(res)
+	}
+
+	n, err := io.ReadFull(res.Body, b)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF // less than len(b) bytes remain in the file
+	}
+
+	return n, err
+}
+
+// ReadRange reads up to n bytes of the named file, starting at byte offset off,
+// without downloading the rest of the file.
+// Fewer than n bytes are returned if the end of the file is reached.
+func (d Datastore) ReadRange(ctx context.Context, name string, off, n int64) ([]byte, error) {
+	f, err := d.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, n)
+
+	i, err := f.ReadAt(b, off)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return b[:i], nil
+}
+
 func lastIndexLines(s []byte, line *int, include func(l int, m string) bool) (int64, bool) {
 	i := len(s) - 1
 	done := false
@@ -347,6 +419,8 @@ type followDatastoreFile struct {
 	c chan struct{}
 	i time.Duration
 	o sync.Once
+
+	retry bool
 }
 
 // Read reads up to len(b) bytes from the DatastoreFile being followed.
@@ -365,10 +439,22 @@ func (f *followDatastoreFile) Read(p []byte) (int, error) {
 			err = nil
 		}
 
+		if err == os.ErrNotExist {
+			_ = f.r.Close()
+			if !f.retry {
+				return 0, io.EOF
+			}
+			err = nil // file was removed or rotated, Stat() below will wait for it to reappear
+		}
+
 		if n > 0 {
 			return n, err
 		}
 
+		if err != nil {
+			return 0, err
+		}
+
 		select {
 		case <-f.c:
 			// Wake up and stop polling once the body has been drained
@@ -378,9 +464,14 @@ func (f *followDatastoreFile) Read(p []byte) (int, error) {
 
 		info, serr := f.r.Stat()
 		if serr != nil {
-			// Return EOF rather than 404 if the file goes away
 			if serr == os.ErrNotExist {
 				_ = f.r.Close()
+				if f.retry && !stop {
+					// Read the replacement file from the start once it has been created
+					offset, _ = f.r.Seek(0, io.SeekStart)
+					continue
+				}
+				// Return EOF rather than 404 if the file goes away
 				return 0, io.EOF
 			}
 			return 0, serr
@@ -388,6 +479,7 @@ func (f *followDatastoreFile) Read(p []byte) (int, error) {
 
 		if info.Size() < offset {
 			// assume file has be truncated
+			_ = f.r.Close()
 			offset, err = f.r.Seek(0, io.SeekStart)
 			if err != nil {
 				return 0, err
@@ -403,6 +495,8 @@ func (f *followDatastoreFile) Close() error {
 }
 
 // Follow returns an io.ReadCloser to stream the file contents as data is appended.
+// If the file is truncated, reading starts over from the beginning of the file.
+// Reads return io.EOF if the file is removed.
 func (f *DatastoreFile) Follow(interval time.Duration) io.ReadCloser {
 	return &followDatastoreFile{
 		r: f,
@@ -410,3 +504,15 @@ func (f *DatastoreFile) Follow(interval time.Duration) io.ReadCloser {
 		i: interval,
 	}
 }
+
+// FollowName is like Follow, but keeps polling if the file is removed,
+// as happens when a log file is rotated, and starts reading the new file
+// from the beginning once it has been created.
+func (f *DatastoreFile) FollowName(interval time.Duration) io.ReadCloser {
+	return &followDatastoreFile{
+		r:     f,
+		c:     make(chan struct{}),
+		i:     interval,
+		retry: true,
+	}
+}
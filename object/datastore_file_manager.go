@@ -57,12 +57,14 @@ func (d Datastore) NewFileManager(dc *Datacenter, force bool) *DatastoreFileMana
 	return m
 }
 
+// WithProgress returns a context that reports the task progress of this DatastoreFileManager to s.
+// Without it, progress is reported to the context Sinker, if any, see progress.WithSinker.
 func (m *DatastoreFileManager) WithProgress(ctx context.Context, s progress.Sinker) context.Context {
 	return context.WithValue(ctx, m, s)
 }
 
 func (m *DatastoreFileManager) wait(ctx context.Context, task *Task) error {
-	var logger progress.Sinker
+	logger := progress.FromContext(ctx)
 	if s, ok := ctx.Value(m).(progress.Sinker); ok {
 		logger = s
 	}
@@ -0,0 +1,185 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+func TestDatastoreFileFollow(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		ref := simulator.Map.Any("Datastore")
+		ds := object.NewDatastore(c, ref.Reference())
+		name := filepath.Join(ref.(*simulator.Datastore).Info.GetDatastoreInfo().Url, "follow.log")
+		interval := 10 * time.Millisecond
+
+		write := func(flag int, data string) {
+			f, err := os.OpenFile(name, flag|os.O_WRONLY|os.O_CREATE, 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = f.WriteString(data); err != nil {
+				t.Fatal(err)
+			}
+			_ = f.Close()
+		}
+
+		// read polls for the file to change only while Read is blocked
+		read := func(r io.Reader, n int) chan string {
+			c := make(chan string, 1)
+			go func() {
+				buf := make([]byte, n)
+				_, err := io.ReadFull(r, buf)
+				if err != nil {
+					t.Error(err)
+				}
+				c <- string(buf)
+			}()
+			return c
+		}
+
+		expect := func(r io.Reader, data string) {
+			t.Helper()
+			if buf := <-read(r, len(data)); buf != data {
+				t.Errorf("read %q, expected %q", buf, data)
+			}
+		}
+
+		write(os.O_TRUNC, "one\n")
+
+		file, err := ds.Open(ctx, "follow.log")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = file.Tail(10); err != nil {
+			t.Fatal(err)
+		}
+
+		r := file.FollowName(interval)
+		expect(r, "one\n")
+
+		write(os.O_APPEND, "two\n")
+		expect(r, "two\n")
+
+		// truncated
+		write(os.O_TRUNC, "3\n")
+		expect(r, "3\n")
+
+		// rotated
+		rotated := read(r, 5)
+		if err = os.Remove(name); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(interval * 10)
+		write(os.O_TRUNC, "four\n")
+		if buf := <-rotated; buf != "four\n" {
+			t.Errorf("read %q", buf)
+		}
+
+		_ = r.Close()
+		if _, err = r.Read(make([]byte, 8)); err != io.EOF {
+			t.Errorf("err=%v", err)
+		}
+
+		// Follow stops when the file is removed
+		file, err = ds.Open(ctx, "follow.log")
+		if err != nil {
+			t.Fatal(err)
+		}
+		r = file.Follow(interval)
+		expect(r, "four\n")
+
+		if err = os.Remove(name); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = r.Read(make([]byte, 8)); err != io.EOF {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
+
+func TestDatastoreFileReadAt(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		ref := simulator.Map.Any("Datastore")
+		ds := object.NewDatastore(c, ref.Reference())
+
+		data := []byte("# Disk DescriptorFile\nversion=1\n")
+		p := soap.DefaultUpload
+		p.ContentLength = int64(len(data))
+		if err := ds.Upload(ctx, bytes.NewReader(data), "disk.vmdk", &p); err != nil {
+			t.Fatal(err)
+		}
+
+		file, err := ds.Open(ctx, "disk.vmdk")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			off  int64
+			n    int
+			data string
+			err  error
+		}{
+			{0, 6, "# Disk", nil},
+			{22, 9, "version=1", nil},
+			{22, 64, "version=1\n", io.EOF},
+			{int64(len(data)), 8, "", io.EOF},
+		}
+
+		for _, test := range tests {
+			b := make([]byte, test.n)
+			n, err := file.ReadAt(b, test.off)
+			if err != test.err {
+				t.Errorf("ReadAt(%d, %d) err=%v", test.off, test.n, err)
+			}
+			if string(b[:n]) != test.data {
+				t.Errorf("ReadAt(%d, %d)=%q", test.off, test.n, b[:n])
+			}
+		}
+
+		// ReadAt does not change the Read offset
+		b := make([]byte, 6)
+		if _, err = io.ReadFull(file, b); err != nil || string(b) != "# Disk" {
+			t.Errorf("Read=%q, err=%v", b, err)
+		}
+
+		b, err = ds.ReadRange(ctx, "disk.vmdk", 22, 512)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "version=1\n" {
+			t.Errorf("ReadRange=%q", b)
+		}
+
+		_, err = ds.ReadRange(ctx, "enoent.vmdk", 0, 512)
+		if err != os.ErrNotExist {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -116,3 +117,104 @@ func (s DistributedVirtualSwitch) ReconfigureLACP(ctx context.Context, spec []ty
 
 	return NewTask(s.Client(), res.Returnval), nil
 }
+
+// Rollback returns a task with the types.BaseDVSConfigSpec result needed to roll back the switch
+// to the given backup, or to its previous config if backup is nil. The result can be applied using Reconfigure.
+func (s DistributedVirtualSwitch) Rollback(ctx context.Context, backup *types.EntityBackupConfig) (*Task, error) {
+	req := types.DVSRollback_Task{
+		This:         s.Reference(),
+		EntityBackup: backup,
+	}
+
+	res, err := methods.DVSRollback_Task(ctx, s.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(s.Client(), res.Returnval), nil
+}
+
+// PerformProductSpecOperation performs the given product spec operation, such as an upgrade, on the switch.
+func (s DistributedVirtualSwitch) PerformProductSpecOperation(ctx context.Context, op types.DistributedVirtualSwitchProductSpecOperationType, spec *types.DistributedVirtualSwitchProductSpec) (*Task, error) {
+	req := types.PerformDvsProductSpecOperation_Task{
+		This:        s.Reference(),
+		Operation:   string(op),
+		ProductSpec: spec,
+	}
+
+	res, err := methods.PerformDvsProductSpecOperation_Task(ctx, s.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(s.Client(), res.Returnval), nil
+}
+
+// Upgrade upgrades the switch to the given product spec version,
+// which must be newer than the current version.
+// The new version is pushed to connected host members, see OutOfSyncHosts.
+func (s DistributedVirtualSwitch) Upgrade(ctx context.Context, spec types.DistributedVirtualSwitchProductSpec) (*Task, error) {
+	return s.PerformProductSpecOperation(ctx, types.DistributedVirtualSwitchProductSpecOperationTypeUpgrade, &spec)
+}
+
+// HostMembers returns the host members of the switch, including their product info and status.
+func (s DistributedVirtualSwitch) HostMembers(ctx context.Context) ([]types.DistributedVirtualSwitchHostMember, error) {
+	var dvs mo.DistributedVirtualSwitch
+
+	err := s.Properties(ctx, s.Reference(), []string{"config"}, &dvs)
+	if err != nil {
+		return nil, err
+	}
+
+	return dvs.Config.GetDVSConfigInfo().Host, nil
+}
+
+// OutOfSyncHosts returns the host members with a config that is out of sync with the switch.
+func (s DistributedVirtualSwitch) OutOfSyncHosts(ctx context.Context) ([]types.ManagedObjectReference, error) {
+	members, err := s.HostMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []types.ManagedObjectReference
+
+	for _, member := range members {
+		status := types.DistributedVirtualSwitchHostMemberHostComponentState(member.Status)
+		if status == types.DistributedVirtualSwitchHostMemberHostComponentStateOutOfSync && member.Config.Host != nil {
+			hosts = append(hosts, *member.Config.Host)
+		}
+	}
+
+	return hosts, nil
+}
+
+// RectifyHosts pushes the switch config to the given host members.
+func (s DistributedVirtualSwitch) RectifyHosts(ctx context.Context, hosts []types.ManagedObjectReference) (*Task, error) {
+	req := types.RectifyDvsOnHost_Task{
+		This:  s.Reference(),
+		Hosts: hosts,
+	}
+
+	res, err := methods.RectifyDvsOnHost_Task(ctx, s.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(s.Client(), res.Returnval), nil
+}
+
+// SyncHosts rectifies any host members that are out of sync with the switch, such as after an Upgrade,
+// waiting for the task to complete. The rectified hosts are returned.
+func (s DistributedVirtualSwitch) SyncHosts(ctx context.Context) ([]types.ManagedObjectReference, error) {
+	hosts, err := s.OutOfSyncHosts(ctx)
+	if err != nil || len(hosts) == 0 {
+		return nil, err
+	}
+
+	task, err := s.RectifyHosts(ctx, hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	return hosts, task.Wait(ctx)
+}
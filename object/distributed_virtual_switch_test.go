@@ -20,9 +20,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func TestDistributedVirtualSwitchEthernetCardBackingInfo(t *testing.T) {
@@ -37,3 +41,198 @@ func TestDistributedVirtualSwitchEthernetCardBackingInfo(t *testing.T) {
 		}
 	})
 }
+
+func TestDistributedVirtualSwitchUpgrade(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		obj := simulator.Map.Any("DistributedVirtualSwitch").(*simulator.DistributedVirtualSwitch)
+		dvs := object.NewDistributedVirtualSwitch(c, obj.Self)
+
+		members, err := dvs.HostMembers(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(members) != len(obj.Summary.HostMember) {
+			t.Fatalf("members=%d", len(members))
+		}
+
+		hosts, err := dvs.OutOfSyncHosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hosts) != 0 {
+			t.Errorf("hosts=%v", hosts)
+		}
+
+		host := object.NewHostSystem(c, *members[0].Config.Host)
+		task, err := host.Disconnect(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		version := obj.Summary.ProductInfo.Version
+
+		task, err = dvs.Upgrade(ctx, types.DistributedVirtualSwitchProductSpec{Version: "8.0.3"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		task, err = dvs.Upgrade(ctx, types.DistributedVirtualSwitchProductSpec{Version: version})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); !fault.Is(err, &types.DvsFault{}) {
+			t.Errorf("downgrade err=%v", err)
+		}
+
+		members, err = dvs.HostMembers(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, member := range members {
+			synced := member.ProductInfo.Version == "8.0.3"
+			if synced == (i == 0) {
+				t.Errorf("%s version=%s status=%s", member.Config.Host, member.ProductInfo.Version, member.Status)
+			}
+		}
+
+		var mdvs mo.DistributedVirtualSwitch
+		if err = dvs.Properties(ctx, dvs.Reference(), []string{"summary", "config"}, &mdvs); err != nil {
+			t.Fatal(err)
+		}
+		if v := mdvs.Config.GetDVSConfigInfo().ProductInfo.Version; v != "8.0.3" || mdvs.Summary.ProductInfo.Version != v {
+			t.Errorf("version=%s", v)
+		}
+
+		hosts, err = dvs.OutOfSyncHosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hosts) != 1 || hosts[0] != host.Reference() {
+			t.Errorf("hosts=%v", hosts)
+		}
+
+		if _, err = dvs.SyncHosts(ctx); !fault.Is(err, &types.HostNotConnected{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		task, err = host.Reconnect(ctx, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		hosts, err = dvs.SyncHosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hosts) != 1 {
+			t.Errorf("hosts=%v", hosts)
+		}
+
+		hosts, err = dvs.OutOfSyncHosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hosts) != 0 {
+			t.Errorf("hosts=%v", hosts)
+		}
+
+		for kind, n := range map[string]int{"DvsUpgradedEvent": 1, "OutOfSyncDvsHost": 1, "DvsHostStatusUpdated": 1} {
+			events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{EventTypeId: []string{kind}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(events) != n {
+				t.Errorf("%s=%d", kind, len(events))
+			}
+		}
+	})
+}
+
+func TestDistributedVirtualSwitchHealthCheck(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		obj := simulator.Map.Any("DistributedVirtualSwitch").(*simulator.DistributedVirtualSwitch)
+		dvs := object.VmwareDistributedVirtualSwitch{DistributedVirtualSwitch: *object.NewDistributedVirtualSwitch(c, obj.Self)}
+
+		results, err := dvs.HealthCheckResults(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != 0 {
+			t.Errorf("results=%d", len(results))
+		}
+
+		task, err := dvs.EnableHealthCheck(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		results, err = dvs.HealthCheckResults(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != len(obj.Summary.HostMember) {
+			t.Fatalf("results=%d", len(results))
+		}
+		for _, r := range results {
+			if len(r.Uplinks) == 0 || r.TeamingStatus != string(types.VMwareDVSTeamingMatchStatusNonIphashMatch) || r.Mismatch() {
+				t.Errorf("%s: %#v", r.Host, r)
+			}
+		}
+
+		// simulate a physical switch port that does not trunk VLAN 100
+		simulator.Map.WithLock(simulator.SpoofContext(), obj, func() {
+			check := obj.Runtime.HostMemberRuntime[0].HealthCheckResult[0].(*types.VMwareDVSVlanHealthCheckResult)
+			check.UntrunkedVlan = []types.NumericRange{{Start: 100, End: 100}}
+		})
+
+		results, err = dvs.HealthCheckResults(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !results[0].Mismatch() || !results[0].Uplinks[0].VlanMismatch() || results[1].Mismatch() {
+			t.Errorf("expected VLAN mismatch on %s only", results[0].Host)
+		}
+
+		task, err = dvs.DisableHealthCheck(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		results, err = dvs.HealthCheckResults(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range results {
+			if len(r.Uplinks) != 0 || r.TeamingStatus != "" {
+				t.Errorf("%s: %#v", r.Host, r)
+			}
+		}
+
+		task, err = dvs.UpdateHealthCheckConfig(ctx, &types.VMwareDVSTeamingHealthCheckConfig{
+			VMwareDVSHealthCheckConfig: types.VMwareDVSHealthCheckConfig{
+				DVSHealthCheckConfig: types.DVSHealthCheckConfig{Enable: types.NewBool(true), Interval: -1},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
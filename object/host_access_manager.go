@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// DCUIAccessOption is the host advanced option listing the users allowed to
+// access the DCUI even when lockdown mode is enabled.
+const DCUIAccessOption = "DCUI.Access"
+
+type HostAccessManager struct {
+	Common
+}
+
+func NewHostAccessManager(c *vim25.Client, ref types.ManagedObjectReference) *HostAccessManager {
+	return &HostAccessManager{
+		Common: NewCommon(c, ref),
+	}
+}
+
+// LockdownMode returns the current lockdown mode of the host.
+func (m HostAccessManager) LockdownMode(ctx context.Context) (types.HostLockdownMode, error) {
+	var am mo.HostAccessManager
+
+	err := m.Properties(ctx, m.Reference(), []string{"lockdownMode"}, &am)
+	if err != nil {
+		return "", err
+	}
+
+	return am.LockdownMode, nil
+}
+
+// ChangeLockdownMode changes the lockdown mode of the host.
+func (m HostAccessManager) ChangeLockdownMode(ctx context.Context, mode types.HostLockdownMode) error {
+	req := types.ChangeLockdownMode{
+		This: m.Reference(),
+		Mode: mode,
+	}
+
+	_, err := methods.ChangeLockdownMode(ctx, m.Client(), &req)
+	return err
+}
+
+// QueryLockdownExceptions returns the list of users which do not lose their permissions
+// when the host enters lockdown mode.
+func (m HostAccessManager) QueryLockdownExceptions(ctx context.Context) ([]string, error) {
+	req := types.QueryLockdownExceptions{
+		This: m.Reference(),
+	}
+
+	res, err := methods.QueryLockdownExceptions(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// UpdateLockdownExceptions replaces the list of lockdown mode exception users.
+func (m HostAccessManager) UpdateLockdownExceptions(ctx context.Context, users []string) error {
+	req := types.UpdateLockdownExceptions{
+		This:  m.Reference(),
+		Users: users,
+	}
+
+	_, err := methods.UpdateLockdownExceptions(ctx, m.Client(), &req)
+	return err
+}
+
+// QuerySystemUsers returns the list of local system users.
+func (m HostAccessManager) QuerySystemUsers(ctx context.Context) ([]string, error) {
+	req := types.QuerySystemUsers{
+		This: m.Reference(),
+	}
+
+	res, err := methods.QuerySystemUsers(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// UpdateSystemUsers replaces the list of local system users.
+func (m HostAccessManager) UpdateSystemUsers(ctx context.Context, users []string) error {
+	req := types.UpdateSystemUsers{
+		This:  m.Reference(),
+		Users: users,
+	}
+
+	_, err := methods.UpdateSystemUsers(ctx, m.Client(), &req)
+	return err
+}
+
+// DCUIAccess returns the list of users in the host's DCUIAccessOption.
+func DCUIAccess(ctx context.Context, m *OptionManager) ([]string, error) {
+	opts, err := m.Query(ctx, DCUIAccessOption)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []string
+	for _, opt := range opts {
+		if s, ok := opt.GetOptionValue().Value.(string); ok {
+			for _, u := range strings.Split(s, ",") {
+				if u = strings.TrimSpace(u); u != "" {
+					users = append(users, u)
+				}
+			}
+		}
+	}
+
+	return users, nil
+}
+
+// UpdateDCUIAccess sets the host's DCUIAccessOption to the given list of users.
+func UpdateDCUIAccess(ctx context.Context, m *OptionManager, users []string) error {
+	return m.Update(ctx, []types.BaseOptionValue{&types.OptionValue{
+		Key:   DCUIAccessOption,
+		Value: strings.Join(users, ","),
+	}})
+}
+
+// LockdownSpec describes the lockdown settings applied by ConfigureLockdown.
+// Fields with a zero value are left unchanged.
+type LockdownSpec struct {
+	Mode       types.HostLockdownMode
+	Exceptions []string
+	DCUIAccess []string
+}
+
+// ConfigureLockdown applies the LockdownSpec to each of the given hosts, in order.
+// When enabling lockdown mode, the exception users and DCUI access list are updated first,
+// so access is not lost part way through. When disabling, the mode is changed first.
+// The first error encountered is returned, wrapped with the reference of the host that failed.
+func ConfigureLockdown(ctx context.Context, hosts []*HostSystem, spec LockdownSpec) error {
+	for _, host := range hosts {
+		if err := configureLockdown(ctx, host, spec); err != nil {
+			return fmt.Errorf("%s: %w", host.Reference(), err)
+		}
+	}
+	return nil
+}
+
+func configureLockdown(ctx context.Context, host *HostSystem, spec LockdownSpec) error {
+	am, err := host.ConfigManager().AccessManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	changeMode := func() error {
+		if spec.Mode == "" {
+			return nil
+		}
+		return am.ChangeLockdownMode(ctx, spec.Mode)
+	}
+
+	if spec.Mode == types.HostLockdownModeLockdownDisabled {
+		if err = changeMode(); err != nil {
+			return err
+		}
+	}
+
+	if spec.Exceptions != nil {
+		if err = am.UpdateLockdownExceptions(ctx, spec.Exceptions); err != nil {
+			return err
+		}
+	}
+
+	if spec.DCUIAccess != nil {
+		om, err := host.ConfigManager().OptionManager(ctx)
+		if err != nil {
+			return err
+		}
+		if err = UpdateDCUIAccess(ctx, om, spec.DCUIAccess); err != nil {
+			return err
+		}
+	}
+
+	if spec.Mode != types.HostLockdownModeLockdownDisabled {
+		return changeMode()
+	}
+
+	return nil
+}
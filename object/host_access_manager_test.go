@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostAccessManager(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		obj := simulator.Map.Any("HostSystem").(*simulator.HostSystem)
+		host := object.NewHostSystem(c, obj.Self)
+
+		m, err := host.ConfigManager().AccessManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mode, err := m.LockdownMode(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mode != types.HostLockdownModeLockdownDisabled {
+			t.Errorf("mode=%s", mode)
+		}
+
+		if err = m.ChangeLockdownMode(ctx, "enoent"); err == nil {
+			t.Error("expected error")
+		}
+
+		users := []string{"admin", "svc-backup"}
+		if err = m.UpdateLockdownExceptions(ctx, users); err != nil {
+			t.Fatal(err)
+		}
+
+		exceptions, err := m.QueryLockdownExceptions(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(exceptions, users) {
+			t.Errorf("exceptions=%v", exceptions)
+		}
+
+		if err = m.UpdateSystemUsers(ctx, users[:1]); err != nil {
+			t.Fatal(err)
+		}
+
+		system, err := m.QuerySystemUsers(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(system, users[:1]) {
+			t.Errorf("system=%v", system)
+		}
+	})
+}
+
+func TestConfigureLockdown(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		hosts, err := find.NewFinder(c).HostSystemList(ctx, "*/*")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := object.LockdownSpec{
+			Mode:       types.HostLockdownModeLockdownStrict,
+			Exceptions: []string{"admin"},
+			DCUIAccess: []string{"root", "admin"},
+		}
+
+		if err = object.ConfigureLockdown(ctx, hosts, spec); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, host := range hosts {
+			m, err := host.ConfigManager().AccessManager(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mode, err := m.LockdownMode(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mode != spec.Mode {
+				t.Errorf("%s mode=%s", host, mode)
+			}
+
+			exceptions, err := m.QueryLockdownExceptions(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(exceptions, spec.Exceptions) {
+				t.Errorf("%s exceptions=%v", host, exceptions)
+			}
+
+			om, err := host.ConfigManager().OptionManager(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			access, err := object.DCUIAccess(ctx, om)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(access, spec.DCUIAccess) {
+				t.Errorf("%s access=%v", host, access)
+			}
+		}
+
+		err = object.ConfigureLockdown(ctx, hosts, object.LockdownSpec{Mode: types.HostLockdownModeLockdownDisabled})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, host := range hosts {
+			m, _ := host.ConfigManager().AccessManager(ctx)
+			mode, _ := m.LockdownMode(ctx)
+			if mode != types.HostLockdownModeLockdownDisabled {
+				t.Errorf("%s mode=%s", host, mode)
+			}
+		}
+	})
+}
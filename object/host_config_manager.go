@@ -169,3 +169,27 @@ func (m HostConfigManager) DateTimeSystem(ctx context.Context) (*HostDateTimeSys
 	}
 	return NewHostDateTimeSystem(m.c, ref), nil
 }
+
+func (m HostConfigManager) PowerSystem(ctx context.Context) (*HostPowerSystem, error) {
+	ref, err := m.reference(ctx, "powerSystem")
+	if err != nil {
+		return nil, err
+	}
+	return NewHostPowerSystem(m.c, ref), nil
+}
+
+func (m HostConfigManager) AccessManager(ctx context.Context) (*HostAccessManager, error) {
+	ref, err := m.reference(ctx, "hostAccessManager", true) // Added in 6.0
+	if err != nil {
+		return nil, err
+	}
+	return NewHostAccessManager(m.c, ref), nil
+}
+
+func (m HostConfigManager) PatchManager(ctx context.Context) (*HostPatchManager, error) {
+	ref, err := m.reference(ctx, "patchManager")
+	if err != nil {
+		return nil, err
+	}
+	return NewHostPatchManager(m.c, ref), nil
+}
@@ -46,6 +46,8 @@ func TestHostConfigManager(t *testing.T) {
 			"ServiceSystem",
 			"CertificateManager",
 			"DateTimeSystem",
+			"AccessManager",
+			"PatchManager",
 		}
 
 		rm := reflect.ValueOf(m)
@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// HostDateTimeDrift is the difference between a host's clock and the clock of the server
+// (vCenter or ESX) the client is connected to, along with the host's NTP configuration.
+type HostDateTimeDrift struct {
+	Host       types.ManagedObjectReference `json:"host"`
+	Name       string                       `json:"name"`
+	HostTime   time.Time                    `json:"hostTime"`
+	ServerTime time.Time                    `json:"serverTime"`
+	// Drift is HostTime minus ServerTime, positive when the host clock is ahead.
+	Drift      time.Duration `json:"drift"`
+	NtpServers []string      `json:"ntpServers"`
+	NtpRunning bool          `json:"ntpRunning"`
+	// Exceeded is true if the absolute Drift is greater than the threshold.
+	Exceeded bool `json:"exceeded"`
+	// Err is set if the host could not be queried, in which case the time fields are zero.
+	Err error `json:"-"`
+}
+
+// clockOffset returns the difference between a remote clock and the local clock,
+// using the midpoint of the request to compensate for round-trip latency.
+func clockOffset(query func() (*time.Time, error)) (time.Duration, error) {
+	start := time.Now()
+
+	now, err := query()
+	if err != nil {
+		return 0, err
+	}
+
+	mid := start.Add(time.Since(start) / 2)
+
+	return now.Sub(mid), nil
+}
+
+// HostDateTimeDrifts queries the date and time of each host concurrently, returning the drift of
+// each host clock versus the server clock. A host is flagged as Exceeded if its absolute drift
+// is greater than threshold, unless threshold is 0.
+// Both clocks are compared against the local clock, such that the client's own clock skew cancels out.
+// An error is returned only if the server time cannot be queried, per-host errors are set in HostDateTimeDrift.Err.
+func HostDateTimeDrifts(ctx context.Context, c *vim25.Client, hosts []*HostSystem, threshold time.Duration) ([]HostDateTimeDrift, error) {
+	server, err := clockOffset(func() (*time.Time, error) {
+		return methods.GetCurrentTime(ctx, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]HostDateTimeDrift, len(hosts))
+
+	var wg sync.WaitGroup
+
+	for i := range hosts {
+		wg.Add(1)
+		go func(host *HostSystem, drift *HostDateTimeDrift) {
+			defer wg.Done()
+			drift.Err = drift.query(ctx, host, server, threshold)
+		}(hosts[i], &res[i])
+	}
+
+	wg.Wait()
+
+	return res, nil
+}
+
+func (d *HostDateTimeDrift) query(ctx context.Context, host *HostSystem, server, threshold time.Duration) error {
+	d.Host = host.Reference()
+	d.Name = host.Name()
+
+	if d.Name == "" {
+		name, err := host.ObjectName(ctx)
+		if err != nil {
+			return err
+		}
+		d.Name = name
+	}
+
+	m := host.ConfigManager()
+
+	s, err := m.DateTimeSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	offset, err := clockOffset(func() (*time.Time, error) {
+		return s.Query(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	d.HostTime = now.Add(offset)
+	d.ServerTime = now.Add(server)
+	d.Drift = offset - server
+
+	if threshold > 0 {
+		drift := d.Drift
+		if drift < 0 {
+			drift = -drift
+		}
+		d.Exceeded = drift > threshold
+	}
+
+	var info mo.HostDateTimeSystem
+	if err = s.Properties(ctx, s.Reference(), []string{"dateTimeInfo"}, &info); err != nil {
+		return err
+	}
+	if info.DateTimeInfo.NtpConfig != nil {
+		d.NtpServers = info.DateTimeInfo.NtpConfig.Server
+	}
+
+	ss, err := m.ServiceSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	services, err := ss.Service(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		if service.Key == "ntpd" {
+			d.NtpRunning = service.Running
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostDateTimeDrifts(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		hosts, err := find.NewFinder(c).HostSystemList(ctx, "*/*")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		host := hosts[len(hosts)-1]
+
+		s, err := host.ConfigManager().DateTimeSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = s.Update(ctx, time.Now().Add(-10*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+
+		servers := []string{"pool.ntp.org"}
+		err = s.UpdateConfig(ctx, types.HostDateTimeConfig{NtpConfig: &types.HostNtpConfig{Server: servers}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ss, err := host.ConfigManager().ServiceSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ss.Start(ctx, "ntpd"); err != nil {
+			t.Fatal(err)
+		}
+
+		drifts, err := object.HostDateTimeDrifts(ctx, c, hosts, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(drifts) != len(hosts) {
+			t.Fatalf("%d drifts", len(drifts))
+		}
+
+		for i, d := range drifts {
+			if d.Err != nil {
+				t.Fatal(d.Err)
+			}
+			if d.Host != hosts[i].Reference() {
+				t.Errorf("host=%s", d.Host)
+			}
+
+			if hosts[i] == host {
+				if !d.Exceeded || d.Drift > -9*time.Minute || !d.NtpRunning || len(d.NtpServers) != 1 {
+					t.Errorf("drift=%#v", d)
+				}
+				continue
+			}
+
+			if d.Exceeded || d.Drift > time.Second || d.Drift < -time.Second || d.NtpRunning {
+				t.Errorf("drift=%#v", d)
+			}
+		}
+
+		// threshold of 0 disables the check
+		drifts, err = object.HostDateTimeDrifts(ctx, c, hosts, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, d := range drifts {
+			if d.Exceeded {
+				t.Errorf("drift=%#v", d)
+			}
+		}
+	})
+}
@@ -67,3 +67,30 @@ func (s HostDateTimeSystem) Query(ctx context.Context) (*time.Time, error) {
 
 	return &res.Returnval, nil
 }
+
+func (s HostDateTimeSystem) QueryAvailableTimeZones(ctx context.Context) ([]types.HostDateTimeSystemTimeZone, error) {
+	req := types.QueryAvailableTimeZones{
+		This: s.Reference(),
+	}
+
+	res, err := methods.QueryAvailableTimeZones(ctx, s.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// TestService checks that the configured time service (NTP or PTP) is working normally.
+func (s HostDateTimeSystem) TestService(ctx context.Context) (*types.HostDateTimeSystemServiceTestResult, error) {
+	req := types.TestTimeService{
+		This: s.Reference(),
+	}
+
+	res, err := methods.TestTimeService(ctx, s.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
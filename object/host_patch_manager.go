@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/progress"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type HostPatchManager struct {
+	Common
+}
+
+func NewHostPatchManager(c *vim25.Client, ref types.ManagedObjectReference) *HostPatchManager {
+	return &HostPatchManager{
+		Common: NewCommon(c, ref),
+	}
+}
+
+// HostPatchSpec specifies the location of update metadata, offline bundles and VIBs.
+// URLs must use the http, https or file scheme, or be an absolute path on the host.
+type HostPatchSpec struct {
+	MetaUrls   []string
+	BundleUrls []string
+	VibUrls    []string // Only used by Stage and Install
+	Spec       *types.HostPatchManagerPatchManagerOperationSpec
+}
+
+// Validate returns an error if the spec contains no URLs or a URL is not supported.
+func (s HostPatchSpec) Validate() error {
+	var urls []string
+	urls = append(urls, s.MetaUrls...)
+	urls = append(urls, s.BundleUrls...)
+	urls = append(urls, s.VibUrls...)
+
+	if len(urls) == 0 {
+		return errors.New("no metadata, bundle or VIB URL specified")
+	}
+
+	for _, s := range urls {
+		u, err := url.Parse(s)
+		if err != nil {
+			return err
+		}
+		switch u.Scheme {
+		case "http", "https":
+			if u.Host == "" {
+				return fmt.Errorf("URL %q has no host", s)
+			}
+		case "file":
+		case "":
+			if !path.IsAbs(u.Path) {
+				return fmt.Errorf("path %q is not absolute", s)
+			}
+		default:
+			return fmt.Errorf("URL %q has unsupported scheme", s)
+		}
+	}
+
+	return nil
+}
+
+// Scan scans the host for applicable updates in the given metadata and bundles.
+func (m HostPatchManager) Scan(ctx context.Context, spec HostPatchSpec) (*Task, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := types.ScanHostPatchV2_Task{
+		This:       m.Reference(),
+		MetaUrls:   spec.MetaUrls,
+		BundleUrls: spec.BundleUrls,
+		Spec:       spec.Spec,
+	}
+
+	res, err := methods.ScanHostPatchV2_Task(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.Client(), res.Returnval), nil
+}
+
+// Stage downloads the given updates to the host, without installing them.
+func (m HostPatchManager) Stage(ctx context.Context, spec HostPatchSpec) (*Task, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := types.StageHostPatch_Task{
+		This:       m.Reference(),
+		MetaUrls:   spec.MetaUrls,
+		BundleUrls: spec.BundleUrls,
+		VibUrls:    spec.VibUrls,
+		Spec:       spec.Spec,
+	}
+
+	res, err := methods.StageHostPatch_Task(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.Client(), res.Returnval), nil
+}
+
+// Install installs the given updates on the host.
+func (m HostPatchManager) Install(ctx context.Context, spec HostPatchSpec) (*Task, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := types.InstallHostPatchV2_Task{
+		This:       m.Reference(),
+		MetaUrls:   spec.MetaUrls,
+		BundleUrls: spec.BundleUrls,
+		VibUrls:    spec.VibUrls,
+		Spec:       spec.Spec,
+	}
+
+	res, err := methods.InstallHostPatchV2_Task(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.Client(), res.Returnval), nil
+}
+
+// HostPatchStatus is the typed status of a single bulletin, as reported by a HostPatchManager task result.
+type HostPatchStatus struct {
+	ID            string
+	Applicable    bool
+	Installed     bool
+	Reasons       []types.HostPatchManagerReason
+	Integrity     types.HostPatchManagerIntegrityStatus
+	InstallState  []types.HostPatchManagerInstallState
+	Prerequisites []string
+}
+
+// HostPatchResult is the typed result of a HostPatchManager task.
+type HostPatchResult struct {
+	Version   string
+	Status    []HostPatchStatus
+	XmlResult string
+}
+
+// NewHostPatchResult converts the given result to its typed form.
+func NewHostPatchResult(res types.HostPatchManagerResult) *HostPatchResult {
+	r := &HostPatchResult{
+		Version:   res.Version,
+		XmlResult: res.XmlResult,
+	}
+
+	for _, s := range res.Status {
+		status := HostPatchStatus{
+			ID:         s.Id,
+			Applicable: s.Applicable,
+			Installed:  s.Installed,
+			Integrity:  types.HostPatchManagerIntegrityStatus(s.Integrity),
+		}
+		for _, reason := range s.Reason {
+			status.Reasons = append(status.Reasons, types.HostPatchManagerReason(reason))
+		}
+		for _, state := range s.InstallState {
+			status.InstallState = append(status.InstallState, types.HostPatchManagerInstallState(state))
+		}
+		for _, p := range s.PrerequisitePatch {
+			status.Prerequisites = append(status.Prerequisites, p.Id)
+		}
+		r.Status = append(r.Status, status)
+	}
+
+	return r
+}
+
+// Applicable returns the status of bulletins applicable to the host.
+func (r *HostPatchResult) Applicable() []HostPatchStatus {
+	var status []HostPatchStatus
+	for _, s := range r.Status {
+		if s.Applicable {
+			status = append(status, s)
+		}
+	}
+	return status
+}
+
+// WaitForHostPatchResult waits for the given HostPatchManager task and returns its typed result.
+func WaitForHostPatchResult(ctx context.Context, task *Task, s ...progress.Sinker) (*HostPatchResult, error) {
+	info, err := task.WaitForResult(ctx, s...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res := info.Result.(type) {
+	case types.HostPatchManagerResult:
+		return NewHostPatchResult(res), nil
+	case *types.HostPatchManagerResult:
+		return NewHostPatchResult(*res), nil
+	case nil:
+		return &HostPatchResult{}, nil
+	default:
+		return nil, fmt.Errorf("unexpected task result type: %T", res)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostPatchSpecValidate(t *testing.T) {
+	tests := []struct {
+		spec  object.HostPatchSpec
+		valid bool
+	}{
+		{object.HostPatchSpec{}, false},
+		{object.HostPatchSpec{MetaUrls: []string{"https://depot.example.com/metadata.zip"}}, true},
+		{object.HostPatchSpec{BundleUrls: []string{"/vmfs/volumes/ds/update.zip"}}, true},
+		{object.HostPatchSpec{VibUrls: []string{"file:///tmp/driver.vib"}}, true},
+		{object.HostPatchSpec{MetaUrls: []string{"ftp://depot.example.com/metadata.zip"}}, false},
+		{object.HostPatchSpec{MetaUrls: []string{"https:///metadata.zip"}}, false},
+		{object.HostPatchSpec{BundleUrls: []string{"update.zip"}}, false},
+	}
+
+	for _, test := range tests {
+		err := test.spec.Validate()
+		if test.valid && err != nil {
+			t.Errorf("%v: %s", test.spec, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%v: expected error", test.spec)
+		}
+	}
+}
+
+func TestHostPatchManager(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		obj := simulator.Map.Any("HostSystem").(*simulator.HostSystem)
+		host := object.NewHostSystem(c, obj.Self)
+
+		m, err := host.ConfigManager().PatchManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := object.HostPatchSpec{
+			MetaUrls: []string{"https://depot.example.com/ESXi-8.0U2-22380479.zip"},
+		}
+
+		scan := func() *object.HostPatchResult {
+			task, err := m.Scan(ctx, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := object.WaitForHostPatchResult(ctx, task)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Status) != 1 {
+				t.Fatalf("status=%#v", res.Status)
+			}
+			if res.Status[0].ID != "ESXi-8.0U2-22380479" {
+				t.Errorf("id=%s", res.Status[0].ID)
+			}
+			return res
+		}
+
+		res := scan()
+		if len(res.Applicable()) != 1 || res.Status[0].Installed {
+			t.Errorf("status=%#v", res.Status[0])
+		}
+
+		for _, f := range []func(context.Context, object.HostPatchSpec) (*object.Task, error){m.Stage, m.Install} {
+			task, err := f(ctx, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = object.WaitForHostPatchResult(ctx, task); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		res = scan()
+		status := res.Status[0]
+		if len(res.Applicable()) != 0 || !status.Installed {
+			t.Errorf("status=%#v", status)
+		}
+		if len(status.InstallState) != 1 || status.InstallState[0] != types.HostPatchManagerInstallStateImageActive {
+			t.Errorf("install state=%v", status.InstallState)
+		}
+		if status.Integrity != types.HostPatchManagerIntegrityStatusValidated {
+			t.Errorf("integrity=%s", status.Integrity)
+		}
+
+		_, err = m.Install(ctx, object.HostPatchSpec{})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// HostPowerPolicy ShortName values of the policies provided by ESX.
+const (
+	PowerPolicyHighPerformance = "static"
+	PowerPolicyBalanced        = "dynamic"
+	PowerPolicyLowPower        = "low"
+	PowerPolicyCustom          = "custom"
+)
+
+// powerPolicyAliases maps the display names of the ESX policies to their ShortName.
+var powerPolicyAliases = map[string]string{
+	"high performance": PowerPolicyHighPerformance,
+	"high-performance": PowerPolicyHighPerformance,
+	"balanced":         PowerPolicyBalanced,
+	"low power":        PowerPolicyLowPower,
+	"low-power":        PowerPolicyLowPower,
+}
+
+type HostPowerSystem struct {
+	Common
+}
+
+func NewHostPowerSystem(c *vim25.Client, ref types.ManagedObjectReference) *HostPowerSystem {
+	return &HostPowerSystem{
+		Common: NewCommon(c, ref),
+	}
+}
+
+// Info returns the current power management policy of the host.
+func (s HostPowerSystem) Info(ctx context.Context) (*types.PowerSystemInfo, error) {
+	var ps mo.HostPowerSystem
+
+	err := s.Properties(ctx, s.Reference(), []string{"info"}, &ps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ps.Info, nil
+}
+
+// Capability returns the power management policies supported by the host.
+func (s HostPowerSystem) Capability(ctx context.Context) (*types.PowerSystemCapability, error) {
+	var ps mo.HostPowerSystem
+
+	err := s.Properties(ctx, s.Reference(), []string{"capability"}, &ps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ps.Capability, nil
+}
+
+// ConfigurePolicy sets the power management policy of the host to the policy with the given key.
+func (s HostPowerSystem) ConfigurePolicy(ctx context.Context, key int32) error {
+	req := types.ConfigurePowerPolicy{
+		This: s.Reference(),
+		Key:  key,
+	}
+
+	_, err := methods.ConfigurePowerPolicy(ctx, s.c, &req)
+	return err
+}
+
+// FindPolicy returns the available policy matching name, which can be a policy key, ShortName, Name
+// or one of the ESX display names, such as "High performance", "Balanced" or "Low power".
+func (s HostPowerSystem) FindPolicy(ctx context.Context, name string) (*types.HostPowerPolicy, error) {
+	capability, err := s.Capability(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	match := strings.ToLower(name)
+	if alias, ok := powerPolicyAliases[match]; ok {
+		match = alias
+	}
+
+	for i, p := range capability.AvailablePolicy {
+		if strconv.Itoa(int(p.Key)) == match || p.ShortName == match || strings.EqualFold(p.Name, name) {
+			return &capability.AvailablePolicy[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("power policy %q not available", name)
+}
+
+// SetPolicy sets the power management policy of the host to the policy matching name, as resolved by FindPolicy.
+// Settings of the "custom" policy are configured via the host's "Power." advanced options.
+func (s HostPowerSystem) SetPolicy(ctx context.Context, name string) error {
+	p, err := s.FindPolicy(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return s.ConfigurePolicy(ctx, p.Key)
+}
+
+// HostCpuPowerManagement describes the CPU power management of a host.
+type HostCpuPowerManagement struct {
+	types.HostCpuPowerManagementInfo
+
+	// PStates is true if the host supports ACPI performance states, used for frequency scaling and turbo boost.
+	PStates bool `json:"pStates"`
+	// CStates is true if the host supports ACPI idle states.
+	CStates bool `json:"cStates"`
+}
+
+func newHostCpuPowerManagement(info *types.HostCpuPowerManagementInfo) *HostCpuPowerManagement {
+	pm := new(HostCpuPowerManagement)
+	if info == nil {
+		return pm
+	}
+
+	pm.HostCpuPowerManagementInfo = *info
+	pm.PStates = strings.Contains(info.HardwareSupport, "P-states")
+	pm.CStates = strings.Contains(info.HardwareSupport, "C-states")
+
+	return pm
+}
@@ -86,3 +86,13 @@ func (s HostServiceSystem) UpdatePolicy(ctx context.Context, id string, policy s
 	_, err := methods.UpdateServicePolicy(ctx, s.Client(), &req)
 	return err
 }
+
+func (s HostServiceSystem) Uninstall(ctx context.Context, id string) error {
+	req := types.UninstallService{
+		This: s.Reference(),
+		Id:   id,
+	}
+
+	_, err := methods.UninstallService(ctx, s.Client(), &req)
+	return err
+}
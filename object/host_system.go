@@ -95,6 +95,23 @@ func (h HostSystem) ManagementIPs(ctx context.Context) ([]net.IP, error) {
 	return internal.HostSystemManagementIPs(info.NetConfig), nil
 }
 
+// CpuPowerManagement returns the current CPU power management policy and the
+// power states supported by the host hardware.
+func (h HostSystem) CpuPowerManagement(ctx context.Context) (*HostCpuPowerManagement, error) {
+	var mh mo.HostSystem
+
+	err := h.Properties(ctx, h.Reference(), []string{"hardware.cpuPowerManagementInfo"}, &mh)
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.Hardware == nil {
+		return newHostCpuPowerManagement(nil), nil
+	}
+
+	return newHostCpuPowerManagement(mh.Hardware.CpuPowerManagementInfo), nil
+}
+
 func (h HostSystem) Disconnect(ctx context.Context) (*Task, error) {
 	req := types.DisconnectHost_Task{
 		This: h.Reference(),
@@ -152,3 +169,127 @@ func (h HostSystem) ExitMaintenanceMode(ctx context.Context, timeout int32) (*Ta
 
 	return NewTask(h.c, res.Returnval), nil
 }
+
+// QueryMemoryOverheadEx returns the estimated memory overhead in bytes of a VM with the given config on this host.
+func (h HostSystem) QueryMemoryOverheadEx(ctx context.Context, info types.VirtualMachineConfigInfo) (int64, error) {
+	req := types.QueryMemoryOverheadEx{
+		This:         h.Reference(),
+		VmConfigInfo: info,
+	}
+
+	res, err := methods.QueryMemoryOverheadEx(ctx, h.c, &req)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Returnval, nil
+}
+
+// MemoryOverhead returns the estimated memory overhead in bytes of a VM created with the given spec on this host.
+func (h HostSystem) MemoryOverhead(ctx context.Context, spec types.VirtualMachineConfigSpec) (int64, error) {
+	info := types.VirtualMachineConfigInfo{
+		Name:    spec.Name,
+		GuestId: spec.GuestId,
+		Version: spec.Version,
+		Hardware: types.VirtualHardware{
+			NumCPU:            spec.NumCPUs,
+			NumCoresPerSocket: spec.NumCoresPerSocket,
+			MemoryMB:          int32(spec.MemoryMB),
+		},
+		CpuAllocation:    spec.CpuAllocation,
+		MemoryAllocation: spec.MemoryAllocation,
+	}
+
+	for _, change := range spec.DeviceChange {
+		dspec := change.GetVirtualDeviceConfigSpec()
+		if dspec.Operation == types.VirtualDeviceConfigSpecOperationAdd {
+			info.Hardware.Device = append(info.Hardware.Device, dspec.Device)
+		}
+	}
+
+	return h.QueryMemoryOverheadEx(ctx, info)
+}
+
+// AdmissionEstimate is the result of HostSystem.EstimateAdmission.
+type AdmissionEstimate struct {
+	// Host is the host the estimate applies to.
+	Host types.ManagedObjectReference
+	// MemoryOverhead is the estimated memory overhead of the VM in bytes.
+	MemoryOverhead int64
+	// MemoryRequested is the configured memory of the VM plus MemoryOverhead, in bytes.
+	MemoryRequested int64
+	// MemoryAvailable is the memory capacity of the host less its current usage, in bytes.
+	MemoryAvailable int64
+	// CpuRequested is the CPU reservation of the VM in MHz, if any.
+	CpuRequested int64
+	// CpuAvailable is the CPU capacity of the host less its current usage, in MHz.
+	CpuAvailable int64
+	// Fault is the reason the VM is not expected to be admitted by the host, nil otherwise.
+	Fault types.BaseMethodFault
+}
+
+// Admitted returns true if the VM is expected to be admitted by the host.
+func (e *AdmissionEstimate) Admitted() bool {
+	return e.Fault == nil
+}
+
+// EstimateAdmission estimates if a VM created with the given spec can be powered on by this host,
+// based on the host's state, CPU count, current resource usage and the VM's memory overhead.
+// The estimate does not account for resource pool reservations or limits, nor HA admission control.
+func (h HostSystem) EstimateAdmission(ctx context.Context, spec types.VirtualMachineConfigSpec) (*AdmissionEstimate, error) {
+	var mh mo.HostSystem
+
+	err := h.Properties(ctx, h.Reference(), []string{"runtime", "summary.hardware", "summary.quickStats"}, &mh)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &AdmissionEstimate{Host: h.Reference()}
+
+	if mh.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+		e.Fault = &types.HostNotConnected{}
+		return e, nil
+	}
+	if mh.Runtime.InMaintenanceMode {
+		e.Fault = &types.InvalidHostState{Host: &e.Host}
+		return e, nil
+	}
+
+	hw := mh.Summary.Hardware
+	stats := mh.Summary.QuickStats
+
+	if hw != nil {
+		e.MemoryAvailable = hw.MemorySize - int64(stats.OverallMemoryUsage)*1024*1024
+		e.CpuAvailable = int64(hw.CpuMhz)*int64(hw.NumCpuCores) - int64(stats.OverallCpuUsage)
+
+		if spec.NumCPUs > int32(hw.NumCpuThreads) {
+			e.Fault = &types.NotEnoughCpus{NumCpuDest: int32(hw.NumCpuThreads), NumCpuVm: spec.NumCPUs}
+			return e, nil
+		}
+	}
+
+	e.MemoryOverhead, err = h.MemoryOverhead(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	e.MemoryRequested = spec.MemoryMB*1024*1024 + e.MemoryOverhead
+	if spec.CpuAllocation != nil && spec.CpuAllocation.Reservation != nil {
+		e.CpuRequested = *spec.CpuAllocation.Reservation
+	}
+
+	switch {
+	case e.MemoryRequested > e.MemoryAvailable:
+		e.Fault = &types.InsufficientMemoryResourcesFault{
+			Unreserved: e.MemoryAvailable,
+			Requested:  e.MemoryRequested,
+		}
+	case e.CpuRequested > e.CpuAvailable:
+		e.Fault = &types.InsufficientCpuResourcesFault{
+			Unreserved: e.CpuAvailable,
+			Requested:  e.CpuRequested,
+		}
+	}
+
+	return e, nil
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/pem"
+	"reflect"
 	"testing"
 
 	"github.com/vmware/govmomi/find"
@@ -27,6 +28,7 @@ import (
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func TestHostSystemManagementIPs(t *testing.T) {
@@ -89,3 +91,92 @@ func TestHostSystemConfig(t *testing.T) {
 		}
 	})
 }
+
+func TestHostSystemEstimateAdmission(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		host, err := finder.HostSystem(ctx, "DC0_C0_H0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := types.VirtualMachineConfigSpec{
+			NumCPUs:  1,
+			MemoryMB: 1024,
+			DeviceChange: []types.BaseVirtualDeviceConfigSpec{
+				&types.VirtualDeviceConfigSpec{
+					Operation: types.VirtualDeviceConfigSpecOperationAdd,
+					Device:    &types.VirtualMachineVideoCard{VideoRamSizeInKB: 4096},
+				},
+			},
+		}
+
+		overhead, err := host.MemoryOverhead(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if overhead != 30*1024*1024 {
+			t.Errorf("overhead=%d", overhead)
+		}
+
+		if _, err = host.MemoryOverhead(ctx, types.VirtualMachineConfigSpec{}); err == nil {
+			t.Error("expected error")
+		}
+
+		e, err := host.EstimateAdmission(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !e.Admitted() || e.MemoryRequested != 1024*1024*1024+overhead {
+			t.Errorf("estimate=%#v", e)
+		}
+
+		reservation := int64(1 << 20)
+		tests := []struct {
+			spec  types.VirtualMachineConfigSpec
+			fault types.BaseMethodFault
+		}{
+			{types.VirtualMachineConfigSpec{NumCPUs: 16, MemoryMB: 1024}, &types.NotEnoughCpus{}},
+			{types.VirtualMachineConfigSpec{NumCPUs: 1, MemoryMB: 64 * 1024}, &types.InsufficientMemoryResourcesFault{}},
+			{types.VirtualMachineConfigSpec{NumCPUs: 1, MemoryMB: 1024, CpuAllocation: &types.ResourceAllocationInfo{Reservation: &reservation}}, &types.InsufficientCpuResourcesFault{}},
+		}
+
+		for _, test := range tests {
+			e, err := host.EstimateAdmission(ctx, test.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reflect.TypeOf(e.Fault) != reflect.TypeOf(test.fault) {
+				t.Errorf("fault=%T, expected %T", e.Fault, test.fault)
+			}
+		}
+
+		task, err := host.EnterMaintenanceMode(ctx, 0, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		cluster, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := cluster.EstimateAdmission(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res) < 2 {
+			t.Fatalf("res=%d", len(res))
+		}
+		for _, e := range res {
+			_, maintenance := e.Fault.(*types.InvalidHostState)
+			if (e.Host == host.Reference()) != maintenance {
+				t.Errorf("%s fault=%#v", e.Host, e.Fault)
+			}
+		}
+	})
+}
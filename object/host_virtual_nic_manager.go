@@ -18,6 +18,8 @@ package object
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -91,3 +93,123 @@ func (m HostVirtualNicManager) SelectVnic(ctx context.Context, nicType string, d
 	_, err := methods.SelectVnicForNicType(ctx, m.Client(), &req)
 	return err
 }
+
+// QueryNetConfig returns the candidate and selected VMkernel NICs for the given service nicType.
+func (m HostVirtualNicManager) QueryNetConfig(ctx context.Context, nicType string) (*types.VirtualNicManagerNetConfig, error) {
+	req := types.QueryNetConfig{
+		This:    m.Reference(),
+		NicType: nicType,
+	}
+
+	res, err := methods.QueryNetConfig(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// selectedVnicDevices returns the devices of the VMkernel NICs selected in the given config.
+func selectedVnicDevices(config *types.VirtualNicManagerNetConfig) []string {
+	var devices []string
+
+	for _, key := range config.SelectedVnic {
+		for _, vnic := range config.CandidateVnic {
+			if vnic.Key == key {
+				devices = append(devices, vnic.Device)
+				break
+			}
+		}
+	}
+
+	return devices
+}
+
+// SelectedVnics returns the devices of the VMkernel NICs selected for the given service nicType,
+// such as which vmk is used for vMotion.
+func (m HostVirtualNicManager) SelectedVnics(ctx context.Context, nicType string) ([]string, error) {
+	config, err := m.QueryNetConfig(ctx, nicType)
+	if err != nil {
+		return nil, err
+	}
+
+	return selectedVnicDevices(config), nil
+}
+
+// SelectedVnicsByType returns the devices of the VMkernel NICs selected for each service nicType
+// supported by the host. Services without a selection map to an empty list.
+func (m HostVirtualNicManager) SelectedVnicsByType(ctx context.Context) (map[string][]string, error) {
+	info, err := m.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string][]string, len(info.NetConfig))
+
+	for i := range info.NetConfig {
+		selected[info.NetConfig[i].NicType] = selectedVnicDevices(&info.NetConfig[i])
+	}
+
+	return selected, nil
+}
+
+// SetSelectedVnics changes the VMkernel NICs selected for the given service nicType to the given devices.
+// Each device must be a candidate for nicType, multiple devices must be allowed by nicType
+// and at least one device must remain selected for management.
+// Devices are selected before others are deselected, such that the service is not interrupted.
+func (m HostVirtualNicManager) SetSelectedVnics(ctx context.Context, nicType string, devices ...string) error {
+	config, err := m.QueryNetConfig(ctx, nicType)
+	if err != nil {
+		return err
+	}
+
+	if len(devices) > 1 && !config.MultiSelectAllowed {
+		return fmt.Errorf("%s does not allow multiple vnics to be selected", nicType)
+	}
+
+	if len(devices) == 0 && nicType == string(types.HostVirtualNicManagerNicTypeManagement) {
+		return fmt.Errorf("%s requires at least one vnic to be selected", nicType)
+	}
+
+	for _, device := range devices {
+		candidate := false
+		for _, vnic := range config.CandidateVnic {
+			if vnic.Device == device {
+				candidate = true
+				break
+			}
+		}
+		if !candidate {
+			return fmt.Errorf("%s is not a candidate vnic for %s", device, nicType)
+		}
+	}
+
+	current := selectedVnicDevices(config)
+
+	for _, device := range devices {
+		if slices.Contains(current, device) {
+			continue
+		}
+		if err = m.SelectVnic(ctx, nicType, device); err != nil {
+			return err
+		}
+	}
+
+	if !config.MultiSelectAllowed {
+		// selecting a vnic replaces the previous selection
+		if current, err = m.SelectedVnics(ctx, nicType); err != nil {
+			return err
+		}
+	}
+
+	for _, device := range current {
+		if slices.Contains(devices, device) {
+			continue
+		}
+		if err = m.DeselectVnic(ctx, nicType, device); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
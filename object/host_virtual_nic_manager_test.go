@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostVirtualNicManagerSelectedVnics(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		host, err := find.NewFinder(c).HostSystem(ctx, "DC0_C0_H0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m, err := host.ConfigManager().VirtualNicManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vmotion := string(types.HostVirtualNicManagerNicTypeVmotion)
+		management := string(types.HostVirtualNicManagerNicTypeManagement)
+		vsan := string(types.HostVirtualNicManagerNicTypeVsan)
+
+		selected, err := m.SelectedVnicsByType(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(selected[management], []string{"vmk0"}) {
+			t.Errorf("management=%v", selected[management])
+		}
+		if devices, ok := selected[vmotion]; !ok || len(devices) != 0 {
+			t.Errorf("vmotion=%v", devices)
+		}
+
+		if err = m.SetSelectedVnics(ctx, vmotion, "vmk0"); err != nil {
+			t.Fatal(err)
+		}
+
+		devices, err := m.SelectedVnics(ctx, vmotion)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(devices, []string{"vmk0"}) {
+			t.Errorf("vmotion=%v", devices)
+		}
+
+		if err = m.SetSelectedVnics(ctx, vmotion); err != nil {
+			t.Fatal(err)
+		}
+
+		devices, err = m.SelectedVnics(ctx, vmotion)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(devices) != 0 {
+			t.Errorf("vmotion=%v", devices)
+		}
+
+		// vsan is selected via HostVsanSystem
+		if err = m.SetSelectedVnics(ctx, vsan, "vmk0"); err != nil {
+			t.Fatal(err)
+		}
+
+		devices, err = m.SelectedVnics(ctx, vsan)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(devices, []string{"vmk0"}) {
+			t.Errorf("vsan=%v", devices)
+		}
+
+		tests := []struct {
+			nicType string
+			devices []string
+		}{
+			{management, nil},
+			{vmotion, []string{"vmk9"}},
+			{"enoent", []string{"vmk0"}},
+		}
+
+		for _, test := range tests {
+			if err = m.SetSelectedVnics(ctx, test.nicType, test.devices...); err == nil {
+				t.Errorf("expected error for %s=%v", test.nicType, test.devices)
+			}
+		}
+	})
+}
@@ -86,3 +86,19 @@ func (s HostVsanSystem) updateVnic(ctx context.Context, device string, enable bo
 	_, err = task.WaitForResult(ctx, nil)
 	return err
 }
+
+// QueryDisksForVsan returns the eligibility of the given disks for use by vSAN.
+// If no disks are specified, all disks on the host are queried.
+func (s HostVsanSystem) QueryDisksForVsan(ctx context.Context, canonicalName ...string) ([]types.VsanHostDiskResult, error) {
+	req := types.QueryDisksForVsan{
+		This:          s.Reference(),
+		CanonicalName: canonicalName,
+	}
+
+	res, err := methods.QueryDisksForVsan(ctx, s.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// IoFilterManager manages the IO filters installed on a compute resource, such as a cluster.
+type IoFilterManager struct {
+	Common
+}
+
+// GetIoFilterManager wraps NewIoFilterManager, returning ErrNotSupported
+// when the client is not connected to a vCenter instance.
+func GetIoFilterManager(c *vim25.Client) (*IoFilterManager, error) {
+	if c.ServiceContent.IoFilterManager == nil {
+		return nil, ErrNotSupported
+	}
+	return NewIoFilterManager(c), nil
+}
+
+func NewIoFilterManager(c *vim25.Client) *IoFilterManager {
+	m := IoFilterManager{
+		Common: NewCommon(c, *c.ServiceContent.IoFilterManager),
+	}
+
+	return &m
+}
+
+// Install installs the IO filter bundle at the given VIB URL on the compute resource.
+func (m IoFilterManager) Install(ctx context.Context, url string, compRes mo.Reference) (*Task, error) {
+	req := types.InstallIoFilter_Task{
+		This:    m.Reference(),
+		VibUrl:  url,
+		CompRes: compRes.Reference(),
+	}
+
+	res, err := methods.InstallIoFilter_Task(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.c, res.Returnval), nil
+}
+
+// Upgrade upgrades the IO filter with the given ID to the bundle at the given VIB URL.
+func (m IoFilterManager) Upgrade(ctx context.Context, id string, url string, compRes mo.Reference) (*Task, error) {
+	req := types.UpgradeIoFilter_Task{
+		This:     m.Reference(),
+		FilterId: id,
+		CompRes:  compRes.Reference(),
+		VibUrl:   url,
+	}
+
+	res, err := methods.UpgradeIoFilter_Task(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.c, res.Returnval), nil
+}
+
+// Uninstall uninstalls the IO filter with the given ID from the compute resource.
+func (m IoFilterManager) Uninstall(ctx context.Context, id string, compRes mo.Reference) (*Task, error) {
+	req := types.UninstallIoFilter_Task{
+		This:     m.Reference(),
+		FilterId: id,
+		CompRes:  compRes.Reference(),
+	}
+
+	res, err := methods.UninstallIoFilter_Task(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.c, res.Returnval), nil
+}
+
+// ResolveInstallationErrors resolves the errors of the last install, upgrade or uninstall
+// of the IO filter with the given ID on the cluster.
+func (m IoFilterManager) ResolveInstallationErrors(ctx context.Context, id string, cluster mo.Reference) (*Task, error) {
+	req := types.ResolveInstallationErrorsOnCluster_Task{
+		This:     m.Reference(),
+		FilterId: id,
+		Cluster:  cluster.Reference(),
+	}
+
+	res, err := methods.ResolveInstallationErrorsOnCluster_Task(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.c, res.Returnval), nil
+}
+
+// Info returns the IO filters installed on the compute resource.
+func (m IoFilterManager) Info(ctx context.Context, compRes mo.Reference) ([]types.ClusterIoFilterInfo, error) {
+	req := types.QueryIoFilterInfo{
+		This:    m.Reference(),
+		CompRes: compRes.Reference(),
+	}
+
+	res, err := methods.QueryIoFilterInfo(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// Issues returns the issues of the last operation on the IO filter with the given ID.
+func (m IoFilterManager) Issues(ctx context.Context, id string, compRes mo.Reference) (*types.IoFilterQueryIssueResult, error) {
+	req := types.QueryIoFilterIssues{
+		This:     m.Reference(),
+		FilterId: id,
+		CompRes:  compRes.Reference(),
+	}
+
+	res, err := methods.QueryIoFilterIssues(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
+// Disks returns the virtual disks using the IO filter with the given ID.
+func (m IoFilterManager) Disks(ctx context.Context, id string, compRes mo.Reference) ([]types.VirtualDiskId, error) {
+	req := types.QueryDisksUsingFilter{
+		This:     m.Reference(),
+		FilterId: id,
+		CompRes:  compRes.Reference(),
+	}
+
+	res, err := methods.QueryDisksUsingFilter(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// VirtualMachines returns the VMs with one or more virtual disks using the IO filter with the given ID.
+func (m IoFilterManager) VirtualMachines(ctx context.Context, id string, compRes mo.Reference) ([]*VirtualMachine, error) {
+	disks, err := m.Disks(ctx, id, compRes)
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*VirtualMachine
+	seen := make(map[types.ManagedObjectReference]bool)
+
+	for _, disk := range disks {
+		if seen[disk.Vm] {
+			continue
+		}
+		seen[disk.Vm] = true
+		vms = append(vms, NewVirtualMachine(m.c, disk.Vm))
+	}
+
+	return vms, nil
+}
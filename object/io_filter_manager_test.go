@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestIoFilterManager(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		const id = "vmwarevmcrypt"
+
+		finder := find.NewFinder(c)
+		cluster, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m, err := object.GetIoFilterManager(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wait := func(task *object.Task, err error) error {
+			if err != nil {
+				return err
+			}
+			return task.Wait(ctx)
+		}
+
+		setFilter := func(filters ...string) {
+			devices, err := vm.Device(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			disk := devices.SelectByType((*types.VirtualDisk)(nil))[0].(*types.VirtualDisk)
+			disk.Iofilter = filters
+			if err = vm.EditDevice(ctx, disk); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err = wait(m.Install(ctx, "https://example.com/bundles/"+id+".zip", cluster)); err != nil {
+			t.Fatal(err)
+		}
+
+		err = wait(m.Install(ctx, "https://example.com/bundles/"+id+".zip", cluster))
+		if !fault.Is(err, &types.AlreadyExists{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		info, err := m.Info(ctx, cluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(info) != 1 || info[0].Id != id || info[0].OpType != string(types.IoFilterOperationInstall) {
+			t.Errorf("info=%#v", info)
+		}
+
+		setFilter(id)
+
+		vms, err := m.VirtualMachines(ctx, id, cluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(vms) != 1 || vms[0].Reference() != vm.Reference() {
+			t.Errorf("vms=%v", vms)
+		}
+
+		err = wait(m.Uninstall(ctx, id, cluster))
+		if !fault.Is(err, &types.ResourceInUse{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		if err = wait(m.Upgrade(ctx, id, "https://example.com/bundles/"+id+"-2.zip", cluster)); err != nil {
+			t.Fatal(err)
+		}
+
+		issues, err := m.Issues(ctx, id, cluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if issues.OpType != string(types.IoFilterOperationUpgrade) {
+			t.Errorf("issues=%#v", issues)
+		}
+
+		if err = wait(m.ResolveInstallationErrors(ctx, id, cluster)); err != nil {
+			t.Fatal(err)
+		}
+
+		setFilter()
+
+		disks, err := m.Disks(ctx, id, cluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(disks) != 0 {
+			t.Errorf("disks=%v", disks)
+		}
+
+		if err = wait(m.Uninstall(ctx, id, cluster)); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = m.Disks(ctx, id, cluster)
+		if !fault.Is(err, &types.NotFound{}) {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// IpPoolManager manages the network protocol profiles (IP pools) of a datacenter,
+// used by vApps and OVF deployments with the transient or fixedAllocated IP allocation policy.
+type IpPoolManager struct {
+	Common
+}
+
+func NewIpPoolManager(c *vim25.Client) *IpPoolManager {
+	return &IpPoolManager{
+		Common: NewCommon(c, *c.ServiceContent.IpPoolManager),
+	}
+}
+
+// NewIpPoolConfig returns an IP pool config for the given subnet in CIDR notation, such as "10.0.0.0/24" or "2001:db8::/64".
+// Each range is specified as a start address, a hash (#) and the length of the range, such as "10.0.0.10#20".
+// The pool is enabled if any range is specified.
+func NewIpPoolConfig(cidr string, gateway string, ranges ...string) (*types.IpPoolIpPoolConfigInfo, error) {
+	ip, subnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	v4 := ip.To4() != nil
+
+	if gateway != "" {
+		gw := net.ParseIP(gateway)
+		if gw == nil || (gw.To4() != nil) != v4 || !subnet.Contains(gw) {
+			return nil, fmt.Errorf("gateway %q is not in subnet %s", gateway, subnet)
+		}
+	}
+
+	for _, r := range ranges {
+		start, length, ok := strings.Cut(r, "#")
+		ip := net.ParseIP(strings.TrimSpace(start))
+		if !ok || ip == nil || (ip.To4() != nil) != v4 || !subnet.Contains(ip) {
+			return nil, fmt.Errorf("range %q is not in subnet %s", r, subnet)
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(length)); err != nil || n <= 0 {
+			return nil, fmt.Errorf("range %q has invalid length", r)
+		}
+	}
+
+	return &types.IpPoolIpPoolConfigInfo{
+		SubnetAddress: subnet.IP.String(),
+		Netmask:       net.IP(subnet.Mask).String(),
+		Gateway:       gateway,
+		Range:         strings.Join(ranges, ","),
+		IpPoolEnabled: types.NewBool(len(ranges) != 0),
+	}, nil
+}
+
+func (m IpPoolManager) CreateIpPool(ctx context.Context, dc *Datacenter, pool types.IpPool) (int32, error) {
+	req := types.CreateIpPool{
+		This: m.Reference(),
+		Dc:   dc.Reference(),
+		Pool: pool,
+	}
+
+	res, err := methods.CreateIpPool(ctx, m.c, &req)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Returnval, nil
+}
+
+func (m IpPoolManager) UpdateIpPool(ctx context.Context, dc *Datacenter, pool types.IpPool) error {
+	req := types.UpdateIpPool{
+		This: m.Reference(),
+		Dc:   dc.Reference(),
+		Pool: pool,
+	}
+
+	_, err := methods.UpdateIpPool(ctx, m.c, &req)
+	return err
+}
+
+func (m IpPoolManager) DestroyIpPool(ctx context.Context, dc *Datacenter, id int32, force bool) error {
+	req := types.DestroyIpPool{
+		This:  m.Reference(),
+		Dc:    dc.Reference(),
+		Id:    id,
+		Force: force,
+	}
+
+	_, err := methods.DestroyIpPool(ctx, m.c, &req)
+	return err
+}
+
+func (m IpPoolManager) QueryIpPools(ctx context.Context, dc *Datacenter) ([]types.IpPool, error) {
+	req := types.QueryIpPools{
+		This: m.Reference(),
+		Dc:   dc.Reference(),
+	}
+
+	res, err := methods.QueryIpPools(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// FindIpPool returns the pool with the given name or ID.
+func (m IpPoolManager) FindIpPool(ctx context.Context, dc *Datacenter, name string) (*types.IpPool, error) {
+	pools, err := m.QueryIpPools(ctx, dc)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pools {
+		if pools[i].Name == name || strconv.Itoa(int(pools[i].Id)) == name {
+			return &pools[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("ip pool %q not found", name)
+}
+
+// AssociateNetworks adds the given networks to the pool's network associations,
+// making the pool available to vApps and VMs connected to these networks.
+// Networks already associated with the pool are ignored.
+func (m IpPoolManager) AssociateNetworks(ctx context.Context, dc *Datacenter, pool *types.IpPool, networks ...types.ManagedObjectReference) error {
+	associated := make(map[types.ManagedObjectReference]bool)
+	for _, a := range pool.NetworkAssociation {
+		if a.Network != nil {
+			associated[*a.Network] = true
+		}
+	}
+
+	update := false
+	for i := range networks {
+		if associated[networks[i]] {
+			continue
+		}
+		associated[networks[i]] = true
+		pool.NetworkAssociation = append(pool.NetworkAssociation, types.IpPoolAssociation{Network: &networks[i]})
+		update = true
+	}
+
+	if !update {
+		return nil
+	}
+
+	// Only the configuration is updated, allocation counters are read-only.
+	spec := types.IpPool{
+		Id:                 pool.Id,
+		Name:               pool.Name,
+		Ipv4Config:         pool.Ipv4Config,
+		Ipv6Config:         pool.Ipv6Config,
+		DnsDomain:          pool.DnsDomain,
+		DnsSearchPath:      pool.DnsSearchPath,
+		HostPrefix:         pool.HostPrefix,
+		HttpProxy:          pool.HttpProxy,
+		NetworkAssociation: pool.NetworkAssociation,
+	}
+
+	return m.UpdateIpPool(ctx, dc, spec)
+}
+
+func (m IpPoolManager) AllocateIpv4Address(ctx context.Context, dc *Datacenter, poolID int32, allocationID string) (string, error) {
+	req := types.AllocateIpv4Address{
+		This:         m.Reference(),
+		Dc:           dc.Reference(),
+		PoolId:       poolID,
+		AllocationId: allocationID,
+	}
+
+	res, err := methods.AllocateIpv4Address(ctx, m.c, &req)
+	if err != nil {
+		return "", err
+	}
+
+	return res.Returnval, nil
+}
+
+func (m IpPoolManager) AllocateIpv6Address(ctx context.Context, dc *Datacenter, poolID int32, allocationID string) (string, error) {
+	req := types.AllocateIpv6Address{
+		This:         m.Reference(),
+		Dc:           dc.Reference(),
+		PoolId:       poolID,
+		AllocationId: allocationID,
+	}
+
+	res, err := methods.AllocateIpv6Address(ctx, m.c, &req)
+	if err != nil {
+		return "", err
+	}
+
+	return res.Returnval, nil
+}
+
+func (m IpPoolManager) ReleaseIpAllocation(ctx context.Context, dc *Datacenter, poolID int32, allocationID string) error {
+	req := types.ReleaseIpAllocation{
+		This:         m.Reference(),
+		Dc:           dc.Reference(),
+		PoolId:       poolID,
+		AllocationId: allocationID,
+	}
+
+	_, err := methods.ReleaseIpAllocation(ctx, m.c, &req)
+	return err
+}
+
+func (m IpPoolManager) QueryIPAllocations(ctx context.Context, dc *Datacenter, poolID int32, extensionKey string) ([]types.IpPoolManagerIpAllocation, error) {
+	req := types.QueryIPAllocations{
+		This:         m.Reference(),
+		Dc:           dc.Reference(),
+		PoolId:       poolID,
+		ExtensionKey: extensionKey,
+	}
+
+	res, err := methods.QueryIPAllocations(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestNewIpPoolConfig(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		gateway string
+		ranges  []string
+		valid   bool
+	}{
+		{"10.0.0.0/24", "10.0.0.1", []string{"10.0.0.10#20"}, true},
+		{"10.0.0.0/24", "", nil, true},
+		{"2001:db8::/64", "2001:db8::1", []string{"2001:db8::10#20"}, true},
+		{"10.0.0.0", "", nil, false},
+		{"10.0.0.0/24", "10.0.1.1", nil, false},
+		{"10.0.0.0/24", "10.0.0.1", []string{"10.0.1.10#20"}, false},
+		{"10.0.0.0/24", "10.0.0.1", []string{"10.0.0.10"}, false},
+		{"10.0.0.0/24", "10.0.0.1", []string{"2001:db8::10#20"}, false},
+	}
+
+	for _, test := range tests {
+		_, err := object.NewIpPoolConfig(test.cidr, test.gateway, test.ranges...)
+		if test.valid && err != nil {
+			t.Errorf("%s: %s", test.cidr, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%s %s %s: expected error", test.cidr, test.gateway, test.ranges)
+		}
+	}
+
+	config, err := object.NewIpPoolConfig("192.168.5.17/24", "192.168.5.1", "192.168.5.100#10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.SubnetAddress != "192.168.5.0" || config.Netmask != "255.255.255.0" {
+		t.Errorf("config=%#v", config)
+	}
+	if config.IpPoolEnabled == nil || !*config.IpPoolEnabled {
+		t.Error("expected pool to be enabled")
+	}
+}
+
+func TestIpPoolManager(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		network, err := finder.Network(ctx, "VM Network")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := object.NewIpPoolManager(c)
+
+		config, err := object.NewIpPoolConfig("10.20.0.0/24", "10.20.0.1", "10.20.0.10#5")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		id, err := m.CreateIpPool(ctx, dc, types.IpPool{Name: "govmomi-test", Ipv4Config: config})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pool, err := m.FindIpPool(ctx, dc, "govmomi-test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pool.Id != id || pool.AvailableIpv4Addresses != 5 {
+			t.Errorf("pool=%#v", pool)
+		}
+
+		if _, err = m.FindIpPool(ctx, dc, "enoent"); err == nil {
+			t.Error("expected error")
+		}
+
+		for i := 0; i < 2; i++ { // associating twice is a no-op
+			if err = m.AssociateNetworks(ctx, dc, pool, network.Reference()); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		pool, err = m.FindIpPool(ctx, dc, "govmomi-test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(pool.NetworkAssociation) != 1 || *pool.NetworkAssociation[0].Network != network.Reference() {
+			t.Errorf("associations=%#v", pool.NetworkAssociation)
+		}
+
+		ip, err := m.AllocateIpv4Address(ctx, dc, id, "govmomi-test")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		allocations, err := m.QueryIPAllocations(ctx, dc, id, "govmomi-test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(allocations) != 1 || allocations[0].IpAddress != ip {
+			t.Errorf("allocations=%#v", allocations)
+		}
+
+		if err = m.ReleaseIpAllocation(ctx, dc, id, "govmomi-test"); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = m.DestroyIpPool(ctx, dc, id, false); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = m.FindIpPool(ctx, dc, "govmomi-test"); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"slices"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// GetProperties retrieves the given properties of the object referenced by ref, or all properties if none are given.
+// T must be the mo struct type of the object, for example:
+//
+//	vm, err := object.GetProperties[mo.VirtualMachine](ctx, c, ref, "config.hardware", "runtime")
+func GetProperties[T mo.Reference](ctx context.Context, c *vim25.Client, ref types.ManagedObjectReference, props ...string) (T, error) {
+	var o T
+
+	err := property.DefaultCollector(c).RetrieveOne(ctx, ref, props, &o)
+
+	return o, err
+}
+
+// GetPropertiesBulk retrieves the given properties of the objects referenced by refs, or all properties if none are given,
+// using a single PropertyCollector request. The results are in the same order as refs and
+// T must be the mo struct type of the objects, for example:
+//
+//	vms, err := object.GetPropertiesBulk[mo.VirtualMachine](ctx, c, refs, "name", "runtime.powerState")
+func GetPropertiesBulk[T mo.Reference](ctx context.Context, c *vim25.Client, refs []types.ManagedObjectReference, props ...string) ([]T, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	var objs []T
+
+	err := property.DefaultCollector(c).Retrieve(ctx, refs, props, &objs)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(objs, func(a, b T) int {
+		return slices.Index(refs, a.Reference()) - slices.Index(refs, b.Reference())
+	})
+
+	return objs, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestGetProperties(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		var refs []types.ManagedObjectReference
+		for _, obj := range simulator.Map.All("VirtualMachine") {
+			refs = append(refs, obj.Reference())
+		}
+		slices.Reverse(refs)
+
+		vm, err := object.GetProperties[mo.VirtualMachine](ctx, c, refs[0], "name", "runtime.powerState")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if vm.Self != refs[0] || vm.Name == "" || vm.Runtime.PowerState == "" {
+			t.Errorf("vm=%#v", vm)
+		}
+		if vm.Config != nil {
+			t.Error("config was not requested")
+		}
+
+		vm, err = object.GetProperties[mo.VirtualMachine](ctx, c, refs[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if vm.Config == nil {
+			t.Error("expected all properties")
+		}
+
+		host, err := object.GetProperties[mo.HostSystem](ctx, c, *vm.Runtime.Host, "name")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if host.Name == "" {
+			t.Errorf("host=%#v", host)
+		}
+
+		vms, err := object.GetPropertiesBulk[mo.VirtualMachine](ctx, c, refs, "name")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(vms) != len(refs) {
+			t.Fatalf("vms=%d", len(vms))
+		}
+		for i := range vms {
+			if vms[i].Self != refs[i] {
+				t.Errorf("vms[%d]=%s, expected %s", i, vms[i].Self, refs[i])
+			}
+		}
+
+		vms, err = object.GetPropertiesBulk[mo.VirtualMachine](ctx, c, nil)
+		if err != nil || vms != nil {
+			t.Errorf("vms=%v, err=%v", vms, err)
+		}
+
+		_, err = object.GetProperties[mo.VirtualMachine](ctx, c, types.ManagedObjectReference{Type: "VirtualMachine", Value: "enoent"})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// ErrNameCollision is wrapped by the RenameResult.Err of an object whose new name is already used
+// by another object in the same folder, or is the new name of another object in the same folder.
+var ErrNameCollision = errors.New("name collision")
+
+// RenameFunc returns the new name of the object with the given name,
+// where index is the position of the object in the selection passed to Rename.
+type RenameFunc func(index int, name string) (string, error)
+
+// RenamePrefix returns a RenameFunc that replaces the given prefix with replacement.
+// Names that do not start with prefix are unchanged.
+func RenamePrefix(prefix, replacement string) RenameFunc {
+	return func(_ int, name string) (string, error) {
+		if s, ok := strings.CutPrefix(name, prefix); ok {
+			return replacement + s, nil
+		}
+		return name, nil
+	}
+}
+
+// RenameTemplate returns a RenameFunc that executes the given text/template.
+// The template data fields are Name, the current name of the object, Index, the 0-based position
+// of the object in the selection and Seq, the 1-based position. The functions lower, upper, replace,
+// trimPrefix and trimSuffix are wrappers for the strings package equivalents. For example:
+//
+//	object.RenameTemplate(`{{trimPrefix .Name "tmp-"}}-{{printf "%03d" .Seq}}`)
+func RenameTemplate(text string) (RenameFunc, error) {
+	funcs := template.FuncMap{
+		"lower":      strings.ToLower,
+		"upper":      strings.ToUpper,
+		"replace":    strings.ReplaceAll,
+		"trimPrefix": strings.TrimPrefix,
+		"trimSuffix": strings.TrimSuffix,
+	}
+
+	tmpl, err := template.New("rename").Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(index int, name string) (string, error) {
+		var buf bytes.Buffer
+
+		data := struct {
+			Name       string
+			Index, Seq int
+		}{name, index, index + 1}
+
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return "", err
+		}
+
+		return buf.String(), nil
+	}, nil
+}
+
+// RenameResult is the result of renaming an object via Rename.
+type RenameResult struct {
+	Object  types.ManagedObjectReference `json:"object"`
+	OldName string                       `json:"oldName"`
+	NewName string                       `json:"newName"`
+	// Renamed is true if the object was renamed, false if the name is unchanged,
+	// in dry-run mode or if Err is set.
+	Renamed bool `json:"renamed"`
+	// Collision is the object already using NewName, or also being renamed to NewName, if any.
+	Collision *types.ManagedObjectReference `json:"collision,omitempty"`
+	Err       error                         `json:"-"`
+}
+
+// Rename renames each of the given objects to the name returned by fn.
+// Before any object is renamed, new names are checked for collisions with the other objects in the same folder,
+// including the new names of the selected objects. An object with a colliding name is not renamed and
+// its RenameResult.Err wraps ErrNameCollision. A name that is used by another selected object is available once
+// that object has been renamed, as such objects are renamed in dependency order; objects that swap names collide.
+// If dryRun is true, the results are computed and no objects are renamed.
+// An error is returned only if the objects cannot be queried or fn fails, per-object errors are set in RenameResult.Err.
+func Rename(ctx context.Context, c *vim25.Client, refs []types.ManagedObjectReference, fn RenameFunc, dryRun bool) ([]RenameResult, error) {
+	objs, err := GetPropertiesBulk[mo.ManagedEntity](ctx, c, refs, "name", "parent")
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]RenameResult, len(objs))
+	selected := make(map[types.ManagedObjectReference]*RenameResult, len(objs))
+	parent := make(map[types.ManagedObjectReference]types.ManagedObjectReference, len(objs))
+	// current names of the objects in each folder
+	names := make(map[types.ManagedObjectReference]map[string]types.ManagedObjectReference)
+
+	for i, obj := range objs {
+		name, err := fn(i, obj.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = RenameResult{
+			Object:  obj.Self,
+			OldName: obj.Name,
+			NewName: name,
+		}
+		selected[obj.Self] = &res[i]
+
+		if obj.Parent != nil {
+			parent[obj.Self] = *obj.Parent
+			if obj.Parent.Type == "Folder" {
+				names[*obj.Parent] = nil
+			}
+		}
+	}
+
+	for ref := range names {
+		folder, err := GetProperties[mo.Folder](ctx, c, ref, "childEntity")
+		if err != nil {
+			return nil, err
+		}
+
+		children, err := GetPropertiesBulk[mo.ManagedEntity](ctx, c, folder.ChildEntity, "name")
+		if err != nil {
+			return nil, err
+		}
+
+		names[ref] = make(map[string]types.ManagedObjectReference, len(children))
+		for _, child := range children {
+			names[ref][child.Name] = child.Self
+		}
+	}
+
+	// selected objects with the same new name in the same folder
+	targets := make(map[types.ManagedObjectReference]map[string]*RenameResult)
+
+	for i := range res {
+		r := &res[i]
+		if r.NewName == r.OldName {
+			continue
+		}
+
+		folder := parent[r.Object]
+		if targets[folder] == nil {
+			targets[folder] = make(map[string]*RenameResult)
+		}
+
+		if other, ok := targets[folder][r.NewName]; ok {
+			r.collide(other.Object)
+			if other.Err == nil {
+				other.collide(r.Object)
+			}
+		} else {
+			targets[folder][r.NewName] = r
+		}
+	}
+
+	// new names used by objects that keep their current name, until no more collisions are found
+	for changed := true; changed; {
+		changed = false
+
+		for i := range res {
+			r := &res[i]
+			if r.Err != nil || r.NewName == r.OldName {
+				continue
+			}
+
+			holder, ok := names[parent[r.Object]][r.NewName]
+			if !ok || holder == r.Object {
+				continue
+			}
+
+			if h := selected[holder]; h == nil || h.Err != nil || h.NewName == h.OldName {
+				r.collide(holder)
+				changed = true
+			}
+		}
+	}
+
+	// rename objects whose new name is not the current name of another pending object, until none remain
+	pending := make(map[string]*RenameResult)
+	for i := range res {
+		r := &res[i]
+		if r.Err == nil && r.NewName != r.OldName {
+			pending[r.key(parent)] = r
+		}
+	}
+
+	for len(pending) != 0 {
+		var ready []*RenameResult
+
+		for i := range res {
+			r := &res[i]
+			if pending[r.key(parent)] != r {
+				continue
+			}
+			key := parent[r.Object].Value + "/" + r.NewName
+			if holder, ok := pending[key]; !ok || holder == r {
+				ready = append(ready, r)
+			}
+		}
+
+		if len(ready) == 0 {
+			// the remaining objects swap names with each other
+			for _, r := range pending {
+				holder := pending[parent[r.Object].Value+"/"+r.NewName]
+				r.collide(holder.Object)
+			}
+			break
+		}
+
+		for _, r := range ready {
+			delete(pending, r.key(parent))
+
+			if dryRun {
+				continue
+			}
+
+			task, err := NewCommon(c, r.Object).Rename(ctx, r.NewName)
+			if err == nil {
+				err = task.Wait(ctx)
+			}
+
+			r.Err = err
+			r.Renamed = err == nil
+		}
+	}
+
+	return res, nil
+}
+
+// key returns the folder and current name of the object, as used to track pending renames.
+func (r *RenameResult) key(parent map[types.ManagedObjectReference]types.ManagedObjectReference) string {
+	return parent[r.Object].Value + "/" + r.OldName
+}
+
+func (r *RenameResult) collide(ref types.ManagedObjectReference) {
+	r.Collision = &ref
+	r.Err = fmt.Errorf("%w: %q is used by %s", ErrNameCollision, r.NewName, ref)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestRename(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		vms, err := finder.VirtualMachineList(ctx, "DC0_H0_VM*")
+		if err != nil {
+			t.Fatal(err)
+		}
+		vm0, vm1 := vms[0].Reference(), vms[1].Reference()
+
+		rename := func(fn object.RenameFunc, dryRun bool, refs ...types.ManagedObjectReference) []object.RenameResult {
+			res, err := object.Rename(ctx, c, refs, fn, dryRun)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != len(refs) {
+				t.Fatalf("%d results", len(res))
+			}
+			return res
+		}
+
+		to := func(names ...string) object.RenameFunc {
+			return func(i int, _ string) (string, error) {
+				return names[i], nil
+			}
+		}
+
+		// dry run
+		res := rename(object.RenamePrefix("DC0_H0_", "host-"), true, vm0, vm1)
+		for _, r := range res {
+			if r.Err != nil || r.Renamed || r.NewName != "host-"+r.OldName[7:] {
+				t.Errorf("%#v", r)
+			}
+		}
+		if _, err = finder.VirtualMachine(ctx, "DC0_H0_VM0"); err != nil {
+			t.Error(err)
+		}
+
+		// collision with an object that is not renamed
+		res = rename(to("DC0_H0_VM1"), false, vm0)
+		if !errors.Is(res[0].Err, object.ErrNameCollision) || res[0].Renamed || *res[0].Collision != vm1 {
+			t.Errorf("%#v", res[0])
+		}
+
+		// collision between new names
+		res = rename(to("dup", "dup"), false, vm0, vm1)
+		for _, r := range res {
+			if !errors.Is(r.Err, object.ErrNameCollision) || r.Renamed {
+				t.Errorf("%#v", r)
+			}
+		}
+
+		// swapped names
+		res = rename(to("DC0_H0_VM1", "DC0_H0_VM0"), false, vm0, vm1)
+		for _, r := range res {
+			if !errors.Is(r.Err, object.ErrNameCollision) || r.Renamed {
+				t.Errorf("%#v", r)
+			}
+		}
+
+		fn, err := object.RenameTemplate(`{{upper (trimPrefix .Name "tmp-")}}-{{printf "%03d" .Seq}}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name, _ := fn(1, "tmp-web"); name != "WEB-002" {
+			t.Errorf("name=%s", name)
+		}
+
+		// a new name that is the current name of another selected object
+		res = rename(to("DC0_H0_VM1", "DC0_H0_VM2"), false, vm0, vm1)
+		for _, r := range res {
+			if r.Err != nil || !r.Renamed {
+				t.Errorf("%#v", r)
+			}
+		}
+
+		for name, ref := range map[string]types.ManagedObjectReference{"DC0_H0_VM1": vm0, "DC0_H0_VM2": vm1} {
+			vm, err := finder.VirtualMachine(ctx, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vm.Reference() != ref {
+				t.Errorf("%s=%s", name, vm.Reference())
+			}
+		}
+
+		// unchanged names
+		res = rename(to("DC0_H0_VM1"), false, vm0)
+		if res[0].Err != nil || res[0].Renamed {
+			t.Errorf("%#v", res[0])
+		}
+	})
+}
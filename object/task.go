@@ -19,8 +19,10 @@ package object
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/retry"
 	"github.com/vmware/govmomi/task"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -55,11 +57,24 @@ func (t *Task) Wait(ctx context.Context) error {
 // WaitForResult wait for a task to complete.
 // NOTE: This method create a thread-safe PropertyCollector instance per-call, so it is thread safe.
 // The downside of this approach is the additional resource usage on the vCenter side for each call.
-func (t *Task) WaitForResult(ctx context.Context, s ...progress.Sinker) (taskInfo *types.TaskInfo, result error) {
+// If ctx has a retry.Policy, waiting is retried when it fails, such as while vpxd is restarting.
+func (t *Task) WaitForResult(ctx context.Context, s ...progress.Sinker) (*types.TaskInfo, error) {
 	var pr progress.Sinker
 	if len(s) == 1 {
 		pr = s[0]
 	}
+
+	var info *types.TaskInfo
+	err := t.retry(ctx, func(ctx context.Context) error {
+		var err error
+		info, err = t.waitForResult(ctx, pr)
+		return err
+	})
+
+	return info, err
+}
+
+func (t *Task) waitForResult(ctx context.Context, pr progress.Sinker) (taskInfo *types.TaskInfo, result error) {
 	p, err := property.DefaultCollector(t.c).Create(ctx)
 	if err != nil {
 		return nil, err
@@ -83,6 +98,22 @@ func (t *Task) WaitForResult(ctx context.Context, s ...progress.Sinker) (taskInf
 	return task.WaitEx(ctx, t.Reference(), p, pr)
 }
 
+// retry calls fn according to the retry.Policy of ctx, if any.
+// An error of the task itself is not retried, as waiting again would not change the task's outcome.
+func (t *Task) retry(ctx context.Context, fn func(context.Context) error) error {
+	policy := retry.FromContext(ctx)
+	if policy == nil {
+		return fn(ctx)
+	}
+
+	return retry.WithRetry(ctx, func(attempt int, err error) (bool, time.Duration) {
+		if _, ok := err.(task.Error); ok {
+			return false, 0
+		}
+		return policy(attempt, err)
+	}, fn)
+}
+
 // WaitEx waits for a task to complete.
 // NOTE: This method use the same PropertyCollector instance in each call, thus reducing resource usage on the vCenter side.
 // The downside of this approach is that this method is not thread safe.
@@ -94,13 +125,22 @@ func (t *Task) WaitEx(ctx context.Context) error {
 // WaitForResultEx waits for a task to complete.
 // NOTE: This method use the same PropertyCollector instance in each call, thus reducing resource usage on the vCenter side.
 // The downside of this approach is that this method is not thread safe.
+// If ctx has a retry.Policy, waiting is retried when it fails, such as while vpxd is restarting.
 func (t *Task) WaitForResultEx(ctx context.Context, s ...progress.Sinker) (*types.TaskInfo, error) {
 	var pr progress.Sinker
 	if len(s) == 1 {
 		pr = s[0]
 	}
 	p := property.DefaultCollector(t.c)
-	return task.WaitEx(ctx, t.Reference(), p, pr)
+
+	var info *types.TaskInfo
+	err := t.retry(ctx, func(ctx context.Context) error {
+		var err error
+		info, err = task.WaitEx(ctx, t.Reference(), p, pr)
+		return err
+	})
+
+	return info, err
 }
 
 func (t *Task) Cancel(ctx context.Context) error {
@@ -23,7 +23,9 @@ import (
 	"net"
 	"path"
 	"strings"
+	"time"
 
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/nfc"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
@@ -612,6 +614,51 @@ func (v VirtualMachine) SetBootOptions(ctx context.Context, options *types.Virtu
 	return task.Wait(ctx)
 }
 
+// ReplicationConfig returns the VirtualMachine's config.repConfig property,
+// the vSphere Replication settings of the VM, or nil if replication is not enabled.
+func (v VirtualMachine) ReplicationConfig(ctx context.Context) (*types.ReplicationConfigSpec, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"config.repConfig"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Config == nil {
+		return nil, nil
+	}
+
+	return o.Config.RepConfig, nil
+}
+
+// SetReplicationConfig reconfigures the VirtualMachine with the given vSphere Replication settings.
+// To enable replication, spec.Generation must be 0. Otherwise, spec.Generation must match the
+// generation of the current settings, as returned by ReplicationConfig.
+func (v VirtualMachine) SetReplicationConfig(ctx context.Context, spec types.ReplicationConfigSpec) error {
+	task, err := v.Reconfigure(ctx, types.VirtualMachineConfigSpec{RepConfig: &spec})
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
+
+// PauseReplication pauses or resumes the vSphere Replication of the VirtualMachine.
+func (v VirtualMachine) PauseReplication(ctx context.Context, pause bool) error {
+	spec, err := v.ReplicationConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	if spec == nil {
+		return fmt.Errorf("%s replication is not enabled", v.Reference())
+	}
+
+	spec.Paused = pause
+
+	return v.SetReplicationConfig(ctx, *spec)
+}
+
 // Answer answers a pending question.
 func (v VirtualMachine) Answer(ctx context.Context, id, answer string) error {
 	req := types.AnswerVM{
@@ -660,6 +707,90 @@ func (v VirtualMachine) CreateSnapshot(ctx context.Context, name string, descrip
 	return NewTask(v.c, res.Returnval), nil
 }
 
+// VssBackupType is the VSS_BACKUP_TYPE of a Windows guest quiesced snapshot, see QuiesceSpec.
+type VssBackupType int32
+
+const (
+	VssBackupTypeUndefined = VssBackupType(iota)
+	VssBackupTypeFull
+	VssBackupTypeIncremental
+	VssBackupTypeDifferential
+	VssBackupTypeLog
+	VssBackupTypeCopy
+	VssBackupTypeOther
+)
+
+// QuiesceSpec specifies how the guest is quiesced by CreateSnapshotEx.
+// The VSS options apply to Windows guests only, where VMware Tools quiesces applications using
+// the Volume Shadow Copy Service. VSS writers cannot be excluded via the API, they are excluded
+// within the guest by the VMware Tools vmbackup.conf file.
+type QuiesceSpec struct {
+	// Timeout is the maximum time to quiesce the guest and create the snapshot,
+	// in the range of 5 to 240 minutes. A value of 0 uses the server's default.
+	Timeout time.Duration
+	// VssBackupType is the type of VSS backup. VssBackupTypeUndefined uses the server's default.
+	VssBackupType VssBackupType
+	// VssBackupContext is the context of the VSS backup. An empty value uses the server's default.
+	VssBackupContext types.VirtualMachineWindowsQuiesceSpecVssBackupContext
+	// VssBootableSystemState specifies if a bootable system state backup is performed.
+	VssBootableSystemState *bool
+	// VssPartialFileSupport specifies if partial file support is enabled.
+	VssPartialFileSupport *bool
+}
+
+// GuestQuiesceSpec returns the spec as a VirtualMachineWindowsQuiesceSpec if any VSS option is set,
+// otherwise as a VirtualMachineGuestQuiesceSpec.
+func (s QuiesceSpec) GuestQuiesceSpec() types.BaseVirtualMachineGuestQuiesceSpec {
+	spec := types.VirtualMachineGuestQuiesceSpec{
+		Timeout: int32(s.Timeout.Minutes()),
+	}
+
+	if s.VssBackupType == VssBackupTypeUndefined && s.VssBackupContext == "" &&
+		s.VssBootableSystemState == nil && s.VssPartialFileSupport == nil {
+		return &spec
+	}
+
+	return &types.VirtualMachineWindowsQuiesceSpec{
+		VirtualMachineGuestQuiesceSpec: spec,
+		VssBackupType:                  int32(s.VssBackupType),
+		VssBackupContext:               string(s.VssBackupContext),
+		VssBootableSystemState:         s.VssBootableSystemState,
+		VssPartialFileSupport:          s.VssPartialFileSupport,
+	}
+}
+
+// CreateSnapshotEx creates a new snapshot of a virtual machine, quiescing the guest as specified by spec.
+// If spec is nil, the guest is not quiesced.
+// If quiescing fails, the task error can be checked with IsQuiesceFault.
+func (v VirtualMachine) CreateSnapshotEx(ctx context.Context, name string, description string, memory bool, spec *QuiesceSpec) (*Task, error) {
+	req := types.CreateSnapshotEx_Task{
+		This:        v.Reference(),
+		Name:        name,
+		Description: description,
+		Memory:      memory,
+	}
+
+	if spec != nil {
+		req.QuiesceSpec = spec.GuestQuiesceSpec()
+	}
+
+	res, err := methods.CreateSnapshotEx_Task(ctx, v.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(v.c, res.Returnval), nil
+}
+
+// IsQuiesceFault returns true if err is caused by the guest failing to quiesce its file systems or applications,
+// in which case the snapshot may be retried without quiescing, for example.
+func IsQuiesceFault(err error) bool {
+	if err == nil {
+		return false
+	}
+	return fault.Is(err, &types.FilesystemQuiesceFault{}) || fault.Is(err, &types.ApplicationQuiesceFault{})
+}
+
 // RemoveAllSnapshot removes all snapshots of a virtual machine
 func (v VirtualMachine) RemoveAllSnapshot(ctx context.Context, consolidate *bool) (*Task, error) {
 	req := types.RemoveAllSnapshots_Task{
@@ -999,6 +1130,25 @@ func (v VirtualMachine) UpgradeVM(ctx context.Context, version string) (*Task, e
 	return NewTask(v.c, res.Returnval), nil
 }
 
+// ApplyEvcMode applies the given EVC feature masks to the powered off VM (vSphere 6.7+),
+// such as the EVCMode.FeatureMask of a supported EVC mode.
+// If completeMasks is true, the masks are applied as the complete set, replacing the VM's existing masks.
+// An empty mask removes the per-VM EVC configuration.
+func (v VirtualMachine) ApplyEvcMode(ctx context.Context, mask []types.HostFeatureMask, completeMasks bool) (*Task, error) {
+	req := types.ApplyEvcModeVM_Task{
+		This:          v.Reference(),
+		Mask:          mask,
+		CompleteMasks: types.NewBool(completeMasks),
+	}
+
+	res, err := methods.ApplyEvcModeVM_Task(ctx, v.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(v.c, res.Returnval), nil
+}
+
 // UUID is a helper to get the UUID of the VirtualMachine managed object.
 // This method returns an empty string if an error occurs when retrieving UUID from the VirtualMachine object.
 func (v VirtualMachine) UUID(ctx context.Context) string {
@@ -0,0 +1,434 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	pbm "github.com/vmware/govmomi/pbm/types"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// VirtualMachineConfigBackup is a portable document of a VM's configuration, without disk data.
+// Tags and storage policies are referenced by category and tag name and by policy ID respectively,
+// custom attributes by field name.
+type VirtualMachineConfigBackup struct {
+	Name string `json:"name"`
+	// Config is the ConfigSpec to create the VM, adding each device other than those created for any VM.
+	Config        types.VirtualMachineConfigSpec    `json:"config"`
+	Tags          []VirtualMachineConfigBackupTag   `json:"tags,omitempty"`
+	CustomValues  map[string]string                 `json:"customValues,omitempty"`
+	StoragePolicy *VirtualMachineConfigBackupPolicy `json:"storagePolicy,omitempty"`
+}
+
+// VirtualMachineConfigBackupTag is a tag attached to a VM.
+type VirtualMachineConfigBackupTag struct {
+	Category string `json:"category"`
+	Name     string `json:"name"`
+}
+
+// VirtualMachineConfigBackupPolicy is the storage policy ID associated with the VM home and with each virtual disk.
+type VirtualMachineConfigBackupPolicy struct {
+	Home string                                 `json:"home,omitempty"`
+	Disk []VirtualMachineConfigBackupDiskPolicy `json:"disk,omitempty"`
+}
+
+// VirtualMachineConfigBackupDiskPolicy is the storage policy ID associated with the virtual disk of the given device key.
+type VirtualMachineConfigBackupDiskPolicy struct {
+	Key    int32  `json:"key"`
+	Policy string `json:"policy"`
+}
+
+// disk returns the storage policy ID associated with the virtual disk of the given device key, if any.
+func (p *VirtualMachineConfigBackupPolicy) disk(key int32) (string, bool) {
+	for _, d := range p.Disk {
+		if d.Key == key {
+			return d.Policy, true
+		}
+	}
+	return "", false
+}
+
+// StoragePolicyQuerier queries the storage policies associated with VMs and virtual disks, as implemented by pbm.Client.
+type StoragePolicyQuerier interface {
+	QueryAssociatedProfiles(ctx context.Context, entities []pbm.PbmServerObjectRef) ([]pbm.PbmQueryProfileResult, error)
+}
+
+// VirtualMachineConfigBackupTagger lists and attaches the tags of a VM by category and tag name,
+// as implemented by tags.Manager.ConfigBackupTagger.
+type VirtualMachineConfigBackupTagger interface {
+	AttachedTags(ctx context.Context, ref mo.Reference) ([]VirtualMachineConfigBackupTag, error)
+	AttachTags(ctx context.Context, ref mo.Reference, tags []VirtualMachineConfigBackupTag) error
+}
+
+// VirtualMachineConfigBackupOptions are the optional clients used to backup and restore
+// the tags and storage policies of a VM. Tags and policies are skipped if the corresponding client is nil.
+type VirtualMachineConfigBackupOptions struct {
+	Tags   VirtualMachineConfigBackupTagger
+	Policy StoragePolicyQuerier
+}
+
+// isDefaultDevice returns true if the device is created for any VM, such as the PCI controller or video card.
+func isDefaultDevice(device types.BaseVirtualDevice) bool {
+	switch device.(type) {
+	case *types.VirtualPCIController, *types.VirtualPS2Controller, *types.VirtualIDEController,
+		*types.VirtualSIOController, *types.VirtualKeyboard, *types.VirtualPointingDevice,
+		*types.VirtualMachineVideoCard, *types.VirtualMachineVMCIDevice:
+		return true
+	}
+	return false
+}
+
+// BackupConfig returns a VirtualMachineConfigBackup of the VM.
+func (v VirtualMachine) BackupConfig(ctx context.Context, opts VirtualMachineConfigBackupOptions) (*VirtualMachineConfigBackup, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"config", "customValue"}, &o)
+	if err != nil {
+		return nil, err
+	}
+	if o.Config == nil {
+		return nil, fmt.Errorf("%s config is not available", v.Reference())
+	}
+
+	spec := o.Config.ToConfigSpec()
+	spec.ChangeVersion = ""
+	spec.DeviceChange = nil
+
+	var disks []int32
+
+	for _, device := range o.Config.Hardware.Device {
+		if isDefaultDevice(device) {
+			continue
+		}
+
+		var fop types.VirtualDeviceConfigSpecFileOperation
+		if disk, ok := device.(*types.VirtualDisk); ok {
+			fop = types.VirtualDeviceConfigSpecFileOperationCreate
+			disks = append(disks, disk.Key)
+		}
+
+		spec.DeviceChange = append(spec.DeviceChange, &types.VirtualDeviceConfigSpec{
+			Operation:     types.VirtualDeviceConfigSpecOperationAdd,
+			FileOperation: fop,
+			Device:        device,
+		})
+	}
+
+	b := &VirtualMachineConfigBackup{
+		Name:   o.Config.Name,
+		Config: spec,
+	}
+
+	if err = b.backupCustomValues(ctx, v, o.CustomValue); err != nil {
+		return nil, err
+	}
+
+	if opts.Tags != nil {
+		if b.Tags, err = opts.Tags.AttachedTags(ctx, v.Reference()); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Policy != nil {
+		if err = b.backupPolicy(ctx, v, opts.Policy, disks); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+func (b *VirtualMachineConfigBackup) backupCustomValues(ctx context.Context, v VirtualMachine, values []types.BaseCustomFieldValue) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	m, err := GetCustomFieldsManager(v.Client())
+	if err != nil {
+		return err
+	}
+
+	fields, err := m.Field(ctx)
+	if err != nil {
+		return err
+	}
+
+	b.CustomValues = make(map[string]string)
+
+	for _, val := range values {
+		s, ok := val.(*types.CustomFieldStringValue)
+		if !ok {
+			continue
+		}
+		if def := fields.ByKey(s.Key); def != nil {
+			b.CustomValues[def.Name] = s.Value
+		}
+	}
+
+	return nil
+}
+
+func (b *VirtualMachineConfigBackup) backupPolicy(ctx context.Context, v VirtualMachine, q StoragePolicyQuerier, disks []int32) error {
+	entities := []pbm.PbmServerObjectRef{{
+		ObjectType: string(pbm.PbmObjectTypeVirtualMachine),
+		Key:        v.Reference().Value,
+	}}
+
+	for _, key := range disks {
+		entities = append(entities, pbm.PbmServerObjectRef{
+			ObjectType: string(pbm.PbmObjectTypeVirtualDiskId),
+			Key:        fmt.Sprintf("%s:%d", v.Reference().Value, key),
+		})
+	}
+
+	results, err := q.QueryAssociatedProfiles(ctx, entities)
+	if err != nil {
+		return err
+	}
+
+	policy := new(VirtualMachineConfigBackupPolicy)
+
+	for _, res := range results {
+		if len(res.ProfileId) == 0 {
+			continue
+		}
+		id := res.ProfileId[0].UniqueId
+
+		switch pbm.PbmObjectType(res.Object.ObjectType) {
+		case pbm.PbmObjectTypeVirtualMachine:
+			policy.Home = id
+		case pbm.PbmObjectTypeVirtualDiskId:
+			_, disk, _ := strings.Cut(res.Object.Key, ":")
+			if key, err := strconv.Atoi(disk); err == nil {
+				policy.Disk = append(policy.Disk, VirtualMachineConfigBackupDiskPolicy{Key: int32(key), Policy: id})
+			}
+		}
+	}
+
+	if policy.Home != "" || len(policy.Disk) != 0 {
+		b.StoragePolicy = policy
+	}
+
+	return nil
+}
+
+// Encode writes the backup as JSON, including the VMOMI type names required to decode the device config.
+func (b *VirtualMachineConfigBackup) Encode(w io.Writer) error {
+	var buf bytes.Buffer
+
+	if err := types.NewJSONEncoder(&buf).Encode(b); err != nil {
+		return err
+	}
+
+	_, err := io.Copy(w, &buf)
+	return err
+}
+
+// DecodeVirtualMachineConfigBackup reads a VirtualMachineConfigBackup written by Encode.
+func DecodeVirtualMachineConfigBackup(r io.Reader) (*VirtualMachineConfigBackup, error) {
+	b := new(VirtualMachineConfigBackup)
+
+	if err := types.NewJSONDecoder(r).Decode(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// clone returns a deep copy of the backup, such that its config can be modified.
+func (b *VirtualMachineConfigBackup) clone() (*VirtualMachineConfigBackup, error) {
+	var buf bytes.Buffer
+
+	if err := b.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return DecodeVirtualMachineConfigBackup(&buf)
+}
+
+func profileSpec(id string) []types.BaseVirtualMachineProfileSpec {
+	if id == "" {
+		return nil
+	}
+	return []types.BaseVirtualMachineProfileSpec{&types.VirtualMachineDefinedProfileSpec{ProfileId: id}}
+}
+
+// RestoreVirtualMachineConfig creates a VM in the folder from the given backup, along with its tags,
+// custom attributes and storage policies. The VM is placed on the given datastore, if any, otherwise the datastore
+// of the backup's VM. Virtual disks are created empty, with the capacity recorded in the backup.
+// The VM is assigned new UUIDs and NICs with a generated MAC address are assigned a new address.
+func (f Folder) RestoreVirtualMachineConfig(ctx context.Context, b *VirtualMachineConfigBackup, pool *ResourcePool, host *HostSystem, datastore *Datastore, opts VirtualMachineConfigBackupOptions) (*VirtualMachine, error) {
+	b, err := b.clone()
+	if err != nil {
+		return nil, err
+	}
+
+	spec := b.Config
+	spec.Name = b.Name
+	spec.Uuid = ""
+	spec.InstanceUuid = ""
+
+	// the VM home directory is created by CreateVM
+	var home DatastorePath
+	if spec.Files != nil {
+		home.FromString(spec.Files.VmPathName)
+	}
+	if datastore != nil {
+		if home.Datastore, err = datastore.ObjectName(ctx); err != nil {
+			return nil, err
+		}
+	}
+	home.Path = ""
+	spec.Files = &types.VirtualMachineFileInfo{VmPathName: home.String()}
+
+	if b.StoragePolicy != nil {
+		spec.VmProfile = profileSpec(b.StoragePolicy.Home)
+	}
+
+	for _, change := range spec.DeviceChange {
+		dspec := change.GetVirtualDeviceConfigSpec()
+
+		switch device := dspec.Device.(type) {
+		case *types.VirtualDisk:
+			// create a new disk in the VM home directory
+			if backing, ok := device.Backing.(types.BaseVirtualDeviceFileBackingInfo); ok {
+				info := backing.GetVirtualDeviceFileBackingInfo()
+				info.FileName = ""
+				info.Datastore = nil
+			}
+			if b.StoragePolicy != nil {
+				id, _ := b.StoragePolicy.disk(device.Key)
+				dspec.Profile = profileSpec(id)
+			}
+		case types.BaseVirtualEthernetCard:
+			card := device.GetVirtualEthernetCard()
+			if card.AddressType != string(types.VirtualEthernetCardMacTypeManual) {
+				card.MacAddress = ""
+			}
+			if backing, ok := card.Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo); ok {
+				backing.Port.PortKey = ""
+			}
+		}
+	}
+
+	task, err := f.CreateVM(ctx, spec, pool, host)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := task.WaitForResult(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vm := NewVirtualMachine(f.Client(), info.Result.(types.ManagedObjectReference))
+
+	return vm, b.restoreMetadata(ctx, *vm, opts)
+}
+
+// RestoreConfig applies the given backup to the VM, along with its tags, custom attributes and storage policies.
+// The VM name, files, UUIDs, hardware version and devices are not changed. Storage policies are applied to
+// the VM's virtual disks with the same device key as in the backup.
+func (v VirtualMachine) RestoreConfig(ctx context.Context, b *VirtualMachineConfigBackup, opts VirtualMachineConfigBackupOptions) error {
+	b, err := b.clone()
+	if err != nil {
+		return err
+	}
+
+	spec := b.Config
+	spec.Name = ""
+	spec.Files = nil
+	spec.Uuid = ""
+	spec.InstanceUuid = ""
+	spec.Version = ""
+	spec.CreateDate = nil
+	spec.DeviceChange = nil
+
+	if b.StoragePolicy != nil {
+		spec.VmProfile = profileSpec(b.StoragePolicy.Home)
+
+		devices, err := v.Device(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, disk := range devices.SelectByType((*types.VirtualDisk)(nil)) {
+			id, ok := b.StoragePolicy.disk(disk.GetVirtualDevice().Key)
+			if !ok {
+				continue
+			}
+			spec.DeviceChange = append(spec.DeviceChange, &types.VirtualDeviceConfigSpec{
+				Operation: types.VirtualDeviceConfigSpecOperationEdit,
+				Device:    disk,
+				Profile:   profileSpec(id),
+			})
+		}
+	}
+
+	task, err := v.Reconfigure(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	if err = task.Wait(ctx); err != nil {
+		return err
+	}
+
+	return b.restoreMetadata(ctx, v, opts)
+}
+
+// restoreMetadata sets the custom attributes and attaches the tags of the backup to the given VM.
+func (b *VirtualMachineConfigBackup) restoreMetadata(ctx context.Context, vm VirtualMachine, opts VirtualMachineConfigBackupOptions) error {
+	if len(b.CustomValues) != 0 {
+		m, err := GetCustomFieldsManager(vm.Client())
+		if err != nil {
+			return err
+		}
+
+		for name, val := range b.CustomValues {
+			key, err := m.FindKey(ctx, name)
+			if err != nil {
+				if !errors.Is(err, ErrKeyNameNotFound) {
+					return err
+				}
+				def, err := m.Add(ctx, name, vm.Reference().Type, nil, nil)
+				if err != nil {
+					return err
+				}
+				key = def.Key
+			}
+
+			if err = m.Set(ctx, vm.Reference(), key, val); err != nil {
+				return err
+			}
+		}
+	}
+
+	if opts.Tags != nil && len(b.Tags) != 0 {
+		return opts.Tags.AttachTags(ctx, vm.Reference(), b.Tags)
+	}
+
+	return nil
+}
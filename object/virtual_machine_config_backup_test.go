@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/pbm"
+	_ "github.com/vmware/govmomi/pbm/simulator"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/rest"
+	_ "github.com/vmware/govmomi/vapi/simulator"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineConfigBackup(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		const policy = "aa6d5a82-1c88-45da-85d3-3d74b91a5bad"
+
+		rc := rest.NewClient(c)
+		if err := rc.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+		m := tags.NewManager(rc)
+
+		pc, err := pbm.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		opts := object.VirtualMachineConfigBackupOptions{Tags: m.ConfigBackupTagger(), Policy: pc}
+
+		finder := find.NewFinder(c)
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// tag, custom attribute and storage policy to backup
+		category, err := m.CreateCategory(ctx, &tags.Category{Name: "env", Cardinality: "SINGLE"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tag, err := m.CreateTag(ctx, &tags.Tag{Name: "prod", CategoryID: category})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = m.AttachTag(ctx, tag, vm); err != nil {
+			t.Fatal(err)
+		}
+
+		fields, err := object.GetCustomFieldsManager(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		field, err := fields.Add(ctx, "owner", "VirtualMachine", nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = fields.Set(ctx, vm.Reference(), field.Key, "ops"); err != nil {
+			t.Fatal(err)
+		}
+
+		devices, err := vm.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		disk := devices.SelectByType((*types.VirtualDisk)(nil))[0]
+
+		task, err := vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{
+			VmProfile: []types.BaseVirtualMachineProfileSpec{&types.VirtualMachineDefinedProfileSpec{ProfileId: policy}},
+			DeviceChange: []types.BaseVirtualDeviceConfigSpec{&types.VirtualDeviceConfigSpec{
+				Operation: types.VirtualDeviceConfigSpecOperationEdit,
+				Device:    disk,
+				Profile:   []types.BaseVirtualMachineProfileSpec{&types.VirtualMachineDefinedProfileSpec{ProfileId: policy}},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		backup, err := vm.BackupConfig(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(backup.Tags) != 1 || backup.Tags[0].Category != "env" || backup.Tags[0].Name != "prod" {
+			t.Errorf("tags=%#v", backup.Tags)
+		}
+		if backup.CustomValues["owner"] != "ops" {
+			t.Errorf("custom values=%#v", backup.CustomValues)
+		}
+		if p := backup.StoragePolicy; p == nil || p.Home != policy || len(p.Disk) != 1 || p.Disk[0].Key != disk.GetVirtualDevice().Key || p.Disk[0].Policy != policy {
+			t.Errorf("policy=%#v", p)
+		}
+
+		var buf bytes.Buffer
+		if err = backup.Encode(&buf); err != nil {
+			t.Fatal(err)
+		}
+		backup, err = object.DecodeVirtualMachineConfigBackup(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// restore as a new VM
+		backup.Name = "DC0_H0_VM0-restored"
+
+		folder, err := finder.DefaultFolder(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool, err := vm.ResourcePool(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		clone, err := folder.RestoreVirtualMachineConfig(ctx, backup, pool, nil, nil, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var src, dst mo.VirtualMachine
+		if err = vm.Properties(ctx, vm.Reference(), []string{"config"}, &src); err != nil {
+			t.Fatal(err)
+		}
+		if err = clone.Properties(ctx, clone.Reference(), []string{"config", "customValue"}, &dst); err != nil {
+			t.Fatal(err)
+		}
+
+		if dst.Config.Name != backup.Name {
+			t.Errorf("name=%s", dst.Config.Name)
+		}
+		if dst.Config.Uuid == src.Config.Uuid {
+			t.Error("uuid not changed")
+		}
+		if dst.Config.Hardware.MemoryMB != src.Config.Hardware.MemoryMB || dst.Config.Hardware.NumCPU != src.Config.Hardware.NumCPU {
+			t.Errorf("hardware=%#v", dst.Config.Hardware)
+		}
+		if n, m := len(dst.Config.Hardware.Device), len(src.Config.Hardware.Device); n != m {
+			t.Errorf("%d devices, expected %d", n, m)
+		}
+		if len(dst.CustomValue) != 1 || dst.CustomValue[0].(*types.CustomFieldStringValue).Value != "ops" {
+			t.Errorf("custom values=%#v", dst.CustomValue)
+		}
+
+		attached, err := m.GetAttachedTags(ctx, clone)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(attached) != 1 || attached[0].Name != "prod" {
+			t.Errorf("tags=%#v", attached)
+		}
+
+		restored, err := clone.BackupConfig(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p := restored.StoragePolicy; p == nil || p.Home != policy || len(p.Disk) != 1 {
+			t.Errorf("policy=%#v", p)
+		}
+
+		// restore in place
+		task, err = vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{MemoryMB: 2 * int64(src.Config.Hardware.MemoryMB)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if err = m.DetachTag(ctx, tag, vm); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = vm.RestoreConfig(ctx, backup, opts); err != nil {
+			t.Fatal(err)
+		}
+
+		var props mo.VirtualMachine
+		if err = vm.Properties(ctx, vm.Reference(), []string{"config"}, &props); err != nil {
+			t.Fatal(err)
+		}
+		if props.Config.Name != "DC0_H0_VM0" {
+			t.Errorf("name=%s", props.Config.Name)
+		}
+		if props.Config.Hardware.MemoryMB != src.Config.Hardware.MemoryMB {
+			t.Errorf("memory=%d", props.Config.Hardware.MemoryMB)
+		}
+
+		attached, err = m.GetAttachedTags(ctx, vm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(attached) != 1 {
+			t.Errorf("tags=%#v", attached)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineReplication(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := vm.ReplicationConfig(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if config != nil {
+			t.Fatalf("config=%#v", config)
+		}
+
+		if err = vm.PauseReplication(ctx, true); err == nil {
+			t.Error("expected error")
+		}
+
+		spec := types.ReplicationConfigSpec{
+			VmReplicationId: "GID-0d1b1c0e",
+			Destination:     "10.0.0.42",
+			Port:            31031,
+			Rpo:             0,
+		}
+
+		err = vm.SetReplicationConfig(ctx, spec)
+		if !isRepFault(err, types.ReplicationVmConfigFaultReasonForFaultOutOfBoundsRpoValue) {
+			t.Errorf("err=%v", err)
+		}
+
+		spec.Rpo = 15
+		if err = vm.SetReplicationConfig(ctx, spec); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err = vm.ReplicationConfig(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if config == nil || config.Rpo != 15 || config.Paused || config.Generation == spec.Generation {
+			t.Fatalf("config=%#v", config)
+		}
+
+		// stale generation
+		err = vm.SetReplicationConfig(ctx, spec)
+		if !isRepFault(err, types.ReplicationVmConfigFaultReasonForFaultStaleGenerationNumber) {
+			t.Errorf("err=%v", err)
+		}
+
+		// replication ID cannot be changed
+		spec = *config
+		spec.VmReplicationId = "GID-other"
+		err = vm.SetReplicationConfig(ctx, spec)
+		if !isRepFault(err, types.ReplicationVmConfigFaultReasonForFaultReconfigureVmReplicationIdNotAllowed) {
+			t.Errorf("err=%v", err)
+		}
+
+		if err = vm.PauseReplication(ctx, true); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err = vm.ReplicationConfig(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !config.Paused {
+			t.Errorf("config=%#v", config)
+		}
+
+		if err = vm.PauseReplication(ctx, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func isRepFault(err error, reason types.ReplicationVmConfigFaultReasonForFault) bool {
+	var f *types.ReplicationVmConfigFault
+	_, ok := fault.As(err, &f)
+	return ok && f.Reason == string(reason)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineCreateSnapshotEx(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := &object.QuiesceSpec{
+			Timeout:          10 * time.Minute,
+			VssBackupType:    object.VssBackupTypeCopy,
+			VssBackupContext: types.VirtualMachineWindowsQuiesceSpecVssBackupContextCtx_backup,
+		}
+
+		if _, ok := spec.GuestQuiesceSpec().(*types.VirtualMachineWindowsQuiesceSpec); !ok {
+			t.Errorf("spec=%T", spec.GuestQuiesceSpec())
+		}
+		if _, ok := (object.QuiesceSpec{}).GuestQuiesceSpec().(*types.VirtualMachineGuestQuiesceSpec); !ok {
+			t.Error("expected VirtualMachineGuestQuiesceSpec")
+		}
+
+		task, err := vm.CreateSnapshotEx(ctx, "backup", "", false, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		var o mo.VirtualMachine
+		if err = vm.Properties(ctx, vm.Reference(), []string{"snapshot"}, &o); err != nil {
+			t.Fatal(err)
+		}
+		if !o.Snapshot.RootSnapshotList[0].Quiesced {
+			t.Error("expected quiesced snapshot")
+		}
+
+		_, err = vm.CreateSnapshotEx(ctx, "invalid", "", false, &object.QuiesceSpec{Timeout: time.Minute})
+		if err == nil {
+			t.Error("expected error")
+		}
+		if object.IsQuiesceFault(err) {
+			t.Error("expected non-quiesce fault")
+		}
+
+		defer func() { simulator.TaskDelay = simulator.DelayConfig{} }()
+
+		for _, fault := range []types.BaseMethodFault{&types.ApplicationQuiesceFault{}, &types.FilesystemQuiesceFault{}} {
+			simulator.TaskDelay = simulator.DelayConfig{
+				MethodFailure: map[string]float64{"CreateSnapshot": 1},
+				Fault:         fault,
+			}
+
+			task, err = vm.CreateSnapshotEx(ctx, "failure", "", false, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); !object.IsQuiesceFault(err) {
+				t.Errorf("%T: err=%v", fault, err)
+			}
+		}
+	})
+}
@@ -16,6 +16,14 @@ limitations under the License.
 
 package object
 
+import (
+	"context"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
 type VmwareDistributedVirtualSwitch struct {
 	DistributedVirtualSwitch
 }
@@ -23,3 +31,143 @@ type VmwareDistributedVirtualSwitch struct {
 func (s VmwareDistributedVirtualSwitch) GetInventoryPath() string {
 	return s.InventoryPath
 }
+
+// DVSUplinkHealthCheck is the VLAN and MTU health check result of a host member uplink port.
+type DVSUplinkHealthCheck struct {
+	UplinkPortKey string `json:"uplinkPortKey"`
+	// TrunkedVlan are the VLANs trunked by the physical switch port connected to the uplink.
+	TrunkedVlan []types.NumericRange `json:"trunkedVlan,omitempty"`
+	// UntrunkedVlan are the VLANs used by the switch that are not trunked by the physical switch port.
+	UntrunkedVlan []types.NumericRange `json:"untrunkedVlan,omitempty"`
+	// MtuMismatch is true if the physical switch port MTU does not match the switch MTU.
+	MtuMismatch             bool                 `json:"mtuMismatch"`
+	VlanSupportSwitchMtu    []types.NumericRange `json:"vlanSupportSwitchMtu,omitempty"`
+	VlanNotSupportSwitchMtu []types.NumericRange `json:"vlanNotSupportSwitchMtu,omitempty"`
+}
+
+// VlanMismatch returns true if any VLAN used by the switch is not trunked by the physical switch port.
+func (r *DVSUplinkHealthCheck) VlanMismatch() bool {
+	return len(r.UntrunkedVlan) != 0
+}
+
+// DVSHostHealthCheck is the health check result of a switch host member.
+type DVSHostHealthCheck struct {
+	Host types.ManagedObjectReference `json:"host"`
+	// TeamingStatus is a types.VMwareDVSTeamingMatchStatus value, empty if the teaming health check is disabled.
+	TeamingStatus string                  `json:"teamingStatus,omitempty"`
+	Uplinks       []*DVSUplinkHealthCheck `json:"uplinks,omitempty"`
+}
+
+// TeamingMismatch returns true if the switch teaming policy does not match the physical switch config.
+func (r *DVSHostHealthCheck) TeamingMismatch() bool {
+	switch types.VMwareDVSTeamingMatchStatus(r.TeamingStatus) {
+	case types.VMwareDVSTeamingMatchStatusIphashMismatch, types.VMwareDVSTeamingMatchStatusNonIphashMismatch:
+		return true
+	}
+	return false
+}
+
+// Mismatch returns true if any VLAN, MTU or teaming mismatch was detected.
+func (r *DVSHostHealthCheck) Mismatch() bool {
+	if r.TeamingMismatch() {
+		return true
+	}
+	for _, uplink := range r.Uplinks {
+		if uplink.VlanMismatch() || uplink.MtuMismatch {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateHealthCheckConfig updates the switch health check config.
+func (s VmwareDistributedVirtualSwitch) UpdateHealthCheckConfig(ctx context.Context, config ...types.BaseDVSHealthCheckConfig) (*Task, error) {
+	req := types.UpdateDVSHealthCheckConfig_Task{
+		This:              s.Reference(),
+		HealthCheckConfig: config,
+	}
+
+	res, err := methods.UpdateDVSHealthCheckConfig_Task(ctx, s.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(s.Client(), res.Returnval), nil
+}
+
+// healthCheckConfig returns the VLAN/MTU and teaming health check config.
+func healthCheckConfig(enable bool, interval int32) []types.BaseDVSHealthCheckConfig {
+	config := types.VMwareDVSHealthCheckConfig{
+		DVSHealthCheckConfig: types.DVSHealthCheckConfig{
+			Enable:   types.NewBool(enable),
+			Interval: interval,
+		},
+	}
+
+	return []types.BaseDVSHealthCheckConfig{
+		&types.VMwareDVSVlanMtuHealthCheckConfig{VMwareDVSHealthCheckConfig: config},
+		&types.VMwareDVSTeamingHealthCheckConfig{VMwareDVSHealthCheckConfig: config},
+	}
+}
+
+// EnableHealthCheck enables the VLAN/MTU and teaming health checks,
+// running at the given interval in minutes.
+func (s VmwareDistributedVirtualSwitch) EnableHealthCheck(ctx context.Context, interval int32) (*Task, error) {
+	return s.UpdateHealthCheckConfig(ctx, healthCheckConfig(true, interval)...)
+}
+
+// DisableHealthCheck disables the VLAN/MTU and teaming health checks.
+func (s VmwareDistributedVirtualSwitch) DisableHealthCheck(ctx context.Context) (*Task, error) {
+	return s.UpdateHealthCheckConfig(ctx, healthCheckConfig(false, 0)...)
+}
+
+// HealthCheckResults returns the health check results of each host member.
+func (s VmwareDistributedVirtualSwitch) HealthCheckResults(ctx context.Context) ([]DVSHostHealthCheck, error) {
+	var dvs mo.DistributedVirtualSwitch
+
+	err := s.Properties(ctx, s.Reference(), []string{"runtime"}, &dvs)
+	if err != nil {
+		return nil, err
+	}
+
+	if dvs.Runtime == nil {
+		return nil, nil
+	}
+
+	var results []DVSHostHealthCheck
+
+	for _, member := range dvs.Runtime.HostMemberRuntime {
+		result := DVSHostHealthCheck{Host: member.Host}
+		uplinks := make(map[string]*DVSUplinkHealthCheck)
+
+		uplink := func(key string) *DVSUplinkHealthCheck {
+			r, ok := uplinks[key]
+			if !ok {
+				r = &DVSUplinkHealthCheck{UplinkPortKey: key}
+				uplinks[key] = r
+				result.Uplinks = append(result.Uplinks, r)
+			}
+			return r
+		}
+
+		for _, check := range member.HealthCheckResult {
+			switch r := check.(type) {
+			case *types.VMwareDVSVlanHealthCheckResult:
+				u := uplink(r.UplinkPortKey)
+				u.TrunkedVlan = r.TrunkedVlan
+				u.UntrunkedVlan = r.UntrunkedVlan
+			case *types.VMwareDVSMtuHealthCheckResult:
+				u := uplink(r.UplinkPortKey)
+				u.MtuMismatch = r.MtuMismatch
+				u.VlanSupportSwitchMtu = r.VlanSupportSwitchMtu
+				u.VlanNotSupportSwitchMtu = r.VlanNotSupportSwitchMtu
+			case *types.VMwareDVSTeamingHealthCheckResult:
+				result.TeamingStatus = r.TeamingStatus
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/progress"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// VsanUpgradeSystemInstance is the vCenter instance of the VsanUpgradeSystem managed object.
+var VsanUpgradeSystemInstance = types.ManagedObjectReference{
+	Type:  "VsanUpgradeSystem",
+	Value: "vsan-upgrade-system",
+}
+
+type VsanUpgradeSystem struct {
+	Common
+}
+
+func NewVsanUpgradeSystem(c *vim25.Client) *VsanUpgradeSystem {
+	return &VsanUpgradeSystem{
+		Common: NewCommon(c, VsanUpgradeSystemInstance),
+	}
+}
+
+// PreflightCheck performs an upgrade pre-flight check on the given cluster.
+// Absence of issues in the result means the check passed.
+func (m VsanUpgradeSystem) PreflightCheck(ctx context.Context, cluster types.ManagedObjectReference, downgrade bool) (*types.VsanUpgradeSystemPreflightCheckResult, error) {
+	req := types.PerformVsanUpgradePreflightCheck{
+		This:            m.Reference(),
+		Cluster:         cluster,
+		DowngradeFormat: types.NewBool(downgrade),
+	}
+
+	res, err := methods.PerformVsanUpgradePreflightCheck(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
+// Upgrade starts an on-disk format upgrade of the given cluster.
+// The req.This and req.Cluster fields are set by this method.
+func (m VsanUpgradeSystem) Upgrade(ctx context.Context, cluster types.ManagedObjectReference, req types.PerformVsanUpgrade_Task) (*Task, error) {
+	req.This = m.Reference()
+	req.Cluster = cluster
+
+	res, err := methods.PerformVsanUpgrade_Task(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(m.Client(), res.Returnval), nil
+}
+
+// Status returns the status of the current or most recent upgrade of the given cluster.
+func (m VsanUpgradeSystem) Status(ctx context.Context, cluster types.ManagedObjectReference) (*types.VsanUpgradeSystemUpgradeStatus, error) {
+	req := types.QueryVsanUpgradeStatus{
+		This:    m.Reference(),
+		Cluster: cluster,
+	}
+
+	res, err := methods.QueryVsanUpgradeStatus(ctx, m.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
+// VsanPreflightError is returned by VsanUpgradeSystem.UpgradeCluster when the pre-flight check reports issues.
+type VsanPreflightError struct {
+	Issues []types.BaseVsanUpgradeSystemPreflightCheckIssue
+}
+
+func (e *VsanPreflightError) Error() string {
+	msgs := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		msgs[i] = issue.GetVsanUpgradeSystemPreflightCheckIssue().Msg
+	}
+	return fmt.Sprintf("vSAN upgrade pre-flight check failed: %s", strings.Join(msgs, "; "))
+}
+
+// UpgradeCluster runs the pre-flight check on the given cluster and if no issues are reported,
+// performs the upgrade and waits for it to complete, reporting task progress to the optional Sinker.
+// A *VsanPreflightError is returned if the pre-flight check reports any issues.
+func (m VsanUpgradeSystem) UpgradeCluster(ctx context.Context, cluster types.ManagedObjectReference, req types.PerformVsanUpgrade_Task, s ...progress.Sinker) error {
+	downgrade := req.DowngradeFormat != nil && *req.DowngradeFormat
+
+	check, err := m.PreflightCheck(ctx, cluster, downgrade)
+	if err != nil {
+		return err
+	}
+
+	if len(check.Issues) != 0 {
+		return &VsanPreflightError{Issues: check.Issues}
+	}
+
+	task, err := m.Upgrade(ctx, cluster, req)
+	if err != nil {
+		return err
+	}
+
+	_, err = task.WaitForResult(ctx, s...)
+	return err
+}
+
+// VsanDiskFormatVersions returns the vSAN on-disk format version of each disk in use by vSAN,
+// keyed by host reference and then disk canonical name.
+func VsanDiskFormatVersions(ctx context.Context, hosts []*HostSystem) (map[types.ManagedObjectReference]map[string]int32, error) {
+	versions := make(map[types.ManagedObjectReference]map[string]int32, len(hosts))
+
+	for _, host := range hosts {
+		vsan, err := host.ConfigManager().VsanSystem(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", host.Reference(), err)
+		}
+
+		disks, err := vsan.QueryDisksForVsan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", host.Reference(), err)
+		}
+
+		v := make(map[string]int32)
+		for _, disk := range disks {
+			if disk.State != string(types.VsanHostDiskResultStateInUse) || disk.Disk.VsanDiskInfo == nil {
+				continue
+			}
+			v[disk.Disk.CanonicalName] = disk.Disk.VsanDiskInfo.FormatVersion
+		}
+		versions[host.Reference()] = v
+	}
+
+	return versions, nil
+}
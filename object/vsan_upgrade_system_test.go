@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func updateVsan(ctx context.Context, t *testing.T, host *object.HostSystem, info types.VsanHostConfigInfo) {
+	t.Helper()
+
+	s, err := host.ConfigManager().VsanSystem(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	task, err := s.Update(ctx, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = task.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVsanUpgradeSystem(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		cluster, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		hosts, err := cluster.Hosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := object.NewVsanUpgradeSystem(c)
+
+		check, err := m.PreflightCheck(ctx, cluster.Reference(), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(check.Issues) != 1 {
+			t.Fatalf("issues=%d", len(check.Issues)) // vSAN is not enabled
+		}
+
+		var perr *object.VsanPreflightError
+		err = m.UpgradeCluster(ctx, cluster.Reference(), types.PerformVsanUpgrade_Task{})
+		if !errors.As(err, &perr) {
+			t.Fatalf("err=%v", err)
+		}
+
+		spec := &types.ClusterConfigSpecEx{
+			VsanConfig: &types.VsanClusterConfigInfo{Enabled: types.NewBool(true)},
+		}
+		task, err := cluster.Reconfigure(ctx, spec, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		// claim each host's disk for vSAN, using an old on-disk format
+		for _, host := range hosts {
+			vsan, err := host.ConfigManager().VsanSystem(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := vsan.QueryDisksForVsan(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != 1 || res[0].State != string(types.VsanHostDiskResultStateEligible) {
+				t.Fatalf("disks=%#v", res)
+			}
+
+			disk := res[0].Disk
+			disk.VsanDiskInfo = &types.VsanHostVsanDiskInfo{VsanUuid: disk.Uuid, FormatVersion: 15}
+
+			updateVsan(ctx, t, host, types.VsanHostConfigInfo{
+				StorageInfo: &types.VsanHostConfigInfoStorageInfo{
+					AutoClaimStorage: types.NewBool(host == hosts[0]),
+					DiskMapping:      []types.VsanHostDiskMapping{{Ssd: disk}},
+				},
+			})
+
+			res, err = vsan.QueryDisksForVsan(ctx, disk.CanonicalName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != 1 || res[0].State != string(types.VsanHostDiskResultStateInUse) || res[0].VsanUuid != disk.Uuid {
+				t.Fatalf("disks=%#v", res)
+			}
+
+			res, err = vsan.QueryDisksForVsan(ctx, "enoent")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != 0 {
+				t.Errorf("disks=%d", len(res))
+			}
+		}
+
+		check, err = m.PreflightCheck(ctx, cluster.Reference(), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(check.Issues) != 1 {
+			t.Fatalf("issues=%d", len(check.Issues))
+		}
+		issue, ok := check.Issues[0].(*types.VsanUpgradeSystemAutoClaimEnabledOnHostsIssue)
+		if !ok || len(issue.Hosts) != 1 || issue.Hosts[0] != hosts[0].Reference() {
+			t.Fatalf("issue=%#v", check.Issues[0])
+		}
+
+		updateVsan(ctx, t, hosts[0], types.VsanHostConfigInfo{
+			StorageInfo: &types.VsanHostConfigInfoStorageInfo{
+				AutoClaimStorage: types.NewBool(false),
+				DiskMapping: []types.VsanHostDiskMapping{{Ssd: types.HostScsiDisk{
+					ScsiLun:      types.ScsiLun{CanonicalName: "mpx.vmhba0:C0:T0:L0"},
+					VsanDiskInfo: &types.VsanHostVsanDiskInfo{VsanUuid: "disk0", FormatVersion: 15},
+				}}},
+			},
+		})
+
+		versions := func(expect int32) {
+			t.Helper()
+
+			v, err := object.VsanDiskFormatVersions(ctx, hosts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(v) != len(hosts) {
+				t.Fatalf("hosts=%d", len(v))
+			}
+			for ref, disks := range v {
+				if len(disks) != 1 || disks["mpx.vmhba0:C0:T0:L0"] != expect {
+					t.Errorf("%s: %v", ref, disks)
+				}
+			}
+		}
+
+		versions(15)
+
+		status, err := m.Status(ctx, cluster.Reference())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.Completed != nil || len(status.History) != 0 {
+			t.Errorf("status=%#v", status)
+		}
+
+		if err = m.UpgradeCluster(ctx, cluster.Reference(), types.PerformVsanUpgrade_Task{}); err != nil {
+			t.Fatal(err)
+		}
+
+		versions(17)
+
+		status, err = m.Status(ctx, cluster.Reference())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.Completed == nil || !*status.Completed || len(status.History) != len(hosts) {
+			t.Errorf("status=%#v", status)
+		}
+
+		req := types.PerformVsanUpgrade_Task{DowngradeFormat: types.NewBool(true)}
+		if err = m.UpgradeCluster(ctx, cluster.Reference(), req); err != nil {
+			t.Fatal(err)
+		}
+
+		versions(16)
+
+		_, err = m.PreflightCheck(ctx, hosts[0].Reference(), false)
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
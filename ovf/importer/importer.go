@@ -19,17 +19,24 @@ package importer
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/nfc"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/ovf"
+	"github.com/vmware/govmomi/retry"
 	"github.com/vmware/govmomi/vapi/library"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/progress"
@@ -39,6 +46,8 @@ import (
 
 type Importer struct {
 	Log progress.LogFunc
+	// ProgressLogger, if set, creates the upload progress loggers instead of progress.NewProgressLogger with Log.
+	ProgressLogger func(prefix string) *progress.ProgressLogger
 
 	Name           string
 	VerifyManifest bool
@@ -56,6 +65,22 @@ type Importer struct {
 
 	Archive  Archive
 	Manifest map[string]*library.Checksum
+
+	// Resume is the path of a file used to save the progress of Import.
+	// If the file exists and its lease is still ready, an interrupted Import
+	// continues with that lease, uploading only the files not yet uploaded.
+	// The file is removed once Import completes.
+	Resume string
+	// Report is set by Import, describing the uploaded files and their checksums.
+	Report *Report
+
+	// Threads is the number of files uploaded in parallel by Import, defaults to 1.
+	Threads int
+	// Retries is the number of times the upload of a file is retried after a transient error,
+	// such as a dropped connection. Each retry restarts the upload of the file from the beginning.
+	Retries int
+
+	mu sync.Mutex
 }
 
 func (imp *Importer) ReadManifest(fpath string) error {
@@ -113,6 +138,11 @@ func (imp *Importer) Import(ctx context.Context, fpath string, opts Options) (*t
 		return nil, err
 	}
 
+	pool, err := imp.ipPool(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
 	cisp := types.OvfCreateImportSpecParams{
 		DiskProvisioning:   opts.DiskProvisioning,
 		EntityName:         name,
@@ -139,6 +169,13 @@ func (imp *Importer) Import(ctx context.Context, fpath string, opts Options) (*t
 		}
 	}
 
+	// The pool is associated once the import spec is valid, such that an invalid import has no side effect
+	if pool != nil {
+		if err = imp.associateIPPool(ctx, pool, nmap); err != nil {
+			return nil, err
+		}
+	}
+
 	if opts.Annotation != "" {
 		switch s := spec.ImportSpec.(type) {
 		case *types.VirtualMachineImportSpec:
@@ -154,26 +191,90 @@ func (imp *Importer) Import(ctx context.Context, fpath string, opts Options) (*t
 		}
 	}
 
-	lease, err := imp.ResourcePool.ImportVApp(ctx, spec.ImportSpec, imp.Folder, imp.Host)
+	lease, info, err := imp.resumeLease(ctx, name, spec.FileItem)
 	if err != nil {
 		return nil, err
 	}
 
-	info, err := lease.Wait(ctx, spec.FileItem)
-	if err != nil {
-		return nil, err
+	if lease == nil {
+		lease, err = imp.ResourcePool.ImportVApp(ctx, spec.ImportSpec, imp.Folder, imp.Host)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err = lease.Wait(ctx, spec.FileItem)
+		if err != nil {
+			return nil, err
+		}
+
+		imp.Report = newReport(name, lease, info)
+		if err = imp.Report.save(imp.Resume); err != nil {
+			return nil, err
+		}
 	}
 
 	u := lease.StartUpdater(ctx, info)
 	defer u.Done()
 
+	var items []nfc.FileItem
 	for _, i := range info.Items {
-		if err := imp.Upload(ctx, lease, i); err != nil {
+		if f := imp.Report.file(i); f.Uploaded {
+			f.Skipped = true
+			close(i.Sink()) // mark as complete for the lease updater
+			continue
+		}
+		items = append(items, i)
+	}
+
+	if err = imp.uploadItems(ctx, lease, items); err != nil {
+		return nil, err
+	}
+
+	if err = lease.Complete(ctx); err != nil {
+		return nil, err
+	}
+
+	if imp.Resume != "" {
+		if err = os.Remove(imp.Resume); err != nil {
 			return nil, err
 		}
 	}
 
-	return &info.Entity, lease.Complete(ctx)
+	return &info.Entity, nil
+}
+
+// resumeLease returns the lease saved to imp.Resume, if any and still ready for upload.
+func (imp *Importer) resumeLease(ctx context.Context, name string, items []types.OvfFileItem) (*nfc.Lease, *nfc.LeaseInfo, error) {
+	if imp.Resume == "" {
+		return nil, nil, nil
+	}
+
+	r, err := readReport(imp.Resume)
+	if err != nil || r == nil {
+		return nil, nil, err
+	}
+
+	if r.Lease == nil || r.Name != name {
+		return nil, nil, fmt.Errorf("resume %s: import of %q does not match %q", imp.Resume, r.Name, name)
+	}
+
+	lease := nfc.NewLease(imp.Client, *r.Lease)
+
+	info, err := lease.Wait(ctx, items)
+	if err == nil && len(info.Items) != len(items) {
+		err = errors.New("files do not match")
+	}
+	if err != nil {
+		if imp.Log != nil {
+			_, _ = imp.Log(fmt.Sprintf("Unable to resume import with %s (%s), restarting...\n", r.Lease, err))
+		}
+		return nil, nil, nil
+	}
+
+	r.Resumed = true
+	imp.Report = r
+
+	return lease, info, nil
 }
 
 func (imp *Importer) NetworkMap(ctx context.Context, e *ovf.Envelope, networks []Network) ([]types.OvfNetworkMapping, error) {
@@ -211,6 +312,51 @@ func (imp *Importer) NetworkMap(ctx context.Context, e *ovf.Envelope, networks [
 	return nmap, nil
 }
 
+// AssociateIPPool associates the mapped networks with the IP pool specified by opts.IPPool,
+// when the IP allocation policy allocates addresses from a pool.
+// Import calls AssociateIPPool after CreateImportSpec succeeds.
+func (imp *Importer) AssociateIPPool(ctx context.Context, opts Options, nmap []types.OvfNetworkMapping) error {
+	pool, err := imp.ipPool(ctx, opts)
+	if err != nil || pool == nil {
+		return err
+	}
+
+	return imp.associateIPPool(ctx, pool, nmap)
+}
+
+// ipPool returns the IP pool specified by opts.IPPool, if any,
+// validating the IP allocation policy allocates addresses from a pool.
+func (imp *Importer) ipPool(ctx context.Context, opts Options) (*types.IpPool, error) {
+	if opts.IPPool == "" {
+		return nil, nil
+	}
+
+	switch types.VAppIPAssignmentInfoIpAllocationPolicy(opts.IPAllocationPolicy) {
+	case types.VAppIPAssignmentInfoIpAllocationPolicyTransientPolicy,
+		types.VAppIPAssignmentInfoIpAllocationPolicyFixedAllocatedPolicy:
+	default:
+		return nil, fmt.Errorf("IPPool requires IPAllocationPolicy %s or %s",
+			types.VAppIPAssignmentInfoIpAllocationPolicyTransientPolicy,
+			types.VAppIPAssignmentInfoIpAllocationPolicyFixedAllocatedPolicy)
+	}
+
+	if imp.Datacenter == nil {
+		return nil, errors.New("IPPool requires a datacenter")
+	}
+
+	return object.NewIpPoolManager(imp.Client).FindIpPool(ctx, imp.Datacenter, opts.IPPool)
+}
+
+// associateIPPool associates the mapped networks with the given IP pool.
+func (imp *Importer) associateIPPool(ctx context.Context, pool *types.IpPool, nmap []types.OvfNetworkMapping) error {
+	networks := make([]types.ManagedObjectReference, len(nmap))
+	for i := range nmap {
+		networks[i] = nmap[i].Network
+	}
+
+	return object.NewIpPoolManager(imp.Client).AssociateNetworks(ctx, imp.Datacenter, pool, networks...)
+}
+
 func OVFMap(op []Property) (p []types.KeyValue) {
 	for _, v := range op {
 		p = append(p, types.KeyValue{
@@ -282,28 +428,198 @@ func ValidateChecksum(ctx context.Context, lease *nfc.Lease, sum *library.Checks
 }
 
 func (imp *Importer) Upload(ctx context.Context, lease *nfc.Lease, item nfc.FileItem) error {
-	file := item.Path
+	if imp.Report == nil {
+		imp.Report = new(Report)
+	}
 
-	f, size, err := imp.Archive.Open(file)
+	return imp.upload(ctx, lease, item, nil)
+}
+
+func (imp *Importer) progressLogger(prefix string) *progress.ProgressLogger {
+	if imp.ProgressLogger != nil {
+		return imp.ProgressLogger(prefix)
+	}
+	return progress.NewProgressLogger(imp.Log, prefix)
+}
+
+// uploadItems uploads the given items, up to imp.Threads at a time, saving the Report after each upload.
+// When uploading in parallel, the aggregate progress of all items is reported by a single logger.
+// An upload error does not cancel the other uploads, such that a resumed import only
+// needs to upload the files that failed.
+func (imp *Importer) uploadItems(ctx context.Context, lease *nfc.Lease, items []nfc.FileItem) error {
+	threads := max(imp.Threads, 1)
+
+	if threads == 1 || len(items) < 2 {
+		for _, item := range items {
+			if err := imp.upload(ctx, lease, item, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	logger := imp.progressLogger(fmt.Sprintf("Uploading %d files... ", len(items)))
+	group := progress.NewGroup(logger)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	limit := make(chan struct{}, threads)
+
+	for _, item := range items {
+		limit <- struct{}{}
+		wg.Add(1)
+
+		go func(item nfc.FileItem) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+
+			if err := imp.upload(ctx, lease, item, group); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", item.Path, err))
+				mu.Unlock()
+			}
+		}(item)
+	}
+
+	wg.Wait()
+	group.Done()
+	logger.Wait()
+
+	return errors.Join(errs...)
+}
+
+// isTransient returns true if the upload error is likely to succeed on retry,
+// such as a dropped connection or a 502, 503 or 504 response from the reverse proxy.
+func isTransient(err error) bool {
+	return retry.IsServiceUnavailable(err) ||
+		vim25.IsTemporaryNetworkError(err) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryPolicy returns the Policy used to retry the upload of the given file after a transient error, logging each retry.
+func (imp *Importer) retryPolicy(file string) retry.Policy {
+	policy := retry.MaxAttempts(imp.Retries+1, retry.On(retry.Exponential(time.Second, 30*time.Second), isTransient))
+
+	return func(attempt int, err error) (bool, time.Duration) {
+		ok, delay := policy(attempt, err)
+		if ok && imp.Log != nil {
+			_, _ = imp.Log(fmt.Sprintf("\rUpload of %s failed (%s), retrying in %s...\n", path.Base(file), err, delay.Round(time.Second)))
+		}
+		return ok, delay
+	}
+}
+
+// attempts is a progress.Sinker for an upload that may be retried, forwarding the reports of each
+// attempt to a single downstream channel. Error reports are dropped, as the next attempt restarts
+// the progress of the file. The final error, if any, is sent by done.
+type attempts struct {
+	ch chan<- progress.Report
+	wg sync.WaitGroup
+}
+
+func newAttempts(s progress.Sinker) *attempts {
+	return &attempts{ch: s.Sink()}
+}
+
+func (a *attempts) Sink() chan<- progress.Report {
+	ch := make(chan progress.Report)
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		for r := range ch {
+			if r.Error() == nil {
+				a.ch <- r
+			}
+		}
+	}()
+
+	return ch
+}
+
+func (a *attempts) done(err error) {
+	a.wg.Wait()
 	if err != nil {
-		return err
+		a.ch <- progress.Event{Err: err}
+	}
+	close(a.ch)
+}
+
+// upload uploads the given item, retrying after transient errors according to imp.Retries.
+// NFC uploads cannot continue from the middle of a stream, so each attempt restarts the file from the beginning.
+// Progress is reported to s, or a new logger for the item if s is nil.
+func (imp *Importer) upload(ctx context.Context, lease *nfc.Lease, item nfc.FileItem, s progress.Sinker) error {
+	file := item.Path
+
+	imp.mu.Lock()
+	report := imp.Report.file(item)
+	imp.mu.Unlock()
+
+	sum, ok := imp.Manifest[file]
+	if imp.VerifyManifest && !ok {
+		return fmt.Errorf("missing checksum for %v in manifest file", file)
+	}
+
+	var algorithm string
+	if ok {
+		algorithm = sum.Algorithm
 	}
-	defer f.Close()
 
-	logger := progress.NewProgressLogger(imp.Log, fmt.Sprintf("Uploading %s... ", path.Base(file)))
-	defer logger.Wait()
+	if _, _, err := newHash(algorithm); err != nil {
+		return err
+	}
 
-	opts := soap.Upload{
-		ContentLength: size,
-		Progress:      logger,
+	if s == nil {
+		logger := imp.progressLogger(fmt.Sprintf("Uploading %s... ", path.Base(file)))
+		defer logger.Wait()
+		s = logger
 	}
 
-	err = lease.Upload(ctx, item, f, opts)
+	p := newAttempts(s)
+	var h hash.Hash
+	var retries int
+
+	err := retry.WithRetry(ctx, imp.retryPolicy(file), func(ctx context.Context) error {
+		var err error
+
+		if h != nil {
+			retries++
+		}
+		algorithm, h, _ = newHash(algorithm)
+
+		f, size, err := imp.Archive.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		opts := soap.Upload{
+			ContentLength: size,
+			Progress:      p,
+		}
+
+		return lease.Upload(ctx, item, io.TeeReader(f, h), opts)
+	})
+	p.done(err)
 	if err != nil {
 		return err
 	}
 
+	checksum := hex.EncodeToString(h.Sum(nil))
+	verified := false
+
 	if imp.VerifyManifest {
+		// Compare the checksum computed by the client while uploading, in case the file was corrupted locally.
+		if !strings.EqualFold(sum.Checksum, checksum) {
+			return fmt.Errorf("manifest checksum %v mismatch with computed checksum %v for file %v",
+				sum.Checksum, checksum, file)
+		}
+
 		mapImportKeyToKey := func(urls []types.HttpNfcLeaseDeviceUrl, importKey string) string {
 			for _, url := range urls {
 				if url.ImportKey == importKey {
@@ -316,11 +632,20 @@ func (imp *Importer) Upload(ctx context.Context, lease *nfc.Lease, item nfc.File
 		if err != nil {
 			return err
 		}
-		sum, ok := imp.Manifest[file]
-		if !ok {
-			return fmt.Errorf("missing checksum for %v in manifest file", file)
+		if err = ValidateChecksum(ctx, lease, sum, file, mapImportKeyToKey(leaseInfo.DeviceUrl, item.DeviceId)); err != nil {
+			return err
 		}
-		return ValidateChecksum(ctx, lease, sum, file, mapImportKeyToKey(leaseInfo.DeviceUrl, item.DeviceId))
+		verified = true
 	}
-	return nil
+
+	imp.mu.Lock()
+	defer imp.mu.Unlock()
+
+	report.Algorithm = algorithm
+	report.Checksum = checksum
+	report.Verified = verified
+	report.Retries = retries
+	report.Uploaded = true
+
+	return imp.Report.save(imp.Resume)
 }
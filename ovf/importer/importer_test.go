@@ -0,0 +1,406 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/ovf/importer"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	disk  = "ttylinux-pc_i486-16.1-disk1.vmdk"
+	disk2 = "ttylinux-pc_i486-16.1-disk2.vmdk"
+)
+
+// addDisk adds disk2 to the ttylinux.ovf fixture.
+func addDisk(ovf []byte) []byte {
+	r := strings.NewReplacer(
+		"</References>",
+		`  <File ovf:href="`+disk2+`" ovf:id="file2" ovf:size="10595840"/>
+  </References>`,
+		"</DiskSection>",
+		`  <Disk ovf:capacity="30" ovf:capacityAllocationUnits="byte * 2^20" ovf:diskId="vmdisk2" ovf:fileRef="file2"
+          ovf:format="http://www.vmware.com/interfaces/specifications/vmdk.html#streamOptimized"/>
+  </DiskSection>`,
+		"</VirtualHardwareSection>",
+		`  <Item>
+        <rasd:AddressOnParent>1</rasd:AddressOnParent>
+        <rasd:ElementName>disk1</rasd:ElementName>
+        <rasd:HostResource>ovf:/disk/vmdisk2</rasd:HostResource>
+        <rasd:InstanceID>10</rasd:InstanceID>
+        <rasd:Parent>3</rasd:Parent>
+        <rasd:ResourceType>17</rasd:ResourceType>
+      </Item>
+    </VirtualHardwareSection>`,
+	)
+	return []byte(r.Replace(string(ovf)))
+}
+
+// testArchive serves the ttylinux.ovf fixture along with fake disk content and its manifest.
+type testArchive struct {
+	disk  []byte
+	fail  bool
+	disk2 bool // add a second disk to the OVF, using the same content
+}
+
+func (a *testArchive) checksum() string {
+	sum := sha256.Sum256(a.disk)
+	return hex.EncodeToString(sum[:])
+}
+
+func (a *testArchive) Open(name string) (io.ReadCloser, int64, error) {
+	switch filepath.Base(name) {
+	case "ttylinux.ovf":
+		b, err := os.ReadFile("../fixtures/ttylinux.ovf")
+		if err != nil {
+			return nil, 0, err
+		}
+		if a.disk2 {
+			b = addDisk(b)
+		}
+		return io.NopCloser(bytes.NewReader(b)), int64(len(b)), nil
+	case "ttylinux.mf":
+		mf := fmt.Sprintf("SHA256(%s)= %s\n", disk, a.checksum())
+		return io.NopCloser(strings.NewReader(mf)), int64(len(mf)), nil
+	case disk, disk2:
+		if a.fail {
+			return nil, 0, errors.New("interrupted")
+		}
+		return io.NopCloser(bytes.NewReader(a.disk)), int64(len(a.disk)), nil
+	}
+
+	return nil, 0, os.ErrNotExist
+}
+
+func TestImportResume(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		ds, err := finder.DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pool, err := finder.ResourcePool(ctx, "DC0_C0/Resources")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		folder, err := finder.DefaultFolder(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		archive := &testArchive{disk: []byte("disk content"), fail: true}
+		checksum := archive.checksum()
+
+		resume := filepath.Join(t.TempDir(), "import.json")
+
+		imp := importer.Importer{
+			Log:            func(msg string) (int, error) { return len(msg), nil },
+			Client:         c,
+			Finder:         finder,
+			Datacenter:     dc,
+			Datastore:      ds,
+			ResourcePool:   pool,
+			Folder:         folder,
+			Archive:        archive,
+			Resume:         resume,
+			VerifyManifest: true,
+		}
+
+		name := "ttylinux"
+		opts := importer.Options{Name: &name}
+
+		// upload fails, the progress file is left behind with the lease
+		if _, err = imp.Import(ctx, "ttylinux.ovf", opts); err == nil {
+			t.Fatal("expected error")
+		}
+
+		var report importer.Report
+		b, err := os.ReadFile(resume)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = json.Unmarshal(b, &report); err != nil {
+			t.Fatal(err)
+		}
+		if report.Lease == nil || len(report.Files) != 1 || report.Files[0].Uploaded {
+			t.Fatalf("report=%#v", report)
+		}
+
+		// resume with the same lease
+		archive.fail = false
+		ref, err := imp.Import(ctx, "ttylinux.ovf", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !imp.Report.Resumed || *imp.Report.Lease != *report.Lease || *ref != *report.Entity {
+			t.Errorf("report=%#v", imp.Report)
+		}
+		f := imp.Report.Files[0]
+		if !f.Uploaded || !f.Verified || f.Checksum != checksum {
+			t.Errorf("file=%#v", f)
+		}
+		if _, err = os.Stat(resume); !os.IsNotExist(err) {
+			t.Errorf("resume file not removed: %v", err)
+		}
+
+		// the progress file of another import is rejected
+		if err = os.WriteFile(resume, b, 0600); err != nil {
+			t.Fatal(err)
+		}
+		name = "ttylinux-2"
+		if _, err = imp.Import(ctx, "ttylinux.ovf", opts); err == nil {
+			t.Error("expected error")
+		}
+
+		// the lease saved to the progress file has completed, the import is restarted
+		report.Name = name
+		if b, err = json.Marshal(&report); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.WriteFile(resume, b, 0600); err != nil {
+			t.Fatal(err)
+		}
+		ref, err = imp.Import(ctx, "ttylinux.ovf", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if imp.Report.Resumed || *imp.Report.Lease == *report.Lease || *ref == *report.Entity {
+			t.Errorf("report=%#v", imp.Report)
+		}
+	})
+}
+
+// flakyTransport fails the first n NFC uploads of each file with a 503 status.
+type flakyTransport struct {
+	http.RoundTripper
+
+	n      int
+	mu     sync.Mutex
+	failed map[string]int
+}
+
+func (t *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.Contains(req.URL.Path, "/nfc/") && req.Method == http.MethodPost {
+		t.mu.Lock()
+		fail := t.failed[req.URL.Path] < t.n
+		if fail {
+			t.failed[req.URL.Path]++
+		}
+		t.mu.Unlock()
+
+		if fail {
+			_, _ = io.Copy(io.Discard, req.Body)
+			_ = req.Body.Close()
+			return &http.Response{
+				Status:     "503 Service Unavailable",
+				StatusCode: http.StatusServiceUnavailable,
+				Body:       http.NoBody,
+				Request:    req,
+			}, nil
+		}
+	}
+
+	return t.RoundTripper.RoundTrip(req)
+}
+
+func TestImportRetry(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		ds, err := finder.DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pool, err := finder.ResourcePool(ctx, "DC0_C0/Resources")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		folder, err := finder.DefaultFolder(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		transport := &flakyTransport{RoundTripper: c.Client.Transport, n: 1, failed: make(map[string]int)}
+		c.Client.Transport = transport
+
+		archive := &testArchive{disk: []byte("disk content"), disk2: true}
+
+		imp := importer.Importer{
+			Log:            func(msg string) (int, error) { return len(msg), nil },
+			Client:         c,
+			Finder:         finder,
+			Datacenter:     dc,
+			Datastore:      ds,
+			ResourcePool:   pool,
+			Folder:         folder,
+			Archive:        archive,
+			VerifyManifest: false,
+			Threads:        2,
+		}
+
+		name := "ttylinux"
+		opts := importer.Options{Name: &name}
+
+		// no retries, both uploads fail
+		_, err = imp.Import(ctx, "ttylinux.ovf", opts)
+		if err == nil || !strings.Contains(err.Error(), disk) || !strings.Contains(err.Error(), disk2) {
+			t.Fatalf("err=%v", err)
+		}
+
+		// each upload fails once and is retried
+		clear(transport.failed)
+		imp.Retries = 2
+		name = "ttylinux-2"
+
+		if _, err = imp.Import(ctx, "ttylinux.ovf", opts); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(imp.Report.Files) != 2 {
+			t.Fatalf("report=%#v", imp.Report)
+		}
+		for _, f := range imp.Report.Files {
+			if !f.Uploaded || f.Retries != 1 || f.Checksum != archive.checksum() {
+				t.Errorf("file=%#v", f)
+			}
+		}
+	})
+}
+
+func TestImportIPPool(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		ds, err := finder.DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pool, err := finder.ResourcePool(ctx, "DC0_C0/Resources")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		folder, err := finder.DefaultFolder(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		net, err := finder.Network(ctx, "DC0_DVPG0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		imp := importer.Importer{
+			Log:          func(msg string) (int, error) { return len(msg), nil },
+			Client:       c,
+			Finder:       finder,
+			Datacenter:   dc,
+			Datastore:    ds,
+			ResourcePool: pool,
+			Folder:       folder,
+			Archive:      &testArchive{disk: []byte("disk content")},
+		}
+
+		m := object.NewIpPoolManager(c)
+
+		associated := func() bool {
+			p, err := m.FindIpPool(ctx, dc, "ip-pool")
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, a := range p.NetworkAssociation {
+				if a.Network != nil && *a.Network == net.Reference() {
+					return true
+				}
+			}
+			return false
+		}
+
+		if associated() {
+			t.Fatal("network already associated")
+		}
+
+		name := "ttylinux"
+		opts := importer.Options{
+			Name:               &name,
+			IPPool:             "ip-pool",
+			IPAllocationPolicy: string(types.VAppIPAssignmentInfoIpAllocationPolicyTransientPolicy),
+			DiskProvisioning:   "enoent",
+			NetworkMapping:     []importer.Network{{Name: "nat", Network: "DC0_DVPG0"}},
+		}
+
+		// the import spec has errors, the pool is not changed
+		if _, err = imp.Import(ctx, "ttylinux.ovf", opts); err == nil {
+			t.Fatal("expected error")
+		}
+		if associated() {
+			t.Error("network associated with an invalid import spec")
+		}
+
+		opts.DiskProvisioning = string(types.OvfCreateImportSpecParamsDiskProvisioningTypeThin)
+		if _, err = imp.Import(ctx, "ttylinux.ovf", opts); err != nil {
+			t.Fatal(err)
+		}
+		if !associated() {
+			t.Error("network not associated")
+		}
+	})
+}
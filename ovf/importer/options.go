@@ -77,6 +77,10 @@ type Options struct {
 	AllIPProtocolOptions []string `json:",omitempty"`
 	IPProtocol           string
 
+	// IPPool is the name of a network protocol profile (IP pool) to associate with each mapped network,
+	// as required by the transient and fixedAllocated IP allocation policies.
+	IPPool string `json:",omitempty"`
+
 	PropertyMapping []Property `json:",omitempty"`
 
 	NetworkMapping []Network `json:",omitempty"`
@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"strings"
+
+	"github.com/vmware/govmomi/nfc"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// Report describes the result of Importer.Import, including the checksum of each uploaded file.
+// When Importer.Resume is set, the Report is saved to that file after each upload,
+// allowing an interrupted import to continue with the same lease, uploading only the remaining files.
+type Report struct {
+	Name    string                        `json:"name"`
+	Entity  *types.ManagedObjectReference `json:"entity,omitempty"`
+	Lease   *types.ManagedObjectReference `json:"lease,omitempty"`
+	Resumed bool                          `json:"resumed"`
+	Files   []*FileReport                 `json:"files"`
+}
+
+// FileReport describes the upload of a file referenced by the OVF.
+type FileReport struct {
+	Path     string `json:"path"`
+	DeviceID string `json:"deviceId"`
+	Size     int64  `json:"size"`
+	Uploaded bool   `json:"uploaded"`
+	// Skipped is true if the file was uploaded by a previous attempt of a resumed import.
+	Skipped bool `json:"skipped,omitempty"`
+	// Algorithm and Checksum are computed by the client while uploading the file.
+	Algorithm string `json:"algorithm,omitempty"`
+	Checksum  string `json:"checksum,omitempty"`
+	// Retries is the number of times the upload was retried after a transient error.
+	Retries int `json:"retries,omitempty"`
+	// Verified is true if Checksum matches the manifest (.mf) entry of the file.
+	Verified bool `json:"verified"`
+}
+
+func newReport(name string, lease *nfc.Lease, info *nfc.LeaseInfo) *Report {
+	ref := lease.Reference()
+
+	r := &Report{
+		Name:   name,
+		Entity: &info.Entity,
+		Lease:  &ref,
+	}
+
+	for _, item := range info.Items {
+		r.Files = append(r.Files, &FileReport{
+			Path:     item.Path,
+			DeviceID: item.DeviceId,
+			Size:     item.Size,
+		})
+	}
+
+	return r
+}
+
+// file returns the report of the given item, adding one if not found.
+func (r *Report) file(item nfc.FileItem) *FileReport {
+	for _, f := range r.Files {
+		if f.DeviceID == item.DeviceId && f.Path == item.Path {
+			return f
+		}
+	}
+
+	f := &FileReport{Path: item.Path, DeviceID: item.DeviceId, Size: item.Size}
+	r.Files = append(r.Files, f)
+	return f
+}
+
+// readReport returns the Report saved to the given file, or nil if the file does not exist.
+func readReport(name string) (*Report, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var r Report
+	if err = json.NewDecoder(f).Decode(&r); err != nil {
+		return nil, fmt.Errorf("resume %s: %s", name, err)
+	}
+
+	return &r, nil
+}
+
+func (r *Report) save(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, b, 0600)
+}
+
+// newHash returns a hash for the given manifest checksum algorithm, defaulting to SHA256.
+func newHash(algorithm string) (string, hash.Hash, error) {
+	switch strings.ToUpper(algorithm) {
+	case "SHA1":
+		return "SHA1", sha1.New(), nil
+	case "SHA256", "":
+		return "SHA256", sha256.New(), nil
+	case "SHA512":
+		return "SHA512", sha512.New(), nil
+	default:
+		return "", nil, fmt.Errorf("unsupported manifest checksum algorithm %q", algorithm)
+	}
+}
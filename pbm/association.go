@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pbm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/pbm/types"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+// VirtualMachinePolicy is the storage policy associated with a VM home and each of its virtual disks.
+// An empty policy ID means no policy is associated.
+type VirtualMachinePolicy struct {
+	VirtualMachine vim.ManagedObjectReference `json:"virtualMachine"`
+	Home           string                     `json:"home"`
+	Disk           map[int32]string           `json:"disk"`
+}
+
+// Compliant returns true if the VM home and all virtual disks are associated with the given policy ID.
+func (p *VirtualMachinePolicy) Compliant(id string) bool {
+	if p.Home != id {
+		return false
+	}
+
+	for _, disk := range p.Disk {
+		if disk != id {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PolicyReconcileResult is the result of applying a storage policy to a VM via ApplyVirtualMachinePolicy.
+type PolicyReconcileResult struct {
+	VirtualMachine vim.ManagedObjectReference `json:"virtualMachine"`
+	Before         VirtualMachinePolicy       `json:"before"`
+	After          VirtualMachinePolicy       `json:"after"`
+	Changed        bool                       `json:"changed"`
+	Error          error                      `json:"-"`
+}
+
+// VirtualMachineServerObjectRef returns the PbmServerObjectRef for a VM home.
+func VirtualMachineServerObjectRef(vm vim.ManagedObjectReference) types.PbmServerObjectRef {
+	return types.PbmServerObjectRef{
+		ObjectType: string(types.PbmObjectTypeVirtualMachine),
+		Key:        vm.Value,
+	}
+}
+
+// VirtualDiskServerObjectRef returns the PbmServerObjectRef for a VM's virtual disk.
+func VirtualDiskServerObjectRef(vm vim.ManagedObjectReference, key int32) types.PbmServerObjectRef {
+	return types.PbmServerObjectRef{
+		ObjectType: string(types.PbmObjectTypeVirtualDiskId),
+		Key:        fmt.Sprintf("%s:%d", vm.Value, key),
+	}
+}
+
+func virtualDisks(ctx context.Context, c *vim25.Client, refs []vim.ManagedObjectReference) ([]mo.VirtualMachine, error) {
+	var vms []mo.VirtualMachine
+
+	pc := property.DefaultCollector(c)
+	err := pc.Retrieve(ctx, refs, []string{"config.hardware.device"}, &vms)
+	if err != nil {
+		return nil, err
+	}
+
+	return vms, nil
+}
+
+// QueryVirtualMachinePolicy returns the storage policy associated with the home and each virtual disk of the given VMs,
+// using a single QueryAssociatedProfiles call.
+func (c *Client) QueryVirtualMachinePolicy(ctx context.Context, vc *vim25.Client, refs []vim.ManagedObjectReference) ([]VirtualMachinePolicy, error) {
+	vms, err := virtualDisks(ctx, vc, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []types.PbmServerObjectRef
+	policy := make(map[vim.ManagedObjectReference]*VirtualMachinePolicy, len(vms))
+
+	for _, vm := range vms {
+		policy[vm.Self] = &VirtualMachinePolicy{
+			VirtualMachine: vm.Self,
+			Disk:           make(map[int32]string),
+		}
+
+		entities = append(entities, VirtualMachineServerObjectRef(vm.Self))
+
+		if vm.Config == nil {
+			continue
+		}
+
+		disks := object.VirtualDeviceList(vm.Config.Hardware.Device).SelectByType((*vim.VirtualDisk)(nil))
+		for _, disk := range disks {
+			key := disk.GetVirtualDevice().Key
+			policy[vm.Self].Disk[key] = ""
+			entities = append(entities, VirtualDiskServerObjectRef(vm.Self, key))
+		}
+	}
+
+	results, err := c.QueryAssociatedProfiles(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range results {
+		if len(res.ProfileId) == 0 {
+			continue
+		}
+		id := res.ProfileId[0].UniqueId
+
+		vm, disk, isDisk := strings.Cut(res.Object.Key, ":")
+
+		p, ok := policy[vim.ManagedObjectReference{Type: "VirtualMachine", Value: vm}]
+		if !ok {
+			continue
+		}
+
+		switch types.PbmObjectType(res.Object.ObjectType) {
+		case types.PbmObjectTypeVirtualMachine:
+			p.Home = id
+		case types.PbmObjectTypeVirtualDiskId:
+			key, err := strconv.Atoi(disk)
+			if isDisk && err == nil {
+				p.Disk[int32(key)] = id
+			}
+		}
+	}
+
+	res := make([]VirtualMachinePolicy, 0, len(refs))
+	for _, ref := range refs {
+		if p, ok := policy[ref]; ok {
+			res = append(res, *p)
+		}
+	}
+
+	return res, nil
+}
+
+// ApplyVirtualMachinePolicy associates the given storage policy ID with the home and all virtual disks of the given VMs.
+// VMs that are already compliant are not reconfigured. A failure to reconfigure a VM is recorded in its
+// PolicyReconcileResult and does not stop the remaining VMs from being reconfigured.
+func (c *Client) ApplyVirtualMachinePolicy(ctx context.Context, vc *vim25.Client, refs []vim.ManagedObjectReference, id string) ([]PolicyReconcileResult, error) {
+	before, err := c.QueryVirtualMachinePolicy(ctx, vc, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	vms, err := virtualDisks(ctx, vc, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(map[vim.ManagedObjectReference]object.VirtualDeviceList, len(vms))
+	for _, vm := range vms {
+		if vm.Config != nil {
+			devices[vm.Self] = object.VirtualDeviceList(vm.Config.Hardware.Device)
+		}
+	}
+
+	profile := []vim.BaseVirtualMachineProfileSpec{
+		&vim.VirtualMachineDefinedProfileSpec{ProfileId: id},
+	}
+
+	results := make([]PolicyReconcileResult, len(before))
+
+	for i, p := range before {
+		results[i] = PolicyReconcileResult{
+			VirtualMachine: p.VirtualMachine,
+			Before:         p,
+		}
+
+		if p.Compliant(id) {
+			continue
+		}
+
+		spec := vim.VirtualMachineConfigSpec{VmProfile: profile}
+
+		disks := devices[p.VirtualMachine].SelectByType((*vim.VirtualDisk)(nil))
+		for _, disk := range disks {
+			spec.DeviceChange = append(spec.DeviceChange, &vim.VirtualDeviceConfigSpec{
+				Operation: vim.VirtualDeviceConfigSpecOperationEdit,
+				Device:    disk,
+				Profile:   profile,
+			})
+		}
+
+		task, err := object.NewVirtualMachine(vc, p.VirtualMachine).Reconfigure(ctx, spec)
+		if err == nil {
+			err = task.Wait(ctx)
+		}
+
+		results[i].Error = err
+		results[i].Changed = err == nil
+	}
+
+	after, err := c.QueryVirtualMachinePolicy(ctx, vc, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range after {
+		results[i].After = after[i]
+	}
+
+	return results, nil
+}
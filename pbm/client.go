@@ -19,6 +19,7 @@ package pbm
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/vmware/govmomi/pbm/methods"
 	"github.com/vmware/govmomi/pbm/types"
@@ -114,6 +115,40 @@ func (c *Client) CheckRequirements(ctx context.Context, hubs []types.PbmPlacemen
 	return res.Returnval, nil
 }
 
+// CheckCompatibility checks the placement compatibility of the given hubs with the storage requirement profile.
+// If no hubs are given, all datastores and storage pods are checked.
+func (c *Client) CheckCompatibility(ctx context.Context, hubs []types.PbmPlacementHub, id types.PbmProfileId) (PlacementCompatibilityResult, error) {
+	req := types.PbmCheckCompatibility{
+		This:         c.ServiceContent.PlacementSolver,
+		HubsToSearch: hubs,
+		Profile:      id,
+	}
+
+	res, err := methods.PbmCheckCompatibility(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// QueryMatchingHub returns the hubs that are compatible with the storage requirement profile.
+// If no hubs are given, all datastores and storage pods are searched.
+func (c *Client) QueryMatchingHub(ctx context.Context, hubs []types.PbmPlacementHub, id types.PbmProfileId) ([]types.PbmPlacementHub, error) {
+	req := types.PbmQueryMatchingHub{
+		This:         c.ServiceContent.PlacementSolver,
+		HubsToSearch: hubs,
+		Profile:      id,
+	}
+
+	res, err := methods.PbmQueryMatchingHub(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
 func (l PlacementCompatibilityResult) CompatibleDatastores() []types.PbmPlacementHub {
 	var compatibleDatastores []types.PbmPlacementHub
 
@@ -136,6 +171,74 @@ func (l PlacementCompatibilityResult) NonCompatibleDatastores() []types.PbmPlace
 	return nonCompatibleDatastores
 }
 
+// HubCompatibility explains the compatibility of a placement hub with a storage profile.
+type HubCompatibility struct {
+	Hub        types.PbmPlacementHub `json:"hub"`
+	Compatible bool                  `json:"compatible"`
+	Errors     []string              `json:"errors,omitempty"`
+	Warnings   []string              `json:"warnings,omitempty"`
+}
+
+// Explain returns the human-readable reasons for each hub being incompatible with, or having warnings about,
+// the storage profile requirements.
+func (l PlacementCompatibilityResult) Explain() []HubCompatibility {
+	res := make([]HubCompatibility, len(l))
+
+	for i, r := range l {
+		res[i] = HubCompatibility{
+			Hub:        r.Hub,
+			Compatible: len(r.Error) == 0,
+		}
+		for _, f := range r.Error {
+			res[i].Errors = append(res[i].Errors, FaultMessage(f))
+		}
+		for _, f := range r.Warning {
+			res[i].Warnings = append(res[i].Warnings, FaultMessage(f))
+		}
+	}
+
+	return res
+}
+
+func hubName(hub types.PbmPlacementHub) string {
+	return hub.HubType + ":" + hub.HubId
+}
+
+// FaultMessage returns a human-readable message for the given placement compatibility fault.
+// The server provided LocalizedMessage is used when set, otherwise a message is derived from the fault details.
+func FaultMessage(f vim.LocalizedMethodFault) string {
+	if f.LocalizedMessage != "" {
+		return f.LocalizedMessage
+	}
+
+	switch fault := f.Fault.(type) {
+	case *types.PbmCapabilityProfilePropertyMismatchFault:
+		return fmt.Sprintf("%s: capability %s.%s property %q requires %v, but provides %v",
+			hubName(fault.Hub), fault.CapabilityInstanceId.Namespace, fault.CapabilityInstanceId.Id,
+			fault.RequirementPropertyInstance.Id, fault.RequirementPropertyInstance.Value, fault.ResourcePropertyInstance.Value)
+	case *types.PbmIncompatibleVendorSpecificRuleSet:
+		return fmt.Sprintf("%s: vendor specific rule set %s.%s is incompatible",
+			hubName(fault.Hub), fault.CapabilityInstanceId.Namespace, fault.CapabilityInstanceId.Id)
+	case types.BasePbmPropertyMismatchFault:
+		m := fault.GetPbmPropertyMismatchFault()
+		return fmt.Sprintf("%s: capability %s.%s property %q requires %v",
+			hubName(m.Hub), m.CapabilityInstanceId.Namespace, m.CapabilityInstanceId.Id,
+			m.RequirementPropertyInstance.Id, m.RequirementPropertyInstance.Value)
+	case types.BasePbmCompatibilityCheckFault:
+		return fmt.Sprintf("%s: not compatible with the storage profile", hubName(fault.GetPbmCompatibilityCheckFault().Hub))
+	case *types.PbmNonExistentHubs:
+		names := make([]string, len(fault.Hubs))
+		for i := range fault.Hubs {
+			names[i] = hubName(fault.Hubs[i])
+		}
+		return fmt.Sprintf("hubs do not exist: %s", strings.Join(names, ", "))
+	case nil:
+		return "unknown fault"
+	}
+
+	return strings.TrimPrefix(fmt.Sprintf("%T", f.Fault), "*types.")
+}
+
 func (c *Client) CreateProfile(ctx context.Context, capabilityProfileCreateSpec types.PbmCapabilityProfileCreateSpec) (*types.PbmProfileId, error) {
 	req := types.PbmCreate{
 		This:       c.ServiceContent.ProfileManager,
@@ -313,3 +416,60 @@ func (c *Client) QueryAssociatedProfiles(ctx context.Context, entities []types.P
 
 	return res.Returnval, nil
 }
+
+// DatastoreHub returns the PbmPlacementHub for the given Datastore or StoragePod reference.
+func DatastoreHub(ref vim.ManagedObjectReference) types.PbmPlacementHub {
+	return types.PbmPlacementHub{
+		HubType: ref.Type,
+		HubId:   ref.Value,
+	}
+}
+
+func (c *Client) QueryDefaultRequirementProfile(ctx context.Context, hub types.PbmPlacementHub) (*types.PbmProfileId, error) {
+	req := types.PbmQueryDefaultRequirementProfile{
+		This: c.ServiceContent.ProfileManager,
+		Hub:  hub,
+	}
+
+	res, err := methods.PbmQueryDefaultRequirementProfile(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+func (c *Client) QueryDefaultRequirementProfiles(ctx context.Context, datastores []types.PbmPlacementHub) ([]types.PbmDefaultProfileInfo, error) {
+	req := types.PbmQueryDefaultRequirementProfiles{
+		This:       c.ServiceContent.ProfileManager,
+		Datastores: datastores,
+	}
+
+	res, err := methods.PbmQueryDefaultRequirementProfiles(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+func (c *Client) AssignDefaultRequirementProfile(ctx context.Context, id types.PbmProfileId, datastores []types.PbmPlacementHub) error {
+	req := types.PbmAssignDefaultRequirementProfile{
+		This:       c.ServiceContent.ProfileManager,
+		Profile:    id,
+		Datastores: datastores,
+	}
+
+	_, err := methods.PbmAssignDefaultRequirementProfile(ctx, c, &req)
+	return err
+}
+
+func (c *Client) ResetDefaultRequirementProfile(ctx context.Context, id *types.PbmProfileId) error {
+	req := types.PbmResetDefaultRequirementProfile{
+		This:    c.ServiceContent.ProfileManager,
+		Profile: id,
+	}
+
+	_, err := methods.PbmResetDefaultRequirementProfile(ctx, c, &req)
+	return err
+}
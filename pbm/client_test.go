@@ -313,3 +313,54 @@ func TestClient(t *testing.T) {
 	}
 	t.Logf("Profile: %+v successfully deleted", []types.PbmProfileId{*vsanProfileID, *vsansiocProfileID})
 }
+
+func TestPlacementCompatibilityExplain(t *testing.T) {
+	hub := types.PbmPlacementHub{HubType: "Datastore", HubId: "datastore-1"}
+	capability := types.PbmCapabilityMetadataUniqueId{Namespace: "VSAN", Id: "hostFailuresToTolerate"}
+
+	res := PlacementCompatibilityResult{
+		{
+			Hub: types.PbmPlacementHub{HubType: "Datastore", HubId: "datastore-0"},
+			Warning: []vim.LocalizedMethodFault{
+				{Fault: new(vim.NotSupported), LocalizedMessage: "storage I/O control is disabled"},
+			},
+		},
+		{
+			Hub: hub,
+			Error: []vim.LocalizedMethodFault{
+				{Fault: &types.PbmCapabilityProfilePropertyMismatchFault{
+					PbmPropertyMismatchFault: types.PbmPropertyMismatchFault{
+						PbmCompatibilityCheckFault:  types.PbmCompatibilityCheckFault{Hub: hub},
+						CapabilityInstanceId:        capability,
+						RequirementPropertyInstance: types.PbmCapabilityPropertyInstance{Id: "hostFailuresToTolerate", Value: int32(2)},
+					},
+					ResourcePropertyInstance: types.PbmCapabilityPropertyInstance{Id: "hostFailuresToTolerate", Value: int32(1)},
+				}},
+				{Fault: &types.PbmNonExistentHubs{Hubs: []types.PbmPlacementHub{hub}}},
+				{Fault: new(vim.NotFound)},
+			},
+		},
+	}
+
+	expect := []HubCompatibility{
+		{
+			Hub:        res[0].Hub,
+			Compatible: true,
+			Warnings:   []string{"storage I/O control is disabled"},
+		},
+		{
+			Hub:        hub,
+			Compatible: false,
+			Errors: []string{
+				`Datastore:datastore-1: capability VSAN.hostFailuresToTolerate property "hostFailuresToTolerate" requires 2, but provides 1`,
+				"hubs do not exist: Datastore:datastore-1",
+				"NotFound",
+			},
+		},
+	}
+
+	explain := res.Explain()
+	if !reflect.DeepEqual(explain, expect) {
+		t.Errorf("explain=%#v", explain)
+	}
+}
@@ -0,0 +1,324 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/vmware/govmomi/pbm"
+	"github.com/vmware/govmomi/pbm/types"
+	"github.com/vmware/govmomi/simulator"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+// dataServiceNamespace is the capability namespace of data service (I/O filter) rules,
+// such as VM Encryption, which are provided by hosts rather than datastores.
+const dataServiceNamespace = "com.vmware.storageprofile.dataservice"
+
+// tagNamespace is the capability namespace of tag based placement rules,
+// where the capability ID is a tag category name and the property value is the set of tag names.
+const tagNamespace = "http://www.vmware.com/storage/tag"
+
+// consumptionDomainNamespace is the capability namespace of storage topology rules, such as Zonal,
+// which depend on the Supervisor zones a datastore is accessible from, rather than the datastore itself.
+const consumptionDomainNamespace = "com.vmware.storage.consumptiondomain"
+
+// AssignCapabilityProfile assigns the RESOURCE category capability profile to the given datastore.
+// The profile describes the capabilities the datastore provides when checking placement compatibility
+// against requirement profiles via PbmCheckCompatibility, PbmCheckRequirements and PbmQueryMatchingHub.
+// Property values of the profile can be a scalar, a PbmCapabilityRange or a PbmCapabilityDiscreteSet.
+// A nil profile resets the datastore to the capabilities of its type, where datastores of type "vsan"
+// provide the vSAN capabilities, datastores of type "PMEM" provide the PMem capabilities and
+// datastores of any other type provide no capabilities.
+func (m *PlacementSolver) AssignCapabilityProfile(ds vim.ManagedObjectReference, profile *types.PbmCapabilityProfile) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if profile == nil {
+		delete(m.capabilities, ds.Value)
+		return
+	}
+
+	m.capabilities[ds.Value] = profile
+}
+
+// capabilityProfile returns the capability profile of the given datastore.
+func (m *PlacementSolver) capabilityProfile(ctx *simulator.Context, ref vim.ManagedObjectReference) *types.PbmCapabilityProfile {
+	m.mu.Lock()
+	profile, ok := m.capabilities[ref.Value]
+	m.mu.Unlock()
+	if ok {
+		return profile
+	}
+
+	ds, ok := simulator.Map.Get(ref).(*simulator.Datastore)
+	if !ok {
+		return nil
+	}
+
+	var kind string
+	simulator.Map.WithLock(ctx, ds, func() {
+		kind = ds.Summary.Type
+	})
+
+	switch vim.HostFileSystemVolumeFileSystemType(kind) {
+	case vim.HostFileSystemVolumeFileSystemTypeVsan:
+		return vsanCapabilityProfile
+	case vim.HostFileSystemVolumeFileSystemTypePMEM:
+		return pmemCapabilityProfile
+	}
+
+	return nil
+}
+
+// placementHubs returns the given hubs, or all datastores if none are given.
+func placementHubs(hubs []types.PbmPlacementHub) []types.PbmPlacementHub {
+	if len(hubs) != 0 {
+		return hubs
+	}
+
+	for _, ds := range simulator.Map.All("Datastore") {
+		hubs = append(hubs, pbm.DatastoreHub(ds.Reference()))
+	}
+
+	return hubs
+}
+
+// checkCompatibility returns the placement compatibility of each hub with all of the given requirement constraints.
+func (m *PlacementSolver) checkCompatibility(ctx *simulator.Context, hubs []types.PbmPlacementHub, constraints []types.BasePbmCapabilityConstraints) []types.PbmPlacementCompatibilityResult {
+	var res []types.PbmPlacementCompatibilityResult
+
+	for _, hub := range placementHubs(hubs) {
+		ref := vim.ManagedObjectReference{Type: hub.HubType, Value: hub.HubId}
+		capabilities := resourceCapabilities(m.capabilityProfile(ctx, ref))
+		capabilities = append(capabilities, tagCapabilities(ref)...)
+
+		result := types.PbmPlacementCompatibilityResult{Hub: hub}
+		for _, c := range constraints {
+			result.Error = append(result.Error, compatibilityFaults(hub, capabilities, c)...)
+		}
+
+		res = append(res, result)
+	}
+
+	return res
+}
+
+// resourceCapabilities returns the capability instances of all rule sets of the given capability profile.
+func resourceCapabilities(profile *types.PbmCapabilityProfile) []types.PbmCapabilityInstance {
+	if profile == nil {
+		return nil
+	}
+
+	constraints, ok := profile.Constraints.(*types.PbmCapabilitySubProfileConstraints)
+	if !ok {
+		return nil
+	}
+
+	var capabilities []types.PbmCapabilityInstance
+	for _, sub := range constraints.SubProfiles {
+		capabilities = append(capabilities, sub.Capability...)
+	}
+
+	return capabilities
+}
+
+// tagCapabilities returns a tag based placement capability instance for each category
+// of the tags attached to the given hub, providing the names of the attached tags.
+func tagCapabilities(ref vim.ManagedObjectReference) []types.PbmCapabilityInstance {
+	var categories []string
+	names := make(map[string][]vim.AnyType)
+
+	for _, tag := range simulator.Map.AttachedTags(ref) {
+		if _, ok := names[tag.ParentCategoryName]; !ok {
+			categories = append(categories, tag.ParentCategoryName)
+		}
+		names[tag.ParentCategoryName] = append(names[tag.ParentCategoryName], tag.TagName)
+	}
+
+	var capabilities []types.PbmCapabilityInstance
+	for _, category := range categories {
+		capabilities = append(capabilities, types.PbmCapabilityInstance{
+			Id: types.PbmCapabilityMetadataUniqueId{
+				Namespace: tagNamespace,
+				Id:        category,
+			},
+			Constraint: []types.PbmCapabilityConstraintInstance{{
+				PropertyInstance: []types.PbmCapabilityPropertyInstance{{
+					Id:    fmt.Sprintf("com.vmware.storage.tag.%s.property", category),
+					Value: types.PbmCapabilityDiscreteSet{Values: names[category]},
+				}},
+			}},
+		})
+	}
+
+	return capabilities
+}
+
+// compatibilityFaults returns the faults that make the hub incompatible with the requirement constraints, if any.
+// As with vCenter, the requirements are satisfied if any of the rule sets is satisfied,
+// where a rule set is satisfied if the hub provides all of its rules.
+func compatibilityFaults(hub types.PbmPlacementHub, capabilities []types.PbmCapabilityInstance, constraints types.BasePbmCapabilityConstraints) []vim.LocalizedMethodFault {
+	req, ok := constraints.(*types.PbmCapabilitySubProfileConstraints)
+	if !ok || len(req.SubProfiles) == 0 {
+		return nil
+	}
+
+	var faults []vim.LocalizedMethodFault
+
+	for _, sub := range req.SubProfiles {
+		f := ruleSetFaults(hub, capabilities, sub.Capability)
+		if len(f) == 0 {
+			return nil
+		}
+		faults = append(faults, f...)
+	}
+
+	return faults
+}
+
+// ruleSetFaults returns a fault for each requirement property the hub does not provide.
+// Data service and storage topology rules are not provided by datastores and are ignored.
+func ruleSetFaults(hub types.PbmPlacementHub, capabilities []types.PbmCapabilityInstance, rules []types.PbmCapabilityInstance) []vim.LocalizedMethodFault {
+	var faults []vim.LocalizedMethodFault
+
+	for _, rule := range rules {
+		switch rule.Id.Namespace {
+		case dataServiceNamespace, consumptionDomainNamespace:
+			continue
+		}
+
+		for _, constraint := range rule.Constraint {
+			for _, prop := range constraint.PropertyInstance {
+				mismatch := types.PbmPropertyMismatchFault{
+					PbmCompatibilityCheckFault:  types.PbmCompatibilityCheckFault{Hub: hub},
+					CapabilityInstanceId:        rule.Id,
+					RequirementPropertyInstance: prop,
+				}
+
+				var fault vim.BaseMethodFault = &mismatch
+
+				if resource, ok := findProperty(capabilities, rule.Id, prop.Id); ok {
+					if provides(resource.Value, prop.Value) {
+						continue
+					}
+					fault = &types.PbmCapabilityProfilePropertyMismatchFault{
+						PbmPropertyMismatchFault: mismatch,
+						ResourcePropertyInstance: *resource,
+					}
+				}
+
+				f := vim.LocalizedMethodFault{Fault: fault}
+				f.LocalizedMessage = pbm.FaultMessage(f)
+				faults = append(faults, f)
+			}
+		}
+	}
+
+	return faults
+}
+
+// findProperty returns the property of the given capability provided by a hub.
+func findProperty(capabilities []types.PbmCapabilityInstance, id types.PbmCapabilityMetadataUniqueId, prop string) (*types.PbmCapabilityPropertyInstance, bool) {
+	for _, c := range capabilities {
+		if c.Id != id {
+			continue
+		}
+		for _, constraint := range c.Constraint {
+			for i := range constraint.PropertyInstance {
+				if constraint.PropertyInstance[i].Id == prop {
+					return &constraint.PropertyInstance[i], true
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// provides returns true if the resource property value satisfies the requirement property value.
+// A PbmCapabilityDiscreteSet requirement is satisfied if any of its values is provided.
+// A PbmCapabilityRange requirement is satisfied if both its min and max values are provided.
+func provides(resource, requirement any) bool {
+	switch req := deref(requirement).(type) {
+	case types.PbmCapabilityDiscreteSet:
+		for _, val := range req.Values {
+			if provides(resource, val) {
+				return true
+			}
+		}
+		return false
+	case types.PbmCapabilityRange:
+		return provides(resource, req.Min) && provides(resource, req.Max)
+	}
+
+	switch res := deref(resource).(type) {
+	case types.PbmCapabilityDiscreteSet:
+		for _, val := range res.Values {
+			if equalValue(val, requirement) {
+				return true
+			}
+		}
+		return false
+	case types.PbmCapabilityRange:
+		val, ok := numeric(requirement)
+		if !ok {
+			return false
+		}
+		lo, loOK := numeric(res.Min)
+		hi, hiOK := numeric(res.Max)
+		return loOK && hiOK && val >= lo && val <= hi
+	}
+
+	return equalValue(resource, requirement)
+}
+
+// deref returns the value pointed to by val, if val is a pointer.
+func deref(val any) any {
+	rval := reflect.ValueOf(val)
+	if rval.Kind() == reflect.Pointer && !rval.IsNil() {
+		return rval.Elem().Interface()
+	}
+	return val
+}
+
+// numeric returns val as a float64, if val is a number.
+func numeric(val any) (float64, bool) {
+	rval := reflect.ValueOf(deref(val))
+
+	switch rval.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rval.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rval.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rval.Float(), true
+	}
+
+	return 0, false
+}
+
+// equalValue compares property values, where numbers of any type and size are compared by value.
+func equalValue(a, b any) bool {
+	if x, ok := numeric(a); ok {
+		y, ok := numeric(b)
+		return ok && x == y
+	}
+
+	return reflect.DeepEqual(deref(a), deref(b))
+}
@@ -23,12 +23,21 @@ import (
 	vim "github.com/vmware/govmomi/vim25/types"
 )
 
-// profiles is a captured from vCenter 6.7's default set of PBM profiles.
+// IDs of the system-created default requirement profiles for each datastore type.
+const (
+	vsanDefaultProfileID = "aa6d5a82-1c88-45da-85d3-3d74b91a5bad"
+	vvolDefaultProfileID = "f4e5bade-15a2-4805-bf8e-52318c4ce443"
+	pmemDefaultProfileID = "c268da1b-b343-49f7-a468-b1deeb7078e0"
+	vmfsDefaultProfileID = "b3f6e2e5-5c3b-4e1a-9b0e-7d8c0e6f4a21"
+)
+
+// profiles is a captured from vCenter 6.7's default set of PBM profiles,
+// along with the VMFS Default Storage Policy.
 var profiles = []types.BasePbmProfile{
 	&types.PbmCapabilityProfile{
 		PbmProfile: types.PbmProfile{
 			ProfileId: types.PbmProfileId{
-				UniqueId: "aa6d5a82-1c88-45da-85d3-3d74b91a5bad",
+				UniqueId: vsanDefaultProfileID,
 			},
 			Name:            "vSAN Default Storage Policy",
 			Description:     "Storage policy used as default for vSAN datastores",
@@ -145,7 +154,7 @@ var profiles = []types.BasePbmProfile{
 	&types.PbmCapabilityProfile{
 		PbmProfile: types.PbmProfile{
 			ProfileId: types.PbmProfileId{
-				UniqueId: "f4e5bade-15a2-4805-bf8e-52318c4ce443",
+				UniqueId: vvolDefaultProfileID,
 			},
 			Name:            "VVol No Requirements Policy",
 			Description:     "Allow the datastore to determine the best placement strategy for storage objects",
@@ -216,7 +225,7 @@ var profiles = []types.BasePbmProfile{
 	&types.PbmCapabilityProfile{
 		PbmProfile: types.PbmProfile{
 			ProfileId: types.PbmProfileId{
-				UniqueId: "c268da1b-b343-49f7-a468-b1deeb7078e0",
+				UniqueId: pmemDefaultProfileID,
 			},
 			Name:            "Host-local PMem Default Storage Policy",
 			Description:     "Storage policy used as default for Host-local PMem datastores",
@@ -262,4 +271,75 @@ var profiles = []types.BasePbmProfile{
 		SystemCreatedProfileType: "PmemDefaultProfile",
 		LineOfService:            "",
 	},
+	&types.PbmCapabilityProfile{
+		PbmProfile: types.PbmProfile{
+			ProfileId: types.PbmProfileId{
+				UniqueId: vmfsDefaultProfileID,
+			},
+			Name:            "VMFS Default Storage Policy",
+			Description:     "Storage policy used as default for VMFS datastores",
+			CreationTime:    time.Now(),
+			CreatedBy:       "Temporary user handle",
+			LastUpdatedTime: time.Now(),
+			LastUpdatedBy:   "Temporary user handle",
+		},
+		ProfileCategory: "REQUIREMENT",
+		ResourceType: types.PbmProfileResourceType{
+			ResourceType: "STORAGE",
+		},
+		Constraints:              &types.PbmCapabilityConstraints{},
+		GenerationId:             0,
+		IsDefault:                false,
+		SystemCreatedProfileType: "",
+		LineOfService:            "",
+	},
+}
+
+// vsanCapabilityProfile is the RESOURCE category profile of vsanDatastores,
+// providing the range of values supported by the vSAN Default Storage Policy capabilities.
+var vsanCapabilityProfile = resourceProfile("vSAN capabilities", "VSAN", map[string]vim.AnyType{
+	"hostFailuresToTolerate": types.PbmCapabilityRange{Min: int32(0), Max: int32(3)},
+	"stripeWidth":            types.PbmCapabilityRange{Min: int32(1), Max: int32(12)},
+	"forceProvisioning":      types.PbmCapabilityDiscreteSet{Values: []vim.AnyType{true, false}},
+	"proportionalCapacity":   types.PbmCapabilityRange{Min: int32(0), Max: int32(100)},
+	"cacheReservation":       types.PbmCapabilityRange{Min: int32(0), Max: int32(1000000)},
+})
+
+// pmemCapabilityProfile is the RESOURCE category profile of PMem datastores.
+var pmemCapabilityProfile = resourceProfile("PMem capabilities", "PMem", map[string]vim.AnyType{
+	"PMemType": "LocalPMem",
+})
+
+// resourceProfile returns a RESOURCE category profile with a capability per property,
+// where the capability and property share the same id as with the vSAN and PMem capabilities.
+func resourceProfile(name, namespace string, props map[string]vim.AnyType) *types.PbmCapabilityProfile {
+	sub := types.PbmCapabilitySubProfile{Name: name}
+
+	for id, val := range props {
+		sub.Capability = append(sub.Capability, types.PbmCapabilityInstance{
+			Id: types.PbmCapabilityMetadataUniqueId{
+				Namespace: namespace,
+				Id:        id,
+			},
+			Constraint: []types.PbmCapabilityConstraintInstance{{
+				PropertyInstance: []types.PbmCapabilityPropertyInstance{{
+					Id:    id,
+					Value: val,
+				}},
+			}},
+		})
+	}
+
+	return &types.PbmCapabilityProfile{
+		PbmProfile: types.PbmProfile{
+			Name: name,
+		},
+		ProfileCategory: string(types.PbmProfileCategoryEnumRESOURCE),
+		ResourceType: types.PbmProfileResourceType{
+			ResourceType: string(types.PbmProfileResourceTypeEnumSTORAGE),
+		},
+		Constraints: &types.PbmCapabilitySubProfileConstraints{
+			SubProfiles: []types.PbmCapabilitySubProfile{sub},
+		},
+	}
 }
@@ -17,6 +17,9 @@ limitations under the License.
 package simulator
 
 import (
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -63,10 +66,12 @@ func New() *simulator.Registry {
 
 	r.Put(&ProfileManager{
 		ManagedObjectReference: content.ProfileManager,
+		defaults:               make(map[string]string),
 	})
 
 	r.Put(&PlacementSolver{
 		ManagedObjectReference: content.PlacementSolver,
+		capabilities:           make(map[string]*types.PbmCapabilityProfile),
 	})
 
 	return r
@@ -88,6 +93,9 @@ func (s *ServiceInstance) PbmRetrieveServiceContent(_ *types.PbmRetrieveServiceC
 
 type ProfileManager struct {
 	vim.ManagedObjectReference
+
+	// defaults maps a datastore ID to its assigned default requirement profile ID
+	defaults map[string]string
 }
 
 func (m *ProfileManager) PbmQueryProfile(req *types.PbmQueryProfile) soap.HasFault {
@@ -119,17 +127,210 @@ func (m *ProfileManager) PbmQueryProfile(req *types.PbmQueryProfile) soap.HasFau
 	return body
 }
 
-func (m *ProfileManager) PbmQueryAssociatedProfile(req *types.PbmQueryAssociatedProfile) soap.HasFault {
+// associatedProfile returns the storage policy applied to the VM home or virtual disk referenced by entity.
+func associatedProfile(ctx *simulator.Context, entity types.PbmServerObjectRef) []types.PbmProfileId {
+	var ref vim.ManagedObjectReference
+	var key int32
+
+	switch types.PbmObjectType(entity.ObjectType) {
+	case types.PbmObjectTypeVirtualMachine:
+		ref = vim.ManagedObjectReference{Type: "VirtualMachine", Value: entity.Key}
+	case types.PbmObjectTypeVirtualDiskId:
+		id, disk, ok := strings.Cut(entity.Key, ":")
+		if !ok {
+			return nil
+		}
+		n, err := strconv.Atoi(disk)
+		if err != nil {
+			return nil
+		}
+		ref = vim.ManagedObjectReference{Type: "VirtualMachine", Value: id}
+		key = int32(n)
+	default:
+		return nil
+	}
+
+	vm, ok := simulator.Map.Get(ref).(*simulator.VirtualMachine)
+	if !ok {
+		return nil
+	}
+
+	var id string
+	simulator.Map.WithLock(ctx, vm, func() {
+		id = vm.Profile[key]
+	})
+	if id == "" {
+		return nil
+	}
+
+	return []types.PbmProfileId{{UniqueId: id}}
+}
+
+func (m *ProfileManager) PbmQueryAssociatedProfile(ctx *simulator.Context, req *types.PbmQueryAssociatedProfile) soap.HasFault {
 	body := new(methods.PbmQueryAssociatedProfileBody)
 	body.Res = new(types.PbmQueryAssociatedProfileResponse)
 
+	body.Res.Returnval = associatedProfile(ctx, req.Entity)
+
 	return body
 }
 
-func (m *ProfileManager) PbmQueryAssociatedProfiles(req *types.PbmQueryAssociatedProfiles) soap.HasFault {
+func (m *ProfileManager) PbmQueryAssociatedProfiles(ctx *simulator.Context, req *types.PbmQueryAssociatedProfiles) soap.HasFault {
 	body := new(methods.PbmQueryAssociatedProfilesBody)
 	body.Res = new(types.PbmQueryAssociatedProfilesResponse)
 
+	for _, entity := range req.Entities {
+		body.Res.Returnval = append(body.Res.Returnval, types.PbmQueryProfileResult{
+			Object:    entity,
+			ProfileId: associatedProfile(ctx, entity),
+		})
+	}
+
+	return body
+}
+
+func findProfile(id string) types.BasePbmProfile {
+	for _, p := range profiles {
+		if id == p.GetPbmProfile().ProfileId.UniqueId {
+			return p
+		}
+	}
+	return nil
+}
+
+// nonExistentHubs returns a PbmNonExistentHubs fault if any of the given hubs is not a Datastore or StoragePod.
+func nonExistentHubs(hubs []types.PbmPlacementHub) *types.PbmNonExistentHubs {
+	var missing []types.PbmPlacementHub
+
+	for _, hub := range hubs {
+		ref := vim.ManagedObjectReference{Type: hub.HubType, Value: hub.HubId}
+		switch ref.Type {
+		case "Datastore", "StoragePod":
+			if simulator.Map.Get(ref) != nil {
+				continue
+			}
+		}
+		missing = append(missing, hub)
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return &types.PbmNonExistentHubs{Hubs: missing}
+}
+
+// defaultProfile returns the ID of the default requirement profile of the given datastore.
+// Datastores without an assigned default use the system-created default profile of their type:
+// the vSAN, VVol, PMem and VMFS Default Storage Policies. Datastores of any other type have no default.
+func (m *ProfileManager) defaultProfile(ctx *simulator.Context, id string) string {
+	if profile, ok := m.defaults[id]; ok {
+		return profile
+	}
+
+	ds, ok := simulator.Map.Get(vim.ManagedObjectReference{Type: "Datastore", Value: id}).(*simulator.Datastore)
+	if !ok {
+		return ""
+	}
+
+	var kind string
+	simulator.Map.WithLock(ctx, ds, func() {
+		kind = ds.Summary.Type
+	})
+
+	switch vim.HostFileSystemVolumeFileSystemType(kind) {
+	case vim.HostFileSystemVolumeFileSystemTypeVsan:
+		return vsanDefaultProfileID
+	case vim.HostFileSystemVolumeFileSystemTypeVVOL:
+		return vvolDefaultProfileID
+	case vim.HostFileSystemVolumeFileSystemTypePMEM:
+		return pmemDefaultProfileID
+	case vim.HostFileSystemVolumeFileSystemTypeVMFS:
+		return vmfsDefaultProfileID
+	}
+
+	return ""
+}
+
+func (m *ProfileManager) PbmQueryDefaultRequirementProfile(ctx *simulator.Context, req *types.PbmQueryDefaultRequirementProfile) soap.HasFault {
+	body := new(methods.PbmQueryDefaultRequirementProfileBody)
+
+	if fault := nonExistentHubs([]types.PbmPlacementHub{req.Hub}); fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.PbmQueryDefaultRequirementProfileResponse)
+	if id := m.defaultProfile(ctx, req.Hub.HubId); id != "" {
+		body.Res.Returnval = &types.PbmProfileId{UniqueId: id}
+	}
+
+	return body
+}
+
+func (m *ProfileManager) PbmQueryDefaultRequirementProfiles(ctx *simulator.Context, req *types.PbmQueryDefaultRequirementProfiles) soap.HasFault {
+	body := new(methods.PbmQueryDefaultRequirementProfilesBody)
+
+	if fault := nonExistentHubs(req.Datastores); fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.PbmQueryDefaultRequirementProfilesResponse)
+
+	// group the datastores by default profile
+	index := make(map[string]int)
+	for _, hub := range req.Datastores {
+		id := m.defaultProfile(ctx, hub.HubId)
+		i, ok := index[id]
+		if !ok {
+			i = len(body.Res.Returnval)
+			index[id] = i
+			info := types.PbmDefaultProfileInfo{}
+			if id != "" {
+				info.DefaultProfile = findProfile(id)
+			}
+			body.Res.Returnval = append(body.Res.Returnval, info)
+		}
+		body.Res.Returnval[i].Datastores = append(body.Res.Returnval[i].Datastores, hub)
+	}
+
+	return body
+}
+
+func (m *ProfileManager) PbmAssignDefaultRequirementProfile(req *types.PbmAssignDefaultRequirementProfile) soap.HasFault {
+	body := new(methods.PbmAssignDefaultRequirementProfileBody)
+
+	if findProfile(req.Profile.UniqueId) == nil {
+		body.Fault_ = simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "profile"})
+		return body
+	}
+
+	if fault := nonExistentHubs(req.Datastores); fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	for _, hub := range req.Datastores {
+		m.defaults[hub.HubId] = req.Profile.UniqueId
+	}
+
+	body.Res = new(types.PbmAssignDefaultRequirementProfileResponse)
+
+	return body
+}
+
+func (m *ProfileManager) PbmResetDefaultRequirementProfile(req *types.PbmResetDefaultRequirementProfile) soap.HasFault {
+	body := new(methods.PbmResetDefaultRequirementProfileBody)
+
+	for hub, id := range m.defaults {
+		if req.Profile == nil || req.Profile.UniqueId == id {
+			delete(m.defaults, hub)
+		}
+	}
+
+	body.Res = new(types.PbmResetDefaultRequirementProfileResponse)
+
 	return body
 }
 
@@ -209,6 +410,12 @@ func (m *ProfileManager) PbmDelete(req *types.PbmDelete) soap.HasFault {
 				break
 			}
 		}
+
+		for hub, def := range m.defaults {
+			if def == id.UniqueId {
+				delete(m.defaults, hub)
+			}
+		}
 	}
 
 	body.Res = new(types.PbmDeleteResponse)
@@ -218,49 +425,94 @@ func (m *ProfileManager) PbmDelete(req *types.PbmDelete) soap.HasFault {
 
 type PlacementSolver struct {
 	vim.ManagedObjectReference
+
+	mu sync.Mutex
+	// capabilities maps a datastore ID to the capability profile assigned via AssignCapabilityProfile
+	capabilities map[string]*types.PbmCapabilityProfile
 }
 
-func (m *PlacementSolver) PbmCheckRequirements(req *types.PbmCheckRequirements) soap.HasFault {
+func (m *PlacementSolver) PbmCheckRequirements(ctx *simulator.Context, req *types.PbmCheckRequirements) soap.HasFault {
 	body := new(methods.PbmCheckRequirementsBody)
-	body.Res = new(types.PbmCheckRequirementsResponse)
-
-	for _, ds := range simulator.Map.All("Datastore") {
-		// TODO: filter
-		ref := ds.Reference()
-		body.Res.Returnval = append(body.Res.Returnval, types.PbmPlacementCompatibilityResult{
-			Hub: types.PbmPlacementHub{
-				HubType: ref.Type,
-				HubId:   ref.Value,
-			},
-			MatchingResources: nil,
-			HowMany:           0,
-			Utilization:       nil,
-			Warning:           nil,
-			Error:             nil,
-		})
+
+	if fault := nonExistentHubs(req.HubsToSearch); fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	var constraints []types.BasePbmCapabilityConstraints
+
+	for _, r := range req.PlacementSubjectRequirement {
+		switch r := r.(type) {
+		case *types.PbmPlacementCapabilityProfileRequirement:
+			c, fault := requirementProfile(r.ProfileId)
+			if fault != nil {
+				body.Fault_ = fault
+				return body
+			}
+			constraints = append(constraints, c)
+		case *types.PbmPlacementCapabilityConstraintsRequirement:
+			constraints = append(constraints, r.Constraints)
+		}
+	}
+
+	body.Res = &types.PbmCheckRequirementsResponse{
+		Returnval: m.checkCompatibility(ctx, req.HubsToSearch, constraints),
 	}
 
 	return body
 }
 
-func (m *PlacementSolver) PbmCheckCompatibility(req *types.PbmCheckCompatibility) soap.HasFault {
+// requirementProfile returns the constraints of the requirement profile with the given id.
+func requirementProfile(id types.PbmProfileId) (types.BasePbmCapabilityConstraints, *soap.Fault) {
+	p, ok := findProfile(id.UniqueId).(*types.PbmCapabilityProfile)
+	if !ok {
+		return nil, simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "profile"})
+	}
+
+	return p.Constraints, nil
+}
+
+func (m *PlacementSolver) PbmCheckCompatibility(ctx *simulator.Context, req *types.PbmCheckCompatibility) soap.HasFault {
 	body := new(methods.PbmCheckCompatibilityBody)
-	body.Res = new(types.PbmCheckCompatibilityResponse)
-
-	for _, ds := range simulator.Map.All("Datastore") {
-		// TODO: filter
-		ref := ds.Reference()
-		body.Res.Returnval = append(body.Res.Returnval, types.PbmPlacementCompatibilityResult{
-			Hub: types.PbmPlacementHub{
-				HubType: ref.Type,
-				HubId:   ref.Value,
-			},
-			MatchingResources: nil,
-			HowMany:           0,
-			Utilization:       nil,
-			Warning:           nil,
-			Error:             nil,
-		})
+
+	if fault := nonExistentHubs(req.HubsToSearch); fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	constraints, fault := requirementProfile(req.Profile)
+	if fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
+	body.Res = &types.PbmCheckCompatibilityResponse{
+		Returnval: m.checkCompatibility(ctx, req.HubsToSearch, []types.BasePbmCapabilityConstraints{constraints}),
+	}
+
+	return body
+}
+
+func (m *PlacementSolver) PbmQueryMatchingHub(ctx *simulator.Context, req *types.PbmQueryMatchingHub) soap.HasFault {
+	body := new(methods.PbmQueryMatchingHubBody)
+
+	if fault := nonExistentHubs(req.HubsToSearch); fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	constraints, fault := requirementProfile(req.Profile)
+	if fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
+	body.Res = new(types.PbmQueryMatchingHubResponse)
+
+	for _, res := range m.checkCompatibility(ctx, req.HubsToSearch, []types.BasePbmCapabilityConstraints{constraints}) {
+		if len(res.Error) == 0 {
+			body.Res.Returnval = append(body.Res.Returnval, res.Hub)
+		}
 	}
 
 	return body
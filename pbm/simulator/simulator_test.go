@@ -24,11 +24,17 @@ import (
 	"testing"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/pbm"
 	"github.com/vmware/govmomi/pbm/types"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/rest"
+	_ "github.com/vmware/govmomi/vapi/simulator"
+	"github.com/vmware/govmomi/vapi/tags"
 	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
 	vim "github.com/vmware/govmomi/vim25/types"
 )
@@ -313,3 +319,497 @@ func TestSimulator(t *testing.T) {
 	}
 	t.Logf("Profile: %+v successfully deleted", []types.PbmProfileId{*vsanProfileID, *vsansiocProfileID})
 }
+
+func TestVirtualMachinePolicy(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		pc, err := pbm.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		id := "aa6d5a82-1c88-45da-85d3-3d74b91a5bad" // vSAN Default Storage Policy
+		vms := simulator.Map.All("VirtualMachine")
+		refs := []vim.ManagedObjectReference{vms[0].Reference(), vms[1].Reference()}
+
+		policy, err := pc.QueryVirtualMachinePolicy(ctx, c, refs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(policy) != len(refs) {
+			t.Fatalf("policy=%d", len(policy))
+		}
+		for _, p := range policy {
+			if p.Home != "" || len(p.Disk) == 0 {
+				t.Errorf("policy=%#v", p)
+			}
+			if p.Compliant(id) {
+				t.Errorf("%s should not be compliant", p.VirtualMachine)
+			}
+		}
+
+		res, err := pc.ApplyVirtualMachinePolicy(ctx, c, refs, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range res {
+			if r.Error != nil || !r.Changed {
+				t.Errorf("%s: changed=%t, err=%v", r.VirtualMachine, r.Changed, r.Error)
+			}
+			if r.Before.Compliant(id) || !r.After.Compliant(id) {
+				t.Errorf("%s: before=%#v, after=%#v", r.VirtualMachine, r.Before, r.After)
+			}
+		}
+
+		// already compliant VMs are not reconfigured
+		res, err = pc.ApplyVirtualMachinePolicy(ctx, c, refs, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range res {
+			if r.Changed || !r.Before.Compliant(id) {
+				t.Errorf("%s: changed=%t, before=%#v", r.VirtualMachine, r.Changed, r.Before)
+			}
+		}
+	})
+}
+
+func TestDefaultRequirementProfile(t *testing.T) {
+	model := simulator.VPX()
+	model.Datastore = 2
+
+	err := model.Run(func(ctx context.Context, c *vim25.Client) error {
+		pc, err := pbm.NewClient(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		id := types.PbmProfileId{UniqueId: "aa6d5a82-1c88-45da-85d3-3d74b91a5bad"}
+
+		var hubs []types.PbmPlacementHub
+		for _, ds := range simulator.Map.All("Datastore") {
+			hubs = append(hubs, pbm.DatastoreHub(ds.Reference()))
+		}
+
+		def, err := pc.QueryDefaultRequirementProfile(ctx, hubs[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if def != nil {
+			t.Errorf("default=%#v", def)
+		}
+
+		err = pc.AssignDefaultRequirementProfile(ctx, id, hubs[:1])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		def, err = pc.QueryDefaultRequirementProfile(ctx, hubs[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if def == nil || def.UniqueId != id.UniqueId {
+			t.Errorf("default=%#v", def)
+		}
+
+		info, err := pc.QueryDefaultRequirementProfiles(ctx, hubs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(info) != 2 || info[0].DefaultProfile.GetPbmProfile().ProfileId != id || len(info[1].Datastores) != len(hubs)-1 {
+			t.Errorf("info=%#v", info)
+		}
+
+		err = pc.AssignDefaultRequirementProfile(ctx, types.PbmProfileId{UniqueId: "enoent"}, hubs)
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		_, err = pc.QueryDefaultRequirementProfile(ctx, types.PbmPlacementHub{HubType: "Datastore", HubId: "enoent"})
+		if !fault.Is(err, &types.PbmNonExistentHubs{}) {
+			t.Errorf("expected PbmNonExistentHubs, got: %v", err)
+		}
+
+		err = pc.ResetDefaultRequirementProfile(ctx, &id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		def, err = pc.QueryDefaultRequirementProfile(ctx, hubs[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if def != nil {
+			t.Errorf("default=%#v", def)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSystemDefaultRequirementProfile(t *testing.T) {
+	model := simulator.VPX()
+	model.Datastore = 3
+	model.Vsan = 1
+
+	err := model.Run(func(ctx context.Context, c *vim25.Client) error {
+		pc, err := pbm.NewClient(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		finder := find.NewFinder(c)
+		hub := func(name string, kind vim.HostFileSystemVolumeFileSystemType) types.PbmPlacementHub {
+			ds, err := finder.Datastore(ctx, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if kind != "" {
+				obj := simulator.Map.Get(ds.Reference()).(*simulator.Datastore)
+				obj.Summary.Type = string(kind)
+			}
+			return pbm.DatastoreHub(ds.Reference())
+		}
+
+		vsan := hub("vsanDatastore", "")
+		hubs := []types.PbmPlacementHub{
+			vsan,
+			hub("LocalDS_0", ""),
+			hub("LocalDS_1", vim.HostFileSystemVolumeFileSystemTypeVVOL),
+			hub("LocalDS_2", vim.HostFileSystemVolumeFileSystemTypeVMFS),
+		}
+
+		expect := []string{
+			"vSAN Default Storage Policy",
+			"",
+			"VVol No Requirements Policy",
+			"VMFS Default Storage Policy",
+		}
+
+		for i, hub := range hubs {
+			id, err := pc.QueryDefaultRequirementProfile(ctx, hub)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			name := ""
+			if id != nil {
+				profiles, err := pc.RetrieveContent(ctx, []types.PbmProfileId{*id})
+				if err != nil {
+					t.Fatal(err)
+				}
+				name = profiles[0].GetPbmProfile().Name
+			}
+
+			if name != expect[i] {
+				t.Errorf("%s default=%q", hub.HubId, name)
+			}
+		}
+
+		info, err := pc.QueryDefaultRequirementProfiles(ctx, hubs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(info) != len(hubs) {
+			t.Errorf("info=%d", len(info))
+		}
+
+		// an assigned default takes precedence until reset
+		encryption := types.PbmProfileId{UniqueId: "4d5f673c-536f-11e6-beb8-9e71128cae77"}
+		if err = pc.AssignDefaultRequirementProfile(ctx, encryption, []types.PbmPlacementHub{vsan}); err != nil {
+			t.Fatal(err)
+		}
+
+		id, err := pc.QueryDefaultRequirementProfile(ctx, vsan)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id == nil || *id != encryption {
+			t.Errorf("default=%#v", id)
+		}
+
+		if err = pc.ResetDefaultRequirementProfile(ctx, &encryption); err != nil {
+			t.Fatal(err)
+		}
+
+		id, err = pc.QueryDefaultRequirementProfile(ctx, vsan)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id == nil || id.UniqueId != vsanDefaultProfileID {
+			t.Errorf("default=%#v", id)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPlacementCompatibility(t *testing.T) {
+	model := simulator.VPX()
+	model.Vsan = 1
+
+	err := model.Run(func(ctx context.Context, c *vim25.Client) error {
+		r := New()
+		model.Service.RegisterSDK(r)
+		solver := r.Get(content.PlacementSolver).(*PlacementSolver)
+
+		pc, err := pbm.NewClient(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		finder := find.NewFinder(c)
+		vsan, err := finder.Datastore(ctx, "vsanDatastore")
+		if err != nil {
+			return err
+		}
+		local, err := finder.Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			return err
+		}
+
+		vsanPolicy := types.PbmProfileId{UniqueId: "aa6d5a82-1c88-45da-85d3-3d74b91a5bad"}
+		encryptionPolicy := types.PbmProfileId{UniqueId: "4d5f673c-536f-11e6-beb8-9e71128cae77"}
+
+		hubs := []types.PbmPlacementHub{pbm.DatastoreHub(vsan.Reference()), pbm.DatastoreHub(local.Reference())}
+
+		res, err := pc.CheckCompatibility(ctx, hubs, vsanPolicy)
+		if err != nil {
+			return err
+		}
+		if len(res) != 2 {
+			t.Fatalf("res=%#v", res)
+		}
+		if len(res[0].Error) != 0 {
+			t.Errorf("vsanDatastore errors=%v", res.Explain()[0].Errors)
+		}
+		if len(res[1].Error) == 0 {
+			t.Error("LocalDS_0 expected to be incompatible")
+		}
+		if _, ok := res[1].Error[0].Fault.(*types.PbmPropertyMismatchFault); !ok {
+			t.Errorf("fault=%T", res[1].Error[0].Fault)
+		}
+
+		matches, err := pc.QueryMatchingHub(ctx, nil, vsanPolicy)
+		if err != nil {
+			return err
+		}
+		if len(matches) != 1 || matches[0] != hubs[0] {
+			t.Errorf("matches=%v", matches)
+		}
+
+		// data service rules are provided by hosts
+		matches, err = pc.QueryMatchingHub(ctx, hubs, encryptionPolicy)
+		if err != nil {
+			return err
+		}
+		if len(matches) != len(hubs) {
+			t.Errorf("matches=%v", matches)
+		}
+
+		// a vSAN requirement outside the range provided by vsanDatastore
+		spec, err := pbm.CreateCapabilityProfileSpec(pbm.CapabilityProfileCreateSpec{
+			Name:     "vSAN FTT=5",
+			Category: string(types.PbmProfileCategoryEnumREQUIREMENT),
+			CapabilityList: []pbm.Capability{{
+				ID:        "hostFailuresToTolerate",
+				Namespace: "VSAN",
+				PropertyList: []pbm.Property{{
+					ID:       "hostFailuresToTolerate",
+					Value:    "5",
+					DataType: "int",
+				}},
+			}},
+		})
+		if err != nil {
+			return err
+		}
+		ftt, err := pc.CreateProfile(ctx, *spec)
+		if err != nil {
+			return err
+		}
+
+		res, err = pc.CheckCompatibility(ctx, hubs[:1], *ftt)
+		if err != nil {
+			return err
+		}
+		if len(res) != 1 || len(res[0].Error) != 1 {
+			t.Fatalf("res=%#v", res)
+		}
+		if _, ok := res[0].Error[0].Fault.(*types.PbmCapabilityProfilePropertyMismatchFault); !ok {
+			t.Errorf("fault=%T", res[0].Error[0].Fault)
+		}
+
+		// tag based placement
+		tag := func(name string) types.PbmCapabilityInstance {
+			return types.PbmCapabilityInstance{
+				Id: types.PbmCapabilityMetadataUniqueId{
+					Namespace: "http://www.vmware.com/storage/tag",
+					Id:        "tier",
+				},
+				Constraint: []types.PbmCapabilityConstraintInstance{{
+					PropertyInstance: []types.PbmCapabilityPropertyInstance{{
+						Id:    "com.vmware.storage.tag.tier.property",
+						Value: types.PbmCapabilityDiscreteSet{Values: []vim.AnyType{name}},
+					}},
+				}},
+			}
+		}
+
+		gold, err := pc.CreateProfile(ctx, types.PbmCapabilityProfileCreateSpec{
+			Name:         "gold",
+			Category:     string(types.PbmProfileCategoryEnumREQUIREMENT),
+			ResourceType: types.PbmProfileResourceType{ResourceType: string(types.PbmProfileResourceTypeEnumSTORAGE)},
+			Constraints: &types.PbmCapabilitySubProfileConstraints{
+				SubProfiles: []types.PbmCapabilitySubProfile{{
+					Name:       "Tag based placement",
+					Capability: []types.PbmCapabilityInstance{tag("gold")},
+				}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		matches, err = pc.QueryMatchingHub(ctx, nil, *gold)
+		if err != nil {
+			return err
+		}
+		if len(matches) != 0 {
+			t.Errorf("matches=%v", matches)
+		}
+
+		solver.AssignCapabilityProfile(local.Reference(), &types.PbmCapabilityProfile{
+			Constraints: &types.PbmCapabilitySubProfileConstraints{
+				SubProfiles: []types.PbmCapabilitySubProfile{{
+					Capability: []types.PbmCapabilityInstance{tag("gold")},
+				}},
+			},
+		})
+
+		matches, err = pc.QueryMatchingHub(ctx, nil, *gold)
+		if err != nil {
+			return err
+		}
+		if len(matches) != 1 || matches[0] != hubs[1] {
+			t.Errorf("matches=%v", matches)
+		}
+
+		solver.AssignCapabilityProfile(local.Reference(), nil)
+
+		matches, err = pc.QueryMatchingHub(ctx, nil, *gold)
+		if err != nil {
+			return err
+		}
+		if len(matches) != 0 {
+			t.Errorf("matches=%v", matches)
+		}
+
+		// tags attached via the vAPI simulator provide tag based placement capabilities
+		rc := rest.NewClient(c)
+		if err = rc.Login(ctx, simulator.DefaultLogin); err != nil {
+			return err
+		}
+		tm := tags.NewManager(rc)
+		category, err := tm.CreateCategory(ctx, &tags.Category{Name: "tier", Cardinality: "SINGLE"})
+		if err != nil {
+			return err
+		}
+		id, err := tm.CreateTag(ctx, &tags.Tag{Name: "gold", CategoryID: category})
+		if err != nil {
+			return err
+		}
+		if err = tm.AttachTag(ctx, id, local); err != nil {
+			return err
+		}
+
+		matches, err = pc.QueryMatchingHub(ctx, nil, *gold)
+		if err != nil {
+			return err
+		}
+		if len(matches) != 1 || matches[0] != hubs[1] {
+			t.Errorf("matches=%v", matches)
+		}
+
+		if err = tm.DetachTag(ctx, id, local); err != nil {
+			return err
+		}
+
+		// storage topology rules are not provided by datastores
+		zonal, err := pc.CreateProfile(ctx, types.PbmCapabilityProfileCreateSpec{
+			Name:         "zonal",
+			Category:     string(types.PbmProfileCategoryEnumREQUIREMENT),
+			ResourceType: types.PbmProfileResourceType{ResourceType: string(types.PbmProfileResourceTypeEnumSTORAGE)},
+			Constraints: &types.PbmCapabilitySubProfileConstraints{
+				SubProfiles: []types.PbmCapabilitySubProfile{{
+					Name: "Consumption domain",
+					Capability: []types.PbmCapabilityInstance{{
+						Id: types.PbmCapabilityMetadataUniqueId{
+							Namespace: "com.vmware.storage.consumptiondomain",
+							Id:        "StorageTopology",
+						},
+						Constraint: []types.PbmCapabilityConstraintInstance{{
+							PropertyInstance: []types.PbmCapabilityPropertyInstance{{
+								Id:    "StorageTopologyType",
+								Value: "Zonal",
+							}},
+						}},
+					}},
+				}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		datastores, err := finder.DatastoreList(ctx, "*")
+		if err != nil {
+			return err
+		}
+		matches, err = pc.QueryMatchingHub(ctx, nil, *zonal)
+		if err != nil {
+			return err
+		}
+		if len(matches) != len(datastores) {
+			t.Errorf("matches=%v", matches)
+		}
+
+		// StoragePod hubs are supported, as with pbm.DatastoreHub
+		folder, err := finder.Folder(ctx, "/DC0/datastore")
+		if err != nil {
+			return err
+		}
+		pod, err := folder.CreateStoragePod(ctx, "pod")
+		if err != nil {
+			return err
+		}
+		res, err = pc.CheckCompatibility(ctx, []types.PbmPlacementHub{pbm.DatastoreHub(pod.Reference())}, *zonal)
+		if err != nil {
+			return err
+		}
+		if len(res) != 1 || len(res[0].Error) != 0 {
+			t.Errorf("res=%#v", res)
+		}
+
+		_, err = pc.CheckCompatibility(ctx, hubs, types.PbmProfileId{UniqueId: "enoent"})
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		_, err = pc.QueryMatchingHub(ctx, []types.PbmPlacementHub{{HubType: "Datastore", HubId: "enoent"}}, vsanPolicy)
+		if !fault.Is(err, &types.PbmNonExistentHubs{}) {
+			t.Errorf("expected PbmNonExistentHubs, got: %v", err)
+		}
+
+		_, err = pc.DeleteProfile(ctx, []types.PbmProfileId{*ftt, *gold})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2014-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"reflect"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func init() {
+	types.AddRequiredFields(reflect.TypeOf((*PbmAboutInfo)(nil)).Elem(), "Name", "Version", "InstanceUuid")
+	types.AddRequiredFields(reflect.TypeOf((*PbmAssignDefaultRequirementProfile)(nil)).Elem(), "This", "Profile", "Datastores")
+	types.AddRequiredFields(reflect.TypeOf((*PbmAssignDefaultRequirementProfileRequestType)(nil)).Elem(), "This", "Profile", "Datastores")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityConstraintInstance)(nil)).Elem(), "PropertyInstance")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityDescription)(nil)).Elem(), "Description", "Value")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityDiscreteSet)(nil)).Elem(), "Values")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityGenericTypeInfo)(nil)).Elem(), "GenericTypeName")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityInstance)(nil)).Elem(), "Id", "Constraint")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityMetadata)(nil)).Elem(), "Id", "Summary", "PropertyMetadata")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityMetadataPerCategory)(nil)).Elem(), "SubCategory", "CapabilityMetadata")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityMetadataUniqueId)(nil)).Elem(), "Namespace", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityNamespaceInfo)(nil)).Elem(), "Version", "Namespace")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityProfile)(nil)).Elem(), "ProfileCategory", "ResourceType", "Constraints", "IsDefault")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityProfileCreateSpec)(nil)).Elem(), "Name", "ResourceType", "Constraints")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityProfilePropertyMismatchFault)(nil)).Elem(), "ResourcePropertyInstance")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityPropertyInstance)(nil)).Elem(), "Id", "Value")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityPropertyMetadata)(nil)).Elem(), "Id", "Summary", "Mandatory")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityRange)(nil)).Elem(), "Min", "Max")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilitySchema)(nil)).Elem(), "VendorInfo", "NamespaceInfo", "CapabilityMetadataPerCategory")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilitySchemaVendorInfo)(nil)).Elem(), "VendorUuid", "Info")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilitySubProfile)(nil)).Elem(), "Name", "Capability")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilitySubProfileConstraints)(nil)).Elem(), "SubProfiles")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityTimeSpan)(nil)).Elem(), "Value", "Unit")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityTypeInfo)(nil)).Elem(), "TypeName")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityVendorNamespaceInfo)(nil)).Elem(), "VendorInfo", "NamespaceInfo")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCapabilityVendorResourceTypeInfo)(nil)).Elem(), "ResourceType", "VendorNamespaceInfo")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckCompatibility)(nil)).Elem(), "This", "Profile")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckCompatibilityRequestType)(nil)).Elem(), "This", "Profile")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckCompatibilityWithSpec)(nil)).Elem(), "This", "ProfileSpec")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckCompatibilityWithSpecRequestType)(nil)).Elem(), "This", "ProfileSpec")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckCompliance)(nil)).Elem(), "This", "Entities")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckComplianceRequestType)(nil)).Elem(), "This", "Entities")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckRequirements)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckRequirementsRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckRollupCompliance)(nil)).Elem(), "This", "Entity")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCheckRollupComplianceRequestType)(nil)).Elem(), "This", "Entity")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCompatibilityCheckFault)(nil)).Elem(), "Hub")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCompliancePolicyStatus)(nil)).Elem(), "ExpectedValue")
+	types.AddRequiredFields(reflect.TypeOf((*PbmComplianceResult)(nil)).Elem(), "CheckTime", "Entity", "ComplianceStatus", "Mismatch")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCreate)(nil)).Elem(), "This", "CreateSpec")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCreateRequestType)(nil)).Elem(), "This", "CreateSpec")
+	types.AddRequiredFields(reflect.TypeOf((*PbmCreateResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*PbmDataServiceToPoliciesMap)(nil)).Elem(), "DataServicePolicy")
+	types.AddRequiredFields(reflect.TypeOf((*PbmDatastoreSpaceStatistics)(nil)).Elem(), "PhysicalTotalInMB", "PhysicalFreeInMB", "PhysicalUsedInMB", "LogicalFreeInMB", "LogicalUsedInMB")
+	types.AddRequiredFields(reflect.TypeOf((*PbmDefaultCapabilityProfile)(nil)).Elem(), "VvolType", "ContainerId")
+	types.AddRequiredFields(reflect.TypeOf((*PbmDefaultProfileInfo)(nil)).Elem(), "Datastores")
+	types.AddRequiredFields(reflect.TypeOf((*PbmDelete)(nil)).Elem(), "This", "ProfileId")
+	types.AddRequiredFields(reflect.TypeOf((*PbmDeleteRequestType)(nil)).Elem(), "This", "ProfileId")
+	types.AddRequiredFields(reflect.TypeOf((*PbmDuplicateName)(nil)).Elem(), "Name")
+	types.AddRequiredFields(reflect.TypeOf((*PbmDuplicateNameFault)(nil)).Elem(), "Name")
+	types.AddRequiredFields(reflect.TypeOf((*PbmExtendedElementDescription)(nil)).Elem(), "Label", "Summary", "Key", "MessageCatalogKeyPrefix")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchCapabilityMetadata)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchCapabilityMetadataRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchCapabilitySchema)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchCapabilitySchemaRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchComplianceResult)(nil)).Elem(), "This", "Entities")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchComplianceResultRequestType)(nil)).Elem(), "This", "Entities")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchEntityHealthStatusSpec)(nil)).Elem(), "ObjectRef")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchResourceType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchResourceTypeRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchRollupComplianceResult)(nil)).Elem(), "This", "Entity")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchRollupComplianceResultRequestType)(nil)).Elem(), "This", "Entity")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchVendorInfo)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFetchVendorInfoRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFindApplicableDefaultProfile)(nil)).Elem(), "This", "Datastores")
+	types.AddRequiredFields(reflect.TypeOf((*PbmFindApplicableDefaultProfileRequestType)(nil)).Elem(), "This", "Datastores")
+	types.AddRequiredFields(reflect.TypeOf((*PbmLegacyHubsNotSupported)(nil)).Elem(), "Hubs")
+	types.AddRequiredFields(reflect.TypeOf((*PbmLegacyHubsNotSupportedFault)(nil)).Elem(), "Hubs")
+	types.AddRequiredFields(reflect.TypeOf((*PbmLineOfServiceInfo)(nil)).Elem(), "LineOfService", "Name")
+	types.AddRequiredFields(reflect.TypeOf((*PbmLoggingConfiguration)(nil)).Elem(), "Component", "LogLevel")
+	types.AddRequiredFields(reflect.TypeOf((*PbmNonExistentHubs)(nil)).Elem(), "Hubs")
+	types.AddRequiredFields(reflect.TypeOf((*PbmNonExistentHubsFault)(nil)).Elem(), "Hubs")
+	types.AddRequiredFields(reflect.TypeOf((*PbmPlacementCapabilityConstraintsRequirement)(nil)).Elem(), "Constraints")
+	types.AddRequiredFields(reflect.TypeOf((*PbmPlacementCapabilityProfileRequirement)(nil)).Elem(), "ProfileId")
+	types.AddRequiredFields(reflect.TypeOf((*PbmPlacementCompatibilityResult)(nil)).Elem(), "Hub")
+	types.AddRequiredFields(reflect.TypeOf((*PbmPlacementHub)(nil)).Elem(), "HubType", "HubId")
+	types.AddRequiredFields(reflect.TypeOf((*PbmPlacementResourceUtilization)(nil)).Elem(), "Name", "Description")
+	types.AddRequiredFields(reflect.TypeOf((*PbmProfile)(nil)).Elem(), "ProfileId", "Name", "CreationTime", "CreatedBy", "LastUpdatedTime", "LastUpdatedBy")
+	types.AddRequiredFields(reflect.TypeOf((*PbmProfileId)(nil)).Elem(), "UniqueId")
+	types.AddRequiredFields(reflect.TypeOf((*PbmProfileOperationOutcome)(nil)).Elem(), "ProfileId")
+	types.AddRequiredFields(reflect.TypeOf((*PbmProfileResourceType)(nil)).Elem(), "ResourceType")
+	types.AddRequiredFields(reflect.TypeOf((*PbmProfileType)(nil)).Elem(), "UniqueId")
+	types.AddRequiredFields(reflect.TypeOf((*PbmPropertyMismatchFault)(nil)).Elem(), "CapabilityInstanceId", "RequirementPropertyInstance")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryAssociatedEntities)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryAssociatedEntitiesRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryAssociatedEntity)(nil)).Elem(), "This", "Profile")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryAssociatedEntityRequestType)(nil)).Elem(), "This", "Profile")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryAssociatedProfile)(nil)).Elem(), "This", "Entity")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryAssociatedProfileRequestType)(nil)).Elem(), "This", "Entity")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryAssociatedProfiles)(nil)).Elem(), "This", "Entities")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryAssociatedProfilesRequestType)(nil)).Elem(), "This", "Entities")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryByRollupComplianceStatus)(nil)).Elem(), "This", "Status")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryByRollupComplianceStatusRequestType)(nil)).Elem(), "This", "Status")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryDefaultRequirementProfile)(nil)).Elem(), "This", "Hub")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryDefaultRequirementProfileRequestType)(nil)).Elem(), "This", "Hub")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryDefaultRequirementProfiles)(nil)).Elem(), "This", "Datastores")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryDefaultRequirementProfilesRequestType)(nil)).Elem(), "This", "Datastores")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryDefaultRequirementProfilesResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryMatchingHub)(nil)).Elem(), "This", "Profile")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryMatchingHubRequestType)(nil)).Elem(), "This", "Profile")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryMatchingHubWithSpec)(nil)).Elem(), "This", "CreateSpec")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryMatchingHubWithSpecRequestType)(nil)).Elem(), "This", "CreateSpec")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryProfile)(nil)).Elem(), "This", "ResourceType")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryProfileRequestType)(nil)).Elem(), "This", "ResourceType")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryProfileResult)(nil)).Elem(), "Object")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryReplicationGroupResult)(nil)).Elem(), "Object")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryReplicationGroups)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQueryReplicationGroupsRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQuerySpaceStatsForStorageContainer)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*PbmQuerySpaceStatsForStorageContainerRequestType)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*PbmResetDefaultRequirementProfile)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmResetDefaultRequirementProfileRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmResetVSanDefaultProfile)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmResetVSanDefaultProfileRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmRetrieveContent)(nil)).Elem(), "This", "ProfileIds")
+	types.AddRequiredFields(reflect.TypeOf((*PbmRetrieveContentRequestType)(nil)).Elem(), "This", "ProfileIds")
+	types.AddRequiredFields(reflect.TypeOf((*PbmRetrieveContentResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*PbmRetrieveServiceContent)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmRetrieveServiceContentRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PbmRetrieveServiceContentResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*PbmRollupComplianceResult)(nil)).Elem(), "OldestCheckTime", "Entity", "OverallComplianceStatus", "ProfileMismatch")
+	types.AddRequiredFields(reflect.TypeOf((*PbmServerObjectRef)(nil)).Elem(), "ObjectType", "Key")
+	types.AddRequiredFields(reflect.TypeOf((*PbmServiceInstanceContent)(nil)).Elem(), "AboutInfo", "SessionManager", "CapabilityMetadataManager", "ProfileManager", "ComplianceManager", "PlacementSolver")
+	types.AddRequiredFields(reflect.TypeOf((*PbmUpdate)(nil)).Elem(), "This", "ProfileId", "UpdateSpec")
+	types.AddRequiredFields(reflect.TypeOf((*PbmUpdateRequestType)(nil)).Elem(), "This", "ProfileId", "UpdateSpec")
+}
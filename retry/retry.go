@@ -0,0 +1,280 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package retry provides retry policies tailored to the transient failures of
+// vSphere APIs, such as TaskInProgress faults, vpxd restarts and expired sessions.
+//
+// Policies are composed from a Backoff and one or more Conditions:
+//
+//	policy := retry.MaxAttempts(5, retry.On(retry.Exponential(time.Second, time.Minute), retry.IsTaskInProgress))
+//
+//	err := retry.WithRetry(ctx, policy, func(ctx context.Context) error {
+//		_, err := vm.PowerOn(ctx)
+//		return err
+//	})
+//
+// SOAP clients can apply a policy to every idempotent method call using RoundTripper.
+// A policy can also be applied to the calls made with a given context using WithPolicy,
+// which is used by vAPI REST clients (see rest.Client.WithRetry) and by object.Task when
+// waiting for a task to complete.
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// Policy determines whether an operation should be retried after it failed with err,
+// and if so, how long to wait before the next attempt.
+// The attempt argument is the number of attempts made so far, starting at 1.
+type Policy func(attempt int, err error) (retry bool, delay time.Duration)
+
+// Condition reports whether err is transient, such that the operation may be retried.
+type Condition func(err error) bool
+
+// Backoff returns how long to wait after the given attempt.
+type Backoff func(attempt int) time.Duration
+
+// Constant returns a Backoff that always waits for delay.
+func Constant(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// Exponential returns a Backoff that doubles the delay after each attempt, starting at initial, up to limit.
+// A random jitter of up to 10% is added to each delay, to spread out the attempts of concurrent callers.
+func Exponential(initial, limit time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		delay := initial
+		for i := 1; i < attempt && delay < limit; i++ {
+			delay *= 2
+		}
+		if delay > limit {
+			delay = limit
+		}
+		if jitter := int64(delay / 10); jitter > 0 {
+			delay += time.Duration(rand.Int63n(jitter))
+		}
+		return delay
+	}
+}
+
+// On returns a Policy that retries when any of the given conditions matches the error,
+// waiting between attempts according to backoff.
+func On(backoff Backoff, conditions ...Condition) Policy {
+	return func(attempt int, err error) (bool, time.Duration) {
+		for _, matches := range conditions {
+			if matches(err) {
+				return true, backoff(attempt)
+			}
+		}
+		return false, 0
+	}
+}
+
+// Any returns a Policy that retries when any of the given policies does,
+// using the delay of the first one that matches.
+func Any(policies ...Policy) Policy {
+	return func(attempt int, err error) (bool, time.Duration) {
+		for _, policy := range policies {
+			if retry, delay := policy(attempt, err); retry {
+				return true, delay
+			}
+		}
+		return false, 0
+	}
+}
+
+// MaxAttempts returns a Policy that limits policy to n attempts in total.
+func MaxAttempts(n int, policy Policy) Policy {
+	return func(attempt int, err error) (bool, time.Duration) {
+		if attempt >= n {
+			return false, 0
+		}
+		return policy(attempt, err)
+	}
+}
+
+// Default returns a Policy suitable for most vSphere API calls, retrying up to 5 attempts
+// with exponential backoff when a task is in progress, the service is unavailable
+// or a temporary network error occurred.
+func Default() Policy {
+	return MaxAttempts(5, On(Exponential(time.Second, 30*time.Second),
+		IsTaskInProgress,
+		IsServiceUnavailable,
+		vim25.IsTemporaryNetworkError,
+	))
+}
+
+// IsTaskInProgress returns true if err is a TaskInProgress fault,
+// such as when the object is busy with another operation.
+func IsTaskInProgress(err error) bool {
+	return fault.Is(err, &types.TaskInProgress{})
+}
+
+// IsServiceUnavailable returns true if the vCenter or ESX service cannot currently handle the request,
+// such as while vpxd is restarting: the connection is refused or the reverse proxy responds with
+// an HTTP 502, 503 or 504 status.
+func IsServiceUnavailable(err error) bool {
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	return IsStatus(err, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout)
+}
+
+// IsSessionExpired returns true if err is a NotAuthenticated fault or an HTTP 401 status,
+// such as when the session has expired. Retrying is only useful if the client logs in again,
+// for example via session/keepalive or session/cache.
+func IsSessionExpired(err error) bool {
+	if fault.Is(err, &types.NotAuthenticated{}) {
+		return true
+	}
+
+	return IsStatus(err, http.StatusUnauthorized)
+}
+
+// IsStatus returns true if err is an HTTP response error of the SOAP or vAPI REST clients,
+// with any of the given status codes.
+func IsStatus(err error, codes ...int) bool {
+	var status interface{ StatusCode() int }
+
+	if !errors.As(err, &status) {
+		return false
+	}
+
+	for _, code := range codes {
+		if status.StatusCode() == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithRetry calls fn until it succeeds or policy determines the error should not be retried,
+// returning the last error. If ctx is done while waiting between attempts, ctx.Err() is returned.
+func WithRetry(ctx context.Context, policy Policy, fn func(context.Context) error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+
+		retry, delay := policy(attempt, err)
+		if !retry {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+type policyContext struct{}
+
+// WithPolicy returns a new Context such that calls made with this context are retried according to policy,
+// by the vAPI REST client and when waiting for a task to complete via object.Task.
+func WithPolicy(ctx context.Context, policy Policy) context.Context {
+	return context.WithValue(ctx, policyContext{}, policy)
+}
+
+// FromContext returns the Policy of the given context, or nil if none was set via WithPolicy.
+func FromContext(ctx context.Context) Policy {
+	policy, _ := ctx.Value(policyContext{}).(Policy)
+	return policy
+}
+
+type nonIdempotentContext struct{}
+
+// WithNonIdempotent returns a new Context such that RoundTripper retries all method calls made with this context,
+// including those that are not idempotent. The caller must ensure a method has no side effect when repeated,
+// such as when an attempt failed with a TaskInProgress fault.
+func WithNonIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, nonIdempotentContext{}, true)
+}
+
+// idempotent are the method name prefixes of read-only methods, which are safe to retry
+// regardless of whether or not a failed attempt reached the server.
+var idempotent = []string{"Retrieve", "ContinueRetrieve", "Query", "Find", "Fetch", "List", "Get", "WaitFor"}
+
+// IsIdempotent returns true if req is a call to a read-only method, such as RetrievePropertiesEx.
+// Method names of the pbm and vslm APIs are matched without their Pbm and Vslm prefix.
+func IsIdempotent(req soap.HasFault) bool {
+	t := reflect.TypeOf(req)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	method := strings.TrimSuffix(t.Name(), "Body")
+	for _, prefix := range []string{"Pbm", "Vslm"} {
+		method = strings.TrimPrefix(method, prefix)
+	}
+
+	for _, prefix := range idempotent {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type roundTripper struct {
+	soap.RoundTripper
+	policy Policy
+}
+
+// RoundTripper wraps rt such that each idempotent method call is retried according to policy.
+// Other method calls are sent once, unless their context opts in via WithNonIdempotent.
+// Unlike vim25.Retry, the policy is aware of vSphere faults and waits are canceled along with the call's context.
+func RoundTripper(rt soap.RoundTripper, policy Policy) soap.RoundTripper {
+	return &roundTripper{RoundTripper: rt, policy: policy}
+}
+
+func (r *roundTripper) RoundTrip(ctx context.Context, req, res soap.HasFault) error {
+	if ctx.Value(nonIdempotentContext{}) == nil && !IsIdempotent(req) {
+		return r.RoundTripper.RoundTrip(ctx, req, res)
+	}
+
+	retry := false
+	return WithRetry(ctx, r.policy, func(ctx context.Context) error {
+		if retry {
+			// the fault of a previous attempt must not linger in the response
+			body := reflect.ValueOf(res).Elem()
+			body.Set(reflect.Zero(body.Type()))
+		}
+		retry = true
+		return r.RoundTripper.RoundTrip(ctx, req, res)
+	})
+}
@@ -0,0 +1,267 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/retry"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestWithRetry(t *testing.T) {
+	ctx := context.Background()
+	busy := soap.WrapVimFault(&types.TaskInProgress{})
+	policy := retry.MaxAttempts(3, retry.On(retry.Constant(0), retry.IsTaskInProgress))
+
+	tests := []struct {
+		name     string
+		errs     []error
+		attempts int
+		err      error
+	}{
+		{"success", nil, 1, nil},
+		{"transient", []error{busy, busy}, 3, nil},
+		{"max attempts", []error{busy, busy, busy, busy}, 3, busy},
+		{"permanent", []error{errors.New("enoent"), busy}, 1, errors.New("enoent")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attempts := 0
+			err := retry.WithRetry(ctx, policy, func(context.Context) error {
+				attempts++
+				if attempts <= len(test.errs) {
+					return test.errs[attempts-1]
+				}
+				return nil
+			})
+
+			if attempts != test.attempts {
+				t.Errorf("attempts=%d", attempts)
+			}
+			if (err == nil) != (test.err == nil) || (err != nil && err.Error() != test.err.Error()) {
+				t.Errorf("err=%v", err)
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	policy = retry.On(retry.Constant(time.Hour), retry.IsTaskInProgress)
+	err := retry.WithRetry(ctx, policy, func(context.Context) error { return busy })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err=%v", err)
+	}
+}
+
+func TestExponential(t *testing.T) {
+	backoff := retry.Exponential(time.Second, 10*time.Second)
+
+	for attempt, expect := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		delay := backoff(attempt + 1)
+		if delay < expect || delay > expect+expect/10 {
+			t.Errorf("attempt %d: delay=%s", attempt+1, delay)
+		}
+	}
+}
+
+func TestConditions(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the ServiceContent request fails with a 503 status
+	_, err = vim25.NewClient(context.Background(), soap.NewClient(u, true))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	tests := []struct {
+		name      string
+		err       error
+		condition retry.Condition
+		expect    bool
+	}{
+		{"soap 503", err, retry.IsServiceUnavailable, true},
+		{"soap 503", err, retry.IsTaskInProgress, false},
+		{"task", task.Error{LocalizedMethodFault: &types.LocalizedMethodFault{Fault: &types.TaskInProgress{}}}, retry.IsTaskInProgress, true},
+		{"not authenticated", soap.WrapVimFault(&types.NotAuthenticated{}), retry.IsSessionExpired, true},
+		{"not authenticated", soap.WrapVimFault(&types.NotAuthenticated{}), retry.IsServiceUnavailable, false},
+	}
+
+	for _, test := range tests {
+		if ok := test.condition(test.err); ok != test.expect {
+			t.Errorf("%s: %t", test.name, ok)
+		}
+	}
+}
+
+// busyRoundTripper fails the first n PowerOffVM_Task calls with a TaskInProgress fault
+type busyRoundTripper struct {
+	soap.RoundTripper
+
+	n int
+}
+
+func (rt *busyRoundTripper) RoundTrip(ctx context.Context, req, res soap.HasFault) error {
+	if _, ok := req.(*methods.PowerOffVM_TaskBody); ok && rt.n > 0 {
+		rt.n--
+		res.(*methods.PowerOffVM_TaskBody).Fault_ = &soap.Fault{
+			String: "busy",
+			Detail: struct {
+				Fault types.AnyType `xml:",any,typeattr"`
+			}{Fault: &types.TaskInProgress{}},
+		}
+		return soap.WrapSoapFault(res.Fault())
+	}
+
+	return rt.RoundTripper.RoundTrip(ctx, req, res)
+}
+
+func TestRoundTripper(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		rt := &busyRoundTripper{RoundTripper: c.RoundTripper, n: 2}
+		c.RoundTripper = retry.RoundTripper(rt, retry.On(retry.Constant(0), retry.IsTaskInProgress))
+
+		vm := object.NewVirtualMachine(c, simulator.Map.Any("VirtualMachine").Reference())
+
+		// PowerOffVM_Task is not idempotent, it is only retried if the caller opts in
+		_, err := vm.PowerOff(ctx)
+		if !retry.IsTaskInProgress(err) {
+			t.Fatalf("err=%v", err)
+		}
+		if rt.n != 1 {
+			t.Errorf("n=%d", rt.n)
+		}
+
+		task, err := vm.PowerOff(retry.WithNonIdempotent(ctx))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if rt.n != 0 {
+			t.Errorf("n=%d", rt.n)
+		}
+	})
+}
+
+func TestIsIdempotent(t *testing.T) {
+	tests := []struct {
+		req    soap.HasFault
+		expect bool
+	}{
+		{new(methods.RetrievePropertiesExBody), true},
+		{new(methods.ContinueRetrievePropertiesExBody), true},
+		{new(methods.WaitForUpdatesExBody), true},
+		{new(methods.QueryIpPoolsBody), true},
+		{new(methods.FindByUuidBody), true},
+		{new(methods.PowerOffVM_TaskBody), false},
+		{new(methods.CreateFolderBody), false},
+		{new(methods.CancelRetrievePropertiesExBody), false},
+	}
+
+	for _, test := range tests {
+		if ok := retry.IsIdempotent(test.req); ok != test.expect {
+			t.Errorf("%T: %t", test.req, ok)
+		}
+	}
+}
+
+var errUnavailable = errors.New("unavailable")
+
+// unavailableRoundTripper fails the first n CreatePropertyCollector calls with errUnavailable
+type unavailableRoundTripper struct {
+	soap.RoundTripper
+
+	n     int
+	calls int
+}
+
+func (rt *unavailableRoundTripper) RoundTrip(ctx context.Context, req, res soap.HasFault) error {
+	if _, ok := req.(*methods.CreatePropertyCollectorBody); ok {
+		rt.calls++
+		if rt.n > 0 {
+			rt.n--
+			return errUnavailable
+		}
+	}
+
+	return rt.RoundTripper.RoundTrip(ctx, req, res)
+}
+
+func TestTaskWait(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		rt := &unavailableRoundTripper{RoundTripper: c.RoundTripper, n: 1}
+		c.RoundTripper = rt
+
+		vm := object.NewVirtualMachine(c, simulator.Map.Any("VirtualMachine").Reference())
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the wait is not retried without a policy
+		if err = task.Wait(ctx); !errors.Is(err, errUnavailable) {
+			t.Fatalf("err=%v", err)
+		}
+
+		policy := retry.MaxAttempts(3, retry.On(retry.Constant(0), func(err error) bool { return true }))
+		rctx := retry.WithPolicy(ctx, policy)
+
+		rt.n = 1
+		if err = task.Wait(rctx); err != nil {
+			t.Fatal(err)
+		}
+
+		// an error of the task itself is not retried
+		task, err = vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rt.calls = 0
+		if err = task.Wait(rctx); !fault.Is(err, &types.InvalidPowerState{}) {
+			t.Fatalf("err=%v", err)
+		}
+		if rt.calls != 1 {
+			t.Errorf("calls=%d", rt.calls)
+		}
+	})
+}
@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oauth provides a vCenter login strategy based on OAuth2 access
+// tokens, such as those issued by VMware Cloud Services (CSP) for VMC on AWS.
+// The access token is exchanged for a SAML token via the vCenter token
+// exchange API, which is then used to create a vim25 and/or REST session.
+package oauth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/sts"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+const (
+	// DefaultCSPURL is the VMware Cloud Services Platform endpoint.
+	DefaultCSPURL = "https://console.cloud.vmware.com"
+
+	cspAuthorizePath = "/csp/gateway/am/api/auth/api-tokens/authorize"
+
+	tokenExchangePath = "/api/vcenter/tokenservice/token-exchange"
+
+	GrantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	TokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeIDToken       = "urn:ietf:params:oauth:token-type:id_token"
+	TokenTypeSAML2         = "urn:ietf:params:oauth:token-type:saml2"
+)
+
+// ErrNotSupported is returned when the vCenter does not support token exchange.
+var ErrNotSupported = errors.New("token exchange not supported by this vCenter")
+
+// TokenSource provides OAuth2 access tokens.
+// Implementations are expected to handle caching and refresh.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenSource that always returns the same token.
+type StaticToken string
+
+func (t StaticToken) Token(_ context.Context) (string, error) {
+	return string(t), nil
+}
+
+// CSP is a TokenSource that exchanges a VMware Cloud Services API token (refresh token)
+// for short lived access tokens. The access token is cached until shortly before it expires.
+type CSP struct {
+	// URL of the CSP endpoint, defaults to DefaultCSPURL.
+	URL string
+	// APIToken is the CSP API (refresh) token.
+	APIToken string
+	// Client is used to send requests to CSP, defaults to http.DefaultClient.
+	Client *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+type cspResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// expiryDelta is the amount of time before expiration that a cached token is refreshed.
+const expiryDelta = 30 * time.Second
+
+func (c *CSP) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && time.Now().Add(expiryDelta).Before(c.expiry) {
+		return c.token, nil
+	}
+
+	u := c.URL
+	if u == "" {
+		u = DefaultCSPURL
+	}
+	u = strings.TrimSuffix(u, "/") + cspAuthorizePath
+
+	form := url.Values{"refresh_token": {c.APIToken}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s %s: %s", req.Method, u, res.Status)
+	}
+
+	var r cspResponse
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return "", err
+	}
+	if r.AccessToken == "" {
+		return "", fmt.Errorf("%s %s: no access_token in response", req.Method, u)
+	}
+
+	c.token = r.AccessToken
+	c.expiry = time.Now().Add(time.Duration(r.ExpiresIn) * time.Second)
+
+	return c.token, nil
+}
+
+// TokenExchangeSpec is the request body of the vCenter token exchange API.
+type TokenExchangeSpec struct {
+	GrantType          string `json:"grant_type"`
+	SubjectToken       string `json:"subject_token"`
+	SubjectTokenType   string `json:"subject_token_type"`
+	RequestedTokenType string `json:"requested_token_type"`
+}
+
+// TokenExchangeInfo is the response body of the vCenter token exchange API.
+type TokenExchangeInfo struct {
+	AccessToken     string `json:"access_token"`
+	IssuedTokenType string `json:"issued_token_type"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in,omitempty"`
+}
+
+// Exchange trades an OAuth2 access token from the given TokenSource for a SAML bearer token,
+// which can be used with sts.Signer to login via SOAP or REST.
+// ErrNotSupported is returned if the vCenter does not provide the token exchange API.
+func Exchange(ctx context.Context, c *rest.Client, src TokenSource) (string, error) {
+	token, err := src.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	spec := TokenExchangeSpec{
+		GrantType:          GrantTypeTokenExchange,
+		SubjectToken:       token,
+		SubjectTokenType:   TokenTypeAccessToken,
+		RequestedTokenType: TokenTypeSAML2,
+	}
+
+	var info TokenExchangeInfo
+	req := c.Resource(tokenExchangePath).Request(http.MethodPost, spec)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	if err = c.Do(ctx, req, &info); err != nil {
+		if rest.IsStatusError(err, http.StatusNotFound) {
+			return "", ErrNotSupported
+		}
+		return "", err
+	}
+
+	if info.IssuedTokenType != "" && info.IssuedTokenType != TokenTypeSAML2 {
+		return "", fmt.Errorf("unexpected issued_token_type: %s", info.IssuedTokenType)
+	}
+
+	return decodeSAML(info.AccessToken)
+}
+
+// decodeSAML decodes a base64url encoded SAML token, as specified by RFC 8693 section 3.
+func decodeSAML(s string) (string, error) {
+	if strings.HasPrefix(strings.TrimSpace(s), "<") {
+		return s, nil // already decoded
+	}
+
+	for _, enc := range []*base64.Encoding{base64.RawURLEncoding, base64.URLEncoding, base64.StdEncoding} {
+		if b, err := enc.DecodeString(s); err == nil {
+			return string(b), nil
+		}
+	}
+
+	return "", errors.New("unable to decode SAML token")
+}
+
+// Login creates a vim25 session using an access token from the given TokenSource.
+func Login(ctx context.Context, c *vim25.Client, src TokenSource) error {
+	token, err := Exchange(ctx, rest.NewClient(c), src)
+	if err != nil {
+		return err
+	}
+
+	header := soap.Header{
+		Security: &sts.Signer{
+			Certificate: c.Certificate(),
+			Token:       token,
+		},
+	}
+
+	return session.NewManager(c).LoginByToken(c.WithHeader(ctx, header))
+}
+
+// LoginREST creates a REST session using an access token from the given TokenSource.
+func LoginREST(ctx context.Context, c *rest.Client, src TokenSource) error {
+	token, err := Exchange(ctx, c, src)
+	if err != nil {
+		return err
+	}
+
+	signer := &sts.Signer{
+		Certificate: c.Certificate(),
+		Token:       token,
+	}
+
+	return c.LoginByToken(c.WithSigner(ctx, signer))
+}
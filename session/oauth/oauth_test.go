@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/session/oauth"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25"
+)
+
+const (
+	apiToken    = "my-api-token"
+	accessToken = "my-access-token"
+	assertion   = `<saml2:Assertion xmlns:saml2="urn:oasis:names:tc:SAML:2.0:assertion" ID="_1"><saml2:Subject><saml2:NameID>cloudadmin@vmc.local</saml2:NameID></saml2:Subject></saml2:Assertion>`
+)
+
+func TestCSP(t *testing.T) {
+	calls := 0
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/csp/gateway/am/api/auth/api-tokens/authorize" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.FormValue("refresh_token") != apiToken {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": accessToken,
+			"token_type":   "bearer",
+			"expires_in":   1799,
+		})
+	}))
+	defer s.Close()
+
+	ctx := context.Background()
+
+	src := &oauth.CSP{URL: s.URL, APIToken: apiToken}
+
+	for i := 0; i < 2; i++ {
+		token, err := src.Token(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != accessToken {
+			t.Errorf("token=%s", token)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("expected token to be cached, calls=%d", calls)
+	}
+
+	src = &oauth.CSP{URL: s.URL, APIToken: "invalid"}
+	if _, err := src.Token(ctx); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func tokenExchange(w http.ResponseWriter, r *http.Request) {
+	var spec oauth.TokenExchangeSpec
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if spec.SubjectToken != accessToken || spec.RequestedTokenType != oauth.TokenTypeSAML2 {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(oauth.TokenExchangeInfo{
+		AccessToken:     base64.RawURLEncoding.EncodeToString([]byte(assertion)),
+		IssuedTokenType: oauth.TokenTypeSAML2,
+		TokenType:       "Bearer",
+	})
+}
+
+func TestLogin(t *testing.T) {
+	model := simulator.VPX()
+	defer model.Remove()
+
+	if err := model.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	model.Service.HandleFunc("/api/vcenter/tokenservice/token-exchange", tokenExchange)
+
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		m := session.NewManager(c)
+		if err := m.Logout(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := oauth.Login(ctx, c, oauth.StaticToken("invalid")); err == nil {
+			t.Error("expected error")
+		}
+
+		if err := oauth.Login(ctx, c, oauth.StaticToken(accessToken)); err != nil {
+			t.Fatal(err)
+		}
+
+		s, err := m.UserSession(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s == nil || s.UserName != "cloudadmin@vmc.local" {
+			t.Errorf("session=%#v", s)
+		}
+	}, model)
+}
+
+func TestExchangeNotSupported(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		_, err := oauth.Exchange(ctx, rest.NewClient(c), oauth.StaticToken(accessToken))
+		if err != oauth.ErrNotSupported {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
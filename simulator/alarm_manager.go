@@ -17,8 +17,8 @@ limitations under the License.
 package simulator
 
 import (
+	"slices"
 	"strings"
-	"time"
 
 	"github.com/vmware/govmomi/simulator/vpx"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -63,29 +63,59 @@ func (m *AlarmManager) matchAlarm(alarm *Alarm, event *types.EventEx) (*mo.Alarm
 	id := event.EventTypeId
 	kind := m.trimPrefix(event.ObjectType)
 
+	var exp []types.BaseAlarmExpression
+
 	switch op := alarm.Info.Expression.(type) {
 	case *types.OrAlarmExpression:
-		for i := range op.Expression {
-			switch x := op.Expression[i].(type) {
-			case *types.EventAlarmExpression:
-				if x.EventTypeId == id && kind == m.trimPrefix(x.ObjectType) {
-					return &alarm.Alarm, x.Status
-				}
+		exp = op.Expression
+	case *types.EventAlarmExpression:
+		exp = []types.BaseAlarmExpression{op}
+	}
+
+	for i := range exp {
+		switch x := exp[i].(type) {
+		case *types.EventAlarmExpression:
+			if x.EventTypeId == id && kind == m.trimPrefix(x.ObjectType) {
+				return &alarm.Alarm, x.Status
 			}
 		}
 	}
+
 	return nil, ""
 }
 
+// applies returns true if the alarm is defined on entity or one of its ancestors.
+func (*AlarmManager) applies(ctx *Context, alarm *Alarm, me mo.Entity) bool {
+	for {
+		obj := me.Entity()
+		if obj.Self == alarm.Info.Entity {
+			return true
+		}
+		if obj.Parent == nil {
+			return false
+		}
+		parent, ok := ctx.Map.Get(*obj.Parent).(mo.Entity)
+		if !ok {
+			return false
+		}
+		me = parent
+	}
+}
+
 // update (e.g. triggeredAlarmState) and propagate up the inventory hierarchy
-func (*AlarmManager) update(ctx *Context, me mo.Entity, update func(mo.Entity) *types.ManagedObjectReference) {
+func (*AlarmManager) update(ctx *Context, me mo.Entity, update func(mo.Entity) (*types.ManagedObjectReference, bool)) {
 	for {
 		if me == nil {
 			break
 		}
 		ctx.WithLock(me, func() {
-			parent := update(me)
-			if parent == nil {
+			parent, changed := update(me)
+			if changed {
+				ctx.Map.Update(me, []types.PropertyChange{
+					{Name: "triggeredAlarmState", Val: me.Entity().TriggeredAlarmState},
+				})
+			}
+			if parent == nil || !changed {
 				me = nil
 			} else {
 				me = ctx.Map.Get(*parent).(mo.Entity)
@@ -94,6 +124,126 @@ func (*AlarmManager) update(ctx *Context, me mo.Entity, update func(mo.Entity) *
 	}
 }
 
+// setStatus sets the status of the alarm triggered by the given entity and propagates the
+// triggeredAlarmState up the inventory hierarchy. A green status clears the triggered alarm.
+// Returns the previous status, which is green if the alarm was not triggered.
+func (m *AlarmManager) setStatus(ctx *Context, alarm *Alarm, me mo.Entity, status types.ManagedEntityStatus, eventKey int32) types.ManagedEntityStatus {
+	now := ctx.Map.Now()
+	entity := me.Reference()
+	key := m.key(alarm.Self, entity)
+	from := types.ManagedEntityStatusGreen
+
+	update := func(me mo.Entity) (*types.ManagedObjectReference, bool) {
+		obj := me.Entity()
+
+		for i, state := range obj.TriggeredAlarmState {
+			if state.Key != key {
+				continue
+			}
+
+			if obj.Self == entity {
+				from = state.OverallStatus
+			}
+
+			switch status {
+			case state.OverallStatus:
+				// no change
+				return nil, false
+			case types.ManagedEntityStatusGreen:
+				// remove
+				obj.TriggeredAlarmState =
+					append(obj.TriggeredAlarmState[:i],
+						obj.TriggeredAlarmState[i+1:]...)
+				return obj.Parent, true
+			default:
+				// status change (e.g. yellow -> red)
+				obj.TriggeredAlarmState[i].OverallStatus = status
+				return obj.Parent, true
+			}
+		}
+
+		if status == types.ManagedEntityStatusGreen {
+			return nil, false // green only clears a triggered alarm
+		}
+
+		// add
+		state := types.AlarmState{
+			Key:           key,
+			Entity:        entity,
+			Alarm:         alarm.Self,
+			OverallStatus: status,
+			Time:          now,
+			EventKey:      eventKey,
+			Acknowledged:  types.NewBool(false),
+		}
+
+		obj.TriggeredAlarmState = append(obj.TriggeredAlarmState, state)
+
+		return obj.Parent, true
+	}
+
+	m.update(ctx, me, update)
+
+	return from
+}
+
+// event returns an AlarmEvent for the given alarm, with event arguments for the given entity.
+func (*AlarmManager) event(ctx *Context, alarm *Alarm, me mo.Entity) types.AlarmEvent {
+	return types.AlarmEvent{
+		Event: entityEvent(ctx, me),
+		Alarm: types.AlarmEventArgument{
+			EntityEventArgument: types.EntityEventArgument{Name: alarm.Info.Name},
+			Alarm:               alarm.Self,
+		},
+	}
+}
+
+// entityEvent returns an Event with event arguments for the given entity.
+func entityEvent(ctx *Context, me mo.Entity) types.Event {
+	var event types.Event
+
+	switch obj := me.(type) {
+	case *VirtualMachine:
+		event = obj.event().Event
+	case *HostSystem:
+		event = obj.event().Event
+	case *Datacenter:
+		event.Datacenter = datacenterEventArgument(obj)
+	default:
+		if me.Entity().Parent != nil {
+			if dc := ctx.Map.getEntityDatacenter(me); dc != nil {
+				event.Datacenter = datacenterEventArgument(dc)
+			}
+		}
+	}
+
+	return event
+}
+
+// entityEventArgument returns a ManagedEntityEventArgument for the given entity reference.
+func entityEventArgument(ctx *Context, ref types.ManagedObjectReference) types.ManagedEntityEventArgument {
+	arg := types.ManagedEntityEventArgument{Entity: ref}
+	if me, ok := ctx.Map.Get(ref).(mo.Entity); ok {
+		arg.Name = me.Entity().Name
+	}
+	return arg
+}
+
+// statusChanged posts an AlarmStatusChangedEvent if the status of alarm triggered by entity has changed.
+func (m *AlarmManager) statusChanged(ctx *Context, alarm *Alarm, me mo.Entity, from, to types.ManagedEntityStatus) {
+	if from == to {
+		return
+	}
+
+	ctx.postEvent(&types.AlarmStatusChangedEvent{
+		AlarmEvent: m.event(ctx, alarm, me),
+		Source:     entityEventArgument(ctx, me.Reference()),
+		Entity:     entityEventArgument(ctx, alarm.Info.Entity),
+		From:       string(from),
+		To:         string(to),
+	})
+}
+
 // postEvent triggers Alarms based on Events
 func (m *AlarmManager) postEvent(ctx *Context, base types.BaseEvent) {
 	event, ok := base.(*types.EventEx)
@@ -102,65 +252,52 @@ func (m *AlarmManager) postEvent(ctx *Context, base types.BaseEvent) {
 	}
 
 	entity := types.ManagedObjectReference{Type: event.ObjectType, Value: event.ObjectId}
-	me := ctx.Map.Get(entity).(mo.Entity)
+	me, ok := ctx.Map.Get(entity).(mo.Entity)
+	if !ok {
+		return // such as a content library object
+	}
 
 	for _, ref := range m.GetAlarmResponse.Returnval {
 		alarm := ctx.Map.Get(ref).(*Alarm)
 		match, status := m.matchAlarm(alarm, event)
-		if match == nil {
+		if match == nil || !m.applies(ctx, alarm, me) {
 			continue
 		}
 
-		now := time.Now()
-		key := m.key(match.Self, entity)
-
-		update := func(me mo.Entity) *types.ManagedObjectReference {
-			obj := me.Entity()
-
-			for i, state := range obj.TriggeredAlarmState {
-				if state.Key != key {
-					continue
-				}
-
-				switch status {
-				case state.OverallStatus:
-					// no change
-					return nil
-				case types.ManagedEntityStatusGreen:
-					// remove
-					obj.TriggeredAlarmState =
-						append(obj.TriggeredAlarmState[:i],
-							obj.TriggeredAlarmState[i+1:]...)
-					return obj.Parent
-				default:
-					// status change (e.g. yellow -> red)
-					obj.TriggeredAlarmState[i].OverallStatus = status
-					return obj.Parent
-				}
-			}
+		from := m.setStatus(ctx, alarm, me, status, event.Key)
+		m.statusChanged(ctx, alarm, me, from, status)
+	}
+}
 
-			if status == types.ManagedEntityStatusGreen {
-				return nil // green only clears a triggered alarm
-			}
+// SetAlarmStatus sets the status of the given alarm triggered by entity, propagating the
+// triggeredAlarmState up the inventory hierarchy and posting an AlarmStatusChangedEvent
+// when the status changes. A green status clears the triggered alarm.
+// This method is not part of the vSphere API, it can be used to simulate alarm state transitions
+// of alarms that are not triggered by events, such as metric and state alarms.
+func (m *AlarmManager) SetAlarmStatus(ctx *Context, alarm, entity types.ManagedObjectReference, status types.ManagedEntityStatus) types.BaseMethodFault {
+	a, ok := ctx.Map.Get(alarm).(*Alarm)
+	if !ok {
+		return &types.ManagedObjectNotFound{Obj: alarm}
+	}
 
-			// add
-			state := types.AlarmState{
-				Key:           key,
-				Entity:        entity,
-				Alarm:         match.Self,
-				OverallStatus: status,
-				Time:          now,
-				EventKey:      event.Key,
-				Acknowledged:  types.NewBool(false),
-			}
+	me, ok := ctx.Map.Get(entity).(mo.Entity)
+	if !ok {
+		return &types.ManagedObjectNotFound{Obj: entity}
+	}
 
-			obj.TriggeredAlarmState = append(obj.TriggeredAlarmState, state)
+	if !m.applies(ctx, a, me) {
+		return new(types.InvalidArgument)
+	}
 
-			return obj.Parent
-		}
+	// lock order consistent with EventManager.PostEvent
+	ctx.WithLock(ctx.Map.EventManager(), func() {
+		ctx.WithLock(m, func() {
+			from := m.setStatus(ctx, a, me, status, 0)
+			m.statusChanged(ctx, a, me, from, status)
+		})
+	})
 
-		m.update(ctx, me, update)
-	}
+	return nil
 }
 
 func (m *AlarmManager) GetAlarm(ctx *Context, req *types.GetAlarm) soap.HasFault {
@@ -170,7 +307,31 @@ func (m *AlarmManager) GetAlarm(ctx *Context, req *types.GetAlarm) soap.HasFault
 
 	if req.Entity == nil || *req.Entity == ctx.Map.content().RootFolder {
 		body.Res.Returnval = m.GetAlarmResponse.Returnval
-	} // else TODO
+	} else {
+		for _, ref := range m.GetAlarmResponse.Returnval {
+			if ctx.Map.Get(ref).(*Alarm).Info.Entity == *req.Entity {
+				body.Res.Returnval = append(body.Res.Returnval, ref)
+			}
+		}
+	}
+
+	return body
+}
+
+func (m *AlarmManager) GetAlarmState(ctx *Context, req *types.GetAlarmState) soap.HasFault {
+	body := new(methods.GetAlarmStateBody)
+
+	me, ok := ctx.Map.Get(req.Entity).(mo.Entity)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Entity})
+		return body
+	}
+
+	body.Res = new(types.GetAlarmStateResponse)
+
+	ctx.WithLock(me, func() {
+		body.Res.Returnval = append(body.Res.Returnval, me.Entity().TriggeredAlarmState...)
+	})
 
 	return body
 }
@@ -192,7 +353,7 @@ func (m *AlarmManager) CreateAlarm(ctx *Context, req *types.CreateAlarm) soap.Ha
 			Info: types.AlarmInfo{
 				AlarmSpec:        *req.Spec.GetAlarmSpec(),
 				Entity:           req.Entity,
-				LastModifiedTime: time.Now(),
+				LastModifiedTime: ctx.Map.Now(),
 				LastModifiedUser: ctx.Session.UserName,
 			},
 		},
@@ -202,6 +363,13 @@ func (m *AlarmManager) CreateAlarm(ctx *Context, req *types.CreateAlarm) soap.Ha
 	alarm.Info.Alarm = ref
 	m.GetAlarmResponse.Returnval = append(m.GetAlarmResponse.Returnval, ref)
 
+	if me, ok := ctx.Map.Get(req.Entity).(mo.Entity); ok {
+		ctx.postEvent(&types.AlarmCreatedEvent{
+			AlarmEvent: m.event(ctx, &alarm, me),
+			Entity:     entityEventArgument(ctx, req.Entity),
+		})
+	}
+
 	body.Res = &types.CreateAlarmResponse{
 		Returnval: ref,
 	}
@@ -212,35 +380,119 @@ func (m *AlarmManager) CreateAlarm(ctx *Context, req *types.CreateAlarm) soap.Ha
 func (m *AlarmManager) AcknowledgeAlarm(ctx *Context, req *types.AcknowledgeAlarm) soap.HasFault {
 	body := new(methods.AcknowledgeAlarmBody)
 
-	now := types.NewTime(time.Now())
+	now := types.NewTime(ctx.Map.Now())
 	key := m.key(req.Alarm, req.Entity)
 	me := ctx.Map.Get(req.Entity).(mo.Entity)
+	acked := false
 
-	update := func(me mo.Entity) *types.ManagedObjectReference {
+	update := func(me mo.Entity) (*types.ManagedObjectReference, bool) {
 		obj := me.Entity()
 
 		for i, state := range obj.TriggeredAlarmState {
 			if state.Key == key {
 				if *obj.TriggeredAlarmState[i].Acknowledged {
-					return nil // already ack-ed
+					return nil, false // already ack-ed
 				}
 				obj.TriggeredAlarmState[i].Acknowledged = types.NewBool(true)
 				obj.TriggeredAlarmState[i].AcknowledgedTime = now
 				obj.TriggeredAlarmState[i].AcknowledgedByUser = ctx.Session.UserName
-				return obj.Parent
+				acked = true
+				return obj.Parent, true
 			}
 		}
 
-		return nil
+		return nil, false
 	}
 
 	m.update(ctx, me, update)
 
+	if alarm, ok := ctx.Map.Get(req.Alarm).(*Alarm); ok && acked {
+		ctx.postEvent(&types.AlarmAcknowledgedEvent{
+			AlarmEvent: m.event(ctx, alarm, me),
+			Source:     entityEventArgument(ctx, req.Entity),
+			Entity:     entityEventArgument(ctx, alarm.Info.Entity),
+		})
+	}
+
 	body.Res = new(types.AcknowledgeAlarmResponse)
 
 	return body
 }
 
+// clearMatches returns true if the triggered alarm state matches the ClearTriggeredAlarms filter.
+func (*AlarmManager) clearMatches(alarm *Alarm, state types.AlarmState, filter types.AlarmFilterSpec) bool {
+	if len(filter.Status) != 0 && !slices.Contains(filter.Status, state.OverallStatus) {
+		return false
+	}
+
+	switch types.AlarmFilterSpecAlarmTypeByEntity(filter.TypeEntity) {
+	case types.AlarmFilterSpecAlarmTypeByEntityEntityTypeHost:
+		if state.Entity.Type != "HostSystem" {
+			return false
+		}
+	case types.AlarmFilterSpecAlarmTypeByEntityEntityTypeVm:
+		if state.Entity.Type != "VirtualMachine" {
+			return false
+		}
+	}
+
+	event := false
+	switch op := alarm.Info.Expression.(type) {
+	case *types.OrAlarmExpression:
+		for i := range op.Expression {
+			if _, ok := op.Expression[i].(*types.EventAlarmExpression); ok {
+				event = true
+			}
+		}
+	case *types.EventAlarmExpression:
+		event = true
+	}
+
+	switch types.AlarmFilterSpecAlarmTypeByTrigger(filter.TypeTrigger) {
+	case types.AlarmFilterSpecAlarmTypeByTriggerTriggerTypeEvent:
+		return event
+	case types.AlarmFilterSpecAlarmTypeByTriggerTriggerTypeMetric:
+		return !event
+	}
+
+	return true
+}
+
+func (m *AlarmManager) ClearTriggeredAlarms(ctx *Context, req *types.ClearTriggeredAlarms) soap.HasFault {
+	// Triggered alarms are propagated up to the root folder
+	root := ctx.Map.Get(ctx.Map.content().RootFolder).(mo.Entity)
+
+	var states []types.AlarmState
+	ctx.WithLock(root, func() {
+		states = append(states, root.Entity().TriggeredAlarmState...)
+	})
+
+	for _, state := range states {
+		alarm, ok := ctx.Map.Get(state.Alarm).(*Alarm)
+		if !ok || !m.clearMatches(alarm, state, req.Filter) {
+			continue
+		}
+
+		me, ok := ctx.Map.Get(state.Entity).(mo.Entity)
+		if !ok {
+			continue
+		}
+
+		from := m.setStatus(ctx, alarm, me, types.ManagedEntityStatusGreen, 0)
+
+		ctx.postEvent(&types.AlarmClearedEvent{
+			AlarmEvent: m.event(ctx, alarm, me),
+			Source:     entityEventArgument(ctx, state.Entity),
+			Entity:     entityEventArgument(ctx, alarm.Info.Entity),
+			From:       string(from),
+		})
+	}
+
+	return &methods.ClearTriggeredAlarmsBody{
+		Res: new(types.ClearTriggeredAlarmsResponse),
+	}
+}
+
 type Alarm struct {
 	mo.Alarm
 }
@@ -262,6 +514,13 @@ func (a *Alarm) RemoveAlarm(ctx *Context, req *types.RemoveAlarm) soap.HasFault
 
 	RemoveReference(&m.GetAlarmResponse.Returnval, req.This)
 
+	if me, ok := ctx.Map.Get(a.Info.Entity).(mo.Entity); ok {
+		ctx.postEvent(&types.AlarmRemovedEvent{
+			AlarmEvent: m.event(ctx, a, me),
+			Entity:     entityEventArgument(ctx, a.Info.Entity),
+		})
+	}
+
 	ctx.Map.Remove(ctx, req.This)
 
 	return &methods.RemoveAlarmBody{
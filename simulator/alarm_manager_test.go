@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/alarm"
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestAlarmManagerStatus(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		m := alarm.NewManager(c)
+		root := c.ServiceContent.RootFolder
+		vm := Map.Any("VirtualMachine").(*VirtualMachine)
+		host := Map.Any("HostSystem").(*HostSystem)
+
+		spec := &types.AlarmSpec{
+			Name:    "vcsim-cpu",
+			Enabled: true,
+			Expression: &types.MetricAlarmExpression{
+				Operator: types.MetricAlarmOperatorIsAbove,
+				Type:     "HostSystem",
+				Metric:   types.PerfMetricId{CounterId: 2},
+				Yellow:   7500,
+				Red:      9000,
+			},
+		}
+
+		ref, err := m.CreateAlarm(ctx, host, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		alarms, err := m.GetAlarm(ctx, host)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(alarms) != 1 || alarms[0].Self != *ref {
+			t.Errorf("alarms=%d", len(alarms))
+		}
+
+		state := func(entity types.ManagedObjectReference) []types.AlarmState {
+			res, err := methods.GetAlarmState(ctx, c, &types.GetAlarmState{This: m.Reference(), Entity: entity})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return res.Returnval
+		}
+
+		sctx := SpoofContext()
+		am := Map.AlarmManager()
+
+		// alarm is defined on the host, not applicable to its ancestors
+		if fault := am.SetAlarmStatus(sctx, *ref, root, types.ManagedEntityStatusRed); fault == nil {
+			t.Error("expected fault")
+		}
+
+		for _, status := range []types.ManagedEntityStatus{"yellow", "red", "red"} {
+			if fault := am.SetAlarmStatus(sctx, *ref, host.Self, status); fault != nil {
+				t.Fatal(fault)
+			}
+		}
+
+		for _, entity := range []types.ManagedObjectReference{host.Self, *host.Parent, root} {
+			s := state(entity)
+			if len(s) != 1 || s[0].OverallStatus != types.ManagedEntityStatusRed || s[0].Entity != host.Self {
+				t.Errorf("%s state=%#v", entity, s)
+			}
+		}
+		if s := state(vm.Self); len(s) != 0 {
+			t.Errorf("vm state=%#v", s)
+		}
+
+		query := func(kind string) []types.BaseEvent {
+			events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{EventTypeId: []string{kind}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return events
+		}
+
+		changed := query("AlarmStatusChangedEvent")
+		if len(changed) != 2 {
+			t.Fatalf("events=%d", len(changed))
+		}
+		e := changed[0].(*types.AlarmStatusChangedEvent) // latest first
+		if e.From != "yellow" || e.To != "red" || e.Source.Entity != host.Self || e.Alarm.Alarm != *ref {
+			t.Errorf("event=%#v", e)
+		}
+		if e.Host == nil || e.Host.Host != host.Self {
+			t.Errorf("event host=%#v", e.Host)
+		}
+
+		_, err = methods.ClearTriggeredAlarms(ctx, c, &types.ClearTriggeredAlarms{
+			This:   m.Reference(),
+			Filter: types.AlarmFilterSpec{TypeEntity: string(types.AlarmFilterSpecAlarmTypeByEntityEntityTypeVm)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := state(root); len(s) != 1 {
+			t.Errorf("root state=%#v", s)
+		}
+
+		_, err = methods.ClearTriggeredAlarms(ctx, c, &types.ClearTriggeredAlarms{This: m.Reference()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, entity := range []types.ManagedObjectReference{host.Self, root} {
+			if s := state(entity); len(s) != 0 {
+				t.Errorf("%s state=%#v", entity, s)
+			}
+		}
+
+		cleared := query("AlarmClearedEvent")
+		if len(cleared) != 1 || cleared[0].(*types.AlarmClearedEvent).From != "red" {
+			t.Errorf("events=%#v", cleared)
+		}
+
+		if len(query("AlarmCreatedEvent")) != 1 {
+			t.Error("expected AlarmCreatedEvent")
+		}
+	})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package simulator
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/vmware/govmomi/object"
@@ -148,16 +149,19 @@ func (m *AuthorizationManager) RetrieveRolePermissions(req *types.RetrieveRolePe
 	}
 }
 
-func (m *AuthorizationManager) HasPrivilegeOnEntities(req *types.HasPrivilegeOnEntities) soap.HasFault {
+func (m *AuthorizationManager) HasPrivilegeOnEntities(ctx *Context, req *types.HasPrivilegeOnEntities) soap.HasFault {
 	var p []types.EntityPrivilege
 
+	user := m.sessionUser(ctx, req.SessionId)
+
 	for _, e := range req.Entity {
 		priv := types.EntityPrivilege{Entity: e}
+		granted := m.granted(ctx, user, e)
 
 		for _, id := range req.PrivId {
 			priv.PrivAvailability = append(priv.PrivAvailability, types.PrivilegeAvailability{
 				PrivId:    id,
-				IsGranted: true,
+				IsGranted: granted(id),
 			})
 		}
 
@@ -171,11 +175,13 @@ func (m *AuthorizationManager) HasPrivilegeOnEntities(req *types.HasPrivilegeOnE
 	}
 }
 
-func (m *AuthorizationManager) HasPrivilegeOnEntity(req *types.HasPrivilegeOnEntity) soap.HasFault {
+func (m *AuthorizationManager) HasPrivilegeOnEntity(ctx *Context, req *types.HasPrivilegeOnEntity) soap.HasFault {
 	p := make([]bool, len(req.PrivId))
 
-	for i := range req.PrivId {
-		p[i] = true
+	granted := m.granted(ctx, m.sessionUser(ctx, req.SessionId), req.Entity)
+
+	for i, id := range req.PrivId {
+		p[i] = granted(id)
 	}
 
 	return &methods.HasPrivilegeOnEntityBody{
@@ -185,16 +191,17 @@ func (m *AuthorizationManager) HasPrivilegeOnEntity(req *types.HasPrivilegeOnEnt
 	}
 }
 
-func (m *AuthorizationManager) HasUserPrivilegeOnEntities(req *types.HasUserPrivilegeOnEntities) soap.HasFault {
+func (m *AuthorizationManager) HasUserPrivilegeOnEntities(ctx *Context, req *types.HasUserPrivilegeOnEntities) soap.HasFault {
 	var p []types.EntityPrivilege
 
 	for _, e := range req.Entities {
 		priv := types.EntityPrivilege{Entity: e}
+		granted := m.granted(ctx, req.UserName, e)
 
 		for _, id := range req.PrivId {
 			priv.PrivAvailability = append(priv.PrivAvailability, types.PrivilegeAvailability{
 				PrivId:    id,
-				IsGranted: true,
+				IsGranted: granted(id),
 			})
 		}
 
@@ -208,15 +215,24 @@ func (m *AuthorizationManager) HasUserPrivilegeOnEntities(req *types.HasUserPriv
 	}
 }
 
-func (m *AuthorizationManager) FetchUserPrivilegeOnEntities(req *types.FetchUserPrivilegeOnEntities) soap.HasFault {
+func (m *AuthorizationManager) FetchUserPrivilegeOnEntities(ctx *Context, req *types.FetchUserPrivilegeOnEntities) soap.HasFault {
 	admin := object.AuthorizationRoleList(m.RoleList).ByName("Admin").Privilege
 
 	var p []types.UserPrivilegeResult
 
 	for _, e := range req.Entities {
+		privs := admin
+		if ctx.Map.strictPermissions.Load() {
+			privs = nil
+			for id := range m.userPrivileges(ctx, req.UserName, e) {
+				privs = append(privs, id)
+			}
+			sort.Strings(privs)
+		}
+
 		p = append(p, types.UserPrivilegeResult{
 			Entity:     e,
-			Privileges: admin,
+			Privileges: privs,
 		})
 	}
 
@@ -334,3 +350,199 @@ func (m *AuthorizationManager) privIDs(ids []string) ([]string, *soap.Fault) {
 
 	return ids, nil
 }
+
+// methodPrivilege maps a method name to the privilege required on the target entity in strict permissions mode.
+// A method name qualified by the managed object type takes precedence, for methods such as Destroy_Task.
+// Methods not listed here only require an authenticated session.
+var methodPrivilege = map[string]string{
+	"AddAuthorizationRole":            "Authorization.ModifyRoles",
+	"AddCustomFieldDef":               "Global.ManageCustomFields",
+	"AddDVPortgroup_Task":             "DVPortgroup.Create",
+	"AddLicense":                      "Global.Licenses",
+	"CloneVM_Task":                    "VirtualMachine.Provisioning.Clone",
+	"CreateClusterEx":                 "Host.Inventory.CreateCluster",
+	"CreateCustomizationSpec":         "VirtualMachine.Provisioning.ModifyCustSpecs",
+	"CreateDVS_Task":                  "DVSwitch.Create",
+	"CreateDatacenter":                "Datacenter.Create",
+	"CreateFolder":                    "Folder.Create",
+	"CreateResourcePool":              "Resource.CreatePool",
+	"CreateSnapshot_Task":             "VirtualMachine.State.CreateSnapshot",
+	"CreateVM_Task":                   "VirtualMachine.Inventory.Create",
+	"CustomizeVM_Task":                "VirtualMachine.Provisioning.Customize",
+	"DeleteCustomizationSpec":         "VirtualMachine.Provisioning.ModifyCustSpecs",
+	"DeleteDatastoreFile_Task":        "Datastore.DeleteFile",
+	"EnterMaintenanceMode_Task":       "Host.Config.Maintenance",
+	"ExitMaintenanceMode_Task":        "Host.Config.Maintenance",
+	"GetCustomizationSpec":            "VirtualMachine.Provisioning.ReadCustSpecs",
+	"MarkAsTemplate":                  "VirtualMachine.Provisioning.MarkAsTemplate",
+	"MarkAsVirtualMachine":            "VirtualMachine.Provisioning.MarkAsVM",
+	"MigrateVM_Task":                  "Resource.HotMigrate",
+	"OverwriteCustomizationSpec":      "VirtualMachine.Provisioning.ModifyCustSpecs",
+	"PowerOffVM_Task":                 "VirtualMachine.Interact.PowerOff",
+	"PowerOnMultiVM_Task":             "VirtualMachine.Interact.PowerOn",
+	"PowerOnVM_Task":                  "VirtualMachine.Interact.PowerOn",
+	"RebootGuest":                     "VirtualMachine.Interact.Reset",
+	"ReconfigVM_Task":                 "VirtualMachine.Config.Settings",
+	"ReconfigureComputeResource_Task": "Host.Inventory.EditCluster",
+	"RegisterVM_Task":                 "VirtualMachine.Inventory.Register",
+	"RelocateVM_Task":                 "Resource.ColdMigrate",
+	"RemoveAllSnapshots_Task":         "VirtualMachine.State.RemoveSnapshot",
+	"RemoveAuthorizationRole":         "Authorization.ModifyRoles",
+	"RemoveEntityPermission":          "Authorization.ModifyPermissions",
+	"RemoveLicense":                   "Global.Licenses",
+	"RemoveSnapshot_Task":             "VirtualMachine.State.RemoveSnapshot",
+	"RenameSnapshot":                  "VirtualMachine.State.RenameSnapshot",
+	"RenameDatastore":                 "Datastore.Rename",
+	"ResetVM_Task":                    "VirtualMachine.Interact.Reset",
+	"RevertToCurrentSnapshot_Task":    "VirtualMachine.State.RevertToSnapshot",
+	"RevertToSnapshot_Task":           "VirtualMachine.State.RevertToSnapshot",
+	"SetCustomValue":                  "Global.SetCustomField",
+	"SetEntityPermissions":            "Authorization.ModifyPermissions",
+	"ShutdownGuest":                   "VirtualMachine.Interact.PowerOff",
+	"SuspendVM_Task":                  "VirtualMachine.Interact.Suspend",
+	"UnregisterVM":                    "VirtualMachine.Inventory.Unregister",
+	"UpdateAssignedLicense":           "Global.Licenses",
+	"UpdateAuthorizationRole":         "Authorization.ModifyRoles",
+
+	"ClusterComputeResource.Destroy_Task": "Host.Inventory.DeleteCluster",
+	"ClusterComputeResource.Rename_Task":  "Host.Inventory.RenameCluster",
+	"Datacenter.Destroy_Task":             "Datacenter.Delete",
+	"Datacenter.Rename_Task":              "Datacenter.Rename",
+	"Folder.Destroy_Task":                 "Folder.Delete",
+	"Folder.Rename_Task":                  "Folder.Rename",
+	"ResourcePool.Destroy_Task":           "Resource.DeletePool",
+	"ResourcePool.Rename_Task":            "Resource.RenamePool",
+	"ResourcePool.UpdateConfig":           "Resource.EditPool",
+	"VirtualMachine.Destroy_Task":         "VirtualMachine.Inventory.Delete",
+	"VirtualMachine.Rename_Task":          "VirtualMachine.Config.Rename",
+}
+
+// SetStrictPermissions enables or disables strict permissions mode.
+// When enabled, method calls are authorized against the permissions assigned to the session user via
+// AuthorizationManager, returning a NoPermission fault when the user lacks the privilege required by the method.
+// The HasPrivilege* and FetchUserPrivilegeOnEntities methods also report the privileges actually granted.
+func (r *Registry) SetStrictPermissions(enable bool) {
+	r.strictPermissions.Store(enable)
+}
+
+// sessionUser returns the user name of the session with the given key, defaulting to the current session.
+func (m *AuthorizationManager) sessionUser(ctx *Context, key string) string {
+	if key == "" || key == ctx.Session.Key {
+		return ctx.Session.UserName
+	}
+	if s, ok := ctx.Map.SessionManager().getSession(key); ok {
+		return s.UserName
+	}
+	return ""
+}
+
+// granted returns a func that reports if the given user has a privilege on the given entity.
+// All privileges are granted unless strict permissions mode is enabled.
+func (m *AuthorizationManager) granted(ctx *Context, user string, entity types.ManagedObjectReference) func(string) bool {
+	if !ctx.Map.strictPermissions.Load() {
+		return func(string) bool { return true }
+	}
+
+	privs := m.userPrivileges(ctx, user, entity)
+
+	return func(id string) bool { return privs[id] }
+}
+
+// userPrivileges returns the privileges granted to user on the given entity,
+// via the nearest permission defined on the entity itself or propagated from one of its ancestors.
+func (m *AuthorizationManager) userPrivileges(ctx *Context, user string, entity types.ManagedObjectReference) map[string]bool {
+	for self := true; ; self = false {
+		if id, ok := m.userRole(user, entity, self); ok {
+			privs := make(map[string]bool)
+
+			for _, role := range m.RoleList {
+				if role.RoleId != id {
+					continue
+				}
+				if len(role.Privilege) != 0 {
+					// All roles other than NoAccess include the system privileges
+					for _, p := range m.system {
+						privs[p] = true
+					}
+				}
+				for _, p := range role.Privilege {
+					privs[p] = true
+				}
+			}
+
+			return privs
+		}
+
+		e, ok := ctx.Map.Get(entity).(mo.Entity)
+		if !ok || e.Entity().Parent == nil {
+			return nil
+		}
+		entity = *e.Entity().Parent
+	}
+}
+
+// userRole returns the role of the permission for user defined on the given entity,
+// where a user permission takes precedence over a group permission.
+// Permissions inherited from an ancestor entity only apply if they propagate.
+func (m *AuthorizationManager) userRole(user string, entity types.ManagedObjectReference, self bool) (int32, bool) {
+	var (
+		id    int32
+		found bool
+	)
+
+	for _, p := range m.permissions[entity] {
+		if !(self || p.Propagate) || !strings.EqualFold(p.Principal, user) {
+			continue
+		}
+		if !p.Group {
+			return p.RoleId, true
+		}
+		id, found = p.RoleId, true
+	}
+
+	return id, found
+}
+
+// permissionEntity returns the entity on which permissions are checked for the given object.
+// Methods of objects that are not entities, such as the service managers, require privileges on the root folder.
+func (m *AuthorizationManager) permissionEntity(ctx *Context, obj mo.Reference) types.ManagedObjectReference {
+	switch x := obj.(type) {
+	case mo.Entity:
+		return x.Reference()
+	case *VirtualMachineSnapshot:
+		return x.Vm
+	}
+
+	return ctx.Map.content().RootFolder
+}
+
+// checkPermission returns a NoPermission fault if the session user lacks the privilege required to invoke method on obj.
+func (m *AuthorizationManager) checkPermission(ctx *Context, obj mo.Reference, method string) types.BaseMethodFault {
+	id, ok := methodPrivilege[typeName(obj)+"."+method]
+	if !ok {
+		id, ok = methodPrivilege[method]
+	}
+	if !ok {
+		return nil
+	}
+
+	entity := m.permissionEntity(ctx, obj)
+
+	var privs map[string]bool
+	ctx.WithLock(m, func() {
+		privs = m.userPrivileges(ctx, ctx.Session.UserName, entity)
+	})
+
+	if privs[id] {
+		return nil
+	}
+
+	return &types.NoPermission{
+		Object:      &entity,
+		PrivilegeId: id,
+		MissingPrivileges: []types.NoPermissionEntityPrivileges{{
+			Entity:       entity,
+			PrivilegeIds: []string{id},
+		}},
+	}
+}
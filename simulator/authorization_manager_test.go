@@ -17,9 +17,16 @@ limitations under the License.
 package simulator
 
 import (
+	"context"
+	"net/url"
 	"testing"
 
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/session"
 	"github.com/vmware/govmomi/simulator/vpx"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -35,3 +42,106 @@ func TestAuthorizationManager(t *testing.T) {
 		})
 	}
 }
+
+func TestStrictPermissions(t *testing.T) {
+	m := VPX()
+	m.StrictPermissions = true
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		authz := object.NewAuthorizationManager(c)
+		sm := session.NewManager(c)
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+
+		for method, id := range methodPrivilege {
+			if _, ok := Map.AuthorizationManager().privileges[id]; !ok {
+				t.Errorf("%s: unknown privilege %s", method, id)
+			}
+		}
+
+		// the default "user" login has no permissions
+		_, err := vm.PowerOff(ctx)
+		if !fault.Is(err, &types.NoPermission{}) {
+			t.Fatalf("expected NoPermission, got: %v", err)
+		}
+
+		// methods without a required privilege are allowed
+		if _, err = methods.GetCurrentTime(ctx, c); err != nil {
+			return err
+		}
+
+		// grant "user" a role with the PowerOff privilege on the VM, as admin
+		if err = sm.Logout(ctx); err != nil {
+			return err
+		}
+		if err = sm.Login(ctx, url.UserPassword("admin", "pass")); err != nil {
+			return err
+		}
+		id, err := authz.AddRole(ctx, "operator", []string{"VirtualMachine.Interact.PowerOff"})
+		if err != nil {
+			return err
+		}
+		err = authz.SetEntityPermissions(ctx, vm.Reference(), []types.Permission{{
+			Principal: "user",
+			RoleId:    id,
+		}})
+		if err != nil {
+			return err
+		}
+
+		if err = sm.Logout(ctx); err != nil {
+			return err
+		}
+		if err = sm.Login(ctx, DefaultLogin); err != nil {
+			return err
+		}
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		_, err = vm.PowerOn(ctx)
+		if !fault.Is(err, &types.NoPermission{}) {
+			t.Errorf("expected NoPermission, got: %v", err)
+		}
+
+		// authorization changes also require privileges
+		_, err = authz.AddRole(ctx, "escalate", []string{"VirtualMachine.Interact.PowerOn"})
+		if !fault.Is(err, &types.NoPermission{}) {
+			t.Errorf("expected NoPermission, got: %v", err)
+		}
+
+		host := Map.Any("HostSystem").Reference()
+
+		privs, err := authz.HasUserPrivilegeOnEntities(ctx, []types.ManagedObjectReference{vm.Reference(), host}, "user", []string{
+			"VirtualMachine.Interact.PowerOff",
+			"VirtualMachine.Interact.PowerOn",
+			"System.Read",
+		})
+		if err != nil {
+			return err
+		}
+		granted := func(i int) []bool {
+			var res []bool
+			for _, p := range privs[i].PrivAvailability {
+				res = append(res, p.IsGranted)
+			}
+			return res
+		}
+		if g := granted(0); !g[0] || g[1] || !g[2] {
+			t.Errorf("vm privileges=%v", g)
+		}
+		// the permission is defined on the VM only
+		if g := granted(1); g[0] || g[1] || g[2] {
+			t.Errorf("host privileges=%v", g)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
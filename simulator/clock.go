@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25"
+)
+
+// Clock is the source of the current time for time-dependent simulator behaviors,
+// such as session expiry, task and event timestamps, alarm state times,
+// performance sample timestamps and ServiceInstance.CurrentTime.
+// The default Clock is the system clock, see Registry.SetClock.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// ManualClock is a Clock that only moves when Set or Advance is called,
+// allowing tests to drive time-dependent behaviors deterministically.
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewManualClock returns a ManualClock starting at the given time.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Now returns the current time of the clock.
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set moves the clock to the given time.
+func (c *ManualClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// Advance moves the clock forward by d and returns the new time.
+func (c *ManualClock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	return c.now
+}
+
+type clockValue struct {
+	Clock
+}
+
+// SetClock sets the Clock used by the objects of this Registry.
+// A nil Clock restores the system clock.
+func (r *Registry) SetClock(clock Clock) {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	r.clock.Store(clockValue{clock})
+}
+
+// Now returns the current time of the Registry's Clock.
+func (r *Registry) Now() time.Time {
+	if c, ok := r.clock.Load().(clockValue); ok {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+// now returns the current time of the vim25 Registry's Clock, which also applies to the sessions shared with other endpoints.
+func (s *Service) now() time.Time {
+	if r, ok := s.sdk[vim25.Path]; ok {
+		return r.Now()
+	}
+	return time.Now()
+}
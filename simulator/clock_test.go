@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/performance"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestManualClock(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewManualClock(start)
+
+	timeout := SessionIdleTimeout
+	SessionIdleTimeout = time.Hour
+	defer func() { SessionIdleTimeout = timeout }()
+
+	m := VPX()
+	m.Clock = clock
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		now, err := methods.GetCurrentTime(ctx, c)
+		if err != nil {
+			return err
+		}
+		if !now.Equal(start) {
+			t.Errorf("current time=%s", now)
+		}
+
+		// task and event timestamps
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+
+		clock.Advance(time.Minute)
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			return err
+		}
+		info, err := task.WaitForResult(ctx)
+		if err != nil {
+			return err
+		}
+		expect := start.Add(time.Minute)
+		if !info.QueueTime.Equal(expect) || !info.StartTime.Equal(expect) || !info.CompleteTime.Equal(expect) {
+			t.Errorf("task times=%s, %s, %s", info.QueueTime, info.StartTime, info.CompleteTime)
+		}
+
+		events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{
+			Entity: &types.EventFilterSpecByEntity{
+				Entity:    vm.Reference(),
+				Recursion: types.EventFilterSpecRecursionOptionSelf,
+			},
+			Type: []string{"VmPoweredOffEvent"},
+		})
+		if err != nil {
+			return err
+		}
+		if len(events) != 1 || !events[0].GetEvent().CreatedTime.Equal(expect) {
+			t.Errorf("events=%#v", events)
+		}
+
+		// performance sample timestamps
+		p := performance.NewManager(c)
+		counters, err := p.CounterInfoByName(ctx)
+		if err != nil {
+			return err
+		}
+		res, err := p.Query(ctx, []types.PerfQuerySpec{{
+			Entity:     vm.Reference(),
+			IntervalId: 20,
+			MetricId:   []types.PerfMetricId{{CounterId: counters["cpu.usage.average"].Key}},
+		}})
+		if err != nil {
+			return err
+		}
+		samples := res[0].(*types.PerfEntityMetric).SampleInfo
+		if last := samples[len(samples)-1].Timestamp; !last.Equal(expect) {
+			t.Errorf("last sample=%s", last)
+		}
+
+		// session expiry
+		sm := session.NewManager(c)
+
+		clock.Advance(SessionIdleTimeout - time.Second)
+		if s, err := sm.UserSession(ctx); err != nil || s == nil {
+			t.Errorf("session=%v, err=%v", s, err)
+		}
+
+		clock.Advance(SessionIdleTimeout + time.Second)
+		if s, err := sm.UserSession(ctx); err != nil || s != nil {
+			t.Errorf("expected expired session=%v, err=%v", s, err)
+		}
+
+		_, err = methods.GetCurrentTime(ctx, c)
+		if !fault.Is(err, &types.NotAuthenticated{}) {
+			t.Errorf("expected NotAuthenticated, got: %v", err)
+		}
+
+		// restore the system clock
+		Map.SetClock(nil)
+		if err = sm.Login(ctx, DefaultLogin); err != nil {
+			return err
+		}
+		now, err = methods.GetCurrentTime(ctx, c)
+		if err != nil {
+			return err
+		}
+		if time.Since(*now) > time.Minute {
+			t.Errorf("current time=%s", now)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
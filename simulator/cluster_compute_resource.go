@@ -17,8 +17,11 @@ limitations under the License.
 package simulator
 
 import (
+	"cmp"
 	"log"
-	"math/rand"
+	"math"
+	"slices"
+	"strconv"
 	"sync/atomic"
 	"time"
 
@@ -35,7 +38,9 @@ import (
 type ClusterComputeResource struct {
 	mo.ClusterComputeResource
 
-	ruleKey int32
+	ruleKey    int32
+	drsKey     int32
+	evcManager *types.ManagedObjectReference
 }
 
 func (c *ClusterComputeResource) RenameTask(ctx *Context, req *types.Rename_Task) soap.HasFault {
@@ -80,6 +85,7 @@ func (add *addHost) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 
 	cr.Host = append(cr.Host, host.Reference())
 	addComputeResource(cr.Summary.GetComputeResourceSummary(), host)
+	cr.configureVsan(task.ctx, host.Reference())
 
 	return host.Reference(), nil
 }
@@ -100,6 +106,15 @@ func (c *ClusterComputeResource) update(cfg *types.ClusterConfigInfoEx, cspec *t
 		if val := cspec.DasConfig.AdmissionControlEnabled; val != nil {
 			cfg.DasConfig.AdmissionControlEnabled = val
 		}
+		if val := cspec.DasConfig.DefaultVmSettings; val != nil {
+			cfg.DasConfig.DefaultVmSettings = val
+		}
+		if val := cspec.DasConfig.AdmissionControlPolicy; val != nil {
+			if fault := c.validateAdmissionControlPolicy(val); fault != nil {
+				return fault
+			}
+			cfg.DasConfig.AdmissionControlPolicy = val
+		}
 	}
 	if cspec.DrsConfig != nil {
 		if val := cspec.DrsConfig.Enabled; val != nil {
@@ -108,11 +123,64 @@ func (c *ClusterComputeResource) update(cfg *types.ClusterConfigInfoEx, cspec *t
 		if val := cspec.DrsConfig.DefaultVmBehavior; val != "" {
 			cfg.DrsConfig.DefaultVmBehavior = val
 		}
+		if val := cspec.DrsConfig.VmotionRate; val != 0 {
+			if val < 1 || val > 5 {
+				return &types.InvalidArgument{InvalidProperty: "drsConfig.vmotionRate"}
+			}
+			cfg.DrsConfig.VmotionRate = val
+		}
+	}
+	if cspec.VsanConfig != nil {
+		if cfg.VsanConfigInfo == nil {
+			cfg.VsanConfigInfo = new(types.VsanClusterConfigInfo)
+		}
+		if val := cspec.VsanConfig.Enabled; val != nil {
+			cfg.VsanConfigInfo.Enabled = val
+		}
+		if val := cspec.VsanConfig.DefaultConfig; val != nil {
+			cfg.VsanConfigInfo.DefaultConfig = val
+		}
 	}
 
 	return nil
 }
 
+// configureVsan applies the cluster's vSAN config to the vSAN system of the given hosts.
+// The cluster uuid is generated when vSAN is first enabled, unless specified via DefaultConfig.
+func (c *ClusterComputeResource) configureVsan(ctx *Context, hosts ...types.ManagedObjectReference) {
+	info := c.ConfigurationEx.(*types.ClusterConfigInfoEx).VsanConfigInfo
+	if info == nil {
+		return
+	}
+
+	if info.Enabled != nil && *info.Enabled {
+		if info.DefaultConfig == nil {
+			info.DefaultConfig = new(types.VsanClusterConfigInfoHostDefaultInfo)
+		}
+		if info.DefaultConfig.Uuid == "" {
+			info.DefaultConfig.Uuid = uuid.New().String()
+		}
+	}
+
+	spec := types.VsanHostConfigInfo{
+		Enabled:     types.NewBool(info.Enabled != nil && *info.Enabled),
+		ClusterInfo: new(types.VsanHostConfigInfoClusterInfo),
+	}
+	if info.DefaultConfig != nil {
+		spec.ClusterInfo.Uuid = info.DefaultConfig.Uuid
+	}
+
+	for _, ref := range hosts {
+		host := ctx.Map.Get(ref).(*HostSystem)
+		if host.ConfigManager.VsanSystem == nil {
+			continue
+		}
+		if vsan, ok := ctx.Map.Get(*host.ConfigManager.VsanSystem).(*HostVsanSystem); ok {
+			vsan.update(ctx, spec)
+		}
+	}
+}
+
 func (c *ClusterComputeResource) updateRules(cfg *types.ClusterConfigInfoEx, cspec *types.ClusterConfigSpecEx) types.BaseMethodFault {
 	for _, spec := range cspec.RulesSpec {
 		var i int
@@ -354,6 +422,14 @@ func (c *ClusterComputeResource) ReconfigureComputeResourceTask(ctx *Context, re
 			return nil, new(types.InvalidArgument)
 		}
 
+		if spec.DrsConfig != nil && isTrue(spec.DrsConfig.Enabled) {
+			for _, host := range c.Host {
+				if fault := licensed(ctx, host.Value, "drs"); fault != nil {
+					return nil, fault
+				}
+			}
+		}
+
 		updates := []func(*types.ClusterConfigInfoEx, *types.ClusterConfigSpecEx) types.BaseMethodFault{
 			c.update,
 			c.updateRules,
@@ -369,6 +445,14 @@ func (c *ClusterComputeResource) ReconfigureComputeResourceTask(ctx *Context, re
 			}
 		}
 
+		if spec.DrsConfig != nil {
+			c.invokeDrs(ctx)
+		}
+
+		if spec.VsanConfig != nil {
+			c.configureVsan(ctx, c.Host...)
+		}
+
 		return nil, nil
 	})
 
@@ -443,9 +527,24 @@ func (c *ClusterComputeResource) PlaceVm(ctx *Context, req *types.PlaceVm) soap.
 
 	switch types.PlacementSpecPlacementType(req.PlacementSpec.PlacementType) {
 	case types.PlacementSpecPlacementTypeClone, types.PlacementSpecPlacementTypeCreate:
+		var vm *VirtualMachine
+		if req.PlacementSpec.Vm != nil {
+			vm, _ = ctx.Map.Get(*req.PlacementSpec.Vm).(*VirtualMachine) // clone source
+		}
+		loads := c.drsLoads(ctx, hosts)
+		if len(loads) == 0 {
+			body.Fault_ = Fault("", new(types.InsufficientResourcesFault))
+			return body
+		}
+		slices.SortStableFunc(loads, func(a, b *drsLoad) int {
+			return cmp.Compare(a.with(vm), b.with(vm))
+		})
+		host := loads[0].host
+		res.Rating = drsRating(loads[0].with(vm))
+
 		spec := &types.VirtualMachineRelocateSpec{
-			Datastore: &datastores[rand.Intn(len(c.Datastore))],
-			Host:      &hosts[rand.Intn(len(c.Host))],
+			Datastore: c.placeDatastore(ctx, host, datastores),
+			Host:      &host.Self,
 			Pool:      c.ResourcePool,
 		}
 		res.Action = append(res.Action, &types.PlacementAction{
@@ -636,6 +735,603 @@ func populateRelocateSpecForPlaceVmRelocate(specPtr **types.VirtualMachineReloca
 	}
 }
 
+func (c *ClusterComputeResource) RecommendHostsForVm(ctx *Context, req *types.RecommendHostsForVm) soap.HasFault {
+	body := new(methods.RecommendHostsForVmBody)
+
+	vm, ok := ctx.Map.Get(req.Vm).(*VirtualMachine)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Vm})
+		return body
+	}
+
+	loads := c.drsLoads(ctx, c.Host)
+	slices.SortStableFunc(loads, func(a, b *drsLoad) int {
+		return cmp.Compare(a.with(vm), b.with(vm))
+	})
+
+	res := &types.RecommendHostsForVmResponse{}
+	for _, load := range loads {
+		res.Returnval = append(res.Returnval, types.ClusterHostRecommendation{
+			Host:   load.host.Self,
+			Rating: drsRating(load.with(vm)),
+		})
+	}
+
+	body.Res = res
+	return body
+}
+
+func (c *ClusterComputeResource) RefreshRecommendation(ctx *Context, req *types.RefreshRecommendation) soap.HasFault {
+	c.invokeDrs(ctx)
+
+	return &methods.RefreshRecommendationBody{
+		Res: new(types.RefreshRecommendationResponse),
+	}
+}
+
+func (c *ClusterComputeResource) ApplyRecommendation(ctx *Context, req *types.ApplyRecommendation) soap.HasFault {
+	body := new(methods.ApplyRecommendationBody)
+
+	for i, rec := range c.Recommendation {
+		if rec.Key != req.Key {
+			continue
+		}
+
+		c.applyRecommendation(ctx, rec)
+
+		ctx.Map.Update(c, []types.PropertyChange{
+			{Name: "recommendation", Val: slices.Delete(slices.Clone(c.Recommendation), i, i+1)},
+		})
+
+		body.Res = new(types.ApplyRecommendationResponse)
+		return body
+	}
+
+	body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "key"})
+	return body
+}
+
+// drsLoad is the simulated load of a cluster host, used for DRS placement and load balancing.
+// The load is the demand of the host's powered on VMs, as a fraction of the host's capacity,
+// where the demand of a VM is its configured number of CPUs and memory size.
+type drsLoad struct {
+	host *HostSystem
+	cpu  float64
+	mem  float64
+	vms  []*VirtualMachine
+}
+
+// demand returns the CPU and memory load of the given vm on this host.
+func (l *drsLoad) demand(vm *VirtualMachine) (float64, float64) {
+	hw := l.host.Summary.Hardware
+	cpu := float64(vm.Summary.Config.NumCpu) / float64(max(hw.NumCpuThreads, 1))
+	mem := float64(vm.Summary.Config.MemorySizeMB) * 1024 * 1024 / float64(max(hw.MemorySize, 1))
+	return cpu, mem
+}
+
+func (l *drsLoad) value() float64 {
+	return max(l.cpu, l.mem)
+}
+
+// with returns the load of this host if the given vm was running on it, vm may be nil.
+func (l *drsLoad) with(vm *VirtualMachine) float64 {
+	if vm == nil || slices.Contains(l.vms, vm) {
+		return l.value()
+	}
+	cpu, mem := l.demand(vm)
+	return max(l.cpu+cpu, l.mem+mem)
+}
+
+func (l *drsLoad) add(vm *VirtualMachine, sign float64) {
+	cpu, mem := l.demand(vm)
+	l.cpu += sign * cpu
+	l.mem += sign * mem
+	if sign > 0 {
+		l.vms = append(l.vms, vm)
+	} else {
+		l.vms = slices.DeleteFunc(l.vms, func(v *VirtualMachine) bool { return v == vm })
+	}
+}
+
+// drsRating converts a host load to a recommendation rating in the range of 1 to 5, where 5 is the best.
+func drsRating(load float64) int32 {
+	return int32(max(1, min(5, 5-int(load*4))))
+}
+
+// drsTolerance is the host load imbalance tolerated by each DRS migration threshold (vmotionRate),
+// from the most conservative (1) to the most aggressive (5).
+var drsTolerance = []float64{0.5, 0.4, 0.3, 0.2, 0.1}
+
+// drsLoads returns the load of the given hosts, excluding hosts that are not connected or in maintenance mode.
+func (c *ClusterComputeResource) drsLoads(ctx *Context, hosts []types.ManagedObjectReference) []*drsLoad {
+	var loads []*drsLoad
+
+	for _, ref := range hosts {
+		host, ok := ctx.Map.Get(ref).(*HostSystem)
+		if !ok || host.Runtime.InMaintenanceMode ||
+			host.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+			continue
+		}
+
+		load := &drsLoad{host: host}
+		for _, vmref := range host.Vm {
+			vm, ok := ctx.Map.Get(vmref).(*VirtualMachine)
+			if ok && vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn && *vm.Runtime.Host == ref {
+				load.add(vm, 1)
+			}
+		}
+		loads = append(loads, load)
+	}
+
+	return loads
+}
+
+// placeDatastore returns the datastore with the most free space, preferring those mounted by the given host.
+func (c *ClusterComputeResource) placeDatastore(ctx *Context, host *HostSystem, datastores []types.ManagedObjectReference) *types.ManagedObjectReference {
+	var place *Datastore
+
+	for _, mounted := range []bool{true, false} {
+		for _, ref := range datastores {
+			ds, ok := ctx.Map.Get(ref).(*Datastore)
+			if !ok || (mounted && !slices.Contains(host.Datastore, ref)) {
+				continue
+			}
+			if place == nil || ds.Summary.FreeSpace > place.Summary.FreeSpace {
+				place = ds
+			}
+		}
+		if place != nil {
+			return &place.Self
+		}
+	}
+
+	return &datastores[0]
+}
+
+// drsBehavior returns the DRS automation level for the given vm, or an empty string if DRS is disabled for the vm.
+func (c *ClusterComputeResource) drsBehavior(vm *VirtualMachine) types.DrsBehavior {
+	cfg := c.ConfigurationEx.(*types.ClusterConfigInfoEx)
+
+	if cfg.DrsConfig.Enabled == nil || !*cfg.DrsConfig.Enabled {
+		return ""
+	}
+
+	behavior := cfg.DrsConfig.DefaultVmBehavior
+	if behavior == "" {
+		behavior = types.DrsBehaviorManual
+	}
+
+	if vm != nil {
+		for _, o := range cfg.DrsVmConfig {
+			if o.Key != vm.Self {
+				continue
+			}
+			if o.Enabled != nil && !*o.Enabled {
+				return ""
+			}
+			if o.Behavior != "" {
+				behavior = o.Behavior
+			}
+		}
+	}
+
+	return behavior
+}
+
+// drsRecommendations returns the migrations that balance the load of the cluster's hosts,
+// given the imbalance tolerated by the DRS migration threshold.
+func (c *ClusterComputeResource) drsRecommendations(ctx *Context) []types.ClusterRecommendation {
+	if c.drsBehavior(nil) == "" {
+		return nil
+	}
+
+	cfg := c.ConfigurationEx.(*types.ClusterConfigInfoEx)
+	rate := cfg.DrsConfig.VmotionRate
+	if rate < 1 || rate > 5 {
+		rate = 3
+	}
+	tolerance := drsTolerance[rate-1]
+
+	loads := c.drsLoads(ctx, c.Host)
+	if len(loads) < 2 {
+		return nil
+	}
+
+	var recs []types.ClusterRecommendation
+	now := time.Now()
+
+	for {
+		slices.SortStableFunc(loads, func(a, b *drsLoad) int {
+			return cmp.Compare(a.value(), b.value())
+		})
+		src, dst := loads[len(loads)-1], loads[0]
+		imbalance := src.value() - dst.value()
+		if imbalance <= tolerance {
+			break
+		}
+
+		// move the VM that best reduces the imbalance between the most and least loaded hosts
+		var move *VirtualMachine
+		best := imbalance
+		for _, vm := range src.vms {
+			if c.drsBehavior(vm) == "" {
+				continue
+			}
+			scpu, smem := src.demand(vm)
+			dcpu, dmem := dst.demand(vm)
+			after := math.Abs(max(src.cpu-scpu, src.mem-smem) - max(dst.cpu+dcpu, dst.mem+dmem))
+			if after < best {
+				move, best = vm, after
+			}
+		}
+		if move == nil {
+			break
+		}
+
+		reason := types.RecommendationReasonCodeFairnessCpuAvg
+		if src.mem > src.cpu {
+			reason = types.RecommendationReasonCodeFairnessMemAvg
+		}
+
+		key := strconv.Itoa(int(atomic.AddInt32(&c.drsKey, 1)))
+		recs = append(recs, types.ClusterRecommendation{
+			Key:        key,
+			Type:       "V1",
+			Time:       now,
+			Rating:     int32(max(1, min(5, int(imbalance*10)))),
+			Reason:     string(reason),
+			ReasonText: string(reason),
+			Target:     &c.Self,
+			Action: []types.BaseClusterAction{&types.ClusterMigrationAction{
+				ClusterAction: types.ClusterAction{Type: "MigrationV1", Target: &move.Self},
+				DrsMigration: &types.ClusterDrsMigration{
+					Key:                   key,
+					Time:                  now,
+					Vm:                    move.Self,
+					Source:                src.host.Self,
+					SourceCpuLoad:         int32(src.cpu * 100),
+					SourceMemoryLoad:      int64(src.mem * 100),
+					Destination:           dst.host.Self,
+					DestinationCpuLoad:    int32(dst.cpu * 100),
+					DestinationMemoryLoad: int64(dst.mem * 100),
+				},
+			}},
+		})
+
+		src.add(move, -1)
+		dst.add(move, 1)
+	}
+
+	return recs
+}
+
+// invokeDrs applies DRS recommendations for fully automated VMs, other recommendations are
+// made available via the cluster's recommendation property.
+func (c *ClusterComputeResource) invokeDrs(ctx *Context) {
+	var pending []types.ClusterRecommendation
+
+	for _, rec := range c.drsRecommendations(ctx) {
+		vm := ctx.Map.Get(*rec.Action[0].GetClusterAction().Target).(*VirtualMachine)
+		if c.drsBehavior(vm) == types.DrsBehaviorFullyAutomated {
+			c.applyRecommendation(ctx, rec)
+		} else {
+			pending = append(pending, rec)
+		}
+	}
+
+	ctx.Map.Update(c, []types.PropertyChange{{Name: "recommendation", Val: pending}})
+}
+
+// applyRecommendation starts a migration task for each action of the given recommendation.
+func (c *ClusterComputeResource) applyRecommendation(ctx *Context, rec types.ClusterRecommendation) {
+	for _, action := range rec.Action {
+		migration, ok := action.(*types.ClusterMigrationAction)
+		if !ok {
+			continue
+		}
+
+		vm := ctx.Map.Get(migration.DrsMigration.Vm).(*VirtualMachine)
+		host := ctx.Map.Get(migration.DrsMigration.Destination).(*HostSystem)
+
+		task := CreateTask(vm, "drsMigrate", func(*Task) (types.AnyType, types.BaseMethodFault) {
+			if host.Runtime.InMaintenanceMode {
+				return nil, new(types.InvalidState)
+			}
+			vm.drsMigrate(ctx, host)
+			return nil, nil
+		})
+		task.Info.DescriptionId = "Drm.ExecuteVMotionLRO"
+		task.Run(ctx)
+	}
+}
+
+// drsPowerOn places the given vm on the least loaded host of the cluster, if DRS is fully automated for the vm.
+func (c *ClusterComputeResource) drsPowerOn(ctx *Context, vm *VirtualMachine) {
+	if c.drsBehavior(vm) != types.DrsBehaviorFullyAutomated {
+		return
+	}
+
+	loads := c.drsLoads(ctx, c.Host)
+	if len(loads) == 0 {
+		return
+	}
+
+	slices.SortStableFunc(loads, func(a, b *drsLoad) int {
+		return cmp.Compare(a.with(vm), b.with(vm))
+	})
+
+	if host := loads[0].host; host.Self != *vm.Runtime.Host {
+		vm.setHost(ctx, host)
+	}
+}
+
+// drsEvacuate migrates the given vm from a host entering maintenance mode to the least loaded of the cluster's
+// other hosts with access to the vm's datastores. Returns false if DRS is disabled for the vm or there is no such host.
+func (c *ClusterComputeResource) drsEvacuate(ctx *Context, host *HostSystem, vm *VirtualMachine) bool {
+	if c.drsBehavior(vm) == "" {
+		return false
+	}
+
+	var hosts []types.ManagedObjectReference
+	for _, ref := range c.Host {
+		h := ctx.Map.Get(ref).(*HostSystem)
+		if ref == host.Self || slices.ContainsFunc(vm.Datastore, func(ds types.ManagedObjectReference) bool {
+			return !slices.Contains(h.Datastore, ds)
+		}) {
+			continue
+		}
+		hosts = append(hosts, ref)
+	}
+
+	loads := c.drsLoads(ctx, hosts)
+	if len(loads) == 0 {
+		return false
+	}
+
+	slices.SortStableFunc(loads, func(a, b *drsLoad) int {
+		return cmp.Compare(a.with(vm), b.with(vm))
+	})
+
+	ctx.WithLock(vm, func() {
+		vm.drsMigrate(ctx, loads[0].host)
+	})
+
+	return true
+}
+
+// dasRestartPriority orders the HA restart priorities, VMs with a higher priority are restarted first.
+var dasRestartPriority = []types.ClusterDasVmSettingsRestartPriority{
+	types.ClusterDasVmSettingsRestartPriorityDisabled,
+	types.ClusterDasVmSettingsRestartPriorityLowest,
+	types.ClusterDasVmSettingsRestartPriorityLow,
+	types.ClusterDasVmSettingsRestartPriorityMedium,
+	types.ClusterDasVmSettingsRestartPriorityHigh,
+	types.ClusterDasVmSettingsRestartPriorityHighest,
+}
+
+// dasPriority returns the HA restart priority of the given vm, as an index of dasRestartPriority.
+func (c *ClusterComputeResource) dasPriority(vm *VirtualMachine) int {
+	cfg := c.ConfigurationEx.(*types.ClusterConfigInfoEx)
+
+	if cfg.DasConfig.Enabled == nil || !*cfg.DasConfig.Enabled {
+		return 0
+	}
+
+	priority := string(types.ClusterDasVmSettingsRestartPriorityMedium)
+	if s := cfg.DasConfig.DefaultVmSettings; s != nil && s.RestartPriority != "" {
+		priority = s.RestartPriority
+	}
+
+	for _, o := range cfg.DasVmConfig {
+		if o.Key != vm.Self {
+			continue
+		}
+		if s := o.DasSettings; s != nil && s.RestartPriority != "" &&
+			s.RestartPriority != string(types.ClusterDasVmSettingsRestartPriorityClusterRestartPriority) {
+			priority = s.RestartPriority
+		}
+	}
+
+	return max(slices.Index(dasRestartPriority, types.ClusterDasVmSettingsRestartPriority(priority)), 0)
+}
+
+// dasFailover restarts the powered on HA protected VMs of the given failed host on the cluster's surviving hosts,
+// in order of restart priority.
+func (c *ClusterComputeResource) dasFailover(ctx *Context, failed *HostSystem) {
+	cfg := c.ConfigurationEx.(*types.ClusterConfigInfoEx)
+	if cfg.DasConfig.Enabled == nil || !*cfg.DasConfig.Enabled {
+		return
+	}
+
+	ctx.postEvent(&types.DasHostFailedEvent{
+		ClusterEvent: types.ClusterEvent{Event: failed.event().Event},
+		FailedHost:   *failed.eventArgument(),
+	})
+
+	var vms []*VirtualMachine
+	for _, ref := range failed.Vm {
+		vm := ctx.Map.Get(ref).(*VirtualMachine)
+		if vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn && c.dasPriority(vm) > 0 {
+			vms = append(vms, vm)
+		}
+	}
+	slices.SortStableFunc(vms, func(a, b *VirtualMachine) int {
+		return cmp.Compare(c.dasPriority(b), c.dasPriority(a))
+	})
+
+	for _, vm := range vms {
+		loads := c.drsLoads(ctx, c.Host)
+		if len(loads) == 0 {
+			break // no surviving hosts
+		}
+		slices.SortStableFunc(loads, func(a, b *drsLoad) int {
+			return cmp.Compare(a.with(vm), b.with(vm))
+		})
+
+		ctx.WithLock(vm, func() {
+			vm.setHost(ctx, loads[0].host)
+			ctx.Map.Update(vm, []types.PropertyChange{
+				{Name: "runtime.bootTime", Val: time.Now()},
+				{Name: "summary.runtime.bootTime", Val: time.Now()},
+			})
+
+			ctx.postEvent(&types.VmRestartedOnAlternateHostEvent{
+				VmPoweredOnEvent: types.VmPoweredOnEvent{VmEvent: vm.event()},
+				SourceHost:       *failed.eventArgument(),
+			})
+		})
+	}
+}
+
+func (c *ClusterComputeResource) validateAdmissionControlPolicy(policy types.BaseClusterDasAdmissionControlPolicy) types.BaseMethodFault {
+	invalid := func(name string) types.BaseMethodFault {
+		return &types.InvalidArgument{InvalidProperty: "dasConfig.admissionControlPolicy." + name}
+	}
+
+	switch p := policy.(type) {
+	case *types.ClusterFailoverLevelAdmissionControlPolicy:
+		if p.FailoverLevel < 1 {
+			return invalid("failoverLevel")
+		}
+		if s, ok := p.SlotPolicy.(*types.ClusterFixedSizeSlotPolicy); ok && (s.Cpu <= 0 || s.Memory <= 0) {
+			return invalid("slotPolicy")
+		}
+	case *types.ClusterFailoverResourcesAdmissionControlPolicy:
+		if p.CpuFailoverResourcesPercent < 0 || p.CpuFailoverResourcesPercent > 100 {
+			return invalid("cpuFailoverResourcesPercent")
+		}
+		if p.MemoryFailoverResourcesPercent < 0 || p.MemoryFailoverResourcesPercent > 100 {
+			return invalid("memoryFailoverResourcesPercent")
+		}
+	case *types.ClusterFailoverHostAdmissionControlPolicy:
+		for _, ref := range p.FailoverHosts {
+			if !slices.Contains(c.Host, ref) {
+				return invalid("failoverHosts")
+			}
+		}
+	}
+
+	return nil
+}
+
+// dasDemand returns the CPU in MHz and memory in bytes reserved by the given vm for HA admission control:
+// its CPU reservation, with a minimum of 32MHz, and its memory reservation plus memory overhead.
+func dasDemand(vm *VirtualMachine) (int64, int64) {
+	cpu, mem := int64(32), int64(0)
+
+	if a := vm.Config.CpuAllocation; a != nil && a.Reservation != nil {
+		cpu = max(cpu, *a.Reservation)
+	}
+	if a := vm.Config.MemoryAllocation; a != nil && a.Reservation != nil {
+		mem = *a.Reservation * 1024 * 1024
+	}
+
+	return cpu, mem + memoryOverhead(&vm.Config.Hardware)
+}
+
+// dasCapacity returns the CPU capacity in MHz and memory capacity in bytes of the given host.
+func dasCapacity(host *HostSystem) (int64, int64) {
+	hw := host.Summary.Hardware
+	if hw == nil {
+		return 0, 0
+	}
+	return int64(hw.CpuMhz) * int64(hw.NumCpuCores), hw.MemorySize
+}
+
+// dasAdmission returns an InsufficientFailoverResourcesFault if powering on the given vm would leave the cluster
+// without the failover capacity required by its HA admission control policy:
+//   - ClusterFailoverLevelAdmissionControlPolicy: the powered on VMs must fit in the slots of the cluster's hosts,
+//     excluding the failoverLevel hosts with the most slots. The slot size is that of the ClusterFixedSizeSlotPolicy,
+//     if any, otherwise the largest dasDemand of the VMs.
+//   - ClusterFailoverResourcesAdmissionControlPolicy: the cluster's CPU and memory capacity not reserved by the
+//     powered on VMs must be at least the given percentages.
+//   - ClusterFailoverHostAdmissionControlPolicy: VMs cannot be powered on the failover hosts.
+func (c *ClusterComputeResource) dasAdmission(ctx *Context, vm *VirtualMachine) types.BaseMethodFault {
+	das := c.ConfigurationEx.(*types.ClusterConfigInfoEx).DasConfig
+
+	if das.Enabled == nil || !*das.Enabled || das.AdmissionControlPolicy == nil {
+		return nil
+	}
+	if das.AdmissionControlEnabled != nil && !*das.AdmissionControlEnabled {
+		return nil
+	}
+
+	var hosts []*HostSystem
+	vms := []*VirtualMachine{vm}
+
+	for _, ref := range c.Host {
+		host := ctx.Map.Get(ref).(*HostSystem)
+		if host.Runtime.InMaintenanceMode || host.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+			continue
+		}
+		hosts = append(hosts, host)
+
+		for _, vref := range host.Vm {
+			v := ctx.Map.Get(vref).(*VirtualMachine)
+			if v != vm && v.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn {
+				vms = append(vms, v)
+			}
+		}
+	}
+
+	fault := new(types.InsufficientFailoverResourcesFault)
+
+	switch p := das.AdmissionControlPolicy.(type) {
+	case *types.ClusterFailoverLevelAdmissionControlPolicy:
+		var slotCpu, slotMem int64
+		if s, ok := p.SlotPolicy.(*types.ClusterFixedSizeSlotPolicy); ok {
+			slotCpu, slotMem = int64(s.Cpu), int64(s.Memory)*1024*1024
+		} else {
+			for _, v := range vms {
+				cpu, mem := dasDemand(v)
+				slotCpu, slotMem = max(slotCpu, cpu), max(slotMem, mem)
+			}
+		}
+
+		var slots []int64
+		for _, host := range hosts {
+			cpu, mem := dasCapacity(host)
+			slots = append(slots, min(cpu/slotCpu, mem/slotMem))
+		}
+		slices.Sort(slots)
+
+		var available int64
+		for _, n := range slots[:max(len(slots)-int(p.FailoverLevel), 0)] {
+			available += n
+		}
+
+		if int64(len(vms)) > available {
+			return fault
+		}
+	case *types.ClusterFailoverResourcesAdmissionControlPolicy:
+		var totalCpu, totalMem, usedCpu, usedMem int64
+		for _, host := range hosts {
+			cpu, mem := dasCapacity(host)
+			totalCpu += cpu
+			totalMem += mem
+		}
+		for _, v := range vms {
+			cpu, mem := dasDemand(v)
+			usedCpu += cpu
+			usedMem += mem
+		}
+
+		if totalCpu == 0 || totalMem == 0 {
+			return fault
+		}
+		if (totalCpu-usedCpu)*100/totalCpu < int64(p.CpuFailoverResourcesPercent) ||
+			(totalMem-usedMem)*100/totalMem < int64(p.MemoryFailoverResourcesPercent) {
+			return fault
+		}
+	case *types.ClusterFailoverHostAdmissionControlPolicy:
+		if slices.Contains(p.FailoverHosts, *vm.Runtime.Host) {
+			return fault
+		}
+	}
+
+	return nil
+}
+
 func CreateClusterComputeResource(ctx *Context, f *Folder, name string, spec types.ClusterConfigSpecEx) (*ClusterComputeResource, types.BaseMethodFault) {
 	if e := ctx.Map.FindByName(name, f.ChildEntity); e != nil {
 		return nil, &types.DuplicateName{
@@ -21,15 +21,19 @@ import (
 	"path"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/simulator/vpx"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -366,3 +370,310 @@ func TestPlaceVmRelocate(t *testing.T) {
 		}
 	})
 }
+
+func TestClusterDrs(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		cluster, err := finder.DefaultClusterComputeResource(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		obj := Map.Get(cluster.Reference()).(*ClusterComputeResource)
+		loaded := obj.Host[0]
+
+		vms, err := finder.VirtualMachineList(ctx, "DC0_C0_RP0_VM*")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// place all VMs on the same host
+		stack := func() {
+			for _, vm := range vms {
+				task, err := vm.Relocate(ctx, types.VirtualMachineRelocateSpec{Host: &loaded}, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err = task.Wait(ctx); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		reconfigure := func(behavior types.DrsBehavior) {
+			spec := &types.ClusterConfigSpecEx{
+				DrsConfig: &types.ClusterDrsConfigInfo{Enabled: types.NewBool(true), DefaultVmBehavior: behavior},
+			}
+			task, err := cluster.Reconfigure(ctx, spec, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// wait for DRS migration tasks to complete
+		balanced := func() bool {
+			for i := 0; i < 100; i++ {
+				if len(Map.Get(loaded).(*HostSystem).Vm) == 1 {
+					return true
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			return false
+		}
+
+		stack()
+
+		res, err := methods.RecommendHostsForVm(ctx, c, &types.RecommendHostsForVm{This: cluster.Reference(), Vm: vms[0].Reference()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		hosts := res.Returnval
+		if len(hosts) != len(obj.Host) || hosts[0].Host == loaded || hosts[len(hosts)-1].Host != loaded {
+			t.Errorf("hosts=%#v", hosts)
+		}
+		if hosts[0].Rating < hosts[len(hosts)-1].Rating {
+			t.Errorf("hosts=%#v", hosts)
+		}
+
+		reconfigure(types.DrsBehaviorManual)
+
+		if len(obj.Recommendation) != 1 {
+			t.Fatalf("recommendation=%#v", obj.Recommendation)
+		}
+		_, err = methods.ApplyRecommendation(ctx, c, &types.ApplyRecommendation{This: cluster.Reference(), Key: obj.Recommendation[0].Key})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !balanced() {
+			t.Error("recommendation not applied")
+		}
+		if len(obj.Recommendation) != 0 {
+			t.Errorf("recommendation=%#v", obj.Recommendation)
+		}
+
+		stack()
+		reconfigure(types.DrsBehaviorFullyAutomated)
+		if !balanced() {
+			t.Error("cluster not balanced")
+		}
+
+		events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{EventTypeId: []string{"DrsVmMigratedEvent"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 2 {
+			t.Errorf("events=%d", len(events))
+		}
+
+		spec := types.PlacementSpec{PlacementType: string(types.PlacementSpecPlacementTypeCreate)}
+		placement, err := cluster.PlaceVm(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		action := placement.Recommendations[0].Action[0].(*types.PlacementAction)
+		if host := Map.Get(*action.TargetHost).(*HostSystem); len(host.Vm) != 0 {
+			t.Errorf("placed on %s with %d VMs", host.Name, len(host.Vm))
+		}
+
+		// fully automated DRS places the VM on power on
+		vm := vms[0]
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		stack()
+		task, err = vm.PowerOn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if host := Map.Get(vm.Reference()).(*VirtualMachine).Runtime.Host; *host == loaded {
+			t.Error("VM not placed on power on")
+		}
+	})
+}
+
+func TestClusterDasAdmissionControl(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		cluster, err := finder.DefaultClusterComputeResource(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ccr := Map.Get(cluster.Reference()).(*ClusterComputeResource)
+		host := Map.Get(ccr.Host[0]).(*HostSystem)
+		hw := host.Summary.Hardware
+
+		vms, err := finder.VirtualMachineList(ctx, "DC0_C0_RP0_VM*")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vm := vms[1]
+		task, err := vm.Relocate(ctx, types.VirtualMachineRelocateSpec{Host: &host.Self}, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		reconfigure := func(policy types.BaseClusterDasAdmissionControlPolicy) error {
+			spec := &types.ClusterConfigSpecEx{
+				DasConfig: &types.ClusterDasConfigInfo{
+					Enabled:                types.NewBool(true),
+					AdmissionControlPolicy: policy,
+				},
+			}
+			task, err := cluster.Reconfigure(ctx, spec, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return task.Wait(ctx)
+		}
+
+		power := func(on bool) error {
+			var task *object.Task
+			if on {
+				task, err = vm.PowerOn(ctx)
+			} else {
+				task, err = vm.PowerOff(ctx)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			return task.Wait(ctx)
+		}
+
+		if err = reconfigure(&types.ClusterFailoverLevelAdmissionControlPolicy{FailoverLevel: 0}); err == nil {
+			t.Error("expected error")
+		}
+
+		// 1 slot per host, 2 slots with 1 host failure tolerated
+		slot := &types.ClusterFixedSizeSlotPolicy{Cpu: hw.CpuMhz * int32(hw.NumCpuCores), Memory: 1024}
+		tests := []struct {
+			policy types.BaseClusterDasAdmissionControlPolicy
+			admit  bool
+		}{
+			{&types.ClusterFailoverLevelAdmissionControlPolicy{FailoverLevel: 1, SlotPolicy: slot}, true},
+			{&types.ClusterFailoverLevelAdmissionControlPolicy{FailoverLevel: 2, SlotPolicy: slot}, false},
+			{&types.ClusterFailoverLevelAdmissionControlPolicy{FailoverLevel: 1}, true},
+			{&types.ClusterFailoverResourcesAdmissionControlPolicy{CpuFailoverResourcesPercent: 25, MemoryFailoverResourcesPercent: 25}, true},
+			{&types.ClusterFailoverResourcesAdmissionControlPolicy{CpuFailoverResourcesPercent: 25, MemoryFailoverResourcesPercent: 100}, false},
+			{&types.ClusterFailoverHostAdmissionControlPolicy{FailoverHosts: ccr.Host[1:]}, true},
+			{&types.ClusterFailoverHostAdmissionControlPolicy{FailoverHosts: ccr.Host[:1]}, false},
+		}
+
+		for i, test := range tests {
+			if err = power(false); err != nil {
+				t.Fatal(err)
+			}
+
+			if err = reconfigure(test.policy); err != nil {
+				t.Fatal(err)
+			}
+
+			err = power(true)
+			if test.admit {
+				if err != nil {
+					t.Errorf("%d: %s", i, err)
+				}
+				continue
+			}
+
+			if !fault.Is(err, &types.InsufficientFailoverResourcesFault{}) {
+				t.Errorf("%d: err=%v", i, err)
+			}
+			if err = power(true); err == nil {
+				t.Errorf("%d: expected error", i)
+			}
+			// admission control does not apply when disabled
+			spec := &types.ClusterConfigSpecEx{DasConfig: &types.ClusterDasConfigInfo{AdmissionControlEnabled: types.NewBool(false)}}
+			task, err := cluster.Reconfigure(ctx, spec, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if err = power(true); err != nil {
+				t.Errorf("%d: %s", i, err)
+			}
+			spec.DasConfig.AdmissionControlEnabled = types.NewBool(true)
+			if task, err = cluster.Reconfigure(ctx, spec, true); err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestClusterVsan(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		cluster, err := find.NewFinder(c).DefaultClusterComputeResource(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		reconfigure := func(enabled bool) {
+			spec := &types.ClusterConfigSpecEx{
+				VsanConfig: &types.VsanClusterConfigInfo{Enabled: types.NewBool(enabled)},
+			}
+			task, err := cluster.Reconfigure(ctx, spec, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		vsanConfig := func() []types.VsanHostConfigInfo {
+			var config []types.VsanHostConfigInfo
+			for _, ref := range Map.Get(cluster.Reference()).(*ClusterComputeResource).Host {
+				host := Map.Get(ref).(*HostSystem)
+				config = append(config, Map.Get(*host.ConfigManager.VsanSystem).(*HostVsanSystem).Config)
+			}
+			return config
+		}
+
+		reconfigure(true)
+
+		task, err := cluster.AddHost(ctx, types.HostConnectSpec{HostName: "vsan.example.com"}, true, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		uuid := Map.Get(cluster.Reference()).(*ClusterComputeResource).ConfigurationEx.(*types.ClusterConfigInfoEx).VsanConfigInfo.DefaultConfig.Uuid
+
+		config := vsanConfig()
+		if len(config) != 4 {
+			t.Fatalf("hosts=%d", len(config))
+		}
+		for _, info := range config {
+			if !*info.Enabled || info.ClusterInfo.Uuid != uuid || info.ClusterInfo.NodeUuid == "" {
+				t.Errorf("config=%#v", info.ClusterInfo)
+			}
+		}
+
+		reconfigure(false)
+
+		for _, info := range vsanConfig() {
+			if *info.Enabled || info.ClusterInfo.Uuid != "" {
+				t.Errorf("config=%#v", info.ClusterInfo)
+			}
+		}
+	})
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type ClusterEVCManager struct {
+	mo.ClusterEVCManager
+}
+
+func (c *ClusterComputeResource) EvcManager(ctx *Context, req *types.EvcManager) soap.HasFault {
+	if c.evcManager == nil {
+		m := &ClusterEVCManager{}
+		m.ManagedCluster = c.Self
+		m.EvcState.SupportedEVCMode = m.supported(ctx)
+		c.evcManager = types.NewReference(ctx.Map.Put(m).Reference())
+	}
+
+	return &methods.EvcManagerBody{
+		Res: &types.EvcManagerResponse{
+			Returnval: c.evcManager,
+		},
+	}
+}
+
+// vendor returns the CPU vendor of the cluster's hosts, if any.
+func (m *ClusterEVCManager) vendor(ctx *Context) string {
+	cluster := ctx.Map.Get(m.ManagedCluster).(*ClusterComputeResource)
+
+	for _, ref := range cluster.Host {
+		host := ctx.Map.Get(ref).(*HostSystem)
+		if host.Hardware != nil && len(host.Hardware.CpuPkg) != 0 {
+			return host.Hardware.CpuPkg[0].Vendor
+		}
+	}
+
+	return ""
+}
+
+// supported returns the EVC modes supported by the ServiceInstance, limited to the cluster's CPU vendor.
+func (m *ClusterEVCManager) supported(ctx *Context) []types.EVCMode {
+	si := ctx.Map.Get(vim25.ServiceInstance).(*ServiceInstance)
+	vendor := m.vendor(ctx)
+
+	var modes []types.EVCMode
+	for _, mode := range si.Capability.SupportedEVCMode {
+		if vendor == "" || mode.Vendor == vendor {
+			modes = append(modes, mode)
+		}
+	}
+
+	return modes
+}
+
+// check returns the EVCMode for the given key or a fault if the mode cannot be applied to the cluster.
+func (m *ClusterEVCManager) check(ctx *Context, key string) (*types.EVCMode, types.BaseMethodFault) {
+	si := ctx.Map.Get(vim25.ServiceInstance).(*ServiceInstance)
+
+	for _, mode := range si.Capability.SupportedEVCMode {
+		if mode.Key != key {
+			continue
+		}
+
+		if vendor := m.vendor(ctx); vendor != "" && vendor != mode.Vendor {
+			return nil, &types.EVCModeIllegalByVendor{
+				ClusterCPUVendor: vendor,
+				ModeCPUVendor:    mode.Vendor,
+			}
+		}
+
+		return &mode, nil
+	}
+
+	return nil, &types.InvalidArgument{InvalidProperty: "evcModeKey"}
+}
+
+// setMode applies the given EVC mode to the cluster and its hosts, a nil mode disables EVC.
+func (m *ClusterEVCManager) setMode(ctx *Context, mode *types.EVCMode) {
+	state := types.ClusterEVCManagerEVCState{
+		SupportedEVCMode: m.supported(ctx),
+	}
+	if mode != nil {
+		state.CurrentEVCModeKey = mode.Key
+		state.GuaranteedCPUFeatures = mode.GuaranteedCPUFeatures
+		state.FeatureCapability = mode.FeatureCapability
+		state.FeatureMask = mode.FeatureMask
+		state.FeatureRequirement = mode.FeatureRequirement
+	}
+
+	ctx.Map.Update(m, []types.PropertyChange{{Name: "evcState", Val: state}})
+
+	cluster := ctx.Map.Get(m.ManagedCluster).(*ClusterComputeResource)
+
+	ctx.WithLock(cluster, func() {
+		cluster.Summary.(*types.ClusterComputeResourceSummary).CurrentEVCModeKey = state.CurrentEVCModeKey
+
+		for _, ref := range cluster.Host {
+			host := ctx.Map.Get(ref).(*HostSystem)
+			ctx.WithLock(host, func() {
+				host.Summary.CurrentEVCModeKey = state.CurrentEVCModeKey
+			})
+		}
+	})
+}
+
+func (m *ClusterEVCManager) ConfigureEvcModeTask(ctx *Context, req *types.ConfigureEvcMode_Task) soap.HasFault {
+	task := CreateTask(m, "configureEvcMode", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		mode, fault := m.check(ctx, req.EvcModeKey)
+		if fault != nil {
+			return nil, fault
+		}
+
+		m.setMode(ctx, mode)
+
+		return nil, nil
+	})
+
+	return &methods.ConfigureEvcMode_TaskBody{
+		Res: &types.ConfigureEvcMode_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *ClusterEVCManager) DisableEvcModeTask(ctx *Context, req *types.DisableEvcMode_Task) soap.HasFault {
+	task := CreateTask(m, "disableEvcMode", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		m.setMode(ctx, nil)
+
+		return nil, nil
+	})
+
+	return &methods.DisableEvcMode_TaskBody{
+		Res: &types.DisableEvcMode_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *ClusterEVCManager) CheckConfigureEvcModeTask(ctx *Context, req *types.CheckConfigureEvcMode_Task) soap.HasFault {
+	task := CreateTask(m, "checkConfigureEvcMode", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		var res []types.ClusterEVCManagerCheckResult
+
+		if _, fault := m.check(ctx, req.EvcModeKey); fault != nil {
+			res = append(res, types.ClusterEVCManagerCheckResult{
+				EvcModeKey: req.EvcModeKey,
+				Error:      types.LocalizedMethodFault{Fault: fault, LocalizedMessage: "EVC mode cannot be applied"},
+				Host:       ctx.Map.Get(m.ManagedCluster).(*ClusterComputeResource).Host,
+			})
+		}
+
+		return res, nil
+	})
+
+	return &methods.CheckConfigureEvcMode_TaskBody{
+		Res: &types.CheckConfigureEvcMode_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *ClusterEVCManager) CheckAddHostEvcTask(ctx *Context, req *types.CheckAddHostEvc_Task) soap.HasFault {
+	task := CreateTask(m, "checkAddHostEvc", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		if req.CnxSpec.HostName == "" {
+			return nil, &types.NoHost{}
+		}
+
+		// all simulated hosts share the same CPU, any EVC mode of the cluster is compatible
+		return []types.ClusterEVCManagerCheckResult(nil), nil
+	})
+
+	return &methods.CheckAddHostEvc_TaskBody{
+		Res: &types.CheckAddHostEvc_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
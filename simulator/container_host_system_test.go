@@ -96,7 +96,7 @@ func TestSyncWithOptionsStruct(t *testing.T) {
 	fault := advOpts.UpdateOptions(&types.UpdateOptions{ChangedValue: []types.BaseOptionValue{option}}).Fault()
 	require.Nil(t, fault, "Expected setting test option to succeed")
 
-	assert.Equal(t, option, hs.Config.Option[1], "Expected mirror to reflect changes")
+	assert.Equal(t, option, hs.Config.Option[len(hs.Config.Option)-1], "Expected mirror to reflect changes")
 }
 
 func TestPerHostOptionManager(t *testing.T) {
@@ -147,7 +147,7 @@ func TestPerHostOptionManager(t *testing.T) {
 	require.Equal(t, 1, len(queryRes.Returnval), "Expected query of updated option to succeed")
 	require.Equal(t, option2.Value, queryRes.Returnval[0].GetOptionValue().Value, "Expected updated value")
 
-	assert.Equal(t, option2, hs.Config.Option[1], "Expected mirror to reflect changes")
+	assert.Equal(t, option2, hs.Config.Option[len(hs.Config.Option)-1], "Expected mirror to reflect changes")
 
 	hs.configure(SpoofContext(), types.HostConnectSpec{}, true)
 	assert.Nil(t, hs.sh, "Expected not to have container backing if not requested")
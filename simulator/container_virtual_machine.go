@@ -135,23 +135,20 @@ func (svm *simVM) prepareGuestOperation(auth types.BaseGuestAuthentication) type
 		return new(types.GuestOperationsUnavailable)
 	}
 
-	if svm.vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+	return validateGuestOperation(svm.vm, auth)
+}
+
+// validateGuestOperation checks the VM power state and guest credentials
+func validateGuestOperation(vm *VirtualMachine, auth types.BaseGuestAuthentication) types.BaseMethodFault {
+	if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
 		return &types.InvalidPowerState{
 			RequestedState: types.VirtualMachinePowerStatePoweredOn,
-			ExistingState:  svm.vm.Runtime.PowerState,
-		}
-	}
-
-	switch creds := auth.(type) {
-	case *types.NamePasswordAuthentication:
-		if creds.Username == "" || creds.Password == "" {
-			return new(types.InvalidGuestLogin)
+			ExistingState:  vm.Runtime.PowerState,
 		}
-	default:
-		return new(types.InvalidGuestLogin)
 	}
 
-	return nil
+	_, fault := vm.guestUser(auth)
+	return fault
 }
 
 // populateDMI writes BIOS UUID DMI files to a container volume
@@ -451,6 +448,11 @@ func ServeGuest(w http.ResponseWriter, r *http.Request) {
 	file := strings.TrimPrefix(r.URL.Path, guestPrefix[:len(guestPrefix)-1])
 	var err error
 
+	if g, ok := memGuests.Load(id); ok {
+		serveMemGuest(g.(*memGuest), file, w, r)
+		return
+	}
+
 	switch r.Method {
 	case http.MethodPut:
 		err = guestUpload(id, file, r)
@@ -0,0 +1,273 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const controlPrefix = "/vcsim/"
+
+// errControlNotFound is returned by the control API when an object or route does not exist.
+var errControlNotFound = errors.New("not found")
+
+// ServeControl handler for the vcsim control API, which allows test harnesses to mutate the inventory at runtime.
+// Requests are authenticated using HTTP basic auth with vcsim's login credentials, or a vim25 session cookie.
+// Objects are referenced by their managed object ID, such as "host-21". The following requests are supported:
+//
+//	POST   /vcsim/hosts                  {"parent": "/DC0/host/DC0_C0", "name": "host-name"}  add a host to a cluster or host folder
+//	POST   /vcsim/hosts/{id}/fail        fail the host via HostSystem.Fail
+//	POST   /vcsim/hosts/{id}/recover     recover the host via HostSystem.Recover
+//	POST   /vcsim/vms/{id}/kill          power off the VM without a guest shutdown
+//	POST   /vcsim/datastores/{id}/fill   {"freeSpace": 0}  set the free space of the datastore in bytes
+//	DELETE /vcsim/sessions?user=name     expire the sessions of the given user, or all sessions if user is empty
+func (s *Service) ServeControl(w http.ResponseWriter, r *http.Request) {
+	if !s.controlAuthenticated(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="vcsim"`)
+		controlResponse(w, http.StatusUnauthorized, nil, errors.New("unauthorized"))
+		return
+	}
+
+	ctx := &Context{
+		req: r,
+		res: w,
+		svc: s,
+
+		Map:     Map,
+		Context: context.Background(),
+		Session: internalSession,
+	}
+
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, controlPrefix), "/"), "/")
+
+	var res any
+	var err error
+	status := http.StatusOK
+
+	switch {
+	case r.Method == http.MethodPost && len(path) == 1 && path[0] == "hosts":
+		res, err = s.controlAddHost(ctx, r.Body)
+		status = http.StatusCreated
+	case r.Method == http.MethodPost && len(path) == 3 && path[0] == "hosts":
+		err = controlHost(ctx, path[1], path[2])
+	case r.Method == http.MethodPost && len(path) == 3 && path[0] == "vms" && path[2] == "kill":
+		err = s.controlKillVM(ctx, path[1])
+	case r.Method == http.MethodPost && len(path) == 3 && path[0] == "datastores" && path[2] == "fill":
+		err = controlFillDatastore(ctx, path[1], r.Body)
+	case r.Method == http.MethodDelete && len(path) == 1 && path[0] == "sessions":
+		n := s.sm.expireSessions(r.URL.Query().Get("user"))
+		res = map[string]int{"expired": n}
+	default:
+		err = errControlNotFound
+	}
+
+	if err != nil {
+		status = http.StatusBadRequest
+		if errors.Is(err, errControlNotFound) {
+			status = http.StatusNotFound
+		}
+	}
+
+	controlResponse(w, status, res, err)
+}
+
+// controlAuthenticated returns true if the request has a valid session cookie or basic auth credentials.
+func (s *Service) controlAuthenticated(r *http.Request) bool {
+	if cookie, err := r.Cookie(soap.SessionCookieName); err == nil {
+		if _, ok := s.sm.getSession(cookie.Value); ok {
+			return true
+		}
+	}
+
+	user, pass, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+
+	return s.sm.Authenticate(*s.Listen, &types.Login{UserName: user, Password: pass})
+}
+
+// controlResponse encodes the control API result or error as JSON.
+func controlResponse(w http.ResponseWriter, status int, res any, err error) {
+	if err != nil {
+		res = map[string]string{"error": err.Error()}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if res != nil {
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
+
+// controlDecode decodes the optional JSON request body.
+func controlDecode(body io.Reader, v any) error {
+	err := json.NewDecoder(body).Decode(v)
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// controlObject returns the object of the given type and managed object ID.
+func controlObject(ctx *Context, kind, id string) (mo.Reference, error) {
+	obj := ctx.Map.Get(types.ManagedObjectReference{Type: kind, Value: id})
+	if obj == nil {
+		return nil, fmt.Errorf("%s:%s %w", kind, id, errControlNotFound)
+	}
+	return obj, nil
+}
+
+// controlAddHost adds a host to the cluster or host folder specified by inventory path or managed object ID.
+func (s *Service) controlAddHost(ctx *Context, body io.Reader) (any, error) {
+	var req struct {
+		Parent string `json:"parent"`
+		Name   string `json:"name"`
+	}
+
+	if err := controlDecode(body, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	var parent types.ManagedObjectReference
+
+	if strings.HasPrefix(req.Parent, "/") {
+		ref, err := object.NewSearchIndex(s.client).FindByInventoryPath(ctx, req.Parent)
+		if err != nil {
+			return nil, err
+		}
+		if ref == nil {
+			return nil, fmt.Errorf("%s %w", req.Parent, errControlNotFound)
+		}
+		parent = ref.Reference()
+	} else {
+		for _, kind := range []string{"ClusterComputeResource", "Folder"} {
+			if obj := ctx.Map.Get(types.ManagedObjectReference{Type: kind, Value: req.Parent}); obj != nil {
+				parent = obj.Reference()
+			}
+		}
+		if parent.Value == "" {
+			return nil, fmt.Errorf("%q %w", req.Parent, errControlNotFound)
+		}
+	}
+
+	spec := types.HostConnectSpec{HostName: req.Name, Force: true}
+
+	var task *object.Task
+	var err error
+
+	switch parent.Type {
+	case "ClusterComputeResource":
+		task, err = object.NewClusterComputeResource(s.client, parent).AddHost(ctx, spec, true, nil, nil)
+	case "Folder":
+		task, err = object.NewFolder(s.client, parent).AddStandaloneHost(ctx, spec, true, nil, nil)
+	default:
+		return nil, fmt.Errorf("parent %s is not a cluster or folder", parent)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := task.WaitForResult(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.Result, nil
+}
+
+// controlHost changes the health of a host.
+func controlHost(ctx *Context, id, action string) error {
+	obj, err := controlObject(ctx, "HostSystem", id)
+	if err != nil {
+		return err
+	}
+	host := obj.(*HostSystem)
+
+	switch action {
+	case "fail":
+		host.Fail(ctx)
+	case "recover":
+		host.Recover(ctx)
+	default:
+		return errControlNotFound
+	}
+
+	return nil
+}
+
+// controlKillVM powers off a VM, as if its process was killed on the host.
+func (s *Service) controlKillVM(ctx *Context, id string) error {
+	obj, err := controlObject(ctx, "VirtualMachine", id)
+	if err != nil {
+		return err
+	}
+
+	task, err := object.NewVirtualMachine(s.client, obj.Reference()).PowerOff(ctx)
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
+
+// controlFillDatastore sets the free space of a datastore.
+func controlFillDatastore(ctx *Context, id string, body io.Reader) error {
+	obj, err := controlObject(ctx, "Datastore", id)
+	if err != nil {
+		return err
+	}
+	ds := obj.(*Datastore)
+
+	var req struct {
+		FreeSpace int64 `json:"freeSpace"`
+	}
+
+	if err := controlDecode(body, &req); err != nil {
+		return err
+	}
+
+	ctx.WithLock(ds, func() {
+		if req.FreeSpace < 0 || req.FreeSpace > ds.Summary.Capacity {
+			err = fmt.Errorf("freeSpace must be between 0 and %d", ds.Summary.Capacity)
+			return
+		}
+
+		ds.Info.GetDatastoreInfo().FreeSpace = req.FreeSpace
+		ctx.Map.Update(ds, []types.PropertyChange{
+			{Name: "summary.freeSpace", Val: req.FreeSpace},
+			{Name: "info", Val: ds.Info},
+		})
+	})
+
+	return err
+}
@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestServeControl(t *testing.T) {
+	ctx := context.Background()
+
+	m := VPX()
+	defer m.Remove()
+
+	if err := m.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	m.Service.Listen = &url.URL{User: url.UserPassword("user", "pass")}
+	s := m.Service.NewServer()
+	defer s.Close()
+
+	c, err := vim25.NewClient(ctx, soap.NewClient(s.URL, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = session.NewManager(c).Login(ctx, s.URL.User); err != nil {
+		t.Fatal(err)
+	}
+
+	control := func(method, path, body string, res any) int {
+		u := *s.URL
+		u.Path, u.RawQuery, _ = strings.Cut(path, "?")
+		u.User = nil
+
+		req, err := http.NewRequest(method, u.String(), strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.SetBasicAuth("user", "pass")
+
+		r, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Body.Close()
+
+		if res != nil {
+			if err := json.NewDecoder(r.Body).Decode(res); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		return r.StatusCode
+	}
+
+	finder := find.NewFinder(c)
+
+	vm, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := finder.Datastore(ctx, "LocalDS_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// authentication is required
+	u := *s.URL
+	u.Path = "/vcsim/sessions"
+	u.User = url.UserPassword("user", "invalid")
+	req, _ := http.NewRequest(http.MethodDelete, u.String(), nil)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status=%d", res.StatusCode)
+	}
+
+	// add a host
+	var host types.ManagedObjectReference
+	status := control(http.MethodPost, "/vcsim/hosts", `{"parent": "/DC0/host/DC0_C0", "name": "esx-new"}`, &host)
+	if status != http.StatusCreated || host.Type != "HostSystem" {
+		t.Fatalf("status=%d host=%s", status, host)
+	}
+	if obj, ok := Map.Get(host).(*HostSystem); !ok || obj.Name != "esx-new" {
+		t.Errorf("host=%#v", obj)
+	}
+
+	var e struct {
+		Error string `json:"error"`
+	}
+	status = control(http.MethodPost, "/vcsim/hosts", `{"parent": "/DC0/host/enoent", "name": "esx-new"}`, &e)
+	if status != http.StatusNotFound || e.Error == "" {
+		t.Errorf("status=%d error=%q", status, e.Error)
+	}
+
+	// flip host health
+	var props mo.HostSystem
+	status = control(http.MethodPost, "/vcsim/hosts/"+host.Value+"/fail", "", nil)
+	if status != http.StatusOK {
+		t.Errorf("status=%d", status)
+	}
+	if err = vm.Properties(ctx, host, []string{"runtime"}, &props); err != nil {
+		t.Fatal(err)
+	}
+	if props.Runtime.ConnectionState != types.HostSystemConnectionStateNotResponding {
+		t.Errorf("state=%s", props.Runtime.ConnectionState)
+	}
+
+	status = control(http.MethodPost, "/vcsim/hosts/"+host.Value+"/recover", "", nil)
+	if status != http.StatusOK {
+		t.Errorf("status=%d", status)
+	}
+	if err = vm.Properties(ctx, host, []string{"runtime"}, &props); err != nil {
+		t.Fatal(err)
+	}
+	if props.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+		t.Errorf("state=%s", props.Runtime.ConnectionState)
+	}
+
+	if status = control(http.MethodPost, "/vcsim/hosts/enoent/fail", "", nil); status != http.StatusNotFound {
+		t.Errorf("status=%d", status)
+	}
+
+	// kill a VM
+	if status = control(http.MethodPost, "/vcsim/vms/"+vm.Reference().Value+"/kill", "", nil); status != http.StatusOK {
+		t.Errorf("status=%d", status)
+	}
+	state, err := vm.PowerState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != types.VirtualMachinePowerStatePoweredOff {
+		t.Errorf("state=%s", state)
+	}
+
+	// fill a datastore
+	if status = control(http.MethodPost, "/vcsim/datastores/"+ds.Reference().Value+"/fill", `{"freeSpace": 1024}`, nil); status != http.StatusOK {
+		t.Errorf("status=%d", status)
+	}
+	var dsProps mo.Datastore
+	if err = ds.Properties(ctx, ds.Reference(), []string{"summary", "info"}, &dsProps); err != nil {
+		t.Fatal(err)
+	}
+	if dsProps.Summary.FreeSpace != 1024 || dsProps.Info.GetDatastoreInfo().FreeSpace != 1024 {
+		t.Errorf("freeSpace=%d", dsProps.Summary.FreeSpace)
+	}
+
+	if status = control(http.MethodPost, "/vcsim/datastores/"+ds.Reference().Value+"/fill", `{"freeSpace": -1}`, nil); status != http.StatusBadRequest {
+		t.Errorf("status=%d", status)
+	}
+
+	// expire sessions
+	var expired struct {
+		Expired int `json:"expired"`
+	}
+	if status = control(http.MethodDelete, "/vcsim/sessions?user=user", "", &expired); status != http.StatusOK || expired.Expired != 1 {
+		t.Errorf("status=%d expired=%d", status, expired.Expired)
+	}
+
+	_, err = vm.PowerState(ctx)
+	if !fault.Is(err, &types.NotAuthenticated{}) {
+		t.Errorf("err=%v", err)
+	}
+
+	if status = control(http.MethodGet, "/vcsim/enoent", "", nil); status != http.StatusNotFound {
+		t.Errorf("status=%d", status)
+	}
+}
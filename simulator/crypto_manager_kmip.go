@@ -17,7 +17,19 @@ limitations under the License.
 package simulator
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
 	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -35,12 +47,92 @@ type CryptoManagerKmip struct {
 	mo.CryptoManagerKmip
 
 	keyIDToProviderID map[string]string
+	// keyIDs tracks the keys in insertion order, so ListKeys results are stable.
+	keyIDs []string
+
+	faultMu sync.Mutex
+	faults  map[string]*CryptoManagerKmipFault
+
+	// serverCerts maps provider and server name to the server's certificate
+	serverCerts map[string]string
+	// trustedCerts maps provider ID to server certificates trusted by vCenter
+	trustedCerts map[string][]string
+	// clientCerts maps provider ID to vCenter's client certificate
+	clientCerts map[string]string
+}
+
+// CryptoManagerKmipFault describes a fault injected via CryptoManagerKmip.InjectFault.
+type CryptoManagerKmipFault struct {
+	// Fault, if non-nil, is returned in place of the method's response.
+	Fault types.BaseMethodFault
+	// Message is the optional fault string.
+	Message string
+	// Delay is applied before the method is handled, to simulate a slow or unreachable KMS.
+	Delay time.Duration
+	// Count is the number of calls the injection applies to.
+	// A value of 0 applies to all calls until ClearFault is called.
+	Count int
+}
+
+// InjectFault configures the fault and/or delay for the given method name, such as "GenerateKey"
+// or "RetrieveKmipServersStatus_Task".
+func (m *CryptoManagerKmip) InjectFault(method string, fault CryptoManagerKmipFault) {
+	m.faultMu.Lock()
+	defer m.faultMu.Unlock()
+
+	if m.faults == nil {
+		m.faults = make(map[string]*CryptoManagerKmipFault)
+	}
+	m.faults[method] = &fault
+}
+
+// ClearFault removes a fault configured via InjectFault.
+// If method is empty, all injected faults are removed.
+func (m *CryptoManagerKmip) ClearFault(method string) {
+	m.faultMu.Lock()
+	defer m.faultMu.Unlock()
+
+	if method == "" {
+		m.faults = nil
+	} else {
+		delete(m.faults, method)
+	}
+}
+
+// injectFault implements the methodFaultInjector interface.
+func (m *CryptoManagerKmip) injectFault(ctx *Context, method string) *soap.Fault {
+	m.faultMu.Lock()
+	f, ok := m.faults[method]
+	if ok && f.Count > 0 {
+		f.Count--
+		if f.Count == 0 {
+			delete(m.faults, method)
+		}
+	}
+	m.faultMu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	if f.Delay > 0 {
+		time.Sleep(f.Delay)
+	}
+
+	if f.Fault == nil {
+		return nil
+	}
+
+	return Fault(f.Message, f.Fault)
 }
 
 func (m *CryptoManagerKmip) init(r *Registry) {
 	if m.keyIDToProviderID == nil {
 		m.keyIDToProviderID = map[string]string{}
 	}
+	m.serverCerts = map[string]string{}
+	m.trustedCerts = map[string][]string{}
+	m.clientCerts = map[string]string{}
 }
 
 func (m *CryptoManagerKmip) ListKmipServers(
@@ -139,15 +231,15 @@ func (c *retrieveKmipServerStatusTask) Run(
 					ManagementType: c.KmipServers[i].ManagementType,
 					OverallStatus:  types.ManagedEntityStatusGreen,
 				}
+				if cert, ok := c.clientCerts[c.KmipServers[i].ClusterId.Id]; ok {
+					clusterStatus.ClientCertInfo = kmipCertInfo(cert)
+				}
 				for k := range c.KmipServers[i].Servers {
 					for l := range c.get[j].Servers {
 						if c.KmipServers[i].Servers[k].Name == c.get[j].Servers[l].Name {
 							clusterStatus.Servers = append(
 								clusterStatus.Servers,
-								types.CryptoManagerKmipServerStatus{
-									Name:   c.KmipServers[i].Servers[k].Name,
-									Status: types.ManagedEntityStatusGreen,
-								},
+								c.serverStatus(c.KmipServers[i].ClusterId.Id, c.KmipServers[i].Servers[k].Name),
 							)
 						}
 					}
@@ -297,6 +389,8 @@ func (m *CryptoManagerKmip) UnregisterKmsCluster(
 		body.Fault_ = Fault("Invalid cluster ID", &types.RuntimeFault{})
 	} else {
 		m.KmipServers = slices.Delete(m.KmipServers, x, x+1)
+		delete(m.trustedCerts, req.ClusterId.Id)
+		delete(m.clientCerts, req.ClusterId.Id)
 		body.Res = &types.UnregisterKmsClusterResponse{}
 	}
 
@@ -459,6 +553,7 @@ func (m *CryptoManagerKmip) GenerateKey(
 	} else {
 		newKey := uuid.NewString()
 		m.keyIDToProviderID[newKey] = provider.ClusterId.Id
+		m.keyIDs = append(m.keyIDs, newKey)
 
 		body.Res = &types.GenerateKeyResponse{
 			Returnval: types.CryptoKeyResult{
@@ -483,29 +578,344 @@ func (m *CryptoManagerKmip) ListKeys(
 		Res: &types.ListKeysResponse{},
 	}
 
-	if len(m.keyIDToProviderID) > 0 {
-		var (
-			i     int
-			limit = len(m.keyIDToProviderID)
-		)
-		if req.Limit != nil {
-			if reqLimit := int(*req.Limit); reqLimit >= 0 && reqLimit < limit {
-				limit = reqLimit
+	limit := len(m.keyIDs)
+	if req.Limit != nil {
+		if reqLimit := int(*req.Limit); reqLimit >= 0 && reqLimit < limit {
+			limit = reqLimit
+		}
+	}
+
+	for _, keyID := range m.keyIDs[:limit] {
+		body.Res.Returnval = append(body.Res.Returnval, types.CryptoKeyId{
+			KeyId: keyID,
+			ProviderId: &types.KeyProviderId{
+				Id: m.keyIDToProviderID[keyID],
+			},
+		})
+	}
+
+	return &body
+}
+
+// state implements the persistent interface, saving generated keys in the ListKeys response format.
+func (m *CryptoManagerKmip) state() (string, any) {
+	res := m.ListKeys(nil, new(types.ListKeys)).(*methods.ListKeysBody).Res
+	return "ListKeys", res
+}
+
+// restore implements the persistent interface.
+func (m *CryptoManagerKmip) restore(state any) {
+	res := state.(*types.ListKeysResponse)
+
+	m.keyIDs = nil
+	m.keyIDToProviderID = make(map[string]string, len(res.Returnval))
+
+	for _, key := range res.Returnval {
+		if key.ProviderId == nil {
+			continue
+		}
+		m.keyIDs = append(m.keyIDs, key.KeyId)
+		m.keyIDToProviderID[key.KeyId] = key.ProviderId.Id
+	}
+}
+
+const (
+	cryptoKeyStatusCheckAvailable = 0x01
+	cryptoKeyStatusCheckVMs       = 0x02
+	cryptoKeyStatusCheckHosts     = 0x04
+)
+
+func (m *CryptoManagerKmip) QueryCryptoKeyStatus(
+	ctx *Context, req *types.QueryCryptoKeyStatus) soap.HasFault {
+
+	body := methods.QueryCryptoKeyStatusBody{
+		Res: &types.QueryCryptoKeyStatusResponse{},
+	}
+
+	for i := range req.KeyIds {
+		keyID := req.KeyIds[i]
+		status := types.CryptoManagerKmipCryptoKeyStatus{
+			KeyId: keyID,
+		}
+
+		if req.CheckKeyBitMap&cryptoKeyStatusCheckAvailable != 0 {
+			reason := m.keyUnavailableReason(keyID)
+			status.KeyAvailable = types.NewBool(reason == "")
+			status.Reason = string(reason)
+		}
+
+		if req.CheckKeyBitMap&cryptoKeyStatusCheckVMs != 0 {
+			for _, obj := range ctx.Map.All("VirtualMachine") {
+				vm := obj.(*VirtualMachine)
+				if vm.Config != nil && isSameCryptoKey(vm.Config.KeyId, keyID) {
+					status.EncryptedVMs = append(status.EncryptedVMs, vm.Self)
+				}
 			}
 		}
-		for keyID, providerID := range m.keyIDToProviderID {
-			if i >= limit {
-				break
+
+		if req.CheckKeyBitMap&cryptoKeyStatusCheckHosts != 0 {
+			for _, obj := range ctx.Map.All("HostSystem") {
+				host := obj.(*HostSystem)
+				if isSameCryptoKey(host.Runtime.CryptoKeyId, keyID) {
+					status.AffectedHosts = append(status.AffectedHosts, host.Self)
+				}
 			}
-			i++
-			body.Res.Returnval = append(body.Res.Returnval, types.CryptoKeyId{
-				KeyId: keyID,
-				ProviderId: &types.KeyProviderId{
-					Id: providerID,
+		}
+
+		body.Res.Returnval = append(body.Res.Returnval, status)
+	}
+
+	return &body
+}
+
+// keyUnavailableReason returns the reason the given key cannot be used for
+// crypto operations, or an empty string if the key is available.
+func (m *CryptoManagerKmip) keyUnavailableReason(
+	keyID types.CryptoKeyId) types.CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason {
+
+	providerID, ok := m.keyIDToProviderID[keyID.KeyId]
+	if !ok {
+		return types.CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateMissingInKMS
+	}
+
+	if keyID.ProviderId != nil && keyID.ProviderId.Id != providerID {
+		return types.CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateMissingInKMS
+	}
+
+	for i := range m.KmipServers {
+		c := m.KmipServers[i]
+		if c.ClusterId.Id != providerID {
+			continue
+		}
+		if c.ManagementType != nativeKeyProvider && len(c.Servers) == 0 {
+			// A KMIP provider without any servers cannot be reached.
+			return types.CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateClusterUnreachable
+		}
+		return ""
+	}
+
+	return types.CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateClusterInvalid
+}
+
+func isSameCryptoKey(a *types.CryptoKeyId, b types.CryptoKeyId) bool {
+	if a == nil || a.KeyId != b.KeyId {
+		return false
+	}
+	if a.ProviderId == nil || b.ProviderId == nil {
+		return true
+	}
+	return a.ProviderId.Id == b.ProviderId.Id
+}
+
+// kmipSelfSignedCert returns a PEM encoded self-signed certificate for the given common name.
+func kmipSelfSignedCert(cn string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	serial, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	now := time.Now()
+
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn, Organization: []string{"VMware"}},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// kmipCertInfo returns the info of a PEM encoded certificate, or nil if invalid.
+func kmipCertInfo(cert string) *types.CryptoManagerKmipCertificateInfo {
+	block, _ := pem.Decode([]byte(cert))
+	if block == nil {
+		return nil
+	}
+
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+
+	sum := sha1.Sum(c.Raw)
+	fingerprint := make([]string, len(sum))
+	for i, b := range sum {
+		fingerprint[i] = fmt.Sprintf("%02X", b)
+	}
+
+	now := time.Now()
+
+	return &types.CryptoManagerKmipCertificateInfo{
+		Subject:             c.Subject.String(),
+		Issuer:              c.Issuer.String(),
+		SerialNumber:        c.SerialNumber.String(),
+		NotBefore:           c.NotBefore,
+		NotAfter:            c.NotAfter,
+		Fingerprint:         strings.Join(fingerprint, ":"),
+		CheckTime:           now,
+		SecondsSinceValid:   int32(now.Sub(c.NotBefore).Seconds()),
+		SecondsBeforeExpire: int32(c.NotAfter.Sub(now).Seconds()),
+	}
+}
+
+func (m *CryptoManagerKmip) findCluster(id string) *types.KmipClusterInfo {
+	for i := range m.KmipServers {
+		if m.KmipServers[i].ClusterId.Id == id {
+			return &m.KmipServers[i]
+		}
+	}
+	return nil
+}
+
+// serverCert returns the certificate of the given KMS server, generated on first use.
+func (m *CryptoManagerKmip) serverCert(providerID string, server types.KmipServerInfo) string {
+	key := providerID + "/" + server.Name
+
+	cert, ok := m.serverCerts[key]
+	if !ok {
+		cn := server.Address
+		if cn == "" {
+			cn = server.Name
+		}
+		cert = kmipSelfSignedCert(cn)
+		m.serverCerts[key] = cert
+	}
+
+	return cert
+}
+
+// trusted returns true if vCenter trusts the certificate of the given KMS server.
+func (m *CryptoManagerKmip) trusted(providerID, serverName string) bool {
+	cert, ok := m.serverCerts[providerID+"/"+serverName]
+	return ok && slices.Contains(m.trustedCerts[providerID], cert)
+}
+
+func (m *CryptoManagerKmip) serverStatus(providerID, serverName string) types.CryptoManagerKmipServerStatus {
+	status := types.CryptoManagerKmipServerStatus{
+		Name:              serverName,
+		Status:            types.ManagedEntityStatusGreen,
+		ClientTrustServer: types.NewBool(m.trusted(providerID, serverName)),
+		ServerTrustClient: types.NewBool(m.clientCerts[providerID] != ""),
+	}
+
+	if cert, ok := m.serverCerts[providerID+"/"+serverName]; ok {
+		status.CertInfo = kmipCertInfo(cert)
+	}
+
+	return status
+}
+
+func (m *CryptoManagerKmip) RetrieveKmipServerCert(
+	ctx *Context, req *types.RetrieveKmipServerCert) soap.HasFault {
+
+	var body methods.RetrieveKmipServerCertBody
+
+	cluster := m.findCluster(req.KeyProvider.Id)
+	if cluster == nil {
+		body.Fault_ = Fault("Invalid cluster ID", &types.RuntimeFault{})
+		return &body
+	}
+
+	for _, server := range cluster.Servers {
+		// vCenter connects to the server's Address and Port to retrieve its certificate
+		if server.Address == req.Server.Address && server.Port == req.Server.Port {
+			cert := m.serverCert(cluster.ClusterId.Id, server)
+			body.Res = &types.RetrieveKmipServerCertResponse{
+				Returnval: types.CryptoManagerKmipServerCertInfo{
+					Certificate:       cert,
+					CertInfo:          kmipCertInfo(cert),
+					ClientTrustServer: types.NewBool(m.trusted(cluster.ClusterId.Id, server.Name)),
 				},
-			})
+			}
+			return &body
 		}
 	}
 
+	body.Fault_ = Fault("Invalid server", &types.RuntimeFault{})
+	return &body
+}
+
+func (m *CryptoManagerKmip) UploadKmipServerCert(
+	ctx *Context, req *types.UploadKmipServerCert) soap.HasFault {
+
+	var body methods.UploadKmipServerCertBody
+
+	if m.findCluster(req.Cluster.Id) == nil {
+		body.Fault_ = Fault("Invalid cluster ID", &types.RuntimeFault{})
+		return &body
+	}
+
+	if kmipCertInfo(req.Certificate) == nil {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "certificate"})
+		return &body
+	}
+
+	if !slices.Contains(m.trustedCerts[req.Cluster.Id], req.Certificate) {
+		m.trustedCerts[req.Cluster.Id] = append(m.trustedCerts[req.Cluster.Id], req.Certificate)
+	}
+
+	body.Res = new(types.UploadKmipServerCertResponse)
+	return &body
+}
+
+func (m *CryptoManagerKmip) GenerateSelfSignedClientCert(
+	ctx *Context, req *types.GenerateSelfSignedClientCert) soap.HasFault {
+
+	var body methods.GenerateSelfSignedClientCertBody
+
+	if m.findCluster(req.Cluster.Id) == nil {
+		body.Fault_ = Fault("Invalid cluster ID", &types.RuntimeFault{})
+		return &body
+	}
+
+	body.Res = &types.GenerateSelfSignedClientCertResponse{
+		Returnval: kmipSelfSignedCert("vCenter"),
+	}
+	return &body
+}
+
+func (m *CryptoManagerKmip) UpdateSelfSignedClientCert(
+	ctx *Context, req *types.UpdateSelfSignedClientCert) soap.HasFault {
+
+	var body methods.UpdateSelfSignedClientCertBody
+
+	if m.findCluster(req.Cluster.Id) == nil {
+		body.Fault_ = Fault("Invalid cluster ID", &types.RuntimeFault{})
+		return &body
+	}
+
+	if kmipCertInfo(req.Certificate) == nil {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "certificate"})
+		return &body
+	}
+
+	m.clientCerts[req.Cluster.Id] = req.Certificate
+
+	body.Res = new(types.UpdateSelfSignedClientCertResponse)
+	return &body
+}
+
+func (m *CryptoManagerKmip) RetrieveClientCert(
+	ctx *Context, req *types.RetrieveClientCert) soap.HasFault {
+
+	var body methods.RetrieveClientCertBody
+
+	if m.findCluster(req.Cluster.Id) == nil {
+		body.Fault_ = Fault("Invalid cluster ID", &types.RuntimeFault{})
+		return &body
+	}
+
+	body.Res = &types.RetrieveClientCertResponse{
+		Returnval: m.clientCerts[req.Cluster.Id],
+	}
 	return &body
 }
@@ -17,8 +17,14 @@ limitations under the License.
 package simulator
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -27,6 +33,7 @@ import (
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vim25/xml"
 )
 
 var DefaultCustomizationSpec = []types.CustomizationSpecItem{
@@ -250,7 +257,7 @@ type CustomizationSpecManager struct {
 }
 
 func (m *CustomizationSpecManager) init(r *Registry) {
-	m.items = DefaultCustomizationSpec
+	m.items = append([]types.CustomizationSpecItem(nil), DefaultCustomizationSpec...)
 
 	// Real VC is different DN, X509v3 extensions, etc.
 	// This is still useful for testing []byte of DER encoded cert over SOAP
@@ -282,18 +289,9 @@ func customizeName(vm *VirtualMachine, base types.BaseCustomizationName) string
 }
 
 func (m *CustomizationSpecManager) DoesCustomizationSpecExist(ctx *Context, req *types.DoesCustomizationSpecExist) soap.HasFault {
-	exists := false
-
-	for _, item := range m.items {
-		if item.Info.Name == req.Name {
-			exists = true
-			break
-		}
-	}
-
 	return &methods.DoesCustomizationSpecExistBody{
 		Res: &types.DoesCustomizationSpecExistResponse{
-			Returnval: exists,
+			Returnval: m.find(req.Name) != -1,
 		},
 	}
 }
@@ -301,13 +299,11 @@ func (m *CustomizationSpecManager) DoesCustomizationSpecExist(ctx *Context, req
 func (m *CustomizationSpecManager) GetCustomizationSpec(ctx *Context, req *types.GetCustomizationSpec) soap.HasFault {
 	body := new(methods.GetCustomizationSpecBody)
 
-	for _, item := range m.items {
-		if item.Info.Name == req.Name {
-			body.Res = &types.GetCustomizationSpecResponse{
-				Returnval: item,
-			}
-			return body
+	if i := m.find(req.Name); i != -1 {
+		body.Res = &types.GetCustomizationSpecResponse{
+			Returnval: m.items[i],
 		}
+		return body
 	}
 
 	body.Fault_ = Fault("", new(types.NotFound))
@@ -315,17 +311,93 @@ func (m *CustomizationSpecManager) GetCustomizationSpec(ctx *Context, req *types
 	return body
 }
 
+// find returns the index of the spec with the given name, or -1 if not found.
+func (m *CustomizationSpecManager) find(name string) int {
+	for i, item := range m.items {
+		if item.Info.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// encrypt encrypts the given password with the EncryptionKey certificate, if the password is in plain text.
+func (m *CustomizationSpecManager) encrypt(p *types.CustomizationPassword) types.BaseMethodFault {
+	if p == nil || !p.PlainText {
+		return nil
+	}
+
+	cert, err := x509.ParseCertificate(m.EncryptionKey)
+	if err != nil {
+		return &types.CustomizationFault{}
+	}
+
+	key, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return &types.CustomizationFault{}
+	}
+
+	data, err := rsa.EncryptPKCS1v15(rand.Reader, key, []byte(p.Value))
+	if err != nil {
+		return &types.CustomizationFault{}
+	}
+
+	p.Value = base64.StdEncoding.EncodeToString(data)
+	p.PlainText = false
+
+	return nil
+}
+
+// encryptSpec encrypts the sensitive fields of the given spec.
+func (m *CustomizationSpecManager) encryptSpec(spec *types.CustomizationSpec) types.BaseMethodFault {
+	if sysprep, ok := spec.Identity.(*types.CustomizationSysprep); ok {
+		passwords := []*types.CustomizationPassword{
+			sysprep.GuiUnattended.Password,
+			sysprep.Identification.DomainAdminPassword,
+		}
+		for _, p := range passwords {
+			if err := m.encrypt(p); err != nil {
+				return err
+			}
+		}
+	}
+
+	spec.EncryptionKey = m.EncryptionKey
+
+	return nil
+}
+
+// prepare returns a copy of the given item for storing, with sensitive fields encrypted and Info updated.
+func (m *CustomizationSpecManager) prepare(ctx *Context, item types.CustomizationSpecItem, version string) (types.CustomizationSpecItem, types.BaseMethodFault) {
+	var spec types.CustomizationSpecItem
+	deepCopy(&item, &spec) // don't modify the request
+
+	if err := m.encryptSpec(&spec.Spec); err != nil {
+		return spec, err
+	}
+
+	n, _ := strconv.ParseInt(version, 10, 64)
+	spec.Info.ChangeVersion = strconv.FormatInt(n+1, 10)
+	spec.Info.LastUpdateTime = types.NewTime(ctx.Map.Now())
+
+	return spec, nil
+}
+
 func (m *CustomizationSpecManager) CreateCustomizationSpec(ctx *Context, req *types.CreateCustomizationSpec) soap.HasFault {
 	body := new(methods.CreateCustomizationSpecBody)
 
-	for _, item := range m.items {
-		if item.Info.Name == req.Item.Info.Name {
-			body.Fault_ = Fault("", &types.AlreadyExists{Name: req.Item.Info.Name})
-			return body
-		}
+	if m.find(req.Item.Info.Name) != -1 {
+		body.Fault_ = Fault("", &types.AlreadyExists{Name: req.Item.Info.Name})
+		return body
 	}
 
-	m.items = append(m.items, req.Item)
+	item, err := m.prepare(ctx, req.Item, "0")
+	if err != nil {
+		body.Fault_ = Fault("", err)
+		return body
+	}
+
+	m.items = append(m.items, item)
 	body.Res = new(types.CreateCustomizationSpecResponse)
 
 	return body
@@ -334,15 +406,136 @@ func (m *CustomizationSpecManager) CreateCustomizationSpec(ctx *Context, req *ty
 func (m *CustomizationSpecManager) OverwriteCustomizationSpec(ctx *Context, req *types.OverwriteCustomizationSpec) soap.HasFault {
 	body := new(methods.OverwriteCustomizationSpecBody)
 
-	for i, item := range m.items {
-		if item.Info.Name == req.Item.Info.Name {
-			m.items[i] = req.Item
-			body.Res = new(types.OverwriteCustomizationSpecResponse)
-			return body
-		}
+	i := m.find(req.Item.Info.Name)
+	if i == -1 {
+		body.Fault_ = Fault("", new(types.NotFound))
+		return body
 	}
 
-	body.Fault_ = Fault("", new(types.NotFound))
+	version := m.items[i].Info.ChangeVersion
+	if v := req.Item.Info.ChangeVersion; v != "" && v != version {
+		body.Fault_ = Fault("", new(types.ConcurrentAccess))
+		return body
+	}
+
+	item, err := m.prepare(ctx, req.Item, version)
+	if err != nil {
+		body.Fault_ = Fault("", err)
+		return body
+	}
+
+	m.items[i] = item
+	body.Res = new(types.OverwriteCustomizationSpecResponse)
+
+	return body
+}
+
+func (m *CustomizationSpecManager) DeleteCustomizationSpec(ctx *Context, req *types.DeleteCustomizationSpec) soap.HasFault {
+	body := new(methods.DeleteCustomizationSpecBody)
+
+	i := m.find(req.Name)
+	if i == -1 {
+		body.Fault_ = Fault("", new(types.NotFound))
+		return body
+	}
+
+	m.items = append(m.items[:i], m.items[i+1:]...)
+	body.Res = new(types.DeleteCustomizationSpecResponse)
+
+	return body
+}
+
+func (m *CustomizationSpecManager) DuplicateCustomizationSpec(ctx *Context, req *types.DuplicateCustomizationSpec) soap.HasFault {
+	body := new(methods.DuplicateCustomizationSpecBody)
+
+	i := m.find(req.Name)
+	if i == -1 {
+		body.Fault_ = Fault("", new(types.NotFound))
+		return body
+	}
+
+	if m.find(req.NewName) != -1 {
+		body.Fault_ = Fault("", &types.AlreadyExists{Name: req.NewName})
+		return body
+	}
+
+	var item types.CustomizationSpecItem
+	deepCopy(&m.items[i], &item)
+	item.Info.Name = req.NewName
+	item.Info.ChangeVersion = "1"
+	item.Info.LastUpdateTime = types.NewTime(ctx.Map.Now())
+
+	m.items = append(m.items, item)
+	body.Res = new(types.DuplicateCustomizationSpecResponse)
+
+	return body
+}
+
+func (m *CustomizationSpecManager) RenameCustomizationSpec(ctx *Context, req *types.RenameCustomizationSpec) soap.HasFault {
+	body := new(methods.RenameCustomizationSpecBody)
+
+	i := m.find(req.Name)
+	if i == -1 {
+		body.Fault_ = Fault("", new(types.NotFound))
+		return body
+	}
+
+	if m.find(req.NewName) != -1 {
+		body.Fault_ = Fault("", &types.AlreadyExists{Name: req.NewName})
+		return body
+	}
+
+	m.items[i].Info.Name = req.NewName
+	body.Res = new(types.RenameCustomizationSpecResponse)
+
+	return body
+}
+
+// customizationSpecXML is the document format of CustomizationSpecItemToXml and XmlToCustomizationSpecItem
+type customizationSpecXML struct {
+	XMLName xml.Name `xml:"ConfigRoot"`
+
+	types.CustomizationSpecItem
+}
+
+func (m *CustomizationSpecManager) CustomizationSpecItemToXml(ctx *Context, req *types.CustomizationSpecItemToXml) soap.HasFault {
+	body := new(methods.CustomizationSpecItemToXmlBody)
+
+	var item types.CustomizationSpecItem
+	deepCopy(&req.Item, &item)
+	if err := m.encryptSpec(&item.Spec); err != nil {
+		body.Fault_ = Fault("", err)
+		return body
+	}
+
+	data, err := xml.MarshalIndent(customizationSpecXML{CustomizationSpecItem: item}, "", "  ")
+	if err != nil {
+		body.Fault_ = Fault(err.Error(), new(types.CustomizationFault))
+		return body
+	}
+
+	body.Res = &types.CustomizationSpecItemToXmlResponse{
+		Returnval: xml.Header + string(data),
+	}
+
+	return body
+}
+
+func (m *CustomizationSpecManager) XmlToCustomizationSpecItem(ctx *Context, req *types.XmlToCustomizationSpecItem) soap.HasFault {
+	body := new(methods.XmlToCustomizationSpecItemBody)
+
+	var spec customizationSpecXML
+	dec := xml.NewDecoder(strings.NewReader(req.SpecItemXml))
+	dec.TypeFunc = types.TypeFunc()
+
+	if err := dec.Decode(&spec); err != nil {
+		body.Fault_ = Fault(err.Error(), new(types.CustomizationFault))
+		return body
+	}
+
+	body.Res = &types.XmlToCustomizationSpecItemResponse{
+		Returnval: spec.CustomizationSpecItem,
+	}
 
 	return body
 }
@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator/internal"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestCustomizationSpecManager(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		m := object.NewCustomizationSpecManager(c)
+
+		password := "secret"
+		item := types.CustomizationSpecItem{
+			Info: types.CustomizationSpecInfo{
+				Name: "vcsim-windows",
+				Type: "Windows",
+			},
+			Spec: types.CustomizationSpec{
+				Identity: &types.CustomizationSysprep{
+					GuiUnattended: types.CustomizationGuiUnattended{
+						Password: &types.CustomizationPassword{Value: password, PlainText: true},
+						TimeZone: 4,
+					},
+					UserData: types.CustomizationUserData{
+						FullName:     "vcsim",
+						OrgName:      "VMware",
+						ComputerName: &types.CustomizationVirtualMachineName{},
+					},
+				},
+				NicSettingMap: []types.CustomizationAdapterMapping{{
+					Adapter: types.CustomizationIPSettings{Ip: &types.CustomizationDhcpIpGenerator{}},
+				}},
+			},
+		}
+
+		if err := m.CreateCustomizationSpec(ctx, item); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.CreateCustomizationSpec(ctx, item); !fault.Is(err, &types.AlreadyExists{}) {
+			t.Errorf("expected AlreadyExists, got: %v", err)
+		}
+
+		spec, err := m.GetCustomizationSpec(ctx, item.Info.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if spec.Info.ChangeVersion != "1" || spec.Info.LastUpdateTime == nil {
+			t.Errorf("info=%#v", spec.Info)
+		}
+
+		// the password is encrypted with the EncryptionKey certificate
+		p := spec.Spec.Identity.(*types.CustomizationSysprep).GuiUnattended.Password
+		if p.PlainText || p.Value == password {
+			t.Errorf("password=%#v", p)
+		}
+		block, _ := pem.Decode(internal.LocalhostKey)
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := base64.StdEncoding.DecodeString(p.Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err = rsa.DecryptPKCS1v15(rand.Reader, key.(*rsa.PrivateKey), data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != password {
+			t.Errorf("decrypted password=%q", data)
+		}
+
+		// overwrite requires the current ChangeVersion
+		spec.Spec.Identity.(*types.CustomizationSysprep).UserData.FullName = "govc"
+		if err = m.OverwriteCustomizationSpec(ctx, *spec); err != nil {
+			t.Fatal(err)
+		}
+		if err = m.OverwriteCustomizationSpec(ctx, *spec); !fault.Is(err, &types.ConcurrentAccess{}) {
+			t.Errorf("expected ConcurrentAccess, got: %v", err)
+		}
+
+		if err = m.DuplicateCustomizationSpec(ctx, item.Info.Name, "vcsim-windows-copy"); err != nil {
+			t.Fatal(err)
+		}
+		if err = m.DuplicateCustomizationSpec(ctx, "enoent", "enoent-copy"); !fault.Is(err, &types.NotFound{}) {
+			t.Errorf("expected NotFound, got: %v", err)
+		}
+		if err = m.RenameCustomizationSpec(ctx, "vcsim-windows-copy", item.Info.Name); !fault.Is(err, &types.AlreadyExists{}) {
+			t.Errorf("expected AlreadyExists, got: %v", err)
+		}
+		if err = m.RenameCustomizationSpec(ctx, "vcsim-windows-copy", "vcsim-windows-2"); err != nil {
+			t.Fatal(err)
+		}
+
+		info, err := m.Info(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, i := range info {
+			names = append(names, i.Name)
+		}
+		expect := []string{"vcsim-linux", "vcsim-linux-static", "vcsim-windows-static", "vcsim-windows-domain", "vcsim-windows", "vcsim-windows-2"}
+		if !reflect.DeepEqual(names, expect) {
+			t.Errorf("names=%v", names)
+		}
+
+		if err = m.DeleteCustomizationSpec(ctx, "vcsim-windows-2"); err != nil {
+			t.Fatal(err)
+		}
+		if ok, _ := m.DoesCustomizationSpecExist(ctx, "vcsim-windows-2"); ok {
+			t.Error("spec should have been deleted")
+		}
+
+		// xml round trip
+		spec, err = m.GetCustomizationSpec(ctx, item.Info.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xml, err := m.CustomizationSpecItemToXml(ctx, *spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		spec2, err := m.XmlToCustomizationSpecItem(ctx, xml)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(spec.Spec, spec2.Spec) || spec.Info.Name != spec2.Info.Name {
+			t.Errorf("xml=%s", xml)
+		}
+		if _, err = m.XmlToCustomizationSpecItem(ctx, "<invalid"); !fault.Is(err, &types.CustomizationFault{}) {
+			t.Errorf("expected CustomizationFault, got: %v", err)
+		}
+	})
+}
+
+func TestCloneVMCustomization(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		folder, err := finder.Folder(ctx, "vm")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec, err := object.NewCustomizationSpecManager(c).GetCustomizationSpec(ctx, "vcsim-linux-static")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// spec NicSettingMap must match the number of NICs
+		cspec := types.VirtualMachineCloneSpec{Customization: &types.CustomizationSpec{Identity: spec.Spec.Identity}}
+		task, err := vm.Clone(ctx, folder, "invalid", cspec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); !fault.Is(err, &types.NicSettingMismatch{}) {
+			t.Errorf("expected NicSettingMismatch, got: %v", err)
+		}
+
+		cspec = types.VirtualMachineCloneSpec{Customization: &spec.Spec, PowerOn: true}
+		task, err = vm.Clone(ctx, folder, "customized", cspec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := task.WaitForResult(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		clone := object.NewVirtualMachine(c, info.Result.(types.ManagedObjectReference))
+
+		events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{
+			Entity: &types.EventFilterSpecByEntity{
+				Entity:    clone.Reference(),
+				Recursion: types.EventFilterSpecRecursionOptionSelf,
+			},
+			Type: []string{"CustomizationStartedEvent", "CustomizationSucceeded"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 2 {
+			t.Errorf("events=%d", len(events))
+		}
+
+		var mvm mo.VirtualMachine
+		err = clone.Properties(ctx, clone.Reference(), []string{"guest.hostName", "config.tools"}, &mvm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(mvm.Guest.HostName, "vcsim-") {
+			t.Errorf("hostname=%q", mvm.Guest.HostName)
+		}
+		if mvm.Config.Tools.PendingCustomization != "" {
+			t.Errorf("pending=%q", mvm.Config.Tools.PendingCustomization)
+		}
+	})
+}
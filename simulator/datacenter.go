@@ -148,7 +148,7 @@ func datacenterEventArgument(obj mo.Entity) *types.DatacenterEventArgument {
 }
 
 func (dc *Datacenter) PowerOnMultiVMTask(ctx *Context, req *types.PowerOnMultiVM_Task) soap.HasFault {
-	task := CreateTask(dc, "powerOnMultiVM", func(_ *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(dc, "powerOnMultiVM", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if dc.isESX {
 			return nil, new(types.NotImplemented)
 		}
@@ -158,6 +158,8 @@ func (dc *Datacenter) PowerOnMultiVMTask(ctx *Context, req *types.PowerOnMultiVM
 		//    +- []Attempted
 		//        +- subTask.result - VM level powerOn task result
 		//        +- ...
+		// VMs are powered on in the order given by the request, where each VM level task
+		// completes before the next is started.
 		res := types.ClusterPowerOnVmResult{}
 		res.Attempted = []types.ClusterAttemptedVmInfo{}
 
@@ -178,10 +180,20 @@ func (dc *Datacenter) PowerOnMultiVMTask(ctx *Context, req *types.PowerOnMultiVM
 
 			// NOTE: Simulator does not actually perform any specific host-level placement
 			// (equivalent to vSphere DRS).
+			var vmTaskBody *methods.PowerOnVM_TaskBody
 			taskCtx.WithLock(vm, func() {
-				vmTaskBody := vm.PowerOnVMTask(taskCtx, &types.PowerOnVM_Task{}).(*methods.PowerOnVM_TaskBody)
-				res.Attempted = append(res.Attempted, types.ClusterAttemptedVmInfo{Vm: ref, Task: &vmTaskBody.Res.Returnval})
+				vmTaskBody = vm.PowerOnVMTask(taskCtx, &types.PowerOnVM_Task{}).(*methods.PowerOnVM_TaskBody)
 			})
+			if vmTaskBody.Fault_ != nil {
+				res.NotAttempted = append(res.NotAttempted, types.ClusterNotAttemptedVmInfo{
+					Vm:    ref,
+					Fault: types.LocalizedMethodFault{Fault: vmTaskBody.Fault_.VimFault().(types.BaseMethodFault)},
+				})
+				continue
+			}
+
+			res.Attempted = append(res.Attempted, types.ClusterAttemptedVmInfo{Vm: ref, Task: &vmTaskBody.Res.Returnval})
+			t.subtask(ctx, vmTaskBody.Res.Returnval).Wait()
 		}
 
 		return res, nil
@@ -159,15 +159,26 @@ func TestDatacenterPowerOnMultiVMs(t *testing.T) {
 			t.Fatalf("Unexpected per-vm tasks in results, found %v, expected %v",
 				len(dcResult.Attempted), len(testVMs))
 		}
+		var prev *types.TaskInfo
 		for i, vmResult := range dcResult.Attempted {
 			if vmResult.Task == nil {
 				t.Fatalf("Found per-vm task nil for VM #%v", i)
 			}
+			if vmResult.Vm != testVMs[i] {
+				t.Errorf("VM #%v attempted out of order: %s", i, vmResult.Vm)
+			}
 			vmTask := object.NewTask(c.Client, *vmResult.Task)
-			err := vmTask.Wait(ctx)
+			vmInfo, err := vmTask.WaitForResult(ctx, nil)
 			if err != nil {
 				t.Fatalf("%v", err)
 			}
+			if vmInfo.ParentTaskKey != info.Key || vmInfo.RootTaskKey != info.Key {
+				t.Errorf("VM #%v parent=%q root=%q", i, vmInfo.ParentTaskKey, vmInfo.RootTaskKey)
+			}
+			if prev != nil && vmInfo.StartTime.Before(*prev.CompleteTime) {
+				t.Errorf("VM #%v started before VM #%v completed", i, i-1)
+			}
+			prev = vmInfo
 		}
 	default:
 		t.Fatalf("Unexpected result type %T returned for DC PowerOnMultiVM", dcResult)
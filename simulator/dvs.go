@@ -18,11 +18,14 @@ package simulator
 
 import (
 	"fmt"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
 
+	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -33,6 +36,25 @@ type DistributedVirtualSwitch struct {
 	mo.DistributedVirtualSwitch
 
 	types.FetchDVPortsResponse
+
+	ports   []types.DistributedVirtualPort
+	portKey int
+}
+
+func newDvsFault(format string, args ...any) *types.DvsFault {
+	msg := fmt.Sprintf(format, args...)
+	return &types.DvsFault{
+		VimFault: types.VimFault{
+			MethodFault: types.MethodFault{
+				FaultCause: &types.LocalizedMethodFault{
+					Fault: &types.SystemErrorFault{
+						Reason: msg,
+					},
+					LocalizedMessage: msg,
+				},
+			},
+		},
+	}
 }
 
 func (s *DistributedVirtualSwitch) eventArgument() *types.DvsEventArgument {
@@ -61,6 +83,10 @@ func (s *DistributedVirtualSwitch) AddDVPortgroupTask(ctx *Context, c *types.Add
 		portgroupNames := s.Summary.PortgroupName
 
 		for _, spec := range c.Spec {
+			if err := s.validateVlan(spec.DefaultPortConfig); err != nil {
+				return nil, err
+			}
+
 			pg := &DistributedVirtualPortgroup{}
 			pg.Name = spec.Name
 			pg.Entity().Name = pg.Name
@@ -151,9 +177,7 @@ func (s *DistributedVirtualSwitch) AddDVPortgroupTask(ctx *Context, c *types.Add
 				}
 			}
 
-			for i := 0; i < int(spec.NumPorts); i++ {
-				pg.PortKeys = append(pg.PortKeys, strconv.Itoa(i))
-			}
+			s.addDVPorts(ctx, pg, int(spec.NumPorts))
 
 			portgroups = append(portgroups, pg.Self)
 			portgroupNames = append(portgroupNames, pg.Name)
@@ -196,6 +220,8 @@ func (s *DistributedVirtualSwitch) ReconfigureDvsTask(ctx *Context, req *types.R
 		spec := req.Spec.GetDVSConfigSpec()
 
 		members := s.Summary.HostMember
+		config := s.Config.GetDVSConfigInfo()
+		hosts := config.Host
 
 		for _, member := range spec.Host {
 			h := ctx.Map.Get(member.Host)
@@ -216,6 +242,7 @@ func (s *DistributedVirtualSwitch) ReconfigureDvsTask(ctx *Context, req *types.R
 					{Name: "network", Val: hostNetworks},
 				})
 				members = append(members, member.Host)
+				hosts = append(hosts, s.newHostMember(config, member))
 				parent := ctx.Map.Get(*host.HostSystem.Parent)
 
 				var pgs []types.ManagedObjectReference
@@ -253,20 +280,43 @@ func (s *DistributedVirtualSwitch) ReconfigureDvsTask(ctx *Context, req *types.R
 				}
 
 				RemoveReference(&members, member.Host)
+				hosts = slices.DeleteFunc(slices.Clone(hosts), func(m types.DistributedVirtualSwitchHostMember) bool {
+					return *m.Config.Host == member.Host
+				})
 
 				ctx.postEvent(&types.DvsHostLeftEvent{
 					DvsEvent: s.event(),
 					HostLeft: *host.eventArgument(),
 				})
 			case types.ConfigSpecOperationEdit:
-				return nil, &types.NotSupported{}
+				i := slices.IndexFunc(hosts, func(m types.DistributedVirtualSwitchHostMember) bool {
+					return *m.Config.Host == member.Host
+				})
+				if i < 0 {
+					return nil, &types.NotFound{}
+				}
+
+				hosts = slices.Clone(hosts)
+				if member.Backing != nil {
+					hosts[i].Config.Backing = member.Backing
+				}
+				if member.MaxProxySwitchPorts != 0 {
+					hosts[i].Config.MaxProxySwitchPorts = member.MaxProxySwitchPorts
+				}
 			}
 		}
 
+		config.Host = hosts
+
 		ctx.Map.Update(s, []types.PropertyChange{
 			{Name: "summary.hostMember", Val: members},
+			{Name: "config", Val: s.Config},
 		})
 
+		if s.Runtime != nil {
+			s.updateHealthCheck(ctx)
+		}
+
 		ctx.postEvent(&types.DvsReconfiguredEvent{
 			DvsEvent:   s.event(),
 			ConfigSpec: spec,
@@ -282,18 +332,339 @@ func (s *DistributedVirtualSwitch) ReconfigureDvsTask(ctx *Context, req *types.R
 	}
 }
 
-func (s *DistributedVirtualSwitch) FetchDVPorts(req *types.FetchDVPorts) soap.HasFault {
+// newHostMember returns the host member config for a host added to the switch.
+func (s *DistributedVirtualSwitch) newHostMember(config *types.DVSConfigInfo, spec types.DistributedVirtualSwitchHostMemberConfigSpec) types.DistributedVirtualSwitchHostMember {
+	member := types.DistributedVirtualSwitchHostMember{
+		Config: types.DistributedVirtualSwitchHostMemberConfigInfo{
+			Host:                 &spec.Host,
+			MaxProxySwitchPorts:  spec.MaxProxySwitchPorts,
+			VendorSpecificConfig: spec.VendorSpecificConfig,
+			Backing:              spec.Backing,
+		},
+		Status: string(types.DistributedVirtualSwitchHostMemberHostComponentStateUp),
+	}
+
+	if member.Config.MaxProxySwitchPorts == 0 {
+		member.Config.MaxProxySwitchPorts = config.DefaultProxySwitchMaxNumPorts
+	}
+
+	if member.Config.Backing == nil {
+		member.Config.Backing = new(types.DistributedVirtualSwitchHostMemberPnicBacking)
+	}
+
+	if s.Summary.ProductInfo != nil {
+		product := *s.Summary.ProductInfo
+		member.ProductInfo = &product
+	}
+
+	return member
+}
+
+// dvsVersionLess returns true if DVS product version a is older than b.
+func dvsVersionLess(a, b string) bool {
+	x := strings.Split(a, ".")
+	y := strings.Split(b, ".")
+
+	for i := 0; i < len(x) && i < len(y); i++ {
+		m, _ := strconv.Atoi(x[i])
+		n, _ := strconv.Atoi(y[i])
+		if m != n {
+			return m < n
+		}
+	}
+
+	return len(x) < len(y)
+}
+
+func (s *DistributedVirtualSwitch) PerformDvsProductSpecOperationTask(ctx *Context, req *types.PerformDvsProductSpecOperation_Task) soap.HasFault {
+	task := CreateTask(s, "performDvsProductSpecOperation", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		switch types.DistributedVirtualSwitchProductSpecOperationType(req.Operation) {
+		case types.DistributedVirtualSwitchProductSpecOperationTypeUpgrade:
+		case types.DistributedVirtualSwitchProductSpecOperationTypePreInstall,
+			types.DistributedVirtualSwitchProductSpecOperationTypeNotifyAvailableUpgrade,
+			types.DistributedVirtualSwitchProductSpecOperationTypeProceedWithUpgrade,
+			types.DistributedVirtualSwitchProductSpecOperationTypeUpdateBundleInfo:
+			return nil, nil
+		default:
+			return nil, &types.InvalidArgument{InvalidProperty: "operation"}
+		}
+
+		spec := req.ProductSpec
+		if spec == nil || spec.Version == "" {
+			return nil, &types.InvalidArgument{InvalidProperty: "productSpec"}
+		}
+
+		product := *s.Summary.ProductInfo
+		if !dvsVersionLess(product.Version, spec.Version) {
+			return nil, newDvsFault("cannot upgrade %s from version %s to %s", s.Name, product.Version, spec.Version)
+		}
+
+		product.Version = spec.Version
+		if spec.Build != "" {
+			product.Build = spec.Build
+		}
+
+		// The new version is pushed to connected hosts, others are out of sync until rectified
+		hosts := slices.Clone(s.Config.GetDVSConfigInfo().Host)
+		var outOfSync []types.DvsOutOfSyncHostArgument
+
+		for i, member := range hosts {
+			host, ok := ctx.Map.Get(*member.Config.Host).(*HostSystem)
+			if !ok {
+				continue
+			}
+
+			if host.Runtime.ConnectionState == types.HostSystemConnectionStateConnected {
+				info := product
+				hosts[i].ProductInfo = &info
+				continue
+			}
+
+			hosts[i].Status = string(types.DistributedVirtualSwitchHostMemberHostComponentStateOutOfSync)
+			hosts[i].StatusDetail = fmt.Sprintf("Switch version %s was not applied to the host", product.Version)
+			outOfSync = append(outOfSync, types.DvsOutOfSyncHostArgument{
+				OutOfSyncHost:   *host.eventArgument(),
+				ConfigParamters: []string{"productInfo"},
+			})
+		}
+
+		config := s.Config.GetDVSConfigInfo()
+		config.ProductInfo = product
+		config.Host = hosts
+
+		ctx.Map.Update(s, []types.PropertyChange{
+			{Name: "summary.productInfo", Val: &product},
+			{Name: "config", Val: s.Config},
+		})
+
+		ctx.postEvent(&types.DvsUpgradedEvent{
+			DvsEvent:    s.event(),
+			ProductInfo: product,
+		})
+
+		if len(outOfSync) != 0 {
+			ctx.postEvent(&types.OutOfSyncDvsHost{
+				DvsEvent:      s.event(),
+				HostOutOfSync: outOfSync,
+			})
+		}
+
+		return nil, nil
+	})
+
+	return &methods.PerformDvsProductSpecOperation_TaskBody{
+		Res: &types.PerformDvsProductSpecOperation_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (s *DistributedVirtualSwitch) RectifyDvsOnHostTask(ctx *Context, req *types.RectifyDvsOnHost_Task) soap.HasFault {
+	task := CreateTask(s, "rectifyDvsOnHost", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if len(req.Hosts) == 0 {
+			return nil, &types.InvalidArgument{InvalidProperty: "hosts"}
+		}
+
+		hosts := slices.Clone(s.Config.GetDVSConfigInfo().Host)
+
+		for _, ref := range req.Hosts {
+			i := slices.IndexFunc(hosts, func(m types.DistributedVirtualSwitchHostMember) bool {
+				return *m.Config.Host == ref
+			})
+			if i == -1 {
+				return nil, newDvsFault("host %s is not a member of %s", ref.Value, s.Name)
+			}
+
+			host, ok := ctx.Map.Get(ref).(*HostSystem)
+			if !ok {
+				return nil, &types.ManagedObjectNotFound{Obj: ref}
+			}
+			if host.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+				return nil, &types.HostNotConnected{}
+			}
+		}
+
+		for _, ref := range req.Hosts {
+			i := slices.IndexFunc(hosts, func(m types.DistributedVirtualSwitchHostMember) bool {
+				return *m.Config.Host == ref
+			})
+
+			member := &hosts[i]
+			old := member.Status
+			oldDetail := member.StatusDetail
+
+			product := *s.Summary.ProductInfo
+			member.ProductInfo = &product
+			member.Status = string(types.DistributedVirtualSwitchHostMemberHostComponentStateUp)
+			member.StatusDetail = ""
+
+			if old != member.Status {
+				host := ctx.Map.Get(ref).(*HostSystem)
+				ctx.postEvent(&types.DvsHostStatusUpdated{
+					DvsEvent:        s.event(),
+					HostMember:      *host.eventArgument(),
+					OldStatus:       old,
+					NewStatus:       member.Status,
+					OldStatusDetail: oldDetail,
+				})
+			}
+		}
+
+		s.Config.GetDVSConfigInfo().Host = hosts
+
+		ctx.Map.Update(s, []types.PropertyChange{
+			{Name: "config", Val: s.Config},
+		})
+
+		if s.Runtime != nil {
+			s.updateHealthCheck(ctx)
+		}
+
+		return nil, nil
+	})
+
+	return &methods.RectifyDvsOnHost_TaskBody{
+		Res: &types.RectifyDvsOnHost_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// updateHealthCheck updates the health check results of each host member, for the enabled health checks.
+// The simulated physical switch trunks all VLANs and supports the switch MTU on each uplink port.
+func (s *DistributedVirtualSwitch) updateHealthCheck(ctx *Context) {
+	config := s.Config.GetDVSConfigInfo()
+
+	var vlanMtu, teaming bool
+	for _, c := range config.HealthCheckConfig {
+		switch c.(type) {
+		case *types.VMwareDVSVlanMtuHealthCheckConfig:
+			vlanMtu = isTrue(c.GetDVSHealthCheckConfig().Enable)
+		case *types.VMwareDVSTeamingHealthCheckConfig:
+			teaming = isTrue(c.GetDVSHealthCheckConfig().Enable)
+		}
+	}
+
+	var uplinks []string
+	for _, ref := range config.UplinkPortgroup {
+		if pg, ok := ctx.Map.Get(ref).(*DistributedVirtualPortgroup); ok {
+			uplinks = append(uplinks, pg.PortKeys...)
+		}
+	}
+
+	status := types.VMwareDVSTeamingMatchStatusNonIphashMatch
+	if setting, ok := config.DefaultPortConfig.(*types.VMwareDVSPortSetting); ok {
+		if policy := setting.UplinkTeamingPolicy; policy != nil && policy.Policy != nil && policy.Policy.Value == "loadbalance_ip" {
+			status = types.VMwareDVSTeamingMatchStatusIphashMatch
+		}
+	}
+
+	vlans := []types.NumericRange{{Start: 0, End: 4094}}
+	runtime := &types.DVSRuntimeInfo{}
+
+	for _, ref := range s.Summary.HostMember {
+		info := types.HostMemberRuntimeInfo{
+			Host:   ref,
+			Status: string(types.DistributedVirtualSwitchHostMemberHostComponentStateUp),
+		}
+
+		for _, member := range config.Host {
+			if *member.Config.Host == ref {
+				info.Status = member.Status
+				info.StatusDetail = member.StatusDetail
+			}
+		}
+
+		if vlanMtu {
+			for _, key := range uplinks {
+				uplink := types.HostMemberUplinkHealthCheckResult{UplinkPortKey: key}
+				info.HealthCheckResult = append(info.HealthCheckResult,
+					&types.VMwareDVSVlanHealthCheckResult{
+						HostMemberUplinkHealthCheckResult: uplink,
+						TrunkedVlan:                       vlans,
+					},
+					&types.VMwareDVSMtuHealthCheckResult{
+						HostMemberUplinkHealthCheckResult: uplink,
+						VlanSupportSwitchMtu:              vlans,
+					},
+				)
+			}
+		}
+
+		if teaming {
+			info.HealthCheckResult = append(info.HealthCheckResult, &types.VMwareDVSTeamingHealthCheckResult{
+				TeamingStatus: string(status),
+			})
+		}
+
+		runtime.HostMemberRuntime = append(runtime.HostMemberRuntime, info)
+	}
+
+	ctx.Map.Update(s, []types.PropertyChange{{Name: "runtime", Val: runtime}})
+}
+
+func (s *DistributedVirtualSwitch) UpdateDVSHealthCheckConfigTask(ctx *Context, req *types.UpdateDVSHealthCheckConfig_Task) soap.HasFault {
+	task := CreateTask(s, "updateDVSHealthCheckConfig", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		config := s.Config.GetDVSConfigInfo()
+		checks := slices.Clone(config.HealthCheckConfig)
+
+		for _, c := range req.HealthCheckConfig {
+			switch c.(type) {
+			case *types.VMwareDVSVlanMtuHealthCheckConfig, *types.VMwareDVSTeamingHealthCheckConfig:
+			default:
+				return nil, &types.InvalidArgument{InvalidProperty: "healthCheckConfig"}
+			}
+
+			if c.GetDVSHealthCheckConfig().Interval < 0 {
+				return nil, &types.InvalidArgument{InvalidProperty: "interval"}
+			}
+
+			i := slices.IndexFunc(checks, func(e types.BaseDVSHealthCheckConfig) bool {
+				return reflect.TypeOf(e) == reflect.TypeOf(c)
+			})
+			if i == -1 {
+				checks = append(checks, c)
+			} else {
+				checks[i] = c
+			}
+		}
+
+		config.HealthCheckConfig = checks
+
+		ctx.Map.Update(s, []types.PropertyChange{{Name: "config", Val: s.Config}})
+
+		s.updateHealthCheck(ctx)
+
+		return nil, nil
+	})
+
+	return &methods.UpdateDVSHealthCheckConfig_TaskBody{
+		Res: &types.UpdateDVSHealthCheckConfig_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (s *DistributedVirtualSwitch) FetchDVPorts(ctx *Context, req *types.FetchDVPorts) soap.HasFault {
 	body := &methods.FetchDVPortsBody{}
 	body.Res = &types.FetchDVPortsResponse{
-		Returnval: s.dvPortgroups(req.Criteria),
+		Returnval: s.dvPorts(ctx, req.Criteria),
 	}
 	return body
 }
 
 func (s *DistributedVirtualSwitch) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
 	task := CreateTask(s, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
-		// TODO: should return ResourceInUse fault if any VM is using a port on this switch
-		// and past that, remove refs from each host.Network, etc
+		// TODO: remove refs from each host.Network, etc
+		for _, port := range s.ports {
+			if port.Connectee != nil {
+				return nil, &types.ResourceInUse{
+					Type: s.Self.Type,
+					Name: s.Name,
+				}
+			}
+		}
+
 		f := ctx.Map.getEntityParent(s, "Folder").(*Folder)
 		folderRemoveChild(ctx, &f.Folder, s.Reference())
 		ctx.postEvent(&types.DvsDestroyedEvent{DvsEvent: s.event()})
@@ -307,25 +678,236 @@ func (s *DistributedVirtualSwitch) DestroyTask(ctx *Context, req *types.Destroy_
 	}
 }
 
-func (s *DistributedVirtualSwitch) dvPortgroups(criteria *types.DistributedVirtualSwitchPortCriteria) []types.DistributedVirtualPort {
+// dvPort returns the port with the given key, or nil if no such port exists.
+func (s *DistributedVirtualSwitch) dvPort(key string) *types.DistributedVirtualPort {
+	for i := range s.ports {
+		if s.ports[i].Key == key {
+			return &s.ports[i]
+		}
+	}
+	return nil
+}
+
+// addDVPorts allocates n ports in the given portgroup.
+// Port keys are unique within the switch, as they are with vCenter.
+func (s *DistributedVirtualSwitch) addDVPorts(ctx *Context, pg *DistributedVirtualPortgroup, n int) {
+	keys := slices.Clone(pg.PortKeys)
+
+	for i := 0; i < n; i++ {
+		key := strconv.Itoa(s.portKey)
+		s.portKey++
+
+		s.ports = append(s.ports, types.DistributedVirtualPort{
+			DvsUuid:      s.Uuid,
+			Key:          key,
+			PortgroupKey: pg.Key,
+		})
+		keys = append(keys, key)
+	}
+
+	ctx.Map.Update(pg, []types.PropertyChange{
+		{Name: "portKeys", Val: keys},
+	})
+	ctx.Map.Update(s, []types.PropertyChange{
+		{Name: "summary.numPorts", Val: int32(len(s.ports))},
+	})
+}
+
+// removeDVPorts releases unconnected ports of the given portgroup, starting with the most recently allocated,
+// until n ports remain. A ResourceInUse fault is returned, without releasing any port, if more than n ports are connected.
+func (s *DistributedVirtualSwitch) removeDVPorts(ctx *Context, pg *DistributedVirtualPortgroup, n int) types.BaseMethodFault {
+	connected := 0
+	for _, key := range pg.PortKeys {
+		if port := s.dvPort(key); port != nil && port.Connectee != nil {
+			connected++
+		}
+	}
+
+	if connected > n {
+		return &types.ResourceInUse{
+			Type: pg.Self.Type,
+			Name: pg.Name,
+		}
+	}
+
+	keys := slices.Clone(pg.PortKeys)
+
+	for i := len(keys) - 1; i >= 0 && len(keys) > n; i-- {
+		port := s.dvPort(keys[i])
+		if port != nil && port.Connectee != nil {
+			continue
+		}
+
+		s.ports = slices.DeleteFunc(s.ports, func(p types.DistributedVirtualPort) bool {
+			return p.Key == keys[i]
+		})
+		keys = slices.Delete(keys, i, i+1)
+	}
+
+	ctx.Map.Update(pg, []types.PropertyChange{
+		{Name: "portKeys", Val: keys},
+	})
+	ctx.Map.Update(s, []types.PropertyChange{
+		{Name: "summary.numPorts", Val: int32(len(s.ports))},
+	})
+
+	return nil
+}
+
+// connectDVPort binds the port with the given key to connectee, or the first free port of the portgroup if key is empty.
+// Ports are added on demand to ephemeral, NSX and auto expanding portgroups, where static portgroups fail once all ports are in use.
+// The key of the connected port is returned.
+func (s *DistributedVirtualSwitch) connectDVPort(
+	ctx *Context,
+	pg *DistributedVirtualPortgroup,
+	key string,
+	connectee types.DistributedVirtualSwitchPortConnectee,
+) (string, types.BaseMethodFault) {
+	if len(s.FetchDVPortsResponse.Returnval) != 0 {
+		return key, nil // ports are static when loaded via FetchDVPorts
+	}
+
+	var port *types.DistributedVirtualPort
+
+	if key != "" {
+		port = s.dvPort(key)
+		if port == nil || port.PortgroupKey != pg.Key {
+			return "", &types.InvalidArgument{InvalidProperty: "port.portKey"}
+		}
+
+		if c := port.Connectee; c != nil && (*c.ConnectedEntity != *connectee.ConnectedEntity || c.NicKey != connectee.NicKey) {
+			return "", &types.ResourceInUse{
+				Type: "DistributedVirtualPort",
+				Name: key,
+			}
+		}
+	} else {
+		for _, k := range pg.PortKeys {
+			if p := s.dvPort(k); p != nil && p.Connectee == nil {
+				port = p
+				break
+			}
+		}
+	}
+
+	if port == nil {
+		expand := pg.Config.Type == string(types.DistributedVirtualPortgroupPortgroupTypeEphemeral) ||
+			pg.Config.BackingType == string(types.DistributedVirtualPortgroupBackingTypeNsx) ||
+			(pg.Config.AutoExpand != nil && *pg.Config.AutoExpand)
+
+		if !expand {
+			return "", newDvsFault("no free ports are available in portgroup %s (%d ports)", pg.Name, len(pg.PortKeys))
+		}
+
+		s.addDVPorts(ctx, pg, 1)
+		port = &s.ports[len(s.ports)-1]
+
+		if pg.Config.Type != string(types.DistributedVirtualPortgroupPortgroupTypeEphemeral) {
+			ctx.Map.Update(pg, []types.PropertyChange{
+				{Name: "config.numPorts", Val: int32(len(pg.PortKeys))},
+			})
+		}
+	}
+
+	port.Connectee = &connectee
+
+	return port.Key, nil
+}
+
+// disconnectDVPort releases the port with the given key, if it is bound to connectee.
+func (s *DistributedVirtualSwitch) disconnectDVPort(key string, connectee types.DistributedVirtualSwitchPortConnectee) {
+	port := s.dvPort(key)
+	if port == nil || port.Connectee == nil {
+		return
+	}
+
+	if *port.Connectee.ConnectedEntity == *connectee.ConnectedEntity && port.Connectee.NicKey == connectee.NicKey {
+		port.Connectee = nil
+	}
+}
+
+// validateVlan validates the vlan of a portgroup's default port setting.
+func (s *DistributedVirtualSwitch) validateVlan(setting types.BaseDVPortSetting) types.BaseMethodFault {
+	vs, ok := setting.(*types.VMwareDVSPortSetting)
+	if !ok || vs.Vlan == nil {
+		return nil
+	}
+
+	invalid := &types.InvalidArgument{InvalidProperty: "defaultPortConfig.vlan"}
+
+	valid := func(id int32) bool {
+		return id >= 0 && id <= 4094
+	}
+
+	switch vlan := vs.Vlan.(type) {
+	case *types.VmwareDistributedVirtualSwitchVlanIdSpec:
+		if !valid(vlan.VlanId) {
+			return invalid
+		}
+	case *types.VmwareDistributedVirtualSwitchTrunkVlanSpec:
+		if len(vlan.VlanId) == 0 {
+			return invalid
+		}
+		for _, r := range vlan.VlanId {
+			if !valid(r.Start) || !valid(r.End) || r.Start > r.End {
+				return invalid
+			}
+		}
+	case *types.VmwareDistributedVirtualSwitchPvlanSpec:
+		if !valid(vlan.PvlanId) || vlan.PvlanId == 0 {
+			return invalid
+		}
+		if config, ok := s.Config.(*types.VMwareDVSConfigInfo); ok && len(config.PvlanConfig) != 0 {
+			found := slices.ContainsFunc(config.PvlanConfig, func(e types.VMwareDVSPvlanMapEntry) bool {
+				return e.SecondaryVlanId == vlan.PvlanId
+			})
+			if !found {
+				return invalid
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *DistributedVirtualSwitch) dvPorts(ctx *Context, criteria *types.DistributedVirtualSwitchPortCriteria) []types.DistributedVirtualPort {
 	res := s.FetchDVPortsResponse.Returnval
 	if len(res) != 0 {
 		return res
 	}
 
-	for _, ref := range s.Portgroup {
-		pg := Map.Get(ref).(*DistributedVirtualPortgroup)
+	for _, port := range s.ports {
+		pg, ok := ctx.Map.Get(types.ManagedObjectReference{Type: "DistributedVirtualPortgroup", Value: port.PortgroupKey}).(*DistributedVirtualPortgroup)
+		if !ok {
+			continue
+		}
 
-		for _, key := range pg.PortKeys {
-			res = append(res, types.DistributedVirtualPort{
-				DvsUuid:      s.Uuid,
-				Key:          key,
-				PortgroupKey: pg.Key,
-				Config: types.DVPortConfigInfo{
-					Setting: pg.Config.DefaultPortConfig,
-				},
-			})
+		port.Config = types.DVPortConfigInfo{
+			Setting: pg.Config.DefaultPortConfig,
+		}
+
+		if c := port.Connectee; c != nil {
+			c := *c
+			status := &types.DVPortStatus{}
+
+			if vm, ok := ctx.Map.Get(*c.ConnectedEntity).(*VirtualMachine); ok {
+				key, _ := strconv.Atoi(c.NicKey)
+				nic, ok := object.VirtualDeviceList(vm.Config.Hardware.Device).FindByKey(int32(key)).(types.BaseVirtualEthernetCard)
+				if ok {
+					card := nic.GetVirtualEthernetCard()
+					status.MacAddress = card.MacAddress
+					status.LinkUp = vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn &&
+						card.Connectable != nil && card.Connectable.Connected
+				}
+				c.AddressHint = status.MacAddress
+				port.ProxyHost = vm.Runtime.Host
+			}
+
+			port.Connectee = &c
+			port.State = &types.DVPortState{RuntimeInfo: status}
 		}
+
+		res = append(res, port)
 	}
 
 	// filter ports by criteria
@@ -345,6 +927,8 @@ func (s *DistributedVirtualSwitch) filterDVPorts(
 	ports = s.filterDVPortsByPortgroupKey(ports, criteria)
 	ports = s.filterDVPortsByPortKey(ports, criteria)
 	ports = s.filterDVPortsByConnected(ports, criteria)
+	ports = s.filterDVPortsByActive(ports, criteria)
+	ports = s.filterDVPortsByUplinkPort(ports, criteria)
 
 	return ports
 }
@@ -432,3 +1016,47 @@ func (s *DistributedVirtualSwitch) filterDVPortsByConnected(
 
 	return filtered
 }
+
+func (s *DistributedVirtualSwitch) filterDVPortsByActive(
+	ports []types.DistributedVirtualPort,
+	criteria *types.DistributedVirtualSwitchPortCriteria,
+) []types.DistributedVirtualPort {
+	if criteria.Active == nil {
+		return ports
+	}
+
+	filtered := []types.DistributedVirtualPort{}
+
+	for _, p := range ports {
+		active := p.State != nil && p.State.RuntimeInfo != nil && p.State.RuntimeInfo.LinkUp
+		if active == *criteria.Active {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered
+}
+
+func (s *DistributedVirtualSwitch) filterDVPortsByUplinkPort(
+	ports []types.DistributedVirtualPort,
+	criteria *types.DistributedVirtualSwitchPortCriteria,
+) []types.DistributedVirtualPort {
+	if criteria.UplinkPort == nil {
+		return ports
+	}
+
+	uplinks := s.Config.GetDVSConfigInfo().UplinkPortgroup
+
+	filtered := []types.DistributedVirtualPort{}
+
+	for _, p := range ports {
+		uplink := slices.ContainsFunc(uplinks, func(ref types.ManagedObjectReference) bool {
+			return ref.Value == p.PortgroupKey
+		})
+		if uplink == *criteria.UplinkPort {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered
+}
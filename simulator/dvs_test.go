@@ -24,6 +24,7 @@ import (
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -94,8 +95,9 @@ func TestDVS(t *testing.T) {
 	}{
 		{types.ConfigSpecOperationAdd, "", nil},                               // Add == OK
 		{types.ConfigSpecOperationAdd, "", &types.AlreadyExists{}},            // Add == fail (AlreadyExists)
-		{types.ConfigSpecOperationEdit, "", &types.NotSupported{}},            // Edit == fail (NotSupported)
+		{types.ConfigSpecOperationEdit, "", nil},                              // Edit == OK
 		{types.ConfigSpecOperationRemove, "", nil},                            // Remove == OK
+		{types.ConfigSpecOperationEdit, "", &types.NotFound{}},                // Edit == fail (NotFound)
 		{types.ConfigSpecOperationAdd, "", nil},                               // Add == OK
 		{types.ConfigSpecOperationAdd, "DVPG0", nil},                          // Add PG == OK
 		{types.ConfigSpecOperationRemove, "", &types.ResourceInUse{}},         // Remove dvs0 == fail (ResourceInUse)
@@ -194,6 +196,17 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 		t.Fatalf("expected 2 portgroups in DVS; got %d", len(pgs))
 	}
 
+	// pgs[0] is the uplink portgroup, pgs[1] has a port connected for each of the 4 VMs
+	uplink := []types.DistributedVirtualPort{
+		{PortgroupKey: pgs[0].Value, Key: "0"},
+	}
+	vms := []types.DistributedVirtualPort{
+		{PortgroupKey: pgs[1].Value, Key: "1"},
+		{PortgroupKey: pgs[1].Value, Key: "2"},
+		{PortgroupKey: pgs[1].Value, Key: "3"},
+		{PortgroupKey: pgs[1].Value, Key: "4"},
+	}
+
 	tests := []struct {
 		name     string
 		criteria *types.DistributedVirtualSwitchPortCriteria
@@ -202,10 +215,7 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 		{
 			"empty criteria",
 			&types.DistributedVirtualSwitchPortCriteria{},
-			[]types.DistributedVirtualPort{
-				{PortgroupKey: pgs[0].Value, Key: "0"},
-				{PortgroupKey: pgs[1].Value, Key: "0"},
-			},
+			append(uplink, vms...),
 		},
 		{
 			"inside PortgroupKeys",
@@ -213,9 +223,7 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 				PortgroupKey: []string{pgs[0].Value},
 				Inside:       types.NewBool(true),
 			},
-			[]types.DistributedVirtualPort{
-				{PortgroupKey: pgs[0].Value, Key: "0"},
-			},
+			uplink,
 		},
 		{
 			"outside PortgroupKeys",
@@ -223,15 +231,20 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 				PortgroupKey: []string{pgs[0].Value},
 				Inside:       types.NewBool(false),
 			},
-			[]types.DistributedVirtualPort{
-				{PortgroupKey: pgs[1].Value, Key: "0"},
-			},
+			vms,
 		},
 		{
 			"PortKeys",
 			&types.DistributedVirtualSwitchPortCriteria{
 				PortKey: []string{"1"},
 			},
+			vms[:1],
+		},
+		{
+			"PortKeys not found",
+			&types.DistributedVirtualSwitchPortCriteria{
+				PortKey: []string{"5"},
+			},
 			[]types.DistributedVirtualPort{},
 		},
 		{
@@ -239,17 +252,35 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 			&types.DistributedVirtualSwitchPortCriteria{
 				Connected: types.NewBool(true),
 			},
-			[]types.DistributedVirtualPort{},
+			vms,
 		},
 		{
 			"not connected",
 			&types.DistributedVirtualSwitchPortCriteria{
 				Connected: types.NewBool(false),
 			},
-			[]types.DistributedVirtualPort{
-				{PortgroupKey: pgs[0].Value, Key: "0"},
-				{PortgroupKey: pgs[1].Value, Key: "0"},
+			uplink,
+		},
+		{
+			"active",
+			&types.DistributedVirtualSwitchPortCriteria{
+				Active: types.NewBool(true),
+			},
+			vms,
+		},
+		{
+			"uplink",
+			&types.DistributedVirtualSwitchPortCriteria{
+				UplinkPort: types.NewBool(true),
 			},
+			uplink,
+		},
+		{
+			"not uplink",
+			&types.DistributedVirtualSwitchPortCriteria{
+				UplinkPort: types.NewBool(false),
+			},
+			vms,
 		},
 	}
 
@@ -279,3 +310,156 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 		})
 	}
 }
+
+func TestDVPortAllocation(t *testing.T) {
+	m := VPX()
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		vswitch := Map.Any("DistributedVirtualSwitch").(*DistributedVirtualSwitch)
+		dvs := object.NewDistributedVirtualSwitch(c, vswitch.Reference())
+
+		task, err := dvs.AddPortgroup(ctx, []types.DVPortgroupConfigSpec{{
+			Name:     "static",
+			Type:     string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding),
+			NumPorts: 1,
+		}})
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		pg := object.NewDistributedVirtualPortgroup(c, Map.FindByName("static", vswitch.Portgroup).Reference())
+		backing, err := pg.EthernetCardBackingInfo(ctx)
+		if err != nil {
+			return err
+		}
+		key := pg.Reference().Value
+
+		var vms []*object.VirtualMachine
+		for _, obj := range Map.All("VirtualMachine") {
+			vms = append(vms, object.NewVirtualMachine(c, obj.Reference()))
+		}
+
+		addNIC := func(vm *object.VirtualMachine, backing types.BaseVirtualDeviceBackingInfo) error {
+			nic, err := object.EthernetCardTypes().CreateEthernetCard("vmxnet3", backing)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return vm.AddDevice(ctx, nic)
+		}
+
+		ports := func(criteria types.DistributedVirtualSwitchPortCriteria) []types.DistributedVirtualPort {
+			criteria.PortgroupKey = []string{key}
+			criteria.Inside = types.NewBool(true)
+			res, err := dvs.FetchDVPorts(ctx, &criteria)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return res
+		}
+
+		reconfigure := func(spec types.DVPortgroupConfigSpec) error {
+			task, err := pg.Reconfigure(ctx, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return task.Wait(ctx)
+		}
+
+		if err = addNIC(vms[0], backing); err != nil {
+			return err
+		}
+
+		connected := ports(types.DistributedVirtualSwitchPortCriteria{Connected: types.NewBool(true)})
+		if len(connected) != 1 {
+			t.Fatalf("connected=%d", len(connected))
+		}
+		port := connected[0]
+		if port.Connectee.ConnectedEntity.Value != vms[0].Reference().Value || port.Connectee.NicKey == "" {
+			t.Errorf("connectee=%#v", port.Connectee)
+		}
+
+		devices, err := vms[0].Device(ctx)
+		if err != nil {
+			return err
+		}
+		nic := devices.SelectByBackingInfo(backing)[0].(types.BaseVirtualEthernetCard).GetVirtualEthernetCard()
+		if nic.Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo).Port.PortKey != port.Key {
+			t.Errorf("nic backing port=%#v", nic.Backing)
+		}
+
+		if err = addNIC(vms[1], backing); err == nil {
+			t.Error("expected error") // no free ports
+		}
+
+		if err = reconfigure(types.DVPortgroupConfigSpec{NumPorts: 2}); err != nil {
+			return err
+		}
+		if err = addNIC(vms[1], backing); err != nil {
+			return err
+		}
+
+		if err = reconfigure(types.DVPortgroupConfigSpec{NumPorts: 1}); err == nil {
+			t.Error("expected error") // ports in use
+		}
+
+		vlan := &types.VMwareDVSPortSetting{Vlan: &types.VmwareDistributedVirtualSwitchVlanIdSpec{VlanId: 4095}}
+		if err = reconfigure(types.DVPortgroupConfigSpec{NumPorts: 2, DefaultPortConfig: vlan}); err == nil {
+			t.Error("expected error") // invalid vlan
+		}
+
+		trunk := &types.VMwareDVSPortSetting{Vlan: &types.VmwareDistributedVirtualSwitchTrunkVlanSpec{
+			VlanId: []types.NumericRange{{Start: 100, End: 10}},
+		}}
+		if err = reconfigure(types.DVPortgroupConfigSpec{NumPorts: 2, DefaultPortConfig: trunk}); err == nil {
+			t.Error("expected error") // invalid range
+		}
+
+		// a port can only be connected to one nic
+		inuse := *backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo)
+		inuse.Port.PortKey = port.Key
+		if err = addNIC(vms[2], &inuse); err == nil {
+			t.Error("expected error")
+		}
+
+		task, err = pg.Destroy(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err == nil {
+			t.Error("expected error") // ports in use
+		}
+
+		for _, vm := range vms[:2] {
+			devices, err := vm.Device(ctx)
+			if err != nil {
+				return err
+			}
+			if err = vm.RemoveDevice(ctx, false, devices.SelectByBackingInfo(backing)...); err != nil {
+				return err
+			}
+		}
+
+		if n := len(ports(types.DistributedVirtualSwitchPortCriteria{Connected: types.NewBool(true)})); n != 0 {
+			t.Errorf("connected=%d", n)
+		}
+
+		if err = reconfigure(types.DVPortgroupConfigSpec{NumPorts: 1}); err != nil {
+			return err
+		}
+		if n := len(ports(types.DistributedVirtualSwitchPortCriteria{})); n != 1 {
+			t.Errorf("ports=%d", n)
+		}
+
+		task, err = pg.Destroy(ctx)
+		if err != nil {
+			return err
+		}
+		return task.Wait(ctx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
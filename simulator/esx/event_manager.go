@@ -99,7 +99,13 @@ var EventInfo = []types.EventDescriptionEventDetail{
 		Key:         "VmBeingMigratedEvent",
 		Description: "VM migrating",
 		Category:    "info",
-		FullFormat:  "Relocating {{.Vm.Name}} from {{.Host.Name}, {{.Ds.Name}} in {{.Datacenter.Name}} to {{.DestHost.Name}, {{.DestDatastore.Name}} in {{.DestDatacenter.Name}}",
+		FullFormat:  "Migrating {{.Vm.Name}} from {{.Host.Name}}, {{.Ds.Name}} in {{.Datacenter.Name}} to {{.DestHost.Name}}, {{.DestDatastore.Name}} in {{.DestDatacenter.Name}}",
+	},
+	{
+		Key:         "VmBeingRelocatedEvent",
+		Description: "VM relocating",
+		Category:    "info",
+		FullFormat:  "Relocating {{.Vm.Name}} from {{.Host.Name}}, {{.Ds.Name}} in {{.Datacenter.Name}} to {{.DestHost.Name}}, {{.DestDatastore.Name}} in {{.DestDatacenter.Name}}",
 	},
 	{
 		Key:         "VmMacAssignedEvent",
@@ -231,7 +237,7 @@ var EventInfo = []types.EventDescriptionEventDetail{
 		Key:         "VmRelocatedEvent",
 		Description: "VM relocated",
 		Category:    "info",
-		FullFormat:  "Completed the relocation of the virtual machine",
+		FullFormat:  "{{.Vm.Name}} on {{.Host.Name}}, {{.Ds.Name}} in {{.Datacenter.Name}} was relocated from {{.SourceHost.Name}}, {{.SourceDatastore.Name}} in {{.SourceDatacenter.Name}}",
 	},
 	{
 		Key:         "CustomizationFailed",
@@ -251,6 +257,30 @@ var EventInfo = []types.EventDescriptionEventDetail{
 		Category:    "info",
 		FullFormat:  "Customization of VM {{.Vm.Name}} succeeded",
 	},
+	{
+		Key:         "HostConnectionLostEvent",
+		Description: "Host connection lost",
+		Category:    "error",
+		FullFormat:  "Host {{.Host.Name}} in {{.Datacenter.Name}} is not responding",
+	},
+	{
+		Key:         "HostConnectedEvent",
+		Description: "Host connected",
+		Category:    "info",
+		FullFormat:  "Connected to {{.Host.Name}} in {{.Datacenter.Name}}",
+	},
+	{
+		Key:         "DasHostFailedEvent",
+		Description: "vSphere HA host failed",
+		Category:    "error",
+		FullFormat:  "A possible host failure has been detected by vSphere HA on {{.FailedHost.Name}} in cluster {{.ComputeResource.Name}} in {{.Datacenter.Name}}",
+	},
+	{
+		Key:         "VmRestartedOnAlternateHostEvent",
+		Description: "VM restarted on alternate host",
+		Category:    "info",
+		FullFormat:  "Virtual machine {{.Vm.Name}} was restarted on {{.Host.Name}} since {{.SourceHost.Name}} failed",
+	},
 	{
 		Key:         "DrsVmMigratedEvent",
 		Description: "DRS VM migrated",
@@ -304,5 +334,34 @@ var EventInfo = []types.EventDescriptionEventDetail{
 		Description: "dvPort group deleted",
 		Category:    "info",
 		FullFormat:  "dvPort group {{.Net.Name}} in {{.Datacenter.Name}} was deleted.",
+	}, {
+		Key:         "AlarmCreatedEvent",
+		Description: "Alarm created",
+		Category:    "info",
+		FullFormat:  "Created alarm '{{.Alarm.Name}}' on {{.Entity.Name}}",
+	},
+	{
+		Key:         "AlarmRemovedEvent",
+		Description: "Alarm removed",
+		Category:    "info",
+		FullFormat:  "Removed alarm '{{.Alarm.Name}}' on {{.Entity.Name}}",
+	},
+	{
+		Key:         "AlarmStatusChangedEvent",
+		Description: "Alarm status changed",
+		Category:    "info",
+		FullFormat:  "Alarm '{{.Alarm.Name}}' on {{.Source.Name}} changed from {{.From}} to {{.To}}",
+	},
+	{
+		Key:         "AlarmAcknowledgedEvent",
+		Description: "Alarm acknowledged",
+		Category:    "info",
+		FullFormat:  "Acknowledged alarm '{{.Alarm.Name}}' on {{.Source.Name}}",
+	},
+	{
+		Key:         "AlarmClearedEvent",
+		Description: "Alarm cleared",
+		Category:    "info",
+		FullFormat:  "Manually cleared alarm '{{.Alarm.Name}}' on {{.Source.Name}} from {{.From}}",
 	},
 }
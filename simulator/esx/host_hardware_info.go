@@ -76,7 +76,7 @@ var HostHardwareInfo = &types.HostHardwareInfo{
 	},
 	CpuPowerManagementInfo: &types.HostCpuPowerManagementInfo{
 		CurrentPolicy:   "Balanced",
-		HardwareSupport: "",
+		HardwareSupport: "ACPI P-states, ACPI C-states",
 	},
 	CpuInfo: types.HostCpuInfo{
 		NumCpuPackages: 2,
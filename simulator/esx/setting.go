@@ -26,11 +26,35 @@ var HardwareVersion = "vmx-13"
 //
 //	govc object.collect -s -dump $(govc object.collect -s HostSystem:ha-host configManager.advancedOption) setting
 var AdvancedOptions = []types.BaseOptionValue{
-	// This list is currently pruned to include a single option for testing
+	// This list is currently pruned to include a few options for testing
 	&types.OptionValue{
 		Key:   "Config.HostAgent.log.level",
 		Value: "info",
 	},
+	&types.OptionValue{
+		Key:   "DCUI.Access",
+		Value: "root",
+	},
+	&types.OptionValue{
+		Key:   "Security.AccountLockFailures",
+		Value: int64(5),
+	},
+	&types.OptionValue{
+		Key:   "Security.AccountUnlockTime",
+		Value: int64(900),
+	},
+	&types.OptionValue{
+		Key:   "UserVars.DcuiTimeOut",
+		Value: int64(600),
+	},
+	&types.OptionValue{
+		Key:   "UserVars.ESXiShellInteractiveTimeOut",
+		Value: int64(0),
+	},
+	&types.OptionValue{
+		Key:   "UserVars.ESXiShellTimeOut",
+		Value: int64(0),
+	},
 }
 
 // Setting is captured from ESX's HostSystem.ServiceContent.setting
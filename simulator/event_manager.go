@@ -21,8 +21,8 @@ import (
 	"container/list"
 	"log"
 	"reflect"
+	"slices"
 	"text/template"
-	"time"
 
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -149,7 +149,7 @@ func (m *EventManager) PostEvent(ctx *Context, req *types.PostEvent) soap.HasFau
 	event := req.EventToPost.GetEvent()
 	event.Key = m.key
 	event.ChainId = event.Key
-	event.CreatedTime = time.Now()
+	event.CreatedTime = ctx.Map.Now()
 	event.UserName = ctx.Session.UserName
 
 	m.formatMessage(req.EventToPost)
@@ -175,6 +175,31 @@ func (m *EventManager) PostEvent(ctx *Context, req *types.PostEvent) soap.HasFau
 	}
 }
 
+func (m *EventManager) LogUserEvent(ctx *Context, req *types.LogUserEvent) soap.HasFault {
+	body := new(methods.LogUserEventBody)
+
+	me, ok := ctx.Map.Get(req.Entity).(mo.Entity)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Entity})
+		return body
+	}
+
+	arg := entityEventArgument(ctx, req.Entity)
+	event := &types.GeneralUserEvent{
+		GeneralEvent: types.GeneralEvent{
+			Event:   entityEvent(ctx, me),
+			Message: req.Msg,
+		},
+		Entity: &arg,
+	}
+	event.FullFormattedMessage = "User logged event: " + req.Msg
+
+	m.PostEvent(ctx, &types.PostEvent{EventToPost: event})
+
+	body.Res = new(types.LogUserEventResponse)
+	return body
+}
+
 type EventHistoryCollector struct {
 	mo.EventHistoryCollector
 
@@ -300,14 +325,15 @@ func (c *EventHistoryCollector) chainMatches(_ *Context, event types.BaseEvent,
 	return true
 }
 
-// typeMatches returns true if one of the spec EventTypeId types matches the event.
+// typeMatches returns true if one of the spec EventTypeId or deprecated Type types matches the event.
 func (c *EventHistoryCollector) typeMatches(_ *Context, event types.BaseEvent, spec *types.EventFilterSpec) bool {
-	if len(spec.EventTypeId) == 0 {
+	ids := append(slices.Clip(spec.EventTypeId), spec.Type...)
+	if len(ids) == 0 {
 		return true
 	}
 
 	matches := func(name string) bool {
-		for _, id := range spec.EventTypeId {
+		for _, id := range ids {
 			if id == name {
 				return true
 			}
@@ -354,6 +380,83 @@ func (c *EventHistoryCollector) timeMatches(_ *Context, event types.BaseEvent, s
 	return true
 }
 
+// userMatches returns true if the spec UserName filter matches the event.
+func (c *EventHistoryCollector) userMatches(_ *Context, event types.BaseEvent, spec *types.EventFilterSpec) bool {
+	if spec.UserName == nil {
+		return true
+	}
+
+	name := event.GetEvent().UserName
+	if name == "" {
+		return spec.UserName.SystemUser
+	}
+
+	if len(spec.UserName.UserList) == 0 {
+		return true // all regular user events
+	}
+
+	return slices.Contains(spec.UserName.UserList, name)
+}
+
+// categoryMatches returns true if one of the spec Category values matches the event's category,
+// as defined by the EventManager description or the EventEx severity.
+func (c *EventHistoryCollector) categoryMatches(ctx *Context, event types.BaseEvent, spec *types.EventFilterSpec) bool {
+	if len(spec.Category) == 0 {
+		return true
+	}
+
+	var category string
+
+	if x, ok := event.(*types.EventEx); ok {
+		category = x.Severity
+	} else {
+		id := reflect.ValueOf(event).Elem().Type().Name()
+		for _, info := range ctx.Map.EventManager().Description.EventInfo {
+			if info.Key == id {
+				category = info.Category
+				break
+			}
+		}
+	}
+
+	return slices.Contains(spec.Category, category)
+}
+
+// tagMatches returns true if one of the spec Tag values matches the event's ChangeTag.
+func (c *EventHistoryCollector) tagMatches(_ *Context, event types.BaseEvent, spec *types.EventFilterSpec) bool {
+	if len(spec.Tag) == 0 {
+		return true
+	}
+
+	return slices.Contains(spec.Tag, event.GetEvent().ChangeTag)
+}
+
+// alarmMatches returns true if the spec Alarm matches the alarm of an AlarmEvent.
+func (c *EventHistoryCollector) alarmMatches(_ *Context, event types.BaseEvent, spec *types.EventFilterSpec) bool {
+	if spec.Alarm == nil {
+		return true
+	}
+
+	if e, ok := event.(types.BaseAlarmEvent); ok {
+		return e.GetAlarmEvent().Alarm.Alarm == *spec.Alarm
+	}
+
+	return false
+}
+
+// scheduledTaskMatches returns true if the spec ScheduledTask matches the task of a ScheduledTaskEvent.
+func (c *EventHistoryCollector) scheduledTaskMatches(_ *Context, event types.BaseEvent, spec *types.EventFilterSpec) bool {
+	if spec.ScheduledTask == nil {
+		return true
+	}
+
+	if e, ok := event.(types.BaseScheduledTaskEvent); ok {
+		return e.GetScheduledTaskEvent().ScheduledTask.ScheduledTask == *spec.ScheduledTask
+	}
+
+	return false
+}
+
 // eventMatches returns true one of the filters matches the event.
 func (c *EventHistoryCollector) eventMatches(ctx *Context, event types.BaseEvent) bool {
 	spec := c.Filter.(types.EventFilterSpec)
@@ -363,7 +466,11 @@ func (c *EventHistoryCollector) eventMatches(ctx *Context, event types.BaseEvent
 		c.typeMatches,
 		c.timeMatches,
 		c.entityMatches,
-		// TODO: spec.UserName, etc
+		c.userMatches,
+		c.categoryMatches,
+		c.tagMatches,
+		c.alarmMatches,
+		c.scheduledTaskMatches,
 	}
 
 	for _, match := range matchers {
@@ -397,7 +504,7 @@ func (c *EventHistoryCollector) ReadNextEvents(ctx *Context, req *types.ReadNext
 	body.Res = new(types.ReadNextEventsResponse)
 
 	c.next(req.MaxCount, func(e *list.Element) {
-		body.Res.Returnval = append(body.Res.Returnval, e.Value.(types.BaseEvent))
+		body.Res.Returnval = append(body.Res.Returnval, c.event(e.Value))
 	})
 
 	return body
@@ -412,12 +519,26 @@ func (c *EventHistoryCollector) ReadPreviousEvents(ctx *Context, req *types.Read
 	body.Res = new(types.ReadPreviousEventsResponse)
 
 	c.prev(req.MaxCount, func(e *list.Element) {
-		body.Res.Returnval = append(body.Res.Returnval, e.Value.(types.BaseEvent))
+		body.Res.Returnval = append(body.Res.Returnval, c.event(e.Value))
 	})
 
 	return body
 }
 
+// event returns the given page item, copied without FullFormattedMessage if the Filter has DisableFullMessage set.
+func (c *EventHistoryCollector) event(item any) types.BaseEvent {
+	event := item.(types.BaseEvent)
+
+	if spec, ok := c.Filter.(types.EventFilterSpec); ok && isTrue(spec.DisableFullMessage) {
+		rval := reflect.New(reflect.ValueOf(event).Elem().Type())
+		rval.Elem().Set(reflect.ValueOf(event).Elem())
+		event = rval.Interface().(types.BaseEvent)
+		event.GetEvent().FullFormattedMessage = ""
+	}
+
+	return event
+}
+
 func (c *EventHistoryCollector) GetLatestPage() []types.BaseEvent {
 	var latestPage []types.BaseEvent
 
@@ -426,7 +547,7 @@ func (c *EventHistoryCollector) GetLatestPage() []types.BaseEvent {
 		if e == nil {
 			break
 		}
-		latestPage = append(latestPage, e.Value.(types.BaseEvent))
+		latestPage = append(latestPage, c.event(e.Value))
 		e = e.Prev()
 	}
 
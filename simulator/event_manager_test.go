@@ -23,6 +23,8 @@ import (
 
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -281,3 +283,99 @@ func TestEventManagerRead(t *testing.T) {
 		t.Errorf("expected %d events, got %d", nevents, count)
 	}
 }
+
+func TestEventManagerFilterSpec(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		em := event.NewManager(c)
+		user := DefaultLogin.Username()
+
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		tagged := &types.GeneralUserEvent{}
+		tagged.ChangeTag = "govmomi-test"
+		if err = em.PostEvent(ctx, tagged); err != nil {
+			t.Fatal(err)
+		}
+
+		query := func(spec types.EventFilterSpec) []types.BaseEvent {
+			collector, err := em.CreateCollectorForEvents(ctx, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer collector.Destroy(ctx)
+
+			var all []types.BaseEvent
+			for {
+				events, err := collector.ReadNextEvents(ctx, 3)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(events) == 0 {
+					return all
+				}
+				all = append(all, events...)
+			}
+		}
+
+		events := query(types.EventFilterSpec{UserName: &types.EventFilterSpecByUsername{UserList: []string{user}}})
+		if len(events) < 2 {
+			t.Errorf("user events=%d", len(events))
+		}
+		for _, e := range events {
+			if e.GetEvent().UserName != user {
+				t.Errorf("user=%s", e.GetEvent().UserName)
+			}
+		}
+
+		events = query(types.EventFilterSpec{UserName: &types.EventFilterSpecByUsername{UserList: []string{"enoent"}}})
+		if len(events) != 0 {
+			t.Errorf("enoent user events=%d", len(events))
+		}
+
+		events = query(types.EventFilterSpec{Tag: []string{tagged.ChangeTag}})
+		if len(events) != 1 || events[0].GetEvent().ChangeTag != tagged.ChangeTag {
+			t.Errorf("tagged events=%d", len(events))
+		}
+
+		events = query(types.EventFilterSpec{Type: []string{"VmPoweredOffEvent"}, Category: []string{"info"}})
+		if len(events) == 0 {
+			t.Error("no info events")
+		}
+		for _, e := range events {
+			category, err := em.EventCategory(ctx, e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := e.(*types.VmPoweredOffEvent); !ok || category != "info" {
+				t.Errorf("%T category=%s", e, category)
+			}
+		}
+
+		events = query(types.EventFilterSpec{Category: []string{"enoent"}})
+		if len(events) != 0 {
+			t.Errorf("enoent category events=%d", len(events))
+		}
+
+		events = query(types.EventFilterSpec{DisableFullMessage: types.NewBool(true)})
+		if len(events) == 0 {
+			t.Error("no events")
+		}
+		for _, e := range events {
+			if e.GetEvent().FullFormattedMessage != "" {
+				t.Errorf("message=%s", e.GetEvent().FullFormattedMessage)
+			}
+		}
+
+		events = query(types.EventFilterSpec{Alarm: &types.ManagedObjectReference{Type: "Alarm", Value: "enoent"}})
+		if len(events) != 0 {
+			t.Errorf("alarm events=%d", len(events))
+		}
+	})
+}
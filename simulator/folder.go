@@ -257,7 +257,7 @@ func (f *Folder) CreateStoragePod(ctx *Context, c *types.CreateStoragePod) soap.
 
 		pod.Name = c.Name
 		pod.ChildType = []string{"Datastore"}
-		pod.Summary = new(types.StoragePodSummary)
+		pod.Summary = &types.StoragePodSummary{Name: c.Name}
 		pod.PodStorageDrsEntry = new(types.PodStorageDrsEntry)
 		pod.PodStorageDrsEntry.StorageDrsConfig.PodConfig.Enabled = true
 
@@ -283,6 +283,7 @@ func (p *StoragePod) MoveIntoFolderTask(ctx *Context, c *types.MoveIntoFolder_Ta
 			return nil, ftask.Info.Error.Fault
 		}
 		p.ChildEntity = append(p.ChildEntity, f.ChildEntity...)
+		p.updateSummary(ctx)
 		return nil, nil
 	})
 	return &methods.MoveIntoFolder_TaskBody{
@@ -292,6 +293,20 @@ func (p *StoragePod) MoveIntoFolderTask(ctx *Context, c *types.MoveIntoFolder_Ta
 	}
 }
 
+// updateSummary sets the pod's capacity and free space to the totals of its datastores.
+func (p *StoragePod) updateSummary(ctx *Context) {
+	summary := types.StoragePodSummary{Name: p.Name}
+
+	for _, ref := range p.ChildEntity {
+		if ds, ok := ctx.Map.Get(ref).(*Datastore); ok {
+			summary.Capacity += ds.Summary.Capacity
+			summary.FreeSpace += ds.Summary.FreeSpace
+		}
+	}
+
+	ctx.Map.Update(p, []types.PropertyChange{{Name: "summary", Val: &summary}})
+}
+
 func (f *Folder) CreateDatacenter(ctx *Context, c *types.CreateDatacenter) soap.HasFault {
 	r := &methods.CreateDatacenterBody{}
 
@@ -374,6 +389,12 @@ func (c *createVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 		config.Name = escapeSpecialCharacters(config.Name)
 	}
 
+	// the pool must have capacity for the VM's reservation, should it be powered on
+	err := admitReservation(c.ctx, c.req.Pool, allocationReservation(config.CpuAllocation, config.MemoryAllocation))
+	if err != nil {
+		return nil, err
+	}
+
 	vm, err := NewVirtualMachine(c.ctx, c.Folder.Self, &c.req.Config)
 	if err != nil {
 		return nil, err
@@ -653,13 +674,14 @@ func (f *Folder) CreateDVSTask(ctx *Context, req *types.CreateDVS_Task) soap.Has
 				ForwardingClass: "etherswitch",
 			}
 		}
+		configInfo.ProductInfo = *dvs.Summary.ProductInfo
 
 		ctx.postEvent(&types.DvsCreatedEvent{
 			DvsEvent: dvs.event(),
 			Parent:   folderEventArgument(&f.Folder),
 		})
 
-		dvs.AddDVPortgroupTask(ctx, &types.AddDVPortgroup_Task{
+		res := dvs.AddDVPortgroupTask(ctx, &types.AddDVPortgroup_Task{
 			Spec: []types.DVPortgroupConfigSpec{{
 				Name:     dvs.Name + "-DVUplinks" + strings.TrimPrefix(dvs.Self.Value, "dvs"),
 				Type:     string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding),
@@ -686,6 +708,9 @@ func (f *Folder) CreateDVSTask(ctx *Context, req *types.CreateDVS_Task) soap.Has
 			}},
 		})
 
+		ctx.Map.Get(res.(*methods.AddDVPortgroup_TaskBody).Res.Returnval).(*Task).Wait()
+		configInfo.UplinkPortgroup = dvs.Portgroup
+
 		return dvs.Reference(), nil
 	})
 
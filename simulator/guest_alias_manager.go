@@ -0,0 +1,286 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package simulator
+
+import (
+	"encoding/base64"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type GuestAliasManager struct {
+	mo.GuestAliasManager
+}
+
+// sameSubject returns true if a and b identify the same guest auth subject.
+func sameSubject(a, b types.BaseGuestAuthSubject) bool {
+	switch x := a.(type) {
+	case *types.GuestAuthNamedSubject:
+		y, ok := b.(*types.GuestAuthNamedSubject)
+		return ok && x.Name == y.Name
+	case *types.GuestAuthAnySubject:
+		_, ok := b.(*types.GuestAuthAnySubject)
+		return ok
+	}
+	return false
+}
+
+func validAlias(username, cert string) types.BaseMethodFault {
+	if username == "" {
+		return &types.InvalidArgument{InvalidProperty: "username"}
+	}
+	if _, err := base64.StdEncoding.DecodeString(cert); err != nil || cert == "" {
+		return &types.InvalidArgument{InvalidProperty: "base64Cert"}
+	}
+	return nil
+}
+
+func (g *memGuest) addAlias(req *types.AddGuestAlias) types.BaseMethodFault {
+	if fault := validAlias(req.Username, req.Base64Cert); fault != nil {
+		return fault
+	}
+	if req.AliasInfo.Subject == nil {
+		return &types.InvalidArgument{InvalidProperty: "aliasInfo.subject"}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.aliases == nil {
+		g.aliases = make(map[string][]types.GuestAliases)
+	}
+
+	aliases := g.aliases[req.Username]
+	i := -1
+	for j := range aliases {
+		if aliases[j].Base64Cert == req.Base64Cert {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		aliases = append(aliases, types.GuestAliases{Base64Cert: req.Base64Cert})
+		i = len(aliases) - 1
+	}
+
+	info := &aliases[i]
+	replaced := false
+	for j := range info.Aliases {
+		if sameSubject(info.Aliases[j].Subject, req.AliasInfo.Subject) {
+			info.Aliases[j] = req.AliasInfo
+			replaced = true
+		}
+	}
+	if !replaced {
+		info.Aliases = append(info.Aliases, req.AliasInfo)
+	}
+
+	g.aliases[req.Username] = aliases
+
+	if req.MapCert {
+		for i := range g.mapped {
+			m := &g.mapped[i]
+			if m.Base64Cert != req.Base64Cert {
+				continue
+			}
+			if m.Username != req.Username {
+				return new(types.GuestMultipleMappings)
+			}
+			for _, s := range m.Subjects {
+				if sameSubject(s, req.AliasInfo.Subject) {
+					return nil
+				}
+			}
+			m.Subjects = append(m.Subjects, req.AliasInfo.Subject)
+			return nil
+		}
+
+		g.mapped = append(g.mapped, types.GuestMappedAliases{
+			Base64Cert: req.Base64Cert,
+			Username:   req.Username,
+			Subjects:   []types.BaseGuestAuthSubject{req.AliasInfo.Subject},
+		})
+	}
+
+	return nil
+}
+
+// removeAlias removes the alias for the given subject, or all aliases for the cert if subject is nil.
+func (g *memGuest) removeAlias(username, cert string, subject types.BaseGuestAuthSubject) types.BaseMethodFault {
+	if fault := validAlias(username, cert); fault != nil {
+		return fault
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	found := false
+
+	var aliases []types.GuestAliases
+	for _, a := range g.aliases[username] {
+		if a.Base64Cert == cert {
+			var keep []types.GuestAuthAliasInfo
+			for _, info := range a.Aliases {
+				if subject == nil || sameSubject(info.Subject, subject) {
+					found = true
+					continue
+				}
+				keep = append(keep, info)
+			}
+			if len(keep) == 0 {
+				continue
+			}
+			a.Aliases = keep
+		}
+		aliases = append(aliases, a)
+	}
+
+	if !found {
+		return &types.InvalidArgument{InvalidProperty: "subject"}
+	}
+
+	g.aliases[username] = aliases
+
+	var mapped []types.GuestMappedAliases
+	for _, m := range g.mapped {
+		if m.Base64Cert == cert && m.Username == username {
+			var keep []types.BaseGuestAuthSubject
+			for _, s := range m.Subjects {
+				if subject == nil || sameSubject(s, subject) {
+					continue
+				}
+				keep = append(keep, s)
+			}
+			if len(keep) == 0 {
+				continue
+			}
+			m.Subjects = keep
+		}
+		mapped = append(mapped, m)
+	}
+	g.mapped = mapped
+
+	return nil
+}
+
+func (g *memGuest) listAliases(username string) []types.GuestAliases {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]types.GuestAliases(nil), g.aliases[username]...)
+}
+
+func (g *memGuest) listMappedAliases() []types.GuestMappedAliases {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]types.GuestMappedAliases(nil), g.mapped...)
+}
+
+func (m *GuestAliasManager) AddGuestAlias(ctx *Context, req *types.AddGuestAlias) soap.HasFault {
+	body := new(methods.AddGuestAliasBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		return g.addAlias(req)
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.AddGuestAliasResponse)
+	return body
+}
+
+func (m *GuestAliasManager) RemoveGuestAlias(ctx *Context, req *types.RemoveGuestAlias) soap.HasFault {
+	body := new(methods.RemoveGuestAliasBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		if req.Subject == nil {
+			return &types.InvalidArgument{InvalidProperty: "subject"}
+		}
+		return g.removeAlias(req.Username, req.Base64Cert, req.Subject)
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.RemoveGuestAliasResponse)
+	return body
+}
+
+func (m *GuestAliasManager) RemoveGuestAliasByCert(ctx *Context, req *types.RemoveGuestAliasByCert) soap.HasFault {
+	body := new(methods.RemoveGuestAliasByCertBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		return g.removeAlias(req.Username, req.Base64Cert, nil)
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.RemoveGuestAliasByCertResponse)
+	return body
+}
+
+func (m *GuestAliasManager) ListGuestAliases(ctx *Context, req *types.ListGuestAliases) soap.HasFault {
+	body := new(methods.ListGuestAliasesBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	var res []types.GuestAliases
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		res = g.listAliases(req.Username)
+		return nil
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.ListGuestAliasesResponse{Returnval: res}
+	return body
+}
+
+func (m *GuestAliasManager) ListGuestMappedAliases(ctx *Context, req *types.ListGuestMappedAliases) soap.HasFault {
+	body := new(methods.ListGuestMappedAliasesBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	var res []types.GuestMappedAliases
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		res = g.listMappedAliases()
+		return nil
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.ListGuestMappedAliasesResponse{Returnval: res}
+	return body
+}
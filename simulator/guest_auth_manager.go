@@ -0,0 +1,216 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type GuestAuthManager struct {
+	mo.GuestAuthManager
+}
+
+// samlAssertion is the subset of a SAML 2.0 assertion used to map a token to a guest user.
+type samlAssertion struct {
+	Subject struct {
+		NameID string `xml:"NameID"`
+	} `xml:"Subject"`
+	Conditions struct {
+		NotBefore    string `xml:"NotBefore,attr"`
+		NotOnOrAfter string `xml:"NotOnOrAfter,attr"`
+	} `xml:"Conditions"`
+	Certificate string `xml:"Signature>KeyInfo>X509Data>X509Certificate"`
+}
+
+// valid returns true if the assertion has a subject and is valid at the given time.
+func (a *samlAssertion) valid(now time.Time) bool {
+	if a.Subject.NameID == "" {
+		return false
+	}
+	if t, err := time.Parse(time.RFC3339Nano, a.Conditions.NotBefore); err == nil && now.Before(t) {
+		return false
+	}
+	if t, err := time.Parse(time.RFC3339Nano, a.Conditions.NotOnOrAfter); err == nil && !now.Before(t) {
+		return false
+	}
+	return true
+}
+
+// matches returns true if the assertion was issued for the given alias cert and subject.
+// Assertions without a signing certificate match any cert.
+func (a *samlAssertion) matches(cert string, subject types.BaseGuestAuthSubject) bool {
+	if a.Certificate != "" && strings.Join(strings.Fields(a.Certificate), "") != cert {
+		return false
+	}
+	return sameSubject(subject, &types.GuestAuthNamedSubject{Name: a.Subject.NameID}) ||
+		sameSubject(subject, &types.GuestAuthAnySubject{})
+}
+
+// guestUser returns the guest user the given credentials authenticate as.
+// NamePasswordAuthentication is accepted with any non-empty username and password.
+// SAMLTokenAuthentication and TicketedSessionAuthentication are validated
+// against the aliases and tickets of the in-memory guest.
+func (vm *VirtualMachine) guestUser(auth types.BaseGuestAuthentication) (string, types.BaseMethodFault) {
+	switch creds := auth.(type) {
+	case *types.NamePasswordAuthentication:
+		if creds.Username == "" || creds.Password == "" {
+			return "", new(types.InvalidGuestLogin)
+		}
+		return creds.Username, nil
+	case *types.SAMLTokenAuthentication, *types.TicketedSessionAuthentication:
+		if vm.guest != nil {
+			return vm.guest.login(auth)
+		}
+	}
+
+	return "", new(types.InvalidGuestLogin)
+}
+
+func (g *memGuest) login(auth types.BaseGuestAuthentication) (string, types.BaseMethodFault) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	switch creds := auth.(type) {
+	case *types.TicketedSessionAuthentication:
+		if user, ok := g.tickets[creds.Ticket]; ok {
+			return user, nil
+		}
+	case *types.SAMLTokenAuthentication:
+		var token samlAssertion
+		if err := xml.Unmarshal([]byte(creds.Token), &token); err != nil || !token.valid(g.now()) {
+			break
+		}
+
+		if creds.Username != "" {
+			for _, a := range g.aliases[creds.Username] {
+				for _, info := range a.Aliases {
+					if token.matches(a.Base64Cert, info.Subject) {
+						return creds.Username, nil
+					}
+				}
+			}
+			break
+		}
+
+		for _, m := range g.mapped {
+			for _, s := range m.Subjects {
+				if token.matches(m.Base64Cert, s) {
+					return m.Username, nil
+				}
+			}
+		}
+	}
+
+	return "", new(types.InvalidGuestLogin)
+}
+
+func (g *memGuest) acquireTicket(user string) string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.tickets == nil {
+		g.tickets = make(map[string]string)
+	}
+
+	ticket := uuid.New().String()
+	g.tickets[ticket] = user
+
+	return ticket
+}
+
+func (g *memGuest) releaseTicket(ticket string) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.tickets[ticket]; !ok {
+		return new(types.InvalidGuestLogin)
+	}
+	delete(g.tickets, ticket)
+
+	return nil
+}
+
+func (m *GuestAuthManager) AcquireCredentialsInGuest(ctx *Context, req *types.AcquireCredentialsInGuest) soap.HasFault {
+	body := new(methods.AcquireCredentialsInGuestBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	var ticket string
+	fault := vm.memGuestOnlyOp(ctx, req.RequestedAuth, func(g *memGuest) types.BaseMethodFault {
+		if _, ok := req.RequestedAuth.(*types.TicketedSessionAuthentication); ok {
+			return &types.InvalidArgument{InvalidProperty: "requestedAuth"}
+		}
+		user, fault := vm.guestUser(req.RequestedAuth)
+		if fault == nil {
+			ticket = g.acquireTicket(user)
+		}
+		return fault
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.AcquireCredentialsInGuestResponse{
+		Returnval: &types.TicketedSessionAuthentication{Ticket: ticket},
+	}
+	return body
+}
+
+func (m *GuestAuthManager) ReleaseCredentialsInGuest(ctx *Context, req *types.ReleaseCredentialsInGuest) soap.HasFault {
+	body := new(methods.ReleaseCredentialsInGuestBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		creds, ok := req.Auth.(*types.TicketedSessionAuthentication)
+		if !ok {
+			return &types.InvalidArgument{InvalidProperty: "auth"}
+		}
+		return g.releaseTicket(creds.Ticket)
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.ReleaseCredentialsInGuestResponse)
+	return body
+}
+
+func (m *GuestAuthManager) ValidateCredentialsInGuest(ctx *Context, req *types.ValidateCredentialsInGuest) soap.HasFault {
+	body := new(methods.ValidateCredentialsInGuestBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	if fault := validateGuestOperation(vm, req.Auth); fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.ValidateCredentialsInGuestResponse)
+	return body
+}
@@ -0,0 +1,688 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// memGuests maps memGuest.id to *memGuest, for use by ServeGuest
+var memGuests sync.Map
+
+// memGuest is an in-memory guest filesystem and process table,
+// backing the guest operations of VMs without a container (see ContainerBackingOptionKey),
+// such that guest operations clients can be tested hermetically.
+//
+// Programs started in the guest do not run, they exit immediately with code 0,
+// with the following exceptions based on the program's base name:
+//   - false: exits with code 1
+//   - sleep: runs for the number of seconds given as its argument, according to the simulator Clock
+//
+// Terminating a running process sets its exit code to 137 (SIGKILL).
+//
+// The guest also has an in-memory Windows registry and guest alias store,
+// and tickets acquired via GuestAuthManager (see VirtualMachine.guestUser).
+type memGuest struct {
+	id  string
+	now func() time.Time
+
+	mu      sync.Mutex
+	files   map[string]*memFile
+	pending map[string]*types.GuestPosixFileAttributes
+	procs   map[int64]*memProcess
+	pid     int64
+	seq     int
+
+	registry map[string]*memRegKey
+	aliases  map[string][]types.GuestAliases
+	mapped   []types.GuestMappedAliases
+	tickets  map[string]string
+}
+
+type memFile struct {
+	dir  bool
+	data []byte
+	attr types.GuestPosixFileAttributes
+}
+
+type memProcess struct {
+	info types.GuestProcessInfo
+	end  time.Time
+	exit int32
+}
+
+func newMemGuest(id string, now func() time.Time) *memGuest {
+	g := &memGuest{
+		id:      id,
+		now:     now,
+		files:   make(map[string]*memFile),
+		pending: make(map[string]*types.GuestPosixFileAttributes),
+		procs:   make(map[int64]*memProcess),
+		pid:     1000,
+	}
+
+	for _, dir := range []string{"/", "/root", "/tmp"} {
+		g.files[dir] = newMemFile(true, 0755, now())
+	}
+	g.files["/tmp"].attr.Permissions = 01777
+
+	memGuests.Store(id, g)
+
+	return g
+}
+
+func newMemFile(dir bool, mode int64, now time.Time) *memFile {
+	return &memFile{
+		dir: dir,
+		attr: types.GuestPosixFileAttributes{
+			GuestFileAttributes: types.GuestFileAttributes{
+				ModificationTime: types.NewTime(now),
+				AccessTime:       types.NewTime(now),
+			},
+			OwnerId:     new(int32),
+			GroupId:     new(int32),
+			Permissions: mode,
+		},
+	}
+}
+
+// memGuest returns the in-memory guest of a VM without a container backing,
+// after validating the VM state and guest credentials.
+func (vm *VirtualMachine) memGuest(ctx *Context, auth types.BaseGuestAuthentication) (*memGuest, types.BaseMethodFault) {
+	if fault := validateGuestOperation(vm, auth); fault != nil {
+		return nil, fault
+	}
+
+	if vm.guest == nil {
+		vm.guest = newMemGuest(vm.uid.String(), ctx.Map.Now)
+	}
+
+	return vm.guest, nil
+}
+
+// memGuestOp calls fn with the in-memory guest of a VM without a container backing.
+func (vm *VirtualMachine) memGuestOp(ctx *Context, auth types.BaseGuestAuthentication, fn func(*memGuest) types.BaseMethodFault) types.BaseMethodFault {
+	g, fault := vm.memGuest(ctx, auth)
+	if fault != nil {
+		return fault
+	}
+	return fn(g)
+}
+
+// memGuestOnlyOp calls fn with the in-memory guest, for operations not supported by container backed VMs.
+func (vm *VirtualMachine) memGuestOnlyOp(ctx *Context, auth types.BaseGuestAuthentication, fn func(*memGuest) types.BaseMethodFault) types.BaseMethodFault {
+	if vm.svm != nil {
+		return new(types.OperationNotSupportedByGuest)
+	}
+	return vm.memGuestOp(ctx, auth, fn)
+}
+
+// remove unregisters the guest from ServeGuest, when its VM is destroyed.
+func (g *memGuest) remove() {
+	if g != nil {
+		memGuests.Delete(g.id)
+	}
+}
+
+func guestPath(name string) string {
+	return path.Clean("/" + name)
+}
+
+// parent returns the parent directory of the given path, g.mu must be held.
+func (g *memGuest) parent(name string) (*memFile, types.BaseMethodFault) {
+	dir := path.Dir(name)
+	f, ok := g.files[dir]
+	if !ok {
+		return nil, &types.FileNotFound{FileFault: types.FileFault{File: dir}}
+	}
+	if !f.dir {
+		return nil, &types.NotADirectory{FileFault: types.FileFault{File: dir}}
+	}
+	return f, nil
+}
+
+// children returns the paths contained by dir, including all descendants if recursive, g.mu must be held.
+func (g *memGuest) children(dir string, recursive bool) []string {
+	var res []string
+	prefix := strings.TrimSuffix(dir, "/") + "/"
+
+	for name := range g.files {
+		if name == dir || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if recursive || !strings.Contains(name[len(prefix):], "/") {
+			res = append(res, name)
+		}
+	}
+
+	sort.Strings(res)
+	return res
+}
+
+func (g *memGuest) fileInfo(name string, f *memFile) types.GuestFileInfo {
+	attr := f.attr
+	info := types.GuestFileInfo{
+		Path:       name,
+		Type:       string(types.GuestFileTypeFile),
+		Size:       int64(len(f.data)),
+		Attributes: &attr,
+	}
+	if f.dir {
+		info.Type = string(types.GuestFileTypeDirectory)
+		info.Size = 4096
+	}
+	return info
+}
+
+func (g *memGuest) stat(name string) (*memFile, types.BaseMethodFault) {
+	f, ok := g.files[name]
+	if !ok {
+		return nil, &types.FileNotFound{FileFault: types.FileFault{File: name}}
+	}
+	return f, nil
+}
+
+func (g *memGuest) listFiles(req *types.ListFilesInGuest) (*types.GuestListFileInfo, types.BaseMethodFault) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	name := guestPath(req.FilePath)
+	f, fault := g.stat(name)
+	if fault != nil {
+		return nil, fault
+	}
+
+	names := []string{name}
+	if f.dir {
+		names = append(names, g.children(name, false)...)
+	}
+
+	res := new(types.GuestListFileInfo)
+
+	for _, file := range names {
+		if req.MatchPattern != "" {
+			if ok, _ := path.Match(req.MatchPattern, path.Base(file)); !ok {
+				continue
+			}
+		}
+		res.Files = append(res.Files, g.fileInfo(file, g.files[file]))
+	}
+
+	if int(req.Index) >= len(res.Files) {
+		res.Files = nil
+	} else {
+		res.Files = res.Files[req.Index:]
+	}
+	if req.MaxResults > 0 && len(res.Files) > int(req.MaxResults) {
+		res.Remaining = int32(len(res.Files)) - req.MaxResults
+		res.Files = res.Files[:req.MaxResults]
+	}
+
+	return res, nil
+}
+
+func (g *memGuest) mkdir(name string, parents bool) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+
+	name = guestPath(name)
+	if f, ok := g.files[name]; ok {
+		if parents && f.dir {
+			return nil
+		}
+		return &types.FileAlreadyExists{FileFault: types.FileFault{File: name}}
+	}
+
+	if parents {
+		var dirs []string
+		for dir := path.Dir(name); ; dir = path.Dir(dir) {
+			if f, ok := g.files[dir]; ok {
+				if !f.dir {
+					return &types.NotADirectory{FileFault: types.FileFault{File: dir}}
+				}
+				break
+			}
+			dirs = append(dirs, dir)
+		}
+		for _, dir := range dirs {
+			g.files[dir] = newMemFile(true, 0755, now)
+		}
+	} else if _, fault := g.parent(name); fault != nil {
+		return fault
+	}
+
+	g.files[name] = newMemFile(true, 0755, now)
+
+	return nil
+}
+
+func (g *memGuest) mktemp(req *types.CreateTemporaryFileInGuest, dir bool) (string, types.BaseMethodFault) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+
+	tmp := req.DirectoryPath
+	if tmp == "" {
+		tmp = "/tmp"
+	}
+	tmp = guestPath(tmp)
+
+	if f, fault := g.stat(tmp); fault != nil {
+		return "", fault
+	} else if !f.dir {
+		return "", &types.NotADirectory{FileFault: types.FileFault{File: tmp}}
+	}
+
+	var name string
+	for {
+		g.seq++
+		name = path.Join(tmp, fmt.Sprintf("%svcsim-%05d%s", req.Prefix, g.seq, req.Suffix))
+		if _, ok := g.files[name]; !ok {
+			break
+		}
+	}
+
+	if dir {
+		g.files[name] = newMemFile(true, 0700, now)
+	} else {
+		g.files[name] = newMemFile(false, 0600, now)
+	}
+
+	return name, nil
+}
+
+func (g *memGuest) deleteFile(name string) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	name = guestPath(name)
+	f, fault := g.stat(name)
+	if fault != nil {
+		return fault
+	}
+	if f.dir {
+		return &types.NotAFile{FileFault: types.FileFault{File: name}}
+	}
+
+	delete(g.files, name)
+
+	return nil
+}
+
+func (g *memGuest) deleteDirectory(name string, recursive bool) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	name = guestPath(name)
+	f, fault := g.stat(name)
+	if fault != nil {
+		return fault
+	}
+	if !f.dir {
+		return &types.NotADirectory{FileFault: types.FileFault{File: name}}
+	}
+	if name == "/" {
+		return &types.GuestPermissionDenied{}
+	}
+
+	children := g.children(name, true)
+	if len(children) != 0 && !recursive {
+		return &types.DirectoryNotEmpty{FileFault: types.FileFault{File: name}}
+	}
+
+	for _, child := range children {
+		delete(g.files, child)
+	}
+	delete(g.files, name)
+
+	return nil
+}
+
+func (g *memGuest) moveFile(src, dst string, overwrite bool) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	src = guestPath(src)
+	dst = guestPath(dst)
+
+	f, fault := g.stat(src)
+	if fault != nil {
+		return fault
+	}
+	if f.dir {
+		return &types.NotAFile{FileFault: types.FileFault{File: src}}
+	}
+
+	if d, ok := g.files[dst]; ok {
+		if d.dir {
+			return &types.NotAFile{FileFault: types.FileFault{File: dst}}
+		}
+		if !overwrite {
+			return &types.FileAlreadyExists{FileFault: types.FileFault{File: dst}}
+		}
+	}
+	if _, fault = g.parent(dst); fault != nil {
+		return fault
+	}
+
+	delete(g.files, src)
+	g.files[dst] = f
+
+	return nil
+}
+
+func (g *memGuest) moveDirectory(src, dst string) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	src = guestPath(src)
+	dst = guestPath(dst)
+
+	f, fault := g.stat(src)
+	if fault != nil {
+		return fault
+	}
+	if !f.dir {
+		return &types.NotADirectory{FileFault: types.FileFault{File: src}}
+	}
+	if _, ok := g.files[dst]; ok {
+		return &types.FileAlreadyExists{FileFault: types.FileFault{File: dst}}
+	}
+	if src == "/" || strings.HasPrefix(dst, src+"/") {
+		return &types.InvalidArgument{InvalidProperty: "dstDirectoryPath"}
+	}
+	if _, fault = g.parent(dst); fault != nil {
+		return fault
+	}
+
+	for _, child := range g.children(src, true) {
+		g.files[dst+strings.TrimPrefix(child, src)] = g.files[child]
+		delete(g.files, child)
+	}
+	delete(g.files, src)
+	g.files[dst] = f
+
+	return nil
+}
+
+func (g *memGuest) changeFileAttributes(name string, attr *types.GuestPosixFileAttributes) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	name = guestPath(name)
+	f, fault := g.stat(name)
+	if fault != nil {
+		return fault
+	}
+
+	f.setAttributes(attr)
+
+	return nil
+}
+
+// setAttributes applies the non-zero fields of attr to the file, the guest mu must be held.
+func (f *memFile) setAttributes(attr *types.GuestPosixFileAttributes) {
+	if attr == nil {
+		return
+	}
+
+	if attr.Permissions != 0 {
+		f.attr.Permissions = attr.Permissions
+	}
+	if attr.OwnerId != nil {
+		f.attr.OwnerId = types.NewInt32(*attr.OwnerId)
+	}
+	if attr.GroupId != nil {
+		f.attr.GroupId = types.NewInt32(*attr.GroupId)
+	}
+	if attr.ModificationTime != nil {
+		f.attr.ModificationTime = types.NewTime(*attr.ModificationTime)
+	}
+	if attr.AccessTime != nil {
+		f.attr.AccessTime = types.NewTime(*attr.AccessTime)
+	}
+}
+
+// transferTo validates the destination of a file transfer to the guest.
+func (g *memGuest) transferTo(req *types.InitiateFileTransferToGuest) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	name := guestPath(req.GuestFilePath)
+	if f, ok := g.files[name]; ok {
+		if f.dir {
+			return &types.NotAFile{FileFault: types.FileFault{File: name}}
+		}
+		if !req.Overwrite {
+			return &types.FileAlreadyExists{FileFault: types.FileFault{File: name}}
+		}
+	}
+
+	_, fault := g.parent(name)
+	if fault == nil {
+		// applied once the file has been uploaded
+		if attr, ok := req.FileAttributes.(*types.GuestPosixFileAttributes); ok {
+			g.pending[name] = attr
+		} else {
+			delete(g.pending, name)
+		}
+	}
+	return fault
+}
+
+// transferFrom validates the source of a file transfer from the guest.
+func (g *memGuest) transferFrom(name string) (*types.FileTransferInformation, types.BaseMethodFault) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	name = guestPath(name)
+	f, fault := g.stat(name)
+	if fault != nil {
+		return nil, fault
+	}
+	if f.dir {
+		return nil, &types.NotAFile{FileFault: types.FileFault{File: name}}
+	}
+
+	attr := f.attr
+	return &types.FileTransferInformation{
+		Attributes: &attr,
+		Size:       int64(len(f.data)),
+	}, nil
+}
+
+func (g *memGuest) upload(name string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	name = guestPath(name)
+	if _, fault := g.parent(name); fault != nil {
+		return fmt.Errorf("%s: %T", name, fault)
+	}
+
+	f, ok := g.files[name]
+	if !ok {
+		f = newMemFile(false, 0644, g.now())
+		g.files[name] = f
+	}
+	if f.dir {
+		return fmt.Errorf("%s: is a directory", name)
+	}
+	f.data = data
+	f.attr.ModificationTime = types.NewTime(g.now())
+	f.setAttributes(g.pending[name])
+	delete(g.pending, name)
+
+	return nil
+}
+
+func (g *memGuest) download(name string, w http.ResponseWriter) error {
+	g.mu.Lock()
+	f, ok := g.files[guestPath(name)]
+	var data []byte
+	if ok && !f.dir {
+		data = f.data
+	}
+	g.mu.Unlock()
+
+	if !ok || f.dir {
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	_, err := w.Write(data)
+	return err
+}
+
+func (g *memGuest) startProgram(spec *types.GuestProgramSpec, owner string) (int64, types.BaseMethodFault) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+
+	if spec.ProgramPath == "" {
+		return 0, &types.InvalidArgument{InvalidProperty: "programPath"}
+	}
+
+	if spec.WorkingDirectory != "" {
+		dir := guestPath(spec.WorkingDirectory)
+		if f, ok := g.files[dir]; !ok || !f.dir {
+			return 0, &types.FileNotFound{FileFault: types.FileFault{File: dir}}
+		}
+	}
+
+	g.pid++
+	p := &memProcess{
+		info: types.GuestProcessInfo{
+			Name:      path.Base(spec.ProgramPath),
+			Pid:       g.pid,
+			Owner:     owner,
+			CmdLine:   strings.TrimSpace(spec.ProgramPath + " " + spec.Arguments),
+			StartTime: now,
+		},
+		end: now,
+	}
+
+	switch p.info.Name {
+	case "false":
+		p.exit = 1
+	case "sleep":
+		secs, err := strconv.ParseFloat(strings.TrimSpace(spec.Arguments), 64)
+		if err != nil {
+			p.exit = 1
+		} else {
+			p.end = now.Add(time.Duration(secs * float64(time.Second)))
+		}
+	}
+
+	g.procs[p.info.Pid] = p
+
+	return p.info.Pid, nil
+}
+
+func (g *memGuest) listProcesses(pids []int64) []types.GuestProcessInfo {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+
+	if len(pids) == 0 {
+		for pid := range g.procs {
+			pids = append(pids, pid)
+		}
+		sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+	}
+
+	var res []types.GuestProcessInfo
+
+	for _, pid := range pids {
+		p, ok := g.procs[pid]
+		if !ok {
+			continue
+		}
+
+		info := p.info
+		if !now.Before(p.end) {
+			info.EndTime = types.NewTime(p.end)
+			info.ExitCode = p.exit
+		}
+
+		res = append(res, info)
+	}
+
+	return res
+}
+
+func (g *memGuest) terminateProcess(pid int64) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+
+	p, ok := g.procs[pid]
+	if !ok {
+		return &types.GuestProcessNotFound{Pid: pid}
+	}
+
+	if now.Before(p.end) {
+		p.end = now
+		p.exit = 137
+	}
+
+	return nil
+}
+
+// serveMemGuest handles in-memory guest file upload/download
+func serveMemGuest(g *memGuest, file string, w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	switch r.Method {
+	case http.MethodPut:
+		err = g.upload(file, r.Body)
+		_ = r.Body.Close()
+	case http.MethodGet:
+		err = g.download(file, w)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err != nil {
+		log.Printf("%s %s: %s", r.Method, r.URL, err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
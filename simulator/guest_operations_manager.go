@@ -57,6 +57,36 @@ func (m *GuestOperationsManager) init(r *Registry) {
 	pm.Self = *m.ProcessManager
 	pm.Manager = process.NewManager()
 	r.Put(pm)
+
+	rm := new(GuestWindowsRegistryManager)
+	if m.GuestWindowsRegistryManager == nil {
+		m.GuestWindowsRegistryManager = &types.ManagedObjectReference{
+			Type:  "GuestWindowsRegistryManager",
+			Value: "guestOperationsWindowsRegistryManager",
+		}
+	}
+	rm.Self = *m.GuestWindowsRegistryManager
+	r.Put(rm)
+
+	auth := new(GuestAuthManager)
+	if m.AuthManager == nil {
+		m.AuthManager = &types.ManagedObjectReference{
+			Type:  "GuestAuthManager",
+			Value: "guestOperationsAuthManager",
+		}
+	}
+	auth.Self = *m.AuthManager
+	r.Put(auth)
+
+	am := new(GuestAliasManager)
+	if m.AliasManager == nil {
+		m.AliasManager = &types.ManagedObjectReference{
+			Type:  "GuestAliasManager",
+			Value: "guestOperationsAliasManager",
+		}
+	}
+	am.Self = *m.AliasManager
+	r.Put(am)
 }
 
 type GuestFileManager struct {
@@ -64,12 +94,17 @@ type GuestFileManager struct {
 }
 
 func guestURL(ctx *Context, vm *VirtualMachine, path string) string {
+	id := vm.guest.id
+	if vm.svm != nil {
+		id = vm.svm.c.id
+	}
+
 	return (&url.URL{
 		Scheme: ctx.svc.Listen.Scheme,
 		Host:   "*", // See guest.FileManager.TransferURL
 		Path:   guestPrefix + strings.TrimPrefix(path, "/"),
 		RawQuery: url.Values{
-			"id":    []string{vm.svm.c.id},
+			"id":    []string{id},
 			"token": []string{ctx.Session.Key},
 		}.Encode(),
 	}).String()
@@ -79,7 +114,15 @@ func (m *GuestFileManager) InitiateFileTransferToGuest(ctx *Context, req *types.
 	body := new(methods.InitiateFileTransferToGuestBody)
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
-	err := vm.svm.prepareGuestOperation(req.Auth)
+	var err types.BaseMethodFault
+	if vm.svm == nil {
+		var g *memGuest
+		if g, err = vm.memGuest(ctx, req.Auth); err == nil {
+			err = g.transferTo(req)
+		}
+	} else {
+		err = vm.svm.prepareGuestOperation(req.Auth)
+	}
 	if err != nil {
 		body.Fault_ = Fault("", err)
 		return body
@@ -96,6 +139,21 @@ func (m *GuestFileManager) InitiateFileTransferFromGuest(ctx *Context, req *type
 	body := new(methods.InitiateFileTransferFromGuestBody)
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	if vm.svm == nil {
+		g, err := vm.memGuest(ctx, req.Auth)
+		if err == nil {
+			var info *types.FileTransferInformation
+			if info, err = g.transferFrom(req.GuestFilePath); err == nil {
+				info.Url = guestURL(ctx, vm, req.GuestFilePath)
+				body.Res = &types.InitiateFileTransferFromGuestResponse{Returnval: *info}
+				return body
+			}
+		}
+		body.Fault_ = Fault("", err)
+		return body
+	}
+
 	err := vm.svm.prepareGuestOperation(req.Auth)
 	if err != nil {
 		body.Fault_ = Fault("", err)
@@ -122,13 +180,27 @@ func (m *GuestProcessManager) StartProgramInGuest(ctx *Context, req *types.Start
 	body := new(methods.StartProgramInGuestBody)
 
 	spec := req.Spec.(*types.GuestProgramSpec)
-	auth := req.Auth.(*types.NamePasswordAuthentication)
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	fault := vm.svm.prepareGuestOperation(auth)
+	if vm.svm == nil {
+		g, fault := vm.memGuest(ctx, req.Auth)
+		if fault == nil {
+			user, _ := vm.guestUser(req.Auth)
+			var pid int64
+			if pid, fault = g.startProgram(spec, user); fault == nil {
+				body.Res = &types.StartProgramInGuestResponse{Returnval: pid}
+				return body
+			}
+		}
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	fault := vm.svm.prepareGuestOperation(req.Auth)
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
+		return body
 	}
 
 	args := []string{"exec"}
@@ -152,7 +224,7 @@ func (m *GuestProcessManager) StartProgramInGuest(ctx *Context, req *types.Start
 	}
 
 	proc := process.New()
-	proc.Owner = auth.Username
+	proc.Owner, _ = vm.guestUser(req.Auth)
 
 	pid, err := m.Start(start, proc)
 	if err != nil {
@@ -171,6 +243,17 @@ func (m *GuestProcessManager) ListProcessesInGuest(ctx *Context, req *types.List
 		Res: new(types.ListProcessesInGuestResponse),
 	}
 
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	if vm.svm == nil {
+		g, fault := vm.memGuest(ctx, req.Auth)
+		if fault != nil {
+			return &methods.ListProcessesInGuestBody{Fault_: Fault("", fault)}
+		}
+		body.Res.Returnval = g.listProcesses(req.Pids)
+		return body
+	}
+
 	procs := m.List(req.Pids)
 
 	for _, proc := range procs {
@@ -196,6 +279,21 @@ func (m *GuestProcessManager) ListProcessesInGuest(ctx *Context, req *types.List
 func (m *GuestProcessManager) TerminateProcessInGuest(ctx *Context, req *types.TerminateProcessInGuest) soap.HasFault {
 	body := new(methods.TerminateProcessInGuestBody)
 
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	if vm.svm == nil {
+		g, fault := vm.memGuest(ctx, req.Auth)
+		if fault == nil {
+			fault = g.terminateProcess(req.Pid)
+		}
+		if fault != nil {
+			body.Fault_ = Fault("", fault)
+		} else {
+			body.Res = new(types.TerminateProcessInGuestResponse)
+		}
+		return body
+	}
+
 	if m.Kill(req.Pid) {
 		body.Res = new(types.TerminateProcessInGuestResponse)
 	} else {
@@ -213,6 +311,14 @@ func (m *GuestFileManager) mktemp(ctx *Context, req *types.CreateTemporaryFileIn
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
+	if vm.svm == nil {
+		g, fault := vm.memGuest(ctx, req.Auth)
+		if fault != nil {
+			return "", fault
+		}
+		return g.mktemp(req, dir)
+	}
+
 	return vm.svm.exec(ctx, req.Auth, args)
 }
 
@@ -298,6 +404,19 @@ func (m *GuestFileManager) ListFilesInGuest(ctx *Context, req *types.ListFilesIn
 		return body
 	}
 
+	if vm.svm == nil {
+		g, fault := vm.memGuest(ctx, req.Auth)
+		if fault == nil {
+			var res *types.GuestListFileInfo
+			if res, fault = g.listFiles(req); fault == nil {
+				body.Res = &types.ListFilesInGuestResponse{Returnval: *res}
+				return body
+			}
+		}
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
 	res, fault := vm.svm.exec(ctx, req.Auth, listFiles(req))
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
@@ -317,7 +436,14 @@ func (m *GuestFileManager) DeleteFileInGuest(ctx *Context, req *types.DeleteFile
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.svm.exec(ctx, req.Auth, args)
+	var fault types.BaseMethodFault
+	if vm.svm == nil {
+		fault = vm.memGuestOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+			return g.deleteFile(req.FilePath)
+		})
+	} else {
+		_, fault = vm.svm.exec(ctx, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -338,7 +464,14 @@ func (m *GuestFileManager) DeleteDirectoryInGuest(ctx *Context, req *types.Delet
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.svm.exec(ctx, req.Auth, args)
+	var fault types.BaseMethodFault
+	if vm.svm == nil {
+		fault = vm.memGuestOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+			return g.deleteDirectory(req.DirectoryPath, req.Recursive)
+		})
+	} else {
+		_, fault = vm.svm.exec(ctx, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -359,7 +492,14 @@ func (m *GuestFileManager) MakeDirectoryInGuest(ctx *Context, req *types.MakeDir
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.svm.exec(ctx, req.Auth, args)
+	var fault types.BaseMethodFault
+	if vm.svm == nil {
+		fault = vm.memGuestOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+			return g.mkdir(req.DirectoryPath, req.CreateParentDirectories)
+		})
+	} else {
+		_, fault = vm.svm.exec(ctx, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -381,7 +521,14 @@ func (m *GuestFileManager) MoveFileInGuest(ctx *Context, req *types.MoveFileInGu
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.svm.exec(ctx, req.Auth, args)
+	var fault types.BaseMethodFault
+	if vm.svm == nil {
+		fault = vm.memGuestOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+			return g.moveFile(req.SrcFilePath, req.DstFilePath, req.Overwrite)
+		})
+	} else {
+		_, fault = vm.svm.exec(ctx, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -399,7 +546,14 @@ func (m *GuestFileManager) MoveDirectoryInGuest(ctx *Context, req *types.MoveDir
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.svm.exec(ctx, req.Auth, args)
+	var fault types.BaseMethodFault
+	if vm.svm == nil {
+		fault = vm.memGuestOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+			return g.moveDirectory(req.SrcDirectoryPath, req.DstDirectoryPath)
+		})
+	} else {
+		_, fault = vm.svm.exec(ctx, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -415,6 +569,22 @@ func (m *GuestFileManager) ChangeFileAttributesInGuest(ctx *Context, req *types.
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
+	if vm.svm == nil {
+		attr, ok := req.FileAttributes.(*types.GuestPosixFileAttributes)
+		if !ok && req.FileAttributes != nil {
+			attr = &types.GuestPosixFileAttributes{GuestFileAttributes: *req.FileAttributes.GetGuestFileAttributes()}
+		}
+		fault := vm.memGuestOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+			return g.changeFileAttributes(req.GuestFilePath, attr)
+		})
+		if fault != nil {
+			body.Fault_ = Fault("", fault)
+		} else {
+			body.Res = new(types.ChangeFileAttributesInGuestResponse)
+		}
+		return body
+	}
+
 	attr, ok := req.FileAttributes.(*types.GuestPosixFileAttributes)
 	if !ok {
 		body.Fault_ = Fault("", new(types.OperationNotSupportedByGuest))
@@ -17,11 +17,20 @@ limitations under the License.
 package simulator
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/guest"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -76,3 +85,514 @@ func TestFileInfo(t *testing.T) {
 		}
 	}
 }
+
+func TestGuestOperationsInMemory(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+
+	m := VPX()
+	m.Clock = clock
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+		auth := &types.NamePasswordAuthentication{Username: "user", Password: "pass"}
+
+		o := guest.NewOperationsManager(c, vm.Reference())
+		fm, err := o.FileManager(ctx)
+		if err != nil {
+			return err
+		}
+		pm, err := o.ProcessManager(ctx)
+		if err != nil {
+			return err
+		}
+
+		// file operations
+		if err = fm.MakeDirectory(ctx, auth, "/tmp/foo/bar", false); !fault.Is(err, &types.FileNotFound{}) {
+			t.Errorf("expected FileNotFound, got: %v", err)
+		}
+		if err = fm.MakeDirectory(ctx, auth, "/tmp/foo/bar", true); err != nil {
+			return err
+		}
+		if err = fm.DeleteDirectory(ctx, auth, "/tmp/foo", false); !fault.Is(err, &types.DirectoryNotEmpty{}) {
+			t.Errorf("expected DirectoryNotEmpty, got: %v", err)
+		}
+		if err = fm.DeleteDirectory(ctx, auth, "/tmp/foo", true); err != nil {
+			return err
+		}
+
+		tmp, err := fm.CreateTemporaryFile(ctx, auth, "test-", ".txt", "")
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(tmp, "/tmp/test-") || !strings.HasSuffix(tmp, ".txt") {
+			t.Errorf("tmp=%s", tmp)
+		}
+
+		ls, err := fm.ListFiles(ctx, auth, "/tmp", 0, 0, "test-*")
+		if err != nil {
+			return err
+		}
+		if len(ls.Files) != 1 || ls.Files[0].Path != tmp {
+			t.Errorf("files=%#v", ls.Files)
+		}
+		attr := ls.Files[0].Attributes.(*types.GuestPosixFileAttributes)
+		if attr.Permissions != 0600 {
+			t.Errorf("permissions=%o", attr.Permissions)
+		}
+
+		err = fm.ChangeFileAttributes(ctx, auth, tmp, &types.GuestPosixFileAttributes{Permissions: 0644})
+		if err != nil {
+			return err
+		}
+
+		content := "hello guest"
+		u, err := fm.InitiateFileTransferToGuest(ctx, auth, tmp, &types.GuestPosixFileAttributes{}, int64(len(content)), true)
+		if err != nil {
+			return err
+		}
+		dst, err := fm.TransferURL(ctx, u)
+		if err != nil {
+			return err
+		}
+		p := soap.DefaultUpload
+		p.ContentLength = int64(len(content))
+		if err = c.Client.Upload(ctx, strings.NewReader(content), dst, &p); err != nil {
+			return err
+		}
+
+		if err = fm.MoveFile(ctx, auth, tmp, "/root/file.txt", false); err != nil {
+			return err
+		}
+
+		info, err := fm.InitiateFileTransferFromGuest(ctx, auth, "/root/file.txt")
+		if err != nil {
+			return err
+		}
+		if info.Size != int64(len(content)) || info.Attributes.(*types.GuestPosixFileAttributes).Permissions != 0644 {
+			t.Errorf("info=%#v", info)
+		}
+		src, err := fm.TransferURL(ctx, info.Url)
+		if err != nil {
+			return err
+		}
+		f, _, err := c.Client.Download(ctx, src, &soap.DefaultDownload)
+		if err != nil {
+			return err
+		}
+		b, err := io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+		if string(b) != content {
+			t.Errorf("content=%q", b)
+		}
+
+		// file attributes are applied once uploaded
+		u, err = fm.InitiateFileTransferToGuest(ctx, auth, "/root/run.sh", &types.GuestPosixFileAttributes{Permissions: 0700}, int64(len(content)), false)
+		if err != nil {
+			return err
+		}
+		if dst, err = fm.TransferURL(ctx, u); err != nil {
+			return err
+		}
+		if err = c.Client.Upload(ctx, strings.NewReader(content), dst, &p); err != nil {
+			return err
+		}
+		ls, err = fm.ListFiles(ctx, auth, "/root/run.sh", 0, 0, "")
+		if err != nil {
+			return err
+		}
+		if perm := ls.Files[0].Attributes.(*types.GuestPosixFileAttributes).Permissions; perm != 0700 {
+			t.Errorf("permissions=%o", perm)
+		}
+
+		// download again using vCenter as a proxy
+		fm.Proxy = true
+		src, err = fm.TransferURL(ctx, info.Url)
+		if err != nil {
+			return err
+		}
+		if src.Host != c.URL().Host || !strings.HasPrefix(src.Path, "/hgw/host-") {
+			t.Errorf("src=%s", src)
+		}
+		f, _, err = c.Client.Download(ctx, src, &soap.DefaultDownload)
+		if err != nil {
+			return err
+		}
+		b, err = io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+		if string(b) != content {
+			t.Errorf("proxy content=%q", b)
+		}
+		fm.Proxy = false
+
+		if _, err = fm.InitiateFileTransferFromGuest(ctx, auth, tmp); !fault.Is(err, &types.FileNotFound{}) {
+			t.Errorf("expected FileNotFound, got: %v", err)
+		}
+		if err = fm.DeleteDirectory(ctx, auth, "/root/file.txt", false); !fault.Is(err, &types.NotADirectory{}) {
+			t.Errorf("expected NotADirectory, got: %v", err)
+		}
+		if err = fm.DeleteFile(ctx, auth, "/root/file.txt"); err != nil {
+			return err
+		}
+
+		// process operations
+		start := func(path, args string) int64 {
+			pid, err := pm.StartProgram(ctx, auth, &types.GuestProgramSpec{ProgramPath: path, Arguments: args})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return pid
+		}
+		process := func(pid int64) types.GuestProcessInfo {
+			procs, err := pm.ListProcesses(ctx, auth, []int64{pid})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(procs) != 1 {
+				t.Fatalf("procs=%#v", procs)
+			}
+			return procs[0]
+		}
+
+		pid := start("/bin/df", "-h")
+		if p := process(pid); p.EndTime == nil || p.ExitCode != 0 || p.CmdLine != "/bin/df -h" || p.Owner != auth.Username {
+			t.Errorf("process=%#v", p)
+		}
+
+		pid = start("/bin/false", "")
+		if p := process(pid); p.EndTime == nil || p.ExitCode != 1 {
+			t.Errorf("process=%#v", p)
+		}
+
+		pid = start("/bin/sleep", "60")
+		if p := process(pid); p.EndTime != nil {
+			t.Errorf("process=%#v", p)
+		}
+		clock.Advance(time.Minute)
+		if p := process(pid); p.EndTime == nil || p.ExitCode != 0 {
+			t.Errorf("process=%#v", p)
+		}
+
+		pid = start("/bin/sleep", "60")
+		if err = pm.TerminateProcess(ctx, auth, pid); err != nil {
+			return err
+		}
+		if p := process(pid); p.EndTime == nil || p.ExitCode != 137 {
+			t.Errorf("process=%#v", p)
+		}
+
+		if err = pm.TerminateProcess(ctx, auth, 1); !fault.Is(err, &types.GuestProcessNotFound{}) {
+			t.Errorf("expected GuestProcessNotFound, got: %v", err)
+		}
+
+		// invalid credentials and power state
+		if _, err = pm.ListProcesses(ctx, &types.NamePasswordAuthentication{}, nil); !fault.Is(err, &types.InvalidGuestLogin{}) {
+			t.Errorf("expected InvalidGuestLogin, got: %v", err)
+		}
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+		if _, err = pm.ListProcesses(ctx, auth, nil); !fault.Is(err, &types.InvalidPowerState{}) {
+			t.Errorf("expected InvalidPowerState, got: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGuestWindowsRegistryInMemory(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		vm := Map.Any("VirtualMachine").Reference()
+		auth := &types.NamePasswordAuthentication{Username: "user", Password: "pass"}
+
+		rm, err := guest.NewOperationsManager(c, vm).WindowsRegistryManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		key := func(path string) types.GuestRegKeyNameSpec {
+			return types.GuestRegKeyNameSpec{RegistryPath: path, WowBitness: string(types.GuestRegKeyWowSpecWOWNative)}
+		}
+		root := `HKEY_LOCAL_MACHINE\SOFTWARE`
+
+		if err = rm.CreateRegistryKey(ctx, auth, key(`HKEY_BOGUS\Foo`), false, ""); !fault.Is(err, &types.GuestRegistryKeyInvalid{}) {
+			t.Errorf("expected GuestRegistryKeyInvalid, got: %v", err)
+		}
+		if err = rm.CreateRegistryKey(ctx, auth, key(root+`\Acme\App`), false, ""); !fault.Is(err, &types.GuestRegistryKeyInvalid{}) {
+			t.Errorf("expected GuestRegistryKeyInvalid, got: %v", err)
+		}
+
+		for _, name := range []string{root, root + `\Acme`, root + `\Acme\App`, root + `\Acme\Tool`} {
+			if err = rm.CreateRegistryKey(ctx, auth, key(name), false, "class"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err = rm.CreateRegistryKey(ctx, auth, key(strings.ToLower(root)), false, ""); !fault.Is(err, &types.GuestRegistryKeyAlreadyExists{}) {
+			t.Errorf("expected GuestRegistryKeyAlreadyExists, got: %v", err)
+		}
+
+		if err = rm.CreateRegistryKey(ctx, auth, key(root+`\Volatile`), true, ""); err != nil {
+			t.Fatal(err)
+		}
+		if err = rm.CreateRegistryKey(ctx, auth, key(root+`\Volatile\Child`), false, ""); !fault.Is(err, &types.GuestRegistryKeyParentVolatile{}) {
+			t.Errorf("expected GuestRegistryKeyParentVolatile, got: %v", err)
+		}
+
+		keys, err := rm.ListRegistryKeys(ctx, auth, key(root), false, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) != 2 || keys[0].Key.KeyName.RegistryPath != root+`\Acme` {
+			t.Errorf("keys=%#v", keys)
+		}
+		keys, err = rm.ListRegistryKeys(ctx, auth, key(root), true, "^A")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) != 2 || keys[1].Key.KeyName.RegistryPath != root+`\Acme\App` || keys[1].Key.ClassType != "class" {
+			t.Errorf("keys=%#v", keys)
+		}
+
+		app := key(root + `\Acme\App`)
+		values := []types.GuestRegValueSpec{
+			{Name: types.GuestRegValueNameSpec{KeyName: app, Name: "Count"}, Data: &types.GuestRegValueDwordSpec{Value: 42}},
+			{Name: types.GuestRegValueNameSpec{KeyName: app, Name: "Path"}, Data: &types.GuestRegValueExpandStringSpec{Value: `%SystemRoot%\app\%NOPE%`}},
+		}
+		for _, val := range values {
+			if err = rm.SetRegistryValue(ctx, auth, val); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		vals, err := rm.ListRegistryValues(ctx, auth, app, true, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(vals) != 2 || vals[0].Data.(*types.GuestRegValueDwordSpec).Value != 42 {
+			t.Fatalf("values=%#v", vals)
+		}
+		if s := vals[1].Data.(*types.GuestRegValueExpandStringSpec).Value; s != `C:\Windows\app\%NOPE%` {
+			t.Errorf("expanded=%s", s)
+		}
+
+		name := types.GuestRegValueNameSpec{KeyName: app, Name: "count"}
+		if err = rm.DeleteRegistryValue(ctx, auth, name); err != nil {
+			t.Fatal(err)
+		}
+		if err = rm.DeleteRegistryValue(ctx, auth, name); !fault.Is(err, &types.GuestRegistryValueNotFound{}) {
+			t.Errorf("expected GuestRegistryValueNotFound, got: %v", err)
+		}
+
+		if err = rm.DeleteRegistryKey(ctx, auth, key(root+`\Acme`), false); !fault.Is(err, &types.GuestRegistryKeyHasSubkeys{}) {
+			t.Errorf("expected GuestRegistryKeyHasSubkeys, got: %v", err)
+		}
+		if err = rm.DeleteRegistryKey(ctx, auth, key(root+`\Acme`), true); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = rm.ListRegistryValues(ctx, auth, app, false, ""); !fault.Is(err, &types.GuestRegistryKeyInvalid{}) {
+			t.Errorf("expected GuestRegistryKeyInvalid, got: %v", err)
+		}
+		if err = rm.DeleteRegistryKey(ctx, auth, key("HKEY_USERS"), true); !fault.Is(err, &types.GuestRegistryKeyInvalid{}) {
+			t.Errorf("expected GuestRegistryKeyInvalid, got: %v", err)
+		}
+	})
+}
+
+func TestGuestAliasesInMemory(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		vm := Map.Any("VirtualMachine").Reference()
+		auth := &types.NamePasswordAuthentication{Username: "user", Password: "pass"}
+
+		am, err := guest.NewOperationsManager(c, vm).AliasManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cert := "Y2VydA=="
+		alice := &types.GuestAuthNamedSubject{Name: "alice@vsphere.local"}
+		anyone := &types.GuestAuthAnySubject{}
+
+		if err = am.AddAlias(ctx, auth, "root", false, "not base64!", types.GuestAuthAliasInfo{Subject: alice}); !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("expected InvalidArgument, got: %v", err)
+		}
+
+		if err = am.AddAlias(ctx, auth, "root", true, cert, types.GuestAuthAliasInfo{Subject: alice, Comment: "alice"}); err != nil {
+			t.Fatal(err)
+		}
+		if err = am.AddAlias(ctx, auth, "root", false, cert, types.GuestAuthAliasInfo{Subject: anyone}); err != nil {
+			t.Fatal(err)
+		}
+		if err = am.AddAlias(ctx, auth, "admin", true, cert, types.GuestAuthAliasInfo{Subject: alice}); !fault.Is(err, &types.GuestMultipleMappings{}) {
+			t.Errorf("expected GuestMultipleMappings, got: %v", err)
+		}
+
+		aliases, err := am.ListAliases(ctx, auth, "root")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(aliases) != 1 || aliases[0].Base64Cert != cert || len(aliases[0].Aliases) != 2 {
+			t.Fatalf("aliases=%#v", aliases)
+		}
+
+		mapped, err := am.ListMappedAliases(ctx, auth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(mapped) != 1 || mapped[0].Username != "root" || len(mapped[0].Subjects) != 1 {
+			t.Errorf("mapped=%#v", mapped)
+		}
+
+		if err = am.RemoveAlias(ctx, auth, "root", cert, alice); err != nil {
+			t.Fatal(err)
+		}
+		if err = am.RemoveAlias(ctx, auth, "root", cert, alice); !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("expected InvalidArgument, got: %v", err)
+		}
+		if mapped, err = am.ListMappedAliases(ctx, auth); err != nil || len(mapped) != 0 {
+			t.Errorf("mapped=%#v (%v)", mapped, err)
+		}
+
+		if err = am.RemoveAliasByCert(ctx, auth, "root", cert); err != nil {
+			t.Fatal(err)
+		}
+		if aliases, err = am.ListAliases(ctx, auth, "root"); err != nil || len(aliases) != 0 {
+			t.Errorf("aliases=%#v (%v)", aliases, err)
+		}
+	})
+}
+
+func TestGuestAuthInMemory(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		vm := Map.Any("VirtualMachine").Reference()
+		auth := &types.NamePasswordAuthentication{Username: "user", Password: "pass"}
+
+		om := guest.NewOperationsManager(c, vm)
+
+		m, err := om.AuthManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		am, err := om.AliasManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pm, err := om.ProcessManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		owner := func(auth types.BaseGuestAuthentication) string {
+			pid, err := pm.StartProgram(ctx, auth, &types.GuestProgramSpec{ProgramPath: "/bin/true"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			procs, err := pm.ListProcesses(ctx, auth, []int64{pid})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return procs[0].Owner
+		}
+
+		cert := "Y2VydA=="
+		token := func(subject, expires string) *types.SAMLTokenAuthentication {
+			return &types.SAMLTokenAuthentication{Token: fmt.Sprintf(`<saml2:Assertion xmlns:saml2="urn:oasis:names:tc:SAML:2.0:assertion" xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+  <ds:Signature><ds:KeyInfo><ds:X509Data><ds:X509Certificate>Y2Vy
+dA==</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature>
+  <saml2:Subject><saml2:NameID>%s</saml2:NameID></saml2:Subject>
+  <saml2:Conditions NotOnOrAfter="%s"/>
+</saml2:Assertion>`, subject, expires)}
+		}
+		expires := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+		// No aliases yet
+		saml := token("alice@vsphere.local", expires)
+		if err = m.ValidateCredentials(ctx, saml); !fault.Is(err, &types.InvalidGuestLogin{}) {
+			t.Errorf("expected InvalidGuestLogin, got: %v", err)
+		}
+
+		alice := &types.GuestAuthNamedSubject{Name: "alice@vsphere.local"}
+		if err = am.AddAlias(ctx, auth, "root", true, cert, types.GuestAuthAliasInfo{Subject: alice}); err != nil {
+			t.Fatal(err)
+		}
+
+		// Mapped alias
+		if err = m.ValidateCredentials(ctx, saml); err != nil {
+			t.Fatal(err)
+		}
+		if user := owner(saml); user != "root" {
+			t.Errorf("owner=%s", user)
+		}
+
+		// Explicit username
+		saml.Username = "root"
+		if err = m.ValidateCredentials(ctx, saml); err != nil {
+			t.Fatal(err)
+		}
+		saml.Username = "admin"
+		if err = m.ValidateCredentials(ctx, saml); !fault.Is(err, &types.InvalidGuestLogin{}) {
+			t.Errorf("expected InvalidGuestLogin, got: %v", err)
+		}
+
+		for _, invalid := range []*types.SAMLTokenAuthentication{
+			token("bob@vsphere.local", expires),
+			token("alice@vsphere.local", time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)),
+			{Token: "invalid"},
+		} {
+			if err = m.ValidateCredentials(ctx, invalid); !fault.Is(err, &types.InvalidGuestLogin{}) {
+				t.Errorf("expected InvalidGuestLogin, got: %v", err)
+			}
+		}
+
+		// Tickets
+		ticket, err := m.AcquireCredentials(ctx, token("alice@vsphere.local", expires), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := ticket.(*types.TicketedSessionAuthentication); !ok {
+			t.Fatalf("ticket=%#v", ticket)
+		}
+		if err = m.ValidateCredentials(ctx, ticket); err != nil {
+			t.Fatal(err)
+		}
+		if user := owner(ticket); user != "root" {
+			t.Errorf("owner=%s", user)
+		}
+
+		if _, err = m.AcquireCredentials(ctx, ticket, 0); !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("expected InvalidArgument, got: %v", err)
+		}
+
+		ticket, err = m.AcquireCredentials(ctx, auth, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user := owner(ticket); user != "user" {
+			t.Errorf("owner=%s", user)
+		}
+
+		if err = m.ReleaseCredentials(ctx, ticket); err != nil {
+			t.Fatal(err)
+		}
+		if err = m.ValidateCredentials(ctx, ticket); !fault.Is(err, &types.InvalidGuestLogin{}) {
+			t.Errorf("expected InvalidGuestLogin, got: %v", err)
+		}
+		if err = m.ReleaseCredentials(ctx, ticket); !fault.Is(err, &types.InvalidGuestLogin{}) {
+			t.Errorf("expected InvalidGuestLogin, got: %v", err)
+		}
+	})
+}
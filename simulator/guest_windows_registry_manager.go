@@ -0,0 +1,415 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package simulator
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type GuestWindowsRegistryManager struct {
+	mo.GuestWindowsRegistryManager
+}
+
+// registryHives are the predefined root keys of the guest registry.
+var registryHives = []string{
+	"HKEY_CLASSES_ROOT",
+	"HKEY_CURRENT_USER",
+	"HKEY_LOCAL_MACHINE",
+	"HKEY_USERS",
+	"HKEY_CURRENT_CONFIG",
+}
+
+// registryEnv is used to expand REG_EXPAND_SZ values.
+var registryEnv = map[string]string{
+	"systemdrive":  `C:`,
+	"systemroot":   `C:\Windows`,
+	"windir":       `C:\Windows`,
+	"programfiles": `C:\Program Files`,
+	"programdata":  `C:\ProgramData`,
+	"temp":         `C:\Windows\Temp`,
+	"tmp":          `C:\Windows\Temp`,
+}
+
+type memRegKey struct {
+	name     string
+	class    string
+	volatile bool
+	written  time.Time
+	values   map[string]types.GuestRegValueSpec
+}
+
+// regKeyPath returns the normalized registry path, used as the key of memGuest.registry,
+// as registry key names are case-insensitive.
+func regKeyPath(name string) string {
+	return strings.ToLower(strings.Trim(name, `\`))
+}
+
+func regKeyParent(name string) string {
+	if i := strings.LastIndex(name, `\`); i > 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+func (g *memGuest) initRegistry() {
+	if g.registry != nil {
+		return
+	}
+
+	g.registry = make(map[string]*memRegKey)
+	for _, hive := range registryHives {
+		g.registry[regKeyPath(hive)] = &memRegKey{
+			name:    hive,
+			written: g.now(),
+			values:  make(map[string]types.GuestRegValueSpec),
+		}
+	}
+}
+
+func (g *memGuest) regKey(name string) (*memRegKey, types.BaseMethodFault) {
+	g.initRegistry()
+
+	key, ok := g.registry[regKeyPath(name)]
+	if !ok {
+		return nil, &types.GuestRegistryKeyInvalid{GuestRegistryKeyFault: types.GuestRegistryKeyFault{KeyName: name}}
+	}
+
+	return key, nil
+}
+
+func (g *memGuest) regKeySpec(key *memRegKey, spec types.GuestRegKeyNameSpec) types.GuestRegKeySpec {
+	return types.GuestRegKeySpec{
+		KeyName: types.GuestRegKeyNameSpec{
+			RegistryPath: key.name,
+			WowBitness:   spec.WowBitness,
+		},
+		ClassType:   key.class,
+		LastWritten: key.written,
+	}
+}
+
+func (g *memGuest) createRegistryKey(req *types.CreateRegistryKeyInGuest) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	name := strings.Trim(req.KeyName.RegistryPath, `\`)
+
+	parent, fault := g.regKey(regKeyParent(name))
+	if fault != nil {
+		return &types.GuestRegistryKeyInvalid{GuestRegistryKeyFault: types.GuestRegistryKeyFault{KeyName: name}}
+	}
+
+	if _, ok := g.registry[regKeyPath(name)]; ok {
+		return &types.GuestRegistryKeyAlreadyExists{GuestRegistryKeyFault: types.GuestRegistryKeyFault{KeyName: name}}
+	}
+
+	if parent.volatile && !req.IsVolatile {
+		return &types.GuestRegistryKeyParentVolatile{GuestRegistryKeyFault: types.GuestRegistryKeyFault{KeyName: name}}
+	}
+
+	g.registry[regKeyPath(name)] = &memRegKey{
+		name:     name,
+		class:    req.ClassType,
+		volatile: req.IsVolatile,
+		written:  g.now(),
+		values:   make(map[string]types.GuestRegValueSpec),
+	}
+
+	return nil
+}
+
+// regSubkeys returns the sorted paths of the subkeys of the given key.
+func (g *memGuest) regSubkeys(name string, recursive bool) []string {
+	prefix := regKeyPath(name) + `\`
+
+	var keys []string
+
+	for path := range g.registry {
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		if recursive || !strings.Contains(path[len(prefix):], `\`) {
+			keys = append(keys, path)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (g *memGuest) listRegistryKeys(req *types.ListRegistryKeysInGuest) ([]types.GuestRegKeyRecordSpec, types.BaseMethodFault) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, fault := g.regKey(req.KeyName.RegistryPath); fault != nil {
+		return nil, fault
+	}
+
+	var match *regexp.Regexp
+	if req.MatchPattern != "" {
+		var err error
+		if match, err = regexp.Compile(req.MatchPattern); err != nil {
+			return nil, &types.InvalidArgument{InvalidProperty: "matchPattern"}
+		}
+	}
+
+	var res []types.GuestRegKeyRecordSpec
+
+	for _, path := range g.regSubkeys(req.KeyName.RegistryPath, req.Recursive) {
+		key := g.registry[path]
+		if match != nil && !match.MatchString(key.name[strings.LastIndex(key.name, `\`)+1:]) {
+			continue
+		}
+		res = append(res, types.GuestRegKeyRecordSpec{Key: g.regKeySpec(key, req.KeyName)})
+	}
+
+	return res, nil
+}
+
+func (g *memGuest) deleteRegistryKey(req *types.DeleteRegistryKeyInGuest) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	name := req.KeyName.RegistryPath
+
+	if _, fault := g.regKey(name); fault != nil || regKeyParent(strings.Trim(name, `\`)) == "" {
+		return &types.GuestRegistryKeyInvalid{GuestRegistryKeyFault: types.GuestRegistryKeyFault{KeyName: name}}
+	}
+
+	subkeys := g.regSubkeys(name, true)
+	if len(subkeys) != 0 && !req.Recursive {
+		return &types.GuestRegistryKeyHasSubkeys{GuestRegistryKeyFault: types.GuestRegistryKeyFault{KeyName: name}}
+	}
+
+	for _, path := range subkeys {
+		delete(g.registry, path)
+	}
+	delete(g.registry, regKeyPath(name))
+
+	return nil
+}
+
+func (g *memGuest) setRegistryValue(req *types.SetRegistryValueInGuest) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key, fault := g.regKey(req.Value.Name.KeyName.RegistryPath)
+	if fault != nil {
+		return fault
+	}
+
+	if req.Value.Data == nil {
+		return &types.InvalidArgument{InvalidProperty: "value.data"}
+	}
+
+	key.values[strings.ToLower(req.Value.Name.Name)] = req.Value
+	key.written = g.now()
+
+	return nil
+}
+
+var registryVar = regexp.MustCompile(`%([^%]+)%`)
+
+// expandRegistryString expands %NAME% references using registryEnv, leaving unknown references as-is.
+func expandRegistryString(s string) string {
+	return registryVar.ReplaceAllStringFunc(s, func(ref string) string {
+		if val, ok := registryEnv[strings.ToLower(strings.Trim(ref, "%"))]; ok {
+			return val
+		}
+		return ref
+	})
+}
+
+func (g *memGuest) listRegistryValues(req *types.ListRegistryValuesInGuest) ([]types.GuestRegValueSpec, types.BaseMethodFault) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key, fault := g.regKey(req.KeyName.RegistryPath)
+	if fault != nil {
+		return nil, fault
+	}
+
+	var match *regexp.Regexp
+	if req.MatchPattern != "" {
+		var err error
+		if match, err = regexp.Compile(req.MatchPattern); err != nil {
+			return nil, &types.InvalidArgument{InvalidProperty: "matchPattern"}
+		}
+	}
+
+	names := make([]string, 0, len(key.values))
+	for name := range key.values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var res []types.GuestRegValueSpec
+
+	for _, name := range names {
+		val := key.values[name]
+		if match != nil && !match.MatchString(val.Name.Name) {
+			continue
+		}
+		if s, ok := val.Data.(*types.GuestRegValueExpandStringSpec); ok && req.ExpandStrings {
+			val.Data = &types.GuestRegValueExpandStringSpec{Value: expandRegistryString(s.Value)}
+		}
+		res = append(res, val)
+	}
+
+	return res, nil
+}
+
+func (g *memGuest) deleteRegistryValue(req *types.DeleteRegistryValueInGuest) types.BaseMethodFault {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key, fault := g.regKey(req.ValueName.KeyName.RegistryPath)
+	if fault != nil {
+		return fault
+	}
+
+	name := strings.ToLower(req.ValueName.Name)
+	if _, ok := key.values[name]; !ok {
+		return &types.GuestRegistryValueNotFound{
+			GuestRegistryValueFault: types.GuestRegistryValueFault{
+				KeyName:   key.name,
+				ValueName: req.ValueName.Name,
+			},
+		}
+	}
+
+	delete(key.values, name)
+	key.written = g.now()
+
+	return nil
+}
+
+func (m *GuestWindowsRegistryManager) CreateRegistryKeyInGuest(ctx *Context, req *types.CreateRegistryKeyInGuest) soap.HasFault {
+	body := new(methods.CreateRegistryKeyInGuestBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		return g.createRegistryKey(req)
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.CreateRegistryKeyInGuestResponse)
+	return body
+}
+
+func (m *GuestWindowsRegistryManager) ListRegistryKeysInGuest(ctx *Context, req *types.ListRegistryKeysInGuest) soap.HasFault {
+	body := new(methods.ListRegistryKeysInGuestBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	var res []types.GuestRegKeyRecordSpec
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		var fault types.BaseMethodFault
+		res, fault = g.listRegistryKeys(req)
+		return fault
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.ListRegistryKeysInGuestResponse{Returnval: res}
+	return body
+}
+
+func (m *GuestWindowsRegistryManager) DeleteRegistryKeyInGuest(ctx *Context, req *types.DeleteRegistryKeyInGuest) soap.HasFault {
+	body := new(methods.DeleteRegistryKeyInGuestBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		return g.deleteRegistryKey(req)
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.DeleteRegistryKeyInGuestResponse)
+	return body
+}
+
+func (m *GuestWindowsRegistryManager) SetRegistryValueInGuest(ctx *Context, req *types.SetRegistryValueInGuest) soap.HasFault {
+	body := new(methods.SetRegistryValueInGuestBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		return g.setRegistryValue(req)
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.SetRegistryValueInGuestResponse)
+	return body
+}
+
+func (m *GuestWindowsRegistryManager) ListRegistryValuesInGuest(ctx *Context, req *types.ListRegistryValuesInGuest) soap.HasFault {
+	body := new(methods.ListRegistryValuesInGuestBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	var res []types.GuestRegValueSpec
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		var fault types.BaseMethodFault
+		res, fault = g.listRegistryValues(req)
+		return fault
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.ListRegistryValuesInGuestResponse{Returnval: res}
+	return body
+}
+
+func (m *GuestWindowsRegistryManager) DeleteRegistryValueInGuest(ctx *Context, req *types.DeleteRegistryValueInGuest) soap.HasFault {
+	body := new(methods.DeleteRegistryValueInGuestBody)
+
+	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
+
+	fault := vm.memGuestOnlyOp(ctx, req.Auth, func(g *memGuest) types.BaseMethodFault {
+		return g.deleteRegistryValue(req)
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.DeleteRegistryValueInGuestResponse)
+	return body
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"slices"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type HostAccessManager struct {
+	mo.HostAccessManager
+
+	Host *mo.HostSystem
+
+	exceptions  []string
+	systemUsers []string
+}
+
+func (m *HostAccessManager) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.HostAccessManager; ref != nil && ref.Value == m.Self.Value {
+				m.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
+func NewHostAccessManager(h *mo.HostSystem) *HostAccessManager {
+	m := &HostAccessManager{Host: h}
+
+	m.LockdownMode = types.HostLockdownModeLockdownDisabled
+	if h.Config != nil && h.Config.LockdownMode != "" {
+		m.LockdownMode = h.Config.LockdownMode
+	}
+
+	return m
+}
+
+func (m *HostAccessManager) ChangeLockdownMode(ctx *Context, req *types.ChangeLockdownMode) soap.HasFault {
+	body := new(methods.ChangeLockdownModeBody)
+
+	if !slices.Contains(req.Mode.Values(), req.Mode) {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "mode"})
+		return body
+	}
+
+	ctx.Map.Update(m, []types.PropertyChange{{Name: "lockdownMode", Val: req.Mode}})
+	if m.Host != nil && m.Host.Config != nil {
+		m.Host.Config.LockdownMode = req.Mode
+		m.Host.Config.AdminDisabled = types.NewBool(req.Mode != types.HostLockdownModeLockdownDisabled)
+	}
+
+	body.Res = new(types.ChangeLockdownModeResponse)
+	return body
+}
+
+func (m *HostAccessManager) QueryLockdownExceptions(ctx *Context, req *types.QueryLockdownExceptions) soap.HasFault {
+	return &methods.QueryLockdownExceptionsBody{
+		Res: &types.QueryLockdownExceptionsResponse{
+			Returnval: m.exceptions,
+		},
+	}
+}
+
+func (m *HostAccessManager) UpdateLockdownExceptions(ctx *Context, req *types.UpdateLockdownExceptions) soap.HasFault {
+	m.exceptions = slices.Clone(req.Users)
+
+	return &methods.UpdateLockdownExceptionsBody{
+		Res: new(types.UpdateLockdownExceptionsResponse),
+	}
+}
+
+func (m *HostAccessManager) QuerySystemUsers(ctx *Context, req *types.QuerySystemUsers) soap.HasFault {
+	return &methods.QuerySystemUsersBody{
+		Res: &types.QuerySystemUsersResponse{
+			Returnval: m.systemUsers,
+		},
+	}
+}
+
+func (m *HostAccessManager) UpdateSystemUsers(ctx *Context, req *types.UpdateSystemUsers) soap.HasFault {
+	m.systemUsers = slices.Clone(req.Users)
+
+	return &methods.UpdateSystemUsersBody{
+		Res: new(types.UpdateSystemUsersResponse),
+	}
+}
@@ -127,7 +127,7 @@ func (s *searchDatastore) queryMatch(file os.FileInfo) bool {
 		case *types.VmDiskFileQuery:
 			if ext == ".vmdk" {
 				// TODO: check Filter and Details fields
-				return !strings.HasSuffix(name, "-flat.vmdk")
+				return !strings.HasSuffix(name, "-flat.vmdk") && !strings.HasSuffix(name, "-delta.vmdk")
 			}
 		case *types.VmLogFileQuery:
 			if ext == ".log" {
@@ -33,6 +33,16 @@ type HostDatastoreSystem struct {
 	Host *mo.HostSystem
 }
 
+func (dss *HostDatastoreSystem) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.DatastoreSystem; ref != nil && ref.Value == dss.Self.Value {
+				dss.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
 func (dss *HostDatastoreSystem) add(ctx *Context, ds *Datastore) *soap.Fault {
 	info := ds.Info.GetDatastoreInfo()
 
@@ -0,0 +1,284 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+var utcTimeZone = types.HostDateTimeSystemTimeZone{
+	Key:         "UTC",
+	Name:        "UTC",
+	Description: "UTC",
+}
+
+// timeZones returned by QueryAvailableTimeZones, GmtOffset is the standard (non-DST) offset in seconds.
+var timeZones = []types.HostDateTimeSystemTimeZone{
+	utcTimeZone,
+	{Key: "America/Los_Angeles", Name: "PST", Description: "Pacific Time", GmtOffset: -8 * 3600},
+	{Key: "America/Denver", Name: "MST", Description: "Mountain Time", GmtOffset: -7 * 3600},
+	{Key: "America/Chicago", Name: "CST", Description: "Central Time", GmtOffset: -6 * 3600},
+	{Key: "America/New_York", Name: "EST", Description: "Eastern Time", GmtOffset: -5 * 3600},
+	{Key: "Europe/London", Name: "GMT", Description: "United Kingdom Time", GmtOffset: 0},
+	{Key: "Europe/Berlin", Name: "CET", Description: "Central European Time", GmtOffset: 3600},
+	{Key: "Asia/Kolkata", Name: "IST", Description: "India Standard Time", GmtOffset: 19800},
+	{Key: "Asia/Shanghai", Name: "CST", Description: "China Standard Time", GmtOffset: 8 * 3600},
+	{Key: "Asia/Tokyo", Name: "JST", Description: "Japan Standard Time", GmtOffset: 9 * 3600},
+	{Key: "Australia/Sydney", Name: "AEST", Description: "Australian Eastern Time", GmtOffset: 10 * 3600},
+}
+
+type HostDateTimeSystem struct {
+	mo.HostDateTimeSystem
+
+	Host *mo.HostSystem
+
+	// offset of the host clock from the simulator's clock, as set by UpdateDateTime
+	offset time.Duration
+}
+
+func (s *HostDateTimeSystem) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.DateTimeSystem; ref != nil && ref.Value == s.Self.Value {
+				s.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
+// defaultDateTimeInfo returns the factory default configuration of an ESX host.
+func defaultDateTimeInfo() types.HostDateTimeInfo {
+	return types.HostDateTimeInfo{
+		TimeZone:            utcTimeZone,
+		SystemClockProtocol: string(types.HostDateTimeInfoProtocolNtp),
+		NtpConfig:           new(types.HostNtpConfig),
+		Enabled:             types.NewBool(false),
+		DisableEvents:       types.NewBool(false),
+		DisableFallback:     types.NewBool(false),
+		InFallbackState:     types.NewBool(false),
+		ServiceSync:         types.NewBool(false),
+	}
+}
+
+func NewHostDateTimeSystem(h *mo.HostSystem) *HostDateTimeSystem {
+	s := &HostDateTimeSystem{Host: h}
+
+	s.DateTimeInfo = defaultDateTimeInfo()
+
+	if h.Config != nil {
+		if h.Config.DateTimeInfo != nil {
+			deepCopy(h.Config.DateTimeInfo, &s.DateTimeInfo)
+		}
+		info := s.DateTimeInfo
+		h.Config.DateTimeInfo = &info
+	}
+
+	return s
+}
+
+func (s *HostDateTimeSystem) QueryDateTime(ctx *Context, req *types.QueryDateTime) soap.HasFault {
+	return &methods.QueryDateTimeBody{
+		Res: &types.QueryDateTimeResponse{
+			Returnval: ctx.Map.Now().Add(s.offset),
+		},
+	}
+}
+
+func (s *HostDateTimeSystem) UpdateDateTime(ctx *Context, req *types.UpdateDateTime) soap.HasFault {
+	s.offset = req.DateTime.Sub(ctx.Map.Now())
+
+	return &methods.UpdateDateTimeBody{
+		Res: new(types.UpdateDateTimeResponse),
+	}
+}
+
+func findTimeZone(key string) (types.HostDateTimeSystemTimeZone, bool) {
+	for _, tz := range timeZones {
+		if tz.Key == key {
+			return tz, true
+		}
+	}
+	return types.HostDateTimeSystemTimeZone{}, false
+}
+
+// ntpConfigServers returns the servers specified by HostNtpConfig.ConfigFile "server" lines.
+func ntpConfigServers(lines []string) []string {
+	var servers []string
+
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) > 1 && fields[0] == "server" {
+			servers = append(servers, fields[1])
+		}
+	}
+
+	return servers
+}
+
+func (s *HostDateTimeSystem) UpdateDateTimeConfig(ctx *Context, req *types.UpdateDateTimeConfig) soap.HasFault {
+	body := new(methods.UpdateDateTimeConfigBody)
+
+	info := s.DateTimeInfo
+	config := req.Config
+
+	if config.ResetToFactoryDefaults != nil && *config.ResetToFactoryDefaults {
+		info = defaultDateTimeInfo()
+	}
+
+	if tz := config.TimeZone; tz != "" {
+		zone, ok := findTimeZone(tz)
+		if !ok {
+			body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "timeZone"})
+			return body
+		}
+		info.TimeZone = zone
+	}
+
+	if p := config.Protocol; p != "" {
+		switch types.HostDateTimeInfoProtocol(p) {
+		case types.HostDateTimeInfoProtocolNtp, types.HostDateTimeInfoProtocolPtp:
+			info.SystemClockProtocol = p
+		default:
+			body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "protocol"})
+			return body
+		}
+	}
+
+	if ntp := config.NtpConfig; ntp != nil {
+		ntp = &types.HostNtpConfig{
+			Server:     append([]string(nil), ntp.Server...),
+			ConfigFile: append([]string(nil), ntp.ConfigFile...),
+		}
+		// The config file takes precedence over the server list
+		if len(ntp.ConfigFile) != 0 {
+			ntp.Server = ntpConfigServers(ntp.ConfigFile)
+		}
+		for _, server := range ntp.Server {
+			if strings.TrimSpace(server) == "" {
+				body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "ntpConfig.server"})
+				return body
+			}
+		}
+		info.NtpConfig = ntp
+	}
+
+	if config.PtpConfig != nil {
+		info.PtpConfig = config.PtpConfig
+	}
+	if config.Enabled != nil {
+		info.Enabled = types.NewBool(*config.Enabled)
+	}
+	if config.DisableEvents != nil {
+		info.DisableEvents = types.NewBool(*config.DisableEvents)
+	}
+	if config.DisableFallback != nil {
+		info.DisableFallback = types.NewBool(*config.DisableFallback)
+	}
+
+	ctx.Map.Update(s, []types.PropertyChange{{Name: "dateTimeInfo", Val: info}})
+	if s.Host != nil && s.Host.Config != nil {
+		hinfo := info
+		s.Host.Config.DateTimeInfo = &hinfo
+	}
+
+	body.Res = new(types.UpdateDateTimeConfigResponse)
+	return body
+}
+
+func (s *HostDateTimeSystem) QueryAvailableTimeZones(req *types.QueryAvailableTimeZones) soap.HasFault {
+	return &methods.QueryAvailableTimeZonesBody{
+		Res: &types.QueryAvailableTimeZonesResponse{
+			Returnval: append([]types.HostDateTimeSystemTimeZone(nil), timeZones...),
+		},
+	}
+}
+
+// serviceRunning returns true if the host service with the given key is running.
+func (s *HostDateTimeSystem) serviceRunning(ctx *Context, key string) bool {
+	if s.Host == nil || s.Host.ConfigManager.ServiceSystem == nil {
+		return false
+	}
+
+	ss, ok := ctx.Map.Get(*s.Host.ConfigManager.ServiceSystem).(*HostServiceSystem)
+	if !ok {
+		return false
+	}
+
+	for _, svc := range ss.ServiceInfo.Service {
+		if svc.Key == key {
+			return svc.Running
+		}
+	}
+
+	return false
+}
+
+func (s *HostDateTimeSystem) TestTimeService(ctx *Context, req *types.TestTimeService) soap.HasFault {
+	info := s.DateTimeInfo
+	res := types.HostDateTimeSystemServiceTestResult{WorkingNormally: true}
+
+	report := func(format string, args ...any) {
+		res.WorkingNormally = false
+		res.Report = append(res.Report, fmt.Sprintf(format, args...))
+	}
+
+	protocol := info.SystemClockProtocol
+	if protocol == "" {
+		protocol = string(types.HostDateTimeInfoProtocolNtp)
+	}
+
+	if info.Enabled != nil && !*info.Enabled {
+		report("Time service is disabled")
+	}
+
+	switch types.HostDateTimeInfoProtocol(protocol) {
+	case types.HostDateTimeInfoProtocolNtp:
+		if info.NtpConfig == nil || len(info.NtpConfig.Server) == 0 {
+			report("No NTP servers configured")
+		}
+		if !s.serviceRunning(ctx, "ntpd") {
+			report("NTP service ntpd is not running")
+		}
+	case types.HostDateTimeInfoProtocolPtp:
+		if info.PtpConfig == nil {
+			report("No PTP configuration")
+		}
+	}
+
+	if res.WorkingNormally {
+		res.Report = append(res.Report, fmt.Sprintf("%s service is working normally", strings.ToUpper(protocol)))
+	}
+
+	return &methods.TestTimeServiceBody{
+		Res: &types.TestTimeServiceResponse{
+			Returnval: &res,
+		},
+	}
+}
+
+func (s *HostDateTimeSystem) RefreshDateTimeSystem(req *types.RefreshDateTimeSystem) soap.HasFault {
+	return &methods.RefreshDateTimeSystemBody{
+		Res: new(types.RefreshDateTimeSystemResponse),
+	}
+}
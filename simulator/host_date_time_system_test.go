@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostDateTimeSystem(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, Map.Any("HostSystem").Reference())
+
+		m := host.ConfigManager()
+		dts, err := m.DateTimeSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		zones, err := dts.QueryAvailableTimeZones(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(zones) < 2 {
+			t.Fatalf("zones=%d", len(zones))
+		}
+
+		res, err := dts.TestService(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.WorkingNormally {
+			t.Errorf("expected failure with default config: %v", res.Report)
+		}
+
+		// invalid settings
+		invalid := []types.HostDateTimeConfig{
+			{TimeZone: "Mars/Olympus_Mons"},
+			{Protocol: "sundial"},
+			{NtpConfig: &types.HostNtpConfig{Server: []string{" "}}},
+		}
+		for _, config := range invalid {
+			if err = dts.UpdateConfig(ctx, config); !fault.Is(err, &types.InvalidArgument{}) {
+				t.Errorf("expected InvalidArgument, got: %v", err)
+			}
+		}
+
+		tz := zones[len(zones)-1]
+		err = dts.UpdateConfig(ctx, types.HostDateTimeConfig{
+			TimeZone: tz.Key,
+			Enabled:  types.NewBool(true),
+			NtpConfig: &types.HostNtpConfig{
+				ConfigFile: []string{"restrict default nomodify", "server 0.pool.ntp.org", "server 1.pool.ntp.org iburst"},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var props mo.HostSystem
+		if err = host.Properties(ctx, host.Reference(), []string{"config.dateTimeInfo"}, &props); err != nil {
+			t.Fatal(err)
+		}
+		info := props.Config.DateTimeInfo
+		if info.TimeZone.Key != tz.Key || !*info.Enabled {
+			t.Errorf("info=%#v", info)
+		}
+		servers := info.NtpConfig.Server
+		if len(servers) != 2 || servers[0] != "0.pool.ntp.org" || servers[1] != "1.pool.ntp.org" {
+			t.Errorf("servers=%v", servers)
+		}
+
+		// ntpd is not yet running
+		if res, err = dts.TestService(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if res.WorkingNormally || len(res.Report) != 1 {
+			t.Errorf("report=%v", res.Report)
+		}
+
+		ss, err := m.ServiceSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ss.Start(ctx, "ntpd"); err != nil {
+			t.Fatal(err)
+		}
+
+		if res, err = dts.TestService(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if !res.WorkingNormally {
+			t.Errorf("report=%v", res.Report)
+		}
+
+		err = dts.UpdateConfig(ctx, types.HostDateTimeConfig{ResetToFactoryDefaults: types.NewBool(true)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = host.Properties(ctx, host.Reference(), []string{"config.dateTimeInfo"}, &props); err != nil {
+			t.Fatal(err)
+		}
+		info = props.Config.DateTimeInfo
+		if info.TimeZone.Key != "UTC" || len(info.NtpConfig.Server) != 0 || *info.Enabled {
+			t.Errorf("info=%#v", info)
+		}
+	})
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package simulator
 
 import (
+	"net"
+	"strings"
+
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
@@ -26,15 +29,34 @@ import (
 
 type HostFirewallSystem struct {
 	mo.HostFirewallSystem
+
+	Host *mo.HostSystem
 }
 
-func NewHostFirewallSystem(_ *mo.HostSystem) *HostFirewallSystem {
-	info := esx.HostFirewallInfo
+func (s *HostFirewallSystem) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.FirewallSystem; ref != nil && ref.Value == s.Self.Value {
+				s.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
+func NewHostFirewallSystem(h *mo.HostSystem) *HostFirewallSystem {
+	var info types.HostFirewallInfo
+	deepCopy(&esx.HostFirewallInfo, &info)
+
+	if h != nil && h.Config != nil {
+		config := info
+		h.Config.Firewall = &config
+	}
 
 	return &HostFirewallSystem{
 		HostFirewallSystem: mo.HostFirewallSystem{
 			FirewallInfo: &info,
 		},
+		Host: h,
 	}
 }
 
@@ -49,39 +71,161 @@ func DisableRuleset(info *types.HostFirewallInfo, id string) bool {
 	return false
 }
 
-func (s *HostFirewallSystem) DisableRuleset(req *types.DisableRuleset) soap.HasFault {
-	body := &methods.DisableRulesetBody{}
+func EnableRuleset(info *types.HostFirewallInfo, id string) bool {
+	for i := range info.Ruleset {
+		if info.Ruleset[i].Key == id {
+			info.Ruleset[i].Enabled = true
+			return true
+		}
+	}
 
-	if DisableRuleset(s.HostFirewallSystem.FirewallInfo, req.Id) {
-		body.Res = new(types.DisableRulesetResponse)
-		return body
+	return false
+}
+
+// update applies f to a copy of the firewall info, reflecting the result in
+// both the firewallInfo and the host's config.firewall properties.
+func (s *HostFirewallSystem) update(ctx *Context, f func(*types.HostFirewallInfo) types.BaseMethodFault) types.BaseMethodFault {
+	info := *s.FirewallInfo
+	info.Ruleset = append([]types.HostFirewallRuleset(nil), s.FirewallInfo.Ruleset...)
+
+	if err := f(&info); err != nil {
+		return err
 	}
 
-	body.Fault_ = Fault("", &types.NotFound{})
+	ctx.Map.Update(s, []types.PropertyChange{{Name: "firewallInfo", Val: &info}})
 
-	return body
+	if s.Host != nil && s.Host.Config != nil {
+		config := info
+		ctx.Map.Update(s.Host, []types.PropertyChange{{Name: "config.firewall", Val: &config}})
+	}
+
+	return nil
 }
 
-func EnableRuleset(info *types.HostFirewallInfo, id string) bool {
+// ruleset returns the ruleset with the given key, or a NotFound fault.
+func ruleset(info *types.HostFirewallInfo, id string) (*types.HostFirewallRuleset, types.BaseMethodFault) {
 	for i := range info.Ruleset {
 		if info.Ruleset[i].Key == id {
-			info.Ruleset[i].Enabled = true
-			return true
+			return &info.Ruleset[i], nil
 		}
 	}
 
-	return false
+	return nil, &types.NotFound{}
 }
 
-func (s *HostFirewallSystem) EnableRuleset(req *types.EnableRuleset) soap.HasFault {
+func (s *HostFirewallSystem) DisableRuleset(ctx *Context, req *types.DisableRuleset) soap.HasFault {
+	body := &methods.DisableRulesetBody{}
+
+	err := s.update(ctx, func(info *types.HostFirewallInfo) types.BaseMethodFault {
+		rs, err := ruleset(info, req.Id)
+		if err != nil {
+			return err
+		}
+		rs.Enabled = false
+		return nil
+	})
+	if err != nil {
+		body.Fault_ = Fault("", err)
+		return body
+	}
+
+	body.Res = new(types.DisableRulesetResponse)
+	return body
+}
+
+func (s *HostFirewallSystem) EnableRuleset(ctx *Context, req *types.EnableRuleset) soap.HasFault {
 	body := &methods.EnableRulesetBody{}
 
-	if EnableRuleset(s.HostFirewallSystem.FirewallInfo, req.Id) {
-		body.Res = new(types.EnableRulesetResponse)
+	err := s.update(ctx, func(info *types.HostFirewallInfo) types.BaseMethodFault {
+		rs, err := ruleset(info, req.Id)
+		if err != nil {
+			return err
+		}
+		rs.Enabled = true
+		return nil
+	})
+	if err != nil {
+		body.Fault_ = Fault("", err)
 		return body
 	}
 
-	body.Fault_ = Fault("", &types.NotFound{})
+	body.Res = new(types.EnableRulesetResponse)
+	return body
+}
+
+// validIpList returns true if the given addresses and networks are valid IPv4 or IPv6.
+func validIpList(list *types.HostFirewallRulesetIpList) bool {
+	for _, ip := range list.IpAddress {
+		if net.ParseIP(ip) == nil {
+			return false
+		}
+	}
+
+	for _, n := range list.IpNetwork {
+		ip := net.ParseIP(n.Network)
+		if ip == nil {
+			return false
+		}
+		bits := int32(128)
+		if ip.To4() != nil && !strings.Contains(n.Network, ":") {
+			bits = 32
+		}
+		if n.PrefixLength < 0 || n.PrefixLength > bits {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *HostFirewallSystem) UpdateRuleset(ctx *Context, req *types.UpdateRuleset) soap.HasFault {
+	body := &methods.UpdateRulesetBody{}
 
+	err := s.update(ctx, func(info *types.HostFirewallInfo) types.BaseMethodFault {
+		rs, err := ruleset(info, req.Id)
+		if err != nil {
+			return err
+		}
+		if rs.IpListUserConfigurable != nil && !*rs.IpListUserConfigurable {
+			return &types.HostConfigFault{}
+		}
+		allowed := req.Spec.AllowedHosts
+		if !validIpList(&allowed) {
+			return &types.InvalidArgument{InvalidProperty: "spec.allowedHosts"}
+		}
+		allowed.IpAddress = append([]string(nil), allowed.IpAddress...)
+		allowed.IpNetwork = append([]types.HostFirewallRulesetIpNetwork(nil), allowed.IpNetwork...)
+		rs.AllowedHosts = &allowed
+		return nil
+	})
+	if err != nil {
+		body.Fault_ = Fault("", err)
+		return body
+	}
+
+	body.Res = new(types.UpdateRulesetResponse)
+	return body
+}
+
+func (s *HostFirewallSystem) UpdateDefaultPolicy(ctx *Context, req *types.UpdateDefaultPolicy) soap.HasFault {
+	body := &methods.UpdateDefaultPolicyBody{}
+
+	_ = s.update(ctx, func(info *types.HostFirewallInfo) types.BaseMethodFault {
+		if req.DefaultPolicy.IncomingBlocked != nil {
+			info.DefaultPolicy.IncomingBlocked = types.NewBool(*req.DefaultPolicy.IncomingBlocked)
+		}
+		if req.DefaultPolicy.OutgoingBlocked != nil {
+			info.DefaultPolicy.OutgoingBlocked = types.NewBool(*req.DefaultPolicy.OutgoingBlocked)
+		}
+		return nil
+	})
+
+	body.Res = new(types.UpdateDefaultPolicyResponse)
 	return body
 }
+
+func (s *HostFirewallSystem) RefreshFirewall(req *types.RefreshFirewall) soap.HasFault {
+	return &methods.RefreshFirewallBody{
+		Res: new(types.RefreshFirewallResponse),
+	}
+}
@@ -20,8 +20,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func TestHostFirewallSystem(t *testing.T) {
@@ -66,4 +69,67 @@ func TestHostFirewallSystem(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	ruleset := func() *types.HostFirewallRuleset {
+		var props mo.HostSystem
+		if err := host.Properties(ctx, host.Reference(), []string{"config.firewall"}, &props); err != nil {
+			t.Fatal(err)
+		}
+		for i, rs := range props.Config.Firewall.Ruleset {
+			if rs.Key == "sshServer" {
+				return &props.Config.Firewall.Ruleset[i]
+			}
+		}
+		t.Fatal("sshServer ruleset not found")
+		return nil
+	}
+
+	err = hfs.DisableRuleset(ctx, "sshServer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ruleset().Enabled {
+		t.Error("expected host config.firewall to reflect disabled ruleset")
+	}
+
+	spec := types.HostFirewallRulesetRulesetSpec{
+		AllowedHosts: types.HostFirewallRulesetIpList{
+			IpAddress: []string{"10.0.0.1", "fd01::1"},
+			IpNetwork: []types.HostFirewallRulesetIpNetwork{{Network: "192.168.0.0", PrefixLength: 16}},
+		},
+	}
+	if err = hfs.UpdateRuleset(ctx, "sshServer", spec); err != nil {
+		t.Fatal(err)
+	}
+	allowed := ruleset().AllowedHosts
+	if allowed == nil || allowed.AllIp || len(allowed.IpAddress) != 2 || len(allowed.IpNetwork) != 1 {
+		t.Errorf("allowed=%#v", allowed)
+	}
+
+	invalid := []types.HostFirewallRulesetIpList{
+		{IpAddress: []string{"10.0.0.256"}},
+		{IpNetwork: []types.HostFirewallRulesetIpNetwork{{Network: "192.168.0.0", PrefixLength: 33}}},
+		{IpNetwork: []types.HostFirewallRulesetIpNetwork{{Network: "bogus", PrefixLength: 8}}},
+	}
+	for _, list := range invalid {
+		err = hfs.UpdateRuleset(ctx, "sshServer", types.HostFirewallRulesetRulesetSpec{AllowedHosts: list})
+		if !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("expected InvalidArgument, got: %v", err)
+		}
+	}
+	if err = hfs.UpdateRuleset(ctx, "enoent", spec); !fault.Is(err, &types.NotFound{}) {
+		t.Errorf("expected NotFound, got: %v", err)
+	}
+
+	err = hfs.UpdateDefaultPolicy(ctx, types.HostFirewallDefaultPolicy{IncomingBlocked: types.NewBool(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := hfs.Info(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !*info.DefaultPolicy.IncomingBlocked {
+		t.Error("expected incoming to be blocked")
+	}
 }
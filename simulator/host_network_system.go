@@ -17,6 +17,9 @@ limitations under the License.
 package simulator
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -32,17 +35,26 @@ type HostNetworkSystem struct {
 }
 
 func NewHostNetworkSystem(host *mo.HostSystem) *HostNetworkSystem {
+	vswitch := types.HostVirtualSwitch{
+		Name:      "vSwitch0",
+		Portgroup: []string{"VM Network"},
+	}
+
+	for _, vs := range host.Config.Network.Vswitch {
+		if vs.Name == vswitch.Name {
+			vswitch.Pnic = vs.Pnic
+			vswitch.Spec = vs.Spec
+		}
+	}
+
 	return &HostNetworkSystem{
 		Host: host,
 		HostNetworkSystem: mo.HostNetworkSystem{
 			NetworkInfo: &types.HostNetworkInfo{
-				Vswitch: []types.HostVirtualSwitch{
-					{
-						Name:      "vSwitch0",
-						Portgroup: []string{"VM Network"},
-					},
-				},
+				Vswitch:   []types.HostVirtualSwitch{vswitch},
 				Portgroup: host.Config.Network.Portgroup,
+				Pnic:      host.Config.Network.Pnic,
+				Vnic:      host.Config.Network.Vnic,
 			},
 		},
 	}
@@ -100,6 +112,46 @@ func (s *HostNetworkSystem) RemoveVirtualSwitch(c *types.RemoveVirtualSwitch) so
 	return r
 }
 
+func (s *HostNetworkSystem) UpdateVirtualSwitch(req *types.UpdateVirtualSwitch) soap.HasFault {
+	r := &methods.UpdateVirtualSwitchBody{}
+
+	var vswitch *types.HostVirtualSwitch
+
+	for i := range s.NetworkInfo.Vswitch {
+		if s.NetworkInfo.Vswitch[i].Name == req.VswitchName {
+			vswitch = &s.NetworkInfo.Vswitch[i]
+			break
+		}
+	}
+
+	if vswitch == nil {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	var pnics []string
+
+	if bridge, ok := req.Spec.Bridge.(*types.HostVirtualSwitchBondBridge); ok {
+		for _, device := range bridge.NicDevice {
+			i := slices.IndexFunc(s.NetworkInfo.Pnic, func(pnic types.PhysicalNic) bool {
+				return pnic.Device == device
+			})
+			if i < 0 {
+				r.Fault_ = Fault("", &types.NotFound{})
+				return r
+			}
+			pnics = append(pnics, s.NetworkInfo.Pnic[i].Key)
+		}
+	}
+
+	vswitch.Spec = req.Spec
+	vswitch.Pnic = pnics
+
+	r.Res = &types.UpdateVirtualSwitchResponse{}
+
+	return r
+}
+
 func (s *HostNetworkSystem) AddPortGroup(ctx *Context, c *types.AddPortGroup) soap.HasFault {
 	var vswitch *types.HostVirtualSwitch
 
@@ -187,6 +239,73 @@ func (s *HostNetworkSystem) RemovePortGroup(ctx *Context, c *types.RemovePortGro
 	return r
 }
 
+// dvsPortgroupExists returns true if the given port connection refers to a portgroup
+// of a DistributedVirtualSwitch that this host is a member of.
+func (s *HostNetworkSystem) dvsPortgroupExists(ctx *Context, port *types.DistributedVirtualSwitchPortConnection) bool {
+	for _, obj := range ctx.Map.All("DistributedVirtualSwitch") {
+		dvs := obj.(*DistributedVirtualSwitch)
+		if dvs.Uuid != port.SwitchUuid || FindReference(dvs.Summary.HostMember, s.Host.Self) == nil {
+			continue
+		}
+		for _, ref := range dvs.Portgroup {
+			pg := ctx.Map.Get(ref).(*DistributedVirtualPortgroup)
+			if pg.Key == port.PortgroupKey {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *HostNetworkSystem) UpdateVirtualNic(ctx *Context, req *types.UpdateVirtualNic) soap.HasFault {
+	r := &methods.UpdateVirtualNicBody{}
+
+	i := slices.IndexFunc(s.NetworkInfo.Vnic, func(nic types.HostVirtualNic) bool {
+		return nic.Device == req.Device
+	})
+	if i < 0 {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	nic := &s.NetworkInfo.Vnic[i]
+	spec := req.Nic
+
+	switch {
+	case spec.DistributedVirtualPort != nil:
+		port := spec.DistributedVirtualPort
+		if !s.dvsPortgroupExists(ctx, port) {
+			r.Fault_ = Fault("", &types.NotFound{})
+			return r
+		}
+		nic.Spec.DistributedVirtualPort = port
+		nic.Spec.Portgroup = ""
+	case spec.Portgroup != "":
+		if !slices.ContainsFunc(s.NetworkInfo.Portgroup, func(pg types.HostPortGroup) bool {
+			return pg.Spec.Name == spec.Portgroup
+		}) {
+			r.Fault_ = Fault("", &types.NotFound{})
+			return r
+		}
+		nic.Spec.DistributedVirtualPort = nil
+		nic.Spec.Portgroup = spec.Portgroup
+	}
+
+	nic.Portgroup = nic.Spec.Portgroup
+
+	if spec.Mtu != 0 {
+		nic.Spec.Mtu = spec.Mtu
+	}
+	if spec.Ip != nil {
+		nic.Spec.Ip = spec.Ip
+	}
+
+	r.Res = &types.UpdateVirtualNicResponse{}
+
+	return r
+}
+
 func (s *HostNetworkSystem) UpdateNetworkConfig(req *types.UpdateNetworkConfig) soap.HasFault {
 	s.NetworkConfig = &req.Config
 
@@ -197,6 +316,86 @@ func (s *HostNetworkSystem) UpdateNetworkConfig(req *types.UpdateNetworkConfig)
 	}
 }
 
+// NetworkHint returns the QueryNetworkHint info for the given physical nic device, or nil if not found.
+// The CDP and LLDP neighbor info can be modified via the returned pointer, for example to
+// develop tooling that maps host uplinks to physical switch ports.
+// By default, the physical nics of each host are connected to consecutive ports
+// of a top-of-rack switch shared with the other hosts of its compute resource.
+func (s *HostNetworkSystem) NetworkHint(device string) *types.PhysicalNicHintInfo {
+	hints := s.networkHints()
+
+	for i := range hints {
+		if hints[i].Device == device {
+			return &hints[i]
+		}
+	}
+
+	return nil
+}
+
+// networkHints initializes the QueryNetworkHint response on first use.
+func (s *HostNetworkSystem) networkHints() []types.PhysicalNicHintInfo {
+	if s.QueryNetworkHintResponse.Returnval != nil || s.Host.Config == nil || s.Host.Config.Network == nil {
+		return s.QueryNetworkHintResponse.Returnval
+	}
+
+	name := s.Host.Name
+	port := 1
+	pnics := s.Host.Config.Network.Pnic
+
+	if parent := hostParent(s.Host); parent != nil {
+		name = parent.Name
+		for _, ref := range parent.Host {
+			if ref == s.Host.Self {
+				break
+			}
+			port += len(pnics)
+		}
+	}
+
+	name += "-tor"
+
+	for i, pnic := range pnics {
+		id := fmt.Sprintf("Ethernet1/%d", port+i)
+		duplex := pnic.LinkSpeed == nil || pnic.LinkSpeed.Duplex
+
+		s.QueryNetworkHintResponse.Returnval = append(s.QueryNetworkHintResponse.Returnval, types.PhysicalNicHintInfo{
+			Device: pnic.Device,
+			ConnectedSwitchPort: &types.PhysicalNicCdpInfo{
+				CdpVersion: 2,
+				Timeout:    60,
+				Ttl:        180,
+				Samples:    1,
+				DevId:      name,
+				PortId:     id,
+				DeviceCapability: &types.PhysicalNicCdpDeviceCapability{
+					Router:            true,
+					TransparentBridge: true,
+					NetworkSwitch:     true,
+					IgmpEnabled:       true,
+				},
+				SoftwareVersion:  "vcsim",
+				HardwarePlatform: "vcsim",
+				FullDuplex:       &duplex,
+				Mtu:              1500,
+				SystemName:       name,
+			},
+			LldpInfo: &types.LinkLayerDiscoveryProtocolInfo{
+				ChassisId:  name,
+				PortId:     id,
+				TimeToLive: 120,
+				Parameter: []types.KeyAnyValue{
+					{Key: "Port Description", Value: id},
+					{Key: "System Name", Value: name},
+					{Key: "System Description", Value: "vcsim"},
+				},
+			},
+		})
+	}
+
+	return s.QueryNetworkHintResponse.Returnval
+}
+
 func (s *HostNetworkSystem) QueryNetworkHint(req *types.QueryNetworkHint) soap.HasFault {
 	if s.Host.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
 		return &methods.QueryNetworkHintBody{
@@ -204,9 +403,21 @@ func (s *HostNetworkSystem) QueryNetworkHint(req *types.QueryNetworkHint) soap.H
 		}
 	}
 
+	hints := s.networkHints()
+
+	if len(req.Device) != 0 {
+		var match []types.PhysicalNicHintInfo
+		for _, hint := range hints {
+			if slices.Contains(req.Device, hint.Device) {
+				match = append(match, hint)
+			}
+		}
+		hints = match
+	}
+
 	return &methods.QueryNetworkHintBody{
 		Res: &types.QueryNetworkHintResponse{
-			Returnval: s.QueryNetworkHintResponse.Returnval,
+			Returnval: hints,
 		},
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -170,7 +171,158 @@ func TestHostNetworkSystem(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if len(info) != 0 { // TODO: data is only returned when Model.Load is used
-		t.Errorf("len=%d", len(info))
+	if len(info) != 2 {
+		t.Fatalf("len=%d", len(info))
 	}
+
+	for _, hint := range info {
+		if hint.ConnectedSwitchPort == nil || hint.LldpInfo == nil {
+			t.Errorf("%s: expected CDP and LLDP info", hint.Device)
+		}
+	}
+
+	info, err = ns.QueryNetworkHint(ctx, []string{"vmnic1", "vmnic9"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(info) != 1 || info[0].Device != "vmnic1" {
+		t.Errorf("info=%#v", info)
+	}
+}
+
+func TestHostNetworkSystemNetworkHint(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		cluster := Map.Any("ClusterComputeResource").(*ClusterComputeResource)
+
+		ports := make(map[string]string)
+
+		for _, ref := range cluster.Host {
+			host := object.NewHostSystem(c, ref)
+
+			ns, err := host.ConfigManager().NetworkSystem(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			info, err := ns.QueryNetworkHint(ctx, []string{"vmnic0"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(info) != 1 {
+				t.Fatalf("len=%d", len(info))
+			}
+
+			cdp := info[0].ConnectedSwitchPort
+			lldp := info[0].LldpInfo
+
+			if cdp.DevId != cluster.Name+"-tor" || lldp.ChassisId != cdp.DevId || lldp.PortId != cdp.PortId {
+				t.Errorf("cdp=%#v lldp=%#v", cdp, lldp)
+			}
+
+			if h, ok := ports[cdp.PortId]; ok {
+				t.Errorf("%s: port %s already connected to %s", ref, cdp.PortId, h)
+			}
+			ports[cdp.PortId] = ref.Value
+		}
+
+		// configure the neighbor info of a pnic
+		host := Map.Get(cluster.Host[0]).(*HostSystem)
+		ns := Map.Get(*host.ConfigManager.NetworkSystem).(*HostNetworkSystem)
+
+		if ns.NetworkHint("vmnic9") != nil {
+			t.Error("expected nil")
+		}
+
+		hint := ns.NetworkHint("vmnic0")
+		hint.ConnectedSwitchPort = nil
+		hint.LldpInfo.PortId = "swp1"
+
+		info, err := object.NewHostNetworkSystem(c, ns.Self).QueryNetworkHint(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if info[0].ConnectedSwitchPort != nil || info[0].LldpInfo.PortId != "swp1" {
+			t.Errorf("info=%#v", info[0])
+		}
+	})
+}
+
+func TestHostNetworkSystemUpdateVirtualNic(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		host := Map.Any("HostSystem").(*HostSystem)
+		ns := object.NewHostNetworkSystem(c, *host.ConfigManager.NetworkSystem)
+		dvs := Map.Any("DistributedVirtualSwitch").(*DistributedVirtualSwitch)
+		pg := Map.Get(dvs.Portgroup[len(dvs.Portgroup)-1]).(*DistributedVirtualPortgroup)
+
+		var mns mo.HostNetworkSystem
+		err := ns.Properties(ctx, ns.Reference(), []string{"networkInfo"}, &mns)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		nic := mns.NetworkInfo.Vnic[0]
+		if nic.Device != "vmk0" || nic.Portgroup != "Management Network" {
+			t.Fatalf("vnic=%#v", nic)
+		}
+
+		vswitch := mns.NetworkInfo.Vswitch[0]
+		if len(vswitch.Pnic) != 1 || vswitch.Pnic[0] != "key-vim.host.PhysicalNic-vmnic0" {
+			t.Errorf("pnic=%v", vswitch.Pnic)
+		}
+
+		tests := []struct {
+			port types.DistributedVirtualSwitchPortConnection
+			ok   bool
+		}{
+			{types.DistributedVirtualSwitchPortConnection{SwitchUuid: "enoent", PortgroupKey: pg.Key}, false},
+			{types.DistributedVirtualSwitchPortConnection{SwitchUuid: dvs.Uuid, PortgroupKey: "enoent"}, false},
+			{types.DistributedVirtualSwitchPortConnection{SwitchUuid: dvs.Uuid, PortgroupKey: pg.Key}, true},
+		}
+
+		for _, test := range tests {
+			spec := types.HostVirtualNicSpec{DistributedVirtualPort: &test.port}
+			err = ns.UpdateVirtualNic(ctx, nic.Device, spec)
+			if (err == nil) != test.ok {
+				t.Errorf("%#v: %v", test.port, err)
+			}
+		}
+
+		err = ns.UpdateVirtualNic(ctx, "vmk9", nic.Spec)
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		bridge := &types.HostVirtualSwitchBondBridge{NicDevice: []string{"vmnic9"}}
+		err = ns.UpdateVirtualSwitch(ctx, vswitch.Name, types.HostVirtualSwitchSpec{Bridge: bridge})
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		err = ns.UpdateVirtualSwitch(ctx, vswitch.Name, types.HostVirtualSwitchSpec{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = ns.Properties(ctx, ns.Reference(), []string{"networkInfo"}, &mns)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		nic = mns.NetworkInfo.Vnic[0]
+		if nic.Portgroup != "" || nic.Spec.DistributedVirtualPort == nil || nic.Spec.DistributedVirtualPort.PortgroupKey != pg.Key {
+			t.Errorf("vnic=%#v", nic)
+		}
+
+		if len(mns.NetworkInfo.Vswitch[0].Pnic) != 0 {
+			t.Errorf("pnic=%v", mns.NetworkInfo.Vswitch[0].Pnic)
+		}
+
+		// vmk0 on the host config is updated as well
+		if host.Config.Network.Vnic[0].Spec.DistributedVirtualPort == nil {
+			t.Error("host config not updated")
+		}
+	})
 }
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"path"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// HostPatchManager simulates bulletins without downloading metadata,
+// the base name of each metadata, bundle or VIB URL, without extension, is used as the bulletin ID.
+type HostPatchManager struct {
+	mo.HostPatchManager
+
+	Host *mo.HostSystem
+
+	staged    map[string]bool
+	installed map[string]bool
+}
+
+func (m *HostPatchManager) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.PatchManager; ref != nil && ref.Value == m.Self.Value {
+				m.Host = &h.HostSystem
+			}
+		}
+	}
+	m.staged = make(map[string]bool)
+	m.installed = make(map[string]bool)
+}
+
+func NewHostPatchManager(h *mo.HostSystem) *HostPatchManager {
+	return &HostPatchManager{
+		Host:      h,
+		staged:    make(map[string]bool),
+		installed: make(map[string]bool),
+	}
+}
+
+func (m *HostPatchManager) bulletins(urls ...[]string) ([]string, types.BaseMethodFault) {
+	var ids []string
+
+	for _, list := range urls {
+		for _, u := range list {
+			name := path.Base(u)
+			ids = append(ids, strings.TrimSuffix(name, path.Ext(name)))
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, &types.InvalidArgument{InvalidProperty: "metaUrls"}
+	}
+
+	return ids, nil
+}
+
+func (m *HostPatchManager) result(ids []string) types.HostPatchManagerResult {
+	res := types.HostPatchManagerResult{Version: "1.40"}
+
+	for _, id := range ids {
+		status := types.HostPatchManagerStatus{
+			Id:         id,
+			Applicable: !m.installed[id],
+			Installed:  m.installed[id],
+			Integrity:  string(types.HostPatchManagerIntegrityStatusValidated),
+		}
+		if m.installed[id] {
+			status.Reason = []string{string(types.HostPatchManagerReasonObsoleted)}
+			status.InstallState = []string{string(types.HostPatchManagerInstallStateImageActive)}
+		}
+		res.Status = append(res.Status, status)
+	}
+
+	return res
+}
+
+func (m *HostPatchManager) ScanHostPatchV2Task(ctx *Context, req *types.ScanHostPatchV2_Task) soap.HasFault {
+	task := CreateTask(m, "scanHostPatchV2", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		ids, fault := m.bulletins(req.MetaUrls, req.BundleUrls)
+		if fault != nil {
+			return nil, fault
+		}
+		return m.result(ids), nil
+	})
+
+	return &methods.ScanHostPatchV2_TaskBody{
+		Res: &types.ScanHostPatchV2_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *HostPatchManager) StageHostPatchTask(ctx *Context, req *types.StageHostPatch_Task) soap.HasFault {
+	task := CreateTask(m, "stageHostPatch", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		ids, fault := m.bulletins(req.MetaUrls, req.BundleUrls, req.VibUrls)
+		if fault != nil {
+			return nil, fault
+		}
+		for _, id := range ids {
+			m.staged[id] = true
+		}
+		return m.result(ids), nil
+	})
+
+	return &methods.StageHostPatch_TaskBody{
+		Res: &types.StageHostPatch_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *HostPatchManager) InstallHostPatchV2Task(ctx *Context, req *types.InstallHostPatchV2_Task) soap.HasFault {
+	task := CreateTask(m, "installHostPatchV2", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		ids, fault := m.bulletins(req.MetaUrls, req.BundleUrls, req.VibUrls)
+		if fault != nil {
+			return nil, fault
+		}
+		for _, id := range ids {
+			delete(m.staged, id)
+			m.installed[id] = true
+		}
+		return m.result(ids), nil
+	})
+
+	return &methods.InstallHostPatchV2_TaskBody{
+		Res: &types.InstallHostPatchV2_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// cpuPowerPolicy maps HostPowerPolicy.ShortName to the HostCpuPowerManagementInfo.CurrentPolicy reported by ESX.
+var cpuPowerPolicy = map[string]string{
+	"static":  "High Performance",
+	"dynamic": "Balanced",
+	"low":     "Low Power",
+	"custom":  "Custom",
+}
+
+type HostPowerSystem struct {
+	mo.HostPowerSystem
+
+	Host *mo.HostSystem
+}
+
+func (s *HostPowerSystem) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.PowerSystem; ref != nil && ref.Value == s.Self.Value {
+				s.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
+func NewHostPowerSystem(h *mo.HostSystem) *HostPowerSystem {
+	s := &HostPowerSystem{Host: h}
+
+	if h.Config != nil {
+		if h.Config.PowerSystemCapability != nil {
+			deepCopy(h.Config.PowerSystemCapability, &s.Capability)
+		}
+		if h.Config.PowerSystemInfo != nil {
+			s.Info = *h.Config.PowerSystemInfo
+		}
+	}
+
+	if h.Hardware != nil && h.Hardware.CpuPowerManagementInfo != nil {
+		// copy, as the hardware info is shared with other hosts
+		info := *h.Hardware.CpuPowerManagementInfo
+		h.Hardware.CpuPowerManagementInfo = &info
+	}
+
+	return s
+}
+
+func (s *HostPowerSystem) ConfigurePowerPolicy(ctx *Context, req *types.ConfigurePowerPolicy) soap.HasFault {
+	body := new(methods.ConfigurePowerPolicyBody)
+
+	var policy *types.HostPowerPolicy
+	for i, p := range s.Capability.AvailablePolicy {
+		if p.Key == req.Key {
+			policy = &s.Capability.AvailablePolicy[i]
+			break
+		}
+	}
+
+	if policy == nil {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "key"})
+		return body
+	}
+
+	info := types.PowerSystemInfo{CurrentPolicy: *policy}
+	ctx.Map.Update(s, []types.PropertyChange{{Name: "info", Val: info}})
+
+	if h := s.Host; h != nil {
+		if h.Config != nil {
+			hinfo := info
+			h.Config.PowerSystemInfo = &hinfo
+		}
+		if h.Hardware != nil && h.Hardware.CpuPowerManagementInfo != nil {
+			h.Hardware.CpuPowerManagementInfo.CurrentPolicy = cpuPowerPolicy[policy.ShortName]
+		}
+	}
+
+	body.Res = new(types.ConfigurePowerPolicyResponse)
+	return body
+}
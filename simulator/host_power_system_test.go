@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostPowerSystem(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, Map.Any("HostSystem").Reference())
+
+		ps, err := host.ConfigManager().PowerSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		capability, err := ps.Capability(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(capability.AvailablePolicy) != 4 {
+			t.Errorf("policies=%d", len(capability.AvailablePolicy))
+		}
+
+		info, err := ps.Info(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.CurrentPolicy.ShortName != object.PowerPolicyBalanced {
+			t.Errorf("policy=%s", info.CurrentPolicy.ShortName)
+		}
+
+		for _, name := range []string{"High performance", "static", "1"} {
+			p, err := ps.FindPolicy(ctx, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Key != 1 {
+				t.Errorf("%s: key=%d", name, p.Key)
+			}
+		}
+
+		if _, err = ps.FindPolicy(ctx, "turbo"); err == nil {
+			t.Error("expected error")
+		}
+
+		if err = ps.SetPolicy(ctx, "high-performance"); err != nil {
+			t.Fatal(err)
+		}
+
+		info, err = ps.Info(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.CurrentPolicy.ShortName != object.PowerPolicyHighPerformance {
+			t.Errorf("policy=%s", info.CurrentPolicy.ShortName)
+		}
+
+		pm, err := host.CpuPowerManagement(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pm.CurrentPolicy != "High Performance" {
+			t.Errorf("cpu policy=%s", pm.CurrentPolicy)
+		}
+		if !pm.PStates || !pm.CStates {
+			t.Errorf("hardware support=%s", pm.HardwareSupport)
+		}
+
+		// other hosts are not affected
+		for _, obj := range Map.All("HostSystem") {
+			h := obj.(*HostSystem)
+			if h.Reference() == host.Reference() {
+				continue
+			}
+			if p := h.Hardware.CpuPowerManagementInfo.CurrentPolicy; p != "Balanced" {
+				t.Errorf("%s cpu policy=%s", h.Name, p)
+			}
+		}
+
+		err = ps.ConfigurePolicy(ctx, 42)
+		if !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
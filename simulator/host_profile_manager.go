@@ -0,0 +1,520 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// Host profile policy IDs used to capture the NTP servers and firewall ruleset state of a host.
+// The vswitches of a host are captured as NetworkProfile.Vswitch, keyed by name.
+const (
+	ntpServerPolicy      = "NtpServerPolicy"
+	ntpServerOption      = "FixedNtpServerOption"
+	rulesetEnabledPolicy = "RulesetEnabledPolicy"
+	rulesetEnabledOption = "FixedRulesetEnabledOption"
+)
+
+// HostProfileManager implements host profiles covering a subset of host configuration:
+// the NTP servers, firewall ruleset state and vswitches of a host.
+type HostProfileManager struct {
+	mo.HostProfileManager
+}
+
+type HostProfile struct {
+	mo.HostProfile
+}
+
+type ProfileComplianceManager struct {
+	mo.ProfileComplianceManager
+}
+
+func profilePolicy(id, option string, val types.AnyType) types.ProfilePolicy {
+	return types.ProfilePolicy{
+		Id: id,
+		PolicyOption: &types.PolicyOption{
+			Id:        option,
+			Parameter: []types.KeyAnyValue{{Key: "value", Value: val}},
+		},
+	}
+}
+
+func profilePolicyValue(policy []types.ProfilePolicy, id string) types.AnyType {
+	for _, p := range policy {
+		if p.Id != id || p.PolicyOption == nil {
+			continue
+		}
+		for _, param := range p.PolicyOption.GetPolicyOption().Parameter {
+			if param.Key == "value" {
+				return param.Value
+			}
+		}
+	}
+	return nil
+}
+
+func hostNtpServers(ctx *Context, host *HostSystem) []string {
+	s := ctx.Map.Get(*host.ConfigManager.DateTimeSystem).(*HostDateTimeSystem)
+	if s.DateTimeInfo.NtpConfig == nil {
+		return nil
+	}
+	return s.DateTimeInfo.NtpConfig.Server
+}
+
+// hostApplyProfile returns a HostApplyProfile capturing the current configuration of the given host.
+func hostApplyProfile(ctx *Context, host *HostSystem) *types.HostApplyProfile {
+	profile := &types.HostApplyProfile{
+		ApplyProfile: types.ApplyProfile{Enabled: true},
+		Datetime: &types.DateTimeProfile{
+			ApplyProfile: types.ApplyProfile{
+				Enabled: true,
+				Policy: []types.ProfilePolicy{
+					profilePolicy(ntpServerPolicy, ntpServerOption, types.ArrayOfString{String: hostNtpServers(ctx, host)}),
+				},
+			},
+		},
+		Firewall: &types.FirewallProfile{ApplyProfile: types.ApplyProfile{Enabled: true}},
+		Network:  &types.NetworkProfile{ApplyProfile: types.ApplyProfile{Enabled: true}},
+	}
+
+	fw := ctx.Map.Get(*host.ConfigManager.FirewallSystem).(*HostFirewallSystem)
+	for _, rs := range fw.FirewallInfo.Ruleset {
+		profile.Firewall.Ruleset = append(profile.Firewall.Ruleset, types.FirewallProfileRulesetProfile{
+			ApplyProfile: types.ApplyProfile{
+				Enabled: true,
+				Policy:  []types.ProfilePolicy{profilePolicy(rulesetEnabledPolicy, rulesetEnabledOption, rs.Enabled)},
+			},
+			Key: rs.Key,
+		})
+	}
+
+	ns := ctx.Map.Get(*host.ConfigManager.NetworkSystem).(*HostNetworkSystem)
+	for _, vs := range ns.NetworkInfo.Vswitch {
+		profile.Network.Vswitch = append(profile.Network.Vswitch, types.VirtualSwitchProfile{
+			ApplyProfile: types.ApplyProfile{Enabled: true},
+			Key:          vs.Name,
+			Name:         vs.Name,
+		})
+	}
+
+	return profile
+}
+
+// hostConfigSpec returns the HostConfigSpec required to make the given host compliant with the given profile,
+// along with a ComplianceFailure for each difference.
+func hostConfigSpec(ctx *Context, profile *types.HostApplyProfile, host *HostSystem) (*types.HostConfigSpec, []types.ComplianceFailure) {
+	var failures []types.ComplianceFailure
+	spec := new(types.HostConfigSpec)
+	current := hostApplyProfile(ctx, host)
+
+	fail := func(kind, expression, format string, args ...any) {
+		msg := fmt.Sprintf(format, args...)
+		failures = append(failures, types.ComplianceFailure{
+			FailureType:    kind,
+			ExpressionName: expression,
+			Message:        types.LocalizableMessage{Key: "com.vmware.vim.profile.host." + expression, Message: msg},
+		})
+	}
+
+	if profile.Datetime != nil {
+		if val, ok := profilePolicyValue(profile.Datetime.Policy, ntpServerPolicy).(types.ArrayOfString); ok {
+			servers := hostNtpServers(ctx, host)
+			if !slices.Equal(val.String, servers) {
+				fail("datetime", "ntpServers", "NTP servers %v do not match profile %v", servers, val.String)
+				spec.Datetime = &types.HostDateTimeConfig{NtpConfig: &types.HostNtpConfig{Server: val.String}}
+			}
+		}
+	}
+
+	if profile.Firewall != nil {
+		enabled := make(map[string]bool)
+		for _, rs := range current.Firewall.Ruleset {
+			enabled[rs.Key], _ = profilePolicyValue(rs.Policy, rulesetEnabledPolicy).(bool)
+		}
+
+		for _, rs := range profile.Firewall.Ruleset {
+			val, ok := profilePolicyValue(rs.Policy, rulesetEnabledPolicy).(bool)
+			if _, exists := enabled[rs.Key]; !ok || !exists || val == enabled[rs.Key] {
+				continue
+			}
+			fail("firewall", "rulesetEnabled", "Firewall ruleset %s enabled=%t does not match profile", rs.Key, enabled[rs.Key])
+			if spec.Firewall == nil {
+				spec.Firewall = new(types.HostFirewallConfig)
+			}
+			spec.Firewall.Rule = append(spec.Firewall.Rule, types.HostFirewallConfigRuleSetConfig{
+				RulesetId: rs.Key,
+				Enabled:   val,
+			})
+		}
+	}
+
+	if profile.Network != nil {
+		var config []types.HostVirtualSwitchConfig
+		exists := make(map[string]bool)
+		for _, vs := range current.Network.Vswitch {
+			exists[vs.Name] = true
+		}
+
+		for _, vs := range profile.Network.Vswitch {
+			if exists[vs.Name] {
+				delete(exists, vs.Name)
+				continue
+			}
+			fail("network", "vswitch", "Virtual switch %s is missing", vs.Name)
+			config = append(config, types.HostVirtualSwitchConfig{
+				ChangeOperation: string(types.HostConfigChangeOperationAdd),
+				Name:            vs.Name,
+				Spec:            new(types.HostVirtualSwitchSpec),
+			})
+		}
+
+		for _, vs := range current.Network.Vswitch {
+			if !exists[vs.Name] {
+				continue
+			}
+			fail("network", "vswitch", "Virtual switch %s is not in profile", vs.Name)
+			config = append(config, types.HostVirtualSwitchConfig{
+				ChangeOperation: string(types.HostConfigChangeOperationRemove),
+				Name:            vs.Name,
+			})
+		}
+
+		if len(config) != 0 {
+			spec.Network = &types.HostNetworkConfig{Vswitch: config}
+		}
+	}
+
+	return spec, failures
+}
+
+// applyHostConfigSpec applies the NTP, firewall and vswitch changes of the given spec to the given host.
+func applyHostConfigSpec(ctx *Context, host *HostSystem, spec *types.HostConfigSpec) types.BaseMethodFault {
+	var res soap.HasFault
+
+	if spec.Datetime != nil {
+		s := ctx.Map.Get(*host.ConfigManager.DateTimeSystem).(*HostDateTimeSystem)
+		ctx.WithLock(s, func() {
+			res = s.UpdateDateTimeConfig(ctx, &types.UpdateDateTimeConfig{This: s.Self, Config: *spec.Datetime})
+		})
+		if f := res.Fault(); f != nil {
+			return f.VimFault().(types.BaseMethodFault)
+		}
+	}
+
+	if spec.Firewall != nil {
+		s := ctx.Map.Get(*host.ConfigManager.FirewallSystem).(*HostFirewallSystem)
+		for _, rule := range spec.Firewall.Rule {
+			ctx.WithLock(s, func() {
+				if rule.Enabled {
+					res = s.EnableRuleset(ctx, &types.EnableRuleset{This: s.Self, Id: rule.RulesetId})
+				} else {
+					res = s.DisableRuleset(ctx, &types.DisableRuleset{This: s.Self, Id: rule.RulesetId})
+				}
+			})
+			if f := res.Fault(); f != nil {
+				return f.VimFault().(types.BaseMethodFault)
+			}
+		}
+	}
+
+	if spec.Network != nil {
+		s := ctx.Map.Get(*host.ConfigManager.NetworkSystem).(*HostNetworkSystem)
+		for _, vs := range spec.Network.Vswitch {
+			ctx.WithLock(s, func() {
+				switch types.HostConfigChangeOperation(vs.ChangeOperation) {
+				case types.HostConfigChangeOperationAdd:
+					res = s.AddVirtualSwitch(&types.AddVirtualSwitch{This: s.Self, VswitchName: vs.Name, Spec: vs.Spec})
+				case types.HostConfigChangeOperationRemove:
+					res = s.RemoveVirtualSwitch(&types.RemoveVirtualSwitch{This: s.Self, VswitchName: vs.Name})
+				default:
+					res = &methods.UpdateVirtualSwitchBody{Res: new(types.UpdateVirtualSwitchResponse)}
+				}
+			})
+			if f := res.Fault(); f != nil {
+				return f.VimFault().(types.BaseMethodFault)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *HostProfileManager) CreateProfile(ctx *Context, req *types.CreateProfile) soap.HasFault {
+	body := new(methods.CreateProfileBody)
+
+	spec := req.CreateSpec.GetProfileCreateSpec()
+	if spec.Name == "" {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "createSpec.name"})
+		return body
+	}
+
+	for _, ref := range m.Profile {
+		if ctx.Map.Get(ref).(*HostProfile).Name == spec.Name {
+			body.Fault_ = Fault("", &types.DuplicateName{Name: spec.Name, Object: ref})
+			return body
+		}
+	}
+
+	profile := &HostProfile{}
+
+	switch spec := req.CreateSpec.(type) {
+	case *types.HostProfileHostBasedConfigSpec:
+		host, ok := ctx.Map.Get(spec.Host).(*HostSystem)
+		if !ok {
+			body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: spec.Host})
+			return body
+		}
+		profile.ReferenceHost = &spec.Host
+		profile.Config = &types.HostProfileConfigInfo{ApplyProfile: hostApplyProfile(ctx, host)}
+	case *types.HostProfileCompleteConfigSpec:
+		if spec.ApplyProfile == nil {
+			body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "createSpec.applyProfile"})
+			return body
+		}
+		profile.Config = &types.HostProfileConfigInfo{ApplyProfile: spec.ApplyProfile}
+	default:
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "createSpec"})
+		return body
+	}
+
+	info := profile.Config.(*types.HostProfileConfigInfo)
+	info.Name = spec.Name
+	info.Annotation = spec.Annotation
+	info.Enabled = spec.Enabled == nil || *spec.Enabled
+
+	profile.Name = spec.Name
+	profile.CreatedTime = ctx.Map.Now()
+	profile.ModifiedTime = profile.CreatedTime
+	profile.ComplianceStatus = string(types.ComplianceResultStatusUnknown)
+
+	ref := ctx.Map.Put(profile).Reference()
+	ctx.Map.AppendReference(ctx, m, &m.Profile, ref)
+
+	body.Res = &types.CreateProfileResponse{Returnval: ref}
+
+	return body
+}
+
+func (m *HostProfileManager) ApplyHostConfigTask(ctx *Context, req *types.ApplyHostConfig_Task) soap.HasFault {
+	body := new(methods.ApplyHostConfig_TaskBody)
+
+	host, ok := ctx.Map.Get(req.Host).(*HostSystem)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Host})
+		return body
+	}
+
+	task := CreateTask(host, "applyHostConfig", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		return nil, applyHostConfigSpec(ctx, host, &req.ConfigSpec)
+	})
+
+	body.Res = &types.ApplyHostConfig_TaskResponse{
+		Returnval: task.Run(ctx),
+	}
+
+	return body
+}
+
+func (p *HostProfile) DestroyProfile(ctx *Context, req *types.DestroyProfile) soap.HasFault {
+	m := ctx.Map.Get(*ctx.Map.content().HostProfileManager).(*HostProfileManager)
+
+	ctx.Map.RemoveReference(ctx, m, &m.Profile, p.Self)
+	ctx.Map.Remove(ctx, p.Self)
+
+	return &methods.DestroyProfileBody{
+		Res: new(types.DestroyProfileResponse),
+	}
+}
+
+func (p *HostProfile) AssociateProfile(ctx *Context, req *types.AssociateProfile) soap.HasFault {
+	for _, ref := range req.Entity {
+		if _, ok := ctx.Map.Get(ref).(*HostSystem); !ok {
+			return &methods.AssociateProfileBody{
+				Fault_: Fault("", &types.ManagedObjectNotFound{Obj: ref}),
+			}
+		}
+	}
+
+	entity := slices.Clone(p.Entity)
+	for _, ref := range req.Entity {
+		if !slices.Contains(entity, ref) {
+			entity = append(entity, ref)
+		}
+	}
+
+	ctx.Map.Update(p, []types.PropertyChange{{Name: "entity", Val: entity}})
+
+	return &methods.AssociateProfileBody{
+		Res: new(types.AssociateProfileResponse),
+	}
+}
+
+func (p *HostProfile) DissociateProfile(ctx *Context, req *types.DissociateProfile) soap.HasFault {
+	var entity []types.ManagedObjectReference
+
+	if len(req.Entity) != 0 {
+		for _, ref := range p.Entity {
+			if !slices.Contains(req.Entity, ref) {
+				entity = append(entity, ref)
+			}
+		}
+	}
+
+	ctx.Map.Update(p, []types.PropertyChange{{Name: "entity", Val: entity}})
+
+	return &methods.DissociateProfileBody{
+		Res: new(types.DissociateProfileResponse),
+	}
+}
+
+func (p *HostProfile) ExecuteHostProfile(ctx *Context, req *types.ExecuteHostProfile) soap.HasFault {
+	body := new(methods.ExecuteHostProfileBody)
+
+	host, ok := ctx.Map.Get(req.Host).(*HostSystem)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Host})
+		return body
+	}
+
+	spec, _ := hostConfigSpec(ctx, p.Config.(*types.HostProfileConfigInfo).ApplyProfile, host)
+
+	body.Res = &types.ExecuteHostProfileResponse{
+		Returnval: &types.ProfileExecuteResult{
+			Status:     string(types.ProfileExecuteResultStatusSuccess),
+			ConfigSpec: spec,
+		},
+	}
+
+	return body
+}
+
+// checkCompliance checks the compliance of the given hosts with the profile, updating the compliance status
+// of the profile and of each host.
+func (p *HostProfile) checkCompliance(ctx *Context, hosts []types.ManagedObjectReference) []types.ComplianceResult {
+	var res []types.ComplianceResult
+	status := types.ComplianceResultStatusCompliant
+
+	for _, ref := range hosts {
+		host, ok := ctx.Map.Get(ref).(*HostSystem)
+		if !ok {
+			continue
+		}
+
+		now := ctx.Map.Now()
+		_, failures := hostConfigSpec(ctx, p.Config.(*types.HostProfileConfigInfo).ApplyProfile, host)
+
+		result := types.ComplianceResult{
+			Profile:          &p.Self,
+			ComplianceStatus: string(types.ComplianceResultStatusCompliant),
+			Entity:           &host.Self,
+			CheckTime:        &now,
+			Failure:          failures,
+		}
+		if len(failures) != 0 {
+			result.ComplianceStatus = string(types.ComplianceResultStatusNonCompliant)
+			status = types.ComplianceResultStatusNonCompliant
+		}
+
+		ctx.Map.AtomicUpdate(ctx, host, []types.PropertyChange{{
+			Name: "complianceCheckState",
+			Val: &types.HostSystemComplianceCheckState{
+				State:     result.ComplianceStatus,
+				CheckTime: now,
+			},
+		}})
+
+		res = append(res, result)
+	}
+
+	if len(res) == 0 {
+		status = types.ComplianceResultStatusUnknown
+	}
+
+	ctx.Map.Update(p, []types.PropertyChange{{Name: "complianceStatus", Val: string(status)}})
+
+	return res
+}
+
+func (p *HostProfile) CheckProfileComplianceTask(ctx *Context, req *types.CheckProfileCompliance_Task) soap.HasFault {
+	task := CreateTask(p, "checkProfileCompliance", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		hosts := req.Entity
+		if len(hosts) == 0 {
+			hosts = p.Entity
+		}
+
+		return p.checkCompliance(ctx, hosts), nil
+	})
+
+	return &methods.CheckProfileCompliance_TaskBody{
+		Res: &types.CheckProfileCompliance_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *ProfileComplianceManager) CheckComplianceTask(ctx *Context, req *types.CheckCompliance_Task) soap.HasFault {
+	task := CreateTask(m, "checkCompliance", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		var res []types.ComplianceResult
+
+		profiles := req.Profile
+		if len(profiles) == 0 {
+			// profiles associated with any of the given entities
+			hpm := ctx.Map.Get(*ctx.Map.content().HostProfileManager).(*HostProfileManager)
+			for _, ref := range hpm.Profile {
+				p := ctx.Map.Get(ref).(*HostProfile)
+				for _, entity := range req.Entity {
+					if slices.Contains(p.Entity, entity) {
+						profiles = append(profiles, ref)
+						break
+					}
+				}
+			}
+		}
+
+		for _, ref := range profiles {
+			p, ok := ctx.Map.Get(ref).(*HostProfile)
+			if !ok {
+				return nil, &types.ManagedObjectNotFound{Obj: ref}
+			}
+
+			var hosts []types.ManagedObjectReference
+			for _, entity := range p.Entity {
+				if len(req.Entity) == 0 || slices.Contains(req.Entity, entity) {
+					hosts = append(hosts, entity)
+				}
+			}
+
+			ctx.WithLock(p, func() {
+				res = append(res, p.checkCompliance(ctx, hosts)...)
+			})
+		}
+
+		return res, nil
+	})
+
+	return &methods.CheckCompliance_TaskBody{
+		Res: &types.CheckCompliance_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
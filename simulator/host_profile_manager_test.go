@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostProfileManager(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		hosts, err := find.NewFinder(c).HostSystemList(ctx, "*/*")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref, target := hosts[0], hosts[1]
+
+		res, err := methods.CreateProfile(ctx, c, &types.CreateProfile{
+			This: *c.ServiceContent.HostProfileManager,
+			CreateSpec: &types.HostProfileHostBasedConfigSpec{
+				HostProfileConfigSpec: types.HostProfileConfigSpec{
+					ProfileCreateSpec: types.ProfileCreateSpec{Name: "baseline"},
+				},
+				Host: ref.Reference(),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		profile := res.Returnval
+
+		_, err = methods.AssociateProfile(ctx, c, &types.AssociateProfile{
+			This:   profile,
+			Entity: []types.ManagedObjectReference{ref.Reference(), target.Reference()},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		check := func() []types.ComplianceResult {
+			res, err := methods.CheckCompliance_Task(ctx, c, &types.CheckCompliance_Task{
+				This:    *c.ServiceContent.ComplianceManager,
+				Profile: []types.ManagedObjectReference{profile},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			info, err := object.NewTask(c, res.Returnval).WaitForResult(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return info.Result.(types.ArrayOfComplianceResult).ComplianceResult
+		}
+
+		for _, r := range check() {
+			if r.ComplianceStatus != string(types.ComplianceResultStatusCompliant) {
+				t.Errorf("%s: %#v", r.Entity, r.Failure)
+			}
+		}
+
+		// drift the target host's NTP, firewall and vswitch config
+		m := target.ConfigManager()
+		dts, err := m.DateTimeSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = dts.UpdateConfig(ctx, types.HostDateTimeConfig{NtpConfig: &types.HostNtpConfig{Server: []string{"time.example.com"}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw, err := m.FirewallSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = methods.DisableRuleset(ctx, c, &types.DisableRuleset{This: fw.Reference(), Id: "sshServer"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ns, err := m.NetworkSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ns.AddVirtualSwitch(ctx, "vSwitch1", nil); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, r := range check() {
+			status := types.ComplianceResultStatusCompliant
+			if *r.Entity == target.Reference() {
+				status = types.ComplianceResultStatusNonCompliant
+				if len(r.Failure) != 3 {
+					t.Errorf("failures=%#v", r.Failure)
+				}
+			}
+			if r.ComplianceStatus != string(status) {
+				t.Errorf("%s: %s", r.Entity, r.ComplianceStatus)
+			}
+		}
+
+		var p mo.HostProfile
+		if err = target.Properties(ctx, profile, []string{"complianceStatus"}, &p); err != nil {
+			t.Fatal(err)
+		}
+		if p.ComplianceStatus != string(types.ComplianceResultStatusNonCompliant) {
+			t.Errorf("profile status=%s", p.ComplianceStatus)
+		}
+
+		// remediate
+		exec, err := methods.ExecuteHostProfile(ctx, c, &types.ExecuteHostProfile{This: profile, Host: target.Reference()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		spec := exec.Returnval.GetProfileExecuteResult().ConfigSpec
+		if spec.Datetime == nil || spec.Firewall == nil || spec.Network == nil {
+			t.Fatalf("spec=%#v", spec)
+		}
+
+		apply, err := methods.ApplyHostConfig_Task(ctx, c, &types.ApplyHostConfig_Task{
+			This:       *c.ServiceContent.HostProfileManager,
+			Host:       target.Reference(),
+			ConfigSpec: *spec,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = object.NewTask(c, apply.Returnval).Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, r := range check() {
+			if r.ComplianceStatus != string(types.ComplianceResultStatusCompliant) {
+				t.Errorf("%s: %#v", r.Entity, r.Failure)
+			}
+		}
+
+		var h mo.HostSystem
+		if err = target.Properties(ctx, target.Reference(), []string{"complianceCheckState"}, &h); err != nil {
+			t.Fatal(err)
+		}
+		if h.ComplianceCheckState == nil || h.ComplianceCheckState.State != string(types.ComplianceResultStatusCompliant) {
+			t.Errorf("host state=%#v", h.ComplianceCheckState)
+		}
+
+		if _, err = methods.DestroyProfile(ctx, c, &types.DestroyProfile{This: profile}); err != nil {
+			t.Fatal(err)
+		}
+		if Map.Get(profile) != nil {
+			t.Error("profile not destroyed")
+		}
+	})
+}
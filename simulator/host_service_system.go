@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type HostServiceSystem struct {
+	mo.HostServiceSystem
+
+	Host *mo.HostSystem
+}
+
+func (s *HostServiceSystem) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.ServiceSystem; ref != nil && ref.Value == s.Self.Value {
+				s.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
+func NewHostServiceSystem(h *mo.HostSystem) *HostServiceSystem {
+	s := &HostServiceSystem{Host: h}
+
+	if h.Config != nil && h.Config.Service != nil {
+		deepCopy(h.Config.Service, &s.ServiceInfo)
+	}
+
+	return s
+}
+
+// setInfo reflects the given info in both the serviceInfo and the host's config.service properties.
+func (s *HostServiceSystem) setInfo(ctx *Context, info types.HostServiceInfo) {
+	ctx.Map.Update(s, []types.PropertyChange{{Name: "serviceInfo", Val: info}})
+	if s.Host != nil && s.Host.Config != nil {
+		config := info
+		ctx.Map.Update(s.Host, []types.PropertyChange{{Name: "config.service", Val: &config}})
+	}
+}
+
+// update applies f to the service with the given key, returning false if not found.
+func (s *HostServiceSystem) update(ctx *Context, key string, f func(*types.HostService)) bool {
+	info := s.ServiceInfo
+	info.Service = append([]types.HostService(nil), s.ServiceInfo.Service...)
+
+	for i := range info.Service {
+		if info.Service[i].Key == key {
+			f(&info.Service[i])
+
+			s.setInfo(ctx, info)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *HostServiceSystem) StartService(ctx *Context, req *types.StartService) soap.HasFault {
+	body := new(methods.StartServiceBody)
+
+	if !s.update(ctx, req.Id, func(svc *types.HostService) { svc.Running = true }) {
+		body.Fault_ = Fault("", &types.NotFound{})
+		return body
+	}
+
+	body.Res = new(types.StartServiceResponse)
+	return body
+}
+
+func (s *HostServiceSystem) StopService(ctx *Context, req *types.StopService) soap.HasFault {
+	body := new(methods.StopServiceBody)
+
+	if !s.update(ctx, req.Id, func(svc *types.HostService) { svc.Running = false }) {
+		body.Fault_ = Fault("", &types.NotFound{})
+		return body
+	}
+
+	body.Res = new(types.StopServiceResponse)
+	return body
+}
+
+func (s *HostServiceSystem) RestartService(ctx *Context, req *types.RestartService) soap.HasFault {
+	body := new(methods.RestartServiceBody)
+
+	if !s.update(ctx, req.Id, func(svc *types.HostService) { svc.Running = true }) {
+		body.Fault_ = Fault("", &types.NotFound{})
+		return body
+	}
+
+	body.Res = new(types.RestartServiceResponse)
+	return body
+}
+
+func (s *HostServiceSystem) UpdateServicePolicy(ctx *Context, req *types.UpdateServicePolicy) soap.HasFault {
+	body := new(methods.UpdateServicePolicyBody)
+
+	switch types.HostServicePolicy(req.Policy) {
+	case types.HostServicePolicyOn, types.HostServicePolicyOff, types.HostServicePolicyAutomatic:
+	default:
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "policy"})
+		return body
+	}
+
+	if !s.update(ctx, req.Id, func(svc *types.HostService) { svc.Policy = req.Policy }) {
+		body.Fault_ = Fault("", &types.NotFound{})
+		return body
+	}
+
+	body.Res = new(types.UpdateServicePolicyResponse)
+	return body
+}
+
+func (s *HostServiceSystem) UninstallService(ctx *Context, req *types.UninstallService) soap.HasFault {
+	body := new(methods.UninstallServiceBody)
+
+	for _, svc := range s.ServiceInfo.Service {
+		if svc.Key != req.Id {
+			continue
+		}
+		if !svc.Uninstallable {
+			body.Fault_ = Fault("", &types.HostConfigFault{})
+			return body
+		}
+
+		info := s.ServiceInfo
+		info.Service = nil
+		for _, svc := range s.ServiceInfo.Service {
+			if svc.Key != req.Id {
+				info.Service = append(info.Service, svc)
+			}
+		}
+
+		s.setInfo(ctx, info)
+
+		body.Res = new(types.UninstallServiceResponse)
+		return body
+	}
+
+	body.Fault_ = Fault("", &types.NotFound{})
+	return body
+}
+
+func (s *HostServiceSystem) RefreshServices(ctx *Context, req *types.RefreshServices) soap.HasFault {
+	return &methods.RefreshServicesBody{
+		Res: new(types.RefreshServicesResponse),
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostServiceSystem(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, Map.Any("HostSystem").Reference())
+
+		ss, err := host.ConfigManager().ServiceSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		status := func(id string) *types.HostService {
+			services, err := ss.Service(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range services {
+				if services[i].Key == id {
+					return &services[i]
+				}
+			}
+			t.Fatalf("service %s not found", id)
+			return nil
+		}
+
+		if err = ss.Start(ctx, "enoent"); err == nil {
+			t.Error("expected error")
+		}
+
+		if status("TSM-SSH").Running {
+			t.Error("expected TSM-SSH to be stopped")
+		}
+
+		if err = ss.Start(ctx, "TSM-SSH"); err != nil {
+			t.Fatal(err)
+		}
+
+		if !status("TSM-SSH").Running {
+			t.Error("expected TSM-SSH to be running")
+		}
+
+		if err = ss.UpdatePolicy(ctx, "TSM-SSH", string(types.HostServicePolicyOn)); err != nil {
+			t.Fatal(err)
+		}
+
+		if p := status("TSM-SSH").Policy; p != string(types.HostServicePolicyOn) {
+			t.Errorf("policy=%s", p)
+		}
+
+		if err = ss.Stop(ctx, "TSM-SSH"); err != nil {
+			t.Fatal(err)
+		}
+
+		if status("TSM-SSH").Running {
+			t.Error("expected TSM-SSH to be stopped")
+		}
+
+		if err = ss.UpdatePolicy(ctx, "TSM-SSH", "sometimes"); !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("expected InvalidArgument, got: %v", err)
+		}
+		if err = ss.UpdatePolicy(ctx, "enoent", string(types.HostServicePolicyOn)); !fault.Is(err, &types.NotFound{}) {
+			t.Errorf("expected NotFound, got: %v", err)
+		}
+		if err = ss.Uninstall(ctx, "TSM-SSH"); !fault.Is(err, &types.HostConfigFault{}) {
+			t.Errorf("expected HostConfigFault, got: %v", err)
+		}
+		if err = ss.Uninstall(ctx, "enoent"); !fault.Is(err, &types.NotFound{}) {
+			t.Errorf("expected NotFound, got: %v", err)
+		}
+
+		// service state is reflected in the host's config.service property
+		if err = ss.Start(ctx, "ntpd"); err != nil {
+			t.Fatal(err)
+		}
+		var props mo.HostSystem
+		if err = host.Properties(ctx, host.Reference(), []string{"config.service"}, &props); err != nil {
+			t.Fatal(err)
+		}
+		for _, svc := range props.Config.Service.Service {
+			if svc.Key == "ntpd" && !svc.Running {
+				t.Error("expected host config.service ntpd to be running")
+			}
+		}
+	})
+}
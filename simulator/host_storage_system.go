@@ -31,6 +31,16 @@ type HostStorageSystem struct {
 	HBA  []types.BaseHostHostBusAdapter
 }
 
+func (s *HostStorageSystem) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.StorageSystem; ref != nil && ref.Value == s.Self.Value {
+				s.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
 func NewHostStorageSystem(h *mo.HostSystem) *HostStorageSystem {
 	s := &HostStorageSystem{Host: h}
 
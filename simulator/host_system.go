@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"slices"
 	"sync"
 	"time"
 
@@ -88,7 +89,11 @@ func NewHostSystem(host mo.HostSystem) *HostSystem {
 
 	// copy over the reference advanced options so each host can have it's own, allowing hosts to be configured for
 	// container backing individually
-	deepCopy(esx.AdvancedOptions, &cfg.Option)
+	cfg.Option = make([]types.BaseOptionValue, len(esx.AdvancedOptions))
+	for i, opt := range esx.AdvancedOptions {
+		val := *opt.GetOptionValue()
+		cfg.Option[i] = &val
+	}
 
 	// add a supported option to the AdvancedOption manager
 	simOption := types.OptionDef{ElementDescription: types.ElementDescription{Key: advOptContainerBackingImage}}
@@ -107,6 +112,12 @@ func NewHostSystem(host mo.HostSystem) *HostSystem {
 		{&hs.ConfigManager.FirewallSystem, NewHostFirewallSystem(&hs.HostSystem)},
 		{&hs.ConfigManager.StorageSystem, NewHostStorageSystem(&hs.HostSystem)},
 		{&hs.ConfigManager.CertificateManager, NewHostCertificateManager(&hs.HostSystem)},
+		{&hs.ConfigManager.HostAccessManager, NewHostAccessManager(&hs.HostSystem)},
+		{&hs.ConfigManager.ServiceSystem, NewHostServiceSystem(&hs.HostSystem)},
+		{&hs.ConfigManager.PatchManager, NewHostPatchManager(&hs.HostSystem)},
+		{&hs.ConfigManager.VsanSystem, NewHostVsanSystem(&hs.HostSystem)},
+		{&hs.ConfigManager.DateTimeSystem, NewHostDateTimeSystem(&hs.HostSystem)},
+		{&hs.ConfigManager.PowerSystem, NewHostPowerSystem(&hs.HostSystem)},
 	}
 
 	for _, c := range config {
@@ -543,9 +554,40 @@ func (h *HostSystem) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.Has
 	}
 }
 
+// evacuate migrates the VMs that must leave the host before it can enter maintenance mode,
+// if the host is a member of a cluster with DRS enabled for the VM. Powered off VMs are only
+// evacuated if evacuatePoweredOff is true. Returns a fault if any such VMs remain on the host.
+func (h *HostSystem) evacuate(ctx *Context, evacuatePoweredOff bool) types.BaseMethodFault {
+	cluster, _ := ctx.Map.Get(*h.Parent).(*ClusterComputeResource)
+
+	remain := 0
+	for _, ref := range slices.Clone(h.Vm) {
+		vm := ctx.Map.Get(ref).(*VirtualMachine)
+		if vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn || evacuatePoweredOff {
+			if cluster == nil || !cluster.drsEvacuate(ctx, h, vm) {
+				remain++
+			}
+		}
+	}
+
+	if remain != 0 {
+		return newInvalidStateFault("%s has %d VMs that must be powered off or migrated", h.Name, remain)
+	}
+
+	return nil
+}
+
 func (h *HostSystem) EnterMaintenanceModeTask(ctx *Context, spec *types.EnterMaintenanceMode_Task) soap.HasFault {
 	task := CreateTask(h, "enterMaintenanceMode", func(t *Task) (types.AnyType, types.BaseMethodFault) {
-		h.Runtime.InMaintenanceMode = true
+		ctx.postEvent(&types.EnteringMaintenanceModeEvent{HostEvent: h.event()})
+
+		if fault := h.evacuate(ctx, isTrue(spec.EvacuatePoweredOffVms)); fault != nil {
+			return nil, fault
+		}
+
+		ctx.Map.Update(h, []types.PropertyChange{{Name: "runtime.inMaintenanceMode", Val: true}})
+		ctx.postEvent(&types.EnteredMaintenanceModeEvent{HostEvent: h.event()})
+
 		return nil, nil
 	})
 
@@ -558,7 +600,9 @@ func (h *HostSystem) EnterMaintenanceModeTask(ctx *Context, spec *types.EnterMai
 
 func (h *HostSystem) ExitMaintenanceModeTask(ctx *Context, spec *types.ExitMaintenanceMode_Task) soap.HasFault {
 	task := CreateTask(h, "exitMaintenanceMode", func(t *Task) (types.AnyType, types.BaseMethodFault) {
-		h.Runtime.InMaintenanceMode = false
+		ctx.Map.Update(h, []types.PropertyChange{{Name: "runtime.inMaintenanceMode", Val: false}})
+		ctx.postEvent(&types.ExitMaintenanceModeEvent{HostEvent: h.event()})
+
 		return nil, nil
 	})
 
@@ -595,6 +639,84 @@ func (h *HostSystem) ReconnectHostTask(ctx *Context, spec *types.ReconnectHost_T
 	}
 }
 
+// Fail simulates a failure of the host, such as a loss of power or network isolation.
+// The host's connection state changes to notResponding, a HostConnectionLostEvent is posted
+// and the host's VMs are disconnected. If the host is a member of a cluster with HA enabled,
+// its powered on HA protected VMs are restarted on the surviving hosts of the cluster.
+// See also HostSystem.Recover.
+func (h *HostSystem) Fail(ctx *Context) {
+	ctx.WithLock(h, func() {
+		ctx.Map.Update(h, []types.PropertyChange{
+			{Name: "runtime.connectionState", Val: types.HostSystemConnectionStateNotResponding},
+		})
+	})
+
+	ctx.postEvent(&types.HostConnectionLostEvent{HostEvent: h.event()})
+
+	if cluster, ok := ctx.Map.Get(*h.Parent).(*ClusterComputeResource); ok {
+		cluster.dasFailover(ctx, h) // restarted VMs are moved to other hosts
+	}
+
+	for _, ref := range slices.Clone(h.Vm) {
+		vm := ctx.Map.Get(ref).(*VirtualMachine)
+		vm.setConnectionState(ctx, types.VirtualMachineConnectionStateDisconnected)
+	}
+}
+
+// Recover reconnects a host after HostSystem.Fail, along with any of its VMs that were not restarted by HA.
+func (h *HostSystem) Recover(ctx *Context) {
+	ctx.WithLock(h, func() {
+		ctx.Map.Update(h, []types.PropertyChange{
+			{Name: "runtime.connectionState", Val: types.HostSystemConnectionStateConnected},
+		})
+	})
+
+	ctx.postEvent(&types.HostConnectedEvent{HostEvent: h.event()})
+
+	for _, ref := range slices.Clone(h.Vm) {
+		vm := ctx.Map.Get(ref).(*VirtualMachine)
+		vm.setConnectionState(ctx, types.VirtualMachineConnectionStateConnected)
+	}
+}
+
+// QueryMemoryOverheadEx estimates the memory overhead of a VM in bytes, using an approximation of the
+// overhead reported by ESX: a fixed base, per vCPU overhead, page table overhead of 4MB per 1GB of
+// configured memory and the video RAM of the VM, if any.
+func (h *HostSystem) QueryMemoryOverheadEx(req *types.QueryMemoryOverheadEx) soap.HasFault {
+	body := new(methods.QueryMemoryOverheadExBody)
+
+	hw := req.VmConfigInfo.Hardware
+	if hw.NumCPU <= 0 {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "vmConfigInfo.hardware.numCPU"})
+		return body
+	}
+	if hw.MemoryMB <= 0 {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "vmConfigInfo.hardware.memoryMB"})
+		return body
+	}
+
+	body.Res = &types.QueryMemoryOverheadExResponse{
+		Returnval: memoryOverhead(&hw),
+	}
+
+	return body
+}
+
+// memoryOverhead returns the estimated memory overhead in bytes of a VM with the given hardware,
+// see HostSystem.QueryMemoryOverheadEx.
+func memoryOverhead(hw *types.VirtualHardware) int64 {
+	const mb = 1024 * 1024
+	overhead := 18*mb + 4*mb*int64(hw.NumCPU) + int64(hw.MemoryMB)*mb/256
+
+	for _, device := range hw.Device {
+		if video, ok := device.(*types.VirtualMachineVideoCard); ok {
+			overhead += video.VideoRamSizeInKB * 1024
+		}
+	}
+
+	return overhead
+}
+
 func (s *HostSystem) QueryTpmAttestationReport(req *types.QueryTpmAttestationReport) soap.HasFault {
 	return &methods.QueryTpmAttestationReportBody{
 		Res: &s.QueryTpmAttestationReportResponse,
@@ -18,11 +18,13 @@ package simulator
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator/esx"
@@ -107,6 +109,26 @@ func TestMaintenanceMode(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	err = task.Wait(ctx)
+	if err == nil {
+		t.Fatal("expected error") // powered on VMs remain
+	}
+
+	for _, ref := range hs.Vm {
+		task, err = object.NewVirtualMachine(c, ref).PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	task, err = host.EnterMaintenanceMode(ctx, 1, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	err = task.Wait(ctx)
 	if err != nil {
 		t.Fatal(err)
@@ -131,6 +153,96 @@ func TestMaintenanceMode(t *testing.T) {
 	}
 }
 
+func TestMaintenanceModeEvacuate(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		cluster, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		hosts, err := cluster.Hosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// VMs are placed randomly, use the host with the most VMs
+		slices.SortFunc(hosts, func(a, b *object.HostSystem) int {
+			return len(Map.Get(b.Reference()).(*HostSystem).Vm) - len(Map.Get(a.Reference()).(*HostSystem).Vm)
+		})
+
+		host := Map.Get(hosts[0].Reference()).(*HostSystem)
+		vms := slices.Clone(host.Vm)
+		if len(vms) == 0 {
+			t.Fatal("no vms")
+		}
+
+		// DRS disabled for one of the VMs
+		spec := &types.ClusterConfigSpecEx{
+			DrsVmConfigSpec: []types.ClusterDrsVmConfigSpec{{
+				ArrayUpdateSpec: types.ArrayUpdateSpec{Operation: types.ArrayUpdateOperationAdd},
+				Info:            &types.ClusterDrsVmConfigInfo{Key: vms[0], Enabled: types.NewBool(false)},
+			}},
+		}
+		task, err := cluster.Reconfigure(ctx, spec, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		task, err = hosts[0].EnterMaintenanceMode(ctx, 0, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err == nil {
+			t.Fatal("expected error")
+		}
+		if host.Runtime.InMaintenanceMode {
+			t.Error("in maintenance mode")
+		}
+
+		spec.DrsVmConfigSpec[0].Operation = types.ArrayUpdateOperationRemove
+		spec.DrsVmConfigSpec[0].RemoveKey = vms[0]
+		spec.DrsVmConfigSpec[0].Info = nil
+		task, err = cluster.Reconfigure(ctx, spec, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		task, err = hosts[0].EnterMaintenanceMode(ctx, 0, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if !host.Runtime.InMaintenanceMode {
+			t.Error("not in maintenance mode")
+		}
+
+		for _, ref := range vms {
+			vm := Map.Get(ref).(*VirtualMachine)
+			if *vm.Runtime.Host == host.Self {
+				t.Errorf("%s not evacuated", vm.Name)
+			}
+		}
+
+		for kind, n := range map[string]int{"EnteredMaintenanceModeEvent": 1, "DrsVmMigratedEvent": len(vms)} {
+			events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{EventTypeId: []string{kind}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(events) != n {
+				t.Errorf("%s=%d", kind, len(events))
+			}
+		}
+	})
+}
+
 func TestNewHostSystem(t *testing.T) {
 	m := ESX()
 
@@ -272,3 +384,84 @@ func TestDisconnect(t *testing.T) {
 			types.HostSystemConnectionStateConnected, hs.Runtime.ConnectionState)
 	}
 }
+
+func TestHostFailure(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		cluster, err := finder.DefaultClusterComputeResource(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vms, err := finder.VirtualMachineList(ctx, "DC0_C0_RP0_VM*")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		host := Map.Get(Map.Get(cluster.Reference()).(*ClusterComputeResource).Host[0]).(*HostSystem)
+		for _, vm := range vms {
+			task, err := vm.Relocate(ctx, types.VirtualMachineRelocateSpec{Host: &host.Self}, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		spec := &types.ClusterConfigSpecEx{
+			DasConfig: &types.ClusterDasConfigInfo{Enabled: types.NewBool(true)},
+			DasVmConfigSpec: []types.ClusterDasVmConfigSpec{{
+				ArrayUpdateSpec: types.ArrayUpdateSpec{Operation: types.ArrayUpdateOperationAdd},
+				Info: &types.ClusterDasVmConfigInfo{
+					Key: vms[1].Reference(),
+					DasSettings: &types.ClusterDasVmSettings{
+						RestartPriority: string(types.ClusterDasVmSettingsRestartPriorityDisabled),
+					},
+				},
+			}},
+		}
+		task, err := cluster.Reconfigure(ctx, spec, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		host.Fail(SpoofContext())
+
+		if host.Runtime.ConnectionState != types.HostSystemConnectionStateNotResponding {
+			t.Errorf("state=%s", host.Runtime.ConnectionState)
+		}
+
+		restarted := Map.Get(vms[0].Reference()).(*VirtualMachine)
+		if *restarted.Runtime.Host == host.Self || restarted.Runtime.ConnectionState != types.VirtualMachineConnectionStateConnected {
+			t.Errorf("restarted vm runtime=%#v", restarted.Runtime)
+		}
+
+		disabled := Map.Get(vms[1].Reference()).(*VirtualMachine)
+		if *disabled.Runtime.Host != host.Self || disabled.Runtime.ConnectionState != types.VirtualMachineConnectionStateDisconnected {
+			t.Errorf("disabled vm runtime=%#v", disabled.Runtime)
+		}
+
+		for kind, n := range map[string]int{"HostConnectionLostEvent": 1, "DasHostFailedEvent": 1, "VmRestartedOnAlternateHostEvent": 1} {
+			events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{EventTypeId: []string{kind}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(events) != n {
+				t.Errorf("%s=%d", kind, len(events))
+			}
+		}
+
+		host.Recover(SpoofContext())
+
+		if host.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+			t.Errorf("state=%s", host.Runtime.ConnectionState)
+		}
+		if disabled.Runtime.ConnectionState != types.VirtualMachineConnectionStateConnected {
+			t.Errorf("disabled vm state=%s", disabled.Runtime.ConnectionState)
+		}
+	})
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package simulator
 
 import (
+	"slices"
+
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
@@ -30,15 +32,130 @@ type HostVirtualNicManager struct {
 	Host *mo.HostSystem
 }
 
+func (m *HostVirtualNicManager) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.VirtualNicManager; ref != nil && ref.Value == m.Self.Value {
+				m.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
 func NewHostVirtualNicManager(host *mo.HostSystem) *HostVirtualNicManager {
-	return &HostVirtualNicManager{
-		Host: host,
-		HostVirtualNicManager: mo.HostVirtualNicManager{
-			Info: types.HostVirtualNicManagerInfo{
-				NetConfig: esx.VirtualNicManagerNetConfig,
-			},
-		},
+	m := &HostVirtualNicManager{Host: host}
+
+	info := &types.HostVirtualNicManagerInfo{NetConfig: esx.VirtualNicManagerNetConfig}
+	if host.Config != nil && host.Config.VirtualNicManagerInfo != nil {
+		info = host.Config.VirtualNicManagerInfo
 	}
+	deepCopy(info, &m.Info)
+
+	return m
+}
+
+// netConfig returns the NetConfig for the given nicType, or nil if not found.
+func (m *HostVirtualNicManager) netConfig(nicType string) *types.VirtualNicManagerNetConfig {
+	for i := range m.Info.NetConfig {
+		if m.Info.NetConfig[i].NicType == nicType {
+			return &m.Info.NetConfig[i]
+		}
+	}
+	return nil
+}
+
+// updateVnic selects or deselects the candidate vnic device for nicType.
+func (m *HostVirtualNicManager) updateVnic(ctx *Context, nicType string, device string, enable bool) types.BaseMethodFault {
+	if nicType == string(types.HostVirtualNicManagerNicTypeVsan) {
+		return &types.NotSupported{} // must use HostVsanSystem
+	}
+
+	config := m.netConfig(nicType)
+	if config == nil {
+		return &types.InvalidArgument{InvalidProperty: "nicType"}
+	}
+
+	key := ""
+	for _, vnic := range config.CandidateVnic {
+		if vnic.Device == device {
+			key = vnic.Key
+			break
+		}
+	}
+	if key == "" {
+		return &types.InvalidArgument{InvalidProperty: "device"}
+	}
+
+	selected := slices.DeleteFunc(slices.Clone(config.SelectedVnic), func(k string) bool { return k == key })
+	if enable {
+		if !config.MultiSelectAllowed {
+			selected = nil
+		}
+		selected = append(selected, key)
+	}
+
+	m.setSelected(ctx, config, selected)
+
+	return nil
+}
+
+// setSelected updates the SelectedVnic keys of config, along with the host's VirtualNicManagerInfo.
+func (m *HostVirtualNicManager) setSelected(ctx *Context, config *types.VirtualNicManagerNetConfig, selected []string) {
+	config.SelectedVnic = selected
+
+	ctx.Map.Update(m, []types.PropertyChange{{Name: "info", Val: m.Info}})
+
+	if m.Host.Config == nil || m.Host.Config.VirtualNicManagerInfo == nil {
+		return
+	}
+
+	info := m.Host.Config.VirtualNicManagerInfo
+	for i := range info.NetConfig {
+		if info.NetConfig[i].NicType == config.NicType {
+			info.NetConfig[i].SelectedVnic = slices.Clone(selected)
+		}
+	}
+}
+
+// selectVsanVnics selects the given vnic devices for vsan, as configured via HostVsanSystem.
+func (m *HostVirtualNicManager) selectVsanVnics(ctx *Context, devices []string) {
+	config := m.netConfig(string(types.HostVirtualNicManagerNicTypeVsan))
+	if config == nil {
+		return
+	}
+
+	var selected []string
+	for _, vnic := range config.CandidateVnic {
+		if slices.Contains(devices, vnic.Device) {
+			selected = append(selected, vnic.Key)
+		}
+	}
+
+	m.setSelected(ctx, config, selected)
+}
+
+func (m *HostVirtualNicManager) SelectVnicForNicType(ctx *Context, req *types.SelectVnicForNicType) soap.HasFault {
+	body := new(methods.SelectVnicForNicTypeBody)
+
+	if err := m.updateVnic(ctx, req.NicType, req.Device, true); err != nil {
+		body.Fault_ = Fault("", err)
+		return body
+	}
+
+	body.Res = new(types.SelectVnicForNicTypeResponse)
+	return body
+}
+
+func (m *HostVirtualNicManager) DeselectVnicForNicType(ctx *Context, req *types.DeselectVnicForNicType) soap.HasFault {
+	body := new(methods.DeselectVnicForNicTypeBody)
+
+	if err := m.updateVnic(ctx, req.NicType, req.Device, false); err != nil {
+		body.Fault_ = Fault("", err)
+		return body
+	}
+
+	body.Res = new(types.DeselectVnicForNicTypeResponse)
+	return body
 }
 
 func (m *HostVirtualNicManager) QueryNetConfig(req *types.QueryNetConfig) soap.HasFault {
@@ -0,0 +1,227 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type HostVsanSystem struct {
+	mo.HostVsanSystem
+
+	Host *mo.HostSystem
+}
+
+func (s *HostVsanSystem) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.VsanSystem; ref != nil && ref.Value == s.Self.Value {
+				s.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
+func NewHostVsanSystem(h *mo.HostSystem) *HostVsanSystem {
+	s := &HostVsanSystem{Host: h}
+
+	if h.Config != nil && h.Config.VsanHostConfig != nil {
+		deepCopy(h.Config.VsanHostConfig, &s.Config)
+	}
+
+	return s
+}
+
+// update applies the non-nil fields of spec to the host's vSAN config.
+// When vSAN is enabled, the host joins the cluster with the given uuid, or a generated one if empty.
+func (s *HostVsanSystem) update(ctx *Context, spec types.VsanHostConfigInfo) {
+	config := s.Config
+
+	if spec.Enabled != nil {
+		config.Enabled = spec.Enabled
+	}
+	if spec.StorageInfo != nil {
+		config.StorageInfo = spec.StorageInfo
+		s.claimDisks(config.StorageInfo)
+	}
+	if spec.NetworkInfo != nil {
+		config.NetworkInfo = spec.NetworkInfo
+		s.updateVnics(ctx, spec.NetworkInfo)
+	}
+	if spec.FaultDomainInfo != nil {
+		config.FaultDomainInfo = spec.FaultDomainInfo
+	}
+
+	if s.Host != nil {
+		config.HostSystem = &s.Host.Self
+	}
+
+	config.ClusterInfo = new(types.VsanHostConfigInfoClusterInfo)
+
+	if config.Enabled != nil && *config.Enabled {
+		if spec.ClusterInfo != nil {
+			config.ClusterInfo.Uuid = spec.ClusterInfo.Uuid
+		}
+		if config.ClusterInfo.Uuid == "" {
+			config.ClusterInfo.Uuid = uuid.New().String()
+		}
+		if s.Host != nil && s.Host.Summary.Hardware != nil {
+			config.ClusterInfo.NodeUuid = s.Host.Summary.Hardware.Uuid
+		}
+	}
+
+	ctx.Map.Update(s, []types.PropertyChange{{Name: "config", Val: config}})
+
+	if s.Host != nil && s.Host.Config != nil {
+		s.Host.Config.VsanHostConfig = &config
+	}
+}
+
+// updateVnics selects the vnics of the given vSAN network config in the host's HostVirtualNicManager.
+func (s *HostVsanSystem) updateVnics(ctx *Context, info *types.VsanHostConfigInfoNetworkInfo) {
+	if s.Host == nil || s.Host.ConfigManager.VirtualNicManager == nil {
+		return
+	}
+
+	m, ok := ctx.Map.Get(*s.Host.ConfigManager.VirtualNicManager).(*HostVirtualNicManager)
+	if !ok {
+		return
+	}
+
+	var devices []string
+	for _, port := range info.Port {
+		devices = append(devices, port.Device)
+	}
+
+	ctx.WithLock(m, func() { m.selectVsanVnics(ctx, devices) })
+}
+
+func (s *HostVsanSystem) UpdateVsanTask(ctx *Context, req *types.UpdateVsan_Task) soap.HasFault {
+	task := CreateTask(s, "updateVsan", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		s.update(ctx, req.Config)
+		return nil, nil
+	})
+
+	return &methods.UpdateVsan_TaskBody{
+		Res: &types.UpdateVsan_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// mappedDisks returns pointers to the disks of the host's vSAN disk mappings.
+func mappedDisks(info *types.VsanHostConfigInfoStorageInfo) []*types.HostScsiDisk {
+	var disks []*types.HostScsiDisk
+
+	if info == nil {
+		return nil
+	}
+
+	for i := range info.DiskMapping {
+		m := &info.DiskMapping[i]
+		disks = append(disks, &m.Ssd)
+		for j := range m.NonSsd {
+			disks = append(disks, &m.NonSsd[j])
+		}
+	}
+
+	return disks
+}
+
+// claimDisks assigns vSAN disk info to newly claimed disks, using the current on-disk format version.
+func (s *HostVsanSystem) claimDisks(info *types.VsanHostConfigInfoStorageInfo) {
+	for _, disk := range mappedDisks(info) {
+		if disk.VsanDiskInfo == nil {
+			disk.VsanDiskInfo = &types.VsanHostVsanDiskInfo{
+				VsanUuid:      uuid.New().String(),
+				FormatVersion: vsanDiskFormatVersion,
+			}
+		}
+	}
+}
+
+// upgrade sets the on-disk format of the host's vSAN disks to the current version,
+// or when downgrade is true, to the previous version.
+func (s *HostVsanSystem) upgrade(ctx *Context, downgrade bool) {
+	config := s.Config
+
+	for _, disk := range mappedDisks(config.StorageInfo) {
+		if disk.VsanDiskInfo == nil {
+			continue
+		}
+		version := vsanDiskFormatVersion
+		if downgrade {
+			version = max(disk.VsanDiskInfo.FormatVersion-1, 1)
+		}
+		disk.VsanDiskInfo.FormatVersion = version
+	}
+
+	ctx.Map.Update(s, []types.PropertyChange{{Name: "config", Val: config}})
+}
+
+func (s *HostVsanSystem) QueryDisksForVsan(ctx *Context, req *types.QueryDisksForVsan) soap.HasFault {
+	var res []types.VsanHostDiskResult
+
+	match := func(name string) bool {
+		return len(req.CanonicalName) == 0 || slices.Contains(req.CanonicalName, name)
+	}
+
+	inUse := make(map[string]*types.HostScsiDisk)
+	for _, disk := range mappedDisks(s.Config.StorageInfo) {
+		inUse[disk.CanonicalName] = disk
+	}
+
+	if s.Host != nil && s.Host.Config != nil && s.Host.Config.StorageDevice != nil {
+		for _, lun := range s.Host.Config.StorageDevice.ScsiLun {
+			disk, ok := lun.(*types.HostScsiDisk)
+			if !ok || !match(disk.CanonicalName) || inUse[disk.CanonicalName] != nil {
+				continue
+			}
+			res = append(res, types.VsanHostDiskResult{
+				Disk:  *disk,
+				State: string(types.VsanHostDiskResultStateEligible),
+			})
+		}
+	}
+
+	for _, disk := range mappedDisks(s.Config.StorageInfo) {
+		if !match(disk.CanonicalName) {
+			continue
+		}
+		r := types.VsanHostDiskResult{
+			Disk:  *disk,
+			State: string(types.VsanHostDiskResultStateInUse),
+		}
+		if disk.VsanDiskInfo != nil {
+			r.VsanUuid = disk.VsanDiskInfo.VsanUuid
+		}
+		res = append(res, r)
+	}
+
+	return &methods.QueryDisksForVsanBody{
+		Res: &types.QueryDisksForVsanResponse{
+			Returnval: res,
+		},
+	}
+}
@@ -18,6 +18,7 @@ package simulator
 
 import (
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"hash"
@@ -35,8 +36,9 @@ import (
 )
 
 type metadata struct {
-	sha1 []byte
-	size int64
+	sha1   []byte
+	sha256 []byte
+	size   int64
 }
 
 type HttpNfcLease struct {
@@ -72,11 +74,12 @@ func ServeNFC(w http.ResponseWriter, r *http.Request) {
 	status := http.StatusOK
 	var dst hash.Hash
 	var src io.ReadCloser
+	sum256 := sha256.New()
 
 	switch r.Method {
 	case http.MethodPut, http.MethodPost:
 		dst = sha1.New()
-		src = r.Body
+		src = io.NopCloser(io.TeeReader(r.Body, sum256)) // request Body is closed by the server
 	case http.MethodGet:
 		f, err := os.Open(file)
 		if err != nil {
@@ -92,8 +95,9 @@ func ServeNFC(w http.ResponseWriter, r *http.Request) {
 	_ = src.Close()
 	if dst != nil {
 		lease.metadata[name] = metadata{
-			sha1: dst.Sum(nil),
-			size: n,
+			sha1:   dst.Sum(nil),
+			sha256: sum256.Sum(nil),
+			size:   n,
 		}
 	}
 
@@ -185,9 +189,11 @@ func (l *HttpNfcLease) HttpNfcLeaseGetManifest(ctx *Context, req *types.HttpNfcL
 	entries := []types.HttpNfcLeaseManifestEntry{}
 	for name, md := range l.metadata {
 		entries = append(entries, types.HttpNfcLeaseManifestEntry{
-			Key:  l.getDeviceKey(name),
-			Sha1: hex.EncodeToString(md.sha1),
-			Size: md.size,
+			Key:          l.getDeviceKey(name),
+			Sha1:         hex.EncodeToString(md.sha1),
+			ChecksumType: string(types.HttpNfcLeaseManifestEntryChecksumTypeSha256),
+			Checksum:     hex.EncodeToString(md.sha256),
+			Size:         md.size,
 		})
 	}
 	return &methods.HttpNfcLeaseGetManifestBody{
@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// IoFilterManager tracks the IO filters installed per compute resource.
+// The filter ID and name are derived from the VIB URL file name, without extension.
+type IoFilterManager struct {
+	mo.IoFilterManager
+
+	filters map[types.ManagedObjectReference][]*ioFilter
+}
+
+type ioFilter struct {
+	types.ClusterIoFilterInfo
+
+	upgrades int
+}
+
+func (m *IoFilterManager) init(*Registry) {
+	m.filters = make(map[types.ManagedObjectReference][]*ioFilter)
+}
+
+func (m *IoFilterManager) hosts(ctx *Context, ref types.ManagedObjectReference) ([]types.ManagedObjectReference, types.BaseMethodFault) {
+	switch obj := ctx.Map.Get(ref).(type) {
+	case *ClusterComputeResource:
+		return obj.Host, nil
+	case *mo.ComputeResource:
+		return obj.Host, nil
+	}
+	return nil, &types.ManagedObjectNotFound{Obj: ref}
+}
+
+func (m *IoFilterManager) filter(ctx *Context, id string, ref types.ManagedObjectReference) (*ioFilter, types.BaseMethodFault) {
+	if _, fault := m.hosts(ctx, ref); fault != nil {
+		return nil, fault
+	}
+
+	for _, f := range m.filters[ref] {
+		if f.Id == id {
+			return f, nil
+		}
+	}
+
+	return nil, &types.NotFound{}
+}
+
+// disks returns the virtual disks of VMs on the compute resource that use the given filter.
+func (m *IoFilterManager) disks(ctx *Context, id string, ref types.ManagedObjectReference) []types.VirtualDiskId {
+	var disks []types.VirtualDiskId
+
+	hosts, _ := m.hosts(ctx, ref)
+	for _, h := range hosts {
+		host := ctx.Map.Get(h).(*HostSystem)
+		for _, ref := range host.Vm {
+			vm, ok := ctx.Map.Get(ref).(*VirtualMachine)
+			if !ok || vm.Config == nil {
+				continue
+			}
+			for _, device := range vm.Config.Hardware.Device {
+				if disk, ok := device.(*types.VirtualDisk); ok && slices.Contains(disk.Iofilter, id) {
+					disks = append(disks, types.VirtualDiskId{Vm: ref, DiskId: disk.Key})
+				}
+			}
+		}
+	}
+
+	return disks
+}
+
+func ioFilterName(url string) string {
+	name := path.Base(url)
+	return strings.TrimSuffix(name, path.Ext(name))
+}
+
+func (m *IoFilterManager) InstallIoFilterTask(ctx *Context, req *types.InstallIoFilter_Task) soap.HasFault {
+	task := CreateTask(m, "installIoFilter", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		if _, fault := m.hosts(ctx, req.CompRes); fault != nil {
+			return nil, fault
+		}
+
+		name := ioFilterName(req.VibUrl)
+		if name == "" || name == "." || name == "/" {
+			return nil, &types.InvalidArgument{InvalidProperty: "vibUrl"}
+		}
+
+		for _, f := range m.filters[req.CompRes] {
+			if f.Id == name {
+				return nil, &types.AlreadyExists{Name: name}
+			}
+		}
+
+		f := &ioFilter{
+			ClusterIoFilterInfo: types.ClusterIoFilterInfo{
+				IoFilterInfo: types.IoFilterInfo{
+					Id:          name,
+					Name:        name,
+					Vendor:      "VMW",
+					Version:     "1.0.0",
+					ReleaseDate: ctx.Map.Now().Format("2006-01-02"),
+				},
+				OpType: string(types.IoFilterOperationInstall),
+				VibUrl: req.VibUrl,
+			},
+		}
+
+		m.filters[req.CompRes] = append(m.filters[req.CompRes], f)
+
+		return nil, nil
+	})
+
+	return &methods.InstallIoFilter_TaskBody{
+		Res: &types.InstallIoFilter_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *IoFilterManager) UpgradeIoFilterTask(ctx *Context, req *types.UpgradeIoFilter_Task) soap.HasFault {
+	task := CreateTask(m, "upgradeIoFilter", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		f, fault := m.filter(ctx, req.FilterId, req.CompRes)
+		if fault != nil {
+			return nil, fault
+		}
+
+		f.upgrades++
+		f.Version = fmt.Sprintf("1.0.%d", f.upgrades)
+		f.OpType = string(types.IoFilterOperationUpgrade)
+		f.VibUrl = req.VibUrl
+
+		return nil, nil
+	})
+
+	return &methods.UpgradeIoFilter_TaskBody{
+		Res: &types.UpgradeIoFilter_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *IoFilterManager) UninstallIoFilterTask(ctx *Context, req *types.UninstallIoFilter_Task) soap.HasFault {
+	task := CreateTask(m, "uninstallIoFilter", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		f, fault := m.filter(ctx, req.FilterId, req.CompRes)
+		if fault != nil {
+			return nil, fault
+		}
+
+		if len(m.disks(ctx, req.FilterId, req.CompRes)) != 0 {
+			return nil, &types.ResourceInUse{Type: "IoFilter", Name: req.FilterId}
+		}
+
+		m.filters[req.CompRes] = slices.DeleteFunc(m.filters[req.CompRes], func(x *ioFilter) bool {
+			return x == f
+		})
+
+		return nil, nil
+	})
+
+	return &methods.UninstallIoFilter_TaskBody{
+		Res: &types.UninstallIoFilter_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *IoFilterManager) ResolveInstallationErrorsOnClusterTask(ctx *Context, req *types.ResolveInstallationErrorsOnCluster_Task) soap.HasFault {
+	task := CreateTask(m, "resolveInstallationErrorsOnCluster", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		_, fault := m.filter(ctx, req.FilterId, req.Cluster)
+		return nil, fault
+	})
+
+	return &methods.ResolveInstallationErrorsOnCluster_TaskBody{
+		Res: &types.ResolveInstallationErrorsOnCluster_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *IoFilterManager) QueryIoFilterInfo(ctx *Context, req *types.QueryIoFilterInfo) soap.HasFault {
+	body := new(methods.QueryIoFilterInfoBody)
+
+	if _, fault := m.hosts(ctx, req.CompRes); fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.QueryIoFilterInfoResponse)
+	for _, f := range m.filters[req.CompRes] {
+		body.Res.Returnval = append(body.Res.Returnval, f.ClusterIoFilterInfo)
+	}
+
+	return body
+}
+
+func (m *IoFilterManager) QueryIoFilterIssues(ctx *Context, req *types.QueryIoFilterIssues) soap.HasFault {
+	body := new(methods.QueryIoFilterIssuesBody)
+
+	f, fault := m.filter(ctx, req.FilterId, req.CompRes)
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.QueryIoFilterIssuesResponse{
+		Returnval: types.IoFilterQueryIssueResult{OpType: f.OpType},
+	}
+
+	return body
+}
+
+func (m *IoFilterManager) QueryDisksUsingFilter(ctx *Context, req *types.QueryDisksUsingFilter) soap.HasFault {
+	body := new(methods.QueryDisksUsingFilterBody)
+
+	if _, fault := m.filter(ctx, req.FilterId, req.CompRes); fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.QueryDisksUsingFilterResponse{
+		Returnval: m.disks(ctx, req.FilterId, req.CompRes),
+	}
+
+	return body
+}
@@ -60,15 +60,95 @@ type IpPoolManager struct {
 }
 
 func (m *IpPoolManager) init(*Registry) {
+	config := *ipPool.config
 	m.pools = map[int32]*IpPool{
-		1: ipPool,
+		1: MustNewIpPool(&config),
 	}
 	m.nextPoolId = 2
 }
 
-func (m *IpPoolManager) CreateIpPool(req *types.CreateIpPool) soap.HasFault {
+// ipPoolNetwork returns the Network of the given Network, DistributedVirtualPortgroup or OpaqueNetwork.
+func ipPoolNetwork(obj mo.Reference) *mo.Network {
+	switch n := obj.(type) {
+	case *mo.Network:
+		return n
+	case *mo.OpaqueNetwork:
+		return &n.Network
+	case *DistributedVirtualPortgroup:
+		return &n.Network
+	}
+	return nil
+}
+
+// validateNetworks returns a fault if any network associated with the pool does not exist,
+// otherwise sets the name of each associated network.
+func (m *IpPoolManager) validateNetworks(ctx *Context, pool *types.IpPool) *soap.Fault {
+	for i, a := range pool.NetworkAssociation {
+		if a.Network == nil {
+			continue
+		}
+
+		n := ipPoolNetwork(ctx.Map.Get(*a.Network))
+		if n == nil {
+			return Fault("", &types.InvalidArgument{InvalidProperty: fmt.Sprintf("pool.networkAssociation[%d].network", i)})
+		}
+
+		pool.NetworkAssociation[i].NetworkName = n.Name
+	}
+
+	return nil
+}
+
+// associateNetworks sets summary.ipPoolId and summary.ipPoolName of the networks associated with the given pool,
+// clearing these properties of the networks that are no longer associated with the pool.
+// A nil pool clears the properties of all networks associated with the pool id.
+func (m *IpPoolManager) associateNetworks(ctx *Context, id int32, pool *types.IpPool) {
+	associated := make(map[types.ManagedObjectReference]bool)
+	if pool != nil {
+		for _, a := range pool.NetworkAssociation {
+			if a.Network != nil {
+				associated[*a.Network] = true
+			}
+		}
+	}
+
+	for _, kind := range []string{"Network", "DistributedVirtualPortgroup", "OpaqueNetwork"} {
+		for _, obj := range ctx.Map.All(kind) {
+			n := ipPoolNetwork(obj)
+			if n == nil {
+				continue
+			}
+
+			ctx.WithLock(obj, func() {
+				summary := networkSummary(n)
+				s := summary.GetNetworkSummary()
+
+				switch {
+				case associated[n.Self]:
+					s.IpPoolId = types.NewInt32(id)
+					s.IpPoolName = pool.Name
+				case s.IpPoolId != nil && *s.IpPoolId == id:
+					s.IpPoolId = nil
+					s.IpPoolName = ""
+				default:
+					return
+				}
+
+				ctx.Map.Update(obj, []types.PropertyChange{{Name: "summary", Val: summary}})
+			})
+		}
+	}
+}
+
+func (m *IpPoolManager) CreateIpPool(ctx *Context, req *types.CreateIpPool) soap.HasFault {
 	body := &methods.CreateIpPoolBody{}
 	id := m.nextPoolId
+	req.Pool.Id = id
+
+	if fault := m.validateNetworks(ctx, &req.Pool); fault != nil {
+		body.Fault_ = fault
+		return body
+	}
 
 	var err error
 	m.pools[id], err = NewIpPool(&req.Pool)
@@ -78,6 +158,7 @@ func (m *IpPoolManager) CreateIpPool(req *types.CreateIpPool) soap.HasFault {
 	}
 
 	m.nextPoolId++
+	m.associateNetworks(ctx, id, &req.Pool)
 
 	body.Res = &types.CreateIpPoolResponse{
 		Returnval: id,
@@ -86,12 +167,22 @@ func (m *IpPoolManager) CreateIpPool(req *types.CreateIpPool) soap.HasFault {
 	return body
 }
 
-func (m *IpPoolManager) DestroyIpPool(req *types.DestroyIpPool) soap.HasFault {
-	delete(m.pools, req.Id)
+func (m *IpPoolManager) DestroyIpPool(ctx *Context, req *types.DestroyIpPool) soap.HasFault {
+	body := &methods.DestroyIpPoolBody{}
 
-	return &methods.DestroyIpPoolBody{
-		Res: &types.DestroyIpPoolResponse{},
+	if pool, ok := m.pools[req.Id]; ok {
+		if !req.Force && pool.config.AllocatedIpv4Addresses+pool.config.AllocatedIpv6Addresses != 0 {
+			body.Fault_ = Fault("pool is in use", &types.InvalidState{})
+			return body
+		}
+
+		delete(m.pools, req.Id)
+		m.associateNetworks(ctx, req.Id, nil)
 	}
+
+	body.Res = &types.DestroyIpPoolResponse{}
+
+	return body
 }
 
 func (m *IpPoolManager) QueryIpPools(req *types.QueryIpPools) soap.HasFault {
@@ -110,7 +201,7 @@ func (m *IpPoolManager) QueryIpPools(req *types.QueryIpPools) soap.HasFault {
 	}
 }
 
-func (m *IpPoolManager) UpdateIpPool(req *types.UpdateIpPool) soap.HasFault {
+func (m *IpPoolManager) UpdateIpPool(ctx *Context, req *types.UpdateIpPool) soap.HasFault {
 	body := &methods.UpdateIpPoolBody{}
 
 	var pool *IpPool
@@ -127,12 +218,19 @@ func (m *IpPoolManager) UpdateIpPool(req *types.UpdateIpPool) soap.HasFault {
 		return body
 	}
 
+	if fault := m.validateNetworks(ctx, &req.Pool); fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
 	m.pools[req.Pool.Id], err = NewIpPool(&req.Pool)
 	if err != nil {
 		body.Fault_ = Fault(err.Error(), &types.RuntimeFault{})
 		return body
 	}
 
+	m.associateNetworks(ctx, req.Pool.Id, &req.Pool)
+
 	body.Res = &types.UpdateIpPoolResponse{}
 
 	return body
@@ -149,7 +247,7 @@ func (m *IpPoolManager) AllocateIpv4Address(req *types.AllocateIpv4Address) soap
 
 	ip, err := pool.AllocateIPv4(req.AllocationId)
 	if err != nil {
-		body.Fault_ = Fault(err.Error(), &types.RuntimeFault{})
+		body.Fault_ = pool.fault(err)
 		return body
 	}
 
@@ -171,7 +269,7 @@ func (m *IpPoolManager) AllocateIpv6Address(req *types.AllocateIpv6Address) soap
 
 	ip, err := pool.AllocateIpv6(req.AllocationId)
 	if err != nil {
-		body.Fault_ = Fault(err.Error(), &types.RuntimeFault{})
+		body.Fault_ = pool.fault(err)
 		return body
 	}
 
@@ -234,6 +332,23 @@ var (
 	errInvalidAllocation = errors.New("allocation id not recognized")
 )
 
+// fault returns NoAvailableIp if the pool is exhausted, otherwise a RuntimeFault.
+func (p *IpPool) fault(err error) *soap.Fault {
+	if err != errNoIpAvailable {
+		return Fault(err.Error(), &types.RuntimeFault{})
+	}
+
+	fault := new(types.NoAvailableIp)
+	for _, a := range p.config.NetworkAssociation {
+		if a.Network != nil {
+			fault.Network = *a.Network
+			break
+		}
+	}
+
+	return Fault(err.Error(), fault)
+}
+
 type IpPool struct {
 	config         *types.IpPool
 	ipv4Allocation map[string]string
@@ -315,6 +430,9 @@ func (p *IpPool) init() error {
 		}
 	}
 
+	p.config.AvailableIpv4Addresses = int32(len(p.ipv4Pool))
+	p.config.AvailableIpv6Addresses = int32(len(p.ipv6Pool))
+
 	return nil
 }
 
@@ -24,7 +24,13 @@ import (
 	"testing"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -360,3 +366,97 @@ func TestIpPoolManagerAllocate(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestIpPoolManagerNetworks(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		m := object.NewIpPoolManager(c)
+		finder := find.NewFinder(c)
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		net, err := finder.Network(ctx, "DC0_DVPG0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := object.NewIpPoolConfig("10.0.0.0/24", "10.0.0.1", "10.0.0.10#2")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		summary := func() *types.NetworkSummary {
+			var pg mo.DistributedVirtualPortgroup
+			if err := property.DefaultCollector(c).RetrieveOne(ctx, net.Reference(), []string{"summary"}, &pg); err != nil {
+				t.Fatal(err)
+			}
+			return pg.Summary.GetNetworkSummary()
+		}
+
+		enoent := types.ManagedObjectReference{Type: "Network", Value: "enoent"}
+		_, err = m.CreateIpPool(ctx, dc, types.IpPool{
+			Name:               "invalid",
+			Ipv4Config:         config,
+			NetworkAssociation: []types.IpPoolAssociation{{Network: &enoent}},
+		})
+		if !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		ref := net.Reference()
+		id, err := m.CreateIpPool(ctx, dc, types.IpPool{
+			Name:               "k8s",
+			Ipv4Config:         config,
+			NetworkAssociation: []types.IpPoolAssociation{{Network: &ref}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s := summary(); s.IpPoolId == nil || *s.IpPoolId != id || s.IpPoolName != "k8s" {
+			t.Errorf("summary=%#v", s)
+		}
+
+		pool, err := m.FindIpPool(ctx, dc, "k8s")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pool.NetworkAssociation[0].NetworkName != "DC0_DVPG0" {
+			t.Errorf("association=%#v", pool.NetworkAssociation)
+		}
+
+		for _, alloc := range []string{"vm-1", "vm-2"} {
+			if _, err = m.AllocateIpv4Address(ctx, dc, id, alloc); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var exhausted *types.NoAvailableIp
+		_, err = m.AllocateIpv4Address(ctx, dc, id, "vm-3")
+		if _, ok := fault.As(err, &exhausted); !ok || exhausted.Network != ref {
+			t.Errorf("err=%v", err)
+		}
+
+		if err = m.ReleaseIpAllocation(ctx, dc, id, "vm-1"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = m.AllocateIpv4Address(ctx, dc, id, "vm-3"); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = m.DestroyIpPool(ctx, dc, id, false); !fault.Is(err, &types.InvalidState{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		if err = m.DestroyIpPool(ctx, dc, id, true); err != nil {
+			t.Fatal(err)
+		}
+
+		if s := summary(); s.IpPoolId != nil || s.IpPoolName != "" {
+			t.Errorf("summary=%#v", s)
+		}
+	})
+}
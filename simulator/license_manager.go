@@ -30,6 +30,8 @@ limitations under the License.
 package simulator
 
 import (
+	"regexp"
+
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -59,6 +61,13 @@ var EvalLicense = types.LicenseManagerLicenseInfo{
 	},
 }
 
+// Licenses defines the license keys known to LicenseManager.AddLicense, including the features they
+// enable (Properties with Key "feature") and their capacity (Total units of CostUnit).
+// Other license keys are added with the features of EvalLicense and no capacity limit.
+var Licenses = map[string]types.LicenseManagerLicenseInfo{}
+
+var licenseKeyFormat = regexp.MustCompile(`^[0-9A-Z]{5}(-[0-9A-Z]{5}){4}$`)
+
 type LicenseManager struct {
 	mo.LicenseManager
 }
@@ -79,17 +88,31 @@ func (m *LicenseManager) AddLicense(req *types.AddLicense) soap.HasFault {
 
 	for _, license := range m.Licenses {
 		if license.LicenseKey == req.LicenseKey {
-			body.Res.Returnval = licenseInfo(license.LicenseKey, license.Labels)
+			body.Res.Returnval = license
 			return body
 		}
 	}
 
-	m.Licenses = append(m.Licenses, types.LicenseManagerLicenseInfo{
-		LicenseKey: req.LicenseKey,
-		Labels:     req.Labels,
-	})
+	if !licenseKeyFormat.MatchString(req.LicenseKey) {
+		body.Fault_ = Fault("", &types.InvalidLicense{LicenseContent: req.LicenseKey})
+		return body
+	}
 
-	body.Res.Returnval = licenseInfo(req.LicenseKey, req.Labels)
+	info := licenseInfo(req.LicenseKey, req.Labels)
+	if license, ok := Licenses[req.LicenseKey]; ok {
+		if license.CostUnit != "" && license.Total <= 0 {
+			body.Fault_ = Fault("no license capacity", &types.InvalidLicense{LicenseContent: req.LicenseKey})
+			return body
+		}
+		info = license
+		info.LicenseKey = req.LicenseKey
+		info.Labels = req.Labels
+		info.Used = 0
+	}
+
+	m.Licenses = append(m.Licenses, info)
+
+	body.Res.Returnval = info
 
 	return body
 }
@@ -101,6 +124,10 @@ func (m *LicenseManager) RemoveLicense(req *types.RemoveLicense) soap.HasFault {
 
 	for i, license := range m.Licenses {
 		if req.LicenseKey == license.LicenseKey {
+			if license.Used != 0 {
+				body.Fault_ = Fault("license is in use", &types.ResourceInUse{Name: license.LicenseKey})
+				return body
+			}
 			m.Licenses = append(m.Licenses[:i], m.Licenses[i+1:]...)
 			return body
 		}
@@ -108,6 +135,15 @@ func (m *LicenseManager) RemoveLicense(req *types.RemoveLicense) soap.HasFault {
 	return body
 }
 
+func (m *LicenseManager) license(key string) *types.LicenseManagerLicenseInfo {
+	for i := range m.Licenses {
+		if m.Licenses[i].LicenseKey == key {
+			return &m.Licenses[i]
+		}
+	}
+	return nil
+}
+
 func (m *LicenseManager) UpdateLicenseLabel(req *types.UpdateLicenseLabel) soap.HasFault {
 	body := &methods.UpdateLicenseLabelBody{}
 
@@ -147,37 +183,192 @@ func (m *LicenseManager) UpdateLicenseLabel(req *types.UpdateLicenseLabel) soap.
 
 type LicenseAssignmentManager struct {
 	mo.LicenseAssignmentManager
+
+	// assigned maps an entity ID to its assigned license key.
+	// Entities without an assignment use EvalLicense.
+	assigned map[string]types.LicenseAssignmentManagerLicenseAssignment
+}
+
+// licenseEntity returns the HostSystem for the given entity ID, or nil for the vCenter InstanceUuid.
+func licenseEntity(ctx *Context, id string) (*HostSystem, types.BaseMethodFault) {
+	if id == ctx.Map.content().About.InstanceUuid {
+		return nil, nil
+	}
+
+	host, ok := ctx.Map.Get(types.ManagedObjectReference{Type: "HostSystem", Value: id}).(*HostSystem)
+	if !ok {
+		return nil, &types.LicenseEntityNotFound{EntityId: id}
+	}
+
+	return host, nil
 }
 
-func (m *LicenseAssignmentManager) QueryAssignedLicenses(req *types.QueryAssignedLicenses) soap.HasFault {
+// licenseCost returns the number of license units used by the given entity.
+func licenseCost(host *HostSystem, license *types.LicenseManagerLicenseInfo) int32 {
+	if host != nil && license.CostUnit == "cpuPackage" && host.Hardware != nil {
+		return int32(host.Hardware.CpuInfo.NumCpuPackages)
+	}
+	return 1
+}
+
+func (m *LicenseAssignmentManager) assignment(ctx *Context, id string) types.LicenseAssignmentManagerLicenseAssignment {
+	a, ok := m.assigned[id]
+	if !ok {
+		return types.LicenseAssignmentManagerLicenseAssignment{
+			EntityId:        id,
+			AssignedLicense: EvalLicense,
+		}
+	}
+
+	lm := ctx.Map.Get(*ctx.Map.content().LicenseManager).(*LicenseManager)
+	ctx.WithLock(lm, func() {
+		if license := lm.license(a.AssignedLicense.LicenseKey); license != nil {
+			a.AssignedLicense = *license
+		}
+	})
+
+	return a
+}
+
+func (m *LicenseAssignmentManager) QueryAssignedLicenses(ctx *Context, req *types.QueryAssignedLicenses) soap.HasFault {
 	body := &methods.QueryAssignedLicensesBody{
 		Res: &types.QueryAssignedLicensesResponse{},
 	}
 
+	if req.EntityId == "" && len(m.assigned) != 0 {
+		for id := range m.assigned {
+			body.Res.Returnval = append(body.Res.Returnval, m.assignment(ctx, id))
+		}
+		return body
+	}
+
 	// EntityId can be a HostSystem or the vCenter InstanceUuid
 	if req.EntityId != "" {
-		if req.EntityId != Map.content().About.InstanceUuid {
-			id := types.ManagedObjectReference{
-				Type:  "HostSystem",
-				Value: req.EntityId,
-			}
+		if _, fault := licenseEntity(ctx, req.EntityId); fault != nil {
+			return body
+		}
+	}
 
-			if Map.Get(id) == nil {
-				return body
+	body.Res.Returnval = []types.LicenseAssignmentManagerLicenseAssignment{
+		m.assignment(ctx, req.EntityId),
+	}
+
+	return body
+}
+
+func (m *LicenseAssignmentManager) UpdateAssignedLicense(ctx *Context, req *types.UpdateAssignedLicense) soap.HasFault {
+	body := new(methods.UpdateAssignedLicenseBody)
+
+	host, fault := licenseEntity(ctx, req.Entity)
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	lm := ctx.Map.Get(*ctx.Map.content().LicenseManager).(*LicenseManager)
+	ctx.WithLock(lm, func() {
+		license := lm.license(req.LicenseKey)
+		if license == nil {
+			fault = &types.InvalidLicense{LicenseContent: req.LicenseKey}
+			return
+		}
+
+		prev, ok := m.assigned[req.Entity]
+		if ok && prev.AssignedLicense.LicenseKey == req.LicenseKey {
+			body.Res = &types.UpdateAssignedLicenseResponse{Returnval: *license}
+			return
+		}
+
+		cost := licenseCost(host, license)
+		if license.CostUnit != "" && license.Used+cost > license.Total {
+			fault = new(types.NotEnoughLicenses)
+			return
+		}
+
+		if ok {
+			if old := lm.license(prev.AssignedLicense.LicenseKey); old != nil {
+				old.Used -= licenseCost(host, old)
 			}
 		}
+		license.Used += cost
+
+		if m.assigned == nil {
+			m.assigned = make(map[string]types.LicenseAssignmentManagerLicenseAssignment)
+		}
+		m.assigned[req.Entity] = types.LicenseAssignmentManagerLicenseAssignment{
+			EntityId:          req.Entity,
+			EntityDisplayName: req.EntityDisplayName,
+			AssignedLicense:   *license,
+		}
+
+		body.Res = &types.UpdateAssignedLicenseResponse{Returnval: *license}
+	})
+
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
 	}
 
-	body.Res.Returnval = []types.LicenseAssignmentManagerLicenseAssignment{
-		{
-			EntityId:        req.EntityId,
-			AssignedLicense: EvalLicense,
-		},
+	return body
+}
+
+func (m *LicenseAssignmentManager) RemoveAssignedLicense(ctx *Context, req *types.RemoveAssignedLicense) soap.HasFault {
+	body := &methods.RemoveAssignedLicenseBody{
+		Res: new(types.RemoveAssignedLicenseResponse),
+	}
+
+	prev, ok := m.assigned[req.EntityId]
+	if !ok {
+		return body
 	}
 
+	host, _ := licenseEntity(ctx, req.EntityId)
+
+	lm := ctx.Map.Get(*ctx.Map.content().LicenseManager).(*LicenseManager)
+	ctx.WithLock(lm, func() {
+		if license := lm.license(prev.AssignedLicense.LicenseKey); license != nil {
+			license.Used -= licenseCost(host, license)
+		}
+	})
+
+	delete(m.assigned, req.EntityId)
+
 	return body
 }
 
+// licensed returns LicenseRestricted if the license assigned to the given entity does not include the feature.
+// The evaluation license includes all features.
+func licensed(ctx *Context, id string, feature string) types.BaseMethodFault {
+	ref := ctx.Map.content().LicenseManager
+	if ref == nil {
+		return nil
+	}
+	lm := ctx.Map.Get(*ref).(*LicenseManager)
+	if lm.LicenseAssignmentManager == nil {
+		return nil
+	}
+	am := ctx.Map.Get(*lm.LicenseAssignmentManager).(*LicenseAssignmentManager)
+
+	var license types.LicenseManagerLicenseInfo
+	ctx.WithLock(am, func() {
+		license = am.assignment(ctx, id).AssignedLicense
+	})
+
+	if license.EditionKey == EvalLicense.EditionKey {
+		return nil
+	}
+
+	for _, p := range license.Properties {
+		if p.Key != "feature" {
+			continue
+		}
+		if kv, ok := p.Value.(types.KeyValue); ok && kv.Key == feature {
+			return nil
+		}
+	}
+
+	return new(types.LicenseRestricted)
+}
+
 func licenseInfo(key string, labels []types.KeyValue) types.LicenseManagerLicenseInfo {
 	info := EvalLicense
 
@@ -22,8 +22,11 @@ import (
 	"testing"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/license"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func TestLicenseManagerVPX(t *testing.T) {
@@ -204,3 +207,169 @@ func TestAddRemoveLicense(t *testing.T) {
 		t.Fatal("no licenses")
 	}
 }
+
+func TestLicenseFeatures(t *testing.T) {
+	key := "ABCDE-00000-00000-00000-00001"
+	Licenses[key] = types.LicenseManagerLicenseInfo{
+		EditionKey: "esx.enterprise.cpuPackage",
+		Name:       "vSphere Enterprise",
+		Total:      2,
+		CostUnit:   "cpuPackage",
+		Properties: []types.KeyAnyValue{
+			{Key: "feature", Value: types.KeyValue{Key: "vmotion", Value: "vSphere vMotion"}},
+		},
+	}
+	defer delete(Licenses, key)
+
+	Test(func(ctx context.Context, c *vim25.Client) {
+		lm := license.NewManager(c)
+		am, err := lm.AssignmentManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = lm.Add(ctx, "invalid", nil)
+		if !fault.Is(err, &types.InvalidLicense{}) {
+			t.Errorf("expected InvalidLicense, got: %v", err)
+		}
+
+		info, err := lm.Add(ctx, key, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Total != 2 || info.Used != 0 {
+			t.Errorf("info=%#v", info)
+		}
+
+		finder := find.NewFinder(c)
+		cluster, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		hosts, err := cluster.Hosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// each host uses 2 cpuPackage units
+		_, err = am.Update(ctx, hosts[0].Reference().Value, key, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = am.Update(ctx, hosts[1].Reference().Value, key, "")
+		if !fault.Is(err, &types.NotEnoughLicenses{}) {
+			t.Errorf("expected NotEnoughLicenses, got: %v", err)
+		}
+		_, err = am.Update(ctx, "enoent", key, "")
+		if !fault.Is(err, &types.LicenseEntityNotFound{}) {
+			t.Errorf("expected LicenseEntityNotFound, got: %v", err)
+		}
+
+		la, err := am.QueryAssigned(ctx, hosts[0].Reference().Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if la[0].AssignedLicense.LicenseKey != key || la[0].AssignedLicense.Used != 2 {
+			t.Errorf("assigned=%#v", la[0].AssignedLicense)
+		}
+
+		err = lm.Remove(ctx, key)
+		if !fault.Is(err, &types.ResourceInUse{}) {
+			t.Errorf("expected ResourceInUse, got: %v", err)
+		}
+
+		// drs is not a feature of the assigned license
+		spec := &types.ClusterConfigSpecEx{
+			DrsConfig: &types.ClusterDrsConfigInfo{Enabled: types.NewBool(true)},
+		}
+		task, err := cluster.Reconfigure(ctx, spec, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = task.Wait(ctx)
+		if !fault.Is(err, &types.LicenseRestricted{}) {
+			t.Errorf("expected LicenseRestricted, got: %v", err)
+		}
+
+		// vMotion to a host licensed without cross switch vMotion
+		vm, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		src, err := vm.HostSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if src.Reference() == hosts[0].Reference() {
+			if err = am.Remove(ctx, hosts[0].Reference().Value); err != nil {
+				t.Fatal(err)
+			}
+			if _, err = am.Update(ctx, hosts[1].Reference().Value, key, ""); err != nil {
+				t.Fatal(err)
+			}
+			hosts[0], hosts[1] = hosts[1], hosts[0]
+		}
+
+		devices, err := vm.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nic := devices.SelectByType((*types.VirtualEthernetCard)(nil))[0]
+		pg, err := finder.Network(ctx, "VM Network")
+		if err != nil {
+			t.Fatal(err)
+		}
+		backing, err := pg.EthernetCardBackingInfo(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nic.GetVirtualDevice().Backing = backing
+
+		host := hosts[0].Reference()
+		rspec := types.VirtualMachineRelocateSpec{
+			Host: &host,
+			DeviceChange: []types.BaseVirtualDeviceConfigSpec{&types.VirtualDeviceConfigSpec{
+				Operation: types.VirtualDeviceConfigSpecOperationEdit,
+				Device:    nic,
+			}},
+		}
+		task, err = vm.Relocate(ctx, rspec, types.VirtualMachineMovePriorityDefaultPriority)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = task.Wait(ctx)
+		if !fault.Is(err, &types.LicenseRestricted{}) {
+			t.Errorf("expected LicenseRestricted, got: %v", err)
+		}
+
+		// evaluation mode includes all features
+		if err = am.Remove(ctx, host.Value); err != nil {
+			t.Fatal(err)
+		}
+		la, err = am.QueryAssigned(ctx, host.Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if la[0].AssignedLicense.EditionKey != EvalLicense.EditionKey {
+			t.Errorf("assigned=%#v", la[0].AssignedLicense)
+		}
+		task, err = vm.Relocate(ctx, rspec, types.VirtualMachineMovePriorityDefaultPriority)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Error(err)
+		}
+		task, err = cluster.Reconfigure(ctx, spec, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Error(err)
+		}
+
+		if err = lm.Remove(ctx, key); err != nil {
+			t.Error(err)
+		}
+	})
+}
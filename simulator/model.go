@@ -56,6 +56,17 @@ type DelayConfig struct {
 	// DelayJitter defines the delay jitter as a coefficient of variation (stddev/mean).
 	// This can be used to simulate unpredictable delay. 0 means no jitter, i.e. all invocations get the same delay.
 	DelayJitter float64
+
+	// Failure specifies the probability, in the range of 0 to 1, that a call fails with Fault. 0 means no failure.
+	// This can be used to test client retry and error handling.
+	Failure float64
+
+	// MethodFailure specifies the failure probability of a specific method, overriding Failure.
+	// Each entry in the map represents the name of a method and its associated failure probability.
+	MethodFailure map[string]float64
+
+	// Fault is returned by calls failed according to Failure or MethodFailure, defaults to SystemError.
+	Fault types.BaseMethodFault
 }
 
 // Model is used to populate a Model with an initial set of managed entities.
@@ -140,9 +151,44 @@ type Model struct {
 	// Name prefix: POD, vcsim flag: -pod
 	Pod int `json:"pod"`
 
+	// Vsan specifies the number of ClusterComputeResource entities per Datacenter to enable vSAN on,
+	// starting with the first cluster. Each vSAN cluster has a vsanDatastore mounted on the cluster's hosts.
+	// Datastore name: vsanDatastore, vcsim flag: -vsan
+	Vsan int `json:"vsan"`
+
 	// Delay configurations
 	DelayConfig DelayConfig `json:"-"`
 
+	// PerfMetricConfig configures synthetic performance metric values, see Registry.SetPerfMetricConfig
+	PerfMetricConfig map[string]PerfMetricConfig `json:"-"`
+
+	// Clock is the source of the current time, see Registry.SetClock
+	Clock Clock `json:"-"`
+
+	// StrictPermissions enables authorization of each method call against the permissions
+	// assigned via AuthorizationManager, see Registry.SetStrictPermissions
+	// vcsim flag: -strict-permissions
+	StrictPermissions bool `json:"-"`
+
+	// AdmissionControl enables enforcement of resource pool CPU and memory reservations,
+	// see Registry.SetAdmissionControl
+	// vcsim flag: -admission-control
+	AdmissionControl bool `json:"-"`
+
+	// Linked specifies the URLs of other vCenter simulator instances in the same SSO domain, as with Enhanced Linked Mode.
+	// The LookupService includes a vcenterserver registration for each instance,
+	// which can be the destination of a cross vCenter clone or relocate using a ServiceLocator.
+	// vcsim flag: -linked
+	Linked []string `json:"-"`
+
+	// Persist specifies a directory where the Model is saved by Remove and loaded from by Create,
+	// allowing simulator state to survive process restarts.
+	// If the directory does not contain a saved Model, Create populates the inventory as usual.
+	// The Model is saved to the "model" sub directory and Datastore files are stored in the
+	// "datastore" sub directory, rather than a temporary directory.
+	// vcsim flag: -persist
+	Persist string `json:"-"`
+
 	// total number of inventory objects, set by Count()
 	total int
 
@@ -232,9 +278,11 @@ func (*Model) fmtName(prefix string, num int) string {
 
 // kinds maps managed object types to their vcsim wrapper types
 var kinds = map[string]reflect.Type{
+	"Alarm":                              reflect.TypeOf((*Alarm)(nil)).Elem(),
 	"AlarmManager":                       reflect.TypeOf((*AlarmManager)(nil)).Elem(),
 	"AuthorizationManager":               reflect.TypeOf((*AuthorizationManager)(nil)).Elem(),
 	"ClusterComputeResource":             reflect.TypeOf((*ClusterComputeResource)(nil)).Elem(),
+	"ClusterEVCManager":                  reflect.TypeOf((*ClusterEVCManager)(nil)).Elem(),
 	"CustomFieldsManager":                reflect.TypeOf((*CustomFieldsManager)(nil)).Elem(),
 	"CustomizationSpecManager":           reflect.TypeOf((*CustomizationSpecManager)(nil)).Elem(),
 	"CryptoManagerKmip":                  reflect.TypeOf((*CryptoManagerKmip)(nil)).Elem(),
@@ -250,16 +298,31 @@ var kinds = map[string]reflect.Type{
 	"FileManager":                        reflect.TypeOf((*FileManager)(nil)).Elem(),
 	"Folder":                             reflect.TypeOf((*Folder)(nil)).Elem(),
 	"GuestOperationsManager":             reflect.TypeOf((*GuestOperationsManager)(nil)).Elem(),
+	"HostAccessManager":                  reflect.TypeOf((*HostAccessManager)(nil)).Elem(),
 	"HostDatastoreBrowser":               reflect.TypeOf((*HostDatastoreBrowser)(nil)).Elem(),
+	"HostDatastoreSystem":                reflect.TypeOf((*HostDatastoreSystem)(nil)).Elem(),
+	"HostDateTimeSystem":                 reflect.TypeOf((*HostDateTimeSystem)(nil)).Elem(),
+	"HostFirewallSystem":                 reflect.TypeOf((*HostFirewallSystem)(nil)).Elem(),
 	"HostLocalAccountManager":            reflect.TypeOf((*HostLocalAccountManager)(nil)).Elem(),
 	"HostNetworkSystem":                  reflect.TypeOf((*HostNetworkSystem)(nil)).Elem(),
+	"HostPatchManager":                   reflect.TypeOf((*HostPatchManager)(nil)).Elem(),
+	"HostPowerSystem":                    reflect.TypeOf((*HostPowerSystem)(nil)).Elem(),
+	"HostProfile":                        reflect.TypeOf((*HostProfile)(nil)).Elem(),
+	"HostProfileManager":                 reflect.TypeOf((*HostProfileManager)(nil)).Elem(),
 	"HostCertificateManager":             reflect.TypeOf((*HostCertificateManager)(nil)).Elem(),
+	"HostServiceSystem":                  reflect.TypeOf((*HostServiceSystem)(nil)).Elem(),
+	"HostStorageSystem":                  reflect.TypeOf((*HostStorageSystem)(nil)).Elem(),
 	"HostSystem":                         reflect.TypeOf((*HostSystem)(nil)).Elem(),
+	"HostVirtualNicManager":              reflect.TypeOf((*HostVirtualNicManager)(nil)).Elem(),
+	"HostVsanSystem":                     reflect.TypeOf((*HostVsanSystem)(nil)).Elem(),
+	"IoFilterManager":                    reflect.TypeOf((*IoFilterManager)(nil)).Elem(),
 	"IpPoolManager":                      reflect.TypeOf((*IpPoolManager)(nil)).Elem(),
+	"LicenseAssignmentManager":           reflect.TypeOf((*LicenseAssignmentManager)(nil)).Elem(),
 	"LicenseManager":                     reflect.TypeOf((*LicenseManager)(nil)).Elem(),
 	"OptionManager":                      reflect.TypeOf((*OptionManager)(nil)).Elem(),
 	"OvfManager":                         reflect.TypeOf((*OvfManager)(nil)).Elem(),
 	"PerformanceManager":                 reflect.TypeOf((*PerformanceManager)(nil)).Elem(),
+	"ProfileComplianceManager":           reflect.TypeOf((*ProfileComplianceManager)(nil)).Elem(),
 	"PropertyCollector":                  reflect.TypeOf((*PropertyCollector)(nil)).Elem(),
 	"ResourcePool":                       reflect.TypeOf((*ResourcePool)(nil)).Elem(),
 	"SearchIndex":                        reflect.TypeOf((*SearchIndex)(nil)).Elem(),
@@ -277,6 +340,7 @@ var kinds = map[string]reflect.Type{
 	"VirtualMachineCompatibilityChecker": reflect.TypeOf((*VmCompatibilityChecker)(nil)).Elem(),
 	"VirtualMachineProvisioningChecker":  reflect.TypeOf((*VmProvisioningChecker)(nil)).Elem(),
 	"VmwareDistributedVirtualSwitch":     reflect.TypeOf((*DistributedVirtualSwitch)(nil)).Elem(),
+	"VsanUpgradeSystem":                  reflect.TypeOf((*VsanUpgradeSystem)(nil)).Elem(),
 }
 
 func loadObject(content types.ObjectContent) (mo.Reference, error) {
@@ -289,7 +353,7 @@ func loadObject(content types.ObjectContent) (mo.Reference, error) {
 	}
 
 	if obj == nil {
-		// No vcsim wrapper for this type, e.g. IoFilterManager
+		// No vcsim wrapper for this type, e.g. HostSpecificationManager
 		x, err := mo.ObjectContentToType(content, true)
 		if err != nil {
 			return nil, err
@@ -386,6 +450,12 @@ func (m *Model) loadMethod(obj mo.Reference, dir string) error {
 	for _, x := range info {
 		name := strings.TrimSuffix(x.Name(), ".xml") + "Response"
 		path := filepath.Join(dir, x.Name())
+		if ok, err := m.loadState(obj, name, path); ok {
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		response := reflect.ValueOf(obj).Elem().FieldByName(name)
 		if response == zero {
 			return fmt.Errorf("field %T.%s not found", obj, name)
@@ -466,6 +536,8 @@ func (m *Model) Load(dir string) error {
 			}
 		}
 
+		ctx.Map.reserve(obj.Reference())
+
 		return m.loadMethod(ctx.Map.Put(obj), dir)
 	})
 
@@ -474,18 +546,58 @@ func (m *Model) Load(dir string) error {
 	}
 
 	m.Service = New(s)
+	m.Service.dir = dir
+	m.Service.delay = &m.DelayConfig
+	m.configure(ctx.Map)
 
 	return m.resolveReferences(ctx)
 }
 
 // Create populates the Model with the given ModelConfig
 func (m *Model) Create() error {
+	if m.Persist != "" {
+		if dir := filepath.Join(m.Persist, persistModelDir); saved(dir) {
+			return m.Load(dir)
+		}
+	}
+
+	if len(m.Linked) != 0 {
+		// instances in the same SSO domain are identified by InstanceUuid
+		m.ServiceContent.About.InstanceUuid = uuid.New().String()
+	}
+
 	ctx := SpoofContext()
 	m.Service = New(NewServiceInstance(ctx, m.ServiceContent, m.RootFolder))
 	ctx.Map = Map
+	m.configure(ctx.Map)
 	return m.CreateInfrastructure(ctx)
 }
 
+func (m *Model) configure(r *Registry) {
+	for name, config := range m.PerfMetricConfig {
+		r.SetPerfMetricConfig(name, config)
+	}
+	if m.Clock != nil {
+		r.SetClock(m.Clock)
+	}
+	if m.StrictPermissions {
+		r.SetStrictPermissions(true)
+	}
+	if m.AdmissionControl {
+		r.SetAdmissionControl(true)
+	}
+	if len(m.Linked) != 0 {
+		// see lookup/simulator
+		key, val := "vcsim.linked.url", strings.Join(m.Linked, ",")
+		om := r.OptionManager()
+		if opt := om.find(key); opt != nil {
+			opt.Value = val
+		} else {
+			om.Setting = append(om.Setting, &types.OptionValue{Key: key, Value: val})
+		}
+	}
+}
+
 func (m *Model) CreateInfrastructure(ctx *Context) error {
 	client := m.Service.client
 	root := object.NewRootFolder(client)
@@ -496,6 +608,8 @@ func (m *Model) CreateInfrastructure(ctx *Context) error {
 
 	// We need to defer VM creation until after the datastores are created.
 	var vms []func() error
+	// vsanDatastores are created after the local datastores, such that LocalDS_0 remains the first datastore of each host.
+	var vsan []func() error
 	// 1 DVS per DC, added to all hosts
 	var dvs *object.DistributedVirtualSwitch
 	// 1 NIC per VM, backed by a DVPG if Model.Portgroup > 0
@@ -664,9 +778,10 @@ func (m *Model) CreateInfrastructure(ctx *Context) error {
 		for npg := 0; npg < m.Portgroup; npg++ {
 			name := m.fmtName(dcName+"_DVPG", npg)
 			spec := types.DVPortgroupConfigSpec{
-				Name:     name,
-				Type:     string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding),
-				NumPorts: 1,
+				Name:       name,
+				Type:       string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding),
+				NumPorts:   1,
+				AutoExpand: types.NewBool(true),
 			}
 
 			task, err := dvs.AddPortgroup(ctx, []types.DVPortgroupConfigSpec{spec})
@@ -737,15 +852,41 @@ func (m *Model) CreateInfrastructure(ctx *Context) error {
 				return err
 			}
 
+			var clusterHosts []*object.HostSystem
+
 			for nhost := 0; nhost < m.ClusterHost; nhost++ {
 				name := m.fmtName(clusterName+"_H", nhost)
 
-				_, err = addHost(name, func(spec types.HostConnectSpec) (*object.Task, error) {
+				host, err := addHost(name, func(spec types.HostConnectSpec) (*object.Task, error) {
 					return cluster.AddHost(ctx, spec, true, nil, nil)
 				})
 				if err != nil {
 					return err
 				}
+				clusterHosts = append(clusterHosts, host)
+			}
+
+			if ncluster < m.Vsan {
+				spec := &types.ClusterConfigSpecEx{
+					VsanConfig: &types.VsanClusterConfigInfo{Enabled: types.NewBool(true)},
+				}
+
+				task, err := cluster.Reconfigure(ctx, spec, true)
+				if err != nil {
+					return err
+				}
+				if err = task.Wait(ctx); err != nil {
+					return err
+				}
+
+				name := "vsanDatastore"
+				if ncluster > 0 {
+					name = fmt.Sprintf("%s (%d)", name, ncluster)
+				}
+
+				vsan = append(vsan, func() error {
+					return m.createVsanDatastore(ctx, dcName, name, clusterHosts)
+				})
 			}
 
 			rootRP, err := cluster.ResourcePool(ctx)
@@ -815,6 +956,12 @@ func (m *Model) CreateInfrastructure(ctx *Context) error {
 		}
 	}
 
+	for _, createDatastore := range vsan {
+		if err := createDatastore(); err != nil {
+			return err
+		}
+	}
+
 	for _, createVM := range vms {
 		err := createVM()
 		if err != nil {
@@ -829,6 +976,11 @@ func (m *Model) CreateInfrastructure(ctx *Context) error {
 }
 
 func (m *Model) createTempDir(dc string, name string) (string, error) {
+	if m.Persist != "" {
+		dir := filepath.Join(m.Persist, persistDatastoreDir, fmt.Sprintf("%s-%s", dc, name))
+		return dir, os.MkdirAll(dir, 0755)
+	}
+
 	dir, err := os.MkdirTemp("", fmt.Sprintf("govcsim-%s-%s-", dc, name))
 	if err == nil {
 		m.dirs = append(m.dirs, dir)
@@ -858,8 +1010,40 @@ func (m *Model) createLocalDatastore(dc string, name string, hosts []*object.Hos
 	return nil
 }
 
-// Remove cleans up items created by the Model, such as local datastore directories
+// createVsanDatastore creates a datastore with temporary local file storage, as createLocalDatastore does,
+// typed as a vsanDatastore of the cluster the given hosts are members of.
+func (m *Model) createVsanDatastore(ctx *Context, dc string, name string, hosts []*object.HostSystem) error {
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	if err := m.createLocalDatastore(dc, name, hosts); err != nil {
+		return err
+	}
+
+	host := ctx.Map.Get(hosts[0].Reference()).(*HostSystem)
+	ds := ctx.Map.FindByName(name, host.Datastore).(*Datastore)
+
+	info := &types.VsanDatastoreInfo{DatastoreInfo: *ds.Info.GetDatastoreInfo()}
+	if config := host.Config.VsanHostConfig; config != nil && config.ClusterInfo != nil {
+		info.MembershipUuid = config.ClusterInfo.Uuid
+	}
+
+	ds.Info = info
+	ds.Summary.Type = string(types.HostFileSystemVolumeFileSystemTypeVsan)
+
+	return nil
+}
+
+// Remove cleans up items created by the Model, such as local datastore directories.
+// If Model.Persist is set, the Model is saved to that directory first.
 func (m *Model) Remove() {
+	if m.Persist != "" {
+		if err := m.Save(filepath.Join(m.Persist, persistModelDir)); err != nil {
+			log.Printf("failed to save model to %s: %s", m.Persist, err)
+		}
+	}
+
 	// Remove associated vm containers, if any
 	Map.m.Lock()
 	for _, obj := range Map.objects {
@@ -958,8 +1142,8 @@ func RunContainer(ctx context.Context, c *vim25.Client, vm mo.Reference, args st
 	return task.Wait(ctx)
 }
 
-// delay sleeps according to DelayConfig. If no delay specified, returns immediately.
-func (dc *DelayConfig) delay(method string) {
+// duration returns the delay of the given method according to DelayConfig.
+func (dc *DelayConfig) duration(method string) time.Duration {
 	d := 0
 	if dc.Delay > 0 {
 		d = dc.Delay
@@ -970,8 +1154,27 @@ func (dc *DelayConfig) delay(method string) {
 	if dc.DelayJitter > 0 {
 		d += int(rand.NormFloat64() * dc.DelayJitter * float64(d))
 	}
-	if d > 0 {
-		//fmt.Printf("Delaying method %s %d ms\n", method, d)
-		time.Sleep(time.Duration(d) * time.Millisecond)
+	return time.Duration(d) * time.Millisecond
+}
+
+// delay sleeps according to DelayConfig. If no delay specified, returns immediately.
+func (dc *DelayConfig) delay(method string) {
+	if d := dc.duration(method); d > 0 {
+		time.Sleep(d)
+	}
+}
+
+// fault returns the fault of the given method if it should fail according to DelayConfig, otherwise nil.
+func (dc *DelayConfig) fault(method string) types.BaseMethodFault {
+	rate := dc.Failure
+	if mf, ok := dc.MethodFailure[method]; ok {
+		rate = mf
+	}
+	if rate <= 0 || rand.Float64() >= rate {
+		return nil
+	}
+	if dc.Fault == nil {
+		return &types.SystemError{Reason: "vcsim failure injection"}
 	}
+	return dc.Fault
 }
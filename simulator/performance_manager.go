@@ -1,5 +1,5 @@
 /*
-Copyright (c) 2017 VMware, Inc. All Rights Reserved.
+Copyright (c) 2017-2024 VMware, Inc. All Rights Reserved.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -17,7 +17,10 @@ limitations under the License.
 package simulator
 
 import (
-	"math/rand"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -171,6 +174,260 @@ func (p *PerformanceManager) QueryAvailablePerfMetric(ctx *Context, req *types.Q
 	return body
 }
 
+// PerfWaveform specifies the pattern of the synthetic metric values returned by QueryPerf, see PerfMetricConfig.
+type PerfWaveform string
+
+const (
+	// PerfWaveformSample cycles through the counter's captured sample data, with some noise added (default).
+	PerfWaveformSample = PerfWaveform("sample")
+	// PerfWaveformConstant always returns the Base value.
+	PerfWaveformConstant = PerfWaveform("constant")
+	// PerfWaveformSine oscillates around the Base value, completing a cycle each Period.
+	PerfWaveformSine = PerfWaveform("sine")
+	// PerfWaveformRandomWalk drifts randomly around the Base value, changing direction at most once per Period.
+	PerfWaveformRandomWalk = PerfWaveform("walk")
+)
+
+// PerfMetricConfig configures the synthetic values of performance counters, see Registry.SetPerfMetricConfig.
+// Values are derived from the entity, counter, instance and sample timestamp,
+// such that repeated queries for the same time range return the same values.
+type PerfMetricConfig struct {
+	// Waveform defaults to PerfWaveformSample.
+	Waveform PerfWaveform
+	// Base is the constant value or midpoint of the waveform.
+	// A value of 0 defaults to the mean of the counter's sample data.
+	Base int64
+	// Amplitude is the maximum deviation from Base.
+	// A value of 0 defaults to Base / 2.
+	Amplitude int64
+	// Period is the duration of a sine cycle or random walk step.
+	// A value of 0 defaults to 1 hour.
+	Period time.Duration
+}
+
+// SetPerfMetricConfig applies the given PerfMetricConfig to counters with the given name,
+// such as "cpu.usage.average", or group, such as "cpu".
+// The name "*" applies to any counter without its own PerfMetricConfig.
+func (r *Registry) SetPerfMetricConfig(name string, config PerfMetricConfig) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.perfMetricConfig == nil {
+		r.perfMetricConfig = make(map[string]PerfMetricConfig)
+	}
+	r.perfMetricConfig[name] = config
+}
+
+// ClearPerfMetricConfig removes the PerfMetricConfig for the given name.
+// If name is empty, all metric configuration is removed.
+func (r *Registry) ClearPerfMetricConfig(name string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if name == "" {
+		r.perfMetricConfig = nil
+	} else {
+		delete(r.perfMetricConfig, name)
+	}
+}
+
+func (r *Registry) getPerfMetricConfig(info *types.PerfCounterInfo) PerfMetricConfig {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	group := info.GroupInfo.GetElementDescription().Key
+	name := fmt.Sprintf("%s.%s.%s", group, info.NameInfo.GetElementDescription().Key, info.RollupType)
+
+	for _, key := range []string{name, group, "*"} {
+		if config, ok := r.perfMetricConfig[key]; ok {
+			return config
+		}
+	}
+	return PerfMetricConfig{}
+}
+
+// realtimeInterval is retained for 1 hour, as with ESX.
+var realtimeInterval = types.PerfInterval{
+	Name:           "Realtime",
+	SamplingPeriod: realtimeProviderSummary.RefreshRate,
+	Length:         3600,
+	Enabled:        true,
+}
+
+// interval returns the PerfInterval with the given sampling period, where -1 or 0 is the realtime interval.
+func (p *PerformanceManager) interval(id int32) (*types.PerfInterval, bool) {
+	if id <= 0 || id == realtimeInterval.SamplingPeriod {
+		return &realtimeInterval, true
+	}
+	for i := range p.HistoricalInterval {
+		if p.HistoricalInterval[i].SamplingPeriod == id {
+			return &p.HistoricalInterval[i], true
+		}
+	}
+	return nil, false
+}
+
+func (p *PerformanceManager) UpdatePerfInterval(ctx *Context, req *types.UpdatePerfInterval) soap.HasFault {
+	body := new(methods.UpdatePerfIntervalBody)
+
+	for i, interval := range p.HistoricalInterval {
+		if interval.Key != req.Interval.Key {
+			continue
+		}
+
+		if req.Interval.SamplingPeriod != interval.SamplingPeriod {
+			body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "interval.samplingPeriod"})
+			return body
+		}
+		if req.Interval.Length < interval.SamplingPeriod {
+			body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "interval.length"})
+			return body
+		}
+		if req.Interval.Level < 0 || req.Interval.Level > 4 {
+			body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "interval.level"})
+			return body
+		}
+
+		intervals := slices.Clone(p.HistoricalInterval)
+		intervals[i] = req.Interval
+		ctx.Map.Update(p, []types.PropertyChange{{Name: "historicalInterval", Val: intervals}})
+
+		body.Res = new(types.UpdatePerfIntervalResponse)
+		return body
+	}
+
+	body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "interval.key"})
+	return body
+}
+
+// samples returns the sample timestamps of the given interval within the range of the query spec, up to now,
+// in ascending order and limited to the interval's retention period and the spec's MaxSample.
+func (p *PerformanceManager) samples(qs *types.PerfQuerySpec, interval *types.PerfInterval, now time.Time) []types.PerfSampleInfo {
+	if !interval.Enabled {
+		return nil // data is not collected for disabled intervals
+	}
+
+	period := time.Duration(interval.SamplingPeriod) * time.Second
+
+	end := now
+	if qs.EndTime != nil && qs.EndTime.Before(now) {
+		end = *qs.EndTime
+	}
+	end = end.Truncate(period)
+
+	start := now.Add(-time.Duration(interval.Length) * time.Second)
+	if qs.StartTime != nil && qs.StartTime.After(start) {
+		start = *qs.StartTime // exclusive
+	}
+
+	var info []types.PerfSampleInfo
+	for ts := start.Truncate(period).Add(period); !ts.After(end); ts = ts.Add(period) {
+		info = append(info, types.PerfSampleInfo{Timestamp: ts, Interval: interval.SamplingPeriod})
+	}
+
+	if qs.MaxSample > 0 && len(info) > int(qs.MaxSample) {
+		info = info[len(info)-int(qs.MaxSample):] // most recent samples
+	}
+
+	return info
+}
+
+// metricHash returns a hash of the given values, used to derive deterministic metric values.
+func metricHash(values ...any) uint64 {
+	h := fnv.New64a()
+	for _, v := range values {
+		fmt.Fprint(h, v, "/")
+	}
+	return h.Sum64()
+}
+
+// metricNoise returns a uniformly distributed value in the range [0, 1) for the given seed and x.
+func metricNoise(seed uint64, x int64) float64 {
+	// splitmix64
+	z := seed + uint64(x)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+	return float64(z>>11) / (1 << 53)
+}
+
+// metricGauss returns a normally distributed value with mean 0 and standard deviation 1 for the given seed and x.
+func metricGauss(seed uint64, x int64) float64 {
+	u1 := 1 - metricNoise(seed, x) // (0, 1]
+	u2 := metricNoise(^seed, x)
+	return math.Sqrt(-2*math.Log(u1)) * math.Cos(2*math.Pi*u2)
+}
+
+// metricWalk returns a value in the range [-1, 1] at time t (in seconds), following a random walk
+// with steps of the given period, made smooth by interpolating between 4 octaves of random points.
+func metricWalk(seed uint64, t int64, period int64) float64 {
+	var sum, weight float64
+
+	for octave := 0; octave < 4; octave++ {
+		step := period >> octave
+		if step <= 0 {
+			break
+		}
+		k := t / step
+		f := float64(t%step) / float64(step)
+		f = f * f * (3 - 2*f) // smoothstep
+		a := metricNoise(seed+uint64(octave), k)*2 - 1
+		b := metricNoise(seed+uint64(octave), k+1)*2 - 1
+		w := 1 / float64(int64(1)<<octave)
+		sum += w * (a + (b-a)*f)
+		weight += w
+	}
+
+	return sum / weight
+}
+
+// metricValue returns the synthetic value of the given metric at the given time.
+func (p *PerformanceManager) metricValue(config *PerfMetricConfig, points []int64, seed uint64, ts time.Time, interval int32) int64 {
+	t := ts.Unix()
+	phase := int64(seed % (1 << 20))
+	var v int64
+
+	switch config.Waveform {
+	case "", PerfWaveformSample:
+		if len(points) == 0 {
+			return 0
+		}
+		v = points[(t/int64(interval)+phase)%int64(len(points))]
+		if scale := v / 5; scale > 0 {
+			// Add some gaussian noise to make the data look more "real"
+			v += int64(metricGauss(seed, t) * float64(scale))
+		}
+	default:
+		base := config.Base
+		if base == 0 && len(points) != 0 {
+			for _, point := range points {
+				base += point
+			}
+			base /= int64(len(points))
+		}
+		amplitude := config.Amplitude
+		if amplitude == 0 {
+			amplitude = base / 2
+		}
+		period := int64(config.Period.Seconds())
+		if period <= 0 {
+			period = 3600
+		}
+
+		switch config.Waveform {
+		case PerfWaveformConstant:
+			v = base
+		case PerfWaveformSine:
+			x := float64((t+phase)%period) / float64(period)
+			v = base + int64(float64(amplitude)*math.Sin(2*math.Pi*x))
+		case PerfWaveformRandomWalk:
+			v = base + int64(float64(amplitude)*metricWalk(seed, t, period))
+		}
+	}
+
+	return max(v, 0)
+}
+
 func (p *PerformanceManager) QueryPerf(ctx *Context, req *types.QueryPerf) soap.HasFault {
 	body := new(methods.QueryPerfBody)
 	body.Res = new(types.QueryPerfResponse)
@@ -183,65 +440,41 @@ func (p *PerformanceManager) QueryPerf(ctx *Context, req *types.QueryPerf) soap.
 			body.Fault_ = Fault("", &types.InvalidArgument{
 				InvalidProperty: "Entity",
 			})
-		}
-		var start, end time.Time
-		if qs.StartTime == nil {
-			start = time.Now().Add(time.Duration(-365*24) * time.Hour) // Assume we have data for a year
-		} else {
-			start = *qs.StartTime
-		}
-		if qs.EndTime == nil {
-			end = time.Now()
-		} else {
-			end = *qs.EndTime
+			return body
 		}
 
-		// Generate metric series. Divide into n buckets of interval seconds
-		interval := qs.IntervalId
-		if interval == -1 || interval == 0 {
-			interval = 20 // TODO: Determine from entity type
-		}
-		n := 1 + int32(end.Sub(start).Seconds())/interval
-		if qs.MaxSample > 0 && n > qs.MaxSample {
-			n = qs.MaxSample
+		interval, ok := p.interval(qs.IntervalId)
+		if !ok {
+			body.Fault_ = Fault("", &types.InvalidArgument{
+				InvalidProperty: "IntervalId",
+			})
+			return body
 		}
 
 		metrics := new(types.PerfEntityMetric)
 		metrics.Entity = qs.Entity
-
-		// Loop through each interval "tick"
-		metrics.SampleInfo = make([]types.PerfSampleInfo, n)
+		metrics.SampleInfo = p.samples(&qs, interval, ctx.Map.Now())
 		metrics.Value = make([]types.BasePerfMetricSeries, len(qs.MetricId))
-		for tick := int32(0); tick < n; tick++ {
-			metrics.SampleInfo[tick] = types.PerfSampleInfo{Timestamp: end.Add(time.Duration(-interval*tick) * time.Second), Interval: interval}
-		}
 
 		series := make([]*types.PerfMetricIntSeries, len(qs.MetricId))
 		for j, mid := range qs.MetricId {
-			// Create list of metrics for this tick
-			series[j] = &types.PerfMetricIntSeries{Value: make([]int64, n)}
+			series[j] = &types.PerfMetricIntSeries{Value: make([]int64, len(metrics.SampleInfo))}
 			series[j].Id = mid
-			points := metricData[mid.CounterId]
-			offset := int64(start.Unix()) / int64(interval)
-
-			for tick := int32(0); tick < n; tick++ {
-				var p int64
-
-				// Use sample data if we have it. Otherwise, just send 0.
-				if len(points) > 0 {
-					p = points[(offset+int64(tick))%int64(len(points))]
-					scale := p / 5
-					if scale > 0 {
-						// Add some gaussian noise to make the data look more "real"
-						p += int64(rand.NormFloat64() * float64(scale))
-						if p < 0 {
-							p = 0
-						}
-					}
-				} else {
-					p = 0
+
+			var config PerfMetricConfig
+			limit := int64(math.MaxInt64)
+			if info, ok := p.perfCounterIndex[mid.CounterId]; ok {
+				config = ctx.Map.getPerfMetricConfig(&info)
+				if info.UnitInfo.GetElementDescription().Key == "percent" {
+					limit = 10000 // percent values are in hundredths
 				}
-				series[j].Value[tick] = p
+			}
+			points := metricData[mid.CounterId]
+			seed := metricHash(qs.Entity, mid.CounterId, mid.Instance)
+
+			for tick, sample := range metrics.SampleInfo {
+				v := p.metricValue(&config, points, seed, sample.Timestamp, interval.SamplingPeriod)
+				series[j].Value[tick] = min(v, limit)
 			}
 			metrics.Value[j] = series[j]
 		}
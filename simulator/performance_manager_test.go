@@ -19,12 +19,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/simulator/vpx"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -407,3 +411,106 @@ func TestQueryPerf(t *testing.T) {
 		}
 	}
 }
+
+func TestQueryPerfWaveform(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		p := performance.NewManager(c)
+		vm := Map.Any("VirtualMachine")
+
+		counters, err := p.CounterInfoByName(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counter := counters["cpu.usage.average"]
+
+		query := func(spec types.PerfQuerySpec) *types.PerfEntityMetric {
+			spec.Entity = vm.Reference()
+			spec.MetricId = []types.PerfMetricId{{CounterId: counter.Key}}
+			res, err := p.Query(ctx, []types.PerfQuerySpec{spec})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return res[0].(*types.PerfEntityMetric)
+		}
+
+		values := func(m *types.PerfEntityMetric) []int64 {
+			return m.Value[0].(*types.PerfMetricIntSeries).Value
+		}
+
+		realtime := query(types.PerfQuerySpec{IntervalId: 20})
+		if n := len(realtime.SampleInfo); n != 180 {
+			t.Errorf("realtime samples=%d", n)
+		}
+		for i, s := range realtime.SampleInfo {
+			if s.Interval != 20 || s.Timestamp.Unix()%20 != 0 {
+				t.Errorf("sample=%#v", s)
+			}
+			if i > 0 && !s.Timestamp.After(realtime.SampleInfo[i-1].Timestamp) {
+				t.Errorf("sample %d not in ascending order", i)
+			}
+		}
+
+		end := realtime.SampleInfo[len(realtime.SampleInfo)-1].Timestamp
+		start := end.Add(-time.Hour)
+		spec := types.PerfQuerySpec{IntervalId: 300, StartTime: &start, EndTime: &end}
+
+		historical := query(spec)
+		if n := len(historical.SampleInfo); n != 12 {
+			t.Errorf("historical samples=%d", n)
+		}
+		if !slices.Equal(values(historical), values(query(spec))) {
+			t.Error("values differ for the same time range")
+		}
+
+		spec.MaxSample = 3
+		latest := query(spec)
+		if n := len(latest.SampleInfo); n != 3 || !latest.SampleInfo[2].Timestamp.Equal(end.Truncate(5*time.Minute)) {
+			t.Errorf("latest samples=%#v", latest.SampleInfo)
+		}
+		spec.MaxSample = 0
+
+		Map.SetPerfMetricConfig("cpu", PerfMetricConfig{Waveform: PerfWaveformConstant, Base: 4200})
+		for _, v := range values(query(spec)) {
+			if v != 4200 {
+				t.Errorf("constant=%d", v)
+			}
+		}
+
+		for _, waveform := range []PerfWaveform{PerfWaveformSine, PerfWaveformRandomWalk} {
+			Map.SetPerfMetricConfig("cpu.usage.average", PerfMetricConfig{Waveform: waveform, Base: 5000, Amplitude: 1000, Period: time.Hour})
+			v := values(query(spec))
+			if slices.Min(v) < 4000 || slices.Max(v) > 6000 || slices.Min(v) == slices.Max(v) {
+				t.Errorf("%s=%v", waveform, v)
+			}
+		}
+		Map.ClearPerfMetricConfig("")
+
+		_, err = p.Query(ctx, []types.PerfQuerySpec{{Entity: vm.Reference(), IntervalId: 42}})
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		intervals, err := p.HistoricalInterval(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		interval := intervals[0]
+		interval.Enabled = false
+
+		update := func(interval types.PerfInterval) error {
+			_, err := methods.UpdatePerfInterval(ctx, c, &types.UpdatePerfInterval{This: p.Reference(), Interval: interval})
+			return err
+		}
+		if err = update(interval); err != nil {
+			t.Fatal(err)
+		}
+		if n := len(query(spec).SampleInfo); n != 0 {
+			t.Errorf("disabled interval samples=%d", n)
+		}
+
+		interval.Key = -1
+		if err = update(interval); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,268 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vim25/xml"
+)
+
+// persistDatastoreDir is the Model.Persist sub directory used for Datastore files
+const persistDatastoreDir = "datastore"
+
+// persistModelDir is the Model.Persist sub directory used by Model.Save and Model.Load
+const persistModelDir = "model"
+
+// savedFile matches the name of an object file written by Model.Save, capturing the encoded reference.
+var savedFile = regexp.MustCompile(`^[0-9]{4}-([A-Z][A-Za-z]*-[^/]+)\.xml$`)
+
+// Persister is implemented by endpoints with state outside of the Registry, such as the vAPI simulator.
+// See Service.Persist.
+type Persister interface {
+	// Save writes state to the given directory, called by Model.Save
+	Save(dir string) error
+	// Load reads state from the given directory, called by Service.Persist after Model.Load
+	Load(dir string) error
+}
+
+// persistent is implemented by objects with state that is not available via the PropertyCollector.
+// The state is saved in the same format as 'govc object.save' uses for method responses.
+type persistent interface {
+	// state returns a method name and pointer to its response, such as "ListKeys" and *types.ListKeysResponse
+	state() (string, any)
+	// restore is called with a response of the same type returned by state, as decoded by Model.Load
+	restore(any)
+}
+
+// Persist registers p such that its state is included by Model.Save.
+// If the Service was created by Model.Load, p's state is loaded from the same directory.
+func (s *Service) Persist(p Persister) error {
+	s.persist = append(s.persist, p)
+
+	if s.dir != "" {
+		return p.Load(s.dir)
+	}
+
+	return nil
+}
+
+// saved returns true if dir contains a Model saved by Model.Save or 'govc object.save'
+func saved(dir string) bool {
+	files, _ := filepath.Glob(filepath.Join(dir, "*-"+vim25.ServiceInstance.Encode()+".xml"))
+	return len(files) != 0
+}
+
+// reserve ensures newReference does not generate a Value that is already used by the given ref,
+// as objects loaded by Model.Load may have been created by another simulator instance.
+func (r *Registry) reserve(ref types.ManagedObjectReference) {
+	n, err := strconv.ParseInt(strings.TrimPrefix(ref.Value, valuePrefix(ref.Type)), 10, 64)
+	if err != nil {
+		return
+	}
+
+	for {
+		c := atomic.LoadInt64(&r.counter)
+		if n <= c || atomic.CompareAndSwapInt64(&r.counter, c, n) {
+			return
+		}
+	}
+}
+
+func (m *Model) encode(path string, data any) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	e := xml.NewEncoder(f)
+	e.Indent("", "  ")
+	if err = e.Encode(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// clean removes any objects previously saved by Model.Save in dir,
+// along with the per-object directories used for persistent state.
+// Other files in dir are left as-is.
+func (m *Model) clean(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		match := savedFile.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		if err = os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+		if err = os.RemoveAll(filepath.Join(dir, match[1])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Save the Model Registry to the given directory, in the format used by 'govc object.save'.
+// Endpoint state registered via Service.Persist is also saved.
+// The saved Model can be loaded using Model.Load or the 'vcsim -load' flag.
+func (m *Model) Save(dir string) error {
+	ctx := SpoofContext()
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	if err := m.clean(dir); err != nil {
+		return err
+	}
+
+	var refs []types.ManagedObjectReference
+	ctx.Map.m.Lock()
+	for ref := range ctx.Map.objects {
+		refs = append(refs, ref)
+	}
+	ctx.Map.m.Unlock()
+
+	// ServiceInstance must be loaded first, followed by entities, such as HostSystem,
+	// which other objects link to when loaded via their init method.
+	order := func(ref types.ManagedObjectReference) int {
+		if ref == vim25.ServiceInstance {
+			return 0
+		}
+		if _, ok := ctx.Map.Get(ref).(mo.Entity); ok {
+			return 1
+		}
+		return 2
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		oi, oj := order(refs[i]), order(refs[j])
+		if oi == oj {
+			return refs[i].String() < refs[j].String()
+		}
+		return oi < oj
+	})
+
+	kinds := make(map[string]bool)
+	spec := types.PropertyFilterSpec{}
+	for _, ref := range refs {
+		spec.ObjectSet = append(spec.ObjectSet, types.ObjectSpec{Obj: ref})
+		if !kinds[ref.Type] {
+			kinds[ref.Type] = true
+			spec.PropSet = append(spec.PropSet, types.PropertySpec{Type: ref.Type, All: types.NewBool(true)})
+		}
+	}
+
+	pc := ctx.Map.Get(ctx.Map.content().PropertyCollector).(*PropertyCollector)
+	res, fault := pc.collect(ctx, &types.RetrievePropertiesEx{SpecSet: []types.PropertyFilterSpec{spec}})
+	if fault != nil {
+		return fmt.Errorf("RetrievePropertiesEx: %T", fault)
+	}
+
+	for i, content := range res.Objects {
+		ref := content.Obj.Encode()
+
+		if content.Obj.Type == "Task" {
+			saveTask(&content)
+		}
+
+		if err := m.encode(filepath.Join(dir, fmt.Sprintf("%04d-%s.xml", i, ref)), content); err != nil {
+			return err
+		}
+
+		if p, ok := ctx.Map.Get(content.Obj).(persistent); ok {
+			var name string
+			var state any
+			ctx.WithLock(content.Obj, func() { name, state = p.state() })
+
+			if err := os.MkdirAll(filepath.Join(dir, ref), 0755); err != nil {
+				return err
+			}
+			if err := m.encode(filepath.Join(dir, ref, name+".xml"), state); err != nil {
+				return err
+			}
+		}
+	}
+
+	if m.Service != nil {
+		for _, p := range m.Service.persist {
+			if err := p.Save(dir); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// saveTask marks any queued or running task as failed, as its Task.Execute func cannot be saved.
+func saveTask(content *types.ObjectContent) {
+	for i, p := range content.PropSet {
+		if info, ok := p.Val.(types.TaskInfo); ok {
+			switch info.State {
+			case types.TaskInfoStateQueued, types.TaskInfoStateRunning:
+				info.State = types.TaskInfoStateError
+				info.Error = &types.LocalizedMethodFault{
+					Fault:            new(types.RequestCanceled),
+					LocalizedMessage: "task interrupted by simulator restart",
+				}
+				content.PropSet[i].Val = info
+			}
+		}
+	}
+}
+
+// loadState decodes the given file into obj's persistent state, returning false if not implemented.
+func (m *Model) loadState(obj mo.Reference, name, path string) (bool, error) {
+	p, ok := obj.(persistent)
+	if !ok {
+		return false, nil
+	}
+
+	method, state := p.state()
+	if method+"Response" != name {
+		return false, nil
+	}
+
+	val := reflect.New(reflect.TypeOf(state).Elem()).Interface()
+	if err := m.decode(path, val); err != nil {
+		return true, err
+	}
+
+	p.restore(val)
+
+	return true, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestModelPersist(t *testing.T) {
+	dir := t.TempDir()
+
+	var folder types.ManagedObjectReference
+	var keys []string
+
+	listKeys := func(ctx context.Context, c *vim25.Client) []string {
+		res, err := methods.ListKeys(ctx, c, &types.ListKeys{This: *c.ServiceContent.CryptoManager})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, key := range res.Returnval {
+			ids = append(ids, key.KeyId)
+		}
+		return ids
+	}
+
+	m := VPX()
+	m.Persist = dir
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		f, err := object.NewRootFolder(c).CreateFolder(ctx, "persisted")
+		if err != nil {
+			return err
+		}
+		folder = f.Reference()
+
+		provider := &types.KeyProviderId{Id: "persist-kms"}
+		crypto := *c.ServiceContent.CryptoManager
+
+		_, err = methods.RegisterKmsCluster(ctx, c, &types.RegisterKmsCluster{
+			This:           crypto,
+			ClusterId:      *provider,
+			ManagementType: string(types.KmipClusterInfoKmsManagementTypeUnknown),
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < 3; i++ {
+			_, err = methods.GenerateKey(ctx, c, &types.GenerateKey{This: crypto, KeyProvider: provider})
+			if err != nil {
+				return err
+			}
+		}
+
+		keys = listKeys(ctx, c)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !saved(filepath.Join(dir, persistModelDir)) {
+		t.Fatal("model not saved")
+	}
+
+	m = VPX()
+	m.Persist = dir
+
+	err = m.Run(func(ctx context.Context, c *vim25.Client) error {
+		f, err := find.NewFinder(c).Folder(ctx, "/persisted")
+		if err != nil {
+			return err
+		}
+		if f.Reference() != folder {
+			t.Errorf("folder=%s, expected %s", f.Reference(), folder)
+		}
+
+		ids := listKeys(ctx, c)
+		if len(ids) != len(keys) {
+			t.Fatalf("keys=%v, expected %v", ids, keys)
+		}
+		for i := range keys {
+			if ids[i] != keys[i] {
+				t.Errorf("key %d=%s, expected %s", i, ids[i], keys[i])
+			}
+		}
+
+		nf, err := object.NewRootFolder(c).CreateFolder(ctx, "created")
+		if err != nil {
+			return err
+		}
+		if nf.Reference() == folder {
+			t.Errorf("new folder reused %s", folder)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestModelSaveClean(t *testing.T) {
+	dir := t.TempDir()
+
+	// files and directories not written by Model.Save must be preserved
+	keep := []string{"my-config.xml", "0001-notes.txt", "config"}
+	for _, name := range keep[:2] {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "config"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	stale := filepath.Join(dir, "9999-Folder-group-stale.xml")
+	if err := os.WriteFile(stale, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "Folder-group-stale"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	m := ESX()
+	defer m.Remove()
+
+	if err := m.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Save(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range keep {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Error(err)
+		}
+	}
+
+	for _, name := range []string{stale, filepath.Join(dir, "Folder-group-stale")} {
+		if _, err := os.Stat(name); !os.IsNotExist(err) {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+
+	if !saved(dir) {
+		t.Error("model not saved")
+	}
+}
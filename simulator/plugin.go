@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vim25/xml"
+)
+
+// RegisterObjectType registers kind as the vcsim implementation of the managed object type with the given name.
+// Instances of kind are created for objects of this type when the ServiceContent or an inventory is loaded,
+// such as via Model.Load. The kind must be a struct type that implements mo.Reference via a pointer receiver,
+// with the managed object type embedded as its first field. As with vim25/mo, cns/mo and eam/mo,
+// the managed object type must be declared in a package named "mo" for use with the PropertyCollector.
+// RegisterObjectType is not safe for concurrent use and should be called from an init func.
+func RegisterObjectType(name string, kind reflect.Type) {
+	if !reflect.PointerTo(kind).Implements(reflect.TypeOf((*mo.Reference)(nil)).Elem()) {
+		panic(fmt.Sprintf("%s does not implement mo.Reference", kind))
+	}
+	kinds[name] = kind
+}
+
+// AddType registers kind for decoding requests to the Registry's endpoint, where name is the xsi:type or
+// element name of the type. Types registered via vim25/types.Add do not need to be registered with the Registry.
+func (r *Registry) AddType(name string, kind reflect.Type) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.types == nil {
+		r.types = make(map[string]reflect.Type)
+	}
+	r.types[name] = kind
+}
+
+// methodKey is the managed object type and name of a method registered via AddMethod.
+type methodKey struct {
+	kind string
+	name string
+}
+
+// methodFunc is a method registered via AddMethod.
+type methodFunc func(*Context, mo.Reference, any) (any, types.BaseMethodFault)
+
+// AddMethod registers fn as the handler for the method with the given name, invoked on objects of the given
+// managed object type, such as "VirtualMachine". The Req type is registered with the Registry to decode requests,
+// such that neither a WSDL nor generated vim25 bindings are required for the method.
+// Req must be a struct type named after the method, with a This field of type types.ManagedObjectReference,
+// as per the request types in vim25/types. The value returned by fn is encoded as the returnval of the
+// "${name}Response" element, unless fn returns a fault. The object's lock is held while fn is called.
+// A method registered via AddMethod takes precedence over the method implemented by the object's Go type,
+// if any, which allows for overriding the behavior of core methods.
+func AddMethod[Req any, Res any](r *Registry, kind, name string, fn func(*Context, mo.Reference, *Req) (Res, types.BaseMethodFault)) {
+	rtype := reflect.TypeOf((*Req)(nil)).Elem()
+	if f, ok := rtype.FieldByName("This"); !ok || f.Type != reflect.TypeOf(types.ManagedObjectReference{}) {
+		panic(fmt.Sprintf("%s does not have a 'This' field of type types.ManagedObjectReference", rtype))
+	}
+
+	r.AddType(name, rtype)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.methods == nil {
+		r.methods = make(map[methodKey]methodFunc)
+	}
+	r.methods[methodKey{kind, name}] = func(ctx *Context, obj mo.Reference, req any) (any, types.BaseMethodFault) {
+		return fn(ctx, obj, req.(*Req))
+	}
+}
+
+// RemoveMethod removes a method registered via AddMethod.
+func (r *Registry) RemoveMethod(kind, name string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	delete(r.methods, methodKey{kind, name})
+}
+
+// method returns the method registered via AddMethod for the given managed object type and method name, if any.
+func (r *Registry) method(kind, name string) methodFunc {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return r.methods[methodKey{kind, name}]
+}
+
+// methodBody is the soap.HasFault implementation for methods registered via AddMethod.
+type methodBody struct {
+	Res    *methodResponse
+	Fault_ *soap.Fault
+}
+
+func (b *methodBody) Fault() *soap.Fault { return b.Fault_ }
+
+// methodResponse encodes the returnval of a method registered via AddMethod.
+type methodResponse struct {
+	name      string
+	Returnval any
+}
+
+// MarshalXML renames the start element to "${name}Response"
+func (r *methodResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = r.name + "Response"
+
+	return e.EncodeElement(struct {
+		Returnval any `xml:"returnval,omitempty,typeattr"`
+	}{r.Returnval}, start)
+}
+
+// callMethod invokes a method registered via AddMethod.
+func callMethod(ctx *Context, fn methodFunc, handler mo.Reference, method *Method) soap.HasFault {
+	body := new(methodBody)
+
+	var res any
+	var fault types.BaseMethodFault
+	ctx.Map.WithLock(ctx, handler, func() {
+		res, fault = fn(ctx, handler, method.Body)
+	})
+
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+	} else {
+		body.Res = &methodResponse{name: method.Name, Returnval: res}
+	}
+
+	return body
+}
+
+// setReturnval sets the Returnval field of the response Res field to that of a method registered via AddMethod,
+// for use by Service.RoundTrip.
+func (b *methodBody) setReturnval(res reflect.Value) {
+	val := reflect.New(res.Type().Elem())
+	res.Set(val)
+
+	field := val.Elem().FieldByName("Returnval")
+	if !field.IsValid() || b.Res.Returnval == nil {
+		return
+	}
+
+	rval := reflect.ValueOf(b.Res.Returnval)
+	switch {
+	case rval.Type().AssignableTo(field.Type()):
+		field.Set(rval)
+	case rval.Kind() == reflect.Pointer && !rval.IsNil() && rval.Elem().Type().AssignableTo(field.Type()):
+		field.Set(rval.Elem())
+	case field.Kind() == reflect.Pointer && rval.Type().AssignableTo(field.Type().Elem()):
+		field.Set(reflect.New(field.Type().Elem()))
+		field.Elem().Set(rval)
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// The following types would typically be declared in a vendor's client package.
+
+type AcmeGreet struct {
+	This types.ManagedObjectReference `xml:"_this"`
+	Name string                       `xml:"name"`
+}
+
+type AcmeGreetResponse struct {
+	Returnval string `xml:"returnval"`
+}
+
+type AcmeGreetBody struct {
+	Req    *AcmeGreet         `xml:"urn:vim25 AcmeGreet,omitempty"`
+	Res    *AcmeGreetResponse `xml:"AcmeGreetResponse,omitempty"`
+	Fault_ *soap.Fault        `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *AcmeGreetBody) Fault() *soap.Fault { return b.Fault_ }
+
+func acmeGreet(ctx context.Context, r soap.RoundTripper, req *AcmeGreet) (*AcmeGreetResponse, error) {
+	var reqBody, resBody AcmeGreetBody
+
+	reqBody.Req = req
+
+	if err := r.RoundTrip(ctx, &reqBody, &resBody); err != nil {
+		return nil, err
+	}
+
+	return resBody.Res, nil
+}
+
+// acmeManager is a custom managed object type
+type acmeManager struct {
+	mo.ExtensibleManagedObject
+
+	greeting string
+}
+
+func (m *acmeManager) AcmeGreet(ctx *Context, req *AcmeGreet) soap.HasFault {
+	return &AcmeGreetBody{
+		Res: &AcmeGreetResponse{Returnval: m.greeting + " " + req.Name},
+	}
+}
+
+func TestAddMethod(t *testing.T) {
+	m := VPX()
+	defer m.Remove()
+
+	if err := m.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	s := m.Service.NewServer()
+	defer s.Close()
+
+	greet := func(ctx *Context, obj mo.Reference, req *AcmeGreet) (string, types.BaseMethodFault) {
+		if req.Name == "" {
+			return "", &types.InvalidArgument{InvalidProperty: "name"}
+		}
+		return "Hello " + req.Name + " from " + obj.(mo.Entity).Entity().Name, nil
+	}
+
+	AddMethod(Map, "VirtualMachine", "AcmeGreet", greet)
+
+	// a custom managed object type
+	manager := &acmeManager{greeting: "Howdy"}
+	manager.Self = types.ManagedObjectReference{Type: "AcmeManager", Value: "acme-manager"}
+	Map.Put(manager)
+
+	ctx := context.Background()
+
+	c, err := vim25.NewClient(ctx, soap.NewClient(s.URL, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = session.NewManager(c).Login(ctx, s.URL.User); err != nil {
+		t.Fatal(err)
+	}
+
+	vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the HTTP client and the in-memory client
+	for _, rt := range []soap.RoundTripper{c, m.Service} {
+		res, err := acmeGreet(ctx, rt, &AcmeGreet{This: vm.Reference(), Name: "bob"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Returnval != "Hello bob from DC0_H0_VM0" {
+			t.Errorf("returnval=%q", res.Returnval)
+		}
+
+		_, err = acmeGreet(ctx, rt, &AcmeGreet{This: vm.Reference()})
+		var invalid *types.InvalidArgument
+		if _, ok := fault.As(err, &invalid); !ok || invalid.InvalidProperty != "name" {
+			t.Errorf("err=%v", err)
+		}
+
+		// not registered for this type
+		_, err = acmeGreet(ctx, rt, &AcmeGreet{This: c.ServiceContent.RootFolder, Name: "bob"})
+		if !fault.Is(err, &types.MethodNotFound{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		// implemented by the custom type
+		res, err = acmeGreet(ctx, rt, &AcmeGreet{This: manager.Self, Name: "alice"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Returnval != "Howdy alice" {
+			t.Errorf("returnval=%q", res.Returnval)
+		}
+	}
+
+	// override a core method
+	AddMethod(Map, "VirtualMachine", "PowerOffVM_Task", func(*Context, mo.Reference, *types.PowerOffVM_Task) (*types.ManagedObjectReference, types.BaseMethodFault) {
+		return nil, new(types.NotSupported)
+	})
+
+	_, err = vm.PowerOff(ctx)
+	if !fault.Is(err, &types.NotSupported{}) {
+		t.Errorf("err=%v", err)
+	}
+
+	Map.RemoveMethod("VirtualMachine", "PowerOffVM_Task")
+
+	task, err := vm.PowerOff(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = task.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// custom objects are visible to the PropertyCollector
+	var props []types.ObjectContent
+	err = property.DefaultCollector(c).Retrieve(ctx, []types.ManagedObjectReference{manager.Self}, []string{"value"}, &props)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(props) != 1 || props[0].Obj != manager.Self {
+		t.Errorf("props=%#v", props)
+	}
+}
+
+func TestRegisterObjectType(t *testing.T) {
+	RegisterObjectType("AcmeManager", reflect.TypeOf((*acmeManager)(nil)).Elem())
+	defer delete(kinds, "AcmeManager")
+
+	obj, err := loadObject(types.ObjectContent{Obj: types.ManagedObjectReference{Type: "AcmeManager", Value: "acme-manager"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := obj.(*acmeManager); !ok {
+		t.Errorf("obj=%T", obj)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	RegisterObjectType("AcmeManager", reflect.TypeOf(""))
+}
@@ -52,8 +52,31 @@ func (s *DistributedVirtualPortgroup) RenameTask(ctx *Context, req *types.Rename
 
 func (s *DistributedVirtualPortgroup) ReconfigureDVPortgroupTask(ctx *Context, req *types.ReconfigureDVPortgroup_Task) soap.HasFault {
 	task := CreateTask(s, "reconfigureDvPortgroup", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		dvs := ctx.Map.Get(*s.Config.DistributedVirtualSwitch).(*DistributedVirtualSwitch)
+
+		var err types.BaseMethodFault
+		ctx.WithLock(dvs, func() {
+			if err = dvs.validateVlan(req.Spec.DefaultPortConfig); err != nil {
+				return
+			}
+
+			numPorts := int(req.Spec.NumPorts)
+			if numPorts == 0 {
+				numPorts = len(s.PortKeys) // unchanged
+			}
+
+			if n := len(s.PortKeys); numPorts > n {
+				dvs.addDVPorts(ctx, s, numPorts-n)
+			} else if numPorts < n {
+				err = dvs.removeDVPorts(ctx, s, numPorts)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+
 		s.Config.DefaultPortConfig = req.Spec.DefaultPortConfig
-		s.Config.NumPorts = req.Spec.NumPorts
+		s.Config.NumPorts = int32(len(s.PortKeys))
 		s.Config.AutoExpand = req.Spec.AutoExpand
 		s.Config.Type = req.Spec.Type
 		s.Config.Description = req.Spec.Description
@@ -78,6 +101,15 @@ func (s *DistributedVirtualPortgroup) ReconfigureDVPortgroupTask(ctx *Context, r
 func (s *DistributedVirtualPortgroup) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
 	task := CreateTask(s, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		vswitch := ctx.Map.Get(*s.Config.DistributedVirtualSwitch).(*DistributedVirtualSwitch)
+
+		var err types.BaseMethodFault
+		ctx.WithLock(vswitch, func() {
+			err = vswitch.removeDVPorts(ctx, s, 0)
+		})
+		if err != nil {
+			return nil, err
+		}
+
 		ctx.Map.RemoveReference(ctx, vswitch, &vswitch.Portgroup, s.Reference())
 		ctx.Map.removeString(ctx, vswitch, &vswitch.Summary.PortgroupName, s.Name)
 
@@ -63,7 +63,7 @@ func TestReconfigurePortgroup(t *testing.T) {
 	}
 
 	pg := object.NewDistributedVirtualPortgroup(c,
-		Map.Any("DistributedVirtualPortgroup").Reference())
+		Map.FindByName("pg1", Map.Get(dvs.Reference()).(*DistributedVirtualSwitch).Portgroup).Reference())
 	pgspec := types.DVPortgroupConfigSpec{
 		NumPorts: 5,
 		Name:     "pg1",
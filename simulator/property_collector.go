@@ -562,8 +562,9 @@ func (pc *PropertyCollector) CreateFilter(ctx *Context, c *types.CreateFilter) s
 	body := &methods.CreateFilterBody{}
 
 	filter := &PropertyFilter{
-		pc:   pc,
-		refs: make(map[types.ManagedObjectReference]struct{}),
+		pc:     pc,
+		refs:   make(map[types.ManagedObjectReference]struct{}),
+		values: make(map[types.ManagedObjectReference]map[string]types.AnyType),
 	}
 	filter.PartialUpdates = c.PartialUpdates
 	filter.Spec = c.Spec
@@ -804,7 +805,13 @@ func (pc *PropertyCollector) apply(ctx *Context, update *types.UpdateSet) types.
 				Kind: types.ObjectUpdateKindEnter,
 			}
 
+			values := make(map[string]types.AnyType, len(o.PropSet))
+			filter.values[o.Obj] = values
+
 			for _, p := range o.PropSet {
+				if p.Val != nil {
+					values[p.Name] = p.Val
+				}
 				ou.ChangeSet = append(ou.ChangeSet, types.PropertyChange{
 					Op:   types.PropertyChangeOpAssign,
 					Name: p.Name,
@@ -952,6 +959,7 @@ func (pc *PropertyCollector) WaitForUpdatesEx(ctx *Context, r *types.WaitForUpda
 			for _, f := range pc.Filter {
 				filter := ctx.Session.Get(f).(*PropertyFilter)
 				fu := types.PropertyFilterUpdate{Filter: f}
+				modified := make(map[types.ManagedObjectReference]int) // index into fu.ObjectSet
 
 				for _, update := range updates {
 					switch update.Kind {
@@ -966,17 +974,32 @@ func (pc *PropertyCollector) WaitForUpdatesEx(ctx *Context, r *types.WaitForUpda
 						}
 						if _, ok := filter.refs[update.Obj]; ok {
 							// This object has already been applied by the filter,
-							// now check if the property spec applies for this update.
-							update = filter.apply(ctx, update)
-							if len(update.ChangeSet) != 0 {
-								fu.ObjectSet = append(fu.ObjectSet, update)
+							// now check if the property spec applies for this update
+							// and diff against the values last sent.
+							update, fault := filter.diff(ctx, update)
+							if fault != nil {
+								body.Fault_ = Fault("", fault)
+								body.Res = nil
+								return body
+							}
+							if len(update.ChangeSet) == 0 {
+								continue
+							}
+							if i, ok := modified[update.Obj]; ok {
+								// coalesce multiple updates to the same object
+								fu.ObjectSet[i].ChangeSet = mergeChanges(fu.ObjectSet[i].ChangeSet, update.ChangeSet)
+								continue
 							}
+							modified[update.Obj] = len(fu.ObjectSet)
+							fu.ObjectSet = append(fu.ObjectSet, update)
 						}
 					case types.ObjectUpdateKindLeave: // Delete
 						if _, ok := filter.refs[update.Obj]; !ok {
 							continue
 						}
 						delete(filter.refs, update.Obj)
+						delete(filter.values, update.Obj)
+						delete(modified, update.Obj)
 						fu.ObjectSet = append(fu.ObjectSet, update)
 					}
 				}
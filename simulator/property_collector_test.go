@@ -456,6 +456,68 @@ func TestIncrementalWaitForUpdates(t *testing.T) {
 	task.Wait(ctx)
 }
 
+func TestWaitForUpdatesIndexedProperty(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		obj := Map.Any("VirtualMachine").(*VirtualMachine)
+		vm := object.NewVirtualMachine(c, obj.Self)
+
+		devices, err := vm.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nic := devices.SelectByType((*types.VirtualEthernetCard)(nil))[0]
+		key := nic.GetVirtualDevice().Key
+
+		backing := fmt.Sprintf("config.hardware.device[%d].backing", key)
+		props := []string{backing, "config.hardware.device", "name"}
+
+		var changes []types.PropertyChange
+		wait := make(chan bool)
+		filter := new(property.WaitFilter).Add(obj.Self, obj.Self.Type, props)
+
+		go func() {
+			werr := property.WaitForUpdates(ctx, property.DefaultCollector(c), filter, func(updates []types.ObjectUpdate) bool {
+				if updates[0].Kind == types.ObjectUpdateKindEnter {
+					wait <- true
+					return false
+				}
+				for _, update := range updates {
+					changes = append(changes, update.ChangeSet...)
+				}
+				return true
+			})
+			if werr != nil {
+				t.Error(werr)
+			}
+			close(wait)
+		}()
+
+		<-wait // wait for enter
+
+		nic.GetVirtualDevice().Backing = &types.VirtualEthernetCardNetworkBackingInfo{
+			VirtualDeviceDeviceBackingInfo: types.VirtualDeviceDeviceBackingInfo{
+				DeviceName: "VM Network",
+			},
+		}
+		if err = vm.EditDevice(ctx, nic); err != nil {
+			t.Fatal(err)
+		}
+
+		<-wait // wait for modify
+
+		if len(changes) != 2 {
+			t.Fatalf("changes=%#v", changes)
+		}
+		if changes[0].Name != backing || changes[1].Name != "config.hardware.device" {
+			t.Errorf("changes=%s, %s", changes[0].Name, changes[1].Name)
+		}
+		b, ok := changes[0].Val.(types.VirtualEthernetCardNetworkBackingInfo)
+		if !ok || b.DeviceName != "VM Network" {
+			t.Errorf("backing=%#v", changes[0].Val)
+		}
+	})
+}
+
 func TestWaitForUpdatesOneUpdateCalculation(t *testing.T) {
 	/*
 	 * In this test, we use WaitForUpdatesEx in non-blocking way
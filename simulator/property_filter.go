@@ -18,6 +18,8 @@ package simulator
 
 import (
 	"reflect"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/vmware/govmomi/vim25/methods"
@@ -31,6 +33,9 @@ type PropertyFilter struct {
 
 	pc   *PropertyCollector
 	refs map[types.ManagedObjectReference]struct{}
+
+	// values last sent to the client for each object in refs
+	values map[types.ManagedObjectReference]map[string]types.AnyType
 }
 
 func (f *PropertyFilter) DestroyPropertyFilter(ctx *Context, c *types.DestroyPropertyFilter) soap.HasFault {
@@ -122,3 +127,135 @@ func (f *PropertyFilter) apply(ctx *Context, change types.ObjectUpdate) types.Ob
 
 	return change
 }
+
+// keyed returns true if the given property path is an element of a keyed array, e.g. "field[key]"
+func keyed(name string) bool {
+	var field mo.Field
+	return field.FromString(name) && field.Key != nil && field.Item == ""
+}
+
+// nested returns true if the given property path is nested within the given changed property,
+// e.g. "config.hardware.device[4000].backing" is nested within "config.hardware.device".
+func nested(path, change string) bool {
+	return strings.HasPrefix(path, change+".") || strings.HasPrefix(path, change+"[")
+}
+
+// diff applies the filter to the given ObjectUpdate, then collects the current values of the filter Spec.PropSet
+// paths that are nested within or contain a changed property, adding a PropertyChange for each value that differs from the
+// last update sent to the client. This includes changes to nested and indexed properties that are not named in
+// the update's ChangeSet. Paths unrelated to the update are not collected.
+func (f *PropertyFilter) diff(ctx *Context, change types.ObjectUpdate) (types.ObjectUpdate, types.BaseMethodFault) {
+	names := make([]string, len(change.ChangeSet))
+	for i, p := range change.ChangeSet {
+		names[i] = p.Name
+	}
+
+	update := f.apply(ctx, change)
+
+	values := f.values[update.Obj]
+	if values == nil {
+		values = make(map[string]types.AnyType)
+		f.values[update.Obj] = values
+	}
+
+	seen := make(map[string]bool, len(update.ChangeSet))
+	for _, p := range update.ChangeSet {
+		seen[p.Name] = true
+		values[p.Name] = p.Val
+	}
+
+	var propSet []types.PropertySpec
+	var paths []string
+
+	for _, ps := range f.Spec.PropSet {
+		var pathSet []string
+		for _, path := range ps.PathSet {
+			related := func(name string) bool { return nested(path, name) || nested(name, path) }
+			if seen[path] || !slices.ContainsFunc(names, related) {
+				continue
+			}
+			pathSet = append(pathSet, path)
+		}
+		if len(pathSet) != 0 {
+			propSet = append(propSet, types.PropertySpec{Type: ps.Type, PathSet: pathSet})
+			paths = append(paths, pathSet...)
+		}
+	}
+
+	if len(propSet) == 0 {
+		return update, nil
+	}
+
+	spec := types.PropertyFilterSpec{
+		PropSet:                       propSet,
+		ObjectSet:                     []types.ObjectSpec{{Obj: update.Obj}},
+		ReportMissingObjectsInResults: types.NewBool(true),
+	}
+
+	res, fault := f.pc.collect(ctx, &types.RetrievePropertiesEx{SpecSet: []types.PropertyFilterSpec{spec}})
+	if fault != nil {
+		return update, fault
+	}
+	if len(res.Objects) == 0 {
+		return update, nil // object may have since been deleted
+	}
+
+	current := make(map[string]types.AnyType)
+
+	for _, p := range res.Objects[0].PropSet {
+		if p.Val == nil {
+			continue // unset, e.g. a keyed array element that does not exist
+		}
+		current[p.Name] = p.Val
+		old, ok := values[p.Name]
+		values[p.Name] = p.Val
+		if seen[p.Name] || (ok && reflect.DeepEqual(old, p.Val)) {
+			continue
+		}
+		seen[p.Name] = true
+		op := types.PropertyChangeOpAssign
+		if !ok && keyed(p.Name) {
+			op = types.PropertyChangeOpAdd
+		}
+		update.ChangeSet = append(update.ChangeSet, types.PropertyChange{
+			Op:   op,
+			Name: p.Name,
+			Val:  p.Val,
+		})
+	}
+
+	sort.Strings(paths)
+
+	for _, name := range paths {
+		if _, ok := current[name]; ok || seen[name] {
+			continue
+		}
+		if _, ok := values[name]; !ok {
+			continue // was not set in the last update either
+		}
+		delete(values, name)
+		op := types.PropertyChangeOpAssign
+		if keyed(name) {
+			op = types.PropertyChangeOpRemove
+		}
+		update.ChangeSet = append(update.ChangeSet, types.PropertyChange{
+			Op:   op,
+			Name: name,
+		})
+	}
+
+	return update, nil
+}
+
+// mergeChanges replaces any change in dst with a change to the same property in src, appending the others.
+func mergeChanges(dst, src []types.PropertyChange) []types.PropertyChange {
+	for _, change := range src {
+		i := slices.IndexFunc(dst, func(c types.PropertyChange) bool { return c.Name == change.Name })
+		if i == -1 {
+			dst = append(dst, change)
+		} else {
+			dst[i] = change
+		}
+	}
+	return dst
+}
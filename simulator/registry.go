@@ -78,6 +78,16 @@ type Registry struct {
 	Handler   func(*Context, *Method) (mo.Reference, types.BaseMethodFault)
 
 	tagManager tagManager
+
+	perfMetricConfig map[string]PerfMetricConfig
+
+	clock atomic.Value // clockValue
+
+	strictPermissions atomic.Bool
+	admissionControl  atomic.Bool
+
+	types   map[string]reflect.Type
+	methods map[methodKey]methodFunc
 }
 
 // tagManager is an interface to simplify internal interaction with the vapi tag manager simulator.
@@ -88,6 +98,16 @@ type tagManager interface {
 	DetachTag(types.ManagedObjectReference, types.VslmTagEntry) types.BaseMethodFault
 }
 
+// AttachedTags returns the vAPI tags attached to the given object,
+// or nil if the vAPI simulator is not registered with the Service.
+func (r *Registry) AttachedTags(ref types.ManagedObjectReference) []types.VslmTagEntry {
+	if r.tagManager == nil {
+		return nil
+	}
+	tags, _ := r.tagManager.AttachedTags(ref)
+	return tags
+}
+
 // NewRegistry creates a new instances of Registry
 func NewRegistry() *Registry {
 	r := &Registry{
@@ -103,6 +123,13 @@ func NewRegistry() *Registry {
 }
 
 func (r *Registry) typeFunc(name string) (reflect.Type, bool) {
+	r.m.Lock()
+	kind, ok := r.types[name]
+	r.m.Unlock()
+	if ok {
+		return kind, ok
+	}
+
 	if r.Namespace != "" && r.Namespace != vim25.Namespace {
 		if kind, ok := defaultMapType(r.Namespace + ":" + name); ok {
 			return kind, ok
@@ -571,6 +598,11 @@ func (r *Registry) SessionManager() *SessionManager {
 	return r.Get(r.content().SessionManager.Reference()).(*SessionManager)
 }
 
+// AuthorizationManager returns the AuthorizationManager singleton
+func (r *Registry) AuthorizationManager() *AuthorizationManager {
+	return r.Get(r.content().AuthorizationManager.Reference()).(*AuthorizationManager)
+}
+
 // OptionManager returns the OptionManager singleton
 func (r *Registry) OptionManager() *OptionManager {
 	return r.Get(r.content().Setting.Reference()).(*OptionManager)
@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator/esx"
@@ -129,7 +131,7 @@ func (p *ResourcePool) createChild(name string, spec types.ResourceConfigSpec) (
 	return child, nil
 }
 
-func (p *ResourcePool) CreateResourcePool(c *types.CreateResourcePool) soap.HasFault {
+func (p *ResourcePool) CreateResourcePool(ctx *Context, c *types.CreateResourcePool) soap.HasFault {
 	body := &methods.CreateResourcePoolBody{}
 
 	child, err := p.createChild(c.Name, c.Spec)
@@ -138,10 +140,17 @@ func (p *ResourcePool) CreateResourcePool(c *types.CreateResourcePool) soap.HasF
 		return body
 	}
 
+	if err := admitReservation(ctx, p.Self, poolReservation(&child.ResourcePool)); err != nil {
+		body.Fault_ = Fault("", err)
+		return body
+	}
+
 	Map.PutEntity(p, Map.NewEntity(child))
 
 	p.ResourcePool.ResourcePool = append(p.ResourcePool.ResourcePool, child.Reference())
 
+	updatePoolRuntime(ctx, child.Self)
+
 	body.Res = &types.CreateResourcePoolResponse{
 		Returnval: child.Reference(),
 	}
@@ -160,6 +169,10 @@ func updateResourceAllocation(kind string, src, dst *types.ResourceAllocationInf
 		dst.Reservation = src.Reservation
 	}
 
+	if src.ExpandableReservation != nil {
+		dst.ExpandableReservation = src.ExpandableReservation
+	}
+
 	if src.Limit != nil {
 		dst.Limit = src.Limit
 	}
@@ -171,7 +184,7 @@ func updateResourceAllocation(kind string, src, dst *types.ResourceAllocationInf
 	return nil
 }
 
-func (p *ResourcePool) UpdateConfig(c *types.UpdateConfig) soap.HasFault {
+func (p *ResourcePool) UpdateConfig(ctx *Context, c *types.UpdateConfig) soap.HasFault {
 	body := &methods.UpdateConfigBody{}
 
 	if c.Name != "" {
@@ -189,15 +202,30 @@ func (p *ResourcePool) UpdateConfig(c *types.UpdateConfig) soap.HasFault {
 	spec := c.Config
 
 	if spec != nil {
-		if err := updateResourceAllocation("memory", &spec.MemoryAllocation, &p.Config.MemoryAllocation); err != nil {
+		pool := p.ResourcePool
+
+		if err := updateResourceAllocation("memory", &spec.MemoryAllocation, &pool.Config.MemoryAllocation); err != nil {
 			body.Fault_ = Fault("", err)
 			return body
 		}
 
-		if err := updateResourceAllocation("cpu", &spec.CpuAllocation, &p.Config.CpuAllocation); err != nil {
+		if err := updateResourceAllocation("cpu", &spec.CpuAllocation, &pool.Config.CpuAllocation); err != nil {
 			body.Fault_ = Fault("", err)
 			return body
 		}
+
+		if pool.Parent != nil {
+			delta := effectiveReservation(ctx, &pool).sub(effectiveReservation(ctx, &p.ResourcePool)).max(reservation{})
+			if err := admitReservation(ctx, *pool.Parent, delta); err != nil {
+				body.Fault_ = Fault("", err)
+				return body
+			}
+		}
+
+		p.Config.MemoryAllocation = pool.Config.MemoryAllocation
+		p.Config.CpuAllocation = pool.Config.CpuAllocation
+
+		updatePoolRuntime(ctx, p.Self)
 	}
 
 	body.Res = &types.UpdateConfigResponse{}
@@ -374,9 +402,15 @@ func (p *ResourcePool) CreateVApp(req *types.CreateVApp) soap.HasFault {
 		child.ParentFolder = &folder
 	}
 
+	child.Summary = &types.VirtualAppSummary{
+		ResourcePoolSummary: *pool.Summary.GetResourcePoolSummary(),
+		VAppState:           types.VirtualAppVAppStateStopped,
+	}
+
 	child.VAppConfig = &types.VAppConfigInfo{
 		VmConfigInfo: types.VmConfigInfo{},
 		Annotation:   req.ConfigSpec.Annotation,
+		EntityConfig: req.ConfigSpec.EntityConfig,
 	}
 
 	for _, product := range req.ConfigSpec.Product {
@@ -480,6 +514,351 @@ func (a *VirtualApp) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.Has
 	return (&ResourcePool{ResourcePool: a.ResourcePool}).DestroyTask(ctx, req)
 }
 
+func (a *VirtualApp) UpdateVAppConfig(ctx *Context, req *types.UpdateVAppConfig) soap.HasFault {
+	body := &methods.UpdateVAppConfigBody{}
+
+	entities := a.entities()
+
+	for i, config := range req.Spec.EntityConfig {
+		if config.Key == nil || !slices.ContainsFunc(entities, func(e types.VAppEntityConfigInfo) bool { return *e.Key == *config.Key }) {
+			body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: fmt.Sprintf("spec.entityConfig[%d].key", i)})
+			return body
+		}
+		if config.StartOrder < 0 || config.StartDelay < 0 || config.StopDelay < 0 {
+			body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: fmt.Sprintf("spec.entityConfig[%d]", i)})
+			return body
+		}
+	}
+
+	var config types.VAppConfigInfo
+	if a.VAppConfig != nil {
+		config = *a.VAppConfig
+	}
+
+	for _, spec := range req.Spec.EntityConfig {
+		i := slices.IndexFunc(config.EntityConfig, func(e types.VAppEntityConfigInfo) bool { return *e.Key == *spec.Key })
+		if i == -1 {
+			config.EntityConfig = append(config.EntityConfig, spec)
+		} else {
+			config.EntityConfig = slices.Clone(config.EntityConfig)
+			config.EntityConfig[i] = spec
+		}
+	}
+
+	if req.Spec.Annotation != "" {
+		config.Annotation = req.Spec.Annotation
+	}
+
+	ctx.Map.Update(a, []types.PropertyChange{{Name: "vAppConfig", Val: &config}})
+
+	body.Res = new(types.UpdateVAppConfigResponse)
+
+	return body
+}
+
+// entities returns the start and stop configuration of the VMs and child vApps in the vApp, sorted by start order.
+// Entities without an EntityConfig use the defaults of vCenter: start order 1 with the powerOn and powerOff actions.
+func (a *VirtualApp) entities() []types.VAppEntityConfigInfo {
+	refs := slices.Clone(a.Vm)
+	for _, ref := range a.ResourcePool.ResourcePool {
+		if ref.Type == "VirtualApp" {
+			refs = append(refs, ref)
+		}
+	}
+
+	var entities []types.VAppEntityConfigInfo
+
+	for i := range refs {
+		entity := types.VAppEntityConfigInfo{
+			Key:         &refs[i],
+			StartOrder:  1,
+			StartAction: string(types.VAppAutoStartActionPowerOn),
+			StopAction:  string(types.VAppAutoStartActionPowerOff),
+		}
+
+		for _, config := range a.entityConfig() {
+			if config.Key != nil && *config.Key == refs[i] {
+				entity = config
+				if entity.StartAction == "" {
+					entity.StartAction = string(types.VAppAutoStartActionPowerOn)
+				}
+				if entity.StopAction == "" {
+					entity.StopAction = string(types.VAppAutoStartActionPowerOff)
+				}
+			}
+		}
+
+		entities = append(entities, entity)
+	}
+
+	slices.SortStableFunc(entities, func(a, b types.VAppEntityConfigInfo) int {
+		return int(a.StartOrder - b.StartOrder)
+	})
+
+	return entities
+}
+
+func (a *VirtualApp) entityConfig() []types.VAppEntityConfigInfo {
+	if a.VAppConfig == nil {
+		return nil
+	}
+	return a.VAppConfig.EntityConfig
+}
+
+// startOrder groups the given entities by their start order.
+func startOrder(entities []types.VAppEntityConfigInfo) [][]types.VAppEntityConfigInfo {
+	var groups [][]types.VAppEntityConfigInfo
+
+	for i, entity := range entities {
+		if i == 0 || entity.StartOrder != entities[i-1].StartOrder {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], entity)
+	}
+
+	return groups
+}
+
+func (a *VirtualApp) vAppState() types.VirtualAppVAppState {
+	if s, ok := a.Summary.(*types.VirtualAppSummary); ok && s.VAppState != "" {
+		return s.VAppState
+	}
+	return types.VirtualAppVAppStateStopped
+}
+
+func (a *VirtualApp) setVAppState(ctx *Context, state types.VirtualAppVAppState) {
+	summary := &types.VirtualAppSummary{
+		ResourcePoolSummary: *a.Summary.GetResourcePoolSummary(),
+		VAppState:           state,
+	}
+	if s, ok := a.Summary.(*types.VirtualAppSummary); ok {
+		summary.Product = s.Product
+	}
+
+	ctx.Map.Update(a, []types.PropertyChange{{Name: "summary", Val: summary}})
+}
+
+// vmPowerState returns the power state of the given VM, waiting for any task of another context to release the lock.
+func vmPowerState(ctx *Context, vm *VirtualMachine) types.VirtualMachinePowerState {
+	var state types.VirtualMachinePowerState
+	ctx.WithLock(vm, func() {
+		state = vm.Runtime.PowerState
+	})
+	return state
+}
+
+// waitEntity waits for the subtask of the vApp task to complete, returning its fault if any.
+func waitEntity(ctx *Context, task *Task, ref types.ManagedObjectReference) types.BaseMethodFault {
+	sub := task.subtask(ctx, ref)
+	sub.Wait()
+	if sub.Info.Error != nil {
+		return sub.Info.Error.Fault
+	}
+	return nil
+}
+
+// delay waits for the given number of seconds of the Registry's Clock to pass,
+// as configured by VAppEntityConfigInfo start and stop delays.
+func delay(ctx *Context, seconds int32) {
+	if seconds <= 0 {
+		return
+	}
+	deadline := ctx.Map.Now().Add(time.Duration(seconds) * time.Second)
+	for ctx.Map.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// PowerOnVAppTask powers on the entities of the vApp in start order. Entities with the same start order are
+// powered on before the vApp waits for the largest StartDelay of the group and continues with the next group.
+// As guest heartbeats are available as soon as a VM is powered on in vcsim, entities WaitingForGuest are not delayed.
+func (a *VirtualApp) PowerOnVAppTask(ctx *Context, req *types.PowerOnVApp_Task) soap.HasFault {
+	task := CreateTask(a, "powerOnVApp", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if a.vAppState() == types.VirtualAppVAppStateStarted {
+			return nil, new(types.InvalidState)
+		}
+
+		for _, group := range startOrder(a.entities()) {
+			var wait int32
+
+			for _, entity := range group {
+				if entity.StartAction != string(types.VAppAutoStartActionPowerOn) {
+					continue
+				}
+
+				var res soap.HasFault
+
+				switch obj := ctx.Map.Get(*entity.Key).(type) {
+				case *VirtualMachine:
+					if vmPowerState(ctx, obj) == types.VirtualMachinePowerStatePoweredOn {
+						continue
+					}
+					ctx.WithLock(obj, func() {
+						res = obj.PowerOnVMTask(ctx, &types.PowerOnVM_Task{This: obj.Self})
+					})
+					if fault := res.Fault(); fault != nil {
+						return nil, fault.VimFault().(types.BaseMethodFault)
+					}
+					if err := waitEntity(ctx, t, res.(*methods.PowerOnVM_TaskBody).Res.Returnval); err != nil {
+						return nil, err
+					}
+				case *VirtualApp:
+					if obj.vAppState() == types.VirtualAppVAppStateStarted {
+						continue
+					}
+					ctx.WithLock(obj, func() {
+						res = obj.PowerOnVAppTask(ctx, &types.PowerOnVApp_Task{This: obj.Self})
+					})
+					if err := waitEntity(ctx, t, res.(*methods.PowerOnVApp_TaskBody).Res.Returnval); err != nil {
+						return nil, err
+					}
+				default:
+					continue
+				}
+
+				if !isTrue(entity.WaitingForGuest) && entity.StartDelay > wait {
+					wait = entity.StartDelay
+				}
+			}
+
+			delay(ctx, wait)
+		}
+
+		a.setVAppState(ctx, types.VirtualAppVAppStateStarted)
+
+		return nil, nil
+	})
+
+	return &methods.PowerOnVApp_TaskBody{
+		Res: &types.PowerOnVApp_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// stopVApp stops the entities of the vApp in reverse start order, using the stop action returned by the given func.
+func (a *VirtualApp) stopVApp(ctx *Context, t *Task, action func(types.VAppEntityConfigInfo) types.VAppAutoStartAction) types.BaseMethodFault {
+	groups := startOrder(a.entities())
+	slices.Reverse(groups)
+
+	for _, group := range groups {
+		var wait int32
+
+		for _, entity := range group {
+			var res soap.HasFault
+
+			switch obj := ctx.Map.Get(*entity.Key).(type) {
+			case *VirtualMachine:
+				if vmPowerState(ctx, obj) != types.VirtualMachinePowerStatePoweredOn {
+					continue
+				}
+
+				switch action(entity) {
+				case types.VAppAutoStartActionPowerOff:
+					ctx.WithLock(obj, func() {
+						res = obj.PowerOffVMTask(ctx, &types.PowerOffVM_Task{This: obj.Self})
+					})
+					if err := waitEntity(ctx, t, res.(*methods.PowerOffVM_TaskBody).Res.Returnval); err != nil {
+						return err
+					}
+				case types.VAppAutoStartActionSuspend:
+					ctx.WithLock(obj, func() {
+						res = obj.SuspendVMTask(ctx, &types.SuspendVM_Task{This: obj.Self})
+					})
+					if err := waitEntity(ctx, t, res.(*methods.SuspendVM_TaskBody).Res.Returnval); err != nil {
+						return err
+					}
+				case types.VAppAutoStartActionGuestShutdown:
+					ctx.WithLock(obj, func() {
+						res = obj.ShutdownGuest(ctx, &types.ShutdownGuest{This: obj.Self})
+					})
+					if fault := res.Fault(); fault != nil {
+						return fault.VimFault().(types.BaseMethodFault)
+					}
+					if entity.StopDelay > wait {
+						wait = entity.StopDelay
+					}
+				}
+			case *VirtualApp:
+				if obj.vAppState() != types.VirtualAppVAppStateStarted {
+					continue
+				}
+
+				ctx.WithLock(obj, func() {
+					res = obj.PowerOffVAppTask(ctx, &types.PowerOffVApp_Task{
+						This:  obj.Self,
+						Force: action(entity) == types.VAppAutoStartActionPowerOff,
+					})
+				})
+				if err := waitEntity(ctx, t, res.(*methods.PowerOffVApp_TaskBody).Res.Returnval); err != nil {
+					return err
+				}
+			}
+		}
+
+		delay(ctx, wait)
+	}
+
+	return nil
+}
+
+// PowerOffVAppTask stops the entities of the vApp in reverse start order, using the StopAction of each entity.
+// The vApp waits for the largest StopDelay of the entities in a group using the guestShutdown action, before it
+// continues with the next group. If force is true, all VMs are powered off regardless of their StopAction.
+func (a *VirtualApp) PowerOffVAppTask(ctx *Context, req *types.PowerOffVApp_Task) soap.HasFault {
+	task := CreateTask(a, "powerOffVApp", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if a.vAppState() == types.VirtualAppVAppStateStopped {
+			return nil, new(types.InvalidState)
+		}
+
+		fault := a.stopVApp(ctx, t, func(entity types.VAppEntityConfigInfo) types.VAppAutoStartAction {
+			if req.Force {
+				return types.VAppAutoStartActionPowerOff
+			}
+			return types.VAppAutoStartAction(entity.StopAction)
+		})
+		if fault != nil {
+			return nil, fault
+		}
+
+		a.setVAppState(ctx, types.VirtualAppVAppStateStopped)
+
+		return nil, nil
+	})
+
+	return &methods.PowerOffVApp_TaskBody{
+		Res: &types.PowerOffVApp_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// SuspendVAppTask suspends the VMs of the vApp in reverse start order.
+func (a *VirtualApp) SuspendVAppTask(ctx *Context, req *types.SuspendVApp_Task) soap.HasFault {
+	task := CreateTask(a, "suspendVApp", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if a.vAppState() != types.VirtualAppVAppStateStarted {
+			return nil, new(types.InvalidState)
+		}
+
+		fault := a.stopVApp(ctx, t, func(types.VAppEntityConfigInfo) types.VAppAutoStartAction {
+			return types.VAppAutoStartActionSuspend
+		})
+		if fault != nil {
+			return nil, fault
+		}
+
+		a.setVAppState(ctx, types.VirtualAppVAppStateStopped)
+
+		return nil, nil
+	})
+
+	return &methods.SuspendVApp_TaskBody{
+		Res: &types.SuspendVApp_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (p *ResourcePool) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
 	task := CreateTask(p, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if strings.HasSuffix(p.Parent.Type, "ComputeResource") {
@@ -534,3 +913,203 @@ func (p *ResourcePool) DestroyChildren(ctx *Context, req *types.DestroyChildren)
 
 	return &methods.DestroyChildrenBody{Res: new(types.DestroyChildrenResponse)}
 }
+
+// SetAdmissionControl enables or disables resource pool admission control.
+// When enabled, the CPU and memory reservations of child pools and powered on VMs are tracked in the runtime
+// info of each ResourcePool, and a reservation exceeding the unreserved capacity of a pool faults with
+// InsufficientCpuResourcesFault or InsufficientMemoryResourcesFault. A pool with an expandable reservation
+// can borrow the unreserved capacity of its parent. The capacity of a root pool is that of its owner's hosts
+// which are connected and not in maintenance mode.
+func (r *Registry) SetAdmissionControl(enable bool) {
+	r.admissionControl.Store(enable)
+}
+
+// reservation is a CPU reservation in MHz and a memory reservation in MB.
+type reservation struct {
+	cpu, mem int64
+}
+
+func (r reservation) add(o reservation) reservation {
+	return reservation{r.cpu + o.cpu, r.mem + o.mem}
+}
+
+func (r reservation) sub(o reservation) reservation {
+	return reservation{r.cpu - o.cpu, r.mem - o.mem}
+}
+
+func (r reservation) max(o reservation) reservation {
+	return reservation{max(r.cpu, o.cpu), max(r.mem, o.mem)}
+}
+
+func allocationReservation(cpu, mem *types.ResourceAllocationInfo) reservation {
+	var r reservation
+	if cpu != nil && cpu.Reservation != nil {
+		r.cpu = *cpu.Reservation
+	}
+	if mem != nil && mem.Reservation != nil {
+		r.mem = *mem.Reservation
+	}
+	return r
+}
+
+func poolReservation(pool *mo.ResourcePool) reservation {
+	return allocationReservation(&pool.Config.CpuAllocation, &pool.Config.MemoryAllocation)
+}
+
+func vmReservation(vm *VirtualMachine) reservation {
+	return allocationReservation(vm.Config.CpuAllocation, vm.Config.MemoryAllocation)
+}
+
+// lookupPool returns the ResourcePool for the given reference, or nil if ref is not a ResourcePool.
+func lookupPool(ctx *Context, ref types.ManagedObjectReference) *mo.ResourcePool {
+	if ref.Type != "ResourcePool" {
+		return nil
+	}
+	obj := ctx.Map.Get(ref)
+	if obj == nil {
+		return nil
+	}
+	pool, _ := asResourcePoolMO(obj)
+	return pool
+}
+
+// parentPool returns the parent of the given pool, or nil if pool is a root pool.
+func parentPool(ctx *Context, pool *mo.ResourcePool) *mo.ResourcePool {
+	if pool.Parent == nil {
+		return nil
+	}
+	return lookupPool(ctx, *pool.Parent)
+}
+
+// poolCapacity returns the capacity of the given pool.
+// The capacity of a child pool is its own reservation.
+func poolCapacity(ctx *Context, pool *mo.ResourcePool) reservation {
+	if parentPool(ctx, pool) != nil {
+		return poolReservation(pool)
+	}
+
+	var r reservation
+	for _, ref := range resourcePoolHosts(ctx, &ResourcePool{ResourcePool: *pool}) {
+		host := ctx.Map.Get(ref).(*HostSystem)
+		if host.Runtime.InMaintenanceMode || host.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+			continue
+		}
+		cpu, mem := dasCapacity(host)
+		r = r.add(reservation{cpu, mem / (1024 * 1024)})
+	}
+	return r
+}
+
+// poolReserved returns the capacity of the given pool reserved by its child pools and by its powered on VMs.
+// An expandable child pool reserves the greater of its own reservation and the capacity reserved within it.
+func poolReserved(ctx *Context, pool *mo.ResourcePool) (reservation, reservation) {
+	var pools, vms reservation
+
+	for _, ref := range pool.ResourcePool {
+		child := lookupPool(ctx, ref)
+		if child == nil {
+			continue
+		}
+		pools = pools.add(effectiveReservation(ctx, child))
+	}
+
+	for _, ref := range pool.Vm {
+		vm, ok := ctx.Map.Get(ref).(*VirtualMachine)
+		if ok && vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn {
+			vms = vms.add(vmReservation(vm))
+		}
+	}
+
+	return pools, vms
+}
+
+// effectiveReservation returns the capacity a child pool reserves in its parent.
+func effectiveReservation(ctx *Context, pool *mo.ResourcePool) reservation {
+	r := poolReservation(pool)
+	pools, vms := poolReserved(ctx, pool)
+	used := pools.add(vms)
+
+	if isTrue(pool.Config.CpuAllocation.ExpandableReservation) {
+		r.cpu = max(r.cpu, used.cpu)
+	}
+	if isTrue(pool.Config.MemoryAllocation.ExpandableReservation) {
+		r.mem = max(r.mem, used.mem)
+	}
+
+	return r
+}
+
+// admitReservation returns an InsufficientResourcesFault if the given reservation exceeds the unreserved capacity
+// of the pool, including the capacity an expandable pool can borrow from its ancestors.
+// No fault is returned if admission control is disabled, see Registry.SetAdmissionControl.
+func admitReservation(ctx *Context, ref types.ManagedObjectReference, req reservation) types.BaseMethodFault {
+	if !ctx.Map.admissionControl.Load() {
+		return nil
+	}
+
+	pool := lookupPool(ctx, ref)
+
+	for pool != nil && (req.cpu > 0 || req.mem > 0) {
+		pools, vms := poolReserved(ctx, pool)
+		unreserved := poolCapacity(ctx, pool).sub(pools).sub(vms).max(reservation{})
+		parent := parentPool(ctx, pool)
+
+		var borrow reservation
+
+		if req.cpu > unreserved.cpu {
+			if parent == nil || !isTrue(pool.Config.CpuAllocation.ExpandableReservation) {
+				return &types.InsufficientCpuResourcesFault{Unreserved: unreserved.cpu, Requested: req.cpu}
+			}
+			borrow.cpu = req.cpu - unreserved.cpu
+		}
+
+		if req.mem > unreserved.mem {
+			if parent == nil || !isTrue(pool.Config.MemoryAllocation.ExpandableReservation) {
+				return &types.InsufficientMemoryResourcesFault{
+					Unreserved: unreserved.mem * 1024 * 1024,
+					Requested:  req.mem * 1024 * 1024,
+				}
+			}
+			borrow.mem = req.mem - unreserved.mem
+		}
+
+		pool, req = parent, borrow
+	}
+
+	return nil
+}
+
+// updatePoolRuntime updates the reservation usage in the runtime info of the given pool and its ancestors,
+// if admission control is enabled.
+func updatePoolRuntime(ctx *Context, ref types.ManagedObjectReference) {
+	if !ctx.Map.admissionControl.Load() {
+		return
+	}
+
+	for pool := lookupPool(ctx, ref); pool != nil; pool = parentPool(ctx, pool) {
+		pools, vms := poolReserved(ctx, pool)
+		capacity := poolCapacity(ctx, pool)
+		unreserved := capacity.sub(pools).sub(vms).max(reservation{})
+
+		runtime := pool.Runtime
+		runtime.Cpu.ReservationUsed = pools.cpu + vms.cpu
+		runtime.Cpu.ReservationUsedForVm = vms.cpu
+		runtime.Cpu.UnreservedForPool = unreserved.cpu
+		runtime.Cpu.UnreservedForVm = unreserved.cpu
+		runtime.Memory.ReservationUsed = (pools.mem + vms.mem) * 1024 * 1024
+		runtime.Memory.ReservationUsedForVm = vms.mem * 1024 * 1024
+		runtime.Memory.UnreservedForPool = unreserved.mem * 1024 * 1024
+		runtime.Memory.UnreservedForVm = unreserved.mem * 1024 * 1024
+		if parentPool(ctx, pool) == nil {
+			runtime.Cpu.MaxUsage = capacity.cpu
+			runtime.Memory.MaxUsage = capacity.mem * 1024 * 1024
+		}
+
+		obj := ctx.Map.Get(pool.Self)
+		ctx.Map.AtomicUpdate(ctx, obj, []types.PropertyChange{{Name: "runtime", Val: runtime}})
+		if pool.Summary != nil {
+			s := pool.Summary.GetResourcePoolSummary()
+			ctx.WithLock(obj, func() { s.Runtime = runtime })
+		}
+	}
+}
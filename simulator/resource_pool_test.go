@@ -20,13 +20,17 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
@@ -308,6 +312,181 @@ func TestCreateVAppVPX(t *testing.T) {
 	}
 }
 
+func TestVAppPowerOrder(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		pool := Map.Any("ResourcePool")
+		parent := object.NewResourcePool(c, pool.Reference())
+
+		vapp, err := parent.CreateVApp(ctx, "myapp", types.DefaultResourceConfigSpec(), NewVAppConfigSpec(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var vms []*object.VirtualMachine
+		for _, name := range []string{"db", "app", "web"} {
+			task, err := vapp.CreateChildVM(ctx, types.VirtualMachineConfigSpec{
+				Name:    name,
+				GuestId: string(types.VirtualMachineGuestOsIdentifierOtherGuest),
+				Files:   &types.VirtualMachineFileInfo{VmPathName: "[LocalDS_0]"},
+			}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := task.WaitForResult(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			vms = append(vms, object.NewVirtualMachine(c, res.Result.(types.ManagedObjectReference)))
+		}
+
+		ref := func(vm *object.VirtualMachine) *types.ManagedObjectReference {
+			return types.NewReference(vm.Reference())
+		}
+
+		err = vapp.UpdateConfig(ctx, types.VAppConfigSpec{
+			EntityConfig: []types.VAppEntityConfigInfo{
+				{Key: ref(vms[0]), StartOrder: 1, StartDelay: 600},
+				{Key: ref(vms[1]), StartOrder: 2, StopAction: string(types.VAppAutoStartActionSuspend)},
+				{Key: ref(vms[2]), StartOrder: 3, StartAction: string(types.VAppAutoStartActionNone)},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = vapp.UpdateConfig(ctx, types.VAppConfigSpec{
+			EntityConfig: []types.VAppEntityConfigInfo{{Key: types.NewReference(Map.Any("HostSystem").Reference())}},
+		})
+		if !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		state := func() types.VirtualAppVAppState {
+			var app mo.VirtualApp
+			if err := vapp.Properties(ctx, vapp.Reference(), []string{"summary"}, &app); err != nil {
+				t.Fatal(err)
+			}
+			return app.Summary.(*types.VirtualAppSummary).VAppState
+		}
+
+		powerState := func(vm *object.VirtualMachine) types.VirtualMachinePowerState {
+			s, err := vm.PowerState(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		}
+
+		// subtasks returns the VM level tasks created by the given vApp level task
+		subtasks := func(info *types.TaskInfo) map[string]types.TaskInfo {
+			collector, err := task.NewManager(c).CreateCollectorForTasks(ctx, types.TaskFilterSpec{
+				ParentTaskKey: []string{info.Key},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			page, err := collector.LatestPage(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tasks := make(map[string]types.TaskInfo)
+			for _, info := range page {
+				tasks[info.Entity.Value] = info
+			}
+			return tasks
+		}
+
+		// startDelay waits on the simulator clock, which only moves when advanced
+		clock := NewManualClock(time.Now())
+		Map.SetClock(clock)
+		defer Map.SetClock(nil)
+
+		done := make(chan struct{})
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case <-time.After(10 * time.Millisecond):
+					clock.Advance(time.Minute)
+				}
+			}
+		}()
+
+		ptask, err := vapp.PowerOn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := ptask.WaitForResult(ctx, nil)
+		close(done)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s := state(); s != types.VirtualAppVAppStateStarted {
+			t.Errorf("state=%s", s)
+		}
+
+		expect := []types.VirtualMachinePowerState{
+			types.VirtualMachinePowerStatePoweredOn,
+			types.VirtualMachinePowerStatePoweredOn,
+			types.VirtualMachinePowerStatePoweredOff, // startAction=none
+		}
+		for i, vm := range vms {
+			if s := powerState(vm); s != expect[i] {
+				t.Errorf("%s state=%s", vm.Reference(), s)
+			}
+		}
+
+		tasks := subtasks(info)
+		if len(tasks) != 2 {
+			t.Fatalf("subtasks=%d", len(tasks))
+		}
+		db, app := tasks[vms[0].Reference().Value], tasks[vms[1].Reference().Value]
+		if db.RootTaskKey != info.Key || app.DescriptionId != "VirtualMachine.powerOn" {
+			t.Errorf("db=%#v app=%#v", db, app)
+		}
+		// the app tier starts after the db tier's startDelay
+		if d := app.StartTime.Sub(*db.CompleteTime); d < 10*time.Minute {
+			t.Errorf("startDelay=%s", d)
+		}
+
+		ptask, err = vapp.PowerOn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ptask.Wait(ctx); !fault.Is(err, &types.InvalidState{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		// stop actions apply in reverse start order
+		ptask, err = vapp.PowerOff(ctx, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err = ptask.WaitForResult(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s := state(); s != types.VirtualAppVAppStateStopped {
+			t.Errorf("state=%s", s)
+		}
+		if s := powerState(vms[0]); s != types.VirtualMachinePowerStatePoweredOff {
+			t.Errorf("state=%s", s)
+		}
+		if s := powerState(vms[1]); s != types.VirtualMachinePowerStateSuspended {
+			t.Errorf("state=%s", s)
+		}
+
+		tasks = subtasks(info)
+		db, app = tasks[vms[0].Reference().Value], tasks[vms[1].Reference().Value]
+		if app.DescriptionId != "VirtualMachine.suspend" || db.DescriptionId != "VirtualMachine.powerOff" || db.StartTime.Before(*app.CompleteTime) {
+			t.Errorf("db=%#v app=%#v", db, app)
+		}
+	})
+}
+
 func TestResourcePoolValidation(t *testing.T) {
 	tests := []func() bool{
 		func() bool {
@@ -359,3 +538,136 @@ func TestResourcePoolValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestResourcePoolAdmissionControl(t *testing.T) {
+	m := VPX()
+	m.AdmissionControl = true
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			return err
+		}
+		finder.SetDatacenter(dc)
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			return err
+		}
+
+		root, err := finder.ResourcePool(ctx, "DC0_C0/Resources")
+		if err != nil {
+			return err
+		}
+
+		allocation := func(cpu, mem int64, expandable bool) types.ResourceConfigSpec {
+			spec := types.DefaultResourceConfigSpec()
+			spec.CpuAllocation.Reservation = types.NewInt64(cpu)
+			spec.CpuAllocation.ExpandableReservation = types.NewBool(expandable)
+			spec.MemoryAllocation.Reservation = types.NewInt64(mem)
+			spec.MemoryAllocation.ExpandableReservation = types.NewBool(expandable)
+			return spec
+		}
+
+		_, err = root.Create(ctx, "huge", allocation(1<<40, 0, false))
+		if !fault.Is(err, &types.InsufficientCpuResourcesFault{}) {
+			t.Errorf("expected InsufficientCpuResourcesFault, got: %v", err)
+		}
+
+		_, err = root.Create(ctx, "huge", allocation(0, 1<<40, false))
+		if !fault.Is(err, &types.InsufficientMemoryResourcesFault{}) {
+			t.Errorf("expected InsufficientMemoryResourcesFault, got: %v", err)
+		}
+
+		pool, err := root.Create(ctx, "capped", allocation(1000, 1024, false))
+		if err != nil {
+			return err
+		}
+
+		var rp mo.ResourcePool
+		if err = pool.Properties(ctx, pool.Reference(), []string{"runtime"}, &rp); err != nil {
+			return err
+		}
+		if rp.Runtime.Cpu.UnreservedForVm != 1000 {
+			t.Errorf("cpu unreserved=%d", rp.Runtime.Cpu.UnreservedForVm)
+		}
+
+		create := func(name string, cpu int64) (*object.VirtualMachine, error) {
+			spec := types.VirtualMachineConfigSpec{
+				Name:          name,
+				GuestId:       string(types.VirtualMachineGuestOsIdentifierOtherGuest),
+				Files:         &types.VirtualMachineFileInfo{VmPathName: "[LocalDS_0]"},
+				CpuAllocation: &types.ResourceAllocationInfo{Reservation: types.NewInt64(cpu)},
+			}
+			task, err := folders.VmFolder.CreateVM(ctx, spec, pool, nil)
+			if err != nil {
+				return nil, err
+			}
+			info, err := task.WaitForResult(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return object.NewVirtualMachine(c, info.Result.(types.ManagedObjectReference)), nil
+		}
+
+		powerOn := func(vm *object.VirtualMachine) error {
+			task, err := vm.PowerOn(ctx)
+			if err != nil {
+				return err
+			}
+			return task.Wait(ctx)
+		}
+
+		_, err = create("too-big", 2000)
+		if !fault.Is(err, &types.InsufficientCpuResourcesFault{}) {
+			t.Errorf("expected InsufficientCpuResourcesFault, got: %v", err)
+		}
+
+		vm1, err := create("vm1", 600)
+		if err != nil {
+			return err
+		}
+		vm2, err := create("vm2", 600)
+		if err != nil {
+			return err
+		}
+
+		if err = powerOn(vm1); err != nil {
+			return err
+		}
+
+		if err = pool.Properties(ctx, pool.Reference(), []string{"runtime"}, &rp); err != nil {
+			return err
+		}
+		if rp.Runtime.Cpu.ReservationUsedForVm != 600 || rp.Runtime.Cpu.UnreservedForVm != 400 {
+			t.Errorf("cpu runtime=%#v", rp.Runtime.Cpu)
+		}
+
+		err = powerOn(vm2)
+		if !fault.Is(err, &types.InsufficientCpuResourcesFault{}) {
+			t.Errorf("expected InsufficientCpuResourcesFault, got: %v", err)
+		}
+
+		// an expandable reservation can borrow from the parent pool
+		spec := allocation(1000, 1024, true)
+		if err = pool.UpdateConfig(ctx, "", &spec); err != nil {
+			return err
+		}
+
+		if err = powerOn(vm2); err != nil {
+			return err
+		}
+
+		if err = root.Properties(ctx, root.Reference(), []string{"runtime"}, &rp); err != nil {
+			return err
+		}
+		if rp.Runtime.Cpu.ReservationUsed != 1200 {
+			t.Errorf("cpu runtime=%#v", rp.Runtime.Cpu)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -17,11 +17,10 @@ limitations under the License.
 package simulator
 
 import (
-	"time"
-
 	"github.com/google/uuid"
 
 	"github.com/vmware/govmomi/simulator/internal"
+	"github.com/vmware/govmomi/simulator/vpx"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
@@ -53,6 +52,8 @@ func NewServiceInstance(ctx *Context, content types.ServiceContent, folder mo.Fo
 		CreateDefaultESX(ctx, f)
 	} else {
 		content.About.InstanceUuid = uuid.New().String()
+		s.Capability.SupportedEVCMode = vpx.EVCMode
+		Map.Put(NewVsanUpgradeSystem())
 	}
 
 	refs := mo.References(content)
@@ -80,10 +81,10 @@ func (s *ServiceInstance) RetrieveServiceContent(*types.RetrieveServiceContent)
 	}
 }
 
-func (*ServiceInstance) CurrentTime(*types.CurrentTime) soap.HasFault {
+func (*ServiceInstance) CurrentTime(ctx *Context, _ *types.CurrentTime) soap.HasFault {
 	return &methods.CurrentTimeBody{
 		Res: &types.CurrentTimeResponse{
-			Returnval: time.Now(),
+			Returnval: ctx.Map.Now(),
 		},
 	}
 }
@@ -0,0 +1,217 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// serviceFault converts an error returned by a remote vCenter instance to a fault.
+func serviceFault(err error) types.BaseMethodFault {
+	if soap.IsSoapFault(err) {
+		if fault, ok := soap.ToSoapFault(err).VimFault().(types.BaseMethodFault); ok {
+			return fault
+		}
+	}
+
+	var terr task.Error
+	if errors.As(err, &terr) {
+		return terr.Fault()
+	}
+
+	return &types.SystemError{Reason: err.Error()}
+}
+
+// serviceClient returns a Client logged in to the vCenter instance referred to by a ServiceLocator,
+// for example another vcsim instance in the same SSO domain.
+func serviceClient(ctx context.Context, locator *types.ServiceLocator) (*vim25.Client, types.BaseMethodFault) {
+	u, err := url.Parse(locator.Url)
+	if err != nil || u.Host == "" {
+		return nil, &types.InvalidArgument{InvalidProperty: "service.url"}
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = vim25.Path
+	}
+
+	creds, ok := locator.Credential.(*types.ServiceLocatorNamePassword)
+	if !ok {
+		return nil, &types.InvalidArgument{InvalidProperty: "service.credential"}
+	}
+
+	insecure := locator.SslThumbprint == ""
+	sc := soap.NewClient(u, insecure)
+	if !insecure {
+		sc.SetThumbprint(u.Host, locator.SslThumbprint)
+	}
+
+	c, err := vim25.NewClient(ctx, sc)
+	if err != nil {
+		return nil, serviceFault(err)
+	}
+
+	if err = session.NewManager(c).Login(ctx, url.UserPassword(creds.Username, creds.Password)); err != nil {
+		return nil, serviceFault(err)
+	}
+
+	return c, nil
+}
+
+// createService creates a copy of vm within the vCenter instance referred to by spec.Service,
+// placed using spec's Folder, Pool, Host and Datastore, which refer to objects of that instance.
+func (vm *VirtualMachine) createService(spec *types.VirtualMachineRelocateSpec, name string, powerOn bool) (*types.ManagedObjectReference, types.BaseMethodFault) {
+	switch {
+	case spec.Folder == nil:
+		return nil, &types.InvalidArgument{InvalidProperty: "spec.folder"}
+	case spec.Pool == nil:
+		return nil, &types.InvalidArgument{InvalidProperty: "spec.pool"}
+	case spec.Datastore == nil:
+		return nil, &types.InvalidArgument{InvalidProperty: "spec.datastore"}
+	}
+
+	// The task may outlive the request that started it
+	ctx := context.Background()
+
+	c, fault := serviceClient(ctx, spec.Service)
+	if fault != nil {
+		return nil, fault
+	}
+	defer func() {
+		_ = session.NewManager(c).Logout(ctx)
+	}()
+
+	var ds mo.Datastore
+	if err := property.DefaultCollector(c).RetrieveOne(ctx, *spec.Datastore, []string{"name"}, &ds); err != nil {
+		return nil, serviceFault(err)
+	}
+
+	config := types.VirtualMachineConfigSpec{
+		Name:                name,
+		Version:             vm.Config.Version,
+		GuestId:             vm.Config.GuestId,
+		Annotation:          vm.Config.Annotation,
+		NumCPUs:             vm.Config.Hardware.NumCPU,
+		MemoryMB:            int64(vm.Config.Hardware.MemoryMB),
+		NumCoresPerSocket:   vm.Config.Hardware.NumCoresPerSocket,
+		VirtualICH7MPresent: vm.Config.Hardware.VirtualICH7MPresent,
+		VirtualSMCPresent:   vm.Config.Hardware.VirtualSMCPresent,
+		Files: &types.VirtualMachineFileInfo{
+			VmPathName: (&object.DatastorePath{Datastore: ds.Name, Path: name}).String(),
+		},
+		// Disks are created on the destination's home datastore
+		DeviceChange: vm.cloneDeviceChange(nil),
+	}
+
+	var host *object.HostSystem
+	if spec.Host != nil {
+		host = object.NewHostSystem(c, *spec.Host)
+	}
+
+	folder := object.NewFolder(c, *spec.Folder)
+	t, err := folder.CreateVM(ctx, config, object.NewResourcePool(c, *spec.Pool), host)
+	if err != nil {
+		return nil, serviceFault(err)
+	}
+	info, err := t.WaitForResult(ctx)
+	if err != nil {
+		return nil, serviceFault(err)
+	}
+
+	ref := info.Result.(types.ManagedObjectReference)
+	clone := object.NewVirtualMachine(c, ref)
+
+	if len(spec.DeviceChange) != 0 {
+		// For example, to map network backings to those of the destination instance
+		t, err = clone.Reconfigure(ctx, types.VirtualMachineConfigSpec{DeviceChange: spec.DeviceChange})
+		if err == nil {
+			err = t.Wait(ctx)
+		}
+		if err != nil {
+			return nil, serviceFault(err)
+		}
+	}
+
+	if powerOn {
+		t, err = clone.PowerOn(ctx)
+		if err == nil {
+			err = t.Wait(ctx)
+		}
+		if err != nil {
+			return nil, serviceFault(err)
+		}
+	}
+
+	return &ref, nil
+}
+
+// cloneService implements a cross vCenter clone, where Spec.Location.Service refers to the destination instance.
+func (vm *VirtualMachine) cloneService(ctx *Context, req *types.CloneVM_Task) soap.HasFault {
+	task := CreateTask(vm, "cloneVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		ref, fault := vm.createService(&req.Spec.Location, req.Name, req.Spec.PowerOn && !req.Spec.Template)
+		if fault != nil {
+			return nil, fault
+		}
+
+		return *ref, nil
+	})
+
+	return &methods.CloneVM_TaskBody{
+		Res: &types.CloneVM_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// relocateService implements a cross vCenter migration, where spec.Service refers to the destination instance.
+// The source VM is destroyed once the VM has been created within the destination instance.
+func (vm *VirtualMachine) relocateService(ctx *Context, spec *types.VirtualMachineRelocateSpec) types.BaseMethodFault {
+	powerOn := vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn
+
+	if _, fault := vm.createService(spec, vm.Name, powerOn); fault != nil {
+		return fault
+	}
+
+	ctx.postEvent(&types.VmEmigratingEvent{VmEvent: vm.event()})
+
+	if powerOn {
+		vm.svm.stop(ctx)
+		ctx.Map.Update(vm, []types.PropertyChange{
+			{Name: "runtime.powerState", Val: types.VirtualMachinePowerStatePoweredOff},
+			{Name: "summary.runtime.powerState", Val: types.VirtualMachinePowerStatePoweredOff},
+		})
+	}
+
+	res := vm.DestroyTask(ctx, &types.Destroy_Task{This: vm.Self})
+	dtask := ctx.Map.Get(res.(*methods.Destroy_TaskBody).Res.Returnval).(*Task)
+	dtask.Wait()
+	if dtask.Info.Error != nil {
+		return dtask.Info.Error.Fault
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestServiceLocator(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pool, err := finder.ResourcePool(ctx, "DC0_C0/Resources")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ds, err := finder.Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The destination instance is this instance, as vcsim supports 1 instance per process
+		u := *c.URL()
+		u.User = nil
+		password, _ := DefaultLogin.Password()
+
+		spec := types.VirtualMachineRelocateSpec{
+			Service: &types.ServiceLocator{
+				InstanceUuid: c.ServiceContent.About.InstanceUuid,
+				Url:          u.String(),
+				Credential: &types.ServiceLocatorNamePassword{
+					Username: DefaultLogin.Username(),
+					Password: password,
+				},
+			},
+			Pool:      types.NewReference(pool.Reference()),
+			Datastore: types.NewReference(ds.Reference()),
+		}
+
+		// destination folder is required
+		task, err := vm.Clone(ctx, folders.VmFolder, "clone", types.VirtualMachineCloneSpec{Location: spec})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("expected InvalidArgument, got: %v", err)
+		}
+
+		dest, err := folders.VmFolder.CreateFolder(ctx, "linked")
+		if err != nil {
+			t.Fatal(err)
+		}
+		spec.Folder = types.NewReference(dest.Reference())
+
+		task, err = vm.Clone(ctx, folders.VmFolder, "clone", types.VirtualMachineCloneSpec{Location: spec, PowerOn: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := task.WaitForResult(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var clone mo.VirtualMachine
+		ref := info.Result.(types.ManagedObjectReference)
+		err = vm.Properties(ctx, ref, []string{"name", "parent", "runtime.powerState", "config.hardware.device", "datastore"}, &clone)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if clone.Name != "clone" || *clone.Parent != dest.Reference() || clone.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+			t.Errorf("clone=%s, parent=%s, state=%s", clone.Name, clone.Parent, clone.Runtime.PowerState)
+		}
+		if len(clone.Datastore) != 1 || clone.Datastore[0] != ds.Reference() {
+			t.Errorf("datastore=%v", clone.Datastore)
+		}
+		disks := object.VirtualDeviceList(clone.Config.Hardware.Device).SelectByType((*types.VirtualDisk)(nil))
+		if len(disks) != 1 {
+			t.Errorf("%d disks", len(disks))
+		}
+
+		// relocate a powered on VM, removing it from the source instance
+		task, err = vm.Relocate(ctx, spec, types.VirtualMachineMovePriorityDefaultPriority)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		var src mo.VirtualMachine
+		if err = vm.Properties(ctx, vm.Reference(), []string{"name"}, &src); !fault.Is(err, &types.ManagedObjectNotFound{}) {
+			t.Errorf("expected ManagedObjectNotFound, got: %v", err)
+		}
+
+		var moved mo.VirtualMachine
+		obj, err := finder.VirtualMachine(ctx, "/DC0/vm/linked/DC0_C0_RP0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = obj.Properties(ctx, obj.Reference(), []string{"runtime.powerState"}, &moved); err != nil {
+			t.Fatal(err)
+		}
+		if moved.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+			t.Errorf("state=%s", moved.Runtime.PowerState)
+		}
+
+		// invalid credentials
+		spec.Service.Credential = &types.ServiceLocatorSAMLCredential{}
+		task, err = obj.Relocate(ctx, spec, types.VirtualMachineMovePriorityDefaultPriority)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("expected InvalidArgument, got: %v", err)
+		}
+	})
+}
@@ -55,6 +55,9 @@ var (
 	// SessionIdleTimeout duration used to expire idle sessions
 	SessionIdleTimeout time.Duration
 
+	// SessionTTL duration used to expire sessions, regardless of activity
+	SessionTTL time.Duration
+
 	sessionMutex sync.Mutex
 
 	// secureCookies enables Set-Cookie.Secure=true
@@ -64,7 +67,7 @@ var (
 )
 
 func createSession(ctx *Context, name string, locale string) types.UserSession {
-	now := time.Now().UTC()
+	now := ctx.svc.now().UTC()
 
 	if locale == "" {
 		locale = session.Locale
@@ -113,6 +116,22 @@ func (m *SessionManager) delSession(id string) {
 	delete(m.sessions, id)
 }
 
+// expireSessions removes the sessions of the given user, or all sessions if user is empty,
+// returning the number of sessions removed.
+func (m *SessionManager) expireSessions(user string) int {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+
+	n := 0
+	for id, session := range m.sessions {
+		if user == "" || session.UserName == user {
+			delete(m.sessions, id)
+			n++
+		}
+	}
+	return n
+}
+
 func (m *SessionManager) putSession(s Session) {
 	sessionMutex.Lock()
 	defer sessionMutex.Unlock()
@@ -333,6 +352,9 @@ type Context struct {
 func (c *Context) mapSession() {
 	if cookie, err := c.req.Cookie(soap.SessionCookieName); err == nil {
 		if val, ok := c.svc.sm.getSession(cookie.Value); ok {
+			if (SessionIdleTimeout != 0 || SessionTTL != 0) && c.svc.sm.expiredSession(val.Key, c.svc.now()) {
+				return
+			}
 			c.SetSession(val, false)
 		}
 	}
@@ -343,7 +365,7 @@ func (m *SessionManager) expiredSession(id string, now time.Time) bool {
 
 	s, ok := m.getSession(id)
 	if ok {
-		expired = now.Sub(s.LastActiveTime) > SessionIdleTimeout
+		expired = SessionExpired(s.LoginTime, s.LastActiveTime, now)
 		if expired {
 			m.delSession(id)
 		}
@@ -352,6 +374,15 @@ func (m *SessionManager) expiredSession(id string, now time.Time) bool {
 	return expired
 }
 
+// SessionExpired reports whether a session created at the given login time and last active at the given time
+// has expired at time now, according to SessionIdleTimeout and SessionTTL.
+func SessionExpired(login, active, now time.Time) bool {
+	if SessionIdleTimeout != 0 && now.Sub(active) > SessionIdleTimeout {
+		return true
+	}
+	return SessionTTL != 0 && now.Sub(login) > SessionTTL
+}
+
 // SessionIdleWatch starts a goroutine that calls func expired() at SessionIdleTimeout intervals.
 // The goroutine exits if the func returns true.
 func SessionIdleWatch(ctx context.Context, id string, expired func(string, time.Time) bool) {
@@ -378,7 +409,7 @@ func SessionIdleWatch(ctx context.Context, id string, expired func(string, time.
 func (c *Context) SetSession(session Session, login bool) {
 	session.UserAgent = c.req.UserAgent()
 	session.IpAddress = strings.Split(c.req.RemoteAddr, ":")[0]
-	session.LastActiveTime = time.Now()
+	session.LastActiveTime = c.svc.now()
 	session.CallCount++
 
 	c.svc.sm.putSession(session)
@@ -399,7 +430,9 @@ func (c *Context) SetSession(session Session, login bool) {
 			Locale:    session.Locale,
 		})
 
-		SessionIdleWatch(c.Context, session.Key, c.svc.sm.expiredSession)
+		SessionIdleWatch(c.Context, session.Key, func(id string, _ time.Time) bool {
+			return c.svc.sm.expiredSession(id, c.svc.now())
+		})
 	}
 }
 
@@ -415,6 +448,14 @@ func (c *Context) WithLock(obj mo.Reference, f func()) {
 	c.Map.WithLock(c, obj, f)
 }
 
+// For returns a copy of this Context with the Registry of the given SDK path, such as vim25.Path.
+// It allows the methods of an endpoint registered via RegisterSDK to invoke methods of another endpoint.
+func (c *Context) For(sdk string) *Context {
+	nc := *c
+	nc.Map = c.svc.sdk[sdk]
+	return &nc
+}
+
 // postEvent wraps EventManager.PostEvent for internal use, with a lock on the EventManager.
 func (c *Context) postEvent(events ...types.BaseEvent) {
 	m := c.Map.EventManager()
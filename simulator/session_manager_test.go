@@ -22,6 +22,7 @@ import (
 	"log"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/fault"
@@ -29,6 +30,7 @@ import (
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/session"
 	"github.com/vmware/govmomi/simulator/vpx"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
@@ -358,3 +360,33 @@ func TestSessionManagerPropertyCollector(t *testing.T) {
 		t.Errorf("kind=%s", set.Kind)
 	}
 }
+
+func TestSessionManagerTTL(t *testing.T) {
+	ttl := SessionTTL
+	SessionTTL = time.Hour
+	defer func() { SessionTTL = ttl }()
+
+	clock := NewManualClock(time.Now())
+	m := VPX()
+	m.Clock = clock
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		sm := session.NewManager(c)
+
+		clock.Advance(SessionTTL - time.Minute)
+		if s, err := sm.UserSession(ctx); err != nil || s == nil {
+			t.Errorf("session=%v, err=%v", s, err)
+		}
+
+		// sessions expire regardless of activity
+		clock.Advance(2 * time.Minute)
+		if s, err := sm.UserSession(ctx); err != nil || s != nil {
+			t.Errorf("expected expired session=%v, err=%v", s, err)
+		}
+
+		return sm.Login(ctx, DefaultLogin)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -78,6 +78,9 @@ type Service struct {
 
 	readAll func(io.Reader) ([]byte, error)
 
+	dir     string // directory loaded via Model.Load
+	persist []Persister
+
 	Listen   *url.URL
 	TLS      *tls.Config
 	ServeMux *http.ServeMux
@@ -113,6 +116,11 @@ type serverFaultBody struct {
 
 func (b *serverFaultBody) Fault() *soap.Fault { return b.Reason }
 
+// methodFaultInjector can be implemented by objects to inject a fault in place of a method call.
+type methodFaultInjector interface {
+	injectFault(*Context, string) *soap.Fault
+}
+
 func serverFault(msg string) soap.HasFault {
 	return &serverFaultBody{Reason: Fault(msg, &types.InvalidRequest{})}
 }
@@ -185,8 +193,10 @@ func (s *Service) call(ctx *Context, method *Method) soap.HasFault {
 		name = name[:len(name)-len(vTaskSuffix)] + sTaskSuffix
 	}
 
+	fn := ctx.Map.method(method.This.Type, method.Name)
+
 	m := reflect.ValueOf(handler).MethodByName(name)
-	if !m.IsValid() {
+	if !m.IsValid() && fn == nil {
 		msg := fmt.Sprintf("%s does not implement: %s", method.This, method.Name)
 		log.Print(msg)
 		fault := &types.MethodNotFound{Receiver: method.This, Method: method.Name}
@@ -203,9 +213,31 @@ func (s *Service) call(ctx *Context, method *Method) soap.HasFault {
 		}
 	}
 
+	if session != internalSession && ctx.Map.strictPermissions.Load() {
+		if fault := ctx.Map.AuthorizationManager().checkPermission(ctx, handler, method.Name); fault != nil {
+			msg := fmt.Sprintf("%s permission denied: %s", method.This, method.Name)
+			return &serverFaultBody{Reason: Fault(msg, fault)}
+		}
+	}
+
 	// We have a valid call. Introduce a delay if requested
 	if s.delay != nil {
 		s.delay.delay(method.Name)
+
+		if fault := s.delay.fault(method.Name); fault != nil {
+			msg := fmt.Sprintf("%s injected failure: %s", method.This, method.Name)
+			return &serverFaultBody{Reason: Fault(msg, fault)}
+		}
+	}
+
+	if f, ok := handler.(methodFaultInjector); ok {
+		if fault := f.injectFault(ctx, method.Name); fault != nil {
+			return &serverFaultBody{Reason: fault}
+		}
+	}
+
+	if fn != nil {
+		return callMethod(ctx, fn, handler, method)
 	}
 
 	var args, res []reflect.Value
@@ -260,6 +292,11 @@ func (s *Service) RoundTrip(ctx context.Context, request, response soap.HasFault
 		return soap.WrapSoapFault(err)
 	}
 
+	if b, ok := res.(*methodBody); ok {
+		b.setReturnval(field(response, "Res"))
+		return nil
+	}
+
 	field(response, "Res").Set(field(res, "Res"))
 
 	return nil
@@ -377,6 +414,15 @@ func (s *Service) About(w http.ResponseWriter, r *http.Request) {
 				about.Methods = append(about.Methods, strings.Replace(m.Name, "Task", "_Task", 1))
 			}
 		}
+
+		sdk.m.Lock()
+		for key := range sdk.methods {
+			if !seen[key.name] {
+				seen[key.name] = true
+				about.Methods = append(about.Methods, key.name)
+			}
+		}
+		sdk.m.Unlock()
 	}
 
 	sort.Strings(about.Methods)
@@ -614,6 +660,30 @@ func (s *Service) ServeDatastore(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+const hostGatewayPrefix = "/hgw/"
+
+// ServeHostGateway handler for vCenter proxied ESX host access via /hgw/<host-moid> path.
+// Only guest file transfers are supported.
+func (s *Service) ServeHostGateway(w http.ResponseWriter, r *http.Request) {
+	p := strings.SplitN(strings.TrimPrefix(r.URL.Path, hostGatewayPrefix), "/", 2)
+	ref := types.ManagedObjectReference{Type: "HostSystem", Value: p[0]}
+
+	if len(p) != 2 || Map.Get(ref) == nil {
+		log.Printf("invalid host gateway path: %s", r.URL.Path)
+		http.NotFound(w, r)
+		return
+	}
+
+	r.URL.Path = "/" + p[1]
+
+	if !strings.HasPrefix(r.URL.Path, guestPrefix) {
+		http.NotFound(w, r)
+		return
+	}
+
+	ServeGuest(w, r)
+}
+
 // ServiceVersions handler for the /sdk/vimServiceVersions.xml path.
 func (s *Service) ServiceVersions(w http.ResponseWriter, r *http.Request) {
 	const versions = xml.Header + `<namespaces version="1.0">
@@ -686,8 +756,10 @@ func (s *Service) NewServer() *Server {
 	mux.HandleFunc(Map.Path+"/vsanServiceVersions.xml", s.ServiceVersionsVsan)
 	mux.HandleFunc(folderPrefix, s.ServeDatastore)
 	mux.HandleFunc(guestPrefix, ServeGuest)
+	mux.HandleFunc(hostGatewayPrefix, s.ServeHostGateway)
 	mux.HandleFunc(nfcPrefix, ServeNFC)
 	mux.HandleFunc("/about", s.About)
+	mux.HandleFunc(controlPrefix, s.ServeControl)
 
 	if s.Listen == nil {
 		s.Listen = new(url.URL)
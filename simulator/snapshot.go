@@ -20,6 +20,9 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -73,8 +76,6 @@ func (v *VirtualMachineSnapshot) createSnapshotFiles() types.BaseMethodFault {
 }
 
 func (v *VirtualMachineSnapshot) removeSnapshotFiles(ctx *Context) types.BaseMethodFault {
-	// TODO: also remove delta disks that were created when snapshot was taken
-
 	vm := ctx.Map.Get(v.Vm).(*VirtualMachine)
 
 	for idx, sLayout := range vm.Layout.Snapshot {
@@ -110,17 +111,211 @@ func (v *VirtualMachineSnapshot) removeSnapshotFiles(ctx *Context) types.BaseMet
 	return nil
 }
 
+var deltaDiskSuffix = regexp.MustCompile(`-[0-9]{6}$`)
+
+// diskBackings returns the backing of each disk in the given devices.
+func diskBackings(devices []types.BaseVirtualDevice) []*types.VirtualDiskFlatVer2BackingInfo {
+	var backings []*types.VirtualDiskFlatVer2BackingInfo
+
+	for _, device := range object.VirtualDeviceList(devices).SelectByType((*types.VirtualDisk)(nil)) {
+		if b, ok := device.(*types.VirtualDisk).Backing.(*types.VirtualDiskFlatVer2BackingInfo); ok {
+			backings = append(backings, b)
+		}
+	}
+
+	return backings
+}
+
+// snapshotDiskBackings returns the disk backings of the VM and those captured by each of its snapshots.
+// Each backing is the head of a chain, linked via the Parent field, down to the base disk.
+func (vm *VirtualMachine) snapshotDiskBackings(ctx *Context) []*types.VirtualDiskFlatVer2BackingInfo {
+	backings := diskBackings(vm.Config.Hardware.Device)
+
+	if vm.Snapshot != nil {
+		for _, ref := range allSnapshotsInTree(vm.Snapshot.RootSnapshotList) {
+			if s, ok := ctx.Map.Get(ref).(*VirtualMachineSnapshot); ok {
+				backings = append(backings, diskBackings(s.Config.Hardware.Device)...)
+			}
+		}
+	}
+
+	return backings
+}
+
+// deltaDisks returns the names of the delta disks in the given backing chains.
+func deltaDisks(backings []*types.VirtualDiskFlatVer2BackingInfo) map[string]bool {
+	names := make(map[string]bool)
+
+	for _, b := range backings {
+		for ; b.Parent != nil; b = b.Parent {
+			names[b.FileName] = true
+		}
+	}
+
+	return names
+}
+
+// createDeltaDisks adds a delta disk to the head of each disk chain, in the same directory as its parent
+// unless dir is specified. The parent disks are then read-only, as captured by a snapshot
+// or shared with an instant clone.
+func (vm *VirtualMachine) createDeltaDisks(ctx *Context, devices []types.BaseVirtualDevice, dir *object.DatastorePath) types.BaseMethodFault {
+	dc := ctx.Map.getEntityDatacenter(vm)
+
+	for _, b := range diskBackings(devices) {
+		p, fault := parseDatastorePath(b.FileName)
+		if fault != nil {
+			return fault
+		}
+
+		base := deltaDiskSuffix.ReplaceAllString(strings.TrimSuffix(p.Path, ".vmdk"), "")
+		if dir != nil {
+			p.Datastore = dir.Datastore
+			base = path.Join(dir.Path, path.Base(base))
+		}
+
+		for index := 1; ; index++ {
+			name := object.DatastorePath{
+				Datastore: p.Datastore,
+				Path:      fmt.Sprintf("%s-%06d.vmdk", base, index),
+			}
+
+			err := vdmCreateVirtualDisk(types.VirtualDeviceConfigSpecFileOperationCreate, &types.CreateVirtualDisk_Task{
+				Datacenter: &dc.Self,
+				Name:       name.String(),
+			})
+			if err != nil {
+				if _, ok := err.(*types.FileAlreadyExists); ok {
+					continue
+				}
+				return err
+			}
+
+			parent := *b
+			b.FileName = name.String()
+			b.Parent = &parent
+			break
+		}
+	}
+
+	return nil
+}
+
+// mergeableDeltaDisk returns the name of a delta disk that can be merged into its parent,
+// where the parent is not the head of any chain and has no other child.
+// A parent in another directory, such as the disk an instant clone was created from, is never merged into.
+func mergeableDeltaDisk(backings []*types.VirtualDiskFlatVer2BackingInfo) string {
+	heads := make(map[string]bool)
+	children := make(map[string]map[string]bool)
+
+	for _, b := range backings {
+		heads[b.FileName] = true
+
+		for ; b.Parent != nil; b = b.Parent {
+			if children[b.Parent.FileName] == nil {
+				children[b.Parent.FileName] = make(map[string]bool)
+			}
+			children[b.Parent.FileName][b.FileName] = true
+		}
+	}
+
+	for parent, names := range children {
+		if heads[parent] || len(names) != 1 {
+			continue
+		}
+		for name := range names {
+			if path.Dir(name) == path.Dir(parent) {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
+
+// consolidateDisks merges delta disks into their parents, where the parent is no longer captured by a snapshot.
+func consolidateDisks(backings []*types.VirtualDiskFlatVer2BackingInfo) {
+	for {
+		name := mergeableDeltaDisk(backings)
+		if name == "" {
+			return
+		}
+
+		for _, b := range backings {
+			if b.FileName == name {
+				*b = *b.Parent
+				continue
+			}
+
+			for ; b.Parent != nil; b = b.Parent {
+				if b.Parent.FileName == name {
+					b.Parent = b.Parent.Parent
+					break
+				}
+			}
+		}
+	}
+}
+
+// deleteDeltaDisks deletes the given delta disks that are no longer part of the VM's disk chains.
+func (vm *VirtualMachine) deleteDeltaDisks(ctx *Context, names map[string]bool) {
+	dc := ctx.Map.getEntityDatacenter(vm)
+	dm := ctx.Map.VirtualDiskManager()
+	live := deltaDisks(vm.snapshotDiskBackings(ctx))
+
+	for name := range names {
+		if live[name] {
+			continue
+		}
+
+		res := dm.DeleteVirtualDiskTask(ctx, &types.DeleteVirtualDisk_Task{
+			Name:       name,
+			Datacenter: &dc.Self,
+		})
+		ctask := ctx.Map.Get(res.(*methods.DeleteVirtualDisk_TaskBody).Res.Returnval).(*Task)
+		ctask.Wait()
+	}
+}
+
+// updateConsolidation consolidates the VM's disks if requested, otherwise flags that consolidation is needed.
+// The given delta disks are deleted if no longer part of the VM's disk chains.
+func (vm *VirtualMachine) updateConsolidation(ctx *Context, consolidate *bool, deltas map[string]bool) {
+	backings := vm.snapshotDiskBackings(ctx)
+
+	if consolidate == nil || *consolidate {
+		consolidateDisks(backings)
+	}
+
+	vm.deleteDeltaDisks(ctx, deltas)
+
+	vm.Runtime.ConsolidationNeeded = types.NewBool(mergeableDeltaDisk(backings) != "")
+
+	ctx.Map.Update(vm, []types.PropertyChange{
+		{Name: "config.hardware.device", Val: vm.Config.Hardware.Device},
+		{Name: "runtime", Val: vm.Runtime},
+	})
+}
+
 func (v *VirtualMachineSnapshot) RemoveSnapshotTask(ctx *Context, req *types.RemoveSnapshot_Task) soap.HasFault {
 	task := CreateTask(v.Vm, "removeSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		var changes []types.PropertyChange
 
+		removed := []types.ManagedObjectReference{req.This}
+
 		vm := ctx.Map.Get(v.Vm).(*VirtualMachine)
 		ctx.WithLock(vm, func() {
-			if vm.Snapshot.CurrentSnapshot != nil && *vm.Snapshot.CurrentSnapshot == req.This {
+			if req.RemoveChildren {
+				if ss := findSnapshotInTree(vm.Snapshot.RootSnapshotList, req.This); ss != nil {
+					removed = append(removed, allSnapshotsInTree(ss.ChildSnapshotList)...)
+				}
+			}
+
+			if vm.Snapshot.CurrentSnapshot != nil && slices.Contains(removed, *vm.Snapshot.CurrentSnapshot) {
 				parent := findParentSnapshotInTree(vm.Snapshot.RootSnapshotList, req.This)
 				changes = append(changes, types.PropertyChange{Name: "snapshot.currentSnapshot", Val: parent})
 			}
 
+			deltas := deltaDisks(vm.snapshotDiskBackings(ctx))
+
 			rootSnapshots := removeSnapshotInTree(vm.Snapshot.RootSnapshotList, req.This, req.RemoveChildren)
 			changes = append(changes, types.PropertyChange{Name: "snapshot.rootSnapshotList", Val: rootSnapshots})
 
@@ -137,12 +332,19 @@ func (v *VirtualMachineSnapshot) RemoveSnapshotTask(ctx *Context, req *types.Rem
 				}
 			}
 
-			ctx.Map.Get(req.This).(*VirtualMachineSnapshot).removeSnapshotFiles(ctx)
+			for _, ref := range removed {
+				ctx.Map.Get(ref).(*VirtualMachineSnapshot).removeSnapshotFiles(ctx)
+			}
 
 			ctx.Map.Update(vm, changes)
+
+			vm.updateConsolidation(ctx, req.Consolidate, deltas)
+			vm.RefreshStorageInfo(ctx, nil)
 		})
 
-		ctx.Map.Remove(ctx, req.This)
+		for _, ref := range removed {
+			ctx.Map.Remove(ctx, ref)
+		}
 
 		return nil, nil
 	})
@@ -154,18 +356,53 @@ func (v *VirtualMachineSnapshot) RemoveSnapshotTask(ctx *Context, req *types.Rem
 	}
 }
 
+// revert restores the VM config captured by the snapshot, with new delta disks on top of the snapshot's disks.
+// Delta disks of the current state that are not captured by any snapshot are deleted.
+func (v *VirtualMachineSnapshot) revert(ctx *Context, vm *VirtualMachine) types.BaseMethodFault {
+	deltas := deltaDisks(vm.snapshotDiskBackings(ctx))
+
+	config := new(types.VirtualMachineConfigInfo)
+	deepCopy(&v.Config, config)
+
+	// The identity and location of the VM are not captured by the snapshot
+	config.Name = vm.Config.Name
+	config.Uuid = vm.Config.Uuid
+	config.InstanceUuid = vm.Config.InstanceUuid
+	config.LocationId = vm.Config.LocationId
+	config.Files = vm.Config.Files
+
+	if fault := vm.createDeltaDisks(ctx, config.Hardware.Device, nil); fault != nil {
+		return fault
+	}
+
+	vm.DataSets = copyDataSetsForVmClone(v.DataSets)
+
+	ctx.Map.Update(vm, []types.PropertyChange{
+		{Name: "config", Val: config},
+		{Name: "summary.config.numCpu", Val: config.Hardware.NumCPU},
+		{Name: "summary.config.memorySizeMB", Val: config.Hardware.MemoryMB},
+		{Name: "summary.config.numVirtualDisks", Val: int32(len(diskBackings(config.Hardware.Device)))},
+		{Name: "snapshot.currentSnapshot", Val: v.Self},
+	})
+	vm.updateLastModifiedAndChangeVersion(ctx)
+
+	vm.deleteDeltaDisks(ctx, deltas)
+	vm.RefreshStorageInfo(ctx, nil)
+
+	return nil
+}
+
 func (v *VirtualMachineSnapshot) RevertToSnapshotTask(ctx *Context, req *types.RevertToSnapshot_Task) soap.HasFault {
 	task := CreateTask(v.Vm, "revertToSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		var fault types.BaseMethodFault
+
 		vm := ctx.Map.Get(v.Vm).(*VirtualMachine)
 
 		ctx.WithLock(vm, func() {
-			vm.DataSets = copyDataSetsForVmClone(v.DataSets)
-			ctx.Map.Update(vm, []types.PropertyChange{
-				{Name: "snapshot.currentSnapshot", Val: v.Self},
-			})
+			fault = v.revert(ctx, vm)
 		})
 
-		return nil, nil
+		return nil, fault
 	})
 
 	return &methods.RevertToSnapshot_TaskBody{
@@ -17,6 +17,9 @@ limitations under the License.
 package simulator
 
 import (
+	"cmp"
+	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
@@ -29,6 +32,18 @@ import (
 
 type StorageResourceManager struct {
 	mo.StorageResourceManager
+
+	key             int
+	recommendations map[string]*storagePlacement
+}
+
+// storagePlacement is a pending recommendation of RecommendDatastores,
+// executed by ApplyStorageDrsRecommendation.
+type storagePlacement struct {
+	spec   types.StoragePlacementSpec
+	pod    types.ManagedObjectReference
+	action *types.StoragePlacementAction
+	group  []string // keys of all recommendations made by the same request
 }
 
 func (m *StorageResourceManager) ConfigureStorageDrsForPodTask(ctx *Context, req *types.ConfigureStorageDrsForPod_Task) soap.HasFault {
@@ -74,58 +89,115 @@ func (m *StorageResourceManager) pod(ref *types.ManagedObjectReference) *Storage
 	return cluster
 }
 
-func (m *StorageResourceManager) RecommendDatastores(req *types.RecommendDatastores) soap.HasFault {
+// placementSize returns the disk space in bytes required by the given placement spec.
+func (m *StorageResourceManager) placementSize(ctx *Context, spec *types.StoragePlacementSpec) int64 {
+	var size int64
+
+	disk := func(device types.BaseVirtualDevice) {
+		if d, ok := device.(*types.VirtualDisk); ok {
+			size += max(d.CapacityInBytes, d.CapacityInKB*1024)
+		}
+	}
+
+	switch types.StoragePlacementSpecPlacementType(spec.Type) {
+	case types.StoragePlacementSpecPlacementTypeCreate:
+		for _, change := range spec.ConfigSpec.DeviceChange {
+			d := change.GetVirtualDeviceConfigSpec()
+			if d.Operation == types.VirtualDeviceConfigSpecOperationAdd && d.FileOperation == types.VirtualDeviceConfigSpecFileOperationCreate {
+				disk(d.Device)
+			}
+		}
+	case types.StoragePlacementSpecPlacementTypeClone:
+		if vm, ok := ctx.Map.Get(*spec.Vm).(*VirtualMachine); ok && vm.Config != nil {
+			for _, device := range vm.Config.Hardware.Device {
+				disk(device)
+			}
+		}
+	}
+
+	return size
+}
+
+// candidates returns the datastores of the given pod with at least size bytes of free space,
+// ordered by the most free space first.
+func (m *StorageResourceManager) candidates(ctx *Context, pod *StoragePod, size int64) []*Datastore {
+	var datastores []*Datastore
+
+	for _, ref := range pod.ChildEntity {
+		ds, ok := ctx.Map.Get(ref).(*Datastore)
+		if !ok || !ds.Summary.Accessible || ds.Summary.FreeSpace < size {
+			continue
+		}
+		if ds.Summary.MaintenanceMode == string(types.DatastoreSummaryMaintenanceModeStateInMaintenance) {
+			continue
+		}
+		datastores = append(datastores, ds)
+	}
+
+	slices.SortStableFunc(datastores, func(a, b *Datastore) int {
+		return cmp.Compare(b.Summary.FreeSpace, a.Summary.FreeSpace)
+	})
+
+	return datastores
+}
+
+func (m *StorageResourceManager) RecommendDatastores(ctx *Context, req *types.RecommendDatastores) soap.HasFault {
 	spec := req.StorageSpec.PodSelectionSpec
 	body := new(methods.RecommendDatastoresBody)
 	res := new(types.RecommendDatastoresResponse)
-	key := 0
+	var group []string
 	invalid := func(prop string) soap.HasFault {
 		body.Fault_ = Fault("", &types.InvalidArgument{
 			InvalidProperty: prop,
 		})
 		return body
 	}
-	add := func(cluster *StoragePod, ds types.ManagedObjectReference) {
-		key++
-		res.Returnval.Recommendations = append(res.Returnval.Recommendations, types.ClusterRecommendation{
-			Key:            strconv.Itoa(key),
-			Type:           "V1",
-			Time:           time.Now(),
-			Rating:         1,
-			Reason:         "storagePlacement",
-			ReasonText:     "Satisfy storage initial placement requests",
-			WarningText:    "",
-			WarningDetails: (*types.LocalizableMessage)(nil),
-			Prerequisite:   nil,
-			Action: []types.BaseClusterAction{
-				&types.StoragePlacementAction{
-					ClusterAction: types.ClusterAction{
-						Type:   "StoragePlacementV1",
-						Target: (*types.ManagedObjectReference)(nil),
-					},
-					Vm: (*types.ManagedObjectReference)(nil),
-					RelocateSpec: types.VirtualMachineRelocateSpec{
-						Service:      (*types.ServiceLocator)(nil),
-						Folder:       (*types.ManagedObjectReference)(nil),
-						Datastore:    &ds,
-						DiskMoveType: "moveAllDiskBackingsAndAllowSharing",
-						Pool:         (*types.ManagedObjectReference)(nil),
-						Host:         (*types.ManagedObjectReference)(nil),
-						Disk:         nil,
-						Transform:    "",
-						DeviceChange: nil,
-						Profile:      nil,
-					},
-					Destination:       ds,
-					SpaceUtilBefore:   5.00297212600708,
-					SpaceDemandBefore: 5.00297212600708,
-					SpaceUtilAfter:    5.16835880279541,
-					SpaceDemandAfter:  5.894514083862305,
-					IoLatencyBefore:   0,
-				},
+	util := func(ds *Datastore, size int64) float32 {
+		if ds.Summary.Capacity == 0 {
+			return 0
+		}
+		used := ds.Summary.Capacity - ds.Summary.FreeSpace + size
+		return float32(used) * 100 / float32(ds.Summary.Capacity)
+	}
+	add := func(cluster *StoragePod, ds *Datastore, size int64) {
+		m.key++
+		key := strconv.Itoa(m.key)
+		before := util(ds, 0)
+		after := util(ds, size)
+		action := &types.StoragePlacementAction{
+			ClusterAction: types.ClusterAction{
+				Type:   "StoragePlacementV1",
+				Target: (*types.ManagedObjectReference)(nil),
+			},
+			Vm: req.StorageSpec.Vm,
+			RelocateSpec: types.VirtualMachineRelocateSpec{
+				Datastore:    &ds.Self,
+				DiskMoveType: "moveAllDiskBackingsAndAllowSharing",
 			},
-			Target: &cluster.Self,
+			Destination:       ds.Self,
+			SpaceUtilBefore:   before,
+			SpaceDemandBefore: before,
+			SpaceUtilAfter:    after,
+			SpaceDemandAfter:  after,
+			IoLatencyBefore:   0,
+		}
+		res.Returnval.Recommendations = append(res.Returnval.Recommendations, types.ClusterRecommendation{
+			Key:         key,
+			Type:        "V1",
+			Time:        time.Now(),
+			Rating:      max(5-int32(len(group)), 1),
+			Reason:      "storagePlacement",
+			ReasonText:  "Satisfy storage initial placement requests",
+			WarningText: "",
+			Action:      []types.BaseClusterAction{action},
+			Target:      &cluster.Self,
 		})
+		group = append(group, key)
+		m.recommendations[key] = &storagePlacement{
+			spec:   req.StorageSpec,
+			pod:    cluster.Self,
+			action: action,
+		}
 	}
 
 	var devices object.VirtualDeviceList
@@ -162,6 +234,9 @@ func (m *StorageResourceManager) RecommendDatastores(req *types.RecommendDatasto
 		}
 	}
 
+	pods := make(map[types.ManagedObjectReference]*StoragePod)
+	var order []*StoragePod
+
 	for _, placement := range spec.InitialVmConfig {
 		cluster := m.pod(&placement.StoragePod)
 		if cluster == nil {
@@ -174,11 +249,179 @@ func (m *StorageResourceManager) RecommendDatastores(req *types.RecommendDatasto
 			}
 		}
 
-		for _, ds := range cluster.ChildEntity {
-			add(cluster, ds)
+		if pods[cluster.Self] == nil {
+			pods[cluster.Self] = cluster
+			order = append(order, cluster)
+		}
+	}
+
+	if len(order) == 0 && req.StorageSpec.Type == string(types.StoragePlacementSpecPlacementTypeClone) {
+		// the VM's disks are placed in the pod selected for the VM's home directory
+		if cluster := m.pod(spec.StoragePod); cluster != nil {
+			order = append(order, cluster)
+		}
+	}
+
+	if m.recommendations == nil {
+		m.recommendations = make(map[string]*storagePlacement)
+	}
+
+	size := m.placementSize(ctx, &req.StorageSpec)
+
+	for _, cluster := range order {
+		for _, ds := range m.candidates(ctx, cluster, size) {
+			add(cluster, ds, size)
 		}
 	}
 
+	for _, key := range group {
+		m.recommendations[key].group = group
+	}
+
 	body.Res = res
 	return body
 }
+
+// apply executes the given placement, returning the created or cloned VM.
+func (m *StorageResourceManager) apply(ctx *Context, p *storagePlacement) (*types.ManagedObjectReference, types.BaseMethodFault) {
+	dest := p.action.Destination
+	var ref types.ManagedObjectReference
+
+	switch types.StoragePlacementSpecPlacementType(p.spec.Type) {
+	case types.StoragePlacementSpecPlacementTypeCreate:
+		spec := *p.spec.ConfigSpec
+		if spec.Files == nil || spec.Files.VmPathName == "" {
+			ds := ctx.Map.Get(dest).(*Datastore)
+			spec.Files = &types.VirtualMachineFileInfo{VmPathName: fmt.Sprintf("[%s]", ds.Name)}
+		}
+		for _, change := range spec.DeviceChange {
+			if disk, ok := change.GetVirtualDeviceConfigSpec().Device.(*types.VirtualDisk); ok {
+				if backing, ok := disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo); ok && (backing.Datastore == nil || backing.Datastore.Value == "") {
+					backing.Datastore = &dest
+				}
+			}
+		}
+
+		folder := p.spec.Folder
+		if folder == nil {
+			folder = &ctx.Map.getEntityDatacenter(ctx.Map.Get(p.pod).(mo.Entity)).VmFolder
+		}
+		f := ctx.Map.Get(*folder).(*Folder)
+
+		ctx.WithLock(f, func() {
+			ref = f.CreateVMTask(ctx, &types.CreateVM_Task{
+				This:   f.Self,
+				Config: spec,
+				Pool:   *p.spec.ResourcePool,
+				Host:   p.spec.Host,
+			}).(*methods.CreateVM_TaskBody).Res.Returnval
+		})
+	case types.StoragePlacementSpecPlacementTypeClone:
+		spec := *p.spec.CloneSpec
+		spec.Location.Datastore = &dest
+		vm := ctx.Map.Get(*p.spec.Vm).(*VirtualMachine)
+
+		ctx.WithLock(vm, func() {
+			ref = vm.CloneVMTask(ctx, &types.CloneVM_Task{
+				This:   vm.Self,
+				Folder: *p.spec.Folder,
+				Name:   p.spec.CloneName,
+				Spec:   spec,
+			}).(*methods.CloneVM_TaskBody).Res.Returnval
+		})
+	default:
+		return nil, new(types.NotSupported)
+	}
+
+	task := ctx.Map.Get(ref).(*Task)
+	task.Wait()
+	if task.Info.Error != nil {
+		return nil, task.Info.Error.Fault
+	}
+
+	vm := task.Info.Result.(types.ManagedObjectReference)
+	return &vm, nil
+}
+
+// placement removes and returns the pending recommendation with the given key,
+// along with the other recommendations made by the same request.
+func (m *StorageResourceManager) placement(key string) *storagePlacement {
+	p, ok := m.recommendations[key]
+	if !ok {
+		return nil
+	}
+
+	for _, key := range p.group {
+		delete(m.recommendations, key)
+	}
+
+	return p
+}
+
+func (m *StorageResourceManager) ApplyStorageDrsRecommendationTask(ctx *Context, req *types.ApplyStorageDrsRecommendation_Task) soap.HasFault {
+	task := CreateTask(m, "applyStorageDrsRecommendation", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		var res types.ApplyStorageRecommendationResult
+
+		for _, key := range req.Key {
+			if _, ok := m.recommendations[key]; !ok {
+				return nil, &types.InvalidArgument{InvalidProperty: "key"}
+			}
+		}
+
+		for _, key := range req.Key {
+			p := m.placement(key)
+			if p == nil {
+				continue // applied as part of another key's request
+			}
+
+			vm, fault := m.apply(ctx, p)
+			if fault != nil {
+				return nil, fault
+			}
+			res.Vm = vm
+		}
+
+		return res, nil
+	})
+
+	return &methods.ApplyStorageDrsRecommendation_TaskBody{
+		Res: &types.ApplyStorageDrsRecommendation_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *StorageResourceManager) ApplyStorageDrsRecommendationToPodTask(ctx *Context, req *types.ApplyStorageDrsRecommendationToPod_Task) soap.HasFault {
+	task := CreateTask(m, "applyStorageDrsRecommendationToPod", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		p, ok := m.recommendations[req.Key]
+		if !ok {
+			return nil, &types.InvalidArgument{InvalidProperty: "key"}
+		}
+		if p.pod != req.Pod {
+			return nil, &types.InvalidArgument{InvalidProperty: "pod"}
+		}
+
+		vm, fault := m.apply(ctx, m.placement(req.Key))
+		if fault != nil {
+			return nil, fault
+		}
+
+		return types.ApplyStorageRecommendationResult{Vm: vm}, nil
+	})
+
+	return &methods.ApplyStorageDrsRecommendationToPod_TaskBody{
+		Res: &types.ApplyStorageDrsRecommendationToPod_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *StorageResourceManager) CancelStorageDrsRecommendation(req *types.CancelStorageDrsRecommendation) soap.HasFault {
+	for _, key := range req.Key {
+		_ = m.placement(key)
+	}
+
+	return &methods.CancelStorageDrsRecommendationBody{
+		Res: new(types.CancelStorageDrsRecommendationResponse),
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestStorageDrsPlacement(t *testing.T) {
+	m := VPX()
+	m.Pod = 1
+	m.Datastore = 3
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			return err
+		}
+		finder.SetDatacenter(dc)
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			return err
+		}
+
+		pod, err := finder.DatastoreCluster(ctx, "DC0_POD0")
+		if err != nil {
+			return err
+		}
+		podRef := pod.Reference()
+
+		var datastores []types.ManagedObjectReference
+		for _, name := range []string{"LocalDS_1", "LocalDS_2"} {
+			ds, err := finder.Datastore(ctx, name)
+			if err != nil {
+				return err
+			}
+			datastores = append(datastores, ds.Reference())
+		}
+
+		task, err := pod.MoveInto(ctx, datastores)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		var mpod mo.StoragePod
+		if err = pod.Properties(ctx, podRef, []string{"summary"}, &mpod); err != nil {
+			return err
+		}
+		if mpod.Summary.Capacity == 0 || mpod.Summary.FreeSpace == 0 {
+			t.Errorf("summary=%#v", mpod.Summary)
+		}
+
+		pool, err := finder.ResourcePool(ctx, "DC0_C0/Resources")
+		if err != nil {
+			return err
+		}
+		poolRef := pool.Reference()
+
+		var devices object.VirtualDeviceList
+		scsi, err := devices.CreateSCSIController("pvscsi")
+		if err != nil {
+			return err
+		}
+		devices = append(devices, scsi)
+		disk := devices.CreateDisk(scsi.(types.BaseVirtualController), types.ManagedObjectReference{}, "")
+		disk.CapacityInKB = 1024
+		devices = append(devices, disk)
+
+		deviceChange, err := devices.ConfigSpec(types.VirtualDeviceConfigSpecOperationAdd)
+		if err != nil {
+			return err
+		}
+		deviceChange[1].GetVirtualDeviceConfigSpec().FileOperation = types.VirtualDeviceConfigSpecFileOperationCreate
+
+		spec := types.StoragePlacementSpec{
+			Type:         string(types.StoragePlacementSpecPlacementTypeCreate),
+			ResourcePool: &poolRef,
+			ConfigSpec: &types.VirtualMachineConfigSpec{
+				Name:         "sdrs-vm",
+				GuestId:      string(types.VirtualMachineGuestOsIdentifierOtherGuest),
+				NumCPUs:      1,
+				MemoryMB:     32,
+				DeviceChange: deviceChange,
+			},
+			PodSelectionSpec: types.StorageDrsPodSelectionSpec{
+				StoragePod: &podRef,
+				InitialVmConfig: []types.VmPodConfigForPlacement{{
+					StoragePod: podRef,
+					Disk:       []types.PodDiskLocator{{DiskId: disk.Key, DiskBackingInfo: disk.Backing}},
+				}},
+			},
+		}
+
+		srm := object.NewStorageResourceManager(c)
+		res, err := srm.RecommendDatastores(ctx, spec)
+		if err != nil {
+			return err
+		}
+		if len(res.Recommendations) != len(datastores) {
+			t.Fatalf("recommendations=%d", len(res.Recommendations))
+		}
+		for _, rec := range res.Recommendations {
+			action := rec.Action[0].(*types.StoragePlacementAction)
+			if !slices.Contains(datastores, action.Destination) {
+				t.Errorf("destination=%s", action.Destination)
+			}
+			if action.SpaceUtilAfter <= action.SpaceUtilBefore {
+				t.Errorf("util before=%f after=%f", action.SpaceUtilBefore, action.SpaceUtilAfter)
+			}
+		}
+
+		key := res.Recommendations[0].Key
+		dest := res.Recommendations[0].Action[0].(*types.StoragePlacementAction).Destination
+
+		task, err = srm.ApplyStorageDrsRecommendation(ctx, []string{key})
+		if err != nil {
+			return err
+		}
+		info, err := task.WaitForResult(ctx)
+		if err != nil {
+			return err
+		}
+		vmRef := info.Result.(types.ApplyStorageRecommendationResult).Vm
+		if vmRef == nil {
+			t.Fatal("no vm")
+		}
+
+		var vm mo.VirtualMachine
+		if err = pod.Properties(ctx, *vmRef, []string{"datastore"}, &vm); err != nil {
+			return err
+		}
+		if len(vm.Datastore) != 1 || vm.Datastore[0] != dest {
+			t.Errorf("datastore=%v", vm.Datastore)
+		}
+
+		// recommendations of the same request cannot be applied once any of them has been applied
+		for _, rec := range res.Recommendations {
+			task, err = srm.ApplyStorageDrsRecommendation(ctx, []string{rec.Key})
+			if err != nil {
+				return err
+			}
+			if err = task.Wait(ctx); err == nil {
+				t.Errorf("expected error applying %s", rec.Key)
+			}
+		}
+
+		// clone into the pod, without an InitialVmConfig
+		vmFolder := folders.VmFolder.Reference()
+		spec = types.StoragePlacementSpec{
+			Type:             string(types.StoragePlacementSpecPlacementTypeClone),
+			Vm:               vmRef,
+			Folder:           &vmFolder,
+			CloneName:        "sdrs-clone",
+			CloneSpec:        &types.VirtualMachineCloneSpec{},
+			PodSelectionSpec: types.StorageDrsPodSelectionSpec{StoragePod: &podRef},
+		}
+
+		res, err = srm.RecommendDatastores(ctx, spec)
+		if err != nil {
+			return err
+		}
+		if len(res.Recommendations) == 0 {
+			t.Fatal("no recommendations")
+		}
+
+		key = res.Recommendations[0].Key
+
+		task, err = srm.ApplyStorageDrsRecommendationToPod(ctx, nil, key)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err == nil {
+			t.Error("expected error") // wrong pod
+		}
+
+		task, err = srm.ApplyStorageDrsRecommendationToPod(ctx, pod, key)
+		if err != nil {
+			return err
+		}
+		info, err = task.WaitForResult(ctx)
+		if err != nil {
+			return err
+		}
+		if info.Result.(types.ApplyStorageRecommendationResult).Vm == nil {
+			t.Error("no clone")
+		}
+
+		res, err = srm.RecommendDatastores(ctx, spec)
+		if err != nil {
+			return err
+		}
+		if err = srm.CancelStorageDrsRecommendation(ctx, []string{res.Recommendations[0].Key}); err != nil {
+			return err
+		}
+		task, err = srm.ApplyStorageDrsRecommendation(ctx, []string{res.Recommendations[0].Key})
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err == nil {
+			t.Error("expected error") // canceled
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -31,12 +31,32 @@ import (
 const vTaskSuffix = "_Task" // vmomi suffix
 const sTaskSuffix = "Task"  // simulator suffix (avoiding golint warning)
 
-// TaskDelay applies to all tasks.
+// TaskDelay applies to all tasks. A delayed task reports info.progress updates while running,
+// a task failed by TaskDelay.Failure or TaskDelay.MethodFailure completes with TaskDelay.Fault.
 // Names for DelayConfig.MethodDelay will differ for task and api delays. API
 // level names often look like PowerOff_Task, whereas the task name is simply
 // PowerOff.
 var TaskDelay = DelayConfig{}
 
+// simulate applies TaskDelay to the task, reporting info.progress updates while the task is delayed,
+// and returns a fault if the task should fail.
+func (t *Task) simulate(r *Registry) types.BaseMethodFault {
+	const steps = 10
+
+	if d := TaskDelay.duration(t.Info.Name); d > 0 {
+		step := d / steps
+		for i := 1; i < steps; i++ {
+			time.Sleep(step)
+			r.AtomicUpdate(t.ctx, t, []types.PropertyChange{
+				{Name: "info.progress", Val: int32(i * 100 / steps)},
+			})
+		}
+		time.Sleep(d - step*(steps-1))
+	}
+
+	return TaskDelay.fault(t.Info.Name)
+}
+
 type Task struct {
 	mo.Task
 
@@ -71,8 +91,8 @@ func CreateTask(e mo.Reference, name string, run func(*Task) (types.AnyType, typ
 	task.Info.DescriptionId = fmt.Sprintf("%s.%s", ref.Type, id)
 	task.Info.Entity = &ref
 	task.Info.EntityName = ref.Value
-	task.Info.Reason = &types.TaskReasonUser{UserName: "vcsim"} // TODO: Context.Session.User
-	task.Info.QueueTime = time.Now()
+	task.Info.Reason = &types.TaskReasonUser{UserName: "vcsim"} // set to Context.Session.UserName by Run
+	task.Info.QueueTime = Map.Now()
 	task.Info.State = types.TaskInfoStateQueued
 
 	Map.Put(task)
@@ -101,10 +121,16 @@ func (t *Task) Run(ctx *Context) types.ManagedObjectReference {
 	// global Map variable.
 	vimMap := Map
 
-	vimMap.AtomicUpdate(t.ctx, t, []types.PropertyChange{
-		{Name: "info.startTime", Val: time.Now()},
+	changes := []types.PropertyChange{
+		{Name: "info.startTime", Val: vimMap.Now()},
 		{Name: "info.state", Val: types.TaskInfoStateRunning},
-	})
+	}
+	if ctx.Session != nil && ctx.Session.UserName != "" {
+		changes = append(changes, types.PropertyChange{
+			Name: "info.reason", Val: &types.TaskReasonUser{UserName: ctx.Session.UserName},
+		})
+	}
+	vimMap.AtomicUpdate(t.ctx, t, changes)
 
 	tr := &taskReference{
 		Self: *t.Info.Entity,
@@ -123,11 +149,15 @@ func (t *Task) Run(ctx *Context) types.ManagedObjectReference {
 		unlock = vimMap.AcquireLock(ctx, tr)
 	}
 	go func() {
-		TaskDelay.delay(t.Info.Name)
+		injected := t.simulate(vimMap)
 		if !handoff {
 			unlock = vimMap.AcquireLock(ctx, tr)
 		}
-		res, err := t.Execute(t)
+		var res types.AnyType
+		err := injected
+		if err == nil {
+			res, err = t.Execute(t)
+		}
 		unlock()
 
 		state := types.TaskInfoStateSuccess
@@ -141,7 +171,7 @@ func (t *Task) Run(ctx *Context) types.ManagedObjectReference {
 		}
 
 		vimMap.AtomicUpdate(t.ctx, t, []types.PropertyChange{
-			{Name: "info.completeTime", Val: time.Now()},
+			{Name: "info.completeTime", Val: vimMap.Now()},
 			{Name: "info.state", Val: state},
 			{Name: "info.result", Val: res},
 			{Name: "info.error", Val: fault},
@@ -151,6 +181,25 @@ func (t *Task) Run(ctx *Context) types.ManagedObjectReference {
 	return t.Self
 }
 
+// subtask links the task with the given reference to the parent task t, setting the
+// info.parentTaskKey and info.rootTaskKey properties as vCenter does for tasks created
+// on behalf of another task.
+func (t *Task) subtask(ctx *Context, ref types.ManagedObjectReference) *Task {
+	sub := ctx.Map.Get(ref).(*Task)
+
+	root := t.Info.RootTaskKey
+	if root == "" {
+		root = t.Info.Key
+	}
+
+	ctx.Map.AtomicUpdate(ctx, sub, []types.PropertyChange{
+		{Name: "info.parentTaskKey", Val: t.Info.Key},
+		{Name: "info.rootTaskKey", Val: root},
+	})
+
+	return sub
+}
+
 // RunBlocking() should only be used when an async simulator task needs to wait
 // on another async simulator task.
 // It polls for task completion to avoid the need to set up a PropertyCollector.
@@ -202,9 +251,9 @@ func (t *Task) SetTaskState(ctx *Context, req *types.SetTaskState) soap.HasFault
 
 	switch req.State {
 	case types.TaskInfoStateRunning:
-		changes = append(changes, types.PropertyChange{Name: "info.startTime", Val: time.Now()})
+		changes = append(changes, types.PropertyChange{Name: "info.startTime", Val: ctx.Map.Now()})
 	case types.TaskInfoStateError, types.TaskInfoStateSuccess:
-		changes = append(changes, types.PropertyChange{Name: "info.completeTime", Val: time.Now()})
+		changes = append(changes, types.PropertyChange{Name: "info.completeTime", Val: ctx.Map.Now()})
 
 		if req.Fault != nil {
 			changes = append(changes, types.PropertyChange{Name: "info.error", Val: req.Fault})
@@ -258,7 +307,7 @@ func (t *Task) CancelTask(ctx *Context, req *types.CancelTask) soap.HasFault {
 
 	changes := []types.PropertyChange{
 		{Name: "info.cancelled", Val: true},
-		{Name: "info.completeTime", Val: time.Now()},
+		{Name: "info.completeTime", Val: ctx.Map.Now()},
 		{Name: "info.state", Val: types.TaskInfoStateError},
 		{Name: "info.error", Val: &types.LocalizedMethodFault{
 			Fault:            &types.RequestCanceled{},
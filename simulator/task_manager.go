@@ -18,6 +18,7 @@ package simulator
 
 import (
 	"container/list"
+	"slices"
 	"sync"
 	"time"
 
@@ -29,13 +30,17 @@ import (
 	"github.com/vmware/govmomi/vim25/types"
 )
 
-var recentTaskMax = 200 // the VC limit
+var (
+	recentTaskMax    = 200              // the VC limit
+	recentTaskExpire = 10 * time.Minute // completed tasks are evicted from recentTask after this duration
+)
 
 type TaskManager struct {
 	mo.TaskManager
 	sync.Mutex
 
 	history *history
+	done    map[types.ManagedObjectReference]time.Time // completion time of tasks in RecentTask
 }
 
 func (m *TaskManager) init(r *Registry) {
@@ -53,17 +58,41 @@ func (m *TaskManager) init(r *Registry) {
 	}
 
 	m.history = newHistory()
+	m.done = make(map[types.ManagedObjectReference]time.Time)
 
 	r.AddHandler(m)
 }
 
-func recentTask(recent []types.ManagedObjectReference, ref types.ManagedObjectReference) []types.PropertyChange {
-	// TODO: tasks completed > 10m ago should be removed
-	recent = append(recent, ref)
-	if len(recent) > recentTaskMax {
-		recent = recent[1:]
+// recentTask appends ref to the recent list, evicting tasks where keep returns false.
+// If the list exceeds recentTaskMax, the oldest completed tasks are evicted first, followed by the oldest tasks.
+func (m *TaskManager) recentTask(recent []types.ManagedObjectReference, ref types.ManagedObjectReference, keep func(types.ManagedObjectReference) bool) []types.PropertyChange {
+	var tasks []types.ManagedObjectReference
+	for _, task := range recent {
+		if keep(task) {
+			tasks = append(tasks, task)
+		}
+	}
+	tasks = append(tasks, ref)
+
+	for i := 0; len(tasks) > recentTaskMax && i < len(tasks); {
+		if _, ok := m.done[tasks[i]]; ok {
+			tasks = append(tasks[:i], tasks[i+1:]...)
+			continue
+		}
+		i++
+	}
+
+	if n := len(tasks) - recentTaskMax; n > 0 {
+		tasks = tasks[n:]
 	}
-	return []types.PropertyChange{{Name: "recentTask", Val: recent}}
+
+	return []types.PropertyChange{{Name: "recentTask", Val: tasks}}
+}
+
+// expired returns true if the given task completed more than recentTaskExpire ago.
+func (m *TaskManager) expired(ref types.ManagedObjectReference, now time.Time) bool {
+	done, ok := m.done[ref]
+	return ok && now.Sub(done) > recentTaskExpire
 }
 
 func (m *TaskManager) PutObject(obj mo.Reference) {
@@ -79,7 +108,20 @@ func (m *TaskManager) PutObject(obj mo.Reference) {
 	// - TaskHistoryCollector instances, if Filter matches
 	// - $MO.RecentTask
 	m.Lock()
-	ctx.Map.Update(m, recentTask(m.RecentTask, task.Self))
+	now := ctx.Map.Now()
+	ctx.Map.Update(m, m.recentTask(m.RecentTask, task.Self, func(ref types.ManagedObjectReference) bool {
+		return !m.expired(ref, now)
+	}))
+
+	recent := make(map[types.ManagedObjectReference]bool, len(m.RecentTask))
+	for _, ref := range m.RecentTask {
+		recent[ref] = true
+	}
+	for ref := range m.done {
+		if !recent[ref] {
+			delete(m.done, ref)
+		}
+	}
 
 	pushHistory(m.history.page, task)
 
@@ -92,12 +134,17 @@ func (m *TaskManager) PutObject(obj mo.Reference) {
 			}
 		})
 	}
-	m.Unlock()
-
 	entity := ctx.Map.Get(*task.Info.Entity)
 	if e, ok := entity.(mo.Entity); ok {
-		ctx.Map.Update(entity, recentTask(e.Entity().RecentTask, task.Self))
+		// TaskManager.RecentTask is a superset of each entity's recentTask
+		changes := m.recentTask(e.Entity().RecentTask, task.Self, func(ref types.ManagedObjectReference) bool {
+			return recent[ref]
+		})
+		m.Unlock()
+		ctx.Map.Update(entity, changes)
+		return
 	}
+	m.Unlock()
 }
 
 func taskStateChanged(pc []types.PropertyChange) bool {
@@ -123,13 +170,29 @@ func (m *TaskManager) UpdateObject(obj mo.Reference, pc []types.PropertyChange)
 	}
 
 	m.Lock()
+	switch task.Info.State {
+	case types.TaskInfoStateSuccess, types.TaskInfoStateError:
+		if task.Info.CompleteTime != nil {
+			m.done[task.Self] = *task.Info.CompleteTime
+		}
+	}
+
 	for _, hc := range m.history.collectors {
 		c := hc.(*TaskHistoryCollector)
 		ctx := SpoofContext()
 		ctx.WithLock(c, func() {
-			if c.hasTask(ctx, &task.Info) {
-				ctx.Map.Update(c, []types.PropertyChange{{Name: "latestPage", Val: c.GetLatestPage()}})
+			if !c.hasTask(ctx, &task.Info) {
+				// the task may now match the Filter, such as State or Time
+				if !c.taskMatches(ctx, &task.Info) {
+					return
+				}
+				t, ok := ctx.Map.Get(task.Self).(*Task)
+				if !ok {
+					return
+				}
+				pushHistory(c.page, t)
 			}
+			ctx.Map.Update(c, []types.PropertyChange{{Name: "latestPage", Val: c.GetLatestPage()}})
 		})
 	}
 	m.Unlock()
@@ -171,7 +234,7 @@ func (m *TaskManager) CreateTask(ctx *Context, req *types.CreateTask) soap.HasFa
 	task.Info.Entity = &req.Obj
 	task.Info.EntityName = req.Obj.Value
 	task.Info.Reason = &types.TaskReasonUser{UserName: ctx.Session.UserName}
-	task.Info.QueueTime = time.Now()
+	task.Info.QueueTime = ctx.Map.Now()
 	task.Info.State = types.TaskInfoStateQueued
 
 	body.Res = &types.CreateTaskResponse{Returnval: task.Info}
@@ -261,7 +324,22 @@ func (c *TaskHistoryCollector) timeMatches(_ *Context, task *types.TaskInfo, spe
 		return true
 	}
 
-	created := task.QueueTime
+	var created time.Time
+
+	switch spec.Time.TimeType {
+	case types.TaskFilterSpecTimeOptionStartedTime:
+		if task.StartTime == nil {
+			return false
+		}
+		created = *task.StartTime
+	case types.TaskFilterSpecTimeOptionCompletedTime:
+		if task.CompleteTime == nil {
+			return false
+		}
+		created = *task.CompleteTime
+	default:
+		created = task.QueueTime
+	}
 
 	if begin := spec.Time.BeginTime; begin != nil {
 		if created.Before(*begin) {
@@ -278,6 +356,59 @@ func (c *TaskHistoryCollector) timeMatches(_ *Context, task *types.TaskInfo, spe
 	return true
 }
 
+// userMatches returns true if the spec UserName filter matches the user that initiated the task.
+func (c *TaskHistoryCollector) userMatches(_ *Context, task *types.TaskInfo, spec types.TaskFilterSpec) bool {
+	if spec.UserName == nil {
+		return true
+	}
+
+	reason, ok := task.Reason.(*types.TaskReasonUser)
+	if !ok {
+		return spec.UserName.SystemUser
+	}
+
+	if len(spec.UserName.UserList) == 0 {
+		return true // all regular user tasks
+	}
+
+	return slices.Contains(spec.UserName.UserList, reason.UserName)
+}
+
+// reasonMatches returns true if the spec Alarm and ScheduledTask filters match the task reason.
+func (c *TaskHistoryCollector) reasonMatches(_ *Context, task *types.TaskInfo, spec types.TaskFilterSpec) bool {
+	if spec.Alarm != nil {
+		reason, ok := task.Reason.(*types.TaskReasonAlarm)
+		if !ok || reason.Alarm != *spec.Alarm {
+			return false
+		}
+	}
+
+	if spec.ScheduledTask != nil {
+		reason, ok := task.Reason.(*types.TaskReasonSchedule)
+		if !ok || reason.ScheduledTask != *spec.ScheduledTask {
+			return false
+		}
+	}
+
+	return true
+}
+
+// keyMatches returns true if the spec EventChainId, Tag, ParentTaskKey, RootTaskKey and ActivationId filters match the task.
+func (c *TaskHistoryCollector) keyMatches(_ *Context, task *types.TaskInfo, spec types.TaskFilterSpec) bool {
+	matches := func(list []string, val string) bool {
+		return len(list) == 0 || slices.Contains(list, val)
+	}
+
+	if len(spec.EventChainId) != 0 && !slices.Contains(spec.EventChainId, task.EventChainId) {
+		return false
+	}
+
+	return matches(spec.Tag, task.ChangeTag) &&
+		matches(spec.ParentTaskKey, task.ParentTaskKey) &&
+		matches(spec.RootTaskKey, task.RootTaskKey) &&
+		matches(spec.ActivationId, task.ActivationId)
+}
+
 // taskMatches returns true one of the filters matches the task.
 func (c *TaskHistoryCollector) taskMatches(ctx *Context, task *types.TaskInfo) bool {
 	spec := c.Filter.(types.TaskFilterSpec)
@@ -286,7 +417,9 @@ func (c *TaskHistoryCollector) taskMatches(ctx *Context, task *types.TaskInfo) b
 		c.stateMatches,
 		c.timeMatches,
 		c.entityMatches,
-		// TODO: spec.UserName, etc
+		c.userMatches,
+		c.reasonMatches,
+		c.keyMatches,
 	}
 
 	for _, match := range matchers {
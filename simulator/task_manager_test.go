@@ -19,6 +19,7 @@ package simulator_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/property"
@@ -178,3 +179,99 @@ func TestTaskManagerRead(t *testing.T) {
 		}
 	})
 }
+
+func TestTaskManagerFilterState(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		vm := object.NewVirtualMachine(vc, simulator.Map.Any("VirtualMachine").Reference())
+		tm := task.NewManager(vc)
+
+		begin := time.Now()
+		spec := types.TaskFilterSpec{
+			State: []types.TaskInfoState{types.TaskInfoStateSuccess},
+			Time: &types.TaskFilterSpecByTime{
+				TimeType:  types.TaskFilterSpecTimeOptionCompletedTime,
+				BeginTime: &begin,
+			},
+		}
+
+		c, err := tm.CreateCollectorForTasks(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		page, err := c.LatestPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) != 0 {
+			t.Errorf("page=%d", len(page))
+		}
+
+		ptask, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ptask.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		tasks, err := c.ReadNextTasks(ctx, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tasks) != 1 || tasks[0].Task != ptask.Reference() || tasks[0].State != types.TaskInfoStateSuccess {
+			t.Errorf("tasks=%#v", tasks)
+		}
+	})
+}
+
+func TestTaskManagerFilterUser(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		vm := object.NewVirtualMachine(vc, simulator.Map.Any("VirtualMachine").Reference())
+		tm := task.NewManager(vc)
+		user := simulator.DefaultLogin.Username()
+
+		collector := func(spec types.TaskFilterSpec) *task.HistoryCollector {
+			c, err := tm.CreateCollectorForTasks(ctx, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return c
+		}
+
+		self := &types.TaskFilterSpecByEntity{Entity: vm.Reference(), Recursion: types.TaskFilterSpecRecursionOptionSelf}
+		users := collector(types.TaskFilterSpec{Entity: self, UserName: &types.TaskFilterSpecByUsername{UserList: []string{user}}})
+		enoent := collector(types.TaskFilterSpec{Entity: self, UserName: &types.TaskFilterSpecByUsername{UserList: []string{"enoent"}}})
+		tags := collector(types.TaskFilterSpec{Entity: self, Tag: []string{"enoent"}})
+
+		ptask, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ptask.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		tasks, err := users.ReadNextTasks(ctx, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tasks) != 1 {
+			t.Fatalf("tasks=%d", len(tasks))
+		}
+		reason, ok := tasks[0].Reason.(*types.TaskReasonUser)
+		if !ok || reason.UserName != user {
+			t.Errorf("reason=%#v", tasks[0].Reason)
+		}
+
+		for _, c := range []*task.HistoryCollector{enoent, tags} {
+			tasks, err = c.ReadNextTasks(ctx, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tasks) != 0 {
+				t.Errorf("tasks=%d", len(tasks))
+			}
+		}
+	})
+}
@@ -17,8 +17,13 @@ limitations under the License.
 package simulator
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -64,3 +69,125 @@ func TestNewTask(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestTaskDelay(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+
+		defer func() { TaskDelay = DelayConfig{} }()
+		TaskDelay.MethodDelay = map[string]int{"PowerOff": 200}
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		start := time.Now()
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if d := time.Since(start); d < 200*time.Millisecond {
+			t.Errorf("task completed in %s", d)
+		}
+
+		TaskDelay = DelayConfig{Failure: 1, Fault: new(types.InvalidPowerState)}
+
+		task, err = vm.PowerOn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = task.Wait(ctx)
+		if !fault.Is(err, new(types.InvalidPowerState)) {
+			t.Errorf("err=%v", err)
+		}
+
+		state, err := vm.PowerState(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state != types.VirtualMachinePowerStatePoweredOff {
+			t.Errorf("state=%s", state)
+		}
+
+		// MethodFailure overrides Failure
+		TaskDelay.MethodFailure = map[string]float64{"PowerOn": 0}
+
+		task, err = vm.PowerOn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMethodFailure(t *testing.T) {
+	m := VPX()
+	m.DelayConfig.MethodFailure = map[string]float64{"PowerOffVM_Task": 1}
+
+	Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+
+		_, err := vm.PowerOff(ctx)
+		if !fault.Is(err, new(types.SystemError)) {
+			t.Errorf("err=%v", err)
+		}
+	}, m)
+}
+
+func TestTaskManagerRecentEviction(t *testing.T) {
+	max, expire := recentTaskMax, recentTaskExpire
+	defer func() { recentTaskMax, recentTaskExpire = max, expire }()
+	recentTaskMax = 5
+	recentTaskExpire = time.Hour
+
+	Test(func(ctx context.Context, c *vim25.Client) {
+		obj := Map.Any("VirtualMachine").(*VirtualMachine)
+		vm := object.NewVirtualMachine(c, obj.Self)
+		m := Map.Get(*c.ServiceContent.TaskManager).(*TaskManager)
+
+		recent := func() (int, int) {
+			var tm mo.TaskManager
+			var e mo.VirtualMachine
+			if err := vm.Properties(ctx, m.Self, []string{"recentTask"}, &tm); err != nil {
+				t.Fatal(err)
+			}
+			if err := vm.Properties(ctx, vm.Reference(), []string{"recentTask"}, &e); err != nil {
+				t.Fatal(err)
+			}
+			return len(tm.RecentTask), len(e.RecentTask)
+		}
+
+		power := func() {
+			f := vm.PowerOff
+			if obj.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOff {
+				f = vm.PowerOn
+			}
+			task, err := f(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		for i := 0; i < recentTaskMax*2; i++ {
+			power()
+		}
+
+		n, e := recent()
+		if n != recentTaskMax || e != recentTaskMax {
+			t.Errorf("recentTask=%d, vm recentTask=%d", n, e)
+		}
+
+		recentTaskExpire = 0
+		power()
+
+		n, e = recent()
+		if n != 1 || e != 1 {
+			t.Errorf("expired recentTask=%d, vm recentTask=%d", n, e)
+		}
+	})
+}
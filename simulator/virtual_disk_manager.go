@@ -39,8 +39,13 @@ func (m *VirtualDiskManager) MO() mo.VirtualDiskManager {
 }
 
 func vdmNames(name string) []string {
+	extent := "-flat.vmdk"
+	if deltaDiskSuffix.MatchString(strings.TrimSuffix(name, ".vmdk")) {
+		extent = "-delta.vmdk" // snapshot delta disk
+	}
+
 	return []string{
-		strings.Replace(name, ".vmdk", "-flat.vmdk", 1),
+		strings.Replace(name, ".vmdk", extent, 1),
 		name,
 	}
 }
@@ -25,6 +25,7 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -44,11 +45,16 @@ type VirtualMachine struct {
 	mo.VirtualMachine
 	DataSets map[string]*DataSet
 
-	log string
-	sid int32
-	svm *simVM
-	uid uuid.UUID
-	imc *types.CustomizationSpec
+	// Profile holds the storage policy ID of the VM home (key 0) and of each virtual disk (device key),
+	// as applied via VirtualMachineConfigSpec.VmProfile and VirtualDeviceConfigSpec.Profile.
+	Profile map[int32]string
+
+	log   string
+	sid   int32
+	svm   *simVM
+	uid   uuid.UUID
+	guest *memGuest
+	imc   *types.CustomizationSpec
 }
 
 func asVirtualMachineMO(obj mo.Reference) (*mo.VirtualMachine, bool) {
@@ -496,6 +502,11 @@ func (vm *VirtualMachine) applyExtraConfig(ctx *Context, spec *types.VirtualMach
 			changes = append(changes,
 				types.PropertyChange{Name: "summary." + key, Val: val.Value},
 			)
+		case "runtime.instantCloneFrozen":
+			// As the guest would via: vmware-rpctool "instantclone.freeze"
+			s, _ := val.Value.(string)
+			frozen, _ := strconv.ParseBool(s)
+			changes[len(changes)-1].Val = frozen
 		}
 	}
 
@@ -555,6 +566,39 @@ func validateGuestID(id string) types.BaseMethodFault {
 	return &types.InvalidArgument{InvalidProperty: "configSpec.guestId"}
 }
 
+// validateReplication validates the vSphere Replication settings of a reconfigure spec.
+// The spec must be based on the current generation of the VM's settings, if any,
+// and the replication ID of a VM cannot be changed once replication is enabled.
+func (vm *VirtualMachine) validateReplication(spec *types.ReplicationConfigSpec) types.BaseMethodFault {
+	fault := func(reason types.ReplicationVmConfigFaultReasonForFault) types.BaseMethodFault {
+		return &types.ReplicationVmConfigFault{Reason: string(reason), VmRef: &vm.Self}
+	}
+
+	if current := vm.Config.RepConfig; current != nil {
+		if spec.Generation != current.Generation {
+			return fault(types.ReplicationVmConfigFaultReasonForFaultStaleGenerationNumber)
+		}
+		if spec.VmReplicationId != current.VmReplicationId {
+			return fault(types.ReplicationVmConfigFaultReasonForFaultReconfigureVmReplicationIdNotAllowed)
+		}
+	} else if spec.Generation != 0 {
+		return fault(types.ReplicationVmConfigFaultReasonForFaultInvalidGenerationNumber)
+	}
+
+	switch {
+	case spec.VmReplicationId == "":
+		return fault(types.ReplicationVmConfigFaultReasonForFaultInvalidVmReplicationId)
+	case spec.Rpo < 1 || spec.Rpo > 1440:
+		return fault(types.ReplicationVmConfigFaultReasonForFaultOutOfBoundsRpoValue)
+	case spec.Destination == "":
+		return fault(types.ReplicationVmConfigFaultReasonForFaultInvalidDestinationIpAddress)
+	case spec.Port < 1 || spec.Port > 65535:
+		return fault(types.ReplicationVmConfigFaultReasonForFaultInvalidDestinationPort)
+	}
+
+	return nil
+}
+
 func (vm *VirtualMachine) configure(ctx *Context, spec *types.VirtualMachineConfigSpec) (result types.BaseMethodFault) {
 	defer func() {
 		if result == nil {
@@ -562,8 +606,18 @@ func (vm *VirtualMachine) configure(ctx *Context, spec *types.VirtualMachineConf
 		}
 	}()
 
+	if spec.RepConfig != nil {
+		if err := vm.validateReplication(spec.RepConfig); err != nil {
+			return err
+		}
+	}
+
 	vm.apply(spec)
 
+	if spec.RepConfig != nil {
+		vm.Config.RepConfig.Generation = spec.RepConfig.Generation + 1
+	}
+
 	if spec.MemoryAllocation != nil {
 		if err := updateResourceAllocation("memory", spec.MemoryAllocation, vm.Config.MemoryAllocation); err != nil {
 			return err
@@ -784,12 +838,62 @@ func (vm *VirtualMachine) addSnapshotLayoutEx(snapshot types.ManagedObjectRefere
 	vm.updateStorage()
 }
 
-// Updates both vm.Layout.Disk and vm.LayoutEx.Disk
+// Updates both vm.Layout.Disk and vm.LayoutEx.Disk, along with the disks of vm.Layout.Snapshot and vm.LayoutEx.Snapshot
 func (vm *VirtualMachine) updateDiskLayouts() types.BaseMethodFault {
+	disksLayout, disksLayoutEx, fault := vm.diskLayouts(vm.Config.Hardware.Device)
+	if fault != nil {
+		return fault
+	}
+
+	vm.Layout.Disk = disksLayout
+
+	vm.LayoutEx.Disk = disksLayoutEx
+
+	for i, snapshotLayoutEx := range vm.LayoutEx.Snapshot {
+		snapshot, ok := Map.Get(snapshotLayoutEx.Key).(*VirtualMachineSnapshot)
+		if !ok {
+			continue
+		}
+
+		disksLayout, disksLayoutEx, fault := vm.diskLayouts(snapshot.Config.Hardware.Device)
+		if fault != nil {
+			return fault
+		}
+
+		vm.LayoutEx.Snapshot[i].Disk = disksLayoutEx
+
+		for j := range vm.Layout.Snapshot {
+			if vm.Layout.Snapshot[j].Key != snapshotLayoutEx.Key {
+				continue
+			}
+
+			var snapshotFiles []string
+			for _, file := range vm.LayoutEx.File {
+				if file.Key == snapshotLayoutEx.DataKey {
+					snapshotFiles = append(snapshotFiles, file.Name)
+				}
+			}
+			for _, disk := range disksLayout {
+				snapshotFiles = append(snapshotFiles, disk.DiskFile...)
+			}
+
+			vm.Layout.Snapshot[j].SnapshotFile = snapshotFiles
+		}
+	}
+
+	vm.LayoutEx.Timestamp = time.Now()
+
+	vm.updateStorage()
+
+	return nil
+}
+
+// diskLayouts returns the layout of each disk in devices, including the files of each disk in its backing chain
+func (vm *VirtualMachine) diskLayouts(devices []types.BaseVirtualDevice) ([]types.VirtualMachineFileLayoutDiskLayout, []types.VirtualMachineFileLayoutExDiskLayout, types.BaseMethodFault) {
 	var disksLayout []types.VirtualMachineFileLayoutDiskLayout
 	var disksLayoutEx []types.VirtualMachineFileLayoutExDiskLayout
 
-	disks := object.VirtualDeviceList(vm.Config.Hardware.Device).SelectByType((*types.VirtualDisk)(nil))
+	disks := object.VirtualDeviceList(devices).SelectByType((*types.VirtualDisk)(nil))
 	for _, disk := range disks {
 		disk := disk.(*types.VirtualDisk)
 		diskBacking := disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo)
@@ -808,7 +912,7 @@ func (vm *VirtualMachine) updateDiskLayouts() types.BaseMethodFault {
 				// get full path including datastore location
 				p, fault := parseDatastorePath(diskName)
 				if fault != nil {
-					return fault
+					return nil, nil, fault
 				}
 
 				datastore := vm.useDatastore(p.Datastore)
@@ -825,9 +929,10 @@ func (vm *VirtualMachine) updateDiskLayouts() types.BaseMethodFault {
 			}
 
 			diskLayout.DiskFile = append(diskLayout.DiskFile, dFileName)
-			diskLayoutEx.Chain = append(diskLayoutEx.Chain, types.VirtualMachineFileLayoutExDiskUnit{
+			// The chain is ordered from the base disk to the running delta disk
+			diskLayoutEx.Chain = append([]types.VirtualMachineFileLayoutExDiskUnit{{
 				FileKey: fileKeys,
-			})
+			}}, diskLayoutEx.Chain...)
 
 			if parent := diskBacking.Parent; parent != nil {
 				diskBacking = parent
@@ -840,14 +945,7 @@ func (vm *VirtualMachine) updateDiskLayouts() types.BaseMethodFault {
 		disksLayoutEx = append(disksLayoutEx, *diskLayoutEx)
 	}
 
-	vm.Layout.Disk = disksLayout
-
-	vm.LayoutEx.Disk = disksLayoutEx
-	vm.LayoutEx.Timestamp = time.Now()
-
-	vm.updateStorage()
-
-	return nil
+	return disksLayout, disksLayoutEx, nil
 }
 
 func (vm *VirtualMachine) updateStorage() types.BaseMethodFault {
@@ -931,9 +1029,15 @@ func (vm *VirtualMachine) RefreshStorageInfo(ctx *Context, req *types.RefreshSto
 			return body
 		}
 
-		if _, err := os.Stat(p.String()); err != nil {
+		datastore := vm.findDatastore(p.Datastore)
+		info, err := os.Stat(path.Join(datastore.Info.GetDatastoreInfo().Url, p.Path))
+		if err != nil {
 			vm.LayoutEx.File = append(vm.LayoutEx.File[:idx], vm.LayoutEx.File[idx+1:]...)
+			continue
 		}
+
+		vm.LayoutEx.File[idx].Size = info.Size()
+		vm.LayoutEx.File[idx].UniqueSize = info.Size()
 	}
 
 	// Directories will be used to locate VM files.
@@ -975,10 +1079,15 @@ func (vm *VirtualMachine) RefreshStorageInfo(ctx *Context, req *types.RefreshSto
 			return body
 		}
 
+		dir := p.Path
+		if path.Ext(dir) == ".vmx" {
+			dir = path.Dir(dir)
+		}
+
 		for _, file := range files {
 			datastorePath := object.DatastorePath{
 				Datastore: p.Datastore,
-				Path:      strings.TrimPrefix(file.Name(), datastore.Info.GetDatastoreInfo().Url),
+				Path:      path.Join(dir, file.Name()),
 			}
 			info, _ := file.Info()
 			vm.addFileLayoutEx(datastorePath, info.Size())
@@ -1265,6 +1374,62 @@ func (vm *VirtualMachine) validateSwitchMembers(id string) types.BaseMethodFault
 	return nil
 }
 
+// dvPortConnection returns the switch and portgroup of a DVPort backing, which are nil if not found.
+func (vm *VirtualMachine) dvPortConnection(ctx *Context, b *types.VirtualEthernetCardDistributedVirtualPortBackingInfo) (*DistributedVirtualSwitch, *DistributedVirtualPortgroup) {
+	ref := types.ManagedObjectReference{Type: "DistributedVirtualPortgroup", Value: b.Port.PortgroupKey}
+	pg, ok := ctx.Map.Get(ref).(*DistributedVirtualPortgroup)
+	if !ok || pg.Config.DistributedVirtualSwitch == nil {
+		return nil, nil
+	}
+
+	dvs, ok := ctx.Map.Get(*pg.Config.DistributedVirtualSwitch).(*DistributedVirtualSwitch)
+	if !ok {
+		return nil, nil
+	}
+
+	return dvs, pg
+}
+
+func (vm *VirtualMachine) dvPortConnectee(nic int32) types.DistributedVirtualSwitchPortConnectee {
+	return types.DistributedVirtualSwitchPortConnectee{
+		ConnectedEntity: &vm.Self,
+		NicKey:          strconv.Itoa(int(nic)),
+		Type:            string(types.DistributedVirtualSwitchPortConnecteeConnecteeTypeVmVnic),
+	}
+}
+
+// connectDVPort binds the ethernet card with the given key to a port of the backing's portgroup,
+// updating the backing's PortKey with the port allocated by the switch.
+func (vm *VirtualMachine) connectDVPort(ctx *Context, b *types.VirtualEthernetCardDistributedVirtualPortBackingInfo, nic int32) types.BaseMethodFault {
+	dvs, pg := vm.dvPortConnection(ctx, b)
+	if dvs == nil {
+		return nil
+	}
+
+	var err types.BaseMethodFault
+	ctx.WithLock(dvs, func() {
+		var key string
+		key, err = dvs.connectDVPort(ctx, pg, b.Port.PortKey, vm.dvPortConnectee(nic))
+		if err == nil {
+			b.Port.PortKey = key
+		}
+	})
+
+	return err
+}
+
+// disconnectDVPort releases the port bound to the ethernet card with the given key.
+func (vm *VirtualMachine) disconnectDVPort(ctx *Context, b *types.VirtualEthernetCardDistributedVirtualPortBackingInfo, nic int32) {
+	dvs, _ := vm.dvPortConnection(ctx, b)
+	if dvs == nil {
+		return
+	}
+
+	ctx.WithLock(dvs, func() {
+		dvs.disconnectDVPort(b.Port.PortKey, vm.dvPortConnectee(nic))
+	})
+}
+
 func (vm *VirtualMachine) configureDevice(
 	ctx *Context,
 	devices object.VirtualDeviceList,
@@ -1312,6 +1477,9 @@ func (vm *VirtualMachine) configureDevice(
 			if err := vm.validateSwitchMembers(b.Port.SwitchUuid); err != nil {
 				return err
 			}
+			if err := vm.connectDVPort(ctx, b, d.Key); err != nil {
+				return err
+			}
 		}
 
 		ctx.Map.Update(vm, []types.PropertyChange{
@@ -1503,6 +1671,12 @@ func (vm *VirtualMachine) removeDevice(ctx *Context, devices object.VirtualDevic
 
 		switch device := spec.Device.(type) {
 		case *types.VirtualDisk:
+			if disk, ok := d.(*types.VirtualDisk); ok && disk.VDiskId != nil {
+				if fcd := vm.fcd(ctx, types.ManagedObjectReference{}, *disk.VDiskId); fcd != nil {
+					fcd.Config.ConsumerId = nil // FCD is no longer attached
+				}
+			}
+
 			if spec.FileOperation == types.VirtualDeviceConfigSpecFileOperationDestroy {
 				var file string
 
@@ -1547,6 +1721,7 @@ func (vm *VirtualMachine) removeDevice(ctx *Context, devices object.VirtualDevic
 			case *types.VirtualEthernetCardDistributedVirtualPortBackingInfo:
 				net.Type = "DistributedVirtualPortgroup"
 				net.Value = b.Port.PortgroupKey
+				vm.disconnectDVPort(ctx, b, key)
 			}
 
 			for j, nicInfo := range vm.Guest.Net {
@@ -1802,12 +1977,17 @@ func (vm *VirtualMachine) configureDevices(ctx *Context, spec *types.VirtualMach
 				return invalid
 			}
 
+			if err = vm.configureDiskCrypto(dspec); err != nil {
+				return err
+			}
+
 			key := device.Key
 			err = vm.configureDevice(ctx, devices, dspec, nil)
 			if err != nil {
 				return err
 			}
 
+			vm.configureProfile(device.Key, dspec.Profile)
 			devices = append(devices, dspec.Device)
 			change.Val = dspec.Device
 			if key != device.Key {
@@ -1825,6 +2005,9 @@ func (vm *VirtualMachine) configureDevices(ctx *Context, spec *types.VirtualMach
 			if oldDevice == nil {
 				return invalid
 			}
+			if err = vm.configureDiskCrypto(dspec); err != nil {
+				return err
+			}
 			rspec.Device = oldDevice
 			devices = vm.removeDevice(ctx, devices, &rspec)
 			if device.DeviceInfo != nil {
@@ -1836,12 +2019,14 @@ func (vm *VirtualMachine) configureDevices(ctx *Context, spec *types.VirtualMach
 				return err
 			}
 
+			vm.configureProfile(device.Key, dspec.Profile)
 			devices = append(devices, dspec.Device)
 			change.Val = dspec.Device
 		case types.VirtualDeviceConfigSpecOperationRemove:
 			change.Op = types.PropertyChangeOpRemove
 
 			devices = vm.removeDevice(ctx, devices, dspec)
+			delete(vm.Profile, device.Key)
 		}
 
 		field.Key = device.Key
@@ -1859,6 +2044,8 @@ func (vm *VirtualMachine) configureDevices(ctx *Context, spec *types.VirtualMach
 		return err
 	}
 
+	vm.configureProfile(0, spec.VmProfile)
+
 	// Do this after device config, as some may apply to the devices themselves (e.g. ethernet -> guest.net)
 	err = vm.applyExtraConfig(ctx, spec)
 	if err != nil {
@@ -1868,6 +2055,93 @@ func (vm *VirtualMachine) configureDevices(ctx *Context, spec *types.VirtualMach
 	return nil
 }
 
+// configureDiskCrypto applies the crypto spec of a disk backing.
+// Disks can only be encrypted if the VM home is encrypted.
+// Other than shallow recrypt, disk crypto operations require a powered off VM.
+func (vm *VirtualMachine) configureDiskCrypto(dspec *types.VirtualDeviceConfigSpec) types.BaseMethodFault {
+	if dspec.Backing == nil || dspec.Backing.Crypto == nil {
+		return nil
+	}
+
+	var keyID **types.CryptoKeyId
+	if disk, ok := dspec.Device.(*types.VirtualDisk); ok {
+		switch backing := disk.Backing.(type) {
+		case *types.VirtualDiskFlatVer2BackingInfo:
+			keyID = &backing.KeyId
+		case *types.VirtualDiskSeSparseBackingInfo:
+			keyID = &backing.KeyId
+		case *types.VirtualDiskSparseVer2BackingInfo:
+			keyID = &backing.KeyId
+		}
+	}
+	if keyID == nil {
+		return &types.InvalidArgument{InvalidProperty: "deviceChange.backing.crypto"}
+	}
+
+	switch dspec.Backing.Crypto.(type) {
+	case *types.CryptoSpecNoOp, *types.CryptoSpecRegister:
+		return nil
+	case *types.CryptoSpecShallowRecrypt:
+	default:
+		if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOff {
+			return &types.InvalidPowerState{
+				ExistingState:  vm.Runtime.PowerState,
+				RequestedState: types.VirtualMachinePowerStatePoweredOff,
+			}
+		}
+	}
+
+	recrypt := func(newKeyID types.CryptoKeyId) types.BaseMethodFault {
+		if *keyID == nil {
+			return newInvalidStateFault("disk is not encrypted")
+		}
+		if newKeyID.ProviderId == nil {
+			newKeyID.ProviderId = (*keyID).ProviderId
+		}
+		*keyID = &newKeyID
+		return nil
+	}
+
+	switch spec := dspec.Backing.Crypto.(type) {
+	case *types.CryptoSpecEncrypt:
+		if vm.Config.KeyId == nil {
+			return newInvalidStateFault("vm is not encrypted")
+		}
+		if *keyID != nil {
+			return newInvalidStateFault("disk is already encrypted")
+		}
+		key := spec.CryptoKeyId
+		*keyID = &key
+	case *types.CryptoSpecDecrypt:
+		if *keyID == nil {
+			return newInvalidStateFault("disk is not encrypted")
+		}
+		*keyID = nil
+	case *types.CryptoSpecDeepRecrypt:
+		return recrypt(spec.NewKeyId)
+	case *types.CryptoSpecShallowRecrypt:
+		return recrypt(spec.NewKeyId)
+	}
+
+	return nil
+}
+
+// configureProfile records the storage policy applied to the VM home (key 0) or a virtual disk.
+func (vm *VirtualMachine) configureProfile(key int32, spec []types.BaseVirtualMachineProfileSpec) {
+	if vm.Profile == nil {
+		vm.Profile = make(map[int32]string)
+	}
+
+	for _, p := range spec {
+		switch p := p.(type) {
+		case *types.VirtualMachineDefinedProfileSpec:
+			vm.Profile[key] = p.ProfileId
+		case *types.VirtualMachineEmptyProfileSpec:
+			delete(vm.Profile, key)
+		}
+	}
+}
+
 type powerVMTask struct {
 	*VirtualMachine
 
@@ -1894,10 +2168,26 @@ func (c *powerVMTask) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 	event := c.event()
 	switch c.state {
 	case types.VirtualMachinePowerStatePoweredOn:
+		host := c.ctx.Map.Get(*c.VirtualMachine.Runtime.Host).(*HostSystem)
+		if cluster, ok := c.ctx.Map.Get(*host.Parent).(*ClusterComputeResource); ok {
+			cluster.drsPowerOn(c.ctx, c.VirtualMachine)
+			event = c.event() // host may have changed
+
+			if fault := cluster.dasAdmission(c.ctx, c.VirtualMachine); fault != nil {
+				return nil, fault
+			}
+		}
+
 		if c.VirtualMachine.hostInMM(c.ctx) {
 			return nil, new(types.InvalidState)
 		}
 
+		if pool := c.VirtualMachine.ResourcePool; pool != nil {
+			if fault := admitReservation(c.ctx, *pool, vmReservation(c.VirtualMachine)); fault != nil {
+				return nil, fault
+			}
+		}
+
 		err := c.svm.start(c.ctx)
 		if err != nil {
 			return nil, &types.MissingPowerOnConfiguration{
@@ -1950,12 +2240,22 @@ func (c *powerVMTask) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 		}
 	}
 
-	c.ctx.Map.Update(c.VirtualMachine, []types.PropertyChange{
+	changes := []types.PropertyChange{
 		{Name: "runtime.powerState", Val: c.state},
 		{Name: "summary.runtime.powerState", Val: c.state},
 		{Name: "summary.runtime.bootTime", Val: boot},
 		{Name: "config.hardware.device", Val: devices},
-	})
+	}
+
+	if c.state != types.VirtualMachinePowerStatePoweredOn && c.VirtualMachine.isFrozen() {
+		changes = append(changes, types.PropertyChange{Name: "runtime.instantCloneFrozen", Val: false})
+	}
+
+	c.ctx.Map.Update(c.VirtualMachine, changes)
+
+	if pool := c.VirtualMachine.ResourcePool; pool != nil {
+		updatePoolRuntime(c.ctx, *pool)
+	}
 
 	return nil, nil
 }
@@ -2203,6 +2503,30 @@ func (vm *VirtualMachine) UpgradeVMTask(ctx *Context, req *types.UpgradeVM_Task)
 	return body
 }
 
+func (vm *VirtualMachine) ApplyEvcModeVMTask(ctx *Context, req *types.ApplyEvcModeVM_Task) soap.HasFault {
+	task := CreateTask(vm, "applyEvcModeVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOff {
+			return nil, &types.InvalidPowerState{
+				ExistingState:  vm.Runtime.PowerState,
+				RequestedState: types.VirtualMachinePowerStatePoweredOff,
+			}
+		}
+
+		// An empty mask removes the per-VM EVC configuration
+		ctx.Map.Update(vm, []types.PropertyChange{
+			{Name: "runtime.featureMask", Val: req.Mask},
+		})
+
+		return nil, nil
+	})
+
+	return &methods.ApplyEvcModeVM_TaskBody{
+		Res: &types.ApplyEvcModeVM_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (vm *VirtualMachine) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
 	dc := ctx.Map.getEntityDatacenter(vm)
 
@@ -2234,6 +2558,8 @@ func (vm *VirtualMachine) DestroyTask(ctx *Context, req *types.Destroy_Task) soa
 			Datacenter: &dc.Self,
 		})
 
+		vm.guest.remove()
+
 		err := vm.svm.remove(ctx)
 		if err != nil {
 			return nil, &types.RuntimeFault{
@@ -2310,6 +2636,10 @@ func (vm *VirtualMachine) cloneDevice() []types.BaseVirtualDevice {
 }
 
 func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soap.HasFault {
+	if req.Spec.Location.Service != nil {
+		return vm.cloneService(ctx, req)
+	}
+
 	pool := req.Spec.Location.Pool
 	if pool == nil {
 		if !vm.Config.Template {
@@ -2347,6 +2677,11 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 		if pool == nil {
 			return nil, &types.InvalidArgument{InvalidProperty: "spec.location.pool"}
 		}
+		if spec := req.Spec.Customization; spec != nil {
+			if fault := vm.nicSettingMismatch(spec); fault != nil {
+				return nil, fault
+			}
+		}
 		if obj := ctx.Map.FindByName(req.Name, folder.ChildEntity); obj != nil {
 			return nil, &types.DuplicateName{
 				Name:   req.Name,
@@ -2369,36 +2704,18 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 		config.VirtualICH7MPresent = vm.Config.Hardware.VirtualICH7MPresent
 		config.VirtualSMCPresent = vm.Config.Hardware.VirtualSMCPresent
 
-		defaultDevices := object.VirtualDeviceList(esx.VirtualDevice)
-		devices := vm.cloneDevice()
-
-		for _, device := range devices {
-			var fop types.VirtualDeviceConfigSpecFileOperation
-
-			if defaultDevices.Find(object.VirtualDeviceList(devices).Name(device)) != nil {
-				// Default devices are added during CreateVMTask
-				continue
-			}
-
-			switch disk := device.(type) {
-			case *types.VirtualDisk:
-				// TODO: consider VirtualMachineCloneSpec.DiskMoveType
-				fop = types.VirtualDeviceConfigSpecFileOperationCreate
-
-				// Leave FileName empty so CreateVM will just create a new one under VmPathName
-				disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo).FileName = ""
-				disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo).Parent = nil
+		// Datastore name per disk key, for disks placed on a datastore other than the VM home
+		diskLocation := make(map[int32]string)
+		for _, disk := range req.Spec.Location.Disk {
+			if ds, ok := ctx.Map.Get(disk.Datastore).(*Datastore); ok {
+				diskLocation[disk.DiskId] = ds.Name
 			}
-
-			config.DeviceChange = append(config.DeviceChange, &types.VirtualDeviceConfigSpec{
-				Operation:     types.VirtualDeviceConfigSpecOperationAdd,
-				Device:        device,
-				FileOperation: fop,
-			})
 		}
+		config.DeviceChange = vm.cloneDeviceChange(diskLocation)
 
-		if dst, src := config, req.Spec.Config; src != nil {
+		if dst, src := &config, req.Spec.Config; src != nil {
 			dst.ExtraConfig = src.ExtraConfig
+			copyNonEmptyValue(&dst.Annotation, &src.Annotation)
 			copyNonEmptyValue(&dst.Uuid, &src.Uuid)
 			copyNonEmptyValue(&dst.InstanceUuid, &src.InstanceUuid)
 			copyNonEmptyValue(&dst.NumCPUs, &src.NumCPUs)
@@ -2426,6 +2743,11 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 		}
 		clone.DataSets = copyDataSetsForVmClone(vm.DataSets)
 
+		if req.Spec.Customization != nil {
+			// applied when the clone is powered on
+			clone.setCustomization(req.Spec.Customization)
+		}
+
 		if req.Spec.Template {
 			_ = clone.MarkAsTemplate(&types.MarkAsTemplate{This: clone.Self})
 		}
@@ -2435,6 +2757,15 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 			SourceVm:     *event.Vm,
 		})
 
+		if req.Spec.PowerOn && !req.Spec.Template {
+			res := clone.PowerOnVMTask(ctx, &types.PowerOnVM_Task{This: clone.Self})
+			ptask := ctx.Map.Get(res.(*methods.PowerOnVM_TaskBody).Res.Returnval).(*Task)
+			ptask.Wait()
+			if ptask.Info.Error != nil {
+				return nil, ptask.Info.Error.Fault
+			}
+		}
+
 		return ref, nil
 	})
 
@@ -2445,6 +2776,49 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 	}
 }
 
+// cloneDeviceChange returns the device changes to create a clone's devices, other than the default devices added by CreateVM.
+// diskLocation maps disk keys to the name of the datastore to place the disk on, rather than the clone's home datastore.
+func (vm *VirtualMachine) cloneDeviceChange(diskLocation map[int32]string) []types.BaseVirtualDeviceConfigSpec {
+	var changes []types.BaseVirtualDeviceConfigSpec
+	defaultDevices := object.VirtualDeviceList(esx.VirtualDevice)
+	devices := vm.cloneDevice()
+
+	for _, device := range devices {
+		var fop types.VirtualDeviceConfigSpecFileOperation
+
+		if defaultDevices.Find(object.VirtualDeviceList(devices).Name(device)) != nil {
+			// Default devices are added during CreateVMTask
+			continue
+		}
+
+		switch x := device.(type) {
+		case *types.VirtualDisk:
+			// TODO: consider VirtualMachineCloneSpec.DiskMoveType
+			fop = types.VirtualDeviceConfigSpecFileOperationCreate
+
+			// Leave FileName empty so CreateVM will just create a new one under VmPathName
+			x.Backing.(*types.VirtualDiskFlatVer2BackingInfo).FileName = ""
+			if ds, ok := diskLocation[x.Key]; ok {
+				x.Backing.(*types.VirtualDiskFlatVer2BackingInfo).FileName = fmt.Sprintf("[%s]", ds)
+			}
+			x.Backing.(*types.VirtualDiskFlatVer2BackingInfo).Parent = nil
+		case types.BaseVirtualEthernetCard:
+			// Leave PortKey empty so the clone is connected to a free port of the same portgroup
+			if b, ok := x.GetVirtualEthernetCard().Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo); ok {
+				b.Port.PortKey = ""
+			}
+		}
+
+		changes = append(changes, &types.VirtualDeviceConfigSpec{
+			Operation:     types.VirtualDeviceConfigSpecOperationAdd,
+			Device:        device,
+			FileOperation: fop,
+		})
+	}
+
+	return changes
+}
+
 func copyNonEmptyValue[T comparable](dst, src *T) {
 	if dst == nil || src == nil {
 		return
@@ -2456,66 +2830,783 @@ func copyNonEmptyValue[T comparable](dst, src *T) {
 	*dst = *src
 }
 
-func (vm *VirtualMachine) RelocateVMTask(ctx *Context, req *types.RelocateVM_Task) soap.HasFault {
-	task := CreateTask(vm, "relocateVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
-		var changes []types.PropertyChange
-
-		if ref := req.Spec.Datastore; ref != nil {
-			ds := ctx.Map.Get(*ref).(*Datastore)
-			ctx.Map.RemoveReference(ctx, ds, &ds.Vm, *ref)
-
-			// TODO: migrate vm.Config.Files, vm.Summary.Config.VmPathName, vm.Layout and vm.LayoutEx
+func (vm *VirtualMachine) isFrozen() bool {
+	return vm.Runtime.InstantCloneFrozen != nil && *vm.Runtime.InstantCloneFrozen
+}
 
-			changes = append(changes, types.PropertyChange{Name: "datastore", Val: []types.ManagedObjectReference{*ref}})
+// instantCloneDeviceChange validates the device changes of an InstantClone location spec,
+// where only edits of ethernet cards and file backed serial or parallel ports are supported.
+func instantCloneDeviceChange(changes []types.BaseVirtualDeviceConfigSpec) types.BaseMethodFault {
+	for _, change := range changes {
+		spec := change.GetVirtualDeviceConfigSpec()
+		valid := false
+
+		if spec.Operation == types.VirtualDeviceConfigSpecOperationEdit {
+			switch device := spec.Device.(type) {
+			case types.BaseVirtualEthernetCard:
+				valid = true
+			case *types.VirtualSerialPort:
+				_, valid = device.Backing.(*types.VirtualSerialPortFileBackingInfo)
+			case *types.VirtualParallelPort:
+				_, valid = device.Backing.(*types.VirtualParallelPortFileBackingInfo)
+			}
 		}
 
-		if ref := req.Spec.Pool; ref != nil {
-			pool := ctx.Map.Get(*ref).(*ResourcePool)
-			ctx.Map.RemoveReference(ctx, pool, &pool.Vm, *ref)
-
-			changes = append(changes, types.PropertyChange{Name: "resourcePool", Val: ref})
+		if !valid {
+			return &types.InvalidArgument{InvalidProperty: "spec.location.deviceChange"}
 		}
+	}
 
-		if ref := req.Spec.Host; ref != nil {
-			host := ctx.Map.Get(*ref).(*HostSystem)
-			ctx.Map.RemoveReference(ctx, host, &host.Vm, *ref)
+	return nil
+}
 
-			changes = append(changes,
-				types.PropertyChange{Name: "runtime.host", Val: ref},
-				types.PropertyChange{Name: "summary.runtime.host", Val: ref},
-			)
-		}
+// instantCloneGuest returns a copy of the source VM's guest info, as the clone is forked from its running state.
+// The NICs of the clone keep their own MAC address and network.
+func (vm *VirtualMachine) instantCloneGuest(clone *VirtualMachine) (*types.GuestInfo, *types.VirtualMachineGuestSummary) {
+	guest := new(types.GuestInfo)
+	deepCopy(vm.Guest, guest)
 
-		if ref := req.Spec.Folder; ref != nil {
-			folder := ctx.Map.Get(*ref).(*Folder)
-			folder.MoveIntoFolderTask(ctx, &types.MoveIntoFolder_Task{
-				List: []types.ManagedObjectReference{vm.Self},
-			})
+	for i := range guest.Net {
+		if i < len(clone.Guest.Net) {
+			nic := clone.Guest.Net[i]
+			guest.Net[i].MacAddress = nic.MacAddress
+			guest.Net[i].Network = nic.Network
+			guest.Net[i].DeviceConfigId = nic.DeviceConfigId
 		}
+	}
 
-		cspec := &types.VirtualMachineConfigSpec{DeviceChange: req.Spec.DeviceChange}
-		if err := vm.configureDevices(ctx, cspec); err != nil {
-			return nil, err
-		}
+	summary := new(types.VirtualMachineGuestSummary)
+	deepCopy(vm.Summary.Guest, summary)
 
-		ctx.postEvent(&types.VmMigratedEvent{
-			VmEvent:          vm.event(),
-			SourceHost:       *ctx.Map.Get(*vm.Runtime.Host).(*HostSystem).eventArgument(),
-			SourceDatacenter: datacenterEventArgument(vm),
-			SourceDatastore:  ctx.Map.Get(vm.Datastore[0]).(*Datastore).eventArgument(),
-		})
+	return guest, summary
+}
 
-		ctx.Map.Update(vm, changes)
+func (vm *VirtualMachine) InstantCloneTask(ctx *Context, req *types.InstantClone_Task) soap.HasFault {
+	spec := req.Spec
+	dc := ctx.Map.getEntityDatacenter(vm)
 
-		return nil, nil
-	})
+	pool := spec.Location.Pool
+	if pool == nil {
+		pool = vm.ResourcePool
+	}
 
-	return &methods.RelocateVM_TaskBody{
-		Res: &types.RelocateVM_TaskResponse{
-			Returnval: task.Run(ctx),
-		},
+	destHost := vm.Runtime.Host
+	if spec.Location.Host != nil {
+		destHost = spec.Location.Host
 	}
-}
+
+	folderRef := dc.VmFolder
+	if spec.Location.Folder != nil {
+		folderRef = *spec.Location.Folder
+	}
+
+	folder, _ := asFolderMO(ctx.Map.Get(folderRef))
+	host := ctx.Map.Get(*destHost).(*HostSystem)
+	event := vm.event()
+
+	vmx := vm.vmx(nil)
+	vmx.Path = spec.Name
+	if ref := spec.Location.Datastore; ref != nil {
+		vmx.Datastore = ctx.Map.Get(*ref).(*Datastore).Name
+	}
+
+	task := CreateTask(vm, "instantClone", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if spec.Name == "" {
+			return nil, &types.InvalidArgument{InvalidProperty: "spec.name"}
+		}
+		if vm.Config.Template {
+			return nil, new(types.NotSupported)
+		}
+		// The source must be running, or frozen by the guest, to fork its memory state
+		if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+			return nil, &types.InvalidPowerState{
+				RequestedState: types.VirtualMachinePowerStatePoweredOn,
+				ExistingState:  vm.Runtime.PowerState,
+			}
+		}
+		if spec.BiosUuid != "" {
+			if _, err := uuid.Parse(spec.BiosUuid); err != nil {
+				return nil, &types.InvalidArgument{InvalidProperty: "spec.biosUuid"}
+			}
+		}
+		if fault := instantCloneDeviceChange(spec.Location.DeviceChange); fault != nil {
+			return nil, fault
+		}
+		if obj := ctx.Map.FindByName(spec.Name, folder.ChildEntity); obj != nil {
+			return nil, &types.DuplicateName{
+				Name:   spec.Name,
+				Object: obj.Reference(),
+			}
+		}
+
+		ctx.postEvent(&types.VmBeingClonedEvent{
+			VmCloneEvent: types.VmCloneEvent{
+				VmEvent: event,
+			},
+			DestFolder: folderEventArgument(folder),
+			DestName:   spec.Name,
+			DestHost:   *host.eventArgument(),
+		})
+
+		config := types.VirtualMachineConfigSpec{
+			Name:                spec.Name,
+			Version:             vm.Config.Version,
+			GuestId:             vm.Config.GuestId,
+			Uuid:                spec.BiosUuid,
+			NumCPUs:             vm.Config.Hardware.NumCPU,
+			MemoryMB:            int64(vm.Config.Hardware.MemoryMB),
+			NumCoresPerSocket:   vm.Config.Hardware.NumCoresPerSocket,
+			VirtualICH7MPresent: vm.Config.Hardware.VirtualICH7MPresent,
+			VirtualSMCPresent:   vm.Config.Hardware.VirtualSMCPresent,
+			Files: &types.VirtualMachineFileInfo{
+				VmPathName: vmx.String(),
+			},
+		}
+
+		// The clone runs with the source configuration, including its extraConfig
+		for _, opt := range vm.Config.ExtraConfig {
+			val := *opt.GetOptionValue()
+			config.ExtraConfig = append(config.ExtraConfig, &val)
+		}
+
+		defaultDevices := object.VirtualDeviceList(esx.VirtualDevice)
+		devices := vm.cloneDevice()
+
+		for _, device := range devices {
+			if defaultDevices.Find(object.VirtualDeviceList(devices).Name(device)) != nil {
+				// Default devices are added during CreateVMTask
+				continue
+			}
+
+			if nic, ok := device.(types.BaseVirtualEthernetCard); ok {
+				// The clone is assigned its own MAC address and a free port of the same portgroup
+				card := nic.GetVirtualEthernetCard()
+				card.MacAddress = ""
+				card.AddressType = string(types.VirtualEthernetCardMacTypeGenerated)
+				if b, ok := card.Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo); ok {
+					b.Port.PortKey = ""
+				}
+			}
+
+			// Disks are added as-is, shared with the source until delta disks are created below
+			config.DeviceChange = append(config.DeviceChange, &types.VirtualDeviceConfigSpec{
+				Operation: types.VirtualDeviceConfigSpecOperationAdd,
+				Device:    device,
+			})
+		}
+
+		res := ctx.Map.Get(folderRef).(vmFolder).CreateVMTask(ctx, &types.CreateVM_Task{
+			This:   folderRef,
+			Config: config,
+			Pool:   *pool,
+			Host:   destHost,
+		})
+
+		ctask := ctx.Map.Get(res.(*methods.CreateVM_TaskBody).Res.Returnval).(*Task)
+		ctask.Wait()
+		if ctask.Info.Error != nil {
+			return nil, ctask.Info.Error.Fault
+		}
+
+		ref := ctask.Info.Result.(types.ManagedObjectReference)
+		clone := ctx.Map.Get(ref).(*VirtualMachine)
+
+		var fault types.BaseMethodFault
+
+		ctx.WithLock(clone, func() {
+			dir := clone.vmx(nil)
+			dir.Path = path.Dir(dir.Path)
+
+			if fault = clone.createDeltaDisks(ctx, clone.Config.Hardware.Device, &dir); fault != nil {
+				return
+			}
+			for _, b := range diskBackings(clone.Config.Hardware.Device) {
+				b.Uuid = virtualDiskUUID(&dc.Self, b.FileName)
+			}
+			if fault = clone.updateDiskLayouts(); fault != nil {
+				return
+			}
+			clone.RefreshStorageInfo(ctx, nil)
+
+			if fault = clone.configureDevices(ctx, &types.VirtualMachineConfigSpec{DeviceChange: spec.Location.DeviceChange}); fault != nil {
+				return
+			}
+
+			if err := clone.svm.start(ctx); err != nil {
+				fault = &types.SystemErrorFault{Reason: err.Error()}
+				return
+			}
+
+			guest, summary := vm.instantCloneGuest(clone)
+
+			devices := clone.cloneDevice()
+			for _, d := range devices {
+				if conn := d.GetVirtualDevice().Connectable; conn != nil {
+					conn.Connected = conn.StartConnected
+				}
+			}
+
+			ctx.Map.Update(clone, []types.PropertyChange{
+				{Name: "runtime.powerState", Val: types.VirtualMachinePowerStatePoweredOn},
+				{Name: "summary.runtime.powerState", Val: types.VirtualMachinePowerStatePoweredOn},
+				{Name: "summary.runtime.bootTime", Val: time.Now()},
+				{Name: "runtime.instantCloneFrozen", Val: false},
+				{Name: "config.hardware.device", Val: devices},
+				{Name: "guest", Val: guest},
+				{Name: "summary.guest", Val: summary},
+			})
+
+			// Transferred properties are applied last, such that SET.guest.* keys customize the clone's guest
+			fault = clone.applyExtraConfig(ctx, &types.VirtualMachineConfigSpec{ExtraConfig: spec.Config})
+		})
+		if fault != nil {
+			return nil, fault
+		}
+
+		clone.DataSets = copyDataSetsForVmClone(vm.DataSets)
+
+		ctx.postEvent(&types.VmClonedEvent{
+			VmCloneEvent: types.VmCloneEvent{VmEvent: clone.event()},
+			SourceVm:     *event.Vm,
+		})
+
+		return ref, nil
+	})
+
+	return &methods.InstantClone_TaskBody{
+		Res: &types.InstantClone_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// switchChange returns true if spec moves a network adapter to a different virtual switch.
+func (vm *VirtualMachine) switchChange(spec *types.VirtualMachineRelocateSpec) bool {
+	devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
+
+	for _, change := range spec.DeviceChange {
+		dspec := change.GetVirtualDeviceConfigSpec()
+		if dspec.Operation != types.VirtualDeviceConfigSpecOperationEdit {
+			continue
+		}
+		nic, ok := dspec.Device.(types.BaseVirtualEthernetCard)
+		if !ok {
+			continue
+		}
+		old, ok := devices.FindByKey(nic.GetVirtualEthernetCard().Key).(types.BaseVirtualEthernetCard)
+		if !ok {
+			continue
+		}
+		if switchID(old.GetVirtualEthernetCard().Backing) != switchID(nic.GetVirtualEthernetCard().Backing) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// switchID returns the ID of the virtual switch for the given ethernet card backing, empty for a standard switch.
+func switchID(backing types.BaseVirtualDeviceBackingInfo) string {
+	switch b := backing.(type) {
+	case *types.VirtualEthernetCardDistributedVirtualPortBackingInfo:
+		return b.Port.SwitchUuid
+	case *types.VirtualEthernetCardOpaqueNetworkBackingInfo:
+		return b.OpaqueNetworkId
+	}
+	return ""
+}
+
+func (vm *VirtualMachine) RelocateVMTask(ctx *Context, req *types.RelocateVM_Task) soap.HasFault {
+	task := CreateTask(vm, "relocateVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		spec := &req.Spec
+		if spec.Service != nil {
+			return nil, vm.relocateService(ctx, spec)
+		}
+
+		src := ctx.Map.Get(*vm.Runtime.Host).(*HostSystem)
+		srcDatastore := ctx.Map.Get(vm.Datastore[0]).(*Datastore)
+
+		host, pool, fault := vm.relocateTarget(ctx, spec)
+		if fault != nil {
+			return nil, fault
+		}
+
+		datastore := srcDatastore
+		if ref := spec.Datastore; ref != nil {
+			ds, ok := ctx.Map.Get(*ref).(*Datastore)
+			if !ok {
+				return nil, &types.InvalidArgument{InvalidProperty: "spec.datastore"}
+			}
+			datastore = ds
+		}
+
+		targets := []*Datastore{datastore}
+		for _, locator := range spec.Disk {
+			ds, ok := ctx.Map.Get(locator.Datastore).(*Datastore)
+			if !ok {
+				return nil, &types.InvalidArgument{InvalidProperty: "spec.disk.datastore"}
+			}
+			targets = append(targets, ds)
+		}
+		for _, ds := range targets {
+			if !slices.Contains(host.Datastore, ds.Self) {
+				return nil, &types.DatastoreNotWritableOnHost{
+					InvalidDatastore: types.InvalidDatastore{Datastore: &ds.Self, Name: ds.Name},
+					Host:             host.Self,
+				}
+			}
+		}
+
+		// a change of host, or of a powered on vm's storage, is a migration rather than a cold relocation
+		migrate := host != src || vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn
+
+		if host != src && vm.switchChange(spec) {
+			if fault := licensed(ctx, host.Self.Value, "xswitchvmotion"); fault != nil {
+				return nil, fault
+			}
+		}
+		event := vm.event()
+		dc := datacenterEventArgument(host)
+
+		if migrate {
+			ctx.postEvent(&types.VmBeingMigratedEvent{
+				VmEvent:        event,
+				DestHost:       *host.eventArgument(),
+				DestDatacenter: dc,
+				DestDatastore:  datastore.eventArgument(),
+			})
+		} else {
+			ctx.postEvent(&types.VmBeingRelocatedEvent{
+				VmRelocateSpecEvent: types.VmRelocateSpecEvent{VmEvent: event},
+				DestHost:            *host.eventArgument(),
+				DestDatacenter:      dc,
+				DestDatastore:       datastore.eventArgument(),
+			})
+		}
+
+		removed, added, fault := vm.relocateFiles(ctx, spec)
+		if fault != nil {
+			return nil, fault
+		}
+
+		for _, ref := range removed {
+			ds := ctx.Map.Get(ref).(*Datastore)
+			ctx.Map.RemoveReference(ctx, ds, &ds.Vm, vm.Self)
+		}
+		for _, ref := range added {
+			ds := ctx.Map.Get(ref).(*Datastore)
+			ctx.Map.AddReference(ctx, ds, &ds.Vm, vm.Self)
+		}
+
+		changes := []types.PropertyChange{
+			{Name: "datastore", Val: vm.Datastore},
+			{Name: "config.files", Val: vm.Config.Files},
+			{Name: "summary.config.vmPathName", Val: vm.Config.Files.VmPathName},
+		}
+
+		if pool != nil && (vm.ResourcePool == nil || *pool != *vm.ResourcePool) {
+			refs := []types.ManagedObjectReference{*pool}
+			if vm.ResourcePool != nil {
+				refs = append(refs, *vm.ResourcePool)
+			}
+			for _, ref := range refs {
+				var vms *[]types.ManagedObjectReference
+				switch p := ctx.Map.Get(ref).(type) {
+				case *ResourcePool:
+					vms = &p.Vm
+				case *VirtualApp:
+					vms = &p.Vm
+				}
+				if ref == *pool {
+					ctx.Map.AddReference(ctx, ctx.Map.Get(ref), vms, vm.Self)
+				} else {
+					ctx.Map.RemoveReference(ctx, ctx.Map.Get(ref), vms, vm.Self)
+				}
+			}
+
+			changes = append(changes, types.PropertyChange{Name: "resourcePool", Val: pool})
+		}
+
+		if host != src {
+			ctx.Map.RemoveReference(ctx, src, &src.Vm, vm.Self)
+			ctx.Map.AddReference(ctx, host, &host.Vm, vm.Self)
+
+			changes = append(changes,
+				types.PropertyChange{Name: "runtime.host", Val: &host.Self},
+				types.PropertyChange{Name: "summary.runtime.host", Val: &host.Self},
+				types.PropertyChange{Name: "environmentBrowser", Val: *hostParent(&host.HostSystem).EnvironmentBrowser},
+			)
+		}
+
+		ctx.Map.Update(vm, changes)
+
+		folder := spec.Folder
+		if folder == nil && dc.Datacenter != event.Datacenter.Datacenter {
+			// moving to another datacenter, default to its vm folder
+			folder = &ctx.Map.Get(dc.Datacenter).(*Datacenter).VmFolder
+		}
+		if folder != nil {
+			var res soap.HasFault
+			f := ctx.Map.Get(*folder).(*Folder)
+			ctx.WithLock(f, func() {
+				res = f.MoveIntoFolderTask(ctx, &types.MoveIntoFolder_Task{
+					This: f.Self,
+					List: []types.ManagedObjectReference{vm.Self},
+				})
+			})
+			mtask := ctx.Map.Get(res.(*methods.MoveIntoFolder_TaskBody).Res.Returnval).(*Task)
+			mtask.Wait()
+			if mtask.Info.Error != nil {
+				return nil, mtask.Info.Error.Fault
+			}
+		}
+
+		cspec := &types.VirtualMachineConfigSpec{DeviceChange: spec.DeviceChange}
+		if err := vm.configureDevices(ctx, cspec); err != nil {
+			return nil, err
+		}
+
+		vm.updateStorage()
+		ctx.Map.Update(vm, []types.PropertyChange{
+			{Name: "layout", Val: vm.Layout},
+			{Name: "layoutEx", Val: vm.LayoutEx},
+			{Name: "storage", Val: vm.Storage},
+			{Name: "summary.storage", Val: vm.Summary.Storage},
+			{Name: "config.hardware.device", Val: vm.Config.Hardware.Device},
+		})
+
+		if migrate {
+			ctx.postEvent(&types.VmMigratedEvent{
+				VmEvent:          vm.event(),
+				SourceHost:       *src.eventArgument(),
+				SourceDatacenter: event.Datacenter,
+				SourceDatastore:  srcDatastore.eventArgument(),
+			})
+		} else {
+			ctx.postEvent(&types.VmRelocatedEvent{
+				VmRelocateSpecEvent: types.VmRelocateSpecEvent{VmEvent: vm.event()},
+				SourceHost:          *src.eventArgument(),
+				SourceDatacenter:    event.Datacenter,
+				SourceDatastore:     srcDatastore.eventArgument(),
+			})
+		}
+
+		return nil, nil
+	})
+
+	return &methods.RelocateVM_TaskBody{
+		Res: &types.RelocateVM_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// relocateTarget returns the destination host and resource pool of a relocation,
+// either of which can be implied by the other when not specified in the spec.
+func (vm *VirtualMachine) relocateTarget(ctx *Context, spec *types.VirtualMachineRelocateSpec) (*HostSystem, *types.ManagedObjectReference, types.BaseMethodFault) {
+	host := ctx.Map.Get(*vm.Runtime.Host).(*HostSystem)
+	pool := vm.ResourcePool
+
+	owner := func(ref types.ManagedObjectReference) *types.ManagedObjectReference {
+		switch p := ctx.Map.Get(ref).(type) {
+		case *ResourcePool:
+			return &p.Owner
+		case *VirtualApp:
+			return &p.Owner
+		}
+		return nil
+	}
+
+	if ref := spec.Host; ref != nil {
+		h, ok := ctx.Map.Get(*ref).(*HostSystem)
+		if !ok {
+			return nil, nil, &types.InvalidArgument{InvalidProperty: "spec.host"}
+		}
+		host = h
+	}
+
+	if ref := spec.Pool; ref != nil {
+		cr := owner(*ref)
+		if cr == nil {
+			return nil, nil, &types.InvalidArgument{InvalidProperty: "spec.pool"}
+		}
+		pool = ref
+
+		if *host.Parent != *cr {
+			if spec.Host != nil {
+				return nil, nil, &types.InvalidArgument{InvalidProperty: "spec.pool"}
+			}
+
+			var hosts []types.ManagedObjectReference
+			switch c := ctx.Map.Get(*cr).(type) {
+			case *ClusterComputeResource:
+				hosts = c.Host
+			case *mo.ComputeResource:
+				hosts = c.Host
+			}
+
+			host = nil
+			for _, ref := range hosts {
+				h := ctx.Map.Get(ref).(*HostSystem)
+				if h.Runtime.ConnectionState == types.HostSystemConnectionStateConnected && !h.Runtime.InMaintenanceMode {
+					host = h
+					break
+				}
+			}
+			if host == nil {
+				return nil, nil, new(types.NoHost)
+			}
+		}
+	} else if pool != nil && *owner(*pool) != *host.Parent {
+		// host is in another compute resource, use its root pool
+		pool = hostParent(&host.HostSystem).ResourcePool
+	}
+
+	return host, pool, nil
+}
+
+// relocateFiles moves the vm's home directory and disk files to the datastores given by spec,
+// updating config.files, disk backings and file layouts to match.
+// Returns the datastores the vm no longer uses and those it now uses.
+func (vm *VirtualMachine) relocateFiles(ctx *Context, spec *types.VirtualMachineRelocateSpec) ([]types.ManagedObjectReference, []types.ManagedObjectReference, types.BaseMethodFault) {
+	fm := ctx.Map.FileManager()
+
+	type move struct {
+		src, dst string // datastore paths
+		from, to string // local paths
+		ds       *Datastore
+	}
+	var files []move
+	var home *move
+	var sources []types.ManagedObjectReference // datastores files are moved from
+
+	localPath := func(ds *Datastore, p object.DatastorePath) string {
+		return path.Join(ds.Info.GetDatastoreInfo().Url, p.Path)
+	}
+
+	dest := func(ref *types.ManagedObjectReference) *Datastore {
+		if ref == nil {
+			return nil
+		}
+		ds, _ := ctx.Map.Get(*ref).(*Datastore)
+		return ds
+	}
+
+	dir := vm.vmx(nil)
+	if path.Ext(dir.Path) == ".vmx" {
+		dir.Path = path.Dir(dir.Path)
+	}
+	src := vm.findDatastore(dir.Datastore)
+
+	if ds := dest(spec.Datastore); ds != nil && ds.Self != src.Self {
+		sources = append(sources, src.Self)
+		dst := object.DatastorePath{Datastore: ds.Name, Path: dir.Path}
+		home = &move{
+			src:  dir.String(),
+			dst:  dst.String(),
+			from: localPath(src, dir),
+			to:   localPath(ds, dir),
+			ds:   ds,
+		}
+	}
+
+	disks := object.VirtualDeviceList(vm.Config.Hardware.Device).SelectByType((*types.VirtualDisk)(nil))
+	for _, device := range disks {
+		disk := device.(*types.VirtualDisk)
+		target := dest(spec.Datastore)
+		for _, locator := range spec.Disk {
+			if locator.DiskId == disk.Key {
+				target = dest(&locator.Datastore)
+			}
+		}
+
+		backing, ok := disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo)
+		if !ok || target == nil {
+			continue
+		}
+
+		for ; backing != nil; backing = backing.Parent {
+			p, fault := parseDatastorePath(backing.FileName)
+			if fault != nil {
+				return nil, nil, fault
+			}
+			ds := vm.findDatastore(p.Datastore)
+
+			if home != nil && strings.HasPrefix(backing.FileName, home.src+"/") {
+				if target == home.ds {
+					continue // moved along with the home directory
+				}
+			} else if target.Self == ds.Self {
+				continue
+			}
+
+			sources = append(sources, ds.Self)
+			for _, name := range vdmNames(backing.FileName) {
+				file, _ := parseDatastorePath(name)
+				dst := object.DatastorePath{Datastore: target.Name, Path: file.Path}
+				files = append(files, move{
+					src:  name,
+					dst:  dst.String(),
+					from: localPath(ds, *file),
+					to:   localPath(target, *file),
+					ds:   target,
+				})
+			}
+		}
+	}
+
+	moves := files
+	if home != nil {
+		moves = append(moves, *home)
+	}
+	if len(moves) == 0 {
+		return nil, nil, nil
+	}
+
+	for _, m := range moves {
+		if _, err := os.Stat(m.to); err == nil {
+			return nil, nil, fm.fault(m.dst, nil, new(types.FileAlreadyExists))
+		}
+	}
+
+	for _, m := range moves {
+		_ = os.MkdirAll(path.Dir(m.to), 0700)
+		if err := os.Rename(m.from, m.to); err != nil {
+			if os.IsNotExist(err) && (home == nil || m.src != home.src) {
+				continue // disk was never written
+			}
+			return nil, nil, fm.fault(m.src, err, new(types.CannotAccessFile))
+		}
+	}
+
+	// file moves take precedence over the home directory move
+	rename := func(name string) (string, *Datastore) {
+		for _, m := range moves {
+			if name == m.src || strings.HasPrefix(name, m.src+"/") {
+				return m.dst + strings.TrimPrefix(name, m.src), m.ds
+			}
+		}
+		return name, nil
+	}
+
+	names := []*string{
+		&vm.Config.Files.VmPathName,
+		&vm.Config.Files.SnapshotDirectory,
+		&vm.Config.Files.SuspendDirectory,
+		&vm.Config.Files.LogDirectory,
+		&vm.Config.Files.FtMetadataDirectory,
+		&vm.Summary.Config.VmPathName,
+		&vm.Layout.SwapFile,
+	}
+	for i := range vm.Layout.Disk {
+		for j := range vm.Layout.Disk[i].DiskFile {
+			names = append(names, &vm.Layout.Disk[i].DiskFile[j])
+		}
+	}
+	for i := range vm.Layout.Snapshot {
+		for j := range vm.Layout.Snapshot[i].SnapshotFile {
+			names = append(names, &vm.Layout.Snapshot[i].SnapshotFile[j])
+		}
+	}
+	for i := range vm.LayoutEx.File {
+		names = append(names, &vm.LayoutEx.File[i].Name)
+	}
+	for _, name := range names {
+		*name, _ = rename(*name)
+	}
+
+	if home != nil {
+		vm.log = path.Join(home.to, path.Base(vm.log))
+	}
+
+	// the home datastore remains the vm's primary datastore
+	var datastores []types.ManagedObjectReference
+	use := func(ref types.ManagedObjectReference) {
+		if !slices.Contains(datastores, ref) {
+			datastores = append(datastores, ref)
+		}
+	}
+
+	if home != nil {
+		use(home.ds.Self)
+	} else {
+		use(src.Self)
+	}
+
+	for _, device := range disks {
+		backing, ok := device.(*types.VirtualDisk).Backing.(*types.VirtualDiskFlatVer2BackingInfo)
+		for ok && backing != nil {
+			if name, ds := rename(backing.FileName); ds != nil {
+				backing.FileName = name
+				backing.Datastore = &ds.Self
+			}
+			if backing.Datastore != nil && backing.Datastore.Value != "" {
+				use(*backing.Datastore)
+			} else if p, fault := parseDatastorePath(backing.FileName); fault == nil {
+				use(vm.findDatastore(p.Datastore).Self)
+			}
+			backing = backing.Parent
+		}
+	}
+
+	for _, ref := range vm.Datastore {
+		if !slices.Contains(sources, ref) {
+			use(ref)
+		}
+	}
+
+	var removed, added []types.ManagedObjectReference
+	for _, ref := range vm.Datastore {
+		if !slices.Contains(datastores, ref) {
+			removed = append(removed, ref)
+		}
+	}
+	for _, ref := range datastores {
+		if !slices.Contains(vm.Datastore, ref) {
+			added = append(added, ref)
+		}
+	}
+
+	vm.Datastore = datastores
+	vm.LayoutEx.Timestamp = time.Now()
+
+	return removed, added, nil
+}
+
+// setHost moves the vm to the given host.
+func (vm *VirtualMachine) setHost(ctx *Context, host *HostSystem) {
+	src := ctx.Map.Get(*vm.Runtime.Host).(*HostSystem)
+	ctx.Map.RemoveReference(ctx, src, &src.Vm, vm.Self)
+	ctx.Map.AddReference(ctx, host, &host.Vm, vm.Self)
+
+	ctx.Map.Update(vm, []types.PropertyChange{
+		{Name: "runtime.host", Val: &host.Self},
+		{Name: "summary.runtime.host", Val: &host.Self},
+	})
+}
+
+func (vm *VirtualMachine) setConnectionState(ctx *Context, state types.VirtualMachineConnectionState) {
+	ctx.WithLock(vm, func() {
+		ctx.Map.Update(vm, []types.PropertyChange{
+			{Name: "runtime.connectionState", Val: state},
+			{Name: "summary.runtime.connectionState", Val: state},
+		})
+	})
+}
+
+// drsMigrate moves the vm to the given host, as initiated by DRS.
+func (vm *VirtualMachine) drsMigrate(ctx *Context, host *HostSystem) {
+	event := types.VmMigratedEvent{
+		SourceHost:       *ctx.Map.Get(*vm.Runtime.Host).(*HostSystem).eventArgument(),
+		SourceDatacenter: datacenterEventArgument(vm),
+		SourceDatastore:  ctx.Map.Get(vm.Datastore[0]).(*Datastore).eventArgument(),
+	}
+
+	vm.setHost(ctx, host)
+
+	event.VmEvent = vm.event()
+	ctx.postEvent(&types.DrsVmMigratedEvent{VmMigratedEvent: event})
+}
 
 func (vm *VirtualMachine) customize(ctx *Context) {
 	if vm.imc == nil {
@@ -2600,6 +3691,31 @@ func (vm *VirtualMachine) customize(ctx *Context) {
 	ctx.postEvent(&types.CustomizationSucceeded{CustomizationEvent: event})
 }
 
+// validateCustomization returns a fault if the given spec cannot be applied to the VM.
+func (vm *VirtualMachine) validateCustomization(spec *types.CustomizationSpec) types.BaseMethodFault {
+	if vm.Config.Tools.PendingCustomization != "" {
+		return new(types.CustomizationPending)
+	}
+	return vm.nicSettingMismatch(spec)
+}
+
+// nicSettingMismatch returns a fault if the spec's NicSettingMap does not match the VM's number of NICs.
+func (vm *VirtualMachine) nicSettingMismatch(spec *types.CustomizationSpec) types.BaseMethodFault {
+	if len(vm.Guest.Net) != len(spec.NicSettingMap) {
+		return &types.NicSettingMismatch{
+			NumberOfNicsInSpec: int32(len(spec.NicSettingMap)),
+			NumberOfNicsInVM:   int32(len(vm.Guest.Net)),
+		}
+	}
+	return nil
+}
+
+// setCustomization sets the spec to apply when the VM is next powered on.
+func (vm *VirtualMachine) setCustomization(spec *types.CustomizationSpec) {
+	vm.imc = spec
+	vm.Config.Tools.PendingCustomization = uuid.New().String()
+}
+
 func (vm *VirtualMachine) CustomizeVMTask(ctx *Context, req *types.CustomizeVM_Task) soap.HasFault {
 	task := CreateTask(vm, "customizeVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if vm.hostInMM(ctx) {
@@ -2612,18 +3728,11 @@ func (vm *VirtualMachine) CustomizeVMTask(ctx *Context, req *types.CustomizeVM_T
 				ExistingState:  vm.Runtime.PowerState,
 			}
 		}
-		if vm.Config.Tools.PendingCustomization != "" {
-			return nil, new(types.CustomizationPending)
-		}
-		if len(vm.Guest.Net) != len(req.Spec.NicSettingMap) {
-			return nil, &types.NicSettingMismatch{
-				NumberOfNicsInSpec: int32(len(req.Spec.NicSettingMap)),
-				NumberOfNicsInVM:   int32(len(vm.Guest.Net)),
-			}
+		if fault := vm.validateCustomization(&req.Spec); fault != nil {
+			return nil, fault
 		}
 
-		vm.imc = &req.Spec
-		vm.Config.Tools.PendingCustomization = uuid.New().String()
+		vm.setCustomization(&req.Spec)
 
 		return nil, nil
 	})
@@ -2635,8 +3744,62 @@ func (vm *VirtualMachine) CustomizeVMTask(ctx *Context, req *types.CustomizeVM_T
 	}
 }
 
+// quiesceSpec validates the given spec of a quiesced snapshot.
+func quiesceSpec(spec types.BaseVirtualMachineGuestQuiesceSpec) types.BaseMethodFault {
+	if spec == nil {
+		return nil
+	}
+
+	if timeout := spec.GetVirtualMachineGuestQuiesceSpec().Timeout; timeout != 0 && (timeout < 5 || timeout > 240) {
+		return &types.InvalidArgument{InvalidProperty: "quiesceSpec.timeout"}
+	}
+
+	if win, ok := spec.(*types.VirtualMachineWindowsQuiesceSpec); ok {
+		if win.VssBackupType < 0 || win.VssBackupType > 6 { // VSS_BACKUP_TYPE
+			return &types.InvalidArgument{InvalidProperty: "quiesceSpec.vssBackupType"}
+		}
+		if c := win.VssBackupContext; c != "" {
+			if !slices.Contains(types.VirtualMachineWindowsQuiesceSpecVssBackupContext("").Strings(), c) {
+				return &types.InvalidArgument{InvalidProperty: "quiesceSpec.vssBackupContext"}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (vm *VirtualMachine) CreateSnapshotExTask(ctx *Context, req *types.CreateSnapshotEx_Task) soap.HasFault {
+	body := new(methods.CreateSnapshotEx_TaskBody)
+
+	if fault := quiesceSpec(req.QuiesceSpec); fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	// the guest is only quiesced when powered on
+	quiesce := req.QuiesceSpec != nil && vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn
+
+	task := vm.createSnapshot(ctx, req.Name, req.Description, quiesce)
+
+	body.Res = &types.CreateSnapshotEx_TaskResponse{
+		Returnval: task.Run(ctx),
+	}
+
+	return body
+}
+
 func (vm *VirtualMachine) CreateSnapshotTask(ctx *Context, req *types.CreateSnapshot_Task) soap.HasFault {
-	task := CreateTask(vm, "createSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := vm.createSnapshot(ctx, req.Name, req.Description, req.Quiesce)
+
+	return &methods.CreateSnapshot_TaskBody{
+		Res: &types.CreateSnapshot_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (vm *VirtualMachine) createSnapshot(ctx *Context, name, description string, quiesce bool) *Task {
+	return CreateTask(vm, "createSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		var changes []types.PropertyChange
 
 		if vm.Snapshot == nil {
@@ -2645,7 +3808,7 @@ func (vm *VirtualMachine) CreateSnapshotTask(ctx *Context, req *types.CreateSnap
 
 		snapshot := &VirtualMachineSnapshot{}
 		snapshot.Vm = vm.Reference()
-		snapshot.Config = *vm.Config
+		deepCopy(vm.Config, &snapshot.Config)
 		snapshot.DataSets = copyDataSetsForVmClone(vm.DataSets)
 
 		ctx.Map.Put(snapshot)
@@ -2653,12 +3816,12 @@ func (vm *VirtualMachine) CreateSnapshotTask(ctx *Context, req *types.CreateSnap
 		treeItem := types.VirtualMachineSnapshotTree{
 			Snapshot:        snapshot.Self,
 			Vm:              snapshot.Vm,
-			Name:            req.Name,
-			Description:     req.Description,
+			Name:            name,
+			Description:     description,
 			Id:              atomic.AddInt32(&vm.sid, 1),
 			CreateTime:      time.Now(),
 			State:           vm.Runtime.PowerState,
-			Quiesced:        req.Quiesce,
+			Quiesced:        quiesce,
 			BackupManifest:  "",
 			ReplaySupported: types.NewBool(false),
 		}
@@ -2683,17 +3846,19 @@ func (vm *VirtualMachine) CreateSnapshotTask(ctx *Context, req *types.CreateSnap
 
 		snapshot.createSnapshotFiles()
 
-		changes = append(changes, types.PropertyChange{Name: "snapshot.currentSnapshot", Val: snapshot.Self})
+		if fault := vm.createDeltaDisks(ctx, vm.Config.Hardware.Device, nil); fault != nil {
+			return nil, fault
+		}
+
+		changes = append(changes,
+			types.PropertyChange{Name: "snapshot.currentSnapshot", Val: snapshot.Self},
+			types.PropertyChange{Name: "config.hardware.device", Val: vm.Config.Hardware.Device},
+		)
 		ctx.Map.Update(vm, changes)
+		vm.updateDiskLayouts()
 
 		return snapshot.Self, nil
 	})
-
-	return &methods.CreateSnapshot_TaskBody{
-		Res: &types.CreateSnapshot_TaskResponse{
-			Returnval: task.Run(ctx),
-		},
-	}
 }
 
 func (vm *VirtualMachine) RevertToCurrentSnapshotTask(ctx *Context, req *types.RevertToCurrentSnapshot_Task) soap.HasFault {
@@ -2707,8 +3872,7 @@ func (vm *VirtualMachine) RevertToCurrentSnapshotTask(ctx *Context, req *types.R
 	snapshot := ctx.Map.Get(*vm.Snapshot.CurrentSnapshot).(*VirtualMachineSnapshot)
 
 	task := CreateTask(vm, "revertSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
-		vm.DataSets = copyDataSetsForVmClone(snapshot.DataSets)
-		return nil, nil
+		return nil, snapshot.revert(ctx, vm)
 	})
 
 	body.Res = &types.RevertToCurrentSnapshot_TaskResponse{
@@ -2725,6 +3889,7 @@ func (vm *VirtualMachine) RemoveAllSnapshotsTask(ctx *Context, req *types.Remove
 		}
 
 		refs := allSnapshotsInTree(vm.Snapshot.RootSnapshotList)
+		deltas := deltaDisks(vm.snapshotDiskBackings(ctx))
 
 		ctx.Map.Update(vm, []types.PropertyChange{
 			{Name: "snapshot", Val: nil},
@@ -2736,6 +3901,9 @@ func (vm *VirtualMachine) RemoveAllSnapshotsTask(ctx *Context, req *types.Remove
 			ctx.Map.Remove(ctx, ref)
 		}
 
+		vm.updateConsolidation(ctx, req.Consolidate, deltas)
+		vm.RefreshStorageInfo(ctx, nil)
+
 		return nil, nil
 	})
 
@@ -2746,6 +3914,23 @@ func (vm *VirtualMachine) RemoveAllSnapshotsTask(ctx *Context, req *types.Remove
 	}
 }
 
+func (vm *VirtualMachine) ConsolidateVMDisksTask(ctx *Context, req *types.ConsolidateVMDisks_Task) soap.HasFault {
+	task := CreateTask(vm, "consolidateDisks", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		deltas := deltaDisks(vm.snapshotDiskBackings(ctx))
+
+		vm.updateConsolidation(ctx, nil, deltas)
+		vm.RefreshStorageInfo(ctx, nil)
+
+		return nil, nil
+	})
+
+	return &methods.ConsolidateVMDisks_TaskBody{
+		Res: &types.ConsolidateVMDisks_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (vm *VirtualMachine) fcd(ctx *Context, ds types.ManagedObjectReference, id types.ID) *VStorageObject {
 	m := ctx.Map.Get(*ctx.Map.content().VStorageObjectManager).(*VcenterVStorageObjectManager)
 	if ds.Value != "" {
@@ -2768,9 +3953,53 @@ func (vm *VirtualMachine) AttachDiskTask(ctx *Context, req *types.AttachDisk_Tas
 			return nil, new(types.InvalidArgument)
 		}
 
-		fcd.Config.ConsumerId = []types.ID{{Id: vm.Config.Uuid}}
+		devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
 
-		// TODO: add device
+		var controller types.BaseVirtualController
+		if req.ControllerKey == 0 {
+			c, err := devices.FindDiskController("")
+			if err != nil {
+				return nil, new(types.MissingController)
+			}
+			controller = c
+		} else {
+			c, ok := devices.FindByKey(req.ControllerKey).(types.BaseVirtualController)
+			if !ok {
+				return nil, &types.InvalidArgument{InvalidProperty: "controllerKey"}
+			}
+			controller = c
+		}
+
+		backing := fcd.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo)
+		disk := &types.VirtualDisk{
+			VirtualDevice: types.VirtualDevice{
+				Backing: &types.VirtualDiskFlatVer2BackingInfo{
+					VirtualDeviceFileBackingInfo: types.VirtualDeviceFileBackingInfo{
+						FileName: backing.FilePath,
+					},
+					DiskMode:        string(types.VirtualDiskModePersistent),
+					ThinProvisioned: types.NewBool(backing.ProvisioningType == string(types.BaseConfigInfoDiskFileBackingInfoProvisioningTypeThin)),
+				},
+			},
+			CapacityInKB: fcd.Config.CapacityInMB * 1024,
+			VDiskId:      &types.ID{Id: fcd.Config.Id.Id},
+		}
+		devices.AssignController(disk, controller)
+		if req.UnitNumber != nil {
+			disk.UnitNumber = req.UnitNumber
+		}
+
+		spec := &types.VirtualMachineConfigSpec{
+			DeviceChange: []types.BaseVirtualDeviceConfigSpec{&types.VirtualDeviceConfigSpec{
+				Operation: types.VirtualDeviceConfigSpecOperationAdd,
+				Device:    disk,
+			}},
+		}
+		if err := vm.configureDevices(ctx, spec); err != nil {
+			return nil, err
+		}
+
+		fcd.Config.ConsumerId = []types.ID{{Id: vm.Config.Uuid}}
 
 		return nil, nil
 	})
@@ -2789,9 +4018,26 @@ func (vm *VirtualMachine) DetachDiskTask(ctx *Context, req *types.DetachDisk_Tas
 			return nil, new(types.InvalidArgument)
 		}
 
-		fcd.Config.ConsumerId = nil
+		devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
+		disks := devices.SelectByType((*types.VirtualDisk)(nil)).Select(func(d types.BaseVirtualDevice) bool {
+			id := d.(*types.VirtualDisk).VDiskId
+			return id != nil && id.Id == req.DiskId.Id
+		})
+		if len(disks) == 0 {
+			return nil, &types.NotFound{}
+		}
+
+		spec := &types.VirtualMachineConfigSpec{
+			DeviceChange: []types.BaseVirtualDeviceConfigSpec{&types.VirtualDeviceConfigSpec{
+				Operation: types.VirtualDeviceConfigSpecOperationRemove,
+				Device:    disks[0],
+			}},
+		}
+		if err := vm.configureDevices(ctx, spec); err != nil {
+			return nil, err
+		}
 
-		// TODO: remove device
+		fcd.Config.ConsumerId = nil
 
 		return nil, nil
 	})
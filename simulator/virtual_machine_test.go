@@ -21,19 +21,25 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/task"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -2122,6 +2128,278 @@ func TestVmRefreshStorageInfo(t *testing.T) {
 	}
 }
 
+func TestVmSnapshotDeltaDisks(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+
+		var mvm mo.VirtualMachine
+		props := []string{"config", "layoutEx", "runtime"}
+		refresh := func() *types.VirtualDiskFlatVer2BackingInfo {
+			if err := vm.Properties(ctx, vm.Reference(), props, &mvm); err != nil {
+				t.Fatal(err)
+			}
+			disks := object.VirtualDeviceList(mvm.Config.Hardware.Device).SelectByType((*types.VirtualDisk)(nil))
+			return disks[0].(*types.VirtualDisk).Backing.(*types.VirtualDiskFlatVer2BackingInfo)
+		}
+
+		wait := func(task *object.Task, err error) {
+			if err == nil {
+				err = task.Wait(ctx)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		finder := find.NewFinder(c)
+		exists := func(name string) bool {
+			var p object.DatastorePath
+			p.FromString(name)
+			ds, err := finder.Datastore(ctx, p.Datastore)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = ds.Stat(ctx, p.Path)
+			return err == nil
+		}
+
+		base := refresh()
+		memory := mvm.Config.Hardware.MemoryMB
+
+		wait(vm.CreateSnapshot(ctx, "s1", "", false, false))
+		disk := refresh()
+		if disk.Parent == nil || disk.Parent.FileName != base.FileName {
+			t.Fatalf("parent=%#v", disk.Parent)
+		}
+		delta1 := disk.FileName
+		if !strings.HasSuffix(delta1, "-000001.vmdk") || !exists(delta1) {
+			t.Errorf("delta=%s", delta1)
+		}
+		if n := len(mvm.LayoutEx.Disk[0].Chain); n != 2 {
+			t.Errorf("chain=%d", n)
+		}
+		if n := len(mvm.LayoutEx.Snapshot[0].Disk[0].Chain); n != 1 {
+			t.Errorf("snapshot chain=%d", n)
+		}
+
+		wait(vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{MemoryMB: int64(memory) * 2}))
+		wait(vm.CreateSnapshot(ctx, "s2", "", false, false))
+		delta2 := refresh().FileName
+		if n := len(mvm.LayoutEx.Disk[0].Chain); n != 3 {
+			t.Errorf("chain=%d", n)
+		}
+
+		// revert restores the config, with a new delta disk on top of the snapshot's disk
+		wait(vm.RevertToSnapshot(ctx, "s1", true))
+		disk = refresh()
+		if mvm.Config.Hardware.MemoryMB != memory {
+			t.Errorf("memory=%d", mvm.Config.Hardware.MemoryMB)
+		}
+		if disk.Parent == nil || disk.Parent.FileName != base.FileName || disk.FileName == delta1 || disk.FileName == delta2 {
+			t.Errorf("disk=%s", disk.FileName)
+		}
+		if exists(delta2) {
+			t.Errorf("%s not deleted", delta2) // not captured by a snapshot
+		}
+		if !exists(delta1) {
+			t.Errorf("%s deleted", delta1) // captured by s2
+		}
+
+		wait(vm.RemoveSnapshot(ctx, "s2", false, nil))
+		if exists(delta1) {
+			t.Errorf("%s not deleted", delta1)
+		}
+
+		// without consolidation, the delta disk remains
+		delta3 := refresh().FileName
+		wait(vm.RemoveSnapshot(ctx, "s1", false, types.NewBool(false)))
+		disk = refresh()
+		if disk.FileName != delta3 || !*mvm.Runtime.ConsolidationNeeded {
+			t.Errorf("disk=%s consolidation needed=%t", disk.FileName, *mvm.Runtime.ConsolidationNeeded)
+		}
+
+		res, err := methods.ConsolidateVMDisks_Task(ctx, c, &types.ConsolidateVMDisks_Task{This: vm.Reference()})
+		wait(object.NewTask(c, res.Returnval), err)
+		disk = refresh()
+		if disk.FileName != base.FileName || disk.Parent != nil || *mvm.Runtime.ConsolidationNeeded {
+			t.Errorf("disk=%s consolidation needed=%t", disk.FileName, *mvm.Runtime.ConsolidationNeeded)
+		}
+		if exists(delta3) {
+			t.Errorf("%s not deleted", delta3)
+		}
+		if n := len(mvm.LayoutEx.Disk[0].Chain); n != 1 {
+			t.Errorf("chain=%d", n)
+		}
+
+		// consolidated when removing all snapshots
+		wait(vm.CreateSnapshot(ctx, "s3", "", false, false))
+		wait(vm.CreateSnapshot(ctx, "s4", "", false, false))
+		wait(vm.RemoveAllSnapshot(ctx, nil))
+		disk = refresh()
+		if disk.FileName != base.FileName || disk.Parent != nil {
+			t.Errorf("disk=%s", disk.FileName)
+		}
+	})
+}
+
+func TestVmInstantClone(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wait := func(task *object.Task, err error) error {
+			if err == nil {
+				err = task.Wait(ctx)
+			}
+			return err
+		}
+
+		var src mo.VirtualMachine
+		if err = vm.Properties(ctx, vm.Reference(), []string{"config", "runtime"}, &src); err != nil {
+			t.Fatal(err)
+		}
+		devices := object.VirtualDeviceList(src.Config.Hardware.Device)
+		disk := devices.SelectByType((*types.VirtualDisk)(nil))[0].(*types.VirtualDisk)
+		nic := devices.SelectByType((*types.VirtualEthernetCard)(nil))[0].(types.BaseVirtualEthernetCard)
+
+		spec := types.VirtualMachineInstantCloneSpec{Name: "ic-1"}
+
+		// only edits of ethernet cards are supported
+		spec.Location.DeviceChange = []types.BaseVirtualDeviceConfigSpec{
+			&types.VirtualDeviceConfigSpec{Operation: types.VirtualDeviceConfigSpecOperationRemove, Device: disk},
+		}
+		if err = wait(vm.InstantClone(ctx, spec)); !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		// freeze the source, as the guest would
+		err = wait(vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{
+			ExtraConfig: []types.BaseOptionValue{&types.OptionValue{Key: "SET.runtime.instantCloneFrozen", Value: "true"}},
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mac := "00:50:56:00:00:01"
+		nic.GetVirtualEthernetCard().MacAddress = mac
+		nic.GetVirtualEthernetCard().AddressType = string(types.VirtualEthernetCardMacTypeManual)
+		if b, ok := nic.GetVirtualEthernetCard().Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo); ok {
+			b.Port.PortKey = "" // the source's port is in use
+		}
+		spec.Location.DeviceChange = []types.BaseVirtualDeviceConfigSpec{
+			&types.VirtualDeviceConfigSpec{Operation: types.VirtualDeviceConfigSpecOperationEdit, Device: nic.(types.BaseVirtualDevice)},
+		}
+		spec.BiosUuid = uuid.NewString()
+		spec.Config = []types.BaseOptionValue{
+			&types.OptionValue{Key: "guestinfo.ic.id", Value: "1"},
+			&types.OptionValue{Key: "SET.guest.hostName", Value: "ic-1"},
+		}
+
+		task, err := vm.InstantClone(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := task.WaitForResult(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		clone := object.NewVirtualMachine(c, info.Result.(types.ManagedObjectReference))
+		var mclone mo.VirtualMachine
+		if err = clone.Properties(ctx, clone.Reference(), []string{"config", "runtime", "guest"}, &mclone); err != nil {
+			t.Fatal(err)
+		}
+
+		if mclone.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn || *mclone.Runtime.InstantCloneFrozen {
+			t.Errorf("runtime=%#v", mclone.Runtime)
+		}
+		if mclone.Config.Uuid != spec.BiosUuid {
+			t.Errorf("uuid=%s", mclone.Config.Uuid)
+		}
+		if mclone.Guest.HostName != "ic-1" {
+			t.Errorf("hostName=%s", mclone.Guest.HostName)
+		}
+		extra := object.OptionValueList(mclone.Config.ExtraConfig)
+		if val, ok := extra.GetString("guestinfo.ic.id"); !ok || val != "1" {
+			t.Errorf("extraConfig=%v", val)
+		}
+
+		cdevices := object.VirtualDeviceList(mclone.Config.Hardware.Device)
+		cdisk := cdevices.SelectByType((*types.VirtualDisk)(nil))[0].(*types.VirtualDisk)
+		backing := cdisk.Backing.(*types.VirtualDiskFlatVer2BackingInfo)
+		if !strings.HasPrefix(backing.FileName, "[LocalDS_0] ic-1/") || backing.Parent == nil ||
+			backing.Parent.FileName != disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo).FileName {
+			t.Errorf("backing=%#v", backing)
+		}
+		cnic := cdevices.SelectByType((*types.VirtualEthernetCard)(nil))[0].(types.BaseVirtualEthernetCard)
+		if cnic.GetVirtualEthernetCard().MacAddress != mac {
+			t.Errorf("mac=%s", cnic.GetVirtualEthernetCard().MacAddress)
+		}
+
+		// the source remains frozen
+		if err = vm.Properties(ctx, vm.Reference(), []string{"runtime"}, &src); err != nil {
+			t.Fatal(err)
+		}
+		if !*src.Runtime.InstantCloneFrozen {
+			t.Error("source not frozen")
+		}
+
+		if err = wait(vm.InstantClone(ctx, spec)); !fault.Is(err, &types.DuplicateName{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		// consolidation does not merge the clone's delta disk into the source disk
+		if err = wait(clone.CreateSnapshot(ctx, "s1", "", false, false)); err != nil {
+			t.Fatal(err)
+		}
+		if err = wait(clone.RemoveAllSnapshot(ctx, nil)); err != nil {
+			t.Fatal(err)
+		}
+		if err = clone.Properties(ctx, clone.Reference(), []string{"config", "runtime"}, &mclone); err != nil {
+			t.Fatal(err)
+		}
+		cdisk = object.VirtualDeviceList(mclone.Config.Hardware.Device).SelectByType((*types.VirtualDisk)(nil))[0].(*types.VirtualDisk)
+		if b := cdisk.Backing.(*types.VirtualDiskFlatVer2BackingInfo); b.FileName != backing.FileName || *mclone.Runtime.ConsolidationNeeded {
+			t.Errorf("backing=%#v", b)
+		}
+
+		// destroying the clone leaves the source disk in place
+		if err = wait(clone.PowerOff(ctx)); err != nil {
+			t.Fatal(err)
+		}
+		if err = wait(clone.Destroy(ctx)); err != nil {
+			t.Fatal(err)
+		}
+		ds, err := finder.Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var p object.DatastorePath
+		p.FromString(backing.Parent.FileName)
+		if _, err = ds.Stat(ctx, p.Path); err != nil {
+			t.Error(err)
+		}
+
+		// powering off unfreezes the source, which can no longer be cloned
+		if err = wait(vm.PowerOff(ctx)); err != nil {
+			t.Fatal(err)
+		}
+		if err = vm.Properties(ctx, vm.Reference(), []string{"runtime"}, &src); err != nil {
+			t.Fatal(err)
+		}
+		if *src.Runtime.InstantCloneFrozen {
+			t.Error("source frozen")
+		}
+		spec.Name = "ic-2"
+		if err = wait(vm.InstantClone(ctx, spec)); !fault.Is(err, &types.InvalidPowerState{}) {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
+
 func TestApplyExtraConfig(t *testing.T) {
 
 	applyAndAssertExtraConfigValue := func(
@@ -3243,3 +3521,248 @@ func TestEncryptDecryptVM(t *testing.T) {
 		})
 	}
 }
+
+func TestRelocateVm(t *testing.T) {
+	m := VPX()
+	m.Cluster = 2
+	m.Datastore = 2
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			return err
+		}
+		finder.SetDatacenter(dc)
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			return err
+		}
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		var ds []*object.Datastore
+		for _, name := range []string{"LocalDS_0", "LocalDS_1"} {
+			d, err := finder.Datastore(ctx, name)
+			if err != nil {
+				return err
+			}
+			ds = append(ds, d)
+		}
+
+		relocate := func(spec types.VirtualMachineRelocateSpec) error {
+			task, err := vm.Relocate(ctx, spec, "")
+			if err != nil {
+				return err
+			}
+			return task.Wait(ctx)
+		}
+
+		props := func() *mo.VirtualMachine {
+			var o mo.VirtualMachine
+			err := vm.Properties(ctx, vm.Reference(), []string{"config", "datastore", "layoutEx", "resourcePool", "runtime"}, &o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return &o
+		}
+
+		disk := func(o *mo.VirtualMachine) *types.VirtualDisk {
+			return object.VirtualDeviceList(o.Config.Hardware.Device).SelectByType((*types.VirtualDisk)(nil))[0].(*types.VirtualDisk)
+		}
+
+		vmDatastores := func(d *object.Datastore) []types.ManagedObjectReference {
+			var o mo.Datastore
+			if err := d.Properties(ctx, d.Reference(), []string{"vm"}, &o); err != nil {
+				t.Fatal(err)
+			}
+			return o.Vm
+		}
+
+		// storage only, the home directory and disk move to LocalDS_1
+		dest := ds[1].Reference()
+		if err = relocate(types.VirtualMachineRelocateSpec{Datastore: &dest}); err != nil {
+			return err
+		}
+
+		o := props()
+		if o.Config.Files.VmPathName != "[LocalDS_1] DC0_C0_RP0_VM0/DC0_C0_RP0_VM0.vmx" {
+			t.Errorf("vmPathName=%s", o.Config.Files.VmPathName)
+		}
+		backing := disk(o).Backing.(*types.VirtualDiskFlatVer2BackingInfo)
+		if backing.FileName != "[LocalDS_1] DC0_C0_RP0_VM0/disk1.vmdk" || *backing.Datastore != dest {
+			t.Errorf("disk=%s", backing.FileName)
+		}
+		for _, file := range o.LayoutEx.File {
+			if !strings.HasPrefix(file.Name, "[LocalDS_1] ") {
+				t.Errorf("layoutEx file=%s", file.Name)
+			}
+		}
+		if len(o.Datastore) != 1 || o.Datastore[0] != dest {
+			t.Errorf("datastore=%v", o.Datastore)
+		}
+		if FindReference(vmDatastores(ds[0]), vm.Reference()) != nil {
+			t.Error("vm still on LocalDS_0")
+		}
+		if FindReference(vmDatastores(ds[1]), vm.Reference()) == nil {
+			t.Error("vm not on LocalDS_1")
+		}
+		dir := Map.Get(dest).(*Datastore).Info.GetDatastoreInfo().Url
+		if _, err = os.Stat(path.Join(dir, "DC0_C0_RP0_VM0", "DC0_C0_RP0_VM0.vmx")); err != nil {
+			t.Error(err)
+		}
+
+		// disk locator moves the disk back to LocalDS_0, the home directory stays
+		src := ds[0].Reference()
+		err = relocate(types.VirtualMachineRelocateSpec{
+			Disk: []types.VirtualMachineRelocateSpecDiskLocator{{DiskId: disk(o).Key, Datastore: src}},
+		})
+		if err != nil {
+			return err
+		}
+
+		o = props()
+		if !strings.HasPrefix(o.Config.Files.VmPathName, "[LocalDS_1] ") {
+			t.Errorf("vmPathName=%s", o.Config.Files.VmPathName)
+		}
+		backing = disk(o).Backing.(*types.VirtualDiskFlatVer2BackingInfo)
+		if backing.FileName != "[LocalDS_0] DC0_C0_RP0_VM0/disk1.vmdk" {
+			t.Errorf("disk=%s", backing.FileName)
+		}
+		if len(o.Datastore) != 2 {
+			t.Errorf("datastore=%v", o.Datastore)
+		}
+
+		// a pool in another cluster implies a host in that cluster
+		pool, err := finder.ResourcePool(ctx, "DC0_C1/Resources")
+		if err != nil {
+			return err
+		}
+		ref := pool.Reference()
+		if err = relocate(types.VirtualMachineRelocateSpec{Pool: &ref}); err != nil {
+			return err
+		}
+
+		o = props()
+		if *o.ResourcePool != ref {
+			t.Errorf("pool=%s", o.ResourcePool)
+		}
+		host := Map.Get(*o.Runtime.Host).(*HostSystem)
+		if host.Parent.Value != Map.Get(ref).(*ResourcePool).Owner.Value {
+			t.Errorf("host=%s", host.Name)
+		}
+
+		// a datastore the destination host cannot access
+		host0, err := finder.HostSystem(ctx, "DC0_H0")
+		if err != nil {
+			return err
+		}
+		invalid := types.ManagedObjectReference{Type: "Datastore", Value: "enoent"}
+		if err = relocate(types.VirtualMachineRelocateSpec{Datastore: &invalid}); err == nil {
+			t.Error("expected error")
+		}
+		h0 := host0.Reference()
+		if err = relocate(types.VirtualMachineRelocateSpec{Host: &h0, Pool: &ref}); err == nil {
+			t.Error("expected error") // host not in the pool's cluster
+		}
+
+		for kind, n := range map[string]int{"VmRelocatedEvent": 2, "VmMigratedEvent": 1} {
+			events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{
+				EventTypeId: []string{kind},
+				Entity: &types.EventFilterSpecByEntity{
+					Entity:    vm.Reference(),
+					Recursion: types.EventFilterSpecRecursionOptionSelf,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if len(events) != n {
+				t.Errorf("%s=%d", kind, len(events))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReconfigVmDiskCrypto(t *testing.T) {
+	model := VPX()
+	model.Autostart = false
+
+	Test(func(ctx context.Context, c *vim25.Client) {
+		ref := Map.Any("VirtualMachine").Reference()
+		vm := object.NewVirtualMachine(c, ref)
+
+		key := types.CryptoKeyId{KeyId: "123", ProviderId: &types.KeyProviderId{Id: "kp"}}
+
+		reconfigure := func(vmSpec, diskSpec types.BaseCryptoSpec) error {
+			devices, err := vm.Device(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			disk := devices.SelectByType((*types.VirtualDisk)(nil))[0]
+
+			task, err := vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{
+				Crypto: vmSpec,
+				DeviceChange: []types.BaseVirtualDeviceConfigSpec{
+					&types.VirtualDeviceConfigSpec{
+						Operation: types.VirtualDeviceConfigSpecOperationEdit,
+						Device:    disk,
+						Backing:   &types.VirtualDeviceConfigSpecBackingSpec{Crypto: diskSpec},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return task.Wait(ctx)
+		}
+
+		diskKey := func() *types.CryptoKeyId {
+			devices, err := vm.Device(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			disk := devices.SelectByType((*types.VirtualDisk)(nil))[0].(*types.VirtualDisk)
+			return disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo).KeyId
+		}
+
+		err := reconfigure(nil, &types.CryptoSpecEncrypt{CryptoKeyId: key})
+		assert.True(t, fault.Is(err, &types.InvalidState{}), "vm is not encrypted")
+
+		assert.NoError(t, reconfigure(&types.CryptoSpecEncrypt{CryptoKeyId: key}, &types.CryptoSpecEncrypt{CryptoKeyId: key}))
+		assert.Equal(t, &key, diskKey())
+
+		err = reconfigure(nil, &types.CryptoSpecEncrypt{CryptoKeyId: key})
+		assert.True(t, fault.Is(err, &types.InvalidState{}), "disk is already encrypted")
+
+		_, err = vm.PowerOn(ctx)
+		assert.NoError(t, err)
+
+		err = reconfigure(nil, &types.CryptoSpecDeepRecrypt{NewKeyId: types.CryptoKeyId{KeyId: "456"}})
+		assert.True(t, fault.Is(err, &types.InvalidPowerState{}))
+
+		// Provider defaults to the current provider
+		assert.NoError(t, reconfigure(nil, &types.CryptoSpecShallowRecrypt{NewKeyId: types.CryptoKeyId{KeyId: "456"}}))
+		assert.Equal(t, &types.CryptoKeyId{KeyId: "456", ProviderId: key.ProviderId}, diskKey())
+
+		_, err = vm.PowerOff(ctx)
+		assert.NoError(t, err)
+
+		assert.NoError(t, reconfigure(nil, &types.CryptoSpecDecrypt{}))
+		assert.Nil(t, diskKey())
+
+		err = reconfigure(nil, &types.CryptoSpecDecrypt{})
+		assert.True(t, fault.Is(err, &types.InvalidState{}), "disk is not encrypted")
+	}, model)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpx
+
+import "github.com/vmware/govmomi/vim25/types"
+
+func evcMode(vendor string, tier int32, key, label string) types.EVCMode {
+	return types.EVCMode{
+		ElementDescription: types.ElementDescription{
+			Description: types.Description{
+				Label:   label,
+				Summary: label,
+			},
+			Key: key,
+		},
+		Vendor:     vendor,
+		VendorTier: tier,
+	}
+}
+
+// EVCMode is the default template for the ServiceInstance capability.supportedEVCMode property.
+// Capture method:
+// govc object.collect -s -dump - capability.supportedEVCMode
+// The captured list has been pruned of the CPU feature details.
+var EVCMode = []types.EVCMode{
+	evcMode("intel", 0, "intel-merom", `Intel® "Merom" Generation`),
+	evcMode("intel", 1, "intel-penryn", `Intel® "Penryn" Generation`),
+	evcMode("intel", 2, "intel-nehalem", `Intel® "Nehalem" Generation`),
+	evcMode("intel", 3, "intel-westmere", `Intel® "Westmere" Generation`),
+	evcMode("intel", 4, "intel-sandybridge", `Intel® "Sandy Bridge" Generation`),
+	evcMode("intel", 5, "intel-ivybridge", `Intel® "Ivy Bridge" Generation`),
+	evcMode("intel", 6, "intel-haswell", `Intel® "Haswell" Generation`),
+	evcMode("intel", 7, "intel-broadwell", `Intel® "Broadwell" Generation`),
+	evcMode("intel", 8, "intel-skylake", `Intel® "Skylake" Generation`),
+	evcMode("intel", 9, "intel-cascadelake", `Intel® "Cascade Lake" Generation`),
+	evcMode("intel", 10, "intel-icelake", `Intel® "Ice Lake" Generation`),
+	evcMode("amd", 0, "amd-rev-e", `AMD Opteron™ Generation 1`),
+	evcMode("amd", 1, "amd-rev-f", `AMD Opteron™ Generation 2`),
+	evcMode("amd", 2, "amd-greyhound", `AMD Opteron™ Generation 3`),
+	evcMode("amd", 4, "amd-bulldozer", `AMD Opteron™ Generation 4`),
+	evcMode("amd", 5, "amd-piledriver", `AMD Opteron™ "Piledriver" Generation`),
+	evcMode("amd", 6, "amd-steamroller", `AMD Opteron™ "Steamroller" Generation`),
+	evcMode("amd", 7, "amd-zen", `AMD "Zen" Generation`),
+	evcMode("amd", 8, "amd-zen2", `AMD "Zen 2" Generation`),
+	evcMode("amd", 9, "amd-zen3", `AMD "Zen 3" Generation`),
+}
@@ -0,0 +1,205 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// vsanDiskFormatVersion is the on-disk format version of newly claimed and upgraded vSAN disks.
+const vsanDiskFormatVersion = int32(17)
+
+type VsanUpgradeSystem struct {
+	mo.VsanUpgradeSystem
+
+	status map[types.ManagedObjectReference]*types.VsanUpgradeSystemUpgradeStatus
+}
+
+func NewVsanUpgradeSystem() *VsanUpgradeSystem {
+	s := new(VsanUpgradeSystem)
+	s.Self = types.ManagedObjectReference{Type: "VsanUpgradeSystem", Value: "vsan-upgrade-system"}
+	return s
+}
+
+func (s *VsanUpgradeSystem) cluster(ctx *Context, ref types.ManagedObjectReference) (*ClusterComputeResource, types.BaseMethodFault) {
+	c, ok := ctx.Map.Get(ref).(*ClusterComputeResource)
+	if !ok {
+		return nil, &types.ManagedObjectNotFound{Obj: ref}
+	}
+	return c, nil
+}
+
+func (s *VsanUpgradeSystem) preflightCheck(ctx *Context, c *ClusterComputeResource) []types.BaseVsanUpgradeSystemPreflightCheckIssue {
+	var issues []types.BaseVsanUpgradeSystemPreflightCheckIssue
+
+	info := c.ConfigurationEx.(*types.ClusterConfigInfoEx).VsanConfigInfo
+	if info == nil || info.Enabled == nil || !*info.Enabled {
+		issues = append(issues, &types.VsanUpgradeSystemPreflightCheckIssue{
+			Msg: fmt.Sprintf("vSAN is not enabled on cluster %s", c.Name),
+		})
+		return issues
+	}
+
+	var disconnected, autoClaim []types.ManagedObjectReference
+
+	for _, ref := range c.Host {
+		host := ctx.Map.Get(ref).(*HostSystem)
+		if host.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+			disconnected = append(disconnected, ref)
+		}
+		if config := host.Config; config != nil && config.VsanHostConfig != nil {
+			if storage := config.VsanHostConfig.StorageInfo; storage != nil && storage.AutoClaimStorage != nil && *storage.AutoClaimStorage {
+				autoClaim = append(autoClaim, ref)
+			}
+		}
+	}
+
+	if len(disconnected) != 0 {
+		issues = append(issues, &types.VsanUpgradeSystemHostsDisconnectedIssue{
+			VsanUpgradeSystemPreflightCheckIssue: types.VsanUpgradeSystemPreflightCheckIssue{
+				Msg: fmt.Sprintf("%d host(s) are disconnected", len(disconnected)),
+			},
+			Hosts: disconnected,
+		})
+	}
+
+	if len(autoClaim) != 0 {
+		issues = append(issues, &types.VsanUpgradeSystemAutoClaimEnabledOnHostsIssue{
+			VsanUpgradeSystemPreflightCheckIssue: types.VsanUpgradeSystemPreflightCheckIssue{
+				Msg: fmt.Sprintf("%d host(s) have automatic disk claiming enabled", len(autoClaim)),
+			},
+			Hosts: autoClaim,
+		})
+	}
+
+	return issues
+}
+
+func (s *VsanUpgradeSystem) PerformVsanUpgradePreflightCheck(ctx *Context, req *types.PerformVsanUpgradePreflightCheck) soap.HasFault {
+	body := new(methods.PerformVsanUpgradePreflightCheckBody)
+
+	c, fault := s.cluster(ctx, req.Cluster)
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.PerformVsanUpgradePreflightCheckResponse{
+		Returnval: types.VsanUpgradeSystemPreflightCheckResult{
+			Issues: s.preflightCheck(ctx, c),
+		},
+	}
+
+	return body
+}
+
+func (s *VsanUpgradeSystem) PerformVsanUpgradeTask(ctx *Context, req *types.PerformVsanUpgrade_Task) soap.HasFault {
+	task := CreateTask(s, "performVsanUpgrade", func(task *Task) (types.AnyType, types.BaseMethodFault) {
+		c, fault := s.cluster(ctx, req.Cluster)
+		if fault != nil {
+			return nil, fault
+		}
+
+		if issues := s.preflightCheck(ctx, c); len(issues) != 0 {
+			return nil, &types.VsanFault{
+				VimFault: types.VimFault{
+					MethodFault: types.MethodFault{
+						FaultMessage: []types.LocalizableMessage{{
+							Key:     "com.vmware.vsan.upgrade.preflight",
+							Message: issues[0].GetVsanUpgradeSystemPreflightCheckIssue().Msg,
+						}},
+					},
+				},
+			}
+		}
+
+		status := &types.VsanUpgradeSystemUpgradeStatus{
+			InProgress: false,
+			Aborted:    types.NewBool(false),
+			Completed:  types.NewBool(true),
+			Progress:   100,
+		}
+
+		downgrade := req.DowngradeFormat != nil && *req.DowngradeFormat
+		op := "upgrade"
+		if downgrade {
+			op = "downgrade"
+		}
+		now := ctx.Map.Now()
+
+		for _, ref := range c.Host {
+			if slices.Contains(req.ExcludeHosts, ref) {
+				continue
+			}
+
+			host := ctx.Map.Get(ref).(*HostSystem)
+			if host.ConfigManager.VsanSystem == nil {
+				continue
+			}
+
+			vsan := ctx.Map.Get(*host.ConfigManager.VsanSystem).(*HostVsanSystem)
+			ctx.WithLock(vsan, func() { vsan.upgrade(ctx, downgrade) })
+
+			status.History = append(status.History, &types.VsanUpgradeSystemUpgradeHistoryItem{
+				Timestamp: now,
+				Host:      &host.Self,
+				Message:   fmt.Sprintf("Disk format %s completed on host %s", op, host.Name),
+				Task:      &task.Self,
+			})
+		}
+
+		if s.status == nil {
+			s.status = make(map[types.ManagedObjectReference]*types.VsanUpgradeSystemUpgradeStatus)
+		}
+		s.status[c.Self] = status
+
+		return nil, nil
+	})
+
+	return &methods.PerformVsanUpgrade_TaskBody{
+		Res: &types.PerformVsanUpgrade_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (s *VsanUpgradeSystem) QueryVsanUpgradeStatus(ctx *Context, req *types.QueryVsanUpgradeStatus) soap.HasFault {
+	body := new(methods.QueryVsanUpgradeStatusBody)
+
+	c, fault := s.cluster(ctx, req.Cluster)
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	status := s.status[c.Self]
+	if status == nil {
+		status = new(types.VsanUpgradeSystemUpgradeStatus)
+	}
+
+	body.Res = &types.QueryVsanUpgradeStatusResponse{
+		Returnval: *status,
+	}
+
+	return body
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package simulator
 
 import (
+	"io"
 	"log"
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -36,6 +38,18 @@ import (
 type VStorageObject struct {
 	types.VStorageObject
 	types.VStorageObjectSnapshotInfo
+
+	Metadata []types.KeyValue
+
+	// snapshots maps a snapshot ID to the state of the object when the snapshot was created
+	snapshots map[string]vStorageObjectSnapshot
+}
+
+// vStorageObjectSnapshot is the state of a VStorageObject when a snapshot was created.
+type vStorageObjectSnapshot struct {
+	path         string // datastore path of the snapshot's copy of the backing file
+	capacityInMB int64
+	metadata     []types.KeyValue
 }
 
 type VcenterVStorageObjectManager struct {
@@ -291,6 +305,10 @@ func (m *VcenterVStorageObjectManager) DeleteVStorageObjectTask(ctx *Context, re
 			return nil, &types.InvalidState{}
 		}
 
+		for _, snapshot := range slices.Clone(obj.Snapshots) {
+			m.removeSnapshot(ctx, req.Datastore, obj, snapshot.Id.Id)
+		}
+
 		backing := obj.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo)
 		ds := ctx.Map.Get(req.Datastore).(*Datastore)
 		dc := ctx.Map.getEntityDatacenter(ds)
@@ -327,6 +345,68 @@ func (m *VcenterVStorageObjectManager) RetrieveSnapshotInfo(req *types.RetrieveS
 	return body
 }
 
+// resolve returns the local file path of the given datastore path.
+func (m *VcenterVStorageObjectManager) resolve(ctx *Context, ds types.ManagedObjectReference, path string) (string, types.BaseMethodFault) {
+	dc := ctx.Map.getEntityDatacenter(ctx.Map.Get(ds).(*Datastore))
+	return ctx.Map.FileManager().resolve(&dc.Self, path)
+}
+
+// copyBacking copies the descriptor and extent files of a VStorageObject, from src to dst datastore paths.
+func (m *VcenterVStorageObjectManager) copyBacking(ctx *Context, ds types.ManagedObjectReference, src, dst string) types.BaseMethodFault {
+	from, fault := m.resolve(ctx, ds, src)
+	if fault != nil {
+		return fault
+	}
+	to, fault := m.resolve(ctx, ds, dst)
+	if fault != nil {
+		return fault
+	}
+
+	fm := ctx.Map.FileManager()
+	dstNames := vdmNames(to)
+
+	for i, name := range vdmNames(from) {
+		in, err := os.Open(name)
+		if err != nil {
+			if i == 0 && os.IsNotExist(err) {
+				continue // registered disks may not have an extent file
+			}
+			return fm.fault(src, err, new(types.FileNotFound))
+		}
+
+		out, err := os.Create(dstNames[i])
+		if err != nil {
+			_ = in.Close()
+			return fm.fault(dst, err, new(types.CannotCreateFile))
+		}
+
+		_, err = io.Copy(out, in)
+		_ = in.Close()
+		_ = out.Close()
+		if err != nil {
+			return fm.fault(dst, err, new(types.FileFault))
+		}
+	}
+
+	return nil
+}
+
+// removeSnapshot removes a snapshot and its copy of the backing file.
+func (m *VcenterVStorageObjectManager) removeSnapshot(ctx *Context, ds types.ManagedObjectReference, obj *VStorageObject, id string) {
+	if snapshot, ok := obj.snapshots[id]; ok {
+		if file, fault := m.resolve(ctx, ds, snapshot.path); fault == nil {
+			for _, name := range vdmNames(file) {
+				_ = os.Remove(name)
+			}
+		}
+		delete(obj.snapshots, id)
+	}
+
+	obj.Snapshots = slices.DeleteFunc(obj.Snapshots, func(s types.VStorageObjectSnapshotInfoVStorageObjectSnapshot) bool {
+		return s.Id.Id == id
+	})
+}
+
 func (m *VcenterVStorageObjectManager) VStorageObjectCreateSnapshotTask(ctx *Context, req *types.VStorageObjectCreateSnapshot_Task) soap.HasFault {
 	task := CreateTask(m, "createSnapshot", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		obj := m.object(req.Datastore, req.Id)
@@ -342,6 +422,21 @@ func (m *VcenterVStorageObjectManager) VStorageObjectCreateSnapshotTask(ctx *Con
 			CreateTime:      time.Now(),
 			Description:     req.Description,
 		}
+
+		backing := obj.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo)
+		path := strings.TrimSuffix(backing.FilePath, ".vmdk") + "-" + snapshot.Id.Id + ".vmdk"
+		if fault := m.copyBacking(ctx, req.Datastore, backing.FilePath, path); fault != nil {
+			return nil, fault
+		}
+
+		if obj.snapshots == nil {
+			obj.snapshots = make(map[string]vStorageObjectSnapshot)
+		}
+		obj.snapshots[snapshot.Id.Id] = vStorageObjectSnapshot{
+			path:         path,
+			capacityInMB: obj.Config.CapacityInMB,
+			metadata:     slices.Clone(obj.Metadata),
+		}
 		obj.Snapshots = append(obj.Snapshots, snapshot)
 
 		return snapshot.Id, nil
@@ -354,6 +449,73 @@ func (m *VcenterVStorageObjectManager) VStorageObjectCreateSnapshotTask(ctx *Con
 	}
 }
 
+func (m *VcenterVStorageObjectManager) RetrieveSnapshotDetails(req *types.RetrieveSnapshotDetails) soap.HasFault {
+	body := new(methods.RetrieveSnapshotDetailsBody)
+
+	obj := m.object(req.Datastore, req.Id)
+	if obj == nil {
+		body.Fault_ = Fault("", new(types.InvalidArgument))
+		return body
+	}
+
+	snapshot, ok := obj.snapshots[req.SnapshotId.Id]
+	if !ok {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "snapshotId"})
+		return body
+	}
+
+	body.Res = &types.RetrieveSnapshotDetailsResponse{
+		Returnval: types.VStorageObjectSnapshotDetails{
+			Path: snapshot.path,
+		},
+	}
+
+	return body
+}
+
+// RevertVStorageObjectTask reverts the backing file, capacity and metadata of a VStorageObject to that of the given
+// snapshot. As with vCenter, the snapshots created after the given snapshot are deleted.
+func (m *VcenterVStorageObjectManager) RevertVStorageObjectTask(ctx *Context, req *types.RevertVStorageObject_Task) soap.HasFault {
+	task := CreateTask(m, "revertVStorageObject", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		obj := m.object(req.Datastore, req.Id)
+		if obj == nil {
+			return nil, new(types.InvalidArgument)
+		}
+
+		if len(obj.Config.ConsumerId) != 0 {
+			return nil, new(types.InvalidState)
+		}
+
+		snapshot, ok := obj.snapshots[req.SnapshotId.Id]
+		if !ok {
+			return nil, &types.InvalidArgument{InvalidProperty: "snapshotId"}
+		}
+
+		backing := obj.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo)
+		if fault := m.copyBacking(ctx, req.Datastore, snapshot.path, backing.FilePath); fault != nil {
+			return nil, fault
+		}
+
+		obj.Config.CapacityInMB = snapshot.capacityInMB
+		obj.Metadata = slices.Clone(snapshot.metadata)
+
+		i := slices.IndexFunc(obj.Snapshots, func(s types.VStorageObjectSnapshotInfoVStorageObjectSnapshot) bool {
+			return s.Id.Id == req.SnapshotId.Id
+		})
+		for _, s := range slices.Clone(obj.Snapshots[i+1:]) {
+			m.removeSnapshot(ctx, req.Datastore, obj, s.Id.Id)
+		}
+
+		return obj.VStorageObject, nil
+	})
+
+	return &methods.RevertVStorageObject_TaskBody{
+		Res: &types.RevertVStorageObject_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (m *VcenterVStorageObjectManager) ExtendDiskTask(ctx *Context, req *types.ExtendDisk_Task) soap.HasFault {
 	task := CreateTask(m, "extendDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		obj := m.object(req.Datastore, req.Id)
@@ -377,7 +539,7 @@ func (m *VcenterVStorageObjectManager) DeleteSnapshotTask(ctx *Context, req *typ
 		if obj != nil {
 			for i := range obj.Snapshots {
 				if *obj.Snapshots[i].Id == req.SnapshotId {
-					obj.Snapshots = append(obj.Snapshots[:i], obj.Snapshots[i+1:]...)
+					m.removeSnapshot(ctx, req.Datastore, obj, req.SnapshotId.Id)
 					return nil, nil
 				}
 			}
@@ -392,6 +554,119 @@ func (m *VcenterVStorageObjectManager) DeleteSnapshotTask(ctx *Context, req *typ
 	}
 }
 
+func (m *VcenterVStorageObjectManager) RetrieveVStorageObjectAssociations(ctx *Context, req *types.RetrieveVStorageObjectAssociations) soap.HasFault {
+	var res []types.VStorageObjectAssociations
+
+	for _, spec := range req.Ids {
+		assoc := types.VStorageObjectAssociations{Id: spec.Id}
+
+		if m.object(spec.Datastore, spec.Id) == nil {
+			assoc.Fault = &types.LocalizedMethodFault{Fault: &types.NotFound{}}
+			res = append(res, assoc)
+			continue
+		}
+
+		for _, obj := range ctx.Map.All("VirtualMachine") {
+			vm := obj.(*VirtualMachine)
+			ctx.WithLock(vm, func() {
+				for _, device := range vm.Config.Hardware.Device {
+					if disk, ok := device.(*types.VirtualDisk); ok && disk.VDiskId != nil && disk.VDiskId.Id == spec.Id.Id {
+						assoc.VmDiskAssociations = append(assoc.VmDiskAssociations, types.VStorageObjectAssociationsVmDiskAssociations{
+							VmId:    vm.Self.Value,
+							DiskKey: disk.Key,
+						})
+					}
+				}
+			})
+		}
+
+		res = append(res, assoc)
+	}
+
+	return &methods.RetrieveVStorageObjectAssociationsBody{
+		Res: &types.RetrieveVStorageObjectAssociationsResponse{
+			Returnval: res,
+		},
+	}
+}
+
+func (m *VcenterVStorageObjectManager) VCenterUpdateVStorageObjectMetadataExTask(ctx *Context, req *types.VCenterUpdateVStorageObjectMetadataEx_Task) soap.HasFault {
+	task := CreateTask(m, "updateVStorageObjectMetadataEx", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		obj := m.object(req.Datastore, req.Id)
+		if obj == nil {
+			return nil, new(types.InvalidArgument)
+		}
+
+		keys := make(map[string]bool)
+		for _, kv := range req.Metadata {
+			if kv.Key == "" || keys[kv.Key] {
+				return nil, &types.InvalidArgument{InvalidProperty: "metadata"}
+			}
+			keys[kv.Key] = true
+		}
+
+		var metadata []types.KeyValue
+		for _, kv := range obj.Metadata {
+			if !keys[kv.Key] && !slices.Contains(req.DeleteKeys, kv.Key) {
+				metadata = append(metadata, kv)
+			}
+		}
+		obj.Metadata = append(metadata, req.Metadata...)
+
+		return nil, nil
+	})
+
+	return &methods.VCenterUpdateVStorageObjectMetadataEx_TaskBody{
+		Res: &types.VCenterUpdateVStorageObjectMetadataEx_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// ObjectMetadata returns the metadata of the object with the given ID on any datastore,
+// or of its snapshot sid if not nil, limited to the keys with the given prefix.
+// The vslm simulator uses this method, as vslm APIs identify an object by ID alone.
+func (m *VcenterVStorageObjectManager) ObjectMetadata(id types.ID, sid *types.ID, prefix string) ([]types.KeyValue, types.BaseMethodFault) {
+	for _, objects := range m.objects {
+		obj, ok := objects[id]
+		if !ok {
+			continue
+		}
+
+		metadata := obj.Metadata
+		if sid != nil {
+			snapshot, ok := obj.snapshots[sid.Id]
+			if !ok {
+				return nil, &types.InvalidArgument{InvalidProperty: "snapshotId"}
+			}
+			metadata = snapshot.metadata
+		}
+
+		var res []types.KeyValue
+		for _, kv := range metadata {
+			if strings.HasPrefix(kv.Key, prefix) {
+				res = append(res, kv)
+			}
+		}
+
+		return res, nil
+	}
+
+	return nil, &types.NotFound{}
+}
+
+// Objects returns the objects on all datastores.
+// The vslm simulator uses this method, as vslm APIs query objects across datastores.
+func (m *VcenterVStorageObjectManager) Objects() []*VStorageObject {
+	var res []*VStorageObject
+	for _, objects := range m.objects {
+		for _, obj := range objects {
+			res = append(res, obj)
+		}
+	}
+	return res
+}
+
 func (m *VcenterVStorageObjectManager) tagID(id types.ID) types.ManagedObjectReference {
 	return types.ManagedObjectReference{
 		Type:  "fcd",
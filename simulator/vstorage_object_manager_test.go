@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vslm"
+)
+
+func TestVStorageObjectMetadata(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		m := vslm.NewObjectManager(c)
+		ds := Map.Any("Datastore").(*Datastore)
+
+		task, err := m.CreateDisk(ctx, types.VslmCreateSpec{
+			Name:         "pvc-0",
+			CapacityInMB: 10,
+			BackingSpec: &types.VslmCreateSpecDiskFileBackingSpec{
+				VslmCreateSpecBackingSpec: types.VslmCreateSpecBackingSpec{Datastore: ds.Self},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := task.WaitForResult(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := res.Result.(types.VStorageObject).Config.Id
+
+		update := func(metadata []types.KeyValue, deleteKeys ...string) error {
+			task, err := m.UpdateMetadata(ctx, ds, id.Id, metadata, deleteKeys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return task.Wait(ctx)
+		}
+
+		metadata := func() []types.KeyValue {
+			vsom := Map.Get(*c.ServiceContent.VStorageObjectManager).(*VcenterVStorageObjectManager)
+			return vsom.object(ds.Self, id).Metadata
+		}
+
+		err = update([]types.KeyValue{{Key: "owner", Value: "k8s"}, {Key: "pvc", Value: "pvc-0"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = update([]types.KeyValue{{Key: "pvc", Value: "pvc-1"}, {Key: "ns", Value: "default"}}, "owner")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expect := []types.KeyValue{{Key: "pvc", Value: "pvc-1"}, {Key: "ns", Value: "default"}}
+		if md := metadata(); !reflect.DeepEqual(md, expect) {
+			t.Errorf("metadata=%#v", md)
+		}
+
+		if err = update([]types.KeyValue{{Key: "ns", Value: "a"}, {Key: "ns", Value: "b"}}); err == nil {
+			t.Error("expected error") // duplicate key
+		}
+
+		task, err = m.UpdateMetadata(ctx, ds, "enoent", nil, []string{"ns"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err == nil {
+			t.Error("expected error")
+		}
+
+		// metadata can only be retrieved via the ESX or vslm endpoints
+		if _, err = m.RetrieveMetadata(ctx, ds, id.Id, "", ""); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestVStorageObjectSnapshots(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		m := vslm.NewObjectManager(c)
+		ds := Map.Any("Datastore").(*Datastore)
+		vsom := Map.Get(*c.ServiceContent.VStorageObjectManager).(*VcenterVStorageObjectManager)
+
+		task, err := m.CreateDisk(ctx, types.VslmCreateSpec{
+			Name:         "pvc-0",
+			CapacityInMB: 10,
+			BackingSpec: &types.VslmCreateSpecDiskFileBackingSpec{
+				VslmCreateSpecBackingSpec: types.VslmCreateSpecBackingSpec{Datastore: ds.Self},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := task.WaitForResult(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := res.Result.(types.VStorageObject).Config.Id
+
+		snapshot := func(desc string) string {
+			task, err := m.CreateSnapshot(ctx, ds, id.Id, desc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := task.WaitForResult(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return res.Result.(types.ID).Id
+		}
+
+		revert := func(sid string) error {
+			task, err := m.Revert(ctx, ds, id.Id, sid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return task.Wait(ctx)
+		}
+
+		task, err = m.UpdateMetadata(ctx, ds, id.Id, []types.KeyValue{{Key: "pvc", Value: "pvc-0"}}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		s0 := snapshot("s0")
+
+		task, err = m.ExtendDisk(ctx, ds, id.Id, 20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		vsom.object(ds.Self, id).Metadata = nil
+
+		s1 := snapshot("s1")
+
+		info, err := m.RetrieveSnapshotInfo(ctx, ds, id.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(info.Snapshots) != 2 || info.Snapshots[0].Description != "s0" {
+			t.Errorf("snapshots=%#v", info.Snapshots)
+		}
+
+		details, err := m.RetrieveSnapshotDetails(ctx, ds, id.Id, s0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file, _ := Map.FileManager().resolve(&Map.getEntityDatacenter(ds).Self, details.Path)
+		if _, err = os.Stat(file); err != nil {
+			t.Error(err)
+		}
+
+		if _, err = m.RetrieveSnapshotDetails(ctx, ds, id.Id, "enoent"); err == nil {
+			t.Error("expected error")
+		}
+
+		// revert to s0 restores capacity and metadata and removes s1
+		if err = revert(s0); err != nil {
+			t.Fatal(err)
+		}
+		obj := vsom.object(ds.Self, id)
+		if obj.Config.CapacityInMB != 10 || len(obj.Metadata) != 1 {
+			t.Errorf("capacity=%d metadata=%#v", obj.Config.CapacityInMB, obj.Metadata)
+		}
+		if len(obj.Snapshots) != 1 || obj.Snapshots[0].Id.Id != s0 {
+			t.Errorf("snapshots=%#v", obj.Snapshots)
+		}
+		if err = revert(s1); !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		// attach tracks the consumer and blocks revert
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+		if err = vm.AttachDisk(ctx, id.Id, object.NewDatastore(c, ds.Self), 0, nil); err != nil {
+			t.Fatal(err)
+		}
+		uuid := Map.Get(vm.Reference()).(*VirtualMachine).Config.Uuid
+		if ids := obj.Config.ConsumerId; len(ids) != 1 || ids[0].Id != uuid {
+			t.Errorf("consumer=%#v", ids)
+		}
+
+		assoc, err := m.RetrieveAssociations(ctx, []types.RetrieveVStorageObjSpec{
+			{Id: id, Datastore: ds.Self},
+			{Id: types.ID{Id: "enoent"}, Datastore: ds.Self},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(assoc) != 2 || len(assoc[0].VmDiskAssociations) != 1 || assoc[0].VmDiskAssociations[0].VmId != vm.Reference().Value {
+			t.Errorf("associations=%#v", assoc)
+		}
+		if assoc[1].Fault == nil {
+			t.Error("expected fault")
+		}
+
+		if err = revert(s0); !fault.Is(err, &types.InvalidState{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		if err = vm.DetachDisk(ctx, id.Id); err != nil {
+			t.Fatal(err)
+		}
+		if len(obj.Config.ConsumerId) != 0 {
+			t.Errorf("consumer=%#v", obj.Config.ConsumerId)
+		}
+
+		task, err = m.DeleteSnapshot(ctx, ds, id.Id, s0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if len(obj.Snapshots) != 0 {
+			t.Errorf("snapshots=%#v", obj.Snapshots)
+		}
+	})
+}
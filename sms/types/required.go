@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2014-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"reflect"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func init() {
+	types.AddRequiredFields(reflect.TypeOf((*BackingStoragePool)(nil)).Elem(), "Uuid", "Type", "CapacityInMB", "UsedSpaceInMB")
+	types.AddRequiredFields(reflect.TypeOf((*CertificateAuthorityFault)(nil)).Elem(), "FaultCode")
+	types.AddRequiredFields(reflect.TypeOf((*CertificateAuthorityFaultFault)(nil)).Elem(), "FaultCode")
+	types.AddRequiredFields(reflect.TypeOf((*CertificateNotTrusted)(nil)).Elem(), "Certificate")
+	types.AddRequiredFields(reflect.TypeOf((*CertificateNotTrustedFault)(nil)).Elem(), "Certificate")
+	types.AddRequiredFields(reflect.TypeOf((*DatastoreBackingPoolMapping)(nil)).Elem(), "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*DatastorePair)(nil)).Elem(), "Datastore1", "Datastore2")
+	types.AddRequiredFields(reflect.TypeOf((*EntityReference)(nil)).Elem(), "Id")
+	types.AddRequiredFields(reflect.TypeOf((*FailoverParam)(nil)).Elem(), "IsPlanned", "CheckOnly")
+	types.AddRequiredFields(reflect.TypeOf((*FailoverReplicationGroupRequestType)(nil)).Elem(), "This", "FailoverParam")
+	types.AddRequiredFields(reflect.TypeOf((*FailoverReplicationGroup_Task)(nil)).Elem(), "This", "FailoverParam")
+	types.AddRequiredFields(reflect.TypeOf((*FailoverReplicationGroup_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*FailoverSuccessResult)(nil)).Elem(), "NewState")
+	types.AddRequiredFields(reflect.TypeOf((*FaultDomainProviderMapping)(nil)).Elem(), "ActiveProvider")
+	types.AddRequiredFields(reflect.TypeOf((*FcStoragePort)(nil)).Elem(), "PortWwn", "NodeWwn")
+	types.AddRequiredFields(reflect.TypeOf((*FcoeStoragePort)(nil)).Elem(), "PortWwn", "NodeWwn")
+	types.AddRequiredFields(reflect.TypeOf((*GroupErrorResult)(nil)).Elem(), "Error")
+	types.AddRequiredFields(reflect.TypeOf((*GroupInfo)(nil)).Elem(), "GroupId")
+	types.AddRequiredFields(reflect.TypeOf((*GroupOperationResult)(nil)).Elem(), "GroupId")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidCertificate)(nil)).Elem(), "Certificate")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidCertificateFault)(nil)).Elem(), "Certificate")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidReplicationState)(nil)).Elem(), "CurrentState")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidReplicationStateFault)(nil)).Elem(), "CurrentState")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidSession)(nil)).Elem(), "SessionCookie")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidSessionFault)(nil)).Elem(), "SessionCookie")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidUrl)(nil)).Elem(), "Url")
+	types.AddRequiredFields(reflect.TypeOf((*InvalidUrlFault)(nil)).Elem(), "Url")
+	types.AddRequiredFields(reflect.TypeOf((*IscsiStoragePort)(nil)).Elem(), "Identifier")
+	types.AddRequiredFields(reflect.TypeOf((*LunHbaAssociation)(nil)).Elem(), "CanonicalName", "Hba")
+	types.AddRequiredFields(reflect.TypeOf((*NameValuePair)(nil)).Elem(), "ParameterName", "ParameterValue")
+	types.AddRequiredFields(reflect.TypeOf((*PointInTimeReplicaId)(nil)).Elem(), "Id")
+	types.AddRequiredFields(reflect.TypeOf((*PointInTimeReplicaInfo)(nil)).Elem(), "Id", "PitName", "TimeStamp")
+	types.AddRequiredFields(reflect.TypeOf((*PolicyAssociation)(nil)).Elem(), "Id", "PolicyId", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*PrepareFailoverReplicationGroupRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PrepareFailoverReplicationGroup_Task)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*PrepareFailoverReplicationGroup_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*PromoteParam)(nil)).Elem(), "IsPlanned")
+	types.AddRequiredFields(reflect.TypeOf((*PromoteReplicationGroupRequestType)(nil)).Elem(), "This", "PromoteParam")
+	types.AddRequiredFields(reflect.TypeOf((*PromoteReplicationGroup_Task)(nil)).Elem(), "This", "PromoteParam")
+	types.AddRequiredFields(reflect.TypeOf((*PromoteReplicationGroup_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*ProviderOutOfProvisioningResource)(nil)).Elem(), "ProvisioningResourceId")
+	types.AddRequiredFields(reflect.TypeOf((*ProviderOutOfProvisioningResourceFault)(nil)).Elem(), "ProvisioningResourceId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryAboutInfo)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryAboutInfoRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryAboutInfoResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*QueryActiveAlarm)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryActiveAlarmRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryArray)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryArrayAssociatedWithLun)(nil)).Elem(), "This", "CanonicalName")
+	types.AddRequiredFields(reflect.TypeOf((*QueryArrayAssociatedWithLunRequestType)(nil)).Elem(), "This", "CanonicalName")
+	types.AddRequiredFields(reflect.TypeOf((*QueryArrayRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryAssociatedBackingStoragePool)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryAssociatedBackingStoragePoolRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDatastoreBackingPoolMapping)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDatastoreBackingPoolMappingRequestType)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDatastoreBackingPoolMappingResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDatastoreCapability)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDatastoreCapabilityRequestType)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDrsMigrationCapabilityForPerformance)(nil)).Elem(), "This", "SrcDatastore", "DstDatastore")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDrsMigrationCapabilityForPerformanceEx)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDrsMigrationCapabilityForPerformanceExRequestType)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDrsMigrationCapabilityForPerformanceExResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDrsMigrationCapabilityForPerformanceRequestType)(nil)).Elem(), "This", "SrcDatastore", "DstDatastore")
+	types.AddRequiredFields(reflect.TypeOf((*QueryDrsMigrationCapabilityForPerformanceResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*QueryFaultDomain)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryFaultDomainRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryFileSystemAssociatedWithArray)(nil)).Elem(), "This", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryFileSystemAssociatedWithArrayRequestType)(nil)).Elem(), "This", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryHostAssociatedWithLun)(nil)).Elem(), "This", "Scsi3Id", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryHostAssociatedWithLunRequestType)(nil)).Elem(), "This", "Scsi3Id", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryLunAssociatedWithArray)(nil)).Elem(), "This", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryLunAssociatedWithArrayRequestType)(nil)).Elem(), "This", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryLunAssociatedWithPort)(nil)).Elem(), "This", "PortId", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryLunAssociatedWithPortRequestType)(nil)).Elem(), "This", "PortId", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryNfsDatastoreAssociatedWithFileSystem)(nil)).Elem(), "This", "FileSystemId", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryNfsDatastoreAssociatedWithFileSystemRequestType)(nil)).Elem(), "This", "FileSystemId", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryNotSupported)(nil)).Elem(), "RelatedEntityType")
+	types.AddRequiredFields(reflect.TypeOf((*QueryNotSupportedFault)(nil)).Elem(), "RelatedEntityType")
+	types.AddRequiredFields(reflect.TypeOf((*QueryPointInTimeReplica)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryPointInTimeReplicaRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryPortAssociatedWithArray)(nil)).Elem(), "This", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryPortAssociatedWithArrayRequestType)(nil)).Elem(), "This", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryPortAssociatedWithLun)(nil)).Elem(), "This", "Scsi3Id", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryPortAssociatedWithLunRequestType)(nil)).Elem(), "This", "Scsi3Id", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryPortAssociatedWithProcessor)(nil)).Elem(), "This", "ProcessorId", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryPortAssociatedWithProcessorRequestType)(nil)).Elem(), "This", "ProcessorId", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryProcessorAssociatedWithArray)(nil)).Elem(), "This", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryProcessorAssociatedWithArrayRequestType)(nil)).Elem(), "This", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryProvider)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryProviderInfo)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryProviderInfoRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryProviderInfoResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*QueryProviderRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryReplicationGroup)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryReplicationGroupInfo)(nil)).Elem(), "This", "RgFilter")
+	types.AddRequiredFields(reflect.TypeOf((*QueryReplicationGroupInfoRequestType)(nil)).Elem(), "This", "RgFilter")
+	types.AddRequiredFields(reflect.TypeOf((*QueryReplicationGroupRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryReplicationGroupSuccessResult)(nil)).Elem(), "RgInfo")
+	types.AddRequiredFields(reflect.TypeOf((*QueryReplicationPeer)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryReplicationPeerRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryReplicationPeerResult)(nil)).Elem(), "SourceDomain")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySessionManager)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySessionManagerRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySessionManagerResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySmsTaskInfo)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySmsTaskInfoRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySmsTaskInfoResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySmsTaskResult)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QuerySmsTaskResultRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryStorageContainer)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryStorageContainerRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryStorageManager)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryStorageManagerRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*QueryStorageManagerResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*QueryVmfsDatastoreAssociatedWithLun)(nil)).Elem(), "This", "Scsi3Id", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*QueryVmfsDatastoreAssociatedWithLunRequestType)(nil)).Elem(), "This", "Scsi3Id", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*RecoveredDevice)(nil)).Elem(), "SourceDeviceId", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*RecoveredDiskInfo)(nil)).Elem(), "DeviceKey", "DsUrl", "DiskPath")
+	types.AddRequiredFields(reflect.TypeOf((*RegisterProviderRequestType)(nil)).Elem(), "This", "ProviderSpec")
+	types.AddRequiredFields(reflect.TypeOf((*RegisterProvider_Task)(nil)).Elem(), "This", "ProviderSpec")
+	types.AddRequiredFields(reflect.TypeOf((*RegisterProvider_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*RelatedStorageArray)(nil)).Elem(), "ArrayId", "Active", "Manageable", "Priority")
+	types.AddRequiredFields(reflect.TypeOf((*ReplicaId)(nil)).Elem(), "Id")
+	types.AddRequiredFields(reflect.TypeOf((*ReplicaIntervalQueryResult)(nil)).Elem(), "FromDate", "ToDate", "Number")
+	types.AddRequiredFields(reflect.TypeOf((*ReplicationGroupData)(nil)).Elem(), "GroupId")
+	types.AddRequiredFields(reflect.TypeOf((*ReplicationTargetInfo)(nil)).Elem(), "TargetGroupId")
+	types.AddRequiredFields(reflect.TypeOf((*ReverseReplicateGroupRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*ReverseReplicateGroup_Task)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*ReverseReplicateGroup_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*ReverseReplicationSuccessResult)(nil)).Elem(), "NewGroupId")
+	types.AddRequiredFields(reflect.TypeOf((*SmsAboutInfo)(nil)).Elem(), "Name", "FullName", "Vendor", "ApiVersion", "InstanceUuid")
+	types.AddRequiredFields(reflect.TypeOf((*SmsProviderInfo)(nil)).Elem(), "Uid", "Name")
+	types.AddRequiredFields(reflect.TypeOf((*SmsProviderSpec)(nil)).Elem(), "Name")
+	types.AddRequiredFields(reflect.TypeOf((*SmsRefreshCACertificatesAndCRLsRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*SmsRefreshCACertificatesAndCRLs_Task)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*SmsRefreshCACertificatesAndCRLs_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*SmsTaskInfo)(nil)).Elem(), "Key", "Task", "State")
+	types.AddRequiredFields(reflect.TypeOf((*SourceGroupInfo)(nil)).Elem(), "State")
+	types.AddRequiredFields(reflect.TypeOf((*SourceGroupMemberInfo)(nil)).Elem(), "DeviceId")
+	types.AddRequiredFields(reflect.TypeOf((*StorageAlarm)(nil)).Elem(), "AlarmId", "AlarmType", "ObjectType", "Status", "AlarmTimeStamp", "MessageId")
+	types.AddRequiredFields(reflect.TypeOf((*StorageArray)(nil)).Elem(), "Name", "Uuid", "VendorId", "ModelId")
+	types.AddRequiredFields(reflect.TypeOf((*StorageCapability)(nil)).Elem(), "Uuid", "Name", "Description")
+	types.AddRequiredFields(reflect.TypeOf((*StorageContainer)(nil)).Elem(), "Uuid", "Name", "MaxVvolSizeInMB", "ProviderId", "ArrayId")
+	types.AddRequiredFields(reflect.TypeOf((*StorageFileSystem)(nil)).Elem(), "Uuid", "Info", "NativeSnapshotSupported", "ThinProvisioningStatus", "Type", "Version")
+	types.AddRequiredFields(reflect.TypeOf((*StorageFileSystemInfo)(nil)).Elem(), "FileServerName", "FileSystemPath")
+	types.AddRequiredFields(reflect.TypeOf((*StorageLun)(nil)).Elem(), "Uuid", "VSphereLunIdentifier", "VendorDisplayName", "CapacityInMB", "UsedSpaceInMB", "LunThinProvisioned", "DrsManagementPermitted", "ThinProvisioningStatus")
+	types.AddRequiredFields(reflect.TypeOf((*StoragePort)(nil)).Elem(), "Uuid", "Type")
+	types.AddRequiredFields(reflect.TypeOf((*StorageProcessor)(nil)).Elem(), "Uuid")
+	types.AddRequiredFields(reflect.TypeOf((*SyncOngoing)(nil)).Elem(), "Task")
+	types.AddRequiredFields(reflect.TypeOf((*SyncOngoingFault)(nil)).Elem(), "Task")
+	types.AddRequiredFields(reflect.TypeOf((*SyncReplicationGroupRequestType)(nil)).Elem(), "This", "PitName")
+	types.AddRequiredFields(reflect.TypeOf((*SyncReplicationGroupSuccessResult)(nil)).Elem(), "TimeStamp")
+	types.AddRequiredFields(reflect.TypeOf((*SyncReplicationGroup_Task)(nil)).Elem(), "This", "PitName")
+	types.AddRequiredFields(reflect.TypeOf((*SyncReplicationGroup_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*TargetDeviceId)(nil)).Elem(), "DomainId", "DeviceId")
+	types.AddRequiredFields(reflect.TypeOf((*TargetGroupInfo)(nil)).Elem(), "SourceInfo", "State")
+	types.AddRequiredFields(reflect.TypeOf((*TargetGroupMemberInfo)(nil)).Elem(), "ReplicaId", "SourceId", "TargetDatastore")
+	types.AddRequiredFields(reflect.TypeOf((*TargetToSourceInfo)(nil)).Elem(), "SourceGroupId")
+	types.AddRequiredFields(reflect.TypeOf((*TestFailoverReplicationGroupStartRequestType)(nil)).Elem(), "This", "TestFailoverParam")
+	types.AddRequiredFields(reflect.TypeOf((*TestFailoverReplicationGroupStart_Task)(nil)).Elem(), "This", "TestFailoverParam")
+	types.AddRequiredFields(reflect.TypeOf((*TestFailoverReplicationGroupStart_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*TestFailoverReplicationGroupStopRequestType)(nil)).Elem(), "This", "Force")
+	types.AddRequiredFields(reflect.TypeOf((*TestFailoverReplicationGroupStop_Task)(nil)).Elem(), "This", "Force")
+	types.AddRequiredFields(reflect.TypeOf((*TestFailoverReplicationGroupStop_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*UnregisterProviderRequestType)(nil)).Elem(), "This", "ProviderId")
+	types.AddRequiredFields(reflect.TypeOf((*UnregisterProvider_Task)(nil)).Elem(), "This", "ProviderId")
+	types.AddRequiredFields(reflect.TypeOf((*UnregisterProvider_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VVolId)(nil)).Elem(), "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderInfo)(nil)).Elem(), "Url")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderReconnectRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderReconnect_Task)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderReconnect_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderRefreshCertificateRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderRefreshCertificate_Task)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderRefreshCertificate_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderRevokeCertificateRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderRevokeCertificate_Task)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderRevokeCertificate_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderSpec)(nil)).Elem(), "Username", "Password", "Url")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderSyncRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderSync_Task)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VasaProviderSync_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VasaVirtualDiskId)(nil)).Elem(), "DiskId")
+	types.AddRequiredFields(reflect.TypeOf((*VirtualDiskKey)(nil)).Elem(), "VmInstanceUUID", "DeviceKey")
+	types.AddRequiredFields(reflect.TypeOf((*VirtualDiskMoId)(nil)).Elem(), "VmMoid", "DiskKey")
+	types.AddRequiredFields(reflect.TypeOf((*VirtualMachineFilePath)(nil)).Elem(), "DsUrl", "VmxPath")
+	types.AddRequiredFields(reflect.TypeOf((*VirtualMachineMoId)(nil)).Elem(), "VmMoid")
+	types.AddRequiredFields(reflect.TypeOf((*VirtualMachineUUID)(nil)).Elem(), "VmInstanceUUID")
+}
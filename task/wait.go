@@ -44,6 +44,18 @@ func (t taskProgress) Error() error {
 	return nil
 }
 
+func (t taskProgress) Event() progress.Event {
+	e := progress.Event{
+		Stage:   t.info.DescriptionId,
+		Percent: t.Percentage(),
+		Err:     t.Error(),
+	}
+	if t.info.Description != nil {
+		e.Message = t.info.Description.Message
+	}
+	return e
+}
+
 type taskCallback struct {
 	ch   chan<- progress.Report
 	info *types.TaskInfo
@@ -21,6 +21,7 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"path"
 	"time"
 
 	"github.com/vmware/govmomi/simulator"
@@ -29,6 +30,7 @@ import (
 	"github.com/vmware/govmomi/vapi/appliance/access/shell"
 	"github.com/vmware/govmomi/vapi/appliance/access/ssh"
 	"github.com/vmware/govmomi/vapi/appliance/shutdown"
+	"github.com/vmware/govmomi/vapi/appliance/update"
 	vapi "github.com/vmware/govmomi/vapi/simulator"
 )
 
@@ -46,6 +48,9 @@ type Handler struct {
 	ssh            ssh.Access
 	shell          shell.Access
 	shutdownConfig shutdown.Config
+	update         update.Info
+	pending        []update.Summary
+	staged         *update.Summary
 }
 
 // New creates a Handler instance
@@ -57,6 +62,20 @@ func New(u *url.URL) *Handler {
 		ssh:            ssh.Access{Enabled: false},
 		shell:          shell.Access{Enabled: false, Timeout: 0},
 		shutdownConfig: shutdown.Config{},
+		update: update.Info{
+			State:   update.StateUpdatesPending,
+			Version: "8.0.2.00000",
+		},
+		pending: []update.Summary{{
+			Version:     "8.0.2.00100",
+			Name:        update.Message{ID: "8.0.2.00100", DefaultMessage: "VC-8.0U2a"},
+			Description: update.Message{ID: "8.0.2.00100", DefaultMessage: "vCenter Server 8.0 Update 2a"},
+			Priority:    "HIGH",
+			Severity:    "CRITICAL",
+			UpdateType:  "Update",
+			ReleaseDate: time.Date(2023, time.October, 26, 0, 0, 0, 0, time.UTC),
+			Size:        6144,
+		}},
 	}
 }
 
@@ -67,6 +86,10 @@ func (h *Handler) Register(s *simulator.Service, r *simulator.Registry) {
 	s.HandleFunc(ssh.Path, h.sshAccess)
 	s.HandleFunc(shell.Path, h.shellAccess)
 	s.HandleFunc(shutdown.Path, h.shutdown)
+	s.HandleFunc(update.Path, h.updateInfo)
+	s.HandleFunc(update.PendingPath, h.updatePending)
+	s.HandleFunc(update.PendingPath+"/", h.updatePendingVersion)
+	s.HandleFunc(update.StagedPath, h.updateStaged)
 }
 
 func (h *Handler) decode(r *http.Request, w http.ResponseWriter, val interface{}) bool {
@@ -187,3 +210,115 @@ func (h *Handler) shutdown(w http.ResponseWriter, r *http.Request) {
 		http.NotFound(w, r)
 	}
 }
+
+func (h *Handler) updateInfo(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		vapi.StatusOK(w, h.update)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *Handler) updatePending(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		now := time.Now().UTC()
+		h.update.LatestQueryTime = &now
+		vapi.StatusOK(w, h.pending)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// updateTask completes the given operation immediately, reporting a succeeded task
+func (h *Handler) updateTask(op string) {
+	now := time.Now().UTC()
+	msg := update.Message{ID: "com.vmware.appliance.update." + op, DefaultMessage: op + " complete"}
+	h.update.Task = &update.Task{
+		Description: msg,
+		Operation:   op,
+		Status:      "SUCCEEDED",
+		Progress:    &update.Progress{Total: 100, Completed: 100, Message: msg},
+		StartTime:   &now,
+		EndTime:     &now,
+	}
+}
+
+func (h *Handler) updatePendingVersion(w http.ResponseWriter, r *http.Request) {
+	version := path.Base(r.URL.Path)
+
+	var pending *update.Summary
+	for i := range h.pending {
+		if h.pending[i].Version == version {
+			pending = &h.pending[i]
+		}
+	}
+	if pending == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		vapi.StatusOK(w, pending)
+	case http.MethodPost:
+		action := r.URL.Query().Get("action")
+		if action == "install" && h.staged == nil {
+			vapi.BadRequest(w, "com.vmware.vapi.std.errors.not_allowed_in_current_state")
+			return
+		}
+
+		switch action {
+		case "precheck":
+			vapi.StatusOK(w, update.PrecheckResult{
+				CheckTime:               time.Now().UTC(),
+				EstimatedTimeToInstall:  30,
+				EstimatedTimeToRollback: 15,
+				RebootRequired:          pending.RebootRequired,
+			})
+		case "stage":
+			h.staged = pending
+			h.updateTask(action)
+			w.WriteHeader(http.StatusNoContent)
+		case "install", "stage-and-install":
+			h.update.Version = pending.Version
+			h.update.State = update.StateUpToDate
+			h.pending = nil
+			h.staged = nil
+			h.updateTask(action)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *Handler) updateStaged(w http.ResponseWriter, r *http.Request) {
+	if h.staged == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		vapi.StatusOK(w, update.StagedInfo{
+			StagingComplete: true,
+			Version:         h.staged.Version,
+			Name:            h.staged.Name,
+			Description:     h.staged.Description,
+			Priority:        h.staged.Priority,
+			Severity:        h.staged.Severity,
+			UpdateType:      h.staged.UpdateType,
+			RebootRequired:  h.staged.RebootRequired,
+			Size:            h.staged.Size,
+		})
+	case http.MethodDelete:
+		h.staged = nil
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,304 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25/progress"
+)
+
+const (
+	Path        = "/api/appliance/update"
+	PendingPath = Path + "/pending"
+	StagedPath  = Path + "/staged"
+)
+
+// Update states, as reported by Info.State
+const (
+	StateUpToDate           = "UP_TO_DATE"
+	StateUpdatesPending     = "UPDATES_PENDING"
+	StateStageInProgress    = "STAGE_IN_PROGRESS"
+	StateInstallInProgress  = "INSTALL_IN_PROGRESS"
+	StateInstallFailed      = "INSTALL_FAILED"
+	StateRollbackInProgress = "ROLLBACK_IN_PROGRESS"
+)
+
+// Source types for Manager.Pending
+const (
+	SourceLocal          = "LOCAL"
+	SourceLocalAndOnline = "LOCAL_AND_ONLINE"
+	SourceLayered        = "LAYERED"
+)
+
+// Manager provides convenience methods for the appliance update API
+type Manager struct {
+	*rest.Client
+}
+
+// NewManager creates a new Manager
+func NewManager(client *rest.Client) *Manager {
+	return &Manager{
+		Client: client,
+	}
+}
+
+// Message is a localizable message
+type Message struct {
+	ID             string   `json:"id"`
+	DefaultMessage string   `json:"default_message"`
+	Args           []string `json:"args,omitempty"`
+}
+
+func (m Message) String() string {
+	return m.DefaultMessage
+}
+
+// Progress of a running update task
+type Progress struct {
+	Total     int64   `json:"total"`
+	Completed int64   `json:"completed"`
+	Message   Message `json:"message"`
+}
+
+// Task describes the most recent stage or install task
+type Task struct {
+	Description Message    `json:"description"`
+	Service     string     `json:"service,omitempty"`
+	Operation   string     `json:"operation,omitempty"`
+	Status      string     `json:"status"`
+	Progress    *Progress  `json:"progress,omitempty"`
+	Error       *Message   `json:"error,omitempty"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+	EndTime     *time.Time `json:"end_time,omitempty"`
+}
+
+// Info is the current update state of the appliance
+type Info struct {
+	State           string     `json:"state"`
+	Task            *Task      `json:"task,omitempty"`
+	Version         string     `json:"version"`
+	LatestQueryTime *time.Time `json:"latest_query_time,omitempty"`
+}
+
+// Summary describes an available update
+type Summary struct {
+	Version        string    `json:"version"`
+	Name           Message   `json:"name"`
+	Description    Message   `json:"description"`
+	Priority       string    `json:"priority"`
+	Severity       string    `json:"severity"`
+	UpdateType     string    `json:"update_type"`
+	ReleaseDate    time.Time `json:"release_date"`
+	RebootRequired bool      `json:"reboot_required"`
+	Size           int64     `json:"size"`
+}
+
+// Notifications groups precheck issues by severity
+type Notifications struct {
+	Info     []Notification `json:"info,omitempty"`
+	Warnings []Notification `json:"warnings,omitempty"`
+	Errors   []Notification `json:"errors,omitempty"`
+}
+
+// Notification is a single precheck issue
+type Notification struct {
+	ID         string   `json:"id"`
+	Time       string   `json:"time,omitempty"`
+	Message    Message  `json:"message"`
+	Resolution *Message `json:"resolution,omitempty"`
+}
+
+// PrecheckResult is the result of Manager.Precheck
+type PrecheckResult struct {
+	CheckTime               time.Time      `json:"check_time"`
+	EstimatedTimeToInstall  int64          `json:"estimated_time_to_install,omitempty"`
+	EstimatedTimeToRollback int64          `json:"estimated_time_to_rollback,omitempty"`
+	RebootRequired          bool           `json:"reboot_required"`
+	Issues                  *Notifications `json:"issues,omitempty"`
+}
+
+// Error returns an error listing any precheck issues with error severity
+func (r *PrecheckResult) Error() error {
+	if r.Issues == nil || len(r.Issues.Errors) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, len(r.Issues.Errors))
+	for i, issue := range r.Issues.Errors {
+		msgs[i] = issue.Message.String()
+	}
+
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+// StagedInfo describes the staged update
+type StagedInfo struct {
+	StagingComplete bool    `json:"staging_complete"`
+	Version         string  `json:"version"`
+	Name            Message `json:"name"`
+	Description     Message `json:"description"`
+	Priority        string  `json:"priority"`
+	Severity        string  `json:"severity"`
+	UpdateType      string  `json:"update_type"`
+	RebootRequired  bool    `json:"reboot_required"`
+	Size            int64   `json:"size"`
+}
+
+// Get returns the current update state of the appliance
+func (m *Manager) Get(ctx context.Context) (*Info, error) {
+	r := m.Resource(Path)
+
+	var info Info
+	return &info, m.Do(ctx, r.Request(http.MethodGet), &info)
+}
+
+// Pending returns the available updates from the given source type and optional repository URL
+func (m *Manager) Pending(ctx context.Context, source, url string) ([]Summary, error) {
+	r := m.Resource(PendingPath).WithParam("source_type", source)
+	if url != "" {
+		r = r.WithParam("url", url)
+	}
+
+	var res []Summary
+	return res, m.Do(ctx, r.Request(http.MethodGet), &res)
+}
+
+// Precheck runs the pre-update checks for the given version
+func (m *Manager) Precheck(ctx context.Context, version string) (*PrecheckResult, error) {
+	r := m.Resource(PendingPath).WithSubpath(version).WithParam("action", "precheck")
+
+	var res PrecheckResult
+	return &res, m.Do(ctx, r.Request(http.MethodPost), &res)
+}
+
+// Stage starts staging the given version
+func (m *Manager) Stage(ctx context.Context, version string) error {
+	r := m.Resource(PendingPath).WithSubpath(version).WithParam("action", "stage")
+
+	return m.Do(ctx, r.Request(http.MethodPost), nil)
+}
+
+type userData struct {
+	UserData map[string]string `json:"user_data,omitempty"`
+}
+
+// Install starts installing the staged version, with answers to any precheck questions in data
+func (m *Manager) Install(ctx context.Context, version string, data map[string]string) error {
+	r := m.Resource(PendingPath).WithSubpath(version).WithParam("action", "install")
+
+	return m.Do(ctx, r.Request(http.MethodPost, userData{data}), nil)
+}
+
+// StageAndInstall starts staging and then installing the given version
+func (m *Manager) StageAndInstall(ctx context.Context, version string, data map[string]string) error {
+	r := m.Resource(PendingPath).WithSubpath(version).WithParam("action", "stage-and-install")
+
+	return m.Do(ctx, r.Request(http.MethodPost, userData{data}), nil)
+}
+
+// Staged returns details of the staged update
+func (m *Manager) Staged(ctx context.Context) (*StagedInfo, error) {
+	r := m.Resource(StagedPath)
+
+	var info StagedInfo
+	return &info, m.Do(ctx, r.Request(http.MethodGet), &info)
+}
+
+// DeleteStaged removes the staged update
+func (m *Manager) DeleteStaged(ctx context.Context) error {
+	r := m.Resource(StagedPath)
+
+	return m.Do(ctx, r.Request(http.MethodDelete), nil)
+}
+
+// report implements progress.Report
+type report struct {
+	info *Info
+	err  error
+}
+
+func (r report) Percentage() float32 {
+	p := r.info.Task.Progress
+	if p == nil || p.Total == 0 {
+		return 0
+	}
+	return float32(p.Completed) * 100 / float32(p.Total)
+}
+
+func (r report) Detail() string {
+	if p := r.info.Task.Progress; p != nil {
+		return p.Message.String()
+	}
+	return ""
+}
+
+func (r report) Error() error {
+	return r.err
+}
+
+// Wait polls the update state at the given interval, until any stage or install task is no longer in progress.
+// Task progress is reported to the optional progress.Sinker.
+// An error is returned if the task failed.
+func (m *Manager) Wait(ctx context.Context, interval time.Duration, s ...progress.Sinker) (*Info, error) {
+	var ch chan<- progress.Report
+	if len(s) == 1 {
+		ch = s[0].Sink()
+		defer close(ch)
+	}
+
+	for {
+		info, err := m.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if info.Task != nil {
+			if info.Task.Status == "FAILED" || info.State == StateInstallFailed {
+				err = errors.New(info.State)
+				if info.Task.Error != nil {
+					err = fmt.Errorf("%s: %s", info.State, info.Task.Error)
+				}
+			}
+			if ch != nil {
+				ch <- report{info: info, err: err}
+			}
+		}
+
+		if err != nil {
+			return info, err
+		}
+
+		switch info.State {
+		case StateStageInProgress, StateInstallInProgress, StateRollbackInProgress:
+		default:
+			return info, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return info, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/appliance/update"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25"
+
+	_ "github.com/vmware/govmomi/vapi/appliance/simulator"
+	_ "github.com/vmware/govmomi/vapi/simulator"
+)
+
+func TestManager(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c := rest.NewClient(vc)
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+
+		m := update.NewManager(c)
+
+		info, err := m.Get(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.State != update.StateUpdatesPending {
+			t.Errorf("state=%s", info.State)
+		}
+
+		pending, err := m.Pending(ctx, update.SourceLocalAndOnline, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(pending) != 1 {
+			t.Fatalf("pending=%d", len(pending))
+		}
+		version := pending[0].Version
+
+		check, err := m.Precheck(ctx, version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = check.Error(); err != nil {
+			t.Error(err)
+		}
+
+		if err = m.Install(ctx, version, nil); err == nil {
+			t.Error("expected error installing before stage")
+		}
+
+		if err = m.Stage(ctx, version); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = m.Wait(ctx, 10*time.Millisecond); err != nil {
+			t.Fatal(err)
+		}
+
+		staged, err := m.Staged(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !staged.StagingComplete || staged.Version != version {
+			t.Errorf("staged=%#v", staged)
+		}
+
+		if err = m.Install(ctx, version, map[string]string{"vmdir.password": "pass"}); err != nil {
+			t.Fatal(err)
+		}
+		info, err = m.Wait(ctx, 10*time.Millisecond)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.State != update.StateUpToDate || info.Version != version {
+			t.Errorf("info=%#v", info)
+		}
+		if info.Task == nil || info.Task.Status != "SUCCEEDED" {
+			t.Errorf("task=%#v", info.Task)
+		}
+
+		pending, err = m.Pending(ctx, update.SourceLocalAndOnline, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(pending) != 0 {
+			t.Errorf("pending=%d", len(pending))
+		}
+	})
+}
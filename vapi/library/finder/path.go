@@ -214,3 +214,17 @@ func (f *PathFinder) ResolveLibraryItemStorage(ctx context.Context, storage []li
 
 	return nil
 }
+
+// ItemStorage returns the storage of a library item, with StorageURIs transformed to Datastore paths.
+func (f *PathFinder) ItemStorage(ctx context.Context, id string) ([]library.Storage, error) {
+	storage, err := f.m.ListLibraryItemStorage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = f.ResolveLibraryItemStorage(ctx, storage); err != nil {
+		return nil, err
+	}
+
+	return storage, nil
+}
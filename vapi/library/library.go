@@ -134,6 +134,62 @@ func (l *Library) Patch(src *Library) {
 	if src.Version != "" {
 		l.Version = src.Version
 	}
+	if src.Publication != nil {
+		if l.Publication == nil {
+			l.Publication = new(Publication)
+		}
+		l.Publication.Patch(src.Publication)
+	}
+	if src.Subscription != nil {
+		if l.Subscription == nil {
+			l.Subscription = new(Subscription)
+		}
+		l.Subscription.Patch(src.Subscription)
+	}
+}
+
+// Patch merges updates from the given src.
+func (p *Publication) Patch(src *Publication) {
+	if src.AuthenticationMethod != "" {
+		p.AuthenticationMethod = src.AuthenticationMethod
+	}
+	if src.UserName != "" {
+		p.UserName = src.UserName
+	}
+	if src.Password != "" {
+		p.Password = src.Password
+	}
+	if src.PersistJSON != nil {
+		p.PersistJSON = src.PersistJSON
+	}
+	if src.Published != nil {
+		p.Published = src.Published
+	}
+}
+
+// Patch merges updates from the given src.
+func (s *Subscription) Patch(src *Subscription) {
+	if src.AuthenticationMethod != "" {
+		s.AuthenticationMethod = src.AuthenticationMethod
+	}
+	if src.AutomaticSyncEnabled != nil {
+		s.AutomaticSyncEnabled = src.AutomaticSyncEnabled
+	}
+	if src.OnDemand != nil {
+		s.OnDemand = src.OnDemand
+	}
+	if src.Password != "" {
+		s.Password = src.Password
+	}
+	if src.SslThumbprint != "" {
+		s.SslThumbprint = src.SslThumbprint
+	}
+	if src.SubscriptionURL != "" {
+		s.SubscriptionURL = src.SubscriptionURL
+	}
+	if src.UserName != "" {
+		s.UserName = src.UserName
+	}
 }
 
 // Manager extends rest.Client, adding content library related methods.
@@ -236,6 +292,34 @@ func (c *Manager) UpdateLibrary(ctx context.Context, l *Library) error {
 	return c.Do(ctx, url.Request(http.MethodPatch, spec), nil)
 }
 
+// UpdateLibraryPublication updates the publication settings of a local library.
+// Only the non-empty fields of pub are changed.
+func (c *Manager) UpdateLibraryPublication(ctx context.Context, l *Library, pub *Publication) error {
+	spec := struct {
+		Library `json:"update_spec"`
+	}{
+		Library{
+			Publication: pub,
+		},
+	}
+	url := c.Resource(internal.LibraryPath).WithID(l.ID)
+	return c.Do(ctx, url.Request(http.MethodPatch, spec), nil)
+}
+
+// UpdateLibrarySubscription updates the subscription settings of a subscribed library.
+// Only the non-empty fields of sub are changed.
+func (c *Manager) UpdateLibrarySubscription(ctx context.Context, l *Library, sub *Subscription) error {
+	spec := struct {
+		Library `json:"update_spec"`
+	}{
+		Library{
+			Subscription: sub,
+		},
+	}
+	url := c.Resource(internal.LibraryPath).WithID(l.ID)
+	return c.Do(ctx, url.Request(http.MethodPatch, spec), nil)
+}
+
 // DeleteLibrary deletes an existing library.
 func (c *Manager) DeleteLibrary(ctx context.Context, library *Library) error {
 	path := internal.LocalLibraryPath
@@ -18,9 +18,19 @@ package library
 
 import (
 	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"io"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/vmware/govmomi/vapi/internal"
+	"github.com/vmware/govmomi/vim25/soap"
 )
 
 // Checksum provides checksum information on library item files.
@@ -55,3 +65,124 @@ func (c *Manager) GetLibraryItemFile(ctx context.Context, id, fileName string) (
 	var res File
 	return &res, c.Do(ctx, url.Request(http.MethodPost, spec), &res)
 }
+
+// Checksum validation status of a library item file.
+const (
+	ChecksumValid    = "VALID"
+	ChecksumInvalid  = "INVALID"
+	ChecksumNotFound = "NOT_FOUND" // the file has no stored checksum
+)
+
+var checksumAlgorithm = map[string]func() hash.Hash{
+	"MD5":    md5.New,
+	"SHA1":   sha1.New,
+	"SHA256": sha256.New,
+	"SHA512": sha512.New,
+}
+
+// FileChecksum is the result of validating the stored checksum of a library item file.
+type FileChecksum struct {
+	Name      string `json:"name"`
+	Algorithm string `json:"algorithm,omitempty"`
+	Expected  string `json:"expected,omitempty"`
+	Actual    string `json:"actual,omitempty"`
+	Status    string `json:"status"`
+}
+
+// ValidateLibraryItemChecksums downloads the files of a library item and validates
+// the content of each file against its stored checksum.
+func (c *Manager) ValidateLibraryItemChecksums(ctx context.Context, id string) ([]FileChecksum, error) {
+	files, err := c.ListLibraryItemFiles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := c.CreateLibraryItemDownloadSession(ctx, Session{LibraryItemID: id})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = c.DeleteLibraryItemDownloadSession(ctx, session)
+	}()
+
+	res := make([]FileChecksum, len(files))
+
+	for i, file := range files {
+		res[i] = FileChecksum{Name: file.Name, Status: ChecksumNotFound}
+
+		if file.Checksum == nil || file.Checksum.Checksum == "" {
+			continue
+		}
+
+		algorithm := file.Checksum.Algorithm
+		if algorithm == "" {
+			algorithm = "SHA1"
+		}
+		newHash, ok := checksumAlgorithm[algorithm]
+		if !ok {
+			return nil, fmt.Errorf("%s: unsupported checksum algorithm %q", file.Name, algorithm)
+		}
+
+		res[i].Algorithm = algorithm
+		res[i].Expected = file.Checksum.Checksum
+
+		src, err := c.prepareDownload(ctx, session, file.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		h := newHash()
+		if err = c.download(ctx, src, h); err != nil {
+			return nil, err
+		}
+
+		res[i].Actual = fmt.Sprintf("%x", h.Sum(nil))
+		res[i].Status = ChecksumValid
+		if res[i].Actual != res[i].Expected {
+			res[i].Status = ChecksumInvalid
+		}
+	}
+
+	return res, nil
+}
+
+// prepareDownload prepares the given file of a download session and waits for its download endpoint.
+func (c *Manager) prepareDownload(ctx context.Context, session, name string) (*url.URL, error) {
+	_, err := c.PrepareLibraryItemDownloadSessionFile(ctx, session, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		info, err := c.GetLibraryItemDownloadSessionFile(ctx, session, name)
+		if err != nil {
+			return nil, err
+		}
+
+		switch info.Status {
+		case "PREPARED":
+			return url.Parse(info.DownloadEndpoint.URI)
+		case "ERROR":
+			return nil, fmt.Errorf("%s: prepare failed: %v", name, info.ErrorMessage)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (c *Manager) download(ctx context.Context, src *url.URL, w io.Writer) error {
+	p := soap.DefaultDownload
+
+	r, _, err := c.Download(ctx, src, &p)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	_, err = io.Copy(w, r)
+	return err
+}
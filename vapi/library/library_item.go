@@ -31,6 +31,10 @@ const (
 	ItemTypeVMTX = "vm-template"
 )
 
+// DeployLibraryItemEvent is the EventEx.EventTypeId of the event posted when a VM or template is deployed
+// from a library item, where EventEx.ObjectId is the ID of the library item.
+const DeployLibraryItemEvent = "com.vmware.cl.DeployLibraryItemEvent"
+
 // Item provides methods to create, read, update, delete, and enumerate library items.
 type Item struct {
 	Cached           bool       `json:"cached,omitempty"`
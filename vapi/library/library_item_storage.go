@@ -51,3 +51,74 @@ func (c *Manager) GetLibraryItemStorage(ctx context.Context, id, fileName string
 	var res []Storage
 	return res, c.Do(ctx, url.Request(http.MethodPost, spec), &res)
 }
+
+// ItemStorageUsage is the storage consumed by a library item.
+type ItemStorageUsage struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Size  int64  `json:"size"`
+	Files int    `json:"files"`
+}
+
+// StorageUsage is the storage consumed by a library and each of its items.
+type StorageUsage struct {
+	ID    string             `json:"id"`
+	Name  string             `json:"name"`
+	Size  int64              `json:"size"`
+	Items []ItemStorageUsage `json:"items"`
+}
+
+// GetLibraryItemStorageUsage returns the storage consumed by the files of a library item.
+func (c *Manager) GetLibraryItemStorageUsage(ctx context.Context, id string) (*ItemStorageUsage, error) {
+	item, err := c.GetLibraryItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := c.ListLibraryItemStorage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := ItemStorageUsage{
+		ID:    item.ID,
+		Name:  item.Name,
+		Files: len(storage),
+	}
+
+	for _, s := range storage {
+		usage.Size += s.Size
+	}
+
+	return &usage, nil
+}
+
+// GetLibraryStorageUsage returns the storage consumed by a library and each of its items.
+func (c *Manager) GetLibraryStorageUsage(ctx context.Context, id string) (*StorageUsage, error) {
+	lib, err := c.GetLibraryByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.ListLibraryItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := StorageUsage{
+		ID:    lib.ID,
+		Name:  lib.Name,
+		Items: make([]ItemStorageUsage, 0, len(items)),
+	}
+
+	for _, item := range items {
+		u, err := c.GetLibraryItemStorageUsage(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		usage.Size += u.Size
+		usage.Items = append(usage.Items, *u)
+	}
+
+	return &usage, nil
+}
@@ -90,3 +90,74 @@ func TestManagerCreateLibrary(t *testing.T) {
 		}
 	})
 }
+
+func TestManagerUpdateLibrary(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c := rest.NewClient(vc)
+
+		err := c.Login(ctx, simulator.DefaultLogin)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ds, err := find.NewFinder(vc).DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := library.NewManager(c)
+
+		published := true
+		id, err := m.CreateLibrary(ctx, library.Library{
+			Name: "example",
+			Type: "LOCAL",
+			Storage: []library.StorageBacking{{
+				DatastoreID: ds.Reference().Value,
+				Type:        "DATASTORE",
+			}},
+			Publication: &library.Publication{Published: &published},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		l, err := m.GetLibraryByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// UpdateLibrary does not send the publication settings
+		l.Name = "renamed"
+		*l.Publication.Published = false
+		if err = m.UpdateLibrary(ctx, l); err != nil {
+			t.Fatal(err)
+		}
+
+		l, err = m.GetLibraryByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l.Name != "renamed" {
+			t.Errorf("name=%s", l.Name)
+		}
+		if l.Publication == nil || !*l.Publication.Published {
+			t.Errorf("publication=%#v", l.Publication)
+		}
+
+		published = false
+		if err = m.UpdateLibraryPublication(ctx, l, &library.Publication{Published: &published}); err != nil {
+			t.Fatal(err)
+		}
+
+		l, err = m.GetLibraryByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l.Name != "renamed" {
+			t.Errorf("name=%s", l.Name)
+		}
+		if l.Publication == nil || *l.Publication.Published {
+			t.Errorf("publication=%#v", l.Publication)
+		}
+	})
+}
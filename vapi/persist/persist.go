@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package persist defines the file format of saved vAPI inventory, such as tags and content libraries,
+// which is not available via the vim25 PropertyCollector.
+// The format is written by the 'govc object.save' command and loaded by the vAPI simulator.
+package persist
+
+import (
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// Dir is the directory within a saved simulator.Model used for vAPI state
+const Dir = "vapi"
+
+// TaggingFile and LibraryFile are the names of files within Dir.
+const (
+	TaggingFile = "tagging.json"
+	LibraryFile = "library.json"
+)
+
+// Tagging is the saved format of tag categories, tags and their attached objects
+type Tagging struct {
+	Categories   []tags.Category  `json:"categories"`
+	Tags         []tags.Tag       `json:"tags"`
+	Associations []TagAssociation `json:"associations"`
+}
+
+// TagAssociation is the list of objects a tag is attached to
+type TagAssociation struct {
+	TagID   string                         `json:"tag_id"`
+	Objects []types.ManagedObjectReference `json:"objects"`
+}
+
+// Library is the saved format of a content library and its items
+type Library struct {
+	Library     library.Library               `json:"library"`
+	Items       []LibraryItem                 `json:"items,omitempty"`
+	Subscribers map[string]library.Subscriber `json:"subscribers,omitempty"`
+}
+
+// LibraryItem is the saved format of a content library item and its files.
+// Template and VMTX are set by the simulator for items backed by a VM template.
+type LibraryItem struct {
+	Item     library.Item                  `json:"item"`
+	Files    []library.File                `json:"files,omitempty"`
+	Template *types.ManagedObjectReference `json:"template,omitempty"`
+	VMTX     *types.ManagedObjectReference `json:"vmtx,omitempty"`
+}
@@ -28,6 +28,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/vmware/govmomi/retry"
 	"github.com/vmware/govmomi/vapi/internal"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -39,7 +40,8 @@ type Client struct {
 	mu sync.Mutex
 
 	*soap.Client
-	sessionID string
+	sessionID   string
+	credentials CredentialProvider
 }
 
 // Session information
@@ -77,6 +79,67 @@ func (c *Client) SessionID(id ...string) string {
 	return c.sessionID
 }
 
+// CredentialProvider can be used to set the Authorization header of each request,
+// such as an OAuth2 bearer token, for vCenters fronted by a gateway or other auth schemes.
+// A request that already has an Authorization header, set by the caller or via WithHeader, is sent as-is.
+// The header is sent in addition to the vmware-api-session-id header, if a session ID is set.
+type CredentialProvider interface {
+	// Authorization returns the value of the Authorization header.
+	Authorization(ctx context.Context) (string, error)
+	// Invalidate is called when a request is rejected with http.StatusUnauthorized,
+	// after which the request is retried once with a new call to Authorization.
+	Invalidate()
+}
+
+// SetCredentialProvider sets the CredentialProvider used to authorize requests.
+// A nil value removes the provider.
+func (c *Client) SetCredentialProvider(p CredentialProvider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.credentials = p
+}
+
+func (c *Client) credentialProvider() CredentialProvider {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.credentials
+}
+
+type bearerToken struct {
+	mu     sync.Mutex
+	token  string
+	source func(context.Context) (string, error)
+}
+
+// NewBearerTokenProvider returns a CredentialProvider which sets the Authorization
+// header to "Bearer " followed by a token from the given source.
+// The token is cached until the server responds with http.StatusUnauthorized,
+// at which point source is called again to refresh the token.
+func NewBearerTokenProvider(source func(context.Context) (string, error)) CredentialProvider {
+	return &bearerToken{source: source}
+}
+
+func (b *bearerToken) Authorization(ctx context.Context) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.token == "" {
+		token, err := b.source(ctx)
+		if err != nil {
+			return "", err
+		}
+		b.token = token
+	}
+
+	return "Bearer " + b.token, nil
+}
+
+func (b *bearerToken) Invalidate() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.token = ""
+}
+
 type marshaledClient struct {
 	SoapClient *soap.Client
 	SessionID  string
@@ -147,6 +210,12 @@ func (c *Client) WithHeader(
 	return context.WithValue(ctx, headersContext{}, headers)
 }
 
+// WithRetry returns a new Context such that calls to a VAPI REST client with this context
+// are retried according to the given policy, see retry.WithPolicy.
+func (c *Client) WithRetry(ctx context.Context, policy retry.Policy) context.Context {
+	return retry.WithPolicy(ctx, policy)
+}
+
 type statusError struct {
 	res *http.Response
 }
@@ -155,6 +224,11 @@ func (e *statusError) Error() string {
 	return fmt.Sprintf("%s %s: %s", e.res.Request.Method, e.res.Request.URL, e.res.Status)
 }
 
+// StatusCode returns the HTTP response status code, see retry.IsStatus
+func (e *statusError) StatusCode() int {
+	return e.res.StatusCode
+}
+
 func IsStatusError(err error, code int) bool {
 	statusErr, ok := err.(*statusError)
 	if !ok || statusErr == nil || statusErr.res == nil {
@@ -171,6 +245,78 @@ type RawResponse struct {
 
 // Do sends the http.Request, decoding resBody if provided.
 func (c *Client) Do(ctx context.Context, req *http.Request, resBody interface{}) error {
+	p := c.credentialProvider()
+	if p != nil && hasAuthorization(ctx, req) {
+		p = nil // the caller's Authorization header takes precedence
+	}
+	policy := retry.FromContext(ctx)
+	if p == nil && policy == nil {
+		return c.do(ctx, req, resBody)
+	}
+
+	// The request may be sent more than once, buffer the body so it can be replayed.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		b, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(b)), nil
+		}
+	}
+
+	if policy == nil {
+		return c.doAuth(ctx, p, req, resBody)
+	}
+
+	return retry.WithRetry(ctx, policy, func(ctx context.Context) error {
+		return c.doAuth(ctx, p, req, resBody)
+	})
+}
+
+// hasAuthorization returns true if req or the headers of ctx (see WithHeader) include an Authorization header.
+func hasAuthorization(ctx context.Context, req *http.Request) bool {
+	if req.Header.Get("Authorization") != "" {
+		return true
+	}
+	headers, ok := ctx.Value(headersContext{}).(http.Header)
+	return ok && headers.Get("Authorization") != ""
+}
+
+// doAuth sends a copy of req, with an Authorization header if p is not nil.
+// If the request is unauthorized, p is invalidated and the request is sent once more.
+func (c *Client) doAuth(ctx context.Context, p CredentialProvider, req *http.Request, resBody interface{}) error {
+	for reauth := false; ; reauth = true {
+		r := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return err
+			}
+			r.Body = body
+		}
+
+		if p == nil {
+			return c.do(ctx, r, resBody)
+		}
+
+		auth, err := p.Authorization(ctx)
+		if err != nil {
+			return err
+		}
+		r.Header.Set("Authorization", auth)
+
+		err = c.do(ctx, r, resBody)
+		if !reauth && IsStatusError(err, http.StatusUnauthorized) {
+			p.Invalidate()
+			continue
+		}
+		return err
+	}
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request, resBody interface{}) error {
 	switch req.Method {
 	case http.MethodPost, http.MethodPatch, http.MethodPut:
 		req.Header.Set("Content-Type", "application/json")
@@ -246,37 +392,57 @@ func (c *Client) Do(ctx context.Context, req *http.Request, resBody interface{})
 }
 
 // authHeaders ensures the given map contains a REST auth header
-func (c *Client) authHeaders(h map[string]string) map[string]string {
-	if _, exists := h[internal.SessionCookieName]; exists {
-		return h
-	}
+func (c *Client) authHeaders(ctx context.Context, h map[string]string) (map[string]string, error) {
 	if h == nil {
 		h = make(map[string]string)
 	}
 
+	if p := c.credentialProvider(); p != nil {
+		if _, exists := h["Authorization"]; !exists {
+			auth, err := p.Authorization(ctx)
+			if err != nil {
+				return nil, err
+			}
+			h["Authorization"] = auth
+		}
+	}
+
+	if _, exists := h[internal.SessionCookieName]; exists {
+		return h, nil
+	}
+
 	h[internal.SessionCookieName] = c.SessionID()
 
-	return h
+	return h, nil
 }
 
 // Download wraps soap.Client.Download, adding the REST authentication header
 func (c *Client) Download(ctx context.Context, u *url.URL, param *soap.Download) (io.ReadCloser, int64, error) {
+	var err error
 	p := *param
-	p.Headers = c.authHeaders(p.Headers)
+	if p.Headers, err = c.authHeaders(ctx, p.Headers); err != nil {
+		return nil, 0, err
+	}
 	return c.Client.Download(ctx, u, &p)
 }
 
 // DownloadFile wraps soap.Client.DownloadFile, adding the REST authentication header
 func (c *Client) DownloadFile(ctx context.Context, file string, u *url.URL, param *soap.Download) error {
+	var err error
 	p := *param
-	p.Headers = c.authHeaders(p.Headers)
+	if p.Headers, err = c.authHeaders(ctx, p.Headers); err != nil {
+		return err
+	}
 	return c.Client.DownloadFile(ctx, file, u, &p)
 }
 
 // Upload wraps soap.Client.Upload, adding the REST authentication header
 func (c *Client) Upload(ctx context.Context, f io.Reader, u *url.URL, param *soap.Upload) error {
+	var err error
 	p := *param
-	p.Headers = c.authHeaders(p.Headers)
+	if p.Headers, err = c.authHeaders(ctx, p.Headers); err != nil {
+		return err
+	}
 	return c.Client.Upload(ctx, f, u, &p)
 }
 
@@ -19,16 +19,21 @@ package rest_test
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
+	"github.com/vmware/govmomi/retry"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vapi/internal"
 	"github.com/vmware/govmomi/vapi/rest"
 	_ "github.com/vmware/govmomi/vapi/simulator"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
 )
 
 func TestSession(t *testing.T) {
@@ -115,3 +120,134 @@ func TestWithHeaders(t *testing.T) {
 		}
 	})
 }
+
+func TestCredentialProvider(t *testing.T) {
+	var tokens []string
+
+	source := func(context.Context) (string, error) {
+		token := fmt.Sprintf("token-%d", len(tokens)+1)
+		tokens = append(tokens, token)
+		return token, nil
+	}
+
+	var body []string
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = append(body, string(b))
+		// token-1 is rejected to simulate an expired token
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`"ok"`))
+	}))
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	c := rest.NewClient(&vim25.Client{Client: soap.NewClient(u, true)})
+	c.SetCredentialProvider(rest.NewBearerTokenProvider(source))
+
+	var res string
+	req := c.Resource("/api/echo").Request(http.MethodPost, "hello")
+	if err = c.Do(ctx, req, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	if res != "ok" {
+		t.Errorf("res=%q", res)
+	}
+
+	if len(tokens) != 2 {
+		t.Errorf("tokens=%v", tokens)
+	}
+
+	if len(body) != 2 || body[0] != body[1] {
+		t.Errorf("expected request body to be replayed: %v", body)
+	}
+
+	// token-2 is cached and not refreshed
+	req = c.Resource("/api/echo").Request(http.MethodGet)
+	if err = c.Do(ctx, req, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tokens) != 2 {
+		t.Errorf("tokens=%v", tokens)
+	}
+
+	// the caller's Authorization header is not replaced
+	req = c.Resource("/api/echo").Request(http.MethodGet)
+	req.Header.Set("Authorization", "Bearer token-2")
+	if err = c.Do(ctx, req, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	req = c.Resource("/api/echo").Request(http.MethodGet)
+	hctx := c.WithHeader(ctx, http.Header{"Authorization": []string{"Bearer token-0"}})
+	if err = c.Do(hctx, req, &res); !rest.IsStatusError(err, http.StatusUnauthorized) {
+		t.Errorf("err=%v", err)
+	}
+
+	if len(tokens) != 2 {
+		t.Errorf("tokens=%v", tokens)
+	}
+
+	c.SetCredentialProvider(nil)
+
+	req = c.Resource("/api/echo").Request(http.MethodGet)
+	if err = c.Do(ctx, req, &res); !rest.IsStatusError(err, http.StatusUnauthorized) {
+		t.Errorf("err=%v", err)
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	var body []string
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = append(body, string(b))
+		// the first 2 attempts are rejected to simulate vpxd restarting
+		if len(body) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`"ok"`))
+	}))
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := rest.NewClient(&vim25.Client{Client: soap.NewClient(u, true)})
+
+	var res string
+	req := c.Resource("/api/echo").Request(http.MethodPost, "hello")
+	if err = c.Do(context.Background(), req, &res); !rest.IsStatusError(err, http.StatusServiceUnavailable) {
+		t.Errorf("err=%v", err)
+	}
+
+	body = nil
+	policy := retry.MaxAttempts(3, retry.On(retry.Constant(0), retry.IsServiceUnavailable))
+	ctx := c.WithRetry(context.Background(), policy)
+
+	req = c.Resource("/api/echo").Request(http.MethodPost, "hello")
+	if err = c.Do(ctx, req, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	if res != "ok" {
+		t.Errorf("res=%q", res)
+	}
+
+	if len(body) != 3 || body[0] != body[2] {
+		t.Errorf("expected request body to be replayed: %v", body)
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/internal"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/persist"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func persistFile(dir, name string) string {
+	return filepath.Join(dir, persist.Dir, name)
+}
+
+func writeJSON(path string, data any) error {
+	b, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0644)
+}
+
+// readJSON decodes the given file into data, if the file exists.
+func readJSON(path string, data any) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	return json.Unmarshal(b, data)
+}
+
+// Save implements simulator.Persister
+func (s *handler) Save(dir string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if err := os.MkdirAll(filepath.Join(dir, persist.Dir), 0755); err != nil {
+		return err
+	}
+
+	if err := writeJSON(persistFile(dir, persist.TaggingFile), s.taggingState()); err != nil {
+		return err
+	}
+
+	return writeJSON(persistFile(dir, persist.LibraryFile), s.libraryState())
+}
+
+func (s *handler) taggingState() persist.Tagging {
+	var state persist.Tagging
+
+	for _, c := range s.Category {
+		state.Categories = append(state.Categories, *c)
+	}
+	sort.Slice(state.Categories, func(i, j int) bool {
+		return state.Categories[i].ID < state.Categories[j].ID
+	})
+
+	for _, t := range s.Tag {
+		state.Tags = append(state.Tags, *t)
+	}
+	sort.Slice(state.Tags, func(i, j int) bool {
+		return state.Tags[i].ID < state.Tags[j].ID
+	})
+
+	for _, t := range state.Tags {
+		a := persist.TagAssociation{TagID: t.ID}
+		for obj, attached := range s.Association[t.ID] {
+			if attached {
+				a.Objects = append(a.Objects, obj.Reference())
+			}
+		}
+		if len(a.Objects) == 0 {
+			continue
+		}
+		sort.Slice(a.Objects, func(i, j int) bool {
+			return a.Objects[i].String() < a.Objects[j].String()
+		})
+		state.Associations = append(state.Associations, a)
+	}
+
+	return state
+}
+
+func (s *handler) libraryState() []persist.Library {
+	var state []persist.Library
+
+	for _, l := range s.Library {
+		ls := persist.Library{Library: *l.Library}
+
+		for _, i := range l.Item {
+			ls.Items = append(ls.Items, persist.LibraryItem{
+				Item:     *i.Item,
+				Files:    i.File,
+				Template: i.Template,
+				VMTX:     l.VMTX[i.ID],
+			})
+		}
+		sort.Slice(ls.Items, func(i, j int) bool {
+			return ls.Items[i].Item.ID < ls.Items[j].Item.ID
+		})
+
+		if len(l.Subs) != 0 {
+			ls.Subscribers = make(map[string]library.Subscriber, len(l.Subs))
+			for id, sub := range l.Subs {
+				ls.Subscribers[id] = *sub
+			}
+		}
+
+		state = append(state, ls)
+	}
+
+	sort.Slice(state, func(i, j int) bool {
+		return state[i].Library.ID < state[j].Library.ID
+	})
+
+	return state
+}
+
+// Load implements simulator.Persister
+func (s *handler) Load(dir string) error {
+	var tagging persist.Tagging
+	if err := readJSON(persistFile(dir, persist.TaggingFile), &tagging); err != nil {
+		return err
+	}
+
+	var libraries []persist.Library
+	if err := readJSON(persistFile(dir, persist.LibraryFile), &libraries); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.loadTagging(tagging)
+
+	return s.loadLibraries(libraries)
+}
+
+func (s *handler) loadTagging(state persist.Tagging) {
+
+	for i := range state.Categories {
+		c := state.Categories[i]
+		s.Category[c.ID] = &c
+	}
+
+	for i := range state.Tags {
+		t := state.Tags[i]
+		s.Tag[t.ID] = &t
+		s.Association[t.ID] = make(map[internal.AssociatedObject]bool)
+	}
+
+	for _, a := range state.Associations {
+		if _, ok := s.Association[a.TagID]; !ok {
+			continue
+		}
+		for _, obj := range a.Objects {
+			s.Association[a.TagID][internal.AssociatedObject{Type: obj.Type, Value: obj.Value}] = true
+		}
+	}
+}
+
+func (s *handler) loadLibraries(state []persist.Library) error {
+	for i := range state {
+		ls := state[i]
+		l := &content{
+			Library: &ls.Library,
+			Item:    make(map[string]*item),
+			Subs:    make(map[string]*library.Subscriber),
+			VMTX:    make(map[string]*types.ManagedObjectReference),
+		}
+
+		for j := range ls.Items {
+			is := ls.Items[j]
+			l.Item[is.Item.ID] = &item{
+				Item:     &is.Item,
+				File:     is.Files,
+				Template: is.Template,
+			}
+			if is.VMTX != nil {
+				l.VMTX[is.Item.ID] = is.VMTX
+			}
+		}
+
+		for id, sub := range ls.Subscribers {
+			sub := sub
+			l.Subs[id] = &sub
+		}
+
+		// File content is not saved by 'govc object.save', but the backing directories are needed
+		// in order to upload new files.
+		if len(l.Storage) != 0 {
+			ref := types.ManagedObjectReference{Type: "Datastore", Value: l.Storage[0].DatastoreID}
+			if _, ok := simulator.Map.Get(ref).(*simulator.Datastore); ok {
+				for id := range l.Item {
+					if err := os.MkdirAll(libraryPath(l.Library, id), 0750); err != nil {
+						return err
+					}
+				}
+				if err := os.MkdirAll(libraryPath(l.Library, ""), 0750); err != nil {
+					return err
+				}
+			}
+		}
+
+		s.Library[l.ID] = l
+	}
+
+	return nil
+}
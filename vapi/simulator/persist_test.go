@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25"
+
+	_ "github.com/vmware/govmomi/vapi/simulator"
+)
+
+func TestPersist(t *testing.T) {
+	dir := t.TempDir()
+
+	var tagID, libID, itemID string
+
+	login := func(ctx context.Context, vc *vim25.Client) *rest.Client {
+		c := rest.NewClient(vc)
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	m := simulator.VPX()
+	m.Persist = dir
+
+	err := m.Run(func(ctx context.Context, vc *vim25.Client) error {
+		c := login(ctx, vc)
+
+		vm, err := find.NewFinder(vc).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		ds, err := find.NewFinder(vc).DefaultDatastore(ctx)
+		if err != nil {
+			return err
+		}
+
+		tm := tags.NewManager(c)
+		cid, err := tm.CreateCategory(ctx, &tags.Category{Name: "region", Cardinality: "SINGLE"})
+		if err != nil {
+			return err
+		}
+		tagID, err = tm.CreateTag(ctx, &tags.Tag{CategoryID: cid, Name: "us-west"})
+		if err != nil {
+			return err
+		}
+		if err = tm.AttachTag(ctx, tagID, vm); err != nil {
+			return err
+		}
+
+		lm := library.NewManager(c)
+		libID, err = lm.CreateLibrary(ctx, library.Library{
+			Name: "persisted",
+			Type: "LOCAL",
+			Storage: []library.StorageBacking{{
+				DatastoreID: ds.Reference().Value,
+				Type:        "DATASTORE",
+			}},
+		})
+		if err != nil {
+			return err
+		}
+		itemID, err = lm.CreateLibraryItem(ctx, library.Item{Name: "item", Type: "ovf", LibraryID: libID})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m = simulator.VPX()
+	m.Persist = dir
+
+	err = m.Run(func(ctx context.Context, vc *vim25.Client) error {
+		c := login(ctx, vc)
+
+		tm := tags.NewManager(c)
+		tag, err := tm.GetTag(ctx, "us-west")
+		if err != nil {
+			return err
+		}
+		if tag.ID != tagID {
+			t.Errorf("tag=%s, expected %s", tag.ID, tagID)
+		}
+
+		objs, err := tm.ListAttachedObjects(ctx, tagID)
+		if err != nil {
+			return err
+		}
+		if len(objs) != 1 {
+			t.Errorf("attached=%v", objs)
+		}
+
+		lm := library.NewManager(c)
+		l, err := lm.GetLibraryByName(ctx, "persisted")
+		if err != nil {
+			return err
+		}
+		if l.ID != libID {
+			t.Errorf("library=%s, expected %s", l.ID, libID)
+		}
+
+		item, err := lm.GetLibraryItem(ctx, itemID)
+		if err != nil {
+			return err
+		}
+		if item.Name != "item" {
+			t.Errorf("item=%s", item.Name)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -40,6 +40,7 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -47,6 +48,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
 	"github.com/vmware/govmomi/nfc"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/ovf"
@@ -60,6 +62,7 @@ import (
 	"github.com/vmware/govmomi/view"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 	vim "github.com/vmware/govmomi/vim25/types"
@@ -95,6 +98,7 @@ type download struct {
 type handler struct {
 	sync.Mutex
 	sm          *simulator.SessionManager
+	now         func() time.Time
 	ServeMux    *http.ServeMux
 	URL         url.URL
 	Category    map[string]*tags.Category
@@ -115,6 +119,9 @@ func init() {
 			for _, p := range patterns {
 				s.Handle(p, h)
 			}
+			if err := s.Persist(h.(*handler)); err != nil {
+				log.Printf("failed to load vAPI state: %s", err)
+			}
 		}
 	})
 }
@@ -123,6 +130,7 @@ func init() {
 func New(u *url.URL, r *simulator.Registry) ([]string, http.Handler) {
 	s := &handler{
 		sm:          r.SessionManager(),
+		now:         r.Now,
 		ServeMux:    http.NewServeMux(),
 		URL:         *u,
 		Category:    make(map[string]*tags.Category),
@@ -188,7 +196,10 @@ func New(u *url.URL, r *simulator.Registry) ([]string, http.Handler) {
 		s.HandleFunc(h.p, h.m)
 	}
 
-	return []string{rest.Path + "/", vapi.Path + "/"}, s
+	// Published libraries use BASIC or no authentication, rather than a vAPI session
+	s.ServeMux.HandleFunc(vcspPath, s.vcsp)
+
+	return []string{rest.Path + "/", vapi.Path + "/", vcspPath}, s
 }
 
 func (s *handler) withClient(f func(context.Context, *vim25.Client) error) error {
@@ -266,7 +277,7 @@ func (s *handler) HandleFunc(pattern string, handler func(http.ResponseWriter, *
 		defer s.Unlock()
 
 		if !s.isAuthorized(r) {
-			w.WriteHeader(http.StatusUnauthorized)
+			unauthenticated(w, r)
 			return
 		}
 
@@ -287,7 +298,12 @@ func (s *handler) isAuthorized(r *http.Request) bool {
 	}
 	info, ok := s.Session[id]
 	if ok {
-		info.LastAccessed = time.Now()
+		now := s.now()
+		if simulator.SessionExpired(info.Created, info.LastAccessed, now) {
+			delete(s.Session, id)
+			return false
+		}
+		info.LastAccessed = now
 	} else {
 		_, ok = s.Update[id]
 	}
@@ -480,6 +496,43 @@ func ApiErrorUnsupported(w http.ResponseWriter) {
 	apiError(w, http.StatusBadRequest, "UNSUPPORTED")
 }
 
+// unauthenticated responds with http.StatusUnauthorized and a vAPI error of type unauthenticated,
+// such as when a session has expired. The error format depends on the "/api" or "/rest" endpoint.
+func unauthenticated(w http.ResponseWriter, r *http.Request) {
+	type message struct {
+		Args           []string `json:"args"`
+		DefaultMessage string   `json:"default_message"`
+		ID             string   `json:"id"`
+	}
+
+	msg := []message{{
+		Args:           []string{},
+		DefaultMessage: "This method requires authentication.",
+		ID:             "vapi.method.authentication.required",
+	}}
+
+	type value struct {
+		Messages []message `json:"messages"`
+	}
+
+	var body any
+	if strings.HasPrefix(r.URL.Path, vapi.Path) {
+		body = struct {
+			ErrorType string    `json:"error_type"`
+			Messages  []message `json:"messages"`
+		}{"UNAUTHENTICATED", msg}
+	} else {
+		body = struct {
+			Type  string `json:"type"`
+			Value value  `json:"value"`
+		}{"com.vmware.vapi.std.errors.unauthenticated", value{msg}}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
 func apiError(w http.ResponseWriter, statusCode int, errorType string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -526,7 +579,7 @@ func (s *handler) expiredSession(id string, now time.Time) bool {
 	s.Lock()
 	session, ok := s.Session[id]
 	if ok {
-		expired = now.Sub(session.LastAccessed) > simulator.SessionIdleTimeout
+		expired = simulator.SessionExpired(session.Created, session.LastAccessed, now)
 		if expired {
 			delete(s.Session, id)
 		}
@@ -545,17 +598,17 @@ func (s *handler) session(w http.ResponseWriter, r *http.Request) {
 			if session, ok := s.Session[id]; ok {
 				OK(w, session)
 			} else {
-				w.WriteHeader(http.StatusUnauthorized)
+				unauthenticated(w, r)
 			}
 			return
 		}
 		user, ok := s.hasAuthorization(r)
 		if !ok {
-			w.WriteHeader(http.StatusUnauthorized)
+			unauthenticated(w, r)
 			return
 		}
 		id = uuid.New().String()
-		now := time.Now()
+		now := s.now()
 		s.Session[id] = &rest.Session{User: user, Created: now, LastAccessed: now}
 		simulator.SessionIdleWatch(context.Background(), id, s.expiredSession)
 		if useHeaderAuthn != "true" {
@@ -883,6 +936,20 @@ func (s *handler) associationID(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// publishURL sets the PublishURL of a published library, as real vCenter does.
+func (s *handler) publishURL(l *library.Library) {
+	pub := l.Publication
+	if pub == nil || pub.Published == nil || !*pub.Published {
+		return
+	}
+
+	pub.PublishURL = (&url.URL{
+		Scheme: s.URL.Scheme,
+		Host:   s.URL.Host,
+		Path:   "/cls/vcsp/lib/" + l.ID,
+	}).String()
+}
+
 func (s *handler) library(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodPost:
@@ -935,15 +1002,7 @@ func (s *handler) library(w http.ResponseWriter, r *http.Request) {
 				VMTX:    make(map[string]*types.ManagedObjectReference),
 			}
 
-			pub := spec.Library.Publication
-			if pub != nil && pub.Published != nil && *pub.Published {
-				// Generate PublishURL as real vCenter does
-				pub.PublishURL = (&url.URL{
-					Scheme: s.URL.Scheme,
-					Host:   s.URL.Host,
-					Path:   "/cls/vcsp/lib/" + id,
-				}).String()
-			}
+			s.publishURL(&spec.Library)
 
 			sub := spec.Library.Subscription
 			if sub != nil {
@@ -951,6 +1010,11 @@ func (s *handler) library(w http.ResponseWriter, r *http.Request) {
 				pid := path.Base(sub.SubscriptionURL)
 				if p, ok := s.Library[pid]; ok {
 					s.Library[id].Item = p.Item
+				} else if err := s.syncLibrary(s.Library[id]); err != nil {
+					delete(s.Library, id)
+					_ = os.RemoveAll(dir)
+					s.error(w, err)
+					return
 				}
 			}
 
@@ -1006,7 +1070,7 @@ func (s *handler) publish(w http.ResponseWriter, r *http.Request, sids []interna
 		}
 
 		ds := &vcenter.DiskStorage{Datastore: l.Library.Storage[0].DatastoreID}
-		ref, err := s.cloneVM(vmtx.Template.Value, vmtx.Name, sub.Placement, ds)
+		ref, err := s.cloneTemplate(vmtx.Template.Value, vmtx.Name, sub.Placement, ds)
 		if err != nil {
 			s.error(w, err)
 			return false
@@ -1045,6 +1109,7 @@ func (s *handler) libraryID(w http.ResponseWriter, r *http.Request) {
 		}
 		if s.decode(r, w, &spec) {
 			l.Patch(&spec.Library)
+			s.publishURL(l.Library)
 			OK(w)
 		}
 	case http.MethodPost:
@@ -1064,14 +1129,30 @@ func (s *handler) libraryID(w http.ResponseWriter, r *http.Request) {
 			}
 			OK(w)
 		case "sync":
-			if l.Type == "SUBSCRIBED" {
-				l.LastSyncTime = types.NewTime(time.Now())
+			switch {
+			case s.isRemote(l):
+				if err := s.syncLibrary(l); err != nil {
+					s.error(w, err)
+					return
+				}
+				OK(w)
+			case l.Type == "SUBSCRIBED":
+				now := time.Now()
+				l.LastSyncTime = types.NewTime(now)
+				for _, item := range l.Item {
+					item.LastSyncTime = types.NewTime(now)
+				}
 				l.cached(true)
 				OK(w)
-			} else {
+			default:
 				http.NotFound(w, r)
 			}
 		case "evict":
+			if s.isRemote(l) {
+				for id := range l.Item {
+					_ = os.RemoveAll(libraryPath(l.Library, id))
+				}
+			}
 			l.cached(false)
 			OK(w)
 		}
@@ -1321,7 +1402,13 @@ func (s *handler) libraryItemID(w http.ResponseWriter, r *http.Request) {
 
 			OK(w, id)
 		case "sync":
-			if l.Type == "SUBSCRIBED" || l.Publication != nil {
+			if s.isRemote(l) {
+				if err := s.syncLibrary(l, id); err != nil {
+					s.error(w, err)
+					return
+				}
+				OK(w)
+			} else if l.Type == "SUBSCRIBED" || l.Publication != nil {
 				item.LastSyncTime = types.NewTime(time.Now())
 				item.cached(true)
 				OK(w)
@@ -1336,6 +1423,9 @@ func (s *handler) libraryItemID(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 		case "evict":
+			if s.isRemote(l) {
+				_ = os.RemoveAll(libraryPath(l.Library, id))
+			}
 			item.cached(false)
 			OK(w, id)
 		}
@@ -1941,11 +2031,12 @@ func (s *handler) libraryItemFileCreate(up *update, name string, body io.ReadClo
 		return err
 	}
 
-	var h hash.Hash
+	algorithm := "SHA1"
 	if hasChecksum(cs) {
-		h = checksum[cs.Algorithm]()
-		in = io.TeeReader(in, h)
+		algorithm = cs.Algorithm
 	}
+	h := checksum[algorithm]()
+	in = io.TeeReader(in, h)
 
 	n, err := io.Copy(file, in)
 	_ = body.Close()
@@ -1957,19 +2048,19 @@ func (s *handler) libraryItemFileCreate(up *update, name string, body io.ReadClo
 		return err
 	}
 
-	if h != nil {
-		sum := fmt.Sprintf("%x", h.Sum(nil))
-		if sum != cs.Checksum {
-			return fmt.Errorf("checksum mismatch: actual=%s, expected=%s", sum, cs.Checksum)
-		}
+	sum := fmt.Sprintf("%x", h.Sum(nil))
+	if hasChecksum(cs) && sum != cs.Checksum {
+		return fmt.Errorf("checksum mismatch: actual=%s, expected=%s", sum, cs.Checksum)
 	}
 
 	i := s.Library[up.Library.ID].Item[up.Session.LibraryItemID]
+	i.ContentVersion = incrementVersion(i.ContentVersion)
 	i.File = append(i.File, library.File{
-		Cached:  types.NewBool(true),
-		Name:    name,
-		Size:    types.NewInt64(n),
-		Version: "1",
+		Cached:   types.NewBool(true),
+		Checksum: &library.Checksum{Algorithm: algorithm, Checksum: sum},
+		Name:     name,
+		Size:     types.NewInt64(n),
+		Version:  "1",
 	})
 
 	return nil
@@ -2097,6 +2188,9 @@ func (s *handler) libraryDeploy(ctx context.Context, c *vim25.Client, lib *libra
 	}
 
 	name := item.ovf()
+	if name == "" {
+		return nil, fmt.Errorf("library item %q does not contain an OVF descriptor", item.Name)
+	}
 	desc, err := os.ReadFile(filepath.Join(libraryPath(lib, item.ID), name))
 	if err != nil {
 		return nil, err
@@ -2172,6 +2266,12 @@ func (s *handler) libraryDeploy(ctx context.Context, c *vim25.Client, lib *libra
 		return nil, errors.New(spec.Error[0].LocalizedMessage)
 	}
 
+	if deploy.DeploymentSpec.Annotation != "" {
+		if vmImportSpec, ok := spec.ImportSpec.(*types.VirtualMachineImportSpec); ok {
+			vmImportSpec.ConfigSpec.Annotation = deploy.DeploymentSpec.Annotation
+		}
+	}
+
 	if config != nil {
 		if vmImportSpec, ok := spec.ImportSpec.(*types.VirtualMachineImportSpec); ok {
 			var configSpecs []types.BaseVirtualDeviceConfigSpec
@@ -2270,16 +2370,16 @@ func (s *handler) libraryItemOVFID(w http.ResponseWriter, r *http.Request) {
 
 	id := s.id(r)
 	ok := false
-	var lib *library.Library
+	var lib *content
 	var item *item
 	for _, l := range s.Library {
-		if l.Library.Type == "SUBSCRIBED" {
+		if l.Library.Type == "SUBSCRIBED" && !s.isRemote(l) {
 			// Subscribers share the same Item map, we need the LOCAL library to find the .ovf on disk
 			continue
 		}
 		item, ok = l.Item[id]
 		if ok {
-			lib = l.Library
+			lib = l
 			break
 		}
 	}
@@ -2300,10 +2400,19 @@ func (s *handler) libraryItemOVFID(w http.ResponseWriter, r *http.Request) {
 	case "deploy":
 		var d vcenter.Deployment
 		err := s.withClient(func(ctx context.Context, c *vim25.Client) error {
-			info, err := s.libraryDeploy(ctx, c, lib, item, spec.Deploy)
+			if s.isRemote(lib) && !item.Cached {
+				// Download content on demand
+				if err := s.syncLibrary(lib, item.ID); err != nil {
+					return err
+				}
+			}
+			info, err := s.libraryDeploy(ctx, c, lib.Library, item, spec.Deploy)
 			if err != nil {
 				return err
 			}
+			if err = deployEvent(ctx, c, item.Item, info.Entity); err != nil {
+				return err
+			}
 			id := vcenter.ResourceID{
 				Type:  info.Entity.Type,
 				Value: info.Entity.Value,
@@ -2338,6 +2447,29 @@ func (s *handler) libraryItemOVFID(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// deployEvent posts the event for a VM or template deployed from a library item, allowing item usage to be tracked.
+func deployEvent(ctx context.Context, c *vim25.Client, item *library.Item, ref types.ManagedObjectReference) error {
+	var name string
+	if e, ok := simulator.Map.Get(ref).(mo.Entity); ok {
+		name = e.Entity().Name
+	}
+
+	return event.NewManager(c).PostEvent(ctx, &types.EventEx{
+		EventTypeId: library.DeployLibraryItemEvent,
+		Severity:    string(types.EventEventSeverityInfo),
+		ObjectId:    item.ID,
+		ObjectName:  item.Name,
+		ObjectType:  "com.vmware.content.library.Item",
+		Event: types.Event{
+			Vm: &types.VmEventArgument{
+				EntityEventArgument: types.EntityEventArgument{Name: name},
+				Vm:                  ref,
+			},
+			FullFormattedMessage: fmt.Sprintf("Deployed library item %s to %s", item.Name, name),
+		},
+	})
+}
+
 func (s *handler) deleteVM(ref *types.ManagedObjectReference) {
 	if ref == nil {
 		return
@@ -2359,39 +2491,73 @@ func (s *handler) reconfigVM(ref types.ManagedObjectReference, config types.Virt
 	})
 }
 
-func (s *handler) cloneVM(source string, name string, p *library.Placement, storage *vcenter.DiskStorage) (*types.ManagedObjectReference, error) {
-	var folder, pool, host, ds *types.ManagedObjectReference
-	if p.Folder != "" {
-		folder = &types.ManagedObjectReference{Type: "Folder", Value: p.Folder}
-	}
-	if p.ResourcePool != "" {
-		pool = &types.ManagedObjectReference{Type: "ResourcePool", Value: p.ResourcePool}
+// clonePlacement returns the destination folder and location of a clone.
+// The folder defaults to the folder of the source VM and the pool defaults to
+// the root ResourcePool of the Cluster or Host when not specified.
+func clonePlacement(ctx context.Context, c *vim25.Client, vm *object.VirtualMachine, p *library.Placement) (*object.Folder, types.VirtualMachineRelocateSpec, error) {
+	var spec types.VirtualMachineRelocateSpec
+	if p == nil {
+		p = new(library.Placement)
 	}
+
 	if p.Host != "" {
-		host = &types.ManagedObjectReference{Type: "HostSystem", Value: p.Host}
+		spec.Host = &types.ManagedObjectReference{Type: "HostSystem", Value: p.Host}
 	}
-	if storage != nil {
-		if storage.Datastore != "" {
-			ds = &types.ManagedObjectReference{Type: "Datastore", Value: storage.Datastore}
+
+	switch {
+	case p.ResourcePool != "":
+		spec.Pool = &types.ManagedObjectReference{Type: "ResourcePool", Value: p.ResourcePool}
+	case p.Cluster != "":
+		ref := types.ManagedObjectReference{Type: "ClusterComputeResource", Value: p.Cluster}
+		pool, err := object.NewComputeResource(c, ref).ResourcePool(ctx)
+		if err != nil {
+			return nil, spec, err
 		}
+		spec.Pool = types.NewReference(pool.Reference())
+	case spec.Host != nil:
+		pool, err := object.NewHostSystem(c, *spec.Host).ResourcePool(ctx)
+		if err != nil {
+			return nil, spec, err
+		}
+		spec.Pool = types.NewReference(pool.Reference())
 	}
 
-	spec := types.VirtualMachineCloneSpec{
-		Template: true,
-		Location: types.VirtualMachineRelocateSpec{
-			Folder:    folder,
-			Pool:      pool,
-			Host:      host,
-			Datastore: ds,
-		},
+	if p.Folder != "" {
+		return object.NewFolder(c, types.ManagedObjectReference{Type: "Folder", Value: p.Folder}), spec, nil
+	}
+
+	var mvm mo.VirtualMachine
+	if err := vm.Properties(ctx, vm.Reference(), []string{"parent"}, &mvm); err != nil {
+		return nil, spec, err
 	}
 
+	return object.NewFolder(c, *mvm.Parent), spec, nil
+}
+
+// datastoreRef returns the Datastore reference of the given storage spec, if any.
+func datastoreRef(storage *vcenter.DiskStorage) *types.ManagedObjectReference {
+	if storage == nil || storage.Datastore == "" {
+		return nil
+	}
+	return &types.ManagedObjectReference{Type: "Datastore", Value: storage.Datastore}
+}
+
+// cloneVM clones the source VM to the given placement, where spec.Location is merged with the resolved placement.
+func (s *handler) cloneVM(source string, name string, p *library.Placement, spec types.VirtualMachineCloneSpec) (*types.ManagedObjectReference, error) {
 	var ref *types.ManagedObjectReference
 
 	return ref, s.withClient(func(ctx context.Context, c *vim25.Client) error {
 		vm := object.NewVirtualMachine(c, types.ManagedObjectReference{Type: "VirtualMachine", Value: source})
 
-		task, err := vm.Clone(ctx, object.NewFolder(c, *folder), name, spec)
+		folder, location, err := clonePlacement(ctx, c, vm, p)
+		if err != nil {
+			return err
+		}
+		spec.Location.Folder = types.NewReference(folder.Reference())
+		spec.Location.Pool = location.Pool
+		spec.Location.Host = location.Host
+
+		task, err := vm.Clone(ctx, folder, name, spec)
 		if err != nil {
 			return err
 		}
@@ -2404,6 +2570,76 @@ func (s *handler) cloneVM(source string, name string, p *library.Placement, stor
 	})
 }
 
+// cloneTemplate clones the source VM as a VM template stored on the given datastore.
+func (s *handler) cloneTemplate(source string, name string, p *library.Placement, storage *vcenter.DiskStorage) (*types.ManagedObjectReference, error) {
+	spec := types.VirtualMachineCloneSpec{
+		Template: true,
+		Location: types.VirtualMachineRelocateSpec{
+			Datastore: datastoreRef(storage),
+		},
+	}
+
+	return s.cloneVM(source, name, p, spec)
+}
+
+// deployTemplate deploys a VM from the source VM template, honoring the placement and storage specs.
+func (s *handler) deployTemplate(source string, deploy vcenter.DeployTemplate) (*types.ManagedObjectReference, error) {
+	spec := types.VirtualMachineCloneSpec{
+		PowerOn: deploy.PoweredOn,
+		Location: types.VirtualMachineRelocateSpec{
+			Datastore: datastoreRef(deploy.VMHomeStorage),
+		},
+	}
+
+	if spec.Location.Datastore == nil {
+		spec.Location.Datastore = datastoreRef(deploy.DiskStorage)
+	}
+
+	if deploy.Description != "" {
+		spec.Config = &types.VirtualMachineConfigSpec{Annotation: deploy.Description}
+	}
+
+	err := s.withClient(func(ctx context.Context, c *vim25.Client) error {
+		vm := object.NewVirtualMachine(c, types.ManagedObjectReference{Type: "VirtualMachine", Value: source})
+
+		devices, err := vm.Device(ctx)
+		if err != nil {
+			return err
+		}
+
+		overrides := make(map[string]*types.ManagedObjectReference)
+		for _, o := range deploy.DiskStorageOverrides {
+			overrides[o.Key] = datastoreRef(&o.Value)
+		}
+
+		for _, disk := range devices.SelectByType((*types.VirtualDisk)(nil)) {
+			key := disk.GetVirtualDevice().Key
+			ds := datastoreRef(deploy.DiskStorage)
+			if ref, ok := overrides[strconv.Itoa(int(key))]; ok {
+				ds = ref
+				delete(overrides, strconv.Itoa(int(key)))
+			}
+			if ds != nil {
+				spec.Location.Disk = append(spec.Location.Disk, types.VirtualMachineRelocateSpecDiskLocator{
+					DiskId:    key,
+					Datastore: *ds,
+				})
+			}
+		}
+
+		for key := range overrides {
+			return fmt.Errorf("disk_storage_overrides: disk %q not found", key)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.cloneVM(source, deploy.Name, deploy.Placement, spec)
+}
+
 func (s *handler) libraryItemCreateTemplate(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		w.WriteHeader(http.StatusMethodNotAllowed)
@@ -2424,7 +2660,7 @@ func (s *handler) libraryItemCreateTemplate(w http.ResponseWriter, r *http.Reque
 	}
 
 	ds := &vcenter.DiskStorage{Datastore: l.Library.Storage[0].DatastoreID}
-	ref, err := s.cloneVM(spec.SourceVM, spec.Name, spec.Placement, ds)
+	ref, err := s.cloneTemplate(spec.SourceVM, spec.Name, spec.Placement, ds)
 	if err != nil {
 		BadRequest(w, err.Error())
 		return
@@ -2515,7 +2751,12 @@ func (s *handler) libraryItemTemplateID(w http.ResponseWriter, r *http.Request)
 		}
 
 		item.cached(true)
-		ref, err := s.cloneVM(item.Template.Value, spec.Name, p, spec.DiskStorage)
+		ref, err := s.deployTemplate(item.Template.Value, spec.DeployTemplate)
+		if err == nil {
+			err = s.withClient(func(ctx context.Context, c *vim25.Client) error {
+				return deployEvent(ctx, c, item.Item, *ref)
+			})
+		}
 		if err != nil {
 			BadRequest(w, err.Error())
 			return
@@ -2536,7 +2777,7 @@ func (s *handler) libraryItemCheckOuts(item *item, w http.ResponseWriter, r *htt
 			return
 		}
 
-		ref, err := s.cloneVM(item.Template.Value, spec.Name, spec.Placement, nil)
+		ref, err := s.cloneVM(item.Template.Value, spec.Name, spec.Placement, types.VirtualMachineCloneSpec{PowerOn: spec.PoweredOn})
 		if err != nil {
 			BadRequest(w, err.Error())
 			return
@@ -0,0 +1,541 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/library/finder"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vapi/vcenter"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+
+	_ "github.com/vmware/govmomi/vapi/simulator"
+)
+
+func TestDeployTemplateLibraryItem(t *testing.T) {
+	m := simulator.VPX()
+	m.Datastore = 2
+
+	err := m.Run(func(ctx context.Context, vc *vim25.Client) error {
+		c := rest.NewClient(vc)
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			return err
+		}
+
+		finder := find.NewFinder(vc)
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			return err
+		}
+		finder.SetDatacenter(dc)
+
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			return err
+		}
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			return err
+		}
+
+		cluster, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			return err
+		}
+
+		ds0, err := finder.Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			return err
+		}
+
+		ds1, err := finder.Datastore(ctx, "LocalDS_1")
+		if err != nil {
+			return err
+		}
+
+		libID, err := library.NewManager(c).CreateLibrary(ctx, library.Library{
+			Name: "templates",
+			Type: "LOCAL",
+			Storage: []library.StorageBacking{{
+				DatastoreID: ds0.Reference().Value,
+				Type:        "DATASTORE",
+			}},
+		})
+		if err != nil {
+			return err
+		}
+
+		vcm := vcenter.NewManager(c)
+
+		itemID, err := vcm.CreateTemplate(ctx, vcenter.Template{
+			Name:     "vmtx",
+			Library:  libID,
+			SourceVM: vm.Reference().Value,
+			Placement: &vcenter.Placement{
+				Folder:  folders.VmFolder.Reference().Value,
+				Cluster: cluster.Reference().Value,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		devices, err := vm.Device(ctx)
+		if err != nil {
+			return err
+		}
+		disk := devices.SelectByType((*types.VirtualDisk)(nil))[0]
+		key := strconv.Itoa(int(disk.GetVirtualDevice().Key))
+
+		deploy := vcenter.DeployTemplate{
+			Name:          "deployed",
+			Description:   "from vmtx",
+			PoweredOn:     true,
+			VMHomeStorage: &vcenter.DiskStorage{Datastore: ds0.Reference().Value},
+			DiskStorageOverrides: []vcenter.DiskStorageOverride{{
+				Key:   key,
+				Value: vcenter.DiskStorage{Datastore: ds1.Reference().Value},
+			}},
+			Placement: &vcenter.Placement{
+				Cluster: cluster.Reference().Value,
+			},
+		}
+
+		ref, err := vcm.DeployTemplateLibraryItem(ctx, itemID, deploy)
+		if err != nil {
+			return err
+		}
+
+		var clone mo.VirtualMachine
+		clone.Self = *ref
+		err = object.NewVirtualMachine(vc, *ref).Properties(ctx, *ref, []string{"config", "runtime", "parent", "resourcePool"}, &clone)
+		if err != nil {
+			return err
+		}
+
+		if clone.Config.Template {
+			t.Error("deployed VM is a template")
+		}
+		if clone.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+			t.Errorf("power state=%s", clone.Runtime.PowerState)
+		}
+		if clone.Config.Annotation != deploy.Description {
+			t.Errorf("annotation=%q", clone.Config.Annotation)
+		}
+		if *clone.Parent != folders.VmFolder.Reference() {
+			t.Errorf("parent=%s", clone.Parent)
+		}
+
+		pool, err := cluster.ResourcePool(ctx)
+		if err != nil {
+			return err
+		}
+		if *clone.ResourcePool != pool.Reference() {
+			t.Errorf("pool=%s", clone.ResourcePool)
+		}
+
+		if !strings.HasPrefix(clone.Config.Files.VmPathName, "[LocalDS_0]") {
+			t.Errorf("vmx=%s", clone.Config.Files.VmPathName)
+		}
+
+		disks := object.VirtualDeviceList(clone.Config.Hardware.Device).SelectByType((*types.VirtualDisk)(nil))
+		if len(disks) != 1 {
+			t.Fatalf("%d disks", len(disks))
+		}
+		backing := disks[0].GetVirtualDevice().Backing.(types.BaseVirtualDeviceFileBackingInfo).GetVirtualDeviceFileBackingInfo()
+		if *backing.Datastore != ds1.Reference() {
+			t.Errorf("disk=%s", backing.FileName)
+		}
+
+		// unknown disk key in overrides
+		deploy.Name = "invalid"
+		deploy.DiskStorageOverrides[0].Key = "1"
+		if _, err = vcm.DeployTemplateLibraryItem(ctx, itemID, deploy); err == nil {
+			t.Error("expected error")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSubscribedLibrarySync(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c := rest.NewClient(vc)
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+
+		ds, err := find.NewFinder(vc).DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		storage := []library.StorageBacking{{DatastoreID: ds.Reference().Value, Type: "DATASTORE"}}
+
+		m := library.NewManager(c)
+
+		pubID, err := m.CreateLibrary(ctx, library.Library{
+			Name:    "published",
+			Type:    "LOCAL",
+			Storage: storage,
+			Publication: &library.Publication{
+				AuthenticationMethod: "BASIC",
+				Password:             "secret",
+				Published:            types.NewBool(true),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pub, err := m.GetLibraryByID(ctx, pubID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		addItem := func(name, content string) string {
+			id, err := m.CreateLibraryItem(ctx, library.Item{Name: name, Type: library.ItemTypeISO, LibraryID: pubID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			session, err := m.CreateLibraryItemUpdateSession(ctx, library.Session{LibraryItemID: id})
+			if err != nil {
+				t.Fatal(err)
+			}
+			file := name + ".iso"
+			update, err := m.AddLibraryItemFile(ctx, session, library.UpdateFile{
+				Name:       file,
+				SourceType: "PUSH",
+				Size:       int64(len(content)),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			u, err := url.Parse(update.UploadEndpoint.URI)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p := soap.DefaultUpload
+			p.ContentLength = int64(len(content))
+			if err = c.Upload(ctx, strings.NewReader(content), u, &p); err != nil {
+				t.Fatal(err)
+			}
+			if err = m.CompleteLibraryItemUpdateSession(ctx, session); err != nil {
+				t.Fatal(err)
+			}
+			return id
+		}
+
+		addItem("one", "content of one")
+
+		// The publish URL path refers to this instance, use lib.json to sync over http as with a remote instance
+		sub := &library.Subscription{
+			AuthenticationMethod: "BASIC",
+			Password:             "invalid",
+			SubscriptionURL:      pub.Publication.PublishURL + "/lib.json",
+			OnDemand:             types.NewBool(true),
+		}
+		spec := library.Library{
+			Name:         "subscribed",
+			Type:         "SUBSCRIBED",
+			Storage:      storage,
+			Subscription: sub,
+		}
+
+		if _, err = m.CreateLibrary(ctx, spec); err == nil {
+			t.Fatal("expected error") // invalid password
+		}
+
+		sub.Password = "secret"
+		subID, err := m.CreateLibrary(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		items := func() map[string]library.Item {
+			ids, err := m.ListLibraryItems(ctx, subID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := make(map[string]library.Item)
+			for _, id := range ids {
+				item, err := m.GetLibraryItem(ctx, id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				res[item.Name] = *item
+			}
+			return res
+		}
+
+		one, ok := items()["one"]
+		if !ok {
+			t.Fatal("item not synced")
+		}
+		if one.Cached || one.SourceID == "" || one.Size != int64(len("content of one")) {
+			t.Errorf("on-demand item=%#v", one)
+		}
+
+		// download content on demand
+		if err = m.SyncLibraryItem(ctx, &one, true); err != nil {
+			t.Fatal(err)
+		}
+		one = items()["one"]
+		if !one.Cached {
+			t.Error("item content not cached")
+		}
+
+		var mds mo.Datastore
+		if err = ds.Properties(ctx, ds.Reference(), []string{"info"}, &mds); err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(mds.Info.GetDatastoreInfo().Url, "contentlib-"+subID, one.ID, "one.iso")
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "content of one" {
+			t.Errorf("content=%q", b)
+		}
+
+		// items added to and removed from the published library
+		two := addItem("two", "content of two")
+		if err = m.DeleteLibraryItem(ctx, &library.Item{ID: one.SourceID}); err != nil {
+			t.Fatal(err)
+		}
+
+		lib, err := m.GetLibraryByID(ctx, subID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = m.SyncLibrary(ctx, lib); err != nil {
+			t.Fatal(err)
+		}
+
+		synced := items()
+		if _, ok = synced["one"]; ok {
+			t.Error("deleted item was not removed")
+		}
+		if item, ok := synced["two"]; !ok || item.SourceID != two || item.Cached {
+			t.Errorf("item=%#v", item)
+		}
+
+		// published library items are not modified by subscribers
+		ids, err := m.ListLibraryItems(ctx, pubID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 1 || ids[0] != two {
+			t.Errorf("published items=%v", ids)
+		}
+	})
+}
+
+func TestSessionExpiry(t *testing.T) {
+	idle, ttl := simulator.SessionIdleTimeout, simulator.SessionTTL
+	simulator.SessionIdleTimeout, simulator.SessionTTL = 10*time.Minute, time.Hour
+	defer func() {
+		simulator.SessionIdleTimeout, simulator.SessionTTL = idle, ttl
+	}()
+
+	clock := simulator.NewManualClock(time.Now())
+	m := simulator.VPX()
+	m.Clock = clock
+
+	err := m.Run(func(ctx context.Context, vc *vim25.Client) error {
+		c := rest.NewClient(vc)
+		tm := tags.NewManager(c)
+
+		// expect the session to have expired, with an error body in the format of the endpoint
+		unauthenticated := func(endpoint, body string) {
+			t.Helper()
+
+			if _, err := tm.ListCategories(ctx); !rest.IsStatusError(err, http.StatusUnauthorized) {
+				t.Errorf("expected 401, got: %v", err)
+			}
+
+			u := c.URL()
+			u.Path = endpoint
+			req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("vmware-api-session-id", c.SessionID())
+			res, err := c.Client.Client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			b, _ := io.ReadAll(res.Body)
+			if res.StatusCode != http.StatusUnauthorized || !strings.Contains(string(b), body) {
+				t.Errorf("%s: %s", res.Status, b)
+			}
+		}
+
+		// idle timeout
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			return err
+		}
+		if _, err := tm.ListCategories(ctx); err != nil {
+			return err
+		}
+		clock.Advance(11 * time.Minute)
+		unauthenticated("/api/content/security-policies", `"error_type":"UNAUTHENTICATED"`)
+
+		// ttl, keeping the session active
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			return err
+		}
+		for i := 0; i < 6; i++ {
+			clock.Advance(9 * time.Minute)
+			if _, err := tm.ListCategories(ctx); err != nil {
+				return err
+			}
+		}
+		clock.Advance(9 * time.Minute)
+		unauthenticated("/rest/com/vmware/cis/tagging/category", `"type":"com.vmware.vapi.std.errors.unauthenticated"`)
+
+		// re-authentication
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			return err
+		}
+		_, err := tm.ListCategories(ctx)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLibraryItemChecksumAndUsage(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c := rest.NewClient(vc)
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+
+		ds, err := find.NewFinder(vc).DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := library.NewManager(c)
+
+		libID, err := m.CreateLibrary(ctx, library.Library{
+			Name:    "governance",
+			Type:    "LOCAL",
+			Storage: []library.StorageBacking{{DatastoreID: ds.Reference().Value, Type: "DATASTORE"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		content := "content of one"
+
+		id, err := m.CreateLibraryItem(ctx, library.Item{Name: "one", Type: library.ItemTypeISO, LibraryID: libID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		session, err := m.CreateLibraryItemUpdateSession(ctx, library.Session{LibraryItemID: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		update, err := m.AddLibraryItemFile(ctx, session, library.UpdateFile{
+			Name:       "one.iso",
+			SourceType: "PUSH",
+			Size:       int64(len(content)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := url.Parse(update.UploadEndpoint.URI)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := soap.DefaultUpload
+		p.ContentLength = int64(len(content))
+		if err = c.Upload(ctx, strings.NewReader(content), u, &p); err != nil {
+			t.Fatal(err)
+		}
+		if err = m.CompleteLibraryItemUpdateSession(ctx, session); err != nil {
+			t.Fatal(err)
+		}
+
+		sums, err := m.ValidateLibraryItemChecksums(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sums) != 1 || sums[0].Status != library.ChecksumValid || sums[0].Algorithm != "SHA1" {
+			t.Errorf("checksums=%#v", sums)
+		}
+
+		usage, err := m.GetLibraryStorageUsage(ctx, libID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if usage.Size != int64(len(content)) || len(usage.Items) != 1 || usage.Items[0].Files != 1 {
+			t.Errorf("usage=%#v", usage)
+		}
+
+		storage, err := m.ListLibraryItemStorage(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		paths, err := finder.NewPathFinder(m, vc).ItemStorage(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(paths) != 1 || !strings.HasPrefix(paths[0].StorageURIs[0], "[LocalDS_0] contentlib-") {
+			t.Errorf("paths=%#v", paths)
+		}
+
+		// corrupt the backing file
+		if err = os.WriteFile(storage[0].StorageURIs[0], []byte("tampered"), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		sums, err = m.ValidateLibraryItemChecksums(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sums) != 1 || sums[0].Status != library.ChecksumInvalid || sums[0].Actual == sums[0].Expected {
+			t.Errorf("checksums=%#v", sums)
+		}
+	})
+}
@@ -0,0 +1,455 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// vcspPath is the path of published libraries, served using the VMware Content Subscription Protocol (VCSP).
+// Libraries published by this simulator or by another vCenter or vcsim instance can be subscribed to.
+const vcspPath = "/cls/vcsp/lib/"
+
+// vcspUser is the user name of published libraries using BASIC authentication
+const vcspUser = "vcsp"
+
+// vcspLibrary is the VCSP lib.json format
+type vcspLibrary struct {
+	VcspVersion    string    `json:"vcspVersion"`
+	Version        string    `json:"version"`
+	ContentVersion string    `json:"contentVersion"`
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Created        time.Time `json:"created"`
+	ItemsHref      string    `json:"itemsHref"`
+}
+
+// vcspItems is the VCSP items.json format
+type vcspItems struct {
+	Items []vcspItem `json:"items"`
+}
+
+type vcspItem struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Type           string     `json:"type"`
+	Description    string     `json:"description,omitempty"`
+	Version        string     `json:"version"`
+	ContentVersion string     `json:"contentVersion"`
+	Created        time.Time  `json:"created"`
+	SelfHref       string     `json:"selfHref"`
+	Files          []vcspFile `json:"files"`
+}
+
+type vcspFile struct {
+	Name  string   `json:"name"`
+	Size  int64    `json:"size"`
+	Hrefs []string `json:"hrefs"`
+}
+
+func vcspID(id string) string {
+	return "urn:uuid:" + id
+}
+
+func vcspVersion(v string) string {
+	if v == "" {
+		return "1"
+	}
+	return v
+}
+
+// incrementVersion returns the next version of the given library or item version.
+func incrementVersion(v string) string {
+	n, _ := strconv.Atoi(v)
+	return strconv.Itoa(n + 1)
+}
+
+func newVCSPItem(i *item) vcspItem {
+	item := vcspItem{
+		ID:             vcspID(i.ID),
+		Name:           i.Name,
+		Type:           "vcsp." + i.Type,
+		Version:        vcspVersion(i.MetadataVersion),
+		ContentVersion: vcspVersion(i.ContentVersion),
+		SelfHref:       i.ID + "/item.json",
+	}
+	if i.Description != nil {
+		item.Description = *i.Description
+	}
+	if i.CreationTime != nil {
+		item.Created = *i.CreationTime
+	}
+	for _, f := range i.File {
+		file := vcspFile{
+			Name:  f.Name,
+			Hrefs: []string{i.ID + "/" + f.Name},
+		}
+		if f.Size != nil {
+			file.Size = *f.Size
+		}
+		item.Files = append(item.Files, file)
+	}
+	return item
+}
+
+// vcsp serves the lib.json, items.json, item.json and file content of published libraries.
+func (s *handler) vcsp(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	p := strings.Split(strings.TrimPrefix(r.URL.Path, vcspPath), "/")
+
+	l, ok := s.Library[p[0]]
+	if !ok || l.Publication == nil || l.Publication.Published == nil || !*l.Publication.Published {
+		http.NotFound(w, r)
+		return
+	}
+
+	if l.Publication.AuthenticationMethod == "BASIC" {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != vcspUser || pass != l.Publication.Password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="vcsp"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+
+	switch len(p) {
+	case 1:
+		p = append(p, "lib.json")
+		fallthrough
+	case 2:
+		switch p[1] {
+		case "lib.json":
+			lib := vcspLibrary{
+				VcspVersion:    "2",
+				Version:        vcspVersion(l.Version),
+				ContentVersion: vcspVersion(l.Version),
+				ID:             vcspID(l.ID),
+				Name:           l.Name,
+				ItemsHref:      "items.json",
+			}
+			if l.CreationTime != nil {
+				lib.Created = *l.CreationTime
+			}
+			StatusOK(w, lib)
+		case "items.json":
+			items := vcspItems{Items: []vcspItem{}}
+			for _, i := range l.Item {
+				if i.Type == library.ItemTypeVMTX {
+					continue // VM templates are published using subscriptions
+				}
+				items.Items = append(items.Items, newVCSPItem(i))
+			}
+			StatusOK(w, items)
+		default:
+			http.NotFound(w, r)
+		}
+	case 3:
+		i, ok := l.Item[p[1]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if p[2] == "item.json" {
+			StatusOK(w, newVCSPItem(i))
+			return
+		}
+		for _, f := range i.File {
+			if f.Name == p[2] {
+				http.ServeFile(w, r, path.Join(libraryPath(l.Library, i.ID), f.Name))
+				return
+			}
+		}
+		http.NotFound(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// vcspClient is used by subscribed libraries to access a published library.
+type vcspClient struct {
+	*http.Client
+	sub *library.Subscription
+}
+
+func newVCSPClient(sub *library.Subscription) *vcspClient {
+	return &vcspClient{
+		Client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		},
+		sub: sub,
+	}
+}
+
+func (c *vcspClient) get(u *url.URL) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.sub.AuthenticationMethod == "BASIC" {
+		user := c.sub.UserName
+		if user == "" {
+			user = vcspUser
+		}
+		req.SetBasicAuth(user, c.sub.Password)
+	}
+
+	res, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		_ = res.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", u, res.Status)
+	}
+
+	return res, nil
+}
+
+func (c *vcspClient) getJSON(u *url.URL, val any) error {
+	res, err := c.get(u)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return json.NewDecoder(res.Body).Decode(val)
+}
+
+// items returns the published library's items, along with the URL used to resolve the items' relative hrefs.
+func (c *vcspClient) items() (*url.URL, []vcspItem, error) {
+	u, err := url.Parse(c.sub.SubscriptionURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if path.Ext(u.Path) != ".json" {
+		u.Path += "/lib.json"
+	}
+
+	var lib vcspLibrary
+	if err = c.getJSON(u, &lib); err != nil {
+		return nil, nil, err
+	}
+
+	u, err = u.Parse(lib.ItemsHref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items vcspItems
+	if err = c.getJSON(u, &items); err != nil {
+		return nil, nil, err
+	}
+
+	return u, items.Items, nil
+}
+
+// download saves the item's files to dir.
+func (c *vcspClient) download(base *url.URL, item vcspItem, dir string) error {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+
+	for _, f := range item.Files {
+		if len(f.Hrefs) == 0 {
+			return fmt.Errorf("item %q file %q has no href", item.Name, f.Name)
+		}
+
+		u, err := base.Parse(f.Hrefs[0])
+		if err != nil {
+			return err
+		}
+
+		res, err := c.get(u)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Create(path.Join(dir, path.Base(f.Name)))
+		if err == nil {
+			_, err = io.Copy(file, res.Body)
+			if cerr := file.Close(); err == nil {
+				err = cerr
+			}
+		}
+		_ = res.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isRemote returns true if l is subscribed to a library that is not published by this simulator instance.
+// Libraries subscribed to a local published library share the publisher's items.
+func (s *handler) isRemote(l *content) bool {
+	if l.Subscription == nil {
+		return false
+	}
+	_, ok := s.Library[path.Base(l.Subscription.SubscriptionURL)]
+	return !ok
+}
+
+// syncItem is the result of synchronizing a published item
+type syncItem struct {
+	vcspItem
+	id     string // subscribed item ID
+	cached bool   // subscribed item content is current
+}
+
+// syncState is the state of a subscribed item prior to synchronization
+type syncState struct {
+	id      string
+	cached  bool
+	version string
+}
+
+// pull fetches the published items and downloads item content to the subscribed library l.
+func pull(l *library.Library, current map[string]syncState, force []string) ([]syncItem, error) {
+	c := newVCSPClient(l.Subscription)
+	onDemand := l.Subscription.OnDemand != nil && *l.Subscription.OnDemand
+
+	base, items, err := c.items()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]syncItem, len(items))
+
+	for i, pi := range items {
+		ci, ok := current[strings.TrimPrefix(pi.ID, "urn:uuid:")]
+		if !ok {
+			ci.id = uuid.New().String()
+		}
+
+		cached := ci.cached && ci.version == pi.ContentVersion
+		download := !cached && (!onDemand || ci.cached || slices.Contains(force, ci.id))
+
+		if download {
+			if err := c.download(base, pi, libraryPath(l, ci.id)); err != nil {
+				return nil, err
+			}
+		}
+
+		res[i] = syncItem{vcspItem: pi, id: ci.id, cached: cached || download}
+	}
+
+	return res, nil
+}
+
+// syncLibrary synchronizes the items of a library subscribed to a remote published library.
+// Item metadata is always synchronized. Item content is downloaded if the subscription is not on-demand,
+// if stale content was cached, or if the subscribed item ID is one of force.
+// The handler lock must be held by the caller and is released while communicating with the publisher,
+// which may be this same simulator instance.
+func (s *handler) syncLibrary(l *content, force ...string) error {
+	current := make(map[string]syncState, len(l.Item))
+	for _, i := range l.Item {
+		current[i.SourceID] = syncState{id: i.ID, cached: i.Cached, version: i.ContentVersion}
+	}
+	lib := *l.Library
+
+	s.Unlock()
+	items, err := pull(&lib, current, force)
+	s.Lock()
+
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool)
+
+	for _, si := range items {
+		seen[si.id] = true
+
+		i, ok := l.Item[si.id]
+		if !ok {
+			i = &item{Item: &library.Item{
+				ID:           si.id,
+				LibraryID:    l.ID,
+				SourceID:     strings.TrimPrefix(si.ID, "urn:uuid:"),
+				CreationTime: types.NewTime(now),
+			}}
+			l.Item[si.id] = i
+		}
+
+		i.Name = si.Name
+		desc := si.Description
+		i.Description = &desc
+		i.Type = strings.TrimPrefix(si.Type, "vcsp.")
+		i.MetadataVersion = si.Version
+		i.ContentVersion = si.ContentVersion
+		i.Version = si.ContentVersion
+		i.LastModifiedTime = types.NewTime(now)
+		i.LastSyncTime = types.NewTime(now)
+		i.Cached = si.cached
+		i.Size = 0
+		i.File = nil
+
+		for _, f := range si.Files {
+			i.Size += f.Size
+			i.File = append(i.File, library.File{
+				Cached:  types.NewBool(si.cached),
+				Name:    path.Base(f.Name),
+				Size:    types.NewInt64(f.Size),
+				Version: si.ContentVersion,
+			})
+		}
+	}
+
+	for id := range l.Item {
+		if !seen[id] {
+			if err := os.RemoveAll(libraryPath(l.Library, id)); err != nil {
+				log.Printf("sync %s: %s", l.Name, err)
+			}
+			delete(l.Item, id)
+		}
+	}
+
+	l.LastSyncTime = types.NewTime(now)
+
+	return nil
+}
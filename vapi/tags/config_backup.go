@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tags
+
+import (
+	"context"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
+)
+
+type configBackupTagger struct {
+	m *Manager
+}
+
+// ConfigBackupTagger returns an object.VirtualMachineConfigBackupTagger, for use with
+// object.VirtualMachine.BackupConfig and RestoreConfig.
+func (c *Manager) ConfigBackupTagger() object.VirtualMachineConfigBackupTagger {
+	return configBackupTagger{c}
+}
+
+func (t configBackupTagger) AttachedTags(ctx context.Context, ref mo.Reference) ([]object.VirtualMachineConfigBackupTag, error) {
+	attached, err := t.m.GetAttachedTags(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []object.VirtualMachineConfigBackupTag
+
+	for _, tag := range attached {
+		category, err := t.m.GetCategory(ctx, tag.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, object.VirtualMachineConfigBackupTag{
+			Category: category.Name,
+			Name:     tag.Name,
+		})
+	}
+
+	return tags, nil
+}
+
+func (t configBackupTagger) AttachTags(ctx context.Context, ref mo.Reference, tags []object.VirtualMachineConfigBackupTag) error {
+	for _, tag := range tags {
+		res, err := t.m.GetTagForCategory(ctx, tag.Name, tag.Category)
+		if err != nil {
+			return err
+		}
+
+		if err = t.m.AttachTag(ctx, res.ID, ref); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
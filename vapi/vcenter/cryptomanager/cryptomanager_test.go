@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cryptomanager_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vapi/vcenter/cryptomanager/kms/providers"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+
+	_ "github.com/vmware/govmomi/vapi/simulator"
+	_ "github.com/vmware/govmomi/vapi/vcenter/cryptomanager/simulator"
+)
+
+func TestNativeKeyProvider(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		rc := rest.NewClient(vc)
+
+		err := rc.Login(ctx, simulator.DefaultLogin)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := providers.NewManager(rc)
+		kmip, err := crypto.GetManagerKmip(vc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Create a native key provider
+		err = m.CreateProvider(ctx, providers.CreateSpec{
+			Provider:    "nkp-1",
+			Constraints: &providers.Constraints{TpmRequired: true},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = m.CreateProvider(ctx, providers.CreateSpec{Provider: "nkp-1"})
+		assert.Error(t, err)
+
+		native, err := kmip.IsNativeProvider(ctx, "nkp-1")
+		assert.NoError(t, err)
+		assert.True(t, native)
+
+		clusters, err := kmip.ListKmipServers(ctx, nil)
+		assert.NoError(t, err)
+		assert.Len(t, clusters, 1)
+		assert.Equal(t, string(types.KmipClusterInfoKmsManagementTypeNativeProvider), clusters[0].ManagementType)
+
+		list, err := m.ListProviders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []providers.Summary{{Provider: "nkp-1", Type: providers.TypeNative, Health: providers.HealthOK}}, list)
+
+		info, err := m.GetProvider(ctx, "nkp-1")
+		assert.NoError(t, err)
+		assert.Equal(t, providers.TypeNative, info.Type)
+		assert.True(t, info.Constraints.TpmRequired)
+		assert.NotEmpty(t, info.NativeInfo.KeyID)
+
+		_, err = m.GetProvider(ctx, "enoent")
+		assert.True(t, rest.IsStatusError(err, 404))
+
+		// Backup
+		res, err := m.ExportProvider(ctx, providers.ExportSpec{Provider: "nkp-1", Password: "secret"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "LOCATION", res.Type)
+
+		f, _, err := m.Download(ctx, res.Location)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config, err := io.ReadAll(f)
+		_ = f.Close()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, config)
+
+		// Restore
+		assert.NoError(t, m.DeleteProvider(ctx, "nkp-1"))
+
+		list, err = m.ListProviders(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, list)
+
+		_, err = m.ImportProvider(ctx, providers.ImportSpec{Config: config, Password: "invalid"})
+		assert.Error(t, err)
+
+		ires, err := m.ImportProvider(ctx, providers.ImportSpec{Config: config, Password: "secret", DryRun: true})
+		assert.NoError(t, err)
+		assert.Equal(t, "nkp-1", ires.Provider)
+
+		list, err = m.ListProviders(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, list)
+
+		ires, err = m.ImportProvider(ctx, providers.ImportSpec{Config: config, Password: "secret"})
+		assert.NoError(t, err)
+		assert.Equal(t, "SUCCESS", ires.Status)
+		assert.Equal(t, "nkp-1", ires.Provider)
+
+		restored, err := m.GetProvider(ctx, "nkp-1")
+		assert.NoError(t, err)
+		assert.Equal(t, info.NativeInfo.KeyID, restored.NativeInfo.KeyID)
+
+		native, err = kmip.IsNativeProvider(ctx, "nkp-1")
+		assert.NoError(t, err)
+		assert.True(t, native)
+	})
+}
@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+const (
+	// Path is the base path of the key providers API.
+	Path = "/api/vcenter/crypto-manager/kms/providers"
+)
+
+// Key provider types.
+const (
+	TypeNative         = "NATIVE"
+	TypeKMIP           = "KMIP"
+	TypeTrustAuthority = "TRUST_AUTHORITY"
+)
+
+// Key provider health states.
+const (
+	HealthNone    = "NONE"
+	HealthOK      = "OK"
+	HealthWarning = "WARNING"
+	HealthError   = "ERROR"
+)
+
+// Manager extends rest.Client, adding key provider related methods.
+type Manager struct {
+	*rest.Client
+}
+
+// NewManager creates a new Manager instance with the given client.
+func NewManager(client *rest.Client) *Manager {
+	return &Manager{
+		Client: client,
+	}
+}
+
+// Constraints
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_ConstraintsSpec
+type Constraints struct {
+	TpmRequired bool `json:"tpm_required"`
+}
+
+// CreateSpec
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_CreateSpec
+type CreateSpec struct {
+	Provider    string       `json:"provider"`
+	Constraints *Constraints `json:"constraints,omitempty"`
+}
+
+// Summary
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_Summary
+type Summary struct {
+	Provider string `json:"provider"`
+	Type     string `json:"type"`
+	Health   string `json:"health"`
+}
+
+// NativeInfo
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_NativeInfo
+type NativeInfo struct {
+	KeyID string `json:"key_id"`
+}
+
+// Info
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_Info
+type Info struct {
+	Health      string       `json:"health"`
+	Type        string       `json:"type"`
+	Constraints *Constraints `json:"constraints_info,omitempty"`
+	NativeInfo  *NativeInfo  `json:"native_info,omitempty"`
+}
+
+// ExportSpec
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_ExportSpec
+type ExportSpec struct {
+	Provider string `json:"provider"`
+	Password string `json:"password,omitempty"`
+}
+
+// Token
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_Token
+type Token struct {
+	Token  string `json:"token"`
+	Expiry string `json:"expiry,omitempty"`
+}
+
+// Location
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_Location
+type Location struct {
+	URL           string `json:"url"`
+	DownloadToken Token  `json:"download_token"`
+}
+
+// ExportResult
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_ExportResult
+type ExportResult struct {
+	Type     string    `json:"type"`
+	Location *Location `json:"location,omitempty"`
+}
+
+// ImportSpec
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_ImportSpec
+type ImportSpec struct {
+	Config      []byte       `json:"config"`
+	Password    string       `json:"password,omitempty"`
+	Constraints *Constraints `json:"constraints,omitempty"`
+	DryRun      bool         `json:"dry_run,omitempty"`
+}
+
+// ImportResult
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/data-structures/CryptoManager_Kms_Providers_ImportResult
+type ImportResult struct {
+	Status   string `json:"status"`
+	Provider string `json:"provider"`
+}
+
+// CreateProvider creates a native key provider.
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/api/vcenter/crypto-manager/kms/providers/post
+func (c *Manager) CreateProvider(ctx context.Context, spec CreateSpec) error {
+	req := c.Resource(Path).Request(http.MethodPost, spec)
+	return c.Do(ctx, req, nil)
+}
+
+// DeleteProvider deletes a key provider.
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/api/vcenter/crypto-manager/kms/providers/provider/delete
+func (c *Manager) DeleteProvider(ctx context.Context, provider string) error {
+	req := c.Resource(Path).WithSubpath(provider).Request(http.MethodDelete)
+	return c.Do(ctx, req, nil)
+}
+
+// GetProvider returns the details of a key provider.
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/api/vcenter/crypto-manager/kms/providers/provider/get
+func (c *Manager) GetProvider(ctx context.Context, provider string) (*Info, error) {
+	req := c.Resource(Path).WithSubpath(provider).Request(http.MethodGet)
+	var res Info
+	return &res, c.Do(ctx, req, &res)
+}
+
+// ListProviders returns a summary of all key providers.
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/api/vcenter/crypto-manager/kms/providers/get
+func (c *Manager) ListProviders(ctx context.Context) ([]Summary, error) {
+	req := c.Resource(Path).Request(http.MethodGet)
+	var res []Summary
+	return res, c.Do(ctx, req, &res)
+}
+
+// ExportProvider exports (backs up) a native key provider.
+// The resulting Location can be used with Download to retrieve the PKCS#12 encoded backup.
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/api/vcenter/crypto-manager/kms/providersactionexport/post
+func (c *Manager) ExportProvider(ctx context.Context, spec ExportSpec) (*ExportResult, error) {
+	req := c.Resource(Path).WithParam("action", "export").Request(http.MethodPost, spec)
+	var res ExportResult
+	return &res, c.Do(ctx, req, &res)
+}
+
+// ImportProvider imports (restores) a native key provider from a backup.
+// https://developer.broadcom.com/xapis/vsphere-automation-api/latest/vcenter/api/vcenter/crypto-manager/kms/providersactionimport/post
+func (c *Manager) ImportProvider(ctx context.Context, spec ImportSpec) (*ImportResult, error) {
+	req := c.Resource(Path).WithParam("action", "import").Request(http.MethodPost, spec)
+	var res ImportResult
+	return &res, c.Do(ctx, req, &res)
+}
+
+// Download the backup of a native key provider from the given Location.
+func (c *Manager) Download(ctx context.Context, l *Location) (io.ReadCloser, int64, error) {
+	u, err := url.Parse(l.URL)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	p := soap.DefaultDownload
+	p.Headers = map[string]string{
+		"Authorization": "Bearer " + l.DownloadToken.Token,
+	}
+
+	return c.Client.Download(ctx, u, &p)
+}
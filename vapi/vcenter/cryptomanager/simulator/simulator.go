@@ -0,0 +1,368 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vmware/govmomi/crypto"
+	"github.com/vmware/govmomi/simulator"
+	vapi "github.com/vmware/govmomi/vapi/simulator"
+	"github.com/vmware/govmomi/vapi/vcenter/cryptomanager/kms/providers"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	// downloadPath serves the backups created via providers.ExportProvider
+	downloadPath = "/cryptomanager/kms/"
+)
+
+func init() {
+	simulator.RegisterEndpoint(func(s *simulator.Service, r *simulator.Registry) {
+		New(s.Listen).Register(s, r)
+	})
+}
+
+// nativeProvider contains the simulated state of a native key provider
+type nativeProvider struct {
+	KeyID       string                 `json:"key_id"`
+	Constraints *providers.Constraints `json:"constraints,omitempty"`
+}
+
+// backup is the simulated PKCS#12 blob returned by the export action.
+// It is not encrypted, the password is only used to validate import requests.
+type backup struct {
+	Provider string         `json:"provider"`
+	Password string         `json:"password"`
+	Native   nativeProvider `json:"native"`
+}
+
+// Handler implements the Key Providers API simulator
+type Handler struct {
+	sync.Mutex
+	URL      *url.URL
+	Native   map[string]*nativeProvider
+	Download map[string][]byte
+}
+
+// New creates a Handler instance
+func New(u *url.URL) *Handler {
+	return &Handler{
+		URL:      u,
+		Native:   make(map[string]*nativeProvider),
+		Download: make(map[string][]byte),
+	}
+}
+
+// Register Key Providers API paths with the vapi simulator's http.ServeMux
+func (h *Handler) Register(s *simulator.Service, r *simulator.Registry) {
+	if r.IsVPX() {
+		s.HandleFunc(providers.Path, h.providers)
+		s.HandleFunc(providers.Path+"/", h.providerID)
+		s.HandleFunc(downloadPath, h.download)
+	}
+}
+
+func (h *Handler) withManager(f func(context.Context, *crypto.ManagerKmip) error) error {
+	return vapi.WithClient(*h.URL, func(ctx context.Context, c *vim25.Client) error {
+		m, err := crypto.GetManagerKmip(c)
+		if err != nil {
+			return err
+		}
+		return f(ctx, m)
+	})
+}
+
+func (h *Handler) list() ([]types.KmipClusterInfo, error) {
+	var clusters []types.KmipClusterInfo
+	err := h.withManager(func(ctx context.Context, m *crypto.ManagerKmip) error {
+		var err error
+		clusters, err = m.ListKmipServers(ctx, nil)
+		return err
+	})
+	return clusters, err
+}
+
+func (h *Handler) find(id string) (*types.KmipClusterInfo, error) {
+	clusters, err := h.list()
+	if err != nil {
+		return nil, err
+	}
+	for i := range clusters {
+		if clusters[i].ClusterId.Id == id {
+			return &clusters[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func providerType(c types.KmipClusterInfo) string {
+	switch c.ManagementType {
+	case string(types.KmipClusterInfoKmsManagementTypeNativeProvider):
+		return providers.TypeNative
+	case string(types.KmipClusterInfoKmsManagementTypeTrustAuthority):
+		return providers.TypeTrustAuthority
+	default:
+		return providers.TypeKMIP
+	}
+}
+
+// register adds the native provider to CryptoManagerKmip.KmipServers
+func (h *Handler) register(w http.ResponseWriter, id string, p nativeProvider) bool {
+	c, err := h.find(id)
+	if err != nil {
+		vapi.ApiErrorGeneral(w)
+		return false
+	}
+	if c != nil {
+		vapi.ApiErrorAlreadyExists(w)
+		return false
+	}
+
+	err = h.withManager(func(ctx context.Context, m *crypto.ManagerKmip) error {
+		return m.RegisterKmipCluster(ctx, id, types.KmipClusterInfoKmsManagementTypeNativeProvider)
+	})
+	if err != nil {
+		vapi.ApiErrorGeneral(w)
+		return false
+	}
+
+	h.Native[id] = &p
+	return true
+}
+
+func (h *Handler) providers(w http.ResponseWriter, r *http.Request) {
+	h.Lock()
+	defer h.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		clusters, err := h.list()
+		if err != nil {
+			vapi.ApiErrorGeneral(w)
+			return
+		}
+		res := []providers.Summary{}
+		for _, c := range clusters {
+			res = append(res, providers.Summary{
+				Provider: c.ClusterId.Id,
+				Type:     providerType(c),
+				Health:   providers.HealthOK,
+			})
+		}
+		vapi.StatusOK(w, res)
+	case http.MethodPost:
+		switch r.URL.Query().Get("action") {
+		case "":
+			h.create(w, r)
+		case "export":
+			h.export(w, r)
+		case "import":
+			h.restore(w, r)
+		default:
+			vapi.ApiErrorInvalidArgument(w)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	var spec providers.CreateSpec
+	if !vapi.Decode(r, w, &spec) {
+		return
+	}
+	if spec.Provider == "" {
+		vapi.ApiErrorInvalidArgument(w)
+		return
+	}
+
+	p := nativeProvider{
+		KeyID:       uuid.NewString(),
+		Constraints: spec.Constraints,
+	}
+	if h.register(w, spec.Provider, p) {
+		vapi.StatusOK(w)
+	}
+}
+
+func hashPassword(password string) string {
+	sum := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(sum[:])
+}
+
+func (h *Handler) export(w http.ResponseWriter, r *http.Request) {
+	var spec providers.ExportSpec
+	if !vapi.Decode(r, w, &spec) {
+		return
+	}
+
+	p, ok := h.Native[spec.Provider]
+	if !ok {
+		if c, _ := h.find(spec.Provider); c != nil {
+			vapi.ApiErrorUnsupported(w) // only native providers can be exported
+		} else {
+			vapi.ApiErrorNotFound(w)
+		}
+		return
+	}
+
+	config, err := json.Marshal(backup{
+		Provider: spec.Provider,
+		Password: hashPassword(spec.Password),
+		Native:   *p,
+	})
+	if err != nil {
+		vapi.ApiErrorGeneral(w)
+		return
+	}
+
+	token := uuid.NewString()
+	h.Download[token] = config
+
+	u := *h.URL
+	u.Path = path.Join(downloadPath, spec.Provider+".p12")
+	u.RawQuery = url.Values{"token": {token}}.Encode()
+
+	vapi.StatusOK(w, providers.ExportResult{
+		Type: "LOCATION",
+		Location: &providers.Location{
+			URL: u.String(),
+			DownloadToken: providers.Token{
+				Token:  token,
+				Expiry: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			},
+		},
+	})
+}
+
+func (h *Handler) restore(w http.ResponseWriter, r *http.Request) {
+	var spec providers.ImportSpec
+	if !vapi.Decode(r, w, &spec) {
+		return
+	}
+
+	var b backup
+	if err := json.Unmarshal(spec.Config, &b); err != nil || b.Provider == "" {
+		vapi.ApiErrorInvalidArgument(w)
+		return
+	}
+	if b.Password != hashPassword(spec.Password) {
+		vapi.ApiErrorUnauthorized(w)
+		return
+	}
+
+	if spec.Constraints != nil {
+		b.Native.Constraints = spec.Constraints
+	}
+
+	res := providers.ImportResult{
+		Status:   "SUCCESS",
+		Provider: b.Provider,
+	}
+
+	if spec.DryRun {
+		if c, _ := h.find(b.Provider); c != nil {
+			vapi.ApiErrorAlreadyExists(w)
+			return
+		}
+		vapi.StatusOK(w, res)
+		return
+	}
+
+	if h.register(w, b.Provider, b.Native) {
+		vapi.StatusOK(w, res)
+	}
+}
+
+func (h *Handler) providerID(w http.ResponseWriter, r *http.Request) {
+	h.Lock()
+	defer h.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, providers.Path+"/")
+
+	c, err := h.find(id)
+	if err != nil {
+		vapi.ApiErrorGeneral(w)
+		return
+	}
+	if c == nil {
+		vapi.ApiErrorNotFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		info := providers.Info{
+			Health: providers.HealthOK,
+			Type:   providerType(*c),
+		}
+		if p, ok := h.Native[id]; ok {
+			info.Constraints = p.Constraints
+			info.NativeInfo = &providers.NativeInfo{KeyID: p.KeyID}
+		}
+		vapi.StatusOK(w, info)
+	case http.MethodDelete:
+		err = h.withManager(func(ctx context.Context, m *crypto.ManagerKmip) error {
+			return m.UnregisterKmsCluster(ctx, id)
+		})
+		if err != nil {
+			vapi.ApiErrorGeneral(w)
+			return
+		}
+		delete(h.Native, id)
+		vapi.StatusOK(w)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *Handler) download(w http.ResponseWriter, r *http.Request) {
+	h.Lock()
+	defer h.Unlock()
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		token = strings.TrimPrefix(auth, "Bearer ")
+	}
+
+	config, ok := h.Download[token]
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-pkcs12")
+	_, _ = w.Write(config)
+}
@@ -31,6 +31,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -53,8 +54,10 @@ import (
 	_ "github.com/vmware/govmomi/vapi/namespace/simulator"
 	_ "github.com/vmware/govmomi/vapi/simulator"
 	_ "github.com/vmware/govmomi/vapi/vcenter/consumptiondomains/simulator"
+	_ "github.com/vmware/govmomi/vapi/vcenter/cryptomanager/simulator"
 	_ "github.com/vmware/govmomi/vapi/vm/simulator"
 	_ "github.com/vmware/govmomi/vsan/simulator"
+	_ "github.com/vmware/govmomi/vslm/simulator"
 )
 
 var (
@@ -75,6 +78,7 @@ func main() {
 	flag.IntVar(&model.Pool, "pool", model.Pool, "Number of resource pools per compute resource")
 	flag.IntVar(&model.App, "app", model.App, "Number of virtual apps per compute resource")
 	flag.IntVar(&model.Pod, "pod", model.Pod, "Number of storage pods per datacenter")
+	flag.IntVar(&model.Vsan, "vsan", model.Vsan, "Number of vSAN enabled clusters per datacenter")
 	flag.IntVar(&model.Portgroup, "pg", model.Portgroup, "Number of port groups")
 	flag.IntVar(&model.PortgroupNSX, "pg-nsx", model.PortgroupNSX, "Number of NSX backed port groups")
 	flag.IntVar(&model.OpaqueNetwork, "nsx", model.OpaqueNetwork, "Number of NSX backed opaque networks")
@@ -96,10 +100,18 @@ func main() {
 	trace := flag.String("trace-file", "", "Trace output file (defaults to stderr)")
 	stdinExit := flag.Bool("stdinexit", false, "Press any key to exit")
 	dir := flag.String("load", "", "Load model from directory")
+	flag.StringVar(&model.Persist, "persist", "", "Persist model state to directory, loaded on start and saved on exit")
 
 	flag.IntVar(&model.DelayConfig.Delay, "delay", model.DelayConfig.Delay, "Method response delay across all methods")
 	methodDelayP := flag.String("method-delay", "", "Delay per method on the form 'method1:delay1,method2:delay2...'")
 	flag.Float64Var(&model.DelayConfig.DelayJitter, "delay-jitter", model.DelayConfig.DelayJitter, "Delay jitter coefficient of variation (tip: 0.5 is a good starting value)")
+	methodFailure := flag.String("method-failure", "", "Failure rate per method on the form 'method1:rate1,method2:rate2...' (e.g. 'PowerOn:0.1')")
+	perfConfig := flag.String("perf-config", "", "Performance metric waveform on the form 'counter1:waveform[:period[:base[:amplitude]]],counter2:...' where waveform is sample, constant, sine or walk (e.g. 'cpu.usage.average:sine:1h,mem:walk')")
+	linked := flag.String("linked", "", "Comma separated URLs of other vcsim instances in the same SSO domain (Enhanced Linked Mode)")
+	flag.BoolVar(&model.StrictPermissions, "strict-permissions", false, "Authorize method calls against the permissions assigned to the session user")
+	flag.BoolVar(&model.AdmissionControl, "admission-control", false, "Enforce resource pool CPU and memory reservations")
+	flag.DurationVar(&simulator.SessionIdleTimeout, "session-idle-timeout", simulator.SessionIdleTimeout, "Expire sessions after the given idle duration (0 to disable)")
+	flag.DurationVar(&simulator.SessionTTL, "session-ttl", simulator.SessionTTL, "Expire sessions after the given duration since login (0 to disable)")
 
 	flag.Parse()
 
@@ -149,6 +161,40 @@ func main() {
 		simulator.TaskDelay.MethodDelay = m
 	}
 
+	if *methodFailure != "" {
+		m := make(map[string]float64)
+		for _, s := range strings.Split(*methodFailure, ",") {
+			tuples := strings.Split(strings.TrimSpace(s), ":")
+			if len(tuples) != 2 {
+				log.Fatal("Incorrect method failure format.")
+			}
+			value, err := strconv.ParseFloat(tuples[1], 64)
+			if err != nil {
+				log.Fatalf("Incorrect format of method-failure argument: %s", err)
+			}
+			m[tuples[0]] = value
+		}
+		model.DelayConfig.MethodFailure = m
+		simulator.TaskDelay.MethodFailure = m
+	}
+
+	if *perfConfig != "" {
+		model.PerfMetricConfig = make(map[string]simulator.PerfMetricConfig)
+		for _, s := range strings.Split(*perfConfig, ",") {
+			name, config, err := parsePerfConfig(strings.TrimSpace(s))
+			if err != nil {
+				log.Fatalf("Incorrect format of perf-config argument: %s", err)
+			}
+			model.PerfMetricConfig[name] = config
+		}
+	}
+
+	if *linked != "" {
+		for _, s := range strings.Split(*linked, ",") {
+			model.Linked = append(model.Linked, strings.TrimSpace(s))
+		}
+	}
+
 	var err error
 
 	if err = updateHostTemplate(u.Host); err != nil {
@@ -165,6 +211,9 @@ func main() {
 		model.DelayConfig.Delay = opts.DelayConfig.Delay
 		model.DelayConfig.MethodDelay = opts.DelayConfig.MethodDelay
 		model.DelayConfig.DelayJitter = opts.DelayConfig.DelayJitter
+		model.Persist = opts.Persist
+		model.DelayConfig.MethodFailure = opts.DelayConfig.MethodFailure
+		model.PerfMetricConfig = opts.PerfMetricConfig
 	}
 
 	tag := " (govmomi simulator)"
@@ -290,6 +339,42 @@ func updateHostTemplate(ip string) error {
 	return nil
 }
 
+// parsePerfConfig parses a single -perf-config entry on the form 'counter:waveform[:period[:base[:amplitude]]]'
+func parsePerfConfig(s string) (string, simulator.PerfMetricConfig, error) {
+	var config simulator.PerfMetricConfig
+
+	tuples := strings.Split(s, ":")
+	if len(tuples) < 2 || len(tuples) > 5 {
+		return "", config, fmt.Errorf("%q", s)
+	}
+
+	config.Waveform = simulator.PerfWaveform(tuples[1])
+	switch config.Waveform {
+	case simulator.PerfWaveformSample, simulator.PerfWaveformConstant, simulator.PerfWaveformSine, simulator.PerfWaveformRandomWalk:
+	default:
+		return "", config, fmt.Errorf("unknown waveform %q", tuples[1])
+	}
+
+	var err error
+	if len(tuples) > 2 {
+		if config.Period, err = time.ParseDuration(tuples[2]); err != nil {
+			return "", config, err
+		}
+	}
+	if len(tuples) > 3 {
+		if config.Base, err = strconv.ParseInt(tuples[3], 10, 64); err != nil {
+			return "", config, err
+		}
+	}
+	if len(tuples) > 4 {
+		if config.Amplitude, err = strconv.ParseInt(tuples[4], 10, 64); err != nil {
+			return "", config, err
+		}
+	}
+
+	return tuples[0], config, nil
+}
+
 func secret(s *string) string {
 	val, err := session.Secret(*s)
 	if err != nil {
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vim25
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+// ServerClock estimates the server's clock relative to the local clock,
+// using the ServiceInstance CurrentTime method.
+// Each sample uses the midpoint of the request to compensate for round-trip latency,
+// and Sync keeps the sample with the lowest latency, as it has the smallest error bound.
+// When synced more than once, the rate at which the offset changes is used
+// to estimate drift between syncs.
+type ServerClock struct {
+	rt soap.RoundTripper
+
+	mu      sync.Mutex
+	offset  time.Duration
+	latency time.Duration
+	synced  time.Time
+	first   time.Time
+	initial time.Duration
+	rate    float64
+}
+
+// NewServerClock returns a ServerClock using the given RoundTripper, typically a *Client.
+// Sync must be called before the clock is used.
+func NewServerClock(rt soap.RoundTripper) *ServerClock {
+	return &ServerClock{rt: rt}
+}
+
+// Sync queries the server time the given number of times, at least once,
+// and updates the clock offset using the sample with the lowest latency.
+func (c *ServerClock) Sync(ctx context.Context, samples int) error {
+	if samples < 1 {
+		samples = 1
+	}
+
+	var (
+		offset  time.Duration
+		latency time.Duration
+		local   time.Time
+	)
+
+	for i := 0; i < samples; i++ {
+		start := time.Now()
+
+		now, err := methods.GetCurrentTime(ctx, c.rt)
+		if err != nil {
+			return err
+		}
+
+		rtt := time.Since(start)
+		mid := start.Add(rtt / 2)
+
+		if i == 0 || rtt < latency {
+			offset = now.Sub(mid)
+			latency = rtt
+			local = mid
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.first.IsZero() {
+		c.first = local
+		c.initial = offset
+	} else if elapsed := local.Sub(c.first); elapsed > 0 {
+		c.rate = float64(offset-c.initial) / float64(elapsed)
+	}
+
+	c.offset = offset
+	c.latency = latency
+	c.synced = local
+
+	return nil
+}
+
+// Offset returns the estimated server time minus local time at the given local time,
+// positive when the server clock is ahead.
+func (c *ServerClock) Offset(local time.Time) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.synced.IsZero() {
+		return c.offset
+	}
+
+	return c.offset + time.Duration(c.rate*float64(local.Sub(c.synced)))
+}
+
+// Latency returns the round-trip time of the sample used by the last Sync.
+// The offset error is bounded by half of this value.
+func (c *ServerClock) Latency() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.latency
+}
+
+// Drift returns the estimated rate at which the offset changes, as a fraction of
+// elapsed local time. For example, 1e-6 is a server clock gaining 1µs per second.
+// Drift is 0 until Sync has been called at least twice.
+func (c *ServerClock) Drift() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rate
+}
+
+// Synced returns the local time of the last Sync, or the zero time if not synced.
+func (c *ServerClock) Synced() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.synced
+}
+
+// Now returns the estimated current server time in UTC.
+func (c *ServerClock) Now() time.Time {
+	return c.ServerTime(time.Now())
+}
+
+// ServerTime converts a local time to the estimated server time in UTC.
+func (c *ServerClock) ServerTime(local time.Time) time.Time {
+	return local.Add(c.Offset(local)).UTC()
+}
+
+// LocalTime converts a server time to the estimated local time.
+func (c *ServerClock) LocalTime(server time.Time) time.Time {
+	return server.Add(-c.Offset(server)).Local()
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vim25_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+)
+
+func TestServerClock(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		clock := vim25.NewServerClock(c)
+
+		if !clock.Synced().IsZero() {
+			t.Error("expected unsynced clock")
+		}
+
+		if err := clock.Sync(ctx, 3); err != nil {
+			t.Fatal(err)
+		}
+
+		if clock.Synced().IsZero() || clock.Latency() <= 0 {
+			t.Errorf("synced=%s latency=%s", clock.Synced(), clock.Latency())
+		}
+
+		if clock.Drift() != 0 {
+			t.Errorf("drift=%f", clock.Drift())
+		}
+
+		// vcsim uses the local clock, the offset is bounded by latency
+		bound := clock.Latency() + time.Millisecond
+		now := time.Now()
+		if offset := clock.Offset(now); offset > bound || offset < -bound {
+			t.Errorf("offset=%s bound=%s", offset, bound)
+		}
+
+		server := clock.Now()
+		if server.Location() != time.UTC {
+			t.Errorf("location=%s", server.Location())
+		}
+		if d := server.Sub(time.Now()); d > time.Second || d < -time.Second {
+			t.Errorf("server=%s", server)
+		}
+
+		local := clock.LocalTime(clock.ServerTime(now))
+		if d := local.Sub(now); d > time.Millisecond || d < -time.Millisecond {
+			t.Errorf("local=%s now=%s", local, now)
+		}
+
+		if err := clock.Sync(ctx, 1); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
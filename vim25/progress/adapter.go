@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Func returns a Sinker that calls fn with the Event of each Report,
+// allowing terminal UIs and other consumers to render structured progress.
+func Func(fn func(Event)) Sinker {
+	return SinkFunc(func() chan<- Report {
+		ch := make(chan Report)
+		go func() {
+			for r := range ch {
+				fn(EventOf(r))
+			}
+		}()
+		return ch
+	})
+}
+
+// Log returns a Sinker that logs the Event of each Report with the given slog.Logger,
+// no more than once per interval. The final Report is always logged,
+// at the Error level if it includes an error.
+func Log(l *slog.Logger, msg string, interval time.Duration) Sinker {
+	return SinkFunc(func() chan<- Report {
+		ch := make(chan Report)
+		go logLoop(l, msg, interval, ch)
+		return ch
+	})
+}
+
+func logLoop(l *slog.Logger, msg string, interval time.Duration, ch <-chan Report) {
+	var last time.Time
+	var e *Event
+
+	for r := range ch {
+		event := EventOf(r)
+		e = &event
+
+		if time.Since(last) >= interval {
+			logEvent(l, msg, e)
+			last = time.Now()
+			e = nil
+		}
+	}
+
+	if e != nil {
+		logEvent(l, msg, e)
+	}
+}
+
+func logEvent(l *slog.Logger, msg string, e *Event) {
+	level := slog.LevelInfo
+	attrs := []slog.Attr{slog.Float64("percent", float64(e.Percent))}
+
+	if e.Stage != "" {
+		attrs = append(attrs, slog.String("stage", e.Stage))
+	}
+	if e.Message != "" {
+		attrs = append(attrs, slog.String("detail", e.Message))
+	}
+	if e.Bytes > 0 {
+		attrs = append(attrs, slog.Int64("bytes", e.Bytes))
+	}
+	if e.Total > 0 {
+		attrs = append(attrs, slog.Int64("total", e.Total))
+	}
+	if e.ETA > 0 {
+		attrs = append(attrs, slog.Duration("eta", e.ETA))
+	}
+	if e.Err != nil {
+		level = slog.LevelError
+		attrs = append(attrs, slog.String("error", e.Err.Error()))
+	}
+
+	l.LogAttrs(context.Background(), level, msg, attrs...)
+}
@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import "context"
+
+type sinkerKey struct{}
+
+// WithSinker returns a copy of ctx with the given Sinker.
+// File transfers and task waiters that are not given a Sinker explicitly
+// report progress to the Sinker of their context.
+// A nil Sinker disables reporting for the returned context.
+func WithSinker(ctx context.Context, s Sinker) context.Context {
+	return context.WithValue(ctx, sinkerKey{}, s)
+}
+
+// FromContext returns the Sinker of the given context, if any.
+func FromContext(ctx context.Context) Sinker {
+	s, _ := ctx.Value(sinkerKey{}).(Sinker)
+	return s
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"testing"
+)
+
+func TestContextSinker(t *testing.T) {
+	ctx := context.Background()
+
+	if s := FromContext(ctx); s != nil {
+		t.Errorf("unexpected sinker: %#v", s)
+	}
+
+	s := &dummySinker{ch: make(chan Report)}
+	ctx = WithSinker(ctx, s)
+	if FromContext(ctx) != s {
+		t.Error("expected sinker")
+	}
+
+	if s := FromContext(WithSinker(ctx, nil)); s != nil {
+		t.Errorf("unexpected sinker: %#v", s)
+	}
+}
@@ -29,4 +29,10 @@ progress reports. They are responsible for closing the channel when done.
 This semantic makes it easy to keep track of multiple progress report channels;
 they are only created when Sink() is called and assumed closed when any
 function that receives a Sinker parameter returns.
+
+Reports may also implement the Eventer interface, providing a structured Event
+with the stage of an operation, bytes transferred and an estimated time to
+completion. EventOf converts any Report to an Event. The Group Sinker combines
+the progress of parallel operations, while the Func and Log Sinkers adapt
+progress Events for terminal UIs and slog based logging.
 */
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"fmt"
+	"time"
+)
+
+// Event is a structured progress Report, including the stage of an operation,
+// the number of bytes transferred and an estimated time to completion.
+type Event struct {
+	Stage   string        // Name of the current stage, if any
+	Percent float32       // Percent complete, 0-100
+	Bytes   int64         // Bytes transferred, if applicable
+	Total   int64         // Total bytes, 0 if unknown
+	ETA     time.Duration // Estimated time remaining, 0 if unknown
+	Message string        // Detail message, such as the transfer rate
+	Err     error
+}
+
+func (e Event) Percentage() float32 {
+	return e.Percent
+}
+
+func (e Event) Detail() string {
+	switch {
+	case e.Stage == "":
+		return e.Message
+	case e.Message == "":
+		return e.Stage
+	default:
+		return fmt.Sprintf("%s: %s", e.Stage, e.Message)
+	}
+}
+
+func (e Event) Error() error {
+	return e.Err
+}
+
+// Eventer is implemented by Report types that provide structured progress.
+type Eventer interface {
+	Event() Event
+}
+
+// EventOf returns the structured form of the given Report.
+// Reports that do not implement Eventer only populate the Percent, Message and Err fields.
+func EventOf(r Report) Event {
+	switch e := r.(type) {
+	case Event:
+		return e
+	case *Event:
+		return *e
+	case Eventer:
+		return e.Event()
+	}
+
+	return Event{
+		Percent: r.Percentage(),
+		Message: r.Detail(),
+		Err:     r.Error(),
+	}
+}
+
+// eta estimates the time to transfer the remaining bytes at the given rate.
+func eta(pos, size int64, bps uint64) time.Duration {
+	if bps == 0 || size <= 0 || pos >= size {
+		return 0
+	}
+	return time.Duration(float64(size-pos) / float64(bps) * float64(time.Second))
+}
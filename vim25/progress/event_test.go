@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEventOf(t *testing.T) {
+	ch := make(chan Report, 1)
+	s := Prefix(&dummySinker{ch}, "upload")
+	pr := NewReader(context.Background(), s, strings.NewReader("helloworld"), 10)
+
+	var buf [4]byte
+	_, _ = pr.Read(buf[:])
+
+	e := EventOf(<-ch)
+	if e.Stage != "upload" || e.Bytes != 4 || e.Total != 10 || e.Percent != 40 {
+		t.Errorf("event=%#v", e)
+	}
+
+	e = EventOf(dummyReport{p: 50, d: "detail"})
+	if e.Percent != 50 || e.Message != "detail" || e.Stage != "" {
+		t.Errorf("event=%#v", e)
+	}
+
+	e = EventOf(Event{Stage: "a", Message: "b"})
+	if d := e.Detail(); d != "a: b" {
+		t.Errorf("detail=%s", d)
+	}
+
+	scaled := scaledReport{Report: Event{Percent: 50, ETA: time.Second}, n: 2, i: 1}
+	e = EventOf(scaled)
+	if e.Percent != 75 || e.ETA != 0 {
+		t.Errorf("event=%#v", e)
+	}
+}
+
+func TestGroup(t *testing.T) {
+	ch := make(chan Report)
+	var events []Event
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for r := range ch {
+			events = append(events, EventOf(r))
+		}
+	}()
+
+	g := NewGroup(&dummySinker{ch})
+
+	a := g.Sink()
+	b := g.Sink()
+
+	a <- Event{Bytes: 50, Total: 100, Percent: 50, ETA: time.Second}
+	b <- Event{Bytes: 100, Total: 300, Percent: 33, ETA: time.Minute}
+
+	close(a)
+	b <- Event{Bytes: 300, Total: 300, Percent: 100}
+	close(b)
+
+	g.Done()
+	wg.Wait()
+
+	if len(events) != 5 {
+		t.Fatalf("%d events", len(events))
+	}
+
+	// Reports from each operation are handled concurrently, look for the combined Event
+	found := false
+	for _, e := range events {
+		if e.Bytes == 150 && e.Total == 400 {
+			found = true
+			if e.Percent != 37.5 || e.ETA != time.Minute {
+				t.Errorf("event=%#v", e)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("events=%#v", events)
+	}
+
+	e := events[len(events)-1]
+	if e.Bytes != 350 || e.Message != "2/2 complete" {
+		t.Errorf("event=%#v", e)
+	}
+
+	// unknown totals use the average percentage
+	ch = make(chan Report, 2)
+	g = NewGroup(&dummySinker{ch})
+	a = g.Sink()
+	a <- dummyReport{p: 10}
+	<-ch
+	b = g.Sink()
+	b <- dummyReport{p: 30, e: errors.New("failed")}
+	e = EventOf(<-ch)
+	if e.Percent != 20 || e.Total != 0 || e.Err == nil {
+		t.Errorf("event=%#v", e)
+	}
+	close(a)
+	close(b)
+	g.Done()
+}
+
+type syncBuffer struct {
+	sync.Mutex
+	b bytes.Buffer
+}
+
+func (s *syncBuffer) Write(p []byte) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+	return s.b.Write(p)
+}
+
+func (s *syncBuffer) String() string {
+	s.Lock()
+	defer s.Unlock()
+	return s.b.String()
+}
+
+func TestLog(t *testing.T) {
+	var buf syncBuffer
+	l := slog.New(slog.NewTextHandler(&buf, nil))
+
+	ch := Log(l, "upload", time.Hour).Sink()
+	ch <- Event{Stage: "disk", Percent: 10, Bytes: 1, Total: 10}
+	ch <- Event{Stage: "disk", Percent: 50}
+	ch <- Event{Stage: "disk", Percent: 100, Err: errors.New("failed")}
+	close(ch)
+
+	// The final Report is logged when the channel is closed
+	deadline := time.Now().Add(5 * time.Second)
+	for strings.Count(buf.String(), "\n") < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("lines=%q", lines)
+	}
+	if !strings.Contains(lines[0], "level=INFO") || !strings.Contains(lines[0], "stage=disk") || !strings.Contains(lines[0], "total=10") {
+		t.Errorf("line=%s", lines[0])
+	}
+	if !strings.Contains(lines[1], "level=ERROR") || !strings.Contains(lines[1], "error=failed") {
+		t.Errorf("line=%s", lines[1])
+	}
+}
+
+func TestFunc(t *testing.T) {
+	events := make(chan Event, 1)
+	ch := Func(func(e Event) { events <- e }).Sink()
+	ch <- dummyReport{p: 42}
+	close(ch)
+
+	if e := <-events; e.Percent != 42 {
+		t.Errorf("event=%#v", e)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Group is a Sinker for parallel operations. Each call to Sink starts tracking
+// a new operation and every report is combined with the latest report of the
+// other operations into a single Event sent downstream.
+// Unlike Aggregator, reports from all operations are handled concurrently.
+type Group struct {
+	downstream chan<- Report
+
+	mu  sync.Mutex
+	ops []*groupOp
+	w   sync.WaitGroup
+}
+
+type groupOp struct {
+	Event
+	done bool
+}
+
+// NewGroup returns a Group that sends combined reports to s.
+func NewGroup(s Sinker) *Group {
+	return &Group{downstream: s.Sink()}
+}
+
+func (g *Group) Sink() chan<- Report {
+	ch := make(chan Report)
+	op := new(groupOp)
+
+	g.mu.Lock()
+	g.ops = append(g.ops, op)
+	g.mu.Unlock()
+
+	g.w.Add(1)
+	go func() {
+		defer g.w.Done()
+
+		for r := range ch {
+			g.update(op, EventOf(r), false)
+		}
+
+		g.update(op, op.Event, true)
+	}()
+
+	return ch
+}
+
+func (g *Group) update(op *groupOp, e Event, done bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	op.Event = e
+	op.done = done
+
+	g.downstream <- g.event()
+}
+
+// event combines the latest Event of each operation, g.mu must be held.
+// Percent is weighted by Total bytes when known for all operations,
+// the ETA is that of the slowest operation.
+func (g *Group) event() Event {
+	var e Event
+	var percent float32
+	var complete int
+	known := true
+
+	for _, op := range g.ops {
+		if op.done {
+			complete++
+		}
+		if e.Err == nil {
+			e.Err = op.Err
+		}
+		if op.Total <= 0 {
+			known = false
+		}
+		if op.ETA > e.ETA {
+			e.ETA = op.ETA
+		}
+		percent += op.Percent
+		e.Bytes += op.Bytes
+		e.Total += op.Total
+	}
+
+	switch {
+	case known && e.Total > 0:
+		e.Percent = 100 * float32(e.Bytes) / float32(e.Total)
+	default:
+		e.Total = 0
+		e.Percent = percent / float32(len(g.ops))
+	}
+
+	e.Message = fmt.Sprintf("%d/%d complete", complete, len(g.ops))
+
+	return e
+}
+
+// Done waits for all operations to complete and closes the downstream channel.
+// No more calls to Sink() may be made after calling Done().
+func (g *Group) Done() {
+	g.w.Wait()
+	close(g.downstream)
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package progress
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 )
@@ -28,6 +30,7 @@ type LogFunc func(msg string) (int, error)
 type ProgressLogger struct {
 	log    LogFunc
 	prefix string
+	json   *json.Encoder
 
 	wg sync.WaitGroup
 
@@ -36,19 +39,77 @@ type ProgressLogger struct {
 }
 
 func NewProgressLogger(log LogFunc, prefix string) *ProgressLogger {
-	p := &ProgressLogger{
+	p := newProgressLogger(log, prefix)
+	p.start()
+	return p
+}
+
+// NewJSONLogger returns a ProgressLogger that writes progress to w as JSON lines,
+// for consumption by programs rather than terminals. A "running" event is written
+// each time the progress changes, at the same interval as NewProgressLogger output,
+// followed by a final "success" or "error" event.
+func NewJSONLogger(w io.Writer, prefix string) *ProgressLogger {
+	p := newProgressLogger(nil, prefix)
+	p.json = json.NewEncoder(w)
+	p.start()
+	return p
+}
+
+func newProgressLogger(log LogFunc, prefix string) *ProgressLogger {
+	return &ProgressLogger{
 		log:    log,
 		prefix: prefix,
 
 		sink: make(chan chan Report),
 		done: make(chan struct{}),
 	}
+}
 
+func (p *ProgressLogger) start() {
 	p.wg.Add(1)
 
 	go p.loopA()
+}
 
-	return p
+// jsonEvent is the JSON encoding of an Event written by NewJSONLogger.
+type jsonEvent struct {
+	Time    time.Time `json:"time"`
+	Name    string    `json:"name"`
+	Status  string    `json:"status"`
+	Stage   string    `json:"stage,omitempty"`
+	Percent float32   `json:"percent"`
+	Bytes   int64     `json:"bytes,omitempty"`
+	Total   int64     `json:"total,omitempty"`
+	ETA     float64   `json:"eta,omitempty"` // seconds
+	Detail  string    `json:"detail,omitempty"`
+	Error   string    `json:"error,omitempty"`
+}
+
+func (p *ProgressLogger) writeJSON(status string, r Report, err error) {
+	e := jsonEvent{
+		Time:   time.Now(),
+		Name:   strings.TrimSpace(strings.TrimRight(strings.TrimSpace(p.prefix), ".")),
+		Status: status,
+	}
+
+	if r != nil {
+		event := EventOf(r)
+		e.Stage = event.Stage
+		e.Percent = event.Percent
+		e.Bytes = event.Bytes
+		e.Total = event.Total
+		e.ETA = event.ETA.Round(time.Second).Seconds()
+		e.Detail = event.Message
+	}
+
+	switch {
+	case err != nil:
+		e.Error = err.Error()
+	case status == "success":
+		e.Percent = 100
+	}
+
+	_ = p.json.Encode(e)
 }
 
 // loopA runs before Sink() has been called.
@@ -61,21 +122,34 @@ func (p *ProgressLogger) loopA() {
 	defer tick.Stop()
 
 	called := false
+	var last Report
 
 	for stop := false; !stop; {
 		select {
 		case ch := <-p.sink:
-			err = p.loopB(tick, ch)
+			last, err = p.loopB(tick, ch)
 			stop = true
 			called = true
 		case <-p.done:
 			stop = true
 		case <-tick.C:
+			if p.json != nil {
+				continue
+			}
 			line := fmt.Sprintf("\r%s", p.prefix)
 			p.log(line)
 		}
 	}
 
+	if p.json != nil {
+		if err != nil && err != io.EOF {
+			p.writeJSON("error", last, err)
+		} else if called {
+			p.writeJSON("success", last, nil)
+		}
+		return
+	}
+
 	if err != nil && err != io.EOF {
 		p.log(fmt.Sprintf("\r%sError: %s\n", p.prefix, err))
 	} else if called {
@@ -83,20 +157,30 @@ func (p *ProgressLogger) loopA() {
 	}
 }
 
-// loopA runs after Sink() has been called.
-func (p *ProgressLogger) loopB(tick *time.Ticker, ch <-chan Report) error {
+// loopB runs after Sink() has been called.
+func (p *ProgressLogger) loopB(tick *time.Ticker, ch <-chan Report) (Report, error) {
 	var r Report
-	var ok bool
+	var ok, changed bool
 	var err error
 
 	for ok = true; ok; {
 		select {
-		case r, ok = <-ch:
-			if !ok {
+		case q, more := <-ch:
+			if !more {
+				ok = false
 				break
 			}
+			r = q
+			changed = true
 			err = r.Error()
 		case <-tick.C:
+			if p.json != nil {
+				if changed {
+					p.writeJSON("running", r, nil)
+					changed = false
+				}
+				continue
+			}
 			line := fmt.Sprintf("\r%s", p.prefix)
 			if r != nil {
 				line += fmt.Sprintf("(%.0f%%", r.Percentage())
@@ -104,13 +188,16 @@ func (p *ProgressLogger) loopB(tick *time.Ticker, ch <-chan Report) error {
 				if detail != "" {
 					line += fmt.Sprintf(", %s", detail)
 				}
+				if eta := EventOf(r).ETA; eta > 0 {
+					line += fmt.Sprintf(", ETA %s", eta.Round(time.Second))
+				}
 				line += ")"
 			}
 			p.log(line)
 		}
 	}
 
-	return err
+	return r, err
 }
 
 func (p *ProgressLogger) Sink() chan<- Report {
@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJSONLogger(t *testing.T) {
+	var buf syncBuffer
+
+	p := NewJSONLogger(&buf, "Uploading disk.vmdk... ")
+	ch := p.Sink()
+	ch <- Event{Stage: "upload", Percent: 50, Bytes: 5, Total: 10, ETA: 2 * time.Second}
+	time.Sleep(250 * time.Millisecond) // a tick writes the running event
+	ch <- Event{Stage: "upload", Percent: 60, Err: errors.New("failed")}
+	close(ch)
+	p.Wait()
+
+	var events []jsonEvent
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var e jsonEvent
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, e)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("events=%#v", events)
+	}
+
+	e := events[0]
+	if e.Name != "Uploading disk.vmdk" || e.Status != "running" || e.Stage != "upload" || e.Percent != 50 || e.Bytes != 5 || e.Total != 10 || e.ETA != 2 {
+		t.Errorf("event=%#v", e)
+	}
+
+	e = events[1]
+	if e.Status != "error" || e.Error != "failed" || e.Percent != 60 {
+		t.Errorf("event=%#v", e)
+	}
+
+	// no events without a call to Sink
+	buf = syncBuffer{}
+	NewJSONLogger(&buf, "noop").Wait()
+	if buf.String() != "" {
+		t.Errorf("output=%s", buf.String())
+	}
+}
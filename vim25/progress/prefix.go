@@ -31,6 +31,16 @@ func (r prefixedReport) Detail() string {
 	return r.prefix
 }
 
+func (r prefixedReport) Event() Event {
+	e := EventOf(r.Report)
+	if e.Stage == "" {
+		e.Stage = r.prefix
+	} else {
+		e.Stage = fmt.Sprintf("%s: %s", r.prefix, e.Stage)
+	}
+	return e
+}
+
 func prefixLoop(upstream <-chan Report, downstream chan<- Report, prefix string) {
 	defer close(downstream)
 
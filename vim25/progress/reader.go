@@ -72,6 +72,20 @@ func (p readerReport) Error() error {
 	return p.err
 }
 
+func (p readerReport) Event() Event {
+	e := Event{
+		Percent: p.Percentage(),
+		Bytes:   p.pos,
+		Message: p.Detail(),
+		ETA:     eta(p.pos, p.size, atomic.LoadUint64(p.bps)),
+		Err:     p.err,
+	}
+	if p.size > 0 {
+		e.Total = p.size
+	}
+	return e
+}
+
 // reader wraps an io.Reader and sends a progress report over a channel for
 // every read it handles.
 type reader struct {
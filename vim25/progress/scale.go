@@ -27,6 +27,15 @@ func (r scaledReport) Percentage() float32 {
 	return b + (r.Report.Percentage() / float32(r.n))
 }
 
+// Event returns the Event of the current step, scaled to the overall percentage.
+// The ETA of the current step does not apply to the overall operation.
+func (r scaledReport) Event() Event {
+	e := EventOf(r.Report)
+	e.Percent = r.Percentage()
+	e.ETA = 0
+	return e
+}
+
 type scaleOne struct {
 	s Sinker
 	n int
@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// bandwidthReader limits the rate of reads from r to the given bytes per second.
+type bandwidthReader struct {
+	ctx   context.Context
+	r     io.Reader
+	limit int64
+
+	start time.Time
+	n     int64
+}
+
+func newBandwidthReader(ctx context.Context, r io.Reader, limit int64) io.Reader {
+	if limit <= 0 {
+		return r
+	}
+	return &bandwidthReader{ctx: ctx, r: r, limit: limit}
+}
+
+func (b *bandwidthReader) Read(p []byte) (int, error) {
+	if err := b.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if b.start.IsZero() {
+		b.start = time.Now()
+	}
+
+	// Avoid bursts larger than the limit
+	if int64(len(p)) > b.limit {
+		p = p[:b.limit]
+	}
+
+	n, err := b.r.Read(p)
+	b.n += int64(n)
+
+	wait := time.Duration(float64(b.n)/float64(b.limit)*float64(time.Second)) - time.Since(b.start)
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-b.ctx.Done():
+			return n, b.ctx.Err()
+		}
+	}
+
+	return n, err
+}
+
+// readCloser pairs a wrapped reader with the Close method of the original.
+type readCloser struct {
+	io.Reader
+	close func() error
+}
+
+func (r *readCloser) Close() error {
+	return r.close()
+}
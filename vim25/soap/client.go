@@ -25,7 +25,6 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -89,7 +88,8 @@ type Client struct {
 	cookie          string
 	insecureCookies bool
 
-	useJSON bool
+	useJSON  bool
+	validate bool
 }
 
 var schemeMatch = regexp.MustCompile(`^\w+://`)
@@ -187,6 +187,8 @@ func newClientWithTransport(u *url.URL, insecure bool, t *http.Transport) *Clien
 		c.insecureCookies = os.Getenv("GOVMOMI_INSECURE_COOKIES") == "true"
 	}
 
+	c.validate = os.Getenv("GOVMOMI_VALIDATE_REQUESTS") == "true"
+
 	return &c
 }
 
@@ -235,6 +237,7 @@ func (c *Client) newServiceClientWithTransport(path string, namespace string, t
 	client.u.RawQuery = vc.RawQuery
 
 	client.UserAgent = c.UserAgent
+	client.validate = c.validate
 
 	vimTypes := c.Types
 	client.Types = func(name string) (reflect.Type, bool) {
@@ -258,6 +261,15 @@ func (c *Client) UseJSON(useJSON bool) {
 	c.useJSON = useJSON
 }
 
+// ValidateRequests enables or disables checking that the fields required by the WSDL are set
+// before a request is sent, see ValidateRequest. Validation can also be enabled by setting
+// the GOVMOMI_VALIDATE_REQUESTS environment variable to "true".
+// Note this method has no locking and clients should be careful to not interfere
+// with concurrent use of the client instance.
+func (c *Client) ValidateRequests(enable bool) {
+	c.validate = enable
+}
+
 // SetRootCAs defines the set of PEM-encoded file locations of root certificate
 // authorities the client uses when verifying server certificates instead of the
 // TLS defaults which uses the host's root CA set. Multiple PEM file locations
@@ -674,6 +686,11 @@ func (e *statusError) Error() string {
 	return e.res.Status
 }
 
+// StatusCode returns the HTTP response status code, see retry.IsStatus
+func (e *statusError) StatusCode() int {
+	return e.res.StatusCode
+}
+
 func newStatusError(res *http.Response) error {
 	return &url.Error{
 		Op:  res.Request.Method,
@@ -684,6 +701,11 @@ func newStatusError(res *http.Response) error {
 
 // RoundTrip executes an API request to VMOMI server.
 func (c *Client) RoundTrip(ctx context.Context, reqBody, resBody HasFault) error {
+	if c.validate {
+		if err := ValidateRequest(reqBody); err != nil {
+			return err
+		}
+	}
 	if !c.useJSON {
 		return c.soapRoundTrip(ctx, reqBody, resBody)
 	}
@@ -793,6 +815,8 @@ type Upload struct {
 	Ticket        *http.Cookie
 	Progress      progress.Sinker
 	Close         bool
+	// Bandwidth limits the transfer rate in bytes per second, 0 for unlimited.
+	Bandwidth int64
 }
 
 var DefaultUpload = Upload{
@@ -800,12 +824,23 @@ var DefaultUpload = Upload{
 	Method: "PUT",
 }
 
-// Upload PUTs the local file to the given URL
+// sinker returns s if not nil, otherwise the Sinker of the given context.
+func sinker(ctx context.Context, s progress.Sinker) progress.Sinker {
+	if s != nil {
+		return s
+	}
+	return progress.FromContext(ctx)
+}
+
+// Upload PUTs the local file to the given URL.
+// Progress is reported to param.Progress, or the context Sinker when nil, see progress.WithSinker.
 func (c *Client) Upload(ctx context.Context, f io.Reader, u *url.URL, param *Upload) error {
 	var err error
 
-	if param.Progress != nil {
-		pr := progress.NewReader(ctx, param.Progress, f, param.ContentLength)
+	f = newBandwidthReader(ctx, f, param.Bandwidth)
+
+	if s := sinker(ctx, param.Progress); s != nil {
+		pr := progress.NewReader(ctx, s, f, param.ContentLength)
 		f = pr
 
 		// Mark progress reader as done when returning from this function.
@@ -843,7 +878,7 @@ func (c *Client) Upload(ctx context.Context, f io.Reader, u *url.URL, param *Upl
 	case http.StatusOK:
 	case http.StatusCreated:
 	default:
-		err = errors.New(res.Status)
+		err = &statusError{res}
 	}
 
 	return err
@@ -879,6 +914,8 @@ type Download struct {
 	Progress progress.Sinker
 	Writer   io.Writer
 	Close    bool
+	// Bandwidth limits the transfer rate in bytes per second, 0 for unlimited.
+	Bandwidth int64
 }
 
 var DefaultDownload = Download{
@@ -906,7 +943,9 @@ func (c *Client) DownloadRequest(ctx context.Context, u *url.URL, param *Downloa
 	return c.Client.Do(req)
 }
 
-// Download GETs the remote file from the given URL
+// Download GETs the remote file from the given URL.
+// Progress is reported to param.Progress, or the context Sinker when nil, as the returned reader is consumed.
+// Reporting is done when the reader is closed.
 func (c *Client) Download(ctx context.Context, u *url.URL, param *Download) (io.ReadCloser, int64, error) {
 	res, err := c.DownloadRequest(ctx, u, param)
 	if err != nil {
@@ -920,18 +959,37 @@ func (c *Client) Download(ctx context.Context, u *url.URL, param *Download) (io.
 	}
 
 	if err != nil {
+		_ = res.Body.Close()
 		return nil, 0, err
 	}
 
-	r := res.Body
+	r := &readCloser{
+		Reader: newBandwidthReader(ctx, res.Body, param.Bandwidth),
+		close:  res.Body.Close,
+	}
+
+	if s := sinker(ctx, param.Progress); s != nil {
+		pr := progress.NewReader(ctx, s, r.Reader, res.ContentLength)
+		r.Reader = pr
+
+		var once sync.Once
+		r.close = func() error {
+			err := res.Body.Close()
+			once.Do(func() { pr.Done(nil) })
+			return err
+		}
+	}
 
 	return r, res.ContentLength, nil
 }
 
+// WriteFile copies src to the given local file and optional w.
+// Progress is reported to s, or the context Sinker when nil.
 func (c *Client) WriteFile(ctx context.Context, file string, src io.Reader, size int64, s progress.Sinker, w io.Writer) error {
 	var err error
 
 	r := src
+	s = sinker(ctx, s)
 
 	fh, err := os.Create(file)
 	if err != nil {
@@ -972,12 +1030,19 @@ func (c *Client) DownloadFile(ctx context.Context, file string, u *url.URL, para
 		param = &DefaultDownload
 	}
 
-	rc, contentLength, err := c.Download(ctx, u, param)
+	s := sinker(ctx, param.Progress)
+
+	// Progress is reported by WriteFile rather than the Download reader
+	p := *param
+	p.Progress = nil
+
+	rc, contentLength, err := c.Download(progress.WithSinker(ctx, nil), u, &p)
 	if err != nil {
 		return err
 	}
+	defer rc.Close()
 
-	return c.WriteFile(ctx, file, rc, contentLength, param.Progress, param.Writer)
+	return c.WriteFile(ctx, file, rc, contentLength, s, param.Writer)
 }
 
 // execName gets the name of the executable for the current process
@@ -17,13 +17,22 @@ limitations under the License.
 package soap
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/progress"
 )
 
 type mockRT struct{}
@@ -229,3 +238,168 @@ func TestParseURL(t *testing.T) {
 		})
 	}
 }
+
+// progressRecorder is a progress.Sinker that records the last report before the channel is closed.
+type progressRecorder struct {
+	done chan progress.Report
+}
+
+func newProgressRecorder() *progressRecorder {
+	return &progressRecorder{done: make(chan progress.Report, 1)}
+}
+
+func (p *progressRecorder) Sink() chan<- progress.Report {
+	ch := make(chan progress.Report)
+	go func() {
+		var last progress.Report
+		for r := range ch {
+			last = r
+		}
+		p.done <- last
+	}()
+	return ch
+}
+
+func (p *progressRecorder) wait(t *testing.T) progress.Report {
+	select {
+	case r := <-p.done:
+		if r == nil {
+			t.Fatal("no progress reports")
+		}
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for progress")
+	}
+	return nil
+}
+
+func newTransferServer(t *testing.T, content []byte) (*Client, *url.URL) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			_, _ = w.Write(content)
+		case http.MethodPut:
+			b, err := io.ReadAll(r.Body)
+			if err != nil || !bytes.Equal(b, content) {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	u, err := url.Parse(s.URL + "/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewClient(u, true), u
+}
+
+func TestTransferContextProgress(t *testing.T) {
+	content := bytes.Repeat([]byte("govmomi"), 1024)
+	c, u := newTransferServer(t, content)
+
+	check := func(r progress.Report) {
+		if r.Error() != nil {
+			t.Error(r.Error())
+		}
+		if r.Percentage() != 100 {
+			t.Errorf("percentage=%f", r.Percentage())
+		}
+	}
+
+	// Upload
+	rec := newProgressRecorder()
+	ctx := progress.WithSinker(context.Background(), rec)
+	p := DefaultUpload
+	p.ContentLength = int64(len(content))
+	if err := c.Upload(ctx, bytes.NewReader(content), u, &p); err != nil {
+		t.Fatal(err)
+	}
+	check(rec.wait(t))
+
+	// Download
+	rec = newProgressRecorder()
+	ctx = progress.WithSinker(context.Background(), rec)
+	rc, _, err := c.Download(ctx, u, &DefaultDownload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.Copy(io.Discard, rc); err != nil {
+		t.Fatal(err)
+	}
+	_ = rc.Close()
+	check(rec.wait(t))
+
+	// DownloadFile, the explicit Sinker takes precedence over the context Sinker
+	rec = newProgressRecorder()
+	d := DefaultDownload
+	d.Progress = rec
+	ctx = progress.WithSinker(context.Background(), newProgressRecorder())
+	file := filepath.Join(t.TempDir(), "file")
+	if err = c.DownloadFile(ctx, file, u, &d); err != nil {
+		t.Fatal(err)
+	}
+	check(rec.wait(t))
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, content) {
+		t.Error("content mismatch")
+	}
+}
+
+func TestTransferBandwidth(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), 4096)
+	c, u := newTransferServer(t, content)
+	ctx := context.Background()
+
+	const limit = 8192 // 4096 bytes should take at least 500ms
+	min := 400 * time.Millisecond
+
+	p := DefaultUpload
+	p.ContentLength = int64(len(content))
+	p.Bandwidth = limit
+	start := time.Now()
+	if err := c.Upload(ctx, bytes.NewReader(content), u, &p); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < min {
+		t.Errorf("upload elapsed=%s", elapsed)
+	}
+
+	d := DefaultDownload
+	d.Bandwidth = limit
+	start = time.Now()
+	rc, _, err := c.Download(ctx, u, &d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, content) {
+		t.Error("content mismatch")
+	}
+	if elapsed := time.Since(start); elapsed < min {
+		t.Errorf("download elapsed=%s", elapsed)
+	}
+
+	// cancel a rate limited transfer
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	d.Bandwidth = 1024
+	rc, _, err = c.Download(ctx, u, &d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	if _, err = io.ReadAll(rc); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err=%v", err)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// RequiredFieldError is returned by ValidateRequest when a field required by the WSDL (minOccurs=1),
+// or by the method, is not set.
+type RequiredFieldError struct {
+	Method string // Method name, such as "CreateVM_Task"
+	Field  string // Field path relative to the method request type, such as "Config.Files"
+}
+
+func (e *RequiredFieldError) Error() string {
+	return fmt.Sprintf("%s: %s is required", e.Method, e.Field)
+}
+
+// requiredPaths are fields required by a method to be set to a non-zero value, but optional in the WSDL,
+// as the field type is also used by other methods or properties.
+var requiredPaths = map[string][]string{
+	"CreateVM_Task": {"Config.Files.VmPathName"},
+}
+
+// isSet returns false if the value of a required field v would be omitted or invalid when encoded.
+// Numeric, boolean and string fields are always considered set, as their zero value is valid,
+// with the exception of enum types, for which the empty string is not a valid value.
+// A ManagedObjectReference is considered not set when its Value is empty, the required fields
+// of other struct types are validated by validateValue.
+func isSet(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return false
+	case reflect.Pointer, reflect.Interface, reflect.Map:
+		return !v.IsNil()
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return true // base64Binary is encoded when empty
+		}
+		return v.Len() != 0
+	case reflect.String:
+		return v.Type().PkgPath() == "" || v.Len() != 0
+	case reflect.Struct:
+		if ref, ok := v.Interface().(types.ManagedObjectReference); ok {
+			return ref.Value != ""
+		}
+	}
+	return true
+}
+
+// ValidateRequest checks that the fields required by the WSDL are set in the request of a method body,
+// returning a *RequiredFieldError for the first field that is not.
+// The required fields of each type are generated from the WSDL minOccurs, see types.RequiredFields.
+// A required field is considered not set when it is nil or empty, see isSet.
+// Bodies without a request field ("Req") are not validated.
+func ValidateRequest(body any) error {
+	v := reflect.Indirect(reflect.ValueOf(body))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	field, ok := v.Type().FieldByName("Req")
+	if !ok {
+		return nil
+	}
+
+	req := v.FieldByIndex(field.Index)
+	if req.Kind() == reflect.Pointer && req.IsNil() {
+		return nil
+	}
+
+	// The method name is the local name of the xml tag, for example: `xml:"urn:vim25 CreateVM_Task,omitempty"`
+	method := strings.Split(field.Tag.Get("xml"), ",")[0]
+	if i := strings.LastIndexByte(method, ' '); i >= 0 {
+		method = method[i+1:]
+	}
+
+	name, ok := validateValue(req, "")
+	if !ok {
+		return &RequiredFieldError{Method: method, Field: name}
+	}
+
+	for _, path := range requiredPaths[method] {
+		if v := lookupPath(req, path); !v.IsValid() || v.IsZero() {
+			return &RequiredFieldError{Method: method, Field: path}
+		}
+	}
+
+	return nil
+}
+
+// lookupPath returns the value of the field path relative to v,
+// or an invalid Value if a pointer along the path is nil.
+func lookupPath(v reflect.Value, path string) reflect.Value {
+	for _, name := range strings.Split(path, ".") {
+		v = reflect.Indirect(v)
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+		v = v.FieldByName(name)
+	}
+	return v
+}
+
+// validateValue returns the path to the first required field of v that is not set and false,
+// or true if all required fields are set.
+func validateValue(v reflect.Value, path string) (string, bool) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return "", true
+		}
+		return validateValue(v.Elem(), path)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return "", true // []byte, types.ByteSlice
+		}
+		for i := 0; i < v.Len(); i++ {
+			if name, ok := validateValue(v.Index(i), path+"["+strconv.Itoa(i)+"]"); !ok {
+				return name, false
+			}
+		}
+	case reflect.Struct:
+		t := v.Type()
+		required := types.RequiredFields(t)
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			name := path
+			if !field.Anonymous {
+				if name != "" {
+					name += "."
+				}
+				name += field.Name
+			}
+
+			fv := v.Field(i)
+
+			if !field.Anonymous && slices.Contains(required, field.Name) && !isSet(fv) {
+				return name, false
+			}
+
+			if name, ok := validateValue(fv, name); !ok {
+				return name, false
+			}
+		}
+	}
+
+	return "", true
+}
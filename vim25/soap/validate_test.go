@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type retrievePropertiesBody struct {
+	Req    *types.RetrieveProperties         `xml:"urn:vim25 RetrieveProperties,omitempty"`
+	Res    *types.RetrievePropertiesResponse `xml:"RetrievePropertiesResponse,omitempty"`
+	Fault_ *Fault                            `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *retrievePropertiesBody) Fault() *Fault { return b.Fault_ }
+
+type reconfigureComputeResourceBody struct {
+	Req    *types.ReconfigureComputeResource_Task `xml:"urn:vim25 ReconfigureComputeResource_Task,omitempty"`
+	Fault_ *Fault                                 `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *reconfigureComputeResourceBody) Fault() *Fault { return b.Fault_ }
+
+type createVMBody struct {
+	Req    *types.CreateVM_Task `xml:"urn:vim25 CreateVM_Task,omitempty"`
+	Fault_ *Fault               `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *createVMBody) Fault() *Fault { return b.Fault_ }
+
+type setTaskStateBody struct {
+	Req    *types.SetTaskState `xml:"urn:vim25 SetTaskState,omitempty"`
+	Fault_ *Fault              `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *setTaskStateBody) Fault() *Fault { return b.Fault_ }
+
+func TestValidateRequest(t *testing.T) {
+	ref := types.ManagedObjectReference{Type: "Folder", Value: "group-d1"}
+	pc := types.ManagedObjectReference{Type: "PropertyCollector", Value: "propertyCollector"}
+	pool := types.ManagedObjectReference{Type: "ResourcePool", Value: "resgroup-1"}
+	cluster := types.ManagedObjectReference{Type: "ClusterComputeResource", Value: "domain-c1"}
+	task := types.ManagedObjectReference{Type: "Task", Value: "task-1"}
+
+	tests := []struct {
+		body  HasFault
+		field string
+	}{
+		{&retrievePropertiesBody{}, ""},
+		{&retrievePropertiesBody{Req: &types.RetrieveProperties{}}, "This"},
+		{&retrievePropertiesBody{Req: &types.RetrieveProperties{This: pc}}, "SpecSet"},
+		{&retrievePropertiesBody{Req: &types.RetrieveProperties{
+			This:    pc,
+			SpecSet: []types.PropertyFilterSpec{{PropSet: []types.PropertySpec{{Type: "Folder"}}}},
+		}}, "SpecSet[0].ObjectSet"},
+		{&retrievePropertiesBody{Req: &types.RetrieveProperties{
+			This: pc,
+			SpecSet: []types.PropertyFilterSpec{{
+				PropSet:   []types.PropertySpec{{Type: "Folder"}},
+				ObjectSet: []types.ObjectSpec{{}},
+			}},
+		}}, "SpecSet[0].ObjectSet[0].Obj"},
+		{&retrievePropertiesBody{Req: &types.RetrieveProperties{
+			This: pc,
+			SpecSet: []types.PropertyFilterSpec{{
+				PropSet:   []types.PropertySpec{{Type: "Folder"}},
+				ObjectSet: []types.ObjectSpec{{Obj: ref}},
+			}},
+		}}, ""},
+		{&reconfigureComputeResourceBody{Req: &types.ReconfigureComputeResource_Task{This: cluster}}, "Spec"},
+		{&reconfigureComputeResourceBody{Req: &types.ReconfigureComputeResource_Task{
+			This: cluster,
+			Spec: &types.ClusterConfigSpecEx{},
+		}}, ""},
+		{&setTaskStateBody{Req: &types.SetTaskState{This: task}}, "State"},
+		{&setTaskStateBody{Req: &types.SetTaskState{This: task, State: types.TaskInfoStateSuccess}}, ""},
+		{&createVMBody{Req: &types.CreateVM_Task{This: ref, Config: types.VirtualMachineConfigSpec{Name: "vm"}}}, "Pool"},
+		{&createVMBody{Req: &types.CreateVM_Task{This: ref, Pool: pool}}, "Config.Files.VmPathName"},
+		{&createVMBody{Req: &types.CreateVM_Task{This: ref, Pool: pool, Config: types.VirtualMachineConfigSpec{
+			Files: &types.VirtualMachineFileInfo{},
+		}}}, "Config.Files.VmPathName"},
+		{&createVMBody{Req: &types.CreateVM_Task{This: ref, Pool: pool, Config: types.VirtualMachineConfigSpec{
+			Files: &types.VirtualMachineFileInfo{VmPathName: "[LocalDS_0]"},
+		}}}, ""},
+	}
+
+	for i, test := range tests {
+		err := ValidateRequest(test.body)
+		if test.field == "" {
+			if err != nil {
+				t.Errorf("%d: %s", i, err)
+			}
+			continue
+		}
+
+		var rerr *RequiredFieldError
+		if !errors.As(err, &rerr) {
+			t.Fatalf("%d: err=%v", i, err)
+		}
+		if rerr.Field != test.field {
+			t.Errorf("%d: field=%s", i, rerr.Field)
+		}
+	}
+
+	err := ValidateRequest(&retrievePropertiesBody{Req: &types.RetrieveProperties{This: pc}})
+	if msg := err.Error(); msg != "RetrieveProperties: SpecSet is required" {
+		t.Errorf("error=%s", msg)
+	}
+
+	err = ValidateRequest(&createVMBody{Req: &types.CreateVM_Task{This: ref, Pool: pool}})
+	if msg := err.Error(); msg != "CreateVM_Task: Config.Files.VmPathName is required" {
+		t.Errorf("error=%s", msg)
+	}
+}
+
+func TestClientValidateRequests(t *testing.T) {
+	t.Setenv("GOVMOMI_VALIDATE_REQUESTS", "")
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer s.Close()
+
+	u, _ := url.Parse(s.URL)
+	c := NewClient(u, true)
+	body := &retrievePropertiesBody{Req: &types.RetrieveProperties{}}
+
+	var rerr *RequiredFieldError
+
+	err := c.RoundTrip(context.Background(), body, body)
+	if err == nil || errors.As(err, &rerr) {
+		t.Errorf("err=%v", err) // request sent, validation disabled by default
+	}
+
+	c.ValidateRequests(true)
+
+	err = c.RoundTrip(context.Background(), body, body)
+	if !errors.As(err, &rerr) {
+		t.Errorf("err=%v", err)
+	}
+
+	t.Setenv("GOVMOMI_VALIDATE_REQUESTS", "true")
+
+	c = NewClient(u, true)
+	if !c.NewServiceClient("/sdk", "vim25").validate {
+		t.Error("expected validation to be enabled")
+	}
+}
@@ -31,6 +31,10 @@ var (
 	// minAPIVersionForEnumValue is used to lookup the minimum API version for
 	// which an enum value is valid.
 	minAPIVersionForEnumValue = map[string]map[string]string{}
+
+	// requiredFields is used to lookup the fields of a type with minOccurs=1
+	// in the WSDL.
+	requiredFields = map[reflect.Type][]string{}
 )
 
 func Add(name string, kind reflect.Type) {
@@ -51,6 +55,16 @@ func AddMinAPIVersionForEnumValue(enumName, enumValue, minAPIVersion string) {
 	}
 }
 
+func AddRequiredFields(kind reflect.Type, fields ...string) {
+	requiredFields[kind] = fields
+}
+
+// RequiredFields returns the names of the fields of kind with minOccurs=1 in the WSDL.
+// Fields of an embedded base type are not included.
+func RequiredFields(kind reflect.Type) []string {
+	return requiredFields[kind]
+}
+
 type Func func(string) (reflect.Type, bool)
 
 func TypeFunc() Func {
@@ -0,0 +1,4274 @@
+/*
+Copyright (c) 2014-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import "reflect"
+
+func init() {
+	requiredFields[reflect.TypeOf((*AbandonHciWorkflow)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AbandonHciWorkflowRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AbdicateDomOwnership)(nil)).Elem()] = []string{"This", "Uuids"}
+	requiredFields[reflect.TypeOf((*AbdicateDomOwnershipRequestType)(nil)).Elem()] = []string{"This", "Uuids"}
+	requiredFields[reflect.TypeOf((*AbortCustomizationRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*AbortCustomization_Task)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*AbortCustomization_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AboutInfo)(nil)).Elem()] = []string{"Name", "FullName", "Vendor", "Version", "Build", "OsType", "ProductLineId", "ApiType", "ApiVersion"}
+	requiredFields[reflect.TypeOf((*AccountCreatedEvent)(nil)).Elem()] = []string{"Spec", "Group"}
+	requiredFields[reflect.TypeOf((*AccountRemovedEvent)(nil)).Elem()] = []string{"Account", "Group"}
+	requiredFields[reflect.TypeOf((*AccountUpdatedEvent)(nil)).Elem()] = []string{"Spec", "Group"}
+	requiredFields[reflect.TypeOf((*AcknowledgeAlarm)(nil)).Elem()] = []string{"This", "Alarm", "Entity"}
+	requiredFields[reflect.TypeOf((*AcknowledgeAlarmRequestType)(nil)).Elem()] = []string{"This", "Alarm", "Entity"}
+	requiredFields[reflect.TypeOf((*AcquireCimServicesTicket)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AcquireCimServicesTicketRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AcquireCimServicesTicketResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AcquireCloneTicket)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AcquireCloneTicketRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AcquireCloneTicketResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AcquireCredentialsInGuest)(nil)).Elem()] = []string{"This", "Vm", "RequestedAuth"}
+	requiredFields[reflect.TypeOf((*AcquireCredentialsInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "RequestedAuth"}
+	requiredFields[reflect.TypeOf((*AcquireCredentialsInGuestResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AcquireGenericServiceTicket)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*AcquireGenericServiceTicketRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*AcquireGenericServiceTicketResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AcquireLocalTicket)(nil)).Elem()] = []string{"This", "UserName"}
+	requiredFields[reflect.TypeOf((*AcquireLocalTicketRequestType)(nil)).Elem()] = []string{"This", "UserName"}
+	requiredFields[reflect.TypeOf((*AcquireLocalTicketResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AcquireMksTicket)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AcquireMksTicketRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AcquireMksTicketResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AcquireTicket)(nil)).Elem()] = []string{"This", "TicketType"}
+	requiredFields[reflect.TypeOf((*AcquireTicketRequestType)(nil)).Elem()] = []string{"This", "TicketType"}
+	requiredFields[reflect.TypeOf((*AcquireTicketResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddAuthorizationRole)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*AddAuthorizationRoleRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*AddAuthorizationRoleResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddCustomFieldDef)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*AddCustomFieldDefRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*AddCustomFieldDefResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddDVPortgroupRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*AddDVPortgroup_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*AddDVPortgroup_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddDisksRequestType)(nil)).Elem()] = []string{"This", "Disk"}
+	requiredFields[reflect.TypeOf((*AddDisks_Task)(nil)).Elem()] = []string{"This", "Disk"}
+	requiredFields[reflect.TypeOf((*AddDisks_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddFilter)(nil)).Elem()] = []string{"This", "ProviderId", "FilterName"}
+	requiredFields[reflect.TypeOf((*AddFilterEntities)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*AddFilterEntitiesRequestType)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*AddFilterRequestType)(nil)).Elem()] = []string{"This", "ProviderId", "FilterName"}
+	requiredFields[reflect.TypeOf((*AddFilterResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddGuestAlias)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Username", "MapCert", "Base64Cert", "AliasInfo"}
+	requiredFields[reflect.TypeOf((*AddGuestAliasRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Username", "MapCert", "Base64Cert", "AliasInfo"}
+	requiredFields[reflect.TypeOf((*AddHostRequestType)(nil)).Elem()] = []string{"This", "Spec", "AsConnected"}
+	requiredFields[reflect.TypeOf((*AddHost_Task)(nil)).Elem()] = []string{"This", "Spec", "AsConnected"}
+	requiredFields[reflect.TypeOf((*AddHost_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddInternetScsiSendTargets)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Targets"}
+	requiredFields[reflect.TypeOf((*AddInternetScsiSendTargetsRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Targets"}
+	requiredFields[reflect.TypeOf((*AddInternetScsiStaticTargets)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Targets"}
+	requiredFields[reflect.TypeOf((*AddInternetScsiStaticTargetsRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Targets"}
+	requiredFields[reflect.TypeOf((*AddKey)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*AddKeyRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*AddKeys)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AddKeysRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AddLicense)(nil)).Elem()] = []string{"This", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*AddLicenseRequestType)(nil)).Elem()] = []string{"This", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*AddLicenseResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddMonitoredEntities)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*AddMonitoredEntitiesRequestType)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*AddNetworkResourcePool)(nil)).Elem()] = []string{"This", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*AddNetworkResourcePoolRequestType)(nil)).Elem()] = []string{"This", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*AddPortGroup)(nil)).Elem()] = []string{"This", "Portgrp"}
+	requiredFields[reflect.TypeOf((*AddPortGroupRequestType)(nil)).Elem()] = []string{"This", "Portgrp"}
+	requiredFields[reflect.TypeOf((*AddServiceConsoleVirtualNic)(nil)).Elem()] = []string{"This", "Portgroup", "Nic"}
+	requiredFields[reflect.TypeOf((*AddServiceConsoleVirtualNicRequestType)(nil)).Elem()] = []string{"This", "Portgroup", "Nic"}
+	requiredFields[reflect.TypeOf((*AddServiceConsoleVirtualNicResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddStandaloneHostRequestType)(nil)).Elem()] = []string{"This", "Spec", "AddConnected"}
+	requiredFields[reflect.TypeOf((*AddStandaloneHost_Task)(nil)).Elem()] = []string{"This", "Spec", "AddConnected"}
+	requiredFields[reflect.TypeOf((*AddStandaloneHost_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddVirtualNic)(nil)).Elem()] = []string{"This", "Portgroup", "Nic"}
+	requiredFields[reflect.TypeOf((*AddVirtualNicRequestType)(nil)).Elem()] = []string{"This", "Portgroup", "Nic"}
+	requiredFields[reflect.TypeOf((*AddVirtualNicResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AddVirtualSwitch)(nil)).Elem()] = []string{"This", "VswitchName"}
+	requiredFields[reflect.TypeOf((*AddVirtualSwitchRequestType)(nil)).Elem()] = []string{"This", "VswitchName"}
+	requiredFields[reflect.TypeOf((*AffinityConfigured)(nil)).Elem()] = []string{"ConfiguredAffinity"}
+	requiredFields[reflect.TypeOf((*AffinityConfiguredFault)(nil)).Elem()] = []string{"ConfiguredAffinity"}
+	requiredFields[reflect.TypeOf((*AfterStartupTaskScheduler)(nil)).Elem()] = []string{"Minute"}
+	requiredFields[reflect.TypeOf((*AlarmAcknowledgedEvent)(nil)).Elem()] = []string{"Source", "Entity"}
+	requiredFields[reflect.TypeOf((*AlarmActionTriggeredEvent)(nil)).Elem()] = []string{"Source", "Entity"}
+	requiredFields[reflect.TypeOf((*AlarmClearedEvent)(nil)).Elem()] = []string{"Source", "Entity", "From"}
+	requiredFields[reflect.TypeOf((*AlarmCreatedEvent)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*AlarmDescription)(nil)).Elem()] = []string{"Expr", "StateOperator", "MetricOperator", "HostSystemConnectionState", "VirtualMachinePowerState", "EntityStatus", "Action"}
+	requiredFields[reflect.TypeOf((*AlarmEmailCompletedEvent)(nil)).Elem()] = []string{"Entity", "To"}
+	requiredFields[reflect.TypeOf((*AlarmEmailFailedEvent)(nil)).Elem()] = []string{"Entity", "To", "Reason"}
+	requiredFields[reflect.TypeOf((*AlarmEvent)(nil)).Elem()] = []string{"Alarm"}
+	requiredFields[reflect.TypeOf((*AlarmEventArgument)(nil)).Elem()] = []string{"Alarm"}
+	requiredFields[reflect.TypeOf((*AlarmInfo)(nil)).Elem()] = []string{"Key", "Alarm", "Entity", "LastModifiedTime", "LastModifiedUser", "CreationEventId"}
+	requiredFields[reflect.TypeOf((*AlarmReconfiguredEvent)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*AlarmRemovedEvent)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*AlarmScriptCompleteEvent)(nil)).Elem()] = []string{"Entity", "Script"}
+	requiredFields[reflect.TypeOf((*AlarmScriptFailedEvent)(nil)).Elem()] = []string{"Entity", "Script", "Reason"}
+	requiredFields[reflect.TypeOf((*AlarmSetting)(nil)).Elem()] = []string{"ToleranceRange", "ReportingFrequency"}
+	requiredFields[reflect.TypeOf((*AlarmSnmpCompletedEvent)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*AlarmSnmpFailedEvent)(nil)).Elem()] = []string{"Entity", "Reason"}
+	requiredFields[reflect.TypeOf((*AlarmSpec)(nil)).Elem()] = []string{"Name", "Description", "Enabled", "Expression"}
+	requiredFields[reflect.TypeOf((*AlarmState)(nil)).Elem()] = []string{"Key", "Entity", "Alarm", "OverallStatus", "Time"}
+	requiredFields[reflect.TypeOf((*AlarmStatusChangedEvent)(nil)).Elem()] = []string{"Source", "Entity", "From", "To"}
+	requiredFields[reflect.TypeOf((*AlarmTriggeringAction)(nil)).Elem()] = []string{"Action", "Green2yellow", "Yellow2red", "Red2yellow", "Yellow2green"}
+	requiredFields[reflect.TypeOf((*AlarmTriggeringActionTransitionSpec)(nil)).Elem()] = []string{"StartState", "FinalState", "Repeats"}
+	requiredFields[reflect.TypeOf((*AllocateIpv4Address)(nil)).Elem()] = []string{"This", "Dc", "PoolId", "AllocationId"}
+	requiredFields[reflect.TypeOf((*AllocateIpv4AddressRequestType)(nil)).Elem()] = []string{"This", "Dc", "PoolId", "AllocationId"}
+	requiredFields[reflect.TypeOf((*AllocateIpv4AddressResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AllocateIpv6Address)(nil)).Elem()] = []string{"This", "Dc", "PoolId", "AllocationId"}
+	requiredFields[reflect.TypeOf((*AllocateIpv6AddressRequestType)(nil)).Elem()] = []string{"This", "Dc", "PoolId", "AllocationId"}
+	requiredFields[reflect.TypeOf((*AllocateIpv6AddressResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AlreadyBeingManaged)(nil)).Elem()] = []string{"IpAddress"}
+	requiredFields[reflect.TypeOf((*AlreadyBeingManagedFault)(nil)).Elem()] = []string{"IpAddress"}
+	requiredFields[reflect.TypeOf((*AlreadyConnected)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*AlreadyConnectedFault)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*AndAlarmExpression)(nil)).Elem()] = []string{"Expression"}
+	requiredFields[reflect.TypeOf((*AnswerFile)(nil)).Elem()] = []string{"CreatedTime", "ModifiedTime"}
+	requiredFields[reflect.TypeOf((*AnswerFileSerializedCreateSpec)(nil)).Elem()] = []string{"AnswerFileConfigString"}
+	requiredFields[reflect.TypeOf((*AnswerFileStatusError)(nil)).Elem()] = []string{"UserInputPath", "ErrMsg"}
+	requiredFields[reflect.TypeOf((*AnswerFileStatusResult)(nil)).Elem()] = []string{"CheckedTime", "Host", "Status"}
+	requiredFields[reflect.TypeOf((*AnswerFileUpdateFailed)(nil)).Elem()] = []string{"Failure"}
+	requiredFields[reflect.TypeOf((*AnswerFileUpdateFailedFault)(nil)).Elem()] = []string{"Failure"}
+	requiredFields[reflect.TypeOf((*AnswerFileUpdateFailure)(nil)).Elem()] = []string{"UserInputPath", "ErrMsg"}
+	requiredFields[reflect.TypeOf((*AnswerVM)(nil)).Elem()] = []string{"This", "QuestionId", "AnswerChoice"}
+	requiredFields[reflect.TypeOf((*AnswerVMRequestType)(nil)).Elem()] = []string{"This", "QuestionId", "AnswerChoice"}
+	requiredFields[reflect.TypeOf((*ApplyEntitiesConfigRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ApplyEntitiesConfig_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ApplyEntitiesConfig_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ApplyEvcModeVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ApplyEvcModeVM_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ApplyEvcModeVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ApplyHostConfigRequestType)(nil)).Elem()] = []string{"This", "Host", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*ApplyHostConfig_Task)(nil)).Elem()] = []string{"This", "Host", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*ApplyHostConfig_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ApplyHostProfileConfigurationResult)(nil)).Elem()] = []string{"StartTime", "CompleteTime", "Host", "Status"}
+	requiredFields[reflect.TypeOf((*ApplyHostProfileConfigurationSpec)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*ApplyProfile)(nil)).Elem()] = []string{"Enabled"}
+	requiredFields[reflect.TypeOf((*ApplyRecommendation)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*ApplyRecommendationRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*ApplyStorageDrsRecommendationRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*ApplyStorageDrsRecommendationToPodRequestType)(nil)).Elem()] = []string{"This", "Pod", "Key"}
+	requiredFields[reflect.TypeOf((*ApplyStorageDrsRecommendationToPod_Task)(nil)).Elem()] = []string{"This", "Pod", "Key"}
+	requiredFields[reflect.TypeOf((*ApplyStorageDrsRecommendationToPod_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ApplyStorageDrsRecommendation_Task)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*ApplyStorageDrsRecommendation_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AreAlarmActionsEnabled)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*AreAlarmActionsEnabledRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*AreAlarmActionsEnabledResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ArrayUpdateSpec)(nil)).Elem()] = []string{"Operation"}
+	requiredFields[reflect.TypeOf((*AssignUserToGroup)(nil)).Elem()] = []string{"This", "User", "Group"}
+	requiredFields[reflect.TypeOf((*AssignUserToGroupRequestType)(nil)).Elem()] = []string{"This", "User", "Group"}
+	requiredFields[reflect.TypeOf((*AssociateProfile)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*AssociateProfileRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*AttachDiskRequestType)(nil)).Elem()] = []string{"This", "DiskId", "Datastore"}
+	requiredFields[reflect.TypeOf((*AttachDisk_Task)(nil)).Elem()] = []string{"This", "DiskId", "Datastore"}
+	requiredFields[reflect.TypeOf((*AttachDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AttachScsiLun)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*AttachScsiLunExRequestType)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*AttachScsiLunEx_Task)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*AttachScsiLunEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*AttachScsiLunRequestType)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*AttachTagToVStorageObject)(nil)).Elem()] = []string{"This", "Id", "Category", "Tag"}
+	requiredFields[reflect.TypeOf((*AttachTagToVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Category", "Tag"}
+	requiredFields[reflect.TypeOf((*AttachVmfsExtent)(nil)).Elem()] = []string{"This", "VmfsPath", "Extent"}
+	requiredFields[reflect.TypeOf((*AttachVmfsExtentRequestType)(nil)).Elem()] = []string{"This", "VmfsPath", "Extent"}
+	requiredFields[reflect.TypeOf((*AuthorizationDescription)(nil)).Elem()] = []string{"Privilege", "PrivilegeGroup"}
+	requiredFields[reflect.TypeOf((*AuthorizationPrivilege)(nil)).Elem()] = []string{"PrivId", "OnParent", "Name", "PrivGroupName"}
+	requiredFields[reflect.TypeOf((*AuthorizationRole)(nil)).Elem()] = []string{"RoleId", "System", "Name", "Info"}
+	requiredFields[reflect.TypeOf((*AutoStartPowerInfo)(nil)).Elem()] = []string{"Key", "StartOrder", "StartDelay", "WaitForHeartbeat", "StartAction", "StopDelay", "StopAction"}
+	requiredFields[reflect.TypeOf((*AutoStartPowerOff)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AutoStartPowerOffRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AutoStartPowerOn)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*AutoStartPowerOnRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*BackupBlobReadFailure)(nil)).Elem()] = []string{"EntityName", "EntityType", "Fault"}
+	requiredFields[reflect.TypeOf((*BackupBlobReadFailureFault)(nil)).Elem()] = []string{"EntityName", "EntityType", "Fault"}
+	requiredFields[reflect.TypeOf((*BackupBlobWriteFailure)(nil)).Elem()] = []string{"EntityName", "EntityType", "Fault"}
+	requiredFields[reflect.TypeOf((*BackupBlobWriteFailureFault)(nil)).Elem()] = []string{"EntityName", "EntityType", "Fault"}
+	requiredFields[reflect.TypeOf((*BackupFirmwareConfiguration)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*BackupFirmwareConfigurationRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*BackupFirmwareConfigurationResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*BadUsernameSessionEvent)(nil)).Elem()] = []string{"IpAddress"}
+	requiredFields[reflect.TypeOf((*BaseConfigInfo)(nil)).Elem()] = []string{"Id", "Name", "CreateTime", "Backing"}
+	requiredFields[reflect.TypeOf((*BaseConfigInfoBackingInfo)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*BaseConfigInfoDiskFileBackingInfo)(nil)).Elem()] = []string{"ProvisioningType"}
+	requiredFields[reflect.TypeOf((*BaseConfigInfoFileBackingInfo)(nil)).Elem()] = []string{"FilePath"}
+	requiredFields[reflect.TypeOf((*BaseConfigInfoRawDiskMappingBackingInfo)(nil)).Elem()] = []string{"LunUuid", "CompatibilityMode"}
+	requiredFields[reflect.TypeOf((*BatchAddHostsToClusterRequestType)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*BatchAddHostsToCluster_Task)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*BatchAddHostsToCluster_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*BatchAddStandaloneHostsRequestType)(nil)).Elem()] = []string{"This", "AddConnected"}
+	requiredFields[reflect.TypeOf((*BatchAddStandaloneHosts_Task)(nil)).Elem()] = []string{"This", "AddConnected"}
+	requiredFields[reflect.TypeOf((*BatchAddStandaloneHosts_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*BatchQueryConnectInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*BatchQueryConnectInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*BatchResult)(nil)).Elem()] = []string{"Result", "HostKey"}
+	requiredFields[reflect.TypeOf((*BindVnic)(nil)).Elem()] = []string{"This", "IScsiHbaName", "VnicDevice"}
+	requiredFields[reflect.TypeOf((*BindVnicRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaName", "VnicDevice"}
+	requiredFields[reflect.TypeOf((*BoolOption)(nil)).Elem()] = []string{"Supported", "DefaultValue"}
+	requiredFields[reflect.TypeOf((*BrowseDiagnosticLog)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*BrowseDiagnosticLogRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*BrowseDiagnosticLogResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CanProvisionObjects)(nil)).Elem()] = []string{"This", "Npbs"}
+	requiredFields[reflect.TypeOf((*CanProvisionObjectsRequestType)(nil)).Elem()] = []string{"This", "Npbs"}
+	requiredFields[reflect.TypeOf((*CanProvisionObjectsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CancelRecommendation)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*CancelRecommendationRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*CancelRetrievePropertiesEx)(nil)).Elem()] = []string{"This", "Token"}
+	requiredFields[reflect.TypeOf((*CancelRetrievePropertiesExRequestType)(nil)).Elem()] = []string{"This", "Token"}
+	requiredFields[reflect.TypeOf((*CancelStorageDrsRecommendation)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*CancelStorageDrsRecommendationRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*CancelTask)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CancelTaskRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CancelWaitForUpdates)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CancelWaitForUpdatesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CannotAccessVmConfig)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*CannotAccessVmConfigFault)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*CannotAccessVmDevice)(nil)).Elem()] = []string{"Device", "Backing", "Connected"}
+	requiredFields[reflect.TypeOf((*CannotAccessVmDisk)(nil)).Elem()] = []string{"Fault"}
+	requiredFields[reflect.TypeOf((*CannotChangeDrsBehaviorForFtSecondary)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotChangeDrsBehaviorForFtSecondaryFault)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotChangeHaSettingsForFtSecondary)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotChangeHaSettingsForFtSecondaryFault)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotComputeFTCompatibleHosts)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotComputeFTCompatibleHostsFault)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotDisconnectHostWithFaultToleranceVm)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*CannotDisconnectHostWithFaultToleranceVmFault)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*CannotMoveFaultToleranceVm)(nil)).Elem()] = []string{"MoveType", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotMoveFaultToleranceVmFault)(nil)).Elem()] = []string{"MoveType", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotMoveVmWithDeltaDisk)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*CannotMoveVmWithDeltaDiskFault)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*CannotPowerOffVmInCluster)(nil)).Elem()] = []string{"Operation", "Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotPowerOffVmInClusterFault)(nil)).Elem()] = []string{"Operation", "Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*CannotUseNetwork)(nil)).Elem()] = []string{"Device", "Backing", "Connected", "Reason"}
+	requiredFields[reflect.TypeOf((*CannotUseNetworkFault)(nil)).Elem()] = []string{"Device", "Backing", "Connected", "Reason"}
+	requiredFields[reflect.TypeOf((*Capability)(nil)).Elem()] = []string{"ProvisioningSupported", "MultiHostSupported", "UserShellAccessSupported"}
+	requiredFields[reflect.TypeOf((*CertMgrRefreshCACertificatesAndCRLsRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*CertMgrRefreshCACertificatesAndCRLs_Task)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*CertMgrRefreshCACertificatesAndCRLs_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CertMgrRefreshCertificatesRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*CertMgrRefreshCertificates_Task)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*CertMgrRefreshCertificates_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CertMgrRevokeCertificatesRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*CertMgrRevokeCertificates_Task)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*CertMgrRevokeCertificates_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ChangeAccessMode)(nil)).Elem()] = []string{"This", "Principal", "IsGroup", "AccessMode"}
+	requiredFields[reflect.TypeOf((*ChangeAccessModeRequestType)(nil)).Elem()] = []string{"This", "Principal", "IsGroup", "AccessMode"}
+	requiredFields[reflect.TypeOf((*ChangeFileAttributesInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "GuestFilePath", "FileAttributes"}
+	requiredFields[reflect.TypeOf((*ChangeFileAttributesInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "GuestFilePath", "FileAttributes"}
+	requiredFields[reflect.TypeOf((*ChangeKeyRequestType)(nil)).Elem()] = []string{"This", "NewKey"}
+	requiredFields[reflect.TypeOf((*ChangeKey_Task)(nil)).Elem()] = []string{"This", "NewKey"}
+	requiredFields[reflect.TypeOf((*ChangeKey_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ChangeLockdownMode)(nil)).Elem()] = []string{"This", "Mode"}
+	requiredFields[reflect.TypeOf((*ChangeLockdownModeRequestType)(nil)).Elem()] = []string{"This", "Mode"}
+	requiredFields[reflect.TypeOf((*ChangeNFSUserPassword)(nil)).Elem()] = []string{"This", "Password"}
+	requiredFields[reflect.TypeOf((*ChangeNFSUserPasswordRequestType)(nil)).Elem()] = []string{"This", "Password"}
+	requiredFields[reflect.TypeOf((*ChangeOwner)(nil)).Elem()] = []string{"This", "Name", "Owner"}
+	requiredFields[reflect.TypeOf((*ChangeOwnerRequestType)(nil)).Elem()] = []string{"This", "Name", "Owner"}
+	requiredFields[reflect.TypeOf((*ChangePassword)(nil)).Elem()] = []string{"This", "User", "OldPassword", "NewPassword"}
+	requiredFields[reflect.TypeOf((*ChangePasswordRequestType)(nil)).Elem()] = []string{"This", "User", "OldPassword", "NewPassword"}
+	requiredFields[reflect.TypeOf((*CheckAddHostEvcRequestType)(nil)).Elem()] = []string{"This", "CnxSpec"}
+	requiredFields[reflect.TypeOf((*CheckAddHostEvc_Task)(nil)).Elem()] = []string{"This", "CnxSpec"}
+	requiredFields[reflect.TypeOf((*CheckAddHostEvc_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckAnswerFileStatusRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*CheckAnswerFileStatus_Task)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*CheckAnswerFileStatus_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckCloneRequestType)(nil)).Elem()] = []string{"This", "Vm", "Folder", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckClone_Task)(nil)).Elem()] = []string{"This", "Vm", "Folder", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckClone_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckCompatibilityRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*CheckCompatibility_Task)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*CheckCompatibility_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckComplianceRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CheckCompliance_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CheckCompliance_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckConfigureEvcModeRequestType)(nil)).Elem()] = []string{"This", "EvcModeKey"}
+	requiredFields[reflect.TypeOf((*CheckConfigureEvcMode_Task)(nil)).Elem()] = []string{"This", "EvcModeKey"}
+	requiredFields[reflect.TypeOf((*CheckConfigureEvcMode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckCustomizationResources)(nil)).Elem()] = []string{"This", "GuestOs"}
+	requiredFields[reflect.TypeOf((*CheckCustomizationResourcesRequestType)(nil)).Elem()] = []string{"This", "GuestOs"}
+	requiredFields[reflect.TypeOf((*CheckCustomizationSpec)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckCustomizationSpecRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckForUpdates)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CheckForUpdatesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CheckHostPatchRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CheckHostPatch_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CheckHostPatch_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckInstantCloneRequestType)(nil)).Elem()] = []string{"This", "Vm", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckInstantClone_Task)(nil)).Elem()] = []string{"This", "Vm", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckInstantClone_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckLicenseFeature)(nil)).Elem()] = []string{"This", "FeatureKey"}
+	requiredFields[reflect.TypeOf((*CheckLicenseFeatureRequestType)(nil)).Elem()] = []string{"This", "FeatureKey"}
+	requiredFields[reflect.TypeOf((*CheckLicenseFeatureResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckMigrateRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*CheckMigrate_Task)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*CheckMigrate_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckPowerOnRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*CheckPowerOn_Task)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*CheckPowerOn_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckProfileComplianceRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CheckProfileCompliance_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CheckProfileCompliance_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckRelocateRequestType)(nil)).Elem()] = []string{"This", "Vm", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckRelocate_Task)(nil)).Elem()] = []string{"This", "Vm", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckRelocate_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CheckVmConfigRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckVmConfig_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CheckVmConfig_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ChoiceOption)(nil)).Elem()] = []string{"ChoiceInfo"}
+	requiredFields[reflect.TypeOf((*ClearComplianceStatus)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ClearComplianceStatusRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ClearNFSUser)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ClearNFSUserRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ClearSystemEventLog)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ClearSystemEventLogRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ClearTriggeredAlarms)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*ClearTriggeredAlarmsRequestType)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*ClearVStorageObjectControlFlags)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*ClearVStorageObjectControlFlagsRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*CloneSession)(nil)).Elem()] = []string{"This", "CloneTicket"}
+	requiredFields[reflect.TypeOf((*CloneSessionRequestType)(nil)).Elem()] = []string{"This", "CloneTicket"}
+	requiredFields[reflect.TypeOf((*CloneSessionResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CloneVAppRequestType)(nil)).Elem()] = []string{"This", "Name", "Target", "Spec"}
+	requiredFields[reflect.TypeOf((*CloneVApp_Task)(nil)).Elem()] = []string{"This", "Name", "Target", "Spec"}
+	requiredFields[reflect.TypeOf((*CloneVApp_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CloneVMRequestType)(nil)).Elem()] = []string{"This", "Folder", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CloneVM_Task)(nil)).Elem()] = []string{"This", "Folder", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CloneVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CloneVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*CloneVStorageObject_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*CloneVStorageObject_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CloseInventoryViewFolder)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*CloseInventoryViewFolderRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*ClusterAction)(nil)).Elem()] = []string{"Type"}
+	requiredFields[reflect.TypeOf((*ClusterActionHistory)(nil)).Elem()] = []string{"Action", "Time"}
+	requiredFields[reflect.TypeOf((*ClusterAffinityRuleSpec)(nil)).Elem()] = []string{"Vm"}
+	requiredFields[reflect.TypeOf((*ClusterAntiAffinityRuleSpec)(nil)).Elem()] = []string{"Vm"}
+	requiredFields[reflect.TypeOf((*ClusterAttemptedVmInfo)(nil)).Elem()] = []string{"Vm"}
+	requiredFields[reflect.TypeOf((*ClusterClusterInitialPlacementAction)(nil)).Elem()] = []string{"Pool"}
+	requiredFields[reflect.TypeOf((*ClusterComplianceCheckedEvent)(nil)).Elem()] = []string{"Profile"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceDVSConfigurationValidation)(nil)).Elem()] = []string{"IsDvsValid", "IsDvpgValid"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceDVSSetting)(nil)).Elem()] = []string{"DvSwitch"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceDVSSettingDVPortgroupToServiceMapping)(nil)).Elem()] = []string{"DvPortgroup", "Service"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceDvsProfileDVPortgroupSpecToServiceMapping)(nil)).Elem()] = []string{"Service"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceHCIConfigInfo)(nil)).Elem()] = []string{"WorkflowState"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceHostConfigurationInput)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceHostConfigurationValidation)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceHostEvacuationInfo)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceHostVmkNicInfo)(nil)).Elem()] = []string{"NicSpec", "Service"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceSummary)(nil)).Elem()] = []string{"CurrentFailoverLevel", "NumVmotions"}
+	requiredFields[reflect.TypeOf((*ClusterComputeResourceVcsSlots)(nil)).Elem()] = []string{"Host", "TotalSlots"}
+	requiredFields[reflect.TypeOf((*ClusterConfigInfo)(nil)).Elem()] = []string{"DasConfig", "DrsConfig"}
+	requiredFields[reflect.TypeOf((*ClusterConfigInfoEx)(nil)).Elem()] = []string{"DasConfig", "DrsConfig"}
+	requiredFields[reflect.TypeOf((*ClusterCreatedEvent)(nil)).Elem()] = []string{"Parent"}
+	requiredFields[reflect.TypeOf((*ClusterDasAamNodeState)(nil)).Elem()] = []string{"Host", "Name", "ConfigState", "RuntimeState"}
+	requiredFields[reflect.TypeOf((*ClusterDasAdvancedRuntimeInfoVmcpCapabilityInfo)(nil)).Elem()] = []string{"StorageAPDSupported", "StoragePDLSupported"}
+	requiredFields[reflect.TypeOf((*ClusterDasDataSummary)(nil)).Elem()] = []string{"HostListVersion", "ClusterConfigVersion", "CompatListVersion"}
+	requiredFields[reflect.TypeOf((*ClusterDasFailoverLevelAdvancedRuntimeInfo)(nil)).Elem()] = []string{"SlotInfo", "TotalSlots", "UsedSlots", "UnreservedSlots", "TotalVms", "TotalHosts", "TotalGoodHosts"}
+	requiredFields[reflect.TypeOf((*ClusterDasFailoverLevelAdvancedRuntimeInfoHostSlots)(nil)).Elem()] = []string{"Host", "Slots"}
+	requiredFields[reflect.TypeOf((*ClusterDasFailoverLevelAdvancedRuntimeInfoSlotInfo)(nil)).Elem()] = []string{"NumVcpus", "CpuMHz", "MemoryMB"}
+	requiredFields[reflect.TypeOf((*ClusterDasFailoverLevelAdvancedRuntimeInfoVmSlots)(nil)).Elem()] = []string{"Vm", "Slots"}
+	requiredFields[reflect.TypeOf((*ClusterDasFdmHostState)(nil)).Elem()] = []string{"State"}
+	requiredFields[reflect.TypeOf((*ClusterDasHostRecommendation)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*ClusterDasVmConfigInfo)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*ClusterDependencyRuleInfo)(nil)).Elem()] = []string{"VmGroup", "DependsOnVmGroup"}
+	requiredFields[reflect.TypeOf((*ClusterDpmHostConfigInfo)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*ClusterDrsFaults)(nil)).Elem()] = []string{"Reason", "FaultsByVm"}
+	requiredFields[reflect.TypeOf((*ClusterDrsFaultsFaultsByVm)(nil)).Elem()] = []string{"Fault"}
+	requiredFields[reflect.TypeOf((*ClusterDrsMigration)(nil)).Elem()] = []string{"Key", "Time", "Vm", "Source", "Destination"}
+	requiredFields[reflect.TypeOf((*ClusterDrsRecommendation)(nil)).Elem()] = []string{"Key", "Rating", "Reason", "ReasonText", "MigrationList"}
+	requiredFields[reflect.TypeOf((*ClusterDrsVmConfigInfo)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*ClusterEVCManagerCheckResult)(nil)).Elem()] = []string{"EvcModeKey", "Error"}
+	requiredFields[reflect.TypeOf((*ClusterEVCManagerEVCState)(nil)).Elem()] = []string{"SupportedEVCMode"}
+	requiredFields[reflect.TypeOf((*ClusterEnterMaintenanceMode)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*ClusterEnterMaintenanceModeRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*ClusterEnterMaintenanceModeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ClusterFailoverHostAdmissionControlInfoHostStatus)(nil)).Elem()] = []string{"Host", "Status"}
+	requiredFields[reflect.TypeOf((*ClusterFailoverLevelAdmissionControlInfo)(nil)).Elem()] = []string{"CurrentFailoverLevel"}
+	requiredFields[reflect.TypeOf((*ClusterFailoverLevelAdmissionControlPolicy)(nil)).Elem()] = []string{"FailoverLevel"}
+	requiredFields[reflect.TypeOf((*ClusterFailoverResourcesAdmissionControlInfo)(nil)).Elem()] = []string{"CurrentCpuFailoverResourcesPercent", "CurrentMemoryFailoverResourcesPercent"}
+	requiredFields[reflect.TypeOf((*ClusterFailoverResourcesAdmissionControlPolicy)(nil)).Elem()] = []string{"CpuFailoverResourcesPercent", "MemoryFailoverResourcesPercent"}
+	requiredFields[reflect.TypeOf((*ClusterFixedSizeSlotPolicy)(nil)).Elem()] = []string{"Cpu", "Memory"}
+	requiredFields[reflect.TypeOf((*ClusterFtVmHostRuleInfo)(nil)).Elem()] = []string{"VmGroupName"}
+	requiredFields[reflect.TypeOf((*ClusterGroupInfo)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*ClusterHostInfraUpdateHaModeAction)(nil)).Elem()] = []string{"OperationType"}
+	requiredFields[reflect.TypeOf((*ClusterHostPowerAction)(nil)).Elem()] = []string{"OperationType"}
+	requiredFields[reflect.TypeOf((*ClusterHostRecommendation)(nil)).Elem()] = []string{"Host", "Rating"}
+	requiredFields[reflect.TypeOf((*ClusterInitialPlacementAction)(nil)).Elem()] = []string{"TargetHost"}
+	requiredFields[reflect.TypeOf((*ClusterIoFilterInfo)(nil)).Elem()] = []string{"OpType"}
+	requiredFields[reflect.TypeOf((*ClusterNetworkConfigSpec)(nil)).Elem()] = []string{"NetworkPortGroup", "IpSettings"}
+	requiredFields[reflect.TypeOf((*ClusterNotAttemptedVmInfo)(nil)).Elem()] = []string{"Vm", "Fault"}
+	requiredFields[reflect.TypeOf((*ClusterPreemptibleVmPairInfo)(nil)).Elem()] = []string{"MonitoredVm", "PreemptibleVm"}
+	requiredFields[reflect.TypeOf((*ClusterRecommendation)(nil)).Elem()] = []string{"Key", "Type", "Time", "Rating", "Reason", "ReasonText"}
+	requiredFields[reflect.TypeOf((*ClusterResourceUsageSummary)(nil)).Elem()] = []string{"CpuUsedMHz", "CpuCapacityMHz", "MemUsedMB", "MemCapacityMB", "StorageUsedMB", "StorageCapacityMB"}
+	requiredFields[reflect.TypeOf((*ClusterStatusChangedEvent)(nil)).Elem()] = []string{"OldStatus", "NewStatus"}
+	requiredFields[reflect.TypeOf((*ClusterUsageSummary)(nil)).Elem()] = []string{"TotalCpuCapacityMhz", "TotalMemCapacityMB", "CpuReservationMhz", "MemReservationMB", "CpuDemandMhz", "MemDemandMB", "StatsGenNumber", "CpuEntitledMhz", "MemEntitledMB", "PoweredOffVmCount", "TotalVmCount"}
+	requiredFields[reflect.TypeOf((*ClusterVmOrchestrationInfo)(nil)).Elem()] = []string{"Vm", "VmReadiness"}
+	requiredFields[reflect.TypeOf((*ComplianceFailure)(nil)).Elem()] = []string{"FailureType", "Message"}
+	requiredFields[reflect.TypeOf((*ComplianceFailureComplianceFailureValues)(nil)).Elem()] = []string{"ComparisonIdentifier"}
+	requiredFields[reflect.TypeOf((*ComplianceLocator)(nil)).Elem()] = []string{"ExpressionName", "ApplyPath"}
+	requiredFields[reflect.TypeOf((*ComplianceProfile)(nil)).Elem()] = []string{"Expression", "RootExpression"}
+	requiredFields[reflect.TypeOf((*ComplianceResult)(nil)).Elem()] = []string{"ComplianceStatus"}
+	requiredFields[reflect.TypeOf((*CompositeHostProfileRequestType)(nil)).Elem()] = []string{"This", "Source"}
+	requiredFields[reflect.TypeOf((*CompositeHostProfile_Task)(nil)).Elem()] = []string{"This", "Source"}
+	requiredFields[reflect.TypeOf((*CompositeHostProfile_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ComputeDiskPartitionInfo)(nil)).Elem()] = []string{"This", "DevicePath", "Layout"}
+	requiredFields[reflect.TypeOf((*ComputeDiskPartitionInfoForResize)(nil)).Elem()] = []string{"This", "Partition", "BlockRange"}
+	requiredFields[reflect.TypeOf((*ComputeDiskPartitionInfoForResizeRequestType)(nil)).Elem()] = []string{"This", "Partition", "BlockRange"}
+	requiredFields[reflect.TypeOf((*ComputeDiskPartitionInfoForResizeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ComputeDiskPartitionInfoRequestType)(nil)).Elem()] = []string{"This", "DevicePath", "Layout"}
+	requiredFields[reflect.TypeOf((*ComputeDiskPartitionInfoResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ComputeResourceConfigInfo)(nil)).Elem()] = []string{"VmSwapPlacement"}
+	requiredFields[reflect.TypeOf((*ComputeResourceEventArgument)(nil)).Elem()] = []string{"ComputeResource"}
+	requiredFields[reflect.TypeOf((*ComputeResourceHostSPBMLicenseInfo)(nil)).Elem()] = []string{"Host", "LicenseState"}
+	requiredFields[reflect.TypeOf((*ComputeResourceHostSeedSpec)(nil)).Elem()] = []string{"SingleHostSpec"}
+	requiredFields[reflect.TypeOf((*ComputeResourceSummary)(nil)).Elem()] = []string{"TotalCpu", "TotalMemory", "NumCpuCores", "NumCpuThreads", "EffectiveCpu", "EffectiveMemory", "NumHosts", "NumEffectiveHosts", "OverallStatus"}
+	requiredFields[reflect.TypeOf((*ConfigTarget)(nil)).Elem()] = []string{"NumCpus", "NumCpuCores", "NumNumaNodes", "MaxMemMBOptimalPerf"}
+	requiredFields[reflect.TypeOf((*ConfigureCryptoKey)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ConfigureCryptoKeyRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ConfigureDatastoreIORMRequestType)(nil)).Elem()] = []string{"This", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*ConfigureDatastoreIORM_Task)(nil)).Elem()] = []string{"This", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*ConfigureDatastoreIORM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ConfigureDatastorePrincipal)(nil)).Elem()] = []string{"This", "UserName"}
+	requiredFields[reflect.TypeOf((*ConfigureDatastorePrincipalRequestType)(nil)).Elem()] = []string{"This", "UserName"}
+	requiredFields[reflect.TypeOf((*ConfigureEvcModeRequestType)(nil)).Elem()] = []string{"This", "EvcModeKey"}
+	requiredFields[reflect.TypeOf((*ConfigureEvcMode_Task)(nil)).Elem()] = []string{"This", "EvcModeKey"}
+	requiredFields[reflect.TypeOf((*ConfigureEvcMode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ConfigureHCIRequestType)(nil)).Elem()] = []string{"This", "ClusterSpec"}
+	requiredFields[reflect.TypeOf((*ConfigureHCI_Task)(nil)).Elem()] = []string{"This", "ClusterSpec"}
+	requiredFields[reflect.TypeOf((*ConfigureHCI_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ConfigureHostCacheRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ConfigureHostCache_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ConfigureHostCache_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ConfigureLicenseSource)(nil)).Elem()] = []string{"This", "LicenseSource"}
+	requiredFields[reflect.TypeOf((*ConfigureLicenseSourceRequestType)(nil)).Elem()] = []string{"This", "LicenseSource"}
+	requiredFields[reflect.TypeOf((*ConfigurePowerPolicy)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*ConfigurePowerPolicyRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*ConfigureStorageDrsForPodRequestType)(nil)).Elem()] = []string{"This", "Pod", "Spec", "Modify"}
+	requiredFields[reflect.TypeOf((*ConfigureStorageDrsForPod_Task)(nil)).Elem()] = []string{"This", "Pod", "Spec", "Modify"}
+	requiredFields[reflect.TypeOf((*ConfigureStorageDrsForPod_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ConfigureVFlashResourceExRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ConfigureVFlashResourceEx_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ConfigureVFlashResourceEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ConfigureVchaRequestType)(nil)).Elem()] = []string{"This", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*ConfigureVcha_Task)(nil)).Elem()] = []string{"This", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*ConfigureVcha_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ConflictingConfiguration)(nil)).Elem()] = []string{"ConfigInConflict"}
+	requiredFields[reflect.TypeOf((*ConflictingConfigurationConfig)(nil)).Elem()] = []string{"PropertyPath"}
+	requiredFields[reflect.TypeOf((*ConflictingConfigurationFault)(nil)).Elem()] = []string{"ConfigInConflict"}
+	requiredFields[reflect.TypeOf((*ConflictingDatastoreFound)(nil)).Elem()] = []string{"Name", "Url"}
+	requiredFields[reflect.TypeOf((*ConflictingDatastoreFoundFault)(nil)).Elem()] = []string{"Name", "Url"}
+	requiredFields[reflect.TypeOf((*ConnectNvmeController)(nil)).Elem()] = []string{"This", "ConnectSpec"}
+	requiredFields[reflect.TypeOf((*ConnectNvmeControllerExRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ConnectNvmeControllerEx_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ConnectNvmeControllerEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ConnectNvmeControllerRequestType)(nil)).Elem()] = []string{"This", "ConnectSpec"}
+	requiredFields[reflect.TypeOf((*ConnectedIso)(nil)).Elem()] = []string{"Cdrom", "Filename"}
+	requiredFields[reflect.TypeOf((*ConnectedIsoFault)(nil)).Elem()] = []string{"Cdrom", "Filename"}
+	requiredFields[reflect.TypeOf((*ConsolidateVMDisksRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ConsolidateVMDisks_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ConsolidateVMDisks_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ContinueRetrievePropertiesEx)(nil)).Elem()] = []string{"This", "Token"}
+	requiredFields[reflect.TypeOf((*ContinueRetrievePropertiesExRequestType)(nil)).Elem()] = []string{"This", "Token"}
+	requiredFields[reflect.TypeOf((*ContinueRetrievePropertiesExResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ConvertNamespacePathToUuidPath)(nil)).Elem()] = []string{"This", "NamespaceUrl"}
+	requiredFields[reflect.TypeOf((*ConvertNamespacePathToUuidPathRequestType)(nil)).Elem()] = []string{"This", "NamespaceUrl"}
+	requiredFields[reflect.TypeOf((*ConvertNamespacePathToUuidPathResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CopyDatastoreFileRequestType)(nil)).Elem()] = []string{"This", "SourceName", "DestinationName"}
+	requiredFields[reflect.TypeOf((*CopyDatastoreFile_Task)(nil)).Elem()] = []string{"This", "SourceName", "DestinationName"}
+	requiredFields[reflect.TypeOf((*CopyDatastoreFile_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CopyVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "SourceName", "DestName"}
+	requiredFields[reflect.TypeOf((*CopyVirtualDisk_Task)(nil)).Elem()] = []string{"This", "SourceName", "DestName"}
+	requiredFields[reflect.TypeOf((*CopyVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CpuIncompatible)(nil)).Elem()] = []string{"Level", "RegisterName"}
+	requiredFields[reflect.TypeOf((*CpuIncompatible1ECX)(nil)).Elem()] = []string{"Sse3", "Ssse3", "Sse41", "Sse42", "Other", "OtherOnly"}
+	requiredFields[reflect.TypeOf((*CpuIncompatible1ECXFault)(nil)).Elem()] = []string{"Sse3", "Ssse3", "Sse41", "Sse42", "Other", "OtherOnly"}
+	requiredFields[reflect.TypeOf((*CpuIncompatible81EDX)(nil)).Elem()] = []string{"Nx", "Ffxsr", "Rdtscp", "Lm", "Other", "OtherOnly"}
+	requiredFields[reflect.TypeOf((*CpuIncompatible81EDXFault)(nil)).Elem()] = []string{"Nx", "Ffxsr", "Rdtscp", "Lm", "Other", "OtherOnly"}
+	requiredFields[reflect.TypeOf((*CreateAlarm)(nil)).Elem()] = []string{"This", "Entity", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateAlarmRequestType)(nil)).Elem()] = []string{"This", "Entity", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateAlarmResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateChildVMRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*CreateChildVM_Task)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*CreateChildVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateCluster)(nil)).Elem()] = []string{"This", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateClusterEx)(nil)).Elem()] = []string{"This", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateClusterExRequestType)(nil)).Elem()] = []string{"This", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateClusterExResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateClusterRequestType)(nil)).Elem()] = []string{"This", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateClusterResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateCollectorForEvents)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*CreateCollectorForEventsRequestType)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*CreateCollectorForEventsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateCollectorForTasks)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*CreateCollectorForTasksRequestType)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*CreateCollectorForTasksResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateCollectorWithInfoFilterForTasks)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*CreateCollectorWithInfoFilterForTasksRequestType)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*CreateCollectorWithInfoFilterForTasksResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateContainerView)(nil)).Elem()] = []string{"This", "Container", "Recursive"}
+	requiredFields[reflect.TypeOf((*CreateContainerViewRequestType)(nil)).Elem()] = []string{"This", "Container", "Recursive"}
+	requiredFields[reflect.TypeOf((*CreateContainerViewResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateCustomizationSpec)(nil)).Elem()] = []string{"This", "Item"}
+	requiredFields[reflect.TypeOf((*CreateCustomizationSpecRequestType)(nil)).Elem()] = []string{"This", "Item"}
+	requiredFields[reflect.TypeOf((*CreateDVPortgroupRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateDVPortgroup_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateDVPortgroup_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateDVSRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateDVS_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateDVS_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateDatacenter)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*CreateDatacenterRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*CreateDatacenterResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateDefaultProfile)(nil)).Elem()] = []string{"This", "ProfileType"}
+	requiredFields[reflect.TypeOf((*CreateDefaultProfileRequestType)(nil)).Elem()] = []string{"This", "ProfileType"}
+	requiredFields[reflect.TypeOf((*CreateDefaultProfileResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateDescriptor)(nil)).Elem()] = []string{"This", "Obj", "Cdp"}
+	requiredFields[reflect.TypeOf((*CreateDescriptorRequestType)(nil)).Elem()] = []string{"This", "Obj", "Cdp"}
+	requiredFields[reflect.TypeOf((*CreateDescriptorResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateDiagnosticPartition)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateDiagnosticPartitionRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateDirectory)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*CreateDirectoryRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*CreateDirectoryResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateDiskFromSnapshotRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId", "Name"}
+	requiredFields[reflect.TypeOf((*CreateDiskFromSnapshot_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId", "Name"}
+	requiredFields[reflect.TypeOf((*CreateDiskFromSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateDiskRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateDisk_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateFilter)(nil)).Elem()] = []string{"This", "Spec", "PartialUpdates"}
+	requiredFields[reflect.TypeOf((*CreateFilterRequestType)(nil)).Elem()] = []string{"This", "Spec", "PartialUpdates"}
+	requiredFields[reflect.TypeOf((*CreateFilterResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateFolder)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*CreateFolderRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*CreateFolderResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateGroup)(nil)).Elem()] = []string{"This", "Group"}
+	requiredFields[reflect.TypeOf((*CreateGroupRequestType)(nil)).Elem()] = []string{"This", "Group"}
+	requiredFields[reflect.TypeOf((*CreateImportSpec)(nil)).Elem()] = []string{"This", "OvfDescriptor", "ResourcePool", "Datastore", "Cisp"}
+	requiredFields[reflect.TypeOf((*CreateImportSpecRequestType)(nil)).Elem()] = []string{"This", "OvfDescriptor", "ResourcePool", "Datastore", "Cisp"}
+	requiredFields[reflect.TypeOf((*CreateImportSpecResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateInventoryView)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateInventoryViewRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateInventoryViewResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateIpPool)(nil)).Elem()] = []string{"This", "Dc", "Pool"}
+	requiredFields[reflect.TypeOf((*CreateIpPoolRequestType)(nil)).Elem()] = []string{"This", "Dc", "Pool"}
+	requiredFields[reflect.TypeOf((*CreateIpPoolResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateListView)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateListViewFromView)(nil)).Elem()] = []string{"This", "View"}
+	requiredFields[reflect.TypeOf((*CreateListViewFromViewRequestType)(nil)).Elem()] = []string{"This", "View"}
+	requiredFields[reflect.TypeOf((*CreateListViewFromViewResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateListViewRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateListViewResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateLocalDatastore)(nil)).Elem()] = []string{"This", "Name", "Path"}
+	requiredFields[reflect.TypeOf((*CreateLocalDatastoreRequestType)(nil)).Elem()] = []string{"This", "Name", "Path"}
+	requiredFields[reflect.TypeOf((*CreateLocalDatastoreResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateNasDatastore)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateNasDatastoreRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateNasDatastoreResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateNvdimmNamespaceRequestType)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*CreateNvdimmNamespace_Task)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*CreateNvdimmNamespace_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateNvdimmPMemNamespaceRequestType)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*CreateNvdimmPMemNamespace_Task)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*CreateNvdimmPMemNamespace_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateNvmeOverRdmaAdapter)(nil)).Elem()] = []string{"This", "RdmaDeviceName"}
+	requiredFields[reflect.TypeOf((*CreateNvmeOverRdmaAdapterRequestType)(nil)).Elem()] = []string{"This", "RdmaDeviceName"}
+	requiredFields[reflect.TypeOf((*CreateObjectScheduledTask)(nil)).Elem()] = []string{"This", "Obj", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateObjectScheduledTaskRequestType)(nil)).Elem()] = []string{"This", "Obj", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateObjectScheduledTaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreatePassiveNodeRequestType)(nil)).Elem()] = []string{"This", "PassiveDeploymentSpec", "SourceVcSpec"}
+	requiredFields[reflect.TypeOf((*CreatePassiveNode_Task)(nil)).Elem()] = []string{"This", "PassiveDeploymentSpec", "SourceVcSpec"}
+	requiredFields[reflect.TypeOf((*CreatePassiveNode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreatePerfInterval)(nil)).Elem()] = []string{"This", "IntervalId"}
+	requiredFields[reflect.TypeOf((*CreatePerfIntervalRequestType)(nil)).Elem()] = []string{"This", "IntervalId"}
+	requiredFields[reflect.TypeOf((*CreateProfile)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*CreateProfileRequestType)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*CreateProfileResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreatePropertyCollector)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreatePropertyCollectorRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreatePropertyCollectorResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateRegistryKeyInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "KeyName", "IsVolatile"}
+	requiredFields[reflect.TypeOf((*CreateRegistryKeyInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "KeyName", "IsVolatile"}
+	requiredFields[reflect.TypeOf((*CreateResourcePool)(nil)).Elem()] = []string{"This", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateResourcePoolRequestType)(nil)).Elem()] = []string{"This", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateResourcePoolResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateScheduledTask)(nil)).Elem()] = []string{"This", "Entity", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateScheduledTaskRequestType)(nil)).Elem()] = []string{"This", "Entity", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateScheduledTaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateScreenshotRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateScreenshot_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateScreenshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateSecondaryVMExRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateSecondaryVMEx_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateSecondaryVMEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateSecondaryVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateSecondaryVM_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CreateSecondaryVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateSnapshotExRequestType)(nil)).Elem()] = []string{"This", "Name", "Memory"}
+	requiredFields[reflect.TypeOf((*CreateSnapshotEx_Task)(nil)).Elem()] = []string{"This", "Name", "Memory"}
+	requiredFields[reflect.TypeOf((*CreateSnapshotEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateSnapshotRequestType)(nil)).Elem()] = []string{"This", "Name", "Memory", "Quiesce"}
+	requiredFields[reflect.TypeOf((*CreateSnapshot_Task)(nil)).Elem()] = []string{"This", "Name", "Memory", "Quiesce"}
+	requiredFields[reflect.TypeOf((*CreateSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateSoftwareAdapter)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateSoftwareAdapterRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateStoragePod)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*CreateStoragePodRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*CreateStoragePodResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateTask)(nil)).Elem()] = []string{"This", "Obj", "TaskTypeId", "Cancelable"}
+	requiredFields[reflect.TypeOf((*CreateTaskAction)(nil)).Elem()] = []string{"TaskTypeId", "Cancelable"}
+	requiredFields[reflect.TypeOf((*CreateTaskRequestType)(nil)).Elem()] = []string{"This", "Obj", "TaskTypeId", "Cancelable"}
+	requiredFields[reflect.TypeOf((*CreateTaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateTemporaryDirectoryInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Prefix", "Suffix"}
+	requiredFields[reflect.TypeOf((*CreateTemporaryDirectoryInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Prefix", "Suffix"}
+	requiredFields[reflect.TypeOf((*CreateTemporaryDirectoryInGuestResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateTemporaryFileInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Prefix", "Suffix"}
+	requiredFields[reflect.TypeOf((*CreateTemporaryFileInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Prefix", "Suffix"}
+	requiredFields[reflect.TypeOf((*CreateTemporaryFileInGuestResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateUser)(nil)).Elem()] = []string{"This", "User"}
+	requiredFields[reflect.TypeOf((*CreateUserRequestType)(nil)).Elem()] = []string{"This", "User"}
+	requiredFields[reflect.TypeOf((*CreateVApp)(nil)).Elem()] = []string{"This", "Name", "ResSpec", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*CreateVAppRequestType)(nil)).Elem()] = []string{"This", "Name", "ResSpec", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*CreateVAppResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateVMRequestType)(nil)).Elem()] = []string{"This", "Config", "Pool"}
+	requiredFields[reflect.TypeOf((*CreateVM_Task)(nil)).Elem()] = []string{"This", "Config", "Pool"}
+	requiredFields[reflect.TypeOf((*CreateVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateVirtualDisk_Task)(nil)).Elem()] = []string{"This", "Name", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateVmfsDatastore)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateVmfsDatastoreRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateVmfsDatastoreResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateVvolDatastore)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateVvolDatastoreRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CreateVvolDatastoreResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CreateWitnessNodeRequestType)(nil)).Elem()] = []string{"This", "WitnessDeploymentSpec", "SourceVcSpec"}
+	requiredFields[reflect.TypeOf((*CreateWitnessNode_Task)(nil)).Elem()] = []string{"This", "WitnessDeploymentSpec", "SourceVcSpec"}
+	requiredFields[reflect.TypeOf((*CreateWitnessNode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CryptoKeyId)(nil)).Elem()] = []string{"KeyId"}
+	requiredFields[reflect.TypeOf((*CryptoKeyPlain)(nil)).Elem()] = []string{"KeyId", "Algorithm", "KeyData"}
+	requiredFields[reflect.TypeOf((*CryptoKeyResult)(nil)).Elem()] = []string{"KeyId", "Success"}
+	requiredFields[reflect.TypeOf((*CryptoManagerHostDisable)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CryptoManagerHostDisableRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CryptoManagerHostEnable)(nil)).Elem()] = []string{"This", "InitialKey"}
+	requiredFields[reflect.TypeOf((*CryptoManagerHostEnableRequestType)(nil)).Elem()] = []string{"This", "InitialKey"}
+	requiredFields[reflect.TypeOf((*CryptoManagerHostKeyStatus)(nil)).Elem()] = []string{"KeyId", "Present"}
+	requiredFields[reflect.TypeOf((*CryptoManagerHostPrepare)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CryptoManagerHostPrepareRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CryptoManagerKmipCertificateInfo)(nil)).Elem()] = []string{"Subject", "Issuer", "SerialNumber", "NotBefore", "NotAfter", "Fingerprint", "CheckTime"}
+	requiredFields[reflect.TypeOf((*CryptoManagerKmipClusterStatus)(nil)).Elem()] = []string{"ClusterId", "Servers"}
+	requiredFields[reflect.TypeOf((*CryptoManagerKmipCryptoKeyStatus)(nil)).Elem()] = []string{"KeyId"}
+	requiredFields[reflect.TypeOf((*CryptoManagerKmipServerCertInfo)(nil)).Elem()] = []string{"Certificate"}
+	requiredFields[reflect.TypeOf((*CryptoManagerKmipServerStatus)(nil)).Elem()] = []string{"Name", "Status", "ConnectionStatus"}
+	requiredFields[reflect.TypeOf((*CryptoSpecDeepRecrypt)(nil)).Elem()] = []string{"NewKeyId"}
+	requiredFields[reflect.TypeOf((*CryptoSpecEncrypt)(nil)).Elem()] = []string{"CryptoKeyId"}
+	requiredFields[reflect.TypeOf((*CryptoSpecRegister)(nil)).Elem()] = []string{"CryptoKeyId"}
+	requiredFields[reflect.TypeOf((*CryptoSpecShallowRecrypt)(nil)).Elem()] = []string{"NewKeyId"}
+	requiredFields[reflect.TypeOf((*CryptoUnlockRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CryptoUnlock_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CryptoUnlock_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CurrentTime)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CurrentTimeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*CurrentTimeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CustomFieldDef)(nil)).Elem()] = []string{"Key", "Name", "Type"}
+	requiredFields[reflect.TypeOf((*CustomFieldDefEvent)(nil)).Elem()] = []string{"FieldKey", "Name"}
+	requiredFields[reflect.TypeOf((*CustomFieldDefRenamedEvent)(nil)).Elem()] = []string{"NewName"}
+	requiredFields[reflect.TypeOf((*CustomFieldStringValue)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*CustomFieldValue)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*CustomFieldValueChangedEvent)(nil)).Elem()] = []string{"Entity", "FieldKey", "Name", "Value"}
+	requiredFields[reflect.TypeOf((*CustomizationAdapterMapping)(nil)).Elem()] = []string{"Adapter"}
+	requiredFields[reflect.TypeOf((*CustomizationCloudinitPrep)(nil)).Elem()] = []string{"Metadata"}
+	requiredFields[reflect.TypeOf((*CustomizationFixedIp)(nil)).Elem()] = []string{"IpAddress"}
+	requiredFields[reflect.TypeOf((*CustomizationFixedIpV6)(nil)).Elem()] = []string{"IpAddress", "SubnetMask"}
+	requiredFields[reflect.TypeOf((*CustomizationFixedName)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*CustomizationGuiRunOnce)(nil)).Elem()] = []string{"CommandList"}
+	requiredFields[reflect.TypeOf((*CustomizationGuiUnattended)(nil)).Elem()] = []string{"TimeZone", "AutoLogon", "AutoLogonCount"}
+	requiredFields[reflect.TypeOf((*CustomizationIPSettings)(nil)).Elem()] = []string{"Ip"}
+	requiredFields[reflect.TypeOf((*CustomizationIPSettingsIpV6AddressSpec)(nil)).Elem()] = []string{"Ip"}
+	requiredFields[reflect.TypeOf((*CustomizationLicenseFilePrintData)(nil)).Elem()] = []string{"AutoMode"}
+	requiredFields[reflect.TypeOf((*CustomizationLinuxPrep)(nil)).Elem()] = []string{"HostName", "Domain"}
+	requiredFields[reflect.TypeOf((*CustomizationPassword)(nil)).Elem()] = []string{"Value", "PlainText"}
+	requiredFields[reflect.TypeOf((*CustomizationPrefixName)(nil)).Elem()] = []string{"Base"}
+	requiredFields[reflect.TypeOf((*CustomizationSpec)(nil)).Elem()] = []string{"Identity", "GlobalIPSettings"}
+	requiredFields[reflect.TypeOf((*CustomizationSpecInfo)(nil)).Elem()] = []string{"Name", "Description", "Type"}
+	requiredFields[reflect.TypeOf((*CustomizationSpecItem)(nil)).Elem()] = []string{"Info", "Spec"}
+	requiredFields[reflect.TypeOf((*CustomizationSpecItemToXml)(nil)).Elem()] = []string{"This", "Item"}
+	requiredFields[reflect.TypeOf((*CustomizationSpecItemToXmlRequestType)(nil)).Elem()] = []string{"This", "Item"}
+	requiredFields[reflect.TypeOf((*CustomizationSpecItemToXmlResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CustomizationSysprep)(nil)).Elem()] = []string{"GuiUnattended", "UserData", "Identification"}
+	requiredFields[reflect.TypeOf((*CustomizationSysprepFailed)(nil)).Elem()] = []string{"SysprepVersion", "SystemVersion"}
+	requiredFields[reflect.TypeOf((*CustomizationSysprepText)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*CustomizationUserData)(nil)).Elem()] = []string{"FullName", "OrgName", "ComputerName", "ProductId"}
+	requiredFields[reflect.TypeOf((*CustomizationWinOptions)(nil)).Elem()] = []string{"ChangeSID", "DeleteAccounts"}
+	requiredFields[reflect.TypeOf((*CustomizeGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Spec"}
+	requiredFields[reflect.TypeOf((*CustomizeGuest_Task)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Spec"}
+	requiredFields[reflect.TypeOf((*CustomizeGuest_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*CustomizeVMRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CustomizeVM_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*CustomizeVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DVPortConfigInfo)(nil)).Elem()] = []string{"ConfigVersion"}
+	requiredFields[reflect.TypeOf((*DVPortConfigSpec)(nil)).Elem()] = []string{"Operation"}
+	requiredFields[reflect.TypeOf((*DVPortState)(nil)).Elem()] = []string{"Stats"}
+	requiredFields[reflect.TypeOf((*DVPortStatus)(nil)).Elem()] = []string{"LinkUp", "Blocked"}
+	requiredFields[reflect.TypeOf((*DVPortgroupConfigInfo)(nil)).Elem()] = []string{"Key", "Name", "NumPorts", "Type", "Policy"}
+	requiredFields[reflect.TypeOf((*DVPortgroupPolicy)(nil)).Elem()] = []string{"BlockOverrideAllowed", "ShapingOverrideAllowed", "VendorConfigOverrideAllowed", "LivePortMovingAllowed", "PortConfigResetAtDisconnect"}
+	requiredFields[reflect.TypeOf((*DVPortgroupReconfiguredEvent)(nil)).Elem()] = []string{"ConfigSpec"}
+	requiredFields[reflect.TypeOf((*DVPortgroupRenamedEvent)(nil)).Elem()] = []string{"OldName", "NewName"}
+	requiredFields[reflect.TypeOf((*DVPortgroupRollbackRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DVPortgroupRollback_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DVPortgroupRollback_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DVPortgroupSelection)(nil)).Elem()] = []string{"DvsUuid", "PortgroupKey"}
+	requiredFields[reflect.TypeOf((*DVSBackupRestoreCapability)(nil)).Elem()] = []string{"BackupRestoreSupported"}
+	requiredFields[reflect.TypeOf((*DVSConfigInfo)(nil)).Elem()] = []string{"Uuid", "Name", "NumStandalonePorts", "NumPorts", "MaxPorts", "UplinkPortPolicy", "DefaultPortConfig", "ProductInfo", "ConfigVersion", "Contact", "CreateTime"}
+	requiredFields[reflect.TypeOf((*DVSCreateSpec)(nil)).Elem()] = []string{"ConfigSpec"}
+	requiredFields[reflect.TypeOf((*DVSFeatureCapability)(nil)).Elem()] = []string{"NetworkResourceManagementSupported"}
+	requiredFields[reflect.TypeOf((*DVSHostLocalPortInfo)(nil)).Elem()] = []string{"SwitchUuid", "PortKey", "Setting", "Vnic"}
+	requiredFields[reflect.TypeOf((*DVSMacLearningPolicy)(nil)).Elem()] = []string{"Enabled"}
+	requiredFields[reflect.TypeOf((*DVSManagerExportEntityRequestType)(nil)).Elem()] = []string{"This", "SelectionSet"}
+	requiredFields[reflect.TypeOf((*DVSManagerExportEntity_Task)(nil)).Elem()] = []string{"This", "SelectionSet"}
+	requiredFields[reflect.TypeOf((*DVSManagerExportEntity_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DVSManagerImportEntityRequestType)(nil)).Elem()] = []string{"This", "EntityBackup", "ImportType"}
+	requiredFields[reflect.TypeOf((*DVSManagerImportEntity_Task)(nil)).Elem()] = []string{"This", "EntityBackup", "ImportType"}
+	requiredFields[reflect.TypeOf((*DVSManagerImportEntity_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DVSManagerLookupDvPortGroup)(nil)).Elem()] = []string{"This", "SwitchUuid", "PortgroupKey"}
+	requiredFields[reflect.TypeOf((*DVSManagerLookupDvPortGroupRequestType)(nil)).Elem()] = []string{"This", "SwitchUuid", "PortgroupKey"}
+	requiredFields[reflect.TypeOf((*DVSManagerPhysicalNicsList)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*DVSNameArrayUplinkPortPolicy)(nil)).Elem()] = []string{"UplinkPortName"}
+	requiredFields[reflect.TypeOf((*DVSNetworkResourceManagementCapability)(nil)).Elem()] = []string{"NetworkResourceManagementSupported", "NetworkResourcePoolHighShareValue", "QosSupported", "UserDefinedNetworkResourcePoolsSupported"}
+	requiredFields[reflect.TypeOf((*DVSNetworkResourcePool)(nil)).Elem()] = []string{"Key", "ConfigVersion", "AllocationInfo"}
+	requiredFields[reflect.TypeOf((*DVSNetworkResourcePoolConfigSpec)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*DVSRollbackCapability)(nil)).Elem()] = []string{"RollbackSupported"}
+	requiredFields[reflect.TypeOf((*DVSRollbackRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DVSRollback_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DVSRollback_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DVSSelection)(nil)).Elem()] = []string{"DvsUuid"}
+	requiredFields[reflect.TypeOf((*DVSSummary)(nil)).Elem()] = []string{"Name", "Uuid", "NumPorts"}
+	requiredFields[reflect.TypeOf((*DVSVmVnicNetworkResourcePool)(nil)).Elem()] = []string{"Key", "ConfigVersion"}
+	requiredFields[reflect.TypeOf((*DailyTaskScheduler)(nil)).Elem()] = []string{"Hour"}
+	requiredFields[reflect.TypeOf((*DasHeartbeatDatastoreInfo)(nil)).Elem()] = []string{"Datastore", "Hosts"}
+	requiredFields[reflect.TypeOf((*DasHostFailedEvent)(nil)).Elem()] = []string{"FailedHost"}
+	requiredFields[reflect.TypeOf((*DasHostIsolatedEvent)(nil)).Elem()] = []string{"IsolatedHost"}
+	requiredFields[reflect.TypeOf((*DatabaseSizeEstimate)(nil)).Elem()] = []string{"Size"}
+	requiredFields[reflect.TypeOf((*DatabaseSizeParam)(nil)).Elem()] = []string{"InventoryDesc"}
+	requiredFields[reflect.TypeOf((*DatacenterCreatedEvent)(nil)).Elem()] = []string{"Parent"}
+	requiredFields[reflect.TypeOf((*DatacenterEventArgument)(nil)).Elem()] = []string{"Datacenter"}
+	requiredFields[reflect.TypeOf((*DatacenterMismatch)(nil)).Elem()] = []string{"InvalidArgument", "ExpectedDatacenter"}
+	requiredFields[reflect.TypeOf((*DatacenterMismatchArgument)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*DatacenterMismatchFault)(nil)).Elem()] = []string{"InvalidArgument", "ExpectedDatacenter"}
+	requiredFields[reflect.TypeOf((*DatacenterRenamedEvent)(nil)).Elem()] = []string{"OldName", "NewName"}
+	requiredFields[reflect.TypeOf((*DatastoreCapability)(nil)).Elem()] = []string{"DirectoryHierarchySupported", "RawDiskMappingsSupported", "PerFileThinProvisioningSupported"}
+	requiredFields[reflect.TypeOf((*DatastoreCapacityIncreasedEvent)(nil)).Elem()] = []string{"OldCapacity", "NewCapacity"}
+	requiredFields[reflect.TypeOf((*DatastoreDiscoveredEvent)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*DatastoreEnterMaintenanceMode)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DatastoreEnterMaintenanceModeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DatastoreEnterMaintenanceModeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DatastoreEventArgument)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*DatastoreExitMaintenanceModeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DatastoreExitMaintenanceMode_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DatastoreExitMaintenanceMode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DatastoreFileCopiedEvent)(nil)).Elem()] = []string{"SourceDatastore", "SourceFile"}
+	requiredFields[reflect.TypeOf((*DatastoreFileEvent)(nil)).Elem()] = []string{"TargetFile"}
+	requiredFields[reflect.TypeOf((*DatastoreFileMovedEvent)(nil)).Elem()] = []string{"SourceDatastore", "SourceFile"}
+	requiredFields[reflect.TypeOf((*DatastoreHostMount)(nil)).Elem()] = []string{"Key", "MountInfo"}
+	requiredFields[reflect.TypeOf((*DatastoreInfo)(nil)).Elem()] = []string{"Name", "Url", "FreeSpace", "MaxFileSize"}
+	requiredFields[reflect.TypeOf((*DatastoreMountPathDatastorePair)(nil)).Elem()] = []string{"OldMountPath", "Datastore"}
+	requiredFields[reflect.TypeOf((*DatastoreNamespaceManagerDirectoryInfo)(nil)).Elem()] = []string{"Capacity", "Used"}
+	requiredFields[reflect.TypeOf((*DatastoreNotWritableOnHost)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*DatastorePrincipalConfigured)(nil)).Elem()] = []string{"DatastorePrincipal"}
+	requiredFields[reflect.TypeOf((*DatastoreRemovedOnHostEvent)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*DatastoreRenamedEvent)(nil)).Elem()] = []string{"OldName", "NewName"}
+	requiredFields[reflect.TypeOf((*DatastoreRenamedOnHostEvent)(nil)).Elem()] = []string{"OldName", "NewName"}
+	requiredFields[reflect.TypeOf((*DatastoreSummary)(nil)).Elem()] = []string{"Name", "Url", "Capacity", "FreeSpace", "Accessible", "Type"}
+	requiredFields[reflect.TypeOf((*DatastoreVVolContainerFailoverPair)(nil)).Elem()] = []string{"TgtContainer"}
+	requiredFields[reflect.TypeOf((*DecodeLicense)(nil)).Elem()] = []string{"This", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*DecodeLicenseRequestType)(nil)).Elem()] = []string{"This", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*DecodeLicenseResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DefragmentAllDisks)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DefragmentAllDisksRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DefragmentVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DefragmentVirtualDisk_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DefragmentVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DeleteCustomizationSpec)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DeleteCustomizationSpecRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DeleteDatastoreFileRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DeleteDatastoreFile_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DeleteDatastoreFile_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DeleteDirectory)(nil)).Elem()] = []string{"This", "DatastorePath"}
+	requiredFields[reflect.TypeOf((*DeleteDirectoryInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "DirectoryPath", "Recursive"}
+	requiredFields[reflect.TypeOf((*DeleteDirectoryInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "DirectoryPath", "Recursive"}
+	requiredFields[reflect.TypeOf((*DeleteDirectoryRequestType)(nil)).Elem()] = []string{"This", "DatastorePath"}
+	requiredFields[reflect.TypeOf((*DeleteFile)(nil)).Elem()] = []string{"This", "DatastorePath"}
+	requiredFields[reflect.TypeOf((*DeleteFileInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "FilePath"}
+	requiredFields[reflect.TypeOf((*DeleteFileInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "FilePath"}
+	requiredFields[reflect.TypeOf((*DeleteFileRequestType)(nil)).Elem()] = []string{"This", "DatastorePath"}
+	requiredFields[reflect.TypeOf((*DeleteHostSpecification)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*DeleteHostSpecificationRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*DeleteHostSubSpecification)(nil)).Elem()] = []string{"This", "Host", "SubSpecName"}
+	requiredFields[reflect.TypeOf((*DeleteHostSubSpecificationRequestType)(nil)).Elem()] = []string{"This", "Host", "SubSpecName"}
+	requiredFields[reflect.TypeOf((*DeleteNvdimmBlockNamespacesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DeleteNvdimmBlockNamespaces_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DeleteNvdimmBlockNamespaces_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DeleteNvdimmNamespaceRequestType)(nil)).Elem()] = []string{"This", "DeleteSpec"}
+	requiredFields[reflect.TypeOf((*DeleteNvdimmNamespace_Task)(nil)).Elem()] = []string{"This", "DeleteSpec"}
+	requiredFields[reflect.TypeOf((*DeleteNvdimmNamespace_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DeleteRegistryKeyInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "KeyName", "Recursive"}
+	requiredFields[reflect.TypeOf((*DeleteRegistryKeyInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "KeyName", "Recursive"}
+	requiredFields[reflect.TypeOf((*DeleteRegistryValueInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "ValueName"}
+	requiredFields[reflect.TypeOf((*DeleteRegistryValueInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "ValueName"}
+	requiredFields[reflect.TypeOf((*DeleteScsiLunState)(nil)).Elem()] = []string{"This", "LunCanonicalName"}
+	requiredFields[reflect.TypeOf((*DeleteScsiLunStateRequestType)(nil)).Elem()] = []string{"This", "LunCanonicalName"}
+	requiredFields[reflect.TypeOf((*DeleteSnapshotRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*DeleteSnapshot_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*DeleteSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DeleteVStorageObjectExRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*DeleteVStorageObjectEx_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*DeleteVStorageObjectEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DeleteVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*DeleteVStorageObject_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*DeleteVStorageObject_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DeleteVffsVolumeState)(nil)).Elem()] = []string{"This", "VffsUuid"}
+	requiredFields[reflect.TypeOf((*DeleteVffsVolumeStateRequestType)(nil)).Elem()] = []string{"This", "VffsUuid"}
+	requiredFields[reflect.TypeOf((*DeleteVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DeleteVirtualDisk_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DeleteVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DeleteVmfsVolumeState)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*DeleteVmfsVolumeStateRequestType)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*DeleteVsanObjects)(nil)).Elem()] = []string{"This", "Uuids"}
+	requiredFields[reflect.TypeOf((*DeleteVsanObjectsRequestType)(nil)).Elem()] = []string{"This", "Uuids"}
+	requiredFields[reflect.TypeOf((*DeleteVsanObjectsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DeltaDiskFormatNotSupported)(nil)).Elem()] = []string{"DeltaDiskFormat"}
+	requiredFields[reflect.TypeOf((*DeltaDiskFormatNotSupportedFault)(nil)).Elem()] = []string{"DeltaDiskFormat"}
+	requiredFields[reflect.TypeOf((*DeployVchaRequestType)(nil)).Elem()] = []string{"This", "DeploymentSpec"}
+	requiredFields[reflect.TypeOf((*DeployVcha_Task)(nil)).Elem()] = []string{"This", "DeploymentSpec"}
+	requiredFields[reflect.TypeOf((*DeployVcha_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*Description)(nil)).Elem()] = []string{"Label", "Summary"}
+	requiredFields[reflect.TypeOf((*DeselectVnic)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DeselectVnicForNicType)(nil)).Elem()] = []string{"This", "NicType", "Device"}
+	requiredFields[reflect.TypeOf((*DeselectVnicForNicTypeRequestType)(nil)).Elem()] = []string{"This", "NicType", "Device"}
+	requiredFields[reflect.TypeOf((*DeselectVnicRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DesiredSoftwareSpec)(nil)).Elem()] = []string{"BaseImageSpec"}
+	requiredFields[reflect.TypeOf((*DesiredSoftwareSpecBaseImageSpec)(nil)).Elem()] = []string{"Version"}
+	requiredFields[reflect.TypeOf((*DesiredSoftwareSpecComponentSpec)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*DesiredSoftwareSpecVendorAddOnSpec)(nil)).Elem()] = []string{"Name", "Version"}
+	requiredFields[reflect.TypeOf((*DestinationVsanDisabled)(nil)).Elem()] = []string{"DestinationCluster"}
+	requiredFields[reflect.TypeOf((*DestinationVsanDisabledFault)(nil)).Elem()] = []string{"DestinationCluster"}
+	requiredFields[reflect.TypeOf((*DestroyChildren)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyChildrenRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyCollector)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyCollectorRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyDatastore)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyDatastoreRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyIpPool)(nil)).Elem()] = []string{"This", "Dc", "Id", "Force"}
+	requiredFields[reflect.TypeOf((*DestroyIpPoolRequestType)(nil)).Elem()] = []string{"This", "Dc", "Id", "Force"}
+	requiredFields[reflect.TypeOf((*DestroyNetwork)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyNetworkRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyProfile)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyProfileRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyPropertyCollector)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyPropertyCollectorRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyPropertyFilter)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyPropertyFilterRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyVchaRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyVcha_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyVcha_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DestroyVffs)(nil)).Elem()] = []string{"This", "VffsPath"}
+	requiredFields[reflect.TypeOf((*DestroyVffsRequestType)(nil)).Elem()] = []string{"This", "VffsPath"}
+	requiredFields[reflect.TypeOf((*DestroyView)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DestroyViewRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*Destroy_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*Destroy_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DetachDiskRequestType)(nil)).Elem()] = []string{"This", "DiskId"}
+	requiredFields[reflect.TypeOf((*DetachDisk_Task)(nil)).Elem()] = []string{"This", "DiskId"}
+	requiredFields[reflect.TypeOf((*DetachDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DetachScsiLun)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*DetachScsiLunExRequestType)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*DetachScsiLunEx_Task)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*DetachScsiLunEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DetachScsiLunRequestType)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*DetachTagFromVStorageObject)(nil)).Elem()] = []string{"This", "Id", "Category", "Tag"}
+	requiredFields[reflect.TypeOf((*DetachTagFromVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Category", "Tag"}
+	requiredFields[reflect.TypeOf((*DeviceBackedVirtualDiskSpec)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*DeviceBackingNotSupported)(nil)).Elem()] = []string{"Backing"}
+	requiredFields[reflect.TypeOf((*DeviceControllerNotSupported)(nil)).Elem()] = []string{"Controller"}
+	requiredFields[reflect.TypeOf((*DeviceControllerNotSupportedFault)(nil)).Elem()] = []string{"Controller"}
+	requiredFields[reflect.TypeOf((*DeviceGroupId)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*DeviceNotSupported)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*DeviceUnsupportedForVmVersion)(nil)).Elem()] = []string{"CurrentVersion", "ExpectedVersion"}
+	requiredFields[reflect.TypeOf((*DeviceUnsupportedForVmVersionFault)(nil)).Elem()] = []string{"CurrentVersion", "ExpectedVersion"}
+	requiredFields[reflect.TypeOf((*DiagnosticManagerAuditRecordResult)(nil)).Elem()] = []string{"NextToken"}
+	requiredFields[reflect.TypeOf((*DiagnosticManagerBundleInfo)(nil)).Elem()] = []string{"Url"}
+	requiredFields[reflect.TypeOf((*DiagnosticManagerLogDescriptor)(nil)).Elem()] = []string{"Key", "FileName", "Creator", "Format", "MimeType", "Info"}
+	requiredFields[reflect.TypeOf((*DiagnosticManagerLogHeader)(nil)).Elem()] = []string{"LineStart", "LineEnd"}
+	requiredFields[reflect.TypeOf((*DisableAlarm)(nil)).Elem()] = []string{"This", "Alarm", "Entity"}
+	requiredFields[reflect.TypeOf((*DisableAlarmRequestType)(nil)).Elem()] = []string{"This", "Alarm", "Entity"}
+	requiredFields[reflect.TypeOf((*DisableClusteredVmdkSupport)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*DisableClusteredVmdkSupportRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*DisableEvcModeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisableEvcMode_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisableEvcMode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DisableFeature)(nil)).Elem()] = []string{"This", "FeatureKey"}
+	requiredFields[reflect.TypeOf((*DisableFeatureRequestType)(nil)).Elem()] = []string{"This", "FeatureKey"}
+	requiredFields[reflect.TypeOf((*DisableFeatureResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DisableHyperThreading)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisableHyperThreadingRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisableMultipathPath)(nil)).Elem()] = []string{"This", "PathName"}
+	requiredFields[reflect.TypeOf((*DisableMultipathPathRequestType)(nil)).Elem()] = []string{"This", "PathName"}
+	requiredFields[reflect.TypeOf((*DisableRuleset)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*DisableRulesetRequestType)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*DisableSecondaryVMRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*DisableSecondaryVM_Task)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*DisableSecondaryVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DisableSmartCardAuthentication)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisableSmartCardAuthenticationRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisallowedChangeByService)(nil)).Elem()] = []string{"ServiceName"}
+	requiredFields[reflect.TypeOf((*DisallowedChangeByServiceFault)(nil)).Elem()] = []string{"ServiceName"}
+	requiredFields[reflect.TypeOf((*DisallowedMigrationDeviceAttached)(nil)).Elem()] = []string{"Fault"}
+	requiredFields[reflect.TypeOf((*DisallowedMigrationDeviceAttachedFault)(nil)).Elem()] = []string{"Fault"}
+	requiredFields[reflect.TypeOf((*DisallowedOperationOnFailoverHost)(nil)).Elem()] = []string{"Host", "Hostname"}
+	requiredFields[reflect.TypeOf((*DisallowedOperationOnFailoverHostFault)(nil)).Elem()] = []string{"Host", "Hostname"}
+	requiredFields[reflect.TypeOf((*DisconnectHostRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisconnectHost_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisconnectHost_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DisconnectNvmeController)(nil)).Elem()] = []string{"This", "DisconnectSpec"}
+	requiredFields[reflect.TypeOf((*DisconnectNvmeControllerExRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisconnectNvmeControllerEx_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DisconnectNvmeControllerEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DisconnectNvmeControllerRequestType)(nil)).Elem()] = []string{"This", "DisconnectSpec"}
+	requiredFields[reflect.TypeOf((*DiscoverFcoeHbas)(nil)).Elem()] = []string{"This", "FcoeSpec"}
+	requiredFields[reflect.TypeOf((*DiscoverFcoeHbasRequestType)(nil)).Elem()] = []string{"This", "FcoeSpec"}
+	requiredFields[reflect.TypeOf((*DiscoverNvmeControllers)(nil)).Elem()] = []string{"This", "DiscoverSpec"}
+	requiredFields[reflect.TypeOf((*DiscoverNvmeControllersRequestType)(nil)).Elem()] = []string{"This", "DiscoverSpec"}
+	requiredFields[reflect.TypeOf((*DiscoverNvmeControllersResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DiskChangeExtent)(nil)).Elem()] = []string{"Start", "Length"}
+	requiredFields[reflect.TypeOf((*DiskChangeInfo)(nil)).Elem()] = []string{"StartOffset", "Length"}
+	requiredFields[reflect.TypeOf((*DiskCryptoSpec)(nil)).Elem()] = []string{"Crypto"}
+	requiredFields[reflect.TypeOf((*DiskNotSupported)(nil)).Elem()] = []string{"Disk"}
+	requiredFields[reflect.TypeOf((*DissociateProfile)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DissociateProfileRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualPort)(nil)).Elem()] = []string{"Key", "Config", "DvsUuid", "Conflict", "LastStatusChange"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualPortgroupInfo)(nil)).Elem()] = []string{"SwitchName", "SwitchUuid", "PortgroupName", "PortgroupKey", "PortgroupType", "UplinkPortgroup", "Portgroup"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualPortgroupProblem)(nil)).Elem()] = []string{"LogicalSwitchUuid", "Fault"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchHostMember)(nil)).Elem()] = []string{"Config", "Status"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchHostMemberConfigInfo)(nil)).Elem()] = []string{"MaxProxySwitchPorts", "Backing"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchHostMemberConfigSpec)(nil)).Elem()] = []string{"Operation", "Host"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchHostMemberHostUplinkState)(nil)).Elem()] = []string{"UplinkName", "State"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchHostMemberPnicSpec)(nil)).Elem()] = []string{"PnicDevice"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchHostMemberRuntimeState)(nil)).Elem()] = []string{"CurrentMaxProxySwitchPorts"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchHostMemberTransportZoneInfo)(nil)).Elem()] = []string{"Uuid", "Type"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchInfo)(nil)).Elem()] = []string{"SwitchName", "SwitchUuid", "DistributedVirtualSwitch"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchKeyedOpaqueBlob)(nil)).Elem()] = []string{"Key", "OpaqueData"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchManagerCompatibilityResult)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchManagerHostArrayFilter)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchManagerHostContainer)(nil)).Elem()] = []string{"Container", "Recursive"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchManagerHostContainerFilter)(nil)).Elem()] = []string{"HostContainer"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchManagerHostDvsFilterSpec)(nil)).Elem()] = []string{"Inclusive"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchManagerHostDvsMembershipFilter)(nil)).Elem()] = []string{"DistributedVirtualSwitch"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchNetworkOffloadSpec)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchPortConnection)(nil)).Elem()] = []string{"SwitchUuid"}
+	requiredFields[reflect.TypeOf((*DistributedVirtualSwitchPortStatistics)(nil)).Elem()] = []string{"PacketsInMulticast", "PacketsOutMulticast", "BytesInMulticast", "BytesOutMulticast", "PacketsInUnicast", "PacketsOutUnicast", "BytesInUnicast", "BytesOutUnicast", "PacketsInBroadcast", "PacketsOutBroadcast", "BytesInBroadcast", "BytesOutBroadcast", "PacketsInDropped", "PacketsOutDropped", "PacketsInException", "PacketsOutException"}
+	requiredFields[reflect.TypeOf((*DoesCustomizationSpecExist)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DoesCustomizationSpecExistRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*DoesCustomizationSpecExistResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DomainNotFound)(nil)).Elem()] = []string{"DomainName"}
+	requiredFields[reflect.TypeOf((*DomainNotFoundFault)(nil)).Elem()] = []string{"DomainName"}
+	requiredFields[reflect.TypeOf((*DownloadDescriptionTree)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DownloadDescriptionTreeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DownloadDescriptionTreeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DpuStatusInfo)(nil)).Elem()] = []string{"DpuId"}
+	requiredFields[reflect.TypeOf((*DpuStatusInfoOperationalInfo)(nil)).Elem()] = []string{"SensorId", "Reading"}
+	requiredFields[reflect.TypeOf((*DropConnections)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DropConnectionsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*DropConnectionsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DrsEnabledEvent)(nil)).Elem()] = []string{"Behavior"}
+	requiredFields[reflect.TypeOf((*DrsResourceConfigureFailedEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*DrsVmotionIncompatibleFault)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*DrsVmotionIncompatibleFaultFault)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*DuplicateCustomizationSpec)(nil)).Elem()] = []string{"This", "Name", "NewName"}
+	requiredFields[reflect.TypeOf((*DuplicateCustomizationSpecRequestType)(nil)).Elem()] = []string{"This", "Name", "NewName"}
+	requiredFields[reflect.TypeOf((*DuplicateIpDetectedEvent)(nil)).Elem()] = []string{"DuplicateIP", "MacAddress"}
+	requiredFields[reflect.TypeOf((*DuplicateName)(nil)).Elem()] = []string{"Name", "Object"}
+	requiredFields[reflect.TypeOf((*DuplicateNameFault)(nil)).Elem()] = []string{"Name", "Object"}
+	requiredFields[reflect.TypeOf((*DuplicateVsanNetworkInterface)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*DuplicateVsanNetworkInterfaceFault)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*DvpgImportEvent)(nil)).Elem()] = []string{"ImportType"}
+	requiredFields[reflect.TypeOf((*DvsApplyOperationFault)(nil)).Elem()] = []string{"ObjectFault"}
+	requiredFields[reflect.TypeOf((*DvsApplyOperationFaultFault)(nil)).Elem()] = []string{"ObjectFault"}
+	requiredFields[reflect.TypeOf((*DvsApplyOperationFaultFaultOnObject)(nil)).Elem()] = []string{"ObjectId", "Type", "Fault"}
+	requiredFields[reflect.TypeOf((*DvsCreatedEvent)(nil)).Elem()] = []string{"Parent"}
+	requiredFields[reflect.TypeOf((*DvsEventArgument)(nil)).Elem()] = []string{"Dvs"}
+	requiredFields[reflect.TypeOf((*DvsFilterConfigSpec)(nil)).Elem()] = []string{"Operation"}
+	requiredFields[reflect.TypeOf((*DvsGreEncapNetworkRuleAction)(nil)).Elem()] = []string{"EncapsulationIp"}
+	requiredFields[reflect.TypeOf((*DvsHealthStatusChangeEvent)(nil)).Elem()] = []string{"SwitchUuid"}
+	requiredFields[reflect.TypeOf((*DvsHostBackInSyncEvent)(nil)).Elem()] = []string{"HostBackInSync"}
+	requiredFields[reflect.TypeOf((*DvsHostInfrastructureTrafficResource)(nil)).Elem()] = []string{"Key", "AllocationInfo"}
+	requiredFields[reflect.TypeOf((*DvsHostJoinedEvent)(nil)).Elem()] = []string{"HostJoined"}
+	requiredFields[reflect.TypeOf((*DvsHostLeftEvent)(nil)).Elem()] = []string{"HostLeft"}
+	requiredFields[reflect.TypeOf((*DvsHostStatusUpdated)(nil)).Elem()] = []string{"HostMember"}
+	requiredFields[reflect.TypeOf((*DvsHostWentOutOfSyncEvent)(nil)).Elem()] = []string{"HostOutOfSync"}
+	requiredFields[reflect.TypeOf((*DvsImportEvent)(nil)).Elem()] = []string{"ImportType"}
+	requiredFields[reflect.TypeOf((*DvsIpPortRange)(nil)).Elem()] = []string{"StartPortNumber", "EndPortNumber"}
+	requiredFields[reflect.TypeOf((*DvsMacRewriteNetworkRuleAction)(nil)).Elem()] = []string{"RewriteMac"}
+	requiredFields[reflect.TypeOf((*DvsMergedEvent)(nil)).Elem()] = []string{"SourceDvs", "DestinationDvs"}
+	requiredFields[reflect.TypeOf((*DvsOperationBulkFault)(nil)).Elem()] = []string{"HostFault"}
+	requiredFields[reflect.TypeOf((*DvsOperationBulkFaultFault)(nil)).Elem()] = []string{"HostFault"}
+	requiredFields[reflect.TypeOf((*DvsOperationBulkFaultFaultOnHost)(nil)).Elem()] = []string{"Host", "Fault"}
+	requiredFields[reflect.TypeOf((*DvsOutOfSyncHostArgument)(nil)).Elem()] = []string{"OutOfSyncHost", "ConfigParamters"}
+	requiredFields[reflect.TypeOf((*DvsPortBlockedEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortConnectedEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortCreatedEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortDeletedEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortDisconnectedEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortEnteredPassthruEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortExitedPassthruEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortJoinPortgroupEvent)(nil)).Elem()] = []string{"PortKey", "PortgroupKey", "PortgroupName"}
+	requiredFields[reflect.TypeOf((*DvsPortLeavePortgroupEvent)(nil)).Elem()] = []string{"PortKey", "PortgroupKey", "PortgroupName"}
+	requiredFields[reflect.TypeOf((*DvsPortLinkDownEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortLinkUpEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortReconfiguredEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortRuntimeChangeEvent)(nil)).Elem()] = []string{"PortKey", "RuntimeInfo"}
+	requiredFields[reflect.TypeOf((*DvsPortUnblockedEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsPortVendorSpecificStateChangeEvent)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*DvsProfile)(nil)).Elem()] = []string{"Key", "Name"}
+	requiredFields[reflect.TypeOf((*DvsRateLimitNetworkRuleAction)(nil)).Elem()] = []string{"PacketsPerSecond"}
+	requiredFields[reflect.TypeOf((*DvsReconfigureVmVnicNetworkResourcePoolRequestType)(nil)).Elem()] = []string{"This", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*DvsReconfigureVmVnicNetworkResourcePool_Task)(nil)).Elem()] = []string{"This", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*DvsReconfigureVmVnicNetworkResourcePool_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*DvsReconfiguredEvent)(nil)).Elem()] = []string{"ConfigSpec"}
+	requiredFields[reflect.TypeOf((*DvsRenamedEvent)(nil)).Elem()] = []string{"OldName", "NewName"}
+	requiredFields[reflect.TypeOf((*DvsScopeViolated)(nil)).Elem()] = []string{"Scope", "Entity"}
+	requiredFields[reflect.TypeOf((*DvsScopeViolatedFault)(nil)).Elem()] = []string{"Scope", "Entity"}
+	requiredFields[reflect.TypeOf((*DvsSingleIpPort)(nil)).Elem()] = []string{"PortNumber"}
+	requiredFields[reflect.TypeOf((*DvsTrafficFilterConfigSpec)(nil)).Elem()] = []string{"Operation"}
+	requiredFields[reflect.TypeOf((*DvsUpgradeAvailableEvent)(nil)).Elem()] = []string{"ProductInfo"}
+	requiredFields[reflect.TypeOf((*DvsUpgradeInProgressEvent)(nil)).Elem()] = []string{"ProductInfo"}
+	requiredFields[reflect.TypeOf((*DvsUpgradeRejectedEvent)(nil)).Elem()] = []string{"ProductInfo"}
+	requiredFields[reflect.TypeOf((*DvsUpgradedEvent)(nil)).Elem()] = []string{"ProductInfo"}
+	requiredFields[reflect.TypeOf((*DvsVNicProfile)(nil)).Elem()] = []string{"Key", "IpConfig"}
+	requiredFields[reflect.TypeOf((*DvsVmVnicNetworkResourcePoolRuntimeInfo)(nil)).Elem()] = []string{"Key", "Status"}
+	requiredFields[reflect.TypeOf((*DvsVmVnicResourcePoolConfigSpec)(nil)).Elem()] = []string{"Operation"}
+	requiredFields[reflect.TypeOf((*DvsVnicAllocatedResource)(nil)).Elem()] = []string{"Vm", "VnicKey"}
+	requiredFields[reflect.TypeOf((*DynamicArray)(nil)).Elem()] = []string{"Val"}
+	requiredFields[reflect.TypeOf((*DynamicProperty)(nil)).Elem()] = []string{"Name", "Val"}
+	requiredFields[reflect.TypeOf((*EVCAdmissionFailedCPUFeaturesForMode)(nil)).Elem()] = []string{"CurrentEVCModeKey"}
+	requiredFields[reflect.TypeOf((*EVCAdmissionFailedCPUFeaturesForModeFault)(nil)).Elem()] = []string{"CurrentEVCModeKey"}
+	requiredFields[reflect.TypeOf((*EVCAdmissionFailedCPUModelForMode)(nil)).Elem()] = []string{"CurrentEVCModeKey"}
+	requiredFields[reflect.TypeOf((*EVCAdmissionFailedCPUModelForModeFault)(nil)).Elem()] = []string{"CurrentEVCModeKey"}
+	requiredFields[reflect.TypeOf((*EVCAdmissionFailedCPUVendor)(nil)).Elem()] = []string{"ClusterCPUVendor", "HostCPUVendor"}
+	requiredFields[reflect.TypeOf((*EVCAdmissionFailedCPUVendorFault)(nil)).Elem()] = []string{"ClusterCPUVendor", "HostCPUVendor"}
+	requiredFields[reflect.TypeOf((*EVCMode)(nil)).Elem()] = []string{"Vendor", "VendorTier"}
+	requiredFields[reflect.TypeOf((*EVCModeIllegalByVendor)(nil)).Elem()] = []string{"ClusterCPUVendor", "ModeCPUVendor"}
+	requiredFields[reflect.TypeOf((*EVCModeIllegalByVendorFault)(nil)).Elem()] = []string{"ClusterCPUVendor", "ModeCPUVendor"}
+	requiredFields[reflect.TypeOf((*EVCUnsupportedByHostHardware)(nil)).Elem()] = []string{"Host", "HostName"}
+	requiredFields[reflect.TypeOf((*EVCUnsupportedByHostHardwareFault)(nil)).Elem()] = []string{"Host", "HostName"}
+	requiredFields[reflect.TypeOf((*EVCUnsupportedByHostSoftware)(nil)).Elem()] = []string{"Host", "HostName"}
+	requiredFields[reflect.TypeOf((*EVCUnsupportedByHostSoftwareFault)(nil)).Elem()] = []string{"Host", "HostName"}
+	requiredFields[reflect.TypeOf((*EagerZeroVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*EagerZeroVirtualDisk_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*EagerZeroVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ElementDescription)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*EmitSyslogMark)(nil)).Elem()] = []string{"This", "Message"}
+	requiredFields[reflect.TypeOf((*EmitSyslogMarkRequestType)(nil)).Elem()] = []string{"This", "Message"}
+	requiredFields[reflect.TypeOf((*EnableAlarm)(nil)).Elem()] = []string{"This", "Alarm", "Entity"}
+	requiredFields[reflect.TypeOf((*EnableAlarmActions)(nil)).Elem()] = []string{"This", "Entity", "Enabled"}
+	requiredFields[reflect.TypeOf((*EnableAlarmActionsRequestType)(nil)).Elem()] = []string{"This", "Entity", "Enabled"}
+	requiredFields[reflect.TypeOf((*EnableAlarmRequestType)(nil)).Elem()] = []string{"This", "Alarm", "Entity"}
+	requiredFields[reflect.TypeOf((*EnableClusteredVmdkSupport)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*EnableClusteredVmdkSupportRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*EnableCrypto)(nil)).Elem()] = []string{"This", "KeyPlain"}
+	requiredFields[reflect.TypeOf((*EnableCryptoRequestType)(nil)).Elem()] = []string{"This", "KeyPlain"}
+	requiredFields[reflect.TypeOf((*EnableFeature)(nil)).Elem()] = []string{"This", "FeatureKey"}
+	requiredFields[reflect.TypeOf((*EnableFeatureRequestType)(nil)).Elem()] = []string{"This", "FeatureKey"}
+	requiredFields[reflect.TypeOf((*EnableFeatureResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*EnableHyperThreading)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*EnableHyperThreadingRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*EnableMultipathPath)(nil)).Elem()] = []string{"This", "PathName"}
+	requiredFields[reflect.TypeOf((*EnableMultipathPathRequestType)(nil)).Elem()] = []string{"This", "PathName"}
+	requiredFields[reflect.TypeOf((*EnableNetworkResourceManagement)(nil)).Elem()] = []string{"This", "Enable"}
+	requiredFields[reflect.TypeOf((*EnableNetworkResourceManagementRequestType)(nil)).Elem()] = []string{"This", "Enable"}
+	requiredFields[reflect.TypeOf((*EnableRuleset)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*EnableRulesetRequestType)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*EnableSecondaryVMRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*EnableSecondaryVM_Task)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*EnableSecondaryVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*EnableSmartCardAuthentication)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*EnableSmartCardAuthenticationRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*EnterLockdownMode)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*EnterLockdownModeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*EnterMaintenanceModeRequestType)(nil)).Elem()] = []string{"This", "Timeout"}
+	requiredFields[reflect.TypeOf((*EnterMaintenanceMode_Task)(nil)).Elem()] = []string{"This", "Timeout"}
+	requiredFields[reflect.TypeOf((*EnterMaintenanceMode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*EntityBackupConfig)(nil)).Elem()] = []string{"EntityType", "ConfigBlob"}
+	requiredFields[reflect.TypeOf((*EntityEventArgument)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*EntityPrivilege)(nil)).Elem()] = []string{"Entity", "PrivAvailability"}
+	requiredFields[reflect.TypeOf((*EnumDescription)(nil)).Elem()] = []string{"Key", "Tags"}
+	requiredFields[reflect.TypeOf((*EstimateDatabaseSize)(nil)).Elem()] = []string{"This", "DbSizeParam"}
+	requiredFields[reflect.TypeOf((*EstimateDatabaseSizeRequestType)(nil)).Elem()] = []string{"This", "DbSizeParam"}
+	requiredFields[reflect.TypeOf((*EstimateDatabaseSizeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*EstimateStorageForConsolidateSnapshotsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*EstimateStorageForConsolidateSnapshots_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*EstimateStorageForConsolidateSnapshots_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*EsxAgentHostManagerUpdateConfig)(nil)).Elem()] = []string{"This", "ConfigInfo"}
+	requiredFields[reflect.TypeOf((*EsxAgentHostManagerUpdateConfigRequestType)(nil)).Elem()] = []string{"This", "ConfigInfo"}
+	requiredFields[reflect.TypeOf((*EvacuateVsanNodeRequestType)(nil)).Elem()] = []string{"This", "MaintenanceSpec", "Timeout"}
+	requiredFields[reflect.TypeOf((*EvacuateVsanNode_Task)(nil)).Elem()] = []string{"This", "MaintenanceSpec", "Timeout"}
+	requiredFields[reflect.TypeOf((*EvacuateVsanNode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*EvcManager)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*EvcManagerRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*Event)(nil)).Elem()] = []string{"Key", "ChainId", "CreatedTime", "UserName"}
+	requiredFields[reflect.TypeOf((*EventAlarmExpression)(nil)).Elem()] = []string{"EventType"}
+	requiredFields[reflect.TypeOf((*EventAlarmExpressionComparison)(nil)).Elem()] = []string{"AttributeName", "Operator", "Value"}
+	requiredFields[reflect.TypeOf((*EventArgDesc)(nil)).Elem()] = []string{"Name", "Type"}
+	requiredFields[reflect.TypeOf((*EventDescription)(nil)).Elem()] = []string{"Category", "EventInfo"}
+	requiredFields[reflect.TypeOf((*EventDescriptionEventDetail)(nil)).Elem()] = []string{"Key", "Category", "FormatOnDatacenter", "FormatOnComputeResource", "FormatOnHost", "FormatOnVm", "FullFormat"}
+	requiredFields[reflect.TypeOf((*EventEx)(nil)).Elem()] = []string{"EventTypeId"}
+	requiredFields[reflect.TypeOf((*EventFilterSpecByEntity)(nil)).Elem()] = []string{"Entity", "Recursion"}
+	requiredFields[reflect.TypeOf((*EventFilterSpecByUsername)(nil)).Elem()] = []string{"SystemUser"}
+	requiredFields[reflect.TypeOf((*ExecuteHostProfile)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*ExecuteHostProfileRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*ExecuteHostProfileResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExecuteSimpleCommand)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExecuteSimpleCommandRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExecuteSimpleCommandResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExitLockdownMode)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExitLockdownModeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExitMaintenanceModeRequestType)(nil)).Elem()] = []string{"This", "Timeout"}
+	requiredFields[reflect.TypeOf((*ExitMaintenanceMode_Task)(nil)).Elem()] = []string{"This", "Timeout"}
+	requiredFields[reflect.TypeOf((*ExitMaintenanceMode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExpandVmfsDatastore)(nil)).Elem()] = []string{"This", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*ExpandVmfsDatastoreRequestType)(nil)).Elem()] = []string{"This", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*ExpandVmfsDatastoreResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExpandVmfsExtent)(nil)).Elem()] = []string{"This", "VmfsPath", "Extent"}
+	requiredFields[reflect.TypeOf((*ExpandVmfsExtentRequestType)(nil)).Elem()] = []string{"This", "VmfsPath", "Extent"}
+	requiredFields[reflect.TypeOf((*ExpiredFeatureLicense)(nil)).Elem()] = []string{"Feature", "Count", "ExpirationDate"}
+	requiredFields[reflect.TypeOf((*ExportAnswerFileRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*ExportAnswerFile_Task)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*ExportAnswerFile_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExportProfile)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExportProfileRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExportProfileResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExportSnapshot)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExportSnapshotRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExportSnapshotResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExportVApp)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExportVAppRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExportVAppResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExportVm)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExportVmRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExportVmResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExtManagedEntityInfo)(nil)).Elem()] = []string{"Type"}
+	requiredFields[reflect.TypeOf((*ExtSolutionManagerInfoTabInfo)(nil)).Elem()] = []string{"Label", "Url"}
+	requiredFields[reflect.TypeOf((*ExtendDiskRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "NewCapacityInMB"}
+	requiredFields[reflect.TypeOf((*ExtendDisk_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "NewCapacityInMB"}
+	requiredFields[reflect.TypeOf((*ExtendDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExtendHCIRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExtendHCI_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExtendHCI_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExtendVffs)(nil)).Elem()] = []string{"This", "VffsPath", "DevicePath"}
+	requiredFields[reflect.TypeOf((*ExtendVffsRequestType)(nil)).Elem()] = []string{"This", "VffsPath", "DevicePath"}
+	requiredFields[reflect.TypeOf((*ExtendVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "Name", "NewCapacityKb"}
+	requiredFields[reflect.TypeOf((*ExtendVirtualDisk_Task)(nil)).Elem()] = []string{"This", "Name", "NewCapacityKb"}
+	requiredFields[reflect.TypeOf((*ExtendVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExtendVmfsDatastore)(nil)).Elem()] = []string{"This", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*ExtendVmfsDatastoreRequestType)(nil)).Elem()] = []string{"This", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*ExtendVmfsDatastoreResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ExtendedDescription)(nil)).Elem()] = []string{"MessageCatalogKeyPrefix"}
+	requiredFields[reflect.TypeOf((*ExtendedElementDescription)(nil)).Elem()] = []string{"MessageCatalogKeyPrefix"}
+	requiredFields[reflect.TypeOf((*ExtendedEvent)(nil)).Elem()] = []string{"EventTypeId", "ManagedObject"}
+	requiredFields[reflect.TypeOf((*ExtendedEventPair)(nil)).Elem()] = []string{"Key", "Value"}
+	requiredFields[reflect.TypeOf((*ExtendedFault)(nil)).Elem()] = []string{"FaultTypeId"}
+	requiredFields[reflect.TypeOf((*ExtendedFaultFault)(nil)).Elem()] = []string{"FaultTypeId"}
+	requiredFields[reflect.TypeOf((*Extension)(nil)).Elem()] = []string{"Description", "Key", "Version", "LastHeartbeatTime"}
+	requiredFields[reflect.TypeOf((*ExtensionClientInfo)(nil)).Elem()] = []string{"Version", "Description", "Company", "Type", "Url"}
+	requiredFields[reflect.TypeOf((*ExtensionEventTypeInfo)(nil)).Elem()] = []string{"EventID"}
+	requiredFields[reflect.TypeOf((*ExtensionFaultTypeInfo)(nil)).Elem()] = []string{"FaultID"}
+	requiredFields[reflect.TypeOf((*ExtensionHealthInfo)(nil)).Elem()] = []string{"Url"}
+	requiredFields[reflect.TypeOf((*ExtensionManagerIpAllocationUsage)(nil)).Elem()] = []string{"ExtensionKey", "NumAddresses"}
+	requiredFields[reflect.TypeOf((*ExtensionOvfConsumerInfo)(nil)).Elem()] = []string{"CallbackUrl", "SectionType"}
+	requiredFields[reflect.TypeOf((*ExtensionPrivilegeInfo)(nil)).Elem()] = []string{"PrivID", "PrivGroupName"}
+	requiredFields[reflect.TypeOf((*ExtensionResourceInfo)(nil)).Elem()] = []string{"Locale", "Module", "Data"}
+	requiredFields[reflect.TypeOf((*ExtensionServerInfo)(nil)).Elem()] = []string{"Url", "Description", "Company", "Type", "AdminEmail"}
+	requiredFields[reflect.TypeOf((*ExtensionTaskTypeInfo)(nil)).Elem()] = []string{"TaskID"}
+	requiredFields[reflect.TypeOf((*ExtractOvfEnvironment)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExtractOvfEnvironmentRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ExtractOvfEnvironmentResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*FailToEnableSPBM)(nil)).Elem()] = []string{"Cs", "CsName", "HostLicenseStates"}
+	requiredFields[reflect.TypeOf((*FailToEnableSPBMFault)(nil)).Elem()] = []string{"Cs", "CsName", "HostLicenseStates"}
+	requiredFields[reflect.TypeOf((*FailToLockFaultToleranceVMs)(nil)).Elem()] = []string{"VmName", "Vm", "AlreadyLockedVm"}
+	requiredFields[reflect.TypeOf((*FailToLockFaultToleranceVMsFault)(nil)).Elem()] = []string{"VmName", "Vm", "AlreadyLockedVm"}
+	requiredFields[reflect.TypeOf((*FailoverNodeInfo)(nil)).Elem()] = []string{"ClusterIpSettings"}
+	requiredFields[reflect.TypeOf((*FaultDomainId)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*FaultToleranceAntiAffinityViolated)(nil)).Elem()] = []string{"HostName", "Host"}
+	requiredFields[reflect.TypeOf((*FaultToleranceAntiAffinityViolatedFault)(nil)).Elem()] = []string{"HostName", "Host"}
+	requiredFields[reflect.TypeOf((*FaultToleranceCannotEditMem)(nil)).Elem()] = []string{"VmName", "Vm"}
+	requiredFields[reflect.TypeOf((*FaultToleranceCannotEditMemFault)(nil)).Elem()] = []string{"VmName", "Vm"}
+	requiredFields[reflect.TypeOf((*FaultToleranceConfigInfo)(nil)).Elem()] = []string{"Role", "InstanceUuids", "ConfigPaths"}
+	requiredFields[reflect.TypeOf((*FaultToleranceCpuIncompatible)(nil)).Elem()] = []string{"Model", "Family", "Stepping"}
+	requiredFields[reflect.TypeOf((*FaultToleranceCpuIncompatibleFault)(nil)).Elem()] = []string{"Model", "Family", "Stepping"}
+	requiredFields[reflect.TypeOf((*FaultToleranceDiskSpec)(nil)).Elem()] = []string{"Disk", "Datastore"}
+	requiredFields[reflect.TypeOf((*FaultToleranceMetaSpec)(nil)).Elem()] = []string{"MetaDataDatastore"}
+	requiredFields[reflect.TypeOf((*FaultToleranceNeedsThickDisk)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*FaultToleranceNeedsThickDiskFault)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*FaultToleranceNotSameBuild)(nil)).Elem()] = []string{"Build"}
+	requiredFields[reflect.TypeOf((*FaultToleranceNotSameBuildFault)(nil)).Elem()] = []string{"Build"}
+	requiredFields[reflect.TypeOf((*FaultTolerancePrimaryConfigInfo)(nil)).Elem()] = []string{"Secondaries"}
+	requiredFields[reflect.TypeOf((*FaultTolerancePrimaryPowerOnNotAttempted)(nil)).Elem()] = []string{"SecondaryVm", "PrimaryVm"}
+	requiredFields[reflect.TypeOf((*FaultTolerancePrimaryPowerOnNotAttemptedFault)(nil)).Elem()] = []string{"SecondaryVm", "PrimaryVm"}
+	requiredFields[reflect.TypeOf((*FaultToleranceSecondaryConfigInfo)(nil)).Elem()] = []string{"PrimaryVM"}
+	requiredFields[reflect.TypeOf((*FaultToleranceSecondaryOpResult)(nil)).Elem()] = []string{"Vm", "PowerOnAttempted"}
+	requiredFields[reflect.TypeOf((*FaultToleranceVmNotDasProtected)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*FaultToleranceVmNotDasProtectedFault)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*FaultsByHost)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*FaultsByVM)(nil)).Elem()] = []string{"Vm"}
+	requiredFields[reflect.TypeOf((*FcoeConfig)(nil)).Elem()] = []string{"PriorityClass", "SourceMac", "VlanRange", "Capabilities", "FcoeActive"}
+	requiredFields[reflect.TypeOf((*FcoeConfigFcoeCapabilities)(nil)).Elem()] = []string{"PriorityClass", "SourceMacAddress", "VlanRange"}
+	requiredFields[reflect.TypeOf((*FcoeConfigFcoeSpecification)(nil)).Elem()] = []string{"UnderlyingPnic"}
+	requiredFields[reflect.TypeOf((*FcoeConfigVlanRange)(nil)).Elem()] = []string{"VlanLow", "VlanHigh"}
+	requiredFields[reflect.TypeOf((*FcoeFaultPnicHasNoPortSet)(nil)).Elem()] = []string{"NicDevice"}
+	requiredFields[reflect.TypeOf((*FcoeFaultPnicHasNoPortSetFault)(nil)).Elem()] = []string{"NicDevice"}
+	requiredFields[reflect.TypeOf((*FetchAuditRecords)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchAuditRecordsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchAuditRecordsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*FetchDVPortKeys)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchDVPortKeysRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchDVPorts)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchDVPortsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchSoftwarePackages)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchSoftwarePackagesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchSystemEventLog)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchSystemEventLogRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*FetchUserPrivilegeOnEntities)(nil)).Elem()] = []string{"This", "Entities", "UserName"}
+	requiredFields[reflect.TypeOf((*FetchUserPrivilegeOnEntitiesRequestType)(nil)).Elem()] = []string{"This", "Entities", "UserName"}
+	requiredFields[reflect.TypeOf((*FileBackedVirtualDiskSpec)(nil)).Elem()] = []string{"CapacityKb"}
+	requiredFields[reflect.TypeOf((*FileFault)(nil)).Elem()] = []string{"File"}
+	requiredFields[reflect.TypeOf((*FileInfo)(nil)).Elem()] = []string{"Path"}
+	requiredFields[reflect.TypeOf((*FileLockInfo)(nil)).Elem()] = []string{"FilePath", "Host", "Mac", "Id", "WorldName", "LockMode"}
+	requiredFields[reflect.TypeOf((*FileQueryFlags)(nil)).Elem()] = []string{"FileType", "FileSize", "Modification"}
+	requiredFields[reflect.TypeOf((*FileTooLarge)(nil)).Elem()] = []string{"Datastore", "FileSize"}
+	requiredFields[reflect.TypeOf((*FileTooLargeFault)(nil)).Elem()] = []string{"Datastore", "FileSize"}
+	requiredFields[reflect.TypeOf((*FileTransferInformation)(nil)).Elem()] = []string{"Attributes", "Size", "Url"}
+	requiredFields[reflect.TypeOf((*FindAllByDnsName)(nil)).Elem()] = []string{"This", "DnsName", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindAllByDnsNameRequestType)(nil)).Elem()] = []string{"This", "DnsName", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindAllByDnsNameResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*FindAllByIp)(nil)).Elem()] = []string{"This", "Ip", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindAllByIpRequestType)(nil)).Elem()] = []string{"This", "Ip", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindAllByIpResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*FindAllByUuid)(nil)).Elem()] = []string{"This", "Uuid", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindAllByUuidRequestType)(nil)).Elem()] = []string{"This", "Uuid", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindAllByUuidResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*FindAssociatedProfile)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*FindAssociatedProfileRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*FindByDatastorePath)(nil)).Elem()] = []string{"This", "Datacenter", "Path"}
+	requiredFields[reflect.TypeOf((*FindByDatastorePathRequestType)(nil)).Elem()] = []string{"This", "Datacenter", "Path"}
+	requiredFields[reflect.TypeOf((*FindByDnsName)(nil)).Elem()] = []string{"This", "DnsName", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindByDnsNameRequestType)(nil)).Elem()] = []string{"This", "DnsName", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindByInventoryPath)(nil)).Elem()] = []string{"This", "InventoryPath"}
+	requiredFields[reflect.TypeOf((*FindByInventoryPathRequestType)(nil)).Elem()] = []string{"This", "InventoryPath"}
+	requiredFields[reflect.TypeOf((*FindByIp)(nil)).Elem()] = []string{"This", "Ip", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindByIpRequestType)(nil)).Elem()] = []string{"This", "Ip", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindByUuid)(nil)).Elem()] = []string{"This", "Uuid", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindByUuidRequestType)(nil)).Elem()] = []string{"This", "Uuid", "VmSearch"}
+	requiredFields[reflect.TypeOf((*FindChild)(nil)).Elem()] = []string{"This", "Entity", "Name"}
+	requiredFields[reflect.TypeOf((*FindChildRequestType)(nil)).Elem()] = []string{"This", "Entity", "Name"}
+	requiredFields[reflect.TypeOf((*FindExtension)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*FindExtensionRequestType)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*FindRulesForVm)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*FindRulesForVmRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*FirewallProfileRulesetProfile)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*FloatOption)(nil)).Elem()] = []string{"Min", "Max", "DefaultValue"}
+	requiredFields[reflect.TypeOf((*FolderEventArgument)(nil)).Elem()] = []string{"Folder"}
+	requiredFields[reflect.TypeOf((*FolderFailedHostResult)(nil)).Elem()] = []string{"Context", "Fault"}
+	requiredFields[reflect.TypeOf((*FolderNewHostSpec)(nil)).Elem()] = []string{"HostCnxSpec"}
+	requiredFields[reflect.TypeOf((*FormatVffs)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*FormatVffsRequestType)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*FormatVffsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*FormatVmfs)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*FormatVmfsRequestType)(nil)).Elem()] = []string{"This", "CreateSpec"}
+	requiredFields[reflect.TypeOf((*FormatVmfsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*FtIssuesOnHost)(nil)).Elem()] = []string{"Host", "HostName"}
+	requiredFields[reflect.TypeOf((*FtIssuesOnHostFault)(nil)).Elem()] = []string{"Host", "HostName"}
+	requiredFields[reflect.TypeOf((*FtVmHostRuleViolation)(nil)).Elem()] = []string{"VmName", "HostName", "HostGroup"}
+	requiredFields[reflect.TypeOf((*FtVmHostRuleViolationFault)(nil)).Elem()] = []string{"VmName", "HostName", "HostGroup"}
+	requiredFields[reflect.TypeOf((*GatewayConnectFault)(nil)).Elem()] = []string{"GatewayType", "GatewayId", "GatewayInfo"}
+	requiredFields[reflect.TypeOf((*GatewayToHostAuthFault)(nil)).Elem()] = []string{"InvalidProperties", "MissingProperties"}
+	requiredFields[reflect.TypeOf((*GatewayToHostAuthFaultFault)(nil)).Elem()] = []string{"InvalidProperties", "MissingProperties"}
+	requiredFields[reflect.TypeOf((*GatewayToHostConnectFault)(nil)).Elem()] = []string{"Hostname"}
+	requiredFields[reflect.TypeOf((*GatewayToHostTrustVerifyFault)(nil)).Elem()] = []string{"VerificationToken", "PropertiesToVerify"}
+	requiredFields[reflect.TypeOf((*GatewayToHostTrustVerifyFaultFault)(nil)).Elem()] = []string{"VerificationToken", "PropertiesToVerify"}
+	requiredFields[reflect.TypeOf((*GeneralEvent)(nil)).Elem()] = []string{"Message"}
+	requiredFields[reflect.TypeOf((*GenerateCertificateSigningRequest)(nil)).Elem()] = []string{"This", "UseIpAddressAsCommonName"}
+	requiredFields[reflect.TypeOf((*GenerateCertificateSigningRequestByDn)(nil)).Elem()] = []string{"This", "DistinguishedName"}
+	requiredFields[reflect.TypeOf((*GenerateCertificateSigningRequestByDnRequestType)(nil)).Elem()] = []string{"This", "DistinguishedName"}
+	requiredFields[reflect.TypeOf((*GenerateCertificateSigningRequestByDnResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GenerateCertificateSigningRequestRequestType)(nil)).Elem()] = []string{"This", "UseIpAddressAsCommonName"}
+	requiredFields[reflect.TypeOf((*GenerateCertificateSigningRequestResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GenerateClientCsr)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*GenerateClientCsrRequestType)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*GenerateClientCsrResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GenerateConfigTaskList)(nil)).Elem()] = []string{"This", "ConfigSpec", "Host"}
+	requiredFields[reflect.TypeOf((*GenerateConfigTaskListRequestType)(nil)).Elem()] = []string{"This", "ConfigSpec", "Host"}
+	requiredFields[reflect.TypeOf((*GenerateConfigTaskListResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GenerateHostConfigTaskSpecRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GenerateHostConfigTaskSpec_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GenerateHostConfigTaskSpec_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GenerateHostProfileTaskListRequestType)(nil)).Elem()] = []string{"This", "ConfigSpec", "Host"}
+	requiredFields[reflect.TypeOf((*GenerateHostProfileTaskList_Task)(nil)).Elem()] = []string{"This", "ConfigSpec", "Host"}
+	requiredFields[reflect.TypeOf((*GenerateHostProfileTaskList_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GenerateKey)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GenerateKeyRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GenerateKeyResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GenerateLogBundlesRequestType)(nil)).Elem()] = []string{"This", "IncludeDefault"}
+	requiredFields[reflect.TypeOf((*GenerateLogBundles_Task)(nil)).Elem()] = []string{"This", "IncludeDefault"}
+	requiredFields[reflect.TypeOf((*GenerateLogBundles_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GenerateSelfSignedClientCert)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*GenerateSelfSignedClientCertRequestType)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*GenerateSelfSignedClientCertResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GenericVmConfigFault)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*GenericVmConfigFaultFault)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*GetAlarm)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetAlarmRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetAlarmState)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*GetAlarmStateRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*GetClusterMode)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetClusterModeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetClusterModeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GetCryptoKeyStatus)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetCryptoKeyStatusRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetCustomizationSpec)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*GetCustomizationSpecRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*GetCustomizationSpecResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GetDefaultKmsCluster)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetDefaultKmsClusterRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetPublicKey)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetPublicKeyRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetPublicKeyResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GetResourceUsage)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetResourceUsageRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetResourceUsageResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GetSiteInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetSiteInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetSiteInfoResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GetSystemVMsRestrictedDatastores)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetSystemVMsRestrictedDatastoresRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetVchaClusterHealth)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetVchaClusterHealthRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetVchaClusterHealthResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GetVchaConfig)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetVchaConfigRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*GetVchaConfigResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GetVsanObjExtAttrs)(nil)).Elem()] = []string{"This", "Uuids"}
+	requiredFields[reflect.TypeOf((*GetVsanObjExtAttrsRequestType)(nil)).Elem()] = []string{"This", "Uuids"}
+	requiredFields[reflect.TypeOf((*GetVsanObjExtAttrsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*GhostDvsProxySwitchDetectedEvent)(nil)).Elem()] = []string{"SwitchUuid"}
+	requiredFields[reflect.TypeOf((*GhostDvsProxySwitchRemovedEvent)(nil)).Elem()] = []string{"SwitchUuid"}
+	requiredFields[reflect.TypeOf((*GlobalMessageChangedEvent)(nil)).Elem()] = []string{"Message"}
+	requiredFields[reflect.TypeOf((*GroupAlarmAction)(nil)).Elem()] = []string{"Action"}
+	requiredFields[reflect.TypeOf((*GuestAliases)(nil)).Elem()] = []string{"Base64Cert", "Aliases"}
+	requiredFields[reflect.TypeOf((*GuestAuthAliasInfo)(nil)).Elem()] = []string{"Subject", "Comment"}
+	requiredFields[reflect.TypeOf((*GuestAuthNamedSubject)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*GuestAuthentication)(nil)).Elem()] = []string{"InteractiveSession"}
+	requiredFields[reflect.TypeOf((*GuestAuthenticationChallenge)(nil)).Elem()] = []string{"ServerChallenge", "SessionID"}
+	requiredFields[reflect.TypeOf((*GuestAuthenticationChallengeFault)(nil)).Elem()] = []string{"ServerChallenge", "SessionID"}
+	requiredFields[reflect.TypeOf((*GuestFileInfo)(nil)).Elem()] = []string{"Path", "Type", "Size", "Attributes"}
+	requiredFields[reflect.TypeOf((*GuestInfo)(nil)).Elem()] = []string{"GuestState"}
+	requiredFields[reflect.TypeOf((*GuestInfoCustomizationInfo)(nil)).Elem()] = []string{"CustomizationStatus"}
+	requiredFields[reflect.TypeOf((*GuestInfoNamespaceGenerationInfo)(nil)).Elem()] = []string{"Key", "GenerationNo"}
+	requiredFields[reflect.TypeOf((*GuestInfoVirtualDiskMapping)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*GuestListFileInfo)(nil)).Elem()] = []string{"Remaining"}
+	requiredFields[reflect.TypeOf((*GuestMappedAliases)(nil)).Elem()] = []string{"Base64Cert", "Username", "Subjects"}
+	requiredFields[reflect.TypeOf((*GuestNicInfo)(nil)).Elem()] = []string{"Connected", "DeviceConfigId"}
+	requiredFields[reflect.TypeOf((*GuestOsDescriptor)(nil)).Elem()] = []string{"Id", "Family", "FullName", "SupportedMaxCPUs", "SupportedMinMemMB", "SupportedMaxMemMB", "RecommendedMemMB", "RecommendedColorDepth", "SupportedDiskControllerList", "RecommendedDiskController", "SupportedNumDisks", "RecommendedDiskSizeMB", "SupportedEthernetCard", "SupportsWakeOnLan"}
+	requiredFields[reflect.TypeOf((*GuestProcessInfo)(nil)).Elem()] = []string{"Name", "Pid", "Owner", "CmdLine", "StartTime"}
+	requiredFields[reflect.TypeOf((*GuestProcessNotFound)(nil)).Elem()] = []string{"Pid"}
+	requiredFields[reflect.TypeOf((*GuestProcessNotFoundFault)(nil)).Elem()] = []string{"Pid"}
+	requiredFields[reflect.TypeOf((*GuestProgramSpec)(nil)).Elem()] = []string{"ProgramPath", "Arguments"}
+	requiredFields[reflect.TypeOf((*GuestRegKeyNameSpec)(nil)).Elem()] = []string{"RegistryPath", "WowBitness"}
+	requiredFields[reflect.TypeOf((*GuestRegKeyRecordSpec)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*GuestRegKeySpec)(nil)).Elem()] = []string{"KeyName", "ClassType", "LastWritten"}
+	requiredFields[reflect.TypeOf((*GuestRegValueDwordSpec)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*GuestRegValueNameSpec)(nil)).Elem()] = []string{"KeyName", "Name"}
+	requiredFields[reflect.TypeOf((*GuestRegValueQwordSpec)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*GuestRegValueSpec)(nil)).Elem()] = []string{"Name", "Data"}
+	requiredFields[reflect.TypeOf((*GuestRegistryFault)(nil)).Elem()] = []string{"WindowsSystemErrorCode"}
+	requiredFields[reflect.TypeOf((*GuestRegistryKeyFault)(nil)).Elem()] = []string{"KeyName"}
+	requiredFields[reflect.TypeOf((*GuestRegistryValueFault)(nil)).Elem()] = []string{"KeyName", "ValueName"}
+	requiredFields[reflect.TypeOf((*GuestScreenInfo)(nil)).Elem()] = []string{"Width", "Height"}
+	requiredFields[reflect.TypeOf((*GuestWindowsProgramSpec)(nil)).Elem()] = []string{"StartMinimized"}
+	requiredFields[reflect.TypeOf((*HasMonitoredEntity)(nil)).Elem()] = []string{"This", "ProviderId", "Entity"}
+	requiredFields[reflect.TypeOf((*HasMonitoredEntityRequestType)(nil)).Elem()] = []string{"This", "ProviderId", "Entity"}
+	requiredFields[reflect.TypeOf((*HasMonitoredEntityResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HasPrivilegeOnEntities)(nil)).Elem()] = []string{"This", "Entity", "SessionId"}
+	requiredFields[reflect.TypeOf((*HasPrivilegeOnEntitiesRequestType)(nil)).Elem()] = []string{"This", "Entity", "SessionId"}
+	requiredFields[reflect.TypeOf((*HasPrivilegeOnEntity)(nil)).Elem()] = []string{"This", "Entity", "SessionId"}
+	requiredFields[reflect.TypeOf((*HasPrivilegeOnEntityRequestType)(nil)).Elem()] = []string{"This", "Entity", "SessionId"}
+	requiredFields[reflect.TypeOf((*HasProvider)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*HasProviderRequestType)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*HasProviderResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HasUserPrivilegeOnEntities)(nil)).Elem()] = []string{"This", "Entities", "UserName"}
+	requiredFields[reflect.TypeOf((*HasUserPrivilegeOnEntitiesRequestType)(nil)).Elem()] = []string{"This", "Entities", "UserName"}
+	requiredFields[reflect.TypeOf((*HbrDiskMigrationAction)(nil)).Elem()] = []string{"CollectionId", "CollectionName", "DiskIds", "Source", "Destination", "SizeTransferred"}
+	requiredFields[reflect.TypeOf((*HbrManagerReplicationVmInfo)(nil)).Elem()] = []string{"State"}
+	requiredFields[reflect.TypeOf((*HbrManagerVmReplicationCapability)(nil)).Elem()] = []string{"Vm", "SupportedQuiesceMode", "CompressionSupported", "MaxSupportedSourceDiskCapacity"}
+	requiredFields[reflect.TypeOf((*HealthStatusChangedEvent)(nil)).Elem()] = []string{"ComponentId", "OldStatus", "NewStatus", "ComponentName"}
+	requiredFields[reflect.TypeOf((*HealthUpdate)(nil)).Elem()] = []string{"Entity", "HealthUpdateInfoId", "Id", "Status", "Remediation"}
+	requiredFields[reflect.TypeOf((*HealthUpdateInfo)(nil)).Elem()] = []string{"Id", "ComponentType", "Description"}
+	requiredFields[reflect.TypeOf((*HostAccessControlEntry)(nil)).Elem()] = []string{"Principal", "Group", "AccessMode"}
+	requiredFields[reflect.TypeOf((*HostAccessRestrictedToManagementServer)(nil)).Elem()] = []string{"ManagementServer"}
+	requiredFields[reflect.TypeOf((*HostAccessRestrictedToManagementServerFault)(nil)).Elem()] = []string{"ManagementServer"}
+	requiredFields[reflect.TypeOf((*HostAccountSpec)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*HostActiveDirectory)(nil)).Elem()] = []string{"ChangeOperation"}
+	requiredFields[reflect.TypeOf((*HostAddFailedEvent)(nil)).Elem()] = []string{"Hostname"}
+	requiredFields[reflect.TypeOf((*HostAssignableHardwareBinding)(nil)).Elem()] = []string{"InstanceId", "Vm"}
+	requiredFields[reflect.TypeOf((*HostAssignableHardwareConfigAttributeOverride)(nil)).Elem()] = []string{"InstanceId", "Name", "Value"}
+	requiredFields[reflect.TypeOf((*HostAuthenticationManagerInfo)(nil)).Elem()] = []string{"AuthConfig"}
+	requiredFields[reflect.TypeOf((*HostAuthenticationStoreInfo)(nil)).Elem()] = []string{"Enabled"}
+	requiredFields[reflect.TypeOf((*HostBootDevice)(nil)).Elem()] = []string{"Key", "Description"}
+	requiredFields[reflect.TypeOf((*HostCacheConfigurationInfo)(nil)).Elem()] = []string{"Key", "SwapSize"}
+	requiredFields[reflect.TypeOf((*HostCacheConfigurationSpec)(nil)).Elem()] = []string{"Datastore", "SwapSize"}
+	requiredFields[reflect.TypeOf((*HostCapability)(nil)).Elem()] = []string{"RecursiveResourcePoolsSupported", "CpuMemoryResourceConfigurationSupported", "RebootSupported", "ShutdownSupported", "VmotionSupported", "StandbySupported", "DatastorePrincipalSupported", "SanSupported", "NfsSupported", "IscsiSupported", "VlanTaggingSupported", "NicTeamingSupported", "HighGuestMemSupported", "MaintenanceModeSupported", "SuspendedRelocateSupported", "RestrictedSnapshotRelocateSupported", "PerVmSwapFiles", "LocalSwapDatastoreSupported", "UnsharedSwapVMotionSupported", "BackgroundSnapshotsSupported", "PreAssignedPCIUnitNumbersSupported", "ScreenshotSupported", "ScaledScreenshotSupported"}
+	requiredFields[reflect.TypeOf((*HostCertificateManagerCertificateInfo)(nil)).Elem()] = []string{"Status"}
+	requiredFields[reflect.TypeOf((*HostCertificateManagerCertificateSpec)(nil)).Elem()] = []string{"Kind"}
+	requiredFields[reflect.TypeOf((*HostClearVStorageObjectControlFlags)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostClearVStorageObjectControlFlagsRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostCloneVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*HostCloneVStorageObject_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*HostCloneVStorageObject_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostCnxFailedAlreadyManagedEvent)(nil)).Elem()] = []string{"ServerName"}
+	requiredFields[reflect.TypeOf((*HostComplianceCheckedEvent)(nil)).Elem()] = []string{"Profile"}
+	requiredFields[reflect.TypeOf((*HostConfigFailed)(nil)).Elem()] = []string{"Failure"}
+	requiredFields[reflect.TypeOf((*HostConfigFailedFault)(nil)).Elem()] = []string{"Failure"}
+	requiredFields[reflect.TypeOf((*HostConfigInfo)(nil)).Elem()] = []string{"Host", "Product"}
+	requiredFields[reflect.TypeOf((*HostConfigSummary)(nil)).Elem()] = []string{"Name", "Port", "VmotionEnabled"}
+	requiredFields[reflect.TypeOf((*HostConfigVFlashCache)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*HostConfigVFlashCacheRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*HostConfigureVFlashResource)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*HostConfigureVFlashResourceRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*HostConnectInfo)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*HostConnectInfoNetworkInfo)(nil)).Elem()] = []string{"Summary"}
+	requiredFields[reflect.TypeOf((*HostConnectSpec)(nil)).Elem()] = []string{"Force"}
+	requiredFields[reflect.TypeOf((*HostCpuIdInfo)(nil)).Elem()] = []string{"Level"}
+	requiredFields[reflect.TypeOf((*HostCpuInfo)(nil)).Elem()] = []string{"NumCpuPackages", "NumCpuCores", "NumCpuThreads", "Hz"}
+	requiredFields[reflect.TypeOf((*HostCpuPackage)(nil)).Elem()] = []string{"Index", "Vendor", "Hz", "BusHz", "Description", "ThreadId"}
+	requiredFields[reflect.TypeOf((*HostCpuSchedulerInfo)(nil)).Elem()] = []string{"Policy"}
+	requiredFields[reflect.TypeOf((*HostCreateDiskRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*HostCreateDisk_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*HostCreateDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostDataTransportConnectionInfo)(nil)).Elem()] = []string{"StaticMemoryConsumed"}
+	requiredFields[reflect.TypeOf((*HostDatastoreConnectInfo)(nil)).Elem()] = []string{"Summary"}
+	requiredFields[reflect.TypeOf((*HostDatastoreExistsConnectInfo)(nil)).Elem()] = []string{"NewDatastoreName"}
+	requiredFields[reflect.TypeOf((*HostDatastoreNameConflictConnectInfo)(nil)).Elem()] = []string{"NewDatastoreName"}
+	requiredFields[reflect.TypeOf((*HostDatastoreSystemCapabilities)(nil)).Elem()] = []string{"NfsMountCreationRequired", "NfsMountCreationSupported", "LocalDatastoreSupported"}
+	requiredFields[reflect.TypeOf((*HostDatastoreSystemDatastoreResult)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*HostDatastoreSystemVvolDatastoreSpec)(nil)).Elem()] = []string{"Name", "ScId"}
+	requiredFields[reflect.TypeOf((*HostDateTimeInfo)(nil)).Elem()] = []string{"TimeZone"}
+	requiredFields[reflect.TypeOf((*HostDateTimeSystemServiceTestResult)(nil)).Elem()] = []string{"WorkingNormally"}
+	requiredFields[reflect.TypeOf((*HostDateTimeSystemTimeZone)(nil)).Elem()] = []string{"Key", "Name", "Description", "GmtOffset"}
+	requiredFields[reflect.TypeOf((*HostDeleteVStorageObjectExRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostDeleteVStorageObjectEx_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostDeleteVStorageObjectEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostDeleteVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostDeleteVStorageObject_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostDeleteVStorageObject_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostDevice)(nil)).Elem()] = []string{"DeviceName", "DeviceType"}
+	requiredFields[reflect.TypeOf((*HostDhcpService)(nil)).Elem()] = []string{"Key", "Spec"}
+	requiredFields[reflect.TypeOf((*HostDhcpServiceConfig)(nil)).Elem()] = []string{"Key", "Spec"}
+	requiredFields[reflect.TypeOf((*HostDhcpServiceSpec)(nil)).Elem()] = []string{"VirtualSwitch", "DefaultLeaseDuration", "LeaseBeginIp", "LeaseEndIp", "MaxLeaseDuration", "UnlimitedLease", "IpSubnetAddr", "IpSubnetMask"}
+	requiredFields[reflect.TypeOf((*HostDiagnosticPartition)(nil)).Elem()] = []string{"StorageType", "DiagnosticType", "Slots", "Id"}
+	requiredFields[reflect.TypeOf((*HostDiagnosticPartitionCreateDescription)(nil)).Elem()] = []string{"Layout", "DiskUuid", "Spec"}
+	requiredFields[reflect.TypeOf((*HostDiagnosticPartitionCreateOption)(nil)).Elem()] = []string{"StorageType", "DiagnosticType", "Disk"}
+	requiredFields[reflect.TypeOf((*HostDiagnosticPartitionCreateSpec)(nil)).Elem()] = []string{"StorageType", "DiagnosticType", "Id", "Partition"}
+	requiredFields[reflect.TypeOf((*HostDigestInfo)(nil)).Elem()] = []string{"DigestMethod", "DigestValue"}
+	requiredFields[reflect.TypeOf((*HostDiskDimensionsChs)(nil)).Elem()] = []string{"Cylinder", "Head", "Sector"}
+	requiredFields[reflect.TypeOf((*HostDiskDimensionsLba)(nil)).Elem()] = []string{"BlockSize", "Block"}
+	requiredFields[reflect.TypeOf((*HostDiskMappingInfo)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*HostDiskMappingOption)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*HostDiskMappingPartitionInfo)(nil)).Elem()] = []string{"Name", "FileSystem", "CapacityInKb"}
+	requiredFields[reflect.TypeOf((*HostDiskMappingPartitionOption)(nil)).Elem()] = []string{"Name", "FileSystem", "CapacityInKb"}
+	requiredFields[reflect.TypeOf((*HostDiskPartitionAttributes)(nil)).Elem()] = []string{"Partition", "StartSector", "EndSector", "Type", "Logical", "Attributes"}
+	requiredFields[reflect.TypeOf((*HostDiskPartitionBlockRange)(nil)).Elem()] = []string{"Type", "Start", "End"}
+	requiredFields[reflect.TypeOf((*HostDiskPartitionInfo)(nil)).Elem()] = []string{"DeviceName", "Spec", "Layout"}
+	requiredFields[reflect.TypeOf((*HostDiskPartitionLayout)(nil)).Elem()] = []string{"Partition"}
+	requiredFields[reflect.TypeOf((*HostDnsConfig)(nil)).Elem()] = []string{"Dhcp", "HostName", "DomainName"}
+	requiredFields[reflect.TypeOf((*HostDvxClass)(nil)).Elem()] = []string{"DeviceClass", "CheckpointSupported", "SwDMATracingSupported", "SriovNic"}
+	requiredFields[reflect.TypeOf((*HostEnableAdminFailedEvent)(nil)).Elem()] = []string{"Permissions"}
+	requiredFields[reflect.TypeOf((*HostEventArgument)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*HostExtendDiskRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "NewCapacityInMB"}
+	requiredFields[reflect.TypeOf((*HostExtendDisk_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "NewCapacityInMB"}
+	requiredFields[reflect.TypeOf((*HostExtendDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostFaultToleranceManagerComponentHealthInfo)(nil)).Elem()] = []string{"IsStorageHealthy", "IsNetworkHealthy"}
+	requiredFields[reflect.TypeOf((*HostFeatureCapability)(nil)).Elem()] = []string{"Key", "FeatureName", "Value"}
+	requiredFields[reflect.TypeOf((*HostFeatureMask)(nil)).Elem()] = []string{"Key", "FeatureName", "Value"}
+	requiredFields[reflect.TypeOf((*HostFeatureVersionInfo)(nil)).Elem()] = []string{"Key", "Value"}
+	requiredFields[reflect.TypeOf((*HostFibreChannelHba)(nil)).Elem()] = []string{"PortWorldWideName", "NodeWorldWideName", "PortType", "Speed"}
+	requiredFields[reflect.TypeOf((*HostFibreChannelOverEthernetHba)(nil)).Elem()] = []string{"UnderlyingNic", "LinkInfo", "IsSoftwareFcoe"}
+	requiredFields[reflect.TypeOf((*HostFibreChannelOverEthernetHbaLinkInfo)(nil)).Elem()] = []string{"VnportMac", "FcfMac", "VlanId"}
+	requiredFields[reflect.TypeOf((*HostFibreChannelOverEthernetTargetTransport)(nil)).Elem()] = []string{"VnportMac", "FcfMac", "VlanId"}
+	requiredFields[reflect.TypeOf((*HostFibreChannelTargetTransport)(nil)).Elem()] = []string{"PortWorldWideName", "NodeWorldWideName"}
+	requiredFields[reflect.TypeOf((*HostFileAccess)(nil)).Elem()] = []string{"Who", "What"}
+	requiredFields[reflect.TypeOf((*HostFileSystemMountInfo)(nil)).Elem()] = []string{"MountInfo", "Volume"}
+	requiredFields[reflect.TypeOf((*HostFileSystemVolume)(nil)).Elem()] = []string{"Type", "Name", "Capacity"}
+	requiredFields[reflect.TypeOf((*HostFirewallConfig)(nil)).Elem()] = []string{"DefaultBlockingPolicy"}
+	requiredFields[reflect.TypeOf((*HostFirewallConfigRuleSetConfig)(nil)).Elem()] = []string{"RulesetId", "Enabled"}
+	requiredFields[reflect.TypeOf((*HostFirewallInfo)(nil)).Elem()] = []string{"DefaultPolicy"}
+	requiredFields[reflect.TypeOf((*HostFirewallRule)(nil)).Elem()] = []string{"Port", "Direction", "Protocol"}
+	requiredFields[reflect.TypeOf((*HostFirewallRuleset)(nil)).Elem()] = []string{"Key", "Label", "Required", "Rule", "Enabled"}
+	requiredFields[reflect.TypeOf((*HostFirewallRulesetIpList)(nil)).Elem()] = []string{"AllIp"}
+	requiredFields[reflect.TypeOf((*HostFirewallRulesetIpNetwork)(nil)).Elem()] = []string{"Network", "PrefixLength"}
+	requiredFields[reflect.TypeOf((*HostFirewallRulesetRulesetSpec)(nil)).Elem()] = []string{"AllowedHosts"}
+	requiredFields[reflect.TypeOf((*HostForceMountedInfo)(nil)).Elem()] = []string{"Persist", "Mounted"}
+	requiredFields[reflect.TypeOf((*HostFru)(nil)).Elem()] = []string{"Type", "PartName", "PartNumber", "Manufacturer"}
+	requiredFields[reflect.TypeOf((*HostGatewaySpec)(nil)).Elem()] = []string{"GatewayType"}
+	requiredFields[reflect.TypeOf((*HostGetVFlashModuleDefaultConfig)(nil)).Elem()] = []string{"This", "VFlashModule"}
+	requiredFields[reflect.TypeOf((*HostGetVFlashModuleDefaultConfigRequestType)(nil)).Elem()] = []string{"This", "VFlashModule"}
+	requiredFields[reflect.TypeOf((*HostGetVFlashModuleDefaultConfigResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostGraphicsConfig)(nil)).Elem()] = []string{"HostDefaultGraphicsType", "SharedPassthruAssignmentPolicy"}
+	requiredFields[reflect.TypeOf((*HostGraphicsConfigDeviceType)(nil)).Elem()] = []string{"DeviceId", "GraphicsType"}
+	requiredFields[reflect.TypeOf((*HostGraphicsInfo)(nil)).Elem()] = []string{"DeviceName", "VendorName", "PciId", "GraphicsType", "MemorySizeInKB"}
+	requiredFields[reflect.TypeOf((*HostHardwareElementInfo)(nil)).Elem()] = []string{"Name", "Status"}
+	requiredFields[reflect.TypeOf((*HostHardwareInfo)(nil)).Elem()] = []string{"SystemInfo", "CpuInfo", "CpuPkg", "MemorySize"}
+	requiredFields[reflect.TypeOf((*HostHardwareSummary)(nil)).Elem()] = []string{"Vendor", "Model", "Uuid", "MemorySize", "CpuModel", "CpuMhz", "NumCpuPkgs", "NumCpuCores", "NumCpuThreads", "NumNics", "NumHBAs"}
+	requiredFields[reflect.TypeOf((*HostHasComponentFailure)(nil)).Elem()] = []string{"HostName", "ComponentType", "ComponentName"}
+	requiredFields[reflect.TypeOf((*HostHasComponentFailureFault)(nil)).Elem()] = []string{"HostName", "ComponentType", "ComponentName"}
+	requiredFields[reflect.TypeOf((*HostHostBusAdapter)(nil)).Elem()] = []string{"Device", "Bus", "Status", "Model"}
+	requiredFields[reflect.TypeOf((*HostHyperThreadScheduleInfo)(nil)).Elem()] = []string{"Available", "Active", "Config"}
+	requiredFields[reflect.TypeOf((*HostImageConfigGetAcceptance)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostImageConfigGetAcceptanceRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostImageConfigGetAcceptanceResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostImageConfigGetProfile)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostImageConfigGetProfileRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostImageConfigGetProfileResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostImageProfileSummary)(nil)).Elem()] = []string{"Name", "Vendor"}
+	requiredFields[reflect.TypeOf((*HostInflateDiskRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostInflateDisk_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostInflateDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHba)(nil)).Elem()] = []string{"IsSoftwareBased", "DiscoveryCapabilities", "DiscoveryProperties", "AuthenticationCapabilities", "AuthenticationProperties", "IpCapabilities", "IpProperties", "IScsiName"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHbaAuthenticationCapabilities)(nil)).Elem()] = []string{"ChapAuthSettable", "Krb5AuthSettable", "SrpAuthSettable", "SpkmAuthSettable"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHbaAuthenticationProperties)(nil)).Elem()] = []string{"ChapAuthEnabled"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHbaDiscoveryCapabilities)(nil)).Elem()] = []string{"ISnsDiscoverySettable", "SlpDiscoverySettable", "StaticTargetDiscoverySettable", "SendTargetsDiscoverySettable"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHbaDiscoveryProperties)(nil)).Elem()] = []string{"ISnsDiscoveryEnabled", "SlpDiscoveryEnabled", "StaticTargetDiscoveryEnabled", "SendTargetsDiscoveryEnabled"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHbaIPCapabilities)(nil)).Elem()] = []string{"AddressSettable", "IpConfigurationMethodSettable", "SubnetMaskSettable", "DefaultGatewaySettable", "PrimaryDnsServerAddressSettable", "AlternateDnsServerAddressSettable"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHbaIPProperties)(nil)).Elem()] = []string{"DhcpConfigurationEnabled"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHbaIscsiIpv6Address)(nil)).Elem()] = []string{"Address", "PrefixLength", "Origin"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHbaSendTarget)(nil)).Elem()] = []string{"Address"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiHbaStaticTarget)(nil)).Elem()] = []string{"Address", "IScsiName"}
+	requiredFields[reflect.TypeOf((*HostInternetScsiTargetTransport)(nil)).Elem()] = []string{"IScsiName", "IScsiAlias"}
+	requiredFields[reflect.TypeOf((*HostInventoryFull)(nil)).Elem()] = []string{"Capacity"}
+	requiredFields[reflect.TypeOf((*HostInventoryFullEvent)(nil)).Elem()] = []string{"Capacity"}
+	requiredFields[reflect.TypeOf((*HostInventoryFullFault)(nil)).Elem()] = []string{"Capacity"}
+	requiredFields[reflect.TypeOf((*HostIoFilterInfo)(nil)).Elem()] = []string{"Available"}
+	requiredFields[reflect.TypeOf((*HostIpChangedEvent)(nil)).Elem()] = []string{"OldIP", "NewIP"}
+	requiredFields[reflect.TypeOf((*HostIpConfig)(nil)).Elem()] = []string{"Dhcp"}
+	requiredFields[reflect.TypeOf((*HostIpConfigIpV6Address)(nil)).Elem()] = []string{"IpAddress", "PrefixLength"}
+	requiredFields[reflect.TypeOf((*HostIpInconsistentEvent)(nil)).Elem()] = []string{"IpAddress", "IpAddress2"}
+	requiredFields[reflect.TypeOf((*HostIpRouteEntry)(nil)).Elem()] = []string{"Network", "PrefixLength", "Gateway"}
+	requiredFields[reflect.TypeOf((*HostIpRouteOp)(nil)).Elem()] = []string{"ChangeOperation", "Route"}
+	requiredFields[reflect.TypeOf((*HostIsolationIpPingFailedEvent)(nil)).Elem()] = []string{"IsolationIp"}
+	requiredFields[reflect.TypeOf((*HostLicensableResourceInfo)(nil)).Elem()] = []string{"Resource"}
+	requiredFields[reflect.TypeOf((*HostLicenseConnectInfo)(nil)).Elem()] = []string{"License", "Evaluation"}
+	requiredFields[reflect.TypeOf((*HostListSummary)(nil)).Elem()] = []string{"Config", "QuickStats", "OverallStatus", "RebootRequired"}
+	requiredFields[reflect.TypeOf((*HostListSummaryGatewaySummary)(nil)).Elem()] = []string{"GatewayType", "GatewayId"}
+	requiredFields[reflect.TypeOf((*HostListVStorageObject)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostListVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostLocalFileSystemVolume)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*HostLocalFileSystemVolumeSpec)(nil)).Elem()] = []string{"Device", "LocalPath"}
+	requiredFields[reflect.TypeOf((*HostLocalPortCreatedEvent)(nil)).Elem()] = []string{"HostLocalPort"}
+	requiredFields[reflect.TypeOf((*HostLowLevelProvisioningManagerDiskLayoutSpec)(nil)).Elem()] = []string{"ControllerType", "BusNumber", "SrcFilename", "DstFilename"}
+	requiredFields[reflect.TypeOf((*HostLowLevelProvisioningManagerFileDeleteResult)(nil)).Elem()] = []string{"FileName", "Fault"}
+	requiredFields[reflect.TypeOf((*HostLowLevelProvisioningManagerFileDeleteSpec)(nil)).Elem()] = []string{"FileName", "FileType"}
+	requiredFields[reflect.TypeOf((*HostLowLevelProvisioningManagerFileReserveResult)(nil)).Elem()] = []string{"BaseName", "ParentDir", "ReservedName"}
+	requiredFields[reflect.TypeOf((*HostLowLevelProvisioningManagerFileReserveSpec)(nil)).Elem()] = []string{"BaseName", "ParentDir", "FileType", "StorageProfile"}
+	requiredFields[reflect.TypeOf((*HostLowLevelProvisioningManagerSnapshotLayoutSpec)(nil)).Elem()] = []string{"Id", "SrcFilename", "DstFilename"}
+	requiredFields[reflect.TypeOf((*HostLowLevelProvisioningManagerVmMigrationStatus)(nil)).Elem()] = []string{"MigrationId", "Type", "Source", "ConsideredSuccessful"}
+	requiredFields[reflect.TypeOf((*HostLowLevelProvisioningManagerVmRecoveryInfo)(nil)).Elem()] = []string{"Version", "BiosUUID", "InstanceUUID"}
+	requiredFields[reflect.TypeOf((*HostMemberRuntimeInfo)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*HostMemberUplinkHealthCheckResult)(nil)).Elem()] = []string{"UplinkPortKey"}
+	requiredFields[reflect.TypeOf((*HostMemoryTierInfo)(nil)).Elem()] = []string{"Name", "Type", "Size"}
+	requiredFields[reflect.TypeOf((*HostMonitoringStateChangedEvent)(nil)).Elem()] = []string{"State"}
+	requiredFields[reflect.TypeOf((*HostMountInfo)(nil)).Elem()] = []string{"AccessMode"}
+	requiredFields[reflect.TypeOf((*HostMultipathInfoFixedLogicalUnitPolicy)(nil)).Elem()] = []string{"Prefer"}
+	requiredFields[reflect.TypeOf((*HostMultipathInfoLogicalUnit)(nil)).Elem()] = []string{"Key", "Id", "Lun", "Path", "Policy"}
+	requiredFields[reflect.TypeOf((*HostMultipathInfoLogicalUnitPolicy)(nil)).Elem()] = []string{"Policy"}
+	requiredFields[reflect.TypeOf((*HostMultipathInfoLogicalUnitStorageArrayTypePolicy)(nil)).Elem()] = []string{"Policy"}
+	requiredFields[reflect.TypeOf((*HostMultipathInfoPath)(nil)).Elem()] = []string{"Key", "Name", "PathState", "Adapter", "Lun"}
+	requiredFields[reflect.TypeOf((*HostMultipathStateInfoPath)(nil)).Elem()] = []string{"Name", "PathState"}
+	requiredFields[reflect.TypeOf((*HostNasVolume)(nil)).Elem()] = []string{"RemoteHost", "RemotePath"}
+	requiredFields[reflect.TypeOf((*HostNasVolumeSpec)(nil)).Elem()] = []string{"RemoteHost", "RemotePath", "LocalPath", "AccessMode"}
+	requiredFields[reflect.TypeOf((*HostNasVolumeUserInfo)(nil)).Elem()] = []string{"User"}
+	requiredFields[reflect.TypeOf((*HostNatService)(nil)).Elem()] = []string{"Key", "Spec"}
+	requiredFields[reflect.TypeOf((*HostNatServiceConfig)(nil)).Elem()] = []string{"Key", "Spec"}
+	requiredFields[reflect.TypeOf((*HostNatServiceNameServiceSpec)(nil)).Elem()] = []string{"DnsAutoDetect", "DnsPolicy", "DnsRetries", "DnsTimeout", "NbdsTimeout", "NbnsRetries", "NbnsTimeout"}
+	requiredFields[reflect.TypeOf((*HostNatServicePortForwardSpec)(nil)).Elem()] = []string{"Type", "Name", "HostPort", "GuestPort", "GuestIpAddress"}
+	requiredFields[reflect.TypeOf((*HostNatServiceSpec)(nil)).Elem()] = []string{"VirtualSwitch", "ActiveFtp", "AllowAnyOui", "ConfigPort", "IpGatewayAddress", "UdpTimeout"}
+	requiredFields[reflect.TypeOf((*HostNetCapabilities)(nil)).Elem()] = []string{"CanSetPhysicalNicLinkSpeed", "SupportsNicTeaming", "SupportsVlan", "UsesServiceConsoleNic", "SupportsNetworkHints", "VswitchConfigSupported", "VnicConfigSupported", "IpRouteConfigSupported", "DnsConfigSupported", "DhcpOnVnicSupported"}
+	requiredFields[reflect.TypeOf((*HostNetworkConfigNetStackSpec)(nil)).Elem()] = []string{"NetStackInstance"}
+	requiredFields[reflect.TypeOf((*HostNetworkResourceRuntime)(nil)).Elem()] = []string{"PnicResourceInfo"}
+	requiredFields[reflect.TypeOf((*HostNumaInfo)(nil)).Elem()] = []string{"Type", "NumNodes"}
+	requiredFields[reflect.TypeOf((*HostNumaNode)(nil)).Elem()] = []string{"TypeId", "CpuID", "MemoryRangeBegin", "MemoryRangeLength"}
+	requiredFields[reflect.TypeOf((*HostNumericSensorInfo)(nil)).Elem()] = []string{"Name", "CurrentReading", "UnitModifier", "BaseUnits", "SensorType"}
+	requiredFields[reflect.TypeOf((*HostNvmeConnectSpec)(nil)).Elem()] = []string{"Subnqn"}
+	requiredFields[reflect.TypeOf((*HostNvmeController)(nil)).Elem()] = []string{"Key", "ControllerNumber", "Subnqn", "Name", "AssociatedAdapter", "TransportType", "FusedOperationSupported", "NumberOfQueues", "QueueSize"}
+	requiredFields[reflect.TypeOf((*HostNvmeDisconnectSpec)(nil)).Elem()] = []string{"HbaName"}
+	requiredFields[reflect.TypeOf((*HostNvmeDiscoveryLog)(nil)).Elem()] = []string{"Complete"}
+	requiredFields[reflect.TypeOf((*HostNvmeDiscoveryLogEntry)(nil)).Elem()] = []string{"Subnqn", "SubsystemType", "SubsystemPortId", "ControllerId", "AdminQueueMaxSize", "TransportParameters", "TransportRequirements", "Connected"}
+	requiredFields[reflect.TypeOf((*HostNvmeNamespace)(nil)).Elem()] = []string{"Key", "Name", "Id", "BlockSize", "CapacityInBlocks"}
+	requiredFields[reflect.TypeOf((*HostNvmeOpaqueTransportParameters)(nil)).Elem()] = []string{"Trtype", "Traddr", "Adrfam", "Trsvcid", "Tsas"}
+	requiredFields[reflect.TypeOf((*HostNvmeOverFibreChannelParameters)(nil)).Elem()] = []string{"NodeWorldWideName", "PortWorldWideName"}
+	requiredFields[reflect.TypeOf((*HostNvmeOverRdmaParameters)(nil)).Elem()] = []string{"Address"}
+	requiredFields[reflect.TypeOf((*HostNvmeOverTcpParameters)(nil)).Elem()] = []string{"Address"}
+	requiredFields[reflect.TypeOf((*HostNvmeSpec)(nil)).Elem()] = []string{"HbaName", "TransportParameters"}
+	requiredFields[reflect.TypeOf((*HostNvmeTopologyInterface)(nil)).Elem()] = []string{"Key", "Adapter"}
+	requiredFields[reflect.TypeOf((*HostOpaqueNetworkInfo)(nil)).Elem()] = []string{"OpaqueNetworkId", "OpaqueNetworkName", "OpaqueNetworkType"}
+	requiredFields[reflect.TypeOf((*HostOpaqueSwitch)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*HostOpaqueSwitchPhysicalNicZone)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*HostPMemVolume)(nil)).Elem()] = []string{"Uuid", "Version"}
+	requiredFields[reflect.TypeOf((*HostPartialMaintenanceModeRuntimeInfo)(nil)).Elem()] = []string{"Key", "HostStatus"}
+	requiredFields[reflect.TypeOf((*HostPatchManagerLocator)(nil)).Elem()] = []string{"Url"}
+	requiredFields[reflect.TypeOf((*HostPatchManagerResult)(nil)).Elem()] = []string{"Version"}
+	requiredFields[reflect.TypeOf((*HostPatchManagerStatus)(nil)).Elem()] = []string{"Id", "Applicable", "Installed", "RestartRequired", "ReconnectRequired", "VmOffRequired"}
+	requiredFields[reflect.TypeOf((*HostPatchManagerStatusPrerequisitePatch)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*HostPathSelectionPolicyOption)(nil)).Elem()] = []string{"Policy"}
+	requiredFields[reflect.TypeOf((*HostPciDevice)(nil)).Elem()] = []string{"Id", "ClassId", "Bus", "Slot", "Function", "VendorId", "SubVendorId", "VendorName", "DeviceId", "SubDeviceId", "DeviceName"}
+	requiredFields[reflect.TypeOf((*HostPciPassthruConfig)(nil)).Elem()] = []string{"Id", "PassthruEnabled"}
+	requiredFields[reflect.TypeOf((*HostPciPassthruInfo)(nil)).Elem()] = []string{"Id", "DependentDevice", "PassthruEnabled", "PassthruCapable", "PassthruActive"}
+	requiredFields[reflect.TypeOf((*HostPlacedVirtualNicIdentifier)(nil)).Elem()] = []string{"Vm", "VnicKey"}
+	requiredFields[reflect.TypeOf((*HostPlugStoreTopologyAdapter)(nil)).Elem()] = []string{"Key", "Adapter"}
+	requiredFields[reflect.TypeOf((*HostPlugStoreTopologyDevice)(nil)).Elem()] = []string{"Key", "Lun"}
+	requiredFields[reflect.TypeOf((*HostPlugStoreTopologyPath)(nil)).Elem()] = []string{"Key", "Name"}
+	requiredFields[reflect.TypeOf((*HostPlugStoreTopologyPlugin)(nil)).Elem()] = []string{"Key", "Name"}
+	requiredFields[reflect.TypeOf((*HostPlugStoreTopologyTarget)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*HostPnicNetworkResourceInfo)(nil)).Elem()] = []string{"PnicDevice"}
+	requiredFields[reflect.TypeOf((*HostPortGroup)(nil)).Elem()] = []string{"ComputedPolicy", "Spec"}
+	requiredFields[reflect.TypeOf((*HostPortGroupPort)(nil)).Elem()] = []string{"Type"}
+	requiredFields[reflect.TypeOf((*HostPortGroupProfile)(nil)).Elem()] = []string{"IpConfig"}
+	requiredFields[reflect.TypeOf((*HostPortGroupSpec)(nil)).Elem()] = []string{"Name", "VlanId", "VswitchName", "Policy"}
+	requiredFields[reflect.TypeOf((*HostPowerPolicy)(nil)).Elem()] = []string{"Key", "Name", "ShortName", "Description"}
+	requiredFields[reflect.TypeOf((*HostPrimaryAgentNotShortNameEvent)(nil)).Elem()] = []string{"PrimaryAgent"}
+	requiredFields[reflect.TypeOf((*HostProfileAppliedEvent)(nil)).Elem()] = []string{"Profile"}
+	requiredFields[reflect.TypeOf((*HostProfileCompleteConfigSpec)(nil)).Elem()] = []string{"DisabledExpressionListChanged"}
+	requiredFields[reflect.TypeOf((*HostProfileHostBasedConfigSpec)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*HostProfileManagerCompositionResultResultElement)(nil)).Elem()] = []string{"Target", "Status"}
+	requiredFields[reflect.TypeOf((*HostProfileManagerCompositionValidationResultResultElement)(nil)).Elem()] = []string{"Target", "Status"}
+	requiredFields[reflect.TypeOf((*HostProfileManagerHostToConfigSpecMap)(nil)).Elem()] = []string{"Host", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*HostProfileResetValidationState)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostProfileResetValidationStateRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostProfileValidationFailureInfo)(nil)).Elem()] = []string{"Name", "Annotation", "UpdateType"}
+	requiredFields[reflect.TypeOf((*HostProtocolEndpoint)(nil)).Elem()] = []string{"PeType", "Uuid"}
+	requiredFields[reflect.TypeOf((*HostProxySwitch)(nil)).Elem()] = []string{"DvsUuid", "DvsName", "Key", "NumPorts", "NumPortsAvailable", "Spec"}
+	requiredFields[reflect.TypeOf((*HostProxySwitchConfig)(nil)).Elem()] = []string{"Uuid"}
+	requiredFields[reflect.TypeOf((*HostProxySwitchEnsInfo)(nil)).Elem()] = []string{"OpsVersion", "NumPSOps", "NumLcoreOps", "ErrorStatus", "LcoreStatus"}
+	requiredFields[reflect.TypeOf((*HostProxySwitchHostLagConfig)(nil)).Elem()] = []string{"LagKey"}
+	requiredFields[reflect.TypeOf((*HostPtpConfigPtpPort)(nil)).Elem()] = []string{"Index"}
+	requiredFields[reflect.TypeOf((*HostQualifiedName)(nil)).Elem()] = []string{"Value", "Type"}
+	requiredFields[reflect.TypeOf((*HostQueryVirtualDiskUuid)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*HostQueryVirtualDiskUuidRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*HostQueryVirtualDiskUuidResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostRdmaDevice)(nil)).Elem()] = []string{"Key", "Device", "ConnectionInfo", "Capability"}
+	requiredFields[reflect.TypeOf((*HostRdmaDeviceCapability)(nil)).Elem()] = []string{"RoceV1Capable", "RoceV2Capable", "IWarpCapable"}
+	requiredFields[reflect.TypeOf((*HostRdmaDeviceConnectionInfo)(nil)).Elem()] = []string{"State", "Mtu", "SpeedInMbps"}
+	requiredFields[reflect.TypeOf((*HostRdmaDevicePnicBacking)(nil)).Elem()] = []string{"PairedUplink"}
+	requiredFields[reflect.TypeOf((*HostReconcileDatastoreInventoryRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostReconcileDatastoreInventory_Task)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostReconcileDatastoreInventory_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostRegisterDisk)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*HostRegisterDiskRequestType)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*HostRegisterDiskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostReliableMemoryInfo)(nil)).Elem()] = []string{"MemorySize"}
+	requiredFields[reflect.TypeOf((*HostRelocateVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*HostRelocateVStorageObject_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*HostRelocateVStorageObject_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostRemoveVFlashResource)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostRemoveVFlashResourceRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostRenameVStorageObject)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Name"}
+	requiredFields[reflect.TypeOf((*HostRenameVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Name"}
+	requiredFields[reflect.TypeOf((*HostResignatureRescanResult)(nil)).Elem()] = []string{"Result"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageInfrastructureObjectPolicy)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageInfrastructureObjectPolicyRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObject)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectMetadata)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectMetadataRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectMetadataValue)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Key"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectMetadataValueRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Key"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectMetadataValueResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectState)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectStateRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostRetrieveVStorageObjectStateResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostRuntimeInfo)(nil)).Elem()] = []string{"ConnectionState", "PowerState", "InMaintenanceMode"}
+	requiredFields[reflect.TypeOf((*HostRuntimeInfoNetStackInstanceRuntimeInfo)(nil)).Elem()] = []string{"NetStackInstanceKey"}
+	requiredFields[reflect.TypeOf((*HostRuntimeInfoStateEncryptionInfo)(nil)).Elem()] = []string{"ProtectionMode"}
+	requiredFields[reflect.TypeOf((*HostScheduleReconcileDatastoreInventory)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostScheduleReconcileDatastoreInventoryRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostScsiDisk)(nil)).Elem()] = []string{"Capacity", "DevicePath"}
+	requiredFields[reflect.TypeOf((*HostScsiDiskPartition)(nil)).Elem()] = []string{"DiskName", "Partition"}
+	requiredFields[reflect.TypeOf((*HostScsiTopologyInterface)(nil)).Elem()] = []string{"Key", "Adapter"}
+	requiredFields[reflect.TypeOf((*HostScsiTopologyLun)(nil)).Elem()] = []string{"Key", "Lun", "ScsiLun"}
+	requiredFields[reflect.TypeOf((*HostScsiTopologyTarget)(nil)).Elem()] = []string{"Key", "Target"}
+	requiredFields[reflect.TypeOf((*HostSerialAttachedHba)(nil)).Elem()] = []string{"NodeWorldWideName"}
+	requiredFields[reflect.TypeOf((*HostService)(nil)).Elem()] = []string{"Key", "Label", "Required", "Uninstallable", "Running", "Policy"}
+	requiredFields[reflect.TypeOf((*HostServiceConfig)(nil)).Elem()] = []string{"ServiceId", "StartupPolicy"}
+	requiredFields[reflect.TypeOf((*HostServiceSourcePackage)(nil)).Elem()] = []string{"SourcePackageName", "Description"}
+	requiredFields[reflect.TypeOf((*HostServiceTicket)(nil)).Elem()] = []string{"Service", "ServiceVersion", "SessionId"}
+	requiredFields[reflect.TypeOf((*HostSetVStorageObjectControlFlags)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostSetVStorageObjectControlFlagsRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostSetVirtualDiskUuidRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*HostSetVirtualDiskUuid_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*HostSetVirtualDiskUuid_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostSevInfo)(nil)).Elem()] = []string{"SevState", "MaxSevEsGuests"}
+	requiredFields[reflect.TypeOf((*HostSgxInfo)(nil)).Elem()] = []string{"SgxState", "TotalEpcMemory", "FlcMode"}
+	requiredFields[reflect.TypeOf((*HostSharedGpuCapabilities)(nil)).Elem()] = []string{"Vgpu", "DiskSnapshotSupported", "MemorySnapshotSupported", "SuspendSupported", "MigrateSupported"}
+	requiredFields[reflect.TypeOf((*HostShortNameInconsistentEvent)(nil)).Elem()] = []string{"ShortName", "ShortName2"}
+	requiredFields[reflect.TypeOf((*HostShortNameToIpFailedEvent)(nil)).Elem()] = []string{"ShortName"}
+	requiredFields[reflect.TypeOf((*HostShutdownEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*HostSnmpDestination)(nil)).Elem()] = []string{"HostName", "Port", "Community"}
+	requiredFields[reflect.TypeOf((*HostSnmpSystemAgentLimits)(nil)).Elem()] = []string{"MaxReadOnlyCommunities", "MaxTrapDestinations", "MaxCommunityLength", "MaxBufferSize"}
+	requiredFields[reflect.TypeOf((*HostSpecGetUpdatedHosts)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostSpecGetUpdatedHostsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HostSpecification)(nil)).Elem()] = []string{"CreatedTime", "Host"}
+	requiredFields[reflect.TypeOf((*HostSpecificationOperationFailed)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*HostSpecificationOperationFailedFault)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*HostSpecificationUpdateEvent)(nil)).Elem()] = []string{"HostSpec"}
+	requiredFields[reflect.TypeOf((*HostSriovConfig)(nil)).Elem()] = []string{"SriovEnabled", "NumVirtualFunction"}
+	requiredFields[reflect.TypeOf((*HostSriovDevicePoolInfo)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*HostSriovInfo)(nil)).Elem()] = []string{"SriovEnabled", "SriovCapable", "SriovActive", "NumVirtualFunctionRequested", "NumVirtualFunction", "MaxVirtualFunctionSupported"}
+	requiredFields[reflect.TypeOf((*HostSslThumbprintInfo)(nil)).Elem()] = []string{"Principal"}
+	requiredFields[reflect.TypeOf((*HostStorageArrayTypePolicyOption)(nil)).Elem()] = []string{"Policy"}
+	requiredFields[reflect.TypeOf((*HostStorageDeviceInfo)(nil)).Elem()] = []string{"SoftwareInternetScsiEnabled"}
+	requiredFields[reflect.TypeOf((*HostStorageOperationalInfo)(nil)).Elem()] = []string{"Property", "Value"}
+	requiredFields[reflect.TypeOf((*HostStorageSystemDiskLocatorLedResult)(nil)).Elem()] = []string{"Key", "Fault"}
+	requiredFields[reflect.TypeOf((*HostStorageSystemScsiLunResult)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*HostStorageSystemVmfsVolumeResult)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*HostSubSpecification)(nil)).Elem()] = []string{"Name", "CreatedTime"}
+	requiredFields[reflect.TypeOf((*HostSubSpecificationDeleteEvent)(nil)).Elem()] = []string{"SubSpecName"}
+	requiredFields[reflect.TypeOf((*HostSubSpecificationUpdateEvent)(nil)).Elem()] = []string{"HostSubSpec"}
+	requiredFields[reflect.TypeOf((*HostSyncFailedEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*HostSystemComplianceCheckState)(nil)).Elem()] = []string{"State", "CheckTime"}
+	requiredFields[reflect.TypeOf((*HostSystemIdentificationInfo)(nil)).Elem()] = []string{"IdentifierValue", "IdentifierType"}
+	requiredFields[reflect.TypeOf((*HostSystemInfo)(nil)).Elem()] = []string{"Vendor", "Model", "Uuid"}
+	requiredFields[reflect.TypeOf((*HostSystemRemediationState)(nil)).Elem()] = []string{"State", "OperationTime"}
+	requiredFields[reflect.TypeOf((*HostSystemResourceInfo)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*HostSystemSwapConfigurationDatastoreOption)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*HostSystemSwapConfigurationSystemSwapOption)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*HostTcpHbaCreateSpec)(nil)).Elem()] = []string{"Pnic"}
+	requiredFields[reflect.TypeOf((*HostTpmAttestationInfo)(nil)).Elem()] = []string{"Time", "Status"}
+	requiredFields[reflect.TypeOf((*HostTpmAttestationReport)(nil)).Elem()] = []string{"TpmPcrValues", "TpmEvents", "TpmLogReliable"}
+	requiredFields[reflect.TypeOf((*HostTpmBootSecurityOptionEventDetails)(nil)).Elem()] = []string{"BootSecurityOption"}
+	requiredFields[reflect.TypeOf((*HostTpmCommandEventDetails)(nil)).Elem()] = []string{"CommandLine"}
+	requiredFields[reflect.TypeOf((*HostTpmDigestInfo)(nil)).Elem()] = []string{"PcrNumber"}
+	requiredFields[reflect.TypeOf((*HostTpmEventDetails)(nil)).Elem()] = []string{"DataHash"}
+	requiredFields[reflect.TypeOf((*HostTpmEventLogEntry)(nil)).Elem()] = []string{"PcrIndex", "EventDetails"}
+	requiredFields[reflect.TypeOf((*HostTpmOptionEventDetails)(nil)).Elem()] = []string{"OptionsFileName"}
+	requiredFields[reflect.TypeOf((*HostTpmSoftwareComponentEventDetails)(nil)).Elem()] = []string{"ComponentName", "VibName", "VibVersion", "VibVendor"}
+	requiredFields[reflect.TypeOf((*HostTpmVersionEventDetails)(nil)).Elem()] = []string{"Version"}
+	requiredFields[reflect.TypeOf((*HostTrustAuthorityAttestationInfo)(nil)).Elem()] = []string{"AttestationStatus"}
+	requiredFields[reflect.TypeOf((*HostUnresolvedVmfsExtent)(nil)).Elem()] = []string{"Device", "DevicePath", "VmfsUuid", "IsHeadExtent", "Ordinal", "StartBlock", "EndBlock", "Reason"}
+	requiredFields[reflect.TypeOf((*HostUnresolvedVmfsResignatureSpec)(nil)).Elem()] = []string{"ExtentDevicePath"}
+	requiredFields[reflect.TypeOf((*HostUnresolvedVmfsResolutionResult)(nil)).Elem()] = []string{"Spec"}
+	requiredFields[reflect.TypeOf((*HostUnresolvedVmfsResolutionSpec)(nil)).Elem()] = []string{"ExtentDevicePath", "UuidResolution"}
+	requiredFields[reflect.TypeOf((*HostUnresolvedVmfsVolume)(nil)).Elem()] = []string{"Extent", "VmfsLabel", "VmfsUuid", "TotalBlocks", "ResolveStatus"}
+	requiredFields[reflect.TypeOf((*HostUnresolvedVmfsVolumeResolveStatus)(nil)).Elem()] = []string{"Resolvable"}
+	requiredFields[reflect.TypeOf((*HostUpdateVStorageObjectMetadataExRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostUpdateVStorageObjectMetadataEx_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostUpdateVStorageObjectMetadataEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostUpdateVStorageObjectMetadataRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostUpdateVStorageObjectMetadata_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostUpdateVStorageObjectMetadata_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostVFlashManagerVFlashCacheConfigInfoVFlashModuleConfigOption)(nil)).Elem()] = []string{"VFlashModule", "VFlashModuleVersion", "MinSupportedModuleVersion", "CacheConsistencyType", "CacheMode", "BlockSizeInKBOption", "ReservationInMBOption", "MaxDiskSizeInKB"}
+	requiredFields[reflect.TypeOf((*HostVFlashManagerVFlashCacheConfigSpec)(nil)).Elem()] = []string{"DefaultVFlashModule", "SwapCacheReservationInGB"}
+	requiredFields[reflect.TypeOf((*HostVFlashManagerVFlashResourceConfigInfo)(nil)).Elem()] = []string{"Capacity"}
+	requiredFields[reflect.TypeOf((*HostVFlashManagerVFlashResourceConfigSpec)(nil)).Elem()] = []string{"VffsUuid"}
+	requiredFields[reflect.TypeOf((*HostVFlashManagerVFlashResourceRunTimeInfo)(nil)).Elem()] = []string{"Usage", "Capacity", "Accessible", "CapacityForVmCache", "FreeForVmCache"}
+	requiredFields[reflect.TypeOf((*HostVMotionCompatibility)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*HostVMotionConfig)(nil)).Elem()] = []string{"Enabled"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectCreateDiskFromSnapshotRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId", "Name"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectCreateDiskFromSnapshot_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId", "Name"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectCreateDiskFromSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectCreateSnapshotRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Description"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectCreateSnapshot_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Description"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectCreateSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectDeleteSnapshotRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectDeleteSnapshot_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectDeleteSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectRetrieveSnapshotInfo)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectRetrieveSnapshotInfoRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectRetrieveSnapshotInfoResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectRevertRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectRevert_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*HostVStorageObjectRevert_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HostVffsSpec)(nil)).Elem()] = []string{"DevicePath", "MajorVersion", "VolumeName"}
+	requiredFields[reflect.TypeOf((*HostVffsVolume)(nil)).Elem()] = []string{"MajorVersion", "Version", "Uuid", "Extent"}
+	requiredFields[reflect.TypeOf((*HostVirtualNic)(nil)).Elem()] = []string{"Device", "Key", "Portgroup", "Spec"}
+	requiredFields[reflect.TypeOf((*HostVirtualNicConfig)(nil)).Elem()] = []string{"Portgroup"}
+	requiredFields[reflect.TypeOf((*HostVirtualNicManagerNicTypeSelection)(nil)).Elem()] = []string{"Vnic"}
+	requiredFields[reflect.TypeOf((*HostVirtualNicOpaqueNetworkSpec)(nil)).Elem()] = []string{"OpaqueNetworkId", "OpaqueNetworkType"}
+	requiredFields[reflect.TypeOf((*HostVirtualSwitch)(nil)).Elem()] = []string{"Name", "Key", "NumPorts", "NumPortsAvailable", "Spec"}
+	requiredFields[reflect.TypeOf((*HostVirtualSwitchBeaconConfig)(nil)).Elem()] = []string{"Interval"}
+	requiredFields[reflect.TypeOf((*HostVirtualSwitchBondBridge)(nil)).Elem()] = []string{"NicDevice"}
+	requiredFields[reflect.TypeOf((*HostVirtualSwitchConfig)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*HostVirtualSwitchSimpleBridge)(nil)).Elem()] = []string{"NicDevice"}
+	requiredFields[reflect.TypeOf((*HostVirtualSwitchSpec)(nil)).Elem()] = []string{"NumPorts"}
+	requiredFields[reflect.TypeOf((*HostVmciAccessManagerAccessSpec)(nil)).Elem()] = []string{"Vm", "Mode"}
+	requiredFields[reflect.TypeOf((*HostVmfsRescanResult)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*HostVmfsSpec)(nil)).Elem()] = []string{"Extent", "MajorVersion", "VolumeName"}
+	requiredFields[reflect.TypeOf((*HostVmfsVolume)(nil)).Elem()] = []string{"BlockSizeMb", "MaxBlocks", "MajorVersion", "Version", "Uuid", "Extent", "VmfsUpgradable"}
+	requiredFields[reflect.TypeOf((*HostVnicConnectedToCustomizedDVPortEvent)(nil)).Elem()] = []string{"Vnic"}
+	requiredFields[reflect.TypeOf((*HostVsanInternalSystemDeleteVsanObjectsResult)(nil)).Elem()] = []string{"Uuid", "Success"}
+	requiredFields[reflect.TypeOf((*HostVsanInternalSystemVsanObjectOperationResult)(nil)).Elem()] = []string{"Uuid"}
+	requiredFields[reflect.TypeOf((*HostVsanInternalSystemVsanPhysicalDiskDiagnosticsResult)(nil)).Elem()] = []string{"DiskUuid", "Success"}
+	requiredFields[reflect.TypeOf((*HostVvolNQN)(nil)).Elem()] = []string{"TargetNQN", "StorageArray", "Online"}
+	requiredFields[reflect.TypeOf((*HostVvolVolume)(nil)).Elem()] = []string{"ScId"}
+	requiredFields[reflect.TypeOf((*HostVvolVolumeSpecification)(nil)).Elem()] = []string{"MaxSizeInMB", "VolumeName", "Uuid"}
+	requiredFields[reflect.TypeOf((*HostWwnConflictEvent)(nil)).Elem()] = []string{"Wwn"}
+	requiredFields[reflect.TypeOf((*HourlyTaskScheduler)(nil)).Elem()] = []string{"Minute"}
+	requiredFields[reflect.TypeOf((*HttpFault)(nil)).Elem()] = []string{"StatusCode", "StatusMessage"}
+	requiredFields[reflect.TypeOf((*HttpFaultFault)(nil)).Elem()] = []string{"StatusCode", "StatusMessage"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseAbort)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseAbortRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseCapabilities)(nil)).Elem()] = []string{"PullModeSupported", "CorsSupported"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseComplete)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseCompleteRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseDatastoreLeaseInfo)(nil)).Elem()] = []string{"DatastoreKey", "Hosts"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseDeviceUrl)(nil)).Elem()] = []string{"Key", "ImportKey", "Url", "SslThumbprint"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseGetManifest)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseGetManifestRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseHostInfo)(nil)).Elem()] = []string{"Url", "SslThumbprint"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseInfo)(nil)).Elem()] = []string{"Lease", "Entity", "TotalDiskCapacityInKB", "LeaseTimeout"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseManifestEntry)(nil)).Elem()] = []string{"Key", "Sha1", "Size", "Disk"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseProbeResult)(nil)).Elem()] = []string{"ServerAccessible"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseProbeUrls)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseProbeUrlsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseProgress)(nil)).Elem()] = []string{"This", "Percent"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseProgressRequestType)(nil)).Elem()] = []string{"This", "Percent"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeasePullFromUrlsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeasePullFromUrls_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeasePullFromUrls_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseSetManifestChecksumType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseSetManifestChecksumTypeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*HttpNfcLeaseSourceFile)(nil)).Elem()] = []string{"TargetDeviceId", "Url", "Create"}
+	requiredFields[reflect.TypeOf((*ID)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*IORMNotSupportedHostOnDatastore)(nil)).Elem()] = []string{"Datastore", "DatastoreName"}
+	requiredFields[reflect.TypeOf((*IORMNotSupportedHostOnDatastoreFault)(nil)).Elem()] = []string{"Datastore", "DatastoreName"}
+	requiredFields[reflect.TypeOf((*ImpersonateUser)(nil)).Elem()] = []string{"This", "UserName"}
+	requiredFields[reflect.TypeOf((*ImpersonateUserRequestType)(nil)).Elem()] = []string{"This", "UserName"}
+	requiredFields[reflect.TypeOf((*ImpersonateUserResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ImportCertificateForCAMRequestType)(nil)).Elem()] = []string{"This", "CertPath", "CamServer"}
+	requiredFields[reflect.TypeOf((*ImportCertificateForCAM_Task)(nil)).Elem()] = []string{"This", "CertPath", "CamServer"}
+	requiredFields[reflect.TypeOf((*ImportCertificateForCAM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ImportHostAddFailure)(nil)).Elem()] = []string{"HostIp"}
+	requiredFields[reflect.TypeOf((*ImportHostAddFailureFault)(nil)).Elem()] = []string{"HostIp"}
+	requiredFields[reflect.TypeOf((*ImportOperationBulkFault)(nil)).Elem()] = []string{"ImportFaults"}
+	requiredFields[reflect.TypeOf((*ImportOperationBulkFaultFault)(nil)).Elem()] = []string{"ImportFaults"}
+	requiredFields[reflect.TypeOf((*ImportOperationBulkFaultFaultOnImport)(nil)).Elem()] = []string{"Fault"}
+	requiredFields[reflect.TypeOf((*ImportUnmanagedSnapshot)(nil)).Elem()] = []string{"This", "Vdisk", "VvolId"}
+	requiredFields[reflect.TypeOf((*ImportUnmanagedSnapshotRequestType)(nil)).Elem()] = []string{"This", "Vdisk", "VvolId"}
+	requiredFields[reflect.TypeOf((*ImportVApp)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ImportVAppRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ImportVAppResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InaccessibleVFlashSource)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*InaccessibleVFlashSourceFault)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*IncompatibleDefaultDevice)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*IncompatibleDefaultDeviceFault)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*IncompatibleHostForFtSecondary)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*IncompatibleHostForFtSecondaryFault)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*IncompatibleHostForVmReplication)(nil)).Elem()] = []string{"VmName", "HostName", "Reason"}
+	requiredFields[reflect.TypeOf((*IncompatibleHostForVmReplicationFault)(nil)).Elem()] = []string{"VmName", "HostName", "Reason"}
+	requiredFields[reflect.TypeOf((*IncompatibleSetting)(nil)).Elem()] = []string{"ConflictingProperty"}
+	requiredFields[reflect.TypeOf((*IncompatibleSettingFault)(nil)).Elem()] = []string{"ConflictingProperty"}
+	requiredFields[reflect.TypeOf((*IncreaseDirectorySize)(nil)).Elem()] = []string{"This", "StableName", "Size"}
+	requiredFields[reflect.TypeOf((*IncreaseDirectorySizeRequestType)(nil)).Elem()] = []string{"This", "StableName", "Size"}
+	requiredFields[reflect.TypeOf((*InflateDiskRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*InflateDisk_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*InflateDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InflateVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*InflateVirtualDisk_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*InflateVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InheritablePolicy)(nil)).Elem()] = []string{"Inherited"}
+	requiredFields[reflect.TypeOf((*InitializeDisksRequestType)(nil)).Elem()] = []string{"This", "Mapping"}
+	requiredFields[reflect.TypeOf((*InitializeDisks_Task)(nil)).Elem()] = []string{"This", "Mapping"}
+	requiredFields[reflect.TypeOf((*InitializeDisks_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InitiateFailoverRequestType)(nil)).Elem()] = []string{"This", "Planned"}
+	requiredFields[reflect.TypeOf((*InitiateFailover_Task)(nil)).Elem()] = []string{"This", "Planned"}
+	requiredFields[reflect.TypeOf((*InitiateFailover_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InitiateFileTransferFromGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "GuestFilePath"}
+	requiredFields[reflect.TypeOf((*InitiateFileTransferFromGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "GuestFilePath"}
+	requiredFields[reflect.TypeOf((*InitiateFileTransferFromGuestResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InitiateFileTransferToGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "GuestFilePath", "FileAttributes", "FileSize", "Overwrite"}
+	requiredFields[reflect.TypeOf((*InitiateFileTransferToGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "GuestFilePath", "FileAttributes", "FileSize", "Overwrite"}
+	requiredFields[reflect.TypeOf((*InitiateFileTransferToGuestResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InstallDate)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*InstallDateRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*InstallDateResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InstallHostPatchRequestType)(nil)).Elem()] = []string{"This", "Repository", "UpdateID"}
+	requiredFields[reflect.TypeOf((*InstallHostPatchV2RequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*InstallHostPatchV2_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*InstallHostPatchV2_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InstallHostPatch_Task)(nil)).Elem()] = []string{"This", "Repository", "UpdateID"}
+	requiredFields[reflect.TypeOf((*InstallHostPatch_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InstallIoFilterRequestType)(nil)).Elem()] = []string{"This", "VibUrl", "CompRes"}
+	requiredFields[reflect.TypeOf((*InstallIoFilter_Task)(nil)).Elem()] = []string{"This", "VibUrl", "CompRes"}
+	requiredFields[reflect.TypeOf((*InstallIoFilter_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InstallServerCertificate)(nil)).Elem()] = []string{"This", "Cert"}
+	requiredFields[reflect.TypeOf((*InstallServerCertificateRequestType)(nil)).Elem()] = []string{"This", "Cert"}
+	requiredFields[reflect.TypeOf((*InstallSmartCardTrustAnchor)(nil)).Elem()] = []string{"This", "Cert"}
+	requiredFields[reflect.TypeOf((*InstallSmartCardTrustAnchorRequestType)(nil)).Elem()] = []string{"This", "Cert"}
+	requiredFields[reflect.TypeOf((*InstantCloneRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*InstantClone_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*InstantClone_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*InsufficientAgentVmsDeployed)(nil)).Elem()] = []string{"HostName", "RequiredNumAgentVms", "CurrentNumAgentVms"}
+	requiredFields[reflect.TypeOf((*InsufficientAgentVmsDeployedFault)(nil)).Elem()] = []string{"HostName", "RequiredNumAgentVms", "CurrentNumAgentVms"}
+	requiredFields[reflect.TypeOf((*InsufficientCpuResourcesFault)(nil)).Elem()] = []string{"Unreserved", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientCpuResourcesFaultFault)(nil)).Elem()] = []string{"Unreserved", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientHostCpuCapacityFault)(nil)).Elem()] = []string{"Unreserved", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientHostCpuCapacityFaultFault)(nil)).Elem()] = []string{"Unreserved", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientHostMemoryCapacityFault)(nil)).Elem()] = []string{"Unreserved", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientHostMemoryCapacityFaultFault)(nil)).Elem()] = []string{"Unreserved", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientMemoryResourcesFault)(nil)).Elem()] = []string{"Unreserved", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientMemoryResourcesFaultFault)(nil)).Elem()] = []string{"Unreserved", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientNetworkResourcePoolCapacity)(nil)).Elem()] = []string{"DvsName", "DvsUuid", "ResourcePoolKey", "Available", "Requested", "Device"}
+	requiredFields[reflect.TypeOf((*InsufficientNetworkResourcePoolCapacityFault)(nil)).Elem()] = []string{"DvsName", "DvsUuid", "ResourcePoolKey", "Available", "Requested", "Device"}
+	requiredFields[reflect.TypeOf((*InsufficientStandbyCpuResource)(nil)).Elem()] = []string{"Available", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientStandbyCpuResourceFault)(nil)).Elem()] = []string{"Available", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientStandbyMemoryResource)(nil)).Elem()] = []string{"Available", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientStandbyMemoryResourceFault)(nil)).Elem()] = []string{"Available", "Requested"}
+	requiredFields[reflect.TypeOf((*InsufficientStorageIops)(nil)).Elem()] = []string{"UnreservedIops", "RequestedIops", "DatastoreName"}
+	requiredFields[reflect.TypeOf((*InsufficientStorageIopsFault)(nil)).Elem()] = []string{"UnreservedIops", "RequestedIops", "DatastoreName"}
+	requiredFields[reflect.TypeOf((*InsufficientVFlashResourcesFault)(nil)).Elem()] = []string{"FreeSpace", "RequestedSpace"}
+	requiredFields[reflect.TypeOf((*InsufficientVFlashResourcesFaultFault)(nil)).Elem()] = []string{"FreeSpace", "RequestedSpace"}
+	requiredFields[reflect.TypeOf((*IntOption)(nil)).Elem()] = []string{"Min", "Max", "DefaultValue"}
+	requiredFields[reflect.TypeOf((*InvalidCAMServer)(nil)).Elem()] = []string{"CamServer"}
+	requiredFields[reflect.TypeOf((*InvalidController)(nil)).Elem()] = []string{"ControllerKey"}
+	requiredFields[reflect.TypeOf((*InvalidControllerFault)(nil)).Elem()] = []string{"ControllerKey"}
+	requiredFields[reflect.TypeOf((*InvalidDasRestartPriorityForFtVm)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*InvalidDasRestartPriorityForFtVmFault)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*InvalidDatastorePath)(nil)).Elem()] = []string{"DatastorePath"}
+	requiredFields[reflect.TypeOf((*InvalidDatastorePathFault)(nil)).Elem()] = []string{"DatastorePath"}
+	requiredFields[reflect.TypeOf((*InvalidDeviceSpec)(nil)).Elem()] = []string{"DeviceIndex"}
+	requiredFields[reflect.TypeOf((*InvalidDrsBehaviorForFtVm)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*InvalidDrsBehaviorForFtVmFault)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*InvalidEditionEvent)(nil)).Elem()] = []string{"Feature"}
+	requiredFields[reflect.TypeOf((*InvalidEditionLicense)(nil)).Elem()] = []string{"Feature"}
+	requiredFields[reflect.TypeOf((*InvalidEditionLicenseFault)(nil)).Elem()] = []string{"Feature"}
+	requiredFields[reflect.TypeOf((*InvalidFolder)(nil)).Elem()] = []string{"Target"}
+	requiredFields[reflect.TypeOf((*InvalidIndexArgument)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*InvalidIndexArgumentFault)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*InvalidIpmiMacAddress)(nil)).Elem()] = []string{"UserProvidedMacAddress", "ObservedMacAddress"}
+	requiredFields[reflect.TypeOf((*InvalidIpmiMacAddressFault)(nil)).Elem()] = []string{"UserProvidedMacAddress", "ObservedMacAddress"}
+	requiredFields[reflect.TypeOf((*InvalidLicense)(nil)).Elem()] = []string{"LicenseContent"}
+	requiredFields[reflect.TypeOf((*InvalidLicenseFault)(nil)).Elem()] = []string{"LicenseContent"}
+	requiredFields[reflect.TypeOf((*InvalidName)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*InvalidNameFault)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*InvalidNasCredentials)(nil)).Elem()] = []string{"UserName"}
+	requiredFields[reflect.TypeOf((*InvalidNasCredentialsFault)(nil)).Elem()] = []string{"UserName"}
+	requiredFields[reflect.TypeOf((*InvalidNetworkResource)(nil)).Elem()] = []string{"RemoteHost", "RemotePath"}
+	requiredFields[reflect.TypeOf((*InvalidNetworkResourceFault)(nil)).Elem()] = []string{"RemoteHost", "RemotePath"}
+	requiredFields[reflect.TypeOf((*InvalidOperationOnSecondaryVm)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*InvalidOperationOnSecondaryVmFault)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*InvalidPowerState)(nil)).Elem()] = []string{"ExistingState"}
+	requiredFields[reflect.TypeOf((*InvalidPowerStateFault)(nil)).Elem()] = []string{"ExistingState"}
+	requiredFields[reflect.TypeOf((*InvalidPrivilege)(nil)).Elem()] = []string{"Privilege"}
+	requiredFields[reflect.TypeOf((*InvalidPrivilegeFault)(nil)).Elem()] = []string{"Privilege"}
+	requiredFields[reflect.TypeOf((*InvalidProperty)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*InvalidPropertyFault)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*InvalidVmState)(nil)).Elem()] = []string{"Vm"}
+	requiredFields[reflect.TypeOf((*InvalidVmStateFault)(nil)).Elem()] = []string{"Vm"}
+	requiredFields[reflect.TypeOf((*InventoryDescription)(nil)).Elem()] = []string{"NumHosts", "NumVirtualMachines"}
+	requiredFields[reflect.TypeOf((*InventoryHasStandardAloneHosts)(nil)).Elem()] = []string{"Hosts"}
+	requiredFields[reflect.TypeOf((*InventoryHasStandardAloneHostsFault)(nil)).Elem()] = []string{"Hosts"}
+	requiredFields[reflect.TypeOf((*IoFilterHostIssue)(nil)).Elem()] = []string{"Host", "Issue"}
+	requiredFields[reflect.TypeOf((*IoFilterInfo)(nil)).Elem()] = []string{"Id", "Name", "Vendor", "Version"}
+	requiredFields[reflect.TypeOf((*IoFilterQueryIssueResult)(nil)).Elem()] = []string{"OpType"}
+	requiredFields[reflect.TypeOf((*IpPoolAssociation)(nil)).Elem()] = []string{"NetworkName"}
+	requiredFields[reflect.TypeOf((*IpPoolManagerIpAllocation)(nil)).Elem()] = []string{"IpAddress", "AllocationId"}
+	requiredFields[reflect.TypeOf((*IpRange)(nil)).Elem()] = []string{"AddressPrefix"}
+	requiredFields[reflect.TypeOf((*IsClusteredVmdkEnabled)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*IsClusteredVmdkEnabledRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*IsClusteredVmdkEnabledResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*IsKmsClusterActive)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*IsKmsClusterActiveRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*IsKmsClusterActiveResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*IsSharedGraphicsActive)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*IsSharedGraphicsActiveRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*IsSharedGraphicsActiveResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*IscsiDependencyEntity)(nil)).Elem()] = []string{"PnicDevice", "VnicDevice", "VmhbaName"}
+	requiredFields[reflect.TypeOf((*IscsiFaultInvalidVnic)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultInvalidVnicFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultPnicInUse)(nil)).Elem()] = []string{"PnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultPnicInUseFault)(nil)).Elem()] = []string{"PnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicAlreadyBound)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicAlreadyBoundFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicHasActivePaths)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicHasActivePathsFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicHasMultipleUplinks)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicHasMultipleUplinksFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicHasNoUplinks)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicHasNoUplinksFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicHasWrongUplink)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicHasWrongUplinkFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicInUse)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicInUseFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicIsLastPath)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicIsLastPathFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicNotBound)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicNotBoundFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicNotFound)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiFaultVnicNotFoundFault)(nil)).Elem()] = []string{"VnicDevice"}
+	requiredFields[reflect.TypeOf((*IscsiMigrationDependency)(nil)).Elem()] = []string{"MigrationAllowed"}
+	requiredFields[reflect.TypeOf((*JoinDomainRequestType)(nil)).Elem()] = []string{"This", "DomainName", "UserName", "Password"}
+	requiredFields[reflect.TypeOf((*JoinDomainWithCAMRequestType)(nil)).Elem()] = []string{"This", "DomainName", "CamServer"}
+	requiredFields[reflect.TypeOf((*JoinDomainWithCAM_Task)(nil)).Elem()] = []string{"This", "DomainName", "CamServer"}
+	requiredFields[reflect.TypeOf((*JoinDomainWithCAM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*JoinDomain_Task)(nil)).Elem()] = []string{"This", "DomainName", "UserName", "Password"}
+	requiredFields[reflect.TypeOf((*JoinDomain_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*KernelModuleInfo)(nil)).Elem()] = []string{"Id", "Name", "Version", "Filename", "OptionString", "Loaded", "Enabled", "UseCount", "ReadOnlySection", "WritableSection", "TextSection", "DataSection", "BssSection"}
+	requiredFields[reflect.TypeOf((*KernelModuleSectionInfo)(nil)).Elem()] = []string{"Address"}
+	requiredFields[reflect.TypeOf((*KeyAnyValue)(nil)).Elem()] = []string{"Key", "Value"}
+	requiredFields[reflect.TypeOf((*KeyNotFound)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*KeyNotFoundFault)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*KeyProviderId)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*KeyValue)(nil)).Elem()] = []string{"Key", "Value"}
+	requiredFields[reflect.TypeOf((*KmipClusterInfo)(nil)).Elem()] = []string{"ClusterId", "UseAsDefault"}
+	requiredFields[reflect.TypeOf((*KmipServerInfo)(nil)).Elem()] = []string{"Name", "Address", "Port"}
+	requiredFields[reflect.TypeOf((*KmipServerSpec)(nil)).Elem()] = []string{"ClusterId", "Info"}
+	requiredFields[reflect.TypeOf((*KmipServerStatus)(nil)).Elem()] = []string{"ClusterId", "Name", "Status", "Description"}
+	requiredFields[reflect.TypeOf((*LargeRDMConversionNotSupported)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*LargeRDMConversionNotSupportedFault)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*LargeRDMNotSupportedOnDatastore)(nil)).Elem()] = []string{"Device", "Datastore", "DatastoreName"}
+	requiredFields[reflect.TypeOf((*LargeRDMNotSupportedOnDatastoreFault)(nil)).Elem()] = []string{"Device", "Datastore", "DatastoreName"}
+	requiredFields[reflect.TypeOf((*LatencySensitivity)(nil)).Elem()] = []string{"Level"}
+	requiredFields[reflect.TypeOf((*LeaveCurrentDomainRequestType)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*LeaveCurrentDomain_Task)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*LeaveCurrentDomain_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*LicenseAssignmentManagerLicenseAssignment)(nil)).Elem()] = []string{"EntityId", "AssignedLicense"}
+	requiredFields[reflect.TypeOf((*LicenseAvailabilityInfo)(nil)).Elem()] = []string{"Feature", "Total", "Available"}
+	requiredFields[reflect.TypeOf((*LicenseDiagnostics)(nil)).Elem()] = []string{"SourceLastChanged", "SourceLost", "SourceLatency", "LicenseRequests", "LicenseRequestFailures", "LicenseFeatureUnknowns", "OpState", "LastStatusUpdate", "OpFailureMessage"}
+	requiredFields[reflect.TypeOf((*LicenseDowngradeDisallowed)(nil)).Elem()] = []string{"Edition", "EntityId", "Features"}
+	requiredFields[reflect.TypeOf((*LicenseDowngradeDisallowedFault)(nil)).Elem()] = []string{"Edition", "EntityId", "Features"}
+	requiredFields[reflect.TypeOf((*LicenseEntityNotFound)(nil)).Elem()] = []string{"EntityId"}
+	requiredFields[reflect.TypeOf((*LicenseEntityNotFoundFault)(nil)).Elem()] = []string{"EntityId"}
+	requiredFields[reflect.TypeOf((*LicenseExpired)(nil)).Elem()] = []string{"LicenseKey"}
+	requiredFields[reflect.TypeOf((*LicenseExpiredEvent)(nil)).Elem()] = []string{"Feature"}
+	requiredFields[reflect.TypeOf((*LicenseExpiredFault)(nil)).Elem()] = []string{"LicenseKey"}
+	requiredFields[reflect.TypeOf((*LicenseFeatureInfo)(nil)).Elem()] = []string{"Key", "FeatureName", "CostUnit"}
+	requiredFields[reflect.TypeOf((*LicenseManagerEvaluationInfo)(nil)).Elem()] = []string{"Properties"}
+	requiredFields[reflect.TypeOf((*LicenseManagerLicenseInfo)(nil)).Elem()] = []string{"LicenseKey", "EditionKey", "Name", "Total", "CostUnit"}
+	requiredFields[reflect.TypeOf((*LicenseNonComplianceEvent)(nil)).Elem()] = []string{"Url"}
+	requiredFields[reflect.TypeOf((*LicenseReservationInfo)(nil)).Elem()] = []string{"Key", "State", "Required"}
+	requiredFields[reflect.TypeOf((*LicenseServerAvailableEvent)(nil)).Elem()] = []string{"LicenseServer"}
+	requiredFields[reflect.TypeOf((*LicenseServerSource)(nil)).Elem()] = []string{"LicenseServer"}
+	requiredFields[reflect.TypeOf((*LicenseServerUnavailable)(nil)).Elem()] = []string{"LicenseServer"}
+	requiredFields[reflect.TypeOf((*LicenseServerUnavailableEvent)(nil)).Elem()] = []string{"LicenseServer"}
+	requiredFields[reflect.TypeOf((*LicenseServerUnavailableFault)(nil)).Elem()] = []string{"LicenseServer"}
+	requiredFields[reflect.TypeOf((*LicenseSourceUnavailable)(nil)).Elem()] = []string{"LicenseSource"}
+	requiredFields[reflect.TypeOf((*LicenseSourceUnavailableFault)(nil)).Elem()] = []string{"LicenseSource"}
+	requiredFields[reflect.TypeOf((*LicenseUsageInfo)(nil)).Elem()] = []string{"Source", "SourceAvailable"}
+	requiredFields[reflect.TypeOf((*LinkDiscoveryProtocolConfig)(nil)).Elem()] = []string{"Protocol", "Operation"}
+	requiredFields[reflect.TypeOf((*LinkLayerDiscoveryProtocolInfo)(nil)).Elem()] = []string{"ChassisId", "PortId", "TimeToLive"}
+	requiredFields[reflect.TypeOf((*ListCACertificateRevocationLists)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListCACertificateRevocationListsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListCACertificates)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListCACertificatesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListFilesInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "FilePath"}
+	requiredFields[reflect.TypeOf((*ListFilesInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "FilePath"}
+	requiredFields[reflect.TypeOf((*ListFilesInGuestResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ListGuestAliases)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Username"}
+	requiredFields[reflect.TypeOf((*ListGuestAliasesRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Username"}
+	requiredFields[reflect.TypeOf((*ListGuestMappedAliases)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ListGuestMappedAliasesRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ListKeys)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListKeysRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListKmipServers)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListKmipServersRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListKmsClusters)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListKmsClustersRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListProcessesInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ListProcessesInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ListRegistryKeysInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "KeyName", "Recursive"}
+	requiredFields[reflect.TypeOf((*ListRegistryKeysInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "KeyName", "Recursive"}
+	requiredFields[reflect.TypeOf((*ListRegistryValuesInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "KeyName", "ExpandStrings"}
+	requiredFields[reflect.TypeOf((*ListRegistryValuesInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "KeyName", "ExpandStrings"}
+	requiredFields[reflect.TypeOf((*ListSmartCardTrustAnchors)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListSmartCardTrustAnchorsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ListTagsAttachedToVStorageObject)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*ListTagsAttachedToVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*ListVStorageObject)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*ListVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*ListVStorageObjectsAttachedToTag)(nil)).Elem()] = []string{"This", "Category", "Tag"}
+	requiredFields[reflect.TypeOf((*ListVStorageObjectsAttachedToTagRequestType)(nil)).Elem()] = []string{"This", "Category", "Tag"}
+	requiredFields[reflect.TypeOf((*LocalDatastoreCreatedEvent)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*LocalLicenseSource)(nil)).Elem()] = []string{"LicenseKeys"}
+	requiredFields[reflect.TypeOf((*LocalizableMessage)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*LocalizationManagerMessageCatalog)(nil)).Elem()] = []string{"ModuleName", "CatalogName", "Locale", "CatalogUri"}
+	requiredFields[reflect.TypeOf((*LocalizedMethodFault)(nil)).Elem()] = []string{"Fault"}
+	requiredFields[reflect.TypeOf((*LockerMisconfiguredEvent)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*LogUserEvent)(nil)).Elem()] = []string{"This", "Entity", "Msg"}
+	requiredFields[reflect.TypeOf((*LogUserEventRequestType)(nil)).Elem()] = []string{"This", "Entity", "Msg"}
+	requiredFields[reflect.TypeOf((*Login)(nil)).Elem()] = []string{"This", "UserName", "Password"}
+	requiredFields[reflect.TypeOf((*LoginBySSPI)(nil)).Elem()] = []string{"This", "Base64Token"}
+	requiredFields[reflect.TypeOf((*LoginBySSPIRequestType)(nil)).Elem()] = []string{"This", "Base64Token"}
+	requiredFields[reflect.TypeOf((*LoginBySSPIResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*LoginByToken)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*LoginByTokenRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*LoginByTokenResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*LoginExtensionByCertificate)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*LoginExtensionByCertificateRequestType)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*LoginExtensionByCertificateResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*LoginExtensionBySubjectName)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*LoginExtensionBySubjectNameRequestType)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*LoginExtensionBySubjectNameResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*LoginRequestType)(nil)).Elem()] = []string{"This", "UserName", "Password"}
+	requiredFields[reflect.TypeOf((*LoginResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*Logout)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*LogoutRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*LongOption)(nil)).Elem()] = []string{"Min", "Max", "DefaultValue"}
+	requiredFields[reflect.TypeOf((*LookupDvPortGroup)(nil)).Elem()] = []string{"This", "PortgroupKey"}
+	requiredFields[reflect.TypeOf((*LookupDvPortGroupRequestType)(nil)).Elem()] = []string{"This", "PortgroupKey"}
+	requiredFields[reflect.TypeOf((*LookupVmOverheadMemory)(nil)).Elem()] = []string{"This", "Vm", "Host"}
+	requiredFields[reflect.TypeOf((*LookupVmOverheadMemoryRequestType)(nil)).Elem()] = []string{"This", "Vm", "Host"}
+	requiredFields[reflect.TypeOf((*LookupVmOverheadMemoryResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MacRange)(nil)).Elem()] = []string{"Address", "Mask"}
+	requiredFields[reflect.TypeOf((*MakeDirectory)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*MakeDirectoryInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "DirectoryPath", "CreateParentDirectories"}
+	requiredFields[reflect.TypeOf((*MakeDirectoryInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "DirectoryPath", "CreateParentDirectories"}
+	requiredFields[reflect.TypeOf((*MakeDirectoryRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*MakePrimaryVMRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*MakePrimaryVM_Task)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*MakePrimaryVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ManagedByInfo)(nil)).Elem()] = []string{"ExtensionKey", "Type"}
+	requiredFields[reflect.TypeOf((*ManagedEntityEventArgument)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*ManagedObjectNotFound)(nil)).Elem()] = []string{"Obj"}
+	requiredFields[reflect.TypeOf((*ManagedObjectNotFoundFault)(nil)).Elem()] = []string{"Obj"}
+	requiredFields[reflect.TypeOf((*ManagedObjectReference)(nil)).Elem()] = []string{"Type", "Value"}
+	requiredFields[reflect.TypeOf((*MarkAsLocalRequestType)(nil)).Elem()] = []string{"This", "ScsiDiskUuid"}
+	requiredFields[reflect.TypeOf((*MarkAsLocal_Task)(nil)).Elem()] = []string{"This", "ScsiDiskUuid"}
+	requiredFields[reflect.TypeOf((*MarkAsLocal_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MarkAsNonLocalRequestType)(nil)).Elem()] = []string{"This", "ScsiDiskUuid"}
+	requiredFields[reflect.TypeOf((*MarkAsNonLocal_Task)(nil)).Elem()] = []string{"This", "ScsiDiskUuid"}
+	requiredFields[reflect.TypeOf((*MarkAsNonLocal_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MarkAsNonSsdRequestType)(nil)).Elem()] = []string{"This", "ScsiDiskUuid"}
+	requiredFields[reflect.TypeOf((*MarkAsNonSsd_Task)(nil)).Elem()] = []string{"This", "ScsiDiskUuid"}
+	requiredFields[reflect.TypeOf((*MarkAsNonSsd_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MarkAsSsdRequestType)(nil)).Elem()] = []string{"This", "ScsiDiskUuid"}
+	requiredFields[reflect.TypeOf((*MarkAsSsd_Task)(nil)).Elem()] = []string{"This", "ScsiDiskUuid"}
+	requiredFields[reflect.TypeOf((*MarkAsSsd_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MarkAsTemplate)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*MarkAsTemplateRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*MarkAsVirtualMachine)(nil)).Elem()] = []string{"This", "Pool"}
+	requiredFields[reflect.TypeOf((*MarkAsVirtualMachineRequestType)(nil)).Elem()] = []string{"This", "Pool"}
+	requiredFields[reflect.TypeOf((*MarkDefault)(nil)).Elem()] = []string{"This", "ClusterId"}
+	requiredFields[reflect.TypeOf((*MarkDefaultRequestType)(nil)).Elem()] = []string{"This", "ClusterId"}
+	requiredFields[reflect.TypeOf((*MarkForRemoval)(nil)).Elem()] = []string{"This", "HbaName", "Remove"}
+	requiredFields[reflect.TypeOf((*MarkForRemovalRequestType)(nil)).Elem()] = []string{"This", "HbaName", "Remove"}
+	requiredFields[reflect.TypeOf((*MarkPerenniallyReserved)(nil)).Elem()] = []string{"This", "LunUuid", "State"}
+	requiredFields[reflect.TypeOf((*MarkPerenniallyReservedExRequestType)(nil)).Elem()] = []string{"This", "State"}
+	requiredFields[reflect.TypeOf((*MarkPerenniallyReservedEx_Task)(nil)).Elem()] = []string{"This", "State"}
+	requiredFields[reflect.TypeOf((*MarkPerenniallyReservedEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MarkPerenniallyReservedRequestType)(nil)).Elem()] = []string{"This", "LunUuid", "State"}
+	requiredFields[reflect.TypeOf((*MarkServiceProviderEntities)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*MarkServiceProviderEntitiesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*MemoryFileFormatNotSupportedByDatastore)(nil)).Elem()] = []string{"DatastoreName", "Type"}
+	requiredFields[reflect.TypeOf((*MemoryFileFormatNotSupportedByDatastoreFault)(nil)).Elem()] = []string{"DatastoreName", "Type"}
+	requiredFields[reflect.TypeOf((*MemorySizeNotRecommended)(nil)).Elem()] = []string{"MemorySizeMB", "MinMemorySizeMB", "MaxMemorySizeMB"}
+	requiredFields[reflect.TypeOf((*MemorySizeNotRecommendedFault)(nil)).Elem()] = []string{"MemorySizeMB", "MinMemorySizeMB", "MaxMemorySizeMB"}
+	requiredFields[reflect.TypeOf((*MemorySizeNotSupported)(nil)).Elem()] = []string{"MemorySizeMB", "MinMemorySizeMB", "MaxMemorySizeMB"}
+	requiredFields[reflect.TypeOf((*MemorySizeNotSupportedByDatastore)(nil)).Elem()] = []string{"Datastore", "MemorySizeMB", "MaxMemorySizeMB"}
+	requiredFields[reflect.TypeOf((*MemorySizeNotSupportedByDatastoreFault)(nil)).Elem()] = []string{"Datastore", "MemorySizeMB", "MaxMemorySizeMB"}
+	requiredFields[reflect.TypeOf((*MemorySizeNotSupportedFault)(nil)).Elem()] = []string{"MemorySizeMB", "MinMemorySizeMB", "MaxMemorySizeMB"}
+	requiredFields[reflect.TypeOf((*MergeDvsRequestType)(nil)).Elem()] = []string{"This", "Dvs"}
+	requiredFields[reflect.TypeOf((*MergeDvs_Task)(nil)).Elem()] = []string{"This", "Dvs"}
+	requiredFields[reflect.TypeOf((*MergeDvs_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MergePermissions)(nil)).Elem()] = []string{"This", "SrcRoleId", "DstRoleId"}
+	requiredFields[reflect.TypeOf((*MergePermissionsRequestType)(nil)).Elem()] = []string{"This", "SrcRoleId", "DstRoleId"}
+	requiredFields[reflect.TypeOf((*MethodAction)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*MethodAlreadyDisabledFault)(nil)).Elem()] = []string{"SourceId"}
+	requiredFields[reflect.TypeOf((*MethodAlreadyDisabledFaultFault)(nil)).Elem()] = []string{"SourceId"}
+	requiredFields[reflect.TypeOf((*MethodDescription)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*MethodNotFound)(nil)).Elem()] = []string{"Receiver", "Method"}
+	requiredFields[reflect.TypeOf((*MethodNotFoundFault)(nil)).Elem()] = []string{"Receiver", "Method"}
+	requiredFields[reflect.TypeOf((*MetricAlarmExpression)(nil)).Elem()] = []string{"Operator", "Type", "Metric"}
+	requiredFields[reflect.TypeOf((*MigrateVMRequestType)(nil)).Elem()] = []string{"This", "Priority"}
+	requiredFields[reflect.TypeOf((*MigrateVM_Task)(nil)).Elem()] = []string{"This", "Priority"}
+	requiredFields[reflect.TypeOf((*MigrateVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MigrationEvent)(nil)).Elem()] = []string{"Fault"}
+	requiredFields[reflect.TypeOf((*MigrationFeatureNotSupported)(nil)).Elem()] = []string{"AtSourceHost", "FailedHostName", "FailedHost"}
+	requiredFields[reflect.TypeOf((*MigrationHostErrorEvent)(nil)).Elem()] = []string{"DstHost"}
+	requiredFields[reflect.TypeOf((*MigrationHostWarningEvent)(nil)).Elem()] = []string{"DstHost"}
+	requiredFields[reflect.TypeOf((*MigrationNotReady)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*MigrationNotReadyFault)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*MigrationResourceErrorEvent)(nil)).Elem()] = []string{"DstPool", "DstHost"}
+	requiredFields[reflect.TypeOf((*MigrationResourceWarningEvent)(nil)).Elem()] = []string{"DstPool", "DstHost"}
+	requiredFields[reflect.TypeOf((*MismatchedBundle)(nil)).Elem()] = []string{"BundleUuid", "HostUuid", "BundleBuildNumber", "HostBuildNumber"}
+	requiredFields[reflect.TypeOf((*MismatchedBundleFault)(nil)).Elem()] = []string{"BundleUuid", "HostUuid", "BundleBuildNumber", "HostBuildNumber"}
+	requiredFields[reflect.TypeOf((*MismatchedNetworkPolicies)(nil)).Elem()] = []string{"Device", "Backing", "Connected"}
+	requiredFields[reflect.TypeOf((*MismatchedNetworkPoliciesFault)(nil)).Elem()] = []string{"Device", "Backing", "Connected"}
+	requiredFields[reflect.TypeOf((*MismatchedVMotionNetworkNames)(nil)).Elem()] = []string{"SourceNetwork", "DestNetwork"}
+	requiredFields[reflect.TypeOf((*MismatchedVMotionNetworkNamesFault)(nil)).Elem()] = []string{"SourceNetwork", "DestNetwork"}
+	requiredFields[reflect.TypeOf((*MissingObject)(nil)).Elem()] = []string{"Obj", "Fault"}
+	requiredFields[reflect.TypeOf((*MissingProperty)(nil)).Elem()] = []string{"Path", "Fault"}
+	requiredFields[reflect.TypeOf((*MksConnectionLimitReached)(nil)).Elem()] = []string{"ConnectionLimit"}
+	requiredFields[reflect.TypeOf((*MksConnectionLimitReachedFault)(nil)).Elem()] = []string{"ConnectionLimit"}
+	requiredFields[reflect.TypeOf((*ModeInfo)(nil)).Elem()] = []string{"Read", "Modify", "Use", "Full"}
+	requiredFields[reflect.TypeOf((*ModifyListView)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ModifyListViewRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*MonthlyByDayTaskScheduler)(nil)).Elem()] = []string{"Day"}
+	requiredFields[reflect.TypeOf((*MonthlyByWeekdayTaskScheduler)(nil)).Elem()] = []string{"Offset", "Weekday"}
+	requiredFields[reflect.TypeOf((*MountError)(nil)).Elem()] = []string{"Vm", "DiskIndex"}
+	requiredFields[reflect.TypeOf((*MountErrorFault)(nil)).Elem()] = []string{"Vm", "DiskIndex"}
+	requiredFields[reflect.TypeOf((*MountToolsInstaller)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*MountToolsInstallerRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*MountVffsVolume)(nil)).Elem()] = []string{"This", "VffsUuid"}
+	requiredFields[reflect.TypeOf((*MountVffsVolumeRequestType)(nil)).Elem()] = []string{"This", "VffsUuid"}
+	requiredFields[reflect.TypeOf((*MountVmfsVolume)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*MountVmfsVolumeExRequestType)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*MountVmfsVolumeEx_Task)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*MountVmfsVolumeEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MountVmfsVolumeRequestType)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*MoveDVPortRequestType)(nil)).Elem()] = []string{"This", "PortKey"}
+	requiredFields[reflect.TypeOf((*MoveDVPort_Task)(nil)).Elem()] = []string{"This", "PortKey"}
+	requiredFields[reflect.TypeOf((*MoveDVPort_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MoveDatastoreFileRequestType)(nil)).Elem()] = []string{"This", "SourceName", "DestinationName"}
+	requiredFields[reflect.TypeOf((*MoveDatastoreFile_Task)(nil)).Elem()] = []string{"This", "SourceName", "DestinationName"}
+	requiredFields[reflect.TypeOf((*MoveDatastoreFile_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MoveDirectoryInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "SrcDirectoryPath", "DstDirectoryPath"}
+	requiredFields[reflect.TypeOf((*MoveDirectoryInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "SrcDirectoryPath", "DstDirectoryPath"}
+	requiredFields[reflect.TypeOf((*MoveFileInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "SrcFilePath", "DstFilePath", "Overwrite"}
+	requiredFields[reflect.TypeOf((*MoveFileInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "SrcFilePath", "DstFilePath", "Overwrite"}
+	requiredFields[reflect.TypeOf((*MoveHostIntoRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*MoveHostInto_Task)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*MoveHostInto_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MoveIntoFolderRequestType)(nil)).Elem()] = []string{"This", "List"}
+	requiredFields[reflect.TypeOf((*MoveIntoFolder_Task)(nil)).Elem()] = []string{"This", "List"}
+	requiredFields[reflect.TypeOf((*MoveIntoFolder_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MoveIntoRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*MoveIntoResourcePool)(nil)).Elem()] = []string{"This", "List"}
+	requiredFields[reflect.TypeOf((*MoveIntoResourcePoolRequestType)(nil)).Elem()] = []string{"This", "List"}
+	requiredFields[reflect.TypeOf((*MoveInto_Task)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*MoveInto_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MoveVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "SourceName", "DestName"}
+	requiredFields[reflect.TypeOf((*MoveVirtualDisk_Task)(nil)).Elem()] = []string{"This", "SourceName", "DestName"}
+	requiredFields[reflect.TypeOf((*MoveVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*MultipleCertificatesVerifyFault)(nil)).Elem()] = []string{"ThumbprintData"}
+	requiredFields[reflect.TypeOf((*MultipleCertificatesVerifyFaultFault)(nil)).Elem()] = []string{"ThumbprintData"}
+	requiredFields[reflect.TypeOf((*MultipleCertificatesVerifyFaultThumbprintData)(nil)).Elem()] = []string{"Port", "Thumbprint"}
+	requiredFields[reflect.TypeOf((*NASDatastoreCreatedEvent)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*NamePasswordAuthentication)(nil)).Elem()] = []string{"Username", "Password"}
+	requiredFields[reflect.TypeOf((*NamespaceFull)(nil)).Elem()] = []string{"Name", "CurrentMaxSize"}
+	requiredFields[reflect.TypeOf((*NamespaceFullFault)(nil)).Elem()] = []string{"Name", "CurrentMaxSize"}
+	requiredFields[reflect.TypeOf((*NamespaceWriteProtected)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*NamespaceWriteProtectedFault)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*NasConfigFault)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*NasConnectionLimitReached)(nil)).Elem()] = []string{"RemoteHost", "RemotePath"}
+	requiredFields[reflect.TypeOf((*NasConnectionLimitReachedFault)(nil)).Elem()] = []string{"RemoteHost", "RemotePath"}
+	requiredFields[reflect.TypeOf((*NasSessionCredentialConflict)(nil)).Elem()] = []string{"RemoteHost", "RemotePath", "UserName"}
+	requiredFields[reflect.TypeOf((*NasSessionCredentialConflictFault)(nil)).Elem()] = []string{"RemoteHost", "RemotePath", "UserName"}
+	requiredFields[reflect.TypeOf((*NasStorageProfile)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*NasVolumeNotMounted)(nil)).Elem()] = []string{"RemoteHost", "RemotePath"}
+	requiredFields[reflect.TypeOf((*NasVolumeNotMountedFault)(nil)).Elem()] = []string{"RemoteHost", "RemotePath"}
+	requiredFields[reflect.TypeOf((*NetBIOSConfigInfo)(nil)).Elem()] = []string{"Mode"}
+	requiredFields[reflect.TypeOf((*NetDhcpConfigInfoDhcpOptions)(nil)).Elem()] = []string{"Enable"}
+	requiredFields[reflect.TypeOf((*NetDhcpConfigSpecDhcpOptionsSpec)(nil)).Elem()] = []string{"Config", "Operation"}
+	requiredFields[reflect.TypeOf((*NetDnsConfigInfo)(nil)).Elem()] = []string{"Dhcp", "HostName", "DomainName"}
+	requiredFields[reflect.TypeOf((*NetIpConfigInfoIpAddress)(nil)).Elem()] = []string{"IpAddress", "PrefixLength"}
+	requiredFields[reflect.TypeOf((*NetIpConfigSpecIpAddressSpec)(nil)).Elem()] = []string{"IpAddress", "PrefixLength", "Operation"}
+	requiredFields[reflect.TypeOf((*NetIpRouteConfigInfoIpRoute)(nil)).Elem()] = []string{"Network", "PrefixLength", "Gateway"}
+	requiredFields[reflect.TypeOf((*NetIpRouteConfigSpecIpRouteSpec)(nil)).Elem()] = []string{"Network", "PrefixLength", "Gateway", "Operation"}
+	requiredFields[reflect.TypeOf((*NetIpStackInfoDefaultRouter)(nil)).Elem()] = []string{"IpAddress", "Device", "Lifetime", "Preference"}
+	requiredFields[reflect.TypeOf((*NetIpStackInfoNetToMedia)(nil)).Elem()] = []string{"IpAddress", "PhysicalAddress", "Device", "Type"}
+	requiredFields[reflect.TypeOf((*NetStackInstanceProfile)(nil)).Elem()] = []string{"Key", "DnsConfig", "IpRouteConfig"}
+	requiredFields[reflect.TypeOf((*NetworkDisruptedAndConfigRolledBack)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*NetworkDisruptedAndConfigRolledBackFault)(nil)).Elem()] = []string{"Host"}
+	requiredFields[reflect.TypeOf((*NetworkEventArgument)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*NetworkRollbackEvent)(nil)).Elem()] = []string{"MethodName", "TransactionId"}
+	requiredFields[reflect.TypeOf((*NetworkSummary)(nil)).Elem()] = []string{"Name", "Accessible"}
+	requiredFields[reflect.TypeOf((*NicSettingMismatch)(nil)).Elem()] = []string{"NumberOfNicsInSpec", "NumberOfNicsInVM"}
+	requiredFields[reflect.TypeOf((*NicSettingMismatchFault)(nil)).Elem()] = []string{"NumberOfNicsInSpec", "NumberOfNicsInVM"}
+	requiredFields[reflect.TypeOf((*NoAccessUserEvent)(nil)).Elem()] = []string{"IpAddress"}
+	requiredFields[reflect.TypeOf((*NoActiveHostInCluster)(nil)).Elem()] = []string{"ComputeResource"}
+	requiredFields[reflect.TypeOf((*NoActiveHostInClusterFault)(nil)).Elem()] = []string{"ComputeResource"}
+	requiredFields[reflect.TypeOf((*NoAvailableIp)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*NoAvailableIpFault)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*NoCompatibleHardAffinityHost)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*NoCompatibleHardAffinityHostFault)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*NoCompatibleSoftAffinityHost)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*NoCompatibleSoftAffinityHostFault)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*NoDiskSpace)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*NoDiskSpaceFault)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*NoHostSuitableForFtSecondary)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*NoHostSuitableForFtSecondaryFault)(nil)).Elem()] = []string{"Vm", "VmName"}
+	requiredFields[reflect.TypeOf((*NoLicenseEvent)(nil)).Elem()] = []string{"Feature"}
+	requiredFields[reflect.TypeOf((*NoPermissionEntityPrivileges)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*NodeDeploymentSpec)(nil)).Elem()] = []string{"Folder", "NodeName", "IpSettings"}
+	requiredFields[reflect.TypeOf((*NodeNetworkSpec)(nil)).Elem()] = []string{"IpSettings"}
+	requiredFields[reflect.TypeOf((*NonHomeRDMVMotionNotSupported)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*NonHomeRDMVMotionNotSupportedFault)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*NonVmwareOuiMacNotSupportedHost)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NonVmwareOuiMacNotSupportedHostFault)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NotEnoughCpus)(nil)).Elem()] = []string{"NumCpuDest", "NumCpuVm"}
+	requiredFields[reflect.TypeOf((*NotSupportedDeviceForFT)(nil)).Elem()] = []string{"Host", "Vm", "DeviceType"}
+	requiredFields[reflect.TypeOf((*NotSupportedDeviceForFTFault)(nil)).Elem()] = []string{"Host", "Vm", "DeviceType"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostForVFlash)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostForVFlashFault)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostForVmcp)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostForVmcpFault)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostForVmemFile)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostForVmemFileFault)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostForVsan)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostForVsanFault)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostInDvs)(nil)).Elem()] = []string{"SwitchProductSpec"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostInDvsFault)(nil)).Elem()] = []string{"SwitchProductSpec"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostInHACluster)(nil)).Elem()] = []string{"HostName", "Build"}
+	requiredFields[reflect.TypeOf((*NotSupportedHostInHAClusterFault)(nil)).Elem()] = []string{"HostName", "Build"}
+	requiredFields[reflect.TypeOf((*NotifyAffectedServices)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*NotifyAffectedServicesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*NsxHostVNicProfile)(nil)).Elem()] = []string{"Key", "IpConfig"}
+	requiredFields[reflect.TypeOf((*NumVirtualCoresPerSocketNotSupported)(nil)).Elem()] = []string{"MaxSupportedCoresPerSocketDest", "NumCoresPerSocketVm"}
+	requiredFields[reflect.TypeOf((*NumVirtualCoresPerSocketNotSupportedFault)(nil)).Elem()] = []string{"MaxSupportedCoresPerSocketDest", "NumCoresPerSocketVm"}
+	requiredFields[reflect.TypeOf((*NumVirtualCpusExceedsLimit)(nil)).Elem()] = []string{"MaxSupportedVcpus"}
+	requiredFields[reflect.TypeOf((*NumVirtualCpusExceedsLimitFault)(nil)).Elem()] = []string{"MaxSupportedVcpus"}
+	requiredFields[reflect.TypeOf((*NumVirtualCpusIncompatible)(nil)).Elem()] = []string{"Reason", "NumCpu"}
+	requiredFields[reflect.TypeOf((*NumVirtualCpusIncompatibleFault)(nil)).Elem()] = []string{"Reason", "NumCpu"}
+	requiredFields[reflect.TypeOf((*NumVirtualCpusNotSupported)(nil)).Elem()] = []string{"MaxSupportedVcpusDest", "NumCpuVm"}
+	requiredFields[reflect.TypeOf((*NumVirtualCpusNotSupportedFault)(nil)).Elem()] = []string{"MaxSupportedVcpusDest", "NumCpuVm"}
+	requiredFields[reflect.TypeOf((*NumericRange)(nil)).Elem()] = []string{"Start", "End"}
+	requiredFields[reflect.TypeOf((*NvdimmDimmInfo)(nil)).Elem()] = []string{"DimmHandle", "HealthInfo", "TotalCapacity", "PersistentCapacity", "AvailablePersistentCapacity", "VolatileCapacity", "AvailableVolatileCapacity", "BlockCapacity", "RepresentationString"}
+	requiredFields[reflect.TypeOf((*NvdimmGuid)(nil)).Elem()] = []string{"Uuid"}
+	requiredFields[reflect.TypeOf((*NvdimmHealthInfo)(nil)).Elem()] = []string{"HealthStatus", "HealthInformation", "DimmTemperature", "DimmTemperatureThreshold", "SpareBlocksPercentage", "SpareBlockThreshold", "DimmLifespanPercentage"}
+	requiredFields[reflect.TypeOf((*NvdimmInterleaveSetInfo)(nil)).Elem()] = []string{"SetId", "RangeType", "BaseAddress", "Size", "AvailableSize", "State"}
+	requiredFields[reflect.TypeOf((*NvdimmNamespaceCreateSpec)(nil)).Elem()] = []string{"BlockSize", "BlockCount", "Type", "LocationID"}
+	requiredFields[reflect.TypeOf((*NvdimmNamespaceDeleteSpec)(nil)).Elem()] = []string{"Uuid"}
+	requiredFields[reflect.TypeOf((*NvdimmNamespaceDetails)(nil)).Elem()] = []string{"Uuid", "FriendlyName", "Size", "Type", "NamespaceHealthStatus", "InterleavesetID", "State"}
+	requiredFields[reflect.TypeOf((*NvdimmNamespaceInfo)(nil)).Elem()] = []string{"Uuid", "FriendlyName", "BlockSize", "BlockCount", "Type", "NamespaceHealthStatus", "LocationID", "State"}
+	requiredFields[reflect.TypeOf((*NvdimmPMemNamespaceCreateSpec)(nil)).Elem()] = []string{"Size", "InterleavesetID"}
+	requiredFields[reflect.TypeOf((*NvdimmRegionInfo)(nil)).Elem()] = []string{"RegionId", "SetId", "RangeType", "StartAddr", "Size", "Offset"}
+	requiredFields[reflect.TypeOf((*NvdimmSummary)(nil)).Elem()] = []string{"NumDimms", "HealthStatus", "TotalCapacity", "PersistentCapacity", "BlockCapacity", "AvailableCapacity", "NumInterleavesets", "NumNamespaces"}
+	requiredFields[reflect.TypeOf((*ObjectContent)(nil)).Elem()] = []string{"Obj"}
+	requiredFields[reflect.TypeOf((*ObjectSpec)(nil)).Elem()] = []string{"Obj"}
+	requiredFields[reflect.TypeOf((*ObjectUpdate)(nil)).Elem()] = []string{"Kind", "Obj"}
+	requiredFields[reflect.TypeOf((*OpaqueNetworkCapability)(nil)).Elem()] = []string{"NetworkReservationSupported"}
+	requiredFields[reflect.TypeOf((*OpaqueNetworkSummary)(nil)).Elem()] = []string{"OpaqueNetworkId", "OpaqueNetworkType"}
+	requiredFields[reflect.TypeOf((*OpaqueNetworkTargetInfo)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*OpenInventoryViewFolder)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*OpenInventoryViewFolderRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*OptionDef)(nil)).Elem()] = []string{"OptionType"}
+	requiredFields[reflect.TypeOf((*OptionProfile)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*OptionValue)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*OrAlarmExpression)(nil)).Elem()] = []string{"Expression"}
+	requiredFields[reflect.TypeOf((*OutOfBounds)(nil)).Elem()] = []string{"ArgumentName"}
+	requiredFields[reflect.TypeOf((*OutOfBoundsFault)(nil)).Elem()] = []string{"ArgumentName"}
+	requiredFields[reflect.TypeOf((*OutOfSyncDvsHost)(nil)).Elem()] = []string{"HostOutOfSync"}
+	requiredFields[reflect.TypeOf((*OverwriteCustomizationSpec)(nil)).Elem()] = []string{"This", "Item"}
+	requiredFields[reflect.TypeOf((*OverwriteCustomizationSpecRequestType)(nil)).Elem()] = []string{"This", "Item"}
+	requiredFields[reflect.TypeOf((*OvfAttribute)(nil)).Elem()] = []string{"ElementName", "AttributeName"}
+	requiredFields[reflect.TypeOf((*OvfConnectedDeviceFloppy)(nil)).Elem()] = []string{"Filename"}
+	requiredFields[reflect.TypeOf((*OvfConnectedDeviceFloppyFault)(nil)).Elem()] = []string{"Filename"}
+	requiredFields[reflect.TypeOf((*OvfConnectedDeviceIso)(nil)).Elem()] = []string{"Filename"}
+	requiredFields[reflect.TypeOf((*OvfConnectedDeviceIsoFault)(nil)).Elem()] = []string{"Filename"}
+	requiredFields[reflect.TypeOf((*OvfConstraint)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*OvfConsumerCallbackFault)(nil)).Elem()] = []string{"ExtensionKey", "ExtensionName"}
+	requiredFields[reflect.TypeOf((*OvfConsumerCommunicationError)(nil)).Elem()] = []string{"Description"}
+	requiredFields[reflect.TypeOf((*OvfConsumerCommunicationErrorFault)(nil)).Elem()] = []string{"Description"}
+	requiredFields[reflect.TypeOf((*OvfConsumerFault)(nil)).Elem()] = []string{"ErrorKey", "Message"}
+	requiredFields[reflect.TypeOf((*OvfConsumerFaultFault)(nil)).Elem()] = []string{"ErrorKey", "Message"}
+	requiredFields[reflect.TypeOf((*OvfConsumerInvalidSection)(nil)).Elem()] = []string{"LineNumber", "Description"}
+	requiredFields[reflect.TypeOf((*OvfConsumerInvalidSectionFault)(nil)).Elem()] = []string{"LineNumber", "Description"}
+	requiredFields[reflect.TypeOf((*OvfConsumerOstNode)(nil)).Elem()] = []string{"Id", "Type"}
+	requiredFields[reflect.TypeOf((*OvfConsumerOvfSection)(nil)).Elem()] = []string{"LineNumber", "Xml"}
+	requiredFields[reflect.TypeOf((*OvfConsumerPowerOnFault)(nil)).Elem()] = []string{"ExtensionKey", "ExtensionName", "Description"}
+	requiredFields[reflect.TypeOf((*OvfConsumerPowerOnFaultFault)(nil)).Elem()] = []string{"ExtensionKey", "ExtensionName", "Description"}
+	requiredFields[reflect.TypeOf((*OvfConsumerUndeclaredSection)(nil)).Elem()] = []string{"QualifiedSectionType"}
+	requiredFields[reflect.TypeOf((*OvfConsumerUndeclaredSectionFault)(nil)).Elem()] = []string{"QualifiedSectionType"}
+	requiredFields[reflect.TypeOf((*OvfConsumerUndefinedPrefix)(nil)).Elem()] = []string{"Prefix"}
+	requiredFields[reflect.TypeOf((*OvfConsumerUndefinedPrefixFault)(nil)).Elem()] = []string{"Prefix"}
+	requiredFields[reflect.TypeOf((*OvfConsumerValidationFault)(nil)).Elem()] = []string{"ExtensionKey", "ExtensionName", "Message"}
+	requiredFields[reflect.TypeOf((*OvfConsumerValidationFaultFault)(nil)).Elem()] = []string{"ExtensionKey", "ExtensionName", "Message"}
+	requiredFields[reflect.TypeOf((*OvfCpuCompatibility)(nil)).Elem()] = []string{"RegisterName", "Level", "RegisterValue", "DesiredRegisterValue"}
+	requiredFields[reflect.TypeOf((*OvfCpuCompatibilityFault)(nil)).Elem()] = []string{"RegisterName", "Level", "RegisterValue", "DesiredRegisterValue"}
+	requiredFields[reflect.TypeOf((*OvfCreateDescriptorResult)(nil)).Elem()] = []string{"OvfDescriptor"}
+	requiredFields[reflect.TypeOf((*OvfCreateImportSpecParams)(nil)).Elem()] = []string{"EntityName"}
+	requiredFields[reflect.TypeOf((*OvfDeploymentOption)(nil)).Elem()] = []string{"Key", "Label", "Description"}
+	requiredFields[reflect.TypeOf((*OvfDiskMappingNotFound)(nil)).Elem()] = []string{"DiskName", "VmName"}
+	requiredFields[reflect.TypeOf((*OvfDiskMappingNotFoundFault)(nil)).Elem()] = []string{"DiskName", "VmName"}
+	requiredFields[reflect.TypeOf((*OvfDuplicatedElementBoundary)(nil)).Elem()] = []string{"Boundary"}
+	requiredFields[reflect.TypeOf((*OvfDuplicatedElementBoundaryFault)(nil)).Elem()] = []string{"Boundary"}
+	requiredFields[reflect.TypeOf((*OvfDuplicatedPropertyIdExport)(nil)).Elem()] = []string{"Fqid"}
+	requiredFields[reflect.TypeOf((*OvfDuplicatedPropertyIdExportFault)(nil)).Elem()] = []string{"Fqid"}
+	requiredFields[reflect.TypeOf((*OvfElement)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*OvfElementInvalidValue)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*OvfElementInvalidValueFault)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*OvfFile)(nil)).Elem()] = []string{"DeviceId", "Path", "Size"}
+	requiredFields[reflect.TypeOf((*OvfFileItem)(nil)).Elem()] = []string{"DeviceId", "Path", "CimType", "Create"}
+	requiredFields[reflect.TypeOf((*OvfHardwareExport)(nil)).Elem()] = []string{"VmPath"}
+	requiredFields[reflect.TypeOf((*OvfHostResourceConstraint)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*OvfHostResourceConstraintFault)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*OvfHostValueNotParsed)(nil)).Elem()] = []string{"Property", "Value"}
+	requiredFields[reflect.TypeOf((*OvfHostValueNotParsedFault)(nil)).Elem()] = []string{"Property", "Value"}
+	requiredFields[reflect.TypeOf((*OvfInvalidPackage)(nil)).Elem()] = []string{"LineNumber"}
+	requiredFields[reflect.TypeOf((*OvfInvalidValue)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*OvfInvalidVmName)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*OvfInvalidVmNameFault)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*OvfManagerCommonParams)(nil)).Elem()] = []string{"Locale", "DeploymentOption"}
+	requiredFields[reflect.TypeOf((*OvfMappedOsId)(nil)).Elem()] = []string{"OvfId", "OvfDescription", "TargetDescription"}
+	requiredFields[reflect.TypeOf((*OvfMappedOsIdFault)(nil)).Elem()] = []string{"OvfId", "OvfDescription", "TargetDescription"}
+	requiredFields[reflect.TypeOf((*OvfMissingElementNormalBoundary)(nil)).Elem()] = []string{"Boundary"}
+	requiredFields[reflect.TypeOf((*OvfMissingElementNormalBoundaryFault)(nil)).Elem()] = []string{"Boundary"}
+	requiredFields[reflect.TypeOf((*OvfMissingHardware)(nil)).Elem()] = []string{"Name", "ResourceType"}
+	requiredFields[reflect.TypeOf((*OvfMissingHardwareFault)(nil)).Elem()] = []string{"Name", "ResourceType"}
+	requiredFields[reflect.TypeOf((*OvfNetworkInfo)(nil)).Elem()] = []string{"Name", "Description"}
+	requiredFields[reflect.TypeOf((*OvfNetworkMapping)(nil)).Elem()] = []string{"Name", "Network"}
+	requiredFields[reflect.TypeOf((*OvfNoSpaceOnController)(nil)).Elem()] = []string{"Parent"}
+	requiredFields[reflect.TypeOf((*OvfNoSpaceOnControllerFault)(nil)).Elem()] = []string{"Parent"}
+	requiredFields[reflect.TypeOf((*OvfNoSupportedHardwareFamily)(nil)).Elem()] = []string{"Version"}
+	requiredFields[reflect.TypeOf((*OvfNoSupportedHardwareFamilyFault)(nil)).Elem()] = []string{"Version"}
+	requiredFields[reflect.TypeOf((*OvfOptionInfo)(nil)).Elem()] = []string{"Option", "Description"}
+	requiredFields[reflect.TypeOf((*OvfParseDescriptorResult)(nil)).Elem()] = []string{"Annotation", "DefaultEntityName", "VirtualApp", "DefaultDeploymentOption"}
+	requiredFields[reflect.TypeOf((*OvfProperty)(nil)).Elem()] = []string{"Type", "Value"}
+	requiredFields[reflect.TypeOf((*OvfPropertyExport)(nil)).Elem()] = []string{"Type", "Value"}
+	requiredFields[reflect.TypeOf((*OvfPropertyExportFault)(nil)).Elem()] = []string{"Type", "Value"}
+	requiredFields[reflect.TypeOf((*OvfPropertyNetworkExport)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*OvfPropertyNetworkExportFault)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*OvfPropertyQualifier)(nil)).Elem()] = []string{"Qualifier"}
+	requiredFields[reflect.TypeOf((*OvfPropertyQualifierDuplicate)(nil)).Elem()] = []string{"Qualifier"}
+	requiredFields[reflect.TypeOf((*OvfPropertyQualifierDuplicateFault)(nil)).Elem()] = []string{"Qualifier"}
+	requiredFields[reflect.TypeOf((*OvfPropertyQualifierFault)(nil)).Elem()] = []string{"Qualifier"}
+	requiredFields[reflect.TypeOf((*OvfPropertyQualifierIgnored)(nil)).Elem()] = []string{"Qualifier"}
+	requiredFields[reflect.TypeOf((*OvfPropertyQualifierIgnoredFault)(nil)).Elem()] = []string{"Qualifier"}
+	requiredFields[reflect.TypeOf((*OvfResourceMap)(nil)).Elem()] = []string{"Source"}
+	requiredFields[reflect.TypeOf((*OvfUnableToExportDisk)(nil)).Elem()] = []string{"DiskName"}
+	requiredFields[reflect.TypeOf((*OvfUnableToExportDiskFault)(nil)).Elem()] = []string{"DiskName"}
+	requiredFields[reflect.TypeOf((*OvfUnknownDevice)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*OvfUnknownDeviceBacking)(nil)).Elem()] = []string{"Backing"}
+	requiredFields[reflect.TypeOf((*OvfUnknownDeviceBackingFault)(nil)).Elem()] = []string{"Backing"}
+	requiredFields[reflect.TypeOf((*OvfUnknownDeviceFault)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*OvfUnknownEntity)(nil)).Elem()] = []string{"LineNumber"}
+	requiredFields[reflect.TypeOf((*OvfUnknownEntityFault)(nil)).Elem()] = []string{"LineNumber"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedAttribute)(nil)).Elem()] = []string{"ElementName", "AttributeName"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedAttributeValue)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedAttributeValueFault)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedDeviceBackingInfo)(nil)).Elem()] = []string{"DeviceName"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedDeviceBackingInfoFault)(nil)).Elem()] = []string{"DeviceName"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedDeviceBackingOption)(nil)).Elem()] = []string{"DeviceName"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedDeviceBackingOptionFault)(nil)).Elem()] = []string{"DeviceName"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedDiskProvisioning)(nil)).Elem()] = []string{"DiskProvisioning", "SupportedDiskProvisioning"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedDiskProvisioningFault)(nil)).Elem()] = []string{"DiskProvisioning", "SupportedDiskProvisioning"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedElement)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedElementValue)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedElementValueFault)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedSection)(nil)).Elem()] = []string{"Info"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedSectionFault)(nil)).Elem()] = []string{"Info"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedSubType)(nil)).Elem()] = []string{"ElementName", "InstanceId", "DeviceType", "DeviceSubType"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedSubTypeFault)(nil)).Elem()] = []string{"ElementName", "InstanceId", "DeviceType", "DeviceSubType"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedType)(nil)).Elem()] = []string{"Name", "InstanceId", "DeviceType"}
+	requiredFields[reflect.TypeOf((*OvfUnsupportedTypeFault)(nil)).Elem()] = []string{"Name", "InstanceId", "DeviceType"}
+	requiredFields[reflect.TypeOf((*OvfWrongNamespace)(nil)).Elem()] = []string{"NamespaceName"}
+	requiredFields[reflect.TypeOf((*OvfWrongNamespaceFault)(nil)).Elem()] = []string{"NamespaceName"}
+	requiredFields[reflect.TypeOf((*OvfXmlFormat)(nil)).Elem()] = []string{"Description"}
+	requiredFields[reflect.TypeOf((*OvfXmlFormatFault)(nil)).Elem()] = []string{"Description"}
+	requiredFields[reflect.TypeOf((*PMemDatastoreInfo)(nil)).Elem()] = []string{"Pmem"}
+	requiredFields[reflect.TypeOf((*ParseDescriptor)(nil)).Elem()] = []string{"This", "OvfDescriptor", "Pdp"}
+	requiredFields[reflect.TypeOf((*ParseDescriptorRequestType)(nil)).Elem()] = []string{"This", "OvfDescriptor", "Pdp"}
+	requiredFields[reflect.TypeOf((*ParseDescriptorResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PasswordField)(nil)).Elem()] = []string{"Value"}
+	requiredFields[reflect.TypeOf((*PatchBinariesNotFound)(nil)).Elem()] = []string{"PatchID"}
+	requiredFields[reflect.TypeOf((*PatchBinariesNotFoundFault)(nil)).Elem()] = []string{"PatchID"}
+	requiredFields[reflect.TypeOf((*PatchInstallFailed)(nil)).Elem()] = []string{"RolledBack"}
+	requiredFields[reflect.TypeOf((*PatchInstallFailedFault)(nil)).Elem()] = []string{"RolledBack"}
+	requiredFields[reflect.TypeOf((*PatchMetadataInvalid)(nil)).Elem()] = []string{"PatchID"}
+	requiredFields[reflect.TypeOf((*PatchNotApplicable)(nil)).Elem()] = []string{"PatchID"}
+	requiredFields[reflect.TypeOf((*PerfCounterInfo)(nil)).Elem()] = []string{"Key", "NameInfo", "GroupInfo", "UnitInfo", "RollupType", "StatsType"}
+	requiredFields[reflect.TypeOf((*PerfEntityMetricBase)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*PerfEntityMetricCSV)(nil)).Elem()] = []string{"SampleInfoCSV"}
+	requiredFields[reflect.TypeOf((*PerfInterval)(nil)).Elem()] = []string{"Key", "SamplingPeriod", "Name", "Length", "Enabled"}
+	requiredFields[reflect.TypeOf((*PerfMetricId)(nil)).Elem()] = []string{"CounterId", "Instance"}
+	requiredFields[reflect.TypeOf((*PerfMetricSeries)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*PerfProviderSummary)(nil)).Elem()] = []string{"Entity", "CurrentSupported", "SummarySupported"}
+	requiredFields[reflect.TypeOf((*PerfQuerySpec)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*PerfSampleInfo)(nil)).Elem()] = []string{"Timestamp", "Interval"}
+	requiredFields[reflect.TypeOf((*PerformDvsProductSpecOperationRequestType)(nil)).Elem()] = []string{"This", "Operation"}
+	requiredFields[reflect.TypeOf((*PerformDvsProductSpecOperation_Task)(nil)).Elem()] = []string{"This", "Operation"}
+	requiredFields[reflect.TypeOf((*PerformDvsProductSpecOperation_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PerformVsanUpgradePreflightCheck)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*PerformVsanUpgradePreflightCheckRequestType)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*PerformVsanUpgradePreflightCheckResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PerformVsanUpgradeRequestType)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*PerformVsanUpgrade_Task)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*PerformVsanUpgrade_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PerformanceDescription)(nil)).Elem()] = []string{"CounterType", "StatsType"}
+	requiredFields[reflect.TypeOf((*PerformanceManagerCounterLevelMapping)(nil)).Elem()] = []string{"CounterId"}
+	requiredFields[reflect.TypeOf((*Permission)(nil)).Elem()] = []string{"Principal", "Group", "RoleId", "Propagate"}
+	requiredFields[reflect.TypeOf((*PermissionAddedEvent)(nil)).Elem()] = []string{"Role", "Propagate"}
+	requiredFields[reflect.TypeOf((*PermissionEvent)(nil)).Elem()] = []string{"Entity", "Principal", "Group"}
+	requiredFields[reflect.TypeOf((*PermissionProfile)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*PermissionUpdatedEvent)(nil)).Elem()] = []string{"Role", "Propagate"}
+	requiredFields[reflect.TypeOf((*PhysicalNic)(nil)).Elem()] = []string{"Device", "Pci", "Spec", "WakeOnLanSupported", "Mac"}
+	requiredFields[reflect.TypeOf((*PhysicalNicCdpDeviceCapability)(nil)).Elem()] = []string{"Router", "TransparentBridge", "SourceRouteBridge", "NetworkSwitch", "Host", "IgmpEnabled", "Repeater"}
+	requiredFields[reflect.TypeOf((*PhysicalNicConfig)(nil)).Elem()] = []string{"Device", "Spec"}
+	requiredFields[reflect.TypeOf((*PhysicalNicHintInfo)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*PhysicalNicIpHint)(nil)).Elem()] = []string{"IpSubnet"}
+	requiredFields[reflect.TypeOf((*PhysicalNicLinkInfo)(nil)).Elem()] = []string{"SpeedMb", "Duplex"}
+	requiredFields[reflect.TypeOf((*PhysicalNicNameHint)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*PhysicalNicProfile)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*PinnedCertificate)(nil)).Elem()] = []string{"SslCertificate"}
+	requiredFields[reflect.TypeOf((*PlaceVm)(nil)).Elem()] = []string{"This", "PlacementSpec"}
+	requiredFields[reflect.TypeOf((*PlaceVmRequestType)(nil)).Elem()] = []string{"This", "PlacementSpec"}
+	requiredFields[reflect.TypeOf((*PlaceVmResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PlacementAffinityRule)(nil)).Elem()] = []string{"RuleType", "RuleScope"}
+	requiredFields[reflect.TypeOf((*PlacementRankResult)(nil)).Elem()] = []string{"Key", "Candidate", "ReservedSpaceMB", "UsedSpaceMB", "TotalSpaceMB", "Utilization"}
+	requiredFields[reflect.TypeOf((*PlacementRankSpec)(nil)).Elem()] = []string{"Specs", "Clusters"}
+	requiredFields[reflect.TypeOf((*PlatformConfigFault)(nil)).Elem()] = []string{"Text"}
+	requiredFields[reflect.TypeOf((*PnicUplinkProfile)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*PodDiskLocator)(nil)).Elem()] = []string{"DiskId"}
+	requiredFields[reflect.TypeOf((*PodStorageDrsEntry)(nil)).Elem()] = []string{"StorageDrsConfig"}
+	requiredFields[reflect.TypeOf((*PolicyOption)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*PortGroupProfile)(nil)).Elem()] = []string{"Key", "Name", "Vlan", "Vswitch", "NetworkPolicy"}
+	requiredFields[reflect.TypeOf((*PosixUserSearchResult)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*PostEvent)(nil)).Elem()] = []string{"This", "EventToPost"}
+	requiredFields[reflect.TypeOf((*PostEventRequestType)(nil)).Elem()] = []string{"This", "EventToPost"}
+	requiredFields[reflect.TypeOf((*PostHealthUpdates)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*PostHealthUpdatesRequestType)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*PowerDownHostToStandByRequestType)(nil)).Elem()] = []string{"This", "TimeoutSec"}
+	requiredFields[reflect.TypeOf((*PowerDownHostToStandBy_Task)(nil)).Elem()] = []string{"This", "TimeoutSec"}
+	requiredFields[reflect.TypeOf((*PowerDownHostToStandBy_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PowerOffVAppRequestType)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*PowerOffVApp_Task)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*PowerOffVApp_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PowerOffVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*PowerOffVM_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*PowerOffVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PowerOnFtSecondaryFailed)(nil)).Elem()] = []string{"Vm", "VmName", "HostSelectionBy", "RootCause"}
+	requiredFields[reflect.TypeOf((*PowerOnFtSecondaryFailedFault)(nil)).Elem()] = []string{"Vm", "VmName", "HostSelectionBy", "RootCause"}
+	requiredFields[reflect.TypeOf((*PowerOnFtSecondaryTimedout)(nil)).Elem()] = []string{"Vm", "VmName", "Timeout"}
+	requiredFields[reflect.TypeOf((*PowerOnFtSecondaryTimedoutFault)(nil)).Elem()] = []string{"Vm", "VmName", "Timeout"}
+	requiredFields[reflect.TypeOf((*PowerOnMultiVMRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*PowerOnMultiVM_Task)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*PowerOnMultiVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PowerOnVAppRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*PowerOnVApp_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*PowerOnVApp_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PowerOnVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*PowerOnVM_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*PowerOnVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PowerSystemCapability)(nil)).Elem()] = []string{"AvailablePolicy"}
+	requiredFields[reflect.TypeOf((*PowerSystemInfo)(nil)).Elem()] = []string{"CurrentPolicy"}
+	requiredFields[reflect.TypeOf((*PowerUpHostFromStandByRequestType)(nil)).Elem()] = []string{"This", "TimeoutSec"}
+	requiredFields[reflect.TypeOf((*PowerUpHostFromStandBy_Task)(nil)).Elem()] = []string{"This", "TimeoutSec"}
+	requiredFields[reflect.TypeOf((*PowerUpHostFromStandBy_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PrepareCrypto)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*PrepareCryptoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*PrepareVchaRequestType)(nil)).Elem()] = []string{"This", "NetworkSpec"}
+	requiredFields[reflect.TypeOf((*PrepareVcha_Task)(nil)).Elem()] = []string{"This", "NetworkSpec"}
+	requiredFields[reflect.TypeOf((*PrepareVcha_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PrivilegeAvailability)(nil)).Elem()] = []string{"PrivId", "IsGranted"}
+	requiredFields[reflect.TypeOf((*PrivilegePolicyDef)(nil)).Elem()] = []string{"CreatePrivilege", "ReadPrivilege", "UpdatePrivilege", "DeletePrivilege"}
+	requiredFields[reflect.TypeOf((*ProductComponentInfo)(nil)).Elem()] = []string{"Id", "Name", "Version", "Release"}
+	requiredFields[reflect.TypeOf((*ProfileApplyProfileElement)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*ProfileApplyProfileProperty)(nil)).Elem()] = []string{"PropertyName", "Array"}
+	requiredFields[reflect.TypeOf((*ProfileCompositeExpression)(nil)).Elem()] = []string{"Operator", "ExpressionName"}
+	requiredFields[reflect.TypeOf((*ProfileCompositePolicyOptionMetadata)(nil)).Elem()] = []string{"Option"}
+	requiredFields[reflect.TypeOf((*ProfileConfigInfo)(nil)).Elem()] = []string{"Name", "Enabled"}
+	requiredFields[reflect.TypeOf((*ProfileDeferredPolicyOptionParameter)(nil)).Elem()] = []string{"InputPath"}
+	requiredFields[reflect.TypeOf((*ProfileDescription)(nil)).Elem()] = []string{"Section"}
+	requiredFields[reflect.TypeOf((*ProfileDescriptionSection)(nil)).Elem()] = []string{"Description"}
+	requiredFields[reflect.TypeOf((*ProfileEvent)(nil)).Elem()] = []string{"Profile"}
+	requiredFields[reflect.TypeOf((*ProfileEventArgument)(nil)).Elem()] = []string{"Profile", "Name"}
+	requiredFields[reflect.TypeOf((*ProfileExecuteError)(nil)).Elem()] = []string{"Message"}
+	requiredFields[reflect.TypeOf((*ProfileExecuteResult)(nil)).Elem()] = []string{"Status"}
+	requiredFields[reflect.TypeOf((*ProfileExpression)(nil)).Elem()] = []string{"Id", "DisplayName", "Negated"}
+	requiredFields[reflect.TypeOf((*ProfileExpressionMetadata)(nil)).Elem()] = []string{"ExpressionId"}
+	requiredFields[reflect.TypeOf((*ProfileMetadata)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*ProfileMetadataProfileOperationMessage)(nil)).Elem()] = []string{"OperationName", "Message"}
+	requiredFields[reflect.TypeOf((*ProfileMetadataProfileSortSpec)(nil)).Elem()] = []string{"PolicyId", "Parameter"}
+	requiredFields[reflect.TypeOf((*ProfileParameterMetadata)(nil)).Elem()] = []string{"Id", "Type", "Optional"}
+	requiredFields[reflect.TypeOf((*ProfileParameterMetadataParameterRelationMetadata)(nil)).Elem()] = []string{"MinCount", "MaxCount"}
+	requiredFields[reflect.TypeOf((*ProfilePolicy)(nil)).Elem()] = []string{"Id", "PolicyOption"}
+	requiredFields[reflect.TypeOf((*ProfilePolicyMetadata)(nil)).Elem()] = []string{"Id", "PossibleOption"}
+	requiredFields[reflect.TypeOf((*ProfilePolicyOptionMetadata)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*ProfileProfileStructure)(nil)).Elem()] = []string{"ProfileTypeName"}
+	requiredFields[reflect.TypeOf((*ProfileProfileStructureProperty)(nil)).Elem()] = []string{"PropertyName", "Array", "Element"}
+	requiredFields[reflect.TypeOf((*ProfilePropertyPath)(nil)).Elem()] = []string{"ProfilePath"}
+	requiredFields[reflect.TypeOf((*ProfileSerializedCreateSpec)(nil)).Elem()] = []string{"ProfileConfigString"}
+	requiredFields[reflect.TypeOf((*ProfileSimpleExpression)(nil)).Elem()] = []string{"ExpressionType"}
+	requiredFields[reflect.TypeOf((*ProfileUpdateFailed)(nil)).Elem()] = []string{"Failure"}
+	requiredFields[reflect.TypeOf((*ProfileUpdateFailedFault)(nil)).Elem()] = []string{"Failure"}
+	requiredFields[reflect.TypeOf((*ProfileUpdateFailedUpdateFailure)(nil)).Elem()] = []string{"ProfilePath", "ErrMsg"}
+	requiredFields[reflect.TypeOf((*PromoteDisksRequestType)(nil)).Elem()] = []string{"This", "Unlink"}
+	requiredFields[reflect.TypeOf((*PromoteDisks_Task)(nil)).Elem()] = []string{"This", "Unlink"}
+	requiredFields[reflect.TypeOf((*PromoteDisks_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*PropertyChange)(nil)).Elem()] = []string{"Name", "Op"}
+	requiredFields[reflect.TypeOf((*PropertyFilterSpec)(nil)).Elem()] = []string{"PropSet", "ObjectSet"}
+	requiredFields[reflect.TypeOf((*PropertyFilterUpdate)(nil)).Elem()] = []string{"Filter"}
+	requiredFields[reflect.TypeOf((*PropertySpec)(nil)).Elem()] = []string{"Type"}
+	requiredFields[reflect.TypeOf((*ProvisionServerPrivateKey)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*ProvisionServerPrivateKeyRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*PutUsbScanCodes)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*PutUsbScanCodesRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*PutUsbScanCodesResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QuarantineModeFault)(nil)).Elem()] = []string{"VmName", "FaultType"}
+	requiredFields[reflect.TypeOf((*QuarantineModeFaultFault)(nil)).Elem()] = []string{"VmName", "FaultType"}
+	requiredFields[reflect.TypeOf((*QueryAnswerFileStatus)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*QueryAnswerFileStatusRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*QueryAssignedLicenses)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAssignedLicensesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailableDisksForVmfs)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailableDisksForVmfsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailableDvsSpec)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailableDvsSpecRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailablePartition)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailablePartitionRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailablePerfMetric)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*QueryAvailablePerfMetricRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*QueryAvailableSsds)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailableSsdsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailableTimeZones)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryAvailableTimeZonesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryBootDevices)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryBootDevicesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryBoundVnics)(nil)).Elem()] = []string{"This", "IScsiHbaName"}
+	requiredFields[reflect.TypeOf((*QueryBoundVnicsRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaName"}
+	requiredFields[reflect.TypeOf((*QueryCandidateNics)(nil)).Elem()] = []string{"This", "IScsiHbaName"}
+	requiredFields[reflect.TypeOf((*QueryCandidateNicsRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaName"}
+	requiredFields[reflect.TypeOf((*QueryChangedDiskAreas)(nil)).Elem()] = []string{"This", "DeviceKey", "StartOffset", "ChangeId"}
+	requiredFields[reflect.TypeOf((*QueryChangedDiskAreasRequestType)(nil)).Elem()] = []string{"This", "DeviceKey", "StartOffset", "ChangeId"}
+	requiredFields[reflect.TypeOf((*QueryChangedDiskAreasResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryCmmds)(nil)).Elem()] = []string{"This", "Queries"}
+	requiredFields[reflect.TypeOf((*QueryCmmdsRequestType)(nil)).Elem()] = []string{"This", "Queries"}
+	requiredFields[reflect.TypeOf((*QueryCmmdsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryCompatibleHostForExistingDvs)(nil)).Elem()] = []string{"This", "Container", "Recursive", "Dvs"}
+	requiredFields[reflect.TypeOf((*QueryCompatibleHostForExistingDvsRequestType)(nil)).Elem()] = []string{"This", "Container", "Recursive", "Dvs"}
+	requiredFields[reflect.TypeOf((*QueryCompatibleHostForNewDvs)(nil)).Elem()] = []string{"This", "Container", "Recursive"}
+	requiredFields[reflect.TypeOf((*QueryCompatibleHostForNewDvsRequestType)(nil)).Elem()] = []string{"This", "Container", "Recursive"}
+	requiredFields[reflect.TypeOf((*QueryCompatibleVmnicsFromHosts)(nil)).Elem()] = []string{"This", "Dvs"}
+	requiredFields[reflect.TypeOf((*QueryCompatibleVmnicsFromHostsRequestType)(nil)).Elem()] = []string{"This", "Dvs"}
+	requiredFields[reflect.TypeOf((*QueryComplianceStatus)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryComplianceStatusRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConfigOption)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConfigOptionDescriptor)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConfigOptionDescriptorRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConfigOptionEx)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConfigOptionExRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConfigOptionRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConfigTarget)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConfigTargetRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConfiguredModuleOptionString)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryConfiguredModuleOptionStringRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryConfiguredModuleOptionStringResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryConnectionInfo)(nil)).Elem()] = []string{"This", "Hostname", "Port", "Username", "Password"}
+	requiredFields[reflect.TypeOf((*QueryConnectionInfoRequestType)(nil)).Elem()] = []string{"This", "Hostname", "Port", "Username", "Password"}
+	requiredFields[reflect.TypeOf((*QueryConnectionInfoResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryConnectionInfoViaSpec)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*QueryConnectionInfoViaSpecRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*QueryConnectionInfoViaSpecResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryConnections)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryConnectionsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryCryptoKeyStatus)(nil)).Elem()] = []string{"This", "CheckKeyBitMap"}
+	requiredFields[reflect.TypeOf((*QueryCryptoKeyStatusRequestType)(nil)).Elem()] = []string{"This", "CheckKeyBitMap"}
+	requiredFields[reflect.TypeOf((*QueryDatacenterConfigOptionDescriptor)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDatacenterConfigOptionDescriptorRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDatastorePerformanceSummary)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*QueryDatastorePerformanceSummaryRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*QueryDateTime)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDateTimeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDateTimeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryDescriptions)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDescriptionsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDirectoryInfo)(nil)).Elem()] = []string{"This", "StableName"}
+	requiredFields[reflect.TypeOf((*QueryDirectoryInfoRequestType)(nil)).Elem()] = []string{"This", "StableName"}
+	requiredFields[reflect.TypeOf((*QueryDirectoryInfoResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryDisksForVsan)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDisksForVsanRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDisksUsingFilter)(nil)).Elem()] = []string{"This", "FilterId", "CompRes"}
+	requiredFields[reflect.TypeOf((*QueryDisksUsingFilterRequestType)(nil)).Elem()] = []string{"This", "FilterId", "CompRes"}
+	requiredFields[reflect.TypeOf((*QueryDisksUsingFilterResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryDvsByUuid)(nil)).Elem()] = []string{"This", "Uuid"}
+	requiredFields[reflect.TypeOf((*QueryDvsByUuidRequestType)(nil)).Elem()] = []string{"This", "Uuid"}
+	requiredFields[reflect.TypeOf((*QueryDvsCheckCompatibility)(nil)).Elem()] = []string{"This", "HostContainer"}
+	requiredFields[reflect.TypeOf((*QueryDvsCheckCompatibilityRequestType)(nil)).Elem()] = []string{"This", "HostContainer"}
+	requiredFields[reflect.TypeOf((*QueryDvsCompatibleHostSpec)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDvsCompatibleHostSpecRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDvsConfigTarget)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDvsConfigTargetRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDvsConfigTargetResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryDvsFeatureCapability)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryDvsFeatureCapabilityRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryEvents)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*QueryEventsRequestType)(nil)).Elem()] = []string{"This", "Filter"}
+	requiredFields[reflect.TypeOf((*QueryExpressionMetadata)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryExpressionMetadataRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryExtensionIpAllocationUsage)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryExtensionIpAllocationUsageRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryFaultToleranceCompatibility)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryFaultToleranceCompatibilityEx)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryFaultToleranceCompatibilityExRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryFaultToleranceCompatibilityRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryFileLockInfo)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*QueryFileLockInfoRequestType)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*QueryFileLockInfoResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryFilterEntities)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*QueryFilterEntitiesRequestType)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*QueryFilterInfoIds)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*QueryFilterInfoIdsRequestType)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*QueryFilterList)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*QueryFilterListRequestType)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*QueryFilterName)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*QueryFilterNameRequestType)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*QueryFilterNameResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryFirmwareConfigUploadURL)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryFirmwareConfigUploadURLRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryFirmwareConfigUploadURLResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryHealthUpdateInfos)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*QueryHealthUpdateInfosRequestType)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*QueryHealthUpdates)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*QueryHealthUpdatesRequestType)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*QueryHostConnectionInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryHostConnectionInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryHostConnectionInfoResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryHostPatchRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryHostPatch_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryHostPatch_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryHostProfileMetadata)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryHostProfileMetadataRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryHostStatus)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryHostStatusRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryHostStatusResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryHostsWithAttachedLun)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*QueryHostsWithAttachedLunRequestType)(nil)).Elem()] = []string{"This", "LunUuid"}
+	requiredFields[reflect.TypeOf((*QueryIORMConfigOption)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*QueryIORMConfigOptionRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*QueryIORMConfigOptionResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryIPAllocations)(nil)).Elem()] = []string{"This", "Dc", "PoolId", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*QueryIPAllocationsRequestType)(nil)).Elem()] = []string{"This", "Dc", "PoolId", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*QueryIPAllocationsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryIoFilterInfo)(nil)).Elem()] = []string{"This", "CompRes"}
+	requiredFields[reflect.TypeOf((*QueryIoFilterInfoRequestType)(nil)).Elem()] = []string{"This", "CompRes"}
+	requiredFields[reflect.TypeOf((*QueryIoFilterIssues)(nil)).Elem()] = []string{"This", "FilterId", "CompRes"}
+	requiredFields[reflect.TypeOf((*QueryIoFilterIssuesRequestType)(nil)).Elem()] = []string{"This", "FilterId", "CompRes"}
+	requiredFields[reflect.TypeOf((*QueryIoFilterIssuesResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryIpPools)(nil)).Elem()] = []string{"This", "Dc"}
+	requiredFields[reflect.TypeOf((*QueryIpPoolsRequestType)(nil)).Elem()] = []string{"This", "Dc"}
+	requiredFields[reflect.TypeOf((*QueryLicenseSourceAvailability)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryLicenseSourceAvailabilityRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryLicenseUsage)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryLicenseUsageRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryLicenseUsageResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryLockdownExceptions)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryLockdownExceptionsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryManagedBy)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*QueryManagedByRequestType)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*QueryMaxQueueDepth)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*QueryMaxQueueDepthRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*QueryMaxQueueDepthResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryMemoryOverhead)(nil)).Elem()] = []string{"This", "MemorySize", "NumVcpus"}
+	requiredFields[reflect.TypeOf((*QueryMemoryOverheadEx)(nil)).Elem()] = []string{"This", "VmConfigInfo"}
+	requiredFields[reflect.TypeOf((*QueryMemoryOverheadExRequestType)(nil)).Elem()] = []string{"This", "VmConfigInfo"}
+	requiredFields[reflect.TypeOf((*QueryMemoryOverheadExResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryMemoryOverheadRequestType)(nil)).Elem()] = []string{"This", "MemorySize", "NumVcpus"}
+	requiredFields[reflect.TypeOf((*QueryMemoryOverheadResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryMigrationDependencies)(nil)).Elem()] = []string{"This", "PnicDevice"}
+	requiredFields[reflect.TypeOf((*QueryMigrationDependenciesRequestType)(nil)).Elem()] = []string{"This", "PnicDevice"}
+	requiredFields[reflect.TypeOf((*QueryMigrationDependenciesResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryModules)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryModulesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryMonitoredEntities)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*QueryMonitoredEntitiesRequestType)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*QueryNFSUser)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryNFSUserRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryNetConfig)(nil)).Elem()] = []string{"This", "NicType"}
+	requiredFields[reflect.TypeOf((*QueryNetConfigRequestType)(nil)).Elem()] = []string{"This", "NicType"}
+	requiredFields[reflect.TypeOf((*QueryNetworkHint)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryNetworkHintRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryObjectsOnPhysicalVsanDisk)(nil)).Elem()] = []string{"This", "Disks"}
+	requiredFields[reflect.TypeOf((*QueryObjectsOnPhysicalVsanDiskRequestType)(nil)).Elem()] = []string{"This", "Disks"}
+	requiredFields[reflect.TypeOf((*QueryObjectsOnPhysicalVsanDiskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryOptions)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryOptionsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryPartitionCreateDesc)(nil)).Elem()] = []string{"This", "DiskUuid", "DiagnosticType"}
+	requiredFields[reflect.TypeOf((*QueryPartitionCreateDescRequestType)(nil)).Elem()] = []string{"This", "DiskUuid", "DiagnosticType"}
+	requiredFields[reflect.TypeOf((*QueryPartitionCreateDescResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryPartitionCreateOptions)(nil)).Elem()] = []string{"This", "StorageType", "DiagnosticType"}
+	requiredFields[reflect.TypeOf((*QueryPartitionCreateOptionsRequestType)(nil)).Elem()] = []string{"This", "StorageType", "DiagnosticType"}
+	requiredFields[reflect.TypeOf((*QueryPathSelectionPolicyOptions)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryPathSelectionPolicyOptionsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryPerf)(nil)).Elem()] = []string{"This", "QuerySpec"}
+	requiredFields[reflect.TypeOf((*QueryPerfComposite)(nil)).Elem()] = []string{"This", "QuerySpec"}
+	requiredFields[reflect.TypeOf((*QueryPerfCompositeRequestType)(nil)).Elem()] = []string{"This", "QuerySpec"}
+	requiredFields[reflect.TypeOf((*QueryPerfCompositeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryPerfCounter)(nil)).Elem()] = []string{"This", "CounterId"}
+	requiredFields[reflect.TypeOf((*QueryPerfCounterByLevel)(nil)).Elem()] = []string{"This", "Level"}
+	requiredFields[reflect.TypeOf((*QueryPerfCounterByLevelRequestType)(nil)).Elem()] = []string{"This", "Level"}
+	requiredFields[reflect.TypeOf((*QueryPerfCounterByLevelResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryPerfCounterRequestType)(nil)).Elem()] = []string{"This", "CounterId"}
+	requiredFields[reflect.TypeOf((*QueryPerfProviderSummary)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*QueryPerfProviderSummaryRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*QueryPerfProviderSummaryResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryPerfRequestType)(nil)).Elem()] = []string{"This", "QuerySpec"}
+	requiredFields[reflect.TypeOf((*QueryPhysicalVsanDisks)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryPhysicalVsanDisksRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryPhysicalVsanDisksResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryPnicStatus)(nil)).Elem()] = []string{"This", "PnicDevice"}
+	requiredFields[reflect.TypeOf((*QueryPnicStatusRequestType)(nil)).Elem()] = []string{"This", "PnicDevice"}
+	requiredFields[reflect.TypeOf((*QueryPnicStatusResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryPolicyMetadata)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryPolicyMetadataRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryProductLockerLocation)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryProductLockerLocationRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryProductLockerLocationResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryProfileStructure)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryProfileStructureRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryProfileStructureResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryProviderList)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryProviderListRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryProviderName)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*QueryProviderNameRequestType)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*QueryProviderNameResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryResourceConfigOption)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryResourceConfigOptionRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryResourceConfigOptionResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryServiceList)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryServiceListRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryStorageArrayTypePolicyOptions)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryStorageArrayTypePolicyOptionsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QuerySupportedFeatures)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QuerySupportedFeaturesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QuerySupportedNetworkOffloadSpec)(nil)).Elem()] = []string{"This", "SwitchProductSpec"}
+	requiredFields[reflect.TypeOf((*QuerySupportedNetworkOffloadSpecRequestType)(nil)).Elem()] = []string{"This", "SwitchProductSpec"}
+	requiredFields[reflect.TypeOf((*QuerySyncingVsanObjects)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QuerySyncingVsanObjectsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QuerySyncingVsanObjectsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QuerySystemUsers)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QuerySystemUsersRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryTargetCapabilities)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryTargetCapabilitiesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryTpmAttestationReport)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryTpmAttestationReportRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryUnmonitoredHosts)(nil)).Elem()] = []string{"This", "ProviderId", "Cluster"}
+	requiredFields[reflect.TypeOf((*QueryUnmonitoredHostsRequestType)(nil)).Elem()] = []string{"This", "ProviderId", "Cluster"}
+	requiredFields[reflect.TypeOf((*QueryUnownedFiles)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryUnownedFilesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryUnresolvedVmfsVolume)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryUnresolvedVmfsVolumeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryUnresolvedVmfsVolumes)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryUnresolvedVmfsVolumesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryUsedVlanIdInDvs)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryUsedVlanIdInDvsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryVMotionCompatibility)(nil)).Elem()] = []string{"This", "Vm", "Host"}
+	requiredFields[reflect.TypeOf((*QueryVMotionCompatibilityExRequestType)(nil)).Elem()] = []string{"This", "Vm", "Host"}
+	requiredFields[reflect.TypeOf((*QueryVMotionCompatibilityEx_Task)(nil)).Elem()] = []string{"This", "Vm", "Host"}
+	requiredFields[reflect.TypeOf((*QueryVMotionCompatibilityEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryVMotionCompatibilityRequestType)(nil)).Elem()] = []string{"This", "Vm", "Host"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskFragmentation)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskFragmentationRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskFragmentationResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskGeometry)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskGeometryRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskGeometryResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskUuid)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskUuidEx)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskUuidExRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskUuidExResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskUuidRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*QueryVirtualDiskUuidResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryVmfsConfigOption)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryVmfsConfigOptionRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryVmfsDatastoreCreateOptions)(nil)).Elem()] = []string{"This", "DevicePath"}
+	requiredFields[reflect.TypeOf((*QueryVmfsDatastoreCreateOptionsRequestType)(nil)).Elem()] = []string{"This", "DevicePath"}
+	requiredFields[reflect.TypeOf((*QueryVmfsDatastoreExpandOptions)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*QueryVmfsDatastoreExpandOptionsRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*QueryVmfsDatastoreExtendOptions)(nil)).Elem()] = []string{"This", "Datastore", "DevicePath"}
+	requiredFields[reflect.TypeOf((*QueryVmfsDatastoreExtendOptionsRequestType)(nil)).Elem()] = []string{"This", "Datastore", "DevicePath"}
+	requiredFields[reflect.TypeOf((*QueryVnicStatus)(nil)).Elem()] = []string{"This", "VnicDevice"}
+	requiredFields[reflect.TypeOf((*QueryVnicStatusRequestType)(nil)).Elem()] = []string{"This", "VnicDevice"}
+	requiredFields[reflect.TypeOf((*QueryVnicStatusResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryVsanObjectUuidsByFilter)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryVsanObjectUuidsByFilterRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryVsanObjects)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryVsanObjectsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*QueryVsanObjectsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryVsanStatistics)(nil)).Elem()] = []string{"This", "Labels"}
+	requiredFields[reflect.TypeOf((*QueryVsanStatisticsRequestType)(nil)).Elem()] = []string{"This", "Labels"}
+	requiredFields[reflect.TypeOf((*QueryVsanStatisticsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QueryVsanUpgradeStatus)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*QueryVsanUpgradeStatusRequestType)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*QueryVsanUpgradeStatusResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*QuestionPending)(nil)).Elem()] = []string{"Text"}
+	requiredFields[reflect.TypeOf((*QuestionPendingFault)(nil)).Elem()] = []string{"Text"}
+	requiredFields[reflect.TypeOf((*QuiesceDatastoreIOForHAFailed)(nil)).Elem()] = []string{"Host", "HostName", "Ds", "DsName"}
+	requiredFields[reflect.TypeOf((*QuiesceDatastoreIOForHAFailedFault)(nil)).Elem()] = []string{"Host", "HostName", "Ds", "DsName"}
+	requiredFields[reflect.TypeOf((*RDMConversionNotSupported)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*RDMConversionNotSupportedFault)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*RDMNotPreserved)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*RDMNotPreservedFault)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*RDMNotSupportedOnDatastore)(nil)).Elem()] = []string{"Device", "Datastore", "DatastoreName"}
+	requiredFields[reflect.TypeOf((*RDMNotSupportedOnDatastoreFault)(nil)).Elem()] = []string{"Device", "Datastore", "DatastoreName"}
+	requiredFields[reflect.TypeOf((*ReadEnvironmentVariableInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ReadEnvironmentVariableInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ReadNextEvents)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*ReadNextEventsRequestType)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*ReadNextTasks)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*ReadNextTasksRequestType)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*ReadOnlyDisksWithLegacyDestination)(nil)).Elem()] = []string{"RoDiskCount", "TimeoutDanger"}
+	requiredFields[reflect.TypeOf((*ReadOnlyDisksWithLegacyDestinationFault)(nil)).Elem()] = []string{"RoDiskCount", "TimeoutDanger"}
+	requiredFields[reflect.TypeOf((*ReadPreviousEvents)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*ReadPreviousEventsRequestType)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*ReadPreviousTasks)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*ReadPreviousTasksRequestType)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*RebootGuest)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RebootGuestRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RebootHostRequestType)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*RebootHost_Task)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*RebootHost_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RecommendDatastores)(nil)).Elem()] = []string{"This", "StorageSpec"}
+	requiredFields[reflect.TypeOf((*RecommendDatastoresRequestType)(nil)).Elem()] = []string{"This", "StorageSpec"}
+	requiredFields[reflect.TypeOf((*RecommendDatastoresResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RecommendHostsForVm)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*RecommendHostsForVmRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*RecommissionVsanNodeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RecommissionVsanNode_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RecommissionVsanNode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconcileDatastoreInventoryRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*ReconcileDatastoreInventory_Task)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*ReconcileDatastoreInventory_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigVMRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigVM_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigurationSatisfiable)(nil)).Elem()] = []string{"This", "Pcbs"}
+	requiredFields[reflect.TypeOf((*ReconfigurationSatisfiableRequestType)(nil)).Elem()] = []string{"This", "Pcbs"}
+	requiredFields[reflect.TypeOf((*ReconfigurationSatisfiableResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigureAlarm)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureAlarmRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureAutostart)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureAutostartRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureClusterRequestType)(nil)).Elem()] = []string{"This", "Spec", "Modify"}
+	requiredFields[reflect.TypeOf((*ReconfigureCluster_Task)(nil)).Elem()] = []string{"This", "Spec", "Modify"}
+	requiredFields[reflect.TypeOf((*ReconfigureCluster_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigureComputeResourceRequestType)(nil)).Elem()] = []string{"This", "Spec", "Modify"}
+	requiredFields[reflect.TypeOf((*ReconfigureComputeResource_Task)(nil)).Elem()] = []string{"This", "Spec", "Modify"}
+	requiredFields[reflect.TypeOf((*ReconfigureComputeResource_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigureDVPortRequestType)(nil)).Elem()] = []string{"This", "Port"}
+	requiredFields[reflect.TypeOf((*ReconfigureDVPort_Task)(nil)).Elem()] = []string{"This", "Port"}
+	requiredFields[reflect.TypeOf((*ReconfigureDVPort_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigureDVPortgroupRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureDVPortgroup_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureDVPortgroup_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigureDatacenterRequestType)(nil)).Elem()] = []string{"This", "Spec", "Modify"}
+	requiredFields[reflect.TypeOf((*ReconfigureDatacenter_Task)(nil)).Elem()] = []string{"This", "Spec", "Modify"}
+	requiredFields[reflect.TypeOf((*ReconfigureDatacenter_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigureDomObject)(nil)).Elem()] = []string{"This", "Uuid", "Policy"}
+	requiredFields[reflect.TypeOf((*ReconfigureDomObjectRequestType)(nil)).Elem()] = []string{"This", "Uuid", "Policy"}
+	requiredFields[reflect.TypeOf((*ReconfigureDvsRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureDvs_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureDvs_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigureHostForDASRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ReconfigureHostForDAS_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ReconfigureHostForDAS_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReconfigureScheduledTask)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureScheduledTaskRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureServiceConsoleReservation)(nil)).Elem()] = []string{"This", "CfgBytes"}
+	requiredFields[reflect.TypeOf((*ReconfigureServiceConsoleReservationRequestType)(nil)).Elem()] = []string{"This", "CfgBytes"}
+	requiredFields[reflect.TypeOf((*ReconfigureSnmpAgent)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureSnmpAgentRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureVirtualMachineReservation)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconfigureVirtualMachineReservationRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*ReconnectHostRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ReconnectHost_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ReconnectHost_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RecoveryEvent)(nil)).Elem()] = []string{"HostName", "PortKey"}
+	requiredFields[reflect.TypeOf((*RectifyDvsHostRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RectifyDvsHost_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RectifyDvsHost_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RectifyDvsOnHostRequestType)(nil)).Elem()] = []string{"This", "Hosts"}
+	requiredFields[reflect.TypeOf((*RectifyDvsOnHost_Task)(nil)).Elem()] = []string{"This", "Hosts"}
+	requiredFields[reflect.TypeOf((*RectifyDvsOnHost_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RecurrentTaskScheduler)(nil)).Elem()] = []string{"Interval"}
+	requiredFields[reflect.TypeOf((*Refresh)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshDVPortState)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshDVPortStateRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshDatastore)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshDatastoreRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshDatastoreStorageInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshDatastoreStorageInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshDateTimeSystem)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshDateTimeSystemRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshFirewall)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshFirewallRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshGraphicsManager)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshGraphicsManagerRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshHealthStatusSystem)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshHealthStatusSystemRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshNetworkSystem)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshNetworkSystemRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshRecommendation)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshRecommendationRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshRuntime)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshRuntimeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshServices)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshServicesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshStorageDrsRecommendation)(nil)).Elem()] = []string{"This", "Pod"}
+	requiredFields[reflect.TypeOf((*RefreshStorageDrsRecommendationRequestType)(nil)).Elem()] = []string{"This", "Pod"}
+	requiredFields[reflect.TypeOf((*RefreshStorageDrsRecommendationsForPodRequestType)(nil)).Elem()] = []string{"This", "Pod"}
+	requiredFields[reflect.TypeOf((*RefreshStorageDrsRecommendationsForPod_Task)(nil)).Elem()] = []string{"This", "Pod"}
+	requiredFields[reflect.TypeOf((*RefreshStorageDrsRecommendationsForPod_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RefreshStorageInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshStorageInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshStorageSystem)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RefreshStorageSystemRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RegisterChildVMRequestType)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*RegisterChildVM_Task)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*RegisterChildVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RegisterDisk)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*RegisterDiskRequestType)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*RegisterDiskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RegisterExtension)(nil)).Elem()] = []string{"This", "Extension"}
+	requiredFields[reflect.TypeOf((*RegisterExtensionRequestType)(nil)).Elem()] = []string{"This", "Extension"}
+	requiredFields[reflect.TypeOf((*RegisterHealthUpdateProvider)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*RegisterHealthUpdateProviderRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*RegisterHealthUpdateProviderResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RegisterKmipServer)(nil)).Elem()] = []string{"This", "Server"}
+	requiredFields[reflect.TypeOf((*RegisterKmipServerRequestType)(nil)).Elem()] = []string{"This", "Server"}
+	requiredFields[reflect.TypeOf((*RegisterKmsCluster)(nil)).Elem()] = []string{"This", "ClusterId"}
+	requiredFields[reflect.TypeOf((*RegisterKmsClusterRequestType)(nil)).Elem()] = []string{"This", "ClusterId"}
+	requiredFields[reflect.TypeOf((*RegisterVMRequestType)(nil)).Elem()] = []string{"This", "Path", "AsTemplate"}
+	requiredFields[reflect.TypeOf((*RegisterVM_Task)(nil)).Elem()] = []string{"This", "Path", "AsTemplate"}
+	requiredFields[reflect.TypeOf((*RegisterVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*Relation)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*ReleaseCredentialsInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ReleaseCredentialsInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ReleaseIpAllocation)(nil)).Elem()] = []string{"This", "Dc", "PoolId", "AllocationId"}
+	requiredFields[reflect.TypeOf((*ReleaseIpAllocationRequestType)(nil)).Elem()] = []string{"This", "Dc", "PoolId", "AllocationId"}
+	requiredFields[reflect.TypeOf((*ReleaseManagedSnapshot)(nil)).Elem()] = []string{"This", "Vdisk"}
+	requiredFields[reflect.TypeOf((*ReleaseManagedSnapshotRequestType)(nil)).Elem()] = []string{"This", "Vdisk"}
+	requiredFields[reflect.TypeOf((*Reload)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ReloadRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ReloadVirtualMachineFromPathRequestType)(nil)).Elem()] = []string{"This", "ConfigurationPath"}
+	requiredFields[reflect.TypeOf((*ReloadVirtualMachineFromPath_Task)(nil)).Elem()] = []string{"This", "ConfigurationPath"}
+	requiredFields[reflect.TypeOf((*ReloadVirtualMachineFromPath_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RelocateVMRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*RelocateVM_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*RelocateVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RelocateVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*RelocateVStorageObject_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Spec"}
+	requiredFields[reflect.TypeOf((*RelocateVStorageObject_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RemoveAlarm)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RemoveAlarmRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RemoveAllSnapshotsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RemoveAllSnapshots_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RemoveAllSnapshots_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RemoveAssignedLicense)(nil)).Elem()] = []string{"This", "EntityId"}
+	requiredFields[reflect.TypeOf((*RemoveAssignedLicenseRequestType)(nil)).Elem()] = []string{"This", "EntityId"}
+	requiredFields[reflect.TypeOf((*RemoveAuthorizationRole)(nil)).Elem()] = []string{"This", "RoleId", "FailIfUsed"}
+	requiredFields[reflect.TypeOf((*RemoveAuthorizationRoleRequestType)(nil)).Elem()] = []string{"This", "RoleId", "FailIfUsed"}
+	requiredFields[reflect.TypeOf((*RemoveCustomFieldDef)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*RemoveCustomFieldDefRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*RemoveDatastore)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*RemoveDatastoreExRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*RemoveDatastoreEx_Task)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*RemoveDatastoreEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RemoveDatastoreRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*RemoveDiskMappingRequestType)(nil)).Elem()] = []string{"This", "Mapping"}
+	requiredFields[reflect.TypeOf((*RemoveDiskMapping_Task)(nil)).Elem()] = []string{"This", "Mapping"}
+	requiredFields[reflect.TypeOf((*RemoveDiskMapping_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RemoveDiskRequestType)(nil)).Elem()] = []string{"This", "Disk"}
+	requiredFields[reflect.TypeOf((*RemoveDisk_Task)(nil)).Elem()] = []string{"This", "Disk"}
+	requiredFields[reflect.TypeOf((*RemoveDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RemoveEntityPermission)(nil)).Elem()] = []string{"This", "Entity", "User", "IsGroup"}
+	requiredFields[reflect.TypeOf((*RemoveEntityPermissionRequestType)(nil)).Elem()] = []string{"This", "Entity", "User", "IsGroup"}
+	requiredFields[reflect.TypeOf((*RemoveFilter)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*RemoveFilterEntities)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*RemoveFilterEntitiesRequestType)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*RemoveFilterRequestType)(nil)).Elem()] = []string{"This", "FilterId"}
+	requiredFields[reflect.TypeOf((*RemoveGroup)(nil)).Elem()] = []string{"This", "GroupName"}
+	requiredFields[reflect.TypeOf((*RemoveGroupRequestType)(nil)).Elem()] = []string{"This", "GroupName"}
+	requiredFields[reflect.TypeOf((*RemoveGuestAlias)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Username", "Base64Cert", "Subject"}
+	requiredFields[reflect.TypeOf((*RemoveGuestAliasByCert)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Username", "Base64Cert"}
+	requiredFields[reflect.TypeOf((*RemoveGuestAliasByCertRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Username", "Base64Cert"}
+	requiredFields[reflect.TypeOf((*RemoveGuestAliasRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Username", "Base64Cert", "Subject"}
+	requiredFields[reflect.TypeOf((*RemoveInternetScsiSendTargets)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Targets"}
+	requiredFields[reflect.TypeOf((*RemoveInternetScsiSendTargetsRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Targets"}
+	requiredFields[reflect.TypeOf((*RemoveInternetScsiStaticTargets)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Targets"}
+	requiredFields[reflect.TypeOf((*RemoveInternetScsiStaticTargetsRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Targets"}
+	requiredFields[reflect.TypeOf((*RemoveKey)(nil)).Elem()] = []string{"This", "Key", "Force"}
+	requiredFields[reflect.TypeOf((*RemoveKeyRequestType)(nil)).Elem()] = []string{"This", "Key", "Force"}
+	requiredFields[reflect.TypeOf((*RemoveKeys)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*RemoveKeysRequestType)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*RemoveKmipServer)(nil)).Elem()] = []string{"This", "ClusterId", "ServerName"}
+	requiredFields[reflect.TypeOf((*RemoveKmipServerRequestType)(nil)).Elem()] = []string{"This", "ClusterId", "ServerName"}
+	requiredFields[reflect.TypeOf((*RemoveLicense)(nil)).Elem()] = []string{"This", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*RemoveLicenseLabel)(nil)).Elem()] = []string{"This", "LicenseKey", "LabelKey"}
+	requiredFields[reflect.TypeOf((*RemoveLicenseLabelRequestType)(nil)).Elem()] = []string{"This", "LicenseKey", "LabelKey"}
+	requiredFields[reflect.TypeOf((*RemoveLicenseRequestType)(nil)).Elem()] = []string{"This", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*RemoveMonitoredEntities)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*RemoveMonitoredEntitiesRequestType)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*RemoveNetworkResourcePool)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*RemoveNetworkResourcePoolRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*RemoveNvmeOverRdmaAdapter)(nil)).Elem()] = []string{"This", "HbaDeviceName"}
+	requiredFields[reflect.TypeOf((*RemoveNvmeOverRdmaAdapterRequestType)(nil)).Elem()] = []string{"This", "HbaDeviceName"}
+	requiredFields[reflect.TypeOf((*RemovePerfInterval)(nil)).Elem()] = []string{"This", "SamplePeriod"}
+	requiredFields[reflect.TypeOf((*RemovePerfIntervalRequestType)(nil)).Elem()] = []string{"This", "SamplePeriod"}
+	requiredFields[reflect.TypeOf((*RemovePortGroup)(nil)).Elem()] = []string{"This", "PgName"}
+	requiredFields[reflect.TypeOf((*RemovePortGroupRequestType)(nil)).Elem()] = []string{"This", "PgName"}
+	requiredFields[reflect.TypeOf((*RemoveScheduledTask)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RemoveScheduledTaskRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RemoveServiceConsoleVirtualNic)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*RemoveServiceConsoleVirtualNicRequestType)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*RemoveSmartCardTrustAnchor)(nil)).Elem()] = []string{"This", "Issuer", "Serial"}
+	requiredFields[reflect.TypeOf((*RemoveSmartCardTrustAnchorByFingerprint)(nil)).Elem()] = []string{"This", "Fingerprint", "Digest"}
+	requiredFields[reflect.TypeOf((*RemoveSmartCardTrustAnchorByFingerprintRequestType)(nil)).Elem()] = []string{"This", "Fingerprint", "Digest"}
+	requiredFields[reflect.TypeOf((*RemoveSmartCardTrustAnchorRequestType)(nil)).Elem()] = []string{"This", "Issuer", "Serial"}
+	requiredFields[reflect.TypeOf((*RemoveSnapshotRequestType)(nil)).Elem()] = []string{"This", "RemoveChildren"}
+	requiredFields[reflect.TypeOf((*RemoveSnapshot_Task)(nil)).Elem()] = []string{"This", "RemoveChildren"}
+	requiredFields[reflect.TypeOf((*RemoveSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RemoveSoftwareAdapter)(nil)).Elem()] = []string{"This", "HbaDeviceName"}
+	requiredFields[reflect.TypeOf((*RemoveSoftwareAdapterRequestType)(nil)).Elem()] = []string{"This", "HbaDeviceName"}
+	requiredFields[reflect.TypeOf((*RemoveUser)(nil)).Elem()] = []string{"This", "UserName"}
+	requiredFields[reflect.TypeOf((*RemoveUserRequestType)(nil)).Elem()] = []string{"This", "UserName"}
+	requiredFields[reflect.TypeOf((*RemoveVirtualNic)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*RemoveVirtualNicRequestType)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*RemoveVirtualSwitch)(nil)).Elem()] = []string{"This", "VswitchName"}
+	requiredFields[reflect.TypeOf((*RemoveVirtualSwitchRequestType)(nil)).Elem()] = []string{"This", "VswitchName"}
+	requiredFields[reflect.TypeOf((*RenameCustomFieldDef)(nil)).Elem()] = []string{"This", "Key", "Name"}
+	requiredFields[reflect.TypeOf((*RenameCustomFieldDefRequestType)(nil)).Elem()] = []string{"This", "Key", "Name"}
+	requiredFields[reflect.TypeOf((*RenameCustomizationSpec)(nil)).Elem()] = []string{"This", "Name", "NewName"}
+	requiredFields[reflect.TypeOf((*RenameCustomizationSpecRequestType)(nil)).Elem()] = []string{"This", "Name", "NewName"}
+	requiredFields[reflect.TypeOf((*RenameDatastore)(nil)).Elem()] = []string{"This", "NewName"}
+	requiredFields[reflect.TypeOf((*RenameDatastoreRequestType)(nil)).Elem()] = []string{"This", "NewName"}
+	requiredFields[reflect.TypeOf((*RenameRequestType)(nil)).Elem()] = []string{"This", "NewName"}
+	requiredFields[reflect.TypeOf((*RenameSnapshot)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RenameSnapshotRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RenameVStorageObject)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Name"}
+	requiredFields[reflect.TypeOf((*RenameVStorageObjectEx)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Name"}
+	requiredFields[reflect.TypeOf((*RenameVStorageObjectExRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Name"}
+	requiredFields[reflect.TypeOf((*RenameVStorageObjectExResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RenameVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Name"}
+	requiredFields[reflect.TypeOf((*Rename_Task)(nil)).Elem()] = []string{"This", "NewName"}
+	requiredFields[reflect.TypeOf((*Rename_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ReplaceCACertificatesAndCRLs)(nil)).Elem()] = []string{"This", "CaCert"}
+	requiredFields[reflect.TypeOf((*ReplaceCACertificatesAndCRLsRequestType)(nil)).Elem()] = []string{"This", "CaCert"}
+	requiredFields[reflect.TypeOf((*ReplaceSmartCardTrustAnchors)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ReplaceSmartCardTrustAnchorsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ReplicationConfigSpec)(nil)).Elem()] = []string{"Generation", "VmReplicationId", "Destination", "Port", "Rpo", "QuiesceGuestEnabled", "Paused", "OppUpdatesEnabled"}
+	requiredFields[reflect.TypeOf((*ReplicationGroupId)(nil)).Elem()] = []string{"FaultDomainId", "DeviceGroupId"}
+	requiredFields[reflect.TypeOf((*ReplicationInfoDiskSettings)(nil)).Elem()] = []string{"Key", "DiskReplicationId"}
+	requiredFields[reflect.TypeOf((*ReplicationInvalidOptions)(nil)).Elem()] = []string{"Options"}
+	requiredFields[reflect.TypeOf((*ReplicationInvalidOptionsFault)(nil)).Elem()] = []string{"Options"}
+	requiredFields[reflect.TypeOf((*ReplicationSpec)(nil)).Elem()] = []string{"ReplicationGroupId"}
+	requiredFields[reflect.TypeOf((*ReplicationVmFault)(nil)).Elem()] = []string{"Reason", "Vm"}
+	requiredFields[reflect.TypeOf((*ReplicationVmInProgressFault)(nil)).Elem()] = []string{"RequestedActivity", "InProgressActivity"}
+	requiredFields[reflect.TypeOf((*ReplicationVmInProgressFaultFault)(nil)).Elem()] = []string{"RequestedActivity", "InProgressActivity"}
+	requiredFields[reflect.TypeOf((*ReplicationVmProgressInfo)(nil)).Elem()] = []string{"Progress", "BytesTransferred", "BytesToTransfer"}
+	requiredFields[reflect.TypeOf((*RescanAllHba)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RescanAllHbaRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RescanHba)(nil)).Elem()] = []string{"This", "HbaDevice"}
+	requiredFields[reflect.TypeOf((*RescanHbaRequestType)(nil)).Elem()] = []string{"This", "HbaDevice"}
+	requiredFields[reflect.TypeOf((*RescanVffs)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RescanVffsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RescanVmfs)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RescanVmfsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetCollector)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetCollectorRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetCounterLevelMapping)(nil)).Elem()] = []string{"This", "Counters"}
+	requiredFields[reflect.TypeOf((*ResetCounterLevelMappingRequestType)(nil)).Elem()] = []string{"This", "Counters"}
+	requiredFields[reflect.TypeOf((*ResetEntityPermissions)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*ResetEntityPermissionsRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*ResetFirmwareToFactoryDefaults)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetFirmwareToFactoryDefaultsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetGuestInformation)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetGuestInformationRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetListView)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetListViewFromView)(nil)).Elem()] = []string{"This", "View"}
+	requiredFields[reflect.TypeOf((*ResetListViewFromViewRequestType)(nil)).Elem()] = []string{"This", "View"}
+	requiredFields[reflect.TypeOf((*ResetListViewRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetSystemHealthInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetSystemHealthInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetVM_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ResetVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ResignatureUnresolvedVmfsVolumeRequestType)(nil)).Elem()] = []string{"This", "ResolutionSpec"}
+	requiredFields[reflect.TypeOf((*ResignatureUnresolvedVmfsVolume_Task)(nil)).Elem()] = []string{"This", "ResolutionSpec"}
+	requiredFields[reflect.TypeOf((*ResignatureUnresolvedVmfsVolume_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ResolveInstallationErrorsOnClusterRequestType)(nil)).Elem()] = []string{"This", "FilterId", "Cluster"}
+	requiredFields[reflect.TypeOf((*ResolveInstallationErrorsOnCluster_Task)(nil)).Elem()] = []string{"This", "FilterId", "Cluster"}
+	requiredFields[reflect.TypeOf((*ResolveInstallationErrorsOnCluster_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ResolveInstallationErrorsOnHostRequestType)(nil)).Elem()] = []string{"This", "FilterId", "Host"}
+	requiredFields[reflect.TypeOf((*ResolveInstallationErrorsOnHost_Task)(nil)).Elem()] = []string{"This", "FilterId", "Host"}
+	requiredFields[reflect.TypeOf((*ResolveInstallationErrorsOnHost_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ResolveMultipleUnresolvedVmfsVolumes)(nil)).Elem()] = []string{"This", "ResolutionSpec"}
+	requiredFields[reflect.TypeOf((*ResolveMultipleUnresolvedVmfsVolumesExRequestType)(nil)).Elem()] = []string{"This", "ResolutionSpec"}
+	requiredFields[reflect.TypeOf((*ResolveMultipleUnresolvedVmfsVolumesEx_Task)(nil)).Elem()] = []string{"This", "ResolutionSpec"}
+	requiredFields[reflect.TypeOf((*ResolveMultipleUnresolvedVmfsVolumesEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ResolveMultipleUnresolvedVmfsVolumesRequestType)(nil)).Elem()] = []string{"This", "ResolutionSpec"}
+	requiredFields[reflect.TypeOf((*ResourceAllocationOption)(nil)).Elem()] = []string{"SharesOption"}
+	requiredFields[reflect.TypeOf((*ResourceConfigOption)(nil)).Elem()] = []string{"CpuAllocationOption", "MemoryAllocationOption"}
+	requiredFields[reflect.TypeOf((*ResourceConfigSpec)(nil)).Elem()] = []string{"CpuAllocation", "MemoryAllocation"}
+	requiredFields[reflect.TypeOf((*ResourcePoolCreatedEvent)(nil)).Elem()] = []string{"Parent"}
+	requiredFields[reflect.TypeOf((*ResourcePoolEvent)(nil)).Elem()] = []string{"ResourcePool"}
+	requiredFields[reflect.TypeOf((*ResourcePoolEventArgument)(nil)).Elem()] = []string{"ResourcePool"}
+	requiredFields[reflect.TypeOf((*ResourcePoolMovedEvent)(nil)).Elem()] = []string{"OldParent", "NewParent"}
+	requiredFields[reflect.TypeOf((*ResourcePoolResourceUsage)(nil)).Elem()] = []string{"ReservationUsed", "ReservationUsedForVm", "UnreservedForPool", "UnreservedForVm", "OverallUsage", "MaxUsage"}
+	requiredFields[reflect.TypeOf((*ResourcePoolRuntimeInfo)(nil)).Elem()] = []string{"Memory", "Cpu", "OverallStatus"}
+	requiredFields[reflect.TypeOf((*ResourcePoolSummary)(nil)).Elem()] = []string{"Name", "Config", "Runtime"}
+	requiredFields[reflect.TypeOf((*RestartService)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*RestartServiceConsoleVirtualNic)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*RestartServiceConsoleVirtualNicRequestType)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*RestartServiceRequestType)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*RestoreFirmwareConfiguration)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*RestoreFirmwareConfigurationRequestType)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*RestrictedByAdministrator)(nil)).Elem()] = []string{"Details"}
+	requiredFields[reflect.TypeOf((*RestrictedByAdministratorFault)(nil)).Elem()] = []string{"Details"}
+	requiredFields[reflect.TypeOf((*RetrieveAllPermissions)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveAllPermissionsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveAnswerFile)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*RetrieveAnswerFileForProfile)(nil)).Elem()] = []string{"This", "Host", "ApplyProfile"}
+	requiredFields[reflect.TypeOf((*RetrieveAnswerFileForProfileRequestType)(nil)).Elem()] = []string{"This", "Host", "ApplyProfile"}
+	requiredFields[reflect.TypeOf((*RetrieveAnswerFileRequestType)(nil)).Elem()] = []string{"This", "Host"}
+	requiredFields[reflect.TypeOf((*RetrieveArgumentDescription)(nil)).Elem()] = []string{"This", "EventTypeId"}
+	requiredFields[reflect.TypeOf((*RetrieveArgumentDescriptionRequestType)(nil)).Elem()] = []string{"This", "EventTypeId"}
+	requiredFields[reflect.TypeOf((*RetrieveCertificateInfoList)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveCertificateInfoListRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveClientCert)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*RetrieveClientCertRequestType)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*RetrieveClientCertResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveClientCsr)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*RetrieveClientCsrRequestType)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*RetrieveClientCsrResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveDasAdvancedRuntimeInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveDasAdvancedRuntimeInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveDescription)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveDescriptionRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveDiskPartitionInfo)(nil)).Elem()] = []string{"This", "DevicePath"}
+	requiredFields[reflect.TypeOf((*RetrieveDiskPartitionInfoRequestType)(nil)).Elem()] = []string{"This", "DevicePath"}
+	requiredFields[reflect.TypeOf((*RetrieveDynamicPassthroughInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveDynamicPassthroughInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveEntityPermissions)(nil)).Elem()] = []string{"This", "Entity", "Inherited"}
+	requiredFields[reflect.TypeOf((*RetrieveEntityPermissionsRequestType)(nil)).Elem()] = []string{"This", "Entity", "Inherited"}
+	requiredFields[reflect.TypeOf((*RetrieveEntityScheduledTask)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveEntityScheduledTaskRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveFreeEpcMemory)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveFreeEpcMemoryRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveFreeEpcMemoryResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveHardwareUptime)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveHardwareUptimeRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveHardwareUptimeResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveHostAccessControlEntries)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveHostAccessControlEntriesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveHostCustomizations)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveHostCustomizationsForProfile)(nil)).Elem()] = []string{"This", "ApplyProfile"}
+	requiredFields[reflect.TypeOf((*RetrieveHostCustomizationsForProfileRequestType)(nil)).Elem()] = []string{"This", "ApplyProfile"}
+	requiredFields[reflect.TypeOf((*RetrieveHostCustomizationsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveHostSpecification)(nil)).Elem()] = []string{"This", "Host", "FromHost"}
+	requiredFields[reflect.TypeOf((*RetrieveHostSpecificationRequestType)(nil)).Elem()] = []string{"This", "Host", "FromHost"}
+	requiredFields[reflect.TypeOf((*RetrieveHostSpecificationResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveKmipServerCert)(nil)).Elem()] = []string{"This", "KeyProvider", "Server"}
+	requiredFields[reflect.TypeOf((*RetrieveKmipServerCertRequestType)(nil)).Elem()] = []string{"This", "KeyProvider", "Server"}
+	requiredFields[reflect.TypeOf((*RetrieveKmipServerCertResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveKmipServersStatusRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveKmipServersStatus_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveKmipServersStatus_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveObjectScheduledTask)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveObjectScheduledTaskRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveProductComponents)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveProductComponentsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveProperties)(nil)).Elem()] = []string{"This", "SpecSet"}
+	requiredFields[reflect.TypeOf((*RetrievePropertiesEx)(nil)).Elem()] = []string{"This", "SpecSet", "Options"}
+	requiredFields[reflect.TypeOf((*RetrievePropertiesExRequestType)(nil)).Elem()] = []string{"This", "SpecSet", "Options"}
+	requiredFields[reflect.TypeOf((*RetrievePropertiesRequestType)(nil)).Elem()] = []string{"This", "SpecSet"}
+	requiredFields[reflect.TypeOf((*RetrieveResult)(nil)).Elem()] = []string{"Objects"}
+	requiredFields[reflect.TypeOf((*RetrieveRolePermissions)(nil)).Elem()] = []string{"This", "RoleId"}
+	requiredFields[reflect.TypeOf((*RetrieveRolePermissionsRequestType)(nil)).Elem()] = []string{"This", "RoleId"}
+	requiredFields[reflect.TypeOf((*RetrieveSelfSignedClientCert)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*RetrieveSelfSignedClientCertRequestType)(nil)).Elem()] = []string{"This", "Cluster"}
+	requiredFields[reflect.TypeOf((*RetrieveSelfSignedClientCertResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveServiceContent)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveServiceContentRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveServiceContentResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveServiceProviderEntities)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveServiceProviderEntitiesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveSnapshotDetails)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*RetrieveSnapshotDetailsRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*RetrieveSnapshotDetailsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveSnapshotInfo)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*RetrieveSnapshotInfoRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*RetrieveSnapshotInfoResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveUserGroups)(nil)).Elem()] = []string{"This", "SearchStr", "ExactMatch", "FindUsers", "FindGroups"}
+	requiredFields[reflect.TypeOf((*RetrieveUserGroupsRequestType)(nil)).Elem()] = []string{"This", "SearchStr", "ExactMatch", "FindUsers", "FindGroups"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageInfrastructureObjectPolicy)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageInfrastructureObjectPolicyRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageObjSpec)(nil)).Elem()] = []string{"Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageObject)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageObjectAssociations)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageObjectAssociationsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageObjectResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageObjectState)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageObjectStateRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*RetrieveVStorageObjectStateResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RetrieveVendorDeviceGroupInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveVendorDeviceGroupInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveVgpuDeviceInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveVgpuDeviceInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveVgpuProfileInfo)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RetrieveVgpuProfileInfoRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RevertToCurrentSnapshotRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RevertToCurrentSnapshot_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RevertToCurrentSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RevertToSnapshotRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RevertToSnapshot_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RevertToSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RevertVStorageObjectExRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*RevertVStorageObjectEx_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*RevertVStorageObjectEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RevertVStorageObjectRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*RevertVStorageObject_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*RevertVStorageObject_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*RewindCollector)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RewindCollectorRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RoleEvent)(nil)).Elem()] = []string{"Role"}
+	requiredFields[reflect.TypeOf((*RoleEventArgument)(nil)).Elem()] = []string{"RoleId", "Name"}
+	requiredFields[reflect.TypeOf((*RollbackEvent)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*RollbackFailure)(nil)).Elem()] = []string{"EntityName", "EntityType"}
+	requiredFields[reflect.TypeOf((*RollbackFailureFault)(nil)).Elem()] = []string{"EntityName", "EntityType"}
+	requiredFields[reflect.TypeOf((*RunScheduledTask)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RunScheduledTaskRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RunScriptAction)(nil)).Elem()] = []string{"Script"}
+	requiredFields[reflect.TypeOf((*RunVsanPhysicalDiskDiagnostics)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RunVsanPhysicalDiskDiagnosticsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*RunVsanPhysicalDiskDiagnosticsResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SAMLTokenAuthentication)(nil)).Elem()] = []string{"Token"}
+	requiredFields[reflect.TypeOf((*SSLVerifyFault)(nil)).Elem()] = []string{"SelfSigned"}
+	requiredFields[reflect.TypeOf((*SSLVerifyFaultFault)(nil)).Elem()] = []string{"SelfSigned"}
+	requiredFields[reflect.TypeOf((*SSPIAuthentication)(nil)).Elem()] = []string{"SspiToken"}
+	requiredFields[reflect.TypeOf((*SSPIChallenge)(nil)).Elem()] = []string{"Base64Token"}
+	requiredFields[reflect.TypeOf((*SSPIChallengeFault)(nil)).Elem()] = []string{"Base64Token"}
+	requiredFields[reflect.TypeOf((*ScanHostPatchRequestType)(nil)).Elem()] = []string{"This", "Repository"}
+	requiredFields[reflect.TypeOf((*ScanHostPatchV2RequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ScanHostPatchV2_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ScanHostPatchV2_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ScanHostPatch_Task)(nil)).Elem()] = []string{"This", "Repository"}
+	requiredFields[reflect.TypeOf((*ScanHostPatch_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ScheduleReconcileDatastoreInventory)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*ScheduleReconcileDatastoreInventoryRequestType)(nil)).Elem()] = []string{"This", "Datastore"}
+	requiredFields[reflect.TypeOf((*ScheduledTaskDescription)(nil)).Elem()] = []string{"Action", "SchedulerInfo", "State", "DayOfWeek", "WeekOfMonth"}
+	requiredFields[reflect.TypeOf((*ScheduledTaskDetail)(nil)).Elem()] = []string{"Frequency"}
+	requiredFields[reflect.TypeOf((*ScheduledTaskEmailCompletedEvent)(nil)).Elem()] = []string{"To"}
+	requiredFields[reflect.TypeOf((*ScheduledTaskEmailFailedEvent)(nil)).Elem()] = []string{"To", "Reason"}
+	requiredFields[reflect.TypeOf((*ScheduledTaskEvent)(nil)).Elem()] = []string{"ScheduledTask", "Entity"}
+	requiredFields[reflect.TypeOf((*ScheduledTaskEventArgument)(nil)).Elem()] = []string{"ScheduledTask"}
+	requiredFields[reflect.TypeOf((*ScheduledTaskFailedEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*ScheduledTaskInfo)(nil)).Elem()] = []string{"ScheduledTask", "Entity", "LastModifiedTime", "LastModifiedUser", "State"}
+	requiredFields[reflect.TypeOf((*ScheduledTaskSpec)(nil)).Elem()] = []string{"Name", "Description", "Enabled", "Scheduler", "Action"}
+	requiredFields[reflect.TypeOf((*ScsiLun)(nil)).Elem()] = []string{"Uuid", "LunType", "OperationalState"}
+	requiredFields[reflect.TypeOf((*ScsiLunCapabilities)(nil)).Elem()] = []string{"UpdateDisplayNameSupported"}
+	requiredFields[reflect.TypeOf((*ScsiLunDescriptor)(nil)).Elem()] = []string{"Quality", "Id"}
+	requiredFields[reflect.TypeOf((*ScsiLunDurableName)(nil)).Elem()] = []string{"Namespace", "NamespaceId"}
+	requiredFields[reflect.TypeOf((*SearchDatastoreRequestType)(nil)).Elem()] = []string{"This", "DatastorePath"}
+	requiredFields[reflect.TypeOf((*SearchDatastoreSubFoldersRequestType)(nil)).Elem()] = []string{"This", "DatastorePath"}
+	requiredFields[reflect.TypeOf((*SearchDatastoreSubFolders_Task)(nil)).Elem()] = []string{"This", "DatastorePath"}
+	requiredFields[reflect.TypeOf((*SearchDatastoreSubFolders_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SearchDatastore_Task)(nil)).Elem()] = []string{"This", "DatastorePath"}
+	requiredFields[reflect.TypeOf((*SearchDatastore_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SecondaryVmAlreadyDisabled)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*SecondaryVmAlreadyDisabledFault)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*SecondaryVmAlreadyEnabled)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*SecondaryVmAlreadyEnabledFault)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*SecondaryVmAlreadyRegistered)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*SecondaryVmAlreadyRegisteredFault)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*SecondaryVmNotRegistered)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*SecondaryVmNotRegisteredFault)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*SelectActivePartition)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SelectActivePartitionRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SelectVnic)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*SelectVnicForNicType)(nil)).Elem()] = []string{"This", "NicType", "Device"}
+	requiredFields[reflect.TypeOf((*SelectVnicForNicTypeRequestType)(nil)).Elem()] = []string{"This", "NicType", "Device"}
+	requiredFields[reflect.TypeOf((*SelectVnicRequestType)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*SendEmailAction)(nil)).Elem()] = []string{"ToList", "CcList", "Subject", "Body"}
+	requiredFields[reflect.TypeOf((*SendNMI)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SendNMIRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SendTestNotification)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SendTestNotificationRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ServerLicenseExpiredEvent)(nil)).Elem()] = []string{"Product"}
+	requiredFields[reflect.TypeOf((*ServiceConsolePortGroupProfile)(nil)).Elem()] = []string{"IpConfig"}
+	requiredFields[reflect.TypeOf((*ServiceConsoleReservationInfo)(nil)).Elem()] = []string{"ServiceConsoleReservedCfg", "ServiceConsoleReserved", "Unreserved"}
+	requiredFields[reflect.TypeOf((*ServiceContent)(nil)).Elem()] = []string{"RootFolder", "PropertyCollector", "About"}
+	requiredFields[reflect.TypeOf((*ServiceLocator)(nil)).Elem()] = []string{"InstanceUuid", "Url", "Credential"}
+	requiredFields[reflect.TypeOf((*ServiceLocatorNamePassword)(nil)).Elem()] = []string{"Username", "Password"}
+	requiredFields[reflect.TypeOf((*ServiceManagerServiceInfo)(nil)).Elem()] = []string{"ServiceName", "Service", "Description"}
+	requiredFields[reflect.TypeOf((*ServiceProfile)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*SessionIsActive)(nil)).Elem()] = []string{"This", "SessionID", "UserName"}
+	requiredFields[reflect.TypeOf((*SessionIsActiveRequestType)(nil)).Elem()] = []string{"This", "SessionID", "UserName"}
+	requiredFields[reflect.TypeOf((*SessionIsActiveResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SessionManagerGenericServiceTicket)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*SessionManagerHttpServiceRequestSpec)(nil)).Elem()] = []string{"Url"}
+	requiredFields[reflect.TypeOf((*SessionManagerLocalTicket)(nil)).Elem()] = []string{"UserName", "PasswordFilePath"}
+	requiredFields[reflect.TypeOf((*SessionManagerVmomiServiceRequestSpec)(nil)).Elem()] = []string{"Method"}
+	requiredFields[reflect.TypeOf((*SessionTerminatedEvent)(nil)).Elem()] = []string{"SessionId", "TerminatedUsername"}
+	requiredFields[reflect.TypeOf((*SetClusterModeRequestType)(nil)).Elem()] = []string{"This", "Mode"}
+	requiredFields[reflect.TypeOf((*SetClusterMode_Task)(nil)).Elem()] = []string{"This", "Mode"}
+	requiredFields[reflect.TypeOf((*SetClusterMode_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SetCollectorPageSize)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*SetCollectorPageSizeRequestType)(nil)).Elem()] = []string{"This", "MaxCount"}
+	requiredFields[reflect.TypeOf((*SetCryptoMode)(nil)).Elem()] = []string{"This", "CryptoMode"}
+	requiredFields[reflect.TypeOf((*SetCryptoModeRequestType)(nil)).Elem()] = []string{"This", "CryptoMode"}
+	requiredFields[reflect.TypeOf((*SetCustomValue)(nil)).Elem()] = []string{"This", "Key", "Value"}
+	requiredFields[reflect.TypeOf((*SetCustomValueRequestType)(nil)).Elem()] = []string{"This", "Key", "Value"}
+	requiredFields[reflect.TypeOf((*SetDefaultKmsCluster)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SetDefaultKmsClusterRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SetDisplayTopology)(nil)).Elem()] = []string{"This", "Displays"}
+	requiredFields[reflect.TypeOf((*SetDisplayTopologyRequestType)(nil)).Elem()] = []string{"This", "Displays"}
+	requiredFields[reflect.TypeOf((*SetEntityPermissions)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*SetEntityPermissionsRequestType)(nil)).Elem()] = []string{"This", "Entity"}
+	requiredFields[reflect.TypeOf((*SetExtensionCertificate)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*SetExtensionCertificateRequestType)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*SetField)(nil)).Elem()] = []string{"This", "Entity", "Key", "Value"}
+	requiredFields[reflect.TypeOf((*SetFieldRequestType)(nil)).Elem()] = []string{"This", "Entity", "Key", "Value"}
+	requiredFields[reflect.TypeOf((*SetKeyCustomAttributes)(nil)).Elem()] = []string{"This", "KeyId", "Spec"}
+	requiredFields[reflect.TypeOf((*SetKeyCustomAttributesRequestType)(nil)).Elem()] = []string{"This", "KeyId", "Spec"}
+	requiredFields[reflect.TypeOf((*SetKeyCustomAttributesResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SetLicenseEdition)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SetLicenseEditionRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SetLocale)(nil)).Elem()] = []string{"This", "Locale"}
+	requiredFields[reflect.TypeOf((*SetLocaleRequestType)(nil)).Elem()] = []string{"This", "Locale"}
+	requiredFields[reflect.TypeOf((*SetMaxQueueDepth)(nil)).Elem()] = []string{"This", "Datastore", "MaxQdepth"}
+	requiredFields[reflect.TypeOf((*SetMaxQueueDepthRequestType)(nil)).Elem()] = []string{"This", "Datastore", "MaxQdepth"}
+	requiredFields[reflect.TypeOf((*SetMultipathLunPolicy)(nil)).Elem()] = []string{"This", "LunId", "Policy"}
+	requiredFields[reflect.TypeOf((*SetMultipathLunPolicyRequestType)(nil)).Elem()] = []string{"This", "LunId", "Policy"}
+	requiredFields[reflect.TypeOf((*SetNFSUser)(nil)).Elem()] = []string{"This", "User", "Password"}
+	requiredFields[reflect.TypeOf((*SetNFSUserRequestType)(nil)).Elem()] = []string{"This", "User", "Password"}
+	requiredFields[reflect.TypeOf((*SetPublicKey)(nil)).Elem()] = []string{"This", "ExtensionKey", "PublicKey"}
+	requiredFields[reflect.TypeOf((*SetPublicKeyRequestType)(nil)).Elem()] = []string{"This", "ExtensionKey", "PublicKey"}
+	requiredFields[reflect.TypeOf((*SetRegistryValueInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Value"}
+	requiredFields[reflect.TypeOf((*SetRegistryValueInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Value"}
+	requiredFields[reflect.TypeOf((*SetScreenResolution)(nil)).Elem()] = []string{"This", "Width", "Height"}
+	requiredFields[reflect.TypeOf((*SetScreenResolutionRequestType)(nil)).Elem()] = []string{"This", "Width", "Height"}
+	requiredFields[reflect.TypeOf((*SetServiceAccount)(nil)).Elem()] = []string{"This", "ExtensionKey", "ServiceAccount"}
+	requiredFields[reflect.TypeOf((*SetServiceAccountRequestType)(nil)).Elem()] = []string{"This", "ExtensionKey", "ServiceAccount"}
+	requiredFields[reflect.TypeOf((*SetTaskDescription)(nil)).Elem()] = []string{"This", "Description"}
+	requiredFields[reflect.TypeOf((*SetTaskDescriptionRequestType)(nil)).Elem()] = []string{"This", "Description"}
+	requiredFields[reflect.TypeOf((*SetTaskState)(nil)).Elem()] = []string{"This", "State"}
+	requiredFields[reflect.TypeOf((*SetTaskStateRequestType)(nil)).Elem()] = []string{"This", "State"}
+	requiredFields[reflect.TypeOf((*SetVStorageObjectControlFlags)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*SetVStorageObjectControlFlagsRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*SetVirtualDiskUuid)(nil)).Elem()] = []string{"This", "Name", "Uuid"}
+	requiredFields[reflect.TypeOf((*SetVirtualDiskUuidExRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*SetVirtualDiskUuidEx_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*SetVirtualDiskUuidEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SetVirtualDiskUuidRequestType)(nil)).Elem()] = []string{"This", "Name", "Uuid"}
+	requiredFields[reflect.TypeOf((*SharesInfo)(nil)).Elem()] = []string{"Shares", "Level"}
+	requiredFields[reflect.TypeOf((*SharesOption)(nil)).Elem()] = []string{"SharesOption", "DefaultLevel"}
+	requiredFields[reflect.TypeOf((*ShrinkVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*ShrinkVirtualDisk_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*ShrinkVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ShutdownGuest)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ShutdownGuestRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ShutdownHostRequestType)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*ShutdownHost_Task)(nil)).Elem()] = []string{"This", "Force"}
+	requiredFields[reflect.TypeOf((*ShutdownHost_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SingleIp)(nil)).Elem()] = []string{"Address"}
+	requiredFields[reflect.TypeOf((*SingleMac)(nil)).Elem()] = []string{"Address"}
+	requiredFields[reflect.TypeOf((*SnapshotIncompatibleDeviceInVm)(nil)).Elem()] = []string{"Fault"}
+	requiredFields[reflect.TypeOf((*SnapshotIncompatibleDeviceInVmFault)(nil)).Elem()] = []string{"Fault"}
+	requiredFields[reflect.TypeOf((*SnapshotRevertIssue)(nil)).Elem()] = []string{"Errors"}
+	requiredFields[reflect.TypeOf((*SnapshotRevertIssueFault)(nil)).Elem()] = []string{"Errors"}
+	requiredFields[reflect.TypeOf((*SoftRuleVioCorrectionDisallowed)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*SoftRuleVioCorrectionDisallowedFault)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*SoftRuleVioCorrectionImpact)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*SoftRuleVioCorrectionImpactFault)(nil)).Elem()] = []string{"VmName"}
+	requiredFields[reflect.TypeOf((*SoftwarePackage)(nil)).Elem()] = []string{"Name", "Version", "Type", "Vendor", "AcceptanceLevel", "Summary", "Description", "Capability"}
+	requiredFields[reflect.TypeOf((*SourceNodeSpec)(nil)).Elem()] = []string{"ManagementVc", "ActiveVc"}
+	requiredFields[reflect.TypeOf((*SsdDiskNotAvailable)(nil)).Elem()] = []string{"DevicePath"}
+	requiredFields[reflect.TypeOf((*SsdDiskNotAvailableFault)(nil)).Elem()] = []string{"DevicePath"}
+	requiredFields[reflect.TypeOf((*StageHostPatchRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StageHostPatch_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StageHostPatch_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*StampAllRulesWithUuidRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StampAllRulesWithUuid_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StampAllRulesWithUuid_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*StandbyGuest)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StandbyGuestRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StartDpuFailover)(nil)).Elem()] = []string{"This", "DvsName"}
+	requiredFields[reflect.TypeOf((*StartDpuFailoverRequestType)(nil)).Elem()] = []string{"This", "DvsName"}
+	requiredFields[reflect.TypeOf((*StartGuestNetworkRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*StartGuestNetwork_Task)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*StartGuestNetwork_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*StartProgramInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Spec"}
+	requiredFields[reflect.TypeOf((*StartProgramInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Spec"}
+	requiredFields[reflect.TypeOf((*StartProgramInGuestResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*StartRecordingRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*StartRecording_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*StartRecording_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*StartReplayingRequestType)(nil)).Elem()] = []string{"This", "ReplaySnapshot"}
+	requiredFields[reflect.TypeOf((*StartReplaying_Task)(nil)).Elem()] = []string{"This", "ReplaySnapshot"}
+	requiredFields[reflect.TypeOf((*StartReplaying_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*StartService)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*StartServiceRequestType)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*StateAlarmExpression)(nil)).Elem()] = []string{"Operator", "Type", "StatePath"}
+	requiredFields[reflect.TypeOf((*StopRecordingRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StopRecording_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StopRecording_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*StopReplayingRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StopReplaying_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*StopReplaying_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*StopService)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*StopServiceRequestType)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*StorageDrsConfigInfo)(nil)).Elem()] = []string{"PodConfig"}
+	requiredFields[reflect.TypeOf((*StorageDrsHbrDiskNotMovable)(nil)).Elem()] = []string{"NonMovableDiskIds"}
+	requiredFields[reflect.TypeOf((*StorageDrsHbrDiskNotMovableFault)(nil)).Elem()] = []string{"NonMovableDiskIds"}
+	requiredFields[reflect.TypeOf((*StorageDrsPlacementRankVmSpec)(nil)).Elem()] = []string{"VmPlacementSpec", "VmClusters"}
+	requiredFields[reflect.TypeOf((*StorageDrsPodConfigInfo)(nil)).Elem()] = []string{"Enabled", "IoLoadBalanceEnabled", "DefaultVmBehavior"}
+	requiredFields[reflect.TypeOf((*StorageIOAllocationOption)(nil)).Elem()] = []string{"LimitOption", "SharesOption"}
+	requiredFields[reflect.TypeOf((*StorageIORMConfigOption)(nil)).Elem()] = []string{"EnabledOption", "CongestionThresholdOption"}
+	requiredFields[reflect.TypeOf((*StorageIORMInfo)(nil)).Elem()] = []string{"Enabled", "CongestionThreshold"}
+	requiredFields[reflect.TypeOf((*StorageMigrationAction)(nil)).Elem()] = []string{"Vm", "RelocateSpec", "Source", "Destination", "SizeTransferred"}
+	requiredFields[reflect.TypeOf((*StoragePerformanceSummary)(nil)).Elem()] = []string{"Interval", "Percentile", "DatastoreReadLatency", "DatastoreWriteLatency", "DatastoreVmLatency", "DatastoreReadIops", "DatastoreWriteIops", "SiocActivityDuration"}
+	requiredFields[reflect.TypeOf((*StoragePlacementAction)(nil)).Elem()] = []string{"RelocateSpec", "Destination"}
+	requiredFields[reflect.TypeOf((*StoragePlacementSpec)(nil)).Elem()] = []string{"Type", "PodSelectionSpec"}
+	requiredFields[reflect.TypeOf((*StoragePodSummary)(nil)).Elem()] = []string{"Name", "Capacity", "FreeSpace"}
+	requiredFields[reflect.TypeOf((*StorageRequirement)(nil)).Elem()] = []string{"Datastore", "FreeSpaceRequiredInKb"}
+	requiredFields[reflect.TypeOf((*StorageResourceManagerStorageProfileStatistics)(nil)).Elem()] = []string{"ProfileId", "TotalSpaceMB", "UsedSpaceMB"}
+	requiredFields[reflect.TypeOf((*StringOption)(nil)).Elem()] = []string{"DefaultValue"}
+	requiredFields[reflect.TypeOf((*StructuredCustomizations)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*SuspendVAppRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SuspendVApp_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SuspendVApp_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SuspendVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SuspendVM_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*SuspendVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*SystemError)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*SystemErrorFault)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*SystemEventInfo)(nil)).Elem()] = []string{"RecordId", "When", "SelType", "Message", "SensorNumber"}
+	requiredFields[reflect.TypeOf((*Tag)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*TaskDescription)(nil)).Elem()] = []string{"MethodInfo", "State", "Reason"}
+	requiredFields[reflect.TypeOf((*TaskEvent)(nil)).Elem()] = []string{"Info"}
+	requiredFields[reflect.TypeOf((*TaskFilterSpecByEntity)(nil)).Elem()] = []string{"Entity", "Recursion"}
+	requiredFields[reflect.TypeOf((*TaskFilterSpecByTime)(nil)).Elem()] = []string{"TimeType"}
+	requiredFields[reflect.TypeOf((*TaskFilterSpecByUsername)(nil)).Elem()] = []string{"SystemUser"}
+	requiredFields[reflect.TypeOf((*TaskInProgress)(nil)).Elem()] = []string{"Task"}
+	requiredFields[reflect.TypeOf((*TaskInfo)(nil)).Elem()] = []string{"Key", "Task", "DescriptionId", "State", "Cancelled", "Cancelable", "Reason", "QueueTime", "EventChainId"}
+	requiredFields[reflect.TypeOf((*TaskReasonAlarm)(nil)).Elem()] = []string{"AlarmName", "Alarm", "EntityName", "Entity"}
+	requiredFields[reflect.TypeOf((*TaskReasonSchedule)(nil)).Elem()] = []string{"Name", "ScheduledTask"}
+	requiredFields[reflect.TypeOf((*TaskReasonUser)(nil)).Elem()] = []string{"UserName"}
+	requiredFields[reflect.TypeOf((*TemplateUpgradeEvent)(nil)).Elem()] = []string{"LegacyTemplate"}
+	requiredFields[reflect.TypeOf((*TemplateUpgradeFailedEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*TerminateFaultTolerantVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*TerminateFaultTolerantVM_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*TerminateFaultTolerantVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*TerminateProcessInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Pid"}
+	requiredFields[reflect.TypeOf((*TerminateProcessInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth", "Pid"}
+	requiredFields[reflect.TypeOf((*TerminateSession)(nil)).Elem()] = []string{"This", "SessionId"}
+	requiredFields[reflect.TypeOf((*TerminateSessionRequestType)(nil)).Elem()] = []string{"This", "SessionId"}
+	requiredFields[reflect.TypeOf((*TerminateVM)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*TerminateVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*TestTimeService)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*TestTimeServiceRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ThirdPartyLicenseAssignmentFailed)(nil)).Elem()] = []string{"Host", "Module"}
+	requiredFields[reflect.TypeOf((*ThirdPartyLicenseAssignmentFailedFault)(nil)).Elem()] = []string{"Host", "Module"}
+	requiredFields[reflect.TypeOf((*TicketedSessionAuthentication)(nil)).Elem()] = []string{"Ticket"}
+	requiredFields[reflect.TypeOf((*TooManyDisksOnLegacyHost)(nil)).Elem()] = []string{"DiskCount", "TimeoutDanger"}
+	requiredFields[reflect.TypeOf((*TooManyDisksOnLegacyHostFault)(nil)).Elem()] = []string{"DiskCount", "TimeoutDanger"}
+	requiredFields[reflect.TypeOf((*ToolsConfigInfoToolsLastInstallInfo)(nil)).Elem()] = []string{"Counter"}
+	requiredFields[reflect.TypeOf((*TraversalSpec)(nil)).Elem()] = []string{"Type", "Path"}
+	requiredFields[reflect.TypeOf((*TurnDiskLocatorLedOffRequestType)(nil)).Elem()] = []string{"This", "ScsiDiskUuids"}
+	requiredFields[reflect.TypeOf((*TurnDiskLocatorLedOff_Task)(nil)).Elem()] = []string{"This", "ScsiDiskUuids"}
+	requiredFields[reflect.TypeOf((*TurnDiskLocatorLedOff_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*TurnDiskLocatorLedOnRequestType)(nil)).Elem()] = []string{"This", "ScsiDiskUuids"}
+	requiredFields[reflect.TypeOf((*TurnDiskLocatorLedOn_Task)(nil)).Elem()] = []string{"This", "ScsiDiskUuids"}
+	requiredFields[reflect.TypeOf((*TurnDiskLocatorLedOn_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*TurnOffFaultToleranceForVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*TurnOffFaultToleranceForVM_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*TurnOffFaultToleranceForVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*TypeDescription)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*UnSupportedDatastoreForVFlash)(nil)).Elem()] = []string{"DatastoreName", "Type"}
+	requiredFields[reflect.TypeOf((*UnSupportedDatastoreForVFlashFault)(nil)).Elem()] = []string{"DatastoreName", "Type"}
+	requiredFields[reflect.TypeOf((*UnassignUserFromGroup)(nil)).Elem()] = []string{"This", "User", "Group"}
+	requiredFields[reflect.TypeOf((*UnassignUserFromGroupRequestType)(nil)).Elem()] = []string{"This", "User", "Group"}
+	requiredFields[reflect.TypeOf((*UnbindVnic)(nil)).Elem()] = []string{"This", "IScsiHbaName", "VnicDevice", "Force"}
+	requiredFields[reflect.TypeOf((*UnbindVnicRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaName", "VnicDevice", "Force"}
+	requiredFields[reflect.TypeOf((*UncustomizableGuest)(nil)).Elem()] = []string{"UncustomizableGuestOS"}
+	requiredFields[reflect.TypeOf((*UncustomizableGuestFault)(nil)).Elem()] = []string{"UncustomizableGuestOS"}
+	requiredFields[reflect.TypeOf((*UnexpectedFault)(nil)).Elem()] = []string{"FaultName"}
+	requiredFields[reflect.TypeOf((*UnexpectedFaultFault)(nil)).Elem()] = []string{"FaultName"}
+	requiredFields[reflect.TypeOf((*UninstallHostPatchRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UninstallHostPatch_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UninstallHostPatch_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UninstallIoFilterRequestType)(nil)).Elem()] = []string{"This", "FilterId", "CompRes"}
+	requiredFields[reflect.TypeOf((*UninstallIoFilter_Task)(nil)).Elem()] = []string{"This", "FilterId", "CompRes"}
+	requiredFields[reflect.TypeOf((*UninstallIoFilter_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UninstallService)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*UninstallServiceRequestType)(nil)).Elem()] = []string{"This", "Id"}
+	requiredFields[reflect.TypeOf((*UnlicensedVirtualMachinesEvent)(nil)).Elem()] = []string{"Unlicensed", "Available"}
+	requiredFields[reflect.TypeOf((*UnlicensedVirtualMachinesFoundEvent)(nil)).Elem()] = []string{"Available"}
+	requiredFields[reflect.TypeOf((*UnmapVmfsVolumeExRequestType)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*UnmapVmfsVolumeEx_Task)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*UnmapVmfsVolumeEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UnmarkServiceProviderEntities)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnmarkServiceProviderEntitiesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnmountDiskMappingRequestType)(nil)).Elem()] = []string{"This", "Mapping"}
+	requiredFields[reflect.TypeOf((*UnmountDiskMapping_Task)(nil)).Elem()] = []string{"This", "Mapping"}
+	requiredFields[reflect.TypeOf((*UnmountDiskMapping_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UnmountForceMountedVmfsVolume)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*UnmountForceMountedVmfsVolumeRequestType)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*UnmountToolsInstaller)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnmountToolsInstallerRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnmountVffsVolume)(nil)).Elem()] = []string{"This", "VffsUuid"}
+	requiredFields[reflect.TypeOf((*UnmountVffsVolumeRequestType)(nil)).Elem()] = []string{"This", "VffsUuid"}
+	requiredFields[reflect.TypeOf((*UnmountVmfsVolume)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*UnmountVmfsVolumeExRequestType)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*UnmountVmfsVolumeEx_Task)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*UnmountVmfsVolumeEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UnmountVmfsVolumeRequestType)(nil)).Elem()] = []string{"This", "VmfsUuid"}
+	requiredFields[reflect.TypeOf((*UnrecognizedHost)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*UnrecognizedHostFault)(nil)).Elem()] = []string{"HostName"}
+	requiredFields[reflect.TypeOf((*UnregisterAndDestroyRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnregisterAndDestroy_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnregisterAndDestroy_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UnregisterExtension)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*UnregisterExtensionRequestType)(nil)).Elem()] = []string{"This", "ExtensionKey"}
+	requiredFields[reflect.TypeOf((*UnregisterHealthUpdateProvider)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*UnregisterHealthUpdateProviderRequestType)(nil)).Elem()] = []string{"This", "ProviderId"}
+	requiredFields[reflect.TypeOf((*UnregisterKmsCluster)(nil)).Elem()] = []string{"This", "ClusterId"}
+	requiredFields[reflect.TypeOf((*UnregisterKmsClusterRequestType)(nil)).Elem()] = []string{"This", "ClusterId"}
+	requiredFields[reflect.TypeOf((*UnregisterVAppRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnregisterVApp_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnregisterVApp_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UnregisterVM)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnregisterVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UnsupportedGuest)(nil)).Elem()] = []string{"UnsupportedGuestOS"}
+	requiredFields[reflect.TypeOf((*UnsupportedGuestFault)(nil)).Elem()] = []string{"UnsupportedGuestOS"}
+	requiredFields[reflect.TypeOf((*UpdateAnswerFileRequestType)(nil)).Elem()] = []string{"This", "Host", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*UpdateAnswerFile_Task)(nil)).Elem()] = []string{"This", "Host", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*UpdateAnswerFile_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateAssignableHardwareConfig)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateAssignableHardwareConfigRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateAssignedLicense)(nil)).Elem()] = []string{"This", "Entity", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*UpdateAssignedLicenseRequestType)(nil)).Elem()] = []string{"This", "Entity", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*UpdateAssignedLicenseResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateAuthorizationRole)(nil)).Elem()] = []string{"This", "RoleId", "NewName"}
+	requiredFields[reflect.TypeOf((*UpdateAuthorizationRoleRequestType)(nil)).Elem()] = []string{"This", "RoleId", "NewName"}
+	requiredFields[reflect.TypeOf((*UpdateBootDevice)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*UpdateBootDeviceRequestType)(nil)).Elem()] = []string{"This", "Key"}
+	requiredFields[reflect.TypeOf((*UpdateChildResourceConfiguration)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateChildResourceConfigurationRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateClusterProfile)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateClusterProfileRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateConfig)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateConfigRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateConsoleIpRouteConfig)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateConsoleIpRouteConfigRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateCounterLevelMapping)(nil)).Elem()] = []string{"This", "CounterLevelMap"}
+	requiredFields[reflect.TypeOf((*UpdateCounterLevelMappingRequestType)(nil)).Elem()] = []string{"This", "CounterLevelMap"}
+	requiredFields[reflect.TypeOf((*UpdateDVSHealthCheckConfigRequestType)(nil)).Elem()] = []string{"This", "HealthCheckConfig"}
+	requiredFields[reflect.TypeOf((*UpdateDVSHealthCheckConfig_Task)(nil)).Elem()] = []string{"This", "HealthCheckConfig"}
+	requiredFields[reflect.TypeOf((*UpdateDVSHealthCheckConfig_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateDVSLacpGroupConfigRequestType)(nil)).Elem()] = []string{"This", "LacpGroupSpec"}
+	requiredFields[reflect.TypeOf((*UpdateDVSLacpGroupConfig_Task)(nil)).Elem()] = []string{"This", "LacpGroupSpec"}
+	requiredFields[reflect.TypeOf((*UpdateDVSLacpGroupConfig_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateDateTime)(nil)).Elem()] = []string{"This", "DateTime"}
+	requiredFields[reflect.TypeOf((*UpdateDateTimeConfig)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateDateTimeConfigRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateDateTimeRequestType)(nil)).Elem()] = []string{"This", "DateTime"}
+	requiredFields[reflect.TypeOf((*UpdateDefaultPolicy)(nil)).Elem()] = []string{"This", "DefaultPolicy"}
+	requiredFields[reflect.TypeOf((*UpdateDefaultPolicyRequestType)(nil)).Elem()] = []string{"This", "DefaultPolicy"}
+	requiredFields[reflect.TypeOf((*UpdateDiskPartitions)(nil)).Elem()] = []string{"This", "DevicePath", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateDiskPartitionsRequestType)(nil)).Elem()] = []string{"This", "DevicePath", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateDnsConfig)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateDnsConfigRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateDvsCapability)(nil)).Elem()] = []string{"This", "Capability"}
+	requiredFields[reflect.TypeOf((*UpdateDvsCapabilityRequestType)(nil)).Elem()] = []string{"This", "Capability"}
+	requiredFields[reflect.TypeOf((*UpdateExtension)(nil)).Elem()] = []string{"This", "Extension"}
+	requiredFields[reflect.TypeOf((*UpdateExtensionRequestType)(nil)).Elem()] = []string{"This", "Extension"}
+	requiredFields[reflect.TypeOf((*UpdateFlags)(nil)).Elem()] = []string{"This", "FlagInfo"}
+	requiredFields[reflect.TypeOf((*UpdateFlagsRequestType)(nil)).Elem()] = []string{"This", "FlagInfo"}
+	requiredFields[reflect.TypeOf((*UpdateGraphicsConfig)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateGraphicsConfigRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateHostImageAcceptanceLevel)(nil)).Elem()] = []string{"This", "NewAcceptanceLevel"}
+	requiredFields[reflect.TypeOf((*UpdateHostImageAcceptanceLevelRequestType)(nil)).Elem()] = []string{"This", "NewAcceptanceLevel"}
+	requiredFields[reflect.TypeOf((*UpdateHostProfile)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateHostProfileRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateHostSpecification)(nil)).Elem()] = []string{"This", "Host", "HostSpec"}
+	requiredFields[reflect.TypeOf((*UpdateHostSpecificationRequestType)(nil)).Elem()] = []string{"This", "Host", "HostSpec"}
+	requiredFields[reflect.TypeOf((*UpdateHostSubSpecification)(nil)).Elem()] = []string{"This", "Host", "HostSubSpec"}
+	requiredFields[reflect.TypeOf((*UpdateHostSubSpecificationRequestType)(nil)).Elem()] = []string{"This", "Host", "HostSubSpec"}
+	requiredFields[reflect.TypeOf((*UpdateHppMultipathLunPolicy)(nil)).Elem()] = []string{"This", "LunId", "Policy"}
+	requiredFields[reflect.TypeOf((*UpdateHppMultipathLunPolicyRequestType)(nil)).Elem()] = []string{"This", "LunId", "Policy"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiAdvancedOptions)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Options"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiAdvancedOptionsRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "Options"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiAlias)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "IScsiAlias"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiAliasRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "IScsiAlias"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiAuthenticationProperties)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "AuthenticationProperties"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiAuthenticationPropertiesRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "AuthenticationProperties"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiDigestProperties)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "DigestProperties"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiDigestPropertiesRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "DigestProperties"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiDiscoveryProperties)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "DiscoveryProperties"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiDiscoveryPropertiesRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "DiscoveryProperties"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiIPProperties)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "IpProperties"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiIPPropertiesRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "IpProperties"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiName)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "IScsiName"}
+	requiredFields[reflect.TypeOf((*UpdateInternetScsiNameRequestType)(nil)).Elem()] = []string{"This", "IScsiHbaDevice", "IScsiName"}
+	requiredFields[reflect.TypeOf((*UpdateIpConfig)(nil)).Elem()] = []string{"This", "IpConfig"}
+	requiredFields[reflect.TypeOf((*UpdateIpConfigRequestType)(nil)).Elem()] = []string{"This", "IpConfig"}
+	requiredFields[reflect.TypeOf((*UpdateIpPool)(nil)).Elem()] = []string{"This", "Dc", "Pool"}
+	requiredFields[reflect.TypeOf((*UpdateIpPoolRequestType)(nil)).Elem()] = []string{"This", "Dc", "Pool"}
+	requiredFields[reflect.TypeOf((*UpdateIpRouteConfig)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateIpRouteConfigRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateIpRouteTableConfig)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateIpRouteTableConfigRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateIpmi)(nil)).Elem()] = []string{"This", "IpmiInfo"}
+	requiredFields[reflect.TypeOf((*UpdateIpmiRequestType)(nil)).Elem()] = []string{"This", "IpmiInfo"}
+	requiredFields[reflect.TypeOf((*UpdateKmipServer)(nil)).Elem()] = []string{"This", "Server"}
+	requiredFields[reflect.TypeOf((*UpdateKmipServerRequestType)(nil)).Elem()] = []string{"This", "Server"}
+	requiredFields[reflect.TypeOf((*UpdateKmsSignedCsrClientCert)(nil)).Elem()] = []string{"This", "Cluster", "Certificate"}
+	requiredFields[reflect.TypeOf((*UpdateKmsSignedCsrClientCertRequestType)(nil)).Elem()] = []string{"This", "Cluster", "Certificate"}
+	requiredFields[reflect.TypeOf((*UpdateLicense)(nil)).Elem()] = []string{"This", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*UpdateLicenseLabel)(nil)).Elem()] = []string{"This", "LicenseKey", "LabelKey", "LabelValue"}
+	requiredFields[reflect.TypeOf((*UpdateLicenseLabelRequestType)(nil)).Elem()] = []string{"This", "LicenseKey", "LabelKey", "LabelValue"}
+	requiredFields[reflect.TypeOf((*UpdateLicenseRequestType)(nil)).Elem()] = []string{"This", "LicenseKey"}
+	requiredFields[reflect.TypeOf((*UpdateLicenseResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateLinkedChildren)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateLinkedChildrenRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateLocalSwapDatastore)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateLocalSwapDatastoreRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateLockdownExceptions)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateLockdownExceptionsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateModuleOptionString)(nil)).Elem()] = []string{"This", "Name", "Options"}
+	requiredFields[reflect.TypeOf((*UpdateModuleOptionStringRequestType)(nil)).Elem()] = []string{"This", "Name", "Options"}
+	requiredFields[reflect.TypeOf((*UpdateNetworkConfig)(nil)).Elem()] = []string{"This", "Config", "ChangeMode"}
+	requiredFields[reflect.TypeOf((*UpdateNetworkConfigRequestType)(nil)).Elem()] = []string{"This", "Config", "ChangeMode"}
+	requiredFields[reflect.TypeOf((*UpdateNetworkConfigResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateNetworkResourcePool)(nil)).Elem()] = []string{"This", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*UpdateNetworkResourcePoolRequestType)(nil)).Elem()] = []string{"This", "ConfigSpec"}
+	requiredFields[reflect.TypeOf((*UpdateOptions)(nil)).Elem()] = []string{"This", "ChangedValue"}
+	requiredFields[reflect.TypeOf((*UpdateOptionsRequestType)(nil)).Elem()] = []string{"This", "ChangedValue"}
+	requiredFields[reflect.TypeOf((*UpdatePassthruConfig)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdatePassthruConfigRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdatePerfInterval)(nil)).Elem()] = []string{"This", "Interval"}
+	requiredFields[reflect.TypeOf((*UpdatePerfIntervalRequestType)(nil)).Elem()] = []string{"This", "Interval"}
+	requiredFields[reflect.TypeOf((*UpdatePhysicalNicLinkSpeed)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*UpdatePhysicalNicLinkSpeedRequestType)(nil)).Elem()] = []string{"This", "Device"}
+	requiredFields[reflect.TypeOf((*UpdatePortGroup)(nil)).Elem()] = []string{"This", "PgName", "Portgrp"}
+	requiredFields[reflect.TypeOf((*UpdatePortGroupRequestType)(nil)).Elem()] = []string{"This", "PgName", "Portgrp"}
+	requiredFields[reflect.TypeOf((*UpdateProductLockerLocationRequestType)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*UpdateProductLockerLocation_Task)(nil)).Elem()] = []string{"This", "Path"}
+	requiredFields[reflect.TypeOf((*UpdateProductLockerLocation_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateProgress)(nil)).Elem()] = []string{"This", "PercentDone"}
+	requiredFields[reflect.TypeOf((*UpdateProgressRequestType)(nil)).Elem()] = []string{"This", "PercentDone"}
+	requiredFields[reflect.TypeOf((*UpdateReferenceHost)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateReferenceHostRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateRuleset)(nil)).Elem()] = []string{"This", "Id", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateRulesetRequestType)(nil)).Elem()] = []string{"This", "Id", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateScsiLunDisplayName)(nil)).Elem()] = []string{"This", "LunUuid", "DisplayName"}
+	requiredFields[reflect.TypeOf((*UpdateScsiLunDisplayNameRequestType)(nil)).Elem()] = []string{"This", "LunUuid", "DisplayName"}
+	requiredFields[reflect.TypeOf((*UpdateSelfSignedClientCert)(nil)).Elem()] = []string{"This", "Cluster", "Certificate"}
+	requiredFields[reflect.TypeOf((*UpdateSelfSignedClientCertRequestType)(nil)).Elem()] = []string{"This", "Cluster", "Certificate"}
+	requiredFields[reflect.TypeOf((*UpdateServiceConsoleVirtualNic)(nil)).Elem()] = []string{"This", "Device", "Nic"}
+	requiredFields[reflect.TypeOf((*UpdateServiceConsoleVirtualNicRequestType)(nil)).Elem()] = []string{"This", "Device", "Nic"}
+	requiredFields[reflect.TypeOf((*UpdateServiceMessage)(nil)).Elem()] = []string{"This", "Message"}
+	requiredFields[reflect.TypeOf((*UpdateServiceMessageRequestType)(nil)).Elem()] = []string{"This", "Message"}
+	requiredFields[reflect.TypeOf((*UpdateServicePolicy)(nil)).Elem()] = []string{"This", "Id", "Policy"}
+	requiredFields[reflect.TypeOf((*UpdateServicePolicyRequestType)(nil)).Elem()] = []string{"This", "Id", "Policy"}
+	requiredFields[reflect.TypeOf((*UpdateSet)(nil)).Elem()] = []string{"Version"}
+	requiredFields[reflect.TypeOf((*UpdateSoftwareInternetScsiEnabled)(nil)).Elem()] = []string{"This", "Enabled"}
+	requiredFields[reflect.TypeOf((*UpdateSoftwareInternetScsiEnabledRequestType)(nil)).Elem()] = []string{"This", "Enabled"}
+	requiredFields[reflect.TypeOf((*UpdateSystemResources)(nil)).Elem()] = []string{"This", "ResourceInfo"}
+	requiredFields[reflect.TypeOf((*UpdateSystemResourcesRequestType)(nil)).Elem()] = []string{"This", "ResourceInfo"}
+	requiredFields[reflect.TypeOf((*UpdateSystemSwapConfiguration)(nil)).Elem()] = []string{"This", "SysSwapConfig"}
+	requiredFields[reflect.TypeOf((*UpdateSystemSwapConfigurationRequestType)(nil)).Elem()] = []string{"This", "SysSwapConfig"}
+	requiredFields[reflect.TypeOf((*UpdateSystemUsers)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateSystemUsersRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateUser)(nil)).Elem()] = []string{"This", "User"}
+	requiredFields[reflect.TypeOf((*UpdateUserRequestType)(nil)).Elem()] = []string{"This", "User"}
+	requiredFields[reflect.TypeOf((*UpdateVAppConfig)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateVAppConfigRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateVStorageInfrastructureObjectPolicyRequestType)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateVStorageInfrastructureObjectPolicy_Task)(nil)).Elem()] = []string{"This", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateVStorageInfrastructureObjectPolicy_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateVStorageObjectCryptoRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*UpdateVStorageObjectCrypto_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*UpdateVStorageObjectCrypto_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateVStorageObjectPolicyRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*UpdateVStorageObjectPolicy_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*UpdateVStorageObjectPolicy_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateVVolVirtualMachineFilesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateVVolVirtualMachineFiles_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpdateVVolVirtualMachineFiles_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateVirtualMachineFilesRequestType)(nil)).Elem()] = []string{"This", "MountPathDatastoreMapping"}
+	requiredFields[reflect.TypeOf((*UpdateVirtualMachineFilesResultFailedVmFileInfo)(nil)).Elem()] = []string{"VmFile", "Fault"}
+	requiredFields[reflect.TypeOf((*UpdateVirtualMachineFiles_Task)(nil)).Elem()] = []string{"This", "MountPathDatastoreMapping"}
+	requiredFields[reflect.TypeOf((*UpdateVirtualMachineFiles_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpdateVirtualNic)(nil)).Elem()] = []string{"This", "Device", "Nic"}
+	requiredFields[reflect.TypeOf((*UpdateVirtualNicRequestType)(nil)).Elem()] = []string{"This", "Device", "Nic"}
+	requiredFields[reflect.TypeOf((*UpdateVirtualSwitch)(nil)).Elem()] = []string{"This", "VswitchName", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateVirtualSwitchRequestType)(nil)).Elem()] = []string{"This", "VswitchName", "Spec"}
+	requiredFields[reflect.TypeOf((*UpdateVmfsUnmapBandwidth)(nil)).Elem()] = []string{"This", "VmfsUuid", "UnmapBandwidthSpec"}
+	requiredFields[reflect.TypeOf((*UpdateVmfsUnmapBandwidthRequestType)(nil)).Elem()] = []string{"This", "VmfsUuid", "UnmapBandwidthSpec"}
+	requiredFields[reflect.TypeOf((*UpdateVmfsUnmapPriority)(nil)).Elem()] = []string{"This", "VmfsUuid", "UnmapPriority"}
+	requiredFields[reflect.TypeOf((*UpdateVmfsUnmapPriorityRequestType)(nil)).Elem()] = []string{"This", "VmfsUuid", "UnmapPriority"}
+	requiredFields[reflect.TypeOf((*UpdateVsanRequestType)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateVsan_Task)(nil)).Elem()] = []string{"This", "Config"}
+	requiredFields[reflect.TypeOf((*UpdateVsan_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpgradeEvent)(nil)).Elem()] = []string{"Message"}
+	requiredFields[reflect.TypeOf((*UpgradeIoFilterRequestType)(nil)).Elem()] = []string{"This", "FilterId", "CompRes", "VibUrl"}
+	requiredFields[reflect.TypeOf((*UpgradeIoFilter_Task)(nil)).Elem()] = []string{"This", "FilterId", "CompRes", "VibUrl"}
+	requiredFields[reflect.TypeOf((*UpgradeIoFilter_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpgradeToolsRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpgradeTools_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpgradeTools_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpgradeVMRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpgradeVM_Task)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpgradeVM_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*UpgradeVmLayout)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpgradeVmLayoutRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*UpgradeVmfs)(nil)).Elem()] = []string{"This", "VmfsPath"}
+	requiredFields[reflect.TypeOf((*UpgradeVmfsRequestType)(nil)).Elem()] = []string{"This", "VmfsPath"}
+	requiredFields[reflect.TypeOf((*UpgradeVsanObjects)(nil)).Elem()] = []string{"This", "Uuids", "NewVersion"}
+	requiredFields[reflect.TypeOf((*UpgradeVsanObjectsRequestType)(nil)).Elem()] = []string{"This", "Uuids", "NewVersion"}
+	requiredFields[reflect.TypeOf((*UploadClientCert)(nil)).Elem()] = []string{"This", "Cluster", "Certificate", "PrivateKey"}
+	requiredFields[reflect.TypeOf((*UploadClientCertRequestType)(nil)).Elem()] = []string{"This", "Cluster", "Certificate", "PrivateKey"}
+	requiredFields[reflect.TypeOf((*UploadKmipServerCert)(nil)).Elem()] = []string{"This", "Cluster", "Certificate"}
+	requiredFields[reflect.TypeOf((*UploadKmipServerCertRequestType)(nil)).Elem()] = []string{"This", "Cluster", "Certificate"}
+	requiredFields[reflect.TypeOf((*UsbScanCodeSpec)(nil)).Elem()] = []string{"KeyEvents"}
+	requiredFields[reflect.TypeOf((*UsbScanCodeSpecKeyEvent)(nil)).Elem()] = []string{"UsbHidCode"}
+	requiredFields[reflect.TypeOf((*UserAssignedToGroup)(nil)).Elem()] = []string{"UserLogin", "Group"}
+	requiredFields[reflect.TypeOf((*UserGroupProfile)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*UserLoginSessionEvent)(nil)).Elem()] = []string{"IpAddress", "Locale", "SessionId"}
+	requiredFields[reflect.TypeOf((*UserNotFound)(nil)).Elem()] = []string{"Principal", "Unresolved"}
+	requiredFields[reflect.TypeOf((*UserNotFoundFault)(nil)).Elem()] = []string{"Principal", "Unresolved"}
+	requiredFields[reflect.TypeOf((*UserPasswordChanged)(nil)).Elem()] = []string{"UserLogin"}
+	requiredFields[reflect.TypeOf((*UserPrivilegeResult)(nil)).Elem()] = []string{"Entity"}
+	requiredFields[reflect.TypeOf((*UserProfile)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*UserSearchResult)(nil)).Elem()] = []string{"Principal", "Group"}
+	requiredFields[reflect.TypeOf((*UserSession)(nil)).Elem()] = []string{"Key", "UserName", "FullName", "LoginTime", "LastActiveTime", "Locale", "MessageLocale"}
+	requiredFields[reflect.TypeOf((*UserUnassignedFromGroup)(nil)).Elem()] = []string{"UserLogin", "Group"}
+	requiredFields[reflect.TypeOf((*VASAStorageArray)(nil)).Elem()] = []string{"Name", "Uuid", "VendorId", "ModelId"}
+	requiredFields[reflect.TypeOf((*VASAStorageArrayDiscoveryFcTransport)(nil)).Elem()] = []string{"NodeWwn", "PortWwn"}
+	requiredFields[reflect.TypeOf((*VASAStorageArrayDiscoveryIpTransport)(nil)).Elem()] = []string{"IpAddress"}
+	requiredFields[reflect.TypeOf((*VASAStorageArrayDiscoverySvcInfo)(nil)).Elem()] = []string{"PortType", "SvcNqn"}
+	requiredFields[reflect.TypeOf((*VAppCloneSpec)(nil)).Elem()] = []string{"Location"}
+	requiredFields[reflect.TypeOf((*VAppCloneSpecNetworkMappingPair)(nil)).Elem()] = []string{"Source", "Destination"}
+	requiredFields[reflect.TypeOf((*VAppCloneSpecResourceMap)(nil)).Elem()] = []string{"Source"}
+	requiredFields[reflect.TypeOf((*VAppConfigInfo)(nil)).Elem()] = []string{"Annotation"}
+	requiredFields[reflect.TypeOf((*VAppProductInfo)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*VAppPropertyFault)(nil)).Elem()] = []string{"Id", "Category", "Label", "Type", "Value"}
+	requiredFields[reflect.TypeOf((*VAppPropertyInfo)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*VCenterUpdateVStorageObjectMetadataExRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*VCenterUpdateVStorageObjectMetadataEx_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore"}
+	requiredFields[reflect.TypeOf((*VCenterUpdateVStorageObjectMetadataEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*VFlashModuleNotSupported)(nil)).Elem()] = []string{"VmName", "ModuleName", "Reason", "HostName"}
+	requiredFields[reflect.TypeOf((*VFlashModuleNotSupportedFault)(nil)).Elem()] = []string{"VmName", "ModuleName", "Reason", "HostName"}
+	requiredFields[reflect.TypeOf((*VFlashModuleVersionIncompatible)(nil)).Elem()] = []string{"ModuleName", "VmRequestModuleVersion", "HostMinSupportedVerson", "HostModuleVersion"}
+	requiredFields[reflect.TypeOf((*VFlashModuleVersionIncompatibleFault)(nil)).Elem()] = []string{"ModuleName", "VmRequestModuleVersion", "HostMinSupportedVerson", "HostModuleVersion"}
+	requiredFields[reflect.TypeOf((*VMFSDatastoreCreatedEvent)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*VMFSDatastoreExpandedEvent)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*VMFSDatastoreExtendedEvent)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*VMotionInterfaceIssue)(nil)).Elem()] = []string{"AtSourceHost", "FailedHost"}
+	requiredFields[reflect.TypeOf((*VMotionLinkCapacityLow)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*VMotionLinkCapacityLowFault)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*VMotionLinkDown)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*VMotionLinkDownFault)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*VMwareDVSConfigInfo)(nil)).Elem()] = []string{"MaxMtu"}
+	requiredFields[reflect.TypeOf((*VMwareDVSHealthCheckCapability)(nil)).Elem()] = []string{"VlanMtuSupported", "TeamingSupported"}
+	requiredFields[reflect.TypeOf((*VMwareDVSMtuHealthCheckResult)(nil)).Elem()] = []string{"MtuMismatch"}
+	requiredFields[reflect.TypeOf((*VMwareDVSPortgroupPolicy)(nil)).Elem()] = []string{"VlanOverrideAllowed", "UplinkTeamingOverrideAllowed", "SecurityPolicyOverrideAllowed"}
+	requiredFields[reflect.TypeOf((*VMwareDVSPvlanConfigSpec)(nil)).Elem()] = []string{"PvlanEntry", "Operation"}
+	requiredFields[reflect.TypeOf((*VMwareDVSPvlanMapEntry)(nil)).Elem()] = []string{"PrimaryVlanId", "SecondaryVlanId", "PvlanType"}
+	requiredFields[reflect.TypeOf((*VMwareDVSTeamingHealthCheckResult)(nil)).Elem()] = []string{"TeamingStatus"}
+	requiredFields[reflect.TypeOf((*VMwareDVSVspanCapability)(nil)).Elem()] = []string{"MixedDestSupported", "DvportSupported", "RemoteSourceSupported", "RemoteDestSupported", "EncapRemoteSourceSupported"}
+	requiredFields[reflect.TypeOf((*VMwareDVSVspanConfigSpec)(nil)).Elem()] = []string{"VspanSession", "Operation"}
+	requiredFields[reflect.TypeOf((*VMwareDvsLacpGroupSpec)(nil)).Elem()] = []string{"LacpGroupConfig", "Operation"}
+	requiredFields[reflect.TypeOf((*VMwareDvsMtuCapability)(nil)).Elem()] = []string{"MinMtuSupported", "MaxMtuSupported"}
+	requiredFields[reflect.TypeOf((*VMwareIpfixConfig)(nil)).Elem()] = []string{"ActiveFlowTimeout", "IdleFlowTimeout", "SamplingRate", "InternalFlowsOnly"}
+	requiredFields[reflect.TypeOf((*VMwareVspanSession)(nil)).Elem()] = []string{"Enabled", "StripOriginalVlan", "NormalTrafficAllowed"}
+	requiredFields[reflect.TypeOf((*VStorageObject)(nil)).Elem()] = []string{"Config"}
+	requiredFields[reflect.TypeOf((*VStorageObjectAssociations)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*VStorageObjectAssociationsVmDiskAssociations)(nil)).Elem()] = []string{"VmId", "DiskKey"}
+	requiredFields[reflect.TypeOf((*VStorageObjectConfigInfo)(nil)).Elem()] = []string{"CapacityInMB"}
+	requiredFields[reflect.TypeOf((*VStorageObjectCreateSnapshotExRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Description"}
+	requiredFields[reflect.TypeOf((*VStorageObjectCreateSnapshotEx_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Description"}
+	requiredFields[reflect.TypeOf((*VStorageObjectCreateSnapshotEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*VStorageObjectCreateSnapshotRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Description"}
+	requiredFields[reflect.TypeOf((*VStorageObjectCreateSnapshot_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "Description"}
+	requiredFields[reflect.TypeOf((*VStorageObjectCreateSnapshot_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*VStorageObjectDeleteSnapshotExRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*VStorageObjectDeleteSnapshotEx_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId"}
+	requiredFields[reflect.TypeOf((*VStorageObjectDeleteSnapshotEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*VStorageObjectExtendDiskExRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "NewCapacityInMB"}
+	requiredFields[reflect.TypeOf((*VStorageObjectExtendDiskEx_Task)(nil)).Elem()] = []string{"This", "Id", "Datastore", "NewCapacityInMB"}
+	requiredFields[reflect.TypeOf((*VStorageObjectExtendDiskEx_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*VStorageObjectSnapshot)(nil)).Elem()] = []string{"Id", "Vclock"}
+	requiredFields[reflect.TypeOf((*VStorageObjectSnapshotInfoVStorageObjectSnapshot)(nil)).Elem()] = []string{"CreateTime", "Description"}
+	requiredFields[reflect.TypeOf((*VVolHostPE)(nil)).Elem()] = []string{"Key", "ProtocolEndpoint"}
+	requiredFields[reflect.TypeOf((*VVolVmConfigFileUpdateResultFailedVmConfigFileInfo)(nil)).Elem()] = []string{"TargetConfigVVolId", "Fault"}
+	requiredFields[reflect.TypeOf((*ValidateCredentialsInGuest)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ValidateCredentialsInGuestRequestType)(nil)).Elem()] = []string{"This", "Vm", "Auth"}
+	requiredFields[reflect.TypeOf((*ValidateHCIConfiguration)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ValidateHCIConfigurationRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*ValidateHost)(nil)).Elem()] = []string{"This", "OvfDescriptor", "Host", "Vhp"}
+	requiredFields[reflect.TypeOf((*ValidateHostProfileCompositionRequestType)(nil)).Elem()] = []string{"This", "Source"}
+	requiredFields[reflect.TypeOf((*ValidateHostProfileComposition_Task)(nil)).Elem()] = []string{"This", "Source"}
+	requiredFields[reflect.TypeOf((*ValidateHostProfileComposition_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ValidateHostRequestType)(nil)).Elem()] = []string{"This", "OvfDescriptor", "Host", "Vhp"}
+	requiredFields[reflect.TypeOf((*ValidateHostResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ValidateMigration)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*ValidateMigrationRequestType)(nil)).Elem()] = []string{"This", "Vm"}
+	requiredFields[reflect.TypeOf((*ValidateStoragePodConfig)(nil)).Elem()] = []string{"This", "Pod", "Spec"}
+	requiredFields[reflect.TypeOf((*ValidateStoragePodConfigRequestType)(nil)).Elem()] = []string{"This", "Pod", "Spec"}
+	requiredFields[reflect.TypeOf((*VasaProviderContainerSpec)(nil)).Elem()] = []string{"ScId", "Deleted"}
+	requiredFields[reflect.TypeOf((*VchaClusterConfigInfo)(nil)).Elem()] = []string{"State"}
+	requiredFields[reflect.TypeOf((*VchaClusterConfigSpec)(nil)).Elem()] = []string{"PassiveIp", "WitnessIp"}
+	requiredFields[reflect.TypeOf((*VchaClusterDeploymentSpec)(nil)).Elem()] = []string{"PassiveDeploymentSpec", "WitnessDeploymentSpec", "ActiveVcSpec"}
+	requiredFields[reflect.TypeOf((*VchaClusterHealth)(nil)).Elem()] = []string{"RuntimeInfo"}
+	requiredFields[reflect.TypeOf((*VchaClusterNetworkSpec)(nil)).Elem()] = []string{"WitnessNetworkSpec", "PassiveNetworkSpec"}
+	requiredFields[reflect.TypeOf((*VchaClusterRuntimeInfo)(nil)).Elem()] = []string{"ClusterState", "ClusterMode"}
+	requiredFields[reflect.TypeOf((*VchaNodeRuntimeInfo)(nil)).Elem()] = []string{"NodeState", "NodeRole", "NodeIp"}
+	requiredFields[reflect.TypeOf((*VimVasaProvider)(nil)).Elem()] = []string{"Url"}
+	requiredFields[reflect.TypeOf((*VimVasaProviderInfo)(nil)).Elem()] = []string{"Provider"}
+	requiredFields[reflect.TypeOf((*VimVasaProviderStatePerArray)(nil)).Elem()] = []string{"Priority", "ArrayId", "Active"}
+	requiredFields[reflect.TypeOf((*VimVasaProviderVirtualHostConfig)(nil)).Elem()] = []string{"ServiceHost"}
+	requiredFields[reflect.TypeOf((*VirtualAppImportSpec)(nil)).Elem()] = []string{"Name", "VAppConfigSpec", "ResourcePoolSpec"}
+	requiredFields[reflect.TypeOf((*VirtualAppLinkInfo)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*VirtualCdromPassthroughBackingInfo)(nil)).Elem()] = []string{"Exclusive"}
+	requiredFields[reflect.TypeOf((*VirtualCdromPassthroughBackingOption)(nil)).Elem()] = []string{"Exclusive"}
+	requiredFields[reflect.TypeOf((*VirtualCdromRemotePassthroughBackingInfo)(nil)).Elem()] = []string{"Exclusive"}
+	requiredFields[reflect.TypeOf((*VirtualCdromRemotePassthroughBackingOption)(nil)).Elem()] = []string{"Exclusive"}
+	requiredFields[reflect.TypeOf((*VirtualController)(nil)).Elem()] = []string{"BusNumber"}
+	requiredFields[reflect.TypeOf((*VirtualControllerOption)(nil)).Elem()] = []string{"Devices"}
+	requiredFields[reflect.TypeOf((*VirtualDevice)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceBackingOption)(nil)).Elem()] = []string{"Type"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceBusSlotOption)(nil)).Elem()] = []string{"Type"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceConfigSpec)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceConnectInfo)(nil)).Elem()] = []string{"StartConnected", "AllowGuestControl", "Connected"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceConnectOption)(nil)).Elem()] = []string{"StartConnected", "AllowGuestControl"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceDeviceBackingInfo)(nil)).Elem()] = []string{"DeviceName"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceDeviceBackingOption)(nil)).Elem()] = []string{"AutoDetectAvailable"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceDeviceGroupInfo)(nil)).Elem()] = []string{"GroupInstanceKey", "SequenceId"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceFileBackingInfo)(nil)).Elem()] = []string{"FileName"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceOption)(nil)).Elem()] = []string{"Type", "Deprecated", "PlugAndPlay"}
+	requiredFields[reflect.TypeOf((*VirtualDevicePciBusSlotInfo)(nil)).Elem()] = []string{"PciSlotNumber"}
+	requiredFields[reflect.TypeOf((*VirtualDevicePipeBackingInfo)(nil)).Elem()] = []string{"PipeName"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceRemoteDeviceBackingInfo)(nil)).Elem()] = []string{"DeviceName"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceRemoteDeviceBackingOption)(nil)).Elem()] = []string{"AutoDetectAvailable"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceURIBackingInfo)(nil)).Elem()] = []string{"ServiceURI", "Direction"}
+	requiredFields[reflect.TypeOf((*VirtualDeviceURIBackingOption)(nil)).Elem()] = []string{"Directions"}
+	requiredFields[reflect.TypeOf((*VirtualDisk)(nil)).Elem()] = []string{"CapacityInKB"}
+	requiredFields[reflect.TypeOf((*VirtualDiskAntiAffinityRuleSpec)(nil)).Elem()] = []string{"DiskId"}
+	requiredFields[reflect.TypeOf((*VirtualDiskDeltaDiskFormatsSupported)(nil)).Elem()] = []string{"DatastoreType", "DeltaDiskFormat"}
+	requiredFields[reflect.TypeOf((*VirtualDiskFlatVer1BackingInfo)(nil)).Elem()] = []string{"DiskMode"}
+	requiredFields[reflect.TypeOf((*VirtualDiskFlatVer1BackingOption)(nil)).Elem()] = []string{"DiskMode", "Split", "WriteThrough", "Growable"}
+	requiredFields[reflect.TypeOf((*VirtualDiskFlatVer2BackingInfo)(nil)).Elem()] = []string{"DiskMode"}
+	requiredFields[reflect.TypeOf((*VirtualDiskFlatVer2BackingOption)(nil)).Elem()] = []string{"DiskMode", "Split", "WriteThrough", "Growable", "HotGrowable", "Uuid"}
+	requiredFields[reflect.TypeOf((*VirtualDiskId)(nil)).Elem()] = []string{"Vm", "DiskId"}
+	requiredFields[reflect.TypeOf((*VirtualDiskLocalPMemBackingInfo)(nil)).Elem()] = []string{"DiskMode"}
+	requiredFields[reflect.TypeOf((*VirtualDiskLocalPMemBackingOption)(nil)).Elem()] = []string{"DiskMode", "Growable", "HotGrowable", "Uuid"}
+	requiredFields[reflect.TypeOf((*VirtualDiskModeNotSupported)(nil)).Elem()] = []string{"Mode"}
+	requiredFields[reflect.TypeOf((*VirtualDiskModeNotSupportedFault)(nil)).Elem()] = []string{"Mode"}
+	requiredFields[reflect.TypeOf((*VirtualDiskOption)(nil)).Elem()] = []string{"CapacityInKB"}
+	requiredFields[reflect.TypeOf((*VirtualDiskOptionVFlashCacheConfigOption)(nil)).Elem()] = []string{"CacheConsistencyType", "CacheMode", "ReservationInMB", "BlockSizeInKB"}
+	requiredFields[reflect.TypeOf((*VirtualDiskPartitionedRawDiskVer2BackingInfo)(nil)).Elem()] = []string{"Partition"}
+	requiredFields[reflect.TypeOf((*VirtualDiskRawDiskMappingVer1BackingOption)(nil)).Elem()] = []string{"CompatibilityMode", "DiskMode", "Uuid"}
+	requiredFields[reflect.TypeOf((*VirtualDiskRawDiskVer2BackingInfo)(nil)).Elem()] = []string{"DescriptorFileName"}
+	requiredFields[reflect.TypeOf((*VirtualDiskRawDiskVer2BackingOption)(nil)).Elem()] = []string{"DescriptorFileNameExtensions", "Uuid"}
+	requiredFields[reflect.TypeOf((*VirtualDiskRuleSpec)(nil)).Elem()] = []string{"DiskRuleType"}
+	requiredFields[reflect.TypeOf((*VirtualDiskSeSparseBackingInfo)(nil)).Elem()] = []string{"DiskMode"}
+	requiredFields[reflect.TypeOf((*VirtualDiskSeSparseBackingOption)(nil)).Elem()] = []string{"DiskMode", "WriteThrough", "Growable", "HotGrowable", "Uuid", "DeltaDiskFormatsSupported"}
+	requiredFields[reflect.TypeOf((*VirtualDiskSparseVer1BackingInfo)(nil)).Elem()] = []string{"DiskMode"}
+	requiredFields[reflect.TypeOf((*VirtualDiskSparseVer1BackingOption)(nil)).Elem()] = []string{"DiskModes", "Split", "WriteThrough", "Growable"}
+	requiredFields[reflect.TypeOf((*VirtualDiskSparseVer2BackingInfo)(nil)).Elem()] = []string{"DiskMode"}
+	requiredFields[reflect.TypeOf((*VirtualDiskSparseVer2BackingOption)(nil)).Elem()] = []string{"DiskMode", "Split", "WriteThrough", "Growable", "HotGrowable", "Uuid"}
+	requiredFields[reflect.TypeOf((*VirtualDiskSpec)(nil)).Elem()] = []string{"DiskType", "AdapterType"}
+	requiredFields[reflect.TypeOf((*VirtualEthernetCardDistributedVirtualPortBackingInfo)(nil)).Elem()] = []string{"Port"}
+	requiredFields[reflect.TypeOf((*VirtualEthernetCardOpaqueNetworkBackingInfo)(nil)).Elem()] = []string{"OpaqueNetworkId", "OpaqueNetworkType"}
+	requiredFields[reflect.TypeOf((*VirtualEthernetCardOption)(nil)).Elem()] = []string{"SupportedOUI", "MacType", "WakeOnLanEnabled"}
+	requiredFields[reflect.TypeOf((*VirtualEthernetCardResourceAllocation)(nil)).Elem()] = []string{"Share"}
+	requiredFields[reflect.TypeOf((*VirtualHardware)(nil)).Elem()] = []string{"NumCPU", "MemoryMB"}
+	requiredFields[reflect.TypeOf((*VirtualHardwareOption)(nil)).Elem()] = []string{"HwVersion", "VirtualDeviceOption", "DeviceListReadonly", "NumCPU", "NumCpuReadonly", "MemoryMB", "NumPCIControllers", "NumIDEControllers", "NumUSBControllers", "NumSIOControllers", "NumPS2Controllers"}
+	requiredFields[reflect.TypeOf((*VirtualHardwareVersionNotSupported)(nil)).Elem()] = []string{"HostName", "Host"}
+	requiredFields[reflect.TypeOf((*VirtualHardwareVersionNotSupportedFault)(nil)).Elem()] = []string{"HostName", "Host"}
+	requiredFields[reflect.TypeOf((*VirtualIDEControllerOption)(nil)).Elem()] = []string{"NumIDEDisks", "NumIDECdroms"}
+	requiredFields[reflect.TypeOf((*VirtualMachineBootOptionsBootableDiskDevice)(nil)).Elem()] = []string{"DeviceKey"}
+	requiredFields[reflect.TypeOf((*VirtualMachineBootOptionsBootableEthernetDevice)(nil)).Elem()] = []string{"DeviceKey"}
+	requiredFields[reflect.TypeOf((*VirtualMachineCapability)(nil)).Elem()] = []string{"SnapshotOperationsSupported", "MultipleSnapshotsSupported", "SnapshotConfigSupported", "PoweredOffSnapshotsSupported", "MemorySnapshotsSupported", "RevertToSnapshotSupported", "QuiescedSnapshotsSupported", "DisableSnapshotsSupported", "LockSnapshotsSupported", "ConsolePreferencesSupported", "CpuFeatureMaskSupported", "S1AcpiManagementSupported", "SettingScreenResolutionSupported", "ToolsAutoUpdateSupported", "VmNpivWwnSupported", "NpivWwnOnNonRdmVmSupported", "SwapPlacementSupported", "ToolsSyncTimeSupported", "VirtualMmuUsageSupported", "DiskSharesSupported", "BootOptionsSupported", "SettingVideoRamSizeSupported"}
+	requiredFields[reflect.TypeOf((*VirtualMachineCertThumbprint)(nil)).Elem()] = []string{"Thumbprint"}
+	requiredFields[reflect.TypeOf((*VirtualMachineCloneSpec)(nil)).Elem()] = []string{"Location", "Template", "PowerOn"}
+	requiredFields[reflect.TypeOf((*VirtualMachineConfigInfo)(nil)).Elem()] = []string{"ChangeVersion", "Modified", "Name", "GuestFullName", "Version", "Uuid", "Template", "GuestId", "AlternateGuestName", "Files", "Flags", "DefaultPowerOps", "Hardware"}
+	requiredFields[reflect.TypeOf((*VirtualMachineConfigInfoDatastoreUrlPair)(nil)).Elem()] = []string{"Name", "Url"}
+	requiredFields[reflect.TypeOf((*VirtualMachineConfigOption)(nil)).Elem()] = []string{"Version", "Description", "GuestOSDescriptor", "GuestOSDefaultIndex", "HardwareOptions", "Capabilities", "Datastore", "SupportedMonitorType"}
+	requiredFields[reflect.TypeOf((*VirtualMachineConfigOptionDescriptor)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*VirtualMachineConfigSummary)(nil)).Elem()] = []string{"Name", "Template", "VmPathName"}
+	requiredFields[reflect.TypeOf((*VirtualMachineConnection)(nil)).Elem()] = []string{"Label", "Client", "UserName"}
+	requiredFields[reflect.TypeOf((*VirtualMachineContentLibraryItemInfo)(nil)).Elem()] = []string{"ContentLibraryItemUuid"}
+	requiredFields[reflect.TypeOf((*VirtualMachineDatastoreInfo)(nil)).Elem()] = []string{"Datastore", "Capability", "MaxFileSize", "Mode"}
+	requiredFields[reflect.TypeOf((*VirtualMachineDatastoreVolumeOption)(nil)).Elem()] = []string{"FileSystemType"}
+	requiredFields[reflect.TypeOf((*VirtualMachineDefinedProfileSpec)(nil)).Elem()] = []string{"ProfileId"}
+	requiredFields[reflect.TypeOf((*VirtualMachineDeviceRuntimeInfo)(nil)).Elem()] = []string{"RuntimeState", "Key"}
+	requiredFields[reflect.TypeOf((*VirtualMachineDisplayTopology)(nil)).Elem()] = []string{"X", "Y", "Width", "Height"}
+	requiredFields[reflect.TypeOf((*VirtualMachineDvxClassInfo)(nil)).Elem()] = []string{"DeviceClass", "VendorName", "SriovNic"}
+	requiredFields[reflect.TypeOf((*VirtualMachineDynamicPassthroughInfo)(nil)).Elem()] = []string{"VendorName", "DeviceName", "VendorId", "DeviceId"}
+	requiredFields[reflect.TypeOf((*VirtualMachineFeatureRequirement)(nil)).Elem()] = []string{"Key", "FeatureName", "Value"}
+	requiredFields[reflect.TypeOf((*VirtualMachineFileLayoutDiskLayout)(nil)).Elem()] = []string{"Key", "DiskFile"}
+	requiredFields[reflect.TypeOf((*VirtualMachineFileLayoutEx)(nil)).Elem()] = []string{"Timestamp"}
+	requiredFields[reflect.TypeOf((*VirtualMachineFileLayoutExDiskLayout)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*VirtualMachineFileLayoutExDiskUnit)(nil)).Elem()] = []string{"FileKey"}
+	requiredFields[reflect.TypeOf((*VirtualMachineFileLayoutExFileInfo)(nil)).Elem()] = []string{"Key", "Name", "Type", "Size"}
+	requiredFields[reflect.TypeOf((*VirtualMachineFileLayoutExSnapshotLayout)(nil)).Elem()] = []string{"Key", "DataKey"}
+	requiredFields[reflect.TypeOf((*VirtualMachineFileLayoutSnapshotLayout)(nil)).Elem()] = []string{"Key", "SnapshotFile"}
+	requiredFields[reflect.TypeOf((*VirtualMachineIdeDiskDevicePartitionInfo)(nil)).Elem()] = []string{"Id", "Capacity"}
+	requiredFields[reflect.TypeOf((*VirtualMachineImportSpec)(nil)).Elem()] = []string{"ConfigSpec"}
+	requiredFields[reflect.TypeOf((*VirtualMachineIndependentFilterSpec)(nil)).Elem()] = []string{"FilterName"}
+	requiredFields[reflect.TypeOf((*VirtualMachineInstantCloneSpec)(nil)).Elem()] = []string{"Name", "Location"}
+	requiredFields[reflect.TypeOf((*VirtualMachineLegacyNetworkSwitchInfo)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*VirtualMachineMemoryReservationInfo)(nil)).Elem()] = []string{"VirtualMachineMin", "VirtualMachineMax", "VirtualMachineReserved", "AllocationPolicy"}
+	requiredFields[reflect.TypeOf((*VirtualMachineMessage)(nil)).Elem()] = []string{"Id"}
+	requiredFields[reflect.TypeOf((*VirtualMachineMetadataManagerVmMetadata)(nil)).Elem()] = []string{"VmId"}
+	requiredFields[reflect.TypeOf((*VirtualMachineMetadataManagerVmMetadataInput)(nil)).Elem()] = []string{"Operation", "VmMetadata"}
+	requiredFields[reflect.TypeOf((*VirtualMachineMetadataManagerVmMetadataOwner)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*VirtualMachineMetadataManagerVmMetadataResult)(nil)).Elem()] = []string{"VmMetadata"}
+	requiredFields[reflect.TypeOf((*VirtualMachineMksTicket)(nil)).Elem()] = []string{"Ticket", "CfgFile"}
+	requiredFields[reflect.TypeOf((*VirtualMachineNetworkInfo)(nil)).Elem()] = []string{"Network"}
+	requiredFields[reflect.TypeOf((*VirtualMachinePciPassthroughInfo)(nil)).Elem()] = []string{"PciDevice", "SystemId"}
+	requiredFields[reflect.TypeOf((*VirtualMachinePciSharedGpuPassthroughInfo)(nil)).Elem()] = []string{"Vgpu"}
+	requiredFields[reflect.TypeOf((*VirtualMachineProfileDetailsDiskProfileDetails)(nil)).Elem()] = []string{"DiskId"}
+	requiredFields[reflect.TypeOf((*VirtualMachineProfileRawData)(nil)).Elem()] = []string{"ExtensionKey"}
+	requiredFields[reflect.TypeOf((*VirtualMachinePropertyRelation)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*VirtualMachineQuestionInfo)(nil)).Elem()] = []string{"Id", "Text", "Choice"}
+	requiredFields[reflect.TypeOf((*VirtualMachineQuickStats)(nil)).Elem()] = []string{"GuestHeartbeatStatus"}
+	requiredFields[reflect.TypeOf((*VirtualMachineQuickStatsMemoryTierStats)(nil)).Elem()] = []string{"MemoryTierType", "ReadBandwidth"}
+	requiredFields[reflect.TypeOf((*VirtualMachineRelocateSpecDiskLocator)(nil)).Elem()] = []string{"DiskId", "Datastore"}
+	requiredFields[reflect.TypeOf((*VirtualMachineRuntimeInfo)(nil)).Elem()] = []string{"ConnectionState", "PowerState", "ToolsInstallerMounted", "NumMksConnections"}
+	requiredFields[reflect.TypeOf((*VirtualMachineRuntimeInfoDasProtectionState)(nil)).Elem()] = []string{"DasProtected"}
+	requiredFields[reflect.TypeOf((*VirtualMachineScsiPassthroughInfo)(nil)).Elem()] = []string{"ScsiClass", "Vendor", "PhysicalUnitNumber"}
+	requiredFields[reflect.TypeOf((*VirtualMachineSgxInfo)(nil)).Elem()] = []string{"EpcSize"}
+	requiredFields[reflect.TypeOf((*VirtualMachineSgxTargetInfo)(nil)).Elem()] = []string{"MaxEpcSize"}
+	requiredFields[reflect.TypeOf((*VirtualMachineSnapshotInfo)(nil)).Elem()] = []string{"RootSnapshotList"}
+	requiredFields[reflect.TypeOf((*VirtualMachineSnapshotTree)(nil)).Elem()] = []string{"Snapshot", "Vm", "Name", "Description", "CreateTime", "State", "Quiesced"}
+	requiredFields[reflect.TypeOf((*VirtualMachineSriovDevicePoolInfo)(nil)).Elem()] = []string{"Key"}
+	requiredFields[reflect.TypeOf((*VirtualMachineSriovInfo)(nil)).Elem()] = []string{"VirtualFunction"}
+	requiredFields[reflect.TypeOf((*VirtualMachineStorageInfo)(nil)).Elem()] = []string{"Timestamp"}
+	requiredFields[reflect.TypeOf((*VirtualMachineStorageSummary)(nil)).Elem()] = []string{"Committed", "Uncommitted", "Unshared", "Timestamp"}
+	requiredFields[reflect.TypeOf((*VirtualMachineSummary)(nil)).Elem()] = []string{"Runtime", "Config", "QuickStats", "OverallStatus"}
+	requiredFields[reflect.TypeOf((*VirtualMachineTargetInfo)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*VirtualMachineTicket)(nil)).Elem()] = []string{"Ticket", "CfgFile"}
+	requiredFields[reflect.TypeOf((*VirtualMachineUsageOnDatastore)(nil)).Elem()] = []string{"Datastore", "Committed", "Uncommitted", "Unshared"}
+	requiredFields[reflect.TypeOf((*VirtualMachineUsbInfo)(nil)).Elem()] = []string{"Description", "Vendor", "Product", "PhysicalPath"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVFlashModuleInfo)(nil)).Elem()] = []string{"VFlashModule"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVMCIDeviceFilterSpec)(nil)).Elem()] = []string{"Rank", "Action", "Protocol", "Direction"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVMCIDeviceOption)(nil)).Elem()] = []string{"AllowUnrestrictedCommunication"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVMCIDeviceOptionFilterSpecOption)(nil)).Elem()] = []string{"Action", "Protocol", "Direction", "LowerDstPortBoundary", "UpperDstPortBoundary"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVMotionStunTimeInfo)(nil)).Elem()] = []string{"MigrationBW", "StunTime"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVendorDeviceGroupInfo)(nil)).Elem()] = []string{"DeviceGroupName"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVendorDeviceGroupInfoComponentDeviceInfo)(nil)).Elem()] = []string{"Type", "VendorName", "DeviceName", "IsConfigurable", "Device"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVgpuDeviceInfo)(nil)).Elem()] = []string{"DeviceName", "DeviceVendorId", "MaxFbSizeInGib", "TimeSlicedCapable", "MigCapable", "ComputeProfileCapable", "QuadroProfileCapable"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVgpuProfileInfo)(nil)).Elem()] = []string{"ProfileName", "DeviceVendorId", "FbSizeInGib", "ProfileSharing", "ProfileClass"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVirtualDeviceGroupsDeviceGroup)(nil)).Elem()] = []string{"GroupInstanceKey"}
+	requiredFields[reflect.TypeOf((*VirtualMachineVirtualDeviceGroupsVendorDeviceGroup)(nil)).Elem()] = []string{"DeviceGroupName"}
+	requiredFields[reflect.TypeOf((*VirtualMachineWipeResult)(nil)).Elem()] = []string{"DiskId", "ShrinkableDiskSpace"}
+	requiredFields[reflect.TypeOf((*VirtualNVDIMM)(nil)).Elem()] = []string{"CapacityInMB"}
+	requiredFields[reflect.TypeOf((*VirtualNVDIMMControllerOption)(nil)).Elem()] = []string{"NumNVDIMMControllers"}
+	requiredFields[reflect.TypeOf((*VirtualNVDIMMOption)(nil)).Elem()] = []string{"CapacityInMB", "Growable", "HotGrowable", "GranularityInMB"}
+	requiredFields[reflect.TypeOf((*VirtualNVMEControllerOption)(nil)).Elem()] = []string{"NumNVMEDisks"}
+	requiredFields[reflect.TypeOf((*VirtualNicManagerNetConfig)(nil)).Elem()] = []string{"NicType", "MultiSelectAllowed"}
+	requiredFields[reflect.TypeOf((*VirtualPCIControllerOption)(nil)).Elem()] = []string{"NumSCSIControllers", "NumEthernetCards", "NumVideoCards", "NumSoundCards", "NumVmiRoms"}
+	requiredFields[reflect.TypeOf((*VirtualPCIPassthroughAllowedDevice)(nil)).Elem()] = []string{"VendorId", "DeviceId"}
+	requiredFields[reflect.TypeOf((*VirtualPCIPassthroughDeviceBackingInfo)(nil)).Elem()] = []string{"Id", "DeviceId", "SystemId", "VendorId"}
+	requiredFields[reflect.TypeOf((*VirtualPCIPassthroughVmiopBackingOption)(nil)).Elem()] = []string{"Vgpu", "MaxInstances"}
+	requiredFields[reflect.TypeOf((*VirtualPCNet32Option)(nil)).Elem()] = []string{"SupportsMorphing"}
+	requiredFields[reflect.TypeOf((*VirtualPS2ControllerOption)(nil)).Elem()] = []string{"NumKeyboards", "NumPointingDevices"}
+	requiredFields[reflect.TypeOf((*VirtualPointingDeviceBackingOption)(nil)).Elem()] = []string{"HostPointingDevice"}
+	requiredFields[reflect.TypeOf((*VirtualPointingDeviceDeviceBackingInfo)(nil)).Elem()] = []string{"HostPointingDevice"}
+	requiredFields[reflect.TypeOf((*VirtualPrecisionClockSystemClockBackingOption)(nil)).Elem()] = []string{"Protocol"}
+	requiredFields[reflect.TypeOf((*VirtualSATAControllerOption)(nil)).Elem()] = []string{"NumSATADisks", "NumSATACdroms"}
+	requiredFields[reflect.TypeOf((*VirtualSCSIController)(nil)).Elem()] = []string{"SharedBus"}
+	requiredFields[reflect.TypeOf((*VirtualSCSIControllerOption)(nil)).Elem()] = []string{"NumSCSIDisks", "NumSCSICdroms", "NumSCSIPassthrough", "Sharing", "DefaultSharedIndex", "HotAddRemove", "ScsiCtlrUnitNumber"}
+	requiredFields[reflect.TypeOf((*VirtualSIOControllerOption)(nil)).Elem()] = []string{"NumFloppyDrives", "NumSerialPorts", "NumParallelPorts"}
+	requiredFields[reflect.TypeOf((*VirtualSerialPort)(nil)).Elem()] = []string{"YieldOnPoll"}
+	requiredFields[reflect.TypeOf((*VirtualSerialPortOption)(nil)).Elem()] = []string{"YieldOnPoll"}
+	requiredFields[reflect.TypeOf((*VirtualSerialPortPipeBackingInfo)(nil)).Elem()] = []string{"Endpoint"}
+	requiredFields[reflect.TypeOf((*VirtualSerialPortPipeBackingOption)(nil)).Elem()] = []string{"Endpoint", "NoRxLoss"}
+	requiredFields[reflect.TypeOf((*VirtualSwitchProfile)(nil)).Elem()] = []string{"Key", "Name", "Link", "NumPorts", "NetworkPolicy"}
+	requiredFields[reflect.TypeOf((*VirtualUSB)(nil)).Elem()] = []string{"Connected"}
+	requiredFields[reflect.TypeOf((*VirtualUSBControllerOption)(nil)).Elem()] = []string{"AutoConnectDevices", "EhciSupported"}
+	requiredFields[reflect.TypeOf((*VirtualUSBRemoteClientBackingInfo)(nil)).Elem()] = []string{"Hostname"}
+	requiredFields[reflect.TypeOf((*VirtualUSBRemoteHostBackingInfo)(nil)).Elem()] = []string{"Hostname"}
+	requiredFields[reflect.TypeOf((*VirtualUSBXHCIControllerOption)(nil)).Elem()] = []string{"AutoConnectDevices", "SupportedSpeeds"}
+	requiredFields[reflect.TypeOf((*VirtualWDT)(nil)).Elem()] = []string{"RunOnBoot", "Running"}
+	requiredFields[reflect.TypeOf((*VirtualWDTOption)(nil)).Elem()] = []string{"RunOnBoot"}
+	requiredFields[reflect.TypeOf((*VmAcquiredTicketEvent)(nil)).Elem()] = []string{"TicketType"}
+	requiredFields[reflect.TypeOf((*VmAlreadyExistsInDatacenter)(nil)).Elem()] = []string{"Host", "Hostname", "Vm"}
+	requiredFields[reflect.TypeOf((*VmAlreadyExistsInDatacenterFault)(nil)).Elem()] = []string{"Host", "Hostname", "Vm"}
+	requiredFields[reflect.TypeOf((*VmAutoRenameEvent)(nil)).Elem()] = []string{"OldName", "NewName"}
+	requiredFields[reflect.TypeOf((*VmBeingClonedEvent)(nil)).Elem()] = []string{"DestFolder", "DestName", "DestHost"}
+	requiredFields[reflect.TypeOf((*VmBeingClonedNoFolderEvent)(nil)).Elem()] = []string{"DestName", "DestHost"}
+	requiredFields[reflect.TypeOf((*VmBeingDeployedEvent)(nil)).Elem()] = []string{"SrcTemplate"}
+	requiredFields[reflect.TypeOf((*VmBeingHotMigratedEvent)(nil)).Elem()] = []string{"DestHost"}
+	requiredFields[reflect.TypeOf((*VmBeingMigratedEvent)(nil)).Elem()] = []string{"DestHost"}
+	requiredFields[reflect.TypeOf((*VmBeingRelocatedEvent)(nil)).Elem()] = []string{"DestHost"}
+	requiredFields[reflect.TypeOf((*VmCloneFailedEvent)(nil)).Elem()] = []string{"DestFolder", "DestName", "DestHost", "Reason"}
+	requiredFields[reflect.TypeOf((*VmClonedEvent)(nil)).Elem()] = []string{"SourceVm"}
+	requiredFields[reflect.TypeOf((*VmConfigFileQueryFlags)(nil)).Elem()] = []string{"ConfigVersion"}
+	requiredFields[reflect.TypeOf((*VmConfigInfo)(nil)).Elem()] = []string{"IpAssignment", "InstallBootRequired", "InstallBootStopDelay"}
+	requiredFields[reflect.TypeOf((*VmDasBeingResetWithScreenshotEvent)(nil)).Elem()] = []string{"ScreenshotFilePath"}
+	requiredFields[reflect.TypeOf((*VmDeployFailedEvent)(nil)).Elem()] = []string{"DestDatastore", "Reason"}
+	requiredFields[reflect.TypeOf((*VmDeployedEvent)(nil)).Elem()] = []string{"SrcTemplate"}
+	requiredFields[reflect.TypeOf((*VmDiskFailedEvent)(nil)).Elem()] = []string{"Disk", "Reason"}
+	requiredFields[reflect.TypeOf((*VmDiskFileQueryFlags)(nil)).Elem()] = []string{"DiskType", "CapacityKb", "HardwareVersion"}
+	requiredFields[reflect.TypeOf((*VmEvent)(nil)).Elem()] = []string{"Template"}
+	requiredFields[reflect.TypeOf((*VmEventArgument)(nil)).Elem()] = []string{"Vm"}
+	requiredFields[reflect.TypeOf((*VmFailedMigrateEvent)(nil)).Elem()] = []string{"DestHost", "Reason"}
+	requiredFields[reflect.TypeOf((*VmFailedRelayoutEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*VmFailedToPowerOffEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*VmFailedToPowerOnEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*VmFailedToRebootGuestEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*VmFailedToResetEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*VmFailedToShutdownGuestEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*VmFailedToStandbyGuestEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*VmFailedToSuspendEvent)(nil)).Elem()] = []string{"Reason"}
+	requiredFields[reflect.TypeOf((*VmFaultToleranceStateChangedEvent)(nil)).Elem()] = []string{"OldState", "NewState"}
+	requiredFields[reflect.TypeOf((*VmFaultToleranceTooManyFtVcpusOnHost)(nil)).Elem()] = []string{"MaxNumFtVcpus"}
+	requiredFields[reflect.TypeOf((*VmFaultToleranceTooManyFtVcpusOnHostFault)(nil)).Elem()] = []string{"MaxNumFtVcpus"}
+	requiredFields[reflect.TypeOf((*VmFaultToleranceTooManyVMsOnHost)(nil)).Elem()] = []string{"MaxNumFtVms"}
+	requiredFields[reflect.TypeOf((*VmFaultToleranceTooManyVMsOnHostFault)(nil)).Elem()] = []string{"MaxNumFtVms"}
+	requiredFields[reflect.TypeOf((*VmHealthMonitoringStateChangedEvent)(nil)).Elem()] = []string{"State"}
+	requiredFields[reflect.TypeOf((*VmHostAffinityRuleViolation)(nil)).Elem()] = []string{"VmName", "HostName"}
+	requiredFields[reflect.TypeOf((*VmHostAffinityRuleViolationFault)(nil)).Elem()] = []string{"VmName", "HostName"}
+	requiredFields[reflect.TypeOf((*VmInstanceUuidAssignedEvent)(nil)).Elem()] = []string{"InstanceUuid"}
+	requiredFields[reflect.TypeOf((*VmInstanceUuidChangedEvent)(nil)).Elem()] = []string{"OldInstanceUuid", "NewInstanceUuid"}
+	requiredFields[reflect.TypeOf((*VmInstanceUuidConflictEvent)(nil)).Elem()] = []string{"ConflictedVm", "InstanceUuid"}
+	requiredFields[reflect.TypeOf((*VmLimitLicense)(nil)).Elem()] = []string{"Limit"}
+	requiredFields[reflect.TypeOf((*VmLimitLicenseFault)(nil)).Elem()] = []string{"Limit"}
+	requiredFields[reflect.TypeOf((*VmMacAssignedEvent)(nil)).Elem()] = []string{"Adapter", "Mac"}
+	requiredFields[reflect.TypeOf((*VmMacChangedEvent)(nil)).Elem()] = []string{"Adapter", "OldMac", "NewMac"}
+	requiredFields[reflect.TypeOf((*VmMacConflictEvent)(nil)).Elem()] = []string{"ConflictedVm", "Mac"}
+	requiredFields[reflect.TypeOf((*VmMessageErrorEvent)(nil)).Elem()] = []string{"Message"}
+	requiredFields[reflect.TypeOf((*VmMessageEvent)(nil)).Elem()] = []string{"Message"}
+	requiredFields[reflect.TypeOf((*VmMessageWarningEvent)(nil)).Elem()] = []string{"Message"}
+	requiredFields[reflect.TypeOf((*VmMigratedEvent)(nil)).Elem()] = []string{"SourceHost"}
+	requiredFields[reflect.TypeOf((*VmNoNetworkAccessEvent)(nil)).Elem()] = []string{"DestHost"}
+	requiredFields[reflect.TypeOf((*VmPodConfigForPlacement)(nil)).Elem()] = []string{"StoragePod"}
+	requiredFields[reflect.TypeOf((*VmPowerOffOnIsolationEvent)(nil)).Elem()] = []string{"IsolatedHost"}
+	requiredFields[reflect.TypeOf((*VmPoweringOnWithCustomizedDVPortEvent)(nil)).Elem()] = []string{"Vnic"}
+	requiredFields[reflect.TypeOf((*VmReconfiguredEvent)(nil)).Elem()] = []string{"ConfigSpec"}
+	requiredFields[reflect.TypeOf((*VmReloadFromPathEvent)(nil)).Elem()] = []string{"ConfigPath"}
+	requiredFields[reflect.TypeOf((*VmReloadFromPathFailedEvent)(nil)).Elem()] = []string{"ConfigPath"}
+	requiredFields[reflect.TypeOf((*VmRelocateFailedEvent)(nil)).Elem()] = []string{"DestHost", "Reason"}
+	requiredFields[reflect.TypeOf((*VmRelocatedEvent)(nil)).Elem()] = []string{"SourceHost"}
+	requiredFields[reflect.TypeOf((*VmRenamedEvent)(nil)).Elem()] = []string{"OldName", "NewName"}
+	requiredFields[reflect.TypeOf((*VmResourcePoolMovedEvent)(nil)).Elem()] = []string{"OldParent", "NewParent"}
+	requiredFields[reflect.TypeOf((*VmRestartedOnAlternateHostEvent)(nil)).Elem()] = []string{"SourceHost"}
+	requiredFields[reflect.TypeOf((*VmShutdownOnIsolationEvent)(nil)).Elem()] = []string{"IsolatedHost"}
+	requiredFields[reflect.TypeOf((*VmSmpFaultToleranceTooManyVMsOnHost)(nil)).Elem()] = []string{"MaxNumSmpFtVms"}
+	requiredFields[reflect.TypeOf((*VmSmpFaultToleranceTooManyVMsOnHostFault)(nil)).Elem()] = []string{"MaxNumSmpFtVms"}
+	requiredFields[reflect.TypeOf((*VmStaticMacConflictEvent)(nil)).Elem()] = []string{"ConflictedVm", "Mac"}
+	requiredFields[reflect.TypeOf((*VmUnsupportedStartingEvent)(nil)).Elem()] = []string{"GuestId"}
+	requiredFields[reflect.TypeOf((*VmUpgradeCompleteEvent)(nil)).Elem()] = []string{"Version"}
+	requiredFields[reflect.TypeOf((*VmUpgradingEvent)(nil)).Elem()] = []string{"Version"}
+	requiredFields[reflect.TypeOf((*VmUuidAssignedEvent)(nil)).Elem()] = []string{"Uuid"}
+	requiredFields[reflect.TypeOf((*VmUuidChangedEvent)(nil)).Elem()] = []string{"OldUuid", "NewUuid"}
+	requiredFields[reflect.TypeOf((*VmUuidConflictEvent)(nil)).Elem()] = []string{"ConflictedVm", "Uuid"}
+	requiredFields[reflect.TypeOf((*VmValidateMaxDevice)(nil)).Elem()] = []string{"Device", "Max", "Count"}
+	requiredFields[reflect.TypeOf((*VmValidateMaxDeviceFault)(nil)).Elem()] = []string{"Device", "Max", "Count"}
+	requiredFields[reflect.TypeOf((*VmVnicPoolReservationViolationClearEvent)(nil)).Elem()] = []string{"VmVnicResourcePoolKey"}
+	requiredFields[reflect.TypeOf((*VmVnicPoolReservationViolationRaiseEvent)(nil)).Elem()] = []string{"VmVnicResourcePoolKey"}
+	requiredFields[reflect.TypeOf((*VmWwnAssignedEvent)(nil)).Elem()] = []string{"NodeWwns", "PortWwns"}
+	requiredFields[reflect.TypeOf((*VmWwnConflictEvent)(nil)).Elem()] = []string{"Wwn"}
+	requiredFields[reflect.TypeOf((*VmfsConfigOption)(nil)).Elem()] = []string{"BlockSizeOption"}
+	requiredFields[reflect.TypeOf((*VmfsDatastoreBaseOption)(nil)).Elem()] = []string{"Layout"}
+	requiredFields[reflect.TypeOf((*VmfsDatastoreCreateSpec)(nil)).Elem()] = []string{"Partition", "Vmfs"}
+	requiredFields[reflect.TypeOf((*VmfsDatastoreExpandSpec)(nil)).Elem()] = []string{"Partition", "Extent"}
+	requiredFields[reflect.TypeOf((*VmfsDatastoreExtendSpec)(nil)).Elem()] = []string{"Partition", "Extent"}
+	requiredFields[reflect.TypeOf((*VmfsDatastoreMultipleExtentOption)(nil)).Elem()] = []string{"VmfsExtent"}
+	requiredFields[reflect.TypeOf((*VmfsDatastoreOption)(nil)).Elem()] = []string{"Info", "Spec"}
+	requiredFields[reflect.TypeOf((*VmfsDatastoreSingleExtentOption)(nil)).Elem()] = []string{"VmfsExtent"}
+	requiredFields[reflect.TypeOf((*VmfsDatastoreSpec)(nil)).Elem()] = []string{"DiskUuid"}
+	requiredFields[reflect.TypeOf((*VmfsMountFault)(nil)).Elem()] = []string{"Uuid"}
+	requiredFields[reflect.TypeOf((*VmfsUnmapBandwidthSpec)(nil)).Elem()] = []string{"Policy", "FixedValue", "DynamicMin", "DynamicMax"}
+	requiredFields[reflect.TypeOf((*VmwareDistributedVirtualSwitchPvlanSpec)(nil)).Elem()] = []string{"PvlanId"}
+	requiredFields[reflect.TypeOf((*VmwareDistributedVirtualSwitchVlanIdSpec)(nil)).Elem()] = []string{"VlanId"}
+	requiredFields[reflect.TypeOf((*VnicPortArgument)(nil)).Elem()] = []string{"Vnic", "Port"}
+	requiredFields[reflect.TypeOf((*VramLimitLicense)(nil)).Elem()] = []string{"Limit"}
+	requiredFields[reflect.TypeOf((*VramLimitLicenseFault)(nil)).Elem()] = []string{"Limit"}
+	requiredFields[reflect.TypeOf((*VsanClusterUuidMismatch)(nil)).Elem()] = []string{"HostClusterUuid", "DestinationClusterUuid"}
+	requiredFields[reflect.TypeOf((*VsanClusterUuidMismatchFault)(nil)).Elem()] = []string{"HostClusterUuid", "DestinationClusterUuid"}
+	requiredFields[reflect.TypeOf((*VsanHostClusterStatus)(nil)).Elem()] = []string{"Health", "NodeState"}
+	requiredFields[reflect.TypeOf((*VsanHostClusterStatusState)(nil)).Elem()] = []string{"State"}
+	requiredFields[reflect.TypeOf((*VsanHostConfigInfoNetworkInfoPortConfig)(nil)).Elem()] = []string{"Device"}
+	requiredFields[reflect.TypeOf((*VsanHostDecommissionMode)(nil)).Elem()] = []string{"ObjectAction"}
+	requiredFields[reflect.TypeOf((*VsanHostDiskMapInfo)(nil)).Elem()] = []string{"Mapping", "Mounted"}
+	requiredFields[reflect.TypeOf((*VsanHostDiskMapResult)(nil)).Elem()] = []string{"Mapping"}
+	requiredFields[reflect.TypeOf((*VsanHostDiskMapping)(nil)).Elem()] = []string{"Ssd", "NonSsd"}
+	requiredFields[reflect.TypeOf((*VsanHostDiskResult)(nil)).Elem()] = []string{"Disk", "State"}
+	requiredFields[reflect.TypeOf((*VsanHostFaultDomainInfo)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*VsanHostIpConfig)(nil)).Elem()] = []string{"UpstreamIpAddress", "DownstreamIpAddress"}
+	requiredFields[reflect.TypeOf((*VsanHostMembershipInfo)(nil)).Elem()] = []string{"NodeUuid", "Hostname"}
+	requiredFields[reflect.TypeOf((*VsanHostRuntimeInfoDiskIssue)(nil)).Elem()] = []string{"DiskId", "Issue"}
+	requiredFields[reflect.TypeOf((*VsanHostVsanDiskInfo)(nil)).Elem()] = []string{"VsanUuid", "FormatVersion"}
+	requiredFields[reflect.TypeOf((*VsanPolicySatisfiability)(nil)).Elem()] = []string{"IsSatisfiable"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemAPIBrokenIssue)(nil)).Elem()] = []string{"Hosts"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemAutoClaimEnabledOnHostsIssue)(nil)).Elem()] = []string{"Hosts"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemHostsDisconnectedIssue)(nil)).Elem()] = []string{"Hosts"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemMissingHostsInClusterIssue)(nil)).Elem()] = []string{"Hosts"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemNetworkPartitionInfo)(nil)).Elem()] = []string{"Hosts"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemNetworkPartitionIssue)(nil)).Elem()] = []string{"Partitions"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemNotEnoughFreeCapacityIssue)(nil)).Elem()] = []string{"ReducedRedundancyUpgradePossible"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemPreflightCheckIssue)(nil)).Elem()] = []string{"Msg"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemRogueHostsInClusterIssue)(nil)).Elem()] = []string{"Uuids"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemUpgradeHistoryDiskGroupOp)(nil)).Elem()] = []string{"Operation", "DiskMapping"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemUpgradeHistoryItem)(nil)).Elem()] = []string{"Timestamp", "Message"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemUpgradeHistoryPreflightFail)(nil)).Elem()] = []string{"PreflightResult"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemUpgradeStatus)(nil)).Elem()] = []string{"InProgress"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemV2ObjectsPresentDuringDowngradeIssue)(nil)).Elem()] = []string{"Uuids"}
+	requiredFields[reflect.TypeOf((*VsanUpgradeSystemWrongEsxVersionIssue)(nil)).Elem()] = []string{"Hosts"}
+	requiredFields[reflect.TypeOf((*VslmCloneSpec)(nil)).Elem()] = []string{"Name"}
+	requiredFields[reflect.TypeOf((*VslmCreateSpec)(nil)).Elem()] = []string{"Name", "BackingSpec", "CapacityInMB"}
+	requiredFields[reflect.TypeOf((*VslmCreateSpecBackingSpec)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*VslmCreateSpecRawDiskMappingBackingSpec)(nil)).Elem()] = []string{"LunUuid", "CompatibilityMode"}
+	requiredFields[reflect.TypeOf((*VslmInfrastructureObjectPolicy)(nil)).Elem()] = []string{"Name", "BackingObjectId", "ProfileId"}
+	requiredFields[reflect.TypeOf((*VslmInfrastructureObjectPolicySpec)(nil)).Elem()] = []string{"Datastore"}
+	requiredFields[reflect.TypeOf((*VslmMigrateSpec)(nil)).Elem()] = []string{"BackingSpec"}
+	requiredFields[reflect.TypeOf((*VslmTagEntry)(nil)).Elem()] = []string{"TagName", "ParentCategoryName"}
+	requiredFields[reflect.TypeOf((*VslmVClockInfo)(nil)).Elem()] = []string{"VClockTime"}
+	requiredFields[reflect.TypeOf((*VspanDestPortConflict)(nil)).Elem()] = []string{"VspanSessionKey1", "VspanSessionKey2", "PortKey"}
+	requiredFields[reflect.TypeOf((*VspanDestPortConflictFault)(nil)).Elem()] = []string{"VspanSessionKey1", "VspanSessionKey2", "PortKey"}
+	requiredFields[reflect.TypeOf((*VspanPortConflict)(nil)).Elem()] = []string{"VspanSessionKey1", "VspanSessionKey2", "PortKey"}
+	requiredFields[reflect.TypeOf((*VspanPortConflictFault)(nil)).Elem()] = []string{"VspanSessionKey1", "VspanSessionKey2", "PortKey"}
+	requiredFields[reflect.TypeOf((*VspanPortMoveFault)(nil)).Elem()] = []string{"SrcPortgroupName", "DestPortgroupName", "PortKey"}
+	requiredFields[reflect.TypeOf((*VspanPortMoveFaultFault)(nil)).Elem()] = []string{"SrcPortgroupName", "DestPortgroupName", "PortKey"}
+	requiredFields[reflect.TypeOf((*VspanPortPromiscChangeFault)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*VspanPortPromiscChangeFaultFault)(nil)).Elem()] = []string{"PortKey"}
+	requiredFields[reflect.TypeOf((*VspanPortgroupPromiscChangeFault)(nil)).Elem()] = []string{"PortgroupName"}
+	requiredFields[reflect.TypeOf((*VspanPortgroupPromiscChangeFaultFault)(nil)).Elem()] = []string{"PortgroupName"}
+	requiredFields[reflect.TypeOf((*VspanPortgroupTypeChangeFault)(nil)).Elem()] = []string{"PortgroupName"}
+	requiredFields[reflect.TypeOf((*VspanPortgroupTypeChangeFaultFault)(nil)).Elem()] = []string{"PortgroupName"}
+	requiredFields[reflect.TypeOf((*VspanPromiscuousPortNotSupported)(nil)).Elem()] = []string{"VspanSessionKey", "PortKey"}
+	requiredFields[reflect.TypeOf((*VspanPromiscuousPortNotSupportedFault)(nil)).Elem()] = []string{"VspanSessionKey", "PortKey"}
+	requiredFields[reflect.TypeOf((*VspanSameSessionPortConflict)(nil)).Elem()] = []string{"VspanSessionKey", "PortKey"}
+	requiredFields[reflect.TypeOf((*VspanSameSessionPortConflictFault)(nil)).Elem()] = []string{"VspanSessionKey", "PortKey"}
+	requiredFields[reflect.TypeOf((*VstorageObjectVCenterQueryChangedDiskAreas)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId", "StartOffset", "ChangeId"}
+	requiredFields[reflect.TypeOf((*VstorageObjectVCenterQueryChangedDiskAreasRequestType)(nil)).Elem()] = []string{"This", "Id", "Datastore", "SnapshotId", "StartOffset", "ChangeId"}
+	requiredFields[reflect.TypeOf((*VstorageObjectVCenterQueryChangedDiskAreasResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*WaitForUpdates)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*WaitForUpdatesEx)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*WaitForUpdatesExRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*WaitForUpdatesRequestType)(nil)).Elem()] = []string{"This"}
+	requiredFields[reflect.TypeOf((*WaitForUpdatesResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*WeeklyTaskScheduler)(nil)).Elem()] = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	requiredFields[reflect.TypeOf((*WillLoseHAProtection)(nil)).Elem()] = []string{"Resolution"}
+	requiredFields[reflect.TypeOf((*WillLoseHAProtectionFault)(nil)).Elem()] = []string{"Resolution"}
+	requiredFields[reflect.TypeOf((*WinNetBIOSConfigInfo)(nil)).Elem()] = []string{"PrimaryWINS"}
+	requiredFields[reflect.TypeOf((*WitnessNodeInfo)(nil)).Elem()] = []string{"IpSettings"}
+	requiredFields[reflect.TypeOf((*XmlToCustomizationSpecItem)(nil)).Elem()] = []string{"This", "SpecItemXml"}
+	requiredFields[reflect.TypeOf((*XmlToCustomizationSpecItemRequestType)(nil)).Elem()] = []string{"This", "SpecItemXml"}
+	requiredFields[reflect.TypeOf((*XmlToCustomizationSpecItemResponse)(nil)).Elem()] = []string{"Returnval"}
+	requiredFields[reflect.TypeOf((*ZeroFillVirtualDiskRequestType)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*ZeroFillVirtualDisk_Task)(nil)).Elem()] = []string{"This", "Name"}
+	requiredFields[reflect.TypeOf((*ZeroFillVirtualDisk_TaskResponse)(nil)).Elem()] = []string{"Returnval"}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// xsdDateTimeLayouts are accepted by ParseTime, in addition to RFC3339.
+// An xsd:dateTime without a zone designator is interpreted as UTC,
+// which is how vCenter and ESX report such values.
+var xsdDateTimeLayouts = []string{
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// IsUnsetTime returns true if the given time field has no value.
+// Unset time fields are nil in optional properties, but some APIs report
+// them as the zero time or as the Unix epoch.
+func IsUnsetTime(t *time.Time) bool {
+	return t == nil || t.IsZero() || t.Unix() == 0
+}
+
+// TimeValue returns the value of a time field in UTC, or the zero time if unset.
+func TimeValue(t *time.Time) time.Time {
+	if IsUnsetTime(t) {
+		return time.Time{}
+	}
+	return t.UTC()
+}
+
+// NewTimeValue returns a pointer to t in UTC, or nil if t is the zero time.
+// The result is suitable for optional time fields, where a zero time
+// would be encoded as "0001-01-01T00:00:00Z" rather than omitted.
+func NewTimeValue(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return NewTime(t.UTC())
+}
+
+// ParseTime parses an RFC3339 or xsd:dateTime timestamp, or a Unix epoch
+// in seconds, milliseconds or microseconds. The result is in UTC.
+func ParseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return TimeFromEpoch(n), nil
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.UTC(), nil
+	}
+
+	for _, layout := range xsdDateTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp: %q", s)
+}
+
+// FormatTime formats t as RFC3339 in the given location, or UTC if loc is nil.
+// An empty string is returned if t is unset.
+func FormatTime(t *time.Time, loc *time.Location) string {
+	if IsUnsetTime(t) {
+		return ""
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format(time.RFC3339Nano)
+}
+
+// TimeFromEpoch converts a Unix epoch to a time in UTC.
+// The unit is inferred from the magnitude of n: seconds, milliseconds or microseconds.
+func TimeFromEpoch(n int64) time.Time {
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+
+	switch {
+	case abs >= 1e15:
+		return time.UnixMicro(n).UTC()
+	case abs >= 1e12:
+		return time.UnixMilli(n).UTC()
+	default:
+		return time.Unix(n, 0).UTC()
+	}
+}
+
+// TimeToEpoch returns t as a Unix epoch in seconds, or 0 if t is unset.
+func TimeToEpoch(t *time.Time) int64 {
+	if IsUnsetTime(t) {
+		return 0
+	}
+	return t.Unix()
+}
+
+// Location returns a time.Location for the given host time zone.
+// The zone is loaded by Key when known to the local tz database,
+// otherwise a fixed zone is created using GmtOffset.
+func (z *HostDateTimeSystemTimeZone) Location() *time.Location {
+	if z == nil {
+		return time.UTC
+	}
+
+	if z.Key != "" {
+		if loc, err := time.LoadLocation(z.Key); err == nil {
+			return loc
+		}
+	}
+
+	name := z.Name
+	if name == "" {
+		name = z.Key
+	}
+
+	return time.FixedZone(name, int(z.GmtOffset))
+}
+
+// NewEventFilterSpecByTime returns a time window for EventFilterSpec.Time.
+// A zero begin or end leaves that side of the window open.
+// Nil is returned if both are zero.
+func NewEventFilterSpecByTime(begin, end time.Time) *EventFilterSpecByTime {
+	if begin.IsZero() && end.IsZero() {
+		return nil
+	}
+	return &EventFilterSpecByTime{
+		BeginTime: NewTimeValue(begin),
+		EndTime:   NewTimeValue(end),
+	}
+}
+
+// NewTaskFilterSpecByTime returns a time window for TaskFilterSpec.Time,
+// matching on the given task time field.
+// A zero begin or end leaves that side of the window open.
+// Nil is returned if both are zero.
+func NewTaskFilterSpecByTime(option TaskFilterSpecTimeOption, begin, end time.Time) *TaskFilterSpecByTime {
+	if begin.IsZero() && end.IsZero() {
+		return nil
+	}
+	return &TaskFilterSpecByTime{
+		TimeType:  option,
+		BeginTime: NewTimeValue(begin),
+		EndTime:   NewTimeValue(end),
+	}
+}
+
+// EventChainWindow returns the time window spanned by the events with the given
+// ChainId, suitable for use with NewEventFilterSpecByTime to query related events.
+// Zero times are returned if no event matches.
+func EventChainWindow(events []BaseEvent, chainID int32) (time.Time, time.Time) {
+	var begin, end time.Time
+
+	for _, e := range events {
+		event := e.GetEvent()
+		if event.ChainId != chainID || event.CreatedTime.IsZero() {
+			continue
+		}
+
+		created := event.CreatedTime.UTC()
+		if begin.IsZero() || created.Before(begin) {
+			begin = created
+		}
+		if created.After(end) {
+			end = created
+		}
+	}
+
+	return begin, end
+}
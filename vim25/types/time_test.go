@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeValue(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 30, 0, 0, time.FixedZone("PST", -8*3600))
+
+	tests := []struct {
+		in    *time.Time
+		unset bool
+	}{
+		{nil, true},
+		{NewTime(time.Time{}), true},
+		{NewTime(time.Unix(0, 0)), true},
+		{&now, false},
+	}
+
+	for _, test := range tests {
+		if IsUnsetTime(test.in) != test.unset {
+			t.Errorf("IsUnsetTime(%v) != %t", test.in, test.unset)
+		}
+		v := TimeValue(test.in)
+		if test.unset {
+			if !v.IsZero() {
+				t.Errorf("TimeValue(%v)=%s", test.in, v)
+			}
+			if TimeToEpoch(test.in) != 0 || FormatTime(test.in, nil) != "" {
+				t.Errorf("expected unset %v", test.in)
+			}
+			continue
+		}
+		if !v.Equal(now) || v.Location() != time.UTC {
+			t.Errorf("TimeValue(%v)=%s", test.in, v)
+		}
+	}
+
+	if NewTimeValue(time.Time{}) != nil {
+		t.Error("expected nil")
+	}
+	if p := NewTimeValue(now); p == nil || p.Location() != time.UTC {
+		t.Errorf("NewTimeValue=%v", p)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	expect := time.Date(2024, 3, 1, 20, 30, 0, 0, time.UTC)
+
+	tests := []string{
+		"2024-03-01T20:30:00Z",
+		"2024-03-01T12:30:00-08:00",
+		"2024-03-01T20:30:00",
+		"2024-03-01T20:30:00.000",
+		"2024-03-01 20:30:00",
+		"1709325000",
+		"1709325000000",
+		"1709325000000000",
+	}
+
+	for _, s := range tests {
+		v, err := ParseTime(s)
+		if err != nil {
+			t.Errorf("ParseTime(%q): %s", s, err)
+			continue
+		}
+		if !v.Equal(expect) || v.Location() != time.UTC {
+			t.Errorf("ParseTime(%q)=%s", s, v)
+		}
+	}
+
+	if _, err := ParseTime("yesterday"); err == nil {
+		t.Error("expected error")
+	}
+
+	if s := FormatTime(&expect, time.FixedZone("PST", -8*3600)); s != "2024-03-01T12:30:00-08:00" {
+		t.Errorf("FormatTime=%s", s)
+	}
+	if n := TimeToEpoch(&expect); n != 1709325000 {
+		t.Errorf("TimeToEpoch=%d", n)
+	}
+}
+
+func TestHostTimeZoneLocation(t *testing.T) {
+	var zone *HostDateTimeSystemTimeZone
+	if zone.Location() != time.UTC {
+		t.Error("expected UTC")
+	}
+
+	zone = &HostDateTimeSystemTimeZone{Key: "no/such/zone", Name: "IST", GmtOffset: 19800}
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	name, offset := now.In(zone.Location()).Zone()
+	if name != "IST" || offset != 19800 {
+		t.Errorf("zone=%s offset=%d", name, offset)
+	}
+}
+
+func TestEventFilterSpecByTime(t *testing.T) {
+	if NewEventFilterSpecByTime(time.Time{}, time.Time{}) != nil {
+		t.Error("expected nil")
+	}
+
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	events := []BaseEvent{
+		&Event{ChainId: 1, CreatedTime: base.Add(time.Minute)},
+		&Event{ChainId: 2, CreatedTime: base},
+		&Event{ChainId: 1, CreatedTime: base.Add(3 * time.Minute)},
+		&Event{ChainId: 1, CreatedTime: base.Add(2 * time.Minute)},
+	}
+
+	begin, end := EventChainWindow(events, 1)
+	if !begin.Equal(base.Add(time.Minute)) || !end.Equal(base.Add(3*time.Minute)) {
+		t.Errorf("begin=%s end=%s", begin, end)
+	}
+
+	spec := NewEventFilterSpecByTime(begin, time.Time{})
+	if spec == nil || !spec.BeginTime.Equal(begin) || spec.EndTime != nil {
+		t.Errorf("spec=%#v", spec)
+	}
+
+	task := NewTaskFilterSpecByTime(TaskFilterSpecTimeOptionStartedTime, begin, end)
+	if task.TimeType != TaskFilterSpecTimeOptionStartedTime || !task.EndTime.Equal(end) {
+		t.Errorf("task=%#v", task)
+	}
+
+	begin, end = EventChainWindow(events, 3)
+	if !begin.IsZero() || !end.IsZero() {
+		t.Errorf("begin=%s end=%s", begin, end)
+	}
+}
@@ -56,6 +56,10 @@ var (
 		Type:  "VimClusterVsanVcStretchedClusterSystem",
 		Value: "vsan-stretched-cluster-system",
 	}
+	VsanVcClusterHealthSystemInstance = vimtypes.ManagedObjectReference{
+		Type:  "VsanVcClusterHealthSystem",
+		Value: "vsan-cluster-health-system",
+	}
 )
 
 // Client used for accessing vsan health APIs.
@@ -139,6 +143,21 @@ func (c *Client) VsanQueryObjectIdentities(ctx context.Context, cluster vimtypes
 	return res.Returnval, nil
 }
 
+// VsanQueryVcClusterHealthSummary returns the health summary of the given vSAN cluster.
+func (c *Client) VsanQueryVcClusterHealthSummary(ctx context.Context, cluster vimtypes.ManagedObjectReference) (*vsantypes.VsanClusterHealthSummary, error) {
+	req := vsantypes.VsanQueryVcClusterHealthSummary{
+		This:    VsanVcClusterHealthSystemInstance,
+		Cluster: &cluster,
+	}
+
+	res, err := methods.VsanQueryVcClusterHealthSummary(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
 // VsanHostGetConfig returns the config of host's vSAN system.
 func (c *Client) VsanHostGetConfig(ctx context.Context, vsanSystem vimtypes.ManagedObjectReference) (*vsantypes.VsanHostConfigInfoEx, error) {
 	req := vimtypes.RetrievePropertiesEx{
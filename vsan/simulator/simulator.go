@@ -17,9 +17,20 @@ limitations under the License.
 package simulator
 
 import (
+	"fmt"
+	"math/rand"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 
+	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	vimmethods "github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/soap"
 	vim "github.com/vmware/govmomi/vim25/types"
 	"github.com/vmware/govmomi/vsan"
@@ -44,13 +55,83 @@ func New() *simulator.Registry {
 		ManagedObjectReference: vsan.VsanVcStretchedClusterSystem,
 	})
 
-	r.Put(&ClusterConfigSystem{
+	config := &ClusterConfigSystem{
 		ManagedObjectReference: vsan.VsanVcClusterConfigSystemInstance,
+	}
+	r.Put(config)
+
+	r.Put(&PerformanceManager{
+		ManagedObjectReference: vsan.VsanPerformanceManagerInstance,
+		config:                 config,
+	})
+
+	r.Put(&ObjectSystem{
+		ManagedObjectReference: vsan.VsanQueryObjectIdentitiesInstance,
+	})
+
+	r.Put(&ClusterHealthSystem{
+		ManagedObjectReference: vsan.VsanVcClusterHealthSystemInstance,
+	})
+
+	r.Put(&PropertyCollector{
+		ManagedObjectReference: vsan.VsanPropertyCollectorInstance,
 	})
 
 	return r
 }
 
+// cluster returns the vSAN enabled cluster with the given reference.
+func cluster(ref *vim.ManagedObjectReference) (*simulator.ClusterComputeResource, *soap.Fault) {
+	if ref == nil {
+		return nil, simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "cluster"})
+	}
+
+	c, ok := simulator.Map.Get(*ref).(*simulator.ClusterComputeResource)
+	if !ok {
+		return nil, simulator.Fault("", &vim.ManagedObjectNotFound{Obj: *ref})
+	}
+
+	info := c.ConfigurationEx.(*vim.ClusterConfigInfoEx).VsanConfigInfo
+	if info == nil || info.Enabled == nil || !*info.Enabled {
+		return nil, simulator.Fault(fmt.Sprintf("vSAN is not enabled on cluster %s", c.Name), &vim.VsanFault{})
+	}
+
+	return c, nil
+}
+
+// clusterUuid returns the vSAN cluster uuid of the given vSAN enabled cluster.
+func clusterUuid(c *simulator.ClusterComputeResource) string {
+	return c.ConfigurationEx.(*vim.ClusterConfigInfoEx).VsanConfigInfo.DefaultConfig.Uuid
+}
+
+// nodeUuids returns the vSAN node uuids of the given cluster's hosts.
+func nodeUuids(c *simulator.ClusterComputeResource) []string {
+	var ids []string
+
+	for _, ref := range c.Host {
+		host := simulator.Map.Get(ref).(*simulator.HostSystem)
+		if config := host.Config.VsanHostConfig; config != nil && config.ClusterInfo != nil {
+			ids = append(ids, config.ClusterInfo.NodeUuid)
+		}
+	}
+
+	return ids
+}
+
+// vms returns the VMs registered to the given cluster's hosts.
+func vms(c *simulator.ClusterComputeResource) []*simulator.VirtualMachine {
+	var list []*simulator.VirtualMachine
+
+	for _, ref := range c.Host {
+		host := simulator.Map.Get(ref).(*simulator.HostSystem)
+		for _, vm := range host.Vm {
+			list = append(list, simulator.Map.Get(vm).(*simulator.VirtualMachine))
+		}
+	}
+
+	return list
+}
+
 type StretchedClusterSystem struct {
 	vim.ManagedObjectReference
 }
@@ -88,9 +169,44 @@ func (s *ClusterConfigSystem) info(ref vim.ManagedObjectReference) *types.VsanCo
 		s.Config[ref] = info
 	}
 
+	// vSAN enablement is owned by the cluster's vim config, such as set via simulator.Model.Vsan
+	if c, ok := simulator.Map.Get(ref).(*simulator.ClusterComputeResource); ok {
+		if config := c.ConfigurationEx.(*vim.ClusterConfigInfoEx).VsanConfigInfo; config != nil {
+			info.Enabled = config.Enabled
+			if config.DefaultConfig != nil {
+				info.DefaultConfig = config.DefaultConfig
+			}
+		}
+	}
+
 	return info
 }
 
+// reconfigure applies the given vSAN config to the cluster's vim config.
+func (s *ClusterConfigSystem) reconfigure(ref vim.ManagedObjectReference, config *types.VsanClusterConfigInfo) vim.BaseMethodFault {
+	c, ok := simulator.Map.Get(ref).(*simulator.ClusterComputeResource)
+	if !ok {
+		return &vim.ManagedObjectNotFound{Obj: ref}
+	}
+
+	ctx := simulator.SpoofContext()
+	spec := vim.VsanClusterConfigInfo(*config)
+
+	res := c.ReconfigureComputeResourceTask(ctx, &vim.ReconfigureComputeResource_Task{
+		This:   ref,
+		Spec:   &vim.ClusterConfigSpecEx{VsanConfig: &spec},
+		Modify: true,
+	})
+
+	task := ctx.Map.Get(res.(*vimmethods.ReconfigureComputeResource_TaskBody).Res.Returnval).(*simulator.Task)
+	task.Wait()
+	if task.Info.Error != nil {
+		return task.Info.Error.Fault
+	}
+
+	return nil
+}
+
 func (s *ClusterConfigSystem) VsanClusterGetConfig(ctx *simulator.Context, req *types.VsanClusterGetConfig) soap.HasFault {
 	return &methods.VsanClusterGetConfigBody{
 		Res: &types.VsanClusterGetConfigResponse{
@@ -102,7 +218,15 @@ func (s *ClusterConfigSystem) VsanClusterGetConfig(ctx *simulator.Context, req *
 func (s *ClusterConfigSystem) VsanClusterReconfig(ctx *simulator.Context, req *types.VsanClusterReconfig) soap.HasFault {
 	task := simulator.CreateTask(s, "vsanClusterReconfig", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
 		// TODO: validate req fields
+		if config := req.VsanReconfigSpec.VsanClusterConfig; config != nil {
+			if fault := s.reconfigure(req.Cluster, config.GetVsanClusterConfigInfo()); fault != nil {
+				return nil, fault
+			}
+		}
 		info := s.info(req.Cluster)
+		if req.VsanReconfigSpec.PerfsvcConfig != nil {
+			info.PerfsvcConfig = req.VsanReconfigSpec.PerfsvcConfig
+		}
 		if req.VsanReconfigSpec.UnmapConfig != nil {
 			info.UnmapConfig = req.VsanReconfigSpec.UnmapConfig
 		}
@@ -118,3 +242,370 @@ func (s *ClusterConfigSystem) VsanClusterReconfig(ctx *simulator.Context, req *t
 		},
 	}
 }
+
+// PerformanceManager generates random samples for the vSAN performance metrics of a cluster's entities.
+type PerformanceManager struct {
+	vim.ManagedObjectReference
+
+	config *ClusterConfigSystem
+}
+
+// perfMetrics are the labels of each supported entity type, returned when a VsanPerfQuerySpec does not specify any.
+var perfMetrics = map[string][]string{
+	"cluster-domclient":   {"iopsRead", "iopsWrite", "throughputRead", "throughputWrite", "latencyAvgRead", "latencyAvgWrite", "congestion", "oio"},
+	"cluster-domcompmgr":  {"iopsRead", "iopsWrite", "throughputRead", "throughputWrite", "latencyAvgRead", "latencyAvgWrite", "congestion", "oio"},
+	"host-domclient":      {"iopsRead", "iopsWrite", "throughputRead", "throughputWrite", "latencyAvgRead", "latencyAvgWrite", "congestion", "oio"},
+	"host-domcompmgr":     {"iopsRead", "iopsWrite", "throughputRead", "throughputWrite", "latencyAvgRead", "latencyAvgWrite", "congestion", "oio"},
+	"vsan-host-net":       {"rxThroughput", "txThroughput", "rxPackets", "txPackets", "rxPacketsLossRate", "txPacketsLossRate"},
+	"virtual-machine":     {"iopsRead", "iopsWrite", "throughputRead", "throughputWrite", "latencyRead", "latencyWrite"},
+	"vsan-cluster-space":  {"used", "total"},
+	"vsan-vnic-net":       {"rxThroughput", "txThroughput"},
+	"vsan-pnic-net":       {"rxThroughput", "txThroughput"},
+	"cluster-resyncstats": {"resyncBytesLeft", "resyncObjectsLeft"},
+}
+
+const (
+	perfInterval   = 300
+	perfMaxSamples = 1024
+	perfTimeFormat = "2006-01-02 15:04:05"
+)
+
+// entities expands the entity id of the given type, where "*" matches all entities of that type in the cluster.
+func (*PerformanceManager) entities(c *simulator.ClusterComputeResource, kind, id string) []string {
+	if id != "*" {
+		return []string{id}
+	}
+
+	switch {
+	case strings.HasPrefix(kind, "cluster-") || kind == "vsan-cluster-space":
+		return []string{clusterUuid(c)}
+	case kind == "virtual-machine":
+		var ids []string
+		for _, vm := range vms(c) {
+			ids = append(ids, vm.Config.InstanceUuid)
+		}
+		return ids
+	default:
+		return nodeUuids(c)
+	}
+}
+
+func (m *PerformanceManager) query(c *simulator.ClusterComputeResource, spec types.VsanPerfQuerySpec) ([]types.VsanPerfEntityMetricCSV, vim.BaseMethodFault) {
+	kind, id, ok := strings.Cut(spec.EntityRefId, ":")
+	labels, known := perfMetrics[kind]
+	if !ok || !known {
+		return nil, &vim.InvalidArgument{InvalidProperty: "entityRefId"}
+	}
+	if len(spec.Labels) != 0 {
+		labels = spec.Labels
+	}
+
+	if spec.StartTime == nil || spec.EndTime == nil || spec.EndTime.Before(*spec.StartTime) {
+		return nil, &vim.InvalidArgument{InvalidProperty: "endTime"}
+	}
+
+	interval := int(spec.Interval)
+	if interval <= 0 {
+		interval = perfInterval
+	}
+
+	var samples []string
+	for t := spec.StartTime.UTC(); !t.After(*spec.EndTime) && len(samples) < perfMaxSamples; t = t.Add(time.Duration(interval) * time.Second) {
+		samples = append(samples, t.Format(perfTimeFormat))
+	}
+
+	var res []types.VsanPerfEntityMetricCSV
+
+	for _, id := range m.entities(c, kind, id) {
+		metric := types.VsanPerfEntityMetricCSV{
+			EntityRefId: kind + ":" + id,
+			SampleInfo:  strings.Join(samples, ","),
+		}
+
+		for _, label := range labels {
+			values := make([]string, len(samples))
+			for i := range values {
+				values[i] = strconv.Itoa(rand.Intn(1000))
+			}
+
+			metric.Value = append(metric.Value, types.VsanPerfMetricSeriesCSV{
+				MetricId: types.VsanPerfMetricId{
+					Label:                  label,
+					Group:                  spec.Group,
+					MetricsCollectInterval: int32(interval),
+				},
+				Values: strings.Join(values, ","),
+			})
+		}
+
+		res = append(res, metric)
+	}
+
+	return res, nil
+}
+
+func (m *PerformanceManager) VsanPerfQueryPerf(ctx *simulator.Context, req *types.VsanPerfQueryPerf) soap.HasFault {
+	body := new(methods.VsanPerfQueryPerfBody)
+
+	c, fault := cluster(req.Cluster)
+	if fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
+	if config := m.config.info(c.Self).PerfsvcConfig; config != nil && !config.Enabled {
+		body.Fault_ = simulator.Fault("vSAN performance service is not enabled", &vim.VsanFault{})
+		return body
+	}
+
+	res := new(types.VsanPerfQueryPerfResponse)
+
+	for _, spec := range req.QuerySpecs {
+		metrics, err := m.query(c, spec)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return body
+		}
+		res.Returnval = append(res.Returnval, metrics...)
+	}
+
+	body.Res = res
+	return body
+}
+
+func (m *PerformanceManager) VsanPerfCreateStatsObject(ctx *simulator.Context, req *types.VsanPerfCreateStatsObject) soap.HasFault {
+	body := new(methods.VsanPerfCreateStatsObjectBody)
+
+	c, fault := cluster(req.Cluster)
+	if fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
+	m.config.info(c.Self).PerfsvcConfig = &types.VsanPerfsvcConfig{
+		Enabled: true,
+		Profile: req.Profile,
+	}
+
+	body.Res = &types.VsanPerfCreateStatsObjectResponse{
+		Returnval: uuid.NewSHA1(uuid.NameSpaceOID, []byte(c.Self.Value)).String(),
+	}
+
+	return body
+}
+
+func (m *PerformanceManager) VsanPerfDeleteStatsObject(ctx *simulator.Context, req *types.VsanPerfDeleteStatsObject) soap.HasFault {
+	body := new(methods.VsanPerfDeleteStatsObjectBody)
+
+	c, fault := cluster(req.Cluster)
+	if fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
+	m.config.info(c.Self).PerfsvcConfig = &types.VsanPerfsvcConfig{Enabled: false}
+
+	body.Res = &types.VsanPerfDeleteStatsObjectResponse{
+		Returnval: true,
+	}
+
+	return body
+}
+
+// ObjectSystem derives vSAN objects from the files of VMs on a cluster's vsanDatastore:
+// a "vmnamespace" object for the VM's home directory and a "vdisk" object per virtual disk.
+type ObjectSystem struct {
+	vim.ManagedObjectReference
+}
+
+// objectUuid returns a stable uuid for the vSAN object backing the given datastore path.
+func objectUuid(p object.DatastorePath) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(p.String())).String()
+}
+
+// identities returns the vSAN object identities of the given cluster.
+func (*ObjectSystem) identities(c *simulator.ClusterComputeResource) []types.VsanObjectIdentity {
+	var ids []types.VsanObjectIdentity
+
+	isVsan := func(name string) bool {
+		for _, ref := range c.Datastore {
+			ds := simulator.Map.Get(ref).(*simulator.Datastore)
+			if ds.Name == name {
+				return ds.Summary.Type == string(vim.HostFileSystemVolumeFileSystemTypeVsan)
+			}
+		}
+		return false
+	}
+
+	for _, vm := range vms(c) {
+		if vm.Config == nil {
+			continue
+		}
+
+		ref := vm.Reference()
+		var ns string
+
+		var p object.DatastorePath
+		if p.FromString(vm.Config.Files.VmPathName) && isVsan(p.Datastore) {
+			p.Path = path.Dir(p.Path)
+			ns = objectUuid(p)
+
+			ids = append(ids, types.VsanObjectIdentity{
+				Uuid:           ns,
+				Type:           "vmnamespace",
+				VmInstanceUuid: vm.Config.InstanceUuid,
+				VmNsObjectUuid: ns,
+				Vm:             &ref,
+				Description:    vm.Name,
+			})
+		}
+
+		for _, device := range vm.Config.Hardware.Device {
+			disk, ok := device.(*vim.VirtualDisk)
+			if !ok {
+				continue
+			}
+			backing, ok := disk.Backing.(vim.BaseVirtualDeviceFileBackingInfo)
+			if !ok {
+				continue
+			}
+
+			var p object.DatastorePath
+			if !p.FromString(backing.GetVirtualDeviceFileBackingInfo().FileName) || !isVsan(p.Datastore) {
+				continue
+			}
+
+			id := types.VsanObjectIdentity{
+				Uuid:           objectUuid(p),
+				Type:           "vdisk",
+				VmInstanceUuid: vm.Config.InstanceUuid,
+				VmNsObjectUuid: ns,
+				Vm:             &ref,
+			}
+			if info := disk.DeviceInfo; info != nil {
+				id.Description = info.GetDescription().Label
+			}
+
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// health returns the overall health of the given objects, all of which are healthy.
+func (*ObjectSystem) health(c *simulator.ClusterComputeResource, ids []types.VsanObjectIdentity) *types.VsanObjectOverallHealth {
+	detail := types.VsanObjectHealth{
+		NumObjects:      int32(len(ids)),
+		Health:          "healthy",
+		VsanClusterUuid: clusterUuid(c),
+	}
+
+	for _, id := range ids {
+		detail.ObjUuids = append(detail.ObjUuids, id.Uuid)
+	}
+
+	return &types.VsanObjectOverallHealth{
+		ObjectHealthDetail:      []types.VsanObjectHealth{detail},
+		ObjectVersionCompliance: vim.NewBool(true),
+	}
+}
+
+func (s *ObjectSystem) VsanQueryObjectIdentities(ctx *simulator.Context, req *types.VsanQueryObjectIdentities) soap.HasFault {
+	body := new(methods.VsanQueryObjectIdentitiesBody)
+
+	c, fault := cluster(req.Cluster)
+	if fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
+	var ids []types.VsanObjectIdentity
+
+	for _, id := range s.identities(c) {
+		if len(req.ObjUuids) != 0 && !slices.Contains(req.ObjUuids, id.Uuid) {
+			continue
+		}
+		if len(req.ObjTypes) != 0 && !slices.Contains(req.ObjTypes, id.Type) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	res := new(types.VsanObjectIdentityAndHealth)
+
+	if req.IncludeHealth != nil && *req.IncludeHealth {
+		res.Health = s.health(c, ids)
+	}
+	if req.IncludeObjIdentity == nil || *req.IncludeObjIdentity {
+		res.Identities = ids
+	}
+
+	body.Res = &types.VsanQueryObjectIdentitiesResponse{
+		Returnval: res,
+	}
+
+	return body
+}
+
+// ClusterHealthSystem reports all vSAN clusters and their hosts as healthy.
+type ClusterHealthSystem struct {
+	vim.ManagedObjectReference
+}
+
+func (s *ClusterHealthSystem) VsanQueryVcClusterHealthSummary(ctx *simulator.Context, req *types.VsanQueryVcClusterHealthSummary) soap.HasFault {
+	body := new(methods.VsanQueryVcClusterHealthSummaryBody)
+
+	c, fault := cluster(req.Cluster)
+	if fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
+	now := time.Now()
+	status := &types.VsanClusterHealthSystemStatusResult{
+		Status:    "green",
+		GoalState: "installed",
+	}
+
+	for _, ref := range c.Host {
+		host := simulator.Map.Get(ref).(*simulator.HostSystem)
+		status.TrackedHostsStatus = append(status.TrackedHostsStatus, types.VsanHostHealthSystemStatusResult{
+			Hostname: host.Name,
+			Status:   "green",
+		})
+	}
+
+	var objects ObjectSystem
+
+	body.Res = &types.VsanQueryVcClusterHealthSummaryResponse{
+		Returnval: types.VsanClusterHealthSummary{
+			ClusterStatus:            status,
+			Timestamp:                &now,
+			ObjectHealth:             objects.health(c, objects.identities(c)),
+			OverallHealth:            "green",
+			OverallHealthDescription: "No issues found",
+			Cluster:                  &c.Self,
+		},
+	}
+
+	return body
+}
+
+// PropertyCollector retrieves vim properties via the vSAN endpoint, such as HostVsanSystem.config.
+type PropertyCollector struct {
+	vim.ManagedObjectReference
+}
+
+func (*PropertyCollector) RetrievePropertiesEx(ctx *simulator.Context, req *vim.RetrievePropertiesEx) soap.HasFault {
+	// objects are retrieved from the vim25 inventory (aka global Map)
+	vimCtx := simulator.SpoofContext()
+
+	content := vimCtx.Map.Get(vim25.ServiceInstance).(*simulator.ServiceInstance).Content
+	pc := vimCtx.Map.Get(content.PropertyCollector).(*simulator.PropertyCollector)
+
+	r := *req
+	r.This = pc.Self
+
+	return pc.RetrievePropertiesEx(vimCtx, &r)
+}
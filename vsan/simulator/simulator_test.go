@@ -0,0 +1,202 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vsan"
+	"github.com/vmware/govmomi/vsan/types"
+
+	_ "github.com/vmware/govmomi/vsan/simulator"
+)
+
+func TestVsanCluster(t *testing.T) {
+	m := simulator.VPX()
+	m.Cluster = 2
+	m.Vsan = 1
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+
+		ds, err := finder.Datastore(ctx, "vsanDatastore")
+		if err != nil {
+			return err
+		}
+
+		var mds mo.Datastore
+		if err = ds.Properties(ctx, ds.Reference(), []string{"summary", "info"}, &mds); err != nil {
+			return err
+		}
+		if mds.Summary.Type != string(vim.HostFileSystemVolumeFileSystemTypeVsan) {
+			t.Errorf("type=%s", mds.Summary.Type)
+		}
+
+		vc, err := vsan.NewClient(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		c0, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			return err
+		}
+		c1, err := finder.ClusterComputeResource(ctx, "DC0_C1")
+		if err != nil {
+			return err
+		}
+
+		config, err := vc.VsanClusterGetConfig(ctx, c0.Reference())
+		if err != nil {
+			return err
+		}
+		if config.Enabled == nil || !*config.Enabled {
+			t.Error("vSAN not enabled")
+		}
+		if info, ok := mds.Info.(*vim.VsanDatastoreInfo); !ok || info.MembershipUuid != config.DefaultConfig.Uuid {
+			t.Errorf("info=%#v", mds.Info)
+		}
+
+		// the vsanDatastore is only mounted on the hosts of the vSAN cluster
+		for _, cluster := range []*object.ClusterComputeResource{c0, c1} {
+			hosts, err := cluster.Hosts(ctx)
+			if err != nil {
+				return err
+			}
+			for _, host := range hosts {
+				var mhost mo.HostSystem
+				if err = host.Properties(ctx, host.Reference(), []string{"datastore"}, &mhost); err != nil {
+					return err
+				}
+				mounted := slices.Contains(mhost.Datastore, ds.Reference())
+				if mounted != (cluster == c0) {
+					t.Errorf("%s mounted=%t", host.Name(), mounted)
+				}
+			}
+		}
+
+		hosts, err := c0.Hosts(ctx)
+		if err != nil {
+			return err
+		}
+		var host mo.HostSystem
+		if err = hosts[0].Properties(ctx, hosts[0].Reference(), []string{"configManager"}, &host); err != nil {
+			return err
+		}
+		hostConfig, err := vc.VsanHostGetConfig(ctx, *host.ConfigManager.VsanSystem)
+		if err != nil {
+			return err
+		}
+		if hostConfig.ClusterInfo.Uuid != config.DefaultConfig.Uuid || hostConfig.ClusterInfo.NodeUuid == "" {
+			t.Errorf("cluster info=%#v", hostConfig.ClusterInfo)
+		}
+
+		// vSAN is not enabled on the second cluster
+		if _, err = vc.VsanQueryVcClusterHealthSummary(ctx, c1.Reference()); err == nil {
+			t.Error("expected error")
+		}
+
+		summary, err := vc.VsanQueryVcClusterHealthSummary(ctx, c0.Reference())
+		if err != nil {
+			return err
+		}
+		if summary.OverallHealth != "green" || len(summary.ClusterStatus.TrackedHostsStatus) != m.ClusterHost {
+			t.Errorf("summary=%#v", summary)
+		}
+
+		// relocate a VM's home and disk to the vsanDatastore
+		vm, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			return err
+		}
+		task, err := vm.Relocate(ctx, vim.VirtualMachineRelocateSpec{Datastore: vim.NewReference(ds.Reference())}, vim.VirtualMachineMovePriorityDefaultPriority)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		objects, err := vc.VsanQueryObjectIdentities(ctx, c0.Reference())
+		if err != nil {
+			return err
+		}
+		kinds := map[string]int{}
+		for _, id := range objects.Identities {
+			if *id.Vm != vm.Reference() {
+				t.Errorf("vm=%s", id.Vm)
+			}
+			kinds[id.Type]++
+		}
+		if kinds["vmnamespace"] != 1 || kinds["vdisk"] != 1 {
+			t.Errorf("objects=%v", kinds)
+		}
+
+		end := time.Now()
+		start := end.Add(-time.Hour)
+		spec := []types.VsanPerfQuerySpec{
+			{EntityRefId: "cluster-domclient:*", StartTime: &start, EndTime: &end},
+			{EntityRefId: "host-domclient:*", StartTime: &start, EndTime: &end, Labels: []string{"iopsRead"}},
+		}
+		metrics, err := vc.VsanPerfQueryPerf(ctx, vim.NewReference(c0.Reference()), spec)
+		if err != nil {
+			return err
+		}
+		if len(metrics) != 1+m.ClusterHost {
+			t.Fatalf("metrics=%d", len(metrics))
+		}
+		if n := len(strings.Split(metrics[0].SampleInfo, ",")); n != 13 {
+			t.Errorf("samples=%d", n)
+		}
+		if n := len(metrics[1].Value); n != 1 || metrics[1].Value[0].MetricId.Label != "iopsRead" {
+			t.Errorf("values=%#v", metrics[1].Value)
+		}
+
+		spec[0].EntityRefId = "invalid"
+		if _, err = vc.VsanPerfQueryPerf(ctx, vim.NewReference(c0.Reference()), spec); err == nil {
+			t.Error("expected error")
+		}
+
+		// enable vSAN via the vSAN API
+		task, err = vc.VsanClusterReconfig(ctx, c1.Reference(), types.VimVsanReconfigSpec{
+			VsanClusterConfig: &types.VsanClusterConfigInfo{Enabled: vim.NewBool(true)},
+			Modify:            true,
+		})
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		_, err = vc.VsanQueryVcClusterHealthSummary(ctx, c1.Reference())
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -14,6 +14,8 @@ limitations under the License.
 package types
 
 import (
+	"reflect"
+
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -100,3 +102,7 @@ func (b *VsanIscsiTargetServiceConfig) GetVsanIscsiTargetServiceConfig() *VsanIs
 type BaseVsanIscsiTargetServiceConfig interface {
 	GetVsanIscsiTargetServiceConfig() *VsanIscsiTargetServiceConfig
 }
+
+func init() {
+	types.Add("vsan:VsanClusterConfigInfo", reflect.TypeOf((*VsanClusterConfigInfo)(nil)).Elem())
+}
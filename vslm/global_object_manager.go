@@ -20,6 +20,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/vmware/govmomi/fault"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -644,6 +645,76 @@ func (this *GlobalObjectManager) RetrieveMetadataValue(ct context.Context, id vi
 	return res.Returnval, nil
 }
 
+// MetadataQuerySpec returns the query spec for use with ListObjectsForSpec to match objects
+// with the given metadata key and, if not empty, the given metadata value.
+// Note that each query spec is matched independently, such that an object matches if it has the given key
+// and any key with the given value. ListObjectsForMetadata matches the key and value of the same entry.
+func MetadataQuerySpec(key, value string) []types.VslmVsoVStorageObjectQuerySpec {
+	equals := string(types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEquals)
+
+	query := []types.VslmVsoVStorageObjectQuerySpec{{
+		QueryField:    string(types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataKey),
+		QueryOperator: equals,
+		QueryValue:    []string{key},
+	}}
+
+	if value != "" {
+		query = append(query, types.VslmVsoVStorageObjectQuerySpec{
+			QueryField:    string(types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataValue),
+			QueryOperator: equals,
+			QueryValue:    []string{value},
+		})
+	}
+
+	return query
+}
+
+// ListObjectsForMetadata returns the IDs of all objects with the given metadata key and, if not empty, value.
+// Results are paged through ListObjectsForSpec until all records are returned.
+// When value is not empty, the value of each matching object's key is verified using RetrieveMetadataValue.
+func (this *GlobalObjectManager) ListObjectsForMetadata(ctx context.Context, key, value string) ([]vim.ID, error) {
+	const maxResult = 100
+	var ids []vim.ID
+
+	query := MetadataQuerySpec(key, value)
+
+	for {
+		res, err := this.ListObjectsForSpec(ctx, query, maxResult)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return ids, nil
+		}
+
+		for _, id := range res.Id {
+			if value != "" {
+				val, err := this.RetrieveMetadataValue(ctx, id, nil, key)
+				if err != nil {
+					if fault.Is(err, &vim.KeyNotFound{}) || fault.Is(err, &vim.NotFound{}) {
+						continue // key removed or object deleted since the query
+					}
+					return nil, err
+				}
+				if val != value {
+					continue // value matched another key
+				}
+			}
+			ids = append(ids, id)
+		}
+
+		if res.AllRecordsReturned || len(res.Id) == 0 {
+			return ids, nil
+		}
+
+		query = append(MetadataQuerySpec(key, value), types.VslmVsoVStorageObjectQuerySpec{
+			QueryField:    string(types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumId),
+			QueryOperator: string(types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThan),
+			QueryValue:    []string{res.Id[len(res.Id)-1].Id},
+		})
+	}
+}
+
 func (this *GlobalObjectManager) RetrieveObjects(ct context.Context, ids []vim.ID) ([]types.VslmVsoVStorageObjectResult,
 	error) {
 	req := types.VslmRetrieveVStorageObjects{
@@ -410,6 +410,74 @@ func (m ObjectManager) RetrieveSnapshotInfo(ctx context.Context, ds mo.Reference
 	return &res.Returnval, nil
 }
 
+// RetrieveSnapshotDetails returns the details of a VStorageObject snapshot, such as the path of its backing file.
+// Snapshot details can only be retrieved from vCenter.
+func (m ObjectManager) RetrieveSnapshotDetails(ctx context.Context, ds mo.Reference, id, sid string) (*types.VStorageObjectSnapshotDetails, error) {
+	if !m.isVC {
+		return nil, errors.New("RetrieveSnapshotDetails is only supported by VcenterVStorageObjectManager")
+	}
+
+	req := types.RetrieveSnapshotDetails{
+		This:       m.Reference(),
+		Datastore:  ds.Reference(),
+		Id:         types.ID{Id: id},
+		SnapshotId: types.ID{Id: sid},
+	}
+
+	res, err := methods.RetrieveSnapshotDetails(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
+// Revert reverts a VStorageObject to the given snapshot, deleting the snapshots taken after it.
+func (m ObjectManager) Revert(ctx context.Context, ds mo.Reference, id, sid string) (*object.Task, error) {
+	req := types.RevertVStorageObject_Task{
+		This:       m.Reference(),
+		Datastore:  ds.Reference(),
+		Id:         types.ID{Id: id},
+		SnapshotId: types.ID{Id: sid},
+	}
+
+	if m.isVC {
+		res, err := methods.RevertVStorageObject_Task(ctx, m.c, &req)
+		if err != nil {
+			return nil, err
+		}
+
+		return object.NewTask(m.c, res.Returnval), nil
+	}
+
+	res, err := methods.HostVStorageObjectRevert_Task(ctx, m.c, (*types.HostVStorageObjectRevert_Task)(&req))
+	if err != nil {
+		return nil, err
+	}
+
+	return object.NewTask(m.c, res.Returnval), nil
+}
+
+// RetrieveAssociations returns the VM disk associations of the given VStorageObjects.
+// Associations can only be retrieved from vCenter.
+func (m ObjectManager) RetrieveAssociations(ctx context.Context, ids []types.RetrieveVStorageObjSpec) ([]types.VStorageObjectAssociations, error) {
+	if !m.isVC {
+		return nil, errors.New("RetrieveAssociations is only supported by VcenterVStorageObjectManager")
+	}
+
+	req := types.RetrieveVStorageObjectAssociations{
+		This: m.Reference(),
+		Ids:  ids,
+	}
+
+	res, err := methods.RetrieveVStorageObjectAssociations(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
 func (m ObjectManager) AttachTag(ctx context.Context, id string, tag types.VslmTagEntry) error {
 	req := &types.AttachTagToVStorageObject{
 		This:     m.ManagedObjectReference,
@@ -461,6 +529,88 @@ func (m ObjectManager) ListAttachedTags(ctx context.Context, id string) ([]types
 	return res.Returnval, nil
 }
 
+// UpdateMetadata adds or replaces the given metadata key/value pairs of a VStorageObject
+// and removes the metadata with the given deleteKeys.
+func (m ObjectManager) UpdateMetadata(ctx context.Context, ds mo.Reference, id string, metadata []types.KeyValue, deleteKeys []string) (*object.Task, error) {
+	req := types.VCenterUpdateVStorageObjectMetadataEx_Task{
+		This:       m.Reference(),
+		Datastore:  ds.Reference(),
+		Id:         types.ID{Id: id},
+		Metadata:   metadata,
+		DeleteKeys: deleteKeys,
+	}
+
+	if m.isVC {
+		res, err := methods.VCenterUpdateVStorageObjectMetadataEx_Task(ctx, m.c, &req)
+		if err != nil {
+			return nil, err
+		}
+
+		return object.NewTask(m.c, res.Returnval), nil
+	}
+
+	res, err := methods.HostUpdateVStorageObjectMetadataEx_Task(ctx, m.c, (*types.HostUpdateVStorageObjectMetadataEx_Task)(&req))
+	if err != nil {
+		return nil, err
+	}
+
+	return object.NewTask(m.c, res.Returnval), nil
+}
+
+// RetrieveMetadata returns the metadata of a VStorageObject or one of its snapshots, if sid is not empty,
+// limited to the keys with the given prefix, if any.
+// Metadata can only be retrieved from an ESX host, use GlobalObjectManager.RetrieveMetadata with vCenter.
+func (m ObjectManager) RetrieveMetadata(ctx context.Context, ds mo.Reference, id, sid, prefix string) ([]types.KeyValue, error) {
+	if m.isVC {
+		return nil, errors.New("RetrieveMetadata is not supported by VcenterVStorageObjectManager")
+	}
+
+	req := types.HostRetrieveVStorageObjectMetadata{
+		This:      m.Reference(),
+		Datastore: ds.Reference(),
+		Id:        types.ID{Id: id},
+		Prefix:    prefix,
+	}
+
+	if sid != "" {
+		req.SnapshotId = &types.ID{Id: sid}
+	}
+
+	res, err := methods.HostRetrieveVStorageObjectMetadata(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// RetrieveMetadataValue returns the metadata value of the given key for a VStorageObject
+// or one of its snapshots, if sid is not empty.
+// Metadata can only be retrieved from an ESX host, use GlobalObjectManager.RetrieveMetadataValue with vCenter.
+func (m ObjectManager) RetrieveMetadataValue(ctx context.Context, ds mo.Reference, id, sid, key string) (string, error) {
+	if m.isVC {
+		return "", errors.New("RetrieveMetadataValue is not supported by VcenterVStorageObjectManager")
+	}
+
+	req := types.HostRetrieveVStorageObjectMetadataValue{
+		This:      m.Reference(),
+		Datastore: ds.Reference(),
+		Id:        types.ID{Id: id},
+		Key:       key,
+	}
+
+	if sid != "" {
+		req.SnapshotId = &types.ID{Id: sid}
+	}
+
+	res, err := methods.HostRetrieveVStorageObjectMetadataValue(ctx, m.c, &req)
+	if err != nil {
+		return "", err
+	}
+
+	return res.Returnval, nil
+}
+
 func (m ObjectManager) ReconcileDatastoreInventory(ctx context.Context, ds mo.Reference) (*object.Task, error) {
 	req := &types.ReconcileDatastoreInventory_Task{
 		This:      m.Reference(),
@@ -0,0 +1,270 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/simulator/vpx"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vslm"
+	"github.com/vmware/govmomi/vslm/methods"
+	"github.com/vmware/govmomi/vslm/types"
+)
+
+var content = types.VslmServiceInstanceContent{
+	AboutInfo: types.VslmAboutInfo{
+		Name:         "VMware Virtual Storage Lifecycle Manager Service",
+		FullName:     "VMware Virtual Storage Lifecycle Manager Service 1.0.0",
+		Vendor:       "VMware, Inc.",
+		ApiVersion:   "1.0.0",
+		InstanceUuid: "8ba1ef9b-a6d6-4ce1-8796-5cdd5f1d0f4e",
+	},
+	SessionManager:          vim.ManagedObjectReference{Type: "VslmSessionManager", Value: "SessionManager"},
+	VStorageObjectManager:   vim.ManagedObjectReference{Type: "VslmVStorageObjectManager", Value: "VStorageObjectManager"},
+	StorageLifecycleManager: vim.ManagedObjectReference{Type: "VslmStorageLifecycleManager", Value: "StorageLifecycleManager"},
+}
+
+func init() {
+	simulator.RegisterEndpoint(func(s *simulator.Service, r *simulator.Registry) {
+		if r.IsVPX() {
+			s.RegisterSDK(New())
+		}
+	})
+}
+
+func New() *simulator.Registry {
+	r := simulator.NewRegistry()
+	r.Namespace = vslm.Namespace
+	r.Path = vslm.Path
+
+	r.Put(&ServiceInstance{
+		ManagedObjectReference: vslm.ServiceInstance,
+		Content:                content,
+	})
+
+	r.Put(&VStorageObjectManager{
+		ManagedObjectReference: content.VStorageObjectManager,
+	})
+
+	return r
+}
+
+type ServiceInstance struct {
+	vim.ManagedObjectReference
+
+	Content types.VslmServiceInstanceContent
+}
+
+func (s *ServiceInstance) RetrieveContent(_ *types.RetrieveContent) soap.HasFault {
+	return &methods.RetrieveContentBody{
+		Res: &types.RetrieveContentResponse{
+			Returnval: s.Content,
+		},
+	}
+}
+
+// VStorageObjectManager implements the global vslm APIs, using the objects
+// managed by the vim25 simulator's VcenterVStorageObjectManager.
+type VStorageObjectManager struct {
+	vim.ManagedObjectReference
+}
+
+// metadata returns the metadata of the given object or snapshot, limited to the keys with the given prefix.
+func (m *VStorageObjectManager) metadata(ctx *simulator.Context, id vim.ID, sid *vim.ID, prefix string) ([]vim.KeyValue, *soap.Fault) {
+	vsom := simulator.Map.Get(*vpx.ServiceContent.VStorageObjectManager).(*simulator.VcenterVStorageObjectManager)
+
+	var res []vim.KeyValue
+	var fault vim.BaseMethodFault
+
+	simulator.Map.WithLock(ctx, vsom, func() {
+		res, fault = vsom.ObjectMetadata(id, sid, prefix)
+	})
+
+	if fault != nil {
+		return nil, simulator.Fault("", fault)
+	}
+
+	return res, nil
+}
+
+func (m *VStorageObjectManager) VslmRetrieveVStorageObjectMetadata(ctx *simulator.Context, req *types.VslmRetrieveVStorageObjectMetadata) soap.HasFault {
+	body := new(methods.VslmRetrieveVStorageObjectMetadataBody)
+
+	res, fault := m.metadata(ctx, req.Id, req.SnapshotId, req.Prefix)
+	if fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
+	body.Res = &types.VslmRetrieveVStorageObjectMetadataResponse{
+		Returnval: res,
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRetrieveVStorageObjectMetadataValue(ctx *simulator.Context, req *types.VslmRetrieveVStorageObjectMetadataValue) soap.HasFault {
+	body := new(methods.VslmRetrieveVStorageObjectMetadataValueBody)
+
+	res, fault := m.metadata(ctx, req.Id, req.SnapshotId, req.Key)
+	if fault != nil {
+		body.Fault_ = fault
+		return body
+	}
+
+	for _, kv := range res {
+		if kv.Key == req.Key {
+			body.Res = &types.VslmRetrieveVStorageObjectMetadataValueResponse{
+				Returnval: kv.Value,
+			}
+			return body
+		}
+	}
+
+	body.Fault_ = simulator.Fault("", &vim.KeyNotFound{Key: req.Key})
+
+	return body
+}
+
+// queryFields are the query fields supported by VslmListVStorageObjectForSpec.
+var queryFields = []types.VslmVsoVStorageObjectQuerySpecQueryFieldEnum{
+	types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumId,
+	types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumName,
+	types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumDatastoreMoId,
+	types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataKey,
+	types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataValue,
+}
+
+// queryField returns the values of the given query field for obj.
+func queryField(obj *simulator.VStorageObject, field string) []string {
+	switch types.VslmVsoVStorageObjectQuerySpecQueryFieldEnum(field) {
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumId:
+		return []string{obj.Config.Id.Id}
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumName:
+		return []string{obj.Config.Name}
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumDatastoreMoId:
+		return []string{obj.Config.Backing.GetBaseConfigInfoBackingInfo().Datastore.Value}
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataKey,
+		types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataValue:
+		var values []string
+		for _, kv := range obj.Metadata {
+			if field == string(types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataKey) {
+				values = append(values, kv.Key)
+			} else {
+				values = append(values, kv.Value)
+			}
+		}
+		return values
+	}
+
+	return nil
+}
+
+// queryMatch returns true if any of the values compares to any of the spec's QueryValue using the spec's QueryOperator.
+func queryMatch(spec types.VslmVsoVStorageObjectQuerySpec, values []string) bool {
+	for _, val := range values {
+		for _, qval := range spec.QueryValue {
+			c := strings.Compare(val, qval)
+
+			var ok bool
+			switch types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnum(spec.QueryOperator) {
+			case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEquals:
+				ok = c == 0
+			case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumNotEquals:
+				ok = c != 0
+			case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumLessThan:
+				ok = c < 0
+			case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThan:
+				ok = c > 0
+			case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumLessThanOrEqual:
+				ok = c <= 0
+			case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThanOrEqual:
+				ok = c >= 0
+			case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumContains:
+				ok = strings.Contains(val, qval)
+			case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumStartsWith:
+				ok = strings.HasPrefix(val, qval)
+			case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEndsWith:
+				ok = strings.HasSuffix(val, qval)
+			}
+
+			if ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// VslmListVStorageObjectForSpec returns the IDs of the objects matching all of the query specs, in ascending order.
+// As with vCenter, each spec is matched independently, such that the metadataKey and metadataValue
+// fields may match different metadata entries of the same object.
+func (m *VStorageObjectManager) VslmListVStorageObjectForSpec(ctx *simulator.Context, req *types.VslmListVStorageObjectForSpec) soap.HasFault {
+	body := new(methods.VslmListVStorageObjectForSpecBody)
+
+	for _, spec := range req.Query {
+		if !slices.Contains(queryFields, types.VslmVsoVStorageObjectQuerySpecQueryFieldEnum(spec.QueryField)) {
+			body.Fault_ = simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "query.queryField"})
+			return body
+		}
+		if !slices.Contains(types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnum("").Strings(), spec.QueryOperator) {
+			body.Fault_ = simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "query.queryOperator"})
+			return body
+		}
+	}
+
+	vsom := simulator.Map.Get(*vpx.ServiceContent.VStorageObjectManager).(*simulator.VcenterVStorageObjectManager)
+
+	var ids []vim.ID
+
+	simulator.Map.WithLock(ctx, vsom, func() {
+		for _, obj := range vsom.Objects() {
+			match := true
+			for _, spec := range req.Query {
+				if !queryMatch(spec, queryField(obj, spec.QueryField)) {
+					match = false
+					break
+				}
+			}
+			if match {
+				ids = append(ids, obj.Config.Id)
+			}
+		}
+	})
+
+	slices.SortFunc(ids, func(a, b vim.ID) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+
+	res := &types.VslmVsoVStorageObjectQueryResult{AllRecordsReturned: true}
+	if req.MaxResult > 0 && len(ids) > int(req.MaxResult) {
+		ids = ids[:req.MaxResult]
+		res.AllRecordsReturned = false
+	}
+	res.Id = ids
+
+	body.Res = &types.VslmListVStorageObjectForSpecResponse{
+		Returnval: res,
+	}
+
+	return body
+}
@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2024-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator_test
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vslm"
+	vslmtypes "github.com/vmware/govmomi/vslm/types"
+
+	_ "github.com/vmware/govmomi/vslm/simulator"
+)
+
+func TestRetrieveMetadata(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		m := vslm.NewObjectManager(c)
+		ds := simulator.Map.Any("Datastore").(*simulator.Datastore)
+
+		task, err := m.CreateDisk(ctx, types.VslmCreateSpec{
+			Name:         "pvc-0",
+			CapacityInMB: 10,
+			BackingSpec: &types.VslmCreateSpecDiskFileBackingSpec{
+				VslmCreateSpecBackingSpec: types.VslmCreateSpecBackingSpec{Datastore: ds.Self},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := task.WaitForResult(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := res.Result.(types.VStorageObject).Config.Id
+
+		update := func(metadata ...types.KeyValue) {
+			task, err := m.UpdateMetadata(ctx, ds, id.Id, metadata, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		update(types.KeyValue{Key: "k8s.pvc", Value: "pvc-0"}, types.KeyValue{Key: "owner", Value: "ops"})
+
+		task, err = m.CreateSnapshot(ctx, ds, id.Id, "snap-0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err = task.WaitForResult(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sid := res.Result.(types.ID)
+
+		update(types.KeyValue{Key: "owner", Value: "dev"})
+
+		vc, err := vslm.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gm := vslm.NewGlobalObjectManager(vc)
+
+		md, err := gm.RetrieveMetadata(ctx, id, nil, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := []types.KeyValue{{Key: "k8s.pvc", Value: "pvc-0"}, {Key: "owner", Value: "dev"}}
+		if !reflect.DeepEqual(md, expect) {
+			t.Errorf("metadata=%#v", md)
+		}
+
+		md, err = gm.RetrieveMetadata(ctx, id, nil, "k8s.")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(md) != 1 || md[0].Key != "k8s.pvc" {
+			t.Errorf("metadata=%#v", md)
+		}
+
+		val, err := gm.RetrieveMetadataValue(ctx, id, &sid, "owner")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if val != "ops" {
+			t.Errorf("snapshot owner=%s", val)
+		}
+
+		_, err = gm.RetrieveMetadataValue(ctx, id, nil, "enoent")
+		if !fault.Is(err, &types.KeyNotFound{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		_, err = gm.RetrieveMetadata(ctx, id, &types.ID{Id: "enoent"}, "")
+		if !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		_, err = gm.RetrieveMetadata(ctx, types.ID{Id: "enoent"}, nil, "")
+		if !fault.Is(err, &types.NotFound{}) {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
+
+func TestListObjectsForMetadata(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		m := vslm.NewObjectManager(c)
+		ds := simulator.Map.Any("Datastore").(*simulator.Datastore)
+
+		create := func(name string, metadata ...types.KeyValue) types.ID {
+			task, err := m.CreateDisk(ctx, types.VslmCreateSpec{
+				Name:         name,
+				CapacityInMB: 10,
+				BackingSpec: &types.VslmCreateSpecDiskFileBackingSpec{
+					VslmCreateSpecBackingSpec: types.VslmCreateSpecBackingSpec{Datastore: ds.Self},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := task.WaitForResult(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			id := res.Result.(types.VStorageObject).Config.Id
+
+			task, err = m.UpdateMetadata(ctx, ds, id.Id, metadata, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			return id
+		}
+
+		id0 := create("disk-0", types.KeyValue{Key: "a", Value: "1"}, types.KeyValue{Key: "b", Value: "2"})
+		id1 := create("disk-1", types.KeyValue{Key: "a", Value: "2"})
+		_ = create("disk-2", types.KeyValue{Key: "b", Value: "1"})
+
+		vc, err := vslm.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gm := vslm.NewGlobalObjectManager(vc)
+
+		sorted := func(ids ...types.ID) []types.ID {
+			slices.SortFunc(ids, func(a, b types.ID) int { return strings.Compare(a.Id, b.Id) })
+			return ids
+		}
+
+		// the key and value query specs are matched independently
+		res, err := gm.ListObjectsForSpec(ctx, vslm.MetadataQuerySpec("a", "2"), 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res.Id, sorted(id0, id1)) {
+			t.Errorf("ids=%v", res.Id)
+		}
+
+		tests := []struct {
+			key, value string
+			expect     []types.ID
+		}{
+			{"a", "", sorted(id0, id1)},
+			{"a", "1", []types.ID{id0}},
+			{"a", "2", []types.ID{id1}},
+			{"b", "2", []types.ID{id0}},
+			{"c", "", nil},
+			{"a", "3", nil},
+		}
+
+		for _, test := range tests {
+			ids, err := gm.ListObjectsForMetadata(ctx, test.key, test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(ids, test.expect) {
+				t.Errorf("%s=%s: ids=%v", test.key, test.value, ids)
+			}
+		}
+
+		// results are paged
+		res, err = gm.ListObjectsForSpec(ctx, vslm.MetadataQuerySpec("a", ""), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.AllRecordsReturned || len(res.Id) != 1 {
+			t.Errorf("res=%#v", res)
+		}
+
+		_, err = gm.ListObjectsForSpec(ctx, []vslmtypes.VslmVsoVStorageObjectQuerySpec{{
+			QueryField:    "enoent",
+			QueryOperator: string(vslmtypes.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEquals),
+		}}, 1)
+		if !fault.Is(err, &types.InvalidArgument{}) {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
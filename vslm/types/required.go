@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2014-2024 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"reflect"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func init() {
+	types.AddRequiredFields(reflect.TypeOf((*RetrieveContent)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*RetrieveContentRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*RetrieveContentResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmAboutInfo)(nil)).Elem(), "Name", "FullName", "Vendor", "ApiVersion", "InstanceUuid")
+	types.AddRequiredFields(reflect.TypeOf((*VslmAttachDiskRequestType)(nil)).Elem(), "This", "Id", "Vm")
+	types.AddRequiredFields(reflect.TypeOf((*VslmAttachDisk_Task)(nil)).Elem(), "This", "Id", "Vm")
+	types.AddRequiredFields(reflect.TypeOf((*VslmAttachDisk_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmAttachTagToVStorageObject)(nil)).Elem(), "This", "Id", "Category", "Tag")
+	types.AddRequiredFields(reflect.TypeOf((*VslmAttachTagToVStorageObjectRequestType)(nil)).Elem(), "This", "Id", "Category", "Tag")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCancelTask)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCancelTaskRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmClearVStorageObjectControlFlags)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmClearVStorageObjectControlFlagsRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCloneVStorageObjectRequestType)(nil)).Elem(), "This", "Id", "Spec")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCloneVStorageObject_Task)(nil)).Elem(), "This", "Id", "Spec")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCloneVStorageObject_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCreateDiskFromSnapshotRequestType)(nil)).Elem(), "This", "Id", "SnapshotId", "Name")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCreateDiskFromSnapshot_Task)(nil)).Elem(), "This", "Id", "SnapshotId", "Name")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCreateDiskFromSnapshot_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCreateDiskRequestType)(nil)).Elem(), "This", "Spec")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCreateDisk_Task)(nil)).Elem(), "This", "Spec")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCreateDisk_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCreateSnapshotRequestType)(nil)).Elem(), "This", "Id", "Description")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCreateSnapshot_Task)(nil)).Elem(), "This", "Id", "Description")
+	types.AddRequiredFields(reflect.TypeOf((*VslmCreateSnapshot_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmDatastoreSyncStatus)(nil)).Elem(), "DatastoreURL", "ObjectVClock", "SyncVClock")
+	types.AddRequiredFields(reflect.TypeOf((*VslmDeleteSnapshotRequestType)(nil)).Elem(), "This", "Id", "SnapshotId")
+	types.AddRequiredFields(reflect.TypeOf((*VslmDeleteSnapshot_Task)(nil)).Elem(), "This", "Id", "SnapshotId")
+	types.AddRequiredFields(reflect.TypeOf((*VslmDeleteSnapshot_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmDeleteVStorageObjectRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmDeleteVStorageObject_Task)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmDeleteVStorageObject_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmDetachTagFromVStorageObject)(nil)).Elem(), "This", "Id", "Category", "Tag")
+	types.AddRequiredFields(reflect.TypeOf((*VslmDetachTagFromVStorageObjectRequestType)(nil)).Elem(), "This", "Id", "Category", "Tag")
+	types.AddRequiredFields(reflect.TypeOf((*VslmExtendDiskRequestType)(nil)).Elem(), "This", "Id", "NewCapacityInMB")
+	types.AddRequiredFields(reflect.TypeOf((*VslmExtendDisk_Task)(nil)).Elem(), "This", "Id", "NewCapacityInMB")
+	types.AddRequiredFields(reflect.TypeOf((*VslmExtendDisk_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmInflateDiskRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmInflateDisk_Task)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmInflateDisk_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmListTagsAttachedToVStorageObject)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmListTagsAttachedToVStorageObjectRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmListVStorageObjectForSpec)(nil)).Elem(), "This", "MaxResult")
+	types.AddRequiredFields(reflect.TypeOf((*VslmListVStorageObjectForSpecRequestType)(nil)).Elem(), "This", "MaxResult")
+	types.AddRequiredFields(reflect.TypeOf((*VslmListVStorageObjectsAttachedToTag)(nil)).Elem(), "This", "Category", "Tag")
+	types.AddRequiredFields(reflect.TypeOf((*VslmListVStorageObjectsAttachedToTagRequestType)(nil)).Elem(), "This", "Category", "Tag")
+	types.AddRequiredFields(reflect.TypeOf((*VslmLoginByToken)(nil)).Elem(), "This", "DelegatedTokenXml")
+	types.AddRequiredFields(reflect.TypeOf((*VslmLoginByTokenRequestType)(nil)).Elem(), "This", "DelegatedTokenXml")
+	types.AddRequiredFields(reflect.TypeOf((*VslmLogout)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmLogoutRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryChangedDiskAreas)(nil)).Elem(), "This", "Id", "SnapshotId", "StartOffset", "ChangeId")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryChangedDiskAreasRequestType)(nil)).Elem(), "This", "Id", "SnapshotId", "StartOffset", "ChangeId")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryChangedDiskAreasResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryDatastoreInfo)(nil)).Elem(), "This", "DatastoreUrl")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryDatastoreInfoRequestType)(nil)).Elem(), "This", "DatastoreUrl")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryDatastoreInfoResult)(nil)).Elem(), "Datacenter", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryGlobalCatalogSyncStatus)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryGlobalCatalogSyncStatusForDatastore)(nil)).Elem(), "This", "DatastoreURL")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryGlobalCatalogSyncStatusForDatastoreRequestType)(nil)).Elem(), "This", "DatastoreURL")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryGlobalCatalogSyncStatusRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryInfo)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryInfoRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryInfoResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryTaskResult)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmQueryTaskResultRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmReconcileDatastoreInventoryRequestType)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*VslmReconcileDatastoreInventory_Task)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*VslmReconcileDatastoreInventory_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRegisterDisk)(nil)).Elem(), "This", "Path")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRegisterDiskRequestType)(nil)).Elem(), "This", "Path")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRegisterDiskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRelocateVStorageObjectRequestType)(nil)).Elem(), "This", "Id", "Spec")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRelocateVStorageObject_Task)(nil)).Elem(), "This", "Id", "Spec")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRelocateVStorageObject_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRenameVStorageObject)(nil)).Elem(), "This", "Id", "Name")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRenameVStorageObjectRequestType)(nil)).Elem(), "This", "Id", "Name")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveSnapshotDetails)(nil)).Elem(), "This", "Id", "SnapshotId")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveSnapshotDetailsRequestType)(nil)).Elem(), "This", "Id", "SnapshotId")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveSnapshotDetailsResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveSnapshotInfo)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveSnapshotInfoRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveSnapshotInfoResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageInfrastructureObjectPolicy)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageInfrastructureObjectPolicyRequestType)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObject)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectAssociations)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectAssociationsRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectMetadata)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectMetadataRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectMetadataValue)(nil)).Elem(), "This", "Id", "Key")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectMetadataValueRequestType)(nil)).Elem(), "This", "Id", "Key")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectMetadataValueResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectState)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectStateRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectStateResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjects)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRetrieveVStorageObjectsRequestType)(nil)).Elem(), "This")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRevertVStorageObjectRequestType)(nil)).Elem(), "This", "Id", "SnapshotId")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRevertVStorageObject_Task)(nil)).Elem(), "This", "Id", "SnapshotId")
+	types.AddRequiredFields(reflect.TypeOf((*VslmRevertVStorageObject_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmScheduleReconcileDatastoreInventory)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*VslmScheduleReconcileDatastoreInventoryRequestType)(nil)).Elem(), "This", "Datastore")
+	types.AddRequiredFields(reflect.TypeOf((*VslmServiceInstanceContent)(nil)).Elem(), "AboutInfo", "SessionManager", "VStorageObjectManager", "StorageLifecycleManager")
+	types.AddRequiredFields(reflect.TypeOf((*VslmSetVStorageObjectControlFlags)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmSetVStorageObjectControlFlagsRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmSyncDatastore)(nil)).Elem(), "This", "DatastoreUrl", "FullSync")
+	types.AddRequiredFields(reflect.TypeOf((*VslmSyncDatastoreRequestType)(nil)).Elem(), "This", "DatastoreUrl", "FullSync")
+	types.AddRequiredFields(reflect.TypeOf((*VslmTaskInfo)(nil)).Elem(), "Key", "Task", "DescriptionId", "State", "Cancelled", "Cancelable", "Reason", "QueueTime", "EventChainId")
+	types.AddRequiredFields(reflect.TypeOf((*VslmTaskReasonAlarm)(nil)).Elem(), "AlarmName", "Alarm", "EntityName", "Entity")
+	types.AddRequiredFields(reflect.TypeOf((*VslmTaskReasonSchedule)(nil)).Elem(), "Name", "ScheduledTask")
+	types.AddRequiredFields(reflect.TypeOf((*VslmTaskReasonUser)(nil)).Elem(), "UserName")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVStorageInfrastructureObjectPolicyRequestType)(nil)).Elem(), "This", "Spec")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVStorageInfrastructureObjectPolicy_Task)(nil)).Elem(), "This", "Spec")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVStorageInfrastructureObjectPolicy_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVStorageObjectMetadataRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVStorageObjectMetadata_Task)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVStorageObjectMetadata_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVstorageObjectCryptoRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVstorageObjectCrypto_Task)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVstorageObjectCrypto_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVstorageObjectPolicyRequestType)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVstorageObjectPolicy_Task)(nil)).Elem(), "This", "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmUpdateVstorageObjectPolicy_TaskResponse)(nil)).Elem(), "Returnval")
+	types.AddRequiredFields(reflect.TypeOf((*VslmVsoVStorageObjectAssociations)(nil)).Elem(), "Id")
+	types.AddRequiredFields(reflect.TypeOf((*VslmVsoVStorageObjectAssociationsVmDiskAssociation)(nil)).Elem(), "VmId", "DiskKey")
+	types.AddRequiredFields(reflect.TypeOf((*VslmVsoVStorageObjectQueryResult)(nil)).Elem(), "AllRecordsReturned")
+	types.AddRequiredFields(reflect.TypeOf((*VslmVsoVStorageObjectQuerySpec)(nil)).Elem(), "QueryField", "QueryOperator")
+	types.AddRequiredFields(reflect.TypeOf((*VslmVsoVStorageObjectResult)(nil)).Elem(), "Id", "CapacityInMB")
+	types.AddRequiredFields(reflect.TypeOf((*VslmVsoVStorageObjectSnapshotResult)(nil)).Elem(), "BackingObjectId")
+}